@@ -48,6 +48,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	aoeEngine "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/engine"
 	aoeStorage "github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage"
+	taeDB "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	tpeEngine "github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tpe/tuplecodec"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -71,12 +72,15 @@ const (
 	WaitCubeStartExit       = 11
 	StartMOExit             = 12
 	CreateTpeExit           = 13
+	CreateTaeExit           = 14
 )
 
 var (
 	c   *catalog.Catalog
 	mo  *frontend.MOServer
 	pci *frontend.PDCallbackImpl
+	//the storage of the tables when the TAE is enabled
+	tae *taeDB.DB
 )
 
 var (
@@ -90,6 +94,7 @@ var (
 func createMOServer(callback *frontend.PDCallbackImpl) {
 	address := fmt.Sprintf("%s:%d", config.GlobalSystemVariables.GetHost(), config.GlobalSystemVariables.GetPort())
 	pu := config.NewParameterUnit(&config.GlobalSystemVariables, config.HostMmu, config.Mempool, config.StorageEngine, config.ClusterNodes, config.ClusterCatalog)
+	pu.TxnClient = config.TxnClient
 	mo = frontend.NewMOServer(address, pu, callback)
 	frontend.InitServerVersion(MoVersion)
 }
@@ -100,6 +105,18 @@ func runMOServer() error {
 
 func serverShutdown(isgraceful bool) {
 	mo.Stop()
+	closeTae()
+}
+
+//closeTae closes the TAE before the exit which skips the deferred functions
+func closeTae() {
+	if tae == nil {
+		return
+	}
+	if err := tae.Close(); err != nil {
+		logutil.Errorf("close tae failed. error:%v", err)
+	}
+	tae = nil
 }
 
 func registerSignalHandlers() {
@@ -235,6 +252,7 @@ func main() {
 
 	//put the node info to the computation
 	compile.InitAddress(addr)
+	compile.InitMaxRecursionDepth(config.GlobalSystemVariables.GetCteMaxRecursionDepth())
 
 	c = catalog.NewCatalog(a)
 	config.ClusterCatalog = c
@@ -247,6 +265,11 @@ func main() {
 	}
 	var eng engine.Engine
 	enableTpe := config.GlobalSystemVariables.GetEnableTpe()
+	enableTae := config.GlobalSystemVariables.GetEnableTae()
+	if enableTpe && enableTae {
+		logutil.Infof("enableTpe and enableTae can not be both true\n")
+		os.Exit(LoadConfigExit)
+	}
 	if enableTpe {
 		tpeConf := &tpeEngine.TpeConfig{}
 		tpeConf.PBKV = kvs
@@ -290,6 +313,15 @@ func main() {
 			os.Exit(CreateTpeExit)
 		}
 		eng = te
+	} else if enableTae {
+		//the tables are stored in the TAE and the sessions run the statements in its transactions,
+		//there is no engine out of the transactions
+		tae, err = taeDB.Open(targetDir+"/tae", nil)
+		if err != nil {
+			logutil.Infof("open tae error:%v\n", err)
+			os.Exit(CreateTaeExit)
+		}
+		config.TxnClient = tae.TxnClient()
 	} else {
 		eng = aoeEngine.New(c, &cngineConfig)
 	}
@@ -299,29 +331,35 @@ func main() {
 	li := strings.LastIndex(cfg.CubeConfig.ClientAddr, ":")
 	if li == -1 {
 		logutil.Infof("There is no port in client addr")
+		closeTae()
 		os.Exit(LoadConfigExit)
 	}
 	cubePort, err := strconv.ParseInt(string(cfg.CubeConfig.ClientAddr[li+1:]), 10, 32)
 	if err != nil {
 		logutil.Infof("Invalid port")
+		closeTae()
 		os.Exit(LoadConfigExit)
 	}
 
 	srv, err := rpcserver.New(fmt.Sprintf("%s:%d", Host, cubePort+100), 1<<30, logutil.GetGlobalLogger())
 	if err != nil {
 		logutil.Infof("Create rpcserver failed, %v", err)
+		closeTae()
 		os.Exit(CreateRPCExit)
 	}
 	hm := host.New(1 << 40)
 	gm := guest.New(1<<40, hm)
 	proc := process.New(mheap.New(gm))
-	hp := handler.New(eng, proc)
-	srv.Register(hp.Process)
+	if eng != nil {
+		hp := handler.New(eng, proc)
+		srv.Register(hp.Process)
+	}
 
 	err = waitClusterStartup(a, 300*time.Second, int(cfg.CubeConfig.Prophet.Replication.MaxReplicas), int(cfg.ClusterConfig.PreAllocatedGroupNum))
 
 	if err != nil {
 		logutil.Infof("wait cube cluster startup failed, %v", err)
+		closeTae()
 		os.Exit(WaitCubeStartExit)
 	}
 
@@ -331,9 +369,11 @@ func main() {
 
 	//test cluster nodes
 	config.ClusterNodes = engine.Nodes{}
-	err = tpeEngine.DumpDatabaseInfo(eng, args)
-	if err != nil {
-		logutil.Errorf("%s", err)
+	if eng != nil {
+		err = tpeEngine.DumpDatabaseInfo(eng, args)
+		if err != nil {
+			logutil.Errorf("%s", err)
+		}
 	}
 
 	createMOServer(pci)
@@ -341,6 +381,7 @@ func main() {
 	err = runMOServer()
 	if err != nil {
 		logutil.Infof("Start MOServer failed, %v", err)
+		closeTae()
 		os.Exit(StartMOExit)
 	}
 	//registerSignalHandlers()
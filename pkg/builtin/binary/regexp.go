@@ -0,0 +1,85 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binary
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/match"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	// expr REGEXP pattern and expr NOT REGEXP pattern are logical operators like LIKE
+	registerRegMatch("regexp", builtin.RegMatch, "%s regexp %s", match.Sels)
+	registerRegMatch("", builtin.NotRegMatch, "%s not regexp %s", match.NotSels)
+	overload.NegOps[builtin.RegMatch] = builtin.NotRegMatch
+	overload.NegOps[builtin.NotRegMatch] = builtin.RegMatch
+}
+
+func registerRegMatch(name string, op int, format string, fn func(xs, pats, matchTypes *types.Bytes, nsp *nulls.Nulls, rs []int64) ([]int64, error)) {
+	if name != "" {
+		extend.FunctionRegistry[name] = op
+	}
+	extend.BinaryReturnTypes[op] = func(_ extend.Extend, _ extend.Extend) types.T {
+		return types.T_sel
+	}
+	extend.BinaryStrings[op] = func(e extend.Extend, e2 extend.Extend) string {
+		return fmt.Sprintf(format, e, e2)
+	}
+	overload.OpTypes[op] = overload.Binary
+	overload.LogicalOps[op] = overload.MustLogical
+
+	regMatch := func(lv, rv *vector.Vector, proc *process.Process, _, _ bool) (*vector.Vector, error) {
+		lvs, rvs := lv.Col.(*types.Bytes), rv.Col.(*types.Bytes)
+		rows := len(lvs.Offsets)
+		if len(rvs.Offsets) > rows {
+			rows = len(rvs.Offsets)
+		}
+		nsp := new(nulls.Nulls)
+		for _, v := range []*vector.Vector{lv, rv} {
+			nulls.Set(nsp, builtin.ConstNulls(v, rows))
+		}
+		vec, err := process.Get(proc, int64(overload.SelsType.Size)*int64(rows), overload.SelsType)
+		if err != nil {
+			return nil, err
+		}
+		rs := encoding.DecodeInt64Slice(vec.Data)
+		rs = rs[:rows]
+		if rs, err = fn(lvs, rvs, nil, nsp, rs); err != nil {
+			process.Put(proc, vec)
+			return nil, err
+		}
+		vector.SetCol(vec, rs)
+		return vec, nil
+	}
+	for _, lt := range []types.T{types.T_char, types.T_varchar} {
+		for _, rt := range []types.T{types.T_char, types.T_varchar} {
+			overload.BinOps[op] = append(overload.BinOps[op], &overload.BinOp{
+				LeftType:   lt,
+				RightType:  rt,
+				ReturnType: types.T_sel,
+				Fn:         regMatch,
+			})
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/concat"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	// concat(str1, str2, ...) is null if any argument is null
+	extend.FunctionRegistry["concat"] = builtin.Concat
+	extend.MultiReturnTypes[builtin.Concat] = concatReturnType
+	extend.MultiStrings[builtin.Concat] = func(es []extend.Extend) string {
+		return fmt.Sprintf("concat(%s)", joinExtends(es))
+	}
+	overload.OpTypes[builtin.Concat] = overload.Multi
+	for _, typ := range stringTypes {
+		overload.MultiOps[builtin.Concat] = append(overload.MultiOps[builtin.Concat], &overload.MultiOp{
+			Min:        1,
+			Max:        -1,
+			Typ:        typ,
+			ReturnType: types.T_varchar,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount("concat", vecs, 1, -1); err != nil {
+					return nil, err
+				}
+				xss, err := stringArgs("concat", vecs)
+				if err != nil {
+					return nil, err
+				}
+				nsp := resultNulls(vecs, resultRows(vecs))
+				return builtin.NewBytesVector(proc, types.T_varchar, concat.Concat(xss, &types.Bytes{}), nsp)
+			},
+		})
+	}
+
+	// concat_ws(separator, str1, str2, ...) skips the null strings, and it is null if the separator is null
+	extend.FunctionRegistry["concat_ws"] = builtin.ConcatWs
+	extend.MultiReturnTypes[builtin.ConcatWs] = concatReturnType
+	extend.MultiStrings[builtin.ConcatWs] = func(es []extend.Extend) string {
+		return fmt.Sprintf("concat_ws(%s)", joinExtends(es))
+	}
+	overload.OpTypes[builtin.ConcatWs] = overload.Multi
+	for _, typ := range stringTypes {
+		overload.MultiOps[builtin.ConcatWs] = append(overload.MultiOps[builtin.ConcatWs], &overload.MultiOp{
+			Min:        2,
+			Max:        -1,
+			Typ:        typ,
+			ReturnType: types.T_varchar,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount("concat_ws", vecs, 2, -1); err != nil {
+					return nil, err
+				}
+				xss, err := stringArgs("concat_ws", vecs)
+				if err != nil {
+					return nil, err
+				}
+				nsps := make([]*nulls.Nulls, len(vecs)-1)
+				for i, vec := range vecs[1:] {
+					nsps[i] = vec.Nsp
+				}
+				nsp := resultNulls(vecs[:1], resultRows(vecs))
+				return builtin.NewBytesVector(proc, types.T_varchar, concat.ConcatWs(xss[0], xss[1:], nsps, &types.Bytes{}), nsp)
+			},
+		})
+	}
+}
+
+// concatReturnType returns varchar if all the arguments are strings
+func concatReturnType(es []extend.Extend) types.T {
+	for _, e := range es {
+		if !builtin.IsString(e.ReturnType()) {
+			return types.T_any
+		}
+	}
+	return types.T_varchar
+}
+
+func stringArgs(name string, vecs []*vector.Vector) ([]*types.Bytes, error) {
+	xss := make([]*types.Bytes, len(vecs))
+	for i := range vecs {
+		xs, err := stringArg(name, vecs, i)
+		if err != nil {
+			return nil, err
+		}
+		xss[i] = xs
+	}
+	return xss, nil
+}
+
+func joinExtends(es []extend.Extend) string {
+	strs := make([]string, len(es))
+	for i, e := range es {
+		strs[i] = e.String()
+	}
+	return strings.Join(strs, ", ")
+}
@@ -0,0 +1,76 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/converttz"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	// convert_tz(dt, from_tz, to_tz) returns null if any time zone is unknown
+	extend.FunctionRegistry["convert_tz"] = builtin.ConvertTz
+	extend.MultiReturnTypes[builtin.ConvertTz] = func(es []extend.Extend) types.T {
+		return getMultiReturnType(builtin.ConvertTz, es)
+	}
+	extend.MultiStrings[builtin.ConvertTz] = func(es []extend.Extend) string {
+		return fmt.Sprintf("convert_tz(%s)", joinExtends(es))
+	}
+	overload.OpTypes[builtin.ConvertTz] = overload.Multi
+	appendFunctionRets(builtin.ConvertTz, [][]types.T{dateArgTypes, stringTypes, stringTypes}, types.T_datetime)
+	for _, typ := range dateArgTypes {
+		overload.MultiOps[builtin.ConvertTz] = append(overload.MultiOps[builtin.ConvertTz], &overload.MultiOp{
+			Min:        3,
+			Max:        3,
+			Typ:        typ,
+			ReturnType: types.T_datetime,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount("convert_tz", vecs, 3, 3); err != nil {
+					return nil, err
+				}
+				froms, err := stringArg("convert_tz", vecs, 1)
+				if err != nil {
+					return nil, err
+				}
+				tos, err := stringArg("convert_tz", vecs, 2)
+				if err != nil {
+					return nil, err
+				}
+				rows := resultRows(vecs)
+				nsp := resultNulls(vecs, rows)
+				xs, err := builtin.DatetimeColumn(vecs[0], rows, nsp)
+				if err != nil {
+					return nil, err
+				}
+				vec, rs, err := datetimeResult(proc, rows)
+				if err != nil {
+					return nil, err
+				}
+				rs = converttz.ConvertTz(xs, froms, tos, nsp, rs)
+				nulls.Set(vec.Nsp, nsp)
+				vector.SetCol(vec, rs)
+				return vec, nil
+			},
+		})
+	}
+}
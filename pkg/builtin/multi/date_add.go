@@ -0,0 +1,182 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/dateadd"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	// date_add(date, value, unit) is built from date_add(date, interval value unit) and date + interval value unit
+	registerDateAdd("date_add", builtin.DateAdd, 1)
+	registerDateAdd("date_sub", builtin.DateSub, -1)
+	extend.FunctionRegistry["adddate"] = builtin.DateAdd
+	extend.FunctionRegistry["subdate"] = builtin.DateSub
+}
+
+func registerDateAdd(name string, op int, sign int64) {
+	extend.FunctionRegistry[name] = op
+	extend.MultiReturnTypes[op] = func(es []extend.Extend) types.T {
+		if len(es) != 3 {
+			return types.T_any
+		}
+		it, ok := unitOf(es[2])
+		if !ok || !isDateArg(es[0].ReturnType()) {
+			return types.T_any
+		}
+		switch typ := es[1].ReturnType(); {
+		case builtin.IsInteger(typ), builtin.IsString(typ), typ == types.T_float32, typ == types.T_float64:
+		default:
+			return types.T_any
+		}
+		return dateAddReturnType(es[0].ReturnType(), it)
+	}
+	extend.MultiStrings[op] = func(es []extend.Extend) string {
+		if it, ok := unitOf(es[2]); ok {
+			return fmt.Sprintf("%s(%s, interval %s %s)", name, es[0], es[1], it)
+		}
+		return fmt.Sprintf("%s(%s)", name, joinExtends(es))
+	}
+	overload.OpTypes[op] = overload.Multi
+	for _, typ := range dateArgTypes {
+		overload.MultiOps[op] = append(overload.MultiOps[op], &overload.MultiOp{
+			Min:        3,
+			Max:        3,
+			Typ:        typ,
+			ReturnType: dateAddReturnType(typ, types.MicroSecond),
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount(name, vecs, 3, 3); err != nil {
+					return nil, err
+				}
+				it, err := unitArg(name, vecs, 2)
+				if err != nil {
+					return nil, err
+				}
+				rows := resultRows(vecs)
+				nsp := resultNulls(vecs, rows)
+				ns, nit, err := intervalArg(name, vecs[1], it, sign, rows, nsp)
+				if err != nil {
+					return nil, err
+				}
+				if dateAddReturnType(vecs[0].Typ.Oid, it) == types.T_date {
+					vec, err := process.Get(proc, 4*int64(rows), types.Type{Oid: types.T_date, Size: 4})
+					if err != nil {
+						return nil, err
+					}
+					rs := encoding.DecodeDateSlice(vec.Data)[:rows]
+					rs = dateadd.DateAdd(vecs[0].Col.([]types.Date), ns, nit, nsp, rs)
+					nulls.Set(vec.Nsp, nsp)
+					vector.SetCol(vec, rs)
+					return vec, nil
+				}
+				xs, err := builtin.DatetimeColumn(vecs[0], rows, nsp)
+				if err != nil {
+					return nil, err
+				}
+				vec, rs, err := datetimeResult(proc, rows)
+				if err != nil {
+					return nil, err
+				}
+				rs = dateadd.DatetimeAdd(xs, ns, nit, nsp, rs)
+				nulls.Set(vec.Nsp, nsp)
+				vector.SetCol(vec, rs)
+				return vec, nil
+			},
+		})
+	}
+}
+
+// dateAddReturnType returns date if a date is added by the days, weeks, months or years, otherwise datetime
+func dateAddReturnType(typ types.T, it types.IntervalType) types.T {
+	if typ == types.T_date && it.IsDateUnit() {
+		return types.T_date
+	}
+	return types.T_datetime
+}
+
+func isDateArg(typ types.T) bool {
+	return builtin.IsDate(typ) || builtin.IsString(typ)
+}
+
+// intervalArg returns the interval values of the unit normalized by types.NormalizeInterval,
+// the values which are not valid for the unit are set null in nsp
+func intervalArg(name string, vec *vector.Vector, it types.IntervalType, sign int64, rows int, nsp *nulls.Nulls) ([]int64, types.IntervalType, error) {
+	_, nit, err := types.NormalizeIntervalInt(0, it)
+	if err != nil {
+		return nil, nit, err
+	}
+	var strs []string
+	switch vs := vec.Col.(type) {
+	case *types.Bytes:
+		strs = make([]string, len(vs.Offsets))
+		for i := range strs {
+			strs[i] = string(vs.Get(int64(i)))
+		}
+	case []float32:
+		strs = make([]string, len(vs))
+		for i, v := range vs {
+			strs[i] = strconv.FormatFloat(float64(v), 'f', -1, 32)
+		}
+	case []float64:
+		strs = make([]string, len(vs))
+		for i, v := range vs {
+			strs[i] = strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	default:
+		if !builtin.IsInteger(vec.Typ.Oid) {
+			return nil, nit, fmt.Errorf("the interval of '%s' must be a number or a string, but got %s", name, vec.Typ)
+		}
+		xs, err := builtin.Int64Column(vec)
+		if err != nil {
+			return nil, nit, err
+		}
+		ns := make([]int64, len(xs))
+		for i, v := range xs {
+			if ns[i], _, err = types.NormalizeIntervalInt(v, it); err != nil {
+				return nil, nit, err
+			}
+			ns[i] *= sign
+		}
+		return ns, nit, nil
+	}
+	ns := make([]int64, len(strs))
+	for i, s := range strs {
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			continue
+		}
+		n, _, err := types.NormalizeInterval(s, it)
+		if err != nil {
+			if len(strs) == 1 {
+				nulls.Set(nsp, builtin.AllNulls(rows))
+			} else {
+				nulls.Add(nsp, uint64(i))
+			}
+			continue
+		}
+		ns[i] = n * sign
+	}
+	return ns, nit, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/dateformat"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["date_format"] = builtin.DateFormat
+	extend.MultiReturnTypes[builtin.DateFormat] = func(es []extend.Extend) types.T {
+		return getMultiReturnType(builtin.DateFormat, es)
+	}
+	extend.MultiStrings[builtin.DateFormat] = func(es []extend.Extend) string {
+		return fmt.Sprintf("date_format(%s)", joinExtends(es))
+	}
+	overload.OpTypes[builtin.DateFormat] = overload.Multi
+	appendFunctionRets(builtin.DateFormat, [][]types.T{dateArgTypes, stringTypes}, types.T_varchar)
+	for _, typ := range dateArgTypes {
+		overload.MultiOps[builtin.DateFormat] = append(overload.MultiOps[builtin.DateFormat], &overload.MultiOp{
+			Min:        2,
+			Max:        2,
+			Typ:        typ,
+			ReturnType: types.T_varchar,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount("date_format", vecs, 2, 2); err != nil {
+					return nil, err
+				}
+				formats, err := stringArg("date_format", vecs, 1)
+				if err != nil {
+					return nil, err
+				}
+				rows := resultRows(vecs)
+				nsp := resultNulls(vecs, rows)
+				xs, err := builtin.DatetimeColumn(vecs[0], rows, nsp)
+				if err != nil {
+					return nil, err
+				}
+				rs := dateformat.DateFormat(xs, formats, nsp, &types.Bytes{})
+				return builtin.NewBytesVector(proc, types.T_varchar, rs, nsp)
+			},
+		})
+	}
+
+	extend.FunctionRegistry["str_to_date"] = builtin.StrToDate
+	extend.MultiReturnTypes[builtin.StrToDate] = func(es []extend.Extend) types.T {
+		return getMultiReturnType(builtin.StrToDate, es)
+	}
+	extend.MultiStrings[builtin.StrToDate] = func(es []extend.Extend) string {
+		return fmt.Sprintf("str_to_date(%s)", joinExtends(es))
+	}
+	overload.OpTypes[builtin.StrToDate] = overload.Multi
+	appendFunctionRets(builtin.StrToDate, [][]types.T{stringTypes, stringTypes}, types.T_datetime)
+	for _, typ := range stringTypes {
+		overload.MultiOps[builtin.StrToDate] = append(overload.MultiOps[builtin.StrToDate], &overload.MultiOp{
+			Min:        2,
+			Max:        2,
+			Typ:        typ,
+			ReturnType: types.T_datetime,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount("str_to_date", vecs, 2, 2); err != nil {
+					return nil, err
+				}
+				xs, err := stringArg("str_to_date", vecs, 0)
+				if err != nil {
+					return nil, err
+				}
+				formats, err := stringArg("str_to_date", vecs, 1)
+				if err != nil {
+					return nil, err
+				}
+				rows := resultRows(vecs)
+				nsp := resultNulls(vecs, rows)
+				vec, rs, err := datetimeResult(proc, rows)
+				if err != nil {
+					return nil, err
+				}
+				rs = dateformat.StrToDate(xs, formats, nsp, rs)
+				nulls.Set(vec.Nsp, nsp)
+				vector.SetCol(vec, rs)
+				return vec, nil
+			},
+		})
+	}
+}
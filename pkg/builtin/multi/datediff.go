@@ -0,0 +1,134 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/datediff"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["datediff"] = builtin.DateDiff
+	extend.MultiReturnTypes[builtin.DateDiff] = func(es []extend.Extend) types.T {
+		return getMultiReturnType(builtin.DateDiff, es)
+	}
+	extend.MultiStrings[builtin.DateDiff] = func(es []extend.Extend) string {
+		return fmt.Sprintf("datediff(%s)", joinExtends(es))
+	}
+	overload.OpTypes[builtin.DateDiff] = overload.Multi
+	appendFunctionRets(builtin.DateDiff, [][]types.T{dateArgTypes, dateArgTypes}, types.T_int64)
+	for _, typ := range dateArgTypes {
+		overload.MultiOps[builtin.DateDiff] = append(overload.MultiOps[builtin.DateDiff], &overload.MultiOp{
+			Min:        2,
+			Max:        2,
+			Typ:        typ,
+			ReturnType: types.T_int64,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount("datediff", vecs, 2, 2); err != nil {
+					return nil, err
+				}
+				rows := resultRows(vecs)
+				nsp := resultNulls(vecs, rows)
+				xs, err := dateColumn(vecs[0], rows, nsp)
+				if err != nil {
+					return nil, err
+				}
+				ys, err := dateColumn(vecs[1], rows, nsp)
+				if err != nil {
+					return nil, err
+				}
+				vec, rs, err := int64Result(proc, rows)
+				if err != nil {
+					return nil, err
+				}
+				nulls.Set(vec.Nsp, nsp)
+				vector.SetCol(vec, datediff.DateDiff(xs, ys, rs))
+				return vec, nil
+			},
+		})
+	}
+
+	extend.FunctionRegistry["timestampdiff"] = builtin.TimestampDiff
+	extend.MultiReturnTypes[builtin.TimestampDiff] = func(es []extend.Extend) types.T {
+		return getMultiReturnType(builtin.TimestampDiff, es)
+	}
+	extend.MultiStrings[builtin.TimestampDiff] = func(es []extend.Extend) string {
+		return fmt.Sprintf("timestampdiff(%s)", joinExtends(es))
+	}
+	overload.OpTypes[builtin.TimestampDiff] = overload.Multi
+	appendFunctionRets(builtin.TimestampDiff, [][]types.T{stringTypes, dateArgTypes, dateArgTypes}, types.T_int64)
+	for _, typ := range stringTypes {
+		overload.MultiOps[builtin.TimestampDiff] = append(overload.MultiOps[builtin.TimestampDiff], &overload.MultiOp{
+			Min:        3,
+			Max:        3,
+			Typ:        typ,
+			ReturnType: types.T_int64,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount("timestampdiff", vecs, 3, 3); err != nil {
+					return nil, err
+				}
+				it, err := unitArg("timestampdiff", vecs, 0)
+				if err != nil {
+					return nil, err
+				}
+				rows := resultRows(vecs)
+				nsp := resultNulls(vecs, rows)
+				xs, err := builtin.DatetimeColumn(vecs[1], rows, nsp)
+				if err != nil {
+					return nil, err
+				}
+				ys, err := builtin.DatetimeColumn(vecs[2], rows, nsp)
+				if err != nil {
+					return nil, err
+				}
+				vec, rs, err := int64Result(proc, rows)
+				if err != nil {
+					return nil, err
+				}
+				if rs, err = datediff.TimestampDiff(it, xs, ys, rs); err != nil {
+					process.Put(proc, vec)
+					return nil, err
+				}
+				nulls.Set(vec.Nsp, nsp)
+				vector.SetCol(vec, rs)
+				return vec, nil
+			},
+		})
+	}
+}
+
+// dateColumn is builtin.DatetimeColumn which returns the dates
+func dateColumn(vec *vector.Vector, rows int, nsp *nulls.Nulls) ([]types.Date, error) {
+	if vs, ok := vec.Col.([]types.Date); ok {
+		return vs, nil
+	}
+	vs, err := builtin.DatetimeColumn(vec, rows, nsp)
+	if err != nil {
+		return nil, err
+	}
+	rs := make([]types.Date, len(vs))
+	for i, v := range vs {
+		rs[i] = v.ToDate()
+	}
+	return rs, nil
+}
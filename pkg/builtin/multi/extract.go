@@ -0,0 +1,78 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/extract"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	// extract(unit from date) is parsed as extract(unit, date)
+	extend.FunctionRegistry["extract"] = builtin.Extract
+	extend.MultiReturnTypes[builtin.Extract] = func(es []extend.Extend) types.T {
+		return getMultiReturnType(builtin.Extract, es)
+	}
+	extend.MultiStrings[builtin.Extract] = func(es []extend.Extend) string {
+		if it, ok := unitOf(es[0]); ok && len(es) == 2 {
+			return fmt.Sprintf("extract(%s from %s)", it, es[1])
+		}
+		return fmt.Sprintf("extract(%s)", joinExtends(es))
+	}
+	overload.OpTypes[builtin.Extract] = overload.Multi
+	appendFunctionRets(builtin.Extract, [][]types.T{stringTypes, dateArgTypes}, types.T_int64)
+	for _, typ := range stringTypes {
+		overload.MultiOps[builtin.Extract] = append(overload.MultiOps[builtin.Extract], &overload.MultiOp{
+			Min:        2,
+			Max:        2,
+			Typ:        typ,
+			ReturnType: types.T_int64,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount("extract", vecs, 2, 2); err != nil {
+					return nil, err
+				}
+				it, err := unitArg("extract", vecs, 0)
+				if err != nil {
+					return nil, err
+				}
+				rows := resultRows(vecs)
+				nsp := resultNulls(vecs, rows)
+				xs, err := builtin.DatetimeColumn(vecs[1], rows, nsp)
+				if err != nil {
+					return nil, err
+				}
+				vec, rs, err := int64Result(proc, rows)
+				if err != nil {
+					return nil, err
+				}
+				if rs, err = extract.Extract(it, xs, rs); err != nil {
+					process.Put(proc, vec)
+					return nil, err
+				}
+				nulls.Set(vec.Nsp, nsp)
+				vector.SetCol(vec, rs)
+				return vec, nil
+			},
+		})
+	}
+}
@@ -0,0 +1,389 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/broadcast"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/jsonfunc"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var (
+	// jsonTypes are the types accepted as a JSON document, the strings are parsed
+	jsonTypes = []types.T{types.T_json, types.T_char, types.T_varchar}
+	// jsonValueTypes are the types which can be converted to a JSON value
+	jsonValueTypes = []types.T{
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_char, types.T_varchar,
+		types.T_date, types.T_datetime, types.T_json,
+	}
+)
+
+func init() {
+	// json_extract(doc, path, ...) returns null if nothing is matched by the paths
+	registerJson("json_extract", builtin.JsonExtract, 2, -1, types.T_json, func(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		rows := resultRows(vecs)
+		nsp := resultNulls(vecs, rows)
+		docs, err := jsonArg("json_extract", vecs, 0, rows, nsp)
+		if err != nil {
+			return nil, err
+		}
+		paths, err := stringArgs("json_extract", vecs[1:])
+		if err != nil {
+			return nil, err
+		}
+		rs, err := jsonfunc.Extract(docs, paths, nsp, &types.Bytes{})
+		if err != nil {
+			return nil, err
+		}
+		return builtin.NewBytesVector(proc, types.T_json, rs, nsp)
+	})
+
+	// json_unquote(doc) returns the text of the document, the strings are unquoted
+	registerJson("json_unquote", builtin.JsonUnquote, 1, 1, types.T_varchar, func(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		rows := resultRows(vecs)
+		nsp := resultNulls(vecs, rows)
+		if vecs[0].Typ.Oid != types.T_json {
+			rs, err := jsonfunc.UnquoteBytes(vecs[0].Col.(*types.Bytes), nsp, &types.Bytes{})
+			if err != nil {
+				return nil, err
+			}
+			return builtin.NewBytesVector(proc, types.T_varchar, rs, nsp)
+		}
+		docs, err := jsonArg("json_unquote", vecs, 0, rows, nsp)
+		if err != nil {
+			return nil, err
+		}
+		return builtin.NewBytesVector(proc, types.T_varchar, jsonfunc.Unquote(docs, nsp, &types.Bytes{}), nsp)
+	})
+
+	// json_contains(target, candidate[, path]) returns null if nothing is matched by the path
+	registerJson("json_contains", builtin.JsonContains, 2, 3, types.T_int64, func(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		rows := resultRows(vecs)
+		nsp := resultNulls(vecs, rows)
+		docs, err := jsonArg("json_contains", vecs, 0, rows, nsp)
+		if err != nil {
+			return nil, err
+		}
+		candidates, err := jsonArg("json_contains", vecs, 1, rows, nsp)
+		if err != nil {
+			return nil, err
+		}
+		paths, err := pathArg("json_contains", vecs, 2)
+		if err != nil {
+			return nil, err
+		}
+		vec, rs, err := int64Result(proc, rows)
+		if err != nil {
+			return nil, err
+		}
+		if rs, err = jsonfunc.Contains(docs, candidates, paths, nsp, rs); err != nil {
+			process.Put(proc, vec)
+			return nil, err
+		}
+		nulls.Set(vec.Nsp, nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	})
+
+	// json_length(doc[, path]) returns null if nothing is matched by the path
+	registerJson("json_length", builtin.JsonLength, 1, 2, types.T_int64, func(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		rows := resultRows(vecs)
+		nsp := resultNulls(vecs, rows)
+		docs, err := jsonArg("json_length", vecs, 0, rows, nsp)
+		if err != nil {
+			return nil, err
+		}
+		paths, err := pathArg("json_length", vecs, 1)
+		if err != nil {
+			return nil, err
+		}
+		vec, rs, err := int64Result(proc, rows)
+		if err != nil {
+			return nil, err
+		}
+		if rs, err = jsonfunc.Length(docs, paths, nsp, rs); err != nil {
+			process.Put(proc, vec)
+			return nil, err
+		}
+		nulls.Set(vec.Nsp, nsp)
+		vector.SetCol(vec, rs)
+		return vec, nil
+	})
+
+	// json_keys(doc[, path]) returns null if the value is not an object
+	registerJson("json_keys", builtin.JsonKeys, 1, 2, types.T_json, func(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		rows := resultRows(vecs)
+		nsp := resultNulls(vecs, rows)
+		docs, err := jsonArg("json_keys", vecs, 0, rows, nsp)
+		if err != nil {
+			return nil, err
+		}
+		paths, err := pathArg("json_keys", vecs, 1)
+		if err != nil {
+			return nil, err
+		}
+		rs, err := jsonfunc.Keys(docs, paths, nsp, &types.Bytes{})
+		if err != nil {
+			return nil, err
+		}
+		return builtin.NewBytesVector(proc, types.T_json, rs, nsp)
+	})
+
+	// json_array(value, ...) is never null, the null values are the JSON null
+	registerJsonValues("json_array", builtin.JsonArray, func(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		rows := resultRows(vecs)
+		vss, err := jsonValueArgs("json_array", vecs, rows)
+		if err != nil {
+			return nil, err
+		}
+		rs := &types.Bytes{}
+		elems := make([]bytejson.ByteJson, len(vecs))
+		for i := 0; i < rows; i++ {
+			for j, vs := range vss {
+				elems[j] = vs[i]
+			}
+			appendBytes(rs, bytejson.CreateArray(elems))
+		}
+		return builtin.NewBytesVector(proc, types.T_json, rs, new(nulls.Nulls))
+	})
+
+	// json_object(key, value, ...) is never null, the keys must not be null
+	registerJsonValues("json_object", builtin.JsonObject, func(vecs []*vector.Vector, proc *process.Process) (*vector.Vector, error) {
+		if len(vecs)%2 != 0 {
+			return nil, fmt.Errorf("incorrect parameter count in the call to '%s'", "json_object")
+		}
+		rows := resultRows(vecs)
+		vss, err := jsonValueArgs("json_object", vecs, rows)
+		if err != nil {
+			return nil, err
+		}
+		rs := &types.Bytes{}
+		keys := make([]string, len(vecs)/2)
+		values := make([]bytejson.ByteJson, len(vecs)/2)
+		for i := 0; i < rows; i++ {
+			for j := range keys {
+				key := vss[2*j][i]
+				if key.Type() == bytejson.TypeNull {
+					return nil, fmt.Errorf("the key of '%s' must not be null", "json_object")
+				}
+				keys[j], values[j] = string(key.Unquote()), vss[2*j+1][i]
+			}
+			v, err := bytejson.CreateObject(keys, values)
+			if err != nil {
+				return nil, err
+			}
+			appendBytes(rs, v)
+		}
+		return builtin.NewBytesVector(proc, types.T_json, rs, new(nulls.Nulls))
+	})
+}
+
+// registerJson registers the JSON function whose first argument is a JSON document and the others are strings
+func registerJson(name string, op int, min, max int, ret types.T, fn func([]*vector.Vector, *process.Process) (*vector.Vector, error)) {
+	extend.FunctionRegistry[name] = op
+	extend.MultiReturnTypes[op] = func(es []extend.Extend) types.T {
+		return jsonReturnType(es, min, max, op == builtin.JsonContains, ret)
+	}
+	extend.MultiStrings[op] = func(es []extend.Extend) string {
+		return fmt.Sprintf("%s(%s)", name, joinExtends(es))
+	}
+	overload.OpTypes[op] = overload.Multi
+	for _, typ := range jsonTypes {
+		overload.MultiOps[op] = append(overload.MultiOps[op], &overload.MultiOp{
+			Min:        min,
+			Max:        max,
+			Typ:        typ,
+			ReturnType: ret,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount(name, vecs, min, max); err != nil {
+					return nil, err
+				}
+				return fn(vecs, proc)
+			},
+		})
+	}
+}
+
+// registerJsonValues registers the JSON function which builds a JSON value from the values of any type
+func registerJsonValues(name string, op int, fn func([]*vector.Vector, *process.Process) (*vector.Vector, error)) {
+	extend.FunctionRegistry[name] = op
+	extend.MultiReturnTypes[op] = func(es []extend.Extend) types.T {
+		for _, e := range es {
+			if !isJsonValueType(e.ReturnType()) {
+				return types.T_any
+			}
+		}
+		return types.T_json
+	}
+	extend.MultiStrings[op] = func(es []extend.Extend) string {
+		return fmt.Sprintf("%s(%s)", name, joinExtends(es))
+	}
+	overload.OpTypes[op] = overload.Multi
+	for _, typ := range jsonValueTypes {
+		overload.MultiOps[op] = append(overload.MultiOps[op], &overload.MultiOp{
+			Min:        1,
+			Max:        -1,
+			Typ:        typ,
+			ReturnType: types.T_json,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount(name, vecs, 1, -1); err != nil {
+					return nil, err
+				}
+				return fn(vecs, proc)
+			},
+		})
+	}
+}
+
+// jsonReturnType returns ret if the first argument is a JSON document and the others are strings,
+// the second argument of json_contains is a JSON document too
+func jsonReturnType(es []extend.Extend, min, max int, candidate bool, ret types.T) types.T {
+	if len(es) < min || (max >= 0 && len(es) > max) {
+		return types.T_any
+	}
+	for i, e := range es {
+		if i == 0 || i == 1 && candidate {
+			if !isJsonType(e.ReturnType()) {
+				return types.T_any
+			}
+		} else if !builtin.IsString(e.ReturnType()) {
+			return types.T_any
+		}
+	}
+	return ret
+}
+
+func isJsonType(typ types.T) bool {
+	return typ == types.T_json || builtin.IsString(typ)
+}
+
+func isJsonValueType(typ types.T) bool {
+	for _, t := range jsonValueTypes {
+		if t == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonArg returns the JSON documents of the argument i for the rows, the strings are parsed
+// and the null rows are nil
+func jsonArg(name string, vecs []*vector.Vector, i int, rows int, nsp *nulls.Nulls) ([]bytejson.ByteJson, error) {
+	if !isJsonType(vecs[i].Typ.Oid) {
+		return nil, fmt.Errorf("the argument %d of '%s' must be a JSON document, but got %s", i+1, name, vecs[i].Typ)
+	}
+	xs := vecs[i].Col.(*types.Bytes)
+	docs := make([]bytejson.ByteJson, rows)
+	for j := range docs {
+		if nulls.Contains(nsp, uint64(j)) {
+			continue
+		}
+		x := xs.Get(int64(broadcast.Index(j, len(xs.Offsets))))
+		if vecs[i].Typ.Oid == types.T_json {
+			docs[j] = x
+			continue
+		}
+		doc, err := bytejson.Parse(x)
+		if err != nil {
+			return nil, err
+		}
+		docs[j] = doc
+	}
+	return docs, nil
+}
+
+// pathArg returns the paths of the optional argument i, it is nil if the argument is missing
+func pathArg(name string, vecs []*vector.Vector, i int) (*types.Bytes, error) {
+	if i >= len(vecs) {
+		return nil, nil
+	}
+	return stringArg(name, vecs, i)
+}
+
+// jsonValueArgs returns the JSON values of all the arguments for the rows, the nulls are the JSON null
+func jsonValueArgs(name string, vecs []*vector.Vector, rows int) ([][]bytejson.ByteJson, error) {
+	vss := make([][]bytejson.ByteJson, len(vecs))
+	for i, vec := range vecs {
+		vs := make([]bytejson.ByteJson, rows)
+		for j := range vs {
+			k := broadcast.Index(j, vector.Length(vec))
+			if nulls.Contains(vec.Nsp, uint64(k)) {
+				vs[j] = bytejson.Null
+				continue
+			}
+			v, err := jsonValue(vec, k)
+			if err != nil {
+				return nil, fmt.Errorf("the argument %d of '%s' can not be converted to JSON: %v", i+1, name, err)
+			}
+			vs[j] = v
+		}
+		vss[i] = vs
+	}
+	return vss, nil
+}
+
+// jsonValue converts the value at row i of the vector to a JSON value, the strings, dates
+// and datetimes are converted to the JSON strings
+func jsonValue(vec *vector.Vector, i int) (bytejson.ByteJson, error) {
+	switch vs := vec.Col.(type) {
+	case []int8:
+		return bytejson.Create(vs[i])
+	case []int16:
+		return bytejson.Create(vs[i])
+	case []int32:
+		return bytejson.Create(vs[i])
+	case []int64:
+		return bytejson.Create(vs[i])
+	case []uint8:
+		return bytejson.Create(vs[i])
+	case []uint16:
+		return bytejson.Create(vs[i])
+	case []uint32:
+		return bytejson.Create(vs[i])
+	case []uint64:
+		return bytejson.Create(vs[i])
+	case []float32:
+		return bytejson.Create(vs[i])
+	case []float64:
+		return bytejson.Create(vs[i])
+	case []types.Date:
+		return bytejson.Create(vs[i].String())
+	case []types.Datetime:
+		return bytejson.Create(vs[i].String())
+	case *types.Bytes:
+		if vec.Typ.Oid == types.T_json {
+			return vs.Get(int64(i)), nil
+		}
+		return bytejson.Create(string(vs.Get(int64(i))))
+	}
+	return nil, fmt.Errorf("'%s' is not supported", vec.Typ)
+}
+
+func appendBytes(rs *types.Bytes, v []byte) {
+	rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+	rs.Lengths = append(rs.Lengths, uint32(len(v)))
+	rs.Data = append(rs.Data, v...)
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/left"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["left"] = builtin.Left
+	appendFunctionRets(builtin.Left, [][]types.T{stringTypes, intTypes}, types.T_any)
+	extend.MultiReturnTypes[builtin.Left] = func(es []extend.Extend) types.T {
+		return getMultiReturnType(builtin.Left, es)
+	}
+	extend.MultiStrings[builtin.Left] = func(es []extend.Extend) string {
+		return fmt.Sprintf("left(%s, %s)", es[0], es[1])
+	}
+	overload.OpTypes[builtin.Left] = overload.Multi
+	overload.MultiOps[builtin.Left] = []*overload.MultiOp{
+		{
+			Min:        2,
+			Max:        2,
+			Typ:        types.T_char,
+			ReturnType: types.T_char,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				return leftFn(vecs, proc, left.LeftChar)
+			},
+		},
+		{
+			Min:        2,
+			Max:        2,
+			Typ:        types.T_varchar,
+			ReturnType: types.T_varchar,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				return leftFn(vecs, proc, left.LeftVarChar)
+			},
+		},
+	}
+}
+
+func leftFn(vecs []*vector.Vector, proc *process.Process, fn func(*types.Bytes, []int64, *types.Bytes) *types.Bytes) (*vector.Vector, error) {
+	if err := checkArgCount("left", vecs, 2, 2); err != nil {
+		return nil, err
+	}
+	xs, err := stringArg("left", vecs, 0)
+	if err != nil {
+		return nil, err
+	}
+	ns, err := intArg("left", vecs, 1)
+	if err != nil {
+		return nil, err
+	}
+	nsp := resultNulls(vecs, resultRows(vecs))
+	return builtin.NewBytesVector(proc, vecs[0].Typ.Oid, fn(xs, ns, &types.Bytes{}), nsp)
+}
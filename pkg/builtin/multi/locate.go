@@ -0,0 +1,105 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/locate"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	// locate(substr, str[, pos])
+	extend.FunctionRegistry["locate"] = builtin.Locate
+	appendFunctionRets(builtin.Locate, [][]types.T{stringTypes, stringTypes}, types.T_int64)
+	appendFunctionRets(builtin.Locate, [][]types.T{stringTypes, stringTypes, intTypes}, types.T_int64)
+	extend.MultiStrings[builtin.Locate] = func(es []extend.Extend) string {
+		if len(es) == 3 {
+			return fmt.Sprintf("locate(%s, %s, %s)", es[0], es[1], es[2])
+		}
+		return fmt.Sprintf("locate(%s, %s)", es[0], es[1])
+	}
+	registerLocate("locate", builtin.Locate, 2, 3, 0, 1)
+
+	// instr(str, substr)
+	extend.FunctionRegistry["instr"] = builtin.Instr
+	appendFunctionRets(builtin.Instr, [][]types.T{stringTypes, stringTypes}, types.T_int64)
+	extend.MultiStrings[builtin.Instr] = func(es []extend.Extend) string {
+		return fmt.Sprintf("instr(%s, %s)", es[0], es[1])
+	}
+	registerLocate("instr", builtin.Instr, 2, 2, 1, 0)
+
+	// position(substr in str)
+	extend.FunctionRegistry["position"] = builtin.Position
+	appendFunctionRets(builtin.Position, [][]types.T{stringTypes, stringTypes}, types.T_int64)
+	extend.MultiStrings[builtin.Position] = func(es []extend.Extend) string {
+		return fmt.Sprintf("position(%s in %s)", es[0], es[1])
+	}
+	registerLocate("position", builtin.Position, 2, 2, 0, 1)
+}
+
+// registerLocate registers the function which finds the substring in the string,
+// sub and str are the argument indexes of the substring and the string
+func registerLocate(name string, op int, min, max int, sub, str int) {
+	extend.MultiReturnTypes[op] = func(es []extend.Extend) types.T {
+		return getMultiReturnType(op, es)
+	}
+	overload.OpTypes[op] = overload.Multi
+	for _, typ := range stringTypes {
+		overload.MultiOps[op] = append(overload.MultiOps[op], &overload.MultiOp{
+			Min:        min,
+			Max:        max,
+			Typ:        typ,
+			ReturnType: types.T_int64,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount(name, vecs, min, max); err != nil {
+					return nil, err
+				}
+				substrs, err := stringArg(name, vecs, sub)
+				if err != nil {
+					return nil, err
+				}
+				strs, err := stringArg(name, vecs, str)
+				if err != nil {
+					return nil, err
+				}
+				var pos []int64
+				if len(vecs) == 3 {
+					if pos, err = intArg(name, vecs, 2); err != nil {
+						return nil, err
+					}
+				}
+				rows := resultRows(vecs)
+				vec, err := process.Get(proc, 8*int64(rows), types.Type{Oid: types.T_int64, Size: 8})
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt64Slice(vec.Data)
+				rs = rs[:rows]
+				vec.Col = rs
+				vec.Nsp = resultNulls(vecs, rows)
+				vector.SetCol(vec, locate.Locate(substrs, strs, pos, rs))
+				return vec, nil
+			},
+		})
+	}
+}
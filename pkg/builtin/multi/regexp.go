@@ -0,0 +1,189 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/match"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// the kinds of the arguments of the regular expression functions, s is a string and i is an integer
+const (
+	regexpLikeArgs    = "sss"    // regexp_like(expr, pattern[, match_type])
+	regexpReplaceArgs = "sssiis" // regexp_replace(expr, pattern, replacement[, position[, occurrence[, match_type]]])
+	regexpSubstrArgs  = "ssiis"  // regexp_substr(expr, pattern[, position[, occurrence[, match_type]]])
+)
+
+func init() {
+	// regexp_like is a logical function like the REGEXP operator
+	registerRegexpLike("regexp_like", builtin.RegexpLike, "regexp_like(%s)", match.Sels)
+	registerRegexpLike("", builtin.NotRegexpLike, "not regexp_like(%s)", match.NotSels)
+	overload.NegOps[builtin.RegexpLike] = builtin.NotRegexpLike
+	overload.NegOps[builtin.NotRegexpLike] = builtin.RegexpLike
+
+	extend.FunctionRegistry["regexp_replace"] = builtin.RegexpReplace
+	extend.MultiReturnTypes[builtin.RegexpReplace] = func(es []extend.Extend) types.T {
+		return regexpReturnType(es, 3, regexpReplaceArgs, types.T_any)
+	}
+	extend.MultiStrings[builtin.RegexpReplace] = func(es []extend.Extend) string {
+		return fmt.Sprintf("regexp_replace(%s)", joinExtends(es))
+	}
+	overload.OpTypes[builtin.RegexpReplace] = overload.Multi
+	for _, typ := range stringTypes {
+		overload.MultiOps[builtin.RegexpReplace] = append(overload.MultiOps[builtin.RegexpReplace], &overload.MultiOp{
+			Min:        3,
+			Max:        len(regexpReplaceArgs),
+			Typ:        typ,
+			ReturnType: typ,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				args, err := regexpArgs("regexp_replace", vecs, 3, regexpReplaceArgs)
+				if err != nil {
+					return nil, err
+				}
+				nsp := resultNulls(vecs, resultRows(vecs))
+				rs, err := match.Replace(args.strs[0], args.strs[1], args.strs[2], args.ints[3], args.ints[4], args.strs[5], nsp, &types.Bytes{})
+				if err != nil {
+					return nil, err
+				}
+				return builtin.NewBytesVector(proc, vecs[0].Typ.Oid, rs, nsp)
+			},
+		})
+	}
+
+	extend.FunctionRegistry["regexp_substr"] = builtin.RegexpSubstr
+	extend.MultiReturnTypes[builtin.RegexpSubstr] = func(es []extend.Extend) types.T {
+		return regexpReturnType(es, 2, regexpSubstrArgs, types.T_any)
+	}
+	extend.MultiStrings[builtin.RegexpSubstr] = func(es []extend.Extend) string {
+		return fmt.Sprintf("regexp_substr(%s)", joinExtends(es))
+	}
+	overload.OpTypes[builtin.RegexpSubstr] = overload.Multi
+	for _, typ := range stringTypes {
+		overload.MultiOps[builtin.RegexpSubstr] = append(overload.MultiOps[builtin.RegexpSubstr], &overload.MultiOp{
+			Min:        2,
+			Max:        len(regexpSubstrArgs),
+			Typ:        typ,
+			ReturnType: typ,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				args, err := regexpArgs("regexp_substr", vecs, 2, regexpSubstrArgs)
+				if err != nil {
+					return nil, err
+				}
+				nsp := resultNulls(vecs, resultRows(vecs))
+				rs, err := match.Substr(args.strs[0], args.strs[1], args.ints[2], args.ints[3], args.strs[4], nsp, &types.Bytes{})
+				if err != nil {
+					return nil, err
+				}
+				return builtin.NewBytesVector(proc, vecs[0].Typ.Oid, rs, nsp)
+			},
+		})
+	}
+}
+
+func registerRegexpLike(name string, op int, format string, fn func(xs, pats, matchTypes *types.Bytes, nsp *nulls.Nulls, rs []int64) ([]int64, error)) {
+	if name != "" {
+		extend.FunctionRegistry[name] = op
+	}
+	extend.MultiReturnTypes[op] = func(es []extend.Extend) types.T {
+		return regexpReturnType(es, 2, regexpLikeArgs, types.T_sel)
+	}
+	extend.MultiStrings[op] = func(es []extend.Extend) string {
+		return fmt.Sprintf(format, joinExtends(es))
+	}
+	overload.OpTypes[op] = overload.Multi
+	overload.LogicalOps[op] = overload.MustLogical
+	for _, typ := range stringTypes {
+		overload.MultiOps[op] = append(overload.MultiOps[op], &overload.MultiOp{
+			Min:        2,
+			Max:        len(regexpLikeArgs),
+			Typ:        typ,
+			ReturnType: types.T_sel,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				args, err := regexpArgs("regexp_like", vecs, 2, regexpLikeArgs)
+				if err != nil {
+					return nil, err
+				}
+				rows := resultRows(vecs)
+				vec, err := process.Get(proc, int64(overload.SelsType.Size)*int64(rows), overload.SelsType)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeInt64Slice(vec.Data)
+				rs = rs[:rows]
+				if rs, err = fn(args.strs[0], args.strs[1], args.strs[2], resultNulls(vecs, rows), rs); err != nil {
+					process.Put(proc, vec)
+					return nil, err
+				}
+				vector.SetCol(vec, rs)
+				return vec, nil
+			},
+		})
+	}
+}
+
+// regexpArguments are the arguments of a regular expression function, the missing ones are nil
+type regexpArguments struct {
+	strs []*types.Bytes
+	ints [][]int64
+}
+
+func regexpArgs(name string, vecs []*vector.Vector, min int, kinds string) (*regexpArguments, error) {
+	if err := checkArgCount(name, vecs, min, len(kinds)); err != nil {
+		return nil, err
+	}
+	args := &regexpArguments{
+		strs: make([]*types.Bytes, len(kinds)),
+		ints: make([][]int64, len(kinds)),
+	}
+	for i := range vecs {
+		var err error
+		if kinds[i] == 's' {
+			args.strs[i], err = stringArg(name, vecs, i)
+		} else {
+			args.ints[i], err = intArg(name, vecs, i)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return args, nil
+}
+
+// regexpReturnType returns ret, or the type of the first argument if ret is T_any,
+// the return type is T_any if the arguments don't match the kinds
+func regexpReturnType(es []extend.Extend, min int, kinds string, ret types.T) types.T {
+	if len(es) < min || len(es) > len(kinds) {
+		return types.T_any
+	}
+	for i, e := range es {
+		if kinds[i] == 's' && !builtin.IsString(e.ReturnType()) ||
+			kinds[i] == 'i' && !builtin.IsInteger(e.ReturnType()) {
+			return types.T_any
+		}
+	}
+	if ret == types.T_any {
+		return es[0].ReturnType()
+	}
+	return ret
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/repeat"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["repeat"] = builtin.Repeat
+	appendFunctionRets(builtin.Repeat, [][]types.T{stringTypes, intTypes}, types.T_any)
+	extend.MultiReturnTypes[builtin.Repeat] = func(es []extend.Extend) types.T {
+		return getMultiReturnType(builtin.Repeat, es)
+	}
+	extend.MultiStrings[builtin.Repeat] = func(es []extend.Extend) string {
+		return fmt.Sprintf("repeat(%s, %s)", es[0], es[1])
+	}
+	overload.OpTypes[builtin.Repeat] = overload.Multi
+	for _, typ := range stringTypes {
+		overload.MultiOps[builtin.Repeat] = append(overload.MultiOps[builtin.Repeat], &overload.MultiOp{
+			Min:        2,
+			Max:        2,
+			Typ:        typ,
+			ReturnType: typ,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount("repeat", vecs, 2, 2); err != nil {
+					return nil, err
+				}
+				xs, err := stringArg("repeat", vecs, 0)
+				if err != nil {
+					return nil, err
+				}
+				ns, err := intArg("repeat", vecs, 1)
+				if err != nil {
+					return nil, err
+				}
+				nsp := resultNulls(vecs, resultRows(vecs))
+				return builtin.NewBytesVector(proc, vecs[0].Typ.Oid, repeat.Repeat(xs, ns, &types.Bytes{}, nsp), nsp)
+			},
+		})
+	}
+}
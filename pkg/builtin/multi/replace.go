@@ -0,0 +1,62 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/replace"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["replace"] = builtin.Replace
+	appendFunctionRets(builtin.Replace, [][]types.T{stringTypes, stringTypes, stringTypes}, types.T_any)
+	extend.MultiReturnTypes[builtin.Replace] = func(es []extend.Extend) types.T {
+		return getMultiReturnType(builtin.Replace, es)
+	}
+	extend.MultiStrings[builtin.Replace] = func(es []extend.Extend) string {
+		return fmt.Sprintf("replace(%s, %s, %s)", es[0], es[1], es[2])
+	}
+	overload.OpTypes[builtin.Replace] = overload.Multi
+	for _, typ := range stringTypes {
+		overload.MultiOps[builtin.Replace] = append(overload.MultiOps[builtin.Replace], &overload.MultiOp{
+			Min:        3,
+			Max:        3,
+			Typ:        typ,
+			ReturnType: typ,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount("replace", vecs, 3, 3); err != nil {
+					return nil, err
+				}
+				args := make([]*types.Bytes, 3)
+				for i := range args {
+					xs, err := stringArg("replace", vecs, i)
+					if err != nil {
+						return nil, err
+					}
+					args[i] = xs
+				}
+				nsp := resultNulls(vecs, resultRows(vecs))
+				return builtin.NewBytesVector(proc, vecs[0].Typ.Oid, replace.Replace(args[0], args[1], args[2], &types.Bytes{}), nsp)
+			},
+		})
+	}
+}
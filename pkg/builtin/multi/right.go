@@ -0,0 +1,75 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/right"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["right"] = builtin.Right
+	appendFunctionRets(builtin.Right, [][]types.T{stringTypes, intTypes}, types.T_any)
+	extend.MultiReturnTypes[builtin.Right] = func(es []extend.Extend) types.T {
+		return getMultiReturnType(builtin.Right, es)
+	}
+	extend.MultiStrings[builtin.Right] = func(es []extend.Extend) string {
+		return fmt.Sprintf("right(%s, %s)", es[0], es[1])
+	}
+	overload.OpTypes[builtin.Right] = overload.Multi
+	overload.MultiOps[builtin.Right] = []*overload.MultiOp{
+		{
+			Min:        2,
+			Max:        2,
+			Typ:        types.T_char,
+			ReturnType: types.T_char,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				return rightFn(vecs, proc, right.RightChar)
+			},
+		},
+		{
+			Min:        2,
+			Max:        2,
+			Typ:        types.T_varchar,
+			ReturnType: types.T_varchar,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				return rightFn(vecs, proc, right.RightVarChar)
+			},
+		},
+	}
+}
+
+func rightFn(vecs []*vector.Vector, proc *process.Process, fn func(*types.Bytes, []int64, *types.Bytes) *types.Bytes) (*vector.Vector, error) {
+	if err := checkArgCount("right", vecs, 2, 2); err != nil {
+		return nil, err
+	}
+	xs, err := stringArg("right", vecs, 0)
+	if err != nil {
+		return nil, err
+	}
+	ns, err := intArg("right", vecs, 1)
+	if err != nil {
+		return nil, err
+	}
+	nsp := resultNulls(vecs, resultRows(vecs))
+	return builtin.NewBytesVector(proc, vecs[0].Typ.Oid, fn(xs, ns, &types.Bytes{}), nsp)
+}
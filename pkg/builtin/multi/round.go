@@ -50,6 +50,10 @@ var argsAndRets = []argsAndRet{
 	{[]types.T{types.T_float32, types.T_int64}, types.T_float32},
 	{[]types.T{types.T_float64}, types.T_float64},
 	{[]types.T{types.T_float64, types.T_int64}, types.T_float64},
+	{[]types.T{types.T_decimal64}, types.T_decimal64},
+	{[]types.T{types.T_decimal64, types.T_int64}, types.T_decimal64},
+	{[]types.T{types.T_decimal128}, types.T_decimal128},
+	{[]types.T{types.T_decimal128, types.T_int64}, types.T_decimal128},
 }
 
 func init() {
@@ -380,5 +384,83 @@ func init() {
 				return vec, nil
 			},
 		},
+		{
+			Min:        1,
+			Max:        2,
+			Typ:        types.T_decimal64,
+			ReturnType: types.T_decimal64,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				digits := int64(0)
+				vs := vecs[0].Col.([]types.Decimal64)
+				if len(vecs) > 1 {
+					if !cs[1] || vecs[1].Typ.Oid != types.T_int64 {
+						return nil, errors.New("the second argument of the round function must be an int64 constant")
+					}
+					digits = vecs[1].Col.([]int64)[0]
+				}
+				typ := vecs[0].Typ
+				typ.Scale = round.DecimalResultScale(typ.Scale, digits)
+				if vecs[0].Ref == 1 || vecs[0].Ref == 0 {
+					vecs[0].Ref = 0
+					if _, err := round.RoundDecimal64(vs, vs, digits, vecs[0].Typ.Scale); err != nil {
+						return nil, err
+					}
+					vecs[0].Typ = typ
+					return vecs[0], nil
+				}
+				vec, err := process.Get(proc, 8*int64(len(vs)), typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeDecimal64Slice(vec.Data)
+				rs = rs[:len(vs)]
+				if _, err := round.RoundDecimal64(vs, rs, digits, vecs[0].Typ.Scale); err != nil {
+					process.Put(proc, vec)
+					return nil, err
+				}
+				nulls.Set(vec.Nsp, vecs[0].Nsp)
+				vector.SetCol(vec, rs)
+				return vec, nil
+			},
+		},
+		{
+			Min:        1,
+			Max:        2,
+			Typ:        types.T_decimal128,
+			ReturnType: types.T_decimal128,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, cs []bool) (*vector.Vector, error) {
+				digits := int64(0)
+				vs := vecs[0].Col.([]types.Decimal128)
+				if len(vecs) > 1 {
+					if !cs[1] || vecs[1].Typ.Oid != types.T_int64 {
+						return nil, errors.New("the second argument of the round function must be an int64 constant")
+					}
+					digits = vecs[1].Col.([]int64)[0]
+				}
+				typ := vecs[0].Typ
+				typ.Scale = round.DecimalResultScale(typ.Scale, digits)
+				if vecs[0].Ref == 1 || vecs[0].Ref == 0 {
+					vecs[0].Ref = 0
+					if _, err := round.RoundDecimal128(vs, vs, digits, vecs[0].Typ.Scale); err != nil {
+						return nil, err
+					}
+					vecs[0].Typ = typ
+					return vecs[0], nil
+				}
+				vec, err := process.Get(proc, 16*int64(len(vs)), typ)
+				if err != nil {
+					return nil, err
+				}
+				rs := encoding.DecodeDecimal128Slice(vec.Data)
+				rs = rs[:len(vs)]
+				if _, err := round.RoundDecimal128(vs, rs, digits, vecs[0].Typ.Scale); err != nil {
+					process.Put(proc, vec)
+					return nil, err
+				}
+				nulls.Set(vec.Nsp, vecs[0].Nsp)
+				vector.SetCol(vec, rs)
+				return vec, nil
+			},
+		},
 	}
 }
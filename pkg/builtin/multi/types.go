@@ -15,9 +15,16 @@
 package multi
 
 import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
 
 type argsAndRet struct {
@@ -32,3 +39,114 @@ func getMultiReturnType(op int, es []extend.Extend) types.T {
 	}
 	return overload.GetMultiReturnType(op, ts)
 }
+
+var (
+	stringTypes = []types.T{types.T_char, types.T_varchar}
+	// dateArgTypes are the types accepted as a datetime, the strings are parsed
+	dateArgTypes = []types.T{types.T_date, types.T_datetime, types.T_char, types.T_varchar}
+	intTypes     = []types.T{
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	}
+)
+
+// appendFunctionRets registers the return type for all the combinations of the argument types,
+// the return type is the type of the first argument if ret is T_any
+func appendFunctionRets(op int, argTypes [][]types.T, ret types.T) {
+	var walk func(args []types.T)
+	walk = func(args []types.T) {
+		if len(args) == len(argTypes) {
+			r := ret
+			if r == types.T_any {
+				r = args[0]
+			}
+			overload.AppendFunctionRets(op, append([]types.T{}, args...), r)
+			return
+		}
+		for _, typ := range argTypes[len(args)] {
+			walk(append(args, typ))
+		}
+	}
+	walk(make([]types.T, 0, len(argTypes)))
+}
+
+func checkArgCount(name string, vecs []*vector.Vector, min, max int) error {
+	if len(vecs) < min || (max >= 0 && len(vecs) > max) {
+		return fmt.Errorf("incorrect parameter count in the call to '%s'", name)
+	}
+	return nil
+}
+
+func stringArg(name string, vecs []*vector.Vector, i int) (*types.Bytes, error) {
+	if !builtin.IsString(vecs[i].Typ.Oid) {
+		return nil, fmt.Errorf("the argument %d of '%s' must be a string, but got %s", i+1, name, vecs[i].Typ)
+	}
+	return vecs[i].Col.(*types.Bytes), nil
+}
+
+func intArg(name string, vecs []*vector.Vector, i int) ([]int64, error) {
+	if !builtin.IsInteger(vecs[i].Typ.Oid) {
+		return nil, fmt.Errorf("the argument %d of '%s' must be an integer, but got %s", i+1, name, vecs[i].Typ)
+	}
+	return builtin.Int64Column(vecs[i])
+}
+
+// resultRows returns the row count of the result, the constant arguments are used for all the rows
+func resultRows(vecs []*vector.Vector) int {
+	rows := 0
+	for _, vec := range vecs {
+		if n := vector.Length(vec); n > rows {
+			rows = n
+		}
+	}
+	return rows
+}
+
+// resultNulls returns the null rows of the result which is null if any argument is null
+func resultNulls(vecs []*vector.Vector, rows int) *nulls.Nulls {
+	nsp := new(nulls.Nulls)
+	for _, vec := range vecs {
+		nulls.Set(nsp, builtin.ConstNulls(vec, rows))
+	}
+	return nsp
+}
+
+// unitArg returns the interval unit of the constant string argument i
+func unitArg(name string, vecs []*vector.Vector, i int) (types.IntervalType, error) {
+	unit, err := stringArg(name, vecs, i)
+	if err != nil {
+		return types.IntervalTypeInvalid, err
+	}
+	if len(unit.Offsets) != 1 || nulls.Contains(vecs[i].Nsp, 0) {
+		return types.IntervalTypeInvalid, fmt.Errorf("the unit of '%s' must be a constant", name)
+	}
+	return types.IntervalTypeOf(string(unit.Get(0)))
+}
+
+// unitOf returns the interval unit of the constant string extend
+func unitOf(e extend.Extend) (types.IntervalType, bool) {
+	v, ok := e.(*extend.ValueExtend)
+	if !ok || !builtin.IsString(v.V.Typ.Oid) {
+		return types.IntervalTypeInvalid, false
+	}
+	it, err := types.IntervalTypeOf(string(v.V.Col.(*types.Bytes).Get(0)))
+	return it, err == nil
+}
+
+// int64Result returns a bigint vector of the rows values
+func int64Result(proc *process.Process, rows int) (*vector.Vector, []int64, error) {
+	vec, err := process.Get(proc, 8*int64(rows), types.Type{Oid: types.T_int64, Size: 8})
+	if err != nil {
+		return nil, nil, err
+	}
+	return vec, encoding.DecodeInt64Slice(vec.Data)[:rows], nil
+}
+
+// datetimeResult returns a datetime vector of the rows values
+func datetimeResult(proc *process.Process, rows int) (*vector.Vector, []types.Datetime, error) {
+	vec, err := process.Get(proc, 8*int64(rows), types.Type{Oid: types.T_datetime, Size: 8})
+	if err != nil {
+		return nil, nil, err
+	}
+	return vec, encoding.DecodeDatetimeSlice(vec.Data)[:rows], nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multi
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/dateformat"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/unixtime"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	extend.FunctionRegistry["unix_timestamp"] = builtin.UnixTimestamp
+	extend.MultiReturnTypes[builtin.UnixTimestamp] = func(es []extend.Extend) types.T {
+		return getMultiReturnType(builtin.UnixTimestamp, es)
+	}
+	extend.MultiStrings[builtin.UnixTimestamp] = func(es []extend.Extend) string {
+		return fmt.Sprintf("unix_timestamp(%s)", joinExtends(es))
+	}
+	overload.OpTypes[builtin.UnixTimestamp] = overload.Multi
+	appendFunctionRets(builtin.UnixTimestamp, [][]types.T{}, types.T_int64)
+	appendFunctionRets(builtin.UnixTimestamp, [][]types.T{dateArgTypes}, types.T_int64)
+	// unix_timestamp() without arguments returns the current timestamp
+	overload.MultiOps[builtin.UnixTimestamp] = []*overload.MultiOp{
+		{
+			Min:        0,
+			Max:        0,
+			ReturnType: types.T_int64,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount("unix_timestamp", vecs, 0, 0); err != nil {
+					return nil, err
+				}
+				vec, rs, err := int64Result(proc, 1)
+				if err != nil {
+					return nil, err
+				}
+				rs[0] = time.Now().Unix()
+				vector.SetCol(vec, rs)
+				return vec, nil
+			},
+		},
+	}
+	for _, typ := range dateArgTypes {
+		overload.MultiOps[builtin.UnixTimestamp] = append(overload.MultiOps[builtin.UnixTimestamp], &overload.MultiOp{
+			Min:        1,
+			Max:        1,
+			Typ:        typ,
+			ReturnType: types.T_int64,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount("unix_timestamp", vecs, 1, 1); err != nil {
+					return nil, err
+				}
+				rows := resultRows(vecs)
+				nsp := resultNulls(vecs, rows)
+				xs, err := builtin.DatetimeColumn(vecs[0], rows, nsp)
+				if err != nil {
+					return nil, err
+				}
+				vec, rs, err := int64Result(proc, rows)
+				if err != nil {
+					return nil, err
+				}
+				nulls.Set(vec.Nsp, nsp)
+				vector.SetCol(vec, unixtime.UnixTimestamp(xs, rs))
+				return vec, nil
+			},
+		})
+	}
+
+	// from_unixtime(ts) returns a datetime and from_unixtime(ts, format) returns the formatted string
+	extend.FunctionRegistry["from_unixtime"] = builtin.FromUnixtime
+	extend.MultiReturnTypes[builtin.FromUnixtime] = func(es []extend.Extend) types.T {
+		return getMultiReturnType(builtin.FromUnixtime, es)
+	}
+	extend.MultiStrings[builtin.FromUnixtime] = func(es []extend.Extend) string {
+		return fmt.Sprintf("from_unixtime(%s)", joinExtends(es))
+	}
+	overload.OpTypes[builtin.FromUnixtime] = overload.Multi
+	appendFunctionRets(builtin.FromUnixtime, [][]types.T{intTypes}, types.T_datetime)
+	appendFunctionRets(builtin.FromUnixtime, [][]types.T{intTypes, stringTypes}, types.T_varchar)
+	for _, typ := range intTypes {
+		overload.MultiOps[builtin.FromUnixtime] = append(overload.MultiOps[builtin.FromUnixtime], &overload.MultiOp{
+			Min:        1,
+			Max:        2,
+			Typ:        typ,
+			ReturnType: types.T_datetime,
+			Fn: func(vecs []*vector.Vector, proc *process.Process, _ []bool) (*vector.Vector, error) {
+				if err := checkArgCount("from_unixtime", vecs, 1, 2); err != nil {
+					return nil, err
+				}
+				xs, err := intArg("from_unixtime", vecs, 0)
+				if err != nil {
+					return nil, err
+				}
+				rows := resultRows(vecs)
+				nsp := resultNulls(vecs, rows)
+				if len(vecs) == 1 {
+					vec, rs, err := datetimeResult(proc, rows)
+					if err != nil {
+						return nil, err
+					}
+					rs = unixtime.FromUnixtime(xs, nsp, rs)
+					nulls.Set(vec.Nsp, nsp)
+					vector.SetCol(vec, rs)
+					return vec, nil
+				}
+				formats, err := stringArg("from_unixtime", vecs, 1)
+				if err != nil {
+					return nil, err
+				}
+				dts := unixtime.FromUnixtime(xs, nsp, make([]types.Datetime, len(xs)))
+				rs := dateformat.DateFormat(dts, formats, nsp, &types.Bytes{})
+				return builtin.NewBytesVector(proc, types.T_varchar, rs, nsp)
+			},
+		})
+	}
+}
@@ -53,4 +53,49 @@ const (
 	EndsWith
 	Date
 	Bin
+	Upper
+	Lower
+	Ascii
+	Chr
+	HexEncode
+	HexDecode
+	Base64Encode
+	Base64Decode
+	Repeat
+	Left
+	Right
+	Instr
+	Locate
+	Position
+	Replace
+	Translate
+	Concat
+	ConcatWs
+	RegMatch
+	NotRegMatch
+	RegexpLike
+	NotRegexpLike
+	RegexpReplace
+	RegexpSubstr
+	DateAdd
+	DateSub
+	DateDiff
+	TimestampDiff
+	DateFormat
+	StrToDate
+	UnixTimestamp
+	FromUnixtime
+	Extract
+	Hour
+	Minute
+	Second
+	LastDay
+	ConvertTz
+	JsonExtract
+	JsonUnquote
+	JsonContains
+	JsonLength
+	JsonKeys
+	JsonArray
+	JsonObject
 )
@@ -0,0 +1,60 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/chr"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// chr accepts all the integer types
+var chrArgTypes = []types.T{
+	types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+	types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+}
+
+func init() {
+	extend.FunctionRegistry["chr"] = builtin.Chr
+	extend.UnaryReturnTypes[builtin.Chr] = func(_ extend.Extend) types.T {
+		return types.T_varchar
+	}
+	extend.UnaryStrings[builtin.Chr] = func(e extend.Extend) string {
+		return fmt.Sprintf("chr(%s)", e)
+	}
+	overload.OpTypes[builtin.Chr] = overload.Unary
+	for _, typ := range chrArgTypes {
+		overload.UnaryOps[builtin.Chr] = append(overload.UnaryOps[builtin.Chr], &overload.UnaryOp{
+			Typ:        typ,
+			ReturnType: types.T_varchar,
+			Fn: func(lv *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
+				lvs, err := builtin.Int64Column(lv)
+				if err != nil {
+					return nil, err
+				}
+				nsp := new(nulls.Nulls)
+				nulls.Set(nsp, lv.Nsp)
+				return builtin.NewBytesVector(proc, types.T_varchar, chr.Chr(lvs, &types.Bytes{}, nsp), nsp)
+			},
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/encode"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+func init() {
+	registerEncode("hex_encode", builtin.HexEncode, encode.HexEncode)
+	registerEncode("base64_encode", builtin.Base64Encode, encode.Base64Encode)
+	registerDecode("hex_decode", builtin.HexDecode, encode.HexDecode)
+	registerDecode("base64_decode", builtin.Base64Decode, encode.Base64Decode)
+}
+
+// registerEncode registers the encoding function of the strings, the results are varchar
+func registerEncode(name string, op int, fn func(*types.Bytes, *types.Bytes) *types.Bytes) {
+	registerCodec(name, op, func(lv *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
+		return builtin.NewBytesVector(proc, types.T_varchar, fn(lv.Col.(*types.Bytes), &types.Bytes{}), lv.Nsp)
+	})
+}
+
+// registerDecode registers the decoding function of the strings, the malformed strings are decoded to null
+func registerDecode(name string, op int, fn func(*types.Bytes, *types.Bytes, *nulls.Nulls) *types.Bytes) {
+	registerCodec(name, op, func(lv *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
+		nsp := new(nulls.Nulls)
+		nulls.Set(nsp, lv.Nsp)
+		return builtin.NewBytesVector(proc, types.T_varchar, fn(lv.Col.(*types.Bytes), &types.Bytes{}, nsp), nsp)
+	})
+}
+
+func registerCodec(name string, op int, fn func(*vector.Vector, *process.Process, bool) (*vector.Vector, error)) {
+	extend.FunctionRegistry[name] = op
+	extend.UnaryReturnTypes[op] = func(_ extend.Extend) types.T {
+		return types.T_varchar
+	}
+	extend.UnaryStrings[op] = func(e extend.Extend) string {
+		return fmt.Sprintf("%s(%s)", name, e)
+	}
+	overload.OpTypes[op] = overload.Unary
+	overload.UnaryOps[op] = []*overload.UnaryOp{
+		{
+			Typ:        types.T_char,
+			ReturnType: types.T_varchar,
+			Fn:         fn,
+		},
+		{
+			Typ:        types.T_varchar,
+			ReturnType: types.T_varchar,
+			Fn:         fn,
+		},
+	}
+}
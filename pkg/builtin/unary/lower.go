@@ -0,0 +1,67 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/lower"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var LowerArgAndRets = []argsAndRet{
+	{[]types.T{types.T_char}, types.T_char},
+	{[]types.T{types.T_varchar}, types.T_varchar},
+}
+
+func init() {
+	extend.FunctionRegistry["lower"] = builtin.Lower
+	overload.OpTypes[builtin.Lower] = overload.Unary
+
+	for _, item := range LowerArgAndRets {
+		overload.AppendFunctionRets(builtin.Lower, item.args, item.ret)
+	}
+
+	extend.UnaryReturnTypes[builtin.Lower] = func(extend extend.Extend) types.T {
+		return getUnaryReturnType(builtin.Lower, extend)
+	}
+	extend.UnaryStrings[builtin.Lower] = func(extend extend.Extend) string {
+		return fmt.Sprintf("lower(%s)", extend)
+	}
+
+	overload.UnaryOps[builtin.Lower] = []*overload.UnaryOp{
+		{
+			Typ:        types.T_char,
+			ReturnType: types.T_char,
+			Fn: func(inputVec *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
+				results := lower.LowerChar(inputVec.Col.(*types.Bytes), &types.Bytes{})
+				return builtin.NewBytesVector(proc, types.T_char, results, inputVec.Nsp)
+			},
+		},
+		{
+			Typ:        types.T_varchar,
+			ReturnType: types.T_varchar,
+			Fn: func(inputVec *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
+				results := lower.LowerVarChar(inputVec.Col.(*types.Bytes), &types.Bytes{})
+				return builtin.NewBytesVector(proc, types.T_varchar, results, inputVec.Nsp)
+			},
+		},
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unary
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/upper"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+var UpperArgAndRets = []argsAndRet{
+	{[]types.T{types.T_char}, types.T_char},
+	{[]types.T{types.T_varchar}, types.T_varchar},
+}
+
+func init() {
+	extend.FunctionRegistry["upper"] = builtin.Upper
+	overload.OpTypes[builtin.Upper] = overload.Unary
+
+	for _, item := range UpperArgAndRets {
+		overload.AppendFunctionRets(builtin.Upper, item.args, item.ret)
+	}
+
+	extend.UnaryReturnTypes[builtin.Upper] = func(extend extend.Extend) types.T {
+		return getUnaryReturnType(builtin.Upper, extend)
+	}
+	extend.UnaryStrings[builtin.Upper] = func(extend extend.Extend) string {
+		return fmt.Sprintf("upper(%s)", extend)
+	}
+
+	overload.UnaryOps[builtin.Upper] = []*overload.UnaryOp{
+		{
+			Typ:        types.T_char,
+			ReturnType: types.T_char,
+			Fn: func(inputVec *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
+				results := upper.UpperChar(inputVec.Col.(*types.Bytes), &types.Bytes{})
+				return builtin.NewBytesVector(proc, types.T_char, results, inputVec.Nsp)
+			},
+		},
+		{
+			Typ:        types.T_varchar,
+			ReturnType: types.T_varchar,
+			Fn: func(inputVec *vector.Vector, proc *process.Process, _ bool) (*vector.Vector, error) {
+				results := upper.UpperVarChar(inputVec.Col.(*types.Bytes), &types.Bytes{})
+				return builtin.NewBytesVector(proc, types.T_varchar, results, inputVec.Nsp)
+			},
+		},
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builtin
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// NewBytesVector returns a vector of typ holding rs whose data is copied into the memory of proc
+func NewBytesVector(proc *process.Process, typ types.T, rs *types.Bytes, nsp *nulls.Nulls) (*vector.Vector, error) {
+	vec, err := process.Get(proc, int64(len(rs.Data)), types.Type{Oid: typ, Size: 24})
+	if err != nil {
+		return nil, err
+	}
+	copy(vec.Data, rs.Data)
+	rs.Data = vec.Data
+	nulls.Set(vec.Nsp, nsp)
+	vector.SetCol(vec, rs)
+	return vec, nil
+}
+
+// Int64Column returns the values of an integer vector as int64, the uint64 values out of range are saturated
+func Int64Column(vec *vector.Vector) ([]int64, error) {
+	switch vs := vec.Col.(type) {
+	case []int8:
+		return toInt64(vs), nil
+	case []int16:
+		return toInt64(vs), nil
+	case []int32:
+		return toInt64(vs), nil
+	case []int64:
+		return vs, nil
+	case []uint8:
+		return toInt64(vs), nil
+	case []uint16:
+		return toInt64(vs), nil
+	case []uint32:
+		return toInt64(vs), nil
+	case []uint64:
+		rs := make([]int64, len(vs))
+		for i, v := range vs {
+			if v > math.MaxInt64 {
+				rs[i] = math.MaxInt64
+			} else {
+				rs[i] = int64(v)
+			}
+		}
+		return rs, nil
+	}
+	return nil, fmt.Errorf("'%s' is not an integer type", vec.Typ)
+}
+
+// IsInteger returns true if typ is a signed or unsigned integer type
+func IsInteger(typ types.T) bool {
+	switch typ {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return true
+	}
+	return false
+}
+
+// IsString returns true if typ is char or varchar
+func IsString(typ types.T) bool {
+	return typ == types.T_char || typ == types.T_varchar
+}
+
+// IsDate returns true if typ is date or datetime
+func IsDate(typ types.T) bool {
+	return typ == types.T_date || typ == types.T_datetime
+}
+
+// DatetimeColumn returns the values of a date, datetime or string vector used for n rows as datetime,
+// the strings which are not a valid datetime are set null in nsp
+func DatetimeColumn(vec *vector.Vector, n int, nsp *nulls.Nulls) ([]types.Datetime, error) {
+	switch vs := vec.Col.(type) {
+	case []types.Datetime:
+		return vs, nil
+	case []types.Date:
+		rs := make([]types.Datetime, len(vs))
+		for i, v := range vs {
+			rs[i] = v.ToTime()
+		}
+		return rs, nil
+	case *types.Bytes:
+		rs := make([]types.Datetime, len(vs.Offsets))
+		for i := range vs.Offsets {
+			if nulls.Contains(vec.Nsp, uint64(i)) {
+				continue
+			}
+			dt, err := types.ParseDatetime(string(vs.Get(int64(i))))
+			if err != nil {
+				if len(rs) == 1 {
+					nulls.Set(nsp, AllNulls(n))
+				} else {
+					nulls.Add(nsp, uint64(i))
+				}
+				continue
+			}
+			rs[i] = dt
+		}
+		return rs, nil
+	}
+	return nil, fmt.Errorf("'%s' is not a date type", vec.Typ)
+}
+
+// ConstNulls returns the null bitmap of the vector used for n rows,
+// all the rows are null if the vector is a null constant
+func ConstNulls(vec *vector.Vector, n int) *nulls.Nulls {
+	if vector.Length(vec) != 1 || !nulls.Contains(vec.Nsp, 0) {
+		return vec.Nsp
+	}
+	return AllNulls(n)
+}
+
+// AllNulls returns the null bitmap in which all the n rows are null
+func AllNulls(n int) *nulls.Nulls {
+	rows := make([]uint64, n)
+	for i := range rows {
+		rows[i] = uint64(i)
+	}
+	nsp := new(nulls.Nulls)
+	nulls.Add(nsp, rows...)
+	return nsp
+}
+
+func toInt64[T int8 | int16 | int32 | uint8 | uint16 | uint32](xs []T) []int64 {
+	rs := make([]int64, len(xs))
+	for i, x := range xs {
+		rs[i] = int64(x)
+	}
+	return rs
+}
@@ -15,15 +15,91 @@
 package compress
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4"
 )
 
 var Algorithms map[string]int = map[string]int{
-	"lz4":  Lz4,
-	"none": None,
+	"lz4":    Lz4,
+	"none":   None,
+	"zstd":   Zstd,
+	"snappy": Snappy,
+}
+
+var (
+	ErrShortBuffer = errors.New("compress: decompressed data exceeds the buffer")
+)
+
+var (
+	zstdDecoder  *zstd.Decoder
+	zstdEncoders sync.Map // level -> *zstd.Encoder
+)
+
+func init() {
+	var err error
+	if zstdDecoder, err = zstd.NewReader(nil); err != nil {
+		panic(err)
+	}
+}
+
+// ParseAlgorithm returns the algorithm of the given name, which is case
+// insensitive
+func ParseAlgorithm(name string) (T, error) {
+	alg, ok := Algorithms[strings.ToLower(name)]
+	if !ok {
+		return None, fmt.Errorf("unsupported compression algorithm '%s'", name)
+	}
+	return T(alg), nil
+}
+
+// ParseLevel parses a compression level of the algorithm. Only Zstd is
+// tunable, with levels from MinZstdLevel to MaxZstdLevel.
+func ParseLevel(typ T, level string) (int, error) {
+	n, err := strconv.Atoi(level)
+	if err != nil {
+		return 0, fmt.Errorf("invalid compression level '%s'", level)
+	}
+	if typ != Zstd {
+		return 0, fmt.Errorf("compression level is not supported by %s", typ)
+	}
+	if n < MinZstdLevel || n > MaxZstdLevel {
+		return 0, fmt.Errorf("zstd compression level %d out of range [%d, %d]", n, MinZstdLevel, MaxZstdLevel)
+	}
+	return n, nil
+}
+
+// CompressBlockBound returns the maximum size of the compressed output of
+// n bytes
+func CompressBlockBound(n int, typ int) int {
+	switch typ {
+	case Lz4:
+		return lz4.CompressBlockBound(n)
+	case Zstd:
+		bound := n + n>>8
+		if n < 128<<10 {
+			bound += (128<<10 - n) >> 11
+		}
+		return bound
+	case Snappy:
+		return snappy.MaxEncodedLen(n)
+	}
+	return n
 }
 
 func Compress(src, dst []byte, typ int) ([]byte, error) {
+	return CompressWithLevel(src, dst, typ, DefaultZstdLevel)
+}
+
+// CompressWithLevel is like Compress, with level used by the algorithms
+// supporting it. Level 0 stands for the default level.
+func CompressWithLevel(src, dst []byte, typ int, level int) ([]byte, error) {
 	switch typ {
 	case Lz4:
 		n, err := lz4.CompressBlock(src, dst, nil)
@@ -31,10 +107,20 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		enc, err := getZstdEncoder(level)
+		if err != nil {
+			return nil, err
+		}
+		return enc.EncodeAll(src, dst[:0]), nil
+	case Snappy:
+		return snappy.Encode(dst, src), nil
 	}
 	return nil, nil
 }
 
+// Decompress decodes src into dst, which has to be large enough to hold the
+// decompressed data
 func Decompress(src, dst []byte, typ int) ([]byte, error) {
 	switch typ {
 	case Lz4:
@@ -43,6 +129,45 @@ func Decompress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		buf, err := zstdDecoder.DecodeAll(src, dst[:0])
+		if err != nil {
+			return nil, err
+		}
+		return fitInto(buf, dst)
+	case Snappy:
+		buf, err := snappy.Decode(dst, src)
+		if err != nil {
+			return nil, err
+		}
+		return fitInto(buf, dst)
 	}
 	return nil, nil
 }
+
+// fitInto makes sure the decoded data lives in dst, as callers may ignore
+// the returned slice
+func fitInto(buf, dst []byte) ([]byte, error) {
+	if len(buf) > len(dst) {
+		return nil, ErrShortBuffer
+	}
+	if len(buf) > 0 && &buf[0] != &dst[0] {
+		copy(dst, buf)
+	}
+	return dst[:len(buf)], nil
+}
+
+func getZstdEncoder(level int) (*zstd.Encoder, error) {
+	if level == 0 {
+		level = DefaultZstdLevel
+	}
+	if enc, ok := zstdEncoders.Load(level); ok {
+		return enc.(*zstd.Encoder), nil
+	}
+	enc, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)))
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := zstdEncoders.LoadOrStore(level, enc)
+	return actual.(*zstd.Encoder), nil
+}
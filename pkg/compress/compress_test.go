@@ -15,6 +15,7 @@
 package compress
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"log"
@@ -40,3 +41,52 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestZstdAndSnappy(t *testing.T) {
+	xs := make([]int64, 4096)
+	for i := range xs {
+		xs[i] = int64(i % 17)
+	}
+	raw := encoding.EncodeInt64Slice(xs)
+	for _, typ := range []int{Zstd, Snappy} {
+		for _, level := range []int{0, MinZstdLevel, MaxZstdLevel} {
+			buf := make([]byte, CompressBlockBound(len(raw), typ))
+			buf, err := CompressWithLevel(raw, buf, typ, level)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(buf) >= len(raw) {
+				t.Fatalf("%s: compressed size %d not smaller than %d", T(typ), len(buf), len(raw))
+			}
+			data := make([]byte, len(raw))
+			if _, err = Decompress(buf, data, typ); err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(data, raw) {
+				t.Fatalf("%s: decompressed data mismatch", T(typ))
+			}
+			if _, err = Decompress(buf, data[:len(raw)/2], typ); err == nil {
+				t.Fatalf("%s: expected error on short buffer", T(typ))
+			}
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	typ, err := ParseAlgorithm("ZSTD")
+	if err != nil || typ != Zstd {
+		t.Fatalf("unexpected %v %v", typ, err)
+	}
+	if _, err = ParseAlgorithm("gzip"); err == nil {
+		t.Fatal("expected error")
+	}
+	if level, err := ParseLevel(Zstd, "9"); err != nil || level != 9 {
+		t.Fatalf("unexpected %v %v", level, err)
+	}
+	if _, err = ParseLevel(Zstd, "23"); err == nil {
+		t.Fatal("expected error")
+	}
+	if _, err = ParseLevel(Snappy, "1"); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -19,6 +19,14 @@ import "fmt"
 const (
 	None = iota
 	Lz4
+	Zstd
+	Snappy
+)
+
+const (
+	MinZstdLevel     = 1
+	MaxZstdLevel     = 22
+	DefaultZstdLevel = 3
 )
 
 type T uint8
@@ -29,6 +37,10 @@ func (t T) String() string {
 		return "None"
 	case Lz4:
 		return "LZ4"
+	case Zstd:
+		return "ZSTD"
+	case Snappy:
+		return "SNAPPY"
 	}
 	return fmt.Sprintf("unexpected compress type: %d", t)
 }
@@ -17,6 +17,7 @@ package config
 import (
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
 )
@@ -38,6 +39,9 @@ var ClusterNodes engine.Nodes
 //cube catalog
 var ClusterCatalog *catalog.Catalog
 
+//Transaction client of the TAE. nil if the tables are not stored in the TAE.
+var TxnClient txnif.TxnClient
+
 /**
 check if x in a slice
 */
@@ -91,6 +95,10 @@ type ParameterUnit struct {
 
 	//Cube Catalog
 	ClusterCatalog *catalog.Catalog
+
+	//Transaction client of the storage. When it is set, the statements
+	//are executed in the transactions started by it.
+	TxnClient txnif.TxnClient
 }
 
 func NewParameterUnit(sv *SystemVariables, hostMmu *host.Mmu, mempool *mempool.Mempool, storageEngine engine.Engine, clusterNodes engine.Nodes, catalogRef *catalog.Catalog) *ParameterUnit {
@@ -42,6 +42,9 @@ func SetLength(bat *Batch, n int) {
 	for _, vec := range bat.Vecs {
 		vector.SetLength(vec, n)
 	}
+	for _, r := range bat.Rs {
+		r.SetLength(n)
+	}
 	bat.Zs = bat.Zs[:n]
 }
 
@@ -49,6 +52,9 @@ func Shrink(bat *Batch, sels []int64) {
 	for _, vec := range bat.Vecs {
 		vector.Shrink(vec, sels)
 	}
+	for _, r := range bat.Rs {
+		r.Shrink(sels)
+	}
 	vs := bat.Zs
 	for i, sel := range sels {
 		vs[i] = vs[sel]
@@ -63,6 +69,11 @@ func Shuffle(bat *Batch, sels []int64, m *mheap.Mheap) error {
 				return err
 			}
 		}
+		for _, r := range bat.Rs {
+			if err := r.Shuffle(sels, m); err != nil {
+				return err
+			}
+		}
 		data, err := mheap.Alloc(m, int64(len(bat.Zs))*8)
 		if err != nil {
 			return err
@@ -94,7 +105,11 @@ func Clean(bat *Batch, m *mheap.Mheap) {
 			vector.Clean(vec, m)
 		}
 	}
+	for _, r := range bat.Rs {
+		r.Free(m)
+	}
 	bat.Vecs = nil
+	bat.Rs = nil
 	bat.Zs = nil
 }
 
@@ -15,6 +15,7 @@
 package batch
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 )
 
@@ -24,6 +25,8 @@ type Batch struct {
 	Zs []int64
 	// columns
 	Vecs []*vector.Vector
+	// aggregation states of the groups
+	Rs []ring.Ring
 	// anything
 	Ht interface{}
 }
@@ -0,0 +1,479 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// Null is the JSON null
+var Null = ByteJson{byte(TypeNull)}
+
+// Parse validates the JSON text and returns its binary encoding
+func Parse(s []byte) (ByteJson, error) {
+	dec := json.NewDecoder(bytes.NewReader(s))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, errInvalidJson(s, err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errInvalidJson(s, fmt.Errorf("the document root must not be followed by other values"))
+	}
+	return Create(v)
+}
+
+// ParseString is the Parse of a string
+func ParseString(s string) (ByteJson, error) {
+	return Parse([]byte(s))
+}
+
+func errInvalidJson(s []byte, err error) error {
+	return errors.New(errno.DataException, fmt.Sprintf("invalid JSON text: '%s', %v", s, err))
+}
+
+/*
+Create returns the JSON value of v which is one of nil, bool, the integers, the floats, string,
+json.Number, ByteJson, []interface{}, []ByteJson and map[string]interface{}.
+*/
+func Create(v interface{}) (ByteJson, error) {
+	return appendValue(nil, v, 0)
+}
+
+// CreateArray returns the JSON array of the elements
+func CreateArray(elems []ByteJson) ByteJson {
+	bj, _ := Create(elems)
+	return bj
+}
+
+// CreateObject returns the JSON object of the members, the last value is kept for the same keys
+func CreateObject(keys []string, values []ByteJson) (ByteJson, error) {
+	m := make(map[string]interface{}, len(keys))
+	for i, key := range keys {
+		m[key] = values[i]
+	}
+	return Create(m)
+}
+
+func appendValue(buf []byte, v interface{}, depth int) ([]byte, error) {
+	if depth > maxNestDepth {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("the JSON document exceeds the maximum depth %d", maxNestDepth))
+	}
+	switch x := v.(type) {
+	case nil:
+		return append(buf, byte(TypeNull)), nil
+	case bool:
+		if x {
+			return append(buf, byte(TypeTrue)), nil
+		}
+		return append(buf, byte(TypeFalse)), nil
+	case int8:
+		return appendInt64(buf, int64(x)), nil
+	case int16:
+		return appendInt64(buf, int64(x)), nil
+	case int32:
+		return appendInt64(buf, int64(x)), nil
+	case int64:
+		return appendInt64(buf, x), nil
+	case int:
+		return appendInt64(buf, int64(x)), nil
+	case uint8:
+		return appendInt64(buf, int64(x)), nil
+	case uint16:
+		return appendInt64(buf, int64(x)), nil
+	case uint32:
+		return appendInt64(buf, int64(x)), nil
+	case uint64:
+		if x <= math.MaxInt64 {
+			return appendInt64(buf, int64(x)), nil
+		}
+		buf = append(buf, byte(TypeUint64))
+		return appendUint64(buf, x), nil
+	case float32:
+		return appendFloat64(buf, float64(x))
+	case float64:
+		return appendFloat64(buf, x)
+	case json.Number:
+		return appendNumber(buf, string(x))
+	case string:
+		buf = append(buf, byte(TypeString))
+		buf = appendUvarint(buf, uint64(len(x)))
+		return append(buf, x...), nil
+	case ByteJson:
+		return append(buf, x...), nil
+	case []ByteJson:
+		vs := make([]interface{}, len(x))
+		for i := range x {
+			vs[i] = x[i]
+		}
+		return appendArray(buf, vs, depth)
+	case []interface{}:
+		return appendArray(buf, x, depth)
+	case map[string]interface{}:
+		return appendObject(buf, x, depth)
+	}
+	return nil, errors.New(errno.DataException, fmt.Sprintf("'%v' can not be converted to JSON", v))
+}
+
+func appendInt64(buf []byte, v int64) []byte {
+	buf = append(buf, byte(TypeInt64))
+	return appendUint64(buf, uint64(v))
+}
+
+func appendFloat64(buf []byte, v float64) ([]byte, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("'%v' can not be converted to JSON", v))
+	}
+	buf = append(buf, byte(TypeFloat64))
+	return appendUint64(buf, math.Float64bits(v)), nil
+}
+
+// appendNumber appends the number of the JSON text as int64, uint64 or float64 like the mysql
+func appendNumber(buf []byte, s string) ([]byte, error) {
+	if !strings.ContainsAny(s, ".eE") {
+		if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return appendInt64(buf, v), nil
+		}
+		if v, err := strconv.ParseUint(s, 10, 64); err == nil {
+			buf = append(buf, byte(TypeUint64))
+			return appendUint64(buf, v), nil
+		}
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil, errors.New(errno.DataException, fmt.Sprintf("invalid JSON number '%s'", s))
+	}
+	return appendFloat64(buf, v)
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	return append(buf, b[:]...)
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	var b [4]byte
+	binary.LittleEndian.PutUint32(b[:], v)
+	return append(buf, b[:]...)
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	return append(buf, b[:n]...)
+}
+
+func appendArray(buf []byte, vs []interface{}, depth int) ([]byte, error) {
+	var err error
+
+	elems := make([]byte, 0, len(vs)*9)
+	offs := make([]int, len(vs))
+	for i, v := range vs {
+		offs[i] = len(elems)
+		if elems, err = appendValue(elems, v, depth+1); err != nil {
+			return nil, err
+		}
+	}
+	start := headerSize + len(vs)*offsetSize
+	size := start + len(elems)
+	if size > maxOffsetSize {
+		return nil, errors.New(errno.DataException, "the JSON document is too large")
+	}
+	buf = append(buf, byte(TypeArray))
+	buf = appendUint32(buf, uint32(len(vs)))
+	buf = appendUint32(buf, uint32(size))
+	for _, off := range offs {
+		buf = appendUint32(buf, uint32(start+off))
+	}
+	return append(buf, elems...), nil
+}
+
+func appendObject(buf []byte, m map[string]interface{}, depth int) ([]byte, error) {
+	var err error
+
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		if len(key) > maxKeyLength {
+			return nil, errors.New(errno.DataException, "the key of the JSON object is too long")
+		}
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return compareKey(keys[i], keys[j]) < 0 })
+	keysLen := 0
+	for _, key := range keys {
+		keysLen += len(key)
+	}
+	values := make([]byte, 0, len(keys)*9)
+	offs := make([]int, len(keys))
+	for i, key := range keys {
+		offs[i] = len(values)
+		if values, err = appendValue(values, m[key], depth+1); err != nil {
+			return nil, err
+		}
+	}
+	start := headerSize + len(keys)*(keyEntrySize+offsetSize)
+	size := start + keysLen + len(values)
+	if size > maxOffsetSize {
+		return nil, errors.New(errno.DataException, "the JSON document is too large")
+	}
+	buf = append(buf, byte(TypeObject))
+	buf = appendUint32(buf, uint32(len(keys)))
+	buf = appendUint32(buf, uint32(size))
+	off := start
+	for _, key := range keys {
+		buf = appendUint32(buf, uint32(off))
+		buf = append(buf, byte(len(key)), byte(len(key)>>8))
+		off += len(key)
+	}
+	for _, o := range offs {
+		buf = appendUint32(buf, uint32(start+keysLen+o))
+	}
+	for _, key := range keys {
+		buf = append(buf, key...)
+	}
+	return append(buf, values...), nil
+}
+
+// compareKey orders the keys of an object like the mysql, the shorter key is the smaller one
+func compareKey(a, b string) int {
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// Type returns the type of the value
+func (bj ByteJson) Type() Type {
+	return Type(bj[0])
+}
+
+func (bj ByteJson) payload() []byte {
+	return bj[1:]
+}
+
+// Int64 returns the value of an int64
+func (bj ByteJson) Int64() int64 {
+	return int64(binary.LittleEndian.Uint64(bj.payload()))
+}
+
+// Uint64 returns the value of an uint64
+func (bj ByteJson) Uint64() uint64 {
+	return binary.LittleEndian.Uint64(bj.payload())
+}
+
+// Float64 returns the value of a float64
+func (bj ByteJson) Float64() float64 {
+	return math.Float64frombits(binary.LittleEndian.Uint64(bj.payload()))
+}
+
+// Str returns the value of a string
+func (bj ByteJson) Str() []byte {
+	n, w := binary.Uvarint(bj.payload())
+	return bj.payload()[w : w+int(n)]
+}
+
+// Count returns the number of the elements of an array or the members of an object
+func (bj ByteJson) Count() int {
+	return int(binary.LittleEndian.Uint32(bj.payload()))
+}
+
+func (bj ByteJson) size() int {
+	return int(binary.LittleEndian.Uint32(bj.payload()[4:]))
+}
+
+// Index returns the i-th element of an array
+func (bj ByteJson) Index(i int) ByteJson {
+	return bj.valueAt(headerSize+i*offsetSize, i)
+}
+
+// Key returns the i-th key of an object
+func (bj ByteJson) Key(i int) []byte {
+	p := bj.payload()
+	entry := p[headerSize+i*keyEntrySize:]
+	off := binary.LittleEndian.Uint32(entry)
+	n := binary.LittleEndian.Uint16(entry[4:])
+	return p[off : off+uint32(n)]
+}
+
+// Value returns the i-th value of an object
+func (bj ByteJson) Value(i int) ByteJson {
+	return bj.valueAt(headerSize+bj.Count()*keyEntrySize+i*offsetSize, i)
+}
+
+// valueAt returns the i-th value of the container which offsets start at pos
+func (bj ByteJson) valueAt(pos int, i int) ByteJson {
+	p := bj.payload()
+	start := binary.LittleEndian.Uint32(p[pos:])
+	end := uint32(bj.size())
+	if i+1 < bj.Count() {
+		end = binary.LittleEndian.Uint32(p[pos+offsetSize:])
+	}
+	return ByteJson(p[start:end])
+}
+
+// Member returns the value of the key of an object
+func (bj ByteJson) Member(key []byte) (ByteJson, bool) {
+	n := bj.Count()
+	i := sort.Search(n, func(i int) bool {
+		return compareKey(string(bj.Key(i)), string(key)) >= 0
+	})
+	if i < n && bytes.Equal(bj.Key(i), key) {
+		return bj.Value(i), true
+	}
+	return nil, false
+}
+
+// String returns the JSON text of the value like the mysql
+func (bj ByteJson) String() string {
+	return string(bj.appendText(nil))
+}
+
+func (bj ByteJson) appendText(buf []byte) []byte {
+	switch bj.Type() {
+	case TypeNull:
+		return append(buf, "null"...)
+	case TypeTrue:
+		return append(buf, "true"...)
+	case TypeFalse:
+		return append(buf, "false"...)
+	case TypeInt64:
+		return strconv.AppendInt(buf, bj.Int64(), 10)
+	case TypeUint64:
+		return strconv.AppendUint(buf, bj.Uint64(), 10)
+	case TypeFloat64:
+		return appendFloatText(buf, bj.Float64())
+	case TypeString:
+		return appendQuoted(buf, bj.Str())
+	case TypeArray:
+		buf = append(buf, '[')
+		for i, n := 0, bj.Count(); i < n; i++ {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = bj.Index(i).appendText(buf)
+		}
+		return append(buf, ']')
+	case TypeObject:
+		buf = append(buf, '{')
+		for i, n := 0, bj.Count(); i < n; i++ {
+			if i > 0 {
+				buf = append(buf, ", "...)
+			}
+			buf = appendQuoted(buf, bj.Key(i))
+			buf = append(buf, ": "...)
+			buf = bj.Value(i).appendText(buf)
+		}
+		return append(buf, '}')
+	}
+	return buf
+}
+
+// appendFloatText appends the float like the mysql, 1.0 is not shown as 1 and 1e20 is not shown as 1e+20
+func appendFloatText(buf []byte, v float64) []byte {
+	s := strconv.FormatFloat(v, 'g', -1, 64)
+	s = strings.Replace(s, "e+", "e", 1)
+	buf = append(buf, s...)
+	if !strings.ContainsAny(s, ".e") {
+		buf = append(buf, ".0"...)
+	}
+	return buf
+}
+
+func appendQuoted(buf []byte, s []byte) []byte {
+	const hex = "0123456789abcdef"
+
+	buf = append(buf, '"')
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c >= utf8.RuneSelf {
+			_, size := utf8.DecodeRune(s[i:])
+			buf = append(buf, s[i:i+size]...)
+			i += size
+			continue
+		}
+		switch c {
+		case '"', '\\':
+			buf = append(buf, '\\', c)
+		case '\b':
+			buf = append(buf, '\\', 'b')
+		case '\f':
+			buf = append(buf, '\\', 'f')
+		case '\n':
+			buf = append(buf, '\\', 'n')
+		case '\r':
+			buf = append(buf, '\\', 'r')
+		case '\t':
+			buf = append(buf, '\\', 't')
+		default:
+			if c < 0x20 {
+				buf = append(buf, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			} else {
+				buf = append(buf, c)
+			}
+		}
+		i++
+	}
+	return append(buf, '"')
+}
+
+// Unquote returns the value of a string, and the JSON text of the others
+func (bj ByteJson) Unquote() []byte {
+	if bj.Type() == TypeString {
+		return bj.Str()
+	}
+	return bj.appendText(nil)
+}
+
+// SplitValues splits the concatenation of the encoded values
+func SplitValues(data []byte) []ByteJson {
+	var rs []ByteJson
+
+	for len(data) > 0 {
+		n := valueLength(data)
+		rs = append(rs, ByteJson(data[:n]))
+		data = data[n:]
+	}
+	return rs
+}
+
+// valueLength returns the length of the encoded value at the start of data
+func valueLength(data []byte) int {
+	switch Type(data[0]) {
+	case TypeInt64, TypeUint64, TypeFloat64:
+		return 9
+	case TypeString:
+		n, w := binary.Uvarint(data[1:])
+		return 1 + w + int(n)
+	case TypeArray, TypeObject:
+		return 1 + ByteJson(data).size()
+	}
+	return 1
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	kases := []struct {
+		text string
+		want string
+	}{
+		{`null`, `null`},
+		{` true `, `true`},
+		{`-12`, `-12`},
+		{`18446744073709551615`, `18446744073709551615`},
+		{`1.5`, `1.5`},
+		{`1.0`, `1.0`},
+		{`1e20`, `1e20`},
+		{`"a\"b\\c\né\u0001"`, `"a\"b\\c\né\u0001"`},
+		{`[]`, `[]`},
+		{`{}`, `{}`},
+		{`[1, "a", [true, null], {"k": 1.25}]`, `[1, "a", [true, null], {"k": 1.25}]`},
+		// the keys are sorted by the length first and the last value is kept for the same keys
+		{`{"bb": 1, "a": [], "c": 2, "a": {"x": null}}`, `{"a": {"x": null}, "c": 2, "bb": 1}`},
+	}
+	for _, kase := range kases {
+		bj, err := ParseString(kase.text)
+		require.NoError(t, err, kase.text)
+		require.Equal(t, kase.want, bj.String(), kase.text)
+	}
+	for _, text := range []string{``, `{`, `[1,]`, `{"a" 1}`, `1 2`, `nul`, `'a'`} {
+		_, err := ParseString(text)
+		require.Error(t, err, text)
+	}
+}
+
+func TestAccess(t *testing.T) {
+	bj, err := ParseString(`{"name": "mo", "tags": ["a", "b", "c"], "n": {"x": 1, "y": [2, 3]}}`)
+	require.NoError(t, err)
+	require.Equal(t, TypeObject, bj.Type())
+	require.Equal(t, int64(3), bj.Length())
+	v, ok := bj.Member([]byte("tags"))
+	require.True(t, ok)
+	require.Equal(t, 3, v.Count())
+	require.Equal(t, "b", string(v.Index(1).Unquote()))
+	_, ok = bj.Member([]byte("none"))
+	require.False(t, ok)
+	keys, ok := bj.Keys()
+	require.True(t, ok)
+	require.Equal(t, `["n", "name", "tags"]`, keys.String())
+	_, ok = v.Keys()
+	require.False(t, ok)
+}
+
+func TestContains(t *testing.T) {
+	kases := []struct {
+		target    string
+		candidate string
+		want      bool
+	}{
+		{`1`, `1.0`, true},
+		{`"a"`, `"a"`, true},
+		{`"a"`, `["a"]`, false},
+		{`[1, 2, [3, 4]]`, `2`, true},
+		{`[1, 2, [3, 4]]`, `[1, 3]`, true},
+		{`[1, 2, [3, 4]]`, `[1, 5]`, false},
+		{`{"a": 1, "b": {"c": [1, 2]}}`, `{"b": {"c": 2}}`, true},
+		{`{"a": 1, "b": {"c": [1, 2]}}`, `{"a": 2}`, false},
+		{`{"a": 1}`, `1`, false},
+		{`[{"a": 1}, {"b": 2}]`, `{"b": 2}`, true},
+	}
+	for _, kase := range kases {
+		target, err := ParseString(kase.target)
+		require.NoError(t, err)
+		candidate, err := ParseString(kase.candidate)
+		require.NoError(t, err)
+		require.Equal(t, kase.want, target.Contains(candidate), "%s contains %s", kase.target, kase.candidate)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	obj, err := CreateObject([]string{"k", "v", "k"}, []ByteJson{Null, CreateArray([]ByteJson{Null}), CreateArray(nil)})
+	require.NoError(t, err)
+	require.Equal(t, `{"k": [], "v": [null]}`, obj.String())
+	bj, err := Create([]interface{}{int64(1), uint64(1 << 63), 2.5, "x", true, nil})
+	require.NoError(t, err)
+	require.Equal(t, `[1, 9223372036854775808, 2.5, "x", true, null]`, bj.String())
+	s, err := UnquoteString([]byte(`"a\tb"`))
+	require.NoError(t, err)
+	require.Equal(t, "a\tb", string(s))
+	s, err = UnquoteString([]byte(`abc`))
+	require.NoError(t, err)
+	require.Equal(t, "abc", string(s))
+}
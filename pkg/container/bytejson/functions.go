@@ -0,0 +1,186 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"bytes"
+)
+
+/*
+Extract returns the values matched by the paths like the JSON_EXTRACT of the mysql.
+The value is returned itself if there is only one path without wildcard, otherwise the matched
+values are wrapped in an array. It returns false if nothing is matched.
+*/
+func (bj ByteJson) Extract(paths []Path) (ByteJson, bool) {
+	var rs []ByteJson
+
+	for _, p := range paths {
+		rs = bj.find(p.legs, rs)
+	}
+	if len(rs) == 0 {
+		return nil, false
+	}
+	if len(paths) == 1 && !paths[0].wildcard {
+		return rs[0], true
+	}
+	return CreateArray(rs), true
+}
+
+// Length returns the number of the elements of an array or the members of an object, and 1 for a scalar
+func (bj ByteJson) Length() int64 {
+	switch bj.Type() {
+	case TypeArray, TypeObject:
+		return int64(bj.Count())
+	}
+	return 1
+}
+
+// Keys returns the array of the keys of an object, false if it is not an object
+func (bj ByteJson) Keys() (ByteJson, bool) {
+	if bj.Type() != TypeObject {
+		return nil, false
+	}
+	keys := make([]interface{}, bj.Count())
+	for i := range keys {
+		keys[i] = string(bj.Key(i))
+	}
+	rs, err := Create(keys)
+	return rs, err == nil
+}
+
+/*
+Contains returns true if the candidate is contained in the value like the JSON_CONTAINS of the mysql:
+  - a scalar is contained in a scalar if they are equal
+  - a candidate is contained in an array if each element of the candidate (or itself if it is not
+    an array) is contained in some element of the array
+  - an object is contained in an object if each member of the candidate is contained in the member
+    with the same key of the target
+*/
+func (bj ByteJson) Contains(candidate ByteJson) bool {
+	switch bj.Type() {
+	case TypeArray:
+		if candidate.Type() == TypeArray {
+			for i, n := 0, candidate.Count(); i < n; i++ {
+				if !bj.Contains(candidate.Index(i)) {
+					return false
+				}
+			}
+			return true
+		}
+		for i, n := 0, bj.Count(); i < n; i++ {
+			if bj.Index(i).Contains(candidate) {
+				return true
+			}
+		}
+		return false
+	case TypeObject:
+		if candidate.Type() != TypeObject {
+			return false
+		}
+		for i, n := 0, candidate.Count(); i < n; i++ {
+			v, ok := bj.Member(candidate.Key(i))
+			if !ok || !v.Contains(candidate.Value(i)) {
+				return false
+			}
+		}
+		return true
+	}
+	return scalarEqual(bj, candidate)
+}
+
+func scalarEqual(a, b ByteJson) bool {
+	if isNumber(a.Type()) && isNumber(b.Type()) {
+		return compareNumber(a, b) == 0
+	}
+	if a.Type() != b.Type() {
+		return false
+	}
+	switch a.Type() {
+	case TypeString:
+		return bytes.Equal(a.Str(), b.Str())
+	case TypeArray, TypeObject:
+		return false
+	}
+	return true
+}
+
+func isNumber(typ Type) bool {
+	return typ == TypeInt64 || typ == TypeUint64 || typ == TypeFloat64
+}
+
+// compareNumber compares the numbers exactly if both of them are integers
+func compareNumber(a, b ByteJson) int {
+	switch {
+	case a.Type() == TypeFloat64 || b.Type() == TypeFloat64:
+		x, y := toFloat64(a), toFloat64(b)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+		return 0
+	case a.Type() == TypeInt64 && b.Type() == TypeInt64:
+		return compareInt64(a.Int64(), b.Int64())
+	case a.Type() == TypeUint64 && b.Type() == TypeUint64:
+		return compareUint64(a.Uint64(), b.Uint64())
+	case a.Type() == TypeInt64: // b is an uint64 larger than any int64
+		return -1
+	}
+	return 1
+}
+
+func toFloat64(bj ByteJson) float64 {
+	switch bj.Type() {
+	case TypeInt64:
+		return float64(bj.Int64())
+	case TypeUint64:
+		return float64(bj.Uint64())
+	}
+	return bj.Float64()
+}
+
+func compareInt64(x, y int64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func compareUint64(x, y uint64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+// UnquoteString returns the value of the JSON string s like the JSON_UNQUOTE of the mysql,
+// s is returned itself if it is not surrounded by the double quotes
+func UnquoteString(s []byte) ([]byte, error) {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return s, nil
+	}
+	bj, err := Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	return bj.Str(), nil
+}
@@ -0,0 +1,204 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+/*
+ParsePath parses the path expression of the mysql, which is '$' followed by the legs:
+
+	.key or ."key"    the member of an object
+	.*                all the members of an object
+	[n], [last-n]     the element of an array
+	[*]               all the elements of an array
+	**                the value and all the values nested in it, it must be followed by another leg
+*/
+func ParsePath(s string) (Path, error) {
+	var p Path
+
+	r := strings.TrimSpace(s)
+	if len(r) == 0 || r[0] != '$' {
+		return p, errInvalidPath(s)
+	}
+	r = strings.TrimLeft(r[1:], " \t")
+	for len(r) > 0 {
+		var leg pathLeg
+		var ok bool
+
+		switch {
+		case strings.HasPrefix(r, "**"):
+			leg, r, ok = pathLeg{typ: legDoubleWildcard}, r[2:], true
+		case r[0] == '.':
+			leg, r, ok = parseMemberLeg(strings.TrimLeft(r[1:], " \t"))
+		case r[0] == '[':
+			leg, r, ok = parseIndexLeg(r[1:])
+		}
+		if !ok {
+			return p, errInvalidPath(s)
+		}
+		if leg.wildcard || leg.typ == legDoubleWildcard {
+			p.wildcard = true
+		}
+		p.legs = append(p.legs, leg)
+		r = strings.TrimLeft(r, " \t")
+	}
+	if n := len(p.legs); n > 0 && p.legs[n-1].typ == legDoubleWildcard {
+		return p, errInvalidPath(s)
+	}
+	return p, nil
+}
+
+func errInvalidPath(s string) error {
+	return errors.New(errno.DataException, fmt.Sprintf("invalid JSON path expression '%s'", s))
+}
+
+func parseMemberLeg(r string) (pathLeg, string, bool) {
+	leg := pathLeg{typ: legMember}
+	switch {
+	case strings.HasPrefix(r, "*"):
+		leg.key, leg.wildcard = "*", true
+		return leg, r[1:], true
+	case strings.HasPrefix(r, `"`):
+		i := 1
+		for ; i < len(r) && r[i] != '"'; i++ {
+			if r[i] == '\\' {
+				i++
+			}
+		}
+		if i >= len(r) {
+			return leg, r, false
+		}
+		key, err := strconv.Unquote(r[:i+1])
+		if err != nil {
+			return leg, r, false
+		}
+		leg.key = key
+		return leg, r[i+1:], true
+	}
+	i := 0
+	for _, c := range r {
+		if !(c == '_' || c == '$' || unicode.IsLetter(c) || (i > 0 && unicode.IsDigit(c))) {
+			break
+		}
+		i += len(string(c))
+	}
+	if i == 0 {
+		return leg, r, false
+	}
+	leg.key = r[:i]
+	return leg, r[i:], true
+}
+
+func parseIndexLeg(r string) (pathLeg, string, bool) {
+	leg := pathLeg{typ: legIndex}
+	i := strings.IndexByte(r, ']')
+	if i < 0 {
+		return leg, r, false
+	}
+	s := strings.TrimSpace(r[:i])
+	r = r[i+1:]
+	switch {
+	case s == "*":
+		leg.wildcard = true
+		return leg, r, true
+	case strings.HasPrefix(s, "last"):
+		leg.last = true
+		s = strings.TrimSpace(s[4:])
+		if s == "" {
+			return leg, r, true
+		}
+		if s[0] != '-' {
+			return leg, r, false
+		}
+		s = strings.TrimSpace(s[1:])
+	}
+	n, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return leg, r, false
+	}
+	leg.index = int(n)
+	return leg, r, true
+}
+
+// ContainsWildcard returns true if the path may match many values
+func (p Path) ContainsWildcard() bool {
+	return p.wildcard
+}
+
+// find returns the values matched by the legs
+func (bj ByteJson) find(legs []pathLeg, rs []ByteJson) []ByteJson {
+	if len(legs) == 0 {
+		return append(rs, bj)
+	}
+	leg := legs[0]
+	switch leg.typ {
+	case legMember:
+		if bj.Type() != TypeObject {
+			return rs
+		}
+		if leg.wildcard {
+			for i, n := 0, bj.Count(); i < n; i++ {
+				rs = bj.Value(i).find(legs[1:], rs)
+			}
+			return rs
+		}
+		if v, ok := bj.Member([]byte(leg.key)); ok {
+			rs = v.find(legs[1:], rs)
+		}
+	case legIndex:
+		if bj.Type() != TypeArray {
+			// the value which is not an array is treated as an array of itself like the mysql
+			if leg.wildcard || leg.index != 0 {
+				return rs
+			}
+			return bj.find(legs[1:], rs)
+		}
+		n := bj.Count()
+		if leg.wildcard {
+			for i := 0; i < n; i++ {
+				rs = bj.Index(i).find(legs[1:], rs)
+			}
+			return rs
+		}
+		i := leg.index
+		if leg.last {
+			i = n - 1 - leg.index
+		}
+		if i >= 0 && i < n {
+			rs = bj.Index(i).find(legs[1:], rs)
+		}
+	case legDoubleWildcard:
+		rs = bj.find(legs[1:], rs)
+		switch bj.Type() {
+		case TypeArray:
+			for i, n := 0, bj.Count(); i < n; i++ {
+				rs = bj.Index(i).find(legs, rs)
+			}
+		case TypeObject:
+			for i, n := 0, bj.Count(); i < n; i++ {
+				rs = bj.Value(i).find(legs, rs)
+			}
+		}
+	}
+	return rs
+}
@@ -0,0 +1,64 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtract(t *testing.T) {
+	doc, err := ParseString(`{"a": 1, "b": [10, 20, {"c": "x"}], "my key": {"c": "y"}}`)
+	require.NoError(t, err)
+	kases := []struct {
+		paths []string
+		want  string // empty if nothing is matched
+	}{
+		{[]string{`$`}, doc.String()},
+		{[]string{`$.a`}, `1`},
+		{[]string{`$.b[1]`}, `20`},
+		{[]string{`$.b[last]`}, `{"c": "x"}`},
+		{[]string{`$.b[last - 2]`}, `10`},
+		{[]string{`$.b[3]`}, ``},
+		{[]string{`$."my key".c`}, `"y"`},
+		{[]string{`$.a[0]`}, `1`},
+		{[]string{`$.a[1]`}, ``},
+		{[]string{`$.none`}, ``},
+		{[]string{`$.b[*]`}, `[10, 20, {"c": "x"}]`},
+		{[]string{`$.*.c`}, `["y"]`},
+		{[]string{`$**.c`}, `["x", "y"]`},
+		{[]string{`$.a`, `$.b[0]`}, `[1, 10]`},
+		{[]string{`$.none`, `$.a`}, `[1]`},
+	}
+	for _, kase := range kases {
+		paths := make([]Path, len(kase.paths))
+		for i, s := range kase.paths {
+			paths[i], err = ParsePath(s)
+			require.NoError(t, err, s)
+		}
+		rs, ok := doc.Extract(paths)
+		if kase.want == "" {
+			require.False(t, ok, "%v", kase.paths)
+			continue
+		}
+		require.True(t, ok, "%v", kase.paths)
+		require.Equal(t, kase.want, rs.String(), "%v", kase.paths)
+	}
+	for _, s := range []string{``, `a`, `$.`, `$[`, `$[x]`, `$**`, `$.a b`, `$."a`} {
+		_, err := ParsePath(s)
+		require.Error(t, err, s)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bytejson
+
+/*
+ByteJson is the binary encoding of a JSON value stored in the vectors of the JSON type.
+The first byte is the type of the value and the rest is the payload:
+
+	null, true, false    no payload
+	int64, uint64        8 bytes little endian
+	float64              8 bytes of the IEEE 754 bits little endian
+	string               uvarint length | bytes
+	array                count uint32 | size uint32 | offsets [count]uint32 | elements
+	object               count uint32 | size uint32 | keys [count]{offset uint32, length uint16} |
+	                     offsets [count]uint32 | keys | values
+
+The offsets are relative to the start of the payload and size is the length of the payload,
+so an element of an array is found by its index and a member of an object is found by the binary
+search of the keys which are sorted like the mysql, without decoding the whole document.
+*/
+type ByteJson []byte
+
+// Type is the type of a JSON value
+type Type byte
+
+const (
+	TypeNull Type = iota
+	TypeTrue
+	TypeFalse
+	TypeInt64
+	TypeUint64
+	TypeFloat64
+	TypeString
+	TypeArray
+	TypeObject
+)
+
+const (
+	headerSize    = 8 // count and size of an array or an object
+	offsetSize    = 4
+	keyEntrySize  = 6 // offset and length of a key
+	maxKeyLength  = 1<<16 - 1
+	maxNestDepth  = 100
+	maxOffsetSize = 1<<32 - 1
+)
+
+// Path is a parsed JSON path expression like '$.a[0]'
+type Path struct {
+	legs []pathLeg
+	// wildcard is true if the path contains '*' or '**' which matches many values
+	wildcard bool
+}
+
+type pathLegType byte
+
+const (
+	legMember pathLegType = iota
+	legIndex
+	legDoubleWildcard
+)
+
+type pathLeg struct {
+	typ pathLegType
+	// key is the member name, '*' if it is the wildcard of the members
+	key      string
+	wildcard bool
+	// index is the array index, or the distance from the last one if last is true
+	index int
+	last  bool
+}
@@ -194,3 +194,22 @@ func (r *ApproxCountDistinctRing) Eval(_ []int64) *vector.Vector {
 		Typ:  types.Type{Oid: types.T_uint64, Size: 8},
 	}
 }
+
+// InsertVector inserts the non-null values of the vector into the sketch,
+// the values are hashed in the same way as approx_count_distinct.
+func InsertVector(sk *hll.Sketch, vec *vector.Vector) {
+	newBytesIterSolo(vec).Foreach(func(data []byte) { sk.Insert(data) })
+}
+
+// Supported returns true if the values of the type can be inserted into the sketch
+func Supported(oid types.T) bool {
+	switch oid {
+	case types.T_char, types.T_varchar, types.T_json,
+		types.T_int8, types.T_uint8, types.T_int16, types.T_uint16,
+		types.T_int32, types.T_uint32, types.T_int64, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_date, types.T_datetime,
+		types.T_decimal64, types.T_decimal128:
+		return true
+	}
+	return false
+}
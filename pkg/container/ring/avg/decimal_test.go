@@ -0,0 +1,49 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avg
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimalRing(t *testing.T) {
+	m := mheap.New(guest.New(1<<20, host.New(1<<20)))
+	typ := types.Type{Oid: types.T_decimal128, Size: 16, Width: 20, Scale: 1}
+	vec := vector.New(typ)
+	for _, v := range []string{"1.0", "2.5", "-7.3"} {
+		d, err := types.ParseStringToDecimal128(v, typ.Width, typ.Scale)
+		require.NoError(t, err)
+		require.NoError(t, vector.Append(vec, []types.Decimal128{d}))
+	}
+	nulls.Add(vec.Nsp, 1)
+
+	r := NewDecimal(typ)
+	require.NoError(t, r.Grows(2, m))
+	r.BulkFill(0, []int64{1, 1, 1}, vec)
+	r.Fill(1, 1, 2, vec)
+
+	vec = r.Eval([]int64{3, 2})
+	require.Equal(t, types.Type{Oid: types.T_decimal128, Size: 16, Width: 38, Scale: 5}, vec.Typ)
+	require.Equal(t, "-3.15000", string(vec.Col.([]types.Decimal128)[0].Decimal128ToString(5)))
+	require.True(t, nulls.Contains(vec.Nsp, 1))
+}
@@ -22,3 +22,11 @@ type AvgRing struct {
 	Vs  []float64
 	Typ types.Type
 }
+
+// DecimalRing averages decimal64 and decimal128 values, the sums are kept as decimal128
+type DecimalRing struct {
+	Da  []byte
+	Ns  []int64
+	Vs  []types.Decimal128
+	Typ types.Type
+}
@@ -0,0 +1,198 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonagg
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+func NewArrayAgg(typ types.Type) *JsonAggRing {
+	return &JsonAggRing{Typ: typ}
+}
+
+func NewObjectAgg(typ types.Type) *JsonAggRing {
+	return &JsonAggRing{Typ: typ, IsObject: true}
+}
+
+func (r *JsonAggRing) String() string {
+	return fmt.Sprintf("%v-%v", r.Vs, r.Ns)
+}
+
+func (r *JsonAggRing) Free(_ *mheap.Mheap) {
+	r.Vs = nil
+	r.Ns = nil
+}
+
+func (r *JsonAggRing) Count() int {
+	return len(r.Vs)
+}
+
+func (r *JsonAggRing) Size() int {
+	return 0
+}
+
+func (r *JsonAggRing) Dup() ring.Ring {
+	return &JsonAggRing{
+		Typ:      r.Typ,
+		IsObject: r.IsObject,
+	}
+}
+
+func (r *JsonAggRing) Type() types.Type {
+	return r.Typ
+}
+
+func (r *JsonAggRing) SetLength(n int) {
+	r.Vs = r.Vs[:n]
+	r.Ns = r.Ns[:n]
+}
+
+func (r *JsonAggRing) Shrink(sels []int64) {
+	for i, sel := range sels {
+		r.Vs[i] = r.Vs[sel]
+		r.Ns[i] = r.Ns[sel]
+	}
+	r.Vs = r.Vs[:len(sels)]
+	r.Ns = r.Ns[:len(sels)]
+}
+
+func (r *JsonAggRing) Shuffle(_ []int64, _ *mheap.Mheap) error {
+	return nil
+}
+
+func (r *JsonAggRing) Grow(m *mheap.Mheap) error {
+	return r.Grows(1, m)
+}
+
+func (r *JsonAggRing) Grows(size int, m *mheap.Mheap) error {
+	if r.Mp == nil {
+		r.Mp = m
+	}
+	for i := 0; i < size; i++ {
+		r.Ns = append(r.Ns, 0)
+		r.Vs = append(r.Vs, nil)
+	}
+	return nil
+}
+
+func (r *JsonAggRing) Fill(i int64, sel, z int64, vec *vector.Vector) {
+	if nulls.Contains(vec.Nsp, uint64(sel)) {
+		r.Ns[i] += z
+		return
+	}
+	v := bytejson.ByteJson(vec.Col.(*types.Bytes).Get(sel))
+	for ; z > 0; z-- {
+		r.Vs[i] = r.appendValue(r.Vs[i], v)
+	}
+}
+
+func (r *JsonAggRing) BatchFill(start int64, os []uint8, vps []uint64, zs []int64, vec *vector.Vector) {
+	for i := range os {
+		r.Fill(int64(vps[i]-1), int64(i)+start, zs[int64(i)+start], vec)
+	}
+}
+
+func (r *JsonAggRing) BulkFill(i int64, zs []int64, vec *vector.Vector) {
+	for j, z := range zs {
+		r.Fill(i, int64(j), z, vec)
+	}
+}
+
+// appendValue appends the elements of the array or the keys and values of the object
+func (r *JsonAggRing) appendValue(data []byte, v bytejson.ByteJson) []byte {
+	switch {
+	case r.IsObject && v.Type() == bytejson.TypeObject:
+		for i, n := 0, v.Count(); i < n; i++ {
+			key, _ := bytejson.Create(string(v.Key(i)))
+			data = append(data, key...)
+			data = append(data, v.Value(i)...)
+		}
+	case !r.IsObject && v.Type() == bytejson.TypeArray:
+		for i, n := 0, v.Count(); i < n; i++ {
+			data = append(data, v.Index(i)...)
+		}
+	}
+	return data
+}
+
+func (r *JsonAggRing) Add(a interface{}, x, y int64) {
+	ar := a.(*JsonAggRing)
+	r.Vs[x] = append(r.Vs[x], ar.Vs[y]...)
+	r.Ns[x] += ar.Ns[y]
+}
+
+func (r *JsonAggRing) BatchAdd(a interface{}, start int64, os []uint8, vps []uint64) {
+	for i := range os {
+		r.Add(a, int64(vps[i]-1), int64(i)+start)
+	}
+}
+
+func (r *JsonAggRing) Mul(a interface{}, x, y, z int64) {
+	ar := a.(*JsonAggRing)
+	for i := int64(0); i < z; i++ {
+		r.Vs[x] = append(r.Vs[x], ar.Vs[y]...)
+	}
+	r.Ns[x] += ar.Ns[y] * z
+}
+
+func (r *JsonAggRing) Eval(zs []int64) *vector.Vector {
+	var data []byte
+	var os, ns []uint32
+
+	defer func() {
+		r.Vs = nil
+		r.Ns = nil
+	}()
+	nsp := new(nulls.Nulls)
+	for i, z := range zs {
+		var v bytejson.ByteJson
+
+		if z-r.Ns[i] == 0 {
+			nulls.Add(nsp, uint64(i))
+		} else if vs := bytejson.SplitValues(r.Vs[i]); r.IsObject {
+			keys := make([]string, len(vs)/2)
+			values := make([]bytejson.ByteJson, len(vs)/2)
+			for j := range keys {
+				keys[j], values[j] = string(vs[2*j].Str()), vs[2*j+1]
+			}
+			v, _ = bytejson.CreateObject(keys, values)
+		} else {
+			v = bytejson.CreateArray(vs)
+		}
+		os = append(os, uint32(len(data)))
+		ns = append(ns, uint32(len(v)))
+		data = append(data, v...)
+	}
+	if err := r.Mp.Gm.Alloc(int64(cap(data))); err != nil {
+		return nil
+	}
+	return &vector.Vector{
+		Nsp: nsp,
+		Or:  false,
+		Typ: r.Typ,
+		Col: &types.Bytes{
+			Offsets: os,
+			Lengths: ns,
+			Data:    data,
+		},
+	}
+}
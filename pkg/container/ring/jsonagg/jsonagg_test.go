@@ -0,0 +1,78 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonagg
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArrayAgg(t *testing.T) {
+	m := mheap.New(guest.New(1<<20, host.New(1<<20)))
+	typ := types.Type{Oid: types.T_json, Size: 24}
+	vec := newJsonVector(t, typ, `[1]`, `["a"]`, `[{"b": null}]`)
+
+	r := NewArrayAgg(typ)
+	require.NoError(t, r.Grows(2, m))
+	r.Fill(0, 0, 1, vec)
+	r.Fill(0, 1, 2, vec)
+	r.Fill(1, 2, 1, vec)
+	r2 := r.Dup().(*JsonAggRing)
+	require.NoError(t, r2.Grow(m))
+	r2.Fill(0, 0, 1, vec)
+	r.Add(r2, 1, 0)
+	requireJsonVector(t, r.Eval([]int64{3, 2}), `[1, "a", "a"]`, `[{"b": null}, 1]`)
+}
+
+func TestObjectAgg(t *testing.T) {
+	m := mheap.New(guest.New(1<<20, host.New(1<<20)))
+	typ := types.Type{Oid: types.T_json, Size: 24}
+	vec := newJsonVector(t, typ, `{"a": 1}`, `{"b": [2]}`, `{"a": 3}`)
+
+	r := NewObjectAgg(typ)
+	require.NoError(t, r.Grows(2, m))
+	r.BulkFill(0, []int64{1, 1, 1}, vec)
+	r.Ns[1]++
+	requireJsonVector(t, r.Eval([]int64{3, 1}), `{"a": 3, "b": [2]}`, "")
+}
+
+func newJsonVector(t *testing.T, typ types.Type, vs ...string) *vector.Vector {
+	vec := vector.New(typ)
+	for _, v := range vs {
+		data, err := bytejson.ParseString(v)
+		require.NoError(t, err)
+		require.NoError(t, vector.Append(vec, [][]byte{data}))
+	}
+	return vec
+}
+
+func requireJsonVector(t *testing.T, vec *vector.Vector, vs ...string) {
+	col := vec.Col.(*types.Bytes)
+	for i, v := range vs {
+		if v == "" {
+			require.True(t, nulls.Contains(vec.Nsp, uint64(i)))
+			continue
+		}
+		require.Equal(t, v, bytejson.ByteJson(col.Get(int64(i))).String())
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonagg
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+/*
+JsonAggRing is the ring of JSON_ARRAYAGG and JSON_OBJECTAGG, the argument is the JSON array of
+the value for JSON_ARRAYAGG and the JSON object of the key and value for JSON_OBJECTAGG.
+Vs[i] is the concatenation of the encoded elements of the arrays, or the encoded keys and values
+of the objects, which are merged into a JSON array or object when the ring is evaluated.
+*/
+type JsonAggRing struct {
+	IsObject bool
+	Ns       []int64
+	Vs       [][]byte
+	Typ      types.Type
+	Mp       *mheap.Mheap
+}
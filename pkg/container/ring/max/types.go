@@ -111,3 +111,17 @@ type DatetimeRing struct {
 	Ns  []int64
 	Typ types.Type
 }
+
+type Decimal64Ring struct {
+	Da  []byte
+	Vs  []types.Decimal64
+	Ns  []int64
+	Typ types.Type
+}
+
+type Decimal128Ring struct {
+	Da  []byte
+	Vs  []types.Decimal128
+	Ns  []int64
+	Typ types.Type
+}
@@ -36,3 +36,11 @@ type FloatRing struct {
 	Vs  []float64
 	Typ types.Type
 }
+
+// DecimalRing sums decimal64 and decimal128 values into decimal128 of the same scale
+type DecimalRing struct {
+	Da  []byte
+	Ns  []int64
+	Vs  []types.Decimal128
+	Typ types.Type
+}
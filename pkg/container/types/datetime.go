@@ -40,6 +40,31 @@ func (dt Datetime) String() string {
 	return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", y, m, d, hour, minute, sec)
 }
 
+// String2 stringify datetime, including its fractional seconds precision part(fsp)
+func (dt Datetime) String2(precision int32) string {
+	if precision > 0 {
+		if precision > microSecondsDigits {
+			precision = microSecondsDigits
+		}
+		msec := fmt.Sprintf("%06d", int64(dt)&microSecondMask)
+		return dt.String() + "." + msec[:precision]
+	}
+	return dt.String()
+}
+
+// Truncate drops the fractional seconds beyond the precision
+func (dt Datetime) Truncate(precision int32) Datetime {
+	if precision >= microSecondsDigits {
+		return dt
+	}
+	unit := int64(1)
+	for i := precision; i < microSecondsDigits; i++ {
+		unit *= 10
+	}
+	msec := int64(dt) & microSecondMask
+	return Datetime(int64(dt) - msec + msec/unit*unit)
+}
+
 const (
 	//tsMask         = ^uint64(0) >> 1
 	hasMonotonic = 1 << 63
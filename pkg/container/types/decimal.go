@@ -25,19 +25,19 @@
 // internally, precision in range (0, 18] is represented as Decimal64, precision in range [19, 38] is represented as Decimal128
 //
 // we support addition, subtraction, multiplication, division between decimal data types, and between decimal and integers
-// for decimal64, addition and subtraction operation
-// have result of type decimal64, the result's scale is the maximum of its two operands, overflow may happen in these two operations and no precaution is implemented,
-// nor does any indication. for multiplication and division on decimal64, the result is of type Decimal128 and therefore these two operations are safe on Decimal64, that is,
-// overflow is guaranteed IMPOSSIBLE.
-//
-// For Decimal128, operations on this data type may overflow and no precautions nor indications are implemented, but these will only happen in extreme use cases, that is, when the result can not fit into a 128 bit representation
+// the result type follows MySQL's precision and scale inference:
+//   addition and subtraction on decimal64 have result of type decimal64, the result's scale is the maximum of its two operands
+//   multiplication has result of type decimal128, the result's scale is the sum of its two operands' scales
+//   division has result of type decimal128, the result's scale is the dividend's scale plus DecimalDivScaleIncrement,
+//   and the quotient is rounded half away from zero
+// overflow is detected for all these operations and reported as an OUT_OF_RANGE error instead of wrapping around.
 //
 // Comparison operations <, >, =, !=, <=, >= are also supported between decimal types, and between decimals and integers.
 //
 // in cases where a literal string needs to be interpreted as decimal, for example, "select * from decimal_table where a = 1.23",
 // the string literal "1.23" will be interpreted as a decimal128
 // for operations between decimals and integers, the integer type will be cast to a decimal128 before operation, and the result is of type decimal128
-// operations between decimals and floats are not defined.
+// for operations between decimals and floats, the decimal type will be cast to a float64 before operation, and the result is of type float64
 
 package types
 
@@ -48,6 +48,8 @@ import (
 	"strconv"
 	"strings"
 	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 )
 
 // #include <stdint.h>
@@ -167,8 +169,90 @@ import (
 // void int64_to_int128(void*a, void* result) {
 // 		*(__int128*)result = *(int64_t*)a;
 // }
+// static bool scale_int128_checked(__int128* v, int32_t scale_diff) {
+//		for (int i = 0; i < scale_diff; i++) {
+//			if (__builtin_mul_overflow(*v, 10, v)) {
+//				return true;
+//			}
+//		}
+//		return false;
+// }
+// void add_int128_int128_checked(void* a, void* b, void* a_scale_diff, void* b_scale_diff, void* result, void* overflow) {
+//		__int128 x = *(__int128*)a, y = *(__int128*)b;
+//		*(bool*)overflow = scale_int128_checked(&x, *(int32_t*)a_scale_diff) || scale_int128_checked(&y, *(int32_t*)b_scale_diff) ||
+//			__builtin_add_overflow(x, y, (__int128*)result);
+// }
+// void sub_int128_int128_checked(void* a, void* b, void* a_scale_diff, void* b_scale_diff, void* result, void* overflow) {
+//		__int128 x = *(__int128*)a, y = *(__int128*)b;
+//		*(bool*)overflow = scale_int128_checked(&x, *(int32_t*)a_scale_diff) || scale_int128_checked(&y, *(int32_t*)b_scale_diff) ||
+//			__builtin_sub_overflow(x, y, (__int128*)result);
+// }
+// void mul_int128_int128_checked(void* a, void* b, void* result, void* overflow) {
+//		*(bool*)overflow = __builtin_mul_overflow(*(__int128*)a, *(__int128*)b, (__int128*)result);
+// }
+// void mul_int128_int64_checked(void* a, void* b, void* result, void* overflow) {
+//		__int128 y = *(int64_t*)b;
+//		*(bool*)overflow = __builtin_mul_overflow(*(__int128*)a, y, (__int128*)result);
+// }
+// // the quotient is rounded half away from zero
+// void div_int128_int128_round(void* a, void* b, void* a_scale_diff, void* result, void* overflow) {
+//		__int128 x = *(__int128*)a, y = *(__int128*)b;
+//		if (scale_int128_checked(&x, *(int32_t*)a_scale_diff)) {
+//			*(bool*)overflow = true;
+//			return;
+//		}
+//		__int128 q = x / y, r = x % y;
+//		if (r < 0) {
+//			r = -r;
+//		}
+//		if (y < 0) {
+//			y = -y;
+//		}
+//		if (r >= y - r) {
+//			q += ((x < 0) != (*(__int128*)b < 0)) ? -1 : 1;
+//		}
+//		*(__int128*)result = q;
+//		*(bool*)overflow = false;
+// }
+// // rescale drops or appends fractional digits, the dropped digits are rounded half away from zero
+// void rescale_int128(void* a, void* from, void* to, void* result, void* overflow) {
+//		__int128 x = *(__int128*)a;
+//		int32_t diff = *(int32_t*)to - *(int32_t*)from;
+//		if (diff >= 0) {
+//			*(bool*)overflow = scale_int128_checked(&x, diff);
+//			*(__int128*)result = x;
+//			return;
+//		}
+//		__int128 last = 0;
+//		for (int i = 0; i < -diff; i++) {
+//			last = x % 10;
+//			x /= 10;
+//		}
+//		if (last >= 5) {
+//			x++;
+//		} else if (last <= -5) {
+//			x--;
+//		}
+//		*(__int128*)result = x;
+//		*(bool*)overflow = false;
+// }
+// void int128_to_int64_checked(void* a, void* result, void* overflow) {
+//		__int128 x = *(__int128*)a;
+//		*(bool*)overflow = x > INT64_MAX || x < INT64_MIN;
+//		*(int64_t*)result = (int64_t)x;
+// }
 import "C"
 
+// DecimalDivScaleIncrement is the number of digits by which the scale of a division result grows,
+// it is the same as MySQL's default div_precision_increment
+const DecimalDivScaleIncrement = 4
+
+var (
+	errDecimal64OutOfRange  = moerr.NewError(moerr.OUT_OF_RANGE, "decimal64 value out of range")
+	errDecimal128OutOfRange = moerr.NewError(moerr.OUT_OF_RANGE, "decimal128 value out of range")
+	errDecimalDivByZero     = moerr.NewError(moerr.DIVIVISION_BY_ZERO, "division by zero")
+)
+
 func ScaleDecimal64(a Decimal64, b int64) (result Decimal64) {
 	return Decimal64(int64(a) * b)
 }
@@ -262,9 +346,13 @@ func Decimal64Decimal64Mul(a Decimal64, b Decimal64) (result Decimal128) {
 	return result
 }
 
-func Decimal128Decimal128Mul(a Decimal128, b Decimal128) (result Decimal128) {
-	C.mul_int128_int128(unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&result))
-	return result
+func Decimal128Decimal128Mul(a Decimal128, b Decimal128) (result Decimal128, err error) {
+	var overflow bool
+	C.mul_int128_int128_checked(unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&result), unsafe.Pointer(&overflow))
+	if overflow {
+		return result, errDecimal128OutOfRange
+	}
+	return result, nil
 }
 
 func InitDecimal128(value int64) (result Decimal128) {
@@ -320,6 +408,7 @@ func NegDecimal128(a Decimal128) (result Decimal128) {
 }
 
 func decimalStringPreprocess(s string, precision, scale int32) (result []byte, carry bool, neg bool, err error) {
+	s = strings.TrimSpace(s)
 	if s == "" {
 		return result, carry, neg, errors.New("invalid decimal string")
 	}
@@ -337,6 +426,7 @@ func decimalStringPreprocess(s string, precision, scale int32) (result []byte, c
 				part0Bytes = part0Bytes[1:]
 			}
 		}
+		part0Bytes = trimLeadingZeros(part0Bytes)
 		if len(part0Bytes) > int(precision-scale) { // for example, input "123.45" is invalid for Decimal(5, 3)
 			return result, carry, neg, errors.New(fmt.Sprintf("input decimal value out of range for Decimal(%d, %d)", precision, scale))
 		}
@@ -363,10 +453,14 @@ func decimalStringPreprocess(s string, precision, scale int32) (result []byte, c
 		if part0Bytes[0] == '+' {
 			part0Bytes = part0Bytes[1:]
 		}
-		if part0Bytes[0] == '-' {
+		if len(part0Bytes) > 0 && part0Bytes[0] == '-' {
 			neg = true
 			part0Bytes = part0Bytes[1:]
 		}
+		if len(part0Bytes) == 0 {
+			return result, carry, neg, errors.New("invalid decimal string")
+		}
+		part0Bytes = trimLeadingZeros(part0Bytes)
 		if len(part0Bytes) > int(precision-scale) { // for example, input "123" is invalid for Decimal(5, 3)
 			return result, carry, neg, errors.New(fmt.Sprintf("input decimal value out of range for Decimal(%d, %d)", precision, scale))
 		}
@@ -380,6 +474,13 @@ func decimalStringPreprocess(s string, precision, scale int32) (result []byte, c
 	}
 }
 
+func trimLeadingZeros(s []byte) []byte {
+	for len(s) > 0 && s[0] == '0' {
+		s = s[1:]
+	}
+	return s
+}
+
 //todo: use strconv to simplify this code
 func ParseStringToDecimal64(s string, precision, scale int32) (result Decimal64, err error) {
 	sInBytes, carry, neg, err := decimalStringPreprocess(s, precision, scale)
@@ -449,9 +550,6 @@ func (a Decimal64) Decimal64ToString(scale int32) []byte {
 		result := strconv.FormatInt(aInInt64, 10)
 		return []byte(result)
 	}
-	if aInInt64 == 0 {
-		return []byte("0")
-	}
 	result := strconv.FormatInt(aInInt64, 10)
 	neg := false
 	if aInInt64 < 0 {
@@ -482,7 +580,7 @@ func (a Decimal128) Decimal128ToString(scale int32) []byte {
 	neg := Decimal128IsNegative(a)
 	notZero := Decimal128IsNotZero(a)
 	if notZero == false {
-		return []byte("0")
+		result = "0"
 	}
 	tmp := a
 	digits := "0123456789"
@@ -512,21 +610,15 @@ func (a Decimal128) Decimal128ToString(scale int32) []byte {
 	return []byte(result)
 }
 
-func Decimal64Add(a, b Decimal64, aScale, bScale int32) (result Decimal64) {
-	if aScale > bScale {
-		scaleDiff := aScale - bScale
-		scale := int64(math.Pow10(int(scaleDiff)))
-		bScaled := ScaleDecimal64(b, scale)
-		result = Decimal64AddAligned(a, bScaled)
-	} else if aScale < bScale {
-		scaleDiff := bScale - aScale
-		scale := int64(math.Pow10(int(scaleDiff)))
-		aScaled := ScaleDecimal64(a, scale)
-		result = Decimal64AddAligned(aScaled, b)
-	} else {
-		result = Decimal64AddAligned(a, b)
+func Decimal64Add(a, b Decimal64, aScale, bScale int32) (result Decimal64, err error) {
+	if a, b, err = alignDecimal64(a, b, aScale, bScale); err != nil {
+		return result, err
 	}
-	return result
+	result = Decimal64AddAligned(a, b)
+	if (a >= 0) == (b >= 0) && (result >= 0) != (a >= 0) {
+		return result, errDecimal64OutOfRange
+	}
+	return result, nil
 }
 
 func Decimal64AddAligned(a, b Decimal64) (result Decimal64) {
@@ -534,21 +626,15 @@ func Decimal64AddAligned(a, b Decimal64) (result Decimal64) {
 	return result
 }
 
-func Decimal64Sub(a, b Decimal64, aScale, bScale int32) (result Decimal64) {
-	if aScale > bScale {
-		scaleDiff := aScale - bScale
-		scale := int64(math.Pow10(int(scaleDiff)))
-		bScaled := ScaleDecimal64(b, scale)
-		result = Decimal64SubAligned(a, bScaled)
-	} else if aScale < bScale {
-		scaleDiff := bScale - aScale
-		scale := int64(math.Pow10(int(scaleDiff)))
-		aScaled := ScaleDecimal64(a, scale)
-		result = Decimal64SubAligned(aScaled, b)
-	} else {
-		result = Decimal64SubAligned(a, b)
+func Decimal64Sub(a, b Decimal64, aScale, bScale int32) (result Decimal64, err error) {
+	if a, b, err = alignDecimal64(a, b, aScale, bScale); err != nil {
+		return result, err
 	}
-	return result
+	result = Decimal64SubAligned(a, b)
+	if (a >= 0) != (b >= 0) && (result >= 0) != (a >= 0) {
+		return result, errDecimal64OutOfRange
+	}
+	return result, nil
 }
 
 func Decimal64SubAligned(a, b Decimal64) (result Decimal64) {
@@ -556,25 +642,26 @@ func Decimal64SubAligned(a, b Decimal64) (result Decimal64) {
 	return result
 }
 
-func Decimal128Add(a, b Decimal128, aScale, bScale int32) (result Decimal128) {
+// alignDecimal64 scales up the operand with the smaller scale so that both operands have the same scale
+func alignDecimal64(a, b Decimal64, aScale, bScale int32) (Decimal64, Decimal64, error) {
+	var err error
 	if aScale > bScale {
-		bScaled := b
-		scaleDiff := aScale - bScale
-		for i := 0; i < int(scaleDiff); i++ {
-			bScaled = ScaleDecimal128By10(bScaled)
-		}
-		result = Decimal128AddAligned(a, bScaled)
+		b, err = RescaleDecimal64(b, bScale, aScale)
 	} else if aScale < bScale {
-		aScaled := a
-		scaleDiff := bScale - aScale
-		for i := 0; i < int(scaleDiff); i++ {
-			aScaled = ScaleDecimal128By10(aScaled)
-		}
-		result = Decimal128AddAligned(aScaled, b)
-	} else {
-		result = Decimal128AddAligned(a, b)
+		a, err = RescaleDecimal64(a, aScale, bScale)
 	}
-	return result
+	return a, b, err
+}
+
+func Decimal128Add(a, b Decimal128, aScale, bScale int32) (result Decimal128, err error) {
+	var overflow bool
+	aScaleDiff, bScaleDiff := decimal128ScaleDiffs(aScale, bScale)
+	C.add_int128_int128_checked(unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&aScaleDiff), unsafe.Pointer(&bScaleDiff),
+		unsafe.Pointer(&result), unsafe.Pointer(&overflow))
+	if overflow {
+		return result, errDecimal128OutOfRange
+	}
+	return result, nil
 }
 
 func Decimal128AddAligned(a, b Decimal128) (result Decimal128) {
@@ -582,25 +669,15 @@ func Decimal128AddAligned(a, b Decimal128) (result Decimal128) {
 	return result
 }
 
-func Decimal128Sub(a, b Decimal128, aScale, bScale int32) (result Decimal128) {
-	if aScale > bScale {
-		bScaled := b
-		scaleDiff := aScale - bScale
-		for i := 0; i < int(scaleDiff); i++ {
-			bScaled = ScaleDecimal128By10(bScaled)
-		}
-		result = Decimal128SubAligned(a, bScaled)
-	} else if aScale < bScale {
-		aScaled := a
-		scaleDiff := bScale - aScale
-		for i := 0; i < int(scaleDiff); i++ {
-			aScaled = ScaleDecimal128By10(aScaled)
-		}
-		result = Decimal128SubAligned(aScaled, b)
-	} else {
-		result = Decimal128SubAligned(a, b)
+func Decimal128Sub(a, b Decimal128, aScale, bScale int32) (result Decimal128, err error) {
+	var overflow bool
+	aScaleDiff, bScaleDiff := decimal128ScaleDiffs(aScale, bScale)
+	C.sub_int128_int128_checked(unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&aScaleDiff), unsafe.Pointer(&bScaleDiff),
+		unsafe.Pointer(&result), unsafe.Pointer(&overflow))
+	if overflow {
+		return result, errDecimal128OutOfRange
 	}
-	return result
+	return result, nil
 }
 
 func Decimal128SubAligned(a, b Decimal128) (result Decimal128) {
@@ -608,25 +685,166 @@ func Decimal128SubAligned(a, b Decimal128) (result Decimal128) {
 	return result
 }
 
-func Decimal64Decimal64Div(a, b Decimal64, aScale, bScale int32) (result Decimal128) {
-	aScaled := InitDecimal128(int64(a))
-	for i := 0; i < int(bScale); i++ {
-		aScaled = ScaleDecimal128By10(aScaled)
+// decimal128ScaleDiffs returns how many digits each operand needs to be scaled up by to have the same scale
+func decimal128ScaleDiffs(aScale, bScale int32) (int32, int32) {
+	if aScale > bScale {
+		return 0, aScale - bScale
 	}
-	C.div_int128_int64(unsafe.Pointer(&aScaled), unsafe.Pointer(&b), unsafe.Pointer(&result))
-	return result
+	return bScale - aScale, 0
 }
 
-func Decimal128Decimal128Div(a, b Decimal128, aScale, bScale int32) (result Decimal128) {
-	aScaled := a
-	for i := 0; i < int(bScale); i++ {
-		aScaled = ScaleDecimal128By10(aScaled)
+// DecimalDivResultScale returns the scale of the quotient of a dividend with scale aScale
+func DecimalDivResultScale(aScale int32) int32 {
+	scale := aScale + DecimalDivScaleIncrement
+	if scale > 38 {
+		scale = 38
 	}
-	C.div_int128_int128(unsafe.Pointer(&aScaled), unsafe.Pointer(&b), unsafe.Pointer(&result))
-	return result
+	if scale < aScale {
+		scale = aScale
+	}
+	return scale
+}
+
+// Decimal64Decimal64Div returns a / b with the scale of DecimalDivResultScale(aScale)
+func Decimal64Decimal64Div(a, b Decimal64, aScale, bScale int32) (result Decimal128, err error) {
+	return Decimal128Decimal128Div(Decimal64ToDecimal128(a), Decimal64ToDecimal128(b), aScale, bScale)
+}
+
+// Decimal128Decimal128Div returns a / b with the scale of DecimalDivResultScale(aScale)
+func Decimal128Decimal128Div(a, b Decimal128, aScale, bScale int32) (result Decimal128, err error) {
+	if Decimal128IsZero(b) {
+		return result, errDecimalDivByZero
+	}
+	var overflow bool
+	scaleDiff := DecimalDivResultScale(aScale) - aScale + bScale
+	C.div_int128_int128_round(unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&scaleDiff), unsafe.Pointer(&result), unsafe.Pointer(&overflow))
+	if overflow {
+		return result, errDecimal128OutOfRange
+	}
+	return result, nil
 }
 
 func Decimal64ToDecimal128(a Decimal64) (result Decimal128) {
 	C.int64_to_int128(unsafe.Pointer(&a), unsafe.Pointer(&result))
 	return result
 }
+
+func Decimal128ToDecimal64(a Decimal128) (result Decimal64, err error) {
+	var overflow bool
+	C.int128_to_int64_checked(unsafe.Pointer(&a), unsafe.Pointer(&result), unsafe.Pointer(&overflow))
+	if overflow {
+		return result, errDecimal64OutOfRange
+	}
+	return result, nil
+}
+
+// RescaleDecimal64 converts a decimal64 of scale from to a decimal64 of scale to,
+// the dropped fractional digits are rounded half away from zero
+func RescaleDecimal64(a Decimal64, from, to int32) (Decimal64, error) {
+	switch {
+	case to > from:
+		if a == 0 {
+			return a, nil
+		}
+		if to-from > 18 {
+			return a, errDecimal64OutOfRange
+		}
+		scale := int64(math.Pow10(int(to - from)))
+		if int64(a) > math.MaxInt64/scale || int64(a) < math.MinInt64/scale {
+			return a, errDecimal64OutOfRange
+		}
+		return Decimal64(int64(a) * scale), nil
+	case to < from:
+		if from-to > 18 {
+			return 0, nil
+		}
+		scale := int64(math.Pow10(int(from - to)))
+		q, r := int64(a)/scale, int64(a)%scale
+		if r >= scale-scale/2 {
+			q++
+		} else if r <= -(scale - scale/2) {
+			q--
+		}
+		return Decimal64(q), nil
+	}
+	return a, nil
+}
+
+// RescaleDecimal128 converts a decimal128 of scale from to a decimal128 of scale to,
+// the dropped fractional digits are rounded half away from zero
+func RescaleDecimal128(a Decimal128, from, to int32) (result Decimal128, err error) {
+	var overflow bool
+	C.rescale_int128(unsafe.Pointer(&a), unsafe.Pointer(&from), unsafe.Pointer(&to), unsafe.Pointer(&result), unsafe.Pointer(&overflow))
+	if overflow {
+		return result, errDecimal128OutOfRange
+	}
+	return result, nil
+}
+
+// MulDecimal128ByInt64 returns a * b, it is mostly used to accumulate repeated values in aggregations
+func MulDecimal128ByInt64(a Decimal128, b int64) (result Decimal128, err error) {
+	var overflow bool
+	C.mul_int128_int64_checked(unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&result), unsafe.Pointer(&overflow))
+	if overflow {
+		return result, errDecimal128OutOfRange
+	}
+	return result, nil
+}
+
+// CheckDecimal64Precision returns an error if a has more than precision digits
+func CheckDecimal64Precision(a Decimal64, precision int32) error {
+	if precision <= 0 || precision >= 19 {
+		return nil
+	}
+	bound := int64(math.Pow10(int(precision)))
+	if int64(a) >= bound || int64(a) <= -bound {
+		return errDecimal64OutOfRange
+	}
+	return nil
+}
+
+// CheckDecimal128Precision returns an error if a has more than precision digits
+func CheckDecimal128Precision(a Decimal128, precision int32) error {
+	if precision <= 0 || precision >= 39 {
+		return nil
+	}
+	bound := InitDecimal128(1)
+	for i := int32(0); i < precision; i++ {
+		bound = ScaleDecimal128By10(bound)
+	}
+	if Decimal128IsNegative(a) {
+		a = NegDecimal128(a)
+	}
+	if CompareDecimal128Decimal128Aligned(a, bound) >= 0 {
+		return errDecimal128OutOfRange
+	}
+	return nil
+}
+
+func Decimal64ToFloat64(a Decimal64, scale int32) float64 {
+	// both operands are exactly representable, so the division is correctly rounded
+	if a <= 1<<53 && a >= -1<<53 && scale <= 22 {
+		return float64(a) / math.Pow10(int(scale))
+	}
+	result, _ := strconv.ParseFloat(string(a.Decimal64ToString(scale)), 64)
+	return result
+}
+
+func Decimal128ToFloat64(a Decimal128, scale int32) float64 {
+	result, _ := strconv.ParseFloat(string(a.Decimal128ToString(scale)), 64)
+	return result
+}
+
+func Float64ToDecimal64(f float64, precision, scale int32) (Decimal64, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, errDecimal64OutOfRange
+	}
+	return ParseStringToDecimal64(strconv.FormatFloat(f, 'f', int(scale), 64), precision, scale)
+}
+
+func Float64ToDecimal128(f float64, precision, scale int32) (Decimal128, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal128{}, errDecimal128OutOfRange
+	}
+	return ParseStringToDecimal128(strconv.FormatFloat(f, 'f', int(scale), 64), precision, scale)
+}
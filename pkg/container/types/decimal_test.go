@@ -15,8 +15,10 @@
 package types
 
 import (
-	"github.com/stretchr/testify/require"
+	"math"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestCompareDecimal64Decimal64(t *testing.T) {
@@ -97,62 +99,74 @@ func TestDecimal128_Decimal128ToString(t *testing.T) {
 func TestDecimal64Add(t *testing.T) {
 	a0 := Decimal64(123)
 	b0 := Decimal64(123)
-	result0 := Decimal64Add(a0, b0, 1, 1)
+	result0, err := Decimal64Add(a0, b0, 1, 1)
+	require.NoError(t, err)
 	require.Equal(t, Decimal64(246), result0)
 	a1 := Decimal64(1230)
 	b1 := Decimal64(123)
-	result1 := Decimal64Add(a1, b1, 1, 1)
+	result1, err := Decimal64Add(a1, b1, 1, 1)
+	require.NoError(t, err)
 	require.Equal(t, Decimal64(1353), result1)
 	a2 := Decimal64(-1230)
 	b2 := Decimal64(123)
-	result2 := Decimal64Add(a2, b2, 1, 1)
+	result2, err := Decimal64Add(a2, b2, 1, 1)
+	require.NoError(t, err)
 	require.Equal(t, Decimal64(-1107), result2)
 }
 
 func TestDecimal64Sub(t *testing.T) {
 	a0 := Decimal64(123)
 	b0 := Decimal64(123)
-	result0 := Decimal64Sub(a0, b0, 1, 1)
+	result0, err := Decimal64Sub(a0, b0, 1, 1)
+	require.NoError(t, err)
 	require.Equal(t, Decimal64(0), result0)
 	a1 := Decimal64(1230)
 	b1 := Decimal64(123)
-	result1 := Decimal64Sub(a1, b1, 1, 1)
+	result1, err := Decimal64Sub(a1, b1, 1, 1)
+	require.NoError(t, err)
 	require.Equal(t, Decimal64(1107), result1)
 	a2 := Decimal64(-1230)
 	b2 := Decimal64(123)
-	result2 := Decimal64Sub(a2, b2, 1, 1)
+	result2, err := Decimal64Sub(a2, b2, 1, 1)
+	require.NoError(t, err)
 	require.Equal(t, Decimal64(-1353), result2)
 }
 
 func TestDecimal128Add(t *testing.T) {
 	a0 := Decimal128{123, 0}
 	b0 := Decimal128{123, 0}
-	result0 := Decimal128Add(a0, b0, 1, 1)
+	result0, err := Decimal128Add(a0, b0, 1, 1)
+	require.NoError(t, err)
 	require.Equal(t, Decimal128{246, 0}, result0)
 	a1 := Decimal128{1230, 0}
 	b1 := Decimal128{123, 0}
-	result1 := Decimal128Add(a1, b1, 1, 1)
+	result1, err := Decimal128Add(a1, b1, 1, 1)
+	require.NoError(t, err)
 	require.Equal(t, Decimal128{1353, 0}, result1)
 	a2 := Decimal128{-1230, -1}
 	b2 := Decimal128{123, 0}
-	result2 := Decimal128Add(a2, b2, 1, 1)
+	result2, err := Decimal128Add(a2, b2, 1, 1)
+	require.NoError(t, err)
 	require.Equal(t, Decimal128{-1107, -1}, result2)
 }
 
 func TestDecimal128Sub(t *testing.T) {
 	a0 := Decimal128{123, 0}
 	b0 := Decimal128{123, 0}
-	result0 := Decimal128Sub(a0, b0, 1, 1)
+	result0, err := Decimal128Sub(a0, b0, 1, 1)
+	require.NoError(t, err)
 	require.Equal(t, Decimal128{0, 0}, result0)
 
 	a1 := Decimal128{1230, 0}
 	b1 := Decimal128{123, 0}
-	result1 := Decimal128Sub(a1, b1, 1, 1)
+	result1, err := Decimal128Sub(a1, b1, 1, 1)
+	require.NoError(t, err)
 	require.Equal(t, Decimal128{1107, 0}, result1)
 
 	a2 := Decimal128{-1230, -1}
 	b2 := Decimal128{123, 0}
-	result2 := Decimal128Sub(a2, b2, 1, 1)
+	result2, err := Decimal128Sub(a2, b2, 1, 1)
+	require.NoError(t, err)
 	require.Equal(t, Decimal128{-1353, -1}, result2)
 }
 
@@ -176,32 +190,38 @@ func TestDecimal64Decimal64Div(t *testing.T) {
 	b0 := Decimal64(123)
 	aScale := int32(1)
 	bScale := int32(2)
-	result0 := Decimal64Decimal64Div(a0, b0, aScale, bScale)
-	require.Equal(t, Decimal128{100, 0}, result0)
+	result0, err := Decimal64Decimal64Div(a0, b0, aScale, bScale)
+	require.NoError(t, err)
+	require.Equal(t, Decimal128{1000000, 0}, result0)
 	a1 := Decimal64(123)
 	b1 := Decimal64(-123)
-	result1 := Decimal64Decimal64Div(a1, b1, aScale, bScale)
-	require.Equal(t, Decimal128{-100, -1}, result1)
+	result1, err := Decimal64Decimal64Div(a1, b1, aScale, bScale)
+	require.NoError(t, err)
+	require.Equal(t, Decimal128{-1000000, -1}, result1)
 	a2 := Decimal64(-1230)
 	b2 := Decimal64(123)
-	result2 := Decimal64Decimal64Div(a2, b2, aScale, bScale)
-	require.Equal(t, Decimal128{-1000, -1}, result2)
+	result2, err := Decimal64Decimal64Div(a2, b2, aScale, bScale)
+	require.NoError(t, err)
+	require.Equal(t, Decimal128{-10000000, -1}, result2)
 }
 
 func TestDecimal128Mul(t *testing.T) {
 	a0 := Decimal128{123, 0}
 	b0 := Decimal128{123, 0}
-	result0 := Decimal128Decimal128Mul(a0, b0)
+	result0, err := Decimal128Decimal128Mul(a0, b0)
+	require.NoError(t, err)
 	require.Equal(t, Decimal128{15129, 0}, result0)
 
 	a1 := Decimal128{1230, 0}
 	b1 := Decimal128{123, 0}
-	result1 := Decimal128Decimal128Mul(a1, b1)
+	result1, err := Decimal128Decimal128Mul(a1, b1)
+	require.NoError(t, err)
 	require.Equal(t, Decimal128{151290, 0}, result1)
 
 	a2 := Decimal128{-1230, -1}
 	b2 := Decimal128{123, 0}
-	result2 := Decimal128Decimal128Mul(a2, b2)
+	result2, err := Decimal128Decimal128Mul(a2, b2)
+	require.NoError(t, err)
 	require.Equal(t, Decimal128{-151290, -1}, result2)
 }
 
@@ -210,18 +230,21 @@ func TestDecimal128Div(t *testing.T) {
 	b0 := Decimal128{123, 0}
 	aScale := int32(1)
 	bScale := int32(2)
-	result0 := Decimal128Decimal128Div(a0, b0, aScale, bScale)
-	require.Equal(t, Decimal128{100, 0}, result0)
+	result0, err := Decimal128Decimal128Div(a0, b0, aScale, bScale)
+	require.NoError(t, err)
+	require.Equal(t, Decimal128{1000000, 0}, result0)
 
 	a1 := Decimal128{1230, 0}
 	b1 := Decimal128{123, 0}
-	result1 := Decimal128Decimal128Div(a1, b1, aScale, bScale)
-	require.Equal(t, Decimal128{1000, 0}, result1)
+	result1, err := Decimal128Decimal128Div(a1, b1, aScale, bScale)
+	require.NoError(t, err)
+	require.Equal(t, Decimal128{10000000, 0}, result1)
 
 	a2 := Decimal128{-1230, -1}
 	b2 := Decimal128{123, 0}
-	result2 := Decimal128Decimal128Div(a2, b2, aScale, bScale)
-	require.Equal(t, Decimal128{-1000, -1}, result2)
+	result2, err := Decimal128Decimal128Div(a2, b2, aScale, bScale)
+	require.NoError(t, err)
+	require.Equal(t, Decimal128{-10000000, -1}, result2)
 }
 
 func TestDecimal64ToDecimal128(t *testing.T) {
@@ -261,3 +284,118 @@ func TestAlignDecimal128UsingScaleDiffBatch(t *testing.T) {
 	require.Equal(t, Decimal128{120000000, 0}, dst[4])
 	require.Equal(t, Decimal128{-1234000000, -1}, dst[5])
 }
+
+func TestDecimalZeroToString(t *testing.T) {
+	require.Equal(t, []byte("0.00"), Decimal64(0).Decimal64ToString(2))
+	require.Equal(t, []byte("0"), Decimal64(0).Decimal64ToString(0))
+	require.Equal(t, []byte("0.00"), Decimal128{}.Decimal128ToString(2))
+	require.Equal(t, []byte("0.0"), Decimal128{}.Decimal128ToString(1))
+	require.Equal(t, []byte("0"), Decimal128{}.Decimal128ToString(0))
+}
+
+func TestDecimalOverflow(t *testing.T) {
+	_, err := Decimal64Add(Decimal64(math.MaxInt64), Decimal64(1), 0, 0)
+	require.Error(t, err)
+	_, err = Decimal64Sub(Decimal64(math.MinInt64), Decimal64(1), 0, 0)
+	require.Error(t, err)
+	_, err = Decimal64Add(Decimal64(math.MaxInt64/10+1), Decimal64(1), 0, 1)
+	require.Error(t, err)
+
+	max := Decimal128{-1, math.MaxInt64}
+	_, err = Decimal128Add(max, Decimal128{1, 0}, 0, 0)
+	require.Error(t, err)
+	_, err = Decimal128Sub(NegDecimal128(max), Decimal128{2, 0}, 0, 0)
+	require.Error(t, err)
+	_, err = Decimal128Decimal128Mul(max, Decimal128{2, 0})
+	require.Error(t, err)
+	_, err = Decimal128Add(max, Decimal128{}, 0, 1)
+	require.Error(t, err)
+	_, err = Decimal128Decimal128Div(Decimal128{1, 0}, Decimal128{}, 0, 0)
+	require.Error(t, err)
+	result, err := Decimal128Add(max, NegDecimal128(max), 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, Decimal128{}, result)
+}
+
+func TestDecimalDivRound(t *testing.T) {
+	// 2 / 3 = 0.6667
+	result, err := Decimal64Decimal64Div(Decimal64(2), Decimal64(3), 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte("0.6667"), result.Decimal128ToString(DecimalDivResultScale(0)))
+	// -2.00 / 3 = -0.666667
+	result, err = Decimal64Decimal64Div(Decimal64(-200), Decimal64(3), 2, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte("-0.666667"), result.Decimal128ToString(DecimalDivResultScale(2)))
+	// 1 / -8 = -0.1250
+	result, err = Decimal64Decimal64Div(Decimal64(1), Decimal64(-8), 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, []byte("-0.1250"), result.Decimal128ToString(DecimalDivResultScale(0)))
+}
+
+func TestRescaleDecimal(t *testing.T) {
+	d64, err := RescaleDecimal64(Decimal64(12345), 3, 1)
+	require.NoError(t, err)
+	require.Equal(t, Decimal64(123), d64)
+	d64, err = RescaleDecimal64(Decimal64(-12355), 3, 2)
+	require.NoError(t, err)
+	require.Equal(t, Decimal64(-1236), d64)
+	d64, err = RescaleDecimal64(Decimal64(12), 1, 4)
+	require.NoError(t, err)
+	require.Equal(t, Decimal64(12000), d64)
+	_, err = RescaleDecimal64(Decimal64(math.MaxInt64/100), 0, 3)
+	require.Error(t, err)
+
+	d128, err := RescaleDecimal128(Decimal128{12345, 0}, 3, 1)
+	require.NoError(t, err)
+	require.Equal(t, Decimal128{123, 0}, d128)
+	d128, err = RescaleDecimal128(NegDecimal128(Decimal128{12355, 0}), 3, 2)
+	require.NoError(t, err)
+	require.Equal(t, NegDecimal128(Decimal128{1236, 0}), d128)
+	d128, err = RescaleDecimal128(Decimal128{7, 0}, 0, -3)
+	require.NoError(t, err)
+	require.Equal(t, Decimal128{}, d128)
+	d128, err = RescaleDecimal128(Decimal128{12, 0}, 1, 4)
+	require.NoError(t, err)
+	require.Equal(t, Decimal128{12000, 0}, d128)
+
+	d64, err = Decimal128ToDecimal64(NegDecimal128(Decimal128{12, 0}))
+	require.NoError(t, err)
+	require.Equal(t, Decimal64(-12), d64)
+	_, err = Decimal128ToDecimal64(Decimal128{0, 1})
+	require.Error(t, err)
+}
+
+func TestDecimalFloatConversion(t *testing.T) {
+	require.Equal(t, 12.34, Decimal64ToFloat64(Decimal64(1234), 2))
+	require.Equal(t, -0.1, Decimal128ToFloat64(NegDecimal128(Decimal128{1, 0}), 1))
+	d64, err := Float64ToDecimal64(12.345, 10, 2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("12.35"), d64.Decimal64ToString(2))
+	d128, err := Float64ToDecimal128(-0.5, 20, 3)
+	require.NoError(t, err)
+	require.Equal(t, []byte("-0.500"), d128.Decimal128ToString(3))
+	_, err = Float64ToDecimal64(12345.6, 5, 2)
+	require.Error(t, err)
+	_, err = Float64ToDecimal128(math.Inf(1), 20, 2)
+	require.Error(t, err)
+}
+
+func TestParseStringToDecimalLeadingZeros(t *testing.T) {
+	d64, err := ParseStringToDecimal64(" 0.5 ", 2, 2)
+	require.NoError(t, err)
+	require.Equal(t, Decimal64(50), d64)
+	d64, err = ParseStringToDecimal64("-007", 3, 2)
+	require.NoError(t, err)
+	require.Equal(t, Decimal64(-700), d64)
+	_, err = ParseStringToDecimal64("-", 3, 2)
+	require.Error(t, err)
+}
+
+func TestCheckDecimalPrecision(t *testing.T) {
+	require.NoError(t, CheckDecimal64Precision(Decimal64(99999), 5))
+	require.Error(t, CheckDecimal64Precision(Decimal64(-100000), 5))
+	require.NoError(t, CheckDecimal64Precision(Decimal64(math.MaxInt64), 19))
+	require.NoError(t, CheckDecimal128Precision(NegDecimal128(InitDecimal128(999)), 3))
+	require.Error(t, CheckDecimal128Precision(NegDecimal128(InitDecimal128(1000)), 3))
+	require.Error(t, CheckDecimal128Precision(Decimal128{0, 1}, 19))
+}
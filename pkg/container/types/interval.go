@@ -0,0 +1,456 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// IntervalType is the unit of the INTERVAL expression and the time functions
+type IntervalType int8
+
+const (
+	IntervalTypeInvalid IntervalType = iota
+	MicroSecond
+	Second
+	Minute
+	Hour
+	Day
+	Week
+	Month
+	Quarter
+	Year
+	Second_MicroSecond
+	Minute_MicroSecond
+	Minute_Second
+	Hour_MicroSecond
+	Hour_Second
+	Hour_Minute
+	Day_MicroSecond
+	Day_Second
+	Day_Minute
+	Day_Hour
+	Year_Month
+)
+
+const (
+	microSecsPerSec  = 1000000
+	unixEpochSecs    = int64(719162) * secsPerDay // the secs from 0001-01-01 to 1970-01-01
+	microSecondMask  = 0xfffff
+	monthsPerYear    = 12
+	monthsPerQuarter = 3
+	daysPerWeek      = 7
+)
+
+var intervalTypeNames = map[string]IntervalType{
+	"microsecond":        MicroSecond,
+	"second":             Second,
+	"minute":             Minute,
+	"hour":               Hour,
+	"day":                Day,
+	"week":               Week,
+	"month":              Month,
+	"quarter":            Quarter,
+	"year":               Year,
+	"second_microsecond": Second_MicroSecond,
+	"minute_microsecond": Minute_MicroSecond,
+	"minute_second":      Minute_Second,
+	"hour_microsecond":   Hour_MicroSecond,
+	"hour_second":        Hour_Second,
+	"hour_minute":        Hour_Minute,
+	"day_microsecond":    Day_MicroSecond,
+	"day_second":         Day_Second,
+	"day_minute":         Day_Minute,
+	"day_hour":           Day_Hour,
+	"year_month":         Year_Month,
+	"sql_tsi_second":     Second,
+	"sql_tsi_minute":     Minute,
+	"sql_tsi_hour":       Hour,
+	"sql_tsi_day":        Day,
+	"sql_tsi_week":       Week,
+	"sql_tsi_month":      Month,
+	"sql_tsi_quarter":    Quarter,
+	"sql_tsi_year":       Year,
+}
+
+// intervalFields are the fields of the interval values from the highest to the lowest
+var intervalFields = map[IntervalType][]IntervalType{
+	Second_MicroSecond: {Second, MicroSecond},
+	Minute_MicroSecond: {Minute, Second, MicroSecond},
+	Minute_Second:      {Minute, Second},
+	Hour_MicroSecond:   {Hour, Minute, Second, MicroSecond},
+	Hour_Second:        {Hour, Minute, Second},
+	Hour_Minute:        {Hour, Minute},
+	Day_MicroSecond:    {Day, Hour, Minute, Second, MicroSecond},
+	Day_Second:         {Day, Hour, Minute, Second},
+	Day_Minute:         {Day, Hour, Minute},
+	Day_Hour:           {Day, Hour},
+	Year_Month:         {Year, Month},
+}
+
+// microSecsOfUnit is the number of microseconds of the time units
+var microSecsOfUnit = map[IntervalType]int64{
+	MicroSecond: 1,
+	Second:      microSecsPerSec,
+	Minute:      secsPerMinute * microSecsPerSec,
+	Hour:        secsPerHour * microSecsPerSec,
+	Day:         secsPerDay * microSecsPerSec,
+	Week:        daysPerWeek * secsPerDay * microSecsPerSec,
+}
+
+// monthsOfUnit is the number of months of the units counted by month
+var monthsOfUnit = map[IntervalType]int64{
+	Month:   1,
+	Quarter: monthsPerQuarter,
+	Year:    monthsPerYear,
+}
+
+var errIncorrectIntervalValue = errors.New(errno.DataException, "Incorrect interval value")
+
+// IntervalTypeOf returns the interval type of the unit name, such as 'day' or 'hour_minute'
+func IntervalTypeOf(s string) (IntervalType, error) {
+	if it, ok := intervalTypeNames[strings.ToLower(s)]; ok {
+		return it, nil
+	}
+	return IntervalTypeInvalid, errors.New(errno.DataException, fmt.Sprintf("invalid interval unit '%s'", s))
+}
+
+func (it IntervalType) String() string {
+	for name, typ := range intervalTypeNames {
+		if typ == it && !strings.HasPrefix(name, "sql_tsi_") {
+			return strings.ToUpper(name)
+		}
+	}
+	return "INVALID"
+}
+
+// IsDateUnit returns true if the interval only moves the date part,
+// a date plus such interval is still a date.
+func (it IntervalType) IsDateUnit() bool {
+	switch it {
+	case Day, Week, Month, Quarter, Year, Year_Month:
+		return true
+	}
+	return false
+}
+
+/*
+NormalizeInterval converts the interval value of the unit to the number of microseconds
+if the unit is a time unit or to the number of months if the unit is counted by month.
+The returned type is MicroSecond or Month.
+The value of the compound units is like '1 2:30:00.5' and the missing leading fields are zero,
+the fraction after the dot is the microseconds and is padded to 6 digits.
+*/
+func NormalizeInterval(s string, it IntervalType) (int64, IntervalType, error) {
+	s = strings.TrimSpace(s)
+	neg := false
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = strings.TrimSpace(s[1:])
+	}
+	var n int64
+	var err error
+	if fields, ok := intervalFields[it]; ok {
+		n, err = compoundInterval(s, fields)
+	} else {
+		n, err = simpleInterval(s, it)
+	}
+	if err != nil {
+		return 0, IntervalTypeInvalid, err
+	}
+	if neg {
+		n = -n
+	}
+	if it == Year_Month {
+		return n, Month, nil
+	}
+	if _, ok := monthsOfUnit[it]; ok {
+		return n, Month, nil
+	}
+	return n, MicroSecond, nil
+}
+
+// NormalizeIntervalInt is NormalizeInterval for the integer interval values
+func NormalizeIntervalInt(v int64, it IntervalType) (int64, IntervalType, error) {
+	if _, ok := intervalFields[it]; ok {
+		return NormalizeInterval(strconv.FormatInt(v, 10), it)
+	}
+	if m, ok := monthsOfUnit[it]; ok {
+		return v * m, Month, nil
+	}
+	if m, ok := microSecsOfUnit[it]; ok {
+		return v * m, MicroSecond, nil
+	}
+	return 0, IntervalTypeInvalid, errIncorrectIntervalValue
+}
+
+func simpleInterval(s string, it IntervalType) (int64, error) {
+	if it == Second {
+		// the fractional seconds are allowed
+		parts := strings.SplitN(s, ".", 2)
+		v, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			return 0, errIncorrectIntervalValue
+		}
+		v *= microSecsPerSec
+		if len(parts) == 2 {
+			frac, err := parseMicroSeconds(parts[1])
+			if err != nil {
+				return 0, err
+			}
+			v += frac
+		}
+		return v, nil
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, errIncorrectIntervalValue
+	}
+	if m, ok := monthsOfUnit[it]; ok {
+		return v * m, nil
+	}
+	if m, ok := microSecsOfUnit[it]; ok {
+		return v * m, nil
+	}
+	return 0, errIncorrectIntervalValue
+}
+
+func compoundInterval(s string, fields []IntervalType) (int64, error) {
+	var values []string
+	start := -1
+	for i := 0; i <= len(s); i++ {
+		if i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			values = append(values, s[start:i])
+			start = -1
+		}
+	}
+	if len(values) == 0 || len(values) > len(fields) {
+		return 0, errIncorrectIntervalValue
+	}
+	// the missing fields are the leading ones
+	fields = fields[len(fields)-len(values):]
+	var n int64
+	for i, f := range fields {
+		var v int64
+		var err error
+		if f == MicroSecond {
+			v, err = parseMicroSeconds(values[i])
+		} else {
+			v, err = strconv.ParseInt(values[i], 10, 64)
+		}
+		if err != nil {
+			return 0, errIncorrectIntervalValue
+		}
+		if m, ok := monthsOfUnit[f]; ok {
+			n += v * m
+		} else {
+			n += v * microSecsOfUnit[f]
+		}
+	}
+	return n, nil
+}
+
+// parseMicroSeconds parses the fraction digits of a second
+func parseMicroSeconds(s string) (int64, error) {
+	if len(s) > microSecondsDigits {
+		s = s[:microSecondsDigits]
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, errIncorrectIntervalValue
+	}
+	for i := len(s); i < microSecondsDigits; i++ {
+		v *= 10
+	}
+	return v, nil
+}
+
+// AddInterval adds the normalized interval to the datetime, false if the result is out of range
+func (dt Datetime) AddInterval(n int64, it IntervalType) (Datetime, bool) {
+	switch it {
+	case MicroSecond:
+		micro := dt.sec()*microSecsPerSec + dt.MicroSec() + n
+		sec, frac := micro/microSecsPerSec, micro%microSecsPerSec
+		if frac < 0 {
+			sec--
+			frac += microSecsPerSec
+		}
+		if sec < 0 {
+			return 0, false
+		}
+		r := Datetime(sec<<20 + frac)
+		return r, validYear(r.ToDate())
+	case Month:
+		d, ok := dt.ToDate().addMonths(n)
+		if !ok {
+			return 0, false
+		}
+		return Datetime((int64(d)*secsPerDay+dt.sec()%secsPerDay)<<20 + dt.MicroSec()), true
+	}
+	return 0, false
+}
+
+// AddInterval adds the normalized interval of the date units to the date
+func (d Date) AddInterval(n int64, it IntervalType) (Date, bool) {
+	switch it {
+	case MicroSecond:
+		r := Date(int64(d) + n/microSecsOfUnit[Day])
+		return r, r >= 0 && validYear(r)
+	case Month:
+		return d.addMonths(n)
+	}
+	return 0, false
+}
+
+func (d Date) addMonths(n int64) (Date, bool) {
+	y, m, day, _ := d.Calendar(true)
+	months := int64(y)*monthsPerYear + int64(m-1) + n
+	if months < monthsPerYear || months >= (MaxDateYear+1)*monthsPerYear {
+		return 0, false
+	}
+	y, m = int32(months/monthsPerYear), uint8(months%monthsPerYear)+1
+	if last := daysOfMonth(y, m); day > last {
+		day = last
+	}
+	return FromCalendar(y, m, day), true
+}
+
+func validYear(d Date) bool {
+	y := d.Year()
+	return y >= 1 && y <= MaxDateYear
+}
+
+func daysOfMonth(year int32, month uint8) uint8 {
+	if isLeap(year) {
+		return leapYearMonthDays[month-1]
+	}
+	return flatYearMonthDays[month-1]
+}
+
+// LastDay returns the last day of the month of the date
+func (d Date) LastDay() Date {
+	y, m, _, _ := d.Calendar(true)
+	return FromCalendar(y, m, daysOfMonth(y, m))
+}
+
+// MicroSec returns the microseconds part of the datetime
+func (dt Datetime) MicroSec() int64 {
+	return int64(dt) & microSecondMask
+}
+
+// UnixTimestamp returns the secs since 1970-01-01 00:00:00 UTC of the datetime in the local time zone
+func (dt Datetime) UnixTimestamp() int64 {
+	return dt.sec() - localTZ - unixEpochSecs
+}
+
+// FromUnix returns the datetime in the local time zone of the secs since 1970-01-01 00:00:00 UTC
+func FromUnix(ts int64) Datetime {
+	return Datetime((ts + localTZ + unixEpochSecs) << 20)
+}
+
+/*
+TimestampDiff returns the number of the complete units from a to b like TIMESTAMPDIFF,
+it is negative if b is earlier than a.
+*/
+func TimestampDiff(it IntervalType, a, b Datetime) (int64, error) {
+	if m, ok := monthsOfUnit[it]; ok {
+		ay, am, ad, _ := a.ToDate().Calendar(true)
+		by, bm, bd, _ := b.ToDate().Calendar(true)
+		months := (int64(by)-int64(ay))*monthsPerYear + int64(bm) - int64(am)
+		// the last month is not complete
+		aRest := (int64(ad)*secsPerDay+a.sec()%secsPerDay)*microSecsPerSec + a.MicroSec()
+		bRest := (int64(bd)*secsPerDay+b.sec()%secsPerDay)*microSecsPerSec + b.MicroSec()
+		if months > 0 && bRest < aRest {
+			months--
+		} else if months < 0 && bRest > aRest {
+			months++
+		}
+		return months / m, nil
+	}
+	if m, ok := microSecsOfUnit[it]; ok {
+		diff := (b.sec()-a.sec())*microSecsPerSec + b.MicroSec() - a.MicroSec()
+		return diff / m, nil
+	}
+	return 0, errors.New(errno.DataException, fmt.Sprintf("invalid unit %s for timestampdiff", it))
+}
+
+/*
+Extract returns the part of the datetime like EXTRACT(unit FROM datetime).
+The compound units return the fields one after another, such as DDHHMMSS for DAY_SECOND.
+*/
+func (dt Datetime) Extract(it IntervalType) (int64, error) {
+	if fields, ok := intervalFields[it]; ok {
+		var r int64
+		for _, f := range fields {
+			v, err := dt.Extract(f)
+			if err != nil {
+				return 0, err
+			}
+			switch f {
+			case MicroSecond:
+				r = r*microSecsPerSec + v
+			case Year:
+				r = v
+			default:
+				r = r*100 + v
+			}
+		}
+		return r, nil
+	}
+	y, m, d, yday := dt.ToDate().Calendar(true)
+	t := dt.sec() % secsPerDay
+	switch it {
+	case MicroSecond:
+		return dt.MicroSec(), nil
+	case Second:
+		return t % secsPerMinute, nil
+	case Minute:
+		return t % secsPerHour / secsPerMinute, nil
+	case Hour:
+		return t / secsPerHour, nil
+	case Day:
+		return int64(d), nil
+	case Week:
+		return int64(dt.ToDate().week(yday)), nil
+	case Month:
+		return int64(m), nil
+	case Quarter:
+		return int64(m-1)/monthsPerQuarter + 1, nil
+	case Year:
+		return int64(y), nil
+	}
+	return 0, errors.New(errno.DataException, fmt.Sprintf("invalid unit %s for extract", it))
+}
+
+// week returns the week of the year in which the weeks start with Sunday, the days before the first Sunday are in week 0.
+func (d Date) week(yday uint16) uint8 {
+	jan1 := int(Date(int32(d) - int32(yday) + 1).DayOfWeek())
+	firstSunday := (daysPerWeek-jan1)%daysPerWeek + 1
+	if int(yday) < firstSunday {
+		return 0
+	}
+	return uint8((int(yday)-firstSunday)/daysPerWeek + 1)
+}
@@ -0,0 +1,157 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustDatetime(t *testing.T, s string) Datetime {
+	dt, err := ParseDatetime(s)
+	require.NoError(t, err)
+	return dt
+}
+
+func TestNormalizeInterval(t *testing.T) {
+	cases := []struct {
+		value string
+		unit  string
+		n     int64
+		typ   IntervalType
+	}{
+		{"1", "day", 86400 * 1000000, MicroSecond},
+		{"-2", "hour", -2 * 3600 * 1000000, MicroSecond},
+		{"1.5", "second", 1500000, MicroSecond},
+		{"3", "quarter", 9, Month},
+		{"1-2", "year_month", 14, Month},
+		{"1:30", "hour_minute", 90 * 60 * 1000000, MicroSecond},
+		{"1:30", "day_second", 90 * 1000000, MicroSecond},
+		{"1 2:03:04.5", "day_microsecond", ((26*60+3)*60+4)*1000000 + 500000, MicroSecond},
+		{"-1 1", "day_hour", -25 * 3600 * 1000000, MicroSecond},
+	}
+	for _, c := range cases {
+		it, err := IntervalTypeOf(c.unit)
+		require.NoError(t, err)
+		n, typ, err := NormalizeInterval(c.value, it)
+		require.NoError(t, err, c.value)
+		require.Equal(t, c.n, n, c.value)
+		require.Equal(t, c.typ, typ, c.value)
+	}
+
+	n, typ, err := NormalizeIntervalInt(2, Week)
+	require.NoError(t, err)
+	require.Equal(t, int64(14*86400*1000000), n)
+	require.Equal(t, MicroSecond, typ)
+
+	_, err = IntervalTypeOf("days")
+	require.Error(t, err)
+	_, _, err = NormalizeInterval("1:2:3", Hour_Minute)
+	require.Error(t, err)
+	_, _, err = NormalizeInterval("x", Day)
+	require.Error(t, err)
+}
+
+func TestAddInterval(t *testing.T) {
+	dt := mustDatetime(t, "2022-01-31 23:59:59")
+	r, ok := dt.AddInterval(1, Month)
+	require.True(t, ok)
+	require.Equal(t, "2022-02-28 23:59:59", r.String())
+	r, ok = dt.AddInterval(1000000, MicroSecond)
+	require.True(t, ok)
+	require.Equal(t, "2022-02-01 00:00:00", r.String())
+	r, ok = dt.AddInterval(-500000, MicroSecond)
+	require.True(t, ok)
+	require.Equal(t, "2022-01-31 23:59:58", r.String())
+	require.Equal(t, int64(500000), r.MicroSec())
+	_, ok = dt.AddInterval(10000*12, Month)
+	require.False(t, ok)
+
+	d, err := ParseDate("2020-02-29")
+	require.NoError(t, err)
+	rd, ok := d.AddInterval(12, Month)
+	require.True(t, ok)
+	require.Equal(t, "2021-02-28", rd.String())
+	rd, ok = d.AddInterval(-86400*1000000, MicroSecond)
+	require.True(t, ok)
+	require.Equal(t, "2020-02-28", rd.String())
+	require.Equal(t, "2020-02-29", FromCalendar(2020, 2, 3).LastDay().String())
+}
+
+func TestTimestampDiff(t *testing.T) {
+	a, b := mustDatetime(t, "2022-01-31 10:00:00"), mustDatetime(t, "2022-03-31 09:00:00")
+	cases := []struct {
+		it IntervalType
+		n  int64
+	}{
+		{Month, 1},
+		{Quarter, 0},
+		{Day, 58},
+		{Hour, 58*24 + 23},
+		{Week, 8},
+	}
+	for _, c := range cases {
+		n, err := TimestampDiff(c.it, a, b)
+		require.NoError(t, err)
+		require.Equal(t, c.n, n, c.it.String())
+		n, err = TimestampDiff(c.it, b, a)
+		require.NoError(t, err)
+		require.Equal(t, -c.n, n, c.it.String())
+	}
+	_, err := TimestampDiff(Day_Hour, a, b)
+	require.Error(t, err)
+}
+
+func TestExtract(t *testing.T) {
+	dt := mustDatetime(t, "2022-05-03 04:05:06.000007")
+	cases := []struct {
+		it IntervalType
+		v  int64
+	}{
+		{MicroSecond, 7},
+		{Second, 6},
+		{Minute, 5},
+		{Hour, 4},
+		{Day, 3},
+		{Week, 18},
+		{Month, 5},
+		{Quarter, 2},
+		{Year, 2022},
+		{Year_Month, 202205},
+		{Day_Hour, 304},
+		{Day_Second, 3040506},
+		{Second_MicroSecond, 6000007},
+		{Hour_Minute, 405},
+	}
+	for _, c := range cases {
+		v, err := dt.Extract(c.it)
+		require.NoError(t, err)
+		require.Equal(t, c.v, v, c.it.String())
+	}
+	// 2022-01-01 is a Saturday
+	v, err := mustDatetime(t, "2022-01-01 00:00:00").Extract(Week)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), v)
+	v, err = mustDatetime(t, "2022-01-02 00:00:00").Extract(Week)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), v)
+}
+
+func TestUnixTimestamp(t *testing.T) {
+	require.Equal(t, int64(0), FromUnix(0).UnixTimestamp())
+	require.Equal(t, int64(1651363200), FromUnix(1651363200).UnixTimestamp())
+	require.Equal(t, "1970-01-01 00:00:00", Datetime(int64(FromUnix(0))-localTZ<<20).String())
+}
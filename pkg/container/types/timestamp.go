@@ -51,18 +51,7 @@ func (ts Timestamp) String() string {
 
 // String2 stringify timestamp, including its fractional seconds precision part(fsp)
 func (ts Timestamp) String2(precision int32) string {
-	dt := Datetime(int64(ts) + localTZ<<20)
-	y, m, d, _ := dt.ToDate().Calendar(true)
-	hour, minute, sec := dt.Clock()
-	if precision > 0 {
-		msec := int64(dt) & 0xfffff
-		msecInstr := fmt.Sprintf("%06d\n", msec)
-		msecInstr = msecInstr[:precision]
-
-		return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d"+"."+msecInstr, y, m, d, hour, minute, sec)
-	}
-
-	return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", y, m, d, hour, minute, sec)
+	return ts.ToDatetime(nil).String2(precision)
 }
 
 // ParseTimestamp will parse a string to be a Timestamp
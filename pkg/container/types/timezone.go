@@ -0,0 +1,126 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata" // the zone database is embedded so that the named zones work without the system one
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// SystemTimeZone is the value of time_zone which means the time zone of the server
+const SystemTimeZone = "SYSTEM"
+
+const (
+	minTimeZoneOffset = -(13*secsPerHour + 59*secsPerMinute)
+	maxTimeZoneOffset = 14 * secsPerHour
+)
+
+/*
+ParseTimeZone returns the location of the time zone like the values of time_zone in the mysql.
+The zone is 'SYSTEM' for the server time zone, an offset from UTC like '+08:00' or '-05:30',
+or a named zone of the IANA database like 'UTC' or 'Asia/Shanghai'.
+The nil location is the server time zone.
+*/
+func ParseTimeZone(s string) (*time.Location, error) {
+	s = strings.TrimSpace(s)
+	if strings.EqualFold(s, SystemTimeZone) {
+		return nil, nil
+	}
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		return parseTimeZoneOffset(s)
+	}
+	if s == "" || strings.HasPrefix(s, ".") || strings.Contains(s, "..") {
+		return nil, errUnknownTimeZone(s)
+	}
+	loc, err := time.LoadLocation(s)
+	if err != nil || loc == time.Local {
+		return nil, errUnknownTimeZone(s)
+	}
+	return loc, nil
+}
+
+func parseTimeZoneOffset(s string) (*time.Location, error) {
+	i := strings.IndexByte(s, ':')
+	if i < 2 || i > 3 || len(s)-i != 3 {
+		return nil, errUnknownTimeZone(s)
+	}
+	h, err := strconv.ParseUint(s[1:i], 10, 8)
+	if err != nil {
+		return nil, errUnknownTimeZone(s)
+	}
+	m, err := strconv.ParseUint(s[i+1:], 10, 8)
+	if err != nil || m > 59 {
+		return nil, errUnknownTimeZone(s)
+	}
+	offset := int(h)*secsPerHour + int(m)*secsPerMinute
+	if s[0] == '-' {
+		offset = -offset
+	}
+	if offset < minTimeZoneOffset || offset > maxTimeZoneOffset {
+		return nil, errUnknownTimeZone(s)
+	}
+	return time.FixedZone(fmt.Sprintf("%c%02d:%02d", s[0], h, m), offset), nil
+}
+
+func errUnknownTimeZone(s string) error {
+	return errors.New(errno.DataException, fmt.Sprintf("unknown or incorrect time zone: '%s'", s))
+}
+
+// TimeZoneName returns the value of time_zone for the location
+func TimeZoneName(loc *time.Location) string {
+	if loc == nil {
+		return SystemTimeZone
+	}
+	return loc.String()
+}
+
+// ToTimestamp converts the datetime of the wall clock in the time zone loc to the timestamp in UTC,
+// the nil location is the server time zone
+func (dt Datetime) ToTimestamp(loc *time.Location) Timestamp {
+	if loc == nil {
+		return Timestamp(int64(dt) - localTZ<<20)
+	}
+	y, m, d, _ := dt.ToDate().Calendar(true)
+	hour, minute, sec := dt.Clock()
+	t := time.Date(int(y), time.Month(m), int(d), int(hour), int(minute), int(sec), 0, loc)
+	return Timestamp((t.Unix()+unixEpochSecs)<<20 + int64(dt)&microSecondMask)
+}
+
+// ToDatetime converts the timestamp in UTC to the datetime of the wall clock in the time zone loc,
+// the nil location is the server time zone
+func (ts Timestamp) ToDatetime(loc *time.Location) Datetime {
+	if loc == nil {
+		return Datetime(int64(ts) + localTZ<<20)
+	}
+	secs := int64(ts) >> 20
+	_, offset := time.Unix(secs-unixEpochSecs, 0).In(loc).Zone()
+	return Datetime((secs+int64(offset))<<20 + int64(ts)&microSecondMask)
+}
+
+// ConvertTimeZone converts the datetime of the wall clock in the time zone from to the one in the time zone to
+func ConvertTimeZone(dt Datetime, from, to *time.Location) Datetime {
+	return dt.ToTimestamp(from).ToDatetime(to)
+}
+
+// NowIn returns the current datetime of the wall clock in the time zone loc
+func NowIn(loc *time.Location) Datetime {
+	return Timestamp(Now()).ToDatetime(loc)
+}
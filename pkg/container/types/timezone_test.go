@@ -0,0 +1,75 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeZone(t *testing.T) {
+	loc, err := ParseTimeZone("system")
+	require.NoError(t, err)
+	require.Nil(t, loc)
+	require.Equal(t, SystemTimeZone, TimeZoneName(loc))
+
+	cases := []struct {
+		zone   string
+		name   string
+		offset int
+	}{
+		{"+08:00", "+08:00", 8 * 3600},
+		{"-5:30", "-05:30", -(5*3600 + 30*60)},
+		{"+14:00", "+14:00", 14 * 3600},
+		{"UTC", "UTC", 0},
+		{"Asia/Shanghai", "Asia/Shanghai", 8 * 3600},
+	}
+	for _, c := range cases {
+		loc, err := ParseTimeZone(c.zone)
+		require.NoError(t, err, c.zone)
+		require.Equal(t, c.name, TimeZoneName(loc))
+		dt := mustDatetime(t, "2022-01-01 00:00:00")
+		require.Equal(t, int64(c.offset), dt.sec()-dt.ToTimestamp(loc).ToDatetime(time.UTC).sec(), c.zone)
+	}
+
+	for _, zone := range []string{"", "+8", "+08:60", "+14:01", "-14:00", "08:00", "Mars/Olympus", "../etc/passwd", "Local"} {
+		_, err := ParseTimeZone(zone)
+		require.Error(t, err, zone)
+	}
+}
+
+func TestTimestampInTimeZone(t *testing.T) {
+	utc, err := ParseTimeZone("UTC")
+	require.NoError(t, err)
+	ny, err := ParseTimeZone("America/New_York")
+	require.NoError(t, err)
+
+	// the offset of New York is -05:00 in the winter and -04:00 in the summer
+	ts := mustDatetime(t, "2022-01-15 12:00:00.123456").ToTimestamp(ny)
+	require.Equal(t, "2022-01-15 17:00:00.123", ts.ToDatetime(utc).String2(3))
+	ts = mustDatetime(t, "2022-07-15 12:00:00").ToTimestamp(ny)
+	require.Equal(t, "2022-07-15 16:00:00", ts.ToDatetime(utc).String())
+	require.Equal(t, "2022-07-15 12:00:00", ts.ToDatetime(ny).String())
+
+	require.Equal(t, "2022-03-13 06:30:00", ConvertTimeZone(mustDatetime(t, "2022-03-13 01:30:00"), ny, utc).String())
+	require.Equal(t, "2022-03-13 03:30:00", ConvertTimeZone(mustDatetime(t, "2022-03-13 07:30:00"), utc, ny).String())
+
+	// the nil location is the server time zone
+	ts = mustDatetime(t, "2022-01-01 00:00:00").ToTimestamp(nil)
+	require.Equal(t, "2022-01-01 00:00:00", ts.ToDatetime(nil).String())
+	require.Equal(t, "2022-01-01 00:00:00.000000", ts.String())
+}
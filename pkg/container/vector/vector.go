@@ -1386,8 +1386,8 @@ func UnionOne(v, w *Vector, sel int64, m *mheap.Mheap) error {
 			}
 			mheap.Free(m, v.Data)
 			v.Data = data
-			n = len(vs.Offsets)
-			vs.Data = data[:vs.Offsets[n-1]+vs.Lengths[n-1]]
+			// the vector may have no strings if it is preallocated
+			vs.Data = data[:n]
 		}
 		vs.Lengths = append(vs.Lengths, uint32(len(from)))
 		{
@@ -1845,11 +1845,9 @@ func Union(v, w *Vector, sels []int64, m *mheap.Mheap) error {
 		v.Col = vs
 	}
 	if nulls.Any(w.Nsp) {
-		j := uint64(oldLen)
-		for _, sel := range sels {
+		for i, sel := range sels {
 			if nulls.Contains(w.Nsp, uint64(sel)) {
-				nulls.Add(v.Nsp, j)
-				j++
+				nulls.Add(v.Nsp, uint64(oldLen+i))
 			}
 		}
 	}
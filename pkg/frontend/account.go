@@ -0,0 +1,1126 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+)
+
+const (
+	//the system database keeps the accounts and the statistics
+	accountCatalogDB = "mo_catalog"
+	//the changes of the accounts in the order they are made
+	accountCatalogTable = "mo_account_log"
+)
+
+// privilegeType is a set of the privileges on a database object
+type privilegeType uint8
+
+const (
+	privilegeSelect privilegeType = 1 << iota
+	privilegeInsert
+	privilegeUpdate
+	privilegeDelete
+	privilegeCreate
+	privilegeDrop
+
+	privilegeAll = privilegeSelect | privilegeInsert | privilegeUpdate | privilegeDelete | privilegeCreate | privilegeDrop
+)
+
+var privilegeNames = []struct {
+	typ  privilegeType
+	name string
+}{
+	{privilegeSelect, "SELECT"},
+	{privilegeInsert, "INSERT"},
+	{privilegeUpdate, "UPDATE"},
+	{privilegeDelete, "DELETE"},
+	{privilegeCreate, "CREATE"},
+	{privilegeDrop, "DROP"},
+}
+
+func (p privilegeType) String() string {
+	if p == privilegeAll {
+		return "ALL PRIVILEGES"
+	}
+	var names []string
+	for _, n := range privilegeNames {
+		if p&n.typ != 0 {
+			names = append(names, n.name)
+		}
+	}
+	if len(names) == 0 {
+		return "USAGE"
+	}
+	return strings.Join(names, ", ")
+}
+
+// convertPrivilege converts the privilege of the GRANT/REVOKE into the supported one
+func convertPrivilege(p *tree.Privilege) (privilegeType, error) {
+	if len(p.ColumnList) != 0 {
+		return 0, NewMysqlError(ER_NOT_SUPPORTED_YET, "column privileges")
+	}
+	switch p.Type {
+	case tree.PRIVILEGE_TYPE_STATIC_ALL:
+		return privilegeAll, nil
+	case tree.PRIVILEGE_TYPE_STATIC_SELECT:
+		return privilegeSelect, nil
+	case tree.PRIVILEGE_TYPE_STATIC_INSERT:
+		return privilegeInsert, nil
+	case tree.PRIVILEGE_TYPE_STATIC_UPDATE:
+		return privilegeUpdate, nil
+	case tree.PRIVILEGE_TYPE_STATIC_DELETE:
+		return privilegeDelete, nil
+	case tree.PRIVILEGE_TYPE_STATIC_CREATE:
+		return privilegeCreate, nil
+	case tree.PRIVILEGE_TYPE_STATIC_DROP:
+		return privilegeDrop, nil
+	case tree.PRIVILEGE_TYPE_STATIC_USAGE:
+		return 0, nil
+	}
+	return 0, NewMysqlError(ER_NOT_SUPPORTED_YET, fmt.Sprintf("the privilege '%s'", tree.String(p, dialect.MYSQL)))
+}
+
+/*
+privilegeObject is the database object the privileges are granted on.
+The empty Db means all the databases, the empty Table means all the tables of the Db.
+*/
+type privilegeObject struct {
+	Db    string `json:"db,omitempty"`
+	Table string `json:"table,omitempty"`
+}
+
+func newPrivilegeObject(db, table string) privilegeObject {
+	return privilegeObject{Db: strings.ToLower(db), Table: strings.ToLower(table)}
+}
+
+func (o privilegeObject) String() string {
+	db, table := o.Db, o.Table
+	if db == "" {
+		db = "*"
+	}
+	if table == "" {
+		table = "*"
+	}
+	return db + "." + table
+}
+
+// privilegeRequest is a privilege a statement needs
+type privilegeRequest struct {
+	privilege privilegeType
+	object    privilegeObject
+}
+
+/*
+account is a user or a role.
+The host part of the account is recorded but not matched yet,
+the user connects from any host.
+*/
+type account struct {
+	Name   string
+	Host   string
+	IsRole bool
+	//SHA1(SHA1(password)), empty if the user has no password
+	Auth []byte
+	//the authentication method, empty means mysql_native_password
+	Plugin string
+	//the SCRAM-SHA-256 secret for the postgresql clients, empty if the password is set by its hash
+	Scram string
+	//the roles granted to the account
+	Roles map[string]struct{}
+	//the privileges granted to the account
+	Privileges map[privilegeObject]privilegeType
+}
+
+func (a *account) kind() string {
+	if a.IsRole {
+		return "ROLE"
+	}
+	return "USER"
+}
+
+func (a *account) clone() *account {
+	c := *a
+	c.Roles = make(map[string]struct{}, len(a.Roles))
+	for r := range a.Roles {
+		c.Roles[r] = struct{}{}
+	}
+	c.Privileges = make(map[privilegeObject]privilegeType, len(a.Privileges))
+	for o, p := range a.Privileges {
+		c.Privileges[o] = p
+	}
+	return &c
+}
+
+type accountOp uint8
+
+const (
+	accountOpCreate accountOp = iota
+	accountOpDrop
+	accountOpSetPassword
+	accountOpGrantPrivilege
+	accountOpRevokePrivilege
+	accountOpGrantRole
+	accountOpRevokeRole
+)
+
+/*
+accountEvent is a change of the accounts.
+The storage engines can not delete the rows, so the accounts are kept
+as the log of their changes which is replayed in the order of Seq.
+*/
+type accountEvent struct {
+	Seq        int64           `json:"seq"`
+	Op         accountOp       `json:"op"`
+	Name       string          `json:"name"`
+	Host       string          `json:"host,omitempty"`
+	IsRole     bool            `json:"is_role,omitempty"`
+	Auth       []byte          `json:"auth,omitempty"`
+	Plugin     string          `json:"plugin,omitempty"`
+	Scram      string          `json:"scram,omitempty"`
+	Role       string          `json:"granted_role,omitempty"`
+	Object     privilegeObject `json:"object"`
+	Privileges privilegeType   `json:"privileges,omitempty"`
+}
+
+// accountSet is all the accounts keyed by the name
+type accountSet map[string]*account
+
+func (s accountSet) clone() accountSet {
+	c := make(accountSet, len(s))
+	for name, a := range s {
+		c[name] = a.clone()
+	}
+	return c
+}
+
+func (s accountSet) get(name string, isRole bool) (*account, error) {
+	a, ok := s[name]
+	if !ok || a.IsRole != isRole {
+		if isRole {
+			return nil, NewMysqlError(ER_UNKNOWN_AUTHID, name, "%")
+		}
+		return nil, NewMysqlError(ER_PASSWORD_NO_MATCH)
+	}
+	return a, nil
+}
+
+// reachable checks the role from is granted to the account to directly or indirectly
+func (s accountSet) reachable(from, to string) bool {
+	if from == to {
+		return true
+	}
+	a, ok := s[from]
+	if !ok {
+		return false
+	}
+	for r := range a.Roles {
+		if s.reachable(r, to) {
+			return true
+		}
+	}
+	return false
+}
+
+// apply validates the change against the accounts and makes it
+func (s accountSet) apply(ev *accountEvent) error {
+	switch ev.Op {
+	case accountOpCreate:
+		if _, ok := s[ev.Name]; ok {
+			return NewMysqlError(ER_CANNOT_USER, "CREATE "+(&account{IsRole: ev.IsRole}).kind(), ev.Name)
+		}
+		s[ev.Name] = &account{
+			Name:       ev.Name,
+			Host:       ev.Host,
+			IsRole:     ev.IsRole,
+			Auth:       ev.Auth,
+			Plugin:     ev.Plugin,
+			Scram:      ev.Scram,
+			Roles:      make(map[string]struct{}),
+			Privileges: make(map[privilegeObject]privilegeType),
+		}
+	case accountOpDrop:
+		a, ok := s[ev.Name]
+		if !ok || a.IsRole != ev.IsRole {
+			return NewMysqlError(ER_CANNOT_USER, "DROP "+(&account{IsRole: ev.IsRole}).kind(), ev.Name)
+		}
+		delete(s, ev.Name)
+		if a.IsRole {
+			for _, other := range s {
+				delete(other.Roles, ev.Name)
+			}
+		}
+	case accountOpSetPassword:
+		a, err := s.get(ev.Name, false)
+		if err != nil {
+			return err
+		}
+		a.Auth = ev.Auth
+		a.Scram = ev.Scram
+		if ev.Plugin != "" {
+			a.Plugin = ev.Plugin
+		}
+	case accountOpGrantPrivilege:
+		a, ok := s[ev.Name]
+		if !ok {
+			return NewMysqlError(ER_PASSWORD_NO_MATCH)
+		}
+		a.Privileges[ev.Object] |= ev.Privileges
+	case accountOpRevokePrivilege:
+		a, ok := s[ev.Name]
+		if !ok {
+			return NewMysqlError(ER_PASSWORD_NO_MATCH)
+		}
+		granted, ok := a.Privileges[ev.Object]
+		if !ok || granted&ev.Privileges != ev.Privileges {
+			return NewMysqlError(ER_NONEXISTING_GRANT, ev.Name, "%")
+		}
+		if granted &^= ev.Privileges; granted == 0 {
+			delete(a.Privileges, ev.Object)
+		} else {
+			a.Privileges[ev.Object] = granted
+		}
+	case accountOpGrantRole:
+		a, ok := s[ev.Name]
+		if !ok {
+			return NewMysqlError(ER_PASSWORD_NO_MATCH)
+		}
+		if _, err := s.get(ev.Role, true); err != nil {
+			return err
+		}
+		if s.reachable(ev.Role, ev.Name) {
+			return NewMysqlError(ER_ROLE_GRANTED_TO_ITSELF, ev.Name, ev.Role)
+		}
+		a.Roles[ev.Role] = struct{}{}
+	case accountOpRevokeRole:
+		a, ok := s[ev.Name]
+		if !ok {
+			return NewMysqlError(ER_PASSWORD_NO_MATCH)
+		}
+		delete(a.Roles, ev.Role)
+	default:
+		return fmt.Errorf("unknown account operation %d", ev.Op)
+	}
+	return nil
+}
+
+// granted returns the privileges on the object granted to the account and its roles
+func (s accountSet) granted(name string, obj privilegeObject, visited map[string]struct{}) privilegeType {
+	if _, ok := visited[name]; ok {
+		return 0
+	}
+	visited[name] = struct{}{}
+	a, ok := s[name]
+	if !ok {
+		return 0
+	}
+	p := a.Privileges[privilegeObject{}]
+	if obj.Db != "" {
+		p |= a.Privileges[privilegeObject{Db: obj.Db}]
+		if obj.Table != "" {
+			p |= a.Privileges[obj]
+		}
+	}
+	for r := range a.Roles {
+		p |= s.granted(r, obj, visited)
+	}
+	return p
+}
+
+// accountStore persists the changes of the accounts
+type accountStore interface {
+	//Load returns the changes after the seq
+	Load(after int64) ([]*accountEvent, error)
+	//Update passes the changes after the seq to fn and appends the changes fn returns.
+	//Both run in one transaction, so the seqs follow the last change seen by fn.
+	Update(after int64, fn func([]*accountEvent) ([]*accountEvent, error)) error
+}
+
+//the accounts changed by the other servers are visible after the interval at most
+const accountRefreshInterval = time.Second
+
+/*
+AccountManager keeps the users, the roles and their privileges.
+The user configured as the dump user is the superuser which has all the
+privileges and is not kept in the system catalog.
+*/
+type AccountManager struct {
+	sync.RWMutex
+	store accountStore
+
+	superuser     string
+	superPassword string
+
+	//the time the changes are replayed from the store last time
+	refreshed time.Time
+	seq       int64
+	accounts  accountSet
+
+	//SHA256(SHA256(password)) of the users authenticated with the caching_sha2_password
+	sha2Cache sync.Map
+}
+
+// sha2CacheEntry is the SHA256(SHA256(password)) of the password whose SHA1(SHA1(password)) is auth
+type sha2CacheEntry struct {
+	auth []byte
+	sha2 []byte
+}
+
+func NewAccountManager(pu *config.ParameterUnit) *AccountManager {
+	am := &AccountManager{
+		accounts: make(accountSet),
+	}
+	if pu.SV != nil {
+		am.superuser = pu.SV.GetDumpuser()
+		am.superPassword = pu.SV.GetDumppassword()
+	}
+	if pu.TxnClient != nil || pu.StorageEngine != nil {
+		am.store = newEngineAccountStore(pu)
+	}
+	return am
+}
+
+func (am *AccountManager) IsSuperuser(user string) bool {
+	return user == am.superuser
+}
+
+// replayLocked applies the changes made after the seq in their order
+func (am *AccountManager) replayLocked(events []*accountEvent) error {
+	sort.Slice(events, func(i, j int) bool { return events[i].Seq < events[j].Seq })
+	for _, ev := range events {
+		if ev.Seq <= am.seq {
+			continue
+		}
+		if err := am.accounts.apply(ev); err != nil {
+			return fmt.Errorf("replay account change %d failed. error:%v", ev.Seq, err)
+		}
+		am.seq = ev.Seq
+	}
+	return nil
+}
+
+/*
+load replays the changes made since the last time, the other servers sharing
+the store may have changed the accounts.
+*/
+func (am *AccountManager) load() error {
+	am.RLock()
+	fresh := time.Since(am.refreshed) < accountRefreshInterval
+	am.RUnlock()
+	if fresh {
+		return nil
+	}
+	am.Lock()
+	defer am.Unlock()
+	if time.Since(am.refreshed) < accountRefreshInterval {
+		return nil
+	}
+	if am.store != nil {
+		events, err := am.store.Load(am.seq)
+		if err != nil {
+			return err
+		}
+		if err = am.replayLocked(events); err != nil {
+			return err
+		}
+	}
+	am.refreshed = time.Now()
+	return nil
+}
+
+/*
+update makes the changes generated by fn.
+fn applies the changes to a copy of the accounts, they are persisted
+and visible only if all of them succeed.
+The changes made by the other servers are replayed in the same transaction
+before fn, so fn validates against the latest accounts and the seqs are
+allocated after the last one in the store. The seq is the primary key of
+the log, the concurrent update taking the same seqs fails on the commit.
+*/
+func (am *AccountManager) update(fn func(accountSet) ([]*accountEvent, error)) error {
+	am.Lock()
+	defer am.Unlock()
+	var accounts accountSet
+	makeEvents := func(newer []*accountEvent) ([]*accountEvent, error) {
+		if err := am.replayLocked(newer); err != nil {
+			return nil, err
+		}
+		accounts = am.accounts.clone()
+		events, err := fn(accounts)
+		if err != nil {
+			return nil, err
+		}
+		for i, ev := range events {
+			ev.Seq = am.seq + int64(i) + 1
+		}
+		return events, nil
+	}
+
+	var events []*accountEvent
+	var err error
+	if am.store != nil {
+		err = am.store.Update(am.seq, func(newer []*accountEvent) ([]*accountEvent, error) {
+			events, err = makeEvents(newer)
+			return events, err
+		})
+	} else {
+		events, err = makeEvents(nil)
+	}
+	if err != nil {
+		return err
+	}
+	am.refreshed = time.Now()
+	am.seq += int64(len(events))
+	am.accounts = accounts
+	return nil
+}
+
+/*
+GetAuthentication returns the authentication string of the user.
+false if there is not the user.
+*/
+func (am *AccountManager) GetAuthentication(user string) ([]byte, bool, error) {
+	if err := am.load(); err != nil {
+		return nil, false, err
+	}
+	am.RLock()
+	defer am.RUnlock()
+	a, ok := am.accounts[user]
+	if !ok || a.IsRole {
+		return nil, false, nil
+	}
+	return a.Auth, true, nil
+}
+
+/*
+GetScramSecret returns the SCRAM-SHA-256 secret of the user.
+empty if there is not the user or the password of the user is set by its hash.
+*/
+func (am *AccountManager) GetScramSecret(user string) (string, error) {
+	if err := am.load(); err != nil {
+		return "", err
+	}
+	am.RLock()
+	defer am.RUnlock()
+	a, ok := am.accounts[user]
+	if !ok || a.IsRole {
+		return "", nil
+	}
+	return a.Scram, nil
+}
+
+/*
+GetAuthPlugin returns the authentication method of the user.
+empty if there is not the user or the user has the default one.
+*/
+func (am *AccountManager) GetAuthPlugin(user string) (string, error) {
+	if err := am.load(); err != nil {
+		return "", err
+	}
+	am.RLock()
+	defer am.RUnlock()
+	a, ok := am.accounts[user]
+	if !ok || a.IsRole {
+		return "", nil
+	}
+	return a.Plugin, nil
+}
+
+/*
+getSha2Cache returns the SHA256(SHA256(password)) cached for the user by the full
+authentication of the caching_sha2_password.
+The entry is stale if the password has been changed since then.
+*/
+func (am *AccountManager) getSha2Cache(user string, auth []byte) ([]byte, bool) {
+	v, ok := am.sha2Cache.Load(user)
+	if !ok {
+		return nil, false
+	}
+	entry := v.(*sha2CacheEntry)
+	if !bytes.Equal(entry.auth, auth) {
+		return nil, false
+	}
+	return entry.sha2, true
+}
+
+func (am *AccountManager) putSha2Cache(user string, auth, sha2 []byte) {
+	am.sha2Cache.Store(user, &sha2CacheEntry{auth: auth, sha2: sha2})
+}
+
+// Check returns the error if the user has not the privileges the requests need
+func (am *AccountManager) Check(user string, reqs []privilegeRequest) error {
+	if am.IsSuperuser(user) || len(reqs) == 0 {
+		return nil
+	}
+	if err := am.load(); err != nil {
+		return err
+	}
+	am.RLock()
+	defer am.RUnlock()
+	for _, req := range reqs {
+		granted := am.accounts.granted(user, req.object, make(map[string]struct{}))
+		if granted&req.privilege == req.privilege {
+			continue
+		}
+		switch {
+		case req.object.Db == "":
+			return NewMysqlError(ER_SPECIFIC_ACCESS_DENIED_ERROR, (req.privilege &^ granted).String())
+		case req.object.Table == "":
+			return NewMysqlError(ER_DBACCESS_DENIED_ERROR, user, "%", req.object.Db)
+		default:
+			return NewMysqlError(ER_TABLEACCESS_DENIED_ERROR, (req.privilege &^ granted).String(), user, "%", req.object.Table)
+		}
+	}
+	return nil
+}
+
+/*
+makeAuthentication returns SHA1(SHA1(password)) kept for the user and the authentication method.
+The method is empty if the user does not specify it.
+The caching_sha2_password checks the password against the same hash in the full authentication.
+*/
+func makeAuthentication(u *tree.User) ([]byte, string, error) {
+	var plugin string
+	switch {
+	case u.AuthPlugin == "":
+	case strings.EqualFold(u.AuthPlugin, AuthNativePassword):
+		plugin = AuthNativePassword
+	case strings.EqualFold(u.AuthPlugin, AuthCachingSha2Password):
+		plugin = AuthCachingSha2Password
+	default:
+		return nil, "", NewMysqlError(ER_PLUGIN_IS_NOT_LOADED, u.AuthPlugin)
+	}
+	auth, err := makePasswordHash(u)
+	return auth, plugin, err
+}
+
+func makePasswordHash(u *tree.User) ([]byte, error) {
+	if u.HashString != "" {
+		//the hash of the mysql_native_password, '*' followed by the hex of SHA1(SHA1(password))
+		if len(u.HashString) != 41 || u.HashString[0] != '*' {
+			return nil, NewMysqlError(ER_PASSWORD_FORMAT)
+		}
+		auth, err := hex.DecodeString(u.HashString[1:])
+		if err != nil {
+			return nil, NewMysqlError(ER_PASSWORD_FORMAT)
+		}
+		return auth, nil
+	}
+	return hashPassword(u.AuthString), nil
+}
+
+/*
+makeScramSecret returns the SCRAM-SHA-256 secret of the password for the postgresql clients.
+It is empty if the user has no password or the password is given by its hash.
+*/
+func makeScramSecret(u *tree.User) (string, error) {
+	if u.HashString != "" || u.AuthString == "" {
+		return "", nil
+	}
+	v, err := newScramVerifier(u.AuthString)
+	if err != nil {
+		return "", err
+	}
+	return v.String(), nil
+}
+
+func hashPassword(password string) []byte {
+	if password == "" {
+		return nil
+	}
+	hash1 := sha1.Sum([]byte(password))
+	hash2 := sha1.Sum(hash1[:])
+	return hash2[:]
+}
+
+func (am *AccountManager) checkNotSuperuser(op string, name string) error {
+	if am.IsSuperuser(name) {
+		return NewMysqlError(ER_CANNOT_USER, op, name)
+	}
+	return nil
+}
+
+// CreateUser creates the users and grants them the roles
+func (am *AccountManager) CreateUser(stmt *tree.CreateUser) error {
+	return am.update(func(s accountSet) ([]*accountEvent, error) {
+		var events []*accountEvent
+		for _, u := range stmt.Users {
+			if err := am.checkNotSuperuser("CREATE USER", u.Username); err != nil {
+				return nil, err
+			}
+			if _, ok := s[u.Username]; ok && stmt.IfNotExists {
+				continue
+			}
+			auth, plugin, err := makeAuthentication(u)
+			if err != nil {
+				return nil, err
+			}
+			scram, err := makeScramSecret(u)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, &accountEvent{Op: accountOpCreate, Name: u.Username, Host: u.Hostname, Auth: auth, Plugin: plugin, Scram: scram})
+			for _, r := range stmt.Roles {
+				events = append(events, &accountEvent{Op: accountOpGrantRole, Name: u.Username, Role: r.UserName})
+			}
+		}
+		return events, applyEvents(s, events)
+	})
+}
+
+// CreateRole creates the roles
+func (am *AccountManager) CreateRole(stmt *tree.CreateRole) error {
+	return am.update(func(s accountSet) ([]*accountEvent, error) {
+		var events []*accountEvent
+		for _, r := range stmt.Roles {
+			if err := am.checkNotSuperuser("CREATE ROLE", r.UserName); err != nil {
+				return nil, err
+			}
+			if _, ok := s[r.UserName]; ok && stmt.IfNotExists {
+				continue
+			}
+			events = append(events, &accountEvent{Op: accountOpCreate, Name: r.UserName, Host: r.HostName, IsRole: true})
+		}
+		return events, applyEvents(s, events)
+	})
+}
+
+// DropUser drops the users
+func (am *AccountManager) DropUser(stmt *tree.DropUser) error {
+	return am.update(func(s accountSet) ([]*accountEvent, error) {
+		var events []*accountEvent
+		for _, u := range stmt.Users {
+			if err := am.checkNotSuperuser("DROP USER", u.Username); err != nil {
+				return nil, err
+			}
+			if a, ok := s[u.Username]; (!ok || a.IsRole) && stmt.IfExists {
+				continue
+			}
+			events = append(events, &accountEvent{Op: accountOpDrop, Name: u.Username})
+		}
+		return events, applyEvents(s, events)
+	})
+}
+
+// DropRole drops the roles and revokes them from the accounts
+func (am *AccountManager) DropRole(stmt *tree.DropRole) error {
+	return am.update(func(s accountSet) ([]*accountEvent, error) {
+		var events []*accountEvent
+		for _, r := range stmt.Roles {
+			if a, ok := s[r.UserName]; (!ok || !a.IsRole) && stmt.IfExists {
+				continue
+			}
+			events = append(events, &accountEvent{Op: accountOpDrop, Name: r.UserName, IsRole: true})
+		}
+		return events, applyEvents(s, events)
+	})
+}
+
+// SetPassword changes the passwords of the users
+func (am *AccountManager) SetPassword(users []*tree.User, ifExists bool) error {
+	return am.update(func(s accountSet) ([]*accountEvent, error) {
+		var events []*accountEvent
+		for _, u := range users {
+			if err := am.checkNotSuperuser("ALTER USER", u.Username); err != nil {
+				return nil, err
+			}
+			if a, ok := s[u.Username]; (!ok || a.IsRole) && ifExists {
+				continue
+			}
+			if !u.ByAuth && u.HashString == "" {
+				continue
+			}
+			auth, plugin, err := makeAuthentication(u)
+			if err != nil {
+				return nil, err
+			}
+			scram, err := makeScramSecret(u)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, &accountEvent{Op: accountOpSetPassword, Name: u.Username, Auth: auth, Plugin: plugin, Scram: scram})
+		}
+		return events, applyEvents(s, events)
+	})
+}
+
+/*
+Grant grants the privileges or the roles to the users and the roles.
+The privileges on a table of the current database db is granted if
+the GRANT specifies only the table name.
+*/
+func (am *AccountManager) Grant(stmt *tree.Grant, db string) error {
+	if stmt.IsProxy {
+		return NewMysqlError(ER_NOT_SUPPORTED_YET, "GRANT PROXY")
+	}
+	return am.update(func(s accountSet) ([]*accountEvent, error) {
+		var events []*accountEvent
+		if stmt.IsGrantRole {
+			for _, u := range stmt.Users {
+				for _, r := range stmt.RolesInGrantRole {
+					events = append(events, &accountEvent{Op: accountOpGrantRole, Name: u.Username, Role: r.UserName})
+				}
+			}
+			return events, applyEvents(s, events)
+		}
+		obj, privileges, err := convertGrant(stmt.Privileges, stmt.Level, db)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range stmt.Users {
+			if err = am.checkNotSuperuser("GRANT", u.Username); err != nil {
+				return nil, err
+			}
+			if privileges != 0 {
+				events = append(events, &accountEvent{Op: accountOpGrantPrivilege, Name: u.Username, Object: obj, Privileges: privileges})
+			}
+		}
+		return events, applyEvents(s, events)
+	})
+}
+
+// Revoke revokes the privileges or the roles from the users and the roles
+func (am *AccountManager) Revoke(stmt *tree.Revoke, db string) error {
+	return am.update(func(s accountSet) ([]*accountEvent, error) {
+		var events []*accountEvent
+		if stmt.IsRevokeRole {
+			for _, u := range stmt.Users {
+				for _, r := range stmt.RolesInRevokeRole {
+					events = append(events, &accountEvent{Op: accountOpRevokeRole, Name: u.Username, Role: r.UserName})
+				}
+			}
+			return events, applyEvents(s, events)
+		}
+		obj, privileges, err := convertGrant(stmt.Privileges, stmt.Level, db)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range stmt.Users {
+			if privileges == privilegeAll {
+				//REVOKE ALL revokes whatever is granted on the object
+				if a, ok := s[u.Username]; ok {
+					privileges = a.Privileges[obj]
+				}
+			}
+			if privileges != 0 {
+				events = append(events, &accountEvent{Op: accountOpRevokePrivilege, Name: u.Username, Object: obj, Privileges: privileges})
+			}
+		}
+		return events, applyEvents(s, events)
+	})
+}
+
+func applyEvents(s accountSet, events []*accountEvent) error {
+	for _, ev := range events {
+		if err := s.apply(ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func convertGrant(privileges []*tree.Privilege, level *tree.PrivilegeLevel, db string) (privilegeObject, privilegeType, error) {
+	var obj privilegeObject
+	switch level.Level {
+	case tree.PRIVILEGE_LEVEL_TYPE_GLOBAL:
+	case tree.PRIVILEGE_LEVEL_TYPE_DATABASE, tree.PRIVILEGE_LEVEL_TYPE_TABLE:
+		dbName := level.DbName
+		if dbName == "" {
+			dbName = db
+		}
+		if dbName == "" {
+			return obj, 0, NewMysqlError(ER_NO_DB_ERROR)
+		}
+		obj = newPrivilegeObject(dbName, level.TabName)
+	default:
+		return obj, 0, NewMysqlError(ER_NOT_SUPPORTED_YET, "the privilege level")
+	}
+	var p privilegeType
+	for _, privilege := range privileges {
+		typ, err := convertPrivilege(privilege)
+		if err != nil {
+			return obj, 0, err
+		}
+		p |= typ
+	}
+	return obj, p, nil
+}
+
+/*
+catalogLog is a table of the system database which keeps the records in json with
+their seqs. The storage engines can not delete the rows, so the records are appended
+and the table is only replaced as a whole.
+Every operation runs in its own transaction if the storage supports it.
+*/
+type catalogLog struct {
+	pu    *config.ParameterUnit
+	table string
+	//the column of the records and its width
+	column string
+	width  int32
+}
+
+func (l *catalogLog) run(fn func(engine.Engine) error) error {
+	if l.pu.TxnClient == nil {
+		return fn(l.pu.StorageEngine)
+	}
+	txn, err := l.pu.TxnClient.StartTxn(nil)
+	if err != nil {
+		return err
+	}
+	if err = fn(moengine.NewEngine(txn)); err != nil {
+		_ = txn.Rollback()
+		return err
+	}
+	return convertTxnError(txn.Commit())
+}
+
+func (l *catalogLog) attrs() []engine.Attribute {
+	return []engine.Attribute{
+		{Name: "seq", Type: types.Type{Oid: types.T_int64, Size: 8}},
+		{Name: l.column, Type: types.Type{Oid: types.T_varchar, Size: 24, Width: l.width}},
+	}
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// scan calls fn on the records after the seq
+func (l *catalogLog) scan(e engine.Engine, after int64, fn func([]byte) error) error {
+	if !containsName(e.Databases(), accountCatalogDB) {
+		return nil
+	}
+	db, err := e.Database(accountCatalogDB)
+	if err != nil {
+		return err
+	}
+	if !containsName(db.Relations(), l.table) {
+		return nil
+	}
+	rel, err := db.Relation(l.table)
+	if err != nil {
+		return err
+	}
+	defer rel.Close()
+	for _, rd := range rel.NewReader(1, nil, nil) {
+		for {
+			bat, err := rd.Read([]uint64{1, 1}, []string{"seq", l.column})
+			if err != nil {
+				return err
+			}
+			if bat == nil {
+				break
+			}
+			seqs := bat.Vecs[0].Col.([]int64)
+			data := bat.Vecs[1].Col.(*types.Bytes)
+			for i := range data.Lengths {
+				if seqs[i] <= after {
+					continue
+				}
+				if err = fn(data.Get(int64(i))); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// write appends the records with their seqs, the database and the table are created if they do not exist
+func (l *catalogLog) write(e engine.Engine, seqs []int64, records [][]byte) error {
+	attrs := l.attrs()
+	bat := batch.New(true, []string{attrs[0].Name, attrs[1].Name})
+	bat.Vecs[0] = vector.New(attrs[0].Type)
+	bat.Vecs[1] = vector.New(attrs[1].Type)
+	if err := vector.Append(bat.Vecs[0], seqs); err != nil {
+		return err
+	}
+	if err := vector.Append(bat.Vecs[1], records); err != nil {
+		return err
+	}
+	if !containsName(e.Databases(), accountCatalogDB) {
+		if err := e.Create(0, accountCatalogDB, 0); err != nil {
+			return err
+		}
+	}
+	db, err := e.Database(accountCatalogDB)
+	if err != nil {
+		return err
+	}
+	if !containsName(db.Relations(), l.table) {
+		defs := make([]engine.TableDef, 0, len(attrs)+1)
+		for _, attr := range attrs {
+			defs = append(defs, &engine.AttributeDef{Attr: attr})
+		}
+		//the records taking the same seq conflict
+		defs = append(defs, &engine.PrimaryIndexDef{Names: []string{attrs[0].Name}})
+		if err = db.Create(0, l.table, defs); err != nil {
+			return err
+		}
+	}
+	rel, err := db.Relation(l.table)
+	if err != nil {
+		return err
+	}
+	defer rel.Close()
+	return rel.Write(0, bat)
+}
+
+// load calls fn on the records after the seq
+func (l *catalogLog) load(after int64, fn func([]byte) error) error {
+	return l.run(func(e engine.Engine) error {
+		return l.scan(e, after, fn)
+	})
+}
+
+// append appends the records with their seqs
+func (l *catalogLog) append(seqs []int64, records [][]byte) error {
+	return l.run(func(e engine.Engine) error {
+		return l.write(e, seqs, records)
+	})
+}
+
+/*
+update passes the records after the seq to fn and appends the records fn returns
+with their seqs in one transaction.
+*/
+func (l *catalogLog) update(after int64, fn func([][]byte) ([]int64, [][]byte, error)) error {
+	return l.run(func(e engine.Engine) error {
+		var newer [][]byte
+		err := l.scan(e, after, func(data []byte) error {
+			newer = append(newer, data)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		seqs, records, err := fn(newer)
+		if err != nil || len(records) == 0 {
+			return err
+		}
+		return l.write(e, seqs, records)
+	})
+}
+
+/*
+rewrite passes all the records to fn and replaces them by the records fn returns
+with their seqs in one transaction, the table is kept if fn removes none of them.
+The storage engines can not delete the rows, so the table is dropped and created again.
+*/
+func (l *catalogLog) rewrite(fn func([][]byte) ([]int64, [][]byte, error)) error {
+	return l.run(func(e engine.Engine) error {
+		var all [][]byte
+		err := l.scan(e, 0, func(data []byte) error {
+			all = append(all, data)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		seqs, records, err := fn(all)
+		if err != nil || len(records) == len(all) {
+			return err
+		}
+		db, err := e.Database(accountCatalogDB)
+		if err != nil {
+			return err
+		}
+		if err = db.Delete(0, l.table); err != nil {
+			return err
+		}
+		if len(records) == 0 {
+			return nil
+		}
+		return l.write(e, seqs, records)
+	})
+}
+
+// engineAccountStore keeps the changes of the accounts in the table of the system database
+type engineAccountStore struct {
+	log *catalogLog
+}
+
+func newEngineAccountStore(pu *config.ParameterUnit) *engineAccountStore {
+	return &engineAccountStore{
+		log: &catalogLog{pu: pu, table: accountCatalogTable, column: "event", width: 4096},
+	}
+}
+
+func decodeAccountEvents(records [][]byte) ([]*accountEvent, error) {
+	events := make([]*accountEvent, len(records))
+	for i, data := range records {
+		events[i] = &accountEvent{}
+		if err := json.Unmarshal(data, events[i]); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+func (s *engineAccountStore) Load(after int64) ([]*accountEvent, error) {
+	var records [][]byte
+	err := s.log.load(after, func(data []byte) error {
+		records = append(records, data)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decodeAccountEvents(records)
+}
+
+func (s *engineAccountStore) Update(after int64, fn func([]*accountEvent) ([]*accountEvent, error)) error {
+	return s.log.update(after, func(newer [][]byte) ([]int64, [][]byte, error) {
+		events, err := decodeAccountEvents(newer)
+		if err != nil {
+			return nil, nil, err
+		}
+		if events, err = fn(events); err != nil {
+			return nil, nil, err
+		}
+		seqs := make([]int64, len(events))
+		data := make([][]byte, len(events))
+		for i, ev := range events {
+			seqs[i] = ev.Seq
+			if data[i], err = json.Marshal(ev); err != nil {
+				return nil, nil, err
+			}
+		}
+		return seqs, data, nil
+	})
+}
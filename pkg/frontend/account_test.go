@@ -0,0 +1,237 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func parseOne(t *testing.T, sql string) tree.Statement {
+	stmts, err := parsers.Parse(dialect.MYSQL, sql)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(stmts))
+	return stmts[0]
+}
+
+func Test_AccountManager(t *testing.T) {
+	tae := openTestTae(t)
+	defer tae.Close()
+
+	pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+	require.NoError(t, err)
+	pu.TxnClient = tae.TxnClient()
+
+	exec := func(am *AccountManager, sql string) error {
+		switch st := parseOne(t, sql).(type) {
+		case *tree.CreateUser:
+			return am.CreateUser(st)
+		case *tree.DropUser:
+			return am.DropUser(st)
+		case *tree.CreateRole:
+			return am.CreateRole(st)
+		case *tree.DropRole:
+			return am.DropRole(st)
+		case *tree.Grant:
+			return am.Grant(st, "db1")
+		case *tree.Revoke:
+			return am.Revoke(st, "db1")
+		case *tree.AlterUser:
+			return am.SetPassword(st.Users, st.IfExists)
+		}
+		panic(sql)
+	}
+	check := func(am *AccountManager, user, sql string) error {
+		return am.Check(user, statementPrivileges(parseOne(t, sql), "db1", user))
+	}
+
+	am := NewAccountManager(pu)
+	superuser := pu.SV.GetDumpuser()
+	require.True(t, am.IsSuperuser(superuser))
+	require.NoError(t, check(am, superuser, "drop database db1"))
+
+	require.NoError(t, exec(am, "create user u1 identified by '111', u2"))
+	require.Error(t, exec(am, "create user u1"))
+	require.NoError(t, exec(am, "create user if not exists u1"))
+	require.Error(t, exec(am, "create user "+superuser))
+	require.NoError(t, exec(am, "create role r1"))
+
+	auth, ok, err := am.GetAuthentication("u1")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, hashPassword("111"), auth)
+	auth, ok, err = am.GetAuthentication("u2")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Nil(t, auth)
+	_, ok, err = am.GetAuthentication("r1")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	//the privileges granted to the user
+	require.Error(t, check(am, "u1", "select * from t1"))
+	require.NoError(t, exec(am, "grant select, insert on t1 to u1"))
+	require.NoError(t, check(am, "u1", "select * from t1"))
+	require.NoError(t, check(am, "u1", "insert into db1.t1 select * from t1"))
+	require.Error(t, check(am, "u1", "insert into t1 select * from t2"))
+	require.Error(t, check(am, "u1", "select * from t1 where a in (select a from t2)"))
+	require.Error(t, check(am, "u1", "delete from t1"))
+	require.Error(t, check(am, "u1", "select * from db2.t1"))
+	err = check(am, "u1", "create user u3")
+	require.Equal(t, ER_SPECIFIC_ACCESS_DENIED_ERROR, err.(*MysqlError).ErrorCode)
+	require.Contains(t, err.Error(), "ALL PRIVILEGES")
+
+	//the privileges granted to the role
+	require.NoError(t, exec(am, "grant all on db1.* to r1"))
+	require.Error(t, check(am, "u2", "drop table t2"))
+	require.NoError(t, exec(am, "grant r1 to u2"))
+	require.NoError(t, check(am, "u2", "drop table t2"))
+	require.NoError(t, check(am, "u2", "with c as (select * from t2) select * from c join t3"))
+	require.Error(t, check(am, "u2", "drop database db2"))
+	require.Error(t, exec(am, "grant u2 to r1"))
+
+	//revoke
+	require.NoError(t, exec(am, "revoke insert on t1 from u1"))
+	require.Error(t, check(am, "u1", "insert into t1 values (1)"))
+	require.Error(t, exec(am, "revoke insert on t1 from u1"))
+	require.NoError(t, exec(am, "alter user u1 identified by '222'"))
+
+	require.NoError(t, exec(am, "create user u3 identified with caching_sha2_password by '333'"))
+	require.Error(t, exec(am, "create user u4 identified with sha256_password by '444'"))
+
+	//the accounts are replayed from the system catalog
+	am2 := NewAccountManager(pu)
+	plugin, err := am2.GetAuthPlugin("u3")
+	require.NoError(t, err)
+	require.Equal(t, AuthCachingSha2Password, plugin)
+	require.NoError(t, exec(am2, "alter user u3 identified by '444'"))
+	plugin, err = am2.GetAuthPlugin("u3")
+	require.NoError(t, err)
+	require.Equal(t, AuthCachingSha2Password, plugin)
+	plugin, err = am2.GetAuthPlugin("u1")
+	require.NoError(t, err)
+	require.Equal(t, "", plugin)
+	auth, ok, err = am2.GetAuthentication("u1")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, hashPassword("222"), auth)
+	require.NoError(t, check(am2, "u1", "select * from t1"))
+	require.Error(t, check(am2, "u1", "insert into t1 values (1)"))
+	require.NoError(t, check(am2, "u2", "update t2 set a = 1"))
+
+	//dropping the role revokes it
+	require.NoError(t, exec(am2, "drop role r1"))
+	require.Error(t, check(am2, "u2", "update t2 set a = 1"))
+	require.NoError(t, exec(am2, "drop user u1, u2, u3"))
+	require.Error(t, exec(am2, "drop user u1"))
+	require.NoError(t, exec(am2, "drop user if exists u1"))
+
+	am3 := NewAccountManager(pu)
+	_, ok, err = am3.GetAuthentication("u1")
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.NoError(t, exec(am3, "create role r1"))
+
+	//the servers sharing the store see the changes of each other
+	am4 := NewAccountManager(pu)
+	_, ok, err = am4.GetAuthentication("u5")
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.NoError(t, exec(am3, "create user u5"))
+	//the update validates against the changes of the other server
+	require.Error(t, exec(am4, "create user u5"))
+	require.NoError(t, exec(am4, "create user u6"))
+	//the reads see them after the refresh interval
+	_, ok, err = am3.GetAuthentication("u6")
+	require.NoError(t, err)
+	require.False(t, ok)
+	time.Sleep(accountRefreshInterval)
+	_, ok, err = am3.GetAuthentication("u6")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NoError(t, exec(am3, "drop user u6"))
+
+	//the seqs are not taken twice
+	events, err := newEngineAccountStore(pu).Load(0)
+	require.NoError(t, err)
+	seqs := make(map[int64]struct{})
+	for _, ev := range events {
+		_, dup := seqs[ev.Seq]
+		require.False(t, dup)
+		seqs[ev.Seq] = struct{}{}
+	}
+	am5 := NewAccountManager(pu)
+	_, ok, err = am5.GetAuthentication("u5")
+	require.NoError(t, err)
+	require.True(t, ok)
+	_, ok, err = am5.GetAuthentication("u6")
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func Test_checkPasswordHash(t *testing.T) {
+	mp := &MysqlProtocolImpl{}
+	salt := []byte("12345678901234567890")
+	password := []byte("111")
+
+	//the client sends SHA1(password) XOR SHA1(salt + SHA1(SHA1(password)))
+	hash1 := sha1.Sum(password)
+	hash2 := sha1.Sum(hash1[:])
+	hash3 := sha1.Sum(append(append([]byte{}, salt...), hash2[:]...))
+	auth := make([]byte, sha1.Size)
+	for i := range auth {
+		auth[i] = hash1[i] ^ hash3[i]
+	}
+	require.True(t, mp.checkPassword(password, salt, auth))
+	require.True(t, mp.checkPasswordHash(hashPassword("111"), salt, auth))
+	require.False(t, mp.checkPasswordHash(hashPassword("112"), salt, auth))
+	require.False(t, mp.checkPasswordHash(nil, salt, auth))
+	require.True(t, mp.checkPasswordHash(nil, salt, nil))
+}
+
+func Test_checkSha2PasswordHash(t *testing.T) {
+	mp := &MysqlProtocolImpl{}
+	salt := []byte("12345678901234567890")
+
+	//the client sends SHA256(password) XOR SHA256(SHA256(SHA256(password)) + salt)
+	hash1 := sha256.Sum256([]byte("111"))
+	hash2 := sha256.Sum256(hash1[:])
+	hash3 := sha256.Sum256(append(append([]byte{}, hash2[:]...), salt...))
+	auth := make([]byte, sha256.Size)
+	for i := range auth {
+		auth[i] = hash1[i] ^ hash3[i]
+	}
+	require.True(t, mp.checkSha2PasswordHash(hash2[:], salt, auth))
+	require.False(t, mp.checkSha2PasswordHash(hash2[:], []byte("09876543210987654321"), auth))
+	require.False(t, mp.checkSha2PasswordHash(hash2[:], salt, auth[1:]))
+
+	//the cached hash is stale after the password is changed
+	am := &AccountManager{}
+	am.putSha2Cache("u1", hashPassword("111"), hash2[:])
+	cached, ok := am.getSha2Cache("u1", hashPassword("111"))
+	require.True(t, ok)
+	require.Equal(t, hash2[:], cached)
+	_, ok = am.getSha2Cache("u1", hashPassword("222"))
+	require.False(t, ok)
+	_, ok = am.getSha2Cache("u2", hashPassword("111"))
+	require.False(t, ok)
+}
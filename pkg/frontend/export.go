@@ -221,8 +221,14 @@ func exportDataToCSVFile(oq *outputQueue) error {
 		}
 
 		switch mysqlColumn.ColumnType() {
-		case defines.MYSQL_TYPE_DECIMAL:
-			return fmt.Errorf("unsupported Decimal")
+		case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL:
+			if value, err2 := oq.mrs.GetString(0, i); err2 != nil {
+				return err2
+			} else {
+				if err = formatOutputString(oq, []byte(value), oq.ep.Symbol[i], oq.ep.Fields.EnclosedBy, oq.ep.ColumnFlag[i]); err != nil {
+					return err
+				}
+			}
 		case defines.MYSQL_TYPE_TINY, defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG, defines.MYSQL_TYPE_YEAR:
 			if value, err2 := oq.mrs.GetInt64(0, i); err2 != nil {
 				return err2
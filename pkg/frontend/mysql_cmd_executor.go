@@ -15,6 +15,7 @@
 package frontend
 
 import (
+	"encoding/binary"
 	"fmt"
 	"os"
 	"runtime/pprof"
@@ -27,6 +28,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2/explain"
+	"github.com/matrixorigin/matrixone/pkg/sql/stats"
 
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -38,6 +40,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -108,6 +111,9 @@ type outputQueue struct {
 	ep      *tree.ExportParam
 	lineStr []byte
 
+	//the rows are sent in the binary protocol
+	binary bool
+
 	getEmptyRowTime time.Duration
 	flushTime       time.Duration
 }
@@ -168,6 +174,12 @@ func (o *outputQueue) flush() error {
 			logutil.Errorf("export to csv file error %v \n", err)
 			return err
 		}
+	} else if o.binary {
+		//send group of row in the binary protocol
+		if err := o.proto.SendResultSetBinaryBatchRow(o.mrs, o.rowIdx); err != nil {
+			logutil.Errorf("flush error %v \n", err)
+			return err
+		}
 	} else {
 		//send group of row
 		if err := o.proto.SendResultSetTextBatchRowSpeedup(o.mrs, o.rowIdx); err != nil {
@@ -226,6 +238,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 	allocateOutBufferTime := time.Since(begin3)
 
 	oq := NewOuputQueue(proto, mrs, uint64(countOfResultSet), ses.ep)
+	oq.binary = uint8(ses.Cmd) == COM_STMT_EXECUTE
 	oq.reset()
 
 	row2colTime := time.Duration(0)
@@ -409,6 +422,18 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 						row[i] = vs.Get(int64(rowIndex))
 					}
 				}
+			case types.T_json:
+				if !nulls.Any(vec.Nsp) { //all data in this column are not null
+					vs := vec.Col.(*types.Bytes)
+					row[i] = bytejson.ByteJson(vs.Get(int64(rowIndex))).String()
+				} else {
+					if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
+						row[i] = nil
+					} else {
+						vs := vec.Col.(*types.Bytes)
+						row[i] = bytejson.ByteJson(vs.Get(int64(rowIndex))).String()
+					}
+				}
 			case types.T_date:
 				if !nulls.Any(vec.Nsp) { //all data in this column are not null
 					vs := vec.Col.([]types.Date)
@@ -434,16 +459,17 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 					}
 				}
 			case types.T_timestamp:
-				precision := vec.Typ.Precision
+				//the timestamps in UTC are shown in the time zone of the session
+				precision, loc := vec.Typ.Precision, ses.GetTimeZone()
 				if !nulls.Any(vec.Nsp) { //all data in this column are not null
 					vs := vec.Col.([]types.Timestamp)
-					row[i] = vs[rowIndex].String2(precision)
+					row[i] = vs[rowIndex].ToDatetime(loc).String2(precision)
 				} else {
 					if nulls.Contains(vec.Nsp, uint64(rowIndex)) { //is null
 						row[i] = nil
 					} else {
 						vs := vec.Col.([]types.Timestamp)
-						row[i] = vs[rowIndex].String2(precision)
+						row[i] = vs[rowIndex].ToDatetime(loc).String2(precision)
 					}
 				}
 			case types.T_decimal64:
@@ -528,7 +554,7 @@ func getDataFromPipeline(obj interface{}, bat *batch.Batch) error {
 func (mce *MysqlCmdExecutor) handleChangeDB(db string) error {
 	ses := mce.GetSession()
 	//TODO: check meta data
-	if _, err := ses.Pu.StorageEngine.Database(db); err != nil {
+	if _, err := ses.GetStorage().Database(db); err != nil {
 		//echo client. no such database
 		return NewMysqlError(ER_BAD_DB_ERROR, db)
 	}
@@ -557,7 +583,7 @@ func (mce *MysqlCmdExecutor) handleSelectDatabase(sel *tree.Select) error {
 	ses.Mrs.AddRow([]interface{}{val})
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	resp := NewResponse(ResultResponse, 0, ses.Cmd, mer)
 
 	if err = proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
@@ -584,7 +610,7 @@ func (mce *MysqlCmdExecutor) handleMaxAllowedPacket() error {
 	ses.Mrs.AddRow(data)
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	resp := NewResponse(ResultResponse, 0, ses.Cmd, mer)
 
 	if err := proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
@@ -610,7 +636,7 @@ func (mce *MysqlCmdExecutor) handleVersionComment() error {
 	ses.Mrs.AddRow(data)
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	resp := NewResponse(ResultResponse, 0, ses.Cmd, mer)
 
 	if err := proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
@@ -636,7 +662,7 @@ func (mce *MysqlCmdExecutor) handleTxIsolation() error {
 	ses.Mrs.AddRow(data)
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	resp := NewResponse(ResultResponse, 0, ses.Cmd, mer)
 
 	if err := proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
@@ -661,12 +687,21 @@ func (mce *MysqlCmdExecutor) handleSelectVariables(v string) error {
 		var data = make([]interface{}, 1)
 		data[0] = "REPEATABLE-READ"
 		ses.Mrs.AddRow(data)
+	} else if v == "time_zone" {
+		col := new(MysqlColumn)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		col.SetName("@@time_zone")
+		ses.Mrs.AddColumn(col)
+
+		var data = make([]interface{}, 1)
+		data[0] = types.TimeZoneName(ses.GetTimeZone())
+		ses.Mrs.AddRow(data)
 	} else {
 		return fmt.Errorf("unsupported system variable %s", v)
 	}
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	resp := NewResponse(ResultResponse, 0, ses.Cmd, mer)
 
 	if err := proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
@@ -724,7 +759,7 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 		loadDb = ses.protocol.GetDatabaseName()
 	}
 
-	dbHandler, err := ses.Pu.StorageEngine.Database(loadDb)
+	dbHandler, err := ses.GetStorage().Database(loadDb)
 	if err != nil {
 		//echo client. no such database
 		return NewMysqlError(ER_BAD_DB_ERROR, loadDb)
@@ -754,11 +789,16 @@ func (mce *MysqlCmdExecutor) handleLoadData(load *tree.Load) error {
 		return err
 	}
 
+	//the loaded data must be committed before the response
+	if err = ses.GetTxnHandler().CommitAfterStatement(nil); err != nil {
+		return err
+	}
+
 	/*
 		response
 	*/
 	info := NewMysqlError(ER_LOAD_INFO, result.Records, result.Deleted, result.Skipped, result.Warnings, result.WriteTimeout).Error()
-	resp := NewOkResponse(result.Records, 0, uint16(result.Warnings), 0, int(COM_QUERY), info)
+	resp := NewOkResponse(result.Records, 0, uint16(result.Warnings), int(ses.GetServerStatus()), int(COM_QUERY), info)
 	if err = proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
 	}
@@ -815,6 +855,9 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 		if err != nil {
 			return err
 		}
+		if c.Type.Oid == types.T_decimal64 || c.Type.Oid == types.T_decimal128 {
+			col.SetDecimal(uint8(c.Type.Scale))
+		}
 
 		/*
 			mysql CMD_FIELD_LIST response: send the column definition per column
@@ -837,14 +880,58 @@ func (mce *MysqlCmdExecutor) handleCmdFieldList(tableName string) error {
 	return err
 }
 
+/*
+setVariables assigns the user defined variables and the system variables the session supports.
+The other system variables are ignored.
+*/
+func setVariables(ses *Session, sv *tree.SetVar) error {
+	if sv != nil {
+		for _, assign := range sv.Assignments {
+			if !assign.System {
+				value, ok := valueOfLiteral(assign.Value)
+				if !ok {
+					return NewMysqlError(ER_WRONG_VALUE_FOR_VAR, assign.Name, tree.String(assign.Value, dialect.MYSQL))
+				}
+				ses.SetUserDefinedVar(assign.Name, value)
+			} else if strings.ToLower(assign.Name) == "autocommit" {
+				on, err := getBoolOfVariableValue(assign.Value)
+				if err != nil {
+					return NewMysqlError(ER_WRONG_VALUE_FOR_VAR, assign.Name, tree.String(assign.Value, dialect.MYSQL))
+				}
+				if err = ses.GetTxnHandler().SetAutocommit(on); err != nil {
+					return err
+				}
+			} else if strings.ToLower(assign.Name) == "snapshot_timestamp" {
+				ts, err := getSnapshotOfVariableValue(assign.Value, ses.GetTimeZone())
+				if err != nil {
+					return NewMysqlError(ER_WRONG_VALUE_FOR_VAR, assign.Name, tree.String(assign.Value, dialect.MYSQL))
+				}
+				ses.GetTxnHandler().SetSnapshot(ts)
+			} else if strings.ToLower(assign.Name) == "time_zone" {
+				loc, err := getTimeZoneOfVariableValue(assign.Value)
+				if err != nil {
+					return NewMysqlError(ER_UNKNOWN_TIME_ZONE, tree.String(assign.Value, dialect.MYSQL))
+				}
+				ses.SetTimeZone(loc)
+			}
+		}
+	}
+	return nil
+}
+
 /*
 handle setvar
 */
-func (mce *MysqlCmdExecutor) handleSetVar(_ *tree.SetVar) error {
+func (mce *MysqlCmdExecutor) handleSetVar(sv *tree.SetVar) error {
 	var err error = nil
-	proto := mce.GetSession().protocol
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	if err = setVariables(ses, sv); err != nil {
+		return err
+	}
 
-	resp := NewOkResponse(0, 0, 0, 0, int(COM_QUERY), "")
+	resp := NewOkResponse(0, 0, 0, int(ses.GetServerStatus()), int(COM_QUERY), "")
 	if err = proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
 	}
@@ -871,7 +958,7 @@ func (mce *MysqlCmdExecutor) handleShowVariables(_ *tree.ShowVariables) error {
 	ses.Mrs.AddColumn(col2)
 
 	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
-	resp := NewResponse(ResultResponse, 0, int(COM_QUERY), mer)
+	resp := NewResponse(ResultResponse, 0, ses.Cmd, mer)
 
 	if err := proto.SendResponse(resp); err != nil {
 		return fmt.Errorf("routine send response failed. error:%v ", err)
@@ -879,28 +966,512 @@ func (mce *MysqlCmdExecutor) handleShowVariables(_ *tree.ShowVariables) error {
 	return err
 }
 
+/*
+handle BEGIN, COMMIT and ROLLBACK
+*/
+func (mce *MysqlCmdExecutor) handleTxnStmt(stmt tree.Statement) error {
+	var err error = nil
+	ses := mce.GetSession()
+	proto := ses.protocol
+	txnHandler := ses.GetTxnHandler()
+
+	switch stmt.(type) {
+	case *tree.BeginTransaction:
+		err = txnHandler.BeginTxn()
+	case *tree.CommitTransaction:
+		err = txnHandler.CommitTxn()
+	case *tree.RollbackTransaction:
+		err = txnHandler.RollbackTxn()
+	}
+	if err != nil {
+		return err
+	}
+
+	resp := NewOkResponse(0, 0, 0, int(ses.GetServerStatus()), int(COM_QUERY), "")
+	if err = proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+/*
+handle PREPARE name FROM 'sql'
+*/
+func (mce *MysqlCmdExecutor) handlePrepareString(st *tree.PrepareString) error {
+	return mce.prepareByName(string(st.Name), st.Sql)
+}
+
+/*
+handle PREPARE name FROM @var
+*/
+func (mce *MysqlCmdExecutor) handlePrepareVar(st *tree.PrepareVar) error {
+	ses := mce.GetSession()
+	value := ses.GetUserDefinedVar(st.Var.Name)
+	if value == nil {
+		return NewMysqlError(ER_PARSE_ERROR, "NULL",
+			"You have an error in your SQL syntax; check the manual that corresponds to your MatrixOne server version for the right syntax to use")
+	}
+	sql, ok := value.(string)
+	if !ok {
+		sql = literalOfValue(value)
+	}
+	return mce.prepareByName(string(st.Name), sql)
+}
+
+func (mce *MysqlCmdExecutor) prepareByName(name string, sql string) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+
+	//the old one is deallocated even if the new one is invalid
+	ses.RemovePrepareStmt(name)
+	stmt, err := NewPrepareStmt(name, sql)
+	if err != nil {
+		return err
+	}
+	ses.SetPrepareStmt(name, stmt)
+
+	resp := NewOkResponse(0, 0, 0, int(ses.GetServerStatus()), int(COM_QUERY), "Statement prepared")
+	if err = proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+/*
+handle EXECUTE name USING @var
+*/
+func (mce *MysqlCmdExecutor) handleExecute(st *tree.Execute) error {
+	ses := mce.GetSession()
+	name := string(st.Name)
+	stmt, ok := ses.GetPrepareStmt(name)
+	if !ok {
+		return NewMysqlError(ER_UNKNOWN_STMT_HANDLER, len(name), name, "EXECUTE")
+	}
+
+	values := make([]interface{}, len(st.Variables))
+	for i, v := range st.Variables {
+		values[i] = ses.GetUserDefinedVar(v.Name)
+	}
+	return mce.doExecutePrepared(stmt, values)
+}
+
+/*
+checkPrivilege checks the current user has the privileges the statement needs.
+*/
+func (mce *MysqlCmdExecutor) checkPrivilege(stmt tree.Statement) error {
+	if mce.routineMgr == nil || mce.routineMgr.GetAccountManager() == nil {
+		return nil
+	}
+	proto := mce.GetSession().protocol
+	user := proto.GetUserName()
+	reqs := statementPrivileges(stmt, proto.GetDatabaseName(), user)
+	return mce.routineMgr.GetAccountManager().Check(user, reqs)
+}
+
+/*
+runAccountStmt changes the accounts by the statement of the user in the database.
+*/
+func runAccountStmt(am *AccountManager, stmt tree.Statement, user, db string) error {
+	var err error
+	switch st := stmt.(type) {
+	case *tree.CreateUser:
+		err = am.CreateUser(st)
+	case *tree.DropUser:
+		err = am.DropUser(st)
+	case *tree.AlterUser:
+		users := st.Users
+		if st.IsUserFunc {
+			//ALTER USER USER() changes the password of the current user
+			u := *st.UserFunc
+			u.Username = user
+			users = []*tree.User{&u}
+		}
+		err = am.SetPassword(users, st.IfExists)
+	case *tree.SetPassword:
+		u := &tree.User{Username: user, AuthString: st.Password, ByAuth: true}
+		if st.User != nil {
+			u.Username = st.User.Username
+		}
+		err = am.SetPassword([]*tree.User{u}, false)
+	case *tree.CreateRole:
+		err = am.CreateRole(st)
+	case *tree.DropRole:
+		err = am.DropRole(st)
+	case *tree.Grant:
+		err = am.Grant(st, db)
+	case *tree.Revoke:
+		err = am.Revoke(st, db)
+	}
+	return err
+}
+
+/*
+handle the account management:
+CREATE USER, DROP USER, ALTER USER, SET PASSWORD, CREATE ROLE, DROP ROLE, GRANT and REVOKE
+*/
+func (mce *MysqlCmdExecutor) handleAccountStmt(stmt tree.Statement) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+	if mce.routineMgr == nil || mce.routineMgr.GetAccountManager() == nil {
+		return NewMysqlError(ER_NOT_SUPPORTED_YET, "account management")
+	}
+	err := runAccountStmt(mce.routineMgr.GetAccountManager(), stmt, proto.GetUserName(), proto.GetDatabaseName())
+	if err != nil {
+		return err
+	}
+
+	resp := NewOkResponse(0, 0, 0, int(ses.GetServerStatus()), int(COM_QUERY), "")
+	if err = proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+/*
+handle DEALLOCATE PREPARE name
+*/
+func (mce *MysqlCmdExecutor) handleDeallocate(st *tree.Deallocate) error {
+	ses := mce.GetSession()
+	proto := ses.protocol
+	name := string(st.Name)
+	if _, ok := ses.GetPrepareStmt(name); !ok {
+		return NewMysqlError(ER_UNKNOWN_STMT_HANDLER, len(name), name, "DEALLOCATE PREPARE")
+	}
+	ses.RemovePrepareStmt(name)
+
+	resp := NewOkResponse(0, 0, 0, int(ses.GetServerStatus()), int(COM_QUERY), "")
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+/*
+handle COM_STMT_PREPARE
+*/
+func (mce *MysqlCmdExecutor) handleStmtPrepare(sql string) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+
+	stmt, err := NewPrepareStmt("", sql)
+	if err != nil {
+		return err
+	}
+	if err = mce.prepareColumns(stmt); err != nil {
+		return err
+	}
+	ses.AddBinaryStmt(stmt)
+	return proto.SendPrepareResponse(stmt)
+}
+
+/*
+prepareColumns gets the columns of the result set of the prepared SELECT from its plan.
+The columns stay unknown if the statement can not be planned before the execution,
+they are sent with the result set then.
+*/
+func (mce *MysqlCmdExecutor) prepareColumns(stmt *PrepareStmt) (retErr error) {
+	ses := mce.GetSession()
+	if _, ok := stmt.Stmt.(*tree.Select); !ok {
+		return nil
+	}
+	//the columns of the tables are not shown to the users who can not read them
+	if err := mce.checkPrivilege(stmt.Stmt); err != nil {
+		return err
+	}
+	if ses.GetStorage() == nil {
+		return nil
+	}
+	db := ses.GetMysqlProtocol().GetDatabaseName()
+	txnHandler := ses.GetTxnHandler()
+	if err := txnHandler.StartByStatement(); err != nil {
+		return err
+	}
+	defer func() {
+		retErr = txnHandler.CommitAfterStatement(retErr)
+	}()
+
+	var ctx plan2.CompilerContext = plan2.NewEngineCompilerContext(ses.GetStorage(), db)
+	if mce.routineMgr != nil && mce.routineMgr.GetStatsManager() != nil {
+		ctx = &statsCompilerContext{
+			CompilerContext: ctx,
+			stats:           mce.routineMgr.GetStatsManager(),
+			db:              db,
+		}
+	}
+	qry, err := plan2.BuildPlan(ctx, stmt.Stmt)
+	if err != nil {
+		logutil.Infof("the columns of the prepared statement are unknown: %v", err)
+		return nil
+	}
+	columns, err := columnsOfQuery(qry)
+	if err != nil {
+		logutil.Infof("the columns of the prepared statement are unknown: %v", err)
+		return nil
+	}
+	stmt.columns = columns
+	return nil
+}
+
+//columnsOfQuery returns the columns projected by the last step of the query
+func columnsOfQuery(qry *plan2.Query) ([]*MysqlColumn, error) {
+	if len(qry.Steps) == 0 {
+		return nil, nil
+	}
+	node := qry.Nodes[qry.Steps[len(qry.Steps)-1]]
+	columns := make([]*MysqlColumn, len(node.ProjectList))
+	for i, expr := range node.ProjectList {
+		col := new(MysqlColumn)
+		name := expr.Alias
+		//the columns unfolded from the star are qualified by the table
+		if j := strings.LastIndexByte(name, '.'); j >= 0 {
+			name = name[j+1:]
+		}
+		col.SetName(name)
+		//the type of the placeholder is known after the binding
+		if expr.Typ == nil {
+			col.SetColumnType(defines.MYSQL_TYPE_VAR_STRING)
+		} else if err := convertEngineTypeToMysqlType(types.T(expr.Typ.Id), col); err != nil {
+			return nil, err
+		}
+		columns[i] = col
+	}
+	return columns, nil
+}
+
+//getBinaryStmtOfRequest returns the statement with the id in the payload of the COM_STMT_XXX
+func (mce *MysqlCmdExecutor) getBinaryStmtOfRequest(data []byte, cmd string) (*PrepareStmt, error) {
+	if len(data) < 4 {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	stmtId := binary.LittleEndian.Uint32(data[:4])
+	stmt, ok := mce.GetSession().GetBinaryStmt(stmtId)
+	if !ok {
+		id := strconv.FormatUint(uint64(stmtId), 10)
+		return nil, NewMysqlError(ER_UNKNOWN_STMT_HANDLER, len(id), id, cmd)
+	}
+	return stmt, nil
+}
+
+/*
+handle COM_STMT_EXECUTE
+*/
+func (mce *MysqlCmdExecutor) handleStmtExecute(data []byte) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+
+	stmt, err := mce.getBinaryStmtOfRequest(data, "mysqld_stmt_execute")
+	if err != nil {
+		return err
+	}
+	values, err := proto.ParseExecuteData(stmt, data)
+	if err != nil {
+		return err
+	}
+	//the long data is only for one execution
+	stmt.ResetLongData()
+
+	mce.addSqlCount(1)
+	logutil.Infof("execute:%s", SubStringFromBegin(stmt.Sql, int(ses.Pu.SV.GetLengthOfQueryPrinted())))
+	return mce.doExecutePrepared(stmt, values)
+}
+
+/*
+handle COM_STMT_SEND_LONG_DATA
+*/
+func (mce *MysqlCmdExecutor) handleStmtSendLongData(data []byte) error {
+	stmt, err := mce.getBinaryStmtOfRequest(data, "mysqld_stmt_send_long_data")
+	if err != nil {
+		return err
+	}
+	//stmt_id int<4>, param_id int<2>, data string<EOF>
+	if len(data) < 6 {
+		return NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	paramId := binary.LittleEndian.Uint16(data[4:6])
+	if int(paramId) >= stmt.ParamCount() {
+		return NewMysqlError(ER_WRONG_ARGUMENTS, "mysqld_stmt_send_long_data")
+	}
+	stmt.AppendLongData(paramId, data[6:])
+	return nil
+}
+
+/*
+handle COM_STMT_CLOSE
+*/
+func (mce *MysqlCmdExecutor) handleStmtClose(data []byte) error {
+	stmt, err := mce.getBinaryStmtOfRequest(data, "mysqld_stmt_close")
+	if err != nil {
+		return err
+	}
+	mce.GetSession().RemoveBinaryStmt(stmt.Id)
+	return nil
+}
+
+/*
+handle COM_STMT_RESET
+*/
+func (mce *MysqlCmdExecutor) handleStmtReset(data []byte) error {
+	stmt, err := mce.getBinaryStmtOfRequest(data, "mysqld_stmt_reset")
+	if err != nil {
+		return err
+	}
+	stmt.ResetLongData()
+	return nil
+}
+
+/*
+handleAnalyzeStmt builds the statistics of the columns of the table by reading all its rows,
+the statistics are persisted and replace the ones built before.
+*/
 func (mce *MysqlCmdExecutor) handleAnalyzeStmt(stmt *tree.AnalyzeStmt) error {
-	// rewrite analyzeStmt to `select approx_count_distinct(col), .. from tbl`
-	// IMO, this approach is simple and future-proof
-	// Although this rewriting processing could have been handled in rewrite module,
-	// `handleAnalyzeStmt` can be easily managed by cron jobs in the future
-	ctx := tree.NewFmtCtx(dialect.MYSQL)
-	ctx.WriteString("select ")
-	for i, ident := range stmt.Cols {
-		if i > 0 {
-			ctx.WriteByte(',')
-		}
-		ctx.WriteString("approx_count_distinct(")
-		ctx.WriteString(string(ident))
-		ctx.WriteByte(')')
-	}
-	ctx.WriteString(" from ")
-	stmt.Table.Format(ctx)
-	sql := ctx.String()
-	return mce.doComQuery(sql)
-}
-
-func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	if mce.routineMgr == nil || mce.routineMgr.GetStatsManager() == nil {
+		return NewMysqlError(ER_NOT_SUPPORTED_YET, "ANALYZE TABLE")
+	}
+
+	dbName := string(stmt.Table.SchemaName)
+	if dbName == "" {
+		dbName = proto.GetDatabaseName()
+	}
+	if dbName == "" {
+		return NewMysqlError(ER_NO_DB_ERROR)
+	}
+	tblName := string(stmt.Table.ObjectName)
+	db, err := ses.GetStorage().Database(dbName)
+	if err != nil {
+		return NewMysqlError(ER_BAD_DB_ERROR, dbName)
+	}
+	rel, err := db.Relation(tblName)
+	if err != nil {
+		return NewMysqlError(ER_NO_SUCH_TABLE, dbName, tblName)
+	}
+	defer rel.Close()
+
+	//all the columns are analyzed if no column is specified
+	var attrs []engine.Attribute
+	for _, def := range rel.TableDefs() {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			attrs = append(attrs, attr.Attr)
+		}
+	}
+	if len(stmt.Cols) > 0 {
+		selected := make([]engine.Attribute, len(stmt.Cols))
+		for i, col := range stmt.Cols {
+			found := false
+			for _, attr := range attrs {
+				if strings.EqualFold(attr.Name, string(col)) {
+					selected[i], found = attr, true
+					break
+				}
+			}
+			if !found {
+				return NewMysqlError(ER_BAD_FIELD_ERROR, string(col), tblName)
+			}
+		}
+		attrs = selected
+	}
+
+	s, err := stats.Analyze(rel, dbName, tblName, attrs)
+	if err != nil {
+		return err
+	}
+	if err = mce.routineMgr.GetStatsManager().Update(s); err != nil {
+		return err
+	}
+
+	for _, name := range []string{"Table", "Op", "Msg_type", "Msg_text"} {
+		col := new(MysqlColumn)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		col.SetName(name)
+		ses.Mrs.AddColumn(col)
+	}
+	ses.Mrs.AddRow([]interface{}{dbName + "." + tblName, "analyze", "status", "OK"})
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, ses.Cmd, mer)
+	if err = proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+/*
+handleShowStats sends the statistics of the columns of the table,
+or the ones of all the analyzed tables if no table is specified.
+*/
+func (mce *MysqlCmdExecutor) handleShowStats(stmt *tree.ShowStats) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+	if mce.routineMgr == nil || mce.routineMgr.GetStatsManager() == nil {
+		return NewMysqlError(ER_NOT_SUPPORTED_YET, "SHOW STATS")
+	}
+	sm := mce.routineMgr.GetStatsManager()
+
+	var tables []*stats.TableStats
+	if stmt.Table != nil {
+		dbName := string(stmt.Table.SchemaName)
+		if dbName == "" {
+			dbName = proto.GetDatabaseName()
+		}
+		if dbName == "" {
+			return NewMysqlError(ER_NO_DB_ERROR)
+		}
+		s, err := sm.Get(dbName, string(stmt.Table.ObjectName))
+		if err != nil {
+			return err
+		}
+		if s != nil {
+			tables = append(tables, s)
+		}
+	} else {
+		var err error
+		if tables, err = sm.List(); err != nil {
+			return err
+		}
+	}
+
+	columns := []struct {
+		name string
+		typ  uint8
+	}{
+		{"Db_name", defines.MYSQL_TYPE_VARCHAR},
+		{"Table_name", defines.MYSQL_TYPE_VARCHAR},
+		{"Column_name", defines.MYSQL_TYPE_VARCHAR},
+		{"Data_type", defines.MYSQL_TYPE_VARCHAR},
+		{"Row_count", defines.MYSQL_TYPE_LONGLONG},
+		{"Null_count", defines.MYSQL_TYPE_LONGLONG},
+		{"Distinct_count", defines.MYSQL_TYPE_LONGLONG},
+		{"Min_value", defines.MYSQL_TYPE_VARCHAR},
+		{"Max_value", defines.MYSQL_TYPE_VARCHAR},
+		{"Buckets", defines.MYSQL_TYPE_LONGLONG},
+		{"Most_common_values", defines.MYSQL_TYPE_VARCHAR},
+	}
+	for _, c := range columns {
+		col := new(MysqlColumn)
+		col.SetColumnType(c.typ)
+		col.SetName(c.name)
+		ses.Mrs.AddColumn(col)
+	}
+	for _, s := range tables {
+		for _, col := range s.Columns {
+			ses.Mrs.AddRow(showStatsRow(s, col))
+		}
+	}
+
+	mer := NewMysqlExecutionResult(0, 0, 0, 0, ses.Mrs)
+	resp := NewResponse(ResultResponse, 0, ses.Cmd, mer)
+	if err := proto.SendResponse(resp); err != nil {
+		return fmt.Errorf("routine send response failed. error:%v ", err)
+	}
+	return nil
+}
+
+/*
+handleExplainStmt sends the plan of the statement, or runs the statement and sends
+the runtime statistics of its operators if the ANALYZE option is set.
+*/
+func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt, proc *process.Process, epoch uint64) error {
 	es := &explain.ExplainOptions{
 		Verbose: false,
 		Anzlyze: false,
@@ -931,6 +1502,8 @@ func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
 				es.Format = explain.EXPLAIN_FORMAT_TEXT
 			} else if strings.EqualFold(v.Value, "JSON") {
 				es.Format = explain.EXPLAIN_FORMAT_JSON
+			} else if strings.EqualFold(v.Value, "DOT") {
+				es.Format = explain.EXPLAIN_FORMAT_DOT
 			} else {
 				return errors.New(errno.InvalidOptionValue, fmt.Sprintf("unrecognized value for EXPLAIN option \"%s\": \"%s\"", v.Name, v.Value))
 			}
@@ -939,19 +1512,35 @@ func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
 		}
 	}
 
-	//get CompilerContext
-	ctx := plan2.NewMockCompilerContext()
-	qry, err := plan2.BuildPlan(ctx, stmt.Statement)
-	if err != nil {
-		//fmt.Sprintf("build Query statement error: '%v'", tree.String(stmt, dialect.MYSQL))
-		return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Build Query statement error:'%v'", tree.String(stmt.Statement, dialect.MYSQL)))
+	if es.Anzlyze && es.Format == explain.EXPLAIN_FORMAT_DOT {
+		return errors.New(errno.FeatureNotSupported, "EXPLAIN ANALYZE does not support the format dot")
 	}
 
 	// build explain data buffer
 	buffer := explain.NewExplainDataBuffer()
-	// generator query explain
-	explainQuery := explain.NewExplainQueryImpl(qry)
-	explainQuery.ExplainPlan(buffer, es)
+	if es.Anzlyze {
+		if err := mce.explainAnalyze(stmt.Statement, proc, epoch, buffer, es); err != nil {
+			return err
+		}
+	} else {
+		//get CompilerContext, the statistics of the analyzed tables are used by the optimizer
+		var ctx plan2.CompilerContext = plan2.NewMockCompilerContext()
+		if mce.routineMgr != nil && mce.routineMgr.GetStatsManager() != nil {
+			ctx = &statsCompilerContext{
+				CompilerContext: ctx,
+				stats:           mce.routineMgr.GetStatsManager(),
+				db:              mce.GetSession().GetMysqlProtocol().GetDatabaseName(),
+			}
+		}
+		qry, err := plan2.BuildPlan(ctx, stmt.Statement)
+		if err != nil {
+			//fmt.Sprintf("build Query statement error: '%v'", tree.String(stmt, dialect.MYSQL))
+			return errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Build Query statement error:'%v'", tree.String(stmt.Statement, dialect.MYSQL)))
+		}
+		// generator query explain
+		explainQuery := explain.NewExplainQueryImpl(qry)
+		explainQuery.ExplainPlan(buffer, es)
+	}
 
 	session := mce.GetSession()
 	protocol := session.GetMysqlProtocol()
@@ -1006,6 +1595,30 @@ func (mce *MysqlCmdExecutor) handleExplainStmt(stmt *tree.ExplainStmt) error {
 	return nil
 }
 
+/*
+explainAnalyze runs the statement with the runtime statistics of the operators collected,
+and writes the operators into the buffer. The results of the statement are discarded.
+*/
+func (mce *MysqlCmdExecutor) explainAnalyze(stmt tree.Statement, proc *process.Process, epoch uint64, buffer *explain.ExplainDataBuffer, es *explain.ExplainOptions) error {
+	ses := mce.GetSession()
+	proto := ses.GetMysqlProtocol()
+
+	exec := compile.New(proto.GetDatabaseName(), tree.String(stmt, dialect.MYSQL), proto.GetUserName(), ses.GetStorage(), proc).BuildStatements([]tree.Statement{stmt})[0]
+	//the process is shared with the following statements
+	exec.SetAnalyze(true)
+	defer exec.SetAnalyze(false)
+	if err := exec.Compile(ses, func(interface{}, *batch.Batch) error {
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := exec.Run(epoch); err != nil {
+		return err
+	}
+	explain.NewExplainAnalyzeImpl(nil, exec.Analysis()).ExplainAnalyze(buffer, es)
+	return nil
+}
+
 func GetExplainColumns(attrs []*plan.Attribute) ([]interface{}, error) {
 	//attrs := plan.BuildExplainResultColumns()
 	cols := make([]*compile.Col, len(attrs))
@@ -1118,11 +1731,64 @@ var GetComputationWrapper = func(db, sql, user string, eng engine.Engine, proc *
 	return cw, err
 }
 
+/*
+GetPreparedComputationWrapper gets the execs of the prepared statement from the computation engine.
+The statement is not parsed again, the params are bound to its placeholders.
+*/
+var GetPreparedComputationWrapper = func(db string, stmt *PrepareStmt, params []tree.Expr, user string, eng engine.Engine, proc *process.Process) ([]ComputationWrapper, error) {
+	comp := compile.New(db, stmt.Sql, user, eng, proc)
+	comp.SetParams(params)
+	execs := comp.BuildStatements([]tree.Statement{stmt.Stmt})
+
+	var cw []ComputationWrapper = nil
+	for _, e := range execs {
+		cw = append(cw, NewComputationWrapperImpl(e))
+	}
+	return cw, nil
+}
+
 //execute query
 func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
+	return mce.executeStatements(func(proc *process.Process) ([]ComputationWrapper, error) {
+		ses := mce.GetSession()
+		proto := ses.GetMysqlProtocol()
+		cws, err := GetComputationWrapper(proto.GetDatabaseName(),
+			sql,
+			proto.GetUserName(),
+			ses.GetStorage(),
+			proc)
+		if err != nil {
+			return nil, NewMysqlError(ER_PARSE_ERROR, err,
+				"You have an error in your SQL syntax; check the manual that corresponds to your MatrixOne server version for the right syntax to use")
+		}
+		return cws, nil
+	})
+}
+
+//execute the prepared statement with the values of the parameters
+func (mce *MysqlCmdExecutor) doExecutePrepared(stmt *PrepareStmt, values []interface{}) error {
+	params, err := stmt.BindParams(values)
+	if err != nil {
+		return err
+	}
+	return mce.executeStatements(func(proc *process.Process) ([]ComputationWrapper, error) {
+		ses := mce.GetSession()
+		proto := ses.GetMysqlProtocol()
+		return GetPreparedComputationWrapper(proto.GetDatabaseName(),
+			stmt,
+			params,
+			proto.GetUserName(),
+			ses.GetStorage(),
+			proc)
+	})
+}
+
+//executeStatements runs the statements built by the function in the process of the query
+func (mce *MysqlCmdExecutor) executeStatements(build func(*process.Process) ([]ComputationWrapper, error)) (retErr error) {
 	ses := mce.GetSession()
 	proto := ses.GetMysqlProtocol()
 	pdHook := ses.GetEpochgc()
+	txnHandler := ses.GetTxnHandler()
 	statementCount := uint64(1)
 
 	//pin the epoch with 1
@@ -1137,23 +1803,27 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
 	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
 
-	cws, err := GetComputationWrapper(proto.GetDatabaseName(),
-		sql,
-		proto.GetUserName(),
-		ses.Pu.StorageEngine,
-		proc)
+	cws, err := build(proc)
 	if err != nil {
-		return NewMysqlError(ER_PARSE_ERROR, err,
-			"You have an error in your SQL syntax; check the manual that corresponds to your MatrixOne server version for the right syntax to use")
+		return err
 	}
 
 	defer func() {
 		ses.Mrs = nil
 	}()
 
+	//the failed statement finishes its transaction
+	defer func() {
+		if retErr != nil {
+			retErr = txnHandler.CommitAfterStatement(retErr)
+		}
+	}()
+
 	for _, cw := range cws {
 		ses.Mrs = &MysqlResultSet{}
 		stmt := cw.GetAst()
+		//the statement is compiled in the time zone set by the previous statements
+		proc.TimeZone = ses.GetTimeZone()
 		//temp try 0 epoch
 		pdHook.IncQueryCountAtEpoch(epoch, 1)
 		statementCount++
@@ -1202,6 +1872,14 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 								return err
 							}
 
+							//next statement
+							continue
+						} else if strings.ToLower(ve.Name) == "time_zone" {
+							err = mce.handleSelectVariables("time_zone")
+							if err != nil {
+								return err
+							}
+
 							//next statement
 							continue
 						}
@@ -1216,7 +1894,11 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			switch t := stmt.(type) {
 			case *tree.ShowDatabases, *tree.CreateDatabase, *tree.ShowCreateDatabase, *tree.ShowWarnings, *tree.ShowErrors,
 				*tree.ShowStatus, *tree.DropDatabase, *tree.Load,
-				*tree.Use, *tree.SetVar:
+				*tree.Use, *tree.SetVar,
+				*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+				*tree.PrepareString, *tree.PrepareVar, *tree.Execute, *tree.Deallocate,
+				*tree.CreateUser, *tree.DropUser, *tree.AlterUser, *tree.SetPassword,
+				*tree.CreateRole, *tree.DropRole, *tree.Grant, *tree.Revoke:
 			case *tree.ShowColumns:
 				if t.Table.ToTableName().SchemaName == "" {
 					return NewMysqlError(ER_NO_DB_ERROR)
@@ -1230,6 +1912,54 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			}
 		}
 
+		if err = mce.checkPrivilege(stmt); err != nil {
+			return err
+		}
+
+		switch st := stmt.(type) {
+		case *tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction:
+			if err = mce.handleTxnStmt(stmt); err != nil {
+				return err
+			}
+
+			//next statement
+			continue
+		case *tree.PrepareString:
+			if err = mce.handlePrepareString(st); err != nil {
+				return err
+			}
+
+			//next statement
+			continue
+		case *tree.PrepareVar:
+			if err = mce.handlePrepareVar(st); err != nil {
+				return err
+			}
+
+			//next statement
+			continue
+		case *tree.Execute:
+			//the prepared statement runs like the query
+			if err = mce.handleExecute(st); err != nil {
+				return err
+			}
+
+			//next statement
+			continue
+		case *tree.Deallocate:
+			if err = mce.handleDeallocate(st); err != nil {
+				return err
+			}
+
+			//next statement
+			continue
+		}
+
+		//the statement runs in the active transaction or a new one
+		if err = txnHandler.StartByStatement(); err != nil {
+			return err
+		}
+
 		var selfHandle = false
 
 		switch st := stmt.(type) {
@@ -1239,14 +1969,17 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			if err != nil {
 				return err
 			}
-			err = proto.sendOKPacket(0, 0, 0, 0, "")
+			if err = txnHandler.CommitAfterStatement(nil); err != nil {
+				return err
+			}
+			err = proto.sendOKPacket(0, 0, ses.GetServerStatus(), 0, "")
 			if err != nil {
 				return err
 			}
 		case *tree.DropDatabase:
 			// if the droped database is the same as the one in use, database must be reseted to empty.
 			if string(st.Name) == proto.GetDatabaseName() {
-				proto.SetUserName("")
+				proto.SetDatabaseName("")
 			}
 		case *tree.Load:
 			selfHandle = true
@@ -1266,22 +1999,41 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			if err != nil {
 				return err
 			}
+		case *tree.CreateUser, *tree.DropUser, *tree.AlterUser,
+			*tree.CreateRole, *tree.DropRole,
+			*tree.Grant, *tree.Revoke, *tree.SetPassword:
+			selfHandle = true
+			if err = mce.handleAccountStmt(st); err != nil {
+				return err
+			}
 		case *tree.AnalyzeStmt:
 			selfHandle = true
 			if err = mce.handleAnalyzeStmt(st); err != nil {
 				return err
 			}
+		case *tree.ShowStats:
+			selfHandle = true
+			if err = mce.handleShowStats(st); err != nil {
+				return err
+			}
 		case *tree.ExplainStmt:
 			selfHandle = true
-			if err = mce.handleExplainStmt(st); err != nil {
+			if err = mce.handleExplainStmt(st, proc, epoch); err != nil {
 				return err
 			}
 		case *tree.ExplainAnalyze:
 			selfHandle = true
-			return errors.New(errno.FeatureNotSupported, "not support explain analyze statement now")
+			explainStmt := tree.NewExplainStmt(st.Statement, "text")
+			explainStmt.Options = append(tree.MakeOptions(tree.MakeOptionElem("analyze", "NULL")), st.Options...)
+			if err = mce.handleExplainStmt(explainStmt, proc, epoch); err != nil {
+				return err
+			}
 		}
 
 		if selfHandle {
+			if err = txnHandler.CommitAfterStatement(nil); err != nil {
+				return err
+			}
 			continue
 		}
 		if err = cw.SetDatabaseName(proto.GetDatabaseName()); err != nil {
@@ -1371,26 +2123,25 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			if ses.Pu.SV.GetRecordTimeElapsedOfSqlRequest() {
 				logutil.Infof("time of Exec.Run : %s", time.Since(runBegin).String())
 			}
+			if err = txnHandler.CommitAfterStatement(nil); err != nil {
+				return err
+			}
 			/*
 				Step 3: Say goodbye
 				mysql COM_QUERY response: End after the data row has been sent.
 				After all row data has been sent, it sends the EOF or OK packet.
 			*/
-			err = proto.sendEOFOrOkPacket(0, 0)
+			err = proto.sendEOFOrOkPacket(0, ses.GetServerStatus())
 			if err != nil {
 				return err
 			}
 		//just status, no result set
 		case *tree.CreateTable, *tree.DropTable, *tree.CreateDatabase, *tree.DropDatabase,
-			*tree.CreateIndex, *tree.DropIndex,
+			*tree.CreateIndex, *tree.DropIndex, *tree.AlterTable,
 			*tree.Insert, *tree.Update,
-			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
 			*tree.SetVar,
 			*tree.Load,
-			*tree.CreateUser, *tree.DropUser, *tree.AlterUser,
-			*tree.CreateRole, *tree.DropRole,
-			*tree.Revoke, *tree.Grant,
-			*tree.SetDefaultRole, *tree.SetRole, *tree.SetPassword,
+			*tree.SetDefaultRole, *tree.SetRole,
 			*tree.Delete:
 			runBegin := time.Now()
 			/*
@@ -1402,14 +2153,19 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 			if ses.Pu.SV.GetRecordTimeElapsedOfSqlRequest() {
 				logutil.Infof("time of Exec.Run : %s", time.Since(runBegin).String())
 			}
+			if err = txnHandler.CommitAfterStatement(nil); err != nil {
+				return err
+			}
 
 			//record ddl drop xxx after the success
 			switch stmt.(type) {
-			case *tree.DropTable, *tree.DropDatabase,
-				*tree.DropIndex, *tree.DropUser, *tree.DropRole:
+			case *tree.DropTable, *tree.DropDatabase, *tree.DropIndex:
 				//test ddl
 				pdHook.IncDDLCountAtEpoch(epoch, 1)
 			}
+			if mce.routineMgr != nil && mce.routineMgr.GetStatsManager() != nil {
+				invalidateStats(mce.routineMgr.GetStatsManager(), stmt, proto.GetDatabaseName())
+			}
 
 			/*
 				Step 2: Echo client
@@ -1418,7 +2174,7 @@ func (mce *MysqlCmdExecutor) doComQuery(sql string) error {
 				cw.GetAffectedRows(),
 				0,
 				0,
-				0,
+				int(ses.GetServerStatus()),
 				int(COM_QUERY),
 				nil,
 			)
@@ -1441,6 +2197,7 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 	logutil.Infof("cmd %v", req.GetCmd())
 
 	ses := mce.GetSession()
+	ses.Cmd = req.GetCmd()
 	if ses.Pu.SV.GetRejectWhenHeartbeatFromPDLeaderIsTimeout() {
 		pdHook := ses.GetEpochgc()
 		if !pdHook.CanAcceptSomething() {
@@ -1492,7 +2249,11 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 		return resp, nil
 	case COM_INIT_DB:
 		var dbname = string(req.GetData().([]byte))
-		err := mce.handleChangeDB(dbname)
+		txnHandler := ses.GetTxnHandler()
+		err := txnHandler.StartByStatement()
+		if err == nil {
+			err = txnHandler.CommitAfterStatement(mce.handleChangeDB(dbname))
+		}
 		if err != nil {
 			resp = NewGeneralErrorResponse(COM_INIT_DB, err)
 		} else {
@@ -1522,6 +2283,43 @@ func (mce *MysqlCmdExecutor) ExecRequest(req *Request) (*Response, error) {
 	case COM_PING:
 		resp = NewGeneralOkResponse(COM_PING)
 
+		return resp, nil
+	case COM_STMT_PREPARE:
+		var sql = string(req.GetData().([]byte))
+		mce.addSqlCount(1)
+		logutil.Infof("prepare:%s", SubStringFromBegin(sql, int(ses.Pu.SV.GetLengthOfQueryPrinted())))
+		err := mce.handleStmtPrepare(sql)
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_PREPARE, err)
+		}
+		return resp, nil
+	case COM_STMT_EXECUTE:
+		err := mce.handleStmtExecute(req.GetData().([]byte))
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_EXECUTE, err)
+		}
+		return resp, nil
+	case COM_STMT_SEND_LONG_DATA:
+		//no response
+		err := mce.handleStmtSendLongData(req.GetData().([]byte))
+		if err != nil {
+			logutil.Errorf("send long data failed. error:%v", err)
+		}
+		return resp, nil
+	case COM_STMT_CLOSE:
+		//no response
+		err := mce.handleStmtClose(req.GetData().([]byte))
+		if err != nil {
+			logutil.Errorf("close statement failed. error:%v", err)
+		}
+		return resp, nil
+	case COM_STMT_RESET:
+		err := mce.handleStmtReset(req.GetData().([]byte))
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_RESET, err)
+		} else {
+			resp = NewGeneralOkResponse(COM_STMT_RESET)
+		}
 		return resp, nil
 	default:
 		err := fmt.Errorf("unsupported command. 0x%x \n", req.GetCmd())
@@ -1541,6 +2339,42 @@ func (mce *MysqlCmdExecutor) Close() {
 	}
 }
 
+/*
+getBoolOfVariableValue gets the boolean of the value like 1, 0, ON, OFF, TRUE and FALSE
+*/
+func getBoolOfVariableValue(value tree.Expr) (bool, error) {
+	switch strings.ToLower(tree.String(value, dialect.MYSQL)) {
+	case "1", "on", "true":
+		return true, nil
+	case "0", "off", "false":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean value %s", tree.String(value, dialect.MYSQL))
+}
+
+//getSnapshotOfVariableValue returns the time of the snapshot in the time zone loc. the empty string means the latest data.
+func getSnapshotOfVariableValue(value tree.Expr, loc *time.Location) (time.Time, error) {
+	v, ok := valueOfLiteral(value)
+	s, isString := v.(string)
+	if !ok || !isString {
+		return time.Time{}, fmt.Errorf("invalid snapshot timestamp %s", tree.String(value, dialect.MYSQL))
+	}
+	if s == "" {
+		return time.Time{}, nil
+	}
+	return compile.ParseSnapshotTimestamp(s, loc)
+}
+
+// getTimeZoneOfVariableValue returns the location of the time_zone like '+08:00', 'UTC' or 'SYSTEM'
+func getTimeZoneOfVariableValue(value tree.Expr) (*time.Location, error) {
+	v, ok := valueOfLiteral(value)
+	s, isString := v.(string)
+	if !ok || !isString {
+		return nil, fmt.Errorf("invalid time zone %s", tree.String(value, dialect.MYSQL))
+	}
+	return types.ParseTimeZone(s)
+}
+
 func NewMysqlCmdExecutor() *MysqlCmdExecutor {
 	return &MysqlCmdExecutor{}
 }
@@ -1578,6 +2412,8 @@ func convertEngineTypeToMysqlType(engineType types.T, col *MysqlColumn) error {
 		col.SetColumnType(defines.MYSQL_TYPE_STRING)
 	case types.T_varchar:
 		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+	case types.T_json:
+		col.SetColumnType(defines.MYSQL_TYPE_JSON)
 	case types.T_date:
 		col.SetColumnType(defines.MYSQL_TYPE_DATE)
 	case types.T_datetime:
@@ -1585,9 +2421,9 @@ func convertEngineTypeToMysqlType(engineType types.T, col *MysqlColumn) error {
 	case types.T_timestamp:
 		col.SetColumnType(defines.MYSQL_TYPE_TIMESTAMP)
 	case types.T_decimal64:
-		col.SetColumnType(defines.MYSQL_TYPE_DECIMAL)
+		col.SetColumnType(defines.MYSQL_TYPE_NEWDECIMAL)
 	case types.T_decimal128:
-		col.SetColumnType(defines.MYSQL_TYPE_DECIMAL)
+		col.SetColumnType(defines.MYSQL_TYPE_NEWDECIMAL)
 	default:
 		return fmt.Errorf("RunWhileSend : unsupported type %d \n", engineType)
 	}
@@ -3,6 +3,7 @@ package frontend
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/fagongzi/goetty/buf"
 	"github.com/golang/mock/gomock"
@@ -15,6 +16,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/compile"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
@@ -639,6 +641,31 @@ func Test_mce_selfhandle(t *testing.T) {
 		err = mce.handleSetVar(nil)
 		convey.So(err, convey.ShouldBeNil)
 
+		for sql, zone := range map[string]string{
+			"set time_zone = '+08:00'":          "+08:00",
+			"set @@time_zone = 'Asia/Shanghai'": "Asia/Shanghai",
+			"set session time_zone = 'system'":  "SYSTEM",
+		} {
+			stmts, err := parsers.Parse(dialect.MYSQL, sql)
+			convey.So(err, convey.ShouldBeNil)
+			err = mce.handleSetVar(stmts[0].(*tree.SetVar))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(types.TimeZoneName(ses.GetTimeZone()), convey.ShouldEqual, zone)
+		}
+		stmts, err := parsers.Parse(dialect.MYSQL, "set time_zone = 'Mars/Olympus'")
+		convey.So(err, convey.ShouldBeNil)
+		err = mce.handleSetVar(stmts[0].(*tree.SetVar))
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//the snapshot timestamp is in the time zone of the session
+		stmts, err = parsers.Parse(dialect.MYSQL, "set time_zone = '+08:00'; set snapshot_timestamp = '2022-05-01 10:00:00'")
+		convey.So(err, convey.ShouldBeNil)
+		for _, stmt := range stmts {
+			convey.So(mce.handleSetVar(stmt.(*tree.SetVar)), convey.ShouldBeNil)
+		}
+		convey.So(ses.GetTxnHandler().GetSnapshot().Equal(time.Date(2022, 5, 1, 2, 0, 0, 0, time.UTC)), convey.ShouldBeTrue)
+		ses.GetTxnHandler().SetSnapshot(time.Time{})
+
 		req := &Request{
 			cmd:  int(COM_FIELD_LIST),
 			data: []byte{'A', 0},
@@ -809,6 +836,9 @@ func Test_typeconvert(t *testing.T) {
 			types.T_varchar,
 			types.T_date,
 			types.T_datetime,
+			types.T_json,
+			types.T_decimal64,
+			types.T_decimal128,
 		}
 
 		type kase struct {
@@ -830,6 +860,9 @@ func Test_typeconvert(t *testing.T) {
 			{tp: defines.MYSQL_TYPE_VARCHAR, signed: true},
 			{tp: defines.MYSQL_TYPE_DATE, signed: true},
 			{tp: defines.MYSQL_TYPE_DATETIME, signed: true},
+			{tp: defines.MYSQL_TYPE_JSON, signed: true},
+			{tp: defines.MYSQL_TYPE_NEWDECIMAL, signed: true},
+			{tp: defines.MYSQL_TYPE_NEWDECIMAL, signed: true},
 		}
 
 		convey.So(len(input), convey.ShouldEqual, len(output))
@@ -932,6 +965,10 @@ func Test_handleSelectVariables(t *testing.T) {
 
 		convey.So(mce.handleSelectVariables(v), convey.ShouldBeNil)
 
+		v = "time_zone"
+		ses.Mrs = &MysqlResultSet{}
+		convey.So(mce.handleSelectVariables(v), convey.ShouldBeNil)
+
 		v = ""
 		convey.So(mce.handleSelectVariables(v), convey.ShouldNotBeNil)
 
@@ -17,8 +17,11 @@ package frontend
 import (
 	"bytes"
 	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/tls"
 	"encoding/binary"
 	"fmt"
+	"math"
 	"math/rand"
 	"strconv"
 	"time"
@@ -124,6 +127,16 @@ const (
 
 	AuthNativePassword string = "mysql_native_password"
 
+	AuthCachingSha2Password string = "caching_sha2_password"
+
+	//the AuthMoreData packet in the caching_sha2_password authentication
+	authMoreDataHeader                   uint8 = 0x01
+	cachingSha2FastAuthSuccess           uint8 = 0x03
+	cachingSha2PerformFullAuthentication uint8 = 0x04
+
+	//the length of the SSLRequest, the handshake response41 truncated before the username
+	sslRequestLength int = 32
+
 	//the length of the mysql protocol header
 	HeaderLengthOfTheProtocol int = 4
 	HeaderOffset              int = 0
@@ -144,6 +157,15 @@ type MysqlProtocol interface {
 
 	SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSet, cnt uint64) error
 
+	//the server send group row of the result set in the binary protocol as an independent packet thread safe
+	SendResultSetBinaryBatchRow(mrs *MysqlResultSet, cnt uint64) error
+
+	//SendPrepareResponse sends the response of the COM_STMT_PREPARE
+	SendPrepareResponse(stmt *PrepareStmt) error
+
+	//ParseExecuteData parses the parameters from the payload of the COM_STMT_EXECUTE
+	ParseExecuteData(stmt *PrepareStmt, data []byte) ([]interface{}, error)
+
 	//SendColumnDefinitionPacket the server send the column definition to the client
 	SendColumnDefinitionPacket(column Column, cmd int) error
 
@@ -279,6 +301,15 @@ type MysqlProtocolImpl struct {
 	rowHandler
 
 	SV *config.SystemVariables
+
+	//the accounts the users are authenticated against. nil if only the dump user is accepted.
+	accounts *AccountManager
+
+	//the TLS config the connection is upgraded with. nil if TLS is not configured.
+	tlsConfig *tls.Config
+
+	//the connection has been upgraded to TLS
+	secure bool
 }
 
 func (mp *MysqlProtocolImpl) GetDatabaseName() string {
@@ -548,6 +579,36 @@ func (mp *MysqlProtocolImpl) appendUint8(data []byte, e uint8) []byte {
 	return mp.append(data, e)
 }
 
+func (mp *MysqlProtocolImpl) appendUint16(data []byte, e uint16) []byte {
+	mp.lenEncBuffer = mp.lenEncBuffer[:9]
+	pos := mp.io.WriteUint16(mp.lenEncBuffer, 0, e)
+	return mp.append(data, mp.lenEncBuffer[:pos]...)
+}
+
+func (mp *MysqlProtocolImpl) appendUint32(data []byte, e uint32) []byte {
+	mp.lenEncBuffer = mp.lenEncBuffer[:9]
+	pos := mp.io.WriteUint32(mp.lenEncBuffer, 0, e)
+	return mp.append(data, mp.lenEncBuffer[:pos]...)
+}
+
+func (mp *MysqlProtocolImpl) appendUint64(data []byte, e uint64) []byte {
+	mp.lenEncBuffer = mp.lenEncBuffer[:9]
+	pos := mp.io.WriteUint64(mp.lenEncBuffer, 0, e)
+	return mp.append(data, mp.lenEncBuffer[:pos]...)
+}
+
+//append the date and the time in the binary protocol to the buffer
+//return the buffer
+func (mp *MysqlProtocolImpl) appendDatetime(data []byte, dt types.Datetime) []byte {
+	year, month, day, _ := dt.ToDate().Calendar(true)
+	hour, minute, sec := dt.Clock()
+	//length [07]
+	data = mp.appendUint8(data, 7)
+	data = mp.appendUint16(data, uint16(year))
+	data = mp.append(data, month, day, uint8(hour), uint8(minute), uint8(sec))
+	return data
+}
+
 //write the count of zeros into the buffer at the position
 //return pos + count
 func (mp *MysqlProtocolImpl) writeZeros(data []byte, pos int, count int) int {
@@ -607,10 +668,49 @@ func (mp *MysqlProtocolImpl) checkPassword(password, salt, auth []byte) bool {
 	return bytes.Equal(hash1, auth)
 }
 
+//checkPasswordHash judges the authentication data from the client with the
+//SHA1( SHA1( password ) ) kept for the user instead of the password.
+//SHA1( password ) is recovered from the authentication data and hashed again.
+func (mp *MysqlProtocolImpl) checkPasswordHash(hash2, salt, auth []byte) bool {
+	if len(hash2) == 0 || len(auth) != sha1.Size {
+		return len(hash2) == 0 && len(auth) == 0
+	}
+	//hash3 = SHA1(salt + SHA1(SHA1(password)))
+	sha := sha1.New()
+	sha.Write(salt)
+	sha.Write(hash2)
+	hash3 := sha.Sum(nil)
+
+	//SHA1(password) = auth XOR hash3
+	hash1 := make([]byte, sha1.Size)
+	for i := range hash1 {
+		hash1[i] = auth[i] ^ hash3[i]
+	}
+	sum := sha1.Sum(hash1)
+	return bytes.Equal(sum[:], hash2)
+}
+
 //the server authenticate that the client can connect and use the database
 func (mp *MysqlProtocolImpl) authenticateUser(authResponse []byte) error {
-	//TODO:check the user and the connection
-	//TODO:get the user's password
+	if mp.accounts != nil && !mp.accounts.IsSuperuser(mp.username) {
+		hash2, ok, err := mp.accounts.GetAuthentication(mp.username)
+		if err != nil {
+			logutil.Errorf("get the authentication of the user %s failed. error:%v", mp.username, err)
+			return fmt.Errorf("check password failed\n")
+		}
+		if !ok {
+			return fmt.Errorf("check password failed\n")
+		}
+		if mp.authPluginOf(mp.username) == AuthCachingSha2Password {
+			return mp.authenticateCachingSha2(hash2, authResponse)
+		}
+		if !mp.checkPasswordHash(hash2, mp.salt, authResponse) {
+			return fmt.Errorf("check password failed\n")
+		}
+		logutil.Infof("check password succeeded\n")
+		return nil
+	}
+
 	var psw []byte
 	if mp.username == mp.SV.GetDumpuser() { //the user dump for test
 		psw = []byte(mp.SV.GetDumppassword())
@@ -625,6 +725,128 @@ func (mp *MysqlProtocolImpl) authenticateUser(authResponse []byte) error {
 	return nil
 }
 
+//authPluginOf returns the authentication method of the user
+func (mp *MysqlProtocolImpl) authPluginOf(user string) string {
+	if mp.accounts == nil || mp.accounts.IsSuperuser(user) {
+		return AuthNativePassword
+	}
+	plugin, err := mp.accounts.GetAuthPlugin(user)
+	if err != nil || plugin == "" {
+		return AuthNativePassword
+	}
+	return plugin
+}
+
+//checkSha2PasswordHash judges the scramble of the caching_sha2_password with the
+//SHA256( SHA256( password ) ) cached for the user.
+//Algorithm: SHA256( password ) XOR SHA256( SHA256( SHA256( password ) ) + salt )
+func (mp *MysqlProtocolImpl) checkSha2PasswordHash(hash2, salt, auth []byte) bool {
+	if len(hash2) != sha256.Size || len(auth) != sha256.Size {
+		return false
+	}
+	//hash3 = SHA256(SHA256(SHA256(password)) + salt)
+	sha := sha256.New()
+	sha.Write(hash2)
+	sha.Write(salt)
+	hash3 := sha.Sum(nil)
+
+	//SHA256(password) = auth XOR hash3
+	hash1 := make([]byte, sha256.Size)
+	for i := range hash1 {
+		hash1[i] = auth[i] ^ hash3[i]
+	}
+	sum := sha256.Sum256(hash1)
+	return bytes.Equal(sum[:], hash2)
+}
+
+/*
+authenticateCachingSha2 authenticates the user with the caching_sha2_password.
+The fast authentication judges the scramble with the SHA256( SHA256( password ) )
+cached by the last full authentication of the user.
+The full authentication receives the password in plaintext,
+so that it is done only on the connection upgraded to TLS.
+hash2 is the SHA1( SHA1( password ) ) kept for the user.
+*/
+func (mp *MysqlProtocolImpl) authenticateCachingSha2(hash2, authResponse []byte) error {
+	if len(hash2) == 0 {
+		if len(authResponse) == 0 {
+			return nil
+		}
+		return fmt.Errorf("check password failed\n")
+	}
+
+	if sha2, ok := mp.accounts.getSha2Cache(mp.username, hash2); ok && mp.checkSha2PasswordHash(sha2, mp.salt, authResponse) {
+		return mp.writePackets(mp.makeAuthMoreDataPayload(cachingSha2FastAuthSuccess))
+	}
+
+	if !mp.secure {
+		return fmt.Errorf("the full authentication of %s needs the secure connection\n", AuthCachingSha2Password)
+	}
+	data, err := mp.exchangeAuthData(mp.makeAuthMoreDataPayload(cachingSha2PerformFullAuthentication))
+	if err != nil {
+		return err
+	}
+	//the password terminated by 0
+	password := data
+	if len(password) != 0 && password[len(password)-1] == 0 {
+		password = password[:len(password)-1]
+	}
+	if !bytes.Equal(hashPassword(string(password)), hash2) {
+		return fmt.Errorf("check password failed\n")
+	}
+	hash1 := sha256.Sum256(password)
+	sha2 := sha256.Sum256(hash1[:])
+	mp.accounts.putSha2Cache(mp.username, hash2, sha2[:])
+	return nil
+}
+
+//isSSLRequest checks the payload is the SSLRequest that asks for upgrading the connection to TLS
+func (mp *MysqlProtocolImpl) isSSLRequest(payload []byte) bool {
+	if len(payload) != sslRequestLength {
+		return false
+	}
+	capabilities, _, ok := mp.io.ReadUint32(payload, 0)
+	return ok && capabilities&CLIENT_PROTOCOL_41 != 0 && capabilities&CLIENT_SSL != 0
+}
+
+//upgradeToTLS upgrades the connection to TLS after the SSLRequest.
+//the client sends the handshake response on the TLS connection then.
+func (mp *MysqlProtocolImpl) upgradeToTLS() error {
+	if mp.tlsConfig == nil || mp.secure {
+		return fmt.Errorf("the server does not accept the SSLRequest")
+	}
+	raw, err := mp.tcpConn.RawConn()
+	if err != nil {
+		return err
+	}
+	conn, ok := raw.(*upgradableConn)
+	if !ok {
+		return fmt.Errorf("the connection can not be upgraded to TLS")
+	}
+
+	//the client may send the TLS handshake right after the SSLRequest
+	var pending []byte
+	if in := mp.tcpConn.InBuf(); in.Readable() > 0 {
+		if _, pending, err = in.ReadAll(); err != nil {
+			return err
+		}
+	}
+	if err = conn.upgrade(mp.tlsConfig, pending); err != nil {
+		return fmt.Errorf("TLS handshake failed. error:%v", err)
+	}
+	mp.secure = true
+	logutil.Infof("connection %d is upgraded to TLS", mp.connectionID)
+	return nil
+}
+
+//serverCapability returns the capabilities the server declares in the handshake
+func (mp *MysqlProtocolImpl) serverCapability() uint32 {
+	if mp.tlsConfig != nil {
+		return DefaultCapability | CLIENT_SSL
+	}
+	return DefaultCapability
+}
+
 func (mp *MysqlProtocolImpl) setSequenceID(value uint8) {
 	mp.sequenceId = value
 }
@@ -646,7 +868,7 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 		}
 
 		authResponse = resp41.authResponse
-		mp.capability = DefaultCapability & resp41.capabilities
+		mp.capability = mp.serverCapability() & resp41.capabilities
 
 		if nameAndCharset, ok := collationID2CharsetAndName[int(resp41.collationID)]; !ok {
 			return fmt.Errorf("get collationName and charset failed")
@@ -668,7 +890,7 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 		}
 
 		authResponse = resp320.authResponse
-		mp.capability = DefaultCapability & resp320.capabilities
+		mp.capability = mp.serverCapability() & resp320.capabilities
 		mp.collationID = int(Utf8mb4CollationID)
 		mp.collationName = "utf8mb4_general_ci"
 		mp.charset = "utf8mb4"
@@ -678,6 +900,12 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 		mp.database = resp320.database
 	}
 
+	if mp.SV.GetRequireSecureTransport() && !mp.secure {
+		fail := errorMsgRefer[ER_SECURE_TRANSPORT_REQUIRED]
+		_ = mp.sendErrPacket(fail.errorCode, fail.sqlStates[0], fail.errorMsgOrFormat)
+		return fmt.Errorf("the user %s logins on the plaintext connection", mp.username)
+	}
+
 	if err := mp.authenticateUser(authResponse); err != nil {
 		fail := errorMsgRefer[ER_ACCESS_DENIED_ERROR]
 		_ = mp.sendErrPacket(fail.errorCode, fail.sqlStates[0], "Access denied for user")
@@ -696,6 +924,7 @@ func (mp *MysqlProtocolImpl) handleHandshake(payload []byte) error {
 func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	var data = make([]byte, HeaderOffset+256)
 	var pos = HeaderOffset
+	var capability = mp.serverCapability()
 	//int<1> protocol version
 	pos = mp.io.WriteUint8(data, pos, clientProtocolVersion)
 
@@ -712,7 +941,7 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	pos = mp.io.WriteUint8(data, pos, 0)
 
 	//int<2>              capabilities flags (lower 2 bytes)
-	pos = mp.io.WriteUint16(data, pos, uint16(DefaultCapability&0xFFFF))
+	pos = mp.io.WriteUint16(data, pos, uint16(capability&0xFFFF))
 
 	//int<1>              character set
 	pos = mp.io.WriteUint8(data, pos, utf8mb4BinCollationID)
@@ -721,9 +950,9 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	pos = mp.io.WriteUint16(data, pos, DefaultClientConnStatus)
 
 	//int<2>              capabilities flags (upper 2 bytes)
-	pos = mp.io.WriteUint16(data, pos, uint16((DefaultCapability>>16)&0xFFFF))
+	pos = mp.io.WriteUint16(data, pos, uint16((capability>>16)&0xFFFF))
 
-	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
+	if (capability & CLIENT_PLUGIN_AUTH) != 0 {
 		//int<1>              length of auth-plugin-data
 		//set 21 always
 		pos = mp.io.WriteUint8(data, pos, uint8(len(mp.salt)+1))
@@ -736,13 +965,13 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	//string[10]     reserved (all [00])
 	pos = mp.writeZeros(data, pos, 10)
 
-	if (DefaultCapability & CLIENT_SECURE_CONNECTION) != 0 {
+	if (capability & CLIENT_SECURE_CONNECTION) != 0 {
 		//string[$len]   auth-plugin-data-part-2 ($len=MAX(13, length of auth-plugin-data - 8))
 		pos = mp.writeCountOfBytes(data, pos, mp.salt[8:])
 		pos = mp.io.WriteUint8(data, pos, 0)
 	}
 
-	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
+	if (capability & CLIENT_PLUGIN_AUTH) != 0 {
 		//string[NUL]    auth-plugin name
 		pos = mp.writeStringNUL(data, pos, AuthNativePassword)
 	}
@@ -848,12 +1077,12 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(data []byte) (bool, resp
 		}
 
 		//to switch authenticate method
-		if info.clientPluginName != AuthNativePassword {
+		if plugin := mp.authPluginOf(info.username); info.clientPluginName != plugin {
 			var err error
-			if info.authResponse, err = mp.negotiateAuthenticationMethod(); err != nil {
+			if info.authResponse, err = mp.negotiateAuthenticationMethod(plugin); err != nil {
 				return false, info, fmt.Errorf("negotiate authentication method failed. error:%v", err)
 			}
-			info.clientPluginName = AuthNativePassword
+			info.clientPluginName = plugin
 		}
 	}
 
@@ -992,10 +1221,23 @@ func (mp *MysqlProtocolImpl) makeAuthSwitchRequestPayload(authMethodName string)
 //the server can send AuthSwitchRequest to ask client to use designated authentication method,
 //if both server and client support CLIENT_PLUGIN_AUTH capability.
 //return data authenticated with new method
-func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod() ([]byte, error) {
-	var err error
-	aswPkt := mp.makeAuthSwitchRequestPayload(AuthNativePassword)
-	err = mp.writePackets(aswPkt)
+func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(authMethodName string) ([]byte, error) {
+	return mp.exchangeAuthData(mp.makeAuthSwitchRequestPayload(authMethodName))
+}
+
+//the server makes a AuthMoreData packet that carries the status of the authentication
+func (mp *MysqlProtocolImpl) makeAuthMoreDataPayload(status uint8) []byte {
+	data := make([]byte, HeaderOffset+2)
+	pos := HeaderOffset
+	pos = mp.io.WriteUint8(data, pos, authMoreDataHeader)
+	pos = mp.io.WriteUint8(data, pos, status)
+	return data[:pos]
+}
+
+//the server sends the packet in the authentication
+//return the data the client responds
+func (mp *MysqlProtocolImpl) exchangeAuthData(payload []byte) ([]byte, error) {
+	err := mp.writePackets(payload)
 	if err != nil {
 		return nil, err
 	}
@@ -1256,7 +1498,7 @@ func (mp *MysqlProtocolImpl) makeResultSetTextRow(data []byte, mrs *MysqlResultS
 		}
 
 		switch mysqlColumn.ColumnType() {
-		case defines.MYSQL_TYPE_DECIMAL:
+		case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL:
 			if value, err2 := mrs.GetString(r, i); err2 != nil {
 				return nil, err2
 			} else {
@@ -1302,7 +1544,7 @@ func (mp *MysqlProtocolImpl) makeResultSetTextRow(data []byte, mrs *MysqlResultS
 					data = mp.appendStringLenEncOfInt64(data, value)
 				}
 			}
-		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING:
+		case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING, defines.MYSQL_TYPE_JSON:
 			if value, err2 := mrs.GetString(r, i); err2 != nil {
 				return nil, err2
 			} else {
@@ -1335,6 +1577,125 @@ func (mp *MysqlProtocolImpl) makeResultSetTextRow(data []byte, mrs *MysqlResultS
 	return data, nil
 }
 
+//the server convert every row of the result set into the format of the binary protocol
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/binary-protocol-resultset-row.html
+func (mp *MysqlProtocolImpl) makeResultSetBinaryRow(data []byte, mrs *MysqlResultSet, r uint64) ([]byte, error) {
+	//packet header [00]
+	data = mp.appendUint8(data, defines.OKHeader)
+
+	//NULL-bitmap, length: (column_count + 7 + 2) / 8. the offset is 2.
+	columnCount := mrs.GetColumnCount()
+	nullBitmap := make([]byte, (columnCount+7+2)/8)
+	for i := uint64(0); i < columnCount; i++ {
+		if isNil, err := mrs.ColumnIsNull(r, i); err != nil {
+			return nil, err
+		} else if isNil {
+			nullBitmap[(i+2)/8] |= 1 << ((i + 2) % 8)
+		}
+	}
+	data = mp.append(data, nullBitmap...)
+
+	for i := uint64(0); i < columnCount; i++ {
+		column, err := mrs.GetColumn(i)
+		if err != nil {
+			return nil, err
+		}
+		mysqlColumn, ok := column.(*MysqlColumn)
+		if !ok {
+			return nil, fmt.Errorf("sendColumn need MysqlColumn")
+		}
+
+		//NULL is in the bitmap
+		if nullBitmap[(i+2)/8]&(1<<((i+2)%8)) != 0 {
+			continue
+		}
+
+		switch mysqlColumn.ColumnType() {
+		case defines.MYSQL_TYPE_TINY:
+			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendUint8(data, uint8(value))
+			}
+		case defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR:
+			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendUint16(data, uint16(value))
+			}
+		case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+			if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendUint32(data, uint32(value))
+			}
+		case defines.MYSQL_TYPE_LONGLONG:
+			if uint32(mysqlColumn.Flag())&defines.UNSIGNED_FLAG != 0 {
+				if value, err2 := mrs.GetUint64(r, i); err2 != nil {
+					return nil, err2
+				} else {
+					data = mp.appendUint64(data, value)
+				}
+			} else {
+				if value, err2 := mrs.GetInt64(r, i); err2 != nil {
+					return nil, err2
+				} else {
+					data = mp.appendUint64(data, uint64(value))
+				}
+			}
+		case defines.MYSQL_TYPE_FLOAT:
+			if value, err2 := mrs.GetFloat64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendUint32(data, math.Float32bits(float32(value)))
+			}
+		case defines.MYSQL_TYPE_DOUBLE:
+			if value, err2 := mrs.GetFloat64(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendUint64(data, math.Float64bits(value))
+			}
+		case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL, defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING, defines.MYSQL_TYPE_JSON:
+			if value, err2 := mrs.GetString(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendStringLenEnc(data, value)
+			}
+		case defines.MYSQL_TYPE_DATE:
+			if value, err2 := mrs.GetValue(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				year, month, day, _ := value.(types.Date).Calendar(true)
+				//length [04]
+				data = mp.appendUint8(data, 4)
+				data = mp.appendUint16(data, uint16(year))
+				data = mp.append(data, month, day)
+			}
+		case defines.MYSQL_TYPE_DATETIME:
+			if value, err2 := mrs.GetValue(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				data = mp.appendDatetime(data, value.(types.Datetime))
+			}
+		case defines.MYSQL_TYPE_TIMESTAMP:
+			if value, err2 := mrs.GetString(r, i); err2 != nil {
+				return nil, err2
+			} else {
+				dt, err3 := types.ParseDatetime(value)
+				if err3 != nil {
+					return nil, err3
+				}
+				data = mp.appendDatetime(data, dt)
+			}
+		case defines.MYSQL_TYPE_TIME:
+			return nil, fmt.Errorf("unsupported MYSQL_TYPE_TIME")
+		default:
+			return nil, fmt.Errorf("unsupported column type %d ", mysqlColumn.ColumnType())
+		}
+	}
+	return data, nil
+}
+
 //the server send group row of the result set as an independent packet
 //thread safe
 func (mp *MysqlProtocolImpl) SendResultSetTextBatchRow(mrs *MysqlResultSet, cnt uint64) error {
@@ -1392,6 +1753,25 @@ func (mp *MysqlProtocolImpl) SendResultSetTextBatchRowSpeedup(mrs *MysqlResultSe
 	return err
 }
 
+//the server send group row of the result set in the binary protocol as an independent packet
+//thread safe
+func (mp *MysqlProtocolImpl) SendResultSetBinaryBatchRow(mrs *MysqlResultSet, cnt uint64) error {
+	if cnt == 0 {
+		return nil
+	}
+
+	mp.GetLock().Lock()
+	defer mp.GetLock().Unlock()
+	var err error = nil
+
+	for i := uint64(0); i < cnt; i++ {
+		if err = mp.sendResultSetBinaryRow(mrs, i); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
 //open a new row of the resultset
 func (mp *MysqlProtocolImpl) openRow(_ []byte) error {
 	if mp.enableLog {
@@ -1606,6 +1986,25 @@ func (mp *MysqlProtocolImpl) sendResultSetTextRow(mrs *MysqlResultSet, r uint64)
 	return nil
 }
 
+//the server send every row of the result set in the binary protocol as an independent packet
+func (mp *MysqlProtocolImpl) sendResultSetBinaryRow(mrs *MysqlResultSet, r uint64) error {
+	var err error
+	err = mp.openRow(nil)
+	if err != nil {
+		return err
+	}
+	if _, err = mp.makeResultSetBinaryRow(nil, mrs, r); err != nil {
+		//ERR_Packet in case of error
+		err1 := mp.sendErrPacket(ER_UNKNOWN_ERROR, DefaultMySQLState, err.Error())
+		if err1 != nil {
+			return err1
+		}
+		return err
+	}
+
+	return mp.closeRow(nil)
+}
+
 //the server send the result set of execution the client
 //the routine follows the article: https://dev.mysql.com/doc/internals/en/com-query-response.html
 func (mp *MysqlProtocolImpl) sendResultSet(set ResultSet, cmd int, warnings, status uint16) error {
@@ -1625,8 +2024,14 @@ func (mp *MysqlProtocolImpl) sendResultSet(set ResultSet, cmd int, warnings, sta
 	}
 
 	//One or more ProtocolText::ResultsetRow packets, each containing column_count values
+	//ProtocolBinary::ResultsetRow packets for the COM_STMT_EXECUTE
 	for i := uint64(0); i < mysqlRS.GetRowCount(); i++ {
-		if err = mp.sendResultSetTextRow(mysqlRS, i); err != nil {
+		if uint8(cmd) == COM_STMT_EXECUTE {
+			err = mp.sendResultSetBinaryRow(mysqlRS, i)
+		} else {
+			err = mp.sendResultSetTextRow(mysqlRS, i)
+		}
+		if err != nil {
 			return err
 		}
 	}
@@ -1647,6 +2052,268 @@ func (mp *MysqlProtocolImpl) sendResultSet(set ResultSet, cmd int, warnings, sta
 	return nil
 }
 
+//the server sends the response of the COM_STMT_PREPARE
+//the routine follows the article: https://dev.mysql.com/doc/internals/en/com-stmt-prepare-response.html
+func (mp *MysqlProtocolImpl) SendPrepareResponse(stmt *PrepareStmt) error {
+	paramCount := stmt.ParamCount()
+	data := make([]byte, HeaderOffset+12)
+	pos := HeaderOffset
+	//status [00] OK
+	pos = mp.io.WriteUint8(data, pos, defines.OKHeader)
+	pos = mp.io.WriteUint32(data, pos, stmt.Id)
+	//the unknown columns are sent with the result set of the COM_STMT_EXECUTE
+	pos = mp.io.WriteUint16(data, pos, uint16(len(stmt.columns)))
+	pos = mp.io.WriteUint16(data, pos, uint16(paramCount))
+	//reserved_1 [00] filler
+	pos = mp.io.WriteUint8(data, pos, 0)
+	//warning_count
+	pos = mp.io.WriteUint16(data, pos, 0)
+	if err := mp.writePackets(data[:pos]); err != nil {
+		return err
+	}
+
+	//num_params * Protocol::ColumnDefinition packets
+	if paramCount > 0 {
+		for i := 0; i < paramCount; i++ {
+			col := new(MysqlColumn)
+			col.SetName("?")
+			col.SetColumnType(defines.MYSQL_TYPE_VAR_STRING)
+			if err := mp.SendColumnDefinitionPacket(col, int(COM_STMT_PREPARE)); err != nil {
+				return err
+			}
+		}
+		if err := mp.SendEOFPacketIf(0, 0); err != nil {
+			return err
+		}
+	}
+
+	//num_columns * Protocol::ColumnDefinition packets
+	if len(stmt.columns) > 0 {
+		for _, col := range stmt.columns {
+			if err := mp.SendColumnDefinitionPacket(col, int(COM_STMT_PREPARE)); err != nil {
+				return err
+			}
+		}
+		if err := mp.SendEOFPacketIf(0, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+ParseExecuteData parses the parameters from the payload of the COM_STMT_EXECUTE.
+The routine follows the article: https://dev.mysql.com/doc/internals/en/com-stmt-execute.html
+The parameter types are kept in the statement for the following executions
+which do not bind the new ones.
+*/
+func (mp *MysqlProtocolImpl) ParseExecuteData(stmt *PrepareStmt, data []byte) ([]interface{}, error) {
+	var ok bool
+	var err error
+	paramCount := stmt.ParamCount()
+	values := make([]interface{}, paramCount)
+
+	//stmt_id int<4>, flags int<1>, iteration_count int<4>
+	pos := 9
+	if len(data) < pos {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	if paramCount == 0 {
+		return values, nil
+	}
+
+	var nullBitmap []byte
+	nullBitmap, pos, ok = mp.readCountOfBytes(data, pos, (paramCount+7)/8)
+	if !ok {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+
+	var newParamsBound uint8
+	newParamsBound, pos, ok = mp.io.ReadUint8(data, pos)
+	if !ok {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	if newParamsBound == 1 {
+		//type of each parameter, length: num-params * 2
+		var paramTypes []byte
+		paramTypes, pos, ok = mp.readCountOfBytes(data, pos, paramCount*2)
+		if !ok {
+			return nil, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		stmt.paramTypes = append(stmt.paramTypes[:0], paramTypes...)
+	}
+	if len(stmt.paramTypes) != paramCount*2 {
+		return nil, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+
+	for i := 0; i < paramCount; i++ {
+		//the value has been sent by the COM_STMT_SEND_LONG_DATA
+		if longData, ok := stmt.longData[uint16(i)]; ok {
+			values[i] = longData
+			continue
+		}
+		if nullBitmap[i/8]&(1<<(i%8)) != 0 {
+			values[i] = nil
+			continue
+		}
+		typ := stmt.paramTypes[i*2]
+		unsigned := stmt.paramTypes[i*2+1]&0x80 != 0
+		values[i], pos, err = mp.readBinaryParam(data, pos, typ, unsigned)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+//read a parameter value in the binary protocol from the buffer at the position
+//return the value ; position + the length of the value
+func (mp *MysqlProtocolImpl) readBinaryParam(data []byte, pos int, typ uint8, unsigned bool) (interface{}, int, error) {
+	var ok bool
+	switch typ {
+	case defines.MYSQL_TYPE_NULL:
+		return nil, pos, nil
+	case defines.MYSQL_TYPE_TINY:
+		var value uint8
+		if value, pos, ok = mp.io.ReadUint8(data, pos); ok {
+			if unsigned {
+				return uint64(value), pos, nil
+			}
+			return int64(int8(value)), pos, nil
+		}
+	case defines.MYSQL_TYPE_SHORT, defines.MYSQL_TYPE_YEAR:
+		var value uint16
+		if value, pos, ok = mp.io.ReadUint16(data, pos); ok {
+			if unsigned {
+				return uint64(value), pos, nil
+			}
+			return int64(int16(value)), pos, nil
+		}
+	case defines.MYSQL_TYPE_INT24, defines.MYSQL_TYPE_LONG:
+		var value uint32
+		if value, pos, ok = mp.io.ReadUint32(data, pos); ok {
+			if unsigned {
+				return uint64(value), pos, nil
+			}
+			return int64(int32(value)), pos, nil
+		}
+	case defines.MYSQL_TYPE_LONGLONG:
+		var value uint64
+		if value, pos, ok = mp.io.ReadUint64(data, pos); ok {
+			if unsigned {
+				return value, pos, nil
+			}
+			return int64(value), pos, nil
+		}
+	case defines.MYSQL_TYPE_FLOAT:
+		var value uint32
+		if value, pos, ok = mp.io.ReadUint32(data, pos); ok {
+			return math.Float32frombits(value), pos, nil
+		}
+	case defines.MYSQL_TYPE_DOUBLE:
+		var value uint64
+		if value, pos, ok = mp.io.ReadUint64(data, pos); ok {
+			return math.Float64frombits(value), pos, nil
+		}
+	case defines.MYSQL_TYPE_DATE, defines.MYSQL_TYPE_DATETIME, defines.MYSQL_TYPE_TIMESTAMP:
+		return mp.readBinaryDatetime(data, pos, typ)
+	case defines.MYSQL_TYPE_TIME:
+		return mp.readBinaryTime(data, pos)
+	case defines.MYSQL_TYPE_DECIMAL, defines.MYSQL_TYPE_NEWDECIMAL,
+		defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING, defines.MYSQL_TYPE_STRING,
+		defines.MYSQL_TYPE_TINY_BLOB, defines.MYSQL_TYPE_MEDIUM_BLOB, defines.MYSQL_TYPE_LONG_BLOB, defines.MYSQL_TYPE_BLOB,
+		defines.MYSQL_TYPE_ENUM, defines.MYSQL_TYPE_SET, defines.MYSQL_TYPE_BIT, defines.MYSQL_TYPE_JSON:
+		var value string
+		if value, pos, ok = mp.readStringLenEnc(data, pos); ok {
+			return value, pos, nil
+		}
+	default:
+		return nil, 0, fmt.Errorf("unsupported parameter type %d ", typ)
+	}
+	return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+}
+
+//read the DATE, DATETIME or TIMESTAMP in the binary protocol
+//return the string of the value ; position + the length of the value
+func (mp *MysqlProtocolImpl) readBinaryDatetime(data []byte, pos int, typ uint8) (interface{}, int, error) {
+	var ok bool
+	var length, month, day, hour, minute, sec uint8
+	var year uint16
+	var microSec uint32
+	if length, pos, ok = mp.io.ReadUint8(data, pos); !ok {
+		return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	if length >= 4 {
+		var ymd []byte
+		if year, pos, ok = mp.io.ReadUint16(data, pos); !ok {
+			return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		if ymd, pos, ok = mp.readCountOfBytes(data, pos, 2); !ok {
+			return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		month, day = ymd[0], ymd[1]
+	}
+	if length >= 7 {
+		var hms []byte
+		if hms, pos, ok = mp.readCountOfBytes(data, pos, 3); !ok {
+			return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		hour, minute, sec = hms[0], hms[1], hms[2]
+	}
+	if length >= 11 {
+		if microSec, pos, ok = mp.io.ReadUint32(data, pos); !ok {
+			return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+	}
+
+	if typ == defines.MYSQL_TYPE_DATE {
+		return fmt.Sprintf("%04d-%02d-%02d", year, month, day), pos, nil
+	}
+	if microSec != 0 {
+		return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d.%06d", year, month, day, hour, minute, sec, microSec), pos, nil
+	}
+	return fmt.Sprintf("%04d-%02d-%02d %02d:%02d:%02d", year, month, day, hour, minute, sec), pos, nil
+}
+
+//read the TIME in the binary protocol
+//return the string of the value ; position + the length of the value
+func (mp *MysqlProtocolImpl) readBinaryTime(data []byte, pos int) (interface{}, int, error) {
+	var ok bool
+	var length uint8
+	var days, microSec uint32
+	var sign string
+	var hms []byte = []byte{0, 0, 0}
+	if length, pos, ok = mp.io.ReadUint8(data, pos); !ok {
+		return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+	}
+	if length >= 8 {
+		var isNegative uint8
+		if isNegative, pos, ok = mp.io.ReadUint8(data, pos); !ok {
+			return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		if isNegative == 1 {
+			sign = "-"
+		}
+		if days, pos, ok = mp.io.ReadUint32(data, pos); !ok {
+			return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+		if hms, pos, ok = mp.readCountOfBytes(data, pos, 3); !ok {
+			return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+	}
+	if length >= 12 {
+		if microSec, pos, ok = mp.io.ReadUint32(data, pos); !ok {
+			return nil, 0, NewMysqlError(ER_MALFORMED_PACKET)
+		}
+	}
+
+	hours := days*24 + uint32(hms[0])
+	if microSec != 0 {
+		return fmt.Sprintf("%s%02d:%02d:%02d.%06d", sign, hours, hms[1], hms[2], microSec), pos, nil
+	}
+	return fmt.Sprintf("%s%02d:%02d:%02d", sign, hours, hms[1], hms[2]), pos, nil
+}
+
 //the server sends the payload to the client
 func (mp *MysqlProtocolImpl) writePackets(payload []byte) error {
 	//protocol header length
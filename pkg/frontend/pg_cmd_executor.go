@@ -0,0 +1,995 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"go/constant"
+	"go/token"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/scanner"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+/*
+parsePgSql parses the sql in the postgresql dialect.
+The statements out of the postgresql grammar are parsed by the mysql grammar
+with the lexical rules of the postgresql, the lexemes it can not read are rejected.
+*/
+func parsePgSql(sql string) ([]tree.Statement, error) {
+	return parsers.Parse(dialect.POSTGRESQL, sql)
+}
+
+/*
+pgStatement is the statement prepared by the Parse.
+The placeholders $n are parsed into the parameters which are bound to the values in the Bind.
+*/
+type pgStatement struct {
+	sql string
+
+	//the types of the parameters. 0 is unspecified.
+	paramOids []uint32
+
+	//nil for the empty query
+	stmt tree.Statement
+}
+
+// pgPortal is the statement bound with the parameters, which is ready to execute
+type pgPortal struct {
+	sql    string
+	stmt   tree.Statement
+	params []tree.Expr
+
+	//the formats of the result columns from the Bind
+	resultFormats []int16
+}
+
+// pgResult is the destination of the rows of the pipeline
+type pgResult struct {
+	proto   *PgProtocolImpl
+	formats []int16
+	loc     *time.Location
+	rows    uint64
+}
+
+/*
+pgFillData sends the rows of the batch in the DataRow messages.
+*/
+func pgFillData(obj interface{}, bat *batch.Batch) error {
+	res := obj.(*pgResult)
+	if bat == nil || len(bat.Vecs) == 0 {
+		return nil
+	}
+	n, err := res.proto.sendDataRows(bat, res.formats, res.loc)
+	atomic.AddUint64(&res.rows, n)
+	return err
+}
+
+/*
+PgCmdExecutor executes the messages from the postgresql client on the session.
+*/
+type PgCmdExecutor struct {
+	ses   *Session
+	proto *PgProtocolImpl
+
+	routineMgr *RoutineManager
+
+	sqlCount uint64
+
+	//the prepared statements and the portals. the unnamed ones have the empty name.
+	stmts   map[string]*pgStatement
+	portals map[string]*pgPortal
+
+	//the messages of the extended query are skipped until the Sync after an error
+	ignoreTillSync bool
+}
+
+func NewPgCmdExecutor(ses *Session, proto *PgProtocolImpl, rm *RoutineManager) *PgCmdExecutor {
+	return &PgCmdExecutor{
+		ses:        ses,
+		proto:      proto,
+		routineMgr: rm,
+		stmts:      make(map[string]*pgStatement),
+		portals:    make(map[string]*pgPortal),
+	}
+}
+
+// readyStatus returns the transaction status sent in the ReadyForQuery
+func (pce *PgCmdExecutor) readyStatus() byte {
+	if pce.ses.GetTxnHandler().IsAborted() {
+		return pgTxnFailed
+	}
+	if pce.ses.GetTxnHandler().IsExplicit() {
+		return pgTxnBlock
+	}
+	return pgTxnIdle
+}
+
+/*
+Loop reads and executes the messages until the client terminates or the connection is closed.
+*/
+func (pce *PgCmdExecutor) Loop() error {
+	if err := pce.proto.sendReadyForQuery(pce.readyStatus()); err != nil {
+		return err
+	}
+	for {
+		typ, data, err := pce.proto.readMessage()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if typ == pgMsgTerminate {
+			return nil
+		}
+		if pce.ignoreTillSync && typ != pgMsgSync {
+			continue
+		}
+
+		pce.ses.PrepareForRequest()
+		switch typ {
+		case pgMsgQuery:
+			err = pce.handleQuery(data)
+		case pgMsgParse:
+			err = pce.handleParse(data)
+		case pgMsgBind:
+			err = pce.handleBind(data)
+		case pgMsgDescribe:
+			err = pce.handleDescribe(data)
+		case pgMsgExecute:
+			err = pce.handleExecute(data)
+		case pgMsgClose:
+			err = pce.handleClose(data)
+		case pgMsgSync:
+			pce.ignoreTillSync = false
+			err = pce.proto.sendReadyForQuery(pce.readyStatus())
+		case pgMsgFlush:
+			err = pce.proto.flush()
+		default:
+			err = NewPgError(pgProtocolViolation, "invalid frontend message type %d", typ)
+		}
+		if err == nil {
+			continue
+		}
+
+		logutil.Errorf("execute the postgresql message %c failed. error:%v", typ, err)
+		if err = pce.proto.sendError(pgErrorOf(err)); err != nil {
+			return err
+		}
+		if typ == pgMsgQuery {
+			if err = pce.proto.sendReadyForQuery(pce.readyStatus()); err != nil {
+				return err
+			}
+		} else {
+			pce.ignoreTillSync = true
+		}
+	}
+}
+
+/*
+handleQuery executes the statements in the simple query.
+*/
+func (pce *PgCmdExecutor) handleQuery(data []byte) error {
+	sql, _, err := pgReadString(data)
+	if err != nil {
+		return err
+	}
+	//the simple query destroys the unnamed statement and portal
+	delete(pce.stmts, "")
+	delete(pce.portals, "")
+
+	stmts, err := parsePgSql(sql)
+	if err != nil {
+		return NewPgError(errno.SyntaxError, "%v", err)
+	}
+	if len(stmts) == 0 {
+		if err = pce.proto.sendEmpty(pgMsgEmptyQueryResponse); err != nil {
+			return err
+		}
+		return pce.proto.sendReadyForQuery(pce.readyStatus())
+	}
+	for _, stmt := range stmts {
+		if err = pce.executeStatement(stmt, sql, nil, nil, true); err != nil {
+			return err
+		}
+	}
+	return pce.proto.sendReadyForQuery(pce.readyStatus())
+}
+
+/*
+handleParse prepares the statement.
+The count of the parameters is the larger one of the types and the placeholders in the sql.
+*/
+func (pce *PgCmdExecutor) handleParse(data []byte) error {
+	name, data, err := pgReadString(data)
+	if err != nil {
+		return err
+	}
+	sql, data, err := pgReadString(data)
+	if err != nil {
+		return err
+	}
+	n, data, err := pgReadInt16(data)
+	if err != nil {
+		return err
+	}
+	oids := make([]uint32, n)
+	for i := range oids {
+		var oid int32
+		if oid, data, err = pgReadInt32(data); err != nil {
+			return err
+		}
+		oids[i] = uint32(oid)
+	}
+	if _, ok := pce.stmts[name]; ok && name != "" {
+		return NewPgError(errno.DuplicatePreparedStatement, "prepared statement \"%s\" already exists", name)
+	}
+
+	stmts, err := parsePgSql(sql)
+	if err != nil {
+		return NewPgError(errno.SyntaxError, "%v", err)
+	}
+	if len(stmts) > 1 {
+		return NewPgError(errno.SyntaxError, "cannot insert multiple commands into a prepared statement")
+	}
+	ps := &pgStatement{sql: sql}
+	if len(stmts) == 1 {
+		ps.stmt = stmts[0]
+	}
+	count := len(oids)
+	if n := pgParamCountOf(sql); n > count {
+		count = n
+	}
+	ps.paramOids = make([]uint32, count)
+	copy(ps.paramOids, oids)
+	pce.stmts[name] = ps
+	return pce.proto.sendEmpty(pgMsgParseComplete)
+}
+
+/*
+handleBind binds the parameters to the prepared statement in the portal.
+*/
+func (pce *PgCmdExecutor) handleBind(data []byte) error {
+	portal, data, err := pgReadString(data)
+	if err != nil {
+		return err
+	}
+	name, data, err := pgReadString(data)
+	if err != nil {
+		return err
+	}
+	ps, ok := pce.stmts[name]
+	if !ok {
+		return NewPgError(errno.InvalidSQLStatementName, "prepared statement \"%s\" does not exist", name)
+	}
+
+	paramFormats, data, err := pgReadFormats(data)
+	if err != nil {
+		return err
+	}
+	n, data, err := pgReadInt16(data)
+	if err != nil {
+		return err
+	}
+	if int(n) != len(ps.paramOids) {
+		return NewPgError(pgProtocolViolation, "bind message supplies %d parameters, but prepared statement \"%s\" requires %d", n, name, len(ps.paramOids))
+	}
+	if len(paramFormats) > 1 && len(paramFormats) != int(n) {
+		return NewPgError(pgProtocolViolation, "bind message has %d parameter formats but %d parameters", len(paramFormats), n)
+	}
+	params := make([]tree.Expr, n)
+	for i := range params {
+		var size int32
+		if size, data, err = pgReadInt32(data); err != nil {
+			return err
+		}
+		var value []byte
+		if size >= 0 {
+			if int(size) > len(data) {
+				return NewPgError(pgProtocolViolation, "insufficient data left in message")
+			}
+			value, data = data[:size], data[size:]
+		}
+		if params[i], err = pgParamOf(ps.paramOids[i], pgFormatOf(paramFormats, i), value, size < 0); err != nil {
+			return err
+		}
+	}
+	resultFormats, _, err := pgReadFormats(data)
+	if err != nil {
+		return err
+	}
+
+	pce.portals[portal] = &pgPortal{
+		sql:           ps.sql,
+		stmt:          ps.stmt,
+		params:        params,
+		resultFormats: resultFormats,
+	}
+	return pce.proto.sendEmpty(pgMsgBindComplete)
+}
+
+/*
+handleDescribe describes the parameters and the result columns of the statement,
+or the result columns of the portal.
+*/
+func (pce *PgCmdExecutor) handleDescribe(data []byte) error {
+	if len(data) == 0 {
+		return NewPgError(pgProtocolViolation, "invalid DESCRIBE message")
+	}
+	kind := data[0]
+	name, _, err := pgReadString(data[1:])
+	if err != nil {
+		return err
+	}
+	switch kind {
+	case 'S':
+		ps, ok := pce.stmts[name]
+		if !ok {
+			return NewPgError(errno.InvalidSQLStatementName, "prepared statement \"%s\" does not exist", name)
+		}
+		//the parameters of the unspecified types are sent in the text
+		oids := make([]uint32, len(ps.paramOids))
+		for i, oid := range ps.paramOids {
+			if oid == pgOidUnknown {
+				oid = pgOidText
+			}
+			oids[i] = oid
+		}
+		if err = pce.proto.sendParameterDescription(oids); err != nil {
+			return err
+		}
+		return pce.describeRows(ps.stmt, ps.sql, pgSampleParams(ps.paramOids), nil)
+	case 'P':
+		pp, ok := pce.portals[name]
+		if !ok {
+			return NewPgError(errno.InvalidCursorName, "portal \"%s\" does not exist", name)
+		}
+		return pce.describeRows(pp.stmt, pp.sql, pp.params, pp.resultFormats)
+	}
+	return NewPgError(pgProtocolViolation, "invalid DESCRIBE message subtype %d", kind)
+}
+
+// describeRows sends the RowDescription of the statement returning the rows, otherwise the NoData
+func (pce *PgCmdExecutor) describeRows(stmt tree.Statement, sql string, params []tree.Expr, formats []int16) (retErr error) {
+	if stmt == nil || !pgReturnsRows(stmt) {
+		return pce.proto.sendEmpty(pgMsgNoData)
+	}
+	if _, ok := stmt.(*tree.ShowVariables); ok {
+		return pce.proto.sendRowDescription(pgShowVariablesColumns, []types.T{types.T_varchar, types.T_varchar}, []int16{0, 0})
+	}
+	txnHandler := pce.ses.GetTxnHandler()
+	if err := txnHandler.StartByStatement(); err != nil {
+		return err
+	}
+	defer func() {
+		retErr = txnHandler.CommitAfterStatement(retErr)
+	}()
+	comp := compile.New(pce.proto.GetDatabaseName(), sql, pce.proto.GetUserName(),
+		pce.ses.GetStorage(), pce.newProcess())
+	comp.SetParams(params)
+	exec := comp.BuildStatements([]tree.Statement{stmt})[0]
+	if err := exec.Compile(&pgResult{}, pgFillData); err != nil {
+		return err
+	}
+	names, typs := pgColumnsOf(exec.Columns())
+	resultFormats, err := pgResultFormats(formats, len(names))
+	if err != nil {
+		return err
+	}
+	return pce.proto.sendRowDescription(names, typs, resultFormats)
+}
+
+/*
+handleExecute executes the portal. All the rows are sent regardless of the max count of the rows.
+*/
+func (pce *PgCmdExecutor) handleExecute(data []byte) error {
+	name, _, err := pgReadString(data)
+	if err != nil {
+		return err
+	}
+	pp, ok := pce.portals[name]
+	if !ok {
+		return NewPgError(errno.InvalidCursorName, "portal \"%s\" does not exist", name)
+	}
+	if pp.stmt == nil {
+		return pce.proto.sendEmpty(pgMsgEmptyQueryResponse)
+	}
+	return pce.executeStatement(pp.stmt, pp.sql, pp.params, pp.resultFormats, false)
+}
+
+// handleClose closes the prepared statement or the portal
+func (pce *PgCmdExecutor) handleClose(data []byte) error {
+	if len(data) == 0 {
+		return NewPgError(pgProtocolViolation, "invalid CLOSE message")
+	}
+	name, _, err := pgReadString(data[1:])
+	if err != nil {
+		return err
+	}
+	switch data[0] {
+	case 'S':
+		delete(pce.stmts, name)
+	case 'P':
+		delete(pce.portals, name)
+	default:
+		return NewPgError(pgProtocolViolation, "invalid CLOSE message subtype %d", data[0])
+	}
+	return pce.proto.sendEmpty(pgMsgCloseComplete)
+}
+
+func (pce *PgCmdExecutor) newProcess() *process.Process {
+	ses := pce.ses
+	pce.sqlCount++
+	proc := process.New(mheap.New(ses.GuestMmu))
+	proc.Id = fmt.Sprintf("%d%d", pce.proto.ConnectionID(), pce.sqlCount)
+	proc.Lim.Size = ses.Pu.SV.GetProcessLimitationSize()
+	proc.Lim.BatchRows = ses.Pu.SV.GetProcessLimitationBatchRows()
+	proc.Lim.PartitionRows = ses.Pu.SV.GetProcessLimitationPartitionRows()
+	proc.TimeZone = ses.GetTimeZone()
+	return proc
+}
+
+// checkPrivilege checks the current user has the privileges the statement needs
+func (pce *PgCmdExecutor) checkPrivilege(stmt tree.Statement) error {
+	if pce.routineMgr == nil || pce.routineMgr.GetAccountManager() == nil {
+		return nil
+	}
+	user := pce.proto.GetUserName()
+	reqs := statementPrivileges(stmt, pce.proto.GetDatabaseName(), user)
+	return pce.routineMgr.GetAccountManager().Check(user, reqs)
+}
+
+// changeDB changes the database of the session, which must exist
+func (pce *PgCmdExecutor) changeDB(db string) (retErr error) {
+	txnHandler := pce.ses.GetTxnHandler()
+	if err := txnHandler.StartByStatement(); err != nil {
+		return err
+	}
+	defer func() {
+		retErr = txnHandler.CommitAfterStatement(retErr)
+	}()
+	if _, err := pce.ses.GetStorage().Database(db); err != nil {
+		return NewPgError(errno.InvalidCatalogName, "database \"%s\" does not exist", db)
+	}
+	pce.proto.SetDatabaseName(db)
+	return nil
+}
+
+/*
+executeStatement executes the statement and sends the results.
+The RowDescription is sent before the rows if describe is true,
+otherwise the client has got it by the Describe.
+*/
+func (pce *PgCmdExecutor) executeStatement(stmt tree.Statement, sql string, params []tree.Expr, formats []int16, describe bool) (retErr error) {
+	ses := pce.ses
+	proto := pce.proto
+	pdHook := ses.GetEpochgc()
+	txnHandler := ses.GetTxnHandler()
+
+	if ses.Pu.SV.GetRejectWhenHeartbeatFromPDLeaderIsTimeout() && !pdHook.CanAcceptSomething() {
+		return NewPgError(errno.ConnectionException, "heartbeat from pdleader is timeout. the server reject sql request")
+	}
+
+	//pin the epoch with 1
+	epoch, _ := pdHook.IncQueryCountAtCurrentEpoch(1)
+	defer func() {
+		pdHook.DecQueryCountAtEpoch(epoch, 1)
+	}()
+
+	//the failed statement finishes its transaction
+	defer func() {
+		if retErr != nil {
+			retErr = txnHandler.CommitAfterStatement(retErr)
+		}
+	}()
+
+	//check database
+	if proto.GetDatabaseName() == "" {
+		switch t := stmt.(type) {
+		case *tree.ShowDatabases, *tree.CreateDatabase, *tree.DropDatabase,
+			*tree.Use, *tree.SetVar,
+			*tree.BeginTransaction, *tree.CommitTransaction, *tree.RollbackTransaction,
+			*tree.CreateUser, *tree.DropUser, *tree.AlterUser, *tree.SetPassword,
+			*tree.CreateRole, *tree.DropRole, *tree.Grant, *tree.Revoke:
+		case *tree.ShowTables:
+			if t.DBName == "" {
+				return NewPgError(errno.InvalidCatalogName, "no database selected")
+			}
+		default:
+			return NewPgError(errno.InvalidCatalogName, "no database selected")
+		}
+	}
+
+	if err := pce.checkPrivilege(stmt); err != nil {
+		return err
+	}
+
+	switch st := stmt.(type) {
+	case *tree.BeginTransaction:
+		if err := txnHandler.BeginTxn(); err != nil {
+			return err
+		}
+		return proto.sendCommandComplete("BEGIN")
+	case *tree.CommitTransaction:
+		if err := txnHandler.CommitTxn(); err != nil {
+			return err
+		}
+		return proto.sendCommandComplete("COMMIT")
+	case *tree.RollbackTransaction:
+		if err := txnHandler.RollbackTxn(); err != nil {
+			return err
+		}
+		return proto.sendCommandComplete("ROLLBACK")
+	case *tree.Use:
+		if err := pce.changeDB(st.Name); err != nil {
+			return err
+		}
+		return proto.sendCommandComplete("SET")
+	case *tree.SetVar:
+		if err := setVariables(ses, st); err != nil {
+			return err
+		}
+		return proto.sendCommandComplete("SET")
+	case *tree.ShowVariables:
+		if describe {
+			if err := proto.sendRowDescription(pgShowVariablesColumns, []types.T{types.T_varchar, types.T_varchar}, []int16{0, 0}); err != nil {
+				return err
+			}
+		}
+		return proto.sendCommandComplete("SELECT 0")
+	case *tree.CreateUser, *tree.DropUser, *tree.AlterUser,
+		*tree.CreateRole, *tree.DropRole,
+		*tree.Grant, *tree.Revoke, *tree.SetPassword:
+		if pce.routineMgr == nil || pce.routineMgr.GetAccountManager() == nil {
+			return NewPgError(errno.FeatureNotSupported, "account management is not supported")
+		}
+		if err := runAccountStmt(pce.routineMgr.GetAccountManager(), st, proto.GetUserName(), proto.GetDatabaseName()); err != nil {
+			return err
+		}
+		return proto.sendCommandComplete(pgCommandTag(st, 0))
+	case *tree.Load, *tree.PrepareString, *tree.PrepareVar, *tree.Execute, *tree.Deallocate,
+		*tree.AnalyzeStmt, *tree.ShowStats, *tree.ExplainStmt, *tree.ExplainAnalyze:
+		return NewPgError(errno.FeatureNotSupported, "the statement is not supported by the postgresql protocol")
+	}
+
+	//the statement runs in the active transaction or a new one
+	if err := txnHandler.StartByStatement(); err != nil {
+		return err
+	}
+	if st, ok := stmt.(*tree.DropDatabase); ok && string(st.Name) == proto.GetDatabaseName() {
+		// if the droped database is the same as the one in use, database must be reseted to empty.
+		proto.SetDatabaseName("")
+	}
+
+	res := &pgResult{
+		proto: proto,
+		loc:   ses.GetTimeZone(),
+	}
+	comp := compile.New(proto.GetDatabaseName(), sql, proto.GetUserName(),
+		ses.GetStorage(), pce.newProcess())
+	comp.SetParams(params)
+	exec := comp.BuildStatements([]tree.Statement{stmt})[0]
+	cmpBegin := time.Now()
+	if err := exec.Compile(res, pgFillData); err != nil {
+		return err
+	}
+	if ses.Pu.SV.GetRecordTimeElapsedOfSqlRequest() {
+		logutil.Infof("time of Exec.Build : %s", time.Since(cmpBegin).String())
+	}
+
+	returnsRows := pgReturnsRows(exec.Statement())
+	if returnsRows {
+		names, typs := pgColumnsOf(exec.Columns())
+		var err error
+		if res.formats, err = pgResultFormats(formats, len(names)); err != nil {
+			return err
+		}
+		if describe {
+			if err = proto.sendRowDescription(names, typs, res.formats); err != nil {
+				return err
+			}
+		}
+	}
+
+	runBegin := time.Now()
+	if err := exec.Run(epoch); err != nil {
+		return err
+	}
+	if ses.Pu.SV.GetRecordTimeElapsedOfSqlRequest() {
+		logutil.Infof("time of Exec.Run : %s", time.Since(runBegin).String())
+	}
+	if err := txnHandler.CommitAfterStatement(nil); err != nil {
+		return err
+	}
+
+	//record ddl drop xxx after the success
+	switch stmt.(type) {
+	case *tree.DropTable, *tree.DropDatabase, *tree.DropIndex:
+		pdHook.IncDDLCountAtEpoch(epoch, 1)
+	}
+	if pce.routineMgr != nil && pce.routineMgr.GetStatsManager() != nil {
+		invalidateStats(pce.routineMgr.GetStatsManager(), stmt, proto.GetDatabaseName())
+	}
+
+	rows := exec.GetAffectedRows()
+	if returnsRows {
+		rows = atomic.LoadUint64(&res.rows)
+	}
+	return proto.sendCommandComplete(pgCommandTag(exec.Statement(), rows))
+}
+
+var pgShowVariablesColumns = []string{"VARIABLE_NAME", "VARIABLE_VALUE"}
+
+// pgReturnsRows checks the statement returns the rows or not
+func pgReturnsRows(stmt tree.Statement) bool {
+	switch stmt.(type) {
+	case *tree.Select,
+		*tree.ShowCreateTable, *tree.ShowCreateDatabase, *tree.ShowTables, *tree.ShowDatabases, *tree.ShowColumns,
+		*tree.ShowProcessList, *tree.ShowErrors, *tree.ShowWarnings, *tree.ShowVariables, *tree.ShowStatus,
+		*tree.ShowIndex:
+		return true
+	}
+	return false
+}
+
+// pgCommandTag returns the tag of the CommandComplete for the statement
+func pgCommandTag(stmt tree.Statement, rows uint64) string {
+	if pgReturnsRows(stmt) {
+		return fmt.Sprintf("SELECT %d", rows)
+	}
+	switch stmt.(type) {
+	case *tree.Insert:
+		return fmt.Sprintf("INSERT 0 %d", rows)
+	case *tree.Update:
+		return fmt.Sprintf("UPDATE %d", rows)
+	case *tree.Delete:
+		return fmt.Sprintf("DELETE %d", rows)
+	case *tree.CreateTable:
+		return "CREATE TABLE"
+	case *tree.DropTable:
+		return "DROP TABLE"
+	case *tree.AlterTable:
+		return "ALTER TABLE"
+	case *tree.CreateDatabase:
+		return "CREATE DATABASE"
+	case *tree.DropDatabase:
+		return "DROP DATABASE"
+	case *tree.CreateIndex:
+		return "CREATE INDEX"
+	case *tree.DropIndex:
+		return "DROP INDEX"
+	case *tree.CreateUser:
+		return "CREATE ROLE"
+	case *tree.DropUser:
+		return "DROP ROLE"
+	case *tree.AlterUser, *tree.SetPassword:
+		return "ALTER ROLE"
+	case *tree.CreateRole:
+		return "CREATE ROLE"
+	case *tree.DropRole:
+		return "DROP ROLE"
+	case *tree.Grant:
+		return "GRANT"
+	case *tree.Revoke:
+		return "REVOKE"
+	}
+	return "OK"
+}
+
+func pgColumnsOf(cols []*compile.Col) ([]string, []types.T) {
+	names := make([]string, len(cols))
+	typs := make([]types.T, len(cols))
+	for i, col := range cols {
+		names[i] = col.Name
+		typs[i] = col.Typ
+	}
+	return names, typs
+}
+
+/*
+pgResultFormats returns the formats of the n result columns.
+No format means all in the text, one format is for all the columns.
+*/
+func pgResultFormats(formats []int16, n int) ([]int16, error) {
+	if len(formats) > 1 && len(formats) != n {
+		return nil, NewPgError(pgProtocolViolation, "bind message has %d result formats but query has %d columns", len(formats), n)
+	}
+	rs := make([]int16, n)
+	for i := range rs {
+		rs[i] = pgFormatOf(formats, i)
+	}
+	return rs, nil
+}
+
+// pgFormatOf returns the format of the i-th value
+func pgFormatOf(formats []int16, i int) int16 {
+	switch len(formats) {
+	case 0:
+		return pgFormatText
+	case 1:
+		return formats[0]
+	}
+	return formats[i]
+}
+
+/*
+pgParamCountOf returns the count of the parameters which is the largest n of the placeholders $n.
+The ones in the strings, the quoted identifiers and the comments are skipped by the scanner.
+*/
+func pgParamCountOf(sql string) int {
+	count := 0
+	scan := scanner.NewPgLexicalScanner(sql)
+	for {
+		tok, str := scan.Scan()
+		switch tok {
+		case 0, scanner.LEX_ERROR:
+			return count
+		case scanner.VALUE_ARG:
+			if n, err := strconv.Atoi(str[2:]); err == nil && n > count && n <= math.MaxUint16 {
+				count = n
+			}
+		}
+	}
+}
+
+/*
+pgSampleParams returns the sample values of the parameter types,
+which the statement is described with before the parameters are bound.
+The null is not used because the plan can not infer the type of it.
+*/
+func pgSampleParams(oids []uint32) []tree.Expr {
+	params := make([]tree.Expr, len(oids))
+	for i, oid := range oids {
+		switch oid {
+		case pgOidInt2, pgOidInt4, pgOidInt8, pgOidNumeric:
+			params[i] = constantOfValue(int64(0))
+		case pgOidFloat4, pgOidFloat8:
+			params[i] = constantOfValue(float64(0))
+		case pgOidBool:
+			params[i] = constantOfValue(false)
+		default:
+			params[i] = constantOfValue("")
+		}
+	}
+	return params
+}
+
+/*
+pgParamOf converts the parameter of the type in the format into the value bound to the statement.
+*/
+func pgParamOf(oid uint32, format int16, data []byte, isNull bool) (tree.Expr, error) {
+	if isNull {
+		return constantOfValue(nil), nil
+	}
+	if format == pgFormatBinary {
+		return pgParamOfBinary(oid, data)
+	}
+	s := string(data)
+	switch oid {
+	case pgOidInt2, pgOidInt4, pgOidInt8:
+		v, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			return nil, NewPgError(pgInvalidTextRepresentation, "invalid input syntax for type integer: \"%s\"", s)
+		}
+		return constantOfValue(v), nil
+	case pgOidFloat4, pgOidFloat8:
+		v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil || math.IsInf(v, 0) || math.IsNaN(v) {
+			return nil, NewPgError(pgInvalidTextRepresentation, "invalid input syntax for type double precision: \"%s\"", s)
+		}
+		return constantOfValue(v), nil
+	case pgOidNumeric:
+		s = strings.TrimSpace(s)
+		if !isNumericLiteral(s) {
+			return nil, NewPgError(pgInvalidTextRepresentation, "invalid input syntax for type numeric: \"%s\"", s)
+		}
+		return pgNumericOf(s), nil
+	case pgOidBool:
+		switch strings.ToLower(strings.TrimSpace(s)) {
+		case "t", "true", "y", "yes", "on", "1":
+			return constantOfValue(true), nil
+		case "f", "false", "n", "no", "off", "0":
+			return constantOfValue(false), nil
+		}
+		return nil, NewPgError(pgInvalidTextRepresentation, "invalid input syntax for type boolean: \"%s\"", s)
+	case pgOidBytea:
+		if strings.HasPrefix(s, "\\x") {
+			b, err := hex.DecodeString(s[2:])
+			if err != nil {
+				return nil, NewPgError(pgInvalidTextRepresentation, "invalid hexadecimal data")
+			}
+			return constantOfValue(b), nil
+		}
+	}
+	return constantOfValue(s), nil
+}
+
+// pgParamOfBinary converts the parameter in the binary format into the value bound to the statement
+func pgParamOfBinary(oid uint32, data []byte) (tree.Expr, error) {
+	invalid := NewPgError(pgInvalidBinaryRepresentation, "incorrect binary data format in bind parameter")
+	switch oid {
+	case pgOidInt2:
+		if len(data) != 2 {
+			return nil, invalid
+		}
+		return constantOfValue(int64(int16(binary.BigEndian.Uint16(data)))), nil
+	case pgOidInt4:
+		if len(data) != 4 {
+			return nil, invalid
+		}
+		return constantOfValue(int64(int32(binary.BigEndian.Uint32(data)))), nil
+	case pgOidInt8:
+		if len(data) != 8 {
+			return nil, invalid
+		}
+		return constantOfValue(int64(binary.BigEndian.Uint64(data))), nil
+	case pgOidFloat4:
+		if len(data) != 4 {
+			return nil, invalid
+		}
+		return constantOfValue(math.Float32frombits(binary.BigEndian.Uint32(data))), nil
+	case pgOidFloat8:
+		if len(data) != 8 {
+			return nil, invalid
+		}
+		return constantOfValue(math.Float64frombits(binary.BigEndian.Uint64(data))), nil
+	case pgOidBool:
+		if len(data) != 1 {
+			return nil, invalid
+		}
+		return constantOfValue(data[0] != 0), nil
+	case pgOidNumeric:
+		s, err := pgNumericOfBinary(data)
+		if err != nil {
+			return nil, invalid
+		}
+		return pgNumericOf(s), nil
+	case pgOidUnknown, pgOidText, pgOidVarchar, pgOidBpchar, pgOidJson, pgOidBytea:
+		return constantOfValue(data), nil
+	}
+	return nil, NewPgError(errno.FeatureNotSupported, "the binary format of the parameter type %d is not supported", oid)
+}
+
+// pgNumericOf converts the decimal string into the integer or the float constant
+func pgNumericOf(s string) tree.Expr {
+	neg := strings.HasPrefix(s, "-")
+	lit, tok := strings.TrimLeft(s, "+-"), token.FLOAT
+	if !strings.ContainsAny(lit, ".eE") {
+		//the leading zeros are the octal in go
+		if lit, tok = strings.TrimLeft(lit, "0"), token.INT; lit == "" {
+			lit = "0"
+		}
+	}
+	v := constant.MakeFromLiteral(lit, tok, 0)
+	if neg {
+		v = constant.UnaryOp(token.SUB, v, 0)
+	}
+	return tree.NewNumVal(v, s, neg)
+}
+
+// pgNumericOfBinary converts the numeric in the binary format into the decimal string
+func pgNumericOfBinary(data []byte) (string, error) {
+	if len(data) < 8 {
+		return "", fmt.Errorf("invalid numeric")
+	}
+	ndigits := int(binary.BigEndian.Uint16(data))
+	weight := int(int16(binary.BigEndian.Uint16(data[2:])))
+	sign := binary.BigEndian.Uint16(data[4:])
+	scale := int(binary.BigEndian.Uint16(data[6:]))
+	if len(data) != 8+2*ndigits || (sign != 0 && sign != 0x4000) {
+		return "", fmt.Errorf("invalid numeric")
+	}
+	digit := func(i int) uint16 {
+		if i < 0 || i >= ndigits {
+			return 0
+		}
+		return binary.BigEndian.Uint16(data[8+2*i:])
+	}
+	var buf strings.Builder
+	if sign != 0 {
+		buf.WriteByte('-')
+	}
+	if weight < 0 {
+		buf.WriteByte('0')
+	}
+	for i := 0; i <= weight; i++ {
+		if i == 0 {
+			buf.WriteString(strconv.Itoa(int(digit(i))))
+		} else {
+			fmt.Fprintf(&buf, "%04d", digit(i))
+		}
+	}
+	if scale > 0 {
+		var frac strings.Builder
+		for i := weight + 1; frac.Len() < scale; i++ {
+			fmt.Fprintf(&frac, "%04d", digit(i))
+		}
+		buf.WriteByte('.')
+		buf.WriteString(frac.String()[:scale])
+	}
+	return buf.String(), nil
+}
+
+// isNumericLiteral checks the string is a decimal number like -1.5 or 2e10
+func isNumericLiteral(s string) bool {
+	if s == "" {
+		return false
+	}
+	if c := s[0]; c != '+' && c != '-' && c != '.' && (c < '0' || c > '9') {
+		return false
+	}
+	_, err := strconv.ParseFloat(s, 64)
+	return err == nil || err.(*strconv.NumError).Err == strconv.ErrRange
+}
+
+// pgReadString reads the string terminated by 0
+func pgReadString(data []byte) (string, []byte, error) {
+	for i, b := range data {
+		if b == 0 {
+			return string(data[:i]), data[i+1:], nil
+		}
+	}
+	return "", nil, NewPgError(pgProtocolViolation, "invalid string in message")
+}
+
+func pgReadInt16(data []byte) (int16, []byte, error) {
+	if len(data) < 2 {
+		return 0, nil, NewPgError(pgProtocolViolation, "insufficient data left in message")
+	}
+	return int16(binary.BigEndian.Uint16(data)), data[2:], nil
+}
+
+func pgReadInt32(data []byte) (int32, []byte, error) {
+	if len(data) < 4 {
+		return 0, nil, NewPgError(pgProtocolViolation, "insufficient data left in message")
+	}
+	return int32(binary.BigEndian.Uint32(data)), data[4:], nil
+}
+
+// pgReadFormats reads the count and the format codes
+func pgReadFormats(data []byte) ([]int16, []byte, error) {
+	n, data, err := pgReadInt16(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	formats := make([]int16, n)
+	for i := range formats {
+		if formats[i], data, err = pgReadInt16(data); err != nil {
+			return nil, nil, err
+		}
+		if formats[i] != pgFormatText && formats[i] != pgFormatBinary {
+			return nil, nil, NewPgError(pgProtocolViolation, "unsupported format code: %d", formats[i])
+		}
+	}
+	return formats, data, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/binary"
+	"go/constant"
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_pgParamCountOf(t *testing.T) {
+	require.Equal(t, 10, pgParamCountOf("select $1, '$2', \"$3\" -- $11\n, $10 /* $12 */ from t where a = $2"))
+	require.Equal(t, 1, pgParamCountOf("select 'a\\', $1"))
+	require.Equal(t, 0, pgParamCountOf("select 1"))
+}
+
+func Test_pgParamOf(t *testing.T) {
+	kases := []struct {
+		oid    uint32
+		format int16
+		data   []byte
+		want   interface{}
+	}{
+		{pgOidInt4, pgFormatText, []byte("12"), int64(12)},
+		{pgOidInt4, pgFormatBinary, pgInt32(-3), int64(-3)},
+		{pgOidInt8, pgFormatBinary, binary.BigEndian.AppendUint64(nil, 1<<40), int64(1 << 40)},
+		{pgOidFloat8, pgFormatBinary, binary.BigEndian.AppendUint64(nil, math.Float64bits(1.5)), 1.5},
+		{pgOidBool, pgFormatText, []byte("t"), true},
+		{pgOidText, pgFormatText, []byte("it's"), "it's"},
+		{pgOidUnknown, pgFormatBinary, []byte("a\\b"), "a\\b"},
+		{pgOidBytea, pgFormatText, []byte("\\x4142"), "AB"},
+	}
+	for _, k := range kases {
+		e, err := pgParamOf(k.oid, k.format, k.data, false)
+		require.NoError(t, err)
+		require.Equal(t, constantOfValue(k.want), e)
+	}
+	e, err := pgParamOf(pgOidInt4, pgFormatText, nil, true)
+	require.NoError(t, err)
+	require.Equal(t, constant.Unknown, e.(*tree.NumVal).Value.Kind())
+
+	for _, k := range []struct {
+		data string
+		kind constant.Kind
+		want string
+	}{{"-1.25", constant.Float, "-1.25"}, {"007", constant.Int, "7"}, {"-12", constant.Int, "-12"}, {"2e3", constant.Float, "2000"}} {
+		e, err = pgParamOf(pgOidNumeric, pgFormatText, []byte(k.data), false)
+		require.NoError(t, err)
+		v := e.(*tree.NumVal).Value
+		require.Equal(t, k.kind, v.Kind())
+		require.Equal(t, k.want, v.String())
+	}
+
+	for _, k := range []struct {
+		oid  uint32
+		data string
+	}{{pgOidInt4, "1; drop table t"}, {pgOidNumeric, "1) or (1"}, {pgOidNumeric, "NaN"}, {pgOidBool, "maybe"}} {
+		_, err = pgParamOf(k.oid, pgFormatText, []byte(k.data), false)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,716 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	sqlerror "github.com/matrixorigin/matrixone/pkg/sql/errors"
+)
+
+// the version 3.0 of the postgresql protocol and the special requests in the startup message
+const (
+	pgProtocolVersion = 196608
+	pgSSLRequest      = 80877103
+	pgGSSENCRequest   = 80877104
+	pgCancelRequest   = 80877102
+)
+
+// the max length of a message from the client
+const pgMaxMessageLength = 1 << 30
+
+// the messages from the client
+const (
+	pgMsgQuery     byte = 'Q'
+	pgMsgParse     byte = 'P'
+	pgMsgBind      byte = 'B'
+	pgMsgDescribe  byte = 'D'
+	pgMsgExecute   byte = 'E'
+	pgMsgSync      byte = 'S'
+	pgMsgClose     byte = 'C'
+	pgMsgFlush     byte = 'H'
+	pgMsgTerminate byte = 'X'
+	pgMsgPassword  byte = 'p'
+)
+
+// the messages from the server
+const (
+	pgMsgAuthentication       byte = 'R'
+	pgMsgParameterStatus      byte = 'S'
+	pgMsgBackendKeyData       byte = 'K'
+	pgMsgReadyForQuery        byte = 'Z'
+	pgMsgRowDescription       byte = 'T'
+	pgMsgDataRow              byte = 'D'
+	pgMsgCommandComplete      byte = 'C'
+	pgMsgEmptyQueryResponse   byte = 'I'
+	pgMsgErrorResponse        byte = 'E'
+	pgMsgParseComplete        byte = '1'
+	pgMsgBindComplete         byte = '2'
+	pgMsgCloseComplete        byte = '3'
+	pgMsgNoData               byte = 'n'
+	pgMsgParameterDescription byte = 't'
+)
+
+// the authentication requests
+const (
+	pgAuthOk                = 0
+	pgAuthCleartextPassword = 3
+	pgAuthSASL              = 10
+	pgAuthSASLContinue      = 11
+	pgAuthSASLFinal         = 12
+)
+
+// the status of the transaction in the ReadyForQuery
+const (
+	pgTxnIdle   byte = 'I'
+	pgTxnBlock  byte = 'T'
+	pgTxnFailed byte = 'E'
+)
+
+// the formats of the parameters and the results
+const (
+	pgFormatText   = 0
+	pgFormatBinary = 1
+)
+
+// the oids of the postgresql types
+const (
+	pgOidUnknown     = 0
+	pgOidBool        = 16
+	pgOidBytea       = 17
+	pgOidInt8        = 20
+	pgOidInt2        = 21
+	pgOidInt4        = 23
+	pgOidText        = 25
+	pgOidJson        = 114
+	pgOidFloat4      = 700
+	pgOidFloat8      = 701
+	pgOidBpchar      = 1042
+	pgOidVarchar     = 1043
+	pgOidDate        = 1082
+	pgOidTimestamp   = 1114
+	pgOidTimestamptz = 1184
+	pgOidNumeric     = 1700
+)
+
+// the error codes of the postgresql, which are not in the errno
+const (
+	pgProtocolViolation           = "08P01"
+	pgInvalidPassword             = "28P01"
+	pgInvalidTextRepresentation   = "22P02"
+	pgInvalidBinaryRepresentation = "22P03"
+)
+
+// the days from 0001-01-01 to 2000-01-01, the epoch of the postgresql dates
+const pgEpochDays = 730119
+
+/*
+pgTypeOf returns the oid and the length of the postgresql type the values of the type are sent as.
+The length is -1 for the types of variable length.
+The timestamps are sent as the timestamps without time zone in the time zone of the session,
+which are shown like the mysql protocol does.
+*/
+func pgTypeOf(typ types.T) (uint32, int16) {
+	switch typ {
+	case types.T_int8, types.T_int16, types.T_uint8:
+		return pgOidInt2, 2
+	case types.T_int32, types.T_uint16:
+		return pgOidInt4, 4
+	case types.T_int64, types.T_uint32:
+		return pgOidInt8, 8
+	case types.T_uint64, types.T_decimal64, types.T_decimal128:
+		return pgOidNumeric, -1
+	case types.T_float32:
+		return pgOidFloat4, 4
+	case types.T_float64:
+		return pgOidFloat8, 8
+	case types.T_char:
+		return pgOidBpchar, -1
+	case types.T_varchar:
+		return pgOidVarchar, -1
+	case types.T_json:
+		return pgOidJson, -1
+	case types.T_date:
+		return pgOidDate, 4
+	case types.T_datetime, types.T_timestamp:
+		return pgOidTimestamp, 8
+	default:
+		return pgOidText, -1
+	}
+}
+
+// PgError is the error sent to the client in the ErrorResponse
+type PgError struct {
+	Severity string
+	Code     string
+	Message  string
+}
+
+func (e *PgError) Error() string {
+	return e.Message
+}
+
+func NewPgError(code, format string, args ...interface{}) *PgError {
+	return &PgError{
+		Severity: "ERROR",
+		Code:     code,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+// pgErrorOf converts the error of the execution into the one of the postgresql protocol
+func pgErrorOf(err error) *PgError {
+	if err == errorTxnAborted {
+		return NewPgError(errno.InvalidTransactionState, "%v", err)
+	}
+	switch e := err.(type) {
+	case *PgError:
+		return e
+	case *MysqlError:
+		return NewPgError(e.SqlState, "%s", e.Error())
+	case *sqlerror.SqlError:
+		return NewPgError(e.Code(), "%s", e.Error())
+	}
+	return NewPgError(errno.InternalError, "%v", err)
+}
+
+/*
+PgProtocolImpl is the connection of the postgresql wire protocol.
+A message is a type byte and an int32 length which includes itself, followed by the content.
+The startup message from the client has no type byte.
+*/
+type PgProtocolImpl struct {
+	//mutex of writing the messages
+	lock sync.Mutex
+
+	conn   net.Conn
+	reader *bufio.Reader
+	writer *bufio.Writer
+
+	//the id of the connection, which is also the process id in the BackendKeyData
+	connectionID uint32
+
+	//the secret key in the BackendKeyData
+	secret uint32
+
+	// whether the startup succeeded
+	established bool
+
+	//whether the connection has been upgraded to TLS
+	secure bool
+
+	database string
+	username string
+
+	//the parameters in the startup message
+	params map[string]string
+
+	//the buffer of the message being written
+	msg []byte
+}
+
+func NewPgProtocol(connectionID uint32, conn net.Conn) *PgProtocolImpl {
+	return &PgProtocolImpl{
+		conn:         conn,
+		reader:       bufio.NewReader(conn),
+		writer:       bufio.NewWriter(conn),
+		connectionID: connectionID,
+		params:       make(map[string]string),
+	}
+}
+
+func (pp *PgProtocolImpl) IsEstablished() bool {
+	return pp.established
+}
+
+func (pp *PgProtocolImpl) SetEstablished() {
+	pp.established = true
+}
+
+// GetRequest gets the Request from the message. The cmd is the type of the message.
+func (pp *PgProtocolImpl) GetRequest(payload []byte) *Request {
+	return &Request{
+		cmd:  int(payload[0]),
+		data: payload[1:],
+	}
+}
+
+/*
+SendResponse sends the response in the messages of the postgresql protocol.
+The data of the OkResponse is the command tag.
+*/
+func (pp *PgProtocolImpl) SendResponse(resp *Response) error {
+	switch resp.category {
+	case OkResponse:
+		tag, _ := resp.data.(string)
+		return pp.sendCommandComplete(tag)
+	case ErrorResponse:
+		err, _ := resp.data.(error)
+		if err == nil {
+			return pp.sendCommandComplete("")
+		}
+		return pp.sendError(pgErrorOf(err))
+	default:
+		return fmt.Errorf("unsupported response:%d ", resp.category)
+	}
+}
+
+func (pp *PgProtocolImpl) ConnectionID() uint32 {
+	return pp.connectionID
+}
+
+func (pp *PgProtocolImpl) Peer() (string, string) {
+	host, port, err := net.SplitHostPort(pp.conn.RemoteAddr().String())
+	if err != nil {
+		logutil.Errorf("get peer host:port failed. error:%v ", err)
+		return "failed", "0"
+	}
+	return host, port
+}
+
+func (pp *PgProtocolImpl) GetDatabaseName() string {
+	return pp.database
+}
+
+func (pp *PgProtocolImpl) SetDatabaseName(db string) {
+	pp.database = db
+}
+
+func (pp *PgProtocolImpl) GetUserName() string {
+	return pp.username
+}
+
+func (pp *PgProtocolImpl) SetUserName(user string) {
+	pp.username = user
+}
+
+func (pp *PgProtocolImpl) Quit() {
+	if err := pp.conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		logutil.Errorf("close the postgresql connection failed. error:%v", err)
+	}
+}
+
+// readStartupMessage reads the length and the content of the startup message
+func (pp *PgProtocolImpl) readStartupMessage() ([]byte, error) {
+	var head [4]byte
+	if _, err := io.ReadFull(pp.reader, head[:]); err != nil {
+		return nil, err
+	}
+	length := binary.BigEndian.Uint32(head[:])
+	if length < 8 || length > 10000 {
+		return nil, fmt.Errorf("invalid length of the startup message %d", length)
+	}
+	data := make([]byte, length-4)
+	if _, err := io.ReadFull(pp.reader, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// readMessage reads the type and the content of the message from the client
+func (pp *PgProtocolImpl) readMessage() (byte, []byte, error) {
+	var head [5]byte
+	if _, err := io.ReadFull(pp.reader, head[:]); err != nil {
+		return 0, nil, err
+	}
+	length := binary.BigEndian.Uint32(head[1:])
+	if length < 4 || length > pgMaxMessageLength {
+		return 0, nil, fmt.Errorf("invalid length of the message %d", length)
+	}
+	data := make([]byte, length-4)
+	if _, err := io.ReadFull(pp.reader, data); err != nil {
+		return 0, nil, err
+	}
+	return head[0], data, nil
+}
+
+/*
+upgradeToTLS answers the SSLRequest and runs the TLS handshake.
+The client goes on with the startup message on the secure connection.
+*/
+func (pp *PgProtocolImpl) upgradeToTLS(cfg *tls.Config) error {
+	if _, err := pp.conn.Write([]byte{'S'}); err != nil {
+		return err
+	}
+	tlsConn := tls.Server(pp.conn, cfg)
+	if err := tlsConn.SetDeadline(time.Now().Add(tlsHandshakeTimeout)); err != nil {
+		return err
+	}
+	if err := tlsConn.Handshake(); err != nil {
+		return err
+	}
+	if err := tlsConn.SetDeadline(time.Time{}); err != nil {
+		return err
+	}
+	pp.conn = tlsConn
+	pp.reader = bufio.NewReader(tlsConn)
+	pp.writer = bufio.NewWriter(tlsConn)
+	pp.secure = true
+	return nil
+}
+
+// beginMessage starts to write the message of the type
+func (pp *PgProtocolImpl) beginMessage(typ byte) {
+	pp.msg = append(pp.msg[:0], typ, 0, 0, 0, 0)
+}
+
+func (pp *PgProtocolImpl) writeByte(b byte) {
+	pp.msg = append(pp.msg, b)
+}
+
+func (pp *PgProtocolImpl) writeInt16(v int16) {
+	pp.msg = append(pp.msg, byte(v>>8), byte(v))
+}
+
+func (pp *PgProtocolImpl) writeInt32(v int32) {
+	pp.msg = append(pp.msg, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (pp *PgProtocolImpl) writeBytes(b []byte) {
+	pp.msg = append(pp.msg, b...)
+}
+
+// writeString writes the string terminated by 0
+func (pp *PgProtocolImpl) writeString(s string) {
+	pp.msg = append(pp.msg, s...)
+	pp.msg = append(pp.msg, 0)
+}
+
+// endMessage fills the length and puts the message into the buffer of the connection
+func (pp *PgProtocolImpl) endMessage() error {
+	binary.BigEndian.PutUint32(pp.msg[1:], uint32(len(pp.msg)-1))
+	_, err := pp.writer.Write(pp.msg)
+	return err
+}
+
+// flush sends the buffered messages to the client
+func (pp *PgProtocolImpl) flush() error {
+	return pp.writer.Flush()
+}
+
+// sendAuthentication sends the authentication request with the data of the mechanism
+func (pp *PgProtocolImpl) sendAuthentication(code int32, data []byte) error {
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
+	pp.beginMessage(pgMsgAuthentication)
+	pp.writeInt32(code)
+	pp.writeBytes(data)
+	if err := pp.endMessage(); err != nil {
+		return err
+	}
+	return pp.flush()
+}
+
+func (pp *PgProtocolImpl) sendParameterStatus(name, value string) error {
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
+	pp.beginMessage(pgMsgParameterStatus)
+	pp.writeString(name)
+	pp.writeString(value)
+	return pp.endMessage()
+}
+
+func (pp *PgProtocolImpl) sendBackendKeyData() error {
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
+	pp.beginMessage(pgMsgBackendKeyData)
+	pp.writeInt32(int32(pp.connectionID))
+	pp.writeInt32(int32(pp.secret))
+	return pp.endMessage()
+}
+
+// sendReadyForQuery tells the client the server is ready for the next query, and flushes the messages
+func (pp *PgProtocolImpl) sendReadyForQuery(status byte) error {
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
+	pp.beginMessage(pgMsgReadyForQuery)
+	pp.writeByte(status)
+	if err := pp.endMessage(); err != nil {
+		return err
+	}
+	return pp.flush()
+}
+
+// sendEmpty sends the message without the content, such as the ParseComplete
+func (pp *PgProtocolImpl) sendEmpty(typ byte) error {
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
+	pp.beginMessage(typ)
+	return pp.endMessage()
+}
+
+func (pp *PgProtocolImpl) sendCommandComplete(tag string) error {
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
+	pp.beginMessage(pgMsgCommandComplete)
+	pp.writeString(tag)
+	return pp.endMessage()
+}
+
+// sendError sends the ErrorResponse and flushes it
+func (pp *PgProtocolImpl) sendError(e *PgError) error {
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
+	pp.beginMessage(pgMsgErrorResponse)
+	pp.writeByte('S')
+	pp.writeString(e.Severity)
+	pp.writeByte('V')
+	pp.writeString(e.Severity)
+	pp.writeByte('C')
+	pp.writeString(e.Code)
+	pp.writeByte('M')
+	pp.writeString(e.Message)
+	pp.writeByte(0)
+	if err := pp.endMessage(); err != nil {
+		return err
+	}
+	return pp.flush()
+}
+
+func (pp *PgProtocolImpl) sendParameterDescription(oids []uint32) error {
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
+	pp.beginMessage(pgMsgParameterDescription)
+	pp.writeInt16(int16(len(oids)))
+	for _, oid := range oids {
+		pp.writeInt32(int32(oid))
+	}
+	return pp.endMessage()
+}
+
+// sendRowDescription sends the names, the types and the formats of the result columns
+func (pp *PgProtocolImpl) sendRowDescription(names []string, typs []types.T, formats []int16) error {
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
+	pp.beginMessage(pgMsgRowDescription)
+	pp.writeInt16(int16(len(names)))
+	for i, name := range names {
+		oid, size := pgTypeOf(typs[i])
+		pp.writeString(name)
+		pp.writeInt32(0) //the oid of the table
+		pp.writeInt16(0) //the number of the column in the table
+		pp.writeInt32(int32(oid))
+		pp.writeInt16(size)
+		pp.writeInt32(-1) //the type modifier
+		pp.writeInt16(formats[i])
+	}
+	return pp.endMessage()
+}
+
+/*
+sendDataRows sends the rows of the batch in the formats.
+The row is sent z times for its multiplicity z.
+*/
+func (pp *PgProtocolImpl) sendDataRows(bat *batch.Batch, formats []int16, loc *time.Location) (uint64, error) {
+	pp.lock.Lock()
+	defer pp.lock.Unlock()
+	var cnt uint64
+	var err error
+	values := make([][]byte, len(bat.Vecs))
+	for j, z := range bat.Zs {
+		if z <= 0 {
+			continue
+		}
+		row := int64(j)
+		if len(bat.Sels) != 0 {
+			row = bat.Sels[j]
+		}
+		for i, vec := range bat.Vecs {
+			if values[i], err = pgValueOf(vec, int(row), formats[i], loc, values[i][:0]); err != nil {
+				return cnt, err
+			}
+		}
+		for k := int64(0); k < z; k++ {
+			pp.beginMessage(pgMsgDataRow)
+			pp.writeInt16(int16(len(values)))
+			for _, v := range values {
+				if v == nil {
+					pp.writeInt32(-1)
+					continue
+				}
+				pp.writeInt32(int32(len(v)))
+				pp.writeBytes(v)
+			}
+			if err = pp.endMessage(); err != nil {
+				return cnt, err
+			}
+			cnt++
+		}
+	}
+	return cnt, nil
+}
+
+/*
+pgValueOf appends the value of the row in the vector to the buf in the format.
+It returns nil for the null.
+*/
+func pgValueOf(vec *vector.Vector, row int, format int16, loc *time.Location, buf []byte) ([]byte, error) {
+	if nulls.Contains(vec.Nsp, uint64(row)) {
+		return nil, nil
+	}
+	if buf == nil {
+		buf = []byte{}
+	}
+	binaryFormat := format == pgFormatBinary
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		return pgAppendInt(buf, int64(vec.Col.([]int8)[row]), 2, binaryFormat), nil
+	case types.T_int16:
+		return pgAppendInt(buf, int64(vec.Col.([]int16)[row]), 2, binaryFormat), nil
+	case types.T_int32:
+		return pgAppendInt(buf, int64(vec.Col.([]int32)[row]), 4, binaryFormat), nil
+	case types.T_int64:
+		return pgAppendInt(buf, vec.Col.([]int64)[row], 8, binaryFormat), nil
+	case types.T_uint8:
+		return pgAppendInt(buf, int64(vec.Col.([]uint8)[row]), 2, binaryFormat), nil
+	case types.T_uint16:
+		return pgAppendInt(buf, int64(vec.Col.([]uint16)[row]), 4, binaryFormat), nil
+	case types.T_uint32:
+		return pgAppendInt(buf, int64(vec.Col.([]uint32)[row]), 8, binaryFormat), nil
+	case types.T_uint64:
+		return pgAppendNumeric(buf, strconv.FormatUint(vec.Col.([]uint64)[row], 10), binaryFormat)
+	case types.T_float32:
+		v := vec.Col.([]float32)[row]
+		if binaryFormat {
+			return binary.BigEndian.AppendUint32(buf, math.Float32bits(v)), nil
+		}
+		return strconv.AppendFloat(buf, float64(v), 'g', -1, 32), nil
+	case types.T_float64:
+		v := vec.Col.([]float64)[row]
+		if binaryFormat {
+			return binary.BigEndian.AppendUint64(buf, math.Float64bits(v)), nil
+		}
+		return strconv.AppendFloat(buf, v, 'g', -1, 64), nil
+	case types.T_char, types.T_varchar:
+		return append(buf, vec.Col.(*types.Bytes).Get(int64(row))...), nil
+	case types.T_json:
+		return append(buf, bytejson.ByteJson(vec.Col.(*types.Bytes).Get(int64(row))).String()...), nil
+	case types.T_date:
+		v := vec.Col.([]types.Date)[row]
+		if binaryFormat {
+			return binary.BigEndian.AppendUint32(buf, uint32(int32(v)-pgEpochDays)), nil
+		}
+		return append(buf, v.String()...), nil
+	case types.T_datetime:
+		return pgAppendDatetime(buf, vec.Col.([]types.Datetime)[row], vec.Typ.Precision, binaryFormat), nil
+	case types.T_timestamp:
+		//the timestamps in UTC are shown in the time zone of the session
+		return pgAppendDatetime(buf, vec.Col.([]types.Timestamp)[row].ToDatetime(loc), vec.Typ.Precision, binaryFormat), nil
+	case types.T_decimal64:
+		return pgAppendNumeric(buf, string(vec.Col.([]types.Decimal64)[row].Decimal64ToString(vec.Typ.Scale)), binaryFormat)
+	case types.T_decimal128:
+		return pgAppendNumeric(buf, string(vec.Col.([]types.Decimal128)[row].Decimal128ToString(vec.Typ.Scale)), binaryFormat)
+	}
+	return nil, fmt.Errorf("unsupported type %v of the postgresql protocol", vec.Typ)
+}
+
+// pgAppendInt appends the integer, whose binary format has the size in bytes
+func pgAppendInt(buf []byte, v int64, size int, binaryFormat bool) []byte {
+	if !binaryFormat {
+		return strconv.AppendInt(buf, v, 10)
+	}
+	switch size {
+	case 2:
+		return binary.BigEndian.AppendUint16(buf, uint16(v))
+	case 4:
+		return binary.BigEndian.AppendUint32(buf, uint32(v))
+	default:
+		return binary.BigEndian.AppendUint64(buf, uint64(v))
+	}
+}
+
+// pgAppendDatetime appends the datetime, whose binary format is the microseconds since 2000-01-01
+func pgAppendDatetime(buf []byte, dt types.Datetime, precision int32, binaryFormat bool) []byte {
+	if !binaryFormat {
+		return append(buf, dt.String2(precision)...)
+	}
+	hour, minute, sec := dt.Clock()
+	secs := (int64(dt.ToDate())-pgEpochDays)*86400 + int64(hour)*3600 + int64(minute)*60 + int64(sec)
+	return binary.BigEndian.AppendUint64(buf, uint64(secs*1000000+dt.MicroSec()))
+}
+
+/*
+pgAppendNumeric appends the decimal number in the string.
+The binary format is the number of the digits, the weight, the sign and the scale in int16,
+followed by the digits in base 10000. The weight is the exponent of the first digit.
+*/
+func pgAppendNumeric(buf []byte, s string, binaryFormat bool) ([]byte, error) {
+	if !binaryFormat {
+		return append(buf, s...), nil
+	}
+	sign := uint16(0)
+	if strings.HasPrefix(s, "-") {
+		sign = 0x4000
+		s = s[1:]
+	}
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	scale := len(fracPart)
+	if n := len(intPart) % 4; n > 0 {
+		intPart = strings.Repeat("0", 4-n) + intPart
+	}
+	if n := len(fracPart) % 4; n > 0 {
+		fracPart += strings.Repeat("0", 4-n)
+	}
+	digits := make([]uint16, 0, (len(intPart)+len(fracPart))/4)
+	for _, part := range []string{intPart, fracPart} {
+		for i := 0; i < len(part); i += 4 {
+			d, err := strconv.ParseUint(part[i:i+4], 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("invalid decimal %q", s)
+			}
+			digits = append(digits, uint16(d))
+		}
+	}
+	weight := len(intPart)/4 - 1
+	for len(digits) > 0 && digits[0] == 0 {
+		digits = digits[1:]
+		weight--
+	}
+	for len(digits) > 0 && digits[len(digits)-1] == 0 {
+		digits = digits[:len(digits)-1]
+	}
+	if len(digits) == 0 {
+		weight, sign = 0, 0
+	}
+	buf = binary.BigEndian.AppendUint16(buf, uint16(len(digits)))
+	buf = binary.BigEndian.AppendUint16(buf, uint16(int16(weight)))
+	buf = binary.BigEndian.AppendUint16(buf, sign)
+	buf = binary.BigEndian.AppendUint16(buf, uint16(scale))
+	for _, d := range digits {
+		buf = binary.BigEndian.AppendUint16(buf, d)
+	}
+	return buf, nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_pgTypeOf(t *testing.T) {
+	oid, size := pgTypeOf(types.T_int32)
+	require.Equal(t, uint32(pgOidInt4), oid)
+	require.Equal(t, int16(4), size)
+	oid, size = pgTypeOf(types.T_decimal128)
+	require.Equal(t, uint32(pgOidNumeric), oid)
+	require.Equal(t, int16(-1), size)
+	oid, _ = pgTypeOf(types.T_timestamp)
+	require.Equal(t, uint32(pgOidTimestamp), oid)
+}
+
+func Test_pgValueOf(t *testing.T) {
+	loc := time.UTC
+	vec := vector.New(types.Type{Oid: types.T_int32, Size: 4})
+	vec.Col = []int32{-2, 7}
+	nulls.Add(vec.Nsp, 1)
+	v, err := pgValueOf(vec, 0, pgFormatText, loc, nil)
+	require.NoError(t, err)
+	require.Equal(t, "-2", string(v))
+	v, err = pgValueOf(vec, 0, pgFormatBinary, loc, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xff, 0xff, 0xff, 0xfe}, v)
+	v, err = pgValueOf(vec, 1, pgFormatText, loc, nil)
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	vec = vector.New(types.Type{Oid: types.T_date, Size: 4})
+	vec.Col = []types.Date{pgEpochDays + 1}
+	v, err = pgValueOf(vec, 0, pgFormatText, loc, nil)
+	require.NoError(t, err)
+	require.Equal(t, "2000-01-02", string(v))
+	v, err = pgValueOf(vec, 0, pgFormatBinary, loc, nil)
+	require.NoError(t, err)
+	require.Equal(t, pgInt32(1), v)
+
+	vec = vector.New(types.Type{Oid: types.T_datetime, Size: 8})
+	dt, err := types.ParseDatetime("2000-01-01 00:00:01.500000")
+	require.NoError(t, err)
+	vec.Col = []types.Datetime{dt}
+	v, err = pgValueOf(vec, 0, pgFormatBinary, loc, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1500000), binary.BigEndian.Uint64(v))
+}
+
+func Test_pgNumeric(t *testing.T) {
+	for _, s := range []string{"0", "1", "-1", "12345.678", "0.0001", "-100000000.5", "9999", "10000"} {
+		b, err := pgAppendNumeric(nil, s, true)
+		require.NoError(t, err)
+		r, err := pgNumericOfBinary(b)
+		require.NoError(t, err)
+		require.Equal(t, s, r)
+	}
+	b, err := pgAppendNumeric(nil, "12345.678", true)
+	require.NoError(t, err)
+	//2 digits 1 2345 and 6780, weight 1, positive, scale 3
+	require.Equal(t, []byte{0, 3, 0, 1, 0, 0, 0, 3, 0, 1, 0x09, 0x29, 0x1a, 0x7c}, b)
+}
+
+func Test_pgErrorOf(t *testing.T) {
+	require.Equal(t, "42000", pgErrorOf(NewMysqlError(ER_PARSE_ERROR, "", "")).Code)
+	require.Equal(t, "XX000", pgErrorOf(fmt.Errorf("x")).Code)
+}
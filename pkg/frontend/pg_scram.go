@@ -0,0 +1,222 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	scramMechanism  = "SCRAM-SHA-256"
+	scramIterations = 4096
+	scramSaltSize   = 16
+	scramNonceSize  = 18
+)
+
+/*
+scramVerifier is the SCRAM-SHA-256 secret of a password.
+The server authenticates the client with it, neither the password nor
+the secret crosses the network.
+*/
+type scramVerifier struct {
+	iterations int
+	salt       []byte
+	storedKey  []byte
+	serverKey  []byte
+}
+
+// newScramVerifier makes the secret of the password with a random salt
+func newScramVerifier(password string) (*scramVerifier, error) {
+	salt := make([]byte, scramSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	return makeScramVerifier(password, salt, scramIterations), nil
+}
+
+func makeScramVerifier(password string, salt []byte, iterations int) *scramVerifier {
+	salted := scramHi([]byte(password), salt, iterations)
+	clientKey := scramHMAC(salted, []byte("Client Key"))
+	storedKey := sha256.Sum256(clientKey)
+	return &scramVerifier{
+		iterations: iterations,
+		salt:       salt,
+		storedKey:  storedKey[:],
+		serverKey:  scramHMAC(salted, []byte("Server Key")),
+	}
+}
+
+// String encodes the secret as SCRAM-SHA-256$<iterations>:<salt>$<StoredKey>:<ServerKey> like postgresql
+func (v *scramVerifier) String() string {
+	enc := base64.StdEncoding
+	return fmt.Sprintf("%s$%d:%s$%s:%s", scramMechanism, v.iterations,
+		enc.EncodeToString(v.salt), enc.EncodeToString(v.storedKey), enc.EncodeToString(v.serverKey))
+}
+
+func parseScramVerifier(s string) (*scramVerifier, error) {
+	parts := strings.Split(s, "$")
+	if len(parts) != 3 || parts[0] != scramMechanism {
+		return nil, fmt.Errorf("invalid %s secret", scramMechanism)
+	}
+	iter, salt, ok1 := strings.Cut(parts[1], ":")
+	stored, server, ok2 := strings.Cut(parts[2], ":")
+	if !ok1 || !ok2 {
+		return nil, fmt.Errorf("invalid %s secret", scramMechanism)
+	}
+	v := &scramVerifier{}
+	var err error
+	if v.iterations, err = strconv.Atoi(iter); err != nil {
+		return nil, err
+	}
+	enc := base64.StdEncoding
+	if v.salt, err = enc.DecodeString(salt); err != nil {
+		return nil, err
+	}
+	if v.storedKey, err = enc.DecodeString(stored); err != nil {
+		return nil, err
+	}
+	if v.serverKey, err = enc.DecodeString(server); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// scramHi is the PBKDF2 with HMAC-SHA-256 of a single block
+func scramHi(password, salt []byte, iterations int) []byte {
+	u := scramHMAC(password, append(append([]byte{}, salt...), 0, 0, 0, 1))
+	hi := append([]byte{}, u...)
+	for i := 1; i < iterations; i++ {
+		u = scramHMAC(password, u)
+		for j := range hi {
+			hi[j] ^= u[j]
+		}
+	}
+	return hi
+}
+
+func scramHMAC(key, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+/*
+scramServer is the server side of a SCRAM-SHA-256 exchange without the channel binding.
+The client sends the client-first-message and the client-final-message,
+the server answers them with the server-first-message and the server-final-message.
+*/
+type scramServer struct {
+	verifier        *scramVerifier
+	nonce           string
+	gs2Header       string
+	clientFirstBare string
+	serverFirst     string
+}
+
+func newScramServer(v *scramVerifier) (*scramServer, error) {
+	nonce := make([]byte, scramNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return &scramServer{verifier: v, nonce: base64.StdEncoding.EncodeToString(nonce)}, nil
+}
+
+// scramAttributes splits the attributes a=value of the message
+func scramAttributes(msg string) ([][2]string, error) {
+	var attrs [][2]string
+	for _, field := range strings.Split(msg, ",") {
+		if len(field) < 2 || field[1] != '=' {
+			return nil, fmt.Errorf("malformed SCRAM message")
+		}
+		attrs = append(attrs, [2]string{field[:1], field[2:]})
+	}
+	return attrs, nil
+}
+
+// first takes the client-first-message and returns the server-first-message
+func (s *scramServer) first(msg string) (string, error) {
+	//gs2-header is the channel binding flag and the authzid, the user is the one of the startup message
+	var cbind, authzid string
+	var ok bool
+	if cbind, msg, ok = strings.Cut(msg, ","); !ok {
+		return "", fmt.Errorf("malformed SCRAM message")
+	}
+	if cbind != "n" && cbind != "y" {
+		return "", fmt.Errorf("unsupported SCRAM channel binding")
+	}
+	if authzid, msg, ok = strings.Cut(msg, ","); !ok {
+		return "", fmt.Errorf("malformed SCRAM message")
+	}
+	s.gs2Header = cbind + "," + authzid + ","
+	s.clientFirstBare = msg
+	attrs, err := scramAttributes(msg)
+	if err != nil {
+		return "", err
+	}
+	if len(attrs) < 2 || attrs[0][0] != "n" || attrs[1][0] != "r" || attrs[1][1] == "" {
+		return "", fmt.Errorf("malformed SCRAM message")
+	}
+	s.nonce = attrs[1][1] + s.nonce
+	s.serverFirst = fmt.Sprintf("r=%s,s=%s,i=%d", s.nonce,
+		base64.StdEncoding.EncodeToString(s.verifier.salt), s.verifier.iterations)
+	return s.serverFirst, nil
+}
+
+/*
+final takes the client-final-message and returns the server-final-message.
+ok is false if the proof of the client is not the one of the password.
+*/
+func (s *scramServer) final(msg string) (serverFinal string, ok bool, err error) {
+	withoutProof, proof, found := strings.Cut(msg, ",p=")
+	if !found {
+		return "", false, fmt.Errorf("malformed SCRAM message")
+	}
+	attrs, err := scramAttributes(withoutProof)
+	if err != nil {
+		return "", false, err
+	}
+	if len(attrs) < 2 || attrs[0][0] != "c" || attrs[1][0] != "r" {
+		return "", false, fmt.Errorf("malformed SCRAM message")
+	}
+	if attrs[0][1] != base64.StdEncoding.EncodeToString([]byte(s.gs2Header)) {
+		return "", false, fmt.Errorf("unexpected SCRAM channel binding")
+	}
+	if attrs[1][1] != s.nonce {
+		return "", false, fmt.Errorf("unexpected SCRAM nonce")
+	}
+	clientProof, err := base64.StdEncoding.DecodeString(proof)
+	if err != nil || len(clientProof) != sha256.Size {
+		return "", false, fmt.Errorf("malformed SCRAM proof")
+	}
+
+	authMessage := []byte(s.clientFirstBare + "," + s.serverFirst + "," + withoutProof)
+	//ClientKey = ClientProof XOR HMAC(StoredKey, AuthMessage)
+	clientKey := scramHMAC(s.verifier.storedKey, authMessage)
+	for i := range clientKey {
+		clientKey[i] ^= clientProof[i]
+	}
+	storedKey := sha256.Sum256(clientKey)
+	if !hmac.Equal(storedKey[:], s.verifier.storedKey) {
+		return "", false, nil
+	}
+	serverSignature := scramHMAC(s.verifier.serverKey, authMessage)
+	return "v=" + base64.StdEncoding.EncodeToString(serverSignature), true, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_scramServer(t *testing.T) {
+	//the example of RFC 7677
+	salt, err := base64.StdEncoding.DecodeString("W22ZaJ0SNY7soEsUEjb6gQ==")
+	require.NoError(t, err)
+	v := makeScramVerifier("pencil", salt, 4096)
+	v2, err := parseScramVerifier(v.String())
+	require.NoError(t, err)
+	require.Equal(t, v, v2)
+
+	const nonce = "rOprNGfwEbeRWgbNEkqO%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0"
+	exchange := func(proof string) (string, bool, error) {
+		s, err := newScramServer(v2)
+		require.NoError(t, err)
+		s.nonce = "%hvYDpWUa2RaTCAfuxFIlj)hNlF$k0"
+		serverFirst, err := s.first("n,,n=user,r=rOprNGfwEbeRWgbNEkqO")
+		require.NoError(t, err)
+		require.Equal(t, "r="+nonce+",s=W22ZaJ0SNY7soEsUEjb6gQ==,i=4096", serverFirst)
+		return s.final("c=biws,r=" + nonce + ",p=" + proof)
+	}
+	serverFinal, ok, err := exchange("dHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ=")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "v=6rriTRBi23WpRR/wtup+mMhUZUn/dB5nLTJRsjl95G4=", serverFinal)
+
+	//the proof of the other password
+	_, ok, err = exchange("AHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ=")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	s, err := newScramServer(v2)
+	require.NoError(t, err)
+	_, err = s.first("p=tls-server-end-point,,n=user,r=abc")
+	require.Error(t, err)
+	_, err = s.first("n,,n=user")
+	require.Error(t, err)
+	_, err = s.first("n,,n=user,r=abc")
+	require.NoError(t, err)
+	_, _, err = s.final("c=biws,r=abc,p=dHzbZapWIk4jUhN+Ute9ytag9zjfMHgsqmmiz7AndVQ=")
+	require.Error(t, err)
+}
@@ -0,0 +1,402 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+)
+
+/*
+PgServer is the listener of the postgresql wire protocol.
+It shares the accounts, the epoch gc and the TLS config with the mysql listener.
+Every connection is served in its own goroutine.
+*/
+type PgServer struct {
+	addr     string
+	listener net.Listener
+	rm       *RoutineManager
+
+	lock   sync.Mutex
+	conns  map[net.Conn]struct{}
+	closed bool
+	wg     sync.WaitGroup
+}
+
+func NewPgServer(addr string, rm *RoutineManager) *PgServer {
+	return &PgServer{
+		addr:  addr,
+		rm:    rm,
+		conns: make(map[net.Conn]struct{}),
+	}
+}
+
+func (ps *PgServer) Start() error {
+	listener, err := net.Listen("tcp4", ps.addr)
+	if err != nil {
+		return err
+	}
+	ps.listener = listener
+	logutil.Infof("Postgresql Server Listening on : %s", listener.Addr().String())
+	ps.wg.Add(1)
+	go ps.accept()
+	return nil
+}
+
+// Stop closes the listener and the connections, then waits for the goroutines of them
+func (ps *PgServer) Stop() error {
+	ps.lock.Lock()
+	ps.closed = true
+	var err error
+	if ps.listener != nil {
+		err = ps.listener.Close()
+	}
+	for conn := range ps.conns {
+		_ = conn.Close()
+	}
+	ps.lock.Unlock()
+	ps.wg.Wait()
+	return err
+}
+
+// Addr returns the address the server listens on
+func (ps *PgServer) Addr() net.Addr {
+	return ps.listener.Addr()
+}
+
+func (ps *PgServer) accept() {
+	defer ps.wg.Done()
+	for {
+		conn, err := ps.listener.Accept()
+		if err != nil {
+			ps.lock.Lock()
+			closed := ps.closed
+			ps.lock.Unlock()
+			if !closed {
+				logutil.Errorf("the postgresql server accepts failed. error:%v", err)
+			}
+			return
+		}
+		ps.lock.Lock()
+		if ps.closed {
+			ps.lock.Unlock()
+			_ = conn.Close()
+			return
+		}
+		ps.conns[conn] = struct{}{}
+		ps.wg.Add(1)
+		ps.lock.Unlock()
+		go ps.serve(conn)
+	}
+}
+
+func (ps *PgServer) serve(conn net.Conn) {
+	defer ps.wg.Done()
+	defer func() {
+		ps.lock.Lock()
+		delete(ps.conns, conn)
+		ps.lock.Unlock()
+	}()
+
+	proto := NewPgProtocol(nextConnectionID(), conn)
+	proto.secret = rand.Uint32()
+	defer proto.Quit()
+
+	if err := ps.startup(proto); err != nil {
+		logutil.Errorf("the postgresql startup failed. error:%v", err)
+		return
+	}
+
+	pu := ps.rm.getParameterUnit()
+	ses := NewSession(proto, ps.rm.getEpochgc(), guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu), pu.Mempool, pu)
+	//the active transaction of the closed connection is rolled back
+	defer ses.Close()
+
+	exe := NewPgCmdExecutor(ses, proto, ps.rm)
+	if db := proto.params["database"]; db != "" {
+		if err := exe.changeDB(db); err != nil {
+			fail := pgErrorOf(err)
+			fail.Severity = "FATAL"
+			_ = proto.sendError(fail)
+			return
+		}
+	}
+	if err := ps.sendStartupParameters(proto); err != nil {
+		logutil.Errorf("the postgresql startup failed. error:%v", err)
+		return
+	}
+	if err := exe.Loop(); err != nil && !errors.Is(err, net.ErrClosed) {
+		logutil.Errorf("the postgresql connection %d quits. error:%v", proto.ConnectionID(), err)
+	}
+}
+
+/*
+startup reads the startup message and authenticates the user.
+The SSLRequest before the startup message upgrades the connection to TLS if it is configured.
+*/
+func (ps *PgServer) startup(proto *PgProtocolImpl) error {
+	for {
+		data, err := proto.readStartupMessage()
+		if err != nil {
+			return err
+		}
+		code := binary.BigEndian.Uint32(data)
+		switch code {
+		case pgSSLRequest:
+			if ps.rm.tlsConfig != nil && !proto.secure {
+				if err = proto.upgradeToTLS(ps.rm.tlsConfig); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err = proto.conn.Write([]byte{'N'}); err != nil {
+				return err
+			}
+			continue
+		case pgGSSENCRequest:
+			if _, err = proto.conn.Write([]byte{'N'}); err != nil {
+				return err
+			}
+			continue
+		case pgCancelRequest:
+			return fmt.Errorf("the cancel request is not supported")
+		case pgProtocolVersion:
+		default:
+			return ps.fail(proto, NewPgError(pgProtocolViolation, "unsupported frontend protocol %d.%d", code>>16, code&0xffff))
+		}
+
+		//the parameters are the pairs of the names and the values
+		params := data[4:]
+		for len(params) > 0 && params[0] != 0 {
+			var name, value string
+			if name, params, err = pgReadString(params); err != nil {
+				return ps.fail(proto, NewPgError(pgProtocolViolation, "invalid startup packet layout"))
+			}
+			if value, params, err = pgReadString(params); err != nil {
+				return ps.fail(proto, NewPgError(pgProtocolViolation, "invalid startup packet layout"))
+			}
+			proto.params[name] = value
+		}
+		break
+	}
+
+	user := proto.params["user"]
+	if user == "" {
+		return ps.fail(proto, NewPgError(errno.InvalidAuthorizationSpecification, "no PostgreSQL user name specified in startup packet"))
+	}
+	proto.SetUserName(user)
+
+	if ps.rm.getParameterUnit().SV.GetRequireSecureTransport() && !proto.secure {
+		return ps.fail(proto, NewPgError(errno.InvalidAuthorizationSpecification, "connections using insecure transport are prohibited"))
+	}
+
+	if err := ps.authenticate(proto, user); err != nil {
+		return err
+	}
+	proto.SetEstablished()
+	return proto.sendAuthentication(pgAuthOk, nil)
+}
+
+// fail sends the fatal error to the client and returns it
+func (ps *PgServer) fail(proto *PgProtocolImpl, e *PgError) error {
+	e.Severity = "FATAL"
+	_ = proto.sendError(e)
+	return e
+}
+
+/*
+authenticate checks the password of the user with SCRAM-SHA-256, so that the password
+does not cross the network.
+The user whose password is set by its mysql_native_password hash has no SCRAM-SHA-256 secret,
+it sends the password in cleartext which is allowed only on the connection upgraded to TLS.
+The user without password logs in with the empty password as it does over mysql.
+The superuser and the user dump without the accounts log in with the password in the config.
+*/
+func (ps *PgServer) authenticate(proto *PgProtocolImpl, user string) error {
+	failed := NewPgError(pgInvalidPassword, "password authentication failed for user \"%s\"", user)
+	var hash2 []byte
+	var secret string
+	exists := true
+	accounts := ps.rm.GetAccountManager()
+	if accounts != nil && !accounts.IsSuperuser(user) {
+		var err error
+		if hash2, exists, err = accounts.GetAuthentication(user); err == nil && exists {
+			secret, err = accounts.GetScramSecret(user)
+		}
+		if err != nil {
+			logutil.Errorf("get the authentication of the user %s failed. error:%v", user, err)
+			return ps.fail(proto, failed)
+		}
+	} else {
+		//the user dump for test, it needs the password as it does over mysql
+		sv := ps.rm.getParameterUnit().SV
+		exists = user == sv.GetDumpuser() && sv.GetDumppassword() != ""
+		if exists {
+			hash2 = hashPassword(sv.GetDumppassword())
+			v, err := newScramVerifier(sv.GetDumppassword())
+			if err != nil {
+				return err
+			}
+			secret = v.String()
+		}
+	}
+
+	switch {
+	case exists && len(hash2) == 0:
+		password, err := ps.readPassword(proto)
+		if err != nil {
+			return err
+		}
+		if password != "" {
+			return ps.fail(proto, failed)
+		}
+		return nil
+	case exists && secret == "":
+		if !proto.secure {
+			return ps.fail(proto, NewPgError(errno.InvalidAuthorizationSpecification,
+				"the password of the user \"%s\" is sent in cleartext only over SSL, set the password again to use %s", user, scramMechanism))
+		}
+		password, err := ps.readPassword(proto)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(hash2, hashPassword(password)) {
+			return ps.fail(proto, failed)
+		}
+		return nil
+	}
+
+	var v *scramVerifier
+	var err error
+	if exists {
+		v, err = parseScramVerifier(secret)
+	} else {
+		//the unknown user fails as the wrong password does
+		v, err = newScramVerifier(user)
+	}
+	if err != nil {
+		return err
+	}
+	ok, err := ps.scramExchange(proto, v)
+	if err != nil {
+		return err
+	}
+	if !ok || !exists {
+		return ps.fail(proto, failed)
+	}
+	return nil
+}
+
+// readPassword asks the client for the password in cleartext
+func (ps *PgServer) readPassword(proto *PgProtocolImpl) (string, error) {
+	if err := proto.sendAuthentication(pgAuthCleartextPassword, nil); err != nil {
+		return "", err
+	}
+	typ, data, err := proto.readMessage()
+	if err != nil {
+		return "", err
+	}
+	if typ != pgMsgPassword {
+		return "", ps.fail(proto, NewPgError(pgProtocolViolation, "expected password response, got message type %d", typ))
+	}
+	password, _, err := pgReadString(data)
+	if err != nil {
+		return "", ps.fail(proto, NewPgError(pgProtocolViolation, "invalid password packet"))
+	}
+	return password, nil
+}
+
+/*
+scramExchange authenticates the client with the SCRAM-SHA-256 secret.
+The SASLInitialResponse carries the client-first-message and the SASLResponse the client-final-message.
+ok is false if the client does not know the password.
+*/
+func (ps *PgServer) scramExchange(proto *PgProtocolImpl, v *scramVerifier) (ok bool, err error) {
+	//the names of the mechanisms terminated by 0, followed by an empty name
+	if err = proto.sendAuthentication(pgAuthSASL, append([]byte(scramMechanism), 0, 0)); err != nil {
+		return false, err
+	}
+	typ, data, err := proto.readMessage()
+	if err != nil {
+		return false, err
+	}
+	if typ != pgMsgPassword {
+		return false, ps.fail(proto, NewPgError(pgProtocolViolation, "expected SASL response, got message type %d", typ))
+	}
+	mechanism, data, err := pgReadString(data)
+	if err != nil {
+		return false, ps.fail(proto, NewPgError(pgProtocolViolation, "invalid SASL initial response"))
+	}
+	if mechanism != scramMechanism {
+		return false, ps.fail(proto, NewPgError(pgProtocolViolation, "client selected an invalid SASL authentication mechanism"))
+	}
+	size, data, err := pgReadInt32(data)
+	if err != nil || int(size) != len(data) {
+		return false, ps.fail(proto, NewPgError(pgProtocolViolation, "invalid SASL initial response"))
+	}
+
+	server, err := newScramServer(v)
+	if err != nil {
+		return false, err
+	}
+	serverFirst, err := server.first(string(data))
+	if err != nil {
+		return false, ps.fail(proto, NewPgError(pgProtocolViolation, "%v", err))
+	}
+	if err = proto.sendAuthentication(pgAuthSASLContinue, []byte(serverFirst)); err != nil {
+		return false, err
+	}
+	if typ, data, err = proto.readMessage(); err != nil {
+		return false, err
+	}
+	if typ != pgMsgPassword {
+		return false, ps.fail(proto, NewPgError(pgProtocolViolation, "expected SASL response, got message type %d", typ))
+	}
+	serverFinal, ok, err := server.final(string(data))
+	if err != nil {
+		return false, ps.fail(proto, NewPgError(pgProtocolViolation, "%v", err))
+	}
+	if !ok {
+		return false, nil
+	}
+	return true, proto.sendAuthentication(pgAuthSASLFinal, []byte(serverFinal))
+}
+
+// sendStartupParameters sends the parameters the client needs and the key of the connection
+func (ps *PgServer) sendStartupParameters(proto *PgProtocolImpl) error {
+	params := [][2]string{
+		{"server_version", serverVersion},
+		{"server_encoding", "UTF8"},
+		{"client_encoding", "UTF8"},
+		{"DateStyle", "ISO, MDY"},
+		{"integer_datetimes", "on"},
+		{"standard_conforming_strings", "on"},
+	}
+	for _, p := range params {
+		if err := proto.sendParameterStatus(p[0], p[1]); err != nil {
+			return err
+		}
+	}
+	return proto.sendBackendKeyData()
+}
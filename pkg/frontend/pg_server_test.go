@@ -0,0 +1,489 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+type pgTestMessage struct {
+	typ  byte
+	data []byte
+}
+
+// pgTestClient is the client speaking the postgresql protocol for the tests
+type pgTestClient struct {
+	t    *testing.T
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func newPgTestClient(t *testing.T, addr string) *pgTestClient {
+	conn, err := net.DialTimeout("tcp", addr, 10*time.Second)
+	require.NoError(t, err)
+	require.NoError(t, conn.SetDeadline(time.Now().Add(time.Minute)))
+	return &pgTestClient{t: t, conn: conn, r: bufio.NewReader(conn)}
+}
+
+func pgCString(s string) []byte {
+	return append([]byte(s), 0)
+}
+
+func pgInt16(v int) []byte {
+	return binary.BigEndian.AppendUint16(nil, uint16(v))
+}
+
+func pgInt32(v int) []byte {
+	return binary.BigEndian.AppendUint32(nil, uint32(v))
+}
+
+func (c *pgTestClient) send(typ byte, parts ...[]byte) {
+	var body []byte
+	for _, p := range parts {
+		body = append(body, p...)
+	}
+	msg := []byte{typ}
+	msg = append(msg, pgInt32(len(body)+4)...)
+	_, err := c.conn.Write(append(msg, body...))
+	require.NoError(c.t, err)
+}
+
+func (c *pgTestClient) startup(params ...string) {
+	body := pgInt32(pgProtocolVersion)
+	for _, p := range params {
+		body = append(body, pgCString(p)...)
+	}
+	body = append(body, 0)
+	_, err := c.conn.Write(append(pgInt32(len(body)+4), body...))
+	require.NoError(c.t, err)
+}
+
+func (c *pgTestClient) recv() pgTestMessage {
+	var head [5]byte
+	_, err := io.ReadFull(c.r, head[:])
+	require.NoError(c.t, err)
+	data := make([]byte, binary.BigEndian.Uint32(head[1:])-4)
+	_, err = io.ReadFull(c.r, data)
+	require.NoError(c.t, err)
+	return pgTestMessage{typ: head[0], data: data}
+}
+
+// recvUntilReady receives the messages until the ReadyForQuery
+func (c *pgTestClient) recvUntilReady() []pgTestMessage {
+	var msgs []pgTestMessage
+	for {
+		msg := c.recv()
+		msgs = append(msgs, msg)
+		if msg.typ == pgMsgReadyForQuery {
+			return msgs
+		}
+	}
+}
+
+// login does the startup with the password and returns the messages after the authentication
+func (c *pgTestClient) login(user, password, db string) []pgTestMessage {
+	c.startup("user", user, "database", db)
+	msg := c.authenticate(password)
+	if msg.typ == pgMsgErrorResponse {
+		return []pgTestMessage{msg}
+	}
+	require.Equal(c.t, pgMsgAuthentication, msg.typ)
+	require.Equal(c.t, uint32(pgAuthOk), binary.BigEndian.Uint32(msg.data))
+	//the fatal error closes the connection without the ReadyForQuery
+	var msgs []pgTestMessage
+	for msg.typ != pgMsgReadyForQuery && msg.typ != pgMsgErrorResponse {
+		msg = c.recv()
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
+
+// authenticate answers the authentication requests with the password and returns the message after them
+func (c *pgTestClient) authenticate(password string) pgTestMessage {
+	msg := c.recv()
+	if msg.typ != pgMsgAuthentication {
+		return msg
+	}
+	switch binary.BigEndian.Uint32(msg.data) {
+	case pgAuthCleartextPassword:
+		c.send(pgMsgPassword, pgCString(password))
+		return c.recv()
+	case pgAuthSASL:
+		require.Equal(c.t, scramMechanism+"\x00\x00", string(msg.data[4:]))
+	default:
+		return msg
+	}
+
+	clientFirstBare := "n=,r=clientnonce"
+	c.send(pgMsgPassword, pgCString(scramMechanism), pgInt32(len(clientFirstBare)+3), []byte("n,,"+clientFirstBare))
+	msg = c.recv()
+	if msg.typ == pgMsgErrorResponse {
+		return msg
+	}
+	require.Equal(c.t, uint32(pgAuthSASLContinue), binary.BigEndian.Uint32(msg.data))
+	serverFirst := string(msg.data[4:])
+	attrs, err := scramAttributes(serverFirst)
+	require.NoError(c.t, err)
+	require.Equal(c.t, 3, len(attrs))
+	require.True(c.t, strings.HasPrefix(attrs[0][1], "clientnonce"))
+	salt, err := base64.StdEncoding.DecodeString(attrs[1][1])
+	require.NoError(c.t, err)
+	iterations, err := strconv.Atoi(attrs[2][1])
+	require.NoError(c.t, err)
+
+	salted := scramHi([]byte(password), salt, iterations)
+	clientKey := scramHMAC(salted, []byte("Client Key"))
+	storedKey := sha256.Sum256(clientKey)
+	withoutProof := "c=biws,r=" + attrs[0][1]
+	authMessage := []byte(clientFirstBare + "," + serverFirst + "," + withoutProof)
+	proof := scramHMAC(storedKey[:], authMessage)
+	for i := range proof {
+		proof[i] ^= clientKey[i]
+	}
+	c.send(pgMsgPassword, []byte(withoutProof+",p="+base64.StdEncoding.EncodeToString(proof)))
+	msg = c.recv()
+	if msg.typ == pgMsgErrorResponse {
+		return msg
+	}
+	require.Equal(c.t, uint32(pgAuthSASLFinal), binary.BigEndian.Uint32(msg.data))
+	serverSignature := scramHMAC(scramHMAC(salted, []byte("Server Key")), authMessage)
+	require.Equal(c.t, "v="+base64.StdEncoding.EncodeToString(serverSignature), string(msg.data[4:]))
+	return c.recv()
+}
+
+func pgTypesOf(msgs []pgTestMessage) string {
+	var s strings.Builder
+	for _, msg := range msgs {
+		s.WriteByte(msg.typ)
+	}
+	return s.String()
+}
+
+// pgErrorFields returns the code and the message of the ErrorResponse
+func pgErrorFields(t *testing.T, msg pgTestMessage) (string, string) {
+	require.Equal(t, pgMsgErrorResponse, msg.typ)
+	var code, message string
+	for data := msg.data; len(data) > 1; {
+		field := data[0]
+		value, rest, err := pgReadString(data[1:])
+		require.NoError(t, err)
+		switch field {
+		case 'C':
+			code = value
+		case 'M':
+			message = value
+		}
+		data = rest
+	}
+	return code, message
+}
+
+// pgDataRow returns the values of the DataRow. nil is the null.
+func pgDataRow(t *testing.T, msg pgTestMessage) [][]byte {
+	require.Equal(t, pgMsgDataRow, msg.typ)
+	n, data, err := pgReadInt16(msg.data)
+	require.NoError(t, err)
+	values := make([][]byte, n)
+	for i := range values {
+		var size int32
+		size, data, err = pgReadInt32(data)
+		require.NoError(t, err)
+		if size >= 0 {
+			values[i], data = data[:size], data[size:]
+		}
+	}
+	return values
+}
+
+func startPgTestServer(t *testing.T, lines ...string) *PgServer {
+	compile.InitAddress("127.0.0.1")
+	sv := loadTestVars(t, t.TempDir(), lines...)
+	pu := config.NewParameterUnit(sv, host.New(sv.GetHostMmuLimitation()), mempool.New(), memEngine.NewTestEngine(), nil, nil)
+	ppu := NewPDCallbackParameterUnit(int(sv.GetPeriodOfEpochTimer()), int(sv.GetPeriodOfPersistence()), int(sv.GetPeriodOfDDLDeleteTimer()), int(sv.GetTimeoutOfHeartbeat()), sv.GetEnableEpochLogging(), math.MaxInt64)
+	rm := NewRoutineManager(pu, NewPDCallbackImpl(ppu))
+	tlsConfig, err := loadTLSConfig(sv)
+	require.NoError(t, err)
+	rm.tlsConfig = tlsConfig
+	ps := NewPgServer("127.0.0.1:0", rm)
+	require.NoError(t, ps.Start())
+	return ps
+}
+
+func Test_PgServerStartup(t *testing.T) {
+	ps := startPgTestServer(t)
+	defer ps.Stop()
+	addr := ps.Addr().String()
+
+	c := newPgTestClient(t, addr)
+	code, _ := pgErrorFields(t, c.login("dump", "112", "test")[0])
+	require.Equal(t, pgInvalidPassword, code)
+	c.conn.Close()
+
+	c = newPgTestClient(t, addr)
+	msgs := c.login("dump", "111", "nodb")
+	code, _ = pgErrorFields(t, msgs[len(msgs)-1])
+	require.Equal(t, "3D000", code)
+	c.conn.Close()
+
+	//the server without TLS refuses the SSLRequest
+	c = newPgTestClient(t, addr)
+	_, err := c.conn.Write(append(pgInt32(8), pgInt32(pgSSLRequest)...))
+	require.NoError(t, err)
+	b, err := c.r.ReadByte()
+	require.NoError(t, err)
+	require.Equal(t, byte('N'), b)
+	msgs = c.login("dump", "111", "test")
+	require.Equal(t, "SSSSSSKZ", pgTypesOf(msgs))
+	require.Equal(t, []byte{pgTxnIdle}, msgs[len(msgs)-1].data)
+	c.send(pgMsgTerminate)
+	c.conn.Close()
+}
+
+func Test_PgServerTLS(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeTestCert(t, dir)
+	ps := startPgTestServer(t,
+		fmt.Sprintf("tlsCertFile = %q", certFile),
+		fmt.Sprintf("tlsKeyFile = %q", keyFile),
+		"requireSecureTransport = true")
+	defer ps.Stop()
+	addr := ps.Addr().String()
+
+	c := newPgTestClient(t, addr)
+	c.startup("user", "dump", "database", "test")
+	code, _ := pgErrorFields(t, c.recv())
+	require.Equal(t, "28000", code)
+	c.conn.Close()
+
+	c = newPgTestClient(t, addr)
+	defer c.conn.Close()
+	_, err := c.conn.Write(append(pgInt32(8), pgInt32(pgSSLRequest)...))
+	require.NoError(t, err)
+	b, err := c.r.ReadByte()
+	require.NoError(t, err)
+	require.Equal(t, byte('S'), b)
+	tlsConn := tls.Client(c.conn, &tls.Config{InsecureSkipVerify: true})
+	require.NoError(t, tlsConn.Handshake())
+	c.conn, c.r = tlsConn, bufio.NewReader(tlsConn)
+	msgs := c.login("dump", "111", "test")
+	require.Equal(t, pgMsgReadyForQuery, msgs[len(msgs)-1].typ)
+
+	//the password set by its hash is sent in cleartext over SSL
+	am := NewAccountManager(&config.ParameterUnit{SV: ps.rm.getParameterUnit().SV})
+	ps.rm.accounts = am
+	require.NoError(t, am.CreateUser(parseOne(t, "create user u2 identified by password '*"+hex.EncodeToString(hashPassword("111"))+"'").(*tree.CreateUser)))
+	c = newPgTestClient(t, addr)
+	defer c.conn.Close()
+	_, err = c.conn.Write(append(pgInt32(8), pgInt32(pgSSLRequest)...))
+	require.NoError(t, err)
+	b, err = c.r.ReadByte()
+	require.NoError(t, err)
+	require.Equal(t, byte('S'), b)
+	tlsConn = tls.Client(c.conn, &tls.Config{InsecureSkipVerify: true})
+	require.NoError(t, tlsConn.Handshake())
+	c.conn, c.r = tlsConn, bufio.NewReader(tlsConn)
+	msgs = c.login("u2", "111", "test")
+	require.Equal(t, pgMsgReadyForQuery, msgs[len(msgs)-1].typ)
+}
+
+func Test_PgServerSimpleQuery(t *testing.T) {
+	ps := startPgTestServer(t)
+	defer ps.Stop()
+
+	c := newPgTestClient(t, ps.Addr().String())
+	defer c.conn.Close()
+	c.login("dump", "111", "test")
+
+	c.send(pgMsgQuery, pgCString("select * from R"))
+	msgs := c.recvUntilReady()
+	require.Equal(t, pgMsgRowDescription, msgs[0].typ)
+	require.Equal(t, "SELECT 20", string(msgs[len(msgs)-2].data[:len(msgs[len(msgs)-2].data)-1]))
+	require.Equal(t, "T"+strings.Repeat("D", 20)+"CZ", pgTypesOf(msgs))
+	{
+		n, data, err := pgReadInt16(msgs[0].data)
+		require.NoError(t, err)
+		require.Equal(t, int16(3), n)
+		var oids []int32
+		for i := 0; i < 3; i++ {
+			var oid int32
+			_, data, err = pgReadString(data)
+			require.NoError(t, err)
+			_, oid, data = data[:6], int32(binary.BigEndian.Uint32(data[6:])), data[18:]
+			oids = append(oids, oid)
+		}
+		require.Equal(t, []int32{pgOidVarchar, pgOidInt8, pgOidFloat8}, oids)
+	}
+	row := pgDataRow(t, msgs[1])
+	require.Equal(t, 3, len(row))
+
+	c.send(pgMsgQuery, pgCString(""))
+	require.Equal(t, "IZ", pgTypesOf(c.recvUntilReady()))
+
+	c.send(pgMsgQuery, pgCString("selec 1"))
+	msgs = c.recvUntilReady()
+	require.Equal(t, "EZ", pgTypesOf(msgs))
+	code, _ := pgErrorFields(t, msgs[0])
+	require.Equal(t, "42601", code)
+
+	//the backslash is not an escape in the strings, the backticks are not the quotes
+	c.send(pgMsgQuery, pgCString("select uid from R where orderId = 'a\\'"))
+	msgs = c.recvUntilReady()
+	require.Equal(t, "TCZ", pgTypesOf(msgs))
+	require.Equal(t, "SELECT 0", string(msgs[1].data[:len(msgs[1].data)-1]))
+	c.send(pgMsgQuery, pgCString("select `uid` from R"))
+	msgs = c.recvUntilReady()
+	require.Equal(t, "EZ", pgTypesOf(msgs))
+	code, _ = pgErrorFields(t, msgs[0])
+	require.Equal(t, "42601", code)
+
+	//the storage of the test server does not support the transaction
+	c.send(pgMsgQuery, pgCString("begin; select uid from R where uid = 1"))
+	require.Equal(t, "EZ", pgTypesOf(c.recvUntilReady()))
+	c.send(pgMsgQuery, pgCString("set time_zone = '+08:00'; commit"))
+	require.Equal(t, "CCZ", pgTypesOf(c.recvUntilReady()))
+}
+
+func Test_PgServerExtendedQuery(t *testing.T) {
+	ps := startPgTestServer(t)
+	defer ps.Stop()
+
+	c := newPgTestClient(t, ps.Addr().String())
+	defer c.conn.Close()
+	c.login("dump", "111", "test")
+
+	//the parameter in the text and the results in the binary
+	c.send(pgMsgParse, pgCString(""), pgCString("select uid, price from R where uid > $1 and orderId <> '$2'"), pgInt16(1), pgInt32(pgOidInt4))
+	c.send(pgMsgBind, pgCString(""), pgCString(""), pgInt16(1), pgInt16(pgFormatText), pgInt16(1), pgInt32(1), []byte("0"), pgInt16(1), pgInt16(pgFormatBinary))
+	c.send(pgMsgDescribe, []byte{'P'}, pgCString(""))
+	c.send(pgMsgExecute, pgCString(""), pgInt32(0))
+	c.send(pgMsgSync)
+	msgs := c.recvUntilReady()
+	require.Equal(t, "12T", pgTypesOf(msgs[:3]))
+	require.Equal(t, "CZ", pgTypesOf(msgs[len(msgs)-2:]))
+	for _, msg := range msgs[3 : len(msgs)-2] {
+		row := pgDataRow(t, msg)
+		require.Equal(t, 8, len(row[0]))
+		require.Equal(t, 8, len(row[1]))
+		require.True(t, binary.BigEndian.Uint64(row[0]) > 0)
+	}
+
+	//the parameters of the unspecified types are described as the text
+	c.send(pgMsgParse, pgCString("s1"), pgCString("select orderId from R where orderId = $1"), pgInt16(0))
+	c.send(pgMsgDescribe, []byte{'S'}, pgCString("s1"))
+	c.send(pgMsgSync)
+	msgs = c.recvUntilReady()
+	require.Equal(t, "1tTZ", pgTypesOf(msgs))
+	require.Equal(t, append(pgInt16(1), pgInt32(pgOidText)...), msgs[1].data)
+
+	//the messages after the error are skipped until the Sync
+	c.send(pgMsgParse, pgCString("s1"), pgCString("select 1"), pgInt16(0))
+	c.send(pgMsgBind, pgCString(""), pgCString("s1"), pgInt16(0), pgInt16(1), pgInt32(-1), pgInt16(0))
+	c.send(pgMsgExecute, pgCString(""), pgInt32(0))
+	c.send(pgMsgSync)
+	msgs = c.recvUntilReady()
+	require.Equal(t, "EZ", pgTypesOf(msgs))
+	code, _ := pgErrorFields(t, msgs[0])
+	require.Equal(t, "42P05", code)
+
+	//the parameter is bound as a value instead of the text in the sql
+	injection := []byte("x' or '1' = '1")
+	c.send(pgMsgBind, pgCString(""), pgCString("s1"), pgInt16(0), pgInt16(1), pgInt32(len(injection)), injection, pgInt16(0))
+	c.send(pgMsgExecute, pgCString(""), pgInt32(0))
+	c.send(pgMsgSync)
+	msgs = c.recvUntilReady()
+	require.Equal(t, "2CZ", pgTypesOf(msgs))
+	require.Equal(t, "SELECT 0", string(msgs[1].data[:len(msgs[1].data)-1]))
+
+	c.send(pgMsgBind, pgCString(""), pgCString("s1"), pgInt16(0), pgInt16(1), pgInt32(1), []byte("x"), pgInt16(0))
+	c.send(pgMsgExecute, pgCString(""), pgInt32(0))
+	c.send(pgMsgClose, []byte{'S'}, pgCString("s1"))
+	c.send(pgMsgSync)
+	msgs = c.recvUntilReady()
+	require.Equal(t, "2C3Z", pgTypesOf(msgs))
+	require.Equal(t, "SELECT 0", string(msgs[1].data[:len(msgs[1].data)-1]))
+
+	c.send(pgMsgBind, pgCString(""), pgCString("s1"), pgInt16(0), pgInt16(0), pgInt16(0))
+	c.send(pgMsgSync)
+	msgs = c.recvUntilReady()
+	code, _ = pgErrorFields(t, msgs[0])
+	require.Equal(t, "26000", code)
+	c.send(pgMsgTerminate)
+}
+
+func Test_PgServerAuthentication(t *testing.T) {
+	ps := startPgTestServer(t)
+	defer ps.Stop()
+	addr := ps.Addr().String()
+
+	am := NewAccountManager(&config.ParameterUnit{SV: ps.rm.getParameterUnit().SV})
+	ps.rm.accounts = am
+	for _, sql := range []string{
+		"create user u1 identified by '111'",
+		"create user u2 identified by password '*832EB84CB764129D05D498ED9CA7E5CE9B8F83EB'",
+		"create user u3",
+	} {
+		require.NoError(t, am.CreateUser(parseOne(t, sql).(*tree.CreateUser)))
+	}
+
+	login := func(user, password string) pgTestMessage {
+		c := newPgTestClient(t, addr)
+		defer c.conn.Close()
+		c.startup("user", user, "database", "test")
+		return c.authenticate(password)
+	}
+	requireOk := func(msg pgTestMessage) {
+		require.Equal(t, pgMsgAuthentication, msg.typ)
+		require.Equal(t, uint32(pgAuthOk), binary.BigEndian.Uint32(msg.data))
+	}
+	requireError := func(msg pgTestMessage, want string) {
+		code, _ := pgErrorFields(t, msg)
+		require.Equal(t, want, code)
+	}
+
+	//the password does not cross the network
+	requireOk(login("u1", "111"))
+	requireError(login("u1", "112"), pgInvalidPassword)
+	requireError(login("u9", "111"), pgInvalidPassword)
+	//the password set by its hash is only sent over SSL
+	requireError(login("u2", "111"), errno.InvalidAuthorizationSpecification)
+	//the user without password
+	requireOk(login("u3", ""))
+	requireError(login("u3", "111"), pgInvalidPassword)
+	//the superuser
+	requireOk(login("dump", "111"))
+	requireError(login("dump", "112"), pgInvalidPassword)
+}
@@ -0,0 +1,250 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"go/constant"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/scanner"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+/*
+PrepareStmt is the statement prepared by the PREPARE or the COM_STMT_PREPARE.
+
+The sql is parsed once on the preparation. On the execution, the values of
+the parameters are bound to the placeholders '?' of the parsed statement
+by their positions, the sql is not parsed again.
+*/
+type PrepareStmt struct {
+	//the name of the statement prepared by the PREPARE
+	Name string
+
+	//the id of the statement prepared by the COM_STMT_PREPARE
+	Id uint32
+
+	Sql string
+
+	//the statement parsed from the sql
+	Stmt tree.Statement
+
+	//the count of the placeholders in the sql
+	paramCount int
+
+	//the columns of the result set, nil if they are unknown before the execution
+	columns []*MysqlColumn
+
+	//the parameter types bound by the last COM_STMT_EXECUTE.
+	//two bytes per parameter: the type and the flag.
+	paramTypes []byte
+
+	//the parameter values sent by the COM_STMT_SEND_LONG_DATA
+	longData map[uint16][]byte
+}
+
+func NewPrepareStmt(name string, sql string) (*PrepareStmt, error) {
+	stmts, err := parsers.Parse(dialect.MYSQL, sql)
+	if err != nil {
+		return nil, NewMysqlError(ER_PARSE_ERROR, err,
+			"You have an error in your SQL syntax; check the manual that corresponds to your MatrixOne server version for the right syntax to use")
+	}
+	//only one statement can be prepared
+	if len(stmts) != 1 {
+		return nil, NewMysqlError(ER_UNSUPPORTED_PS)
+	}
+	switch stmts[0].(type) {
+	case *tree.PrepareString, *tree.PrepareVar, *tree.Execute, *tree.Deallocate:
+		return nil, NewMysqlError(ER_UNSUPPORTED_PS)
+	}
+
+	stmt := &PrepareStmt{
+		Name:     name,
+		Sql:      sql,
+		Stmt:     stmts[0],
+		longData: make(map[uint16][]byte),
+	}
+
+	//the placeholders are numbered by the parser in the order of the scanner
+	scan := scanner.NewScanner(dialect.MYSQL, sql)
+	for {
+		typ, _ := scan.Scan()
+		if typ == 0 || typ == scanner.LEX_ERROR {
+			break
+		}
+		if typ == scanner.VALUE_ARG {
+			stmt.paramCount++
+		}
+	}
+	if stmt.paramCount > 65535 {
+		return nil, NewMysqlError(ER_PS_MANY_PARAM)
+	}
+	return stmt, nil
+}
+
+// ParamCount returns the count of the placeholders
+func (ps *PrepareStmt) ParamCount() int {
+	return ps.paramCount
+}
+
+// AppendLongData appends the data to the parameter
+func (ps *PrepareStmt) AppendLongData(paramId uint16, data []byte) {
+	ps.longData[paramId] = append(ps.longData[paramId], data...)
+}
+
+// ResetLongData clears the data sent by the COM_STMT_SEND_LONG_DATA
+func (ps *PrepareStmt) ResetLongData() {
+	ps.longData = make(map[uint16][]byte)
+}
+
+/*
+BindParams converts the values into the constants bound to the placeholders.
+The i-th constant is for the placeholder at the position i+1.
+*/
+func (ps *PrepareStmt) BindParams(values []interface{}) ([]tree.Expr, error) {
+	if len(values) != ps.paramCount {
+		return nil, NewMysqlError(ER_WRONG_ARGUMENTS, "EXECUTE")
+	}
+	params := make([]tree.Expr, len(values))
+	for i, v := range values {
+		params[i] = constantOfValue(v)
+	}
+	return params, nil
+}
+
+//constantOfValue converts the value into the constant in the statement
+func constantOfValue(value interface{}) tree.Expr {
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return tree.NewNumVal(constant.MakeInt64(1), "1", false)
+		}
+		return tree.NewNumVal(constant.MakeInt64(0), "0", false)
+	case int64:
+		return tree.NewNumVal(constant.MakeInt64(v), strconv.FormatInt(v, 10), v < 0)
+	case uint64:
+		return tree.NewNumVal(constant.MakeUint64(v), strconv.FormatUint(v, 10), false)
+	case float32:
+		return tree.NewNumVal(constant.MakeFloat64(float64(v)), strconv.FormatFloat(float64(v), 'g', -1, 32), v < 0)
+	case float64:
+		return tree.NewNumVal(constant.MakeFloat64(v), strconv.FormatFloat(v, 'g', -1, 64), v < 0)
+	case string:
+		return tree.NewNumVal(constant.MakeString(v), v, false)
+	case []byte:
+		return tree.NewNumVal(constant.MakeString(string(v)), string(v), false)
+	default:
+		return tree.NewNumVal(constant.MakeUnknown(), "null", false)
+	}
+}
+
+//literalOfValue converts the value into the literal in the sql
+func literalOfValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case string:
+		return quoteString(v)
+	case []byte:
+		return quoteString(string(v))
+	default:
+		return "null"
+	}
+}
+
+//quoteString quotes the string with the escaped characters
+func quoteString(s string) string {
+	var buf strings.Builder
+	buf.Grow(len(s) + 2)
+	buf.WriteByte('\'')
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\'':
+			buf.WriteString("''")
+		case '\\':
+			buf.WriteString("\\\\")
+		case 0:
+			buf.WriteString("\\0")
+		default:
+			buf.WriteByte(s[i])
+		}
+	}
+	buf.WriteByte('\'')
+	return buf.String()
+}
+
+/*
+valueOfLiteral evaluates the literal assigned to the user variable.
+*/
+func valueOfLiteral(expr tree.Expr) (interface{}, bool) {
+	switch e := expr.(type) {
+	case *tree.ParenExpr:
+		return valueOfLiteral(e.Expr)
+	case *tree.UnaryExpr:
+		if e.Op != tree.UNARY_MINUS {
+			return nil, false
+		}
+		switch v, ok := valueOfLiteral(e.Expr); val := v.(type) {
+		case int64:
+			return -val, ok
+		case uint64:
+			if val <= 1<<63 {
+				return -int64(val), ok
+			}
+			return -float64(val), ok
+		case float64:
+			return -val, ok
+		}
+		return nil, false
+	case *tree.NumVal:
+		switch e.Value.Kind() {
+		case constant.Unknown:
+			return nil, true
+		case constant.Bool:
+			if constant.BoolVal(e.Value) {
+				return int64(1), true
+			}
+			return int64(0), true
+		case constant.String:
+			return constant.StringVal(e.Value), true
+		case constant.Int:
+			if v, exact := constant.Int64Val(e.Value); exact {
+				return v, true
+			}
+			if v, exact := constant.Uint64Val(e.Value); exact {
+				return v, true
+			}
+		case constant.Float:
+			v, _ := constant.Float64Val(e.Value)
+			return v, true
+		}
+	}
+	return nil, false
+}
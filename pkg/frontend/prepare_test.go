@@ -0,0 +1,336 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"database/sql"
+	"fmt"
+	"go/constant"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/fagongzi/goetty/buf"
+	"github.com/go-sql-driver/mysql"
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	mock_frontend "github.com/matrixorigin/matrixone/pkg/frontend/test"
+	"github.com/matrixorigin/matrixone/pkg/sql/compile"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PrepareStmt(t *testing.T) {
+	convey.Convey("bind params", t, func() {
+		stmt, err := NewPrepareStmt("s1", "select a, '?' from t where a = ? and b in (?, ?) limit ?")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(stmt.ParamCount(), convey.ShouldEqual, 4)
+
+		params, err := stmt.BindParams([]interface{}{int64(-1), "it's \\", nil, uint64(math.MaxUint64)})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(params), convey.ShouldEqual, 4)
+		v, _ := constant.Int64Val(params[0].(*tree.NumVal).Value)
+		convey.So(v, convey.ShouldEqual, -1)
+		convey.So(constant.StringVal(params[1].(*tree.NumVal).Value), convey.ShouldEqual, "it's \\")
+		convey.So(params[2].(*tree.NumVal).Value.Kind(), convey.ShouldEqual, constant.Unknown)
+		u, _ := constant.Uint64Val(params[3].(*tree.NumVal).Value)
+		convey.So(u, convey.ShouldEqual, uint64(math.MaxUint64))
+
+		//the statement is parsed once, the placeholders are numbered in order
+		var offsets []int
+		where := stmt.Stmt.(*tree.Select).Select.(*tree.SelectClause).Where.Expr.(*tree.AndExpr)
+		offsets = append(offsets, where.Left.(*tree.ComparisonExpr).Right.(*tree.ParamExpr).Offset)
+		for _, e := range where.Right.(*tree.ComparisonExpr).Right.(*tree.Tuple).Exprs {
+			offsets = append(offsets, e.(*tree.ParamExpr).Offset)
+		}
+		offsets = append(offsets, stmt.Stmt.(*tree.Select).Limit.Count.(*tree.ParamExpr).Offset)
+		convey.So(offsets, convey.ShouldResemble, []int{1, 2, 3, 4})
+
+		_, err = stmt.BindParams([]interface{}{int64(1)})
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+
+	convey.Convey("invalid statement", t, func() {
+		_, err := NewPrepareStmt("s1", "select * from")
+		convey.So(err, convey.ShouldNotBeNil)
+
+		_, err = NewPrepareStmt("s1", "select 1; select 2")
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_UNSUPPORTED_PS)
+
+		_, err = NewPrepareStmt("s1", "deallocate prepare s2")
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_UNSUPPORTED_PS)
+	})
+
+	convey.Convey("columns of the result set", t, func() {
+		stmt, err := NewPrepareStmt("s1", "select n_name, n_nationkey + 1 as k, ? from nation where n_regionkey = ?")
+		convey.So(err, convey.ShouldBeNil)
+		qry, err := plan2.BuildPlan(plan2.NewMockCompilerContext(), stmt.Stmt)
+		convey.So(err, convey.ShouldBeNil)
+		columns, err := columnsOfQuery(qry)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(columns), convey.ShouldEqual, 3)
+		convey.So(columns[0].Name(), convey.ShouldEqual, "n_name")
+		convey.So(columns[0].ColumnType(), convey.ShouldEqual, defines.MYSQL_TYPE_VARCHAR)
+		convey.So(columns[1].Name(), convey.ShouldEqual, "k")
+		convey.So(columns[1].ColumnType(), convey.ShouldEqual, defines.MYSQL_TYPE_LONGLONG)
+		convey.So(columns[2].ColumnType(), convey.ShouldEqual, defines.MYSQL_TYPE_VAR_STRING)
+	})
+
+	convey.Convey("value of literal", t, func() {
+		stmts, err := parsers.Parse(dialect.MYSQL, "set @a = 1, @b = -2, @c = 1.5, @d = 'x', @e = null, @f = true, @g = a")
+		convey.So(err, convey.ShouldBeNil)
+		want := []interface{}{int64(1), int64(-2), 1.5, "x", nil, int64(1)}
+		for i, assign := range stmts[0].(*tree.SetVar).Assignments {
+			convey.So(assign.System, convey.ShouldBeFalse)
+			v, ok := valueOfLiteral(assign.Value)
+			if i < len(want) {
+				convey.So(ok, convey.ShouldBeTrue)
+				convey.So(v, convey.ShouldResemble, want[i])
+			} else {
+				convey.So(ok, convey.ShouldBeFalse)
+			}
+		}
+	})
+}
+
+func Test_ParseExecuteData(t *testing.T) {
+	convey.Convey("parse the parameters of COM_STMT_EXECUTE", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+		convey.So(err, convey.ShouldBeNil)
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+
+		stmt, err := NewPrepareStmt("", "select ?, ?, ?, ?, ?, ?")
+		convey.So(err, convey.ShouldBeNil)
+
+		data := []byte{
+			1, 0, 0, 0, //stmt_id
+			0,          //flags
+			1, 0, 0, 0, //iteration_count
+			0x02, //null bitmap: the second one is null
+			1,    //new_params_bound_flag
+			defines.MYSQL_TYPE_LONGLONG, 0,
+			defines.MYSQL_TYPE_NULL, 0,
+			defines.MYSQL_TYPE_TINY, 0x80,
+			defines.MYSQL_TYPE_VAR_STRING, 0,
+			defines.MYSQL_TYPE_DATETIME, 0,
+			defines.MYSQL_TYPE_BLOB, 0,
+		}
+		data = append(data, 0xfe, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff) //-2
+		data = append(data, 0xff)                                           //255
+		data = append(data, 3, 'a', 'b', 'c')
+		data = append(data, 7, 0xe6, 0x07, 1, 2, 3, 4, 5) //2022-01-02 03:04:05
+		stmt.AppendLongData(5, []byte("long"))
+		stmt.AppendLongData(5, []byte(" data"))
+
+		values, err := proto.ParseExecuteData(stmt, data)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(values, convey.ShouldResemble, []interface{}{int64(-2), nil, uint64(255), "abc", "2022-01-02 03:04:05", []byte("long data")})
+
+		//the types bound before are used
+		stmt.ResetLongData()
+		data = append(data[:10], 0)
+		data = append(data, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 4, 0xe6, 0x07, 1, 2, 0)
+		values, err = proto.ParseExecuteData(stmt, data)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(values, convey.ShouldResemble, []interface{}{int64(1), nil, uint64(0), "", "2022-01-02 00:00:00", ""})
+
+		//malformed
+		_, err = proto.ParseExecuteData(stmt, data[:20])
+		convey.So(err, convey.ShouldNotBeNil)
+	})
+}
+
+func Test_mce_prepare(t *testing.T) {
+	convey.Convey("PREPARE/DEALLOCATE and COM_STMT_PREPARE/COM_STMT_CLOSE", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+
+		pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+		convey.So(err, convey.ShouldBeNil)
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+		guestMmu := guest.New(pu.SV.GetGuestMmuLimitation(), pu.HostMmu)
+		ses := NewSession(proto, getPCI(), guestMmu, pu.Mempool, pu)
+
+		mce := NewMysqlCmdExecutor()
+		mce.PrepareSessionBeforeExecRequest(ses)
+
+		err = mce.handlePrepareString(&tree.PrepareString{Name: "S1", Sql: "select ? from t"})
+		convey.So(err, convey.ShouldBeNil)
+		stmt, ok := ses.GetPrepareStmt("s1")
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(stmt.ParamCount(), convey.ShouldEqual, 1)
+
+		//prepare from the user variable
+		stmts, err := parsers.Parse(dialect.MYSQL, "set @sql = 'insert into t values (?, ?)'")
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(mce.handleSetVar(stmts[0].(*tree.SetVar)), convey.ShouldBeNil)
+		err = mce.handlePrepareVar(&tree.PrepareVar{Name: "s2", Var: &tree.VarExpr{Name: "SQL"}})
+		convey.So(err, convey.ShouldBeNil)
+		stmt, ok = ses.GetPrepareStmt("s2")
+		convey.So(ok, convey.ShouldBeTrue)
+		convey.So(stmt.ParamCount(), convey.ShouldEqual, 2)
+
+		err = mce.handleExecute(&tree.Execute{Name: "s3"})
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_UNKNOWN_STMT_HANDLER)
+
+		err = mce.handleDeallocate(&tree.Deallocate{Name: "s1"})
+		convey.So(err, convey.ShouldBeNil)
+		_, ok = ses.GetPrepareStmt("s1")
+		convey.So(ok, convey.ShouldBeFalse)
+		err = mce.handleDeallocate(&tree.Deallocate{Name: "s1"})
+		convey.So(err, convey.ShouldNotBeNil)
+
+		//binary protocol
+		err = mce.handleStmtPrepare("select * from t where a > ? and b < ?")
+		convey.So(err, convey.ShouldBeNil)
+		stmt, ok = ses.GetBinaryStmt(1)
+		convey.So(ok, convey.ShouldBeTrue)
+
+		err = mce.handleStmtSendLongData([]byte{1, 0, 0, 0, 1, 0, 'a'})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(stmt.longData[1], convey.ShouldResemble, []byte("a"))
+		err = mce.handleStmtSendLongData([]byte{1, 0, 0, 0, 2, 0, 'a'})
+		convey.So(err, convey.ShouldNotBeNil)
+
+		err = mce.handleStmtReset([]byte{1, 0, 0, 0})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(len(stmt.longData), convey.ShouldEqual, 0)
+
+		err = mce.handleStmtClose([]byte{1, 0, 0, 0})
+		convey.So(err, convey.ShouldBeNil)
+		_, ok = ses.GetBinaryStmt(1)
+		convey.So(ok, convey.ShouldBeFalse)
+
+		err = mce.handleStmtExecute([]byte{1, 0, 0, 0, 0, 1, 0, 0, 0})
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_UNKNOWN_STMT_HANDLER)
+
+		//the user without the privileges can not prepare the query
+		mce.SetRoutineManager(&RoutineManager{accounts: NewAccountManager(pu)})
+		proto.SetUserName("u1")
+		proto.SetDatabaseName("db1")
+		err = mce.handleStmtPrepare("select * from t where a > ?")
+		convey.So(err.(*MysqlError).ErrorCode, convey.ShouldEqual, ER_TABLEACCESS_DENIED_ERROR)
+		_, ok = ses.GetBinaryStmt(2)
+		convey.So(ok, convey.ShouldBeFalse)
+	})
+}
+
+func Test_makeResultSetBinaryRow(t *testing.T) {
+	convey.Convey("binary result set row", t, func() {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ioses := mock_frontend.NewMockIOSession(ctrl)
+		ioses.EXPECT().OutBuf().Return(buf.NewByteBuf(1024)).AnyTimes()
+		ioses.EXPECT().WriteAndFlush(gomock.Any()).Return(nil).AnyTimes()
+		pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+		convey.So(err, convey.ShouldBeNil)
+		proto := NewMysqlClientProtocol(0, ioses, 1024, pu.SV)
+
+		for _, mrs := range []*MysqlResultSet{
+			makeMysqlTinyIntResultSet(false),
+			makeMysqlShortResultSet(true),
+			makeMysqlLongResultSet(false),
+			makeMysqlLongLongResultSet(true),
+			makeMysqlVarcharResultSet(),
+			makeMysqlFloatResultSet(),
+			makeMysqlDoubleResultSet(),
+			makeMysqlDateResultSet(),
+			makeMysqlDatetimeResultSet(),
+			make8ColumnsResultSet(),
+		} {
+			err = proto.SendResultSetBinaryBatchRow(mrs, mrs.GetRowCount())
+			convey.So(err, convey.ShouldBeNil)
+		}
+	})
+}
+
+func Test_PreparedStatementOverServer(t *testing.T) {
+	compile.InitAddress("127.0.0.1")
+	sv := loadTestVars(t, t.TempDir(), "port = 6010")
+	pu := config.NewParameterUnit(sv, host.New(sv.GetHostMmuLimitation()), mempool.New(), memEngine.NewTestEngine(), nil, nil)
+	ppu := NewPDCallbackParameterUnit(int(sv.GetPeriodOfEpochTimer()), int(sv.GetPeriodOfPersistence()), int(sv.GetPeriodOfDDLDeleteTimer()), int(sv.GetTimeoutOfHeartbeat()), sv.GetEnableEpochLogging(), math.MaxInt64)
+	mo := NewMOServer("127.0.0.1:6010", pu, NewPDCallbackImpl(ppu))
+	require.NoError(t, mo.Start())
+	defer mo.Stop()
+
+	cfg := mysql.NewConfig()
+	cfg.User, cfg.Passwd, cfg.DBName = "dump", "111", "test"
+	cfg.Net, cfg.Addr = "tcp", "127.0.0.1:6010"
+	cfg.Timeout, cfg.ReadTimeout, cfg.WriteTimeout = 10*time.Second, 10*time.Second, 10*time.Second
+	connector, err := mysql.NewConnector(cfg)
+	require.NoError(t, err)
+	db := sql.OpenDB(connector)
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	_, err = db.Exec("create table p1 (a int, b varchar(10))")
+	require.NoError(t, err)
+
+	//the statement prepared once is executed with the different values
+	ins, err := db.Prepare("insert into p1 values (?, ?)")
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err = ins.Exec(i, fmt.Sprintf("it's %d", i))
+		require.NoError(t, err)
+	}
+	require.NoError(t, ins.Close())
+
+	sel, err := db.Prepare("select a, b from p1 where a >= ? order by a")
+	require.NoError(t, err)
+	defer sel.Close()
+	for _, from := range []int{0, 2} {
+		rows, err := sel.Query(from)
+		require.NoError(t, err)
+		columns, err := rows.Columns()
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b"}, columns)
+		var got []string
+		for rows.Next() {
+			var a int
+			var b string
+			require.NoError(t, rows.Scan(&a, &b))
+			got = append(got, fmt.Sprintf("%d:%s", a, b))
+		}
+		require.NoError(t, rows.Err())
+		require.NoError(t, rows.Close())
+		var want []string
+		for i := from; i < 3; i++ {
+			want = append(want, fmt.Sprintf("%d:it's %d", i, i))
+		}
+		require.Equal(t, want, got)
+	}
+
+	//the count of the values must be the one of the placeholders
+	_, err = sel.Query()
+	require.Error(t, err)
+}
@@ -0,0 +1,256 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+/*
+statementPrivileges returns the privileges the statement needs.
+db is the current database the unqualified tables belong to, user is the current user.
+The account management needs the global privileges except the user
+changes its own password.
+*/
+func statementPrivileges(stmt tree.Statement, db, user string) []privilegeRequest {
+	pc := &privilegeCollector{db: db}
+	switch st := stmt.(type) {
+	case *tree.Select:
+		pc.collectSelect(st, nil)
+	case *tree.ParenSelect:
+		pc.collectSelect(st, nil)
+	case *tree.Insert:
+		pc.collectTable(st.Table, privilegeInsert, nil)
+		if st.Rows != nil {
+			pc.collectSelect(st.Rows, nil)
+		}
+	case *tree.Update:
+		pc.collectTable(st.Table, privilegeUpdate, nil)
+		pc.collectTables(st.From, nil)
+		for _, e := range st.Exprs {
+			pc.collectExpr(e.Expr, nil)
+		}
+		if st.Where != nil {
+			pc.collectExpr(st.Where.Expr, nil)
+		}
+	case *tree.Delete:
+		pc.collectTable(st.Table, privilegeDelete, nil)
+		if st.Where != nil {
+			pc.collectExpr(st.Where.Expr, nil)
+		}
+	case *tree.Load:
+		pc.add(privilegeInsert, st.Table)
+	case *tree.CreateTable:
+		pc.add(privilegeCreate, &st.Table)
+	case *tree.DropTable:
+		for _, name := range st.Names {
+			pc.add(privilegeDrop, name)
+		}
+	case *tree.CreateIndex:
+		pc.add(privilegeCreate, &st.Table)
+	case *tree.DropIndex:
+		pc.add(privilegeDrop, &st.TableName)
+	case *tree.AlterTable:
+		pc.add(privilegeCreate, st.Table)
+		for _, opt := range st.Options {
+			if _, ok := opt.(*tree.AlterOptionDropColumn); ok {
+				pc.add(privilegeDrop, st.Table)
+				break
+			}
+		}
+	case *tree.CreateDatabase:
+		pc.reqs = append(pc.reqs, privilegeRequest{privilegeCreate, newPrivilegeObject(string(st.Name), "")})
+	case *tree.DropDatabase:
+		pc.reqs = append(pc.reqs, privilegeRequest{privilegeDrop, newPrivilegeObject(string(st.Name), "")})
+	case *tree.ShowColumns:
+		tbl := st.Table.ToTableName()
+		pc.add(privilegeSelect, &tbl)
+	case *tree.ShowCreateTable:
+		tbl := st.Name.ToTableName()
+		pc.add(privilegeSelect, &tbl)
+	case *tree.ShowIndex:
+		pc.add(privilegeSelect, &st.TableName)
+	case *tree.AnalyzeStmt:
+		pc.add(privilegeSelect, st.Table)
+	case *tree.ShowStats:
+		if st.Table != nil {
+			pc.add(privilegeSelect, st.Table)
+		}
+	case *tree.ExplainStmt:
+		return statementPrivileges(st.Statement, db, user)
+	case *tree.ExplainAnalyze:
+		return statementPrivileges(st.Statement, db, user)
+	case *tree.CreateUser, *tree.DropUser, *tree.CreateRole, *tree.DropRole,
+		*tree.Grant, *tree.Revoke, *tree.SetDefaultRole:
+		pc.reqs = append(pc.reqs, privilegeRequest{privilege: privilegeAll})
+	case *tree.AlterUser:
+		if !st.IsUserFunc && !(len(st.Users) == 1 && st.Users[0].Username == user) {
+			pc.reqs = append(pc.reqs, privilegeRequest{privilege: privilegeAll})
+		}
+	case *tree.SetPassword:
+		if st.User != nil && st.User.Username != user {
+			pc.reqs = append(pc.reqs, privilegeRequest{privilege: privilegeAll})
+		}
+	}
+	return pc.reqs
+}
+
+// privilegeCollector collects the tables a statement reads or writes
+type privilegeCollector struct {
+	db   string
+	reqs []privilegeRequest
+}
+
+func (pc *privilegeCollector) add(p privilegeType, tbl *tree.TableName) {
+	db := string(tbl.SchemaName)
+	if db == "" {
+		db = pc.db
+	}
+	pc.reqs = append(pc.reqs, privilegeRequest{p, newPrivilegeObject(db, string(tbl.ObjectName))})
+}
+
+/*
+collectSelect collects the tables read by the query.
+ctes are the names of the common table expressions in the scope, they are not tables.
+*/
+func (pc *privilegeCollector) collectSelect(stmt tree.SelectStatement, ctes map[string]struct{}) {
+	switch stmt := stmt.(type) {
+	case *tree.Select:
+		if stmt.With != nil {
+			scope := make(map[string]struct{}, len(ctes)+len(stmt.With.CTEs))
+			for name := range ctes {
+				scope[name] = struct{}{}
+			}
+			for _, def := range stmt.With.CTEs {
+				scope[string(def.Name.Alias)] = struct{}{}
+			}
+			ctes = scope
+			for _, def := range stmt.With.CTEs {
+				if s, ok := def.Stmt.(tree.SelectStatement); ok {
+					pc.collectSelect(s, ctes)
+				}
+			}
+		}
+		pc.collectSelect(stmt.Select, ctes)
+	case *tree.ParenSelect:
+		pc.collectSelect(stmt.Select, ctes)
+	case *tree.UnionClause:
+		pc.collectSelect(stmt.Left, ctes)
+		pc.collectSelect(stmt.Right, ctes)
+	case *tree.SelectClause:
+		if stmt.From != nil {
+			pc.collectTables(stmt.From.Tables, ctes)
+		}
+		for _, e := range stmt.Exprs {
+			pc.collectExpr(e.Expr, ctes)
+		}
+		if stmt.Where != nil {
+			pc.collectExpr(stmt.Where.Expr, ctes)
+		}
+		if stmt.Having != nil {
+			pc.collectExpr(stmt.Having.Expr, ctes)
+		}
+	}
+}
+
+func (pc *privilegeCollector) collectTables(tables tree.TableExprs, ctes map[string]struct{}) {
+	for _, table := range tables {
+		pc.collectTable(table, privilegeSelect, ctes)
+	}
+}
+
+func (pc *privilegeCollector) collectTable(expr tree.TableExpr, p privilegeType, ctes map[string]struct{}) {
+	switch expr := expr.(type) {
+	case *tree.TableName:
+		if _, ok := ctes[string(expr.ObjectName)]; ok && expr.SchemaName == "" {
+			return
+		}
+		pc.add(p, expr)
+	case *tree.AliasedTableExpr:
+		pc.collectTable(expr.Expr, p, ctes)
+	case *tree.ParenTableExpr:
+		pc.collectTable(expr.Expr, p, ctes)
+	case *tree.JoinTableExpr:
+		pc.collectTable(expr.Left, p, ctes)
+		if expr.Right != nil {
+			pc.collectTable(expr.Right, privilegeSelect, ctes)
+		}
+		if cond, ok := expr.Cond.(*tree.OnJoinCond); ok {
+			pc.collectExpr(cond.Expr, ctes)
+		}
+	case *tree.Subquery:
+		pc.collectSelect(expr.Select, ctes)
+	case *tree.Select:
+		pc.collectSelect(expr, ctes)
+	}
+}
+
+// collectExpr collects the tables read by the subqueries in the expression
+func (pc *privilegeCollector) collectExpr(expr tree.Expr, ctes map[string]struct{}) {
+	switch e := expr.(type) {
+	case *tree.Subquery:
+		pc.collectSelect(e.Select, ctes)
+	case *tree.ParenExpr:
+		pc.collectExpr(e.Expr, ctes)
+	case *tree.AndExpr:
+		pc.collectExpr(e.Left, ctes)
+		pc.collectExpr(e.Right, ctes)
+	case *tree.OrExpr:
+		pc.collectExpr(e.Left, ctes)
+		pc.collectExpr(e.Right, ctes)
+	case *tree.XorExpr:
+		pc.collectExpr(e.Left, ctes)
+		pc.collectExpr(e.Right, ctes)
+	case *tree.NotExpr:
+		pc.collectExpr(e.Expr, ctes)
+	case *tree.BinaryExpr:
+		pc.collectExpr(e.Left, ctes)
+		pc.collectExpr(e.Right, ctes)
+	case *tree.UnaryExpr:
+		pc.collectExpr(e.Expr, ctes)
+	case *tree.ComparisonExpr:
+		pc.collectExpr(e.Left, ctes)
+		pc.collectExpr(e.Right, ctes)
+	case *tree.IsNullExpr:
+		pc.collectExpr(e.Expr, ctes)
+	case *tree.IsNotNullExpr:
+		pc.collectExpr(e.Expr, ctes)
+	case *tree.RangeCond:
+		pc.collectExpr(e.Left, ctes)
+		pc.collectExpr(e.From, ctes)
+		pc.collectExpr(e.To, ctes)
+	case *tree.CastExpr:
+		pc.collectExpr(e.Expr, ctes)
+	case *tree.FuncExpr:
+		for _, arg := range e.Exprs {
+			pc.collectExpr(arg, ctes)
+		}
+	case *tree.Tuple:
+		for _, arg := range e.Exprs {
+			pc.collectExpr(arg, ctes)
+		}
+	case *tree.ExprList:
+		for _, arg := range e.Exprs {
+			pc.collectExpr(arg, ctes)
+		}
+	case *tree.CaseExpr:
+		pc.collectExpr(e.Expr, ctes)
+		for _, w := range e.Whens {
+			pc.collectExpr(w.Cond, ctes)
+			pc.collectExpr(w.Val, ctes)
+		}
+		pc.collectExpr(e.Else, ctes)
+	}
+}
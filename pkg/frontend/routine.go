@@ -46,6 +46,9 @@ type Routine struct {
 	onceCloseNotifyChan sync.Once
 
 	routineMgr *RoutineManager
+
+	//the session lives as long as the connection
+	ses *Session
 }
 
 func (routine *Routine) GetClientProtocol() Protocol {
@@ -76,6 +79,12 @@ func (routine *Routine) Loop() {
 	var err error
 	var resp *Response
 	defer routine.Quit()
+	defer func() {
+		//the active transaction of the closed connection is rolled back
+		if routine.ses != nil {
+			routine.ses.Close()
+		}
+	}()
 	for {
 		quit := false
 		select {
@@ -94,7 +103,11 @@ func (routine *Routine) Loop() {
 		mgr := routine.GetRoutineMgr()
 
 		routine.protocol.(*MysqlProtocolImpl).sequenceId = req.seq
-		ses := NewSession(routine.protocol, mgr.getEpochgc(), routine.guestMmu, routine.mempool, mgr.getParameterUnit())
+		if routine.ses == nil {
+			routine.ses = NewSession(routine.protocol, mgr.getEpochgc(), routine.guestMmu, routine.mempool, mgr.getParameterUnit())
+		}
+		ses := routine.ses
+		ses.PrepareForRequest()
 
 		routine.executor.PrepareSessionBeforeExecRequest(ses)
 
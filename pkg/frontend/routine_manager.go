@@ -15,6 +15,7 @@
 package frontend
 
 import (
+	"crypto/tls"
 	"errors"
 	"sync"
 
@@ -31,6 +32,15 @@ type RoutineManager struct {
 	pdHook *PDCallbackImpl
 
 	pu *config.ParameterUnit
+
+	//the users, the roles and their privileges
+	accounts *AccountManager
+
+	//the statistics of the analyzed tables
+	stats *StatsManager
+
+	//the TLS config the connections are upgraded with. nil if TLS is not configured.
+	tlsConfig *tls.Config
 }
 
 func (rm *RoutineManager) getEpochgc() *PDCallbackImpl {
@@ -41,8 +51,18 @@ func (rm *RoutineManager) getParameterUnit() *config.ParameterUnit {
 	return rm.pu
 }
 
+func (rm *RoutineManager) GetAccountManager() *AccountManager {
+	return rm.accounts
+}
+
+func (rm *RoutineManager) GetStatsManager() *StatsManager {
+	return rm.stats
+}
+
 func (rm *RoutineManager) Created(rs goetty.IOSession) {
 	pro := NewMysqlClientProtocol(nextConnectionID(), rs, int(rm.pu.SV.GetMaxBytesInOutbufToFlush()), rm.pu.SV)
+	pro.accounts = rm.accounts
+	pro.tlsConfig = rm.tlsConfig
 	exe := NewMysqlCmdExecutor()
 	exe.SetRoutineManager(rm)
 
@@ -151,6 +171,11 @@ func (rm *RoutineManager) Handler(rs goetty.IOSession, msg interface{}, received
 			logutil.Infof("RP[%v] Payload80[%v]",rs.RemoteAddr(),di)
 		*/
 
+		//the client asks for TLS before sending the handshake response
+		if protocol.isSSLRequest(payload) {
+			return protocol.upgradeToTLS()
+		}
+
 		err := protocol.handleHandshake(payload)
 		if err != nil {
 			return err
@@ -170,8 +195,10 @@ func NewRoutineManager(pu *config.ParameterUnit, pdHook *PDCallbackImpl) *Routin
 	rm := &RoutineManager{
 		clients: make(map[goetty.IOSession]*Routine),
 
-		pdHook: pdHook,
-		pu:     pu,
+		pdHook:   pdHook,
+		pu:       pu,
+		accounts: NewAccountManager(pu),
+		stats:    NewStatsManager(pu),
 	}
 	return rm
 }
@@ -18,6 +18,8 @@ import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/config"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"net"
+	"strconv"
 	"sync/atomic"
 
 	"github.com/fagongzi/goetty"
@@ -30,6 +32,10 @@ var initConnectionID uint32 = 1000
 type MOServer struct {
 	addr string
 	app  goetty.NetApplication
+	rm   *RoutineManager
+
+	//the listener of the postgresql wire protocol. nil if it is disabled.
+	pg *PgServer
 }
 
 func (mo *MOServer) Start() error {
@@ -46,10 +52,20 @@ func (mo *MOServer) Start() error {
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
 	fmt.Printf("++++++++++++++++++++++++++++++++++++++++++++++++\n")
+	if mo.pg != nil {
+		if err := mo.pg.Start(); err != nil {
+			return err
+		}
+	}
 	return mo.app.Start()
 }
 
 func (mo *MOServer) Stop() error {
+	if mo.pg != nil {
+		if err := mo.pg.Stop(); err != nil {
+			logutil.Errorf("stop the postgresql server failed. error:%v", err)
+		}
+	}
 	return mo.app.Stop()
 }
 
@@ -60,19 +76,46 @@ func nextConnectionID() uint32 {
 func NewMOServer(addr string, pu *config.ParameterUnit, pdHook *PDCallbackImpl) *MOServer {
 	encoder, decoder := NewSqlCodec()
 	rm := NewRoutineManager(pu, pdHook)
-	// TODO asyncFlushBatch
-	app, err := goetty.NewTCPApplication(addr, rm.Handler,
+	tlsConfig, err := loadTLSConfig(pu.SV)
+	if err != nil {
+		logutil.Panicf("start server failed with %+v", err)
+	}
+	rm.tlsConfig = tlsConfig
+
+	opts := []goetty.AppOption{
 		goetty.WithAppSessionOptions(
 			goetty.WithCodec(encoder, decoder),
 			goetty.WithLogger(logutil.GetGlobalLogger()),
 			goetty.WithBufSize(1024*1024, 1024*1024)),
-		goetty.WithAppSessionAware(rm))
+		goetty.WithAppSessionAware(rm),
+	}
+	// TODO asyncFlushBatch
+	var app goetty.NetApplication
+	if tlsConfig == nil {
+		app, err = goetty.NewTCPApplication(addr, rm.Handler, opts...)
+	} else {
+		//the connections are upgraded to TLS when the clients ask for it in the handshake
+		var listener net.Listener
+		if listener, err = net.Listen("tcp4", addr); err == nil {
+			app, err = goetty.NewApplication(upgradableListener{listener}, rm.Handler, opts...)
+		}
+	}
 	if err != nil {
 		logutil.Panicf("start server failed with %+v", err)
 	}
 
-	return &MOServer{
+	mo := &MOServer{
 		addr: addr,
 		app:  app,
+		rm:   rm,
+	}
+	//the postgresql clients connect to the same host on the other port
+	if port := pu.SV.GetPgPort(); port > 0 {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			logutil.Panicf("start server failed with %+v", err)
+		}
+		mo.pg = NewPgServer(net.JoinHostPort(host, strconv.FormatInt(port, 10)), rm)
 	}
+	return mo
 }
@@ -15,8 +15,13 @@
 package frontend
 
 import (
+	"strings"
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 )
@@ -42,6 +47,24 @@ type Session struct {
 	ep *tree.ExportParam
 
 	closeRef *CloseExportData
+
+	//transaction state of the session
+	txnHandler *TxnHandler
+
+	//the statements prepared by the PREPARE
+	prepareStmts map[string]*PrepareStmt
+
+	//the statements prepared by the COM_STMT_PREPARE
+	binaryStmts map[uint32]*PrepareStmt
+
+	//the id of the last statement prepared by the COM_STMT_PREPARE
+	lastStmtId uint32
+
+	//the user defined variables
+	userDefinedVars map[string]interface{}
+
+	//the time zone of the TIMESTAMP values, nil is the server time zone
+	timeZone *time.Location
 }
 
 func NewSession(proto Protocol, pdHook *PDCallbackImpl,
@@ -57,9 +80,109 @@ func NewSession(proto Protocol, pdHook *PDCallbackImpl,
 			Fields:  &tree.Fields{},
 			Lines:   &tree.Lines{},
 		},
+		txnHandler:      InitTxnHandler(PU.TxnClient),
+		prepareStmts:    make(map[string]*PrepareStmt),
+		binaryStmts:     make(map[uint32]*PrepareStmt),
+		userDefinedVars: make(map[string]interface{}),
+	}
+}
+
+/*
+PrepareForRequest resets the states which only live in a request.
+The session lives as long as the connection.
+*/
+func (ses *Session) PrepareForRequest() {
+	ses.Mrs = nil
+	ses.ep = &tree.ExportParam{
+		Outfile: false,
+		Fields:  &tree.Fields{},
+		Lines:   &tree.Lines{},
+	}
+	ses.closeRef = nil
+}
+
+func (ses *Session) GetTxnHandler() *TxnHandler {
+	return ses.txnHandler
+}
+
+/*
+GetStorage returns the engine the statements of the session are executed on.
+When the storage supports the transaction, it is bound to the active transaction.
+*/
+func (ses *Session) GetStorage() engine.Engine {
+	if ses.txnHandler.IsEnabled() {
+		return ses.txnHandler.GetStorage()
+	}
+	return ses.Pu.StorageEngine
+}
+
+// GetServerStatus returns the status flags of the mysql protocol
+func (ses *Session) GetServerStatus() uint16 {
+	if ses.txnHandler.IsEnabled() {
+		return ses.txnHandler.GetServerStatus()
+	}
+	return DefaultClientConnStatus
+}
+
+/*
+Close rollbacks the active transaction when the connection is closed.
+*/
+func (ses *Session) Close() {
+	if err := ses.txnHandler.RollbackTxn(); err != nil {
+		logutil.Errorf("rollback the transaction of the closed session failed. error:%v", err)
 	}
 }
 
+// SetPrepareStmt keeps the statement prepared by the PREPARE. The old one with the same name is replaced.
+func (ses *Session) SetPrepareStmt(name string, stmt *PrepareStmt) {
+	ses.prepareStmts[strings.ToLower(name)] = stmt
+}
+
+func (ses *Session) GetPrepareStmt(name string) (*PrepareStmt, bool) {
+	stmt, ok := ses.prepareStmts[strings.ToLower(name)]
+	return stmt, ok
+}
+
+func (ses *Session) RemovePrepareStmt(name string) {
+	delete(ses.prepareStmts, strings.ToLower(name))
+}
+
+// AddBinaryStmt keeps the statement prepared by the COM_STMT_PREPARE and assigns the id to it
+func (ses *Session) AddBinaryStmt(stmt *PrepareStmt) {
+	ses.lastStmtId++
+	stmt.Id = ses.lastStmtId
+	ses.binaryStmts[stmt.Id] = stmt
+}
+
+func (ses *Session) GetBinaryStmt(id uint32) (*PrepareStmt, bool) {
+	stmt, ok := ses.binaryStmts[id]
+	return stmt, ok
+}
+
+func (ses *Session) RemoveBinaryStmt(id uint32) {
+	delete(ses.binaryStmts, id)
+}
+
+// SetUserDefinedVar sets the value of the user defined variable. The name is case-insensitive.
+func (ses *Session) SetUserDefinedVar(name string, value interface{}) {
+	ses.userDefinedVars[strings.ToLower(name)] = value
+}
+
+// GetUserDefinedVar returns the value of the user defined variable. The undefined one is NULL.
+func (ses *Session) GetUserDefinedVar(name string) interface{} {
+	return ses.userDefinedVars[strings.ToLower(name)]
+}
+
+// SetTimeZone sets the time zone which the TIMESTAMP values are read and written in
+func (ses *Session) SetTimeZone(loc *time.Location) {
+	ses.timeZone = loc
+}
+
+// GetTimeZone returns the time zone of the session. The nil location is the server time zone.
+func (ses *Session) GetTimeZone() *time.Location {
+	return ses.timeZone
+}
+
 func (ses *Session) GetEpochgc() *PDCallbackImpl {
 	return ses.pdHook
 }
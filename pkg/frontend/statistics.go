@@ -0,0 +1,454 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/sql/stats"
+)
+
+const (
+	//the statistics of the columns in the order they are analyzed, kept in the accountCatalogDB
+	statsCatalogTable = "mo_statistics"
+)
+
+/*
+statsRecord is the statistics of a column built by the ANALYZE TABLE.
+The record whose Dropped is set removes the statistics of the table,
+or the ones of all the tables of the database if the Table is empty.
+*/
+type statsRecord struct {
+	Seq      int64              `json:"seq"`
+	Db       string             `json:"db"`
+	Table    string             `json:"table"`
+	RowCount float64            `json:"rowCount"`
+	Column   *stats.ColumnStats `json:"column"`
+	Dropped  bool               `json:"dropped,omitempty"`
+}
+
+// statsStore persists the statistics of the columns
+type statsStore interface {
+	//Load returns the records after the seq
+	Load(after int64) ([]*statsRecord, error)
+	//Update passes the records after the seq to fn and appends the records fn returns.
+	//Both run in one transaction, so the seqs follow the last record seen by fn.
+	Update(after int64, fn func([]*statsRecord) ([]*statsRecord, error)) error
+	//Compact removes the records replaced by the later ones
+	Compact() error
+}
+
+//the statistics updated by the other servers are visible after the interval at most
+const statsRefreshInterval = time.Second
+
+/*
+StatsManager keeps the statistics of the analyzed tables.
+The statistics of a column are replaced by the later ANALYZE TABLE on it,
+the columns not analyzed again keep their statistics. The statistics of
+a table are removed when it is dropped or altered.
+*/
+type StatsManager struct {
+	sync.RWMutex
+	store statsStore
+
+	//the time the records are replayed from the store last time
+	refreshed time.Time
+	seq       int64
+	//the number of the records replaced since the last compaction
+	replaced int
+	//the statistics keyed by statsKey, they are replaced instead of modified
+	tables map[string]*stats.TableStats
+}
+
+func NewStatsManager(pu *config.ParameterUnit) *StatsManager {
+	sm := &StatsManager{
+		tables: make(map[string]*stats.TableStats),
+	}
+	if pu.TxnClient != nil || pu.StorageEngine != nil {
+		sm.store = newEngineStatsStore(pu)
+	}
+	return sm
+}
+
+func statsKey(db, table string) string {
+	return strings.ToLower(db) + "." + strings.ToLower(table)
+}
+
+// drops reports whether the record removes the statistics of the table keyed by the statsKey
+func (rec *statsRecord) drops(key string) bool {
+	if !rec.Dropped {
+		return false
+	}
+	if rec.Table == "" {
+		return strings.HasPrefix(key, strings.ToLower(rec.Db)+".")
+	}
+	return key == statsKey(rec.Db, rec.Table)
+}
+
+// applyStats applies the record to the statistics and returns the number of the records it replaces
+func applyStats(tables map[string]*stats.TableStats, rec *statsRecord) int {
+	if rec.Dropped {
+		replaced := 0
+		for key, s := range tables {
+			if rec.drops(key) {
+				replaced += len(s.Columns)
+				delete(tables, key)
+			}
+		}
+		return replaced
+	}
+	key := statsKey(rec.Db, rec.Table)
+	s := &stats.TableStats{Db: rec.Db, Table: rec.Table}
+	replaced := 0
+	if old, ok := tables[key]; ok {
+		s.Columns = append(s.Columns, old.Columns...)
+		if old.Column(rec.Column.Name) != nil {
+			replaced = 1
+		}
+	}
+	s.RowCount = rec.RowCount
+	s.SetColumn(rec.Column)
+	tables[key] = s
+	return replaced
+}
+
+// replayLocked applies the records after the seq in their order
+func (sm *StatsManager) replayLocked(records []*statsRecord) {
+	sort.Slice(records, func(i, j int) bool { return records[i].Seq < records[j].Seq })
+	for _, rec := range records {
+		if rec.Seq <= sm.seq {
+			continue
+		}
+		sm.replaced += applyStats(sm.tables, rec)
+		sm.seq = rec.Seq
+	}
+}
+
+/*
+load replays the records appended since the last time, the other servers sharing
+the store may have analyzed the tables.
+*/
+func (sm *StatsManager) load() error {
+	sm.RLock()
+	fresh := time.Since(sm.refreshed) < statsRefreshInterval
+	sm.RUnlock()
+	if fresh {
+		return nil
+	}
+	sm.Lock()
+	defer sm.Unlock()
+	if time.Since(sm.refreshed) < statsRefreshInterval {
+		return nil
+	}
+	if sm.store != nil {
+		records, err := sm.store.Load(sm.seq)
+		if err != nil {
+			return err
+		}
+		sm.replayLocked(records)
+	}
+	sm.refreshed = time.Now()
+	return nil
+}
+
+/*
+update persists the records generated by fn and makes them visible.
+The records appended by the other servers are replayed in the same
+transaction before fn, and the seqs are allocated after the last one
+in the store. The records replaced are removed from the store after.
+*/
+func (sm *StatsManager) update(fn func() []*statsRecord) error {
+	sm.Lock()
+	defer sm.Unlock()
+	var records []*statsRecord
+	makeRecords := func(newer []*statsRecord) []*statsRecord {
+		sm.replayLocked(newer)
+		records = fn()
+		for i, rec := range records {
+			rec.Seq = sm.seq + int64(i) + 1
+		}
+		return records
+	}
+
+	if sm.store != nil {
+		err := sm.store.Update(sm.seq, func(newer []*statsRecord) ([]*statsRecord, error) {
+			return makeRecords(newer), nil
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		makeRecords(nil)
+	}
+	sm.replayLocked(records)
+	sm.refreshed = time.Now()
+	if sm.store == nil || sm.replaced == 0 {
+		return nil
+	}
+	if err := sm.store.Compact(); err != nil {
+		return err
+	}
+	sm.replaced = 0
+	return nil
+}
+
+// Update persists the statistics of the analyzed columns of the table and makes them visible
+func (sm *StatsManager) Update(s *stats.TableStats) error {
+	return sm.update(func() []*statsRecord {
+		records := make([]*statsRecord, len(s.Columns))
+		for i, col := range s.Columns {
+			records[i] = &statsRecord{
+				Db:       s.Db,
+				Table:    s.Table,
+				RowCount: s.RowCount,
+				Column:   col,
+			}
+		}
+		return records
+	})
+}
+
+// Invalidate removes the statistics of the table, or the ones of all the tables of the database if the table is empty
+func (sm *StatsManager) Invalidate(db, table string) error {
+	return sm.update(func() []*statsRecord {
+		rec := &statsRecord{Db: db, Table: table, Dropped: true}
+		for key := range sm.tables {
+			if rec.drops(key) {
+				return []*statsRecord{rec}
+			}
+		}
+		return nil
+	})
+}
+
+// Get returns the statistics of the table, nil if the table is not analyzed
+func (sm *StatsManager) Get(db, table string) (*stats.TableStats, error) {
+	if err := sm.load(); err != nil {
+		return nil, err
+	}
+	sm.RLock()
+	defer sm.RUnlock()
+	return sm.tables[statsKey(db, table)], nil
+}
+
+// List returns the statistics of all the analyzed tables in the order of their names
+func (sm *StatsManager) List() ([]*stats.TableStats, error) {
+	if err := sm.load(); err != nil {
+		return nil, err
+	}
+	sm.RLock()
+	defer sm.RUnlock()
+	keys := make([]string, 0, len(sm.tables))
+	for key := range sm.tables {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	list := make([]*stats.TableStats, len(keys))
+	for i, key := range keys {
+		list[i] = sm.tables[key]
+	}
+	return list, nil
+}
+
+// invalidateStats removes the statistics of the tables dropped or altered by the statement, db is the current database
+func invalidateStats(sm *StatsManager, stmt tree.Statement, db string) {
+	var err error
+	switch st := stmt.(type) {
+	case *tree.DropTable:
+		for _, name := range st.Names {
+			dbName := string(name.SchemaName)
+			if dbName == "" {
+				dbName = db
+			}
+			if err = sm.Invalidate(dbName, string(name.ObjectName)); err != nil {
+				break
+			}
+		}
+	case *tree.AlterTable:
+		dbName := string(st.Table.SchemaName)
+		if dbName == "" {
+			dbName = db
+		}
+		err = sm.Invalidate(dbName, string(st.Table.ObjectName))
+	case *tree.DropDatabase:
+		err = sm.Invalidate(string(st.Name), "")
+	}
+	if err != nil {
+		//the statement is committed, the statistics are removed by the next ANALYZE TABLE
+		logutil.Errorf("remove the statistics of %s failed. error:%v", tree.String(stmt, dialect.MYSQL), err)
+	}
+}
+
+// engineStatsStore keeps the statistics in the table of the system database
+type engineStatsStore struct {
+	log *catalogLog
+}
+
+func newEngineStatsStore(pu *config.ParameterUnit) *engineStatsStore {
+	return &engineStatsStore{
+		//the sketch of a column takes about 10KB in json
+		log: &catalogLog{pu: pu, table: statsCatalogTable, column: "stats", width: 1 << 20},
+	}
+}
+
+func decodeStatsRecords(data [][]byte) ([]*statsRecord, error) {
+	records := make([]*statsRecord, len(data))
+	for i, buf := range data {
+		records[i] = &statsRecord{}
+		if err := json.Unmarshal(buf, records[i]); err != nil {
+			return nil, err
+		}
+	}
+	return records, nil
+}
+
+func encodeStatsRecords(records []*statsRecord) ([]int64, [][]byte, error) {
+	seqs := make([]int64, len(records))
+	data := make([][]byte, len(records))
+	for i, rec := range records {
+		seqs[i] = rec.Seq
+		buf, err := json.Marshal(rec)
+		if err != nil {
+			return nil, nil, err
+		}
+		data[i] = buf
+	}
+	return seqs, data, nil
+}
+
+func (s *engineStatsStore) Load(after int64) ([]*statsRecord, error) {
+	var data [][]byte
+	err := s.log.load(after, func(buf []byte) error {
+		data = append(data, buf)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return decodeStatsRecords(data)
+}
+
+func (s *engineStatsStore) Update(after int64, fn func([]*statsRecord) ([]*statsRecord, error)) error {
+	return s.log.update(after, func(newer [][]byte) ([]int64, [][]byte, error) {
+		records, err := decodeStatsRecords(newer)
+		if err != nil {
+			return nil, nil, err
+		}
+		if records, err = fn(records); err != nil {
+			return nil, nil, err
+		}
+		return encodeStatsRecords(records)
+	})
+}
+
+func (s *engineStatsStore) Compact() error {
+	return s.log.rewrite(func(all [][]byte) ([]int64, [][]byte, error) {
+		records, err := decodeStatsRecords(all)
+		if err != nil {
+			return nil, nil, err
+		}
+		return encodeStatsRecords(compactStats(records))
+	})
+}
+
+/*
+compactStats returns the records not replaced by the later ones in the order of their seqs.
+The records dropping the statistics are kept, the servers which have not replayed
+them yet still keep the statistics they remove.
+*/
+func compactStats(records []*statsRecord) []*statsRecord {
+	sort.Slice(records, func(i, j int) bool { return records[i].Seq < records[j].Seq })
+	//the latest record of each column
+	latest := make(map[string]map[string]*statsRecord)
+	var drops []*statsRecord
+	for _, rec := range records {
+		if rec.Dropped {
+			for key := range latest {
+				if rec.drops(key) {
+					delete(latest, key)
+				}
+			}
+			drops = append(drops, rec)
+			continue
+		}
+		key := statsKey(rec.Db, rec.Table)
+		if latest[key] == nil {
+			latest[key] = make(map[string]*statsRecord)
+		}
+		latest[key][strings.ToLower(rec.Column.Name)] = rec
+	}
+	live := drops
+	for _, cols := range latest {
+		for _, rec := range cols {
+			live = append(live, rec)
+		}
+	}
+	sort.Slice(live, func(i, j int) bool { return live[i].Seq < live[j].Seq })
+	return live
+}
+
+/*
+statsCompilerContext is the compiler context whose statistics of the analyzed
+tables are the ones built by ANALYZE TABLE, the others are from the wrapped context.
+*/
+type statsCompilerContext struct {
+	plan2.CompilerContext
+	stats *StatsManager
+	//the database of the tables without the schema
+	db string
+}
+
+func (c *statsCompilerContext) Stats(obj *plan2.ObjectRef) *plan2.TableStats {
+	db := obj.SchemaName
+	if db == "" {
+		db = c.db
+	}
+	if s, err := c.stats.Get(db, obj.ObjName); err == nil && s != nil {
+		_, def := c.Resolve(obj.ObjName)
+		return s.PlanStats(def)
+	}
+	return c.CompilerContext.Stats(obj)
+}
+
+// showStatsRow is a row of the SHOW STATS
+func showStatsRow(s *stats.TableStats, col *stats.ColumnStats) []interface{} {
+	mcvs := make([]string, len(col.MCVs))
+	for i, mcv := range col.MCVs {
+		mcvs[i] = fmt.Sprintf("%s(%.0f)", mcv.Value, mcv.Count)
+	}
+	return []interface{}{
+		s.Db,
+		s.Table,
+		col.Name,
+		col.Type,
+		int64(s.RowCount),
+		int64(col.NullCount),
+		int64(col.Ndv + 0.5),
+		col.Min,
+		col.Max,
+		int64(len(col.Histogram)),
+		strings.Join(mcvs, ", "),
+	}
+}
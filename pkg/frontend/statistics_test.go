@@ -0,0 +1,261 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/sql/stats"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StatsManager(t *testing.T) {
+	tae := openTestTae(t)
+	defer tae.Close()
+
+	pu, err := getParameterUnit("test/system_vars_config.toml", nil)
+	require.NoError(t, err)
+	pu.TxnClient = tae.TxnClient()
+
+	//the table has 1000 rows, id is unique and name has 10 values
+	attrs := []engine.Attribute{
+		{Name: "id", Type: types.Type{Oid: types.T_int64, Size: 8}},
+		{Name: "name", Type: types.Type{Oid: types.T_varchar, Size: 24, Width: 16}},
+	}
+	ids := make([]int64, 1000)
+	names := make([][]byte, 1000)
+	for i := range ids {
+		ids[i] = int64(i)
+		names[i] = []byte(fmt.Sprintf("name%d", i%10))
+	}
+	bat := batch.New(true, []string{"id", "name"})
+	bat.Vecs[0] = vector.New(attrs[0].Type)
+	bat.Vecs[1] = vector.New(attrs[1].Type)
+	require.NoError(t, vector.Append(bat.Vecs[0], ids))
+	require.NoError(t, vector.Append(bat.Vecs[1], names))
+
+	txn, err := tae.TxnClient().StartTxn(nil)
+	require.NoError(t, err)
+	e := moengine.NewEngine(txn)
+	require.NoError(t, e.Create(0, "db1", 0))
+	db, err := e.Database("db1")
+	require.NoError(t, err)
+	require.NoError(t, db.Create(0, "t1", []engine.TableDef{&engine.AttributeDef{Attr: attrs[0]}, &engine.AttributeDef{Attr: attrs[1]}}))
+	rel, err := db.Relation("t1")
+	require.NoError(t, err)
+	require.NoError(t, rel.Write(0, bat))
+	require.NoError(t, txn.Commit())
+
+	txn, err = tae.TxnClient().StartTxn(nil)
+	require.NoError(t, err)
+	db, err = moengine.NewEngine(txn).Database("db1")
+	require.NoError(t, err)
+	rel, err = db.Relation("t1")
+	require.NoError(t, err)
+	s, err := stats.Analyze(rel, "db1", "t1", attrs)
+	require.NoError(t, err)
+	require.NoError(t, txn.Commit())
+
+	sm := NewStatsManager(pu)
+	require.NoError(t, sm.Update(s))
+
+	//the statistics are loaded from the system table
+	sm2 := NewStatsManager(pu)
+	s2, err := sm2.Get("DB1", "T1")
+	require.NoError(t, err)
+	require.NotNil(t, s2)
+	require.Equal(t, float64(1000), s2.RowCount)
+	require.Equal(t, 2, len(s2.Columns))
+	id := s2.Column("id")
+	require.InDelta(t, 1000, id.Ndv, 1000*0.05)
+	require.Equal(t, "0", id.Min)
+	require.Equal(t, "999", id.Max)
+	require.NotEmpty(t, id.Histogram)
+	require.NotEmpty(t, id.Sketch)
+	name := s2.Column("name")
+	require.True(t, name.IsString)
+	require.Equal(t, 10, len(name.MCVs))
+	require.Equal(t, float64(100), name.MCVs[0].Count)
+
+	//the columns not analyzed again keep their statistics
+	require.NoError(t, sm2.Update(&stats.TableStats{
+		Db:       "db1",
+		Table:    "t1",
+		RowCount: 2000,
+		Columns:  []*stats.ColumnStats{{Name: "name", Ndv: 20}},
+	}))
+	sm3 := NewStatsManager(pu)
+	s3, err := sm3.Get("db1", "t1")
+	require.NoError(t, err)
+	require.Equal(t, float64(2000), s3.RowCount)
+	require.Equal(t, float64(20), s3.Column("name").Ndv)
+	require.Equal(t, id.Ndv, s3.Column("id").Ndv)
+	list, err := sm3.List()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+
+	row := showStatsRow(s3, s3.Column("id"))
+	require.Equal(t, []interface{}{"db1", "t1", "id", "BIGINT", int64(2000), int64(0), int64(math.Round(id.Ndv)),
+		"0", "999", int64(len(id.Histogram)), ""}, row)
+
+	//the replaced statistics of name are removed from the store
+	records, err := sm3.store.Load(0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+
+	//the statistics updated by the other servers are visible after the refresh interval
+	require.NoError(t, sm3.Update(&stats.TableStats{
+		Db:       "db1",
+		Table:    "t2",
+		RowCount: 10,
+		Columns:  []*stats.ColumnStats{{Name: "a", Ndv: 10}},
+	}))
+	s2, err = sm2.Get("db1", "t2")
+	require.NoError(t, err)
+	require.Nil(t, s2)
+	sm2.refreshed = time.Time{}
+	s2, err = sm2.Get("db1", "t2")
+	require.NoError(t, err)
+	require.Equal(t, float64(10), s2.RowCount)
+
+	//the statistics of the dropped or altered tables are removed
+	invalidateStats(sm3, &tree.DropTable{Names: tree.TableNames{tree.NewTableName("T1", tree.ObjectNamePrefix{})}}, "db1")
+	s3, err = sm3.Get("db1", "t1")
+	require.NoError(t, err)
+	require.Nil(t, s3)
+	sm2.refreshed = time.Time{}
+	s2, err = sm2.Get("db1", "t1")
+	require.NoError(t, err)
+	require.Nil(t, s2)
+	list, err = NewStatsManager(pu).List()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	require.Equal(t, "t2", list[0].Table)
+	records, err = sm3.store.Load(0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	require.True(t, records[0].Dropped || records[1].Dropped)
+
+	invalidateStats(sm3, &tree.DropDatabase{Name: "db1"}, "")
+	list, err = NewStatsManager(pu).List()
+	require.NoError(t, err)
+	require.Empty(t, list)
+}
+
+func Test_CompactStats(t *testing.T) {
+	column := func(seq int64, table, name string) *statsRecord {
+		return &statsRecord{Seq: seq, Db: "db", Table: table, Column: &stats.ColumnStats{Name: name}}
+	}
+	records := []*statsRecord{
+		column(1, "t1", "a"),
+		column(2, "t1", "b"),
+		column(3, "t2", "a"),
+		column(4, "t1", "A"),
+		{Seq: 5, Db: "db", Table: "t2", Dropped: true},
+		column(6, "t3", "a"),
+		column(7, "t2", "b"),
+	}
+	var seqs []int64
+	for _, rec := range compactStats(records) {
+		seqs = append(seqs, rec.Seq)
+	}
+	require.Equal(t, []int64{2, 4, 5, 6, 7}, seqs)
+
+	records = append(records, &statsRecord{Seq: 8, Db: "DB", Dropped: true})
+	seqs = seqs[:0]
+	for _, rec := range compactStats(records) {
+		seqs = append(seqs, rec.Seq)
+	}
+	require.Equal(t, []int64{5, 8}, seqs)
+}
+
+func Test_StatsCompilerContext(t *testing.T) {
+	sm := &StatsManager{tables: make(map[string]*stats.TableStats)}
+	require.NoError(t, sm.Update(&stats.TableStats{
+		Db:       "tpch",
+		Table:    "nation",
+		RowCount: 50,
+		Columns:  []*stats.ColumnStats{{Name: "n_regionkey", Ndv: 5}},
+	}))
+
+	ctx := &statsCompilerContext{CompilerContext: plan2.NewMockCompilerContext(), stats: sm, db: "tpch"}
+	nation, _ := ctx.Resolve("nation")
+	s := ctx.Stats(nation)
+	require.Equal(t, float64(50), s.RowCount)
+	require.Equal(t, float64(5), s.Cols["N_REGIONKEY"].Ndv)
+	region, _ := ctx.Resolve("region")
+	require.Equal(t, float64(5), ctx.Stats(region).RowCount)
+}
+
+func Test_EngineCompilerContextStats(t *testing.T) {
+	tae := openTestTae(t)
+	defer tae.Close()
+
+	attrs := []engine.Attribute{
+		{Name: "id", Type: types.Type{Oid: types.T_int32, Size: 4}, Primary: true},
+		{Name: "name", Type: types.Type{Oid: types.T_varchar, Size: 24, Width: 16}},
+	}
+	ids := make([]int32, 100)
+	names := make([][]byte, 100)
+	for i := range ids {
+		ids[i] = int32(i + 10)
+		names[i] = []byte(fmt.Sprintf("name%d", i%10))
+	}
+	bat := batch.New(true, []string{"id", "name"})
+	bat.Vecs[0] = vector.New(attrs[0].Type)
+	bat.Vecs[1] = vector.New(attrs[1].Type)
+	require.NoError(t, vector.Append(bat.Vecs[0], ids))
+	require.NoError(t, vector.Append(bat.Vecs[1], names))
+
+	txn, err := tae.TxnClient().StartTxn(nil)
+	require.NoError(t, err)
+	e := moengine.NewEngine(txn)
+	require.NoError(t, e.Create(0, "db1", 0))
+	db, err := e.Database("db1")
+	require.NoError(t, err)
+	require.NoError(t, db.Create(0, "t1", []engine.TableDef{&engine.AttributeDef{Attr: attrs[0]}, &engine.AttributeDef{Attr: attrs[1]}}))
+	rel, err := db.Relation("t1")
+	require.NoError(t, err)
+	require.NoError(t, rel.Write(0, bat))
+	require.NoError(t, txn.Commit())
+
+	txn, err = tae.TxnClient().StartTxn(nil)
+	require.NoError(t, err)
+	defer txn.Commit()
+	//the statistics come from the engine without ANALYZE
+	ctx := plan2.NewEngineCompilerContext(moengine.NewEngine(txn), "db1")
+	obj, _ := ctx.Resolve("t1")
+	require.NotNil(t, obj)
+	s := ctx.Stats(obj)
+	require.NotNil(t, s)
+	require.Equal(t, float64(100), s.RowCount)
+	id := s.Cols["id"]
+	require.NotNil(t, id)
+	require.Equal(t, float64(100), id.Ndv)
+	require.True(t, id.HasRange)
+	require.Equal(t, float64(10), id.Min)
+	require.Equal(t, float64(109), id.Max)
+	require.Nil(t, s.Cols["name"])
+}
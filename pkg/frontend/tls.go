@@ -0,0 +1,184 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+)
+
+// the time limit of the TLS handshake after the SSLRequest
+const tlsHandshakeTimeout = 10 * time.Second
+
+/*
+loadTLSConfig makes the TLS config of the server from the system variables.
+nil if the certificate of the server is not configured.
+The client certificates are verified against the tlsCaFile if they are given.
+*/
+func loadTLSConfig(sv *config.SystemVariables) (*tls.Config, error) {
+	certFile, keyFile := sv.GetTlsCertFile(), sv.GetTlsKeyFile()
+	if certFile == "" && keyFile == "" {
+		if sv.GetRequireSecureTransport() {
+			return nil, errors.New("requireSecureTransport needs tlsCertFile and tlsKeyFile")
+		}
+		return nil, nil
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load the certificate of the server failed. error:%v", err)
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if caFile := sv.GetTlsCaFile(); caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("read the CA file failed. error:%v", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no CA certificate in %s", caFile)
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return cfg, nil
+}
+
+// upgradableListener accepts the connections which can be upgraded to TLS
+type upgradableListener struct {
+	net.Listener
+}
+
+func (l upgradableListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil {
+		return nil, err
+	}
+	return newUpgradableConn(conn), nil
+}
+
+/*
+upgradableConn is the connection which switches to TLS in the middle of the handshake.
+The io session keeps it from the beginning and all the reads and writes
+go through the TLS connection after the upgrade.
+*/
+type upgradableConn struct {
+	//holds the connHolder
+	conn atomic.Value
+}
+
+// connHolder keeps the type stored in the atomic.Value the same
+type connHolder struct {
+	net.Conn
+}
+
+func newUpgradableConn(conn net.Conn) *upgradableConn {
+	uc := &upgradableConn{}
+	uc.conn.Store(connHolder{conn})
+	return uc
+}
+
+func (uc *upgradableConn) current() net.Conn {
+	return uc.conn.Load().(connHolder).Conn
+}
+
+func (uc *upgradableConn) isSecure() bool {
+	_, ok := uc.current().(*tls.Conn)
+	return ok
+}
+
+/*
+upgrade does the TLS handshake on the connection.
+pending are the bytes of the TLS handshake the client sent along with
+the SSLRequest which have been read from the connection already.
+*/
+func (uc *upgradableConn) upgrade(cfg *tls.Config, pending []byte) error {
+	if uc.isSecure() {
+		return errors.New("the connection is TLS already")
+	}
+	raw := uc.current()
+	if len(pending) != 0 {
+		raw = &prefixConn{Conn: raw, prefix: pending}
+	}
+	tc := tls.Server(raw, cfg)
+	if err := tc.SetDeadline(time.Now().Add(tlsHandshakeTimeout)); err != nil {
+		return err
+	}
+	if err := tc.Handshake(); err != nil {
+		return err
+	}
+	if err := tc.SetDeadline(time.Time{}); err != nil {
+		return err
+	}
+	uc.conn.Store(connHolder{tc})
+	return nil
+}
+
+func (uc *upgradableConn) Read(b []byte) (int, error) {
+	return uc.current().Read(b)
+}
+
+func (uc *upgradableConn) Write(b []byte) (int, error) {
+	return uc.current().Write(b)
+}
+
+func (uc *upgradableConn) Close() error {
+	return uc.current().Close()
+}
+
+func (uc *upgradableConn) LocalAddr() net.Addr {
+	return uc.current().LocalAddr()
+}
+
+func (uc *upgradableConn) RemoteAddr() net.Addr {
+	return uc.current().RemoteAddr()
+}
+
+func (uc *upgradableConn) SetDeadline(t time.Time) error {
+	return uc.current().SetDeadline(t)
+}
+
+func (uc *upgradableConn) SetReadDeadline(t time.Time) error {
+	return uc.current().SetReadDeadline(t)
+}
+
+func (uc *upgradableConn) SetWriteDeadline(t time.Time) error {
+	return uc.current().SetWriteDeadline(t)
+}
+
+// prefixConn reads the prefix before reading the connection
+type prefixConn struct {
+	net.Conn
+	prefix []byte
+}
+
+func (pc *prefixConn) Read(b []byte) (int, error) {
+	if len(pc.prefix) != 0 {
+		n := copy(b, pc.prefix)
+		pc.prefix = pc.prefix[n:]
+		return n, nil
+	}
+	return pc.Conn.Read(b)
+}
@@ -0,0 +1,168 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"database/sql"
+	"encoding/pem"
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/mempool"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestCert writes a self-signed certificate for 127.0.0.1 and its key into dir
+func writeTestCert(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "matrixone"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile, keyFile := filepath.Join(dir, "server.pem"), filepath.Join(dir, "server-key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certFile, keyFile
+}
+
+// loadTestVars loads the system variables with the lines of the configuration
+func loadTestVars(t *testing.T, dir string, lines ...string) *config.SystemVariables {
+	sv := &config.SystemVariables{}
+	require.NoError(t, sv.LoadInitialValues())
+	file := filepath.Join(dir, "system_vars_config.toml")
+	content := ""
+	for _, line := range lines {
+		content += line + "\n"
+	}
+	require.NoError(t, os.WriteFile(file, []byte(content), 0600))
+	require.NoError(t, config.LoadvarsConfigFromFile(file, sv))
+	return sv
+}
+
+func Test_loadTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeTestCert(t, dir)
+
+	cfg, err := loadTLSConfig(loadTestVars(t, dir))
+	require.NoError(t, err)
+	require.Nil(t, cfg)
+
+	_, err = loadTLSConfig(loadTestVars(t, dir, "requireSecureTransport = true"))
+	require.Error(t, err)
+
+	_, err = loadTLSConfig(loadTestVars(t, dir, fmt.Sprintf("tlsCertFile = %q", certFile)))
+	require.Error(t, err)
+
+	cfg, err = loadTLSConfig(loadTestVars(t, dir,
+		fmt.Sprintf("tlsCertFile = %q", certFile),
+		fmt.Sprintf("tlsKeyFile = %q", keyFile)))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(cfg.Certificates))
+	require.Equal(t, tls.NoClientCert, cfg.ClientAuth)
+
+	cfg, err = loadTLSConfig(loadTestVars(t, dir,
+		fmt.Sprintf("tlsCertFile = %q", certFile),
+		fmt.Sprintf("tlsKeyFile = %q", keyFile),
+		fmt.Sprintf("tlsCaFile = %q", certFile)))
+	require.NoError(t, err)
+	require.NotNil(t, cfg.ClientCAs)
+	require.Equal(t, tls.VerifyClientCertIfGiven, cfg.ClientAuth)
+
+	_, err = loadTLSConfig(loadTestVars(t, dir,
+		fmt.Sprintf("tlsCertFile = %q", certFile),
+		fmt.Sprintf("tlsKeyFile = %q", keyFile),
+		fmt.Sprintf("tlsCaFile = %q", keyFile)))
+	require.Error(t, err)
+}
+
+func Test_TLSHandshake(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeTestCert(t, dir)
+
+	startServer := func(port int, requireSecure bool) *MOServer {
+		sv := loadTestVars(t, dir,
+			fmt.Sprintf("port = %d", port),
+			fmt.Sprintf("tlsCertFile = %q", certFile),
+			fmt.Sprintf("tlsKeyFile = %q", keyFile),
+			fmt.Sprintf("requireSecureTransport = %v", requireSecure))
+		pu := config.NewParameterUnit(sv, host.New(sv.GetHostMmuLimitation()), mempool.New(), nil, nil, nil)
+		ppu := NewPDCallbackParameterUnit(int(sv.GetPeriodOfEpochTimer()), int(sv.GetPeriodOfPersistence()), int(sv.GetPeriodOfDDLDeleteTimer()), int(sv.GetTimeoutOfHeartbeat()), sv.GetEnableEpochLogging(), math.MaxInt64)
+		mo := NewMOServer(fmt.Sprintf("127.0.0.1:%d", port), pu, NewPDCallbackImpl(ppu))
+		require.NoError(t, mo.Start())
+		return mo
+	}
+	ping := func(user, password string, port int, secure bool) error {
+		cfg := mysql.NewConfig()
+		cfg.User, cfg.Passwd = user, password
+		cfg.Net, cfg.Addr = "tcp", fmt.Sprintf("127.0.0.1:%d", port)
+		cfg.Timeout, cfg.ReadTimeout, cfg.WriteTimeout = 10*time.Second, 10*time.Second, 10*time.Second
+		if secure {
+			cfg.TLSConfig = "skip-verify"
+		}
+		connector, err := mysql.NewConnector(cfg)
+		require.NoError(t, err)
+		db := sql.OpenDB(connector)
+		defer db.Close()
+		return db.Ping()
+	}
+
+	mo := startServer(6008, false)
+	defer mo.Stop()
+	require.NoError(t, ping("dump", "111", 6008, false))
+	require.NoError(t, ping("dump", "111", 6008, true))
+	require.Error(t, ping("dump", "112", 6008, true))
+
+	//caching_sha2_password does the full authentication on the TLS connection only
+	accounts := mo.rm.accounts
+	require.NoError(t, accounts.CreateUser(parseOne(t, "create user u1 identified with caching_sha2_password by '111'").(*tree.CreateUser)))
+	require.Error(t, ping("u1", "111", 6008, false))
+	require.NoError(t, ping("u1", "111", 6008, true))
+	require.NoError(t, ping("u1", "111", 6008, true))
+	require.Error(t, ping("u1", "112", 6008, true))
+
+	mo2 := startServer(6009, true)
+	defer mo2.Stop()
+	require.Error(t, ping("dump", "111", 6009, false))
+	require.NoError(t, ping("dump", "111", 6009, true))
+}
@@ -0,0 +1,388 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"errors"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/moengine"
+)
+
+var (
+	errorNoActiveTxn = errors.New("there is no active transaction")
+
+	errorTxnAborted = errors.New("current transaction is aborted because a statement failed in it, statements are ignored until ROLLBACK")
+
+	errorTxnNotSupported = NewMysqlError(ER_NOT_SUPPORTED_YET, "transactions on this storage engine")
+)
+
+/*
+TxnHandler holds the transaction state of a session.
+
+Without an explicit transaction, every statement runs in its own
+transaction which is committed after the statement succeeds (autocommit)
+or rolled back after it fails.
+BEGIN starts an explicit transaction which spans the following statements
+until COMMIT or ROLLBACK.
+When the autocommit is off, the transaction started by a statement is
+kept until COMMIT or ROLLBACK just like the explicit one.
+A failed statement can not be undone alone, so it makes the explicit
+transaction rollback only: the following statements are rejected and
+COMMIT rolls it back.
+*/
+type TxnHandler struct {
+	client txnif.TxnClient
+
+	//the active transaction. nil if there is not one.
+	txn txnif.AsyncTxn
+
+	//true if the active transaction spans multiple statements
+	explicit bool
+
+	//true if a statement failed in the explicit transaction
+	aborted bool
+
+	autocommit bool
+
+	//the time the statements read the data as of. zero reads the latest data.
+	snapshot time.Time
+
+	//the snapshot transactions started by the active statement, keyed by the unix nanoseconds of their time
+	snapshots map[int64]txnif.AsyncTxn
+
+	storage engine.Engine
+}
+
+func InitTxnHandler(client txnif.TxnClient) *TxnHandler {
+	th := &TxnHandler{
+		client:     client,
+		autocommit: true,
+	}
+	th.storage = &txnEngine{th: th}
+	return th
+}
+
+// IsEnabled checks the storage supports the transaction or not
+func (th *TxnHandler) IsEnabled() bool {
+	return th.client != nil
+}
+
+// IsInTxn checks there is an active transaction or not
+func (th *TxnHandler) IsInTxn() bool {
+	return th.txn != nil
+}
+
+// IsExplicit checks the active transaction spans multiple statements or not
+func (th *TxnHandler) IsExplicit() bool {
+	return th.txn != nil && th.explicit
+}
+
+func (th *TxnHandler) GetTxn() txnif.AsyncTxn {
+	return th.txn
+}
+
+// IsAborted checks the explicit transaction can only be rolled back or not
+func (th *TxnHandler) IsAborted() bool {
+	return th.txn != nil && th.aborted
+}
+
+// GetStorage returns the engine bound to the active transaction
+func (th *TxnHandler) GetStorage() engine.Engine {
+	return th.storage
+}
+
+// GetServerStatus returns the transaction related status flags for the mysql protocol
+func (th *TxnHandler) GetServerStatus() uint16 {
+	var status uint16 = 0
+	if th.autocommit {
+		status |= SERVER_STATUS_AUTOCOMMIT
+	}
+	if th.IsExplicit() {
+		status |= SERVER_STATUS_IN_TRANS
+	}
+	return status
+}
+
+func (th *TxnHandler) IsAutocommit() bool {
+	return th.autocommit
+}
+
+/*
+SetAutocommit changes the autocommit mode.
+Like the mysql, turning the autocommit on commits the active transaction.
+*/
+func (th *TxnHandler) SetAutocommit(on bool) error {
+	if !on && !th.IsEnabled() {
+		return errorTxnNotSupported
+	}
+	if on && !th.autocommit && th.txn != nil {
+		if err := th.CommitTxn(); err != nil {
+			return err
+		}
+	}
+	th.autocommit = on
+	return nil
+}
+
+// GetSnapshot returns the time the statements read the data as of
+func (th *TxnHandler) GetSnapshot() time.Time {
+	return th.snapshot
+}
+
+/*
+SetSnapshot pins the reads of the following statements to the data as of ts.
+The zero ts unpins the reads.
+*/
+func (th *TxnHandler) SetSnapshot(ts time.Time) {
+	th.snapshot = ts
+}
+
+/*
+snapshotTxn returns the snapshot transaction which reads the data as of ts.
+The snapshot transactions are read only and end with the statement.
+*/
+func (th *TxnHandler) snapshotTxn(ts time.Time) (txnif.AsyncTxn, error) {
+	if txn, ok := th.snapshots[ts.UnixNano()]; ok {
+		return txn, nil
+	}
+	txn, err := th.client.StartSnapshotTxn(nil, ts)
+	if err != nil {
+		return nil, err
+	}
+	if th.snapshots == nil {
+		th.snapshots = make(map[int64]txnif.AsyncTxn)
+	}
+	th.snapshots[ts.UnixNano()] = txn
+	return txn, nil
+}
+
+//endSnapshots ends the snapshot transactions started by the statement
+func (th *TxnHandler) endSnapshots() error {
+	var err error
+	for key, txn := range th.snapshots {
+		delete(th.snapshots, key)
+		if txnErr := txn.Commit(); txnErr != nil {
+			logutil.Errorf("commit snapshot txn %s failed. error:%v", txn.String(), txnErr)
+			if err == nil {
+				err = txnErr
+			}
+		}
+	}
+	return err
+}
+
+func (th *TxnHandler) startTxn() error {
+	txn, err := th.client.StartTxn(nil)
+	if err != nil {
+		return err
+	}
+	th.txn = txn
+	return nil
+}
+
+/*
+BeginTxn starts an explicit transaction.
+Like the mysql, the active transaction is committed implicitly before.
+It fails if the storage does not support the transaction, rather than
+running the following statements in autocommit silently.
+*/
+func (th *TxnHandler) BeginTxn() error {
+	if !th.IsEnabled() {
+		return errorTxnNotSupported
+	}
+	if th.txn != nil {
+		if err := th.CommitTxn(); err != nil {
+			return err
+		}
+	}
+	if err := th.startTxn(); err != nil {
+		return err
+	}
+	th.explicit = true
+	return nil
+}
+
+// CommitTxn commits the active transaction if there is one
+func (th *TxnHandler) CommitTxn() error {
+	th.endSnapshots()
+	if th.txn == nil {
+		return nil
+	}
+	if th.aborted {
+		if err := th.RollbackTxn(); err != nil {
+			return err
+		}
+		return errorTxnAborted
+	}
+	txn := th.txn
+	th.txn = nil
+	th.explicit = false
+	if err := txn.Commit(); err != nil {
+		logutil.Errorf("commit txn %s failed. error:%v", txn.String(), err)
+		return convertTxnError(err)
+	}
+	return nil
+}
+
+// RollbackTxn rollbacks the active transaction if there is one
+func (th *TxnHandler) RollbackTxn() error {
+	th.endSnapshots()
+	if th.txn == nil {
+		return nil
+	}
+	txn := th.txn
+	th.txn = nil
+	th.explicit = false
+	th.aborted = false
+	if err := txn.Rollback(); err != nil {
+		logutil.Errorf("rollback txn %s failed. error:%v", txn.String(), err)
+		return err
+	}
+	return nil
+}
+
+/*
+StartByStatement makes sure there is an active transaction for the statement.
+It rejects the statement if the explicit transaction is aborted.
+*/
+func (th *TxnHandler) StartByStatement() error {
+	if th.IsAborted() {
+		return errorTxnAborted
+	}
+	if !th.IsEnabled() || th.txn != nil {
+		return nil
+	}
+	if err := th.startTxn(); err != nil {
+		return err
+	}
+	th.explicit = !th.autocommit
+	return nil
+}
+
+/*
+CommitAfterStatement finishes the transaction of the statement.
+stmtErr is the error of the statement.
+The transaction which is not explicit is committed if the statement succeeds,
+otherwise it is rolled back.
+The explicit transaction is rolled back if the statement meets a conflict,
+otherwise it is aborted by the failed statement since the partial writes
+of the statement can not be undone alone.
+*/
+func (th *TxnHandler) CommitAfterStatement(stmtErr error) error {
+	//the snapshot transactions fail to commit if the statement writes in them
+	if err := th.endSnapshots(); err != nil && stmtErr == nil {
+		stmtErr = err
+	}
+	if th.txn == nil {
+		return stmtErr
+	}
+	if stmtErr != nil {
+		if !th.explicit || isTxnConflict(stmtErr) {
+			if err := th.RollbackTxn(); err != nil {
+				logutil.Errorf("rollback after the statement failed. error:%v", err)
+			}
+		} else if stmtErr != errorTxnAborted {
+			th.aborted = true
+		}
+		return convertTxnError(stmtErr)
+	}
+	if th.explicit {
+		return nil
+	}
+	return th.CommitTxn()
+}
+
+func isTxnConflict(err error) bool {
+	return errors.Is(err, txnif.TxnWWConflictErr) || errors.Is(err, txnif.TxnRWConflictErr)
+}
+
+//convertTxnError converts the conflict error of the storage into the mysql one
+func convertTxnError(err error) error {
+	if isTxnConflict(err) {
+		return NewMysqlError(ER_LOCK_DEADLOCK)
+	}
+	return err
+}
+
+/*
+txnEngine routes the operations into the active transaction of the handler.
+*/
+type txnEngine struct {
+	th *TxnHandler
+}
+
+var _ engine.SnapshotEngine = &txnEngine{}
+
+func (te *txnEngine) current() (engine.Engine, error) {
+	if te.th.txn == nil {
+		return nil, errorNoActiveTxn
+	}
+	if !te.th.snapshot.IsZero() {
+		return te.Snapshot(te.th.snapshot)
+	}
+	return moengine.NewEngine(te.th.txn), nil
+}
+
+// Snapshot returns the engine bound to the snapshot transaction which reads the data as of ts
+func (te *txnEngine) Snapshot(ts time.Time) (engine.Engine, error) {
+	if te.th.txn == nil {
+		return nil, errorNoActiveTxn
+	}
+	txn, err := te.th.snapshotTxn(ts)
+	if err != nil {
+		return nil, err
+	}
+	return moengine.NewEngine(txn), nil
+}
+
+func (te *txnEngine) Delete(epoch uint64, name string) error {
+	eng, err := te.current()
+	if err != nil {
+		return err
+	}
+	return eng.Delete(epoch, name)
+}
+
+func (te *txnEngine) Create(epoch uint64, name string, typ int) error {
+	eng, err := te.current()
+	if err != nil {
+		return err
+	}
+	return eng.Create(epoch, name, typ)
+}
+
+func (te *txnEngine) Databases() []string {
+	eng, err := te.current()
+	if err != nil {
+		return nil
+	}
+	return eng.Databases()
+}
+
+func (te *txnEngine) Database(name string) (engine.Database, error) {
+	eng, err := te.current()
+	if err != nil {
+		return nil, err
+	}
+	return eng.Database(name)
+}
+
+func (te *txnEngine) Node(ip string) *engine.NodeInfo {
+	return moengine.NewEngine(nil).Node(ip)
+}
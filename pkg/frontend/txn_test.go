@@ -0,0 +1,154 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func openTestTae(t *testing.T) *db.DB {
+	dir := testutils.InitTestEnv("frontend", t)
+	tae, err := db.Open(dir, nil)
+	require.NoError(t, err)
+	return tae
+}
+
+func Test_TxnHandlerAutocommit(t *testing.T) {
+	tae := openTestTae(t)
+	defer tae.Close()
+
+	th := InitTxnHandler(tae.TxnClient())
+	require.True(t, th.IsEnabled())
+	require.Equal(t, SERVER_STATUS_AUTOCOMMIT, th.GetServerStatus())
+
+	//the statement runs in its own transaction
+	require.NoError(t, th.StartByStatement())
+	require.True(t, th.IsInTxn())
+	require.False(t, th.IsExplicit())
+	require.NoError(t, th.GetStorage().Create(0, "db1", 0))
+	require.NoError(t, th.CommitAfterStatement(nil))
+	require.False(t, th.IsInTxn())
+
+	//the failed statement rollbacks its transaction
+	require.NoError(t, th.StartByStatement())
+	require.NoError(t, th.GetStorage().Create(0, "db2", 0))
+	stmtErr := errors.New("statement failed")
+	require.Equal(t, stmtErr, th.CommitAfterStatement(stmtErr))
+	require.False(t, th.IsInTxn())
+
+	require.NoError(t, th.StartByStatement())
+	require.ElementsMatch(t, []string{"db1"}, th.GetStorage().Databases())
+	require.NoError(t, th.CommitAfterStatement(nil))
+
+	//no transaction no storage
+	_, err := th.GetStorage().Database("db1")
+	require.Equal(t, errorNoActiveTxn, err)
+}
+
+func Test_TxnHandlerExplicit(t *testing.T) {
+	tae := openTestTae(t)
+	defer tae.Close()
+
+	th := InitTxnHandler(tae.TxnClient())
+
+	//BEGIN ... ROLLBACK
+	require.NoError(t, th.BeginTxn())
+	require.True(t, th.IsExplicit())
+	require.Equal(t, SERVER_STATUS_AUTOCOMMIT|SERVER_STATUS_IN_TRANS, th.GetServerStatus())
+	require.NoError(t, th.StartByStatement())
+	require.NoError(t, th.GetStorage().Create(0, "db1", 0))
+	require.NoError(t, th.CommitAfterStatement(nil))
+	require.True(t, th.IsInTxn())
+
+	//the failed statement aborts the explicit transaction
+	stmtErr := errors.New("statement failed")
+	require.Equal(t, stmtErr, th.CommitAfterStatement(stmtErr))
+	require.True(t, th.IsInTxn())
+	require.True(t, th.IsAborted())
+	require.Equal(t, errorTxnAborted, th.StartByStatement())
+	require.Equal(t, errorTxnAborted, th.CommitAfterStatement(errorTxnAborted))
+	require.NoError(t, th.RollbackTxn())
+	require.False(t, th.IsInTxn())
+	require.False(t, th.IsAborted())
+
+	//COMMIT of the aborted transaction rollbacks the writes of the failed statement
+	require.NoError(t, th.BeginTxn())
+	require.NoError(t, th.StartByStatement())
+	require.NoError(t, th.GetStorage().Create(0, "db3", 0))
+	require.Equal(t, stmtErr, th.CommitAfterStatement(stmtErr))
+	require.Equal(t, errorTxnAborted, th.CommitTxn())
+	require.False(t, th.IsInTxn())
+	require.NoError(t, th.StartByStatement())
+	require.Empty(t, th.GetStorage().Databases())
+	require.NoError(t, th.CommitAfterStatement(nil))
+
+	//BEGIN ... BEGIN commits the first one implicitly
+	require.NoError(t, th.BeginTxn())
+	require.NoError(t, th.GetStorage().Create(0, "db2", 0))
+	require.NoError(t, th.BeginTxn())
+	require.ElementsMatch(t, []string{"db2"}, th.GetStorage().Databases())
+
+	//the conflict ends the explicit transaction
+	err := th.CommitAfterStatement(txnif.TxnWWConflictErr)
+	require.False(t, th.IsInTxn())
+	myErr, ok := err.(*MysqlError)
+	require.True(t, ok)
+	require.Equal(t, ER_LOCK_DEADLOCK, myErr.ErrorCode)
+}
+
+func Test_TxnHandlerAutocommitOff(t *testing.T) {
+	tae := openTestTae(t)
+	defer tae.Close()
+
+	th := InitTxnHandler(tae.TxnClient())
+	require.NoError(t, th.SetAutocommit(false))
+	require.Equal(t, uint16(0), th.GetServerStatus())
+
+	//the statements share the transaction until the commit
+	require.NoError(t, th.StartByStatement())
+	require.True(t, th.IsExplicit())
+	require.NoError(t, th.GetStorage().Create(0, "db1", 0))
+	require.NoError(t, th.CommitAfterStatement(nil))
+	require.True(t, th.IsInTxn())
+
+	//turning the autocommit on commits the active transaction
+	require.NoError(t, th.SetAutocommit(true))
+	require.False(t, th.IsInTxn())
+
+	require.NoError(t, th.StartByStatement())
+	require.ElementsMatch(t, []string{"db1"}, th.GetStorage().Databases())
+	require.NoError(t, th.CommitAfterStatement(nil))
+}
+
+func Test_TxnHandlerDisabled(t *testing.T) {
+	th := InitTxnHandler(nil)
+	require.False(t, th.IsEnabled())
+	require.NoError(t, th.StartByStatement())
+	require.False(t, th.IsInTxn())
+	//the explicit transaction is rejected instead of being ignored
+	require.Error(t, th.BeginTxn())
+	require.False(t, th.IsInTxn())
+	require.Error(t, th.SetAutocommit(false))
+	require.True(t, th.IsAutocommit())
+	require.NoError(t, th.SetAutocommit(true))
+	require.NoError(t, th.CommitAfterStatement(nil))
+	require.NoError(t, th.RollbackTxn())
+}
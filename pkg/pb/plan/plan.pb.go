@@ -396,6 +396,107 @@ func (OrderBySpec_OrderByFlag) EnumDescriptor() ([]byte, []int) {
 	return file_plan_proto_rawDescGZIP(), []int{16, 0}
 }
 
+type FrameBound_BoundType int32
+
+const (
+	FrameBound_UNBOUNDED_PRECEDING FrameBound_BoundType = 0
+	FrameBound_PRECEDING           FrameBound_BoundType = 1
+	FrameBound_CURRENT_ROW         FrameBound_BoundType = 2
+	FrameBound_FOLLOWING           FrameBound_BoundType = 3
+	FrameBound_UNBOUNDED_FOLLOWING FrameBound_BoundType = 4
+)
+
+// Enum value maps for FrameBound_BoundType.
+var (
+	FrameBound_BoundType_name = map[int32]string{
+		0: "UNBOUNDED_PRECEDING",
+		1: "PRECEDING",
+		2: "CURRENT_ROW",
+		3: "FOLLOWING",
+		4: "UNBOUNDED_FOLLOWING",
+	}
+	FrameBound_BoundType_value = map[string]int32{
+		"UNBOUNDED_PRECEDING": 0,
+		"PRECEDING":           1,
+		"CURRENT_ROW":         2,
+		"FOLLOWING":           3,
+		"UNBOUNDED_FOLLOWING": 4,
+	}
+)
+
+func (x FrameBound_BoundType) Enum() *FrameBound_BoundType {
+	p := new(FrameBound_BoundType)
+	*p = x
+	return p
+}
+
+func (x FrameBound_BoundType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FrameBound_BoundType) Descriptor() protoreflect.EnumDescriptor {
+	return file_plan_proto_enumTypes[4].Descriptor()
+}
+
+func (FrameBound_BoundType) Type() protoreflect.EnumType {
+	return &file_plan_proto_enumTypes[4]
+}
+
+func (x FrameBound_BoundType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FrameBound_BoundType.Descriptor instead.
+func (FrameBound_BoundType) EnumDescriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{17, 0}
+}
+
+type FrameClause_FrameType int32
+
+const (
+	FrameClause_ROWS  FrameClause_FrameType = 0
+	FrameClause_RANGE FrameClause_FrameType = 1
+)
+
+// Enum value maps for FrameClause_FrameType.
+var (
+	FrameClause_FrameType_name = map[int32]string{
+		0: "ROWS",
+		1: "RANGE",
+	}
+	FrameClause_FrameType_value = map[string]int32{
+		"ROWS":  0,
+		"RANGE": 1,
+	}
+)
+
+func (x FrameClause_FrameType) Enum() *FrameClause_FrameType {
+	p := new(FrameClause_FrameType)
+	*p = x
+	return p
+}
+
+func (x FrameClause_FrameType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FrameClause_FrameType) Descriptor() protoreflect.EnumDescriptor {
+	return file_plan_proto_enumTypes[5].Descriptor()
+}
+
+func (FrameClause_FrameType) Type() protoreflect.EnumType {
+	return &file_plan_proto_enumTypes[5]
+}
+
+func (x FrameClause_FrameType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FrameClause_FrameType.Descriptor instead.
+func (FrameClause_FrameType) EnumDescriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{18, 0}
+}
+
 type Node_NodeType int32
 
 const (
@@ -509,11 +610,11 @@ func (x Node_NodeType) String() string {
 }
 
 func (Node_NodeType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[4].Descriptor()
+	return file_plan_proto_enumTypes[6].Descriptor()
 }
 
 func (Node_NodeType) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[4]
+	return &file_plan_proto_enumTypes[6]
 }
 
 func (x Node_NodeType) Number() protoreflect.EnumNumber {
@@ -522,7 +623,7 @@ func (x Node_NodeType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Node_NodeType.Descriptor instead.
 func (Node_NodeType) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{19, 0}
+	return file_plan_proto_rawDescGZIP(), []int{21, 0}
 }
 
 type Node_JoinFlag int32
@@ -535,6 +636,8 @@ const (
 	Node_SINGLE Node_JoinFlag = 8
 	Node_MARK   Node_JoinFlag = 16
 	Node_APPLY  Node_JoinFlag = 32
+	// the anti or mark join of NOT IN or IN, which is aware of the null keys
+	Node_NULL_AWARE Node_JoinFlag = 64
 )
 
 // Enum value maps for Node_JoinFlag.
@@ -547,15 +650,17 @@ var (
 		8:  "SINGLE",
 		16: "MARK",
 		32: "APPLY",
+		64: "NULL_AWARE",
 	}
 	Node_JoinFlag_value = map[string]int32{
-		"INNER":  0,
-		"OUTER":  1,
-		"SEMI":   2,
-		"ANTI":   4,
-		"SINGLE": 8,
-		"MARK":   16,
-		"APPLY":  32,
+		"INNER":      0,
+		"OUTER":      1,
+		"SEMI":       2,
+		"ANTI":       4,
+		"SINGLE":     8,
+		"MARK":       16,
+		"APPLY":      32,
+		"NULL_AWARE": 64,
 	}
 )
 
@@ -570,11 +675,11 @@ func (x Node_JoinFlag) String() string {
 }
 
 func (Node_JoinFlag) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[5].Descriptor()
+	return file_plan_proto_enumTypes[7].Descriptor()
 }
 
 func (Node_JoinFlag) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[5]
+	return &file_plan_proto_enumTypes[7]
 }
 
 func (x Node_JoinFlag) Number() protoreflect.EnumNumber {
@@ -583,7 +688,7 @@ func (x Node_JoinFlag) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Node_JoinFlag.Descriptor instead.
 func (Node_JoinFlag) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{19, 1}
+	return file_plan_proto_rawDescGZIP(), []int{21, 1}
 }
 
 type Node_AggMode int32
@@ -619,11 +724,11 @@ func (x Node_AggMode) String() string {
 }
 
 func (Node_AggMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[6].Descriptor()
+	return file_plan_proto_enumTypes[8].Descriptor()
 }
 
 func (Node_AggMode) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[6]
+	return &file_plan_proto_enumTypes[8]
 }
 
 func (x Node_AggMode) Number() protoreflect.EnumNumber {
@@ -632,7 +737,7 @@ func (x Node_AggMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Node_AggMode.Descriptor instead.
 func (Node_AggMode) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{19, 2}
+	return file_plan_proto_rawDescGZIP(), []int{21, 2}
 }
 
 type Query_StatementType int32
@@ -677,11 +782,11 @@ func (x Query_StatementType) String() string {
 }
 
 func (Query_StatementType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plan_proto_enumTypes[7].Descriptor()
+	return file_plan_proto_enumTypes[9].Descriptor()
 }
 
 func (Query_StatementType) Type() protoreflect.EnumType {
-	return &file_plan_proto_enumTypes[7]
+	return &file_plan_proto_enumTypes[9]
 }
 
 func (x Query_StatementType) Number() protoreflect.EnumNumber {
@@ -690,7 +795,7 @@ func (x Query_StatementType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Query_StatementType.Descriptor instead.
 func (Query_StatementType) EnumDescriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{20, 0}
+	return file_plan_proto_rawDescGZIP(), []int{22, 0}
 }
 
 type Type struct {
@@ -1969,6 +2074,125 @@ func (x *OrderBySpec) GetOrderByFlags() OrderBySpec_OrderByFlag {
 	return OrderBySpec_ASC
 }
 
+type FrameBound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type FrameBound_BoundType `protobuf:"varint,1,opt,name=type,proto3,enum=FrameBound_BoundType" json:"type,omitempty"`
+	// offset of N PRECEDING and N FOLLOWING
+	Offset *Expr `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *FrameBound) Reset() {
+	*x = FrameBound{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plan_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameBound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameBound) ProtoMessage() {}
+
+func (x *FrameBound) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameBound.ProtoReflect.Descriptor instead.
+func (*FrameBound) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *FrameBound) GetType() FrameBound_BoundType {
+	if x != nil {
+		return x.Type
+	}
+	return FrameBound_UNBOUNDED_PRECEDING
+}
+
+func (x *FrameBound) GetOffset() *Expr {
+	if x != nil {
+		return x.Offset
+	}
+	return nil
+}
+
+type FrameClause struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  FrameClause_FrameType `protobuf:"varint,1,opt,name=type,proto3,enum=FrameClause_FrameType" json:"type,omitempty"`
+	Start *FrameBound           `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   *FrameBound           `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *FrameClause) Reset() {
+	*x = FrameClause{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_plan_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrameClause) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrameClause) ProtoMessage() {}
+
+func (x *FrameClause) ProtoReflect() protoreflect.Message {
+	mi := &file_plan_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrameClause.ProtoReflect.Descriptor instead.
+func (*FrameClause) Descriptor() ([]byte, []int) {
+	return file_plan_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FrameClause) GetType() FrameClause_FrameType {
+	if x != nil {
+		return x.Type
+	}
+	return FrameClause_ROWS
+}
+
+func (x *FrameClause) GetStart() *FrameBound {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *FrameClause) GetEnd() *FrameBound {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
 type WindowSpec struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1978,12 +2202,15 @@ type WindowSpec struct {
 	OderyBy     []*OrderBySpec `protobuf:"bytes,2,rep,name=odery_by,json=oderyBy,proto3" json:"odery_by,omitempty"`
 	Lead        int32          `protobuf:"varint,3,opt,name=lead,proto3" json:"lead,omitempty"`
 	Lag         int32          `protobuf:"varint,4,opt,name=lag,proto3" json:"lag,omitempty"`
+	Frame       *FrameClause   `protobuf:"bytes,5,opt,name=frame,proto3" json:"frame,omitempty"`
+	// window functions evaluated over this window
+	WinFuncs []*Expr `protobuf:"bytes,6,rep,name=win_funcs,json=winFuncs,proto3" json:"win_funcs,omitempty"`
 }
 
 func (x *WindowSpec) Reset() {
 	*x = WindowSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[17]
+		mi := &file_plan_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1996,7 +2223,7 @@ func (x *WindowSpec) String() string {
 func (*WindowSpec) ProtoMessage() {}
 
 func (x *WindowSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[17]
+	mi := &file_plan_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2009,7 +2236,7 @@ func (x *WindowSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WindowSpec.ProtoReflect.Descriptor instead.
 func (*WindowSpec) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{17}
+	return file_plan_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *WindowSpec) GetPartitionBy() []*Expr {
@@ -2040,6 +2267,20 @@ func (x *WindowSpec) GetLag() int32 {
 	return 0
 }
 
+func (x *WindowSpec) GetFrame() *FrameClause {
+	if x != nil {
+		return x.Frame
+	}
+	return nil
+}
+
+func (x *WindowSpec) GetWinFuncs() []*Expr {
+	if x != nil {
+		return x.WinFuncs
+	}
+	return nil
+}
+
 type UpdateList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2052,7 +2293,7 @@ type UpdateList struct {
 func (x *UpdateList) Reset() {
 	*x = UpdateList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[18]
+		mi := &file_plan_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2065,7 +2306,7 @@ func (x *UpdateList) String() string {
 func (*UpdateList) ProtoMessage() {}
 
 func (x *UpdateList) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[18]
+	mi := &file_plan_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2078,7 +2319,7 @@ func (x *UpdateList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateList.ProtoReflect.Descriptor instead.
 func (*UpdateList) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{18}
+	return file_plan_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdateList) GetColumns() []*Expr {
@@ -2119,12 +2360,13 @@ type Node struct {
 	ObjRef       *ObjectRef     `protobuf:"bytes,17,opt,name=obj_ref,json=objRef,proto3" json:"obj_ref,omitempty"`
 	RowsetData   *RowsetData    `protobuf:"bytes,18,opt,name=rowset_data,json=rowsetData,proto3" json:"rowset_data,omitempty"`
 	ExtraOptions string         `protobuf:"bytes,19,opt,name=extra_options,json=extraOptions,proto3" json:"extra_options,omitempty"`
+	AggMode      Node_AggMode   `protobuf:"varint,20,opt,name=agg_mode,json=aggMode,proto3,enum=Node_AggMode" json:"agg_mode,omitempty"`
 }
 
 func (x *Node) Reset() {
 	*x = Node{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[19]
+		mi := &file_plan_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2137,7 +2379,7 @@ func (x *Node) String() string {
 func (*Node) ProtoMessage() {}
 
 func (x *Node) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[19]
+	mi := &file_plan_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2150,7 +2392,7 @@ func (x *Node) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Node.ProtoReflect.Descriptor instead.
 func (*Node) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{19}
+	return file_plan_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *Node) GetNodeType() Node_NodeType {
@@ -2286,6 +2528,13 @@ func (x *Node) GetExtraOptions() string {
 	return ""
 }
 
+func (x *Node) GetAggMode() Node_AggMode {
+	if x != nil {
+		return x.AggMode
+	}
+	return Node_FULL
+}
+
 type Query struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2305,7 +2554,7 @@ type Query struct {
 func (x *Query) Reset() {
 	*x = Query{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_plan_proto_msgTypes[20]
+		mi := &file_plan_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2318,7 +2567,7 @@ func (x *Query) String() string {
 func (*Query) ProtoMessage() {}
 
 func (x *Query) ProtoReflect() protoreflect.Message {
-	mi := &file_plan_proto_msgTypes[20]
+	mi := &file_plan_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2331,7 +2580,7 @@ func (x *Query) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Query.ProtoReflect.Descriptor instead.
 func (*Query) Descriptor() ([]byte, []int) {
-	return file_plan_proto_rawDescGZIP(), []int{20}
+	return file_plan_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Query) GetStmtType() Query_StatementType {
@@ -2532,118 +2781,149 @@ var file_plan_proto_rawDesc = []byte{
 	0x4c, 0x4c, 0x53, 0x5f, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x4e,
 	0x55, 0x4c, 0x4c, 0x53, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x55,
 	0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x52,
-	0x4e, 0x41, 0x4c, 0x10, 0x10, 0x22, 0x85, 0x01, 0x0a, 0x0a, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x53, 0x70, 0x65, 0x63, 0x12, 0x28, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70,
-	0x72, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x79, 0x12, 0x27,
-	0x0a, 0x08, 0x6f, 0x64, 0x65, 0x72, 0x79, 0x5f, 0x62, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0c, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07,
-	0x6f, 0x64, 0x65, 0x72, 0x79, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x65, 0x61, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x65, 0x61, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6c,
-	0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6c, 0x61, 0x67, 0x22, 0x4c, 0x0a,
-	0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x07, 0x63,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45,
-	0x78, 0x70, 0x72, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x06,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45,
-	0x78, 0x70, 0x72, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xca, 0x09, 0x0a, 0x04,
-	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x04, 0x63, 0x6f,
-	0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x43, 0x6f, 0x73, 0x74, 0x52,
-	0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78,
-	0x70, 0x72, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x05, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x09, 0x6a,
-	0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
-	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x08,
-	0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x6f, 0x6e, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72,
-	0x52, 0x06, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x77, 0x68, 0x65, 0x72,
-	0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45,
-	0x78, 0x70, 0x72, 0x52, 0x09, 0x77, 0x68, 0x65, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20,
-	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79,
-	0x12, 0x28, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x74,
-	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0b, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x42, 0x79, 0x12, 0x2c, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x26, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63,
-	0x52, 0x07, 0x77, 0x69, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52,
-	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x6f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64,
-	0x65, 0x66, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x44, 0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x12, 0x23, 0x0a,
-	0x07, 0x6f, 0x62, 0x6a, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a,
-	0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x52,
-	0x65, 0x66, 0x12, 0x2c, 0x0a, 0x0b, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x52, 0x6f, 0x77, 0x73, 0x65, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
-	0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xff, 0x02, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
-	0x0e, 0x0a, 0x0a, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x01, 0x12,
-	0x0e, 0x0a, 0x0a, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x02, 0x12,
-	0x11, 0x0a, 0x0d, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x41, 0x4e,
-	0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53,
-	0x43, 0x41, 0x4e, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x54, 0x45, 0x52, 0x49, 0x41,
-	0x4c, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x4f, 0x4a,
-	0x45, 0x43, 0x54, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41,
-	0x4c, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x0b, 0x12, 0x0c, 0x0a, 0x08,
-	0x4d, 0x41, 0x54, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x10, 0x14, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45,
-	0x43, 0x55, 0x52, 0x53, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x54, 0x45, 0x10, 0x15, 0x12, 0x08, 0x0a,
-	0x04, 0x53, 0x49, 0x4e, 0x4b, 0x10, 0x16, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x49, 0x4e, 0x4b, 0x5f,
-	0x53, 0x43, 0x41, 0x4e, 0x10, 0x17, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x47, 0x47, 0x10, 0x1e, 0x12,
-	0x08, 0x0a, 0x04, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x1f, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x41, 0x4d,
-	0x50, 0x4c, 0x45, 0x10, 0x20, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x4f, 0x52, 0x54, 0x10, 0x21, 0x12,
-	0x09, 0x0a, 0x05, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x10, 0x22, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e,
-	0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x23, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x49,
-	0x51, 0x55, 0x45, 0x10, 0x24, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10,
-	0x25, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x28,
-	0x12, 0x09, 0x0a, 0x05, 0x53, 0x50, 0x4c, 0x49, 0x54, 0x10, 0x29, 0x12, 0x0a, 0x0a, 0x06, 0x47,
-	0x41, 0x54, 0x48, 0x45, 0x52, 0x10, 0x2a, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x53, 0x53, 0x45, 0x52,
-	0x54, 0x10, 0x32, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x33, 0x12,
-	0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x34, 0x12, 0x0a, 0x0a, 0x06, 0x44,
-	0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x35, 0x22, 0x55, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x46,
-	0x6c, 0x61, 0x67, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09,
-	0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4d,
-	0x49, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x4e, 0x54, 0x49, 0x10, 0x04, 0x12, 0x0a, 0x0a,
-	0x06, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x08, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x41, 0x52,
-	0x4b, 0x10, 0x10, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x10, 0x20, 0x22, 0x28,
-	0x0a, 0x07, 0x41, 0x67, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c,
-	0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x4f, 0x54, 0x54, 0x4f, 0x4d, 0x10, 0x01, 0x12,
-	0x07, 0x0a, 0x03, 0x54, 0x4f, 0x50, 0x10, 0x02, 0x22, 0xe5, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x12, 0x31, 0x0a, 0x09, 0x73, 0x74, 0x6d, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x73, 0x74, 0x6d,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x05, 0x6e,
-	0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52,
-	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x57, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10,
-	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a,
-	0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44,
-	0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x05,
-	0x2a, 0x56, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53,
-	0x45, 0x52, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10,
-	0x03, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x09, 0x0a,
-	0x05, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x05, 0x42, 0x07, 0x5a, 0x05, 0x2f, 0x70, 0x6c, 0x61,
-	0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4e, 0x41, 0x4c, 0x10, 0x10, 0x22, 0xc4, 0x01, 0x0a, 0x0a, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x42,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x2e,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1d, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x6c,
+	0x0a, 0x09, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x55,
+	0x4e, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x45, 0x43, 0x45, 0x44, 0x49,
+	0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52, 0x45, 0x43, 0x45, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54, 0x5f, 0x52,
+	0x4f, 0x57, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x49, 0x4e,
+	0x47, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x4e, 0x42, 0x4f, 0x55, 0x4e, 0x44, 0x45, 0x44,
+	0x5f, 0x46, 0x4f, 0x4c, 0x4c, 0x4f, 0x57, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x22, 0x9d, 0x01, 0x0a,
+	0x0b, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x43, 0x6c, 0x61, 0x75, 0x73, 0x65, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x42,
+	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x03, 0x65,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x20, 0x0a, 0x09, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x4f, 0x57, 0x53, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x01, 0x22, 0xcd, 0x01, 0x0a,
+	0x0a, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x12, 0x28, 0x0a, 0x0c, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0b, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x79, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x64, 0x65, 0x72, 0x79, 0x5f, 0x62,
+	0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
+	0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x6f, 0x64, 0x65, 0x72, 0x79, 0x42, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x6c, 0x65, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x65,
+	0x61, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x6c, 0x61, 0x67, 0x12, 0x22, 0x0a, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x43, 0x6c, 0x61, 0x75, 0x73,
+	0x65, 0x52, 0x05, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x09, 0x77, 0x69, 0x6e, 0x5f,
+	0x66, 0x75, 0x6e, 0x63, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78,
+	0x70, 0x72, 0x52, 0x08, 0x77, 0x69, 0x6e, 0x46, 0x75, 0x6e, 0x63, 0x73, 0x22, 0x4c, 0x0a, 0x0a,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x07, 0x63, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78,
+	0x70, 0x72, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78,
+	0x70, 0x72, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x84, 0x0a, 0x0a, 0x04, 0x4e,
+	0x6f, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x04, 0x63, 0x6f, 0x73,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x43, 0x6f, 0x73, 0x74, 0x52, 0x04,
+	0x63, 0x6f, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70,
+	0x72, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x09, 0x6a, 0x6f,
+	0x69, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x08, 0x6a,
+	0x6f, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x07, 0x6f, 0x6e, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52,
+	0x06, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0a, 0x77, 0x68, 0x65, 0x72, 0x65,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78,
+	0x70, 0x72, 0x52, 0x09, 0x77, 0x68, 0x65, 0x72, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a,
+	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12,
+	0x28, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x74, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x0b, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x12, 0x2c, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x26, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x07, 0x77, 0x69, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x12, 0x1b, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x65,
+	0x66, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x44,
+	0x65, 0x66, 0x52, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x12, 0x23, 0x0a, 0x07,
+	0x6f, 0x62, 0x6a, 0x5f, 0x72, 0x65, 0x66, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x66, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x52, 0x65,
+	0x66, 0x12, 0x2c, 0x0a, 0x0b, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x52, 0x6f, 0x77, 0x73, 0x65, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0a, 0x72, 0x6f, 0x77, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x61, 0x67, 0x67, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x41, 0x67,
+	0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x61, 0x67, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0xff,
+	0x02, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x56, 0x41, 0x4c, 0x55,
+	0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x41, 0x42, 0x4c,
+	0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x55, 0x4e, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x45,
+	0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x04, 0x12, 0x11,
+	0x0a, 0x0d, 0x4d, 0x41, 0x54, 0x45, 0x52, 0x49, 0x41, 0x4c, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10,
+	0x05, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x0a, 0x12, 0x15,
+	0x0a, 0x11, 0x45, 0x58, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x46, 0x55, 0x4e, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x0b, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x41, 0x54, 0x45, 0x52, 0x49, 0x41,
+	0x4c, 0x10, 0x14, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x43, 0x55, 0x52, 0x53, 0x49, 0x56, 0x45,
+	0x5f, 0x43, 0x54, 0x45, 0x10, 0x15, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x49, 0x4e, 0x4b, 0x10, 0x16,
+	0x12, 0x0d, 0x0a, 0x09, 0x53, 0x49, 0x4e, 0x4b, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x10, 0x17, 0x12,
+	0x07, 0x0a, 0x03, 0x41, 0x47, 0x47, 0x10, 0x1e, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x4f, 0x49, 0x4e,
+	0x10, 0x1f, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x41, 0x4d, 0x50, 0x4c, 0x45, 0x10, 0x20, 0x12, 0x08,
+	0x0a, 0x04, 0x53, 0x4f, 0x52, 0x54, 0x10, 0x21, 0x12, 0x09, 0x0a, 0x05, 0x55, 0x4e, 0x49, 0x4f,
+	0x4e, 0x10, 0x22, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4c, 0x4c,
+	0x10, 0x23, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x49, 0x51, 0x55, 0x45, 0x10, 0x24, 0x12, 0x0a,
+	0x0a, 0x06, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x10, 0x25, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x52,
+	0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x28, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x50, 0x4c,
+	0x49, 0x54, 0x10, 0x29, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x41, 0x54, 0x48, 0x45, 0x52, 0x10, 0x2a,
+	0x12, 0x0a, 0x0a, 0x06, 0x41, 0x53, 0x53, 0x45, 0x52, 0x54, 0x10, 0x32, 0x12, 0x0a, 0x0a, 0x06,
+	0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x33, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41,
+	0x54, 0x45, 0x10, 0x34, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x35,
+	0x22, 0x65, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x09, 0x0a, 0x05,
+	0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4d, 0x49, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
+	0x41, 0x4e, 0x54, 0x49, 0x10, 0x04, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x4e, 0x47, 0x4c, 0x45,
+	0x10, 0x08, 0x12, 0x08, 0x0a, 0x04, 0x4d, 0x41, 0x52, 0x4b, 0x10, 0x10, 0x12, 0x09, 0x0a, 0x05,
+	0x41, 0x50, 0x50, 0x4c, 0x59, 0x10, 0x20, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x55, 0x4c, 0x4c, 0x5f,
+	0x41, 0x57, 0x41, 0x52, 0x45, 0x10, 0x40, 0x22, 0x28, 0x0a, 0x07, 0x41, 0x67, 0x67, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x42, 0x4f, 0x54, 0x54, 0x4f, 0x4d, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x4f, 0x50, 0x10,
+	0x02, 0x22, 0xe5, 0x01, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x31, 0x0a, 0x09, 0x73,
+	0x74, 0x6d, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x73, 0x74, 0x6d, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x73,
+	0x74, 0x65, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x1d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x05, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x22, 0x57, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e,
+	0x53, 0x45, 0x52, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
+	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x09,
+	0x0a, 0x05, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x10, 0x05, 0x2a, 0x56, 0x0a, 0x0d, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54,
+	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x4e, 0x53, 0x45, 0x52, 0x54, 0x10, 0x02, 0x12, 0x0a,
+	0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x10,
+	0x05, 0x42, 0x07, 0x5a, 0x05, 0x2f, 0x70, 0x6c, 0x61, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -2658,87 +2938,99 @@ func file_plan_proto_rawDescGZIP() []byte {
 	return file_plan_proto_rawDescData
 }
 
-var file_plan_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_plan_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_plan_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_plan_proto_goTypes = []interface{}{
 	(StatementType)(0),           // 0: StatementType
 	(Type_TypeId)(0),             // 1: Type.TypeId
 	(Function_FuncFlag)(0),       // 2: Function.FuncFlag
 	(OrderBySpec_OrderByFlag)(0), // 3: OrderBySpec.OrderByFlag
-	(Node_NodeType)(0),           // 4: Node.NodeType
-	(Node_JoinFlag)(0),           // 5: Node.JoinFlag
-	(Node_AggMode)(0),            // 6: Node.AggMode
-	(Query_StatementType)(0),     // 7: Query.StatementType
-	(*Type)(nil),                 // 8: Type
-	(*Const)(nil),                // 9: Const
-	(*ParamRef)(nil),             // 10: ParamRef
-	(*VarRef)(nil),               // 11: VarRef
-	(*ColRef)(nil),               // 12: ColRef
-	(*CorrColRef)(nil),           // 13: CorrColRef
-	(*ExprList)(nil),             // 14: ExprList
-	(*SubQuery)(nil),             // 15: SubQuery
-	(*ObjectRef)(nil),            // 16: ObjectRef
-	(*Function)(nil),             // 17: Function
-	(*Expr)(nil),                 // 18: Expr
-	(*ColDef)(nil),               // 19: ColDef
-	(*TableDef)(nil),             // 20: TableDef
-	(*Cost)(nil),                 // 21: Cost
-	(*ColData)(nil),              // 22: ColData
-	(*RowsetData)(nil),           // 23: RowsetData
-	(*OrderBySpec)(nil),          // 24: OrderBySpec
-	(*WindowSpec)(nil),           // 25: WindowSpec
-	(*UpdateList)(nil),           // 26: UpdateList
-	(*Node)(nil),                 // 27: Node
-	(*Query)(nil),                // 28: Query
+	(FrameBound_BoundType)(0),    // 4: FrameBound.BoundType
+	(FrameClause_FrameType)(0),   // 5: FrameClause.FrameType
+	(Node_NodeType)(0),           // 6: Node.NodeType
+	(Node_JoinFlag)(0),           // 7: Node.JoinFlag
+	(Node_AggMode)(0),            // 8: Node.AggMode
+	(Query_StatementType)(0),     // 9: Query.StatementType
+	(*Type)(nil),                 // 10: Type
+	(*Const)(nil),                // 11: Const
+	(*ParamRef)(nil),             // 12: ParamRef
+	(*VarRef)(nil),               // 13: VarRef
+	(*ColRef)(nil),               // 14: ColRef
+	(*CorrColRef)(nil),           // 15: CorrColRef
+	(*ExprList)(nil),             // 16: ExprList
+	(*SubQuery)(nil),             // 17: SubQuery
+	(*ObjectRef)(nil),            // 18: ObjectRef
+	(*Function)(nil),             // 19: Function
+	(*Expr)(nil),                 // 20: Expr
+	(*ColDef)(nil),               // 21: ColDef
+	(*TableDef)(nil),             // 22: TableDef
+	(*Cost)(nil),                 // 23: Cost
+	(*ColData)(nil),              // 24: ColData
+	(*RowsetData)(nil),           // 25: RowsetData
+	(*OrderBySpec)(nil),          // 26: OrderBySpec
+	(*FrameBound)(nil),           // 27: FrameBound
+	(*FrameClause)(nil),          // 28: FrameClause
+	(*WindowSpec)(nil),           // 29: WindowSpec
+	(*UpdateList)(nil),           // 30: UpdateList
+	(*Node)(nil),                 // 31: Node
+	(*Query)(nil),                // 32: Query
 }
 var file_plan_proto_depIdxs = []int32{
 	1,  // 0: Type.id:type_name -> Type.TypeId
-	18, // 1: ExprList.list:type_name -> Expr
-	16, // 2: Function.func:type_name -> ObjectRef
-	18, // 3: Function.args:type_name -> Expr
-	8,  // 4: Expr.typ:type_name -> Type
-	9,  // 5: Expr.c:type_name -> Const
-	10, // 6: Expr.p:type_name -> ParamRef
-	11, // 7: Expr.v:type_name -> VarRef
-	12, // 8: Expr.col:type_name -> ColRef
-	17, // 9: Expr.f:type_name -> Function
-	14, // 10: Expr.list:type_name -> ExprList
-	15, // 11: Expr.sub:type_name -> SubQuery
-	13, // 12: Expr.corr:type_name -> CorrColRef
-	8,  // 13: ColDef.typ:type_name -> Type
-	19, // 14: TableDef.cols:type_name -> ColDef
-	20, // 15: RowsetData.schema:type_name -> TableDef
-	22, // 16: RowsetData.cols:type_name -> ColData
-	18, // 17: OrderBySpec.order_by:type_name -> Expr
+	20, // 1: ExprList.list:type_name -> Expr
+	18, // 2: Function.func:type_name -> ObjectRef
+	20, // 3: Function.args:type_name -> Expr
+	10, // 4: Expr.typ:type_name -> Type
+	11, // 5: Expr.c:type_name -> Const
+	12, // 6: Expr.p:type_name -> ParamRef
+	13, // 7: Expr.v:type_name -> VarRef
+	14, // 8: Expr.col:type_name -> ColRef
+	19, // 9: Expr.f:type_name -> Function
+	16, // 10: Expr.list:type_name -> ExprList
+	17, // 11: Expr.sub:type_name -> SubQuery
+	15, // 12: Expr.corr:type_name -> CorrColRef
+	10, // 13: ColDef.typ:type_name -> Type
+	21, // 14: TableDef.cols:type_name -> ColDef
+	22, // 15: RowsetData.schema:type_name -> TableDef
+	24, // 16: RowsetData.cols:type_name -> ColData
+	20, // 17: OrderBySpec.order_by:type_name -> Expr
 	3,  // 18: OrderBySpec.order_by_flags:type_name -> OrderBySpec.OrderByFlag
-	18, // 19: WindowSpec.partition_by:type_name -> Expr
-	24, // 20: WindowSpec.odery_by:type_name -> OrderBySpec
-	18, // 21: UpdateList.columns:type_name -> Expr
-	18, // 22: UpdateList.values:type_name -> Expr
-	4,  // 23: Node.node_type:type_name -> Node.NodeType
-	21, // 24: Node.cost:type_name -> Cost
-	18, // 25: Node.project_list:type_name -> Expr
-	5,  // 26: Node.join_type:type_name -> Node.JoinFlag
-	18, // 27: Node.on_list:type_name -> Expr
-	18, // 28: Node.where_list:type_name -> Expr
-	18, // 29: Node.group_by:type_name -> Expr
-	18, // 30: Node.grouping_set:type_name -> Expr
-	24, // 31: Node.order_by:type_name -> OrderBySpec
-	26, // 32: Node.update_list:type_name -> UpdateList
-	25, // 33: Node.win_spec:type_name -> WindowSpec
-	18, // 34: Node.limit:type_name -> Expr
-	18, // 35: Node.offset:type_name -> Expr
-	20, // 36: Node.table_def:type_name -> TableDef
-	16, // 37: Node.obj_ref:type_name -> ObjectRef
-	23, // 38: Node.rowset_data:type_name -> RowsetData
-	7,  // 39: Query.stmt_type:type_name -> Query.StatementType
-	27, // 40: Query.nodes:type_name -> Node
-	18, // 41: Query.params:type_name -> Expr
-	42, // [42:42] is the sub-list for method output_type
-	42, // [42:42] is the sub-list for method input_type
-	42, // [42:42] is the sub-list for extension type_name
-	42, // [42:42] is the sub-list for extension extendee
-	0,  // [0:42] is the sub-list for field type_name
+	4,  // 19: FrameBound.type:type_name -> FrameBound.BoundType
+	20, // 20: FrameBound.offset:type_name -> Expr
+	5,  // 21: FrameClause.type:type_name -> FrameClause.FrameType
+	27, // 22: FrameClause.start:type_name -> FrameBound
+	27, // 23: FrameClause.end:type_name -> FrameBound
+	20, // 24: WindowSpec.partition_by:type_name -> Expr
+	26, // 25: WindowSpec.odery_by:type_name -> OrderBySpec
+	28, // 26: WindowSpec.frame:type_name -> FrameClause
+	20, // 27: WindowSpec.win_funcs:type_name -> Expr
+	20, // 28: UpdateList.columns:type_name -> Expr
+	20, // 29: UpdateList.values:type_name -> Expr
+	6,  // 30: Node.node_type:type_name -> Node.NodeType
+	23, // 31: Node.cost:type_name -> Cost
+	20, // 32: Node.project_list:type_name -> Expr
+	7,  // 33: Node.join_type:type_name -> Node.JoinFlag
+	20, // 34: Node.on_list:type_name -> Expr
+	20, // 35: Node.where_list:type_name -> Expr
+	20, // 36: Node.group_by:type_name -> Expr
+	20, // 37: Node.grouping_set:type_name -> Expr
+	26, // 38: Node.order_by:type_name -> OrderBySpec
+	30, // 39: Node.update_list:type_name -> UpdateList
+	29, // 40: Node.win_spec:type_name -> WindowSpec
+	20, // 41: Node.limit:type_name -> Expr
+	20, // 42: Node.offset:type_name -> Expr
+	22, // 43: Node.table_def:type_name -> TableDef
+	18, // 44: Node.obj_ref:type_name -> ObjectRef
+	25, // 45: Node.rowset_data:type_name -> RowsetData
+	8,  // 46: Node.agg_mode:type_name -> Node.AggMode
+	9,  // 47: Query.stmt_type:type_name -> Query.StatementType
+	31, // 48: Query.nodes:type_name -> Node
+	20, // 49: Query.params:type_name -> Expr
+	50, // [50:50] is the sub-list for method output_type
+	50, // [50:50] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_plan_proto_init() }
@@ -2952,7 +3244,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WindowSpec); i {
+			switch v := v.(*FrameBound); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2964,7 +3256,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateList); i {
+			switch v := v.(*FrameClause); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2976,7 +3268,7 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Node); i {
+			switch v := v.(*WindowSpec); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2988,6 +3280,30 @@ func file_plan_proto_init() {
 			}
 		}
 		file_plan_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plan_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_plan_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Query); i {
 			case 0:
 				return &v.state
@@ -3020,8 +3336,8 @@ func file_plan_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_plan_proto_rawDesc,
-			NumEnums:      8,
-			NumMessages:   21,
+			NumEnums:      10,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
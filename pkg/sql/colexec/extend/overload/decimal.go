@@ -0,0 +1,151 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package overload
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+const (
+	maxDecimal64Width  = 18
+	maxDecimal128Width = 38
+)
+
+// decimalAddSubType returns the result type of plus and minus between two decimals,
+// the scale is the larger scale of the operands and the integer part gets one more digit for the carry.
+func decimalAddSubType(oid types.T, lt, rt types.Type) types.Type {
+	scale := lt.Scale
+	if rt.Scale > scale {
+		scale = rt.Scale
+	}
+	width := decimalWidth(oid, lt) - lt.Scale
+	if w := decimalWidth(oid, rt) - rt.Scale; w > width {
+		width = w
+	}
+	return decimalType(oid, width+scale+1, scale)
+}
+
+// decimalMulType returns the result type of multiplication between two decimals,
+// both the precision and the scale are the sums of the operands'.
+func decimalMulType(lt, rt types.Type) types.Type {
+	return decimalType(types.T_decimal128, decimalWidth(types.T_decimal128, lt)+decimalWidth(types.T_decimal128, rt), lt.Scale+rt.Scale)
+}
+
+// decimalDivType returns the result type of division between two decimals,
+// the scale of the dividend is increased by types.DecimalDivScaleIncrement.
+func decimalDivType(lt, rt types.Type) types.Type {
+	width := decimalWidth(types.T_decimal128, lt) + rt.Scale + types.DecimalDivScaleIncrement
+	return decimalType(types.T_decimal128, width, types.DecimalDivResultScale(lt.Scale))
+}
+
+// decimalWidth returns the precision of typ, an unknown precision is treated as the maximum precision of oid.
+func decimalWidth(oid types.T, typ types.Type) int32 {
+	if typ.Width <= 0 {
+		if oid == types.T_decimal64 {
+			return maxDecimal64Width
+		}
+		return maxDecimal128Width
+	}
+	return typ.Width
+}
+
+func decimalType(oid types.T, width, scale int32) types.Type {
+	maxWidth := int32(maxDecimal128Width)
+	if oid == types.T_decimal64 {
+		maxWidth = maxDecimal64Width
+	}
+	if scale > maxWidth {
+		scale = maxWidth
+	}
+	if width > maxWidth {
+		width = maxWidth
+	}
+	if width < scale {
+		width = scale
+	}
+	return types.Type{Oid: oid, Size: int32(oid.FixedLength()), Width: width, Scale: scale}
+}
+
+// newDecimalResult returns a vector of n rows for the result of a binary decimal operation,
+// its null bitmap is the union of the non-constant operands' ones.
+func newDecimalResult(lv, rv *vector.Vector, proc *process.Process, lc, rc bool, typ types.Type) (*vector.Vector, int, error) {
+	n := vector.Length(lv)
+	if lc && !rc {
+		n = vector.Length(rv)
+	}
+	vec, err := process.Get(proc, int64(typ.Size)*int64(n), typ)
+	if err != nil {
+		return nil, 0, err
+	}
+	switch {
+	case lc && !rc:
+		nulls.Set(vec.Nsp, rv.Nsp)
+	case !lc && rc:
+		nulls.Set(vec.Nsp, lv.Nsp)
+	default:
+		nulls.Or(lv.Nsp, rv.Nsp, vec.Nsp)
+	}
+	return vec, n, nil
+}
+
+// freeDecimalOperands gives back the non-constant operands that are no longer referenced.
+func freeDecimalOperands(lv, rv *vector.Vector, proc *process.Process, lc, rc bool) {
+	if !lc && lv.Ref == 0 {
+		process.Put(proc, lv)
+	}
+	if !rc && rv.Ref == 0 {
+		process.Put(proc, rv)
+	}
+}
+
+// notNullSels appends the rows of [0, n) which are not null in nsp to sels.
+func notNullSels(sels []int64, n int, nsp *nulls.Nulls) []int64 {
+	for i := 0; i < n; i++ {
+		if !nulls.Contains(nsp, uint64(i)) {
+			sels = append(sels, int64(i))
+		}
+	}
+	return sels
+}
+
+func decimal64HasZero(vs []types.Decimal64, nsp *nulls.Nulls) bool {
+	for i, v := range vs {
+		if v == 0 && !nulls.Contains(nsp, uint64(i)) {
+			return true
+		}
+	}
+	return false
+}
+
+func decimal128HasZero(vs []types.Decimal128, nsp *nulls.Nulls) bool {
+	for i, v := range vs {
+		if types.Decimal128IsZero(v) && !nulls.Contains(nsp, uint64(i)) {
+			return true
+		}
+	}
+	return false
+}
+
+// decimalCastType returns the result type of a cast to the decimal type typ. The implicit casts of
+// operands carry no precision, their results take the maximum precision and keep the given scale.
+func decimalCastType(typ types.Type, scale int32) types.Type {
+	if typ.Width > 0 {
+		return decimalType(typ.Oid, typ.Width, typ.Scale)
+	}
+	return decimalType(typ.Oid, decimalWidth(typ.Oid, typ), scale)
+}
@@ -571,7 +571,8 @@ func initCastRulesForBinaryOps() {
 		}...)
 	}
 
-	// plus, minus, multiplication, division between ints and decimal
+	// plus, minus, multiplication, division between integers and decimal,
+	// and between floats and decimal
 	{
 		ops := []int{Plus, Minus, Mult, Div}
 		for _, op := range ops {
@@ -580,7 +581,7 @@ func initCastRulesForBinaryOps() {
 					{Oid: types.T_decimal128, Size: 16},
 					{Oid: types.T_decimal128, Size: 16},
 				}
-				for _, intType := range ints {
+				for _, intType := range append(ints, uints...) {
 					OperatorCastRules[op] = append(OperatorCastRules[op], []castRule{
 						{NumArgs: 2, sourceTypes: []types.T{intType, types.T_decimal128}, targetTypes: targetType},
 						{NumArgs: 2, sourceTypes: []types.T{types.T_decimal128, intType}, targetTypes: targetType},
@@ -591,6 +592,20 @@ func initCastRulesForBinaryOps() {
 					}...)
 				}
 			}
+			{
+				targetType := []types.Type{
+					{Oid: types.T_float64, Size: 8},
+					{Oid: types.T_float64, Size: 8},
+				}
+				for _, floatType := range floats {
+					OperatorCastRules[op] = append(OperatorCastRules[op], []castRule{
+						{NumArgs: 2, sourceTypes: []types.T{floatType, types.T_decimal64}, targetTypes: targetType},
+						{NumArgs: 2, sourceTypes: []types.T{types.T_decimal64, floatType}, targetTypes: targetType},
+						{NumArgs: 2, sourceTypes: []types.T{floatType, types.T_decimal128}, targetTypes: targetType},
+						{NumArgs: 2, sourceTypes: []types.T{types.T_decimal128, floatType}, targetTypes: targetType},
+					}...)
+				}
+			}
 		}
 	}
 
@@ -690,7 +705,7 @@ func initCastRulesForBinaryOps() {
 					//		{NumArgs: 2, sourceTypes: []types.T{types.T_decimal128, types.T_decimal64}, targetTypes: targetType},
 					{NumArgs: 2, sourceTypes: []types.T{types.T_decimal64, types.T_decimal128}, targetTypes: targetType},
 				}...)
-				for _, intType := range ints {
+				for _, intType := range append(ints, uints...) {
 					OperatorCastRules[op] = append(OperatorCastRules[op], []castRule{
 						{NumArgs: 2, sourceTypes: []types.T{intType, types.T_decimal128}, targetTypes: targetType},
 						{NumArgs: 2, sourceTypes: []types.T{types.T_decimal128, intType}, targetTypes: targetType},
@@ -809,6 +824,20 @@ func initCastRulesForBinaryOps() {
 					}...)
 				}
 			}
+			{
+				targetType := []types.Type{
+					{Oid: types.T_float64, Size: 8},
+					{Oid: types.T_float64, Size: 8},
+				}
+				for _, floatType := range floats {
+					OperatorCastRules[op] = append(OperatorCastRules[op], []castRule{
+						{NumArgs: 2, sourceTypes: []types.T{floatType, types.T_decimal64}, targetTypes: targetType},
+						{NumArgs: 2, sourceTypes: []types.T{types.T_decimal64, floatType}, targetTypes: targetType},
+						{NumArgs: 2, sourceTypes: []types.T{floatType, types.T_decimal128}, targetTypes: targetType},
+						{NumArgs: 2, sourceTypes: []types.T{types.T_decimal128, floatType}, targetTypes: targetType},
+					}...)
+				}
+			}
 			{
 				/*
 					cast to datetime op datetime :
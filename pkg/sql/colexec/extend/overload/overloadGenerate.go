@@ -42,6 +42,11 @@ var (
 		types.T_char, types.T_varchar,
 	}
 
+	// decimals contains the fixed point number types in mo.
+	decimals = []types.T{
+		types.T_decimal64, types.T_decimal128,
+	}
+
 	// dates contains all time-related types in mo. It has its own compute and express logic, so
 	// it is different to other types and can not use the same template as others.
 	dates = []types.T{
@@ -525,7 +530,11 @@ func GenerateCast() error {
 		Specials1   []lrt // left type is T_char or T_varchar
 		Specials2   []lrt // right type is T_char or T_varchar
 		Specials3   []lrt // conversion between char and varchar
-		Specials4   []lrt // cast ints to decimal128
+		Specials4   []lrt // cast integers to decimals
+		Specials5   []lrt // cast decimals to floats
+		Specials6   []lrt // cast floats to decimals
+		Specials7   []lrt // cast char and varchar to decimals
+		Specials8   []lrt // cast decimals to char and varchar
 	}
 
 	var pTs = pts{
@@ -541,6 +550,10 @@ func GenerateCast() error {
 			{types.T_varchar, types.T_char, types.T_char},
 		},
 		[]lrt{},
+		[]lrt{},
+		[]lrt{},
+		[]lrt{},
+		[]lrt{},
 	}
 	// init source data
 	for _, typ1 := range numerics {
@@ -567,9 +580,23 @@ func GenerateCast() error {
 	}
 	ints := []types.T{
 		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+	}
+	floats := []types.T{
+		types.T_float32, types.T_float64,
 	}
-	for _, intType := range ints {
-		pTs.Specials4 = append(pTs.Specials4, lrt{intType, types.T_decimal128, types.T_decimal128})
+	for _, decimalType := range decimals {
+		for _, intType := range ints {
+			pTs.Specials4 = append(pTs.Specials4, lrt{intType, decimalType, decimalType})
+		}
+		for _, floatType := range floats {
+			pTs.Specials5 = append(pTs.Specials5, lrt{decimalType, floatType, floatType})
+			pTs.Specials6 = append(pTs.Specials6, lrt{floatType, decimalType, decimalType})
+		}
+		for _, charType := range chars {
+			pTs.Specials7 = append(pTs.Specials7, lrt{charType, decimalType, decimalType})
+			pTs.Specials8 = append(pTs.Specials8, lrt{decimalType, charType, charType})
+		}
 	}
 
 	file, err := os.OpenFile("cast.go", os.O_CREATE|os.O_WRONLY, 0755)
@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 func String(arg interface{}, buf *bytes.Buffer) {
@@ -72,11 +73,38 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 				}
 				err := mergeSort(proc.Mp, argument, bat)
 				if err != nil {
+					argument.ctr.closeRuns(proc)
 					return false, err
 				}
+				if spill.Exceeded(proc.Lim.Size, spill.Size(argument.ctr.bat.Vecs)) {
+					if err := argument.ctr.spill(proc); err != nil {
+						argument.ctr.closeRuns(proc)
+						return false, err
+					}
+				}
 				i--
 			}
 			argument.ctr.state = end
+			if len(argument.ctr.runs) > 0 {
+				if err := argument.ctr.openRuns(proc); err != nil {
+					argument.ctr.closeRuns(proc)
+					return false, err
+				}
+				argument.ctr.state = merging
+			}
+		case merging:
+			bat, err := argument.ctr.merge(proc)
+			if err != nil {
+				argument.ctr.closeRuns(proc)
+				return false, err
+			}
+			if bat == nil {
+				argument.ctr.closeRuns(proc)
+				argument.ctr.state = end
+				continue
+			}
+			proc.Reg.InputBatch = bat
+			return false, nil
 		case end:
 			proc.Reg.InputBatch = argument.ctr.bat
 			argument.ctr.bat = nil
@@ -176,3 +204,134 @@ func mergeSort(mp *mheap.Mheap, arg *Argument, b *batch.Batch) error {
 	arg.ctr.bat = result
 	return nil
 }
+
+// spill writes the sorted rows in memory to disk as a run
+func (ctr *container) spill(proc *process.Process) error {
+	f, err := spill.NewFile()
+	if err != nil {
+		return err
+	}
+	ctr.runs = append(ctr.runs, f)
+	if err := f.WriteRun(ctr.bat.Attrs, ctr.bat.Vecs, ctr.bat.Zs, proc.Mp); err != nil {
+		return err
+	}
+	proc.SpillSize += f.Size()
+	batch.Clean(ctr.bat, proc.Mp)
+	ctr.bat = nil
+	return nil
+}
+
+// openRuns spills the rest rows in memory and prepares to merge all the runs
+func (ctr *container) openRuns(proc *process.Process) error {
+	if ctr.bat != nil {
+		if err := ctr.spill(proc); err != nil {
+			return err
+		}
+	}
+	for _, f := range ctr.runs {
+		r, err := f.NewReader()
+		if err != nil {
+			return err
+		}
+		c := &cursor{r: r}
+		ctr.cursors = append(ctr.cursors, c)
+		if err := c.next(proc); err != nil {
+			return err
+		}
+		if c.bat == nil {
+			c.r.Close()
+			ctr.cursors = ctr.cursors[:len(ctr.cursors)-1]
+		}
+	}
+	if len(ctr.cursors) == 0 {
+		return nil
+	}
+	if ctr.cmps[0] == nil {
+		bat := ctr.cursors[0].bat
+		for k := range ctr.cmps {
+			ctr.cmps[k] = compare.New(batch.GetVector(bat, ctr.attrs[k]).Typ.Oid, ctr.ds[k])
+		}
+	}
+	return nil
+}
+
+// merge returns the next batch of the result by merging the runs, it returns nil if all rows are returned
+func (ctr *container) merge(proc *process.Process) (*batch.Batch, error) {
+	var rbat *batch.Batch
+
+	for len(ctr.cursors) > 0 {
+		k := 0 // the cursor of the minimum row
+		for j := 1; j < len(ctr.cursors); j++ {
+			if ctr.compare(ctr.cursors[j], ctr.cursors[k]) < 0 {
+				k = j
+			}
+		}
+		c := ctr.cursors[k]
+		if rbat == nil {
+			rbat = batch.New(false, c.bat.Attrs)
+			for i, vec := range c.bat.Vecs {
+				rbat.Vecs[i] = vector.New(vec.Typ)
+			}
+		}
+		for i, vec := range rbat.Vecs {
+			if err := vector.UnionOne(vec, c.bat.Vecs[i], c.row, proc.Mp); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return nil, err
+			}
+		}
+		rbat.Zs = append(rbat.Zs, c.bat.Zs[c.row])
+		if c.row++; c.row == int64(len(c.bat.Zs)) {
+			if err := c.next(proc); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return nil, err
+			}
+			if c.bat == nil {
+				c.r.Close()
+				ctr.cursors = append(ctr.cursors[:k], ctr.cursors[k+1:]...)
+			}
+		}
+		if len(rbat.Zs) == spill.BatchRows {
+			break
+		}
+	}
+	return rbat, nil
+}
+
+// compare compares the current rows of two cursors
+func (ctr *container) compare(a, b *cursor) int {
+	for k, cmp := range ctr.cmps {
+		cmp.Set(0, batch.GetVector(a.bat, ctr.attrs[k]))
+		cmp.Set(1, batch.GetVector(b.bat, ctr.attrs[k]))
+		if r := cmp.Compare(0, 1, a.row, b.row); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+// closeRuns removes all the runs
+func (ctr *container) closeRuns(proc *process.Process) {
+	for _, c := range ctr.cursors {
+		if c.bat != nil {
+			batch.Clean(c.bat, proc.Mp)
+		}
+		c.r.Close()
+	}
+	ctr.cursors = nil
+	for _, f := range ctr.runs {
+		f.Close()
+	}
+	ctr.runs = nil
+}
+
+// next reads the next batch of the run
+func (c *cursor) next(proc *process.Process) error {
+	var err error
+
+	if c.bat != nil {
+		batch.Clean(c.bat, proc.Mp)
+	}
+	c.row = 0
+	c.bat, err = c.r.Read(proc.Mp)
+	return err
+}
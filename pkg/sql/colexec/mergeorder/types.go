@@ -18,18 +18,21 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compare"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/order"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 // state values
 const (
 	running = iota
+	merging
 	end
 )
 
 type container struct {
 	// state signs the statement of mergeOrder operator
 	//	1. if state is running, operator still range the mergeReceivers to do merge-sort.
-	//	2. if state is end, operator has done and should push data to next operator.
+	//	2. if state is merging, operator merges the sorted runs spilled to disk and pushes data to next operator.
+	//	3. if state is end, operator has done and should push data to next operator.
 	state uint8
 
 	attrs []string // sorted list of attributes
@@ -39,6 +42,18 @@ type container struct {
 
 	// bat store the result of merge-order
 	bat *batch.Batch
+
+	// runs store the sorted runs spilled to disk once the memory budget of the query is reached
+	runs []*spill.File
+	// cursors are the positions of the runs during external merge sort
+	cursors []*cursor
+}
+
+// cursor is the position of a sorted run during external merge sort
+type cursor struct {
+	r   *spill.Reader
+	bat *batch.Batch
+	row int64
 }
 
 type Argument struct {
@@ -0,0 +1,184 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package anti
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{Conds: [2][]int32{{0}, {1}}}, buf)
+	require.Equal(t, "[0] ▷ [1]", buf.String())
+	buf.Reset()
+	String(&Argument{NullAware: true, Conds: [2][]int32{{0}, {1}}}, buf)
+	require.Equal(t, "[0] ▷ [1] null aware", buf.String())
+}
+
+func TestAnti(t *testing.T) {
+	rows, err := run(t, newProcess(newProbe(t), newBuild(t)), newArgument(false))
+	require.NoError(t, err)
+	require.Equal(t, []string{"p0: 1", "p3: 1", "p4: 1", "p5: 1"}, rows)
+
+	rows, err = run(t, newProcess(newProbe(t), nil), newArgument(false))
+	require.NoError(t, err)
+	require.Equal(t, []string{"p0: 1", "p1: 1", "p2: 1", "p3: 1", "p4: 1", "p5: 1"}, rows)
+}
+
+func TestNullAwareAnti(t *testing.T) {
+	// a key of the build side is null
+	rows, err := run(t, newProcess(newProbe(t), newBuild(t)), newArgument(true))
+	require.NoError(t, err)
+	require.Empty(t, rows)
+
+	// the key of p5 is null
+	build := newBuild(t)[:1]
+	rows, err = run(t, newProcess(newProbe(t), build), newArgument(true))
+	require.NoError(t, err)
+	require.Equal(t, []string{"p0: 1", "p3: 1", "p4: 1"}, rows)
+
+	// the build side is empty
+	rows, err = run(t, newProcess(newProbe(t), nil), newArgument(true))
+	require.NoError(t, err)
+	require.Equal(t, []string{"p0: 1", "p1: 1", "p2: 1", "p3: 1", "p4: 1", "p5: 1"}, rows)
+
+	arg := newArgument(true)
+	arg.Conds = [2][]int32{{0, 1}, {0, 1}}
+	_, err = run(t, newProcess(nil, nil), arg)
+	require.Error(t, err)
+}
+
+func newArgument(nullAware bool) *Argument {
+	return &Argument{
+		NullAware: nullAware,
+		Conds:     [2][]int32{{0}, {0}},
+		Result:    []int32{1},
+	}
+}
+
+func newProcess(probe, build []*batch.Batch) *process.Process {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	for _, bats := range [][]*batch.Batch{probe, build} {
+		reg := &process.WaitRegister{
+			Ctx: context.Background(),
+			Ch:  make(chan *batch.Batch, len(bats)+1),
+		}
+		for _, bat := range bats {
+			reg.Ch <- bat
+		}
+		reg.Ch <- nil
+		proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers, reg)
+	}
+	return proc
+}
+
+// run calls the operator until the end, and returns the rows of the results
+// in order, every row is formatted as "attr, attr, ...: z".
+func run(t *testing.T, proc *process.Process, arg *Argument) ([]string, error) {
+	var rows []string
+
+	if err := Prepare(proc, arg); err != nil {
+		return nil, err
+	}
+	for {
+		end, err := Call(proc, arg)
+		if err != nil {
+			return nil, err
+		}
+		if bat := proc.Reg.InputBatch; bat != nil {
+			for i := range bat.Zs {
+				row := make([]string, len(bat.Vecs))
+				for j, vec := range bat.Vecs {
+					row[j] = format(vec, i)
+				}
+				rows = append(rows, fmt.Sprintf("%s: %v", strings.Join(row, ", "), bat.Zs[i]))
+			}
+			batch.Clean(bat, proc.Mp)
+		}
+		if end {
+			break
+		}
+	}
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+	sort.Strings(rows)
+	return rows, nil
+}
+
+func format(vec *vector.Vector, i int) string {
+	if nulls.Contains(vec.Nsp, uint64(i)) {
+		return "null"
+	}
+	switch vs := vec.Col.(type) {
+	case *types.Bytes:
+		return string(vs.Get(int64(i)))
+	case []int8:
+		return fmt.Sprintf("%v", vs[i])
+	default:
+		return fmt.Sprintf("%v", vs.([]int64)[i])
+	}
+}
+
+// newBatch returns a batch of an int64 attribute and a varchar attribute,
+// nil values are nulls.
+func newBatch(t *testing.T, ks []interface{}, ss []string) *batch.Batch {
+	bat := batch.New(2)
+	bat.InitZsOne(len(ks))
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	vs := make([]int64, len(ks))
+	for i, k := range ks {
+		if k == nil {
+			nulls.Add(bat.Vecs[0].Nsp, uint64(i))
+			continue
+		}
+		vs[i] = int64(k.(int))
+	}
+	bs := make([][]byte, len(ss))
+	for i, s := range ss {
+		bs[i] = []byte(s)
+	}
+	require.NoError(t, vector.Append(bat.Vecs[0], vs))
+	require.NoError(t, vector.Append(bat.Vecs[1], bs))
+	return bat
+}
+
+func newProbe(t *testing.T) []*batch.Batch {
+	return []*batch.Batch{
+		newBatch(t, []interface{}{0, 1, 2}, []string{"p0", "p1", "p2"}),
+		newBatch(t, []interface{}{3, 4, nil}, []string{"p3", "p4", "p5"}),
+	}
+}
+
+func newBuild(t *testing.T) []*batch.Batch {
+	return []*batch.Batch{
+		newBatch(t, []interface{}{1, 2, 2}, []string{"b1", "b2", "b2'"}),
+		newBatch(t, []interface{}{5, nil}, []string{"b5", "bn"}),
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatch
+
+import (
+	"bytes"
+	"fmt"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashkey"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	buf.WriteString(fmt.Sprintf("dispatch to %v pipelines", len(n.Regs)))
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.ctr = new(Container)
+	n.ctr.keys = hashkey.New()
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	bat := proc.Reg.InputBatch
+	if bat == nil {
+		for _, reg := range n.Regs {
+			select {
+			case <-reg.Ctx.Done():
+			case reg.Ch <- nil:
+			}
+		}
+		return true, nil
+	}
+	if len(bat.Zs) == 0 {
+		return false, nil
+	}
+	// all rows are in the same group without attributes
+	if len(n.Regs) == 1 || len(bat.Vecs) == 0 {
+		return send(n.Regs[0], bat, proc), nil
+	}
+	defer batch.Clean(bat, proc.Mp)
+	bats, err := n.ctr.split(bat, len(n.Regs), proc)
+	if err != nil {
+		return false, err
+	}
+	for i, b := range bats {
+		if b == nil {
+			continue
+		}
+		if send(n.Regs[i], b, proc) {
+			for _, b := range bats[i+1:] {
+				if b != nil {
+					batch.Clean(b, proc.Mp)
+				}
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// send returns true if the receiver is closed and bat is dropped
+func send(reg *process.WaitRegister, bat *batch.Batch, proc *process.Process) bool {
+	select {
+	case <-reg.Ctx.Done():
+		batch.Clean(bat, proc.Mp)
+		return true
+	case reg.Ch <- bat:
+		return false
+	}
+}
+
+// split partitions the rows of bat into n batches by the hash of their attributes
+func (ctr *Container) split(bat *batch.Batch, n int, proc *process.Process) ([]*batch.Batch, error) {
+	count := len(bat.Zs)
+	if cap(ctr.parts) < count {
+		ctr.parts = make([]uint64, count)
+		ctr.flags = make([]uint8, count)
+	}
+	ctr.parts = ctr.parts[:count]
+	ctr.flags = ctr.flags[:count]
+	for i := 0; i < count; i += hashkey.UnitLimit {
+		m := count - i
+		if m > hashkey.UnitLimit {
+			m = hashkey.UnitLimit
+		}
+		ctr.keys.Fill(bat.Vecs, int64(i), m)
+		for k := 0; k < m; k++ {
+			ctr.parts[i+k] = ctr.keys.States[k][0] % uint64(n)
+		}
+	}
+	bats := make([]*batch.Batch, n)
+	for p := range bats {
+		ctr.sels = ctr.sels[:0]
+		for i, part := range ctr.parts {
+			if part == uint64(p) {
+				ctr.flags[i] = 1
+				ctr.sels = append(ctr.sels, int64(i))
+			} else {
+				ctr.flags[i] = 0
+			}
+		}
+		if len(ctr.sels) == 0 {
+			continue
+		}
+		b, err := ctr.newBatch(bat, proc)
+		if err != nil {
+			for _, b := range bats {
+				if b != nil {
+					batch.Clean(b, proc.Mp)
+				}
+			}
+			return nil, err
+		}
+		bats[p] = b
+	}
+	return bats, nil
+}
+
+// newBatch returns a batch of the rows in ctr.sels of bat
+func (ctr *Container) newBatch(bat *batch.Batch, proc *process.Process) (*batch.Batch, error) {
+	b := batch.New(len(bat.Vecs))
+	b.Zs = make([]int64, len(ctr.sels))
+	for i, sel := range ctr.sels {
+		b.Zs[i] = bat.Zs[sel]
+	}
+	for i, vec := range bat.Vecs {
+		b.Vecs[i] = vector.New(vec.Typ)
+		if err := vector.UnionBatch(b.Vecs[i], vec, 0, len(ctr.sels), ctr.flags, proc.Mp); err != nil {
+			batch.Clean(b, proc.Mp)
+			return nil, err
+		}
+	}
+	if len(bat.Rs) > 0 {
+		b.Rs = make([]ring.Ring, 0, len(bat.Rs))
+		for _, r := range bat.Rs {
+			rr := r.Dup()
+			b.Rs = append(b.Rs, rr)
+			if err := rr.Grows(len(ctr.sels), proc.Mp); err != nil {
+				batch.Clean(b, proc.Mp)
+				return nil, err
+			}
+			for i, sel := range ctr.sels {
+				rr.Add(r, int64(i), sel)
+			}
+		}
+	}
+	return b, nil
+}
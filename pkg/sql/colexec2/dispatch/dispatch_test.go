@@ -0,0 +1,111 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatch
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/sum"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows = 1000 // default rows
+)
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{Regs: newRegisters(2)}, buf)
+	require.Equal(t, "dispatch to 2 pipelines", buf.String())
+}
+
+func TestDispatch(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	arg := &Argument{Regs: newRegisters(2)}
+	require.NoError(t, Prepare(proc, arg))
+	for i := 0; i < 2; i++ {
+		proc.Reg.InputBatch = newBatch(t, proc, Rows)
+		end, err := Call(proc, arg)
+		require.NoError(t, err)
+		require.False(t, end)
+	}
+	proc.Reg.InputBatch = nil
+	end, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, end)
+
+	rows := make([]int, len(arg.Regs))
+	parts := make(map[int64]int)
+	for i, reg := range arg.Regs {
+		for bat := <-reg.Ch; bat != nil; bat = <-reg.Ch {
+			ks := bat.Vecs[0].Col.([]int64)
+			vs := bat.Rs[0].(*sum.IntRing).Vs
+			for j, k := range ks {
+				// rows of the same key are sent to the same register
+				if p, ok := parts[k]; ok {
+					require.Equal(t, p, i)
+				}
+				parts[k] = i
+				require.Equal(t, k*2, vs[j])
+				require.Equal(t, int64(1), bat.Zs[j])
+			}
+			rows[i] += len(ks)
+			batch.Clean(bat, proc.Mp)
+		}
+	}
+	require.NotZero(t, rows[0])
+	require.NotZero(t, rows[1])
+	require.Equal(t, 2*Rows, rows[0]+rows[1])
+	require.Equal(t, Rows, len(parts))
+}
+
+func newRegisters(n int) []*process.WaitRegister {
+	regs := make([]*process.WaitRegister, n)
+	for i := range regs {
+		regs[i] = &process.WaitRegister{
+			Ctx: context.Background(),
+			Ch:  make(chan *batch.Batch, 3),
+		}
+	}
+	return regs
+}
+
+// newBatch returns a batch of partial groups with keys in [0, rows) and the sums of 2 * key
+func newBatch(t *testing.T, proc *process.Process, rows int64) *batch.Batch {
+	bat := batch.New(1)
+	bat.InitZsOne(int(rows))
+	ks := make([]int64, rows)
+	for i := range ks {
+		ks[i] = int64(i)
+	}
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, vector.Append(bat.Vecs[0], ks))
+	r := sum.NewInt(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, r.Grows(int(rows), proc.Mp))
+	for i := range ks {
+		r.Vs[i] = ks[i] * 2
+	}
+	bat.Rs = append(bat.Rs, r)
+	return bat
+}
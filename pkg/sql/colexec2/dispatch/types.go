@@ -0,0 +1,37 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dispatch
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashkey"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+type Container struct {
+	keys  *hashkey.Keys
+	parts []uint64 // partition of every row
+	flags []uint8  // flags[i] == 1: the i-th row belongs to the current partition
+	sels  []int64  // rows of the current partition
+}
+
+// Argument of the dispatch operator, it repartitions the rows of a batch by
+// the hash of all its attributes, rows of the same attribute values are sent
+// to the same register. The aggregation states of the batch are sent along
+// with their rows, so that partial groups can be finalized in parallel by
+// the mergegroup operators reading the registers.
+type Argument struct {
+	Regs []*process.WaitRegister
+	ctr  *Container
+}
@@ -0,0 +1,40 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec2
+
+/*
+colexec2 is the operators on the batch2 for the plans of the plan2.
+
+compile2 runs the plans of plan2 by them: the table scans are lowered to the
+pipelines of the readers with restrict and projection, which evaluate the
+expressions of plan2 by EvalExpr. The AGG nodes are split by the optimizer of
+plan2 into the BOTTOM and TOP aggregations, the BOTTOM one is lowered to group
+in every pipeline and dispatch by the hash of the group by attributes, the TOP
+one to mergegroup in a pipeline per register. merge and output return the
+result. window is run by compile, which evaluates the window functions of the
+select list with it over the rows sorted by the old planner, see
+compile/window.go.
+
+plan2 decorrelates the subqueries in the filters whose correlations are the
+equalities in the filters of their table scans: EXISTS and IN are the SEMI
+joins, NOT EXISTS and NOT IN the ANTI joins, EXISTS and IN in the other
+expressions the MARK joins, and the scalar subqueries the SINGLE joins. The
+JOIN nodes are lowered to join, left, right, semi, anti, mark and single,
+whose left child is the probe side and right child the build side, in a
+pipeline reading both sides from two registers. The inner, semi and right
+joins whose probe side is a table scan publish the bloom filter of their
+build side to joinfilter, which is appended to the pipelines of the scan.
+The subqueries which are not decorrelated are not supported by compile2.
+*/
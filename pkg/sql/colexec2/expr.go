@@ -0,0 +1,272 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package colexec2
+
+import (
+	"fmt"
+	"strings"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	vmprocess "github.com/matrixorigin/matrixone/pkg/vm/process"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+// borrowed is the reference count of the vectors passed to the kernels of
+// overload which are not owned by the evaluation, the kernels only reuse or
+// recycle the vectors whose reference count is 0 or 1.
+const borrowed = 2
+
+var binaryOps = map[string]int{
+	"=":    overload.EQ,
+	"<>":   overload.NE,
+	"<":    overload.LT,
+	"<=":   overload.LE,
+	">":    overload.GT,
+	">=":   overload.GE,
+	"AND":  overload.And,
+	"OR":   overload.Or,
+	"LIKE": overload.Like,
+	"+":    overload.Plus,
+	"-":    overload.Minus,
+	"*":    overload.Mult,
+	"/":    overload.Div,
+	"%":    overload.Mod,
+}
+
+// negations are the functions of the negated results, the filters are the
+// selection vectors which NOT can not be applied on, so NOT is pushed down
+// to the comparisons.
+var negations = map[string]string{
+	"=":   "<>",
+	"<>":  "=",
+	"<":   ">=",
+	">=":  "<",
+	">":   "<=",
+	"<=":  ">",
+	"AND": "OR",
+	"OR":  "AND",
+}
+
+// EvalExpr evaluates the expression of plan2 on the batch, the columns refer to
+// the vectors of the batch by their positions. The result has a row for every
+// row of the batch and is owned by the caller, or it is the selection vector
+// of the rows satisfying the expression if the expression is a filter.
+func EvalExpr(bat *batch.Batch, proc *process.Process, expr *plan.Expr) (*vector.Vector, error) {
+	p := vmprocess.New(proc.Mp)
+	defer func() {
+		// the intermediate results recycled by the kernels
+		for _, vec := range p.Reg.Vecs {
+			vector.Clean(vec, p.Mp)
+		}
+	}()
+	vec, c, err := eval(bat, p, expr)
+	if err != nil {
+		return nil, err
+	}
+	n := len(bat.Zs)
+	if c && vec.Typ.Oid == types.T_sel {
+		// a constant filter selects all rows or none of them
+		sels := make([]int64, 0, n)
+		if len(vec.Col.([]int64)) > 0 {
+			for i := 0; i < n; i++ {
+				sels = append(sels, int64(i))
+			}
+		}
+		if vec.Ref == 0 {
+			vector.Clean(vec, proc.Mp)
+		}
+		rvec := vector.New(vec.Typ)
+		vector.SetCol(rvec, sels)
+		return rvec, nil
+	}
+	if vec.Ref == 0 && (!c || vector.Length(vec) == n) {
+		return vec, nil
+	}
+	rvec, err := own(vec, n, proc.Mp)
+	if vec.Ref == 0 {
+		vector.Clean(vec, proc.Mp)
+	}
+	return rvec, err
+}
+
+// own returns the vector of n rows owned by the caller for a vector of the
+// batch or a constant.
+func own(vec *vector.Vector, n int, m *mheap.Mheap) (*vector.Vector, error) {
+	if vector.Length(vec) == n {
+		rvec, err := vector.Dup(&vector.Vector{Typ: vec.Typ, Col: vec.Col, Nsp: vec.Nsp, Data: vec.Data}, m)
+		if err != nil {
+			return nil, err
+		}
+		// the nulls are filtered in place by the shrink of the vector
+		rvec.Nsp = &nulls.Nulls{}
+		nulls.Set(rvec.Nsp, vec.Nsp)
+		rvec.Ref = 0
+		return rvec, nil
+	}
+	rvec := vector.New(vec.Typ)
+	for i := 0; i < n; i++ {
+		if err := vector.UnionOne(rvec, vec, 0, m); err != nil {
+			vector.Clean(rvec, m)
+			return nil, err
+		}
+	}
+	rvec.Ref = 0
+	return rvec, nil
+}
+
+// eval returns the result of the expression and whether it is a constant
+func eval(bat *batch.Batch, p *vmprocess.Process, expr *plan.Expr) (*vector.Vector, bool, error) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Col:
+		if e.Col.ColPos < 0 || int(e.Col.ColPos) >= len(bat.Vecs) {
+			return nil, false, errors.New(errno.InternalError, fmt.Sprintf("column '%s' is out of the batch", e.Col.Name))
+		}
+		vec := bat.Vecs[e.Col.ColPos]
+		return &vector.Vector{
+			Or:   true,
+			Ref:  borrowed,
+			Typ:  vec.Typ,
+			Col:  vec.Col,
+			Nsp:  vec.Nsp,
+			Data: vec.Data,
+		}, false, nil
+	case *plan.Expr_C:
+		vec, err := constant(e.C)
+		return vec, true, err
+	case *plan.Expr_F:
+		return evalFunction(bat, p, strings.ToUpper(e.F.Func.GetObjName()), e.F.Args, expr.Typ)
+	}
+	return nil, false, errors.New(errno.FeatureNotSupported, fmt.Sprintf("expression '%v' is not supported now", expr))
+}
+
+func evalFunction(bat *batch.Batch, p *vmprocess.Process, name string, args []*plan.Expr, typ *plan.Type) (*vector.Vector, bool, error) {
+	switch name {
+	case "NOT":
+		if len(args) != 1 {
+			break
+		}
+		f, ok := args[0].Expr.(*plan.Expr_F)
+		if !ok {
+			break
+		}
+		fname := strings.ToUpper(f.F.Func.GetObjName())
+		switch fname {
+		case "NOT":
+			return eval(bat, p, f.F.Args[0])
+		case "AND", "OR":
+			nargs := make([]*plan.Expr, len(f.F.Args))
+			for i, arg := range f.F.Args {
+				nargs[i] = not(arg)
+			}
+			return evalFunction(bat, p, negations[fname], nargs, typ)
+		}
+		if neg, ok := negations[fname]; ok {
+			return evalFunction(bat, p, neg, f.F.Args, typ)
+		}
+	case "UNARY_PLUS":
+		if len(args) == 1 {
+			return eval(bat, p, args[0])
+		}
+	case "UNARY_MINUS":
+		if len(args) != 1 {
+			break
+		}
+		vec, c, err := eval(bat, p, args[0])
+		if err != nil {
+			return nil, false, err
+		}
+		rvec, err := overload.UnaryEval(overload.UnaryMinus, vec.Typ.Oid, c, vec, p)
+		return rvec, c, err
+	case "CAST":
+		if len(args) != 1 || typ == nil {
+			break
+		}
+		vec, c, err := eval(bat, p, args[0])
+		if err != nil {
+			return nil, false, err
+		}
+		target := types.T(typ.Id).ToType()
+		if vec.Typ.Oid == target.Oid {
+			return vec, c, nil
+		}
+		rvec, err := overload.BinaryEval(overload.Typecast, vec.Typ.Oid, target.Oid, c, false, vec, vector.New(target), p)
+		return rvec, c, err
+	default:
+		if op, ok := binaryOps[name]; ok {
+			return evalBinary(bat, p, op, args)
+		}
+	}
+	return nil, false, errors.New(errno.FeatureNotSupported, fmt.Sprintf("function '%s' is not supported now", name))
+}
+
+func evalBinary(bat *batch.Batch, p *vmprocess.Process, op int, args []*plan.Expr) (*vector.Vector, bool, error) {
+	if len(args) != 2 {
+		return nil, false, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%s' needs 2 arguments", overload.OpName[op]))
+	}
+	lv, lc, err := eval(bat, p, args[0])
+	if err != nil {
+		return nil, false, err
+	}
+	rv, rc, err := eval(bat, p, args[1])
+	if err != nil {
+		return nil, false, err
+	}
+	vec, err := overload.BinaryEval(op, lv.Typ.Oid, rv.Typ.Oid, lc, rc, lv, rv, p)
+	return vec, lc && rc, err
+}
+
+func not(expr *plan.Expr) *plan.Expr {
+	return &plan.Expr{
+		Typ: expr.Typ,
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: &plan.ObjectRef{ObjName: "NOT"},
+				Args: []*plan.Expr{expr},
+			},
+		},
+	}
+}
+
+func constant(c *plan.Const) (*vector.Vector, error) {
+	var vec *vector.Vector
+	switch v := c.Value.(type) {
+	case *plan.Const_Ival:
+		vec = vector.New(types.T_int64.ToType())
+		vector.SetCol(vec, []int64{v.Ival})
+	case *plan.Const_Dval:
+		vec = vector.New(types.T_float64.ToType())
+		vector.SetCol(vec, []float64{v.Dval})
+	case *plan.Const_Sval:
+		vec = vector.New(types.T_varchar.ToType())
+		vector.SetCol(vec, &types.Bytes{
+			Data:    []byte(v.Sval),
+			Offsets: []uint32{0},
+			Lengths: []uint32{uint32(len(v.Sval))},
+		})
+	}
+	if vec == nil || c.Isnull {
+		return nil, errors.New(errno.FeatureNotSupported, "the null constant is not supported now")
+	}
+	vec.Ref = borrowed
+	return vec, nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"bytes"
+	"fmt"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashkey"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	buf.WriteString("γ([")
+	for i, pos := range n.Exprs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(fmt.Sprintf("%v", pos))
+	}
+	buf.WriteString("], [")
+	for i, agg := range n.Aggs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(agg.String())
+	}
+	buf.WriteString("])")
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.ctr = new(Container)
+	if len(n.Exprs) > 0 {
+		n.ctr.inserted = make([]uint8, hashkey.UnitLimit)
+		n.ctr.zInserted = make([]uint8, hashkey.UnitLimit)
+		n.ctr.values = make([]uint64, hashkey.UnitLimit)
+		n.ctr.vecs = make([]*vector.Vector, len(n.Exprs))
+		n.ctr.keys = hashkey.New()
+		n.ctr.ht = &hashtable.StringHashMap{}
+		n.ctr.ht.Init()
+	}
+	return nil
+}
+
+// Call aggregates all input batches, and returns the groups after the last
+// batch is received.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	ctr := n.ctr
+	for {
+		switch ctr.state {
+		case Build:
+			bat := proc.Reg.InputBatch
+			if bat == nil {
+				ctr.state = Eval
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				return false, nil
+			}
+			proc.Reg.InputBatch = &batch.Batch{}
+			if err := ctr.build(n, bat, proc); err != nil {
+				if ctr.bat != nil {
+					batch.Clean(ctr.bat, proc.Mp)
+					ctr.bat = nil
+				}
+				ctr.state = End
+				return true, err
+			}
+			return false, nil
+		case Eval:
+			ctr.state = End
+			if ctr.bat == nil {
+				proc.Reg.InputBatch = nil
+				return true, nil
+			}
+			if n.NeedEval {
+				Finalize(ctr.bat)
+			}
+			proc.Reg.InputBatch = ctr.bat
+			ctr.bat = nil
+			return true, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+// Finalize turns the aggregation states of bat into the result attributes
+func Finalize(bat *batch.Batch) {
+	for _, r := range bat.Rs {
+		bat.Vecs = append(bat.Vecs, r.Eval(bat.Zs))
+	}
+	bat.Rs = nil
+	for i := range bat.Zs {
+		bat.Zs[i] = 1
+	}
+}
+
+func (ctr *Container) build(n *Argument, bat *batch.Batch, proc *process.Process) error {
+	defer batch.Clean(bat, proc.Mp)
+	if ctr.bat == nil {
+		ctr.bat = batch.New(len(n.Exprs))
+		for i, pos := range n.Exprs {
+			ctr.bat.Vecs[i] = vector.New(bat.Vecs[pos].Typ)
+		}
+		ctr.bat.Rs = make([]ring.Ring, 0, len(n.Aggs))
+		for _, agg := range n.Aggs {
+			r, err := transformer.New(agg.Op, bat.Vecs[agg.Pos].Typ)
+			if err != nil {
+				return err
+			}
+			ctr.bat.Rs = append(ctr.bat.Rs, r)
+		}
+		if len(n.Exprs) == 0 {
+			ctr.bat.Zs = []int64{0}
+			for _, r := range ctr.bat.Rs {
+				if err := r.Grow(proc.Mp); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if len(n.Exprs) == 0 {
+		for _, z := range bat.Zs {
+			ctr.bat.Zs[0] += z
+		}
+		for i, r := range ctr.bat.Rs {
+			r.BulkFill(0, bat.Zs, bat.Vecs[n.Aggs[i].Pos])
+		}
+		return nil
+	}
+	for i, pos := range n.Exprs {
+		ctr.vecs[i] = bat.Vecs[pos]
+	}
+	count := len(bat.Zs)
+	for i := 0; i < count; i += hashkey.UnitLimit {
+		m := count - i
+		if m > hashkey.UnitLimit {
+			m = hashkey.UnitLimit
+		}
+		ctr.keys.Fill(ctr.vecs, int64(i), m)
+		ctr.ht.InsertHashStateBatch(ctr.keys.States[:m], ctr.values)
+		cnt := 0
+		copy(ctr.inserted[:m], ctr.zInserted[:m])
+		for k, v := range ctr.values[:m] {
+			if v > ctr.rows {
+				ctr.inserted[k] = 1
+				ctr.rows++
+				cnt++
+				ctr.bat.Zs = append(ctr.bat.Zs, 0)
+			}
+			ctr.bat.Zs[v-1] += bat.Zs[i+k]
+		}
+		if cnt > 0 {
+			for j, vec := range ctr.bat.Vecs {
+				if err := vector.UnionBatch(vec, ctr.vecs[j], int64(i), cnt, ctr.inserted[:m], proc.Mp); err != nil {
+					return err
+				}
+			}
+			for _, r := range ctr.bat.Rs {
+				if err := r.Grows(cnt, proc.Mp); err != nil {
+					return err
+				}
+			}
+		}
+		for j, r := range ctr.bat.Rs {
+			r.BatchFill(int64(i), ctr.inserted[:m], ctr.values, bat.Zs, bat.Vecs[n.Aggs[j].Pos])
+		}
+	}
+	return nil
+}
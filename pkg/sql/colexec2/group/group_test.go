@@ -0,0 +1,111 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"bytes"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows = 1000 // default rows
+)
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{Exprs: []int32{0}, Aggs: []Aggregate{{Op: transformer.Sum, Pos: 1}}}, buf)
+	require.Equal(t, "γ([0], [sum(1)])", buf.String())
+}
+
+func TestGroup(t *testing.T) {
+	proc := newProcess()
+	arg := &Argument{
+		NeedEval: true,
+		Exprs:    []int32{0},
+		Aggs:     []Aggregate{{Op: transformer.Sum, Pos: 1}, {Op: transformer.StarCount, Pos: 1}},
+	}
+	require.NoError(t, Prepare(proc, arg))
+	bat := run(t, proc, arg, newBatch(t, proc, Rows, 10), newBatch(t, proc, Rows, 10))
+	require.Equal(t, 10, len(bat.Zs))
+	keys := bat.Vecs[0].Col.([]int64)
+	sums := bat.Vecs[1].Col.([]int64)
+	counts := bat.Vecs[2].Col.([]int64)
+	for i, k := range keys {
+		// rows k, k + 10, k + 20, ... of both batches
+		require.Equal(t, 2*(Rows/10*k+10*(Rows/10)*(Rows/10-1)/2), sums[i])
+		require.Equal(t, int64(2*Rows/10), counts[i])
+	}
+	batch.Clean(bat, proc.Mp)
+}
+
+func TestGroupWithoutExprs(t *testing.T) {
+	proc := newProcess()
+	arg := &Argument{
+		Aggs: []Aggregate{{Op: transformer.Max, Pos: 1}},
+	}
+	require.NoError(t, Prepare(proc, arg))
+	bat := run(t, proc, arg, newBatch(t, proc, Rows, 10))
+	require.Equal(t, []int64{Rows}, bat.Zs)
+	require.Equal(t, 0, len(bat.Vecs))
+	require.Equal(t, 1, len(bat.Rs))
+	Finalize(bat)
+	require.Equal(t, []int64{Rows - 1}, bat.Vecs[0].Col.([]int64))
+	batch.Clean(bat, proc.Mp)
+}
+
+func run(t *testing.T, proc *process.Process, arg *Argument, bats ...*batch.Batch) *batch.Batch {
+	for _, bat := range bats {
+		proc.Reg.InputBatch = bat
+		end, err := Call(proc, arg)
+		require.NoError(t, err)
+		require.False(t, end)
+	}
+	proc.Reg.InputBatch = nil
+	end, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, end)
+	return proc.Reg.InputBatch
+}
+
+func newProcess() *process.Process {
+	return process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+}
+
+// newBatch returns a batch of rows (i % groups, i) for i in [0, rows)
+func newBatch(t *testing.T, proc *process.Process, rows, groups int64) *batch.Batch {
+	bat := batch.New(2)
+	bat.InitZsOne(int(rows))
+	ks := make([]int64, rows)
+	vs := make([]int64, rows)
+	for i := range vs {
+		ks[i] = int64(i) % groups
+		vs[i] = int64(i)
+	}
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, vector.Append(bat.Vecs[0], ks))
+	require.NoError(t, vector.Append(bat.Vecs[1], vs))
+	return bat
+}
@@ -0,0 +1,71 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"fmt"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashkey"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+)
+
+const (
+	Build = iota
+	Eval
+	End
+)
+
+// Aggregate is an aggregation function of the group operator.
+type Aggregate struct {
+	// Op is the transformer op of the function, such as transformer.Sum
+	Op int
+	// Pos is the position of the argument
+	Pos int32
+}
+
+type Container struct {
+	state     int
+	rows      uint64 // number of groups
+	inserted  []uint8
+	zInserted []uint8
+	values    []uint64
+	vecs      []*vector.Vector // group by attributes of the input batch
+	keys      *hashkey.Keys
+	ht        *hashtable.StringHashMap
+
+	bat *batch.Batch
+}
+
+// Argument of the hash aggregation. The result batch has the group by
+// attributes in order, and then the results of Aggs. If NeedEval is false
+// the operator is the partial (bottom) phase of a two-phase aggregation,
+// the results are kept as aggregation states in the Rs of the batch, which
+// are repartitioned by dispatch and finalized by mergegroup.
+type Argument struct {
+	NeedEval bool
+	Exprs    []int32 // positions of the group by attributes
+	Aggs     []Aggregate
+	ctr      *Container
+}
+
+func (a Aggregate) String() string {
+	if a.Op < 0 || a.Op >= len(transformer.TransformerNames) {
+		return fmt.Sprintf("Aggregate(%d)(%v)", a.Op, a.Pos)
+	}
+	return fmt.Sprintf("%s(%v)", transformer.TransformerNames[a.Op], a.Pos)
+}
@@ -0,0 +1,250 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hashbuild builds the hash table of the build side of the hash
+// joins, and finds the rows of the build side that match the rows of the
+// probe side. A row whose join keys have a null never matches any row.
+package hashbuild
+
+import (
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashkey"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/joinfilter"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+// ResultPos is an attribute of the result of a join
+type ResultPos struct {
+	Rel int32 // 0 is the probe side and 1 is the build side
+	Pos int32
+}
+
+type HashMap struct {
+	// Bat holds all rows of the build side, it is nil if the build side is empty
+	Bat *batch.Batch
+	// HasNull is true if the join keys of a row of the build side have a null
+	HasNull bool
+	// Sels are the rows of the distinct keys, the rows of key v are Sels[v-1]
+	Sels [][]int64
+	// Filter is the bloom filter of the keys if it is required
+	Filter *joinfilter.Filter
+	ht     *hashtable.StringHashMap
+}
+
+type Prober struct {
+	vecs   []*vector.Vector
+	keys   *hashkey.Keys
+	states [][3]uint64
+	// Values are the keys matched by the rows found last time, 0 means no match
+	Values []uint64
+	// Nulls are true if the join keys of the rows found last time have a null
+	Nulls []bool
+}
+
+// Build receives all batches of the build side from reg, and builds the hash
+// table on the attributes conds.
+func Build(reg *process.WaitRegister, conds []int32, needFilter bool, proc *process.Process) (*HashMap, error) {
+	hm := &HashMap{ht: &hashtable.StringHashMap{}}
+	hm.ht.Init()
+	for {
+		bat := <-reg.Ch
+		if bat == nil {
+			break
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		if err := hm.append(bat, proc); err != nil {
+			batch.Clean(bat, proc.Mp)
+			hm.Free(proc.Mp)
+			return nil, err
+		}
+		batch.Clean(bat, proc.Mp)
+	}
+	if hm.Bat == nil {
+		if needFilter {
+			hm.Filter = joinfilter.New(0)
+		}
+		return hm, nil
+	}
+	count := len(hm.Bat.Zs)
+	if needFilter {
+		hm.Filter = joinfilter.New(count)
+	}
+	vecs := make([]*vector.Vector, len(conds))
+	for i, pos := range conds {
+		vecs[i] = hm.Bat.Vecs[pos]
+	}
+	keys := hashkey.New()
+	flags := make([]bool, hashkey.UnitLimit)
+	states := make([][3]uint64, hashkey.UnitLimit)
+	rows := make([]int64, hashkey.UnitLimit)
+	values := make([]uint64, hashkey.UnitLimit)
+	for i := 0; i < count; i += hashkey.UnitLimit {
+		n := count - i
+		if n > hashkey.UnitLimit {
+			n = hashkey.UnitLimit
+		}
+		if hashkey.FillNulls(vecs, int64(i), n, flags) {
+			hm.HasNull = true
+		}
+		keys.Fill(vecs, int64(i), n)
+		cnt := 0
+		for k := 0; k < n; k++ {
+			if !flags[k] {
+				states[cnt] = keys.States[k]
+				rows[cnt] = int64(i + k)
+				cnt++
+			}
+		}
+		if cnt == 0 {
+			continue
+		}
+		hm.ht.InsertHashStateBatch(states[:cnt], values)
+		for k, v := range values[:cnt] {
+			if v > uint64(len(hm.Sels)) {
+				hm.Sels = append(hm.Sels, nil)
+			}
+			hm.Sels[v-1] = append(hm.Sels[v-1], rows[k])
+			if hm.Filter != nil {
+				hm.Filter.Add(&states[k])
+			}
+		}
+	}
+	return hm, nil
+}
+
+func (hm *HashMap) append(bat *batch.Batch, proc *process.Process) error {
+	if hm.Bat == nil {
+		hm.Bat = batch.New(len(bat.Vecs))
+		for i, vec := range bat.Vecs {
+			hm.Bat.Vecs[i] = vector.New(vec.Typ)
+		}
+	}
+	flags := make([]uint8, len(bat.Zs))
+	for i := range flags {
+		flags[i] = 1
+	}
+	for i, vec := range hm.Bat.Vecs {
+		if err := vector.UnionBatch(vec, bat.Vecs[i], 0, len(flags), flags, proc.Mp); err != nil {
+			return err
+		}
+	}
+	hm.Bat.Zs = append(hm.Bat.Zs, bat.Zs...)
+	return nil
+}
+
+// Free releases the rows of the build side
+func (hm *HashMap) Free(m *mheap.Mheap) {
+	if hm.Bat != nil {
+		batch.Clean(hm.Bat, m)
+		hm.Bat = nil
+	}
+}
+
+func NewProber(conds int) *Prober {
+	return &Prober{
+		vecs:   make([]*vector.Vector, conds),
+		keys:   hashkey.New(),
+		states: make([][3]uint64, hashkey.UnitLimit),
+		Values: make([]uint64, hashkey.UnitLimit),
+		Nulls:  make([]bool, hashkey.UnitLimit),
+	}
+}
+
+// Find looks up the rows [start, start + n) of bat in hm, conds are the
+// positions of the join keys of bat, n must be no more than
+// hashkey.UnitLimit.
+func (p *Prober) Find(hm *HashMap, bat *batch.Batch, conds []int32, start int64, n int) {
+	for i, pos := range conds {
+		p.vecs[i] = bat.Vecs[pos]
+	}
+	hasNull := hashkey.FillNulls(p.vecs, start, n, p.Nulls)
+	p.keys.Fill(p.vecs, start, n)
+	hm.ht.FindHashStateBatch(p.keys.States[:n], p.Values)
+	if hasNull {
+		for k := 0; k < n; k++ {
+			if p.Nulls[k] {
+				p.Values[k] = 0
+			}
+		}
+	}
+}
+
+// NewResult returns an empty batch of the result of a join, typs are the
+// types of the result attributes.
+func NewResult(typs []types.Type) *batch.Batch {
+	rbat := batch.New(len(typs))
+	for i, typ := range typs {
+		rbat.Vecs[i] = vector.New(typ)
+	}
+	return rbat
+}
+
+// NewNulls returns the vectors of a single null row of typs, which are used
+// to fill the attributes of the unmatched side of outer joins by
+// vector.UnionOne.
+func NewNulls(typs []types.Type) []*vector.Vector {
+	vecs := make([]*vector.Vector, len(typs))
+	for i, typ := range typs {
+		vec := vector.New(typ)
+		switch typ.Oid {
+		case types.T_int8:
+			vec.Col = []int8{0}
+		case types.T_int16:
+			vec.Col = []int16{0}
+		case types.T_int32:
+			vec.Col = []int32{0}
+		case types.T_int64:
+			vec.Col = []int64{0}
+		case types.T_uint8:
+			vec.Col = []uint8{0}
+		case types.T_uint16:
+			vec.Col = []uint16{0}
+		case types.T_uint32:
+			vec.Col = []uint32{0}
+		case types.T_uint64:
+			vec.Col = []uint64{0}
+		case types.T_float32:
+			vec.Col = []float32{0}
+		case types.T_float64:
+			vec.Col = []float64{0}
+		case types.T_date:
+			vec.Col = []types.Date{0}
+		case types.T_datetime:
+			vec.Col = []types.Datetime{0}
+		case types.T_timestamp:
+			vec.Col = []types.Timestamp{0}
+		case types.T_decimal64:
+			vec.Col = []types.Decimal64{0}
+		case types.T_decimal128:
+			vec.Col = []types.Decimal128{{}}
+		case types.T_tuple:
+			vec.Col = [][]interface{}{nil}
+		case types.T_char, types.T_varchar, types.T_json:
+			vec.Col = &types.Bytes{
+				Offsets: []uint32{0},
+				Lengths: []uint32{0},
+			}
+		}
+		nulls.Add(vec.Nsp, 0)
+		vecs[i] = vec
+	}
+	return vecs
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashbuild
+
+import (
+	"context"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuild(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	reg := &process.WaitRegister{
+		Ctx: context.Background(),
+		Ch:  make(chan *batch.Batch, 4),
+	}
+	reg.Ch <- newBatch(t, []int64{1, 2, 1}, nil)
+	reg.Ch <- &batch.Batch{}
+	reg.Ch <- newBatch(t, []int64{3, 0, 2}, []uint64{1})
+	reg.Ch <- nil
+	hm, err := Build(reg, []int32{0}, true, proc)
+	require.NoError(t, err)
+	require.True(t, hm.HasNull)
+	require.Equal(t, [][]int64{{0, 2}, {1, 5}, {3}}, hm.Sels)
+	require.Equal(t, 6, len(hm.Bat.Zs))
+
+	p := NewProber(1)
+	bat := newBatch(t, []int64{2, 0, 4, 1}, []uint64{1})
+	p.Find(hm, bat, []int32{0}, 0, 4)
+	require.Equal(t, []uint64{2, 0, 0, 1}, p.Values[:4])
+	require.Equal(t, []bool{false, true, false, false}, p.Nulls[:4])
+	hm.Free(proc.Mp)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+func TestNewNulls(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	typs := []types.Type{
+		{Oid: types.T_int32, Size: 4},
+		{Oid: types.T_varchar, Size: 24},
+		{Oid: types.T_decimal128, Size: 16},
+	}
+	vecs := NewNulls(typs)
+	rbat := NewResult(typs)
+	for i, vec := range vecs {
+		require.NoError(t, vector.UnionOne(rbat.Vecs[i], vec, 0, proc.Mp))
+		require.NoError(t, vector.UnionOne(rbat.Vecs[i], vec, 0, proc.Mp))
+		require.Equal(t, 2, vector.Length(rbat.Vecs[i]))
+		require.True(t, nulls.Contains(rbat.Vecs[i].Nsp, 1))
+	}
+	batch.Clean(rbat, proc.Mp)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+func newBatch(t *testing.T, vs []int64, nsp []uint64) *batch.Batch {
+	bat := batch.New(1)
+	bat.InitZsOne(len(vs))
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, vector.Append(bat.Vecs[0], vs))
+	nulls.Add(bat.Vecs[0].Nsp, nsp...)
+	return bat
+}
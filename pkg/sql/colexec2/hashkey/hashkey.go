@@ -0,0 +1,143 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hashkey serializes the group by columns of a batch into the keys of
+// hashtable.StringHashMap, so the operators that group or repartition rows by
+// the same columns compute the same hash states.
+package hashkey
+
+import (
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+)
+
+// UnitLimit is the number of rows that are serialized and hashed at a time
+const UnitLimit = 256
+
+type Keys struct {
+	keys [][]byte
+	// States are the hash states of the keys filled last time
+	States [][3]uint64
+}
+
+func New() *Keys {
+	return &Keys{
+		keys:   make([][]byte, UnitLimit),
+		States: make([][3]uint64, UnitLimit),
+	}
+}
+
+// Fill serializes and hashes the rows [start, start + n) of vecs, n must be
+// greater than 0 and no more than UnitLimit. Every column of a key is a null
+// flag followed by the value, and strings are prefixed with their lengths.
+func (k *Keys) Fill(vecs []*vector.Vector, start int64, n int) {
+	keys := k.keys[:n]
+	for i := range keys {
+		keys[i] = keys[i][:0]
+	}
+	for _, vec := range vecs {
+		switch vec.Typ.Oid {
+		case types.T_int8:
+			fillFixed(keys, vec.Col.([]int8), vec.Nsp, start)
+		case types.T_int16:
+			fillFixed(keys, vec.Col.([]int16), vec.Nsp, start)
+		case types.T_int32:
+			fillFixed(keys, vec.Col.([]int32), vec.Nsp, start)
+		case types.T_int64:
+			fillFixed(keys, vec.Col.([]int64), vec.Nsp, start)
+		case types.T_uint8:
+			fillFixed(keys, vec.Col.([]uint8), vec.Nsp, start)
+		case types.T_uint16:
+			fillFixed(keys, vec.Col.([]uint16), vec.Nsp, start)
+		case types.T_uint32:
+			fillFixed(keys, vec.Col.([]uint32), vec.Nsp, start)
+		case types.T_uint64:
+			fillFixed(keys, vec.Col.([]uint64), vec.Nsp, start)
+		case types.T_float32:
+			fillFixed(keys, vec.Col.([]float32), vec.Nsp, start)
+		case types.T_float64:
+			fillFixed(keys, vec.Col.([]float64), vec.Nsp, start)
+		case types.T_date:
+			fillFixed(keys, vec.Col.([]types.Date), vec.Nsp, start)
+		case types.T_datetime:
+			fillFixed(keys, vec.Col.([]types.Datetime), vec.Nsp, start)
+		case types.T_timestamp:
+			fillFixed(keys, vec.Col.([]types.Timestamp), vec.Nsp, start)
+		case types.T_decimal64:
+			fillFixed(keys, vec.Col.([]types.Decimal64), vec.Nsp, start)
+		case types.T_decimal128:
+			fillFixed(keys, vec.Col.([]types.Decimal128), vec.Nsp, start)
+		default:
+			vs := vec.Col.(*types.Bytes)
+			for i := range keys {
+				j := start + int64(i)
+				if nulls.Contains(vec.Nsp, uint64(j)) {
+					keys[i] = append(keys[i], 1)
+					continue
+				}
+				keys[i] = append(keys[i], 0)
+				keys[i] = append(keys[i], encoding.EncodeUint32(vs.Lengths[j])...)
+				keys[i] = append(keys[i], vs.Get(j)...)
+			}
+		}
+	}
+	for i := range keys {
+		if l := len(keys[i]); l < 16 {
+			keys[i] = append(keys[i], hashtable.StrKeyPadding[l:]...)
+		}
+	}
+	hashtable.AesBytesBatchGenHashStates(&keys[0], &k.States[0], n)
+}
+
+func fillFixed[T any](keys [][]byte, vs []T, nsp *nulls.Nulls, start int64) {
+	var v T
+
+	sz := int(unsafe.Sizeof(v))
+	hasNull := nulls.Any(nsp)
+	for i := range keys {
+		j := start + int64(i)
+		if hasNull && nulls.Contains(nsp, uint64(j)) {
+			keys[i] = append(keys[i], 1)
+			continue
+		}
+		keys[i] = append(keys[i], 0)
+		keys[i] = append(keys[i], unsafe.Slice((*byte)(unsafe.Pointer(&vs[j])), sz)...)
+	}
+}
+
+// FillNulls sets flags[i] to true if a column of the row start + i of vecs
+// is null, and returns true if any row has a null column.
+func FillNulls(vecs []*vector.Vector, start int64, n int, flags []bool) bool {
+	for i := range flags[:n] {
+		flags[i] = false
+	}
+	hasNull := false
+	for _, vec := range vecs {
+		if !nulls.Any(vec.Nsp) {
+			continue
+		}
+		for i := 0; i < n; i++ {
+			if nulls.Contains(vec.Nsp, uint64(start)+uint64(i)) {
+				flags[i] = true
+				hasNull = true
+			}
+		}
+	}
+	return hasNull
+}
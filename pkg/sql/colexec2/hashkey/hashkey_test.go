@@ -0,0 +1,67 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashkey
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFill(t *testing.T) {
+	xs := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, vector.Append(xs, []int64{1, 1, 0, 0, 2}))
+	nulls.Add(xs.Nsp, 3)
+	ys := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, vector.Append(ys, [][]byte{[]byte("ab"), []byte("ab"), []byte(""), []byte(""), []byte("c")}))
+	ks := New()
+	ks.Fill([]*vector.Vector{xs, ys}, 0, 5)
+	require.Equal(t, ks.States[0], ks.States[1])
+	// null differs from the zero value
+	require.NotEqual(t, ks.States[2], ks.States[3])
+	require.NotEqual(t, ks.States[0], ks.States[4])
+
+	// the same rows hash to the same states at any position
+	ks.Fill([]*vector.Vector{xs, ys}, 1, 1)
+	s := ks.States[0]
+	ks.Fill([]*vector.Vector{xs, ys}, 0, 1)
+	require.Equal(t, s, ks.States[0])
+}
+
+func TestFillStrings(t *testing.T) {
+	xs := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, vector.Append(xs, [][]byte{[]byte("ab"), []byte("a")}))
+	ys := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, vector.Append(ys, [][]byte{[]byte("c"), []byte("bc")}))
+	ks := New()
+	ks.Fill([]*vector.Vector{xs, ys}, 0, 2)
+	require.NotEqual(t, ks.States[0], ks.States[1])
+}
+
+func TestFillNulls(t *testing.T) {
+	xs := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, vector.Append(xs, []int64{1, 2, 3, 4}))
+	ys := vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	require.NoError(t, vector.Append(ys, [][]byte{[]byte("a"), []byte("b"), []byte("c"), []byte("d")}))
+	flags := make([]bool, UnitLimit)
+	require.False(t, FillNulls([]*vector.Vector{xs, ys}, 0, 4, flags))
+	nulls.Add(xs.Nsp, 1)
+	nulls.Add(ys.Nsp, 3)
+	require.True(t, FillNulls([]*vector.Vector{xs, ys}, 1, 3, flags))
+	require.Equal(t, []bool{true, false, true}, flags[:3])
+}
@@ -0,0 +1,46 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package join
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashbuild"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/joinfilter"
+)
+
+const (
+	Build = iota
+	Probe
+	End
+)
+
+type Container struct {
+	state  int
+	hm     *hashbuild.HashMap
+	prober *hashbuild.Prober
+}
+
+// Argument of the inner hash join. The probe side is received from
+// MergeReceivers[0] and the build side from MergeReceivers[1], Conds are
+// the positions of the join keys of both sides, which must have the same
+// types. If Filter is not nil, the bloom filter of the build side is
+// published to it.
+type Argument struct {
+	Conds  [2][]int32
+	Result []hashbuild.ResultPos
+	Typs   []types.Type // types of the result attributes
+	Filter *joinfilter.Runtime
+	ctr    *Container
+}
@@ -0,0 +1,144 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package joinfilter implements the runtime join filter. The join keys of
+// the build side are added to a bloom filter, which is published to the
+// probe side so that the rows that can never be matched are dropped as soon
+// as they are scanned.
+package joinfilter
+
+import (
+	"bytes"
+	"fmt"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashkey"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+const (
+	bitsPerKey = 16
+	probes     = 3
+)
+
+// New returns a filter for about n keys
+func New(n int) *Filter {
+	size := uint64(64)
+	for size < uint64(n)*bitsPerKey {
+		size <<= 1
+	}
+	return &Filter{
+		mask: size - 1,
+		bits: make([]uint64, size/64),
+	}
+}
+
+// Add adds the hash state of a key to the filter, the second part of the
+// state is skipped as it hardly varies between keys of the same length.
+func (f *Filter) Add(state *[3]uint64) {
+	h, d := state[0], state[2]|1
+	for i := 0; i < probes; i++ {
+		pos := h & f.mask
+		f.bits[pos>>6] |= 1 << (pos & 63)
+		h += d
+	}
+}
+
+// Test returns false if the key is not in the filter
+func (f *Filter) Test(state *[3]uint64) bool {
+	h, d := state[0], state[2]|1
+	for i := 0; i < probes; i++ {
+		pos := h & f.mask
+		if f.bits[pos>>6]&(1<<(pos&63)) == 0 {
+			return false
+		}
+		h += d
+	}
+	return true
+}
+
+func NewRuntime() *Runtime {
+	return &Runtime{ready: make(chan struct{})}
+}
+
+// Publish makes f visible to the probe side, a nil filter lets all rows
+// pass. Only the first call takes effect.
+func (r *Runtime) Publish(f *Filter) {
+	r.once.Do(func() {
+		r.f = f
+		close(r.ready)
+	})
+}
+
+// Wait blocks until the filter is published
+func (r *Runtime) Wait() *Filter {
+	<-r.ready
+	return r.f
+}
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	buf.WriteString(fmt.Sprintf("join filter(%v)", n.Conds))
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.ctr = new(Container)
+	n.ctr.keys = hashkey.New()
+	n.ctr.nulls = make([]bool, hashkey.UnitLimit)
+	return nil
+}
+
+// Call waits for the filter of the build side before the first batch is
+// filtered, and keeps the rows whose join keys may be matched.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	bat := proc.Reg.InputBatch
+	if bat == nil || len(bat.Zs) == 0 {
+		return false, nil
+	}
+	n := arg.(*Argument)
+	ctr := n.ctr
+	if !ctr.waited {
+		ctr.filter = n.Runtime.Wait()
+		ctr.waited = true
+	}
+	if ctr.filter == nil {
+		return false, nil
+	}
+	vecs := make([]*vector.Vector, len(n.Conds))
+	for i, pos := range n.Conds {
+		vecs[i] = bat.Vecs[pos]
+	}
+	sels := ctr.sels[:0]
+	count := len(bat.Zs)
+	for i := 0; i < count; i += hashkey.UnitLimit {
+		m := count - i
+		if m > hashkey.UnitLimit {
+			m = hashkey.UnitLimit
+		}
+		hashkey.FillNulls(vecs, int64(i), m, ctr.nulls)
+		ctr.keys.Fill(vecs, int64(i), m)
+		for k := 0; k < m; k++ {
+			if !ctr.nulls[k] && ctr.filter.Test(&ctr.keys.States[k]) {
+				sels = append(sels, int64(i+k))
+			}
+		}
+	}
+	ctr.sels = sels
+	if len(sels) < count {
+		batch.Shrink(bat, sels)
+	}
+	return false, nil
+}
@@ -0,0 +1,120 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinfilter
+
+import (
+	"bytes"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashkey"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows = 1000 // default rows
+)
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{Conds: []int32{0}}, buf)
+	require.Equal(t, "join filter([0])", buf.String())
+}
+
+func TestFilter(t *testing.T) {
+	f := New(Rows)
+	bat := newBatch(t, 0, Rows)
+	keys := hashkey.New()
+	for i := 0; i < Rows; i += hashkey.UnitLimit {
+		n := Rows - i
+		if n > hashkey.UnitLimit {
+			n = hashkey.UnitLimit
+		}
+		keys.Fill(bat.Vecs, int64(i), n)
+		for k := 0; k < n; k++ {
+			f.Add(&keys.States[k])
+		}
+		for k := 0; k < n; k++ {
+			require.True(t, f.Test(&keys.States[k]))
+		}
+	}
+	positives := 0
+	bat = newBatch(t, Rows, Rows)
+	for i := 0; i < Rows; i += hashkey.UnitLimit {
+		n := Rows - i
+		if n > hashkey.UnitLimit {
+			n = hashkey.UnitLimit
+		}
+		keys.Fill(bat.Vecs, int64(i), n)
+		for k := 0; k < n; k++ {
+			if f.Test(&keys.States[k]) {
+				positives++
+			}
+		}
+	}
+	require.Less(t, positives, Rows/20)
+}
+
+func TestJoinFilter(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	arg := &Argument{Conds: []int32{0}, Runtime: NewRuntime()}
+	require.NoError(t, Prepare(proc, arg))
+	go func() {
+		f := New(Rows)
+		keys := hashkey.New()
+		keys.Fill(newBatch(t, 0, 1).Vecs, 0, 1)
+		f.Add(&keys.States[0])
+		arg.Runtime.Publish(f)
+	}()
+	bat := newBatch(t, 0, Rows)
+	nulls.Add(bat.Vecs[0].Nsp, 0)
+	proc.Reg.InputBatch = bat
+	end, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.False(t, end)
+	require.Less(t, len(bat.Zs), Rows/20)
+	for i := range bat.Zs {
+		require.False(t, nulls.Contains(bat.Vecs[0].Nsp, uint64(i)))
+	}
+
+	// a nil filter lets all rows pass
+	arg = &Argument{Conds: []int32{0}, Runtime: NewRuntime()}
+	require.NoError(t, Prepare(proc, arg))
+	arg.Runtime.Publish(nil)
+	proc.Reg.InputBatch = newBatch(t, 0, Rows)
+	_, err = Call(proc, arg)
+	require.NoError(t, err)
+	require.Equal(t, Rows, len(proc.Reg.InputBatch.Zs))
+}
+
+// newBatch returns a batch of the keys [start, start + rows)
+func newBatch(t *testing.T, start, rows int64) *batch.Batch {
+	bat := batch.New(1)
+	bat.InitZsOne(int(rows))
+	vs := make([]int64, rows)
+	for i := range vs {
+		vs[i] = start + int64(i)
+	}
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, vector.Append(bat.Vecs[0], vs))
+	return bat
+}
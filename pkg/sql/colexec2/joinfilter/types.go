@@ -0,0 +1,53 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joinfilter
+
+import (
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashkey"
+)
+
+// Filter is a bloom filter of the hash states of the join keys
+type Filter struct {
+	mask uint64
+	bits []uint64
+}
+
+// Runtime passes the filter from the build side of a join to the scans of
+// the probe side, which run in other pipelines.
+type Runtime struct {
+	once  sync.Once
+	ready chan struct{}
+	f     *Filter
+}
+
+type Container struct {
+	filter *Filter
+	waited bool
+	sels   []int64
+	nulls  []bool
+	keys   *hashkey.Keys
+}
+
+// Argument of the join filter operator, which is put right after the scan
+// of the probe side. It drops the rows that can never be matched, so it is
+// only valid for the joins that drop the unmatched probe rows, that is the
+// inner, semi and right joins.
+type Argument struct {
+	Conds   []int32 // positions of the join keys of the probe side
+	Runtime *Runtime
+	ctr     *Container
+}
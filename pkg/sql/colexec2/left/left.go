@@ -0,0 +1,131 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package left
+
+import (
+	"bytes"
+	"fmt"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashbuild"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashkey"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	buf.WriteString(fmt.Sprintf("%v ⟕ %v", n.Conds[0], n.Conds[1]))
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.ctr = new(Container)
+	n.ctr.prober = hashbuild.NewProber(len(n.Conds[0]))
+	n.ctr.nulls = hashbuild.NewNulls(n.Typs)
+	return nil
+}
+
+// Call builds the hash table of the build side first, and then joins a
+// batch of the probe side at a time.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	ctr := n.ctr
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(n, proc); err != nil {
+				ctr.state = End
+				return true, err
+			}
+			ctr.state = Probe
+		case Probe:
+			bat := <-proc.Reg.MergeReceivers[0].Ch
+			if bat == nil {
+				ctr.state = End
+				ctr.hm.Free(proc.Mp)
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			if err := ctr.probe(n, bat, proc); err != nil {
+				ctr.state = End
+				ctr.hm.Free(proc.Mp)
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			return false, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+func (ctr *Container) build(n *Argument, proc *process.Process) error {
+	hm, err := hashbuild.Build(proc.Reg.MergeReceivers[1], n.Conds[1], false, proc)
+	ctr.hm = hm
+	return err
+}
+
+func (ctr *Container) probe(n *Argument, bat *batch.Batch, proc *process.Process) error {
+	defer batch.Clean(bat, proc.Mp)
+	rbat := hashbuild.NewResult(n.Typs)
+	count := len(bat.Zs)
+	for i := 0; i < count; i += hashkey.UnitLimit {
+		m := count - i
+		if m > hashkey.UnitLimit {
+			m = hashkey.UnitLimit
+		}
+		ctr.prober.Find(ctr.hm, bat, n.Conds[0], int64(i), m)
+		for k, v := range ctr.prober.Values[:m] {
+			row := int64(i + k)
+			if v == 0 {
+				for j, rp := range n.Result {
+					var err error
+					if rp.Rel == 0 {
+						err = vector.UnionOne(rbat.Vecs[j], bat.Vecs[rp.Pos], row, proc.Mp)
+					} else {
+						err = vector.UnionOne(rbat.Vecs[j], ctr.nulls[j], 0, proc.Mp)
+					}
+					if err != nil {
+						batch.Clean(rbat, proc.Mp)
+						return err
+					}
+				}
+				rbat.Zs = append(rbat.Zs, bat.Zs[row])
+				continue
+			}
+			for _, sel := range ctr.hm.Sels[v-1] {
+				for j, rp := range n.Result {
+					var err error
+					if rp.Rel == 0 {
+						err = vector.UnionOne(rbat.Vecs[j], bat.Vecs[rp.Pos], row, proc.Mp)
+					} else {
+						err = vector.UnionOne(rbat.Vecs[j], ctr.hm.Bat.Vecs[rp.Pos], sel, proc.Mp)
+					}
+					if err != nil {
+						batch.Clean(rbat, proc.Mp)
+						return err
+					}
+				}
+				rbat.Zs = append(rbat.Zs, bat.Zs[row]*ctr.hm.Bat.Zs[sel])
+			}
+		}
+	}
+	proc.Reg.InputBatch = rbat
+	return nil
+}
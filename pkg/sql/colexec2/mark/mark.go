@@ -0,0 +1,144 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mark
+
+import (
+	"bytes"
+	"fmt"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashbuild"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashkey"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	if n.NullAware {
+		buf.WriteString(fmt.Sprintf("%v mark %v null aware", n.Conds[0], n.Conds[1]))
+		return
+	}
+	buf.WriteString(fmt.Sprintf("%v mark %v", n.Conds[0], n.Conds[1]))
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	if n.NullAware && len(n.Conds[0]) != 1 {
+		return errors.New(errno.FeatureNotSupported, "null aware mark join of multiple keys")
+	}
+	n.ctr = new(Container)
+	n.ctr.prober = hashbuild.NewProber(len(n.Conds[0]))
+	return nil
+}
+
+// Call builds the hash table of the build side first, and then marks a
+// batch of the probe side at a time.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	ctr := n.ctr
+	for {
+		switch ctr.state {
+		case Build:
+			hm, err := hashbuild.Build(proc.Reg.MergeReceivers[1], n.Conds[1], false, proc)
+			if err != nil {
+				ctr.state = End
+				return true, err
+			}
+			ctr.hm = hm
+			ctr.state = Probe
+		case Probe:
+			bat := <-proc.Reg.MergeReceivers[0].Ch
+			if bat == nil {
+				ctr.state = End
+				ctr.hm.Free(proc.Mp)
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			if err := ctr.probe(n, bat, proc); err != nil {
+				ctr.state = End
+				ctr.hm.Free(proc.Mp)
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			return false, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+func (ctr *Container) probe(n *Argument, bat *batch.Batch, proc *process.Process) error {
+	defer batch.Clean(bat, proc.Mp)
+	count := len(bat.Zs)
+	rbat := batch.New(len(n.Result) + 1)
+	for i, pos := range n.Result {
+		rbat.Vecs[i] = vector.New(bat.Vecs[pos].Typ)
+	}
+	vs, vec, err := newInt8Vector(int64(count), proc.Mp)
+	if err != nil {
+		return err
+	}
+	rbat.Vecs[len(n.Result)] = vec
+	for i := 0; i < count; i += hashkey.UnitLimit {
+		m := count - i
+		if m > hashkey.UnitLimit {
+			m = hashkey.UnitLimit
+		}
+		ctr.prober.Find(ctr.hm, bat, n.Conds[0], int64(i), m)
+		for k, v := range ctr.prober.Values[:m] {
+			row := int64(i + k)
+			switch {
+			case v != 0:
+				vs[row] = 1
+			case n.NullAware && ctr.hm.Bat != nil && (ctr.hm.HasNull || ctr.prober.Nulls[k]):
+				nulls.Add(vec.Nsp, uint64(row))
+			}
+			for j, pos := range n.Result {
+				if err := vector.UnionOne(rbat.Vecs[j], bat.Vecs[pos], row, proc.Mp); err != nil {
+					batch.Clean(rbat, proc.Mp)
+					return err
+				}
+			}
+		}
+	}
+	rbat.Zs = append(rbat.Zs, bat.Zs...)
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+func newInt8Vector(rows int64, m *mheap.Mheap) ([]int8, *vector.Vector, error) {
+	vec := vector.New(types.Type{Oid: types.T_int8, Size: 1})
+	data, err := mheap.Alloc(m, rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	vs := encoding.DecodeInt8Slice(data)[:rows]
+	for i := range vs {
+		vs[i] = 0
+	}
+	vec.Data = data
+	vec.Col = vs
+	return vs, vec, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mark
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashbuild"
+)
+
+const (
+	Build = iota
+	Probe
+	End
+)
+
+type Container struct {
+	state  int
+	hm     *hashbuild.HashMap
+	prober *hashbuild.Prober
+}
+
+// Argument of the mark hash join, which returns all rows of the probe side
+// with a mark attribute appended to the result attributes. The probe side
+// is received from MergeReceivers[0] and the build side from
+// MergeReceivers[1], Conds are the positions of the join keys of both
+// sides, which must have the same types.
+//
+// The mark is an int8, it is 1 if the row matches a row of the build side
+// and 0 otherwise. The mark join of IN is null aware, the mark of an
+// unmatched row is null if the key of the row or a key of the build side is
+// null, unless the build side is empty. Null aware mark join has a single
+// join key.
+type Argument struct {
+	NullAware bool
+	Conds     [2][]int32
+	Result    []int32 // positions of the result attributes in the probe side
+	ctr       *Container
+}
@@ -0,0 +1,59 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"bytes"
+
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func String(_ interface{}, buf *bytes.Buffer) {
+	buf.WriteString(" union all ")
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.ctr = new(Container)
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	ctr := n.ctr
+	for {
+		if len(proc.Reg.MergeReceivers) == 0 {
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+		reg := proc.Reg.MergeReceivers[ctr.i]
+		bat := <-reg.Ch
+		if bat == nil {
+			proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:ctr.i], proc.Reg.MergeReceivers[ctr.i+1:]...)
+			if ctr.i >= len(proc.Reg.MergeReceivers) {
+				ctr.i = 0
+			}
+			continue
+		}
+		if len(bat.Zs) == 0 {
+			continue
+		}
+		proc.Reg.InputBatch = bat
+		if ctr.i = ctr.i + 1; ctr.i >= len(proc.Reg.MergeReceivers) {
+			ctr.i = 0
+		}
+		return false, nil
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows      = 10 // default rows
+	Pipelines = 3  // default number of pipelines sending to the merge
+)
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{}, buf)
+	require.Equal(t, " union all ", buf.String())
+}
+
+func TestMerge(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	for i := 0; i < Pipelines; i++ {
+		reg := &process.WaitRegister{
+			Ctx: context.Background(),
+			Ch:  make(chan *batch.Batch, 3),
+		}
+		reg.Ch <- newBatch(int64(i))
+		reg.Ch <- &batch.Batch{}
+		reg.Ch <- nil
+		proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers, reg)
+	}
+	arg := &Argument{}
+	require.NoError(t, Prepare(proc, arg))
+	rows := make(map[int64]int)
+	for {
+		end, err := Call(proc, arg)
+		require.NoError(t, err)
+		if end {
+			require.Nil(t, proc.Reg.InputBatch)
+			break
+		}
+		for _, v := range proc.Reg.InputBatch.Vecs[0].Col.([]int64) {
+			rows[v]++
+		}
+	}
+	require.Equal(t, Pipelines, len(rows))
+	for i := 0; i < Pipelines; i++ {
+		require.Equal(t, Rows, rows[int64(i)])
+	}
+	end, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, end)
+}
+
+// newBatch returns a batch of Rows rows whose values are all v
+func newBatch(v int64) *batch.Batch {
+	bat := batch.New(1)
+	bat.InitZsOne(Rows)
+	vs := make([]int64, Rows)
+	for i := range vs {
+		vs[i] = v
+	}
+	bat.Vecs[0] = vector.New(types.T_int64.ToType())
+	vector.SetCol(bat.Vecs[0], vs)
+	return bat
+}
@@ -0,0 +1,171 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergegroup
+
+import (
+	"bytes"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/group"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashkey"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func String(_ interface{}, buf *bytes.Buffer) {
+	buf.WriteString("merge γ")
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.ctr = new(Container)
+	n.ctr.inserted = make([]uint8, hashkey.UnitLimit)
+	n.ctr.zInserted = make([]uint8, hashkey.UnitLimit)
+	n.ctr.values = make([]uint64, hashkey.UnitLimit)
+	n.ctr.keys = hashkey.New()
+	n.ctr.ht = &hashtable.StringHashMap{}
+	n.ctr.ht.Init()
+	return nil
+}
+
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	ctr := n.ctr
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(proc); err != nil {
+				if ctr.bat != nil {
+					batch.Clean(ctr.bat, proc.Mp)
+					ctr.bat = nil
+				}
+				ctr.state = End
+				return true, err
+			}
+			ctr.state = Eval
+		case Eval:
+			ctr.state = End
+			if ctr.bat == nil {
+				proc.Reg.InputBatch = nil
+				return true, nil
+			}
+			if n.NeedEval {
+				group.Finalize(ctr.bat)
+			}
+			proc.Reg.InputBatch = ctr.bat
+			ctr.bat = nil
+			return true, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+func (ctr *Container) build(proc *process.Process) error {
+	for {
+		if len(proc.Reg.MergeReceivers) == 0 {
+			return nil
+		}
+		for i := 0; i < len(proc.Reg.MergeReceivers); i++ {
+			reg := proc.Reg.MergeReceivers[i]
+			bat := <-reg.Ch
+			if bat == nil {
+				proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers[:i], proc.Reg.MergeReceivers[i+1:]...)
+				i--
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				i--
+				continue
+			}
+			if err := ctr.merge(bat, proc); err != nil {
+				batch.Clean(bat, proc.Mp)
+				return err
+			}
+			batch.Clean(bat, proc.Mp)
+		}
+	}
+}
+
+// merge adds the partial groups of bat to the groups of ctr, all attributes
+// of bat are group by attributes.
+func (ctr *Container) merge(bat *batch.Batch, proc *process.Process) error {
+	if ctr.bat == nil {
+		ctr.bat = batch.New(len(bat.Vecs))
+		for i, vec := range bat.Vecs {
+			ctr.bat.Vecs[i] = vector.New(vec.Typ)
+		}
+		ctr.bat.Rs = make([]ring.Ring, len(bat.Rs))
+		for i, r := range bat.Rs {
+			ctr.bat.Rs[i] = r.Dup()
+		}
+		if len(bat.Vecs) == 0 {
+			ctr.bat.Zs = []int64{0}
+			for _, r := range ctr.bat.Rs {
+				if err := r.Grow(proc.Mp); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if len(bat.Vecs) == 0 {
+		for k, z := range bat.Zs {
+			ctr.bat.Zs[0] += z
+			for j, r := range ctr.bat.Rs {
+				r.Add(bat.Rs[j], 0, int64(k))
+			}
+		}
+		return nil
+	}
+	count := len(bat.Zs)
+	for i := 0; i < count; i += hashkey.UnitLimit {
+		m := count - i
+		if m > hashkey.UnitLimit {
+			m = hashkey.UnitLimit
+		}
+		ctr.keys.Fill(bat.Vecs, int64(i), m)
+		ctr.ht.InsertHashStateBatch(ctr.keys.States[:m], ctr.values)
+		cnt := 0
+		copy(ctr.inserted[:m], ctr.zInserted[:m])
+		for k, v := range ctr.values[:m] {
+			if v > ctr.rows {
+				ctr.inserted[k] = 1
+				ctr.rows++
+				cnt++
+				ctr.bat.Zs = append(ctr.bat.Zs, 0)
+			}
+			ctr.bat.Zs[v-1] += bat.Zs[i+k]
+		}
+		if cnt > 0 {
+			for j, vec := range ctr.bat.Vecs {
+				if err := vector.UnionBatch(vec, bat.Vecs[j], int64(i), cnt, ctr.inserted[:m], proc.Mp); err != nil {
+					return err
+				}
+			}
+			for _, r := range ctr.bat.Rs {
+				if err := r.Grows(cnt, proc.Mp); err != nil {
+					return err
+				}
+			}
+		}
+		for j, r := range ctr.bat.Rs {
+			r.BatchAdd(bat.Rs[j], int64(i), ctr.inserted[:m], ctr.values)
+		}
+	}
+	return nil
+}
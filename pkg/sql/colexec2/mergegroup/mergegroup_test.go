@@ -0,0 +1,153 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergegroup
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/dispatch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/group"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows      = 1000 // default rows
+	Groups    = 10   // default groups
+	Pipelines = 2    // default number of pipelines of each phase
+)
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{}, buf)
+	require.Equal(t, "merge γ", buf.String())
+}
+
+// TestMergeGroup runs the bottom aggregation and dispatch in every pipeline,
+// and finalizes the groups of every partition in a merge pipeline.
+func TestMergeGroup(t *testing.T) {
+	m := mheap.New(guest.New(1<<30, host.New(1<<30)))
+	regs := make([][]*process.WaitRegister, Pipelines) // regs[i][j]: from the i-th pipeline to the j-th merge
+	for i := range regs {
+		regs[i] = make([]*process.WaitRegister, Pipelines)
+		for j := range regs[i] {
+			regs[i][j] = &process.WaitRegister{
+				Ctx: context.Background(),
+				Ch:  make(chan *batch.Batch, 2),
+			}
+		}
+	}
+	for i := 0; i < Pipelines; i++ {
+		proc := process.New(m)
+		garg := &group.Argument{
+			Exprs: []int32{0},
+			Aggs:  []group.Aggregate{{Op: transformer.Sum, Pos: 1}, {Op: transformer.Avg, Pos: 1}},
+		}
+		darg := &dispatch.Argument{Regs: regs[i]}
+		require.NoError(t, group.Prepare(proc, garg))
+		require.NoError(t, dispatch.Prepare(proc, darg))
+		for _, bat := range []*batch.Batch{newBatch(t, Rows), nil} {
+			proc.Reg.InputBatch = bat
+			_, err := group.Call(proc, garg)
+			require.NoError(t, err)
+		}
+		for _, bat := range []*batch.Batch{proc.Reg.InputBatch, nil} {
+			proc.Reg.InputBatch = bat
+			_, err := dispatch.Call(proc, darg)
+			require.NoError(t, err)
+		}
+	}
+	sums := make(map[int64]int64)
+	avgs := make(map[int64]float64)
+	for j := 0; j < Pipelines; j++ {
+		proc := process.New(m)
+		for i := 0; i < Pipelines; i++ {
+			proc.Reg.MergeReceivers = append(proc.Reg.MergeReceivers, regs[i][j])
+		}
+		arg := &Argument{NeedEval: true}
+		require.NoError(t, Prepare(proc, arg))
+		end, err := Call(proc, arg)
+		require.NoError(t, err)
+		require.True(t, end)
+		if bat := proc.Reg.InputBatch; bat != nil {
+			for k, key := range bat.Vecs[0].Col.([]int64) {
+				_, ok := sums[key]
+				require.False(t, ok)
+				sums[key] = bat.Vecs[1].Col.([]int64)[k]
+				avgs[key] = bat.Vecs[2].Col.([]float64)[k]
+				require.Equal(t, int64(1), bat.Zs[k])
+			}
+			batch.Clean(bat, proc.Mp)
+		}
+	}
+	require.Equal(t, Groups, len(sums))
+	for key, sum := range sums {
+		n := int64(Rows / Groups)
+		require.Equal(t, Pipelines*(n*key+Groups*n*(n-1)/2), sum)
+		require.Equal(t, float64(sum)/float64(Pipelines*n), avgs[key])
+	}
+}
+
+func TestMergeGroupWithoutExprs(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	reg := &process.WaitRegister{
+		Ctx: context.Background(),
+		Ch:  make(chan *batch.Batch, 4),
+	}
+	proc.Reg.MergeReceivers = []*process.WaitRegister{reg}
+	for i := 0; i < Pipelines; i++ {
+		garg := &group.Argument{Aggs: []group.Aggregate{{Op: transformer.StarCount, Pos: 1}}}
+		require.NoError(t, group.Prepare(proc, garg))
+		for _, bat := range []*batch.Batch{newBatch(t, Rows), nil} {
+			proc.Reg.InputBatch = bat
+			_, err := group.Call(proc, garg)
+			require.NoError(t, err)
+		}
+		reg.Ch <- proc.Reg.InputBatch
+	}
+	reg.Ch <- nil
+	arg := &Argument{NeedEval: true}
+	require.NoError(t, Prepare(proc, arg))
+	end, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.True(t, end)
+	require.Equal(t, []int64{Pipelines * Rows}, proc.Reg.InputBatch.Vecs[0].Col.([]int64))
+}
+
+// newBatch returns a batch of rows (i % Groups, i) for i in [0, rows)
+func newBatch(t *testing.T, rows int64) *batch.Batch {
+	bat := batch.New(2)
+	bat.InitZsOne(int(rows))
+	ks := make([]int64, rows)
+	vs := make([]int64, rows)
+	for i := range vs {
+		ks[i] = int64(i) % Groups
+		vs[i] = int64(i)
+	}
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	require.NoError(t, vector.Append(bat.Vecs[0], ks))
+	require.NoError(t, vector.Append(bat.Vecs[1], vs))
+	return bat
+}
@@ -0,0 +1,47 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mergegroup
+
+import (
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/hashtable"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashkey"
+)
+
+const (
+	Build = iota
+	Eval
+	End
+)
+
+type Container struct {
+	state     int
+	rows      uint64 // number of groups
+	inserted  []uint8
+	zInserted []uint8
+	values    []uint64
+	keys      *hashkey.Keys
+	ht        *hashtable.StringHashMap
+
+	bat *batch.Batch
+}
+
+// Argument of the final (top) phase of a two-phase aggregation. It merges the
+// partial groups of the same group by values received from all pipelines,
+// and turns the aggregation states into the results if NeedEval is true.
+type Argument struct {
+	NeedEval bool
+	ctr      *Container
+}
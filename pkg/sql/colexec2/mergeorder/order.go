@@ -23,6 +23,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	order "github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
 	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 func String(arg interface{}, buf *bytes.Buffer) {
@@ -57,10 +58,33 @@ func Call(proc *process.Process, arg interface{}) (bool, error) {
 		switch ctr.state {
 		case Build:
 			if err := ctr.build(n, proc); err != nil {
+				ctr.closeRuns(proc)
 				ctr.state = End
 				return true, err
 			}
 			ctr.state = Eval
+			if len(ctr.runs) > 0 {
+				if err := ctr.openRuns(proc); err != nil {
+					ctr.closeRuns(proc)
+					ctr.state = End
+					return true, err
+				}
+				ctr.state = Merge
+			}
+		case Merge:
+			bat, err := ctr.merge(proc)
+			if err != nil {
+				ctr.closeRuns(proc)
+				ctr.state = End
+				return true, err
+			}
+			if bat == nil {
+				ctr.closeRuns(proc)
+				ctr.state = End
+				continue
+			}
+			proc.Reg.InputBatch = bat
+			return false, nil
 		case Eval:
 			proc.Reg.InputBatch = ctr.bat
 			ctr.bat = nil
@@ -97,6 +121,8 @@ func (ctr *Container) build(n *Argument, proc *process.Process) error {
 				for i, f := range n.Fs {
 					ctr.cmps[i] = compare.New(bat.Vecs[i].Typ.Oid, f.Type == order.Descending)
 				}
+				// the batch may be the first one after the sorted rows are spilled
+				ctr.cmps = ctr.cmps[:len(n.Fs)]
 				for i := len(n.Fs); i < len(bat.Vecs); i++ {
 					ctr.cmps = append(ctr.cmps, compare.New(bat.Vecs[i].Typ.Oid, true))
 				}
@@ -109,6 +135,11 @@ func (ctr *Container) build(n *Argument, proc *process.Process) error {
 				}
 				batch.Clean(bat, proc.Mp)
 			}
+			if spill.Exceeded(proc.Lim.Size, spill.Size(ctr.bat.Vecs)) {
+				if err := ctr.spill(proc); err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
@@ -195,3 +226,129 @@ func makeFlagsOne(n int) []uint8 {
 	}
 	return t
 }
+
+// spill writes the sorted rows in memory to disk as a run
+func (ctr *Container) spill(proc *process.Process) error {
+	f, err := spill.NewFile()
+	if err != nil {
+		return err
+	}
+	ctr.runs = append(ctr.runs, f)
+	if err := f.WriteRun(nil, ctr.bat.Vecs, ctr.bat.Zs, proc.Mp); err != nil {
+		return err
+	}
+	batch.Clean(ctr.bat, proc.Mp)
+	ctr.bat = nil
+	return nil
+}
+
+// openRuns spills the rest rows in memory and prepares to merge all the runs
+func (ctr *Container) openRuns(proc *process.Process) error {
+	if ctr.bat != nil {
+		if err := ctr.spill(proc); err != nil {
+			return err
+		}
+	}
+	for _, f := range ctr.runs {
+		r, err := f.NewReader()
+		if err != nil {
+			return err
+		}
+		c := &cursor{r: r}
+		ctr.cursors = append(ctr.cursors, c)
+		if err := c.next(proc); err != nil {
+			return err
+		}
+		if c.bat == nil {
+			c.r.Close()
+			ctr.cursors = ctr.cursors[:len(ctr.cursors)-1]
+		}
+	}
+	return nil
+}
+
+// merge returns the next batch of the result by merging the runs, it returns nil if all rows are returned
+func (ctr *Container) merge(proc *process.Process) (*batch.Batch, error) {
+	var rbat *batch.Batch
+
+	for len(ctr.cursors) > 0 {
+		k := 0 // the cursor of the minimum row
+		for j := 1; j < len(ctr.cursors); j++ {
+			if ctr.compare(ctr.cursors[j], ctr.cursors[k]) < 0 {
+				k = j
+			}
+		}
+		c := ctr.cursors[k]
+		if rbat == nil {
+			rbat = batch.New(len(c.bat.Vecs))
+			for i, vec := range c.bat.Vecs {
+				rbat.Vecs[i] = vector.New(vec.Typ)
+			}
+		}
+		for i, vec := range rbat.Vecs {
+			if err := vector.UnionOne(vec, c.bat.Vecs[i], c.row, proc.Mp); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return nil, err
+			}
+		}
+		rbat.Zs = append(rbat.Zs, c.bat.Zs[c.row])
+		if c.row++; c.row == int64(len(c.bat.Zs)) {
+			if err := c.next(proc); err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return nil, err
+			}
+			if c.bat == nil {
+				c.r.Close()
+				ctr.cursors = append(ctr.cursors[:k], ctr.cursors[k+1:]...)
+			}
+		}
+		if len(rbat.Zs) == spill.BatchRows {
+			break
+		}
+	}
+	return rbat, nil
+}
+
+// compare compares the current rows of two cursors
+func (ctr *Container) compare(a, b *cursor) int {
+	for i, cmp := range ctr.cmps {
+		cmp.Set(0, batch.GetVector(a.bat, int32(i)))
+		cmp.Set(1, batch.GetVector(b.bat, int32(i)))
+		if r := cmp.Compare(0, 1, a.row, b.row); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+// closeRuns removes all the runs
+func (ctr *Container) closeRuns(proc *process.Process) {
+	for _, c := range ctr.cursors {
+		if c.bat != nil {
+			batch.Clean(c.bat, proc.Mp)
+		}
+		c.r.Close()
+	}
+	ctr.cursors = nil
+	for _, f := range ctr.runs {
+		f.Close()
+	}
+	ctr.runs = nil
+}
+
+// next reads the next batch of the run
+func (c *cursor) next(proc *process.Process) error {
+	var err error
+
+	if c.bat != nil {
+		batch.Clean(c.bat, proc.Mp)
+	}
+	c.row = 0
+	_, vecs, zs, err := c.r.ReadVectors(proc.Mp)
+	if err != nil || vecs == nil {
+		c.bat = nil
+		return err
+	}
+	c.bat = &batch.Batch{Vecs: vecs, Zs: zs}
+	return nil
+}
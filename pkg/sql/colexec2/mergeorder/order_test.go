@@ -91,6 +91,41 @@ func TestOrder(t *testing.T) {
 	}
 }
 
+func TestOrderSpill(t *testing.T) {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	for _, tc := range []orderTestCase{
+		newTestCase(mheap.New(gm), []bool{false}, []types.Type{{Oid: types.T_int8}}, []order.Field{{Pos: 0, Type: 0}}),
+		newTestCase(mheap.New(gm), []bool{true}, []types.Type{{Oid: types.T_int8}}, []order.Field{{Pos: 0, Type: 2}}),
+	} {
+		tc.proc.Lim.Size = 1
+		Prepare(tc.proc, tc.arg)
+		tc.proc.Reg.MergeReceivers[0].Ch <- newBatch(t, tc.ds, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[0].Ch <- nil
+		tc.proc.Reg.MergeReceivers[1].Ch <- newBatch(t, tc.ds, tc.types, tc.proc, Rows)
+		tc.proc.Reg.MergeReceivers[1].Ch <- nil
+		var vs []int8
+		for {
+			ok, err := Call(tc.proc, tc.arg)
+			require.NoError(t, err)
+			if ok {
+				break
+			}
+			if bat := tc.proc.Reg.InputBatch; bat != nil && len(bat.Zs) > 0 {
+				vs = append(vs, bat.Vecs[0].Col.([]int8)...)
+			}
+		}
+		require.Equal(t, 2*Rows, len(vs))
+		for i := 1; i < len(vs); i++ {
+			if tc.ds[0] {
+				require.GreaterOrEqual(t, vs[i-1], vs[i])
+			} else {
+				require.LessOrEqual(t, vs[i-1], vs[i])
+			}
+		}
+	}
+}
+
 func BenchmarkOrder(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		hm := host.New(1 << 30)
@@ -18,11 +18,13 @@ import (
 	compare "github.com/matrixorigin/matrixone/pkg/compare2"
 	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
 	order "github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 const (
 	Build = iota
 	Eval
+	Merge
 	End
 )
 
@@ -32,6 +34,16 @@ type Container struct {
 	cmps  []compare.Compare // compare structures used to do sort work for attrs
 
 	bat *batch.Batch // bat store the result of merge-order
+
+	runs    []*spill.File // runs store the sorted runs spilled to disk once the memory budget is reached
+	cursors []*cursor     // cursors are the positions of the runs during external merge sort
+}
+
+// cursor is the position of a sorted run during external merge sort
+type cursor struct {
+	r   *spill.Reader
+	bat *batch.Batch
+	row int64
 }
 
 type Argument struct {
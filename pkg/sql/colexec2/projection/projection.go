@@ -0,0 +1,73 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projection
+
+import (
+	"bytes"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	buf.WriteString("π(")
+	for i, e := range n.Es {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(e.String())
+	}
+	buf.WriteString(")")
+}
+
+func Prepare(_ *process.Process, _ interface{}) error {
+	return nil
+}
+
+// Call replaces the attributes of the input batch by the results of the expressions
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	bat := proc.Reg.InputBatch
+	if bat == nil || len(bat.Zs) == 0 {
+		return false, nil
+	}
+	n := arg.(*Argument)
+	rbat := batch.New(len(n.Es))
+	for i, e := range n.Es {
+		vec, err := colexec2.EvalExpr(bat, proc, e)
+		if err == nil && vec.Typ.Oid == types.T_sel {
+			vector.Clean(vec, proc.Mp)
+			err = errors.New(errno.FeatureNotSupported, "the condition in the select list is not supported now")
+		}
+		if err != nil {
+			rbat.Vecs = rbat.Vecs[:i]
+			batch.Clean(rbat, proc.Mp)
+			batch.Clean(bat, proc.Mp)
+			proc.Reg.InputBatch = &batch.Batch{}
+			return false, err
+		}
+		rbat.Vecs[i] = vec
+	}
+	rbat.Zs = bat.Zs
+	bat.Zs = nil
+	batch.Clean(bat, proc.Mp)
+	proc.Reg.InputBatch = rbat
+	return false, nil
+}
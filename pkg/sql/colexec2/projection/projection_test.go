@@ -0,0 +1,126 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projection
+
+import (
+	"bytes"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows = 10 // default rows
+)
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{Es: []*plan.Expr{col(0), ival(1)}}, buf)
+}
+
+func TestProjection(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	arg := &Argument{
+		Es: []*plan.Expr{
+			col(1),
+			fn("+", col(0), ival(1)),
+			fn("UNARY_MINUS", col(0)),
+			ival(7),
+			fn("CAST", col(0), typ(plan.Type_FLOAT64)),
+			fn("*", ival(2), ival(3)),
+		},
+	}
+	require.NoError(t, Prepare(proc, arg))
+	proc.Reg.InputBatch = newBatch(t, proc, Rows)
+	end, err := Call(proc, arg)
+	require.NoError(t, err)
+	require.False(t, end)
+	bat := proc.Reg.InputBatch
+	require.Equal(t, Rows, len(bat.Zs))
+	for i := 0; i < Rows; i++ {
+		require.Equal(t, int64(i)*2, bat.Vecs[0].Col.([]int64)[i])
+		require.Equal(t, int64(i)+1, bat.Vecs[1].Col.([]int64)[i])
+		require.Equal(t, -int64(i), bat.Vecs[2].Col.([]int64)[i])
+		require.Equal(t, int64(7), bat.Vecs[3].Col.([]int64)[i])
+		require.Equal(t, float64(i), bat.Vecs[4].Col.([]float64)[i])
+		require.Equal(t, int64(6), bat.Vecs[5].Col.([]int64)[i])
+	}
+	batch.Clean(bat, proc.Mp)
+	require.Equal(t, int64(0), mheap.Size(proc.Mp))
+}
+
+func TestProjectionError(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	for _, e := range []*plan.Expr{fn("=", col(0), ival(1)), fn("SUM", col(0))} {
+		arg := &Argument{Es: []*plan.Expr{col(0), e}}
+		require.NoError(t, Prepare(proc, arg))
+		proc.Reg.InputBatch = newBatch(t, proc, Rows)
+		_, err := Call(proc, arg)
+		require.Error(t, err)
+		require.Equal(t, int64(0), mheap.Size(proc.Mp))
+	}
+}
+
+func col(pos int32) *plan.Expr {
+	return &plan.Expr{Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: pos}}}
+}
+
+func ival(v int64) *plan.Expr {
+	return &plan.Expr{Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Ival{Ival: v}}}}
+}
+
+func typ(id plan.Type_TypeId) *plan.Type {
+	return &plan.Type{Id: id}
+}
+
+func fn(name string, args ...interface{}) *plan.Expr {
+	e := &plan.Expr{Expr: &plan.Expr_F{F: &plan.Function{Func: &plan.ObjectRef{ObjName: name}}}}
+	for _, arg := range args {
+		switch arg := arg.(type) {
+		case *plan.Expr:
+			e.GetF().Args = append(e.GetF().Args, arg)
+		case *plan.Type:
+			e.Typ = arg
+		}
+	}
+	return e
+}
+
+// newBatch returns a batch of rows (i, i * 2) for i in [0, rows)
+func newBatch(t *testing.T, proc *process.Process, rows int) *batch.Batch {
+	bat := batch.New(2)
+	bat.InitZsOne(rows)
+	for j := range bat.Vecs {
+		data, err := mheap.Alloc(proc.Mp, int64(rows)*8)
+		require.NoError(t, err)
+		vs := encoding.DecodeInt64Slice(data)[:rows]
+		for i := range vs {
+			vs[i] = int64(i * (j + 1))
+		}
+		bat.Vecs[j] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		bat.Vecs[j].Data = data
+		bat.Vecs[j].Col = vs
+	}
+	return bat
+}
@@ -0,0 +1,176 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restrict
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	Rows = 10 // default rows
+)
+
+// add unit tests for cases
+type restrictTestCase struct {
+	arg  *Argument
+	rows []int64 // the values of the first attribute of the rows kept
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	String(&Argument{E: col(0)}, buf)
+}
+
+func TestRestrict(t *testing.T) {
+	tcs := []restrictTestCase{
+		{
+			// a > 4 and not (b = 7)
+			arg:  &Argument{E: fn("AND", fn(">", col(0), ival(4)), fn("NOT", fn("=", col(1), dval(7))))},
+			rows: []int64{5, 6, 8, 9},
+		},
+		{
+			// not (a < 2 or c = '9')
+			arg:  &Argument{E: fn("NOT", fn("OR", fn("<", col(0), ival(2)), fn("=", col(2), sval("9"))))},
+			rows: []int64{2, 3, 4, 5, 6, 7, 8},
+		},
+		{
+			// c like '1%'
+			arg:  &Argument{E: fn("LIKE", col(2), sval("1%"))},
+			rows: []int64{1},
+		},
+		{
+			// a + 1 = 11
+			arg:  &Argument{E: fn("=", fn("+", col(0), ival(1)), ival(11))},
+			rows: nil,
+		},
+		{
+			// 1 = 1
+			arg:  &Argument{E: fn("=", ival(1), ival(1))},
+			rows: []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+		},
+	}
+	for _, tc := range tcs {
+		proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+		require.NoError(t, Prepare(proc, tc.arg))
+		proc.Reg.InputBatch = newBatch(t, proc, Rows)
+		end, err := Call(proc, tc.arg)
+		require.NoError(t, err)
+		require.False(t, end)
+		bat := proc.Reg.InputBatch
+		if len(tc.rows) == 0 {
+			require.Equal(t, 0, len(bat.Zs))
+		} else {
+			require.Equal(t, tc.rows, bat.Vecs[0].Col.([]int64))
+			require.Equal(t, len(tc.rows), vector.Length(bat.Vecs[2]))
+			require.Equal(t, len(tc.rows), len(bat.Zs))
+		}
+		batch.Clean(bat, proc.Mp)
+		require.Equal(t, int64(0), mheap.Size(proc.Mp))
+	}
+}
+
+func TestRestrictError(t *testing.T) {
+	proc := process.New(mheap.New(guest.New(1<<30, host.New(1<<30))))
+	for _, e := range []*plan.Expr{col(0), fn("ABS", col(0)), fn("NOT", fn("LIKE", col(2), sval("1%")))} {
+		arg := &Argument{E: e}
+		require.NoError(t, Prepare(proc, arg))
+		proc.Reg.InputBatch = newBatch(t, proc, Rows)
+		_, err := Call(proc, arg)
+		require.Error(t, err)
+		require.Equal(t, int64(0), mheap.Size(proc.Mp))
+	}
+}
+
+func col(pos int32) *plan.Expr {
+	return &plan.Expr{Expr: &plan.Expr_Col{Col: &plan.ColRef{ColPos: pos}}}
+}
+
+func ival(v int64) *plan.Expr {
+	return &plan.Expr{Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Ival{Ival: v}}}}
+}
+
+func dval(v float64) *plan.Expr {
+	return &plan.Expr{Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Dval{Dval: v}}}}
+}
+
+func sval(v string) *plan.Expr {
+	return &plan.Expr{Expr: &plan.Expr_C{C: &plan.Const{Value: &plan.Const_Sval{Sval: v}}}}
+}
+
+func fn(name string, args ...*plan.Expr) *plan.Expr {
+	return &plan.Expr{Expr: &plan.Expr_F{F: &plan.Function{Func: &plan.ObjectRef{ObjName: name}, Args: args}}}
+}
+
+// newBatch returns a batch of rows (i, float64(i), string(i)) for i in [0, rows)
+func newBatch(t *testing.T, proc *process.Process, rows int64) *batch.Batch {
+	bat := batch.New(3)
+	bat.InitZsOne(int(rows))
+	{
+		data, err := mheap.Alloc(proc.Mp, rows*8)
+		require.NoError(t, err)
+		vs := encoding.DecodeInt64Slice(data)[:rows]
+		for i := range vs {
+			vs[i] = int64(i)
+		}
+		bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		bat.Vecs[0].Data = data
+		bat.Vecs[0].Col = vs
+	}
+	{
+		data, err := mheap.Alloc(proc.Mp, rows*8)
+		require.NoError(t, err)
+		vs := encoding.DecodeFloat64Slice(data)[:rows]
+		for i := range vs {
+			vs[i] = float64(i)
+		}
+		bat.Vecs[1] = vector.New(types.Type{Oid: types.T_float64, Size: 8})
+		bat.Vecs[1].Data = data
+		bat.Vecs[1].Col = vs
+	}
+	{
+		col := new(types.Bytes)
+		size := 0
+		for i := int64(0); i < rows; i++ {
+			size += len(strconv.Itoa(int(i)))
+		}
+		data, err := mheap.Alloc(proc.Mp, int64(size))
+		require.NoError(t, err)
+		data = data[:0]
+		for i := int64(0); i < rows; i++ {
+			v := strconv.Itoa(int(i))
+			col.Offsets = append(col.Offsets, uint32(len(data)))
+			col.Lengths = append(col.Lengths, uint32(len(v)))
+			data = append(data, v...)
+		}
+		col.Data = data
+		bat.Vecs[2] = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+		bat.Vecs[2].Data = data
+		bat.Vecs[2].Col = col
+	}
+	return bat
+}
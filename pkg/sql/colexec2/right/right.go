@@ -0,0 +1,170 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package right
+
+import (
+	"bytes"
+	"fmt"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashbuild"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashkey"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	buf.WriteString(fmt.Sprintf("%v ⟖ %v", n.Conds[0], n.Conds[1]))
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.ctr = new(Container)
+	n.ctr.prober = hashbuild.NewProber(len(n.Conds[0]))
+	n.ctr.nulls = hashbuild.NewNulls(n.Typs)
+	return nil
+}
+
+// Call builds the hash table of the build side first, then joins a batch of
+// the probe side at a time, and returns the unmatched rows of the build
+// side at last.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	ctr := n.ctr
+	for {
+		switch ctr.state {
+		case Build:
+			if err := ctr.build(n, proc); err != nil {
+				ctr.state = End
+				return true, err
+			}
+			ctr.state = Probe
+		case Probe:
+			bat := <-proc.Reg.MergeReceivers[0].Ch
+			if bat == nil {
+				ctr.state = Eval
+				continue
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			if ctr.hm.Bat == nil {
+				batch.Clean(bat, proc.Mp)
+				continue
+			}
+			if err := ctr.probe(n, bat, proc); err != nil {
+				ctr.state = End
+				ctr.hm.Free(proc.Mp)
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			return false, nil
+		case Eval:
+			ctr.state = End
+			err := ctr.emit(n, proc)
+			ctr.hm.Free(proc.Mp)
+			if err != nil {
+				proc.Reg.InputBatch = nil
+				return true, err
+			}
+			return false, nil
+		default:
+			proc.Reg.InputBatch = nil
+			return true, nil
+		}
+	}
+}
+
+func (ctr *Container) build(n *Argument, proc *process.Process) error {
+	hm, err := hashbuild.Build(proc.Reg.MergeReceivers[1], n.Conds[1], n.Filter != nil, proc)
+	if n.Filter != nil {
+		if err != nil {
+			n.Filter.Publish(nil)
+		} else {
+			n.Filter.Publish(hm.Filter)
+		}
+	}
+	ctr.hm = hm
+	if err != nil {
+		return err
+	}
+	if hm.Bat != nil {
+		ctr.matched = make([]bool, len(hm.Bat.Zs))
+	}
+	return nil
+}
+
+func (ctr *Container) probe(n *Argument, bat *batch.Batch, proc *process.Process) error {
+	defer batch.Clean(bat, proc.Mp)
+	rbat := hashbuild.NewResult(n.Typs)
+	count := len(bat.Zs)
+	for i := 0; i < count; i += hashkey.UnitLimit {
+		m := count - i
+		if m > hashkey.UnitLimit {
+			m = hashkey.UnitLimit
+		}
+		ctr.prober.Find(ctr.hm, bat, n.Conds[0], int64(i), m)
+		for k, v := range ctr.prober.Values[:m] {
+			if v == 0 {
+				continue
+			}
+			row := int64(i + k)
+			for _, sel := range ctr.hm.Sels[v-1] {
+				ctr.matched[sel] = true
+				for j, rp := range n.Result {
+					var err error
+					if rp.Rel == 0 {
+						err = vector.UnionOne(rbat.Vecs[j], bat.Vecs[rp.Pos], row, proc.Mp)
+					} else {
+						err = vector.UnionOne(rbat.Vecs[j], ctr.hm.Bat.Vecs[rp.Pos], sel, proc.Mp)
+					}
+					if err != nil {
+						batch.Clean(rbat, proc.Mp)
+						return err
+					}
+				}
+				rbat.Zs = append(rbat.Zs, bat.Zs[row]*ctr.hm.Bat.Zs[sel])
+			}
+		}
+	}
+	proc.Reg.InputBatch = rbat
+	return nil
+}
+
+// emit returns the unmatched rows of the build side
+func (ctr *Container) emit(n *Argument, proc *process.Process) error {
+	rbat := hashbuild.NewResult(n.Typs)
+	for sel, ok := range ctr.matched {
+		if ok {
+			continue
+		}
+		for j, rp := range n.Result {
+			var err error
+			if rp.Rel == 0 {
+				err = vector.UnionOne(rbat.Vecs[j], ctr.nulls[j], 0, proc.Mp)
+			} else {
+				err = vector.UnionOne(rbat.Vecs[j], ctr.hm.Bat.Vecs[rp.Pos], int64(sel), proc.Mp)
+			}
+			if err != nil {
+				batch.Clean(rbat, proc.Mp)
+				return err
+			}
+		}
+		rbat.Zs = append(rbat.Zs, ctr.hm.Bat.Zs[sel])
+	}
+	proc.Reg.InputBatch = rbat
+	return nil
+}
@@ -0,0 +1,176 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"fmt"
+
+	compare "github.com/matrixorigin/matrixone/pkg/compare2"
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	order "github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
+)
+
+const (
+	Build = iota
+	End
+)
+
+// FuncType is the kind of a window function.
+type FuncType int8
+
+// FuncType values.
+const (
+	RowNumber FuncType = iota
+	Rank
+	DenseRank
+	Lag
+	Lead
+	FirstValue
+	LastValue
+	Sum
+	Avg
+	Count
+	Min
+	Max
+)
+
+// FrameType is the unit of a window frame.
+type FrameType int8
+
+// FrameType values.
+const (
+	Rows FrameType = iota
+	Range
+)
+
+// BoundType is the kind of a frame bound.
+type BoundType int8
+
+// BoundType values.
+const (
+	UnboundedPreceding BoundType = iota
+	Preceding
+	CurrentRow
+	Following
+	UnboundedFollowing
+)
+
+type Bound struct {
+	Type BoundType
+	// Offset of Preceding and Following, rows of a Rows frame and
+	// the distance to the order by value of a Range frame
+	Offset float64
+}
+
+type Frame struct {
+	Type  FrameType
+	Start Bound
+	End   Bound
+}
+
+type Func struct {
+	Type FuncType
+	// Pos is the position of the argument, -1 for functions without argument
+	Pos int32
+	// Offset of Lag and Lead
+	Offset int64
+}
+
+type Container struct {
+	state int
+	n     int               // number of partition by attributes
+	descs []bool            // descs[i] == true: the keys[i] are in descending order
+	vecs  []*vector.Vector  // partition by attributes and then order by attributes
+	cmps  []compare.Compare // compare structures of vecs
+
+	// peer group of every row, the rows with the same partition by and order by values
+	peerStarts []int64
+	peerEnds   []int64
+	// order by value of every row for the offset of Range frame
+	dists []float64
+
+	// rows of the last partition which may continue in the next batch
+	bat *batch.Batch
+}
+
+// Argument of the window operator. The input rows are sorted by the partition
+// by attributes and then the order by attributes, and the results of Funcs are
+// appended to the batch in order.
+type Argument struct {
+	PartitionBy []int32
+	OrderBy     []order.Field
+	Frame       Frame
+	Funcs       []Func
+	ctr         *Container
+}
+
+var funcName = [...]string{
+	RowNumber:  "row_number",
+	Rank:       "rank",
+	DenseRank:  "dense_rank",
+	Lag:        "lag",
+	Lead:       "lead",
+	FirstValue: "first_value",
+	LastValue:  "last_value",
+	Sum:        "sum",
+	Avg:        "avg",
+	Count:      "count",
+	Min:        "min",
+	Max:        "max",
+}
+
+var boundName = [...]string{
+	UnboundedPreceding: "unbounded preceding",
+	Preceding:          "preceding",
+	CurrentRow:         "current row",
+	Following:          "following",
+	UnboundedFollowing: "unbounded following",
+}
+
+func (i FuncType) String() string {
+	if i < 0 || i > FuncType(len(funcName)-1) {
+		return fmt.Sprintf("FuncType(%d)", i)
+	}
+	return funcName[i]
+}
+
+func (n Func) String() string {
+	switch {
+	case n.Pos < 0:
+		return n.Type.String() + "()"
+	case n.Type == Lag || n.Type == Lead:
+		return fmt.Sprintf("%v(%v, %v)", n.Type, n.Pos, n.Offset)
+	default:
+		return fmt.Sprintf("%v(%v)", n.Type, n.Pos)
+	}
+}
+
+func (b Bound) String() string {
+	if b.Type < 0 || b.Type > BoundType(len(boundName)-1) {
+		return fmt.Sprintf("BoundType(%d)", b.Type)
+	}
+	if b.Type == Preceding || b.Type == Following {
+		return fmt.Sprintf("%v %s", b.Offset, boundName[b.Type])
+	}
+	return boundName[b.Type]
+}
+
+func (f Frame) String() string {
+	if f.Type == Rows {
+		return fmt.Sprintf("rows between %v and %v", f.Start, f.End)
+	}
+	return fmt.Sprintf("range between %v and %v", f.Start, f.End)
+}
@@ -0,0 +1,584 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+
+	compare "github.com/matrixorigin/matrixone/pkg/compare2"
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	order "github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func String(arg interface{}, buf *bytes.Buffer) {
+	n := arg.(*Argument)
+	buf.WriteString("ω([")
+	for i, f := range n.Funcs {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(f.String())
+	}
+	buf.WriteString("], [")
+	for i, pos := range n.PartitionBy {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(fmt.Sprintf("%v", pos))
+	}
+	buf.WriteString("], [")
+	for i, f := range n.OrderBy {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(f.String())
+	}
+	buf.WriteString(fmt.Sprintf("], %v)", n.Frame))
+}
+
+func Prepare(_ *process.Process, arg interface{}) error {
+	n := arg.(*Argument)
+	n.ctr = new(Container)
+	n.ctr.n = len(n.PartitionBy)
+	n.ctr.descs = make([]bool, len(n.PartitionBy)+len(n.OrderBy))
+	for i, f := range n.OrderBy {
+		n.ctr.descs[n.ctr.n+i] = f.Type == order.Descending
+	}
+	return nil
+}
+
+// Call evaluates the window functions batch by batch. The input is sorted by
+// the partition by attributes and then the order by attributes, so a partition
+// is complete once a row of the next partition arrives. The complete partitions
+// are evaluated and sent, and the rows of the last partition are kept until the
+// next batch or the end of the input.
+func Call(proc *process.Process, arg interface{}) (bool, error) {
+	n := arg.(*Argument)
+	ctr := n.ctr
+	switch ctr.state {
+	case Build:
+		bat := proc.Reg.InputBatch
+		if bat == nil {
+			ctr.state = End
+			if ctr.bat == nil {
+				return true, nil
+			}
+			bat, ctr.bat = ctr.bat, nil
+			if err := ctr.eval(n, bat, proc); err != nil {
+				batch.Clean(bat, proc.Mp)
+				return true, err
+			}
+			proc.Reg.InputBatch = bat
+			return true, nil
+		}
+		if len(bat.Zs) == 0 {
+			return false, nil
+		}
+		if err := ctr.build(n, bat, proc); err != nil {
+			ctr.state = End
+			return true, err
+		}
+		return false, nil
+	default:
+		proc.Reg.InputBatch = nil
+		return true, nil
+	}
+}
+
+// build appends the batch to the rows of the last partition, and sends the
+// complete partitions.
+func (ctr *Container) build(n *Argument, bat *batch.Batch, proc *process.Process) error {
+	if ctr.bat != nil {
+		_, err := ctr.bat.Append(proc.Mp, bat)
+		batch.Clean(bat, proc.Mp)
+		if err != nil {
+			batch.Clean(ctr.bat, proc.Mp)
+			ctr.bat = nil
+			proc.Reg.InputBatch = nil
+			return err
+		}
+		bat, ctr.bat = ctr.bat, nil
+	}
+	ctr.prepare(n, bat)
+	last := ctr.lastPartition(int64(len(bat.Zs)))
+	if last == 0 {
+		ctr.bat = bat
+		proc.Reg.InputBatch = &batch.Batch{}
+		return nil
+	}
+	rest, err := split(bat, last, proc.Mp)
+	if err != nil {
+		batch.Clean(bat, proc.Mp)
+		proc.Reg.InputBatch = nil
+		return err
+	}
+	ctr.bat = rest
+	if err := ctr.eval(n, bat, proc); err != nil {
+		batch.Clean(bat, proc.Mp)
+		proc.Reg.InputBatch = nil
+		return err
+	}
+	proc.Reg.InputBatch = bat
+	return nil
+}
+
+// lastPartition returns the first row of the last partition, all rows are
+// a partition if there is no partition by attribute.
+func (ctr *Container) lastPartition(rows int64) int64 {
+	if ctr.n == 0 {
+		return 0
+	}
+	for i := rows - 1; i > 0; i-- {
+		if ctr.compare(0, ctr.n, i-1, i) != 0 {
+			return i
+		}
+	}
+	return 0
+}
+
+// split keeps the rows [0, k) in the batch and returns the rest rows
+func split(bat *batch.Batch, k int64, m *mheap.Mheap) (*batch.Batch, error) {
+	rows := len(bat.Zs)
+	rest := batch.New(len(bat.Vecs))
+	flags := make([]uint8, rows-int(k))
+	for i := range flags {
+		flags[i] = 1
+	}
+	for i, vec := range bat.Vecs {
+		rest.Vecs[i] = vector.New(vec.Typ)
+		if err := vector.UnionBatch(rest.Vecs[i], vec, k, len(flags), flags, m); err != nil {
+			batch.Clean(rest, m)
+			return nil, err
+		}
+	}
+	rest.Zs = append(rest.Zs, bat.Zs[k:]...)
+	batch.SetLength(bat, int(k))
+	return rest, nil
+}
+
+func (ctr *Container) eval(n *Argument, bat *batch.Batch, proc *process.Process) error {
+	ctr.prepare(n, bat)
+	rows := int64(len(bat.Zs))
+
+	// split rows into partitions and peer groups
+	partitions := make([]int64, 0, 16)
+	ctr.peerStarts = make([]int64, rows)
+	ctr.peerEnds = make([]int64, rows)
+	for i, start := int64(0), int64(0); i <= rows; i++ {
+		newPartition := i == 0 || i == rows || ctr.compare(0, ctr.n, i-1, i) != 0
+		if newPartition && i < rows {
+			partitions = append(partitions, i)
+		}
+		if i > 0 && (newPartition || ctr.compare(ctr.n, len(ctr.vecs), i-1, i) != 0) {
+			for j := start; j < i; j++ {
+				ctr.peerStarts[j], ctr.peerEnds[j] = start, i
+			}
+			start = i
+		}
+	}
+	partitions = append(partitions, rows)
+
+	ctr.dists = nil
+	if n.Frame.Type == Range && len(n.OrderBy) == 1 &&
+		(n.Frame.Start.Type == Preceding || n.Frame.Start.Type == Following ||
+			n.Frame.End.Type == Preceding || n.Frame.End.Type == Following) {
+		ctr.dists = getDists(ctr.vecs[ctr.n], ctr.descs[ctr.n])
+	}
+
+	for _, f := range n.Funcs {
+		vec, err := ctr.evalFunc(n, f, bat, partitions, proc)
+		if err != nil {
+			return err
+		}
+		bat.Vecs = append(bat.Vecs, vec)
+	}
+	return nil
+}
+
+// prepare sets the partition by attributes and then the order by attributes
+// of the batch for the comparison, nulls are the smallest values.
+func (ctr *Container) prepare(n *Argument, bat *batch.Batch) {
+	ctr.vecs = ctr.vecs[:0]
+	for _, pos := range n.PartitionBy {
+		ctr.vecs = append(ctr.vecs, batch.GetVector(bat, pos))
+	}
+	for _, f := range n.OrderBy {
+		ctr.vecs = append(ctr.vecs, batch.GetVector(bat, f.Pos))
+	}
+	ctr.cmps = ctr.cmps[:0]
+	for i, vec := range ctr.vecs {
+		cmp := compare.New(vec.Typ.Oid, ctr.descs[i])
+		cmp.Set(0, vec)
+		ctr.cmps = append(ctr.cmps, cmp)
+	}
+}
+
+func (ctr *Container) compare(start, end int, i, j int64) int {
+	for k := start; k < end; k++ {
+		vec := ctr.vecs[k]
+		ni := nulls.Contains(vec.Nsp, uint64(i))
+		nj := nulls.Contains(vec.Nsp, uint64(j))
+		var r int
+		switch {
+		case ni && nj:
+			continue
+		case ni:
+			r = -1
+		case nj:
+			r = 1
+		default:
+			if r = ctr.cmps[k].Compare(0, 0, i, j); r != 0 {
+				return r
+			}
+			continue
+		}
+		if ctr.descs[k] {
+			r = -r
+		}
+		return r
+	}
+	return 0
+}
+
+// frame returns the frame [start, end) of row i in the partition [ps, pe)
+func (ctr *Container) frame(f Frame, i, ps, pe int64) (int64, int64) {
+	start := ctr.bound(f.Type, f.Start, true, i, ps, pe)
+	end := ctr.bound(f.Type, f.End, false, i, ps, pe)
+	if end < start {
+		end = start
+	}
+	return start, end
+}
+
+func (ctr *Container) bound(typ FrameType, b Bound, isStart bool, i, ps, pe int64) int64 {
+	switch b.Type {
+	case UnboundedPreceding:
+		return ps
+	case UnboundedFollowing:
+		return pe
+	case CurrentRow:
+		switch {
+		case typ == Rows && isStart:
+			return i
+		case typ == Rows:
+			return i + 1
+		case isStart:
+			return ctr.peerStarts[i]
+		default:
+			return ctr.peerEnds[i]
+		}
+	}
+	if typ == Rows {
+		pos := i + int64(b.Offset)
+		if b.Type == Preceding {
+			pos = i - int64(b.Offset)
+		}
+		if !isStart {
+			pos++
+		}
+		if pos < ps {
+			return ps
+		}
+		if pos > pe {
+			return pe
+		}
+		return pos
+	}
+	// the distances are ascending in a partition for both directions
+	target := ctr.dists[i] + b.Offset
+	if b.Type == Preceding {
+		target = ctr.dists[i] - b.Offset
+	}
+	if isStart {
+		return ps + int64(sort.Search(int(pe-ps), func(k int) bool { return ctr.dists[ps+int64(k)] >= target }))
+	}
+	return ps + int64(sort.Search(int(pe-ps), func(k int) bool { return ctr.dists[ps+int64(k)] > target }))
+}
+
+func (ctr *Container) evalFunc(n *Argument, f Func, bat *batch.Batch, partitions []int64, proc *process.Process) (*vector.Vector, error) {
+	rows := int64(len(bat.Zs))
+	switch f.Type {
+	case RowNumber, Rank, DenseRank, Count:
+		vs, vec, err := newInt64Vector(rows, proc.Mp)
+		if err != nil {
+			return nil, err
+		}
+		var counts []int64
+		if f.Type == Count {
+			counts = prefixCount(bat, f.Pos)
+		}
+		for p := 0; p < len(partitions)-1; p++ {
+			ps, pe := partitions[p], partitions[p+1]
+			dense := int64(0)
+			for i := ps; i < pe; i++ {
+				switch f.Type {
+				case RowNumber:
+					vs[i] = i - ps + 1
+				case Rank:
+					vs[i] = ctr.peerStarts[i] - ps + 1
+				case DenseRank:
+					if ctr.peerStarts[i] == i {
+						dense++
+					}
+					vs[i] = dense
+				case Count:
+					start, end := ctr.frame(n.Frame, i, ps, pe)
+					vs[i] = counts[end] - counts[start]
+				}
+			}
+		}
+		return vec, nil
+	case Sum, Avg:
+		return ctr.evalSum(n, f, bat, partitions, proc)
+	}
+
+	// functions return a row of the argument
+	src := batch.GetVector(bat, f.Pos)
+	cmp := compare.New(src.Typ.Oid, f.Type == Max)
+	cmp.Set(0, src)
+	vec := vector.New(src.Typ)
+	for p := 0; p < len(partitions)-1; p++ {
+		ps, pe := partitions[p], partitions[p+1]
+		lastStart, lastEnd, best := int64(-1), int64(-1), int64(-1)
+		for i := ps; i < pe; i++ {
+			sel := int64(-1)
+			switch f.Type {
+			case Lag:
+				if i-f.Offset >= ps {
+					sel = i - f.Offset
+				}
+			case Lead:
+				if i+f.Offset < pe {
+					sel = i + f.Offset
+				}
+			case FirstValue:
+				if start, end := ctr.frame(n.Frame, i, ps, pe); start < end {
+					sel = start
+				}
+			case LastValue:
+				if start, end := ctr.frame(n.Frame, i, ps, pe); start < end {
+					sel = end - 1
+				}
+			case Min, Max:
+				start, end := ctr.frame(n.Frame, i, ps, pe)
+				from := start
+				if start == lastStart && end >= lastEnd {
+					from = lastEnd
+				} else {
+					best = -1
+				}
+				for k := from; k < end; k++ {
+					if nulls.Contains(src.Nsp, uint64(k)) {
+						continue
+					}
+					if best < 0 || cmp.Compare(0, 0, k, best) < 0 {
+						best = k
+					}
+				}
+				lastStart, lastEnd, sel = start, end, best
+			}
+			if sel < 0 {
+				if err := vector.UnionOne(vec, src, 0, proc.Mp); err != nil {
+					vector.Clean(vec, proc.Mp)
+					return nil, err
+				}
+				nulls.Add(vec.Nsp, uint64(i))
+				continue
+			}
+			if err := vector.UnionOne(vec, src, sel, proc.Mp); err != nil {
+				vector.Clean(vec, proc.Mp)
+				return nil, err
+			}
+		}
+	}
+	return vec, nil
+}
+
+func (ctr *Container) evalSum(n *Argument, f Func, bat *batch.Batch, partitions []int64, proc *process.Process) (*vector.Vector, error) {
+	rows := int64(len(bat.Zs))
+	src := batch.GetVector(bat, f.Pos)
+	counts := prefixCount(bat, f.Pos)
+	isInt := f.Type == Sum && isIntType(src.Typ.Oid)
+	var isums []int64
+	var fsums []float64
+	if isInt {
+		isums = make([]int64, rows+1)
+		for i := int64(0); i < rows; i++ {
+			isums[i+1] = isums[i]
+			if !nulls.Contains(src.Nsp, uint64(i)) {
+				isums[i+1] += getInt64(src, i)
+			}
+		}
+	} else {
+		fsums = make([]float64, rows+1)
+		for i := int64(0); i < rows; i++ {
+			fsums[i+1] = fsums[i]
+			if !nulls.Contains(src.Nsp, uint64(i)) {
+				fsums[i+1] += getFloat64(src, i)
+			}
+		}
+	}
+
+	var ivs []int64
+	var fvs []float64
+	var vec *vector.Vector
+	var err error
+	if isInt {
+		ivs, vec, err = newInt64Vector(rows, proc.Mp)
+	} else {
+		fvs, vec, err = newFloat64Vector(rows, proc.Mp)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for p := 0; p < len(partitions)-1; p++ {
+		ps, pe := partitions[p], partitions[p+1]
+		for i := ps; i < pe; i++ {
+			start, end := ctr.frame(n.Frame, i, ps, pe)
+			cnt := counts[end] - counts[start]
+			switch {
+			case cnt == 0:
+				nulls.Add(vec.Nsp, uint64(i))
+			case isInt:
+				ivs[i] = isums[end] - isums[start]
+			case f.Type == Sum:
+				fvs[i] = fsums[end] - fsums[start]
+			default:
+				fvs[i] = (fsums[end] - fsums[start]) / float64(cnt)
+			}
+		}
+	}
+	return vec, nil
+}
+
+// prefixCount returns the number of not null values before every row,
+// all rows are counted if pos is negative.
+func prefixCount(bat *batch.Batch, pos int32) []int64 {
+	rows := int64(len(bat.Zs))
+	counts := make([]int64, rows+1)
+	for i := int64(0); i < rows; i++ {
+		counts[i+1] = counts[i]
+		if pos < 0 || !nulls.Contains(batch.GetVector(bat, pos).Nsp, uint64(i)) {
+			counts[i+1]++
+		}
+	}
+	return counts
+}
+
+// getDists returns the order by value of every row which is ascending
+// in a partition, nulls come first.
+func getDists(vec *vector.Vector, desc bool) []float64 {
+	rows := int64(vector.Length(vec))
+	dists := make([]float64, rows)
+	for i := int64(0); i < rows; i++ {
+		switch {
+		case nulls.Contains(vec.Nsp, uint64(i)) && desc:
+			dists[i] = math.Inf(1)
+		case nulls.Contains(vec.Nsp, uint64(i)):
+			dists[i] = math.Inf(-1)
+		case desc:
+			dists[i] = -getFloat64(vec, i)
+		default:
+			dists[i] = getFloat64(vec, i)
+		}
+	}
+	return dists
+}
+
+func newInt64Vector(rows int64, m *mheap.Mheap) ([]int64, *vector.Vector, error) {
+	vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	data, err := mheap.Alloc(m, rows*8)
+	if err != nil {
+		return nil, nil, err
+	}
+	vs := encoding.DecodeInt64Slice(data)[:rows]
+	for i := range vs {
+		vs[i] = 0
+	}
+	vec.Data = data
+	vec.Col = vs
+	return vs, vec, nil
+}
+
+func newFloat64Vector(rows int64, m *mheap.Mheap) ([]float64, *vector.Vector, error) {
+	vec := vector.New(types.Type{Oid: types.T_float64, Size: 8})
+	data, err := mheap.Alloc(m, rows*8)
+	if err != nil {
+		return nil, nil, err
+	}
+	vs := encoding.DecodeFloat64Slice(data)[:rows]
+	for i := range vs {
+		vs[i] = 0
+	}
+	vec.Data = data
+	vec.Col = vs
+	return vs, vec, nil
+}
+
+func isIntType(typ types.T) bool {
+	switch typ {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return true
+	}
+	return false
+}
+
+func getInt64(vec *vector.Vector, i int64) int64 {
+	switch vs := vec.Col.(type) {
+	case []int8:
+		return int64(vs[i])
+	case []int16:
+		return int64(vs[i])
+	case []int32:
+		return int64(vs[i])
+	case []int64:
+		return vs[i]
+	case []uint8:
+		return int64(vs[i])
+	case []uint16:
+		return int64(vs[i])
+	case []uint32:
+		return int64(vs[i])
+	case []uint64:
+		return int64(vs[i])
+	}
+	panic(fmt.Sprintf("unexpected type %s for window function", vec.Typ))
+}
+
+func getFloat64(vec *vector.Vector, i int64) float64 {
+	switch vs := vec.Col.(type) {
+	case []float32:
+		return float64(vs[i])
+	case []float64:
+		return vs[i]
+	case []types.Date:
+		return float64(vs[i])
+	case []types.Datetime:
+		return float64(vs[i])
+	case []uint64:
+		return float64(vs[i])
+	}
+	return float64(getInt64(vec, i))
+}
@@ -0,0 +1,253 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	order "github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+// add unit tests for cases
+type windowTestCase struct {
+	arg  *Argument
+	proc *process.Process
+	// expected results of the functions, nil means null
+	expects [][]interface{}
+}
+
+var (
+	tcs []windowTestCase
+
+	// the rows are (partition, order, value), sorted output is
+	//   (1, 1, 10) (1, 2, 20) (1, 2, null) (1, 4, 40)
+	//   (2, 1, 5)  (2, 3, 7)
+	groups = []int64{2, 1, 1, 1, 2, 1}
+	keys   = []int64{3, 2, 1, 4, 1, 2}
+	values = []int64{7, 20, 10, 40, 5, 0}
+	// position of the null value
+	nullRow = 5
+)
+
+func init() {
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	rowsFrame := Frame{Type: Rows, Start: Bound{Type: UnboundedPreceding}, End: Bound{Type: UnboundedFollowing}}
+	rangeFrame := Frame{Type: Range, Start: Bound{Type: UnboundedPreceding}, End: Bound{Type: CurrentRow}}
+	tcs = []windowTestCase{
+		newTestCase(mheap.New(gm), []int32{0}, []order.Field{{Pos: 1}}, rangeFrame,
+			[]Func{{Type: RowNumber, Pos: -1}, {Type: Rank, Pos: -1}, {Type: DenseRank, Pos: -1}},
+			[][]interface{}{
+				{int64(1), int64(2), int64(3), int64(4), int64(1), int64(2)},
+				{int64(1), int64(2), int64(2), int64(4), int64(1), int64(2)},
+				{int64(1), int64(2), int64(2), int64(3), int64(1), int64(2)},
+			}),
+		newTestCase(mheap.New(gm), []int32{0}, []order.Field{{Pos: 1}}, rangeFrame,
+			[]Func{{Type: Lag, Pos: 2, Offset: 1}, {Type: Lead, Pos: 2, Offset: 2}, {Type: Sum, Pos: 2}, {Type: Count, Pos: 2}, {Type: Count, Pos: -1}},
+			[][]interface{}{
+				{nil, int64(10), int64(20), nil, nil, int64(5)},
+				{nil, int64(40), nil, nil, nil, nil},
+				{int64(10), int64(30), int64(30), int64(70), int64(5), int64(12)},
+				{int64(1), int64(2), int64(2), int64(3), int64(1), int64(2)},
+				{int64(1), int64(3), int64(3), int64(4), int64(1), int64(2)},
+			}),
+		newTestCase(mheap.New(gm), []int32{0}, nil, rowsFrame,
+			[]Func{{Type: Sum, Pos: 2}, {Type: Avg, Pos: 2}, {Type: Min, Pos: 2}, {Type: Max, Pos: 2}},
+			[][]interface{}{
+				{int64(70), int64(70), int64(70), int64(70), int64(12), int64(12)},
+				{float64(70) / 3, float64(70) / 3, float64(70) / 3, float64(70) / 3, float64(6), float64(6)},
+				{int64(10), int64(10), int64(10), int64(10), int64(5), int64(5)},
+				{int64(40), int64(40), int64(40), int64(40), int64(7), int64(7)},
+			}),
+		newTestCase(mheap.New(gm), []int32{0}, []order.Field{{Pos: 1}},
+			Frame{Type: Rows, Start: Bound{Type: Preceding, Offset: 1}, End: Bound{Type: Following, Offset: 1}},
+			[]Func{{Type: Sum, Pos: 2}, {Type: Max, Pos: 2}, {Type: FirstValue, Pos: 2}, {Type: LastValue, Pos: 2}},
+			[][]interface{}{
+				{int64(30), int64(30), int64(60), int64(40), int64(12), int64(12)},
+				{int64(20), int64(20), int64(40), int64(40), int64(7), int64(7)},
+				{int64(10), int64(10), int64(20), nil, int64(5), int64(5)},
+				{int64(20), nil, int64(40), int64(40), int64(7), int64(7)},
+			}),
+		newTestCase(mheap.New(gm), []int32{0}, []order.Field{{Pos: 1}},
+			Frame{Type: Range, Start: Bound{Type: Preceding, Offset: 1}, End: Bound{Type: CurrentRow}},
+			[]Func{{Type: Sum, Pos: 2}, {Type: Min, Pos: 2}},
+			[][]interface{}{
+				{int64(10), int64(30), int64(30), int64(40), int64(5), int64(7)},
+				{int64(10), int64(10), int64(10), int64(40), int64(5), int64(7)},
+			}),
+		newTestCase(mheap.New(gm), nil, []order.Field{{Pos: 1, Type: order.Descending}},
+			Frame{Type: Range, Start: Bound{Type: CurrentRow}, End: Bound{Type: Following, Offset: 1}},
+			[]Func{{Type: RowNumber, Pos: -1}, {Type: Count, Pos: -1}},
+			[][]interface{}{
+				{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6)},
+				{int64(2), int64(3), int64(4), int64(4), int64(2), int64(2)},
+			}),
+	}
+}
+
+func TestString(t *testing.T) {
+	buf := new(bytes.Buffer)
+	for _, tc := range tcs {
+		String(tc.arg, buf)
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	for _, tc := range tcs {
+		Prepare(tc.proc, tc.arg)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	for _, tc := range tcs {
+		Prepare(tc.proc, tc.arg)
+		sels := sortedRows(tc.arg)
+		// rows of a partition come from different batches
+		var results [][]interface{}
+		for _, bat := range []*batch.Batch{
+			newBatch(t, tc.proc, sels[:1]), {}, newBatch(t, tc.proc, sels[1:3]),
+			newBatch(t, tc.proc, sels[3:]), nil} {
+			tc.proc.Reg.InputBatch = bat
+			end, err := Call(tc.proc, tc.arg)
+			require.NoError(t, err)
+			require.Equal(t, bat == nil, end)
+			if out := tc.proc.Reg.InputBatch; out != nil && len(out.Zs) > 0 {
+				require.Equal(t, 3+len(tc.arg.Funcs), len(out.Vecs))
+				results = appendResults(results, out)
+				batch.Clean(out, tc.proc.Mp)
+			}
+		}
+
+		require.Equal(t, 3+len(tc.arg.Funcs), len(results))
+		switch {
+		case tc.arg.PartitionBy == nil:
+			require.Equal(t, []interface{}{int64(4), int64(3), int64(2), int64(2), int64(1), int64(1)}, results[1])
+		case tc.arg.OrderBy == nil:
+			require.Equal(t, []interface{}{int64(1), int64(1), int64(1), int64(1), int64(2), int64(2)}, results[0])
+		default:
+			require.Equal(t, []interface{}{int64(1), int64(1), int64(1), int64(1), int64(2), int64(2)}, results[0])
+			require.Equal(t, []interface{}{int64(1), int64(2), int64(2), int64(4), int64(1), int64(3)}, results[1])
+		}
+		for i, expect := range tc.expects {
+			for j, v := range expect {
+				if f, ok := v.(float64); ok {
+					require.InDelta(t, f, results[3+i][j], 1e-9, "%v row %v", tc.arg.Funcs[i], j)
+					continue
+				}
+				require.Equal(t, v, results[3+i][j], "%v row %v", tc.arg.Funcs[i], j)
+			}
+		}
+
+		tc.proc.Reg.InputBatch = nil
+		end, err := Call(tc.proc, tc.arg)
+		require.NoError(t, err)
+		require.True(t, end)
+		require.Nil(t, tc.proc.Reg.InputBatch)
+	}
+}
+
+func newTestCase(m *mheap.Mheap, partitionBy []int32, orderBy []order.Field, frame Frame, fs []Func, expects [][]interface{}) windowTestCase {
+	return windowTestCase{
+		proc: process.New(m),
+		arg: &Argument{
+			PartitionBy: partitionBy,
+			OrderBy:     orderBy,
+			Frame:       frame,
+			Funcs:       fs,
+		},
+		expects: expects,
+	}
+}
+
+// sortedRows returns the rows sorted by the partition by attributes and then
+// the order by attributes, which is the order of the input of the operator
+func sortedRows(arg *Argument) []int {
+	cols := [][]int64{groups, keys, values}
+	sels := make([]int, len(groups))
+	for i := range sels {
+		sels[i] = i
+	}
+	sort.SliceStable(sels, func(i, j int) bool {
+		for _, pos := range arg.PartitionBy {
+			if x, y := cols[pos][sels[i]], cols[pos][sels[j]]; x != y {
+				return x < y
+			}
+		}
+		for _, f := range arg.OrderBy {
+			if x, y := cols[f.Pos][sels[i]], cols[f.Pos][sels[j]]; x != y {
+				return (x < y) != (f.Type == order.Descending)
+			}
+		}
+		return false
+	})
+	return sels
+}
+
+// create a batch of (partition, order, value) with the rows
+func newBatch(t *testing.T, proc *process.Process, sels []int) *batch.Batch {
+	rows := int64(len(sels))
+	bat := batch.New(3)
+	bat.InitZsOne(int(rows))
+	for i, col := range [][]int64{groups, keys, values} {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		data, err := mheap.Alloc(proc.Mp, rows*8)
+		require.NoError(t, err)
+		vec.Data = data
+		vs := encoding.DecodeInt64Slice(vec.Data)[:rows]
+		for j, sel := range sels {
+			vs[j] = col[sel]
+			if i == 2 && sel == nullRow {
+				nulls.Add(vec.Nsp, uint64(j))
+			}
+		}
+		vec.Col = vs
+		bat.Vecs[i] = vec
+	}
+	return bat
+}
+
+// appendResults appends the values of the batch to the columns, nil is null
+func appendResults(results [][]interface{}, bat *batch.Batch) [][]interface{} {
+	if results == nil {
+		results = make([][]interface{}, len(bat.Vecs))
+	}
+	for i, vec := range bat.Vecs {
+		for j := 0; j < len(bat.Zs); j++ {
+			if nulls.Contains(vec.Nsp, uint64(j)) {
+				results[i] = append(results[i], nil)
+				continue
+			}
+			switch vs := vec.Col.(type) {
+			case []int64:
+				results[i] = append(results[i], vs[j])
+			case []float64:
+				results[i] = append(results[i], vs[j])
+			}
+		}
+	}
+	return results
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/explain"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+)
+
+// SetAnalyze sets whether the runtime statistics of the operators are collected
+// when the exec runs, it should be called before Compile.
+func (e *Exec) SetAnalyze(analyze bool) {
+	e.c.proc.Analyze = analyze
+}
+
+// Analysis returns the operators of the pipelines run by the exec with their runtime statistics.
+func (e *Exec) Analysis() []*explain.AnalyzeNode {
+	if e.scope == nil {
+		return nil
+	}
+	return analyzeScope(e.scope, make(map[*spillReader]struct{}))
+}
+
+// analyzeScope returns the operators of the scope, the last instruction is the root
+// and each instruction is the only child of the next one. The first instruction reads
+// from the data source or the children scopes.
+func analyzeScope(s *Scope, readers map[*spillReader]struct{}) []*explain.AnalyzeNode {
+	var nodes []*explain.AnalyzeNode
+
+	if s.Magic == Normal && s.DataSource != nil {
+		nodes = append(nodes, analyzeSource(s, readers))
+	}
+	for _, ps := range s.PreScopes {
+		nodes = append(nodes, analyzeScope(ps, readers)...)
+	}
+	nodes = mergeAnalyzeNodes(nodes)
+	if s.Proc == nil {
+		return nodes
+	}
+	infos := s.Proc.Analysis
+	// the bytes spilled out of the calls of the instructions are counted into the first one
+	spillSize := s.Proc.SpillSize
+	for _, info := range infos {
+		spillSize -= info.SpillSize
+	}
+	for i, in := range s.Instructions {
+		node := &explain.AnalyzeNode{
+			Name:     vm.Name(in.Op),
+			Parallel: 1,
+			Children: nodes,
+		}
+		if i < len(infos) {
+			node.InputRows = infos[i].InputRows
+			node.OutputRows = infos[i].OutputRows
+			node.InputBatches = infos[i].InputBatches
+			node.OutputBatches = infos[i].OutputBatches
+			node.WallTime = infos[i].WallTime
+			node.CPUTime = infos[i].CPUTime
+			node.MemoryPeak = infos[i].MemoryPeak
+			node.SpillSize = infos[i].SpillSize
+		}
+		if i == 0 {
+			node.SpillSize += spillSize
+		}
+		nodes = []*explain.AnalyzeNode{node}
+	}
+	return nodes
+}
+
+// analyzeSource returns the scan of the data source of a scope, the rows and batches
+// it produces are the ones read by the first instruction.
+func analyzeSource(s *Scope, readers map[*spillReader]struct{}) *explain.AnalyzeNode {
+	node := &explain.AnalyzeNode{
+		Name:     "Table Scan on " + s.DataSource.SchemaName + "." + s.DataSource.RelationName,
+		Parallel: 1,
+	}
+	if s.Proc != nil && len(s.Proc.Analysis) > 0 {
+		node.OutputRows = s.Proc.Analysis[0].InputRows
+		node.OutputBatches = s.Proc.Analysis[0].InputBatches
+	}
+	var r *spillReader
+	switch sr := s.DataSource.R.(type) {
+	case *spillReader:
+		r = sr
+	case *spillPartReader:
+		r = sr.r
+	}
+	if r != nil {
+		node.Name = "Spill Scan"
+		// the partitions of a reader are read by many scopes, but they are spilled only once
+		if _, ok := readers[r]; !ok {
+			readers[r] = struct{}{}
+			node.SpillSize = r.proc.SpillSize
+		}
+	}
+	return node
+}
+
+// mergeAnalyzeNodes merges the nodes of the same operators run by parallel pipelines.
+func mergeAnalyzeNodes(nodes []*explain.AnalyzeNode) []*explain.AnalyzeNode {
+	var rs []*explain.AnalyzeNode
+
+	for _, node := range nodes {
+		merged := false
+		for _, r := range rs {
+			if sameAnalyzeNode(r, node) {
+				mergeAnalyzeNode(r, node)
+				merged = true
+				break
+			}
+		}
+		if !merged {
+			rs = append(rs, node)
+		}
+	}
+	return rs
+}
+
+// sameAnalyzeNode returns true if the two nodes have the same operators in the same shape.
+func sameAnalyzeNode(a, b *explain.AnalyzeNode) bool {
+	if a.Name != b.Name || len(a.Children) != len(b.Children) {
+		return false
+	}
+	for i := range a.Children {
+		if !sameAnalyzeNode(a.Children[i], b.Children[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeAnalyzeNode adds the statistics of b into a, a and b should be the same.
+// The memory peak is the largest one of the parallel pipelines.
+func mergeAnalyzeNode(a, b *explain.AnalyzeNode) {
+	a.Parallel += b.Parallel
+	a.InputRows += b.InputRows
+	a.OutputRows += b.OutputRows
+	a.InputBatches += b.InputBatches
+	a.OutputBatches += b.OutputBatches
+	a.WallTime += b.WallTime
+	a.CPUTime += b.CPUTime
+	if b.MemoryPeak > a.MemoryPeak {
+		a.MemoryPeak = b.MemoryPeak
+	}
+	a.SpillSize += b.SpillSize
+	for i := range a.Children {
+		mergeAnalyzeNode(a.Children[i], b.Children[i])
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// snapshotLayout is the layout of the timestamp of a snapshot read, the fractional
+// seconds are accepted as well.
+const snapshotLayout = "2006-01-02 15:04:05"
+
+// asOfEngine reads the tables referenced by AS OF TIMESTAMP from the snapshots
+// of the engine, and the other tables from the engine itself.
+type asOfEngine struct {
+	engine.Engine
+	// tables maps the schema.table to the engine of its snapshot
+	tables map[string]engine.Engine
+}
+
+type asOfDatabase struct {
+	engine.Database
+	name   string
+	tables map[string]engine.Engine
+}
+
+// ParseSnapshotTimestamp parses the timestamp of a snapshot read in the time zone
+// of the session, the local time zone of the server if loc is nil
+func ParseSnapshotTimestamp(s string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	ts, err := time.ParseInLocation(snapshotLayout, strings.TrimSpace(s), loc)
+	if err != nil {
+		return ts, errors.New(errno.DataException, fmt.Sprintf("invalid snapshot timestamp '%s'", s))
+	}
+	return ts, nil
+}
+
+// compileAsOf makes the tables referenced by AS OF TIMESTAMP read from the snapshots,
+// every reference of such a table must be read as of the same timestamp.
+func (e *Exec) compileAsOf(stmt *tree.Select) error {
+	var se engine.SnapshotEngine
+
+	refs := make(map[string]string)
+	if err := collectAsOf(stmt, e.c.db, refs); err != nil {
+		return err
+	}
+	ae := &asOfEngine{
+		Engine: e.c.e,
+		tables: make(map[string]engine.Engine),
+	}
+	snapshots := make(map[string]engine.Engine)
+	for name, asOf := range refs {
+		if asOf == "" {
+			continue
+		}
+		if se == nil {
+			var ok bool
+			if se, ok = e.c.e.(engine.SnapshotEngine); !ok {
+				return errors.New(errno.FeatureNotSupported, "snapshot reads are not supported by the storage engine")
+			}
+		}
+		if _, ok := snapshots[asOf]; !ok {
+			ts, err := ParseSnapshotTimestamp(asOf, e.c.proc.TimeZone)
+			if err != nil {
+				return err
+			}
+			if snapshots[asOf], err = se.Snapshot(ts); err != nil {
+				return err
+			}
+		}
+		ae.tables[name] = snapshots[asOf]
+	}
+	if len(ae.tables) > 0 {
+		e.c.e = ae
+	}
+	return nil
+}
+
+// collectAsOf collects the tables referenced in the FROM clauses of stmt and the
+// timestamps they are read as of, the timestamp is empty if it is read as the latest.
+func collectAsOf(stmt tree.SelectStatement, db string, refs map[string]string) error {
+	switch stmt := stmt.(type) {
+	case *tree.Select:
+		if stmt.With != nil {
+			for _, def := range stmt.With.CTEs {
+				if s, ok := def.Stmt.(tree.SelectStatement); ok {
+					if err := collectAsOf(s, db, refs); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return collectAsOf(stmt.Select, db, refs)
+	case *tree.ParenSelect:
+		return collectAsOf(stmt.Select, db, refs)
+	case *tree.UnionClause:
+		if err := collectAsOf(stmt.Left, db, refs); err != nil {
+			return err
+		}
+		return collectAsOf(stmt.Right, db, refs)
+	case *tree.SelectClause:
+		if stmt.From == nil {
+			return nil
+		}
+		for _, table := range stmt.From.Tables {
+			if err := collectTableAsOf(table, "", db, refs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func collectTableAsOf(expr tree.TableExpr, asOf string, db string, refs map[string]string) error {
+	switch expr := expr.(type) {
+	case *tree.TableName:
+		schema := string(expr.SchemaName)
+		if len(schema) == 0 {
+			schema = db
+		}
+		name := snapshotTableName(schema, string(expr.ObjectName))
+		if prev, ok := refs[name]; ok && prev != asOf {
+			return errors.New(errno.FeatureNotSupported, fmt.Sprintf("table '%s' is read as of different timestamps", expr.ObjectName))
+		}
+		refs[name] = asOf
+	case *tree.AliasedTableExpr:
+		return collectTableAsOf(expr.Expr, expr.AsOf, db, refs)
+	case *tree.ParenTableExpr:
+		return collectTableAsOf(expr.Expr, asOf, db, refs)
+	case *tree.JoinTableExpr:
+		if err := collectTableAsOf(expr.Left, "", db, refs); err != nil {
+			return err
+		}
+		if expr.Right != nil {
+			return collectTableAsOf(expr.Right, "", db, refs)
+		}
+	case *tree.Select:
+		return collectAsOf(expr, db, refs)
+	}
+	return nil
+}
+
+func snapshotTableName(schema, name string) string {
+	return strings.ToLower(schema) + "." + strings.ToLower(name)
+}
+
+func (e *asOfEngine) Database(name string) (engine.Database, error) {
+	db, err := e.Engine.Database(name)
+	if err != nil {
+		return nil, err
+	}
+	return &asOfDatabase{Database: db, name: name, tables: e.tables}, nil
+}
+
+func (d *asOfDatabase) Relation(name string) (engine.Relation, error) {
+	se, ok := d.tables[snapshotTableName(d.name, name)]
+	if !ok {
+		return d.Database.Relation(name)
+	}
+	db, err := se.Database(d.name)
+	if err != nil {
+		return nil, err
+	}
+	return db.Relation(name)
+}
@@ -20,6 +20,7 @@ import (
 	_ "github.com/matrixorigin/matrixone/pkg/builtin/unary"  // default import
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 )
@@ -29,6 +30,11 @@ func InitAddress(addr string) {
 	Address = addr
 }
 
+// InitMaxRecursionDepth is used to set the maximum number of iterations of a recursive common table expression
+func InitMaxRecursionDepth(depth int64) {
+	MaxRecursionDepth = depth
+}
+
 // New is used to new an object of compile
 func New(db string, sql string, uid string,
 	e engine.Engine, proc *process.Process) *compile {
@@ -41,12 +47,23 @@ func New(db string, sql string, uid string,
 	}
 }
 
+// SetParams binds the values to the placeholders of the prepared statement.
+func (c *compile) SetParams(params []tree.Expr) {
+	c.params = params
+}
+
 // Build generates query execution list based on the result of sql parser.
 func (c *compile) Build() ([]*Exec, error) {
 	stmts, err := parsers.Parse(dialect.MYSQL, c.sql)
 	if err != nil {
 		return nil, err
 	}
+	return c.BuildStatements(stmts), nil
+}
+
+// BuildStatements generates query execution list of the statements parsed by the caller,
+// such as the statements in the other dialects.
+func (c *compile) BuildStatements(stmts []tree.Statement) []*Exec {
 	es := make([]*Exec, len(stmts))
 	for i := range stmts {
 		es[i] = &Exec{
@@ -54,5 +71,5 @@ func (c *compile) Build() ([]*Exec, error) {
 			stmt: stmts[i],
 		}
 	}
-	return es, nil
+	return es
 }
@@ -17,6 +17,8 @@ package compile
 import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2/explain"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -25,6 +27,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
 	"log"
 	"testing"
+	"time"
 )
 
 var querys = []string{
@@ -41,6 +44,45 @@ var querys = []string{
 	// "SHOW CREATE DATABASE db;",
 	"INSERT INTO table1 values(12);",
 	"DROP TABLE table1;",
+	"CREATE TABLE table2(a int, b varchar(10)) COMPRESSION='zstd' PROPERTIES('compression_level'='9');",
+	"INSERT INTO table2 values(1, 'a'), (2, 'b');",
+	"SELECT * FROM table2;",
+	"SELECT upper(b), lower(b), hex_encode(b), repeat(b, a), left(b, a), right(b, 1) FROM table2;",
+	"SELECT concat(b, '-', b), concat_ws(',', b, 'c'), instr(b, 'a'), locate('a', b, 1), position('a' in b) FROM table2;",
+	"SELECT replace(b, 'a', 'x'), translate(b, 'ab', 'x'), ascii(b), chr(a + 64) FROM table2;",
+	"SELECT * FROM table2 WHERE b REGEXP '^a' OR b NOT REGEXP '[ac]';",
+	"SELECT * FROM table2 WHERE regexp_like(b, 'A', 'i') AND NOT regexp_like(b, 'x');",
+	"SELECT regexp_replace(b, '(.)', '$1$1'), regexp_replace(b, 'A', 'x', 1, 0, 'i'), regexp_substr(b, '[a-z]'), regexp_substr(b, 'b', 1, 1) FROM table2;",
+	"DROP TABLE table2;",
+	"CREATE TABLE table4(a int, d date, t datetime);",
+	"INSERT INTO table4 values(1, '2022-01-31', '2022-01-31 10:20:30'), (2, '2020-02-29', '2021-12-31 23:59:59');",
+	"SELECT d + interval 1 month, d - interval a day, interval '1:30' hour_minute + t, t + interval '1.5' second FROM table4;",
+	"SELECT date_add(d, interval a year), date_sub(t, interval '1 2' day_hour), adddate(d, 1), subdate(t, interval 1 week) FROM table4;",
+	"SELECT datediff(t, d), timestampdiff(month, d, t), extract(year_month from t), extract(week from d) FROM table4;",
+	"SELECT hour(t), minute(t), second(t), last_day(d), last_day(t) FROM table4;",
+	"SELECT date_format(t, '%Y/%m/%d %H:%i:%s %W %j'), str_to_date(date_format(t, '%d/%m/%Y %H:%i'), '%d/%m/%Y %H:%i') FROM table4;",
+	"SELECT unix_timestamp(t), from_unixtime(unix_timestamp(t)), from_unixtime(a, '%Y') FROM table4;",
+	"SELECT * FROM table4 WHERE d + interval 1 day > '2022-01-31';",
+	"SELECT datediff(now(), t), convert_tz(t, '+00:00', '+08:00'), convert_tz(d, 'UTC', 'Asia/Shanghai') FROM table4 WHERE t < current_timestamp(3);",
+	"DROP TABLE table4;",
+	"CREATE TABLE table5(a int, j json);",
+	`INSERT INTO table5 values(1, '{"b": [1, 2], "c": "x"}'), (1, '[1, {"b": 2}]'), (2, null);`,
+	"SELECT * FROM table5;",
+	"SELECT j->'$.b', j->>'$.c', json_extract(j, '$.b[0]', '$[1].b'), json_unquote(json_extract(j, '$.c')) FROM table5;",
+	`SELECT json_contains(j, '1', '$.b'), json_length(j), json_length(j, '$.b'), json_keys(j) FROM table5 WHERE json_length(j) = 2;`,
+	"SELECT json_array(a, j), json_object('a', a, 'j', j) FROM table5;",
+	"SELECT a, json_arrayagg(j), json_objectagg(a, j) FROM table5 GROUP BY a;",
+	"DROP TABLE table5;",
+	"CREATE TABLE table6(a int, d decimal(10, 2), e decimal(20, 5));",
+	"INSERT INTO table6 values(1, 12.34, 100.5), (1, -0.5, null), (2, 99999999.99, 0.00001);",
+	"SELECT d + e, d - a, d * e, d / 3, a / d, d + 1.5, cast(d as decimal(12, 1)), cast(d as double), cast(d as char), d + cast('3.14159' as decimal(6, 4)) FROM table6;",
+	"SELECT a, sum(d), avg(d), sum(e), avg(e), max(d), min(e), round(d, 1), round(e) FROM table6 GROUP BY a;",
+	"SELECT * FROM table6 WHERE d > 1 AND e < 1000;",
+	"DROP TABLE table6;",
+	"CREATE TABLE table3(a int) COMPRESSION='snappy';",
+	"INSERT INTO table3 values(1);",
+	"SELECT * FROM table3;",
+	"DROP TABLE table3;",
 	"DROP DATABASE IF EXISTS db;",
 	"select * from R join S on R.uid = S.uid",
 	"select sum(R.price) from R join S on R.uid = S.uid",
@@ -104,3 +146,149 @@ func processQuery(query string, e engine.Engine, proc *process.Process) {
 		}
 	}
 }
+
+func TestCompileAsOf(t *testing.T) {
+	InitAddress("127.0.0.1")
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	e := memEngine.NewTestEngine()
+	c := New("test", "select * from R as of timestamp '2022-05-01 10:00:00'", "", e, proc)
+	es, err := c.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := es[0].Compile(nil, sqlOutput); err == nil {
+		t.Fatal("snapshot reads should not be supported by the memory engine")
+	}
+	if _, err := ParseSnapshotTimestamp("2022-05-01 10:00:00.123", nil); err != nil {
+		t.Fatal(err)
+	}
+	//the timestamp is in the time zone of the session
+	ts, err := ParseSnapshotTimestamp("2022-05-01 10:00:00", time.FixedZone("", 8*3600))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ts.Equal(time.Date(2022, 5, 1, 2, 0, 0, 0, time.UTC)) {
+		t.Fatalf("snapshot timestamp %v is not in the time zone of the session", ts)
+	}
+	if _, err := ParseSnapshotTimestamp("yesterday", nil); err == nil {
+		t.Fatal("invalid snapshot timestamp should be rejected")
+	}
+}
+
+func TestCompileTimeZone(t *testing.T) {
+	InitAddress("127.0.0.1")
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	loc, err := types.ParseTimeZone("+08:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	proc.TimeZone = loc
+	e := memEngine.NewTestEngine()
+	processQuery("CREATE TABLE table5(a int, ts timestamp);", e, proc)
+	processQuery("INSERT INTO table5 values(1, '2022-01-01 08:00:00'), (2, now());", e, proc)
+
+	var rs []types.Timestamp
+	c := New("test", "SELECT ts FROM table5;", "", e, proc)
+	es, err := c.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := es[0].Compile(nil, func(_ interface{}, bat *batch.Batch) error {
+		if bat != nil && len(bat.Vecs) > 0 {
+			rs = append(rs, bat.Vecs[0].Col.([]types.Timestamp)...)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := es[0].Run(0); err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) != 2 {
+		t.Fatalf("expect 2 timestamps, but got %v", rs)
+	}
+	// the timestamps are stored in UTC
+	if s := rs[0].ToDatetime(time.UTC).String(); s != "2022-01-01 00:00:00" {
+		t.Fatalf("expect 2022-01-01 00:00:00 in UTC, but got %s", s)
+	}
+	if d := time.Since(time.Unix(rs[1].ToDatetime(time.UTC).UnixTimestamp(), 0)); d < 0 || d > time.Minute {
+		t.Fatalf("now() in +08:00 is stored as %s UTC", rs[1].ToDatetime(time.UTC))
+	}
+}
+
+func TestCompileAnalyze(t *testing.T) {
+	InitAddress("127.0.0.1")
+	hm := host.New(1 << 30)
+	gm := guest.New(1<<30, hm)
+	proc := process.New(mheap.New(gm))
+	e := memEngine.NewTestEngine()
+	for _, query := range []string{
+		"select orderId, price from R where price > 0;",
+		"select uid, count(*) from R group by uid;",
+	} {
+		c := New("test", query, "", e, proc)
+		es, err := c.Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+		es[0].SetAnalyze(true)
+		rows := 0
+		if err := es[0].Compile(nil, func(_ interface{}, bat *batch.Batch) error {
+			if bat != nil {
+				rows += len(bat.Zs)
+			}
+			return nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if err := es[0].Run(0); err != nil {
+			t.Fatal(err)
+		}
+		nodes := es[0].Analysis()
+		if len(nodes) != 1 || nodes[0].Name != "Output" {
+			t.Fatalf("%s: expect the output as root, but got %v", query, nodes)
+		}
+		if nodes[0].OutputRows != int64(rows) {
+			t.Fatalf("%s: expect %v rows output, but got %v", query, rows, nodes[0].OutputRows)
+		}
+		// the leaves are the scans of R which read all its rows
+		var scanned int64
+		var walk func(n *explain.AnalyzeNode)
+		walk = func(n *explain.AnalyzeNode) {
+			if len(n.Children) == 0 {
+				if n.Name != "Table Scan on test.R" {
+					t.Fatalf("%s: unexpected leaf %s", query, n.Name)
+				}
+				scanned += n.OutputRows
+			}
+			if n.WallTime < 0 || n.CPUTime < 0 {
+				t.Fatalf("%s: negative time of %s", query, n.Name)
+			}
+			for _, child := range n.Children {
+				walk(child)
+			}
+		}
+		walk(nodes[0])
+		if scanned != 20 {
+			t.Fatalf("%s: expect 20 rows scanned, but got %v", query, scanned)
+		}
+	}
+	proc.Analyze = false
+}
+
+func TestMergeAnalyzeNode(t *testing.T) {
+	a := &explain.AnalyzeNode{Name: "Output", Parallel: 1, OutputRows: 10, MemoryPeak: 1 << 20}
+	b := &explain.AnalyzeNode{Name: "Output", Parallel: 1, OutputRows: 5, MemoryPeak: 2 << 20}
+	mergeAnalyzeNode(a, b)
+	if a.Parallel != 2 || a.OutputRows != 15 {
+		t.Fatalf("expect the counters to be summed, but got %v", a)
+	}
+	// the parallel pipelines do not hold their peaks at the same time
+	if a.MemoryPeak != 2<<20 {
+		t.Fatalf("expect the largest memory peak, but got %v", a.MemoryPeak)
+	}
+}
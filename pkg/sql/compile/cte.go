@@ -0,0 +1,583 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// cte is a common table expression of a query.
+type cte struct {
+	name string
+	// table stores all the rows of the cte
+	table *cteTable
+	// exec is the query of a non-recursive cte, or the anchor part of a recursive cte
+	exec *Exec
+	// recursive is the recursive part of a recursive cte, it is nil if the cte is not recursive
+	recursive *tree.Select
+	// distinct is true if the parts of a recursive cte are combined by UNION DISTINCT
+	distinct bool
+	// c is the compile context of the query, it resolves the ctes defined before this one
+	c *compile
+	// rc is the compile context of the recursive part, this cte is resolved as the working table
+	rc *compile
+}
+
+// cteTable stores the rows of a common table expression in memory,
+// every segment is a batch whose vectors are encoded by vector.Show.
+type cteTable struct {
+	rows  int64
+	attrs []engine.Attribute
+	names map[string]int // position of the attribute
+	segs  [][][]byte
+	zs    [][]int64
+}
+
+// cteEngine resolves the common table expressions before the tables of
+// the current database, so the query can scan them as tables.
+type cteEngine struct {
+	engine.Engine
+	db     string
+	tables map[string]*cteTable
+}
+
+type cteDatabase struct {
+	engine.Database
+	err    error // error of the underlying database
+	tables map[string]*cteTable
+}
+
+type cteRelation struct {
+	name  string
+	table *cteTable
+}
+
+type cteReader struct {
+	table *cteTable
+	segs  []int
+}
+
+// compileWith compiles the common table expressions in order, a cte can
+// reference the ctes defined before it.
+func (e *Exec) compileWith(with *tree.With) error {
+	ce := e.cteEngine()
+	c := e.c
+	for _, def := range with.CTEs {
+		name := strings.ToLower(string(def.Name.Alias))
+		if _, ok := ce.tables[name]; ok {
+			return errors.New(errno.DuplicateAlias, fmt.Sprintf("Not unique table/alias: '%s'", def.Name.Alias))
+		}
+		stmt, ok := def.Stmt.(*tree.Select)
+		if !ok {
+			return errors.New(errno.FeatureNotSupported, fmt.Sprintf("statement '%s' is not supported in common table expression", tree.String(def.Stmt, 0)))
+		}
+		// the query of cte only sees the ctes defined before it, the tables are
+		// resolved again when the query runs
+		x := &cte{name: name, c: ce.snapshot().newCompile(c)}
+		if with.IsRecursive && tree.ReferencesTable(stmt, name) {
+			union, ok := stmt.Select.(*tree.UnionClause)
+			if !ok {
+				return errors.New(errno.InvalidRecursion, fmt.Sprintf("recursive common table expression '%s' should be 'anchor UNION [ALL] recursive part'", name))
+			}
+			if union.Type != tree.UNION {
+				return errors.New(errno.FeatureNotSupported, fmt.Sprintf("recursive common table expression '%s' only supports UNION [ALL]", name))
+			}
+			if stmt.OrderBy != nil || stmt.Limit != nil {
+				return errors.New(errno.FeatureNotSupported, fmt.Sprintf("recursive common table expression '%s' does not support ORDER BY or LIMIT", name))
+			}
+			if tree.ReferencesTable(union.Left, name) {
+				return errors.New(errno.InvalidRecursion, fmt.Sprintf("anchor part of recursive common table expression '%s' can not reference itself", name))
+			}
+			stmt = &tree.Select{Select: union.Left}
+			x.recursive = &tree.Select{Select: union.Right}
+			x.distinct = !union.All
+			x.rc = ce.snapshot().newCompile(c)
+		}
+		if err := x.compile(stmt, def.Name.Cols); err != nil {
+			return err
+		}
+		ce.tables[name] = x.table
+		e.ctes = append(e.ctes, x)
+	}
+	return nil
+}
+
+// compile compiles the query of cte and decides the attributes of its table
+func (x *cte) compile(stmt *tree.Select, cols tree.IdentifierList) error {
+	x.exec = &Exec{c: x.c, stmt: stmt}
+	x.table = &cteTable{names: make(map[string]int)}
+	if err := x.exec.Compile(nil, x.table.fill); err != nil {
+		return err
+	}
+	attrs := x.exec.resultAttrs
+	if cols != nil && len(cols) != len(attrs) {
+		return errors.New(errno.InvalidColumnReference, fmt.Sprintf("In definition of view, derived table or common table expression, SELECT list and column names list have different column counts"))
+	}
+	for i, attr := range attrs {
+		name := attr.Name
+		if cols != nil {
+			name = string(cols[i])
+		}
+		if _, ok := x.table.names[name]; ok {
+			return errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s' in common table expression '%s'", name, x.name))
+		}
+		x.table.names[name] = i
+		x.table.attrs = append(x.table.attrs, engine.Attribute{Name: name, Type: attr.Type})
+	}
+	if x.recursive != nil {
+		x.rc.e.(*cteEngine).tables[x.name] = x.table
+		return x.checkRecursive()
+	}
+	return nil
+}
+
+// checkRecursive checks the columns of the recursive part, the values are cast
+// to the column types decided by the anchor part if their types are different.
+func (x *cte) checkRecursive() error {
+	exec, err := x.compileRecursive(x.table.empty())
+	if err != nil {
+		return err
+	}
+	attrs := exec.resultAttrs
+	if len(attrs) != len(x.table.attrs) {
+		return errors.New(errno.InvalidColumnReference, fmt.Sprintf("anchor part and recursive part of common table expression '%s' have different column counts", x.name))
+	}
+	var clause *tree.SelectClause
+	switch stmt := x.recursive.Select.(type) {
+	case *tree.SelectClause:
+		clause = stmt
+	case *tree.ParenSelect:
+		clause, _ = stmt.Select.Select.(*tree.SelectClause)
+	}
+	casted := false
+	for i, attr := range attrs {
+		typ := x.table.attrs[i].Type
+		if attr.Type.Oid == typ.Oid || (isString(attr.Type.Oid) && isString(typ.Oid)) {
+			continue
+		}
+		castType, ok := newCastType(typ.Oid)
+		if !ok || clause == nil || len(clause.Exprs) != len(attrs) {
+			return errors.New(errno.DatatypeMismatch, fmt.Sprintf("column '%s' of common table expression '%s' is %s in anchor part but %s in recursive part",
+				x.table.attrs[i].Name, x.name, typ, attr.Type))
+		}
+		clause.Exprs[i].Expr = &tree.CastExpr{Expr: clause.Exprs[i].Expr, Type: castType}
+		casted = true
+	}
+	if casted {
+		_, err = x.compileRecursive(x.table.empty())
+	}
+	return err
+}
+
+// compileRecursive compiles the recursive part of cte which scans the working table,
+// the rows produced are stored in next.
+func (x *cte) compileRecursive(next *cteTable) (*Exec, error) {
+	exec := &Exec{c: x.rc, stmt: x.recursive}
+	if err := exec.Compile(nil, next.fill); err != nil {
+		return nil, err
+	}
+	return exec, nil
+}
+
+// runCTEs materializes the common table expressions in order, every cte
+// is evaluated once no matter how many times it is referenced.
+func (e *Exec) runCTEs() error {
+	for _, x := range e.ctes {
+		if err := x.exec.Run(0); err != nil {
+			return err
+		}
+		if x.recursive == nil {
+			continue
+		}
+
+		// UNION DISTINCT keeps the rows never produced before, so the recursion
+		// ends when an iteration only produces the known rows
+		var seen map[string]struct{}
+		if x.distinct {
+			seen = make(map[string]struct{})
+			if err := x.table.distinct(seen); err != nil {
+				return err
+			}
+		}
+
+		// evaluate the recursive part with the rows produced by the last iteration
+		// until no more rows are produced
+		tables := x.rc.e.(*cteEngine).tables
+		working := x.table.clone()
+		for depth := int64(0); working.rows > 0; depth++ {
+			if depth >= MaxRecursionDepth {
+				return errors.New(errno.ProgramLimitExceeded, fmt.Sprintf("Recursive query aborted after %v iterations. Try increasing cteMaxRecursionDepth to a larger value", depth))
+			}
+			tables[x.name] = working
+			next := working.empty()
+			exec, err := x.compileRecursive(next)
+			if err != nil {
+				return err
+			}
+			if err := exec.Run(0); err != nil {
+				return err
+			}
+			if x.distinct {
+				if err := next.distinct(seen); err != nil {
+					return err
+				}
+			}
+			x.table.append(next)
+			working = next
+		}
+	}
+	return nil
+}
+
+func (t *cteTable) fill(_ interface{}, bat *batch.Batch) error {
+	seg := make([][]byte, len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		data, err := compactVector(vec).Show()
+		if err != nil {
+			return err
+		}
+		seg[i] = data
+	}
+	zs := make([]int64, len(bat.Zs))
+	copy(zs, bat.Zs)
+	for _, z := range zs {
+		if z > 0 {
+			t.rows += z
+		}
+	}
+	t.segs = append(t.segs, seg)
+	t.zs = append(t.zs, zs)
+	return nil
+}
+
+// compactVector returns a vector whose strings are stored in order, vector.Show
+// requires it but the operators may only change the offsets of strings
+func compactVector(vec *vector.Vector) *vector.Vector {
+	col, ok := vec.Col.(*types.Bytes)
+	if !ok {
+		return vec
+	}
+	bs := &types.Bytes{
+		Offsets: make([]uint32, len(col.Offsets)),
+		Lengths: col.Lengths,
+	}
+	for i := range col.Offsets {
+		bs.Offsets[i] = uint32(len(bs.Data))
+		bs.Data = append(bs.Data, col.Get(int64(i))...)
+	}
+	return &vector.Vector{Typ: vec.Typ, Col: bs, Nsp: vec.Nsp}
+}
+
+// distinct removes the rows of the table which are in seen or duplicated, the rest are added to seen
+func (t *cteTable) distinct(seen map[string]struct{}) error {
+	segs, zs := t.segs, t.zs
+	t.rows, t.segs, t.zs = 0, nil, nil
+	for i, seg := range segs {
+		vecs := make([]*vector.Vector, len(seg))
+		for j, data := range seg {
+			vecs[j] = vector.New(t.attrs[j].Type)
+			if err := vecs[j].Read(data); err != nil {
+				return err
+			}
+		}
+		var sels []int64
+		var key []byte
+		for row, z := range zs[i] {
+			if z <= 0 {
+				continue
+			}
+			key = key[:0]
+			for _, vec := range vecs {
+				var err error
+				if key, err = appendKey(key, vec, int64(row)); err != nil {
+					return err
+				}
+			}
+			if _, ok := seen[string(key)]; ok {
+				continue
+			}
+			seen[string(key)] = struct{}{}
+			sels = append(sels, int64(row))
+		}
+		if len(sels) == 0 {
+			continue
+		}
+		bat := batch.New(true, make([]string, len(vecs)))
+		bat.Vecs = vecs
+		bat.Zs = make([]int64, len(sels))
+		for j := range bat.Zs {
+			bat.Zs[j] = 1
+		}
+		for _, vec := range vecs {
+			vector.Shrink(vec, sels)
+		}
+		if err := t.fill(nil, bat); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendKey appends the value of the row to the key which identifies the row
+func appendKey(key []byte, vec *vector.Vector, row int64) ([]byte, error) {
+	if nulls.Contains(vec.Nsp, uint64(row)) {
+		return append(key, 0), nil
+	}
+	key = append(key, 1)
+	switch vs := vec.Col.(type) {
+	case *types.Bytes:
+		key = append(key, encoding.EncodeUint32(vs.Lengths[row])...)
+		return append(key, vs.Get(row)...), nil
+	case []int8:
+		return append(key, encoding.EncodeFixed(vs[row])...), nil
+	case []int16:
+		return append(key, encoding.EncodeFixed(vs[row])...), nil
+	case []int32:
+		return append(key, encoding.EncodeFixed(vs[row])...), nil
+	case []int64:
+		return append(key, encoding.EncodeFixed(vs[row])...), nil
+	case []uint8:
+		return append(key, encoding.EncodeFixed(vs[row])...), nil
+	case []uint16:
+		return append(key, encoding.EncodeFixed(vs[row])...), nil
+	case []uint32:
+		return append(key, encoding.EncodeFixed(vs[row])...), nil
+	case []uint64:
+		return append(key, encoding.EncodeFixed(vs[row])...), nil
+	case []float32:
+		return append(key, encoding.EncodeFixed(vs[row])...), nil
+	case []float64:
+		return append(key, encoding.EncodeFixed(vs[row])...), nil
+	case []types.Date:
+		return append(key, encoding.EncodeFixed(vs[row])...), nil
+	case []types.Datetime:
+		return append(key, encoding.EncodeFixed(vs[row])...), nil
+	case []types.Decimal64:
+		return append(key, encoding.EncodeFixed(vs[row])...), nil
+	case []types.Decimal128:
+		return append(key, encoding.EncodeFixed(vs[row])...), nil
+	}
+	return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("UNION DISTINCT of recursive common table expression does not support the column of type %s", vec.Typ))
+}
+
+func (t *cteTable) append(o *cteTable) {
+	t.rows += o.rows
+	t.segs = append(t.segs, o.segs...)
+	t.zs = append(t.zs, o.zs...)
+}
+
+// empty returns a table without rows but the same attributes
+func (t *cteTable) empty() *cteTable {
+	return &cteTable{
+		attrs: t.attrs,
+		names: t.names,
+	}
+}
+
+func (t *cteTable) clone() *cteTable {
+	o := t.empty()
+	o.append(t)
+	return o
+}
+
+// castTypes are the types of CAST which the column of a recursive cte can be
+var castTypes = map[types.T]tree.InternalType{
+	types.T_int8:     {Family: tree.IntFamily, FamilyString: "tinyint", Oid: uint32(defines.MYSQL_TYPE_TINY)},
+	types.T_int16:    {Family: tree.IntFamily, FamilyString: "smallint", Oid: uint32(defines.MYSQL_TYPE_SHORT)},
+	types.T_int32:    {Family: tree.IntFamily, FamilyString: "int", Oid: uint32(defines.MYSQL_TYPE_LONG)},
+	types.T_int64:    {Family: tree.IntFamily, FamilyString: "bigint", Oid: uint32(defines.MYSQL_TYPE_LONGLONG)},
+	types.T_uint8:    {Family: tree.IntFamily, FamilyString: "tinyint", Unsigned: true, Oid: uint32(defines.MYSQL_TYPE_TINY)},
+	types.T_uint16:   {Family: tree.IntFamily, FamilyString: "smallint", Unsigned: true, Oid: uint32(defines.MYSQL_TYPE_SHORT)},
+	types.T_uint32:   {Family: tree.IntFamily, FamilyString: "int", Unsigned: true, Oid: uint32(defines.MYSQL_TYPE_LONG)},
+	types.T_uint64:   {Family: tree.IntFamily, FamilyString: "bigint", Unsigned: true, Oid: uint32(defines.MYSQL_TYPE_LONGLONG)},
+	types.T_float32:  {Family: tree.FloatFamily, FamilyString: "float", Oid: uint32(defines.MYSQL_TYPE_FLOAT)},
+	types.T_float64:  {Family: tree.FloatFamily, FamilyString: "double", Oid: uint32(defines.MYSQL_TYPE_DOUBLE)},
+	types.T_date:     {Family: tree.DateFamily, FamilyString: "date", Oid: uint32(defines.MYSQL_TYPE_DATE)},
+	types.T_datetime: {Family: tree.TimestampFamily, FamilyString: "datetime", Oid: uint32(defines.MYSQL_TYPE_DATETIME)},
+}
+
+func newCastType(typ types.T) (*tree.T, bool) {
+	internalType, ok := castTypes[typ]
+	if !ok {
+		return nil, false
+	}
+	locale := ""
+	internalType.Locale = &locale
+	return &tree.T{InternalType: internalType}, true
+}
+
+func isString(typ types.T) bool {
+	return typ == types.T_char || typ == types.T_varchar
+}
+
+// snapshot returns an engine which resolves the ctes defined so far
+func (e *cteEngine) snapshot() *cteEngine {
+	tables := make(map[string]*cteTable, len(e.tables))
+	for name, t := range e.tables {
+		tables[name] = t
+	}
+	return &cteEngine{Engine: e.Engine, db: e.db, tables: tables}
+}
+
+// newCompile returns a copy of c which resolves tables by e
+func (e *cteEngine) newCompile(c *compile) *compile {
+	nc := *c
+	nc.e = e
+	return &nc
+}
+
+func (e *cteEngine) Database(name string) (engine.Database, error) {
+	db, err := e.Engine.Database(name)
+	if name != e.db {
+		return db, err
+	}
+	if err != nil {
+		return &cteDatabase{err: err, tables: e.tables}, nil
+	}
+	return &cteDatabase{Database: db, tables: e.tables}, nil
+}
+
+func (d *cteDatabase) Relations() []string {
+	var rs []string
+	if d.Database != nil {
+		rs = d.Database.Relations()
+	}
+	for name := range d.tables {
+		rs = append(rs, name)
+	}
+	return rs
+}
+
+func (d *cteDatabase) Relation(name string) (engine.Relation, error) {
+	if t, ok := d.tables[strings.ToLower(name)]; ok {
+		return &cteRelation{name: name, table: t}, nil
+	}
+	if d.Database == nil {
+		return nil, d.err
+	}
+	return d.Database.Relation(name)
+}
+
+func (d *cteDatabase) Delete(epoch uint64, name string) error {
+	if d.Database == nil {
+		return d.err
+	}
+	return d.Database.Delete(epoch, name)
+}
+
+func (d *cteDatabase) Create(epoch uint64, name string, defs []engine.TableDef) error {
+	if d.Database == nil {
+		return d.err
+	}
+	return d.Database.Create(epoch, name, defs)
+}
+
+func (r *cteRelation) Close() {}
+
+func (r *cteRelation) ID() string {
+	return r.name
+}
+
+func (r *cteRelation) Rows() int64 {
+	return r.table.rows
+}
+
+func (r *cteRelation) Size(_ string) int64 {
+	return 0
+}
+
+// Nodes returns the local node, the rows of cte are only stored at the node running the query
+func (r *cteRelation) Nodes() engine.Nodes {
+	return engine.Nodes{{Id: Address, Addr: Address}}
+}
+
+func (r *cteRelation) TableDefs() []engine.TableDef {
+	defs := make([]engine.TableDef, len(r.table.attrs))
+	for i, attr := range r.table.attrs {
+		defs[i] = &engine.AttributeDef{Attr: attr}
+	}
+	return defs
+}
+
+func (r *cteRelation) GetPriKeyOrHideKey() ([]engine.Attribute, bool) {
+	return nil, false
+}
+
+func (r *cteRelation) Write(_ uint64, _ *batch.Batch) error {
+	return errors.New(errno.FeatureNotSupported, fmt.Sprintf("common table expression '%s' is read only", r.name))
+}
+
+func (r *cteRelation) AddTableDef(_ uint64, _ engine.TableDef) error {
+	return errors.New(errno.FeatureNotSupported, fmt.Sprintf("common table expression '%s' is read only", r.name))
+}
+
+func (r *cteRelation) DelTableDef(_ uint64, _ engine.TableDef) error {
+	return errors.New(errno.FeatureNotSupported, fmt.Sprintf("common table expression '%s' is read only", r.name))
+}
+
+func (r *cteRelation) NewReader(n int, _ extend.Extend, _ []byte) []engine.Reader {
+	rds := make([]*cteReader, n)
+	for i := range rds {
+		rds[i] = &cteReader{table: r.table}
+	}
+	for i := range r.table.segs {
+		rds[i%n].segs = append(rds[i%n].segs, i)
+	}
+	rs := make([]engine.Reader, n)
+	for i := range rds {
+		rs[i] = rds[i]
+	}
+	return rs
+}
+
+func (r *cteReader) Read(cs []uint64, attrs []string) (*batch.Batch, error) {
+	if len(r.segs) == 0 {
+		return nil, nil
+	}
+	seg := r.segs[0]
+	r.segs = r.segs[1:]
+	bat := batch.New(true, attrs)
+	for i, attr := range attrs {
+		pos, ok := r.table.names[attr]
+		if !ok {
+			return nil, errors.New(errno.UndefinedColumn, fmt.Sprintf("Unknown column '%s'", attr))
+		}
+		// the vector references its data, so every reader gets a copy
+		data := make([]byte, len(r.table.segs[seg][pos]))
+		copy(data, r.table.segs[seg][pos])
+		bat.Vecs[i] = vector.New(r.table.attrs[pos].Type)
+		if err := bat.Vecs[i].Read(data); err != nil {
+			return nil, err
+		}
+		bat.Vecs[i].Or = true
+		bat.Vecs[i].Ref = cs[i]
+	}
+	bat.Zs = make([]int64, len(r.table.zs[seg]))
+	copy(bat.Zs, r.table.zs[seg])
+	return bat, nil
+}
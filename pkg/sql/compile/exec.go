@@ -47,11 +47,41 @@ func (e *Exec) Compile(u interface{}, fill func(interface{}, *batch.Batch) error
 		}
 	}()
 
+	// the tables read as of a timestamp are resolved from the snapshots
+	if stmt, ok := e.stmt.(*tree.Select); ok {
+		if err := e.compileAsOf(stmt); err != nil {
+			return err
+		}
+	}
+
+	// build the common table expressions, the query sees them as tables
+	if stmt, ok := e.stmt.(*tree.Select); ok && stmt.With != nil {
+		if err := e.compileWith(stmt.With); err != nil {
+			return err
+		}
+		qry := *stmt
+		qry.With = nil
+		e.stmt = &qry
+	}
+
+	// the window functions of the select list are evaluated before the query,
+	// which reads their results as a table
+	if stmt, ok := e.stmt.(*tree.Select); ok {
+		qry, err := e.compileWindow(stmt)
+		if err != nil {
+			return err
+		}
+		e.stmt = qry
+	}
+
 	// do semantic analysis and build plan for sql
 	// do ast rewrite
 	e.stmt = rewrite.AstRewrite(e.stmt)
 
-	pn, err := plan.New(e.c.db, e.c.sql, e.c.e).BuildStatement(e.stmt)
+	b := plan.New(e.c.db, e.c.sql, e.c.e)
+	b.SetTimeZone(e.c.proc.TimeZone)
+	b.SetParams(e.c.params)
+	pn, err := b.BuildStatement(e.stmt)
 	if err != nil {
 		return err
 	}
@@ -65,6 +95,7 @@ func (e *Exec) Compile(u interface{}, fill func(interface{}, *batch.Batch) error
 			}
 		}
 		e.resultCols = cols
+		e.resultAttrs = attrs
 	}
 	e.u = u
 	e.e = e.c.e
@@ -87,6 +118,15 @@ func (e *Exec) Run(ts uint64) (err error) {
 		}
 	}()
 
+	if err := e.runCTEs(); err != nil {
+		return err
+	}
+	if e.window != nil {
+		if err := e.window.run(e.c); err != nil {
+			return err
+		}
+	}
+
 	if e.scope == nil {
 		return nil
 	}
@@ -119,6 +159,8 @@ func (e *Exec) Run(ts uint64) (err error) {
 		return e.scope.DropTable(ts)
 	case DropIndex:
 		return e.scope.DropIndex(ts)
+	case AlterTable:
+		return e.scope.AlterTable(ts)
 	case ShowDatabases:
 		return e.scope.ShowDatabases(e.u, e.fill)
 	case ShowTables:
@@ -197,6 +239,12 @@ func (e *Exec) compileScope(pn plan.Plan) (*Scope, error) {
 			Plan:  pn,
 			Proc:  e.c.proc,
 		}, nil
+	case *plan.AlterTable:
+		return &Scope{
+			Magic: AlterTable,
+			Plan:  pn,
+			Proc:  e.c.proc,
+		}, nil
 	case *plan.ShowDatabases:
 		return &Scope{
 			Magic: ShowDatabases,
@@ -367,10 +415,8 @@ func (e *Exec) compilePlanScope(s *plan.Scope) (*Scope, error) {
 			Arg: &merge.Argument{},
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -546,10 +592,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructMergeOrder(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -598,10 +642,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructMergeDedup(),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -665,10 +707,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructMergeLimit(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -711,10 +751,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructMergeOffset(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -788,9 +826,7 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 				NodeInfo:   nodes[i],
 				Magic:      Remote,
 			}
-			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
-			ss[i].Proc.Id = e.c.proc.Id
-			ss[i].Proc.Lim = e.c.proc.Lim
+			ss[i].Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Transform,
 				Arg: constructBareTransform(op),
@@ -813,10 +849,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructBareTransformFromDerived(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -868,10 +902,8 @@ func (e *Exec) compileQ(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructResultProjection(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -991,10 +1023,8 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructUntransform(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -1038,9 +1068,7 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 				NodeInfo:   nodes[i],
 				Magic:      Remote,
 			}
-			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
-			ss[i].Proc.Id = e.c.proc.Id
-			ss[i].Proc.Lim = e.c.proc.Lim
+			ss[i].Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Transform,
 				Arg: constructTransform(op),
@@ -1054,10 +1082,8 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 			Arg: &oplus.Argument{Typ: arg.Typ},
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -1093,10 +1119,8 @@ func (e *Exec) compileAQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructTransformFromDerived(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -1180,10 +1204,8 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			Arg: &merge.Argument{},
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -1223,10 +1245,8 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructMergeOrder(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		{
 			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -1262,10 +1282,8 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructMergeDedup(),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		{
 			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -1301,10 +1319,8 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructMergeLimit(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		{
 			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -1340,10 +1356,8 @@ func (e *Exec) compileCQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructMergeOffset(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		{
 			rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
@@ -1508,10 +1522,8 @@ func (e *Exec) compileCAQ(ps *plan.Scope) (*Scope, error) {
 			Arg: constructCAQUntransform(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 		{
 			for i := 0; i < len(ss); i++ {
@@ -1631,9 +1643,7 @@ func (e *Exec) compileFact(ps *plan.Scope) ([]*Scope, error) {
 				NodeInfo:   nodes[i],
 				Magic:      Remote,
 			}
-			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
-			ss[i].Proc.Id = e.c.proc.Id
-			ss[i].Proc.Lim = e.c.proc.Lim
+			ss[i].Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Transform,
 				Arg: constructBareTransform(op),
@@ -1662,10 +1672,8 @@ func (e *Exec) compileFact(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructBareTransformFromDerived(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
@@ -1751,9 +1759,7 @@ func (e *Exec) compileCAQFact(ps *plan.Scope) ([]*Scope, error) {
 				NodeInfo:   nodes[i],
 				Magic:      Remote,
 			}
-			ss[i].Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
-			ss[i].Proc.Id = e.c.proc.Id
-			ss[i].Proc.Lim = e.c.proc.Lim
+			ss[i].Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 			ss[i].Instructions = append(ss[i].Instructions, vm.Instruction{
 				Op:  vm.Transform,
 				Arg: constructCAQTransform(op),
@@ -1782,10 +1788,8 @@ func (e *Exec) compileCAQFact(ps *plan.Scope) ([]*Scope, error) {
 			Arg: constructCAQTransformFromDerived(op),
 		})
 		ctx, cancel := context.WithCancel(context.Background())
-		rs.Proc = process.New(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(e.c.proc.Mp.Gm.Limit, e.c.proc.Mp.Gm.Mmu)), e.c.proc)
 		rs.Proc.Cancel = cancel
-		rs.Proc.Id = e.c.proc.Id
-		rs.Proc.Lim = e.c.proc.Lim
 		rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, 1)
 		rs.Proc.Reg.MergeReceivers[0] = &process.WaitRegister{
 			Ctx: ctx,
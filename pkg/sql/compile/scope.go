@@ -62,6 +62,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
 	"github.com/matrixorigin/matrixone/pkg/vm/pipeline"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
 )
 
 const (
@@ -159,6 +160,25 @@ func (s *Scope) DropIndex(ts uint64) error {
 	return nil
 }
 
+// AlterTable do alter table work according to alter table plan
+func (s *Scope) AlterTable(ts uint64) error {
+	p, _ := s.Plan.(*plan.AlterTable)
+
+	defer p.Relation.Close()
+	for _, act := range p.Actions {
+		var err error
+		if act.Drop {
+			err = p.Relation.DelTableDef(ts, act.Def)
+		} else {
+			err = p.Relation.AddTableDef(ts, act.Def)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ShowDatabases fill batch with all database names
 func (s *Scope) ShowDatabases(u interface{}, fill func(interface{}, *batch.Batch) error) error {
 	p, _ := s.Plan.(*plan.ShowDatabases)
@@ -720,9 +740,7 @@ func (s *Scope) RunQ(e engine.Engine) error {
 				Attributes:   s.DataSource.Attributes,
 			},
 		}
-		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
-		ss[i].Proc.Id = s.Proc.Id
-		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc = process.NewFromProc(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)), s.Proc)
 	}
 	{
 		var flg bool
@@ -858,6 +876,7 @@ func (s *Scope) RunQ(e engine.Engine) error {
 
 // RunAQ run the scope which sql is a query for single table with aggregate functions
 func (s *Scope) RunAQ(e engine.Engine) error {
+	var size int64
 	var rds []engine.Reader
 
 	mcpu := runtime.NumCPU()
@@ -871,6 +890,7 @@ func (s *Scope) RunAQ(e engine.Engine) error {
 			return err
 		}
 		defer rel.Close()
+		size = estimateSize(rel, s.DataSource.Attributes)
 		rds = rel.NewReader(mcpu, getConditionFromInstructions(s.Instructions), s.NodeInfo.Data)
 	}
 	ss := make([]*Scope, mcpu)
@@ -888,9 +908,13 @@ func (s *Scope) RunAQ(e engine.Engine) error {
 			},
 		}
 		ss[i].Instructions = append(ss[i].Instructions, dupInstruction(s.Instructions[0]))
-		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
-		ss[i].Proc.Id = s.Proc.Id
-		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc = process.NewFromProc(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)), s.Proc)
+	}
+	// the groups may not fit in memory, aggregate the partitions of the relation in turn
+	if arg.Typ == transform.FreeVarsAndBoundVars && spill.Exceeded(s.Proc.Lim.Size, size) {
+		r := s.newAggregationReader(e, ss)
+		defer r.close()
+		ss = s.newSpillScopes(r)
 	}
 	if len(ss) > 3 {
 		ss = newMergeScope(ss, arg.Typ, s.Proc)
@@ -927,6 +951,7 @@ func (s *Scope) RunAQ(e engine.Engine) error {
 // RunCQ run the scope which sql is a query for conjunctive query
 func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 	var err error
+	var sv *spillView
 	var bats []*batch.Batch
 	var rds []engine.Reader
 
@@ -1016,20 +1041,19 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 				if len(bat.Zs) == 0 {
 					continue
 				}
-				if bats[i] == nil {
-					bats[i] = bat
-				} else {
-					if bats[i], err = bats[i].Append(s.Proc.Mp, bat); err != nil {
-						for i := range bats {
-							if bats[i] != nil {
-								batch.Clean(bats[i], s.Proc.Mp)
-							}
+				if sv, err = s.appendView(sv, bats, i, bat, op.Vars[i]); err != nil {
+					for i := range bats {
+						if bats[i] != nil {
+							batch.Clean(bats[i], s.Proc.Mp)
 						}
-						return err
 					}
+					if sv != nil {
+						sv.parts.Close()
+					}
+					return err
 				}
 			}
-			if bats[i] == nil {
+			if bats[i] == nil && (sv == nil || sv.idx != i) {
 				flg = true
 			}
 		}
@@ -1049,6 +1073,9 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 					batch.Clean(bats[i], s.Proc.Mp)
 				}
 			}
+			if sv != nil {
+				sv.parts.Close()
+			}
 			return nil
 		}
 		constructViews(bats, op.Vars)
@@ -1059,6 +1086,9 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 					batch.Clean(bats[i], s.Proc.Mp)
 				}
 			}
+			if sv != nil {
+				sv.parts.Close()
+			}
 		}()
 	}
 	mcpu := runtime.NumCPU()
@@ -1087,9 +1117,7 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 				Attributes:   s.DataSource.Attributes,
 			},
 		}
-		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
-		ss[i].Proc.Id = s.Proc.Id
-		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc = process.NewFromProc(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)), s.Proc)
 		{
 			for _, in := range s.Instructions {
 				ss[i].Instructions = append(ss[i].Instructions, dupInstruction(in))
@@ -1099,6 +1127,11 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 			}
 		}
 	}
+	if sv != nil { // the partitioned dimension table is joined by grace hash join
+		r := s.newGraceReader(e, ss, sv, bats, op.Vars[sv.idx])
+		defer r.close()
+		ss = []*Scope{s.newSpillScope(r)}
+	}
 	{
 		j := 0
 		for k, in := range s.Instructions {
@@ -1117,10 +1150,8 @@ func (s *Scope) RunCQ(e engine.Engine, op *join.Argument) error {
 	})
 	rs.Instructions = append(rs.Instructions, s.Instructions...)
 	ctx, cancel := context.WithCancel(context.Background())
-	rs.Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
+	rs.Proc = process.NewFromProc(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)), s.Proc)
 	rs.Proc.Cancel = cancel
-	rs.Proc.Id = s.Proc.Id
-	rs.Proc.Lim = s.Proc.Lim
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
 		for i := 0; i < len(ss); i++ {
@@ -1150,7 +1181,7 @@ func (s *Scope) RunCQWithSubquery(e engine.Engine, op *join.Argument) error {
 	{ // fill batchs
 		rs := new(Scope)
 		bats = make([]*batch.Batch, len(op.Vars))
-		rs.Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)), s.Proc)
 		rs.PreScopes = s.PreScopes[1:]
 		ctx, cancel := context.WithCancel(context.Background())
 		rs.Proc.Cancel = cancel
@@ -1289,6 +1320,7 @@ func (s *Scope) RunCQWithSubquery(e engine.Engine, op *join.Argument) error {
 // RunCAQ run the scope which sql is a query for conjunctive aggregation query
 func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 	var err error
+	var sv *spillView
 	var bats []*batch.Batch
 	var rds []engine.Reader
 
@@ -1379,20 +1411,19 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 				if len(bat.Zs) == 0 {
 					continue
 				}
-				if bats[i] == nil {
-					bats[i] = bat
-				} else {
-					if bats[i], err = bats[i].Append(s.Proc.Mp, bat); err != nil {
-						for i := range bats {
-							if bats[i] != nil {
-								batch.Clean(bats[i], s.Proc.Mp)
-							}
+				if sv, err = s.appendView(sv, bats, i, bat, op.Vars[i]); err != nil {
+					for i := range bats {
+						if bats[i] != nil {
+							batch.Clean(bats[i], s.Proc.Mp)
 						}
-						return err
 					}
+					if sv != nil {
+						sv.parts.Close()
+					}
+					return err
 				}
 			}
-			if bats[i] == nil {
+			if bats[i] == nil && (sv == nil || sv.idx != i) {
 				flg = true
 			}
 		}
@@ -1412,6 +1443,9 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 					batch.Clean(bats[i], s.Proc.Mp)
 				}
 			}
+			if sv != nil {
+				sv.parts.Close()
+			}
 			return nil
 		}
 		constructViews(bats, op.Vars)
@@ -1422,6 +1456,9 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 					batch.Clean(bats[i], s.Proc.Mp)
 				}
 			}
+			if sv != nil {
+				sv.parts.Close()
+			}
 		}()
 	}
 	mcpu := runtime.NumCPU()
@@ -1450,9 +1487,7 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 				Attributes:   s.DataSource.Attributes,
 			},
 		}
-		ss[i].Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
-		ss[i].Proc.Id = s.Proc.Id
-		ss[i].Proc.Lim = s.Proc.Lim
+		ss[i].Proc = process.NewFromProc(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)), s.Proc)
 		{
 			for _, in := range s.Instructions {
 				ss[i].Instructions = append(ss[i].Instructions, dupInstruction(in))
@@ -1462,6 +1497,11 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 			}
 		}
 	}
+	if sv != nil { // the partitioned dimension table is joined by grace hash join
+		r := s.newGraceReader(e, ss, sv, bats, op.Vars[sv.idx])
+		defer r.close()
+		ss = s.newSpillScopes(r)
+	}
 	if len(ss) > 3 {
 		if len(op.Result) == 0 {
 			ss = newMergeScope(ss, plus.BoundVars, s.Proc)
@@ -1499,10 +1539,8 @@ func (s *Scope) RunCAQ(e engine.Engine, op *times.Argument) error {
 	}
 	rs.Instructions = append(rs.Instructions, s.Instructions...)
 	ctx, cancel := context.WithCancel(context.Background())
-	rs.Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
+	rs.Proc = process.NewFromProc(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)), s.Proc)
 	rs.Proc.Cancel = cancel
-	rs.Proc.Id = s.Proc.Id
-	rs.Proc.Lim = s.Proc.Lim
 	rs.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, len(ss))
 	{
 		for i := 0; i < len(ss); i++ {
@@ -1532,7 +1570,7 @@ func (s *Scope) RunCAQWithSubquery(e engine.Engine, op *times.Argument) error {
 	{ // fill batchs
 		rs := new(Scope)
 		bats = make([]*batch.Batch, len(op.Vars))
-		rs.Proc = process.New(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)))
+		rs.Proc = process.NewFromProc(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)), s.Proc)
 		rs.PreScopes = s.PreScopes[1:]
 		ctx, cancel := context.WithCancel(context.Background())
 		rs.Proc.Cancel = cancel
@@ -1693,11 +1731,9 @@ func newMergeScope(ss []*Scope, typ int, proc *process.Process) []*Scope {
 		{
 			m := len(rs[i].PreScopes)
 			ctx, cancel := context.WithCancel(context.Background())
-			rs[i].Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
+			rs[i].Proc = process.NewFromProc(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)), proc)
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Cancel = cancel
-			rs[i].Proc.Id = proc.Id
-			rs[i].Proc.Lim = proc.Lim
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1747,11 +1783,9 @@ func newMergeOrderScope(ss []*Scope, arg *mergeorder.Argument, proc *process.Pro
 		{
 			m := len(rs[i].PreScopes)
 			ctx, cancel := context.WithCancel(context.Background())
-			rs[i].Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
+			rs[i].Proc = process.NewFromProc(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)), proc)
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Cancel = cancel
-			rs[i].Proc.Id = proc.Id
-			rs[i].Proc.Lim = proc.Lim
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1801,11 +1835,9 @@ func newMergeLimitScope(ss []*Scope, limit uint64, proc *process.Process) []*Sco
 		{
 			m := len(rs[i].PreScopes)
 			ctx, cancel := context.WithCancel(context.Background())
-			rs[i].Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
+			rs[i].Proc = process.NewFromProc(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)), proc)
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Cancel = cancel
-			rs[i].Proc.Id = proc.Id
-			rs[i].Proc.Lim = proc.Lim
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1858,11 +1890,9 @@ func newMergeTopScope(ss []*Scope, arg *mergetop.Argument, proc *process.Process
 		{
 			m := len(rs[i].PreScopes)
 			ctx, cancel := context.WithCancel(context.Background())
-			rs[i].Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
+			rs[i].Proc = process.NewFromProc(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)), proc)
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Cancel = cancel
-			rs[i].Proc.Id = proc.Id
-			rs[i].Proc.Lim = proc.Lim
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -1912,11 +1942,9 @@ func newMergeDedupScope(ss []*Scope, proc *process.Process) []*Scope {
 		{
 			m := len(rs[i].PreScopes)
 			ctx, cancel := context.WithCancel(context.Background())
-			rs[i].Proc = process.New(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)))
+			rs[i].Proc = process.NewFromProc(mheap.New(guest.New(proc.Mp.Gm.Limit, proc.Mp.Gm.Mmu)), proc)
 			rs[i].Proc.Cancel = cancel
 			rs[i].Proc.Cancel = cancel
-			rs[i].Proc.Id = proc.Id
-			rs[i].Proc.Lim = proc.Lim
 			rs[i].Proc.Reg.MergeReceivers = make([]*process.WaitRegister, m)
 			{
 				for j := 0; j < m; j++ {
@@ -0,0 +1,512 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/ring"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/connector"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/join"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/times"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transform"
+	"github.com/matrixorigin/matrixone/pkg/vm"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+	"github.com/matrixorigin/matrixone/pkg/vm/spill"
+)
+
+// ringAttrPrefix is the prefix of the attributes which store the vectors of the rings.
+const ringAttrPrefix = "__mo_ring_"
+
+// spillView is a dimension table whose rows are partitioned to disk, because the
+// memory budget of the query is reached while the dimension tables are read.
+type spillView struct {
+	idx   int               // index of the dimension table
+	parts *spill.Partitions // partitions of the dimension table
+}
+
+// spillReader runs an instruction on the partitions of the rows produced by its
+// scopes in turn, so only one partition is kept in memory at a time.
+//
+//	For grace hash join, the instruction is join or times and the rows of the fact
+//	table are partitioned by the same attributes as the partitioned dimension table.
+//	For hash aggregation, the instruction is transform and the rows are partitioned
+//	by the group by attributes.
+type spillReader struct {
+	e     engine.Engine
+	ss    []*Scope // scopes produce the rows to be partitioned
+	proc  *process.Process
+	ins   vm.Instructions   // instructions run on each partition
+	attrs []string          // attributes used to partition the rows
+	parts *spill.Partitions // partitions of the rows produced by ss
+
+	sv   *spillView     // partitioned dimension table, only for grace hash join
+	bats []*batch.Batch // dimension tables, only for grace hash join
+
+	as   []string    // aliases of the rings, only for times
+	refs []uint64    // reference counts of the rings, only for times
+	rs   []ring.Ring // rings attached to the rows, only for times
+
+	i    int             // index of the next partition to be opened
+	cur  int             // index of the partition being read by Read
+	r    *spill.Reader   // reader of the partition being processed
+	pins vm.Instructions // instructions of the partition being processed
+	view *batch.Batch    // partition of the dimension table in memory
+	err  error           // error occurred while processing the partitions
+	end  bool            // true: all partitions are processed
+}
+
+// spillPartReader reads the results of a partition of a spillReader,
+// it waits until the previous partition is processed.
+type spillPartReader struct {
+	r    *spillReader
+	i    int           // index of the partition
+	wait chan struct{} // closed when the previous partition is processed
+	done chan struct{} // closed when the partition is processed
+	end  bool
+}
+
+// appendView appends a batch to the i-th dimension table. If the memory budget is reached,
+// the dimension table is partitioned to disk, and then all its rows are written to the partitions.
+// Only one dimension table is partitioned and the others are always kept in memory.
+func (s *Scope) appendView(sv *spillView, bats []*batch.Batch, i int, bat *batch.Batch, vars []string) (*spillView, error) {
+	var err error
+
+	if sv != nil && sv.idx == i {
+		defer batch.Clean(bat, s.Proc.Mp)
+		if err = batch.Shuffle(bat, s.Proc.Mp); err != nil {
+			return sv, err
+		}
+		return sv, writePartitions(sv.parts, bat, s.Proc)
+	}
+	if bats[i] == nil {
+		bats[i] = bat
+	} else if bats[i], err = bats[i].Append(s.Proc.Mp, bat); err != nil {
+		return sv, err
+	}
+	if sv != nil || !spill.Exceeded(s.Proc.Lim.Size, viewsSize(bats)) {
+		return sv, nil
+	}
+	parts, err := spill.NewPartitions(spill.PartitionCount, vars)
+	if err != nil {
+		return sv, err
+	}
+	sv = &spillView{idx: i, parts: parts}
+	bat, bats[i] = bats[i], nil
+	defer batch.Clean(bat, s.Proc.Mp)
+	if err = batch.Shuffle(bat, s.Proc.Mp); err != nil {
+		return sv, err
+	}
+	return sv, writePartitions(sv.parts, bat, s.Proc)
+}
+
+// writePartitions writes a batch to the partitions and counts the spilled bytes into the process.
+func writePartitions(parts *spill.Partitions, bat *batch.Batch, proc *process.Process) error {
+	size := parts.Size()
+	err := parts.Write(bat, proc.Mp)
+	proc.SpillSize += parts.Size() - size
+	return err
+}
+
+// newGraceReader returns a reader which joins the rows read by ss with the dimension tables
+// by grace hash join, ss are the scopes whose last instruction is join or times.
+func (s *Scope) newGraceReader(e engine.Engine, ss []*Scope, sv *spillView, bats []*batch.Batch, vars []string) *spillReader {
+	n := len(ss[0].Instructions)
+	in := ss[0].Instructions[n-1]
+	for i := range ss {
+		ss[i].Instructions = ss[i].Instructions[:n-1]
+	}
+	return &spillReader{
+		e:     e,
+		ss:    ss,
+		proc:  s.newSpillProcess(),
+		ins:   vm.Instructions{in},
+		sv:    sv,
+		bats:  bats,
+		attrs: vars,
+	}
+}
+
+// newAggregationReader returns a reader which aggregates the rows read by ss by partitioned
+// hash aggregation, ss are the scopes whose only instruction is transform.
+func (s *Scope) newAggregationReader(e engine.Engine, ss []*Scope) *spillReader {
+	_, in := splitTransform(ss[0].Instructions[0])
+	for i := range ss {
+		ss[i].Instructions[0], _ = splitTransform(ss[i].Instructions[0])
+	}
+	return &spillReader{
+		e:     e,
+		ss:    ss,
+		proc:  s.newSpillProcess(),
+		ins:   vm.Instructions{in},
+		attrs: in.Arg.(*transform.Argument).FreeVars,
+	}
+}
+
+// splitTransform splits a transform into the preprocessing part and the aggregation part,
+// so the rows can be partitioned between them.
+func splitTransform(in vm.Instruction) (vm.Instruction, vm.Instruction) {
+	arg := in.Arg.(*transform.Argument)
+	return vm.Instruction{
+		Op: vm.Transform,
+		Arg: &transform.Argument{
+			Typ:        transform.Bare,
+			Restrict:   arg.Restrict,
+			Projection: arg.Projection,
+		},
+	}, vm.Instruction{
+		Op: vm.Transform,
+		Arg: &transform.Argument{
+			Typ:       arg.Typ,
+			IsMerge:   arg.IsMerge,
+			FreeVars:  arg.FreeVars,
+			BoundVars: arg.BoundVars,
+		},
+	}
+}
+
+// newSpillScope returns a scope which reads the results of all the partitions of r.
+func (s *Scope) newSpillScope(r *spillReader) *Scope {
+	return &Scope{
+		Magic:      Normal,
+		DataSource: &Source{R: r},
+		Proc:       s.newSpillProcess(),
+	}
+}
+
+// newSpillScopes returns a scope for each partition of r. Operators such as plus expect
+// a single batch of results from each scope, so the results of each partition are read
+// by its own scope, and the partitions are still processed in turn.
+func (s *Scope) newSpillScopes(r *spillReader) []*Scope {
+	ss := make([]*Scope, spill.PartitionCount)
+	wait := make(chan struct{})
+	close(wait)
+	for i := range ss {
+		done := make(chan struct{})
+		ss[i] = &Scope{
+			Magic: Normal,
+			DataSource: &Source{
+				R: &spillPartReader{r: r, i: i, wait: wait, done: done},
+			},
+			Proc: s.newSpillProcess(),
+		}
+		wait = done
+	}
+	return ss
+}
+
+func (s *Scope) newSpillProcess() *process.Process {
+	return process.NewFromProc(mheap.New(guest.New(s.Proc.Mp.Gm.Limit, s.Proc.Mp.Gm.Mmu)), s.Proc)
+}
+
+// Read returns the next batch of results, it returns nil if all partitions are processed.
+func (r *spillReader) Read(_ []uint64, _ []string) (*batch.Batch, error) {
+	for !r.end {
+		bat, err := r.read(r.cur)
+		if err != nil {
+			r.close()
+			return nil, err
+		}
+		if bat != nil {
+			return bat, nil
+		}
+		if r.cur++; r.cur == spill.PartitionCount {
+			r.close()
+		}
+	}
+	return nil, nil
+}
+
+// Read returns the next batch of results of the partition, it returns nil if the partition is processed.
+func (p *spillPartReader) Read(_ []uint64, _ []string) (*batch.Batch, error) {
+	if p.end {
+		return nil, nil
+	}
+	<-p.wait
+	bat, err := p.r.read(p.i)
+	if err != nil || bat == nil {
+		p.end = true
+		close(p.done)
+	}
+	return bat, err
+}
+
+// read returns the next batch of results of the i-th partition, it returns nil if the partition is processed.
+func (r *spillReader) read(i int) (*batch.Batch, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	bat, err := r.readPartition(i)
+	if err != nil {
+		r.err = err
+		r.release()
+	}
+	return bat, err
+}
+
+func (r *spillReader) readPartition(i int) (*batch.Batch, error) {
+	if r.parts == nil {
+		if err := r.partition(); err != nil {
+			return nil, err
+		}
+	}
+	if r.i <= i {
+		r.i = i + 1
+		if err := r.open(i); err != nil {
+			return nil, err
+		}
+	}
+	for r.r != nil {
+		bat, err := r.r.Read(r.proc.Mp)
+		if err != nil {
+			return nil, err
+		}
+		vecs := r.attach(bat)
+		r.proc.Reg.InputBatch = bat
+		_, err = vm.Run(r.pins, r.proc)
+		for _, vec := range vecs {
+			vector.Clean(vec, r.proc.Mp)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if bat == nil { // end of the partition
+			r.release()
+		}
+		if rbat := r.proc.Reg.InputBatch; rbat != nil && len(rbat.Zs) > 0 {
+			return rbat, nil
+		}
+	}
+	return nil, nil
+}
+
+// partition runs the scopes and writes their results to the partitions.
+func (r *spillReader) partition() error {
+	var err error
+
+	if r.parts, err = spill.NewPartitions(spill.PartitionCount, r.attrs); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errs := make(chan error, len(r.ss))
+	regs := make([]*process.WaitRegister, len(r.ss))
+	for i, s := range r.ss {
+		regs[i] = &process.WaitRegister{
+			Ctx: ctx,
+			Ch:  make(chan *batch.Batch, 1),
+		}
+		s.Instructions = append(s.Instructions, vm.Instruction{
+			Op: vm.Connector,
+			Arg: &connector.Argument{
+				Mmu: r.proc.Mp.Gm,
+				Reg: regs[i],
+			},
+		})
+		go func(s *Scope) {
+			errs <- s.Run(r.e)
+		}(s)
+	}
+	for _, reg := range regs {
+		for {
+			bat := <-reg.Ch
+			if bat == nil {
+				break
+			}
+			if len(bat.Zs) == 0 {
+				continue
+			}
+			err = r.write(bat)
+			batch.Clean(bat, r.proc.Mp)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	cancel()
+	for range r.ss {
+		if err := <-errs; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// write writes a batch to the partitions. The rings attached to the batch for times are empty
+// and only describe the aggregations, so they are kept in memory and the vectors to be aggregated
+// are written as extra attributes.
+func (r *spillReader) write(bat *batch.Batch) error {
+	if err := batch.Shuffle(bat, r.proc.Mp); err != nil {
+		return err
+	}
+	vecs, ok := bat.Ht.([]*vector.Vector)
+	if !ok {
+		return writePartitions(r.parts, bat, r.proc)
+	}
+	if r.rs == nil {
+		r.as, r.refs = bat.As, bat.Refs
+		for _, rg := range bat.Rs {
+			r.rs = append(r.rs, rg.Dup())
+		}
+	}
+	attrs, bvecs := bat.Attrs, bat.Vecs
+	bat.Attrs = make([]string, 0, len(attrs)+len(vecs))
+	bat.Attrs = append(bat.Attrs, attrs...)
+	for i := range vecs {
+		bat.Attrs = append(bat.Attrs, ringAttrPrefix+strconv.Itoa(i))
+	}
+	bat.Vecs = make([]*vector.Vector, 0, len(bvecs)+len(vecs))
+	bat.Vecs = append(append(bat.Vecs, bvecs...), vecs...)
+	err := writePartitions(r.parts, bat, r.proc)
+	bat.Attrs, bat.Vecs = attrs, bvecs
+	return err
+}
+
+// attach restores the rings of a batch read from the partitions, and returns the vectors to be aggregated.
+func (r *spillReader) attach(bat *batch.Batch) []*vector.Vector {
+	if bat == nil || r.rs == nil {
+		return nil
+	}
+	n := len(bat.Vecs) - len(r.rs)
+	vecs := bat.Vecs[n:]
+	bat.Attrs, bat.Vecs = bat.Attrs[:n], bat.Vecs[:n]
+	bat.As, bat.Refs, bat.Ht = r.as, r.refs, vecs
+	bat.Rs = make([]ring.Ring, len(r.rs))
+	for i, rg := range r.rs {
+		bat.Rs[i] = rg.Dup()
+	}
+	return vecs
+}
+
+// open prepares to process the i-th partition, an empty partition is skipped.
+func (r *spillReader) open(i int) error {
+	var err error
+
+	if r.parts.File(i).Rows() == 0 {
+		return nil
+	}
+	r.pins = make(vm.Instructions, len(r.ins))
+	for j, in := range r.ins {
+		r.pins[j] = dupInstruction(in)
+	}
+	if r.sv != nil {
+		if r.sv.parts.File(i).Rows() == 0 {
+			return nil
+		}
+		if r.view, err = loadPartition(r.sv.parts.File(i), r.proc); err != nil {
+			return err
+		}
+		constructView(r.view, r.attrs)
+		bats := make([]*batch.Batch, len(r.bats))
+		copy(bats, r.bats)
+		bats[r.sv.idx] = r.view
+		switch arg := r.pins[len(r.pins)-1].Arg.(type) {
+		case *join.Argument:
+			arg.Bats = bats
+		case *times.Argument:
+			arg.Bats = bats
+		}
+	}
+	if err = vm.Prepare(r.pins, r.proc); err != nil {
+		return err
+	}
+	r.r, err = r.parts.File(i).NewReader()
+	return err
+}
+
+// release releases the partition being processed.
+func (r *spillReader) release() {
+	if r.r != nil {
+		r.r.Close()
+		r.r = nil
+	}
+	if r.view != nil {
+		batch.Clean(r.view, r.proc.Mp)
+		r.view = nil
+	}
+	r.pins = nil
+}
+
+// close removes all the partitions of the reader.
+func (r *spillReader) close() {
+	r.release()
+	if r.parts != nil {
+		r.parts.Close()
+	}
+	r.end = true
+}
+
+// loadPartition reads all the rows of a partition into a batch.
+func loadPartition(f *spill.File, proc *process.Process) (*batch.Batch, error) {
+	var bat *batch.Batch
+
+	rd, err := f.NewReader()
+	if err != nil {
+		return nil, err
+	}
+	defer rd.Close()
+	for {
+		b, err := rd.Read(proc.Mp)
+		if err != nil {
+			if bat != nil {
+				batch.Clean(bat, proc.Mp)
+			}
+			return nil, err
+		}
+		if b == nil {
+			return bat, nil
+		}
+		if bat == nil {
+			bat = b
+			continue
+		}
+		bat, err = bat.Append(proc.Mp, b)
+		batch.Clean(b, proc.Mp)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// viewsSize returns the memory size of the dimension tables.
+func viewsSize(bats []*batch.Batch) int64 {
+	var size int64
+
+	for _, bat := range bats {
+		if bat != nil {
+			size += spill.Size(bat.Vecs)
+		}
+	}
+	return size
+}
+
+// estimateSize estimates the memory size of the attributes of a relation.
+func estimateSize(rel engine.Relation, attrs []string) int64 {
+	var size int64
+
+	for _, attr := range attrs {
+		size += rel.Size(attr)
+	}
+	if size == 0 { // some engines don't record the size of attributes
+		size = rel.Rows() * int64(len(attrs)) * 8
+	}
+	return size
+}
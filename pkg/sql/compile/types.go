@@ -37,6 +37,7 @@ const (
 	DropDatabase
 	DropTable
 	DropIndex
+	AlterTable
 	ShowDatabases
 	ShowTables
 	ShowColumns
@@ -57,6 +58,9 @@ const (
 // Address is the ip:port of local node
 var Address string
 
+// MaxRecursionDepth is the maximum number of iterations of a recursive common table expression
+var MaxRecursionDepth int64 = 1000
+
 // Source contains information of a relation which will be used in execution,
 type Source struct {
 	IsMerge      bool
@@ -101,8 +105,14 @@ type Exec struct {
 	//	err error
 	//resultCols stores the column information of result.
 	resultCols []*Col
-	scope      *Scope
-	c          *compile
+	//resultAttrs stores the attributes of result with the complete types.
+	resultAttrs []*plan.Attribute
+	//ctes stores the common table expressions which are materialized before the query runs.
+	ctes []*cte
+	//window evaluates the window functions of the select list before the query runs.
+	window *windowQuery
+	scope  *Scope
+	c      *compile
 	//affectRows stores the number of rows affected while insert / update / delete
 	affectRows uint64
 	//e is a db engine instance
@@ -127,4 +137,6 @@ type compile struct {
 	e engine.Engine
 	// proc stores the execution context.
 	proc *process.Process
+	// params values bound to the placeholders of the prepared statement.
+	params []tree.Expr
 }
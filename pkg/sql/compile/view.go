@@ -40,9 +40,13 @@ func init() {
 	}
 }
 
+// constructViews creates hashtables for the dimension tables, the dimension table
+// partitioned to disk is nil and its hashtables are created partition by partition
 func constructViews(bats []*batch.Batch, vars [][]string) {
 	for i := range vars {
-		constructView(bats[i], vars[i])
+		if bats[i] != nil {
+			constructView(bats[i], vars[i])
+		}
 	}
 }
 
@@ -0,0 +1,542 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile
+
+import (
+	"fmt"
+	"go/constant"
+	"strings"
+
+	compare "github.com/matrixorigin/matrixone/pkg/compare2"
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	order "github.com/matrixorigin/matrixone/pkg/sql/colexec2/order"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/window"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+// windowTableName is the table which stores the rows of the query and the
+// results of its window functions.
+const windowTableName = "__mo_window"
+
+// windowQuery evaluates the window functions of the select list. The query
+// without the window functions is materialized in the order of the partition by
+// and order by attributes, the window operator appends the results of the
+// functions batch by batch, and the select list reads them as a table.
+type windowQuery struct {
+	// exec is the query without the window functions
+	exec *Exec
+	// input stores the rows of exec
+	input *cteTable
+	// table stores the rows of exec and the results of the window functions
+	table *cteTable
+	arg   *window.Argument
+}
+
+var windowFuncTypes = map[string]window.FuncType{
+	"row_number":  window.RowNumber,
+	"rank":        window.Rank,
+	"dense_rank":  window.DenseRank,
+	"lag":         window.Lag,
+	"lead":        window.Lead,
+	"first_value": window.FirstValue,
+	"last_value":  window.LastValue,
+	"sum":         window.Sum,
+	"avg":         window.Avg,
+	"count":       window.Count,
+	"min":         window.Min,
+	"max":         window.Max,
+}
+
+// compileWindow compiles the query of the window functions in the select list,
+// and returns the statement which reads their results. The statement is
+// returned as it is if it has no window function.
+func (e *Exec) compileWindow(stmt *tree.Select) (*tree.Select, error) {
+	clause, ok := stmt.Select.(*tree.SelectClause)
+	if !ok {
+		return stmt, nil
+	}
+	var spec *tree.WindowSpec
+	var funcs []*tree.FuncExpr
+	stars := false
+	for _, expr := range clause.Exprs {
+		if _, ok := expr.Expr.(tree.UnqualifiedStar); ok {
+			stars = true
+		}
+		f, ok := expr.Expr.(*tree.FuncExpr)
+		if !ok || f.WindowSpec == nil {
+			continue
+		}
+		if spec == nil {
+			spec = f.WindowSpec
+		} else if tree.String(spec, dialect.MYSQL) != tree.String(f.WindowSpec, dialect.MYSQL) {
+			return nil, errors.New(errno.FeatureNotSupported, "window functions with different windows are not supported now")
+		}
+		funcs = append(funcs, f)
+	}
+	if spec == nil {
+		return stmt, nil
+	}
+	ce := e.cteEngine()
+	items := clause.Exprs
+	if stars {
+		var err error
+		if items, err = e.expandStar(ce, clause); err != nil {
+			return nil, err
+		}
+	}
+
+	// the query projects the items without window functions, the arguments of
+	// the functions, the partition by and order by attributes, and the order by
+	// attributes of the statement. It is sorted by the partition by and order by
+	// attributes. Every expression is projected once, because the vectors of an
+	// attribute projected twice are shared and sorted twice.
+	w := &windowQuery{arg: &window.Argument{}}
+	inner := &tree.SelectClause{
+		From:    clause.From,
+		Where:   clause.Where,
+		GroupBy: clause.GroupBy,
+		Having:  clause.Having,
+	}
+	names := make(map[string]string)
+	column := func(expr tree.Expr, prefix string, i int) *tree.UnresolvedName {
+		key := tree.String(expr, dialect.MYSQL)
+		if name, ok := names[key]; ok {
+			return tree.SetUnresolvedName(name)
+		}
+		name := fmt.Sprintf("%s%d", prefix, i)
+		names[key] = name
+		inner.Exprs = append(inner.Exprs, tree.SelectExpr{Expr: expr, As: tree.UnrestrictedIdentifier(name)})
+		return tree.SetUnresolvedName(name)
+	}
+
+	// the statement reads the items from the table
+	outer := &tree.SelectClause{
+		Distinct: clause.Distinct,
+		From: &tree.From{Tables: tree.TableExprs{
+			&tree.AliasedTableExpr{Expr: tree.NewTableName(windowTableName, tree.ObjectNamePrefix{})},
+		}},
+	}
+	for i, expr := range items {
+		as := expr.As
+		if len(as) == 0 {
+			as = tree.UnrestrictedIdentifier(tree.String(expr.Expr, dialect.MYSQL))
+		}
+		if f, ok := expr.Expr.(*tree.FuncExpr); ok && f.WindowSpec != nil {
+			name := fmt.Sprintf("__w%d", len(w.arg.Funcs))
+			outer.Exprs = append(outer.Exprs, tree.SelectExpr{Expr: tree.SetUnresolvedName(name), As: as})
+			w.arg.Funcs = append(w.arg.Funcs, window.Func{})
+			continue
+		}
+		outer.Exprs = append(outer.Exprs, tree.SelectExpr{Expr: column(expr.Expr, "__c", i), As: as})
+	}
+
+	var args []string
+	for i, f := range funcs {
+		typ, err := windowFuncType(f)
+		if err != nil {
+			return nil, err
+		}
+		fn := window.Func{Type: typ, Pos: -1, Offset: 1}
+		arg := ""
+		switch {
+		case typ == window.RowNumber || typ == window.Rank || typ == window.DenseRank:
+			if len(f.Exprs) != 0 {
+				return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Incorrect parameter count in the call to native function '%s'", tree.String(&f.Func, dialect.MYSQL)))
+			}
+		case typ == window.Count && isCountStar(f):
+		case typ == window.Lag || typ == window.Lead:
+			if len(f.Exprs) < 1 || len(f.Exprs) > 2 {
+				return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("window function '%s' only supports the expression and the offset now", tree.String(f, dialect.MYSQL)))
+			}
+			if len(f.Exprs) == 2 {
+				offset, ok := windowOffset(f.Exprs[1])
+				if !ok || offset != float64(int64(offset)) {
+					return nil, errors.New(errno.WindowingError, fmt.Sprintf("offset of %s must be a non-negative integer constant", tree.String(&f.Func, dialect.MYSQL)))
+				}
+				fn.Offset = int64(offset)
+			}
+			arg = column(f.Exprs[0], "__a", i).Parts[0]
+		default:
+			if len(f.Exprs) != 1 {
+				return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Incorrect parameter count in the call to native function '%s'", tree.String(&f.Func, dialect.MYSQL)))
+			}
+			arg = column(f.Exprs[0], "__a", i).Parts[0]
+		}
+		w.arg.Funcs[i] = fn
+		args = append(args, arg)
+	}
+	var orderBy tree.OrderBy
+	var keys []string
+	for i, expr := range spec.PartitionBy {
+		name := column(expr, "__p", i)
+		keys = append(keys, name.Parts[0])
+		orderBy = append(orderBy, &tree.Order{Expr: name})
+	}
+	for i, o := range spec.OrderBy {
+		name := column(o.Expr, "__s", i)
+		keys = append(keys, name.Parts[0])
+		orderBy = append(orderBy, &tree.Order{Expr: name, Direction: o.Direction})
+	}
+	frame, err := windowFrame(spec)
+	if err != nil {
+		return nil, err
+	}
+	w.arg.Frame = frame
+
+	// the order by attributes of the statement are the items or read from the query
+	var outerOrderBy tree.OrderBy
+	for i, o := range stmt.OrderBy {
+		if f, ok := o.Expr.(*tree.FuncExpr); ok && f.WindowSpec != nil {
+			return nil, errors.New(errno.FeatureNotSupported, "window function in ORDER BY is not supported now")
+		}
+		if isWindowItem(items, tree.String(o.Expr, dialect.MYSQL)) {
+			outerOrderBy = append(outerOrderBy, o)
+			continue
+		}
+		outerOrderBy = append(outerOrderBy, &tree.Order{Expr: column(o.Expr, "__o", i), Direction: o.Direction})
+	}
+
+	w.exec = &Exec{c: ce.snapshot().newCompile(e.c), stmt: &tree.Select{Select: inner, OrderBy: orderBy}}
+	w.input = &cteTable{names: make(map[string]int)}
+	if err := w.exec.Compile(nil, w.input.fill); err != nil {
+		return nil, err
+	}
+	attrs := w.exec.resultAttrs
+	for i, attr := range attrs {
+		w.input.names[attr.Name] = i
+		w.input.attrs = append(w.input.attrs, engine.Attribute{Name: attr.Name, Type: attr.Type})
+	}
+	for i, arg := range args {
+		if len(arg) > 0 {
+			w.arg.Funcs[i].Pos = int32(w.input.names[arg])
+		}
+	}
+	for i, key := range keys {
+		if i < len(spec.PartitionBy) {
+			w.arg.PartitionBy = append(w.arg.PartitionBy, int32(w.input.names[key]))
+			continue
+		}
+		f := order.Field{Pos: int32(w.input.names[key])}
+		if spec.OrderBy[i-len(spec.PartitionBy)].Direction == tree.Descending {
+			f.Type = order.Descending
+		}
+		w.arg.OrderBy = append(w.arg.OrderBy, f)
+	}
+	if err := w.check(attrs); err != nil {
+		return nil, err
+	}
+	w.table = &cteTable{
+		attrs: append([]engine.Attribute{}, w.input.attrs...),
+		names: make(map[string]int),
+	}
+	for name, i := range w.input.names {
+		w.table.names[name] = i
+	}
+	for i, f := range w.arg.Funcs {
+		name := fmt.Sprintf("__w%d", i)
+		w.table.names[name] = len(w.table.attrs)
+		w.table.attrs = append(w.table.attrs, engine.Attribute{Name: name, Type: windowResultType(f, w.input.attrs)})
+	}
+	ce.tables[windowTableName] = w.table
+	e.window = w
+	return &tree.Select{Select: outer, OrderBy: outerOrderBy, Limit: stmt.Limit}, nil
+}
+
+// expandStar returns the items whose '*' is replaced by the columns of the tables
+func (e *Exec) expandStar(ce *cteEngine, clause *tree.SelectClause) (tree.SelectExprs, error) {
+	exec := &Exec{c: ce.snapshot().newCompile(e.c), stmt: &tree.Select{Select: &tree.SelectClause{
+		Exprs: tree.SelectExprs{{Expr: tree.UnqualifiedStar{}}},
+		From:  clause.From,
+	}}}
+	if err := exec.Compile(nil, nil); err != nil {
+		return nil, err
+	}
+	var items tree.SelectExprs
+	for _, expr := range clause.Exprs {
+		if _, ok := expr.Expr.(tree.UnqualifiedStar); !ok {
+			items = append(items, expr)
+			continue
+		}
+		for _, attr := range exec.resultAttrs {
+			items = append(items, tree.SelectExpr{Expr: tree.SetUnresolvedName(attr.Name)})
+		}
+	}
+	return items, nil
+}
+
+// check checks the types of the attributes which the window operator supports
+func (w *windowQuery) check(attrs []*plan.Attribute) error {
+	for _, pos := range w.arg.PartitionBy {
+		if compare.New(attrs[pos].Type.Oid, false) == nil {
+			return errors.New(errno.FeatureNotSupported, fmt.Sprintf("PARTITION BY of type %s is not supported now", attrs[pos].Type))
+		}
+	}
+	for _, f := range w.arg.OrderBy {
+		if compare.New(attrs[f.Pos].Type.Oid, false) == nil {
+			return errors.New(errno.FeatureNotSupported, fmt.Sprintf("ORDER BY of window of type %s is not supported now", attrs[f.Pos].Type))
+		}
+	}
+	if len(w.arg.OrderBy) == 1 && w.arg.Frame.Type == window.Range &&
+		(w.arg.Frame.Start.Offset > 0 || w.arg.Frame.End.Offset > 0) && !isNumeric(attrs[w.arg.OrderBy[0].Pos].Type.Oid) {
+		return errors.New(errno.WindowingError, "RANGE with offset PRECEDING/FOLLOWING requires a numeric or temporal ORDER BY column")
+	}
+	for _, f := range w.arg.Funcs {
+		if f.Pos < 0 {
+			continue
+		}
+		typ := attrs[f.Pos].Type
+		switch f.Type {
+		case window.Sum, window.Avg:
+			if !isNumeric(typ.Oid) || typ.Oid == types.T_date || typ.Oid == types.T_datetime {
+				return errors.New(errno.FeatureNotSupported, fmt.Sprintf("window function '%v' of type %s is not supported now", f.Type, typ))
+			}
+		case window.Min, window.Max:
+			if compare.New(typ.Oid, false) == nil {
+				return errors.New(errno.FeatureNotSupported, fmt.Sprintf("window function '%v' of type %s is not supported now", f.Type, typ))
+			}
+		}
+	}
+	return nil
+}
+
+// run evaluates the window functions with the rows of the query, which are in
+// the order of the partition by and order by attributes.
+func (w *windowQuery) run(c *compile) error {
+	if err := w.exec.Run(0); err != nil {
+		return err
+	}
+	proc := process.New(c.proc.Mp)
+	if err := window.Prepare(proc, w.arg); err != nil {
+		return err
+	}
+	for i := 0; i <= len(w.input.segs); i++ {
+		proc.Reg.InputBatch = nil
+		if i < len(w.input.segs) {
+			bat, err := w.input.batch(i, proc)
+			if err != nil {
+				return err
+			}
+			proc.Reg.InputBatch = bat
+		}
+		end, err := window.Call(proc, w.arg)
+		if err != nil {
+			return err
+		}
+		if bat := proc.Reg.InputBatch; bat != nil && len(bat.Zs) > 0 {
+			err := w.table.fillBatch(bat)
+			batch.Clean(bat, proc.Mp)
+			if err != nil {
+				return err
+			}
+		}
+		if end {
+			break
+		}
+	}
+	return nil
+}
+
+// batch returns the rows of the segment, a row is repeated by its count
+func (t *cteTable) batch(seg int, proc *process.Process) (*batch.Batch, error) {
+	var sels []int64
+	for i, z := range t.zs[seg] {
+		for ; z > 0; z-- {
+			sels = append(sels, int64(i))
+		}
+	}
+	bat := batch.New(len(t.attrs))
+	bat.InitZsOne(len(sels))
+	for i, attr := range t.attrs {
+		data := make([]byte, len(t.segs[seg][i]))
+		copy(data, t.segs[seg][i])
+		vec := vector.New(attr.Type)
+		if err := vec.Read(data); err != nil {
+			batch.Clean(bat, proc.Mp)
+			return nil, err
+		}
+		bat.Vecs[i] = vector.New(attr.Type)
+		if len(sels) == 0 {
+			continue
+		}
+		if err := vector.Union(bat.Vecs[i], vec, sels, proc.Mp); err != nil {
+			batch.Clean(bat, proc.Mp)
+			return nil, err
+		}
+	}
+	return bat, nil
+}
+
+func (t *cteTable) fillBatch(bat *batch.Batch) error {
+	seg := make([][]byte, len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		data, err := compactVector(vec).Show()
+		if err != nil {
+			return err
+		}
+		seg[i] = data
+	}
+	zs := make([]int64, len(bat.Zs))
+	copy(zs, bat.Zs)
+	t.rows += int64(len(zs))
+	t.segs = append(t.segs, seg)
+	t.zs = append(t.zs, zs)
+	return nil
+}
+
+// cteEngine returns the engine which resolves the tables in memory
+func (e *Exec) cteEngine() *cteEngine {
+	if ce, ok := e.c.e.(*cteEngine); ok {
+		return ce
+	}
+	ce := &cteEngine{
+		Engine: e.c.e,
+		db:     e.c.db,
+		tables: make(map[string]*cteTable),
+	}
+	e.c = ce.newCompile(e.c)
+	return ce
+}
+
+func windowFuncType(f *tree.FuncExpr) (window.FuncType, error) {
+	name, ok := f.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok {
+		return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("window function '%s' is not supported now", tree.String(f, dialect.MYSQL)))
+	}
+	typ, ok := windowFuncTypes[strings.ToLower(name.Parts[0])]
+	if !ok {
+		return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("window function '%s' is not supported now", name.Parts[0]))
+	}
+	if f.Type == tree.FUNC_TYPE_DISTINCT {
+		return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("window function '%s' with DISTINCT is not supported now", name.Parts[0]))
+	}
+	return typ, nil
+}
+
+func windowFrame(spec *tree.WindowSpec) (window.Frame, error) {
+	// the default frame is the whole partition without ORDER BY,
+	// and from the partition start to the last peer of current row with ORDER BY
+	if spec.Frame == nil {
+		if len(spec.OrderBy) == 0 {
+			return window.Frame{Type: window.Rows, Start: window.Bound{Type: window.UnboundedPreceding}, End: window.Bound{Type: window.UnboundedFollowing}}, nil
+		}
+		return window.Frame{Type: window.Range, Start: window.Bound{Type: window.UnboundedPreceding}, End: window.Bound{Type: window.CurrentRow}}, nil
+	}
+	frame := window.Frame{Type: window.Rows}
+	if spec.Frame.Type == tree.FRAME_TYPE_RANGE {
+		frame.Type = window.Range
+	}
+	var err error
+	if frame.Start, err = windowBound(spec.Frame.Start); err != nil {
+		return frame, err
+	}
+	frame.End = window.Bound{Type: window.CurrentRow}
+	if spec.Frame.End != nil {
+		if frame.End, err = windowBound(spec.Frame.End); err != nil {
+			return frame, err
+		}
+	}
+	if frame.Start.Type == window.UnboundedFollowing {
+		return frame, errors.New(errno.WindowingError, "frame start cannot be UNBOUNDED FOLLOWING")
+	}
+	if frame.End.Type == window.UnboundedPreceding {
+		return frame, errors.New(errno.WindowingError, "frame end cannot be UNBOUNDED PRECEDING")
+	}
+	if frame.Start.Type > frame.End.Type {
+		return frame, errors.New(errno.WindowingError, fmt.Sprintf("frame starting from '%v' cannot end with '%v'", frame.Start, frame.End))
+	}
+	hasOffset := frame.Start.Type == window.Preceding || frame.Start.Type == window.Following ||
+		frame.End.Type == window.Preceding || frame.End.Type == window.Following
+	if frame.Type == window.Range && hasOffset && len(spec.OrderBy) != 1 {
+		return frame, errors.New(errno.WindowingError, "RANGE with offset PRECEDING/FOLLOWING requires exactly one ORDER BY column")
+	}
+	return frame, nil
+}
+
+func windowBound(b *tree.FrameBound) (window.Bound, error) {
+	bound := window.Bound{Type: window.BoundType(b.Type)}
+	if b.Type != tree.FRAME_BOUND_PRECEDING && b.Type != tree.FRAME_BOUND_FOLLOWING {
+		return bound, nil
+	}
+	offset, ok := windowOffset(b.Expr)
+	if !ok {
+		return bound, errors.New(errno.WindowingError, fmt.Sprintf("offset of frame bound '%s' must be a non-negative constant", tree.String(b, dialect.MYSQL)))
+	}
+	bound.Offset = offset
+	return bound, nil
+}
+
+// windowOffset returns the value of a non-negative number constant
+func windowOffset(expr tree.Expr) (float64, bool) {
+	v, ok := expr.(*tree.NumVal)
+	if !ok || (v.Value.Kind() != constant.Int && v.Value.Kind() != constant.Float) {
+		return 0, false
+	}
+	f, _ := constant.Float64Val(v.Value)
+	return f, f >= 0
+}
+
+func windowResultType(f window.Func, attrs []engine.Attribute) types.Type {
+	switch f.Type {
+	case window.RowNumber, window.Rank, window.DenseRank, window.Count:
+		return types.Type{Oid: types.T_int64, Size: 8}
+	case window.Avg:
+		return types.Type{Oid: types.T_float64, Size: 8}
+	case window.Sum:
+		switch attrs[f.Pos].Type.Oid {
+		case types.T_float32, types.T_float64:
+			return types.Type{Oid: types.T_float64, Size: 8}
+		}
+		return types.Type{Oid: types.T_int64, Size: 8}
+	}
+	return attrs[f.Pos].Type
+}
+
+// isWindowItem returns true if the name is the name of an item
+func isWindowItem(exprs tree.SelectExprs, name string) bool {
+	for _, expr := range exprs {
+		if string(expr.As) == name || (len(expr.As) == 0 && tree.String(expr.Expr, dialect.MYSQL) == name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isCountStar returns true if the function counts all rows, count(*) is
+// parsed as the count of a constant like count(1)
+func isCountStar(f *tree.FuncExpr) bool {
+	if len(f.Exprs) != 1 {
+		return false
+	}
+	_, ok := f.Exprs[0].(*tree.NumVal)
+	return ok
+}
+
+func isNumeric(typ types.T) bool {
+	switch typ {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64, types.T_date, types.T_datetime:
+		return true
+	}
+	return false
+}
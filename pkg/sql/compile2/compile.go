@@ -0,0 +1,813 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/anti"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/dispatch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/group"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/hashbuild"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/join"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/joinfilter"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/left"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mark"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/merge"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergegroup"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/output"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/right"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/semi"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/single"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/viewexec/transformer"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+// New returns a Compile running the queries on the engine by ncpu pipelines
+// per relation, fill is called with u for every batch of the result.
+func New(e engine.Engine, proc *process.Process, ncpu int, u interface{}, fill func(interface{}, *batch.Batch) error) *Compile {
+	if ncpu < 1 {
+		ncpu = 1
+	}
+	return &Compile{
+		e:    e,
+		proc: proc,
+		ncpu: ncpu,
+		u:    u,
+		fill: fill,
+	}
+}
+
+// Compile lowers the query into the scopes, the root scope merges the
+// results of all pipelines and writes them by fill.
+func (c *Compile) Compile(query *plan.Query) error {
+	if query.StmtType != plan.Query_SELECT || len(query.Steps) != 1 {
+		return errors.New(errno.FeatureNotSupported, "only the select queries of one step are supported now")
+	}
+	nodes := make(map[int32]*plan.Node, len(query.Nodes))
+	for _, node := range query.Nodes {
+		nodes[node.NodeId] = node
+	}
+	root, ok := nodes[query.Steps[0]]
+	if !ok {
+		return errors.New(errno.InternalError, fmt.Sprintf("node %v of the step is not in the query", query.Steps[0]))
+	}
+	ss, err := c.compileNode(nodes, root)
+	if err != nil {
+		c.closeRelations()
+		return err
+	}
+	rs := c.newMergeScope(ss)
+	rs.Instructions = append(rs.Instructions, Instruction{
+		Op: Output,
+		Arg: &output.Argument{
+			Data: c.u,
+			Func: c.fill,
+		},
+	})
+	c.scope = rs
+	return nil
+}
+
+// Run runs the scopes of the compiled query.
+func (c *Compile) Run() error {
+	defer c.closeRelations()
+	return c.scope.MergeRun()
+}
+
+func (c *Compile) String() string {
+	return c.scope.String()
+}
+
+// compileNode returns the scopes computing the node, the instructions of
+// the parent node are appended to them.
+func (c *Compile) compileNode(nodes map[int32]*plan.Node, node *plan.Node) ([]*Scope, error) {
+	switch node.NodeType {
+	case plan.Node_TABLE_SCAN:
+		return c.compileTableScan(node)
+	case plan.Node_AGG:
+		if node.AggMode != plan.Node_TOP || len(node.Children) != 1 {
+			return nil, errors.New(errno.FeatureNotSupported, "the aggregation which is not split is not supported now")
+		}
+		bottom, ok := nodes[node.Children[0]]
+		if !ok || bottom.AggMode != plan.Node_BOTTOM || len(bottom.Children) != 1 {
+			return nil, errors.New(errno.InternalError, "the TOP aggregation has no BOTTOM aggregation")
+		}
+		child, ok := nodes[bottom.Children[0]]
+		if !ok {
+			return nil, errors.New(errno.InternalError, fmt.Sprintf("node %v is not in the query", bottom.Children[0]))
+		}
+		ss, err := c.compileNode(nodes, child)
+		if err != nil {
+			return nil, err
+		}
+		return c.compileAggregation(node, bottom, ss)
+	case plan.Node_JOIN:
+		return c.compileJoin(nodes, node)
+	}
+	return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("node '%s' is not supported now", node.NodeType))
+}
+
+// compileTableScan returns a pipeline for every reader of the relation, the
+// pipelines read all attributes of the table, the columns of the filters
+// and the project list refer to the attributes by their positions.
+func (c *Compile) compileTableScan(node *plan.Node) ([]*Scope, error) {
+	if node.ObjRef == nil || node.TableDef == nil {
+		return nil, errors.New(errno.InternalError, "the table scan has no table")
+	}
+	db, err := c.e.Database(node.ObjRef.DbName)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := db.Relation(node.TableDef.Name)
+	if err != nil {
+		return nil, err
+	}
+	c.rels = append(c.rels, rel)
+	for _, e := range node.ProjectList {
+		if hasAggregation(e) {
+			return nil, errors.New(errno.FeatureNotSupported, "the aggregation without group by is not supported now")
+		}
+	}
+	attrs := make([]string, len(node.TableDef.Cols))
+	refCnts := make([]uint64, len(node.TableDef.Cols))
+	for i, col := range node.TableDef.Cols {
+		attrs[i] = col.Name
+		refCnts[i] = 1
+	}
+	rds := rel.NewReader(c.ncpu, nil, nil)
+	ss := make([]*Scope, len(rds))
+	for i := range rds {
+		ss[i] = &Scope{
+			Magic: Normal,
+			DataSource: &Source{
+				SchemaName:   node.ObjRef.DbName,
+				RelationName: node.TableDef.Name,
+				RefCounts:    refCnts,
+				Attributes:   attrs,
+				R:            rds[i],
+			},
+			Proc: c.newProcess(),
+		}
+		ss[i].Instructions = appendFilters(ss[i].Instructions, node.WhereList)
+		if !isIdentity(node.ProjectList, len(attrs)) {
+			ss[i].Instructions = append(ss[i].Instructions, Instruction{
+				Op:  Projection,
+				Arg: &projection.Argument{Es: node.ProjectList},
+			})
+		}
+	}
+	return ss, nil
+}
+
+// compileAggregation lowers the BOTTOM aggregation to the group operators of
+// the pipelines, which dispatch their partial groups by the hash of the group
+// by attributes to the pipelines of the TOP aggregation, and the TOP one to
+// the mergegroup operators of these pipelines.
+func (c *Compile) compileAggregation(top, bottom *plan.Node, ss []*Scope) ([]*Scope, error) {
+	ng := len(bottom.GroupBy)
+	es := make([]*plan.Expr, 0, len(bottom.ProjectList))
+	es = append(es, bottom.GroupBy...)
+	exprs := make([]int32, ng)
+	for i := range exprs {
+		exprs[i] = int32(i)
+	}
+	aggs := make([]group.Aggregate, 0, len(bottom.ProjectList)-ng)
+	for i, expr := range bottom.ProjectList[ng:] {
+		f, ok := expr.Expr.(*plan.Expr_F)
+		if !ok || len(f.F.Args) != 1 {
+			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("aggregation '%s' is not supported now", expr))
+		}
+		name := strings.ToLower(f.F.Func.GetObjName())
+		op, ok := transformer.TransformerNamesMap[name]
+		if !ok {
+			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("aggregation '%s' is not supported now", name))
+		}
+		// count(*) is the count of a constant
+		if _, ok := f.F.Args[0].Expr.(*plan.Expr_C); ok && op == transformer.Count {
+			op = transformer.StarCount
+		}
+		es = append(es, f.F.Args[0])
+		aggs = append(aggs, group.Aggregate{Op: op, Pos: int32(ng + i)})
+	}
+
+	ms := make([]*Scope, len(ss))
+	for j := range ms {
+		ms[j] = c.newScope(len(ss))
+		ms[j].PreScopes = []*Scope{ss[j]}
+		ms[j].Instructions = append(ms[j].Instructions, Instruction{
+			Op:  MergeGroup,
+			Arg: &mergegroup.Argument{NeedEval: true},
+		})
+		ms[j].Instructions = appendFilters(ms[j].Instructions, topExprs(top.WhereList))
+		ms[j].Instructions = append(ms[j].Instructions, Instruction{
+			Op:  Projection,
+			Arg: &projection.Argument{Es: topExprs(top.ProjectList)},
+		})
+	}
+	for i, s := range ss {
+		regs := make([]*process.WaitRegister, len(ms))
+		for j, m := range ms {
+			regs[j] = m.Proc.Reg.MergeReceivers[i]
+		}
+		s.Instructions = append(s.Instructions,
+			Instruction{
+				Op:  Projection,
+				Arg: &projection.Argument{Es: es},
+			},
+			Instruction{
+				Op: Group,
+				Arg: &group.Argument{
+					Exprs: exprs,
+					Aggs:  aggs,
+				},
+			},
+			Instruction{
+				Op:  Dispatch,
+				Arg: &dispatch.Argument{Regs: regs},
+			})
+	}
+	return ms, nil
+}
+
+// compileJoin lowers the join to the hash join operator of its join type,
+// the left child is the probe side and the right child is the build side.
+// The equalities of the columns of both children in the join conditions are
+// the join keys, and the join keys of the inner, semi and right joins whose
+// probe side is a table scan are filtered by the bloom filter of the build
+// side as soon as they are scanned.
+func (c *Compile) compileJoin(nodes map[int32]*plan.Node, node *plan.Node) ([]*Scope, error) {
+	if len(node.Children) != 2 {
+		return nil, errors.New(errno.InternalError, "the join has not two children")
+	}
+	jc := &joinColumns{}
+	for i, id := range node.Children {
+		child, ok := nodes[id]
+		if !ok {
+			return nil, errors.New(errno.InternalError, fmt.Sprintf("node %v is not in the query", id))
+		}
+		jc.children[i] = child
+	}
+	op, err := joinOp(jc.children[0].JoinType, jc.children[1].JoinType)
+	if err != nil {
+		return nil, err
+	}
+	if op == Mark {
+		jc.mark = fmt.Sprintf("mark#%d", node.NodeId)
+	}
+	for _, e := range node.ProjectList {
+		if hasAggregation(e) {
+			return nil, errors.New(errno.FeatureNotSupported, "the aggregation of the join is not supported now")
+		}
+	}
+
+	var keys [2][]*plan.Expr
+	var filters []*plan.Expr
+	for _, e := range node.OnList {
+		l, r, ok, err := jc.equiKeys(e)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			if op != Join {
+				return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("the join condition '%s' of the outer or decorrelated join is not supported now", e))
+			}
+			filters = append(filters, e)
+			continue
+		}
+		if l.Typ == nil || r.Typ == nil || l.Typ.Id != r.Typ.Id {
+			return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("the join keys of different types '%s' are not supported now", e))
+		}
+		keys[0] = append(keys[0], l)
+		keys[1] = append(keys[1], r)
+	}
+	filters = append(filters, node.WhereList...)
+	rpos, typs, err := jc.result(op)
+	if err != nil {
+		return nil, err
+	}
+	if filters, err = jc.remapExprs(filters, op); err != nil {
+		return nil, err
+	}
+	es, err := jc.remapExprs(node.ProjectList, op)
+	if err != nil {
+		return nil, err
+	}
+
+	probe, err := c.compileNode(nodes, jc.children[0])
+	if err != nil {
+		return nil, err
+	}
+	build, err := c.compileNode(nodes, jc.children[1])
+	if err != nil {
+		return nil, err
+	}
+	conds := [2][]int32{
+		appendKeys(probe, keys[0], jc.children[0].ProjectList),
+		appendKeys(build, keys[1], jc.children[1].ProjectList),
+	}
+	var rt *joinfilter.Runtime
+	if (op == Join || op == Semi || op == Right) && len(conds[0]) > 0 && jc.children[0].NodeType == plan.Node_TABLE_SCAN {
+		rt = joinfilter.NewRuntime()
+		for _, s := range probe {
+			s.Instructions = append(s.Instructions, Instruction{
+				Op: JoinFilter,
+				Arg: &joinfilter.Argument{
+					Conds:   conds[0],
+					Runtime: rt,
+				},
+			})
+		}
+	}
+
+	rs := c.newScope(2)
+	rs.PreScopes = []*Scope{
+		c.newConnectedScope(probe, rs, 0),
+		c.newConnectedScope(build, rs, 1),
+	}
+	rs.Instructions = append(rs.Instructions, Instruction{
+		Op:  op,
+		Arg: joinArgument(op, conds, rpos, typs, jc.children[1].JoinType&plan.Node_NULL_AWARE != 0, rt),
+	})
+	rs.Instructions = appendFilters(rs.Instructions, filters)
+	width := len(rpos)
+	if op == Mark {
+		width++
+	}
+	if !isIdentity(es, width) {
+		rs.Instructions = append(rs.Instructions, Instruction{
+			Op:  Projection,
+			Arg: &projection.Argument{Es: es},
+		})
+	}
+	return []*Scope{rs}, nil
+}
+
+// joinOp returns the join operator for the join types of the probe and
+// build children
+func joinOp(probe, build plan.Node_JoinFlag) (int, error) {
+	switch {
+	case probe == plan.Node_INNER && build == plan.Node_INNER:
+		return Join, nil
+	case probe == plan.Node_INNER && build == plan.Node_OUTER:
+		return Left, nil
+	case probe == plan.Node_OUTER && build == plan.Node_INNER:
+		return Right, nil
+	case probe != plan.Node_INNER:
+		// the full outer join is not supported
+	case build == plan.Node_SEMI:
+		return Semi, nil
+	case build&^plan.Node_NULL_AWARE == plan.Node_ANTI:
+		return Anti, nil
+	case build&^plan.Node_NULL_AWARE == plan.Node_MARK:
+		return Mark, nil
+	case build == plan.Node_SINGLE:
+		return Single, nil
+	}
+	return 0, errors.New(errno.FeatureNotSupported, fmt.Sprintf("the join of '%s' and '%s' is not supported now", probe, build))
+}
+
+func joinArgument(op int, conds [2][]int32, rpos []hashbuild.ResultPos, typs []types.Type, nullAware bool, rt *joinfilter.Runtime) interface{} {
+	probe := make([]int32, len(rpos))
+	for i, rp := range rpos {
+		probe[i] = rp.Pos
+	}
+	switch op {
+	case Left:
+		return &left.Argument{Conds: conds, Result: rpos, Typs: typs}
+	case Right:
+		return &right.Argument{Conds: conds, Result: rpos, Typs: typs, Filter: rt}
+	case Semi:
+		return &semi.Argument{Conds: conds, Result: probe, Filter: rt}
+	case Anti:
+		return &anti.Argument{NullAware: nullAware, Conds: conds, Result: probe}
+	case Mark:
+		return &mark.Argument{NullAware: nullAware, Conds: conds, Result: probe}
+	case Single:
+		return &single.Argument{Conds: conds, Result: rpos, Typs: typs}
+	}
+	return &join.Argument{Conds: conds, Result: rpos, Typs: typs, Filter: rt}
+}
+
+// appendKeys returns the positions of the join keys in the results of the
+// scopes, the keys which are not the columns are appended to the results.
+func appendKeys(ss []*Scope, keys []*plan.Expr, cols []*plan.Expr) []int32 {
+	conds := make([]int32, len(keys))
+	es := make([]*plan.Expr, 0, len(cols)+len(keys))
+	for i, e := range cols {
+		es = append(es, &plan.Expr{
+			Typ: e.Typ,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					Name:   e.Alias,
+					ColPos: int32(i),
+				},
+			},
+		})
+	}
+	for i, key := range keys {
+		if col, ok := key.Expr.(*plan.Expr_Col); ok {
+			conds[i] = col.Col.ColPos
+			continue
+		}
+		conds[i] = int32(len(es))
+		es = append(es, key)
+	}
+	if len(es) > len(cols) {
+		for _, s := range ss {
+			s.Instructions = append(s.Instructions, Instruction{
+				Op:  Projection,
+				Arg: &projection.Argument{Es: es},
+			})
+		}
+	}
+	return conds
+}
+
+// newMergeScope returns the scope merging the results of the scopes
+func (c *Compile) newMergeScope(ss []*Scope) *Scope {
+	rs := c.newScope(len(ss))
+	rs.PreScopes = ss
+	rs.Instructions = append(rs.Instructions, Instruction{
+		Op:  Union,
+		Arg: &merge.Argument{},
+	})
+	for i, s := range ss {
+		s.Instructions = append(s.Instructions, Instruction{
+			Op: Connector,
+			Arg: &connector.Argument{
+				Mmu: rs.Proc.Mp.Gm,
+				Reg: rs.Proc.Reg.MergeReceivers[i],
+			},
+		})
+	}
+	return rs
+}
+
+// newConnectedScope returns the scope merging the results of the scopes into
+// the i-th register of the scope s
+func (c *Compile) newConnectedScope(ss []*Scope, s *Scope, i int) *Scope {
+	rs := c.newMergeScope(ss)
+	rs.Instructions = append(rs.Instructions, Instruction{
+		Op: Connector,
+		Arg: &connector.Argument{
+			Mmu: s.Proc.Mp.Gm,
+			Reg: s.Proc.Reg.MergeReceivers[i],
+		},
+	})
+	return rs
+}
+
+// newScope returns a merge scope reading n registers. Every register holds
+// the two batches a pipeline sends at most, the partial groups of its bottom
+// aggregation and the end, so the pipelines dispatching to all merge scopes
+// never wait for each other.
+func (c *Compile) newScope(n int) *Scope {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Scope{
+		Magic: Merge,
+		Proc:  c.newProcess(),
+	}
+	s.Proc.Cancel = cancel
+	s.Proc.Reg.MergeReceivers = make([]*process.WaitRegister, n)
+	for i := range s.Proc.Reg.MergeReceivers {
+		s.Proc.Reg.MergeReceivers[i] = &process.WaitRegister{
+			Ctx: ctx,
+			Ch:  make(chan *batch.Batch, 2),
+		}
+	}
+	return s
+}
+
+func (c *Compile) newProcess() *process.Process {
+	proc := process.New(mheap.New(guest.New(c.proc.Mp.Gm.Limit, c.proc.Mp.Gm.Mmu)))
+	proc.Id = c.proc.Id
+	proc.Lim = c.proc.Lim
+	proc.UnixTime = c.proc.UnixTime
+	return proc
+}
+
+func (c *Compile) closeRelations() {
+	for _, rel := range c.rels {
+		rel.Close()
+	}
+	c.rels = nil
+}
+
+func appendFilters(ins Instructions, es []*plan.Expr) Instructions {
+	for _, e := range es {
+		ins = append(ins, Instruction{
+			Op:  Restrict,
+			Arg: &restrict.Argument{E: e},
+		})
+	}
+	return ins
+}
+
+// isIdentity returns true if the project list is the n columns of the input in order
+func isIdentity(es []*plan.Expr, n int) bool {
+	if len(es) != n {
+		return false
+	}
+	for i, e := range es {
+		col, ok := e.Expr.(*plan.Expr_Col)
+		if !ok || col.Col.ColPos != int32(i) {
+			return false
+		}
+	}
+	return true
+}
+
+func hasAggregation(e *plan.Expr) bool {
+	f, ok := e.Expr.(*plan.Expr_F)
+	if !ok {
+		return false
+	}
+	if _, ok := transformer.TransformerNamesMap[strings.ToLower(f.F.Func.GetObjName())]; ok {
+		return true
+	}
+	for _, arg := range f.F.Args {
+		if hasAggregation(arg) {
+			return true
+		}
+	}
+	return false
+}
+
+// topExprs replaces the aggregation functions of the TOP aggregation by their
+// arguments, which are the columns of the results finalized by mergegroup.
+func topExprs(es []*plan.Expr) []*plan.Expr {
+	rs := make([]*plan.Expr, len(es))
+	for i, e := range es {
+		rs[i] = topExpr(e)
+	}
+	return rs
+}
+
+func topExpr(e *plan.Expr) *plan.Expr {
+	f, ok := e.Expr.(*plan.Expr_F)
+	if !ok {
+		return e
+	}
+	if len(f.F.Args) == 1 {
+		if _, ok := transformer.TransformerNamesMap[strings.ToLower(f.F.Func.GetObjName())]; ok {
+			if col, ok := f.F.Args[0].Expr.(*plan.Expr_Col); ok {
+				return &plan.Expr{
+					Typ:   e.Typ,
+					Alias: e.Alias,
+					Expr:  col,
+				}
+			}
+		}
+	}
+	args := make([]*plan.Expr, len(f.F.Args))
+	for i, arg := range f.F.Args {
+		args[i] = topExpr(arg)
+	}
+	return &plan.Expr{
+		Typ:   e.Typ,
+		Alias: e.Alias,
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: f.F.Func,
+				Args: args,
+			},
+		},
+	}
+}
+
+// joinColumns resolves the columns of the expressions of a join to the
+// columns of its children. The children may be reordered after the
+// expressions are built, so a column is found by its name first, and by its
+// position only if its name is not found.
+type joinColumns struct {
+	children [2]*plan.Node
+	// mark is the name of the mark of the mark join
+	mark string
+}
+
+// resolve returns the child of the column and its position in the result of
+// the child, the child of the mark is -1.
+func (jc *joinColumns) resolve(col *plan.ColRef) (int, int32, error) {
+	if jc.mark != "" && col.Name == jc.mark {
+		return -1, 0, nil
+	}
+	if col.RelPos == 0 || col.RelPos == 1 {
+		es := jc.children[col.RelPos].ProjectList
+		if col.ColPos >= 0 && int(col.ColPos) < len(es) && es[col.ColPos].Alias == col.Name {
+			return int(col.RelPos), col.ColPos, nil
+		}
+	}
+	rel, pos := -1, int32(-1)
+	for i, child := range jc.children {
+		for j, e := range child.ProjectList {
+			if e.Alias == col.Name {
+				if rel == -1 {
+					rel, pos = i, int32(j)
+				} else if rel != i {
+					rel = -2
+				}
+				break
+			}
+		}
+	}
+	if rel >= 0 {
+		return rel, pos, nil
+	}
+	n := int32(len(jc.children[0].ProjectList))
+	switch {
+	case col.ColPos < 0:
+	case col.RelPos == 1 && int(col.ColPos) < len(jc.children[1].ProjectList):
+		return 1, col.ColPos, nil
+	case col.RelPos == 0 && col.ColPos < n:
+		return 0, col.ColPos, nil
+	case col.RelPos == 0 && int(col.ColPos-n) < len(jc.children[1].ProjectList):
+		return 1, col.ColPos - n, nil
+	}
+	return 0, 0, errors.New(errno.InternalError, fmt.Sprintf("column '%s' of the join is not found", col.Name))
+}
+
+// equiKeys returns the join keys of the equality of the columns of both
+// children, the columns of the keys refer to the results of the children.
+func (jc *joinColumns) equiKeys(e *plan.Expr) (*plan.Expr, *plan.Expr, bool, error) {
+	f, ok := e.Expr.(*plan.Expr_F)
+	if !ok || f.F.Func.GetObjName() != "=" || len(f.F.Args) != 2 {
+		return nil, nil, false, nil
+	}
+	var rels [2]int
+	for i, arg := range f.F.Args {
+		rel, err := jc.relation(arg)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		rels[i] = rel
+	}
+	var l, r *plan.Expr
+	switch {
+	case rels[0] == 0 && rels[1] == 1:
+		l, r = f.F.Args[0], f.F.Args[1]
+	case rels[0] == 1 && rels[1] == 0:
+		l, r = f.F.Args[1], f.F.Args[0]
+	default:
+		return nil, nil, false, nil
+	}
+	l, err := jc.remap(l, func(_ int, pos int32) int32 { return pos })
+	if err != nil {
+		return nil, nil, false, err
+	}
+	r, err = jc.remap(r, func(_ int, pos int32) int32 { return pos })
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return l, r, true, nil
+}
+
+// relation returns the child whose columns the expression refers to, it
+// returns -1 if the expression refers to no column or to the columns of
+// both children.
+func (jc *joinColumns) relation(e *plan.Expr) (int, error) {
+	rel := -2
+	var err error
+	var walk func(*plan.Expr)
+	walk = func(e *plan.Expr) {
+		switch ex := e.Expr.(type) {
+		case *plan.Expr_Col:
+			r, _, rerr := jc.resolve(ex.Col)
+			switch {
+			case rerr != nil:
+				err = rerr
+			case rel == -2:
+				rel = r
+			case rel != r:
+				rel = -1
+			}
+		case *plan.Expr_F:
+			for _, arg := range ex.F.Args {
+				walk(arg)
+			}
+		}
+	}
+	walk(e)
+	if rel == -2 {
+		rel = -1
+	}
+	return rel, err
+}
+
+// result returns the attributes of the children in the result of the join
+// and their types, the mark of the mark join follows them.
+func (jc *joinColumns) result(op int) ([]hashbuild.ResultPos, []types.Type, error) {
+	var rpos []hashbuild.ResultPos
+	var typs []types.Type
+	for i, child := range jc.children {
+		if i == 1 && (op == Semi || op == Anti || op == Mark) {
+			break
+		}
+		for j, e := range child.ProjectList {
+			if e.Typ == nil {
+				return nil, nil, errors.New(errno.InternalError, fmt.Sprintf("column '%s' has no type", e.Alias))
+			}
+			typ := types.T(e.Typ.Id).ToType()
+			if typ.Size == 0 {
+				return nil, nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("column '%s' of type '%s' of the join is not supported now", e.Alias, e.Typ.Id))
+			}
+			typ.Width = e.Typ.Width
+			typ.Precision = e.Typ.Precision
+			rpos = append(rpos, hashbuild.ResultPos{Rel: int32(i), Pos: int32(j)})
+			typs = append(typs, typ)
+		}
+	}
+	return rpos, typs, nil
+}
+
+// remapExprs returns the expressions whose columns refer to the result of the join
+func (jc *joinColumns) remapExprs(es []*plan.Expr, op int) ([]*plan.Expr, error) {
+	n := int32(len(jc.children[0].ProjectList))
+	var err error
+	rs := make([]*plan.Expr, len(es))
+	for i, e := range es {
+		rs[i], err = jc.remap(e, func(rel int, pos int32) int32 {
+			switch {
+			case rel == -1:
+				return n
+			case rel == 1 && (op == Semi || op == Anti || op == Mark):
+				return -1
+			case rel == 1:
+				return n + pos
+			}
+			return pos
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+// remap returns the expression whose columns are resolved and placed by fn
+func (jc *joinColumns) remap(e *plan.Expr, fn func(int, int32) int32) (*plan.Expr, error) {
+	switch ex := e.Expr.(type) {
+	case *plan.Expr_Col:
+		rel, pos, err := jc.resolve(ex.Col)
+		if err != nil {
+			return nil, err
+		}
+		if pos = fn(rel, pos); pos < 0 {
+			return nil, errors.New(errno.InternalError, fmt.Sprintf("column '%s' is not in the result of the join", ex.Col.Name))
+		}
+		return &plan.Expr{
+			Typ:   e.Typ,
+			Alias: e.Alias,
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					Name:   ex.Col.Name,
+					ColPos: pos,
+				},
+			},
+		}, nil
+	case *plan.Expr_F:
+		args := make([]*plan.Expr, len(ex.F.Args))
+		for i, arg := range ex.F.Args {
+			var err error
+			if args[i], err = jc.remap(arg, fn); err != nil {
+				return nil, err
+			}
+		}
+		return &plan.Expr{
+			Typ:   e.Typ,
+			Alias: e.Alias,
+			Expr: &plan.Expr_F{
+				F: &plan.Function{
+					Func: ex.F.Func,
+					Args: args,
+				},
+			},
+		}, nil
+	}
+	return e, nil
+}
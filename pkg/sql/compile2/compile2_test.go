@@ -0,0 +1,250 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/joinfilter"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/semi"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+	"github.com/stretchr/testify/require"
+)
+
+// R of the test engine has 20 rows: orderId is i, uid is i % 4 and price is i.
+func TestCompile(t *testing.T) {
+	tcs := []struct {
+		sql  string
+		rows []string
+	}{
+		{
+			sql:  "SELECT price FROM R WHERE uid = 1",
+			rows: []string{"1", "13", "17", "5", "9"},
+		},
+		{
+			sql:  "SELECT uid, COUNT(*), SUM(price) FROM R GROUP BY uid",
+			rows: []string{"0 5 40", "1 5 45", "2 5 50", "3 5 55"},
+		},
+		{
+			sql:  "SELECT uid, MAX(price), MIN(price) FROM R WHERE price > 3 GROUP BY uid HAVING SUM(price) > 40",
+			rows: []string{"1 17 5", "2 18 6", "3 19 7"},
+		},
+		{
+			sql:  "SELECT uid, COUNT(price), AVG(price) FROM R WHERE price < 8 GROUP BY uid",
+			rows: []string{"0 2 2", "1 2 3", "2 2 4", "3 2 5"},
+		},
+	}
+	for _, tc := range tcs {
+		for _, ncpu := range []int{1, 4} {
+			query := buildQuery(t, tc.sql)
+			rows, size := runQuery(t, query, ncpu)
+			require.Equal(t, tc.rows, rows, "%s by %d pipelines", tc.sql, ncpu)
+			require.Equal(t, int64(0), size, "%s by %d pipelines", tc.sql, ncpu)
+		}
+	}
+}
+
+// S of the test engine has 20 rows: orderId is i * 2, uid is i % 2 and price is i.
+func TestCompileJoin(t *testing.T) {
+	odds := []string{"1", "11", "13", "15", "17", "19", "3", "5", "7", "9"}
+	tcs := []struct {
+		sql  string
+		rows []string
+	}{
+		{
+			sql:  "SELECT R.price, S.price FROM R JOIN S ON R.orderId = S.orderId WHERE R.uid = 2",
+			rows: []string{"10 5", "14 7", "18 9", "2 1", "6 3"},
+		},
+		{
+			sql:  "SELECT R.price, S.uid FROM R JOIN S ON R.orderId = S.orderId AND R.price > S.price + 5",
+			rows: []string{"12 0", "14 1", "16 0", "18 1"},
+		},
+		{
+			sql:  "SELECT R.uid, COUNT(*) FROM R JOIN S ON R.uid = S.uid GROUP BY R.uid",
+			rows: []string{"0 50", "1 50"},
+		},
+		{
+			sql:  "SELECT R.uid, COUNT(S.price) FROM R LEFT JOIN S ON R.orderId = S.orderId GROUP BY R.uid",
+			rows: []string{"0 5", "1 0", "2 5", "3 0"},
+		},
+		{
+			sql:  "SELECT S.uid, COUNT(*), COUNT(R.price) FROM R RIGHT JOIN S ON R.orderId = S.orderId GROUP BY S.uid",
+			rows: []string{"0 10 5", "1 10 5"},
+		},
+		{
+			sql:  "SELECT price FROM R WHERE orderId IN (SELECT orderId FROM S)",
+			rows: []string{"0", "10", "12", "14", "16", "18", "2", "4", "6", "8"},
+		},
+		{
+			sql:  "SELECT price FROM R WHERE orderId NOT IN (SELECT orderId FROM S)",
+			rows: odds,
+		},
+		{
+			sql:  "SELECT price FROM R WHERE EXISTS (SELECT * FROM S WHERE S.orderId = R.orderId AND S.price > 5)",
+			rows: []string{"12", "14", "16", "18"},
+		},
+		{
+			sql:  "SELECT price FROM R WHERE NOT EXISTS (SELECT * FROM S WHERE S.orderId = R.orderId)",
+			rows: odds,
+		},
+		{
+			sql:  "SELECT price FROM R WHERE price < 3 OR orderId IN (SELECT orderId FROM S WHERE price > 7)",
+			rows: []string{"0", "1", "16", "18", "2"},
+		},
+		{
+			sql:  "SELECT price FROM R WHERE uid = (SELECT uid FROM S WHERE S.orderId = R.orderId)",
+			rows: []string{"0", "12", "16", "4", "8"},
+		},
+		{
+			sql:  "SELECT price FROM R WHERE price > (SELECT AVG(price) FROM S WHERE S.uid = R.uid)",
+			rows: []string{"12", "13", "16", "17"},
+		},
+		{
+			sql:  "SELECT price FROM R WHERE price > (SELECT AVG(price) FROM S) AND uid = 1",
+			rows: []string{"13", "17"},
+		},
+	}
+	for _, tc := range tcs {
+		for _, ncpu := range []int{1, 4} {
+			query := buildQuery(t, tc.sql)
+			rows, size := runQuery(t, query, ncpu)
+			require.Equal(t, tc.rows, rows, "%s by %d pipelines", tc.sql, ncpu)
+			require.Equal(t, int64(0), size, "%s by %d pipelines", tc.sql, ncpu)
+		}
+	}
+}
+
+func TestCompileAggregation(t *testing.T) {
+	query := buildQuery(t, "SELECT uid, SUM(price) FROM R GROUP BY uid")
+	var modes []plan.Node_AggMode
+	for _, node := range query.Nodes {
+		if node.NodeType == plan.Node_AGG {
+			modes = append(modes, node.AggMode)
+		}
+	}
+	require.ElementsMatch(t, []plan.Node_AggMode{plan.Node_BOTTOM, plan.Node_TOP}, modes)
+
+	hm := host.New(1 << 30)
+	c := New(memEngine.NewTestEngine(), process.New(mheap.New(guest.New(1<<30, hm))), 2, nil, nil)
+	require.NoError(t, c.Compile(query))
+	require.Equal(t, Merge, c.scope.Magic)
+	ms := c.scope.PreScopes
+	require.Equal(t, 2, len(ms))
+	for _, m := range ms {
+		require.Equal(t, MergeGroup, m.Instructions[0].Op)
+		require.Equal(t, 2, len(m.Proc.Reg.MergeReceivers))
+		require.Equal(t, 1, len(m.PreScopes))
+		ins := m.PreScopes[0].Instructions
+		require.Equal(t, Group, ins[len(ins)-2].Op)
+		require.Equal(t, Dispatch, ins[len(ins)-1].Op)
+	}
+	c.closeRelations()
+}
+
+func TestCompileJoinError(t *testing.T) {
+	// the scalar subquery returns more than one row
+	query := buildQuery(t, "SELECT price FROM R WHERE uid = (SELECT uid FROM S WHERE S.uid = R.uid)")
+	for _, ncpu := range []int{1, 4} {
+		c := New(memEngine.NewTestEngine(), process.New(mheap.New(guest.New(1<<30, host.New(1<<30)))), ncpu, nil, func(_ interface{}, _ *batch.Batch) error {
+			return nil
+		})
+		require.NoError(t, c.Compile(query))
+		require.Error(t, c.Run())
+	}
+}
+
+func TestCompileJoinFilter(t *testing.T) {
+	query := buildQuery(t, "SELECT price FROM R WHERE orderId IN (SELECT orderId FROM S)")
+	c := New(memEngine.NewTestEngine(), process.New(mheap.New(guest.New(1<<30, host.New(1<<30)))), 2, nil, nil)
+	require.NoError(t, c.Compile(query))
+	require.Equal(t, 1, len(c.scope.PreScopes))
+	js := c.scope.PreScopes[0]
+	require.Equal(t, Semi, js.Instructions[0].Op)
+	require.Equal(t, 2, len(js.PreScopes))
+	rt := js.Instructions[0].Arg.(*semi.Argument).Filter
+	require.NotNil(t, rt)
+	// the pipelines scanning the probe side are filtered by the filter of the build side
+	for _, s := range js.PreScopes[0].PreScopes {
+		ins := s.Instructions
+		require.Equal(t, JoinFilter, ins[len(ins)-2].Op)
+		require.Equal(t, rt, ins[len(ins)-2].Arg.(*joinfilter.Argument).Runtime)
+	}
+	for _, s := range js.PreScopes[1].PreScopes {
+		for _, in := range s.Instructions {
+			require.NotEqual(t, JoinFilter, in.Op)
+		}
+	}
+	c.closeRelations()
+}
+
+func TestCompileNotSupported(t *testing.T) {
+	for _, sql := range []string{
+		"SELECT * FROM R ORDER BY price",
+		"SELECT * FROM R LEFT JOIN S ON R.uid < S.uid",
+		"SELECT COUNT(*) FROM R",
+	} {
+		query := buildQuery(t, sql)
+		c := New(memEngine.NewTestEngine(), process.New(mheap.New(guest.New(1<<30, host.New(1<<30)))), 1, nil, nil)
+		require.Error(t, c.Compile(query), sql)
+	}
+}
+
+func buildQuery(t *testing.T, sql string) *plan.Query {
+	e := memEngine.NewTestEngine()
+	stmts, err := mysql.Parse(sql)
+	require.NoError(t, err)
+	query, err := plan2.BuildPlan(plan2.NewEngineCompilerContext(e, "test"), stmts[0])
+	require.NoError(t, err)
+	return query
+}
+
+// runQuery returns the sorted rows of the result, and the size of the memory
+// left after the query runs
+func runQuery(t *testing.T, query *plan.Query, ncpu int) ([]string, int64) {
+	var rows []string
+
+	hm := host.New(1 << 30)
+	proc := process.New(mheap.New(guest.New(1<<30, hm)))
+	c := New(memEngine.NewTestEngine(), proc, ncpu, nil, func(_ interface{}, bat *batch.Batch) error {
+		for i := range bat.Zs {
+			row := ""
+			for j, vec := range bat.Vecs {
+				if j > 0 {
+					row += " "
+				}
+				row += fmt.Sprint(reflect.ValueOf(vec.Col).Index(i).Interface())
+			}
+			for k := int64(0); k < bat.Zs[i]; k++ {
+				rows = append(rows, row)
+			}
+		}
+		return nil
+	})
+	require.NoError(t, c.Compile(query))
+	require.NoError(t, c.Run())
+	sort.Strings(rows)
+	return rows, hm.Size()
+}
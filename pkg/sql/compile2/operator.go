@@ -0,0 +1,152 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/anti"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/dispatch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/group"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/join"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/joinfilter"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/left"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mark"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/merge"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/mergegroup"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/output"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/projection"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/restrict"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/right"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/semi"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/single"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+var stringFunc = [...]func(interface{}, *bytes.Buffer){
+	Restrict:   restrict.String,
+	Projection: projection.String,
+	Group:      group.String,
+	Dispatch:   dispatch.String,
+	MergeGroup: mergegroup.String,
+	Join:       join.String,
+	Left:       left.String,
+	Right:      right.String,
+	Semi:       semi.String,
+	Anti:       anti.String,
+	Mark:       mark.String,
+	Single:     single.String,
+	JoinFilter: joinfilter.String,
+	Union:      merge.String,
+	Connector:  connector.String,
+	Output:     output.String,
+}
+
+var prepareFunc = [...]func(*process.Process, interface{}) error{
+	Restrict:   restrict.Prepare,
+	Projection: projection.Prepare,
+	Group:      group.Prepare,
+	Dispatch:   dispatch.Prepare,
+	MergeGroup: mergegroup.Prepare,
+	Join:       join.Prepare,
+	Left:       left.Prepare,
+	Right:      right.Prepare,
+	Semi:       semi.Prepare,
+	Anti:       anti.Prepare,
+	Mark:       mark.Prepare,
+	Single:     single.Prepare,
+	JoinFilter: joinfilter.Prepare,
+	Union:      merge.Prepare,
+	Connector:  connector.Prepare,
+	Output:     output.Prepare,
+}
+
+var execFunc = [...]func(*process.Process, interface{}) (bool, error){
+	Restrict:   restrict.Call,
+	Projection: projection.Call,
+	Group:      group.Call,
+	Dispatch:   dispatch.Call,
+	MergeGroup: mergegroup.Call,
+	Join:       join.Call,
+	Left:       left.Call,
+	Right:      right.Call,
+	Semi:       semi.Call,
+	Anti:       anti.Call,
+	Mark:       mark.Call,
+	Single:     single.Call,
+	JoinFilter: joinfilter.Call,
+	Union:      merge.Call,
+	Connector:  connector.Call,
+	Output:     output.Call,
+}
+
+// String range instructions and call each operator's string function to show a query plan
+func (ins Instructions) String() string {
+	var buf bytes.Buffer
+
+	for i, in := range ins {
+		if i > 0 {
+			buf.WriteString(" -> ")
+		}
+		stringFunc[in.Op](in.Arg, &buf)
+	}
+	return buf.String()
+}
+
+// Prepare range instructions and do init work for each operator's argument by calling its prepare function
+func (ins Instructions) Prepare(proc *process.Process) error {
+	for _, in := range ins {
+		if err := prepareFunc[in.Op](proc, in.Arg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run calls the operators in order on the input batch of proc, it returns true if
+// any of the operators has done its work.
+func (ins Instructions) Run(proc *process.Process) (end bool, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = moerr.NewPanicError(e)
+		}
+	}()
+	for _, in := range ins {
+		ok, err := execFunc[in.Op](proc, in.Arg)
+		if err != nil {
+			return ok || end, err
+		}
+		if ok {
+			end = true
+		}
+	}
+	return end, nil
+}
+
+// drain runs the instructions without input until the end reaches the last
+// operator, the blocking operators return their results before the end.
+func (ins Instructions) drain(proc *process.Process) error {
+	for {
+		proc.Reg.InputBatch = nil
+		if _, err := ins.Run(proc); err != nil {
+			return err
+		}
+		if proc.Reg.InputBatch == nil {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	v1batch "github.com/matrixorigin/matrixone/pkg/container/batch"
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/connector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/dispatch"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/join"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/joinfilter"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/right"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec2/semi"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+func (s *Scope) String() string {
+	return s.Instructions.String()
+}
+
+// Run read data from storage engine and run the instructions of scope.
+func (s *Scope) Run() (err error) {
+	proc := s.Proc
+	defer func() {
+		if err != nil {
+			s.close()
+		} else if err = s.Instructions.drain(proc); err != nil {
+			s.close()
+		}
+	}()
+	if err = s.Instructions.Prepare(proc); err != nil {
+		return err
+	}
+	for {
+		bat, err := s.DataSource.R.Read(s.DataSource.RefCounts, s.DataSource.Attributes)
+		if err != nil {
+			return err
+		}
+		if bat == nil {
+			return nil
+		}
+		if proc.Reg.InputBatch, err = newBatch(bat, proc); err != nil {
+			return err
+		}
+		if end, err := s.Instructions.Run(proc); err != nil || end {
+			return err
+		}
+	}
+}
+
+// MergeRun range and run the scope's pre-scopes by go-routine, and finally run itself to do merge work.
+func (s *Scope) MergeRun() error {
+	errChan := make(chan error, len(s.PreScopes))
+	for i := range s.PreScopes {
+		go func(cs *Scope) {
+			var err error
+			defer func() {
+				errChan <- err
+			}()
+
+			switch cs.Magic {
+			case Normal:
+				err = cs.Run()
+			case Merge:
+				err = cs.MergeRun()
+			}
+		}(s.PreScopes[i])
+	}
+	err := s.runMerge()
+
+	// check sub-goroutine's error
+	for i := 0; i < len(s.PreScopes); i++ {
+		if e := <-errChan; e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+func (s *Scope) runMerge() (err error) {
+	proc := s.Proc
+	defer func() {
+		if err != nil {
+			s.close()
+		} else if err = s.Instructions.drain(proc); err != nil {
+			s.close()
+		}
+		if proc.Cancel != nil {
+			proc.Cancel()
+		}
+	}()
+	if err = s.Instructions.Prepare(proc); err != nil {
+		return err
+	}
+	for {
+		proc.Reg.InputBatch = nil
+		if end, err := s.Instructions.Run(proc); err != nil || end {
+			return err
+		}
+	}
+}
+
+// close tells the consumers of the scope that it has no more batches after it failed
+func (s *Scope) close() {
+	// the pipelines of the probe side waiting for the join filters are
+	// released, as the joins may fail before they build the filters
+	for _, in := range s.Instructions {
+		switch arg := in.Arg.(type) {
+		case *join.Argument:
+			publishNil(arg.Filter)
+		case *right.Argument:
+			publishNil(arg.Filter)
+		case *semi.Argument:
+			publishNil(arg.Filter)
+		}
+	}
+	if len(s.Instructions) == 0 {
+		return
+	}
+	switch arg := s.Instructions[len(s.Instructions)-1].Arg.(type) {
+	case *connector.Argument:
+		select {
+		case <-arg.Reg.Ctx.Done():
+		case arg.Reg.Ch <- nil:
+		}
+	case *dispatch.Argument:
+		for _, reg := range arg.Regs {
+			select {
+			case <-reg.Ctx.Done():
+			case reg.Ch <- nil:
+			}
+		}
+	}
+}
+
+// newBatch copies the batch read from the storage engine into a batch owned by the pipeline
+func publishNil(rt *joinfilter.Runtime) {
+	if rt != nil {
+		rt.Publish(nil)
+	}
+}
+
+func newBatch(bat *v1batch.Batch, proc *process.Process) (*batch.Batch, error) {
+	rbat := batch.New(len(bat.Vecs))
+	for i, vec := range bat.Vecs {
+		v, err := vector.Dup(vec, proc.Mp)
+		if err != nil {
+			batch.Clean(rbat, proc.Mp)
+			return nil, err
+		}
+		v.Ref = 0
+		rbat.Vecs[i] = v
+	}
+	rbat.Zs = append(make([]int64, 0, len(bat.Zs)), bat.Zs...)
+	return rbat, nil
+}
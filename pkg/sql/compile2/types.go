@@ -0,0 +1,103 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compile2
+
+import (
+	batch "github.com/matrixorigin/matrixone/pkg/container/batch2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	process "github.com/matrixorigin/matrixone/pkg/vm/process2"
+)
+
+// operators of the instructions
+const (
+	Restrict = iota
+	Projection
+	Group
+	Dispatch
+	MergeGroup
+	Join
+	Left
+	Right
+	Semi
+	Anti
+	Mark
+	Single
+	JoinFilter
+	Union
+	Connector
+	Output
+)
+
+// Instruction contains the operator code and its argument
+type Instruction struct {
+	// Op specified the operator code of an instruction.
+	Op int
+	// Arg contains the operand of this instruction.
+	Arg interface{}
+}
+
+type Instructions []Instruction
+
+// type of scope
+const (
+	// Normal is the scope reading a relation
+	Normal = iota
+	// Merge is the scope reading the registers of its pre-scopes
+	Merge
+)
+
+// Source contains information of a relation which will be used in execution,
+type Source struct {
+	SchemaName   string
+	RelationName string
+	RefCounts    []uint64
+	Attributes   []string
+	R            engine.Reader
+}
+
+// Scope is the output of the compile process.
+// Each query of plan2 will be compiled to one or more execution unit scopes.
+type Scope struct {
+	// Magic specifies the type of Scope.
+	// 0 -  execution unit for reading data.
+	// 1 -  execution unit for processing intermediate results.
+	Magic int
+	// DataSource stores information about data source.
+	DataSource *Source
+	// PreScopes contains children of this scope will inherit and execute.
+	PreScopes []*Scope
+	// Instructions contains command list of this scope.
+	Instructions Instructions
+	// Proc contains the execution context.
+	Proc *process.Process
+}
+
+// Compile lowers the query of plan2 into the scopes and runs them.
+type Compile struct {
+	// e db engine instance.
+	e engine.Engine
+	// proc stores the execution context.
+	proc *process.Process
+	// ncpu is the number of the pipelines reading a relation.
+	ncpu int
+	// scope is the root scope of the query.
+	scope *Scope
+	// rels are the relations read by the scopes, they are closed after the query runs.
+	rels []engine.Relation
+	u    interface{}
+	// fill is a result writer runs a callback function.
+	// fill will be called when result data is ready.
+	fill func(interface{}, *batch.Batch) error
+}
@@ -57,6 +57,16 @@ func TestExplain(t *testing.T) {
 			input:  "explain (analyze true,verbose false,format JSON) select * from emp",
 			output: "explain (analyze true,verbose false,format JSON) select * from emp",
 		},
+		{
+			name:   "test07",
+			input:  "explain format = json select * from emp",
+			output: "explain (format json) select * from emp",
+		},
+		{
+			name:   "test08",
+			input:  "explain (format dot) select * from emp",
+			output: "explain (format dot) select * from emp",
+		},
 	}
 
 	for _, c := range cases {
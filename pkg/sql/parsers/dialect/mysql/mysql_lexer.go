@@ -44,6 +44,15 @@ func ParseOne(sql string) (tree.Statement, error) {
 	return lexer.stmts[0], nil
 }
 
+//ParsePgLexical parses the sql by the grammar of the mysql and the lexical rules of the postgresql
+func ParsePgLexical(sql string) ([]tree.Statement, error) {
+	lexer := &Lexer{scanner: scanner.NewPgLexicalScanner(sql)}
+	if yyParse(lexer) != 0 {
+		return nil, lexer.scanner.LastError
+	}
+	return lexer.stmts, nil
+}
+
 type Lexer struct {
 	scanner *scanner.Scanner
 	stmts   []tree.Statement
@@ -89,358 +89,372 @@ const FALSE = 57411
 const EMPTY_FROM_CLAUSE = 57412
 const LOWER_THAN_CHARSET = 57413
 const CHARSET = 57414
-const UNIQUE = 57415
-const KEY = 57416
-const OR = 57417
-const XOR = 57418
-const AND = 57419
-const NOT = 57420
-const BETWEEN = 57421
-const CASE = 57422
-const WHEN = 57423
-const THEN = 57424
-const ELSE = 57425
-const END = 57426
-const LE = 57427
-const GE = 57428
-const NE = 57429
-const NULL_SAFE_EQUAL = 57430
-const IS = 57431
-const LIKE = 57432
-const REGEXP = 57433
-const IN = 57434
-const ASSIGNMENT = 57435
-const SHIFT_LEFT = 57436
-const SHIFT_RIGHT = 57437
-const DIV = 57438
-const MOD = 57439
-const UNARY = 57440
-const COLLATE = 57441
-const BINARY = 57442
-const UNDERSCORE_BINARY = 57443
-const INTERVAL = 57444
-const BEGIN = 57445
-const START = 57446
-const TRANSACTION = 57447
-const COMMIT = 57448
-const ROLLBACK = 57449
-const WORK = 57450
-const CONSISTENT = 57451
-const SNAPSHOT = 57452
-const CHAIN = 57453
-const NO = 57454
-const RELEASE = 57455
-const BIT = 57456
-const TINYINT = 57457
-const SMALLINT = 57458
-const MEDIUMINT = 57459
-const INT = 57460
-const INTEGER = 57461
-const BIGINT = 57462
-const INTNUM = 57463
-const REAL = 57464
-const DOUBLE = 57465
-const FLOAT_TYPE = 57466
-const DECIMAL = 57467
-const NUMERIC = 57468
-const TIME = 57469
-const TIMESTAMP = 57470
-const DATETIME = 57471
-const YEAR = 57472
-const CHAR = 57473
-const VARCHAR = 57474
-const BOOL = 57475
-const CHARACTER = 57476
-const VARBINARY = 57477
-const NCHAR = 57478
-const TEXT = 57479
-const TINYTEXT = 57480
-const MEDIUMTEXT = 57481
-const LONGTEXT = 57482
-const BLOB = 57483
-const TINYBLOB = 57484
-const MEDIUMBLOB = 57485
-const LONGBLOB = 57486
-const JSON = 57487
-const ENUM = 57488
-const GEOMETRY = 57489
-const POINT = 57490
-const LINESTRING = 57491
-const POLYGON = 57492
-const GEOMETRYCOLLECTION = 57493
-const MULTIPOINT = 57494
-const MULTILINESTRING = 57495
-const MULTIPOLYGON = 57496
-const INT1 = 57497
-const INT2 = 57498
-const INT3 = 57499
-const INT4 = 57500
-const INT8 = 57501
-const CREATE = 57502
-const ALTER = 57503
-const DROP = 57504
-const RENAME = 57505
-const ANALYZE = 57506
-const ADD = 57507
-const SCHEMA = 57508
-const TABLE = 57509
-const INDEX = 57510
-const VIEW = 57511
-const TO = 57512
-const IGNORE = 57513
-const IF = 57514
-const PRIMARY = 57515
-const COLUMN = 57516
-const CONSTRAINT = 57517
-const SPATIAL = 57518
-const FULLTEXT = 57519
-const FOREIGN = 57520
-const KEY_BLOCK_SIZE = 57521
-const SHOW = 57522
-const DESCRIBE = 57523
-const EXPLAIN = 57524
-const DATE = 57525
-const ESCAPE = 57526
-const REPAIR = 57527
-const OPTIMIZE = 57528
-const TRUNCATE = 57529
-const MAXVALUE = 57530
-const PARTITION = 57531
-const REORGANIZE = 57532
-const LESS = 57533
-const THAN = 57534
-const PROCEDURE = 57535
-const TRIGGER = 57536
-const STATUS = 57537
-const VARIABLES = 57538
-const ROLE = 57539
-const PROXY = 57540
-const AVG_ROW_LENGTH = 57541
-const STORAGE = 57542
-const DISK = 57543
-const MEMORY = 57544
-const CHECKSUM = 57545
-const COMPRESSION = 57546
-const DATA = 57547
-const DIRECTORY = 57548
-const DELAY_KEY_WRITE = 57549
-const ENCRYPTION = 57550
-const ENGINE = 57551
-const MAX_ROWS = 57552
-const MIN_ROWS = 57553
-const PACK_KEYS = 57554
-const ROW_FORMAT = 57555
-const STATS_AUTO_RECALC = 57556
-const STATS_PERSISTENT = 57557
-const STATS_SAMPLE_PAGES = 57558
-const DYNAMIC = 57559
-const COMPRESSED = 57560
-const REDUNDANT = 57561
-const COMPACT = 57562
-const FIXED = 57563
-const COLUMN_FORMAT = 57564
-const AUTO_RANDOM = 57565
-const RESTRICT = 57566
-const CASCADE = 57567
-const ACTION = 57568
-const PARTIAL = 57569
-const SIMPLE = 57570
-const CHECK = 57571
-const ENFORCED = 57572
-const RANGE = 57573
-const LIST = 57574
-const ALGORITHM = 57575
-const LINEAR = 57576
-const PARTITIONS = 57577
-const SUBPARTITION = 57578
-const SUBPARTITIONS = 57579
-const TYPE = 57580
-const PROPERTIES = 57581
-const PARSER = 57582
-const VISIBLE = 57583
-const INVISIBLE = 57584
-const BTREE = 57585
-const HASH = 57586
-const RTREE = 57587
-const BSI = 57588
-const ZONEMAP = 57589
-const EXPIRE = 57590
-const ACCOUNT = 57591
-const UNLOCK = 57592
-const DAY = 57593
-const NEVER = 57594
-const SECOND = 57595
-const ASCII = 57596
-const COALESCE = 57597
-const COLLATION = 57598
-const HOUR = 57599
-const MICROSECOND = 57600
-const MINUTE = 57601
-const MONTH = 57602
-const QUARTER = 57603
-const REPEAT = 57604
-const REVERSE = 57605
-const ROW_COUNT = 57606
-const WEEK = 57607
-const REVOKE = 57608
-const FUNCTION = 57609
-const PRIVILEGES = 57610
-const TABLESPACE = 57611
-const EXECUTE = 57612
-const SUPER = 57613
-const GRANT = 57614
-const OPTION = 57615
-const REFERENCES = 57616
-const REPLICATION = 57617
-const SLAVE = 57618
-const CLIENT = 57619
-const USAGE = 57620
-const RELOAD = 57621
-const FILE = 57622
-const TEMPORARY = 57623
-const ROUTINE = 57624
-const EVENT = 57625
-const SHUTDOWN = 57626
-const NULLX = 57627
-const AUTO_INCREMENT = 57628
-const APPROXNUM = 57629
-const SIGNED = 57630
-const UNSIGNED = 57631
-const ZEROFILL = 57632
-const USER = 57633
-const IDENTIFIED = 57634
-const CIPHER = 57635
-const ISSUER = 57636
-const X509 = 57637
-const SUBJECT = 57638
-const SAN = 57639
-const REQUIRE = 57640
-const SSL = 57641
-const NONE = 57642
-const PASSWORD = 57643
-const MAX_QUERIES_PER_HOUR = 57644
-const MAX_UPDATES_PER_HOUR = 57645
-const MAX_CONNECTIONS_PER_HOUR = 57646
-const MAX_USER_CONNECTIONS = 57647
-const FORMAT = 57648
-const VERBOSE = 57649
-const CONNECTION = 57650
-const LOAD = 57651
-const INFILE = 57652
-const TERMINATED = 57653
-const OPTIONALLY = 57654
-const ENCLOSED = 57655
-const ESCAPED = 57656
-const STARTING = 57657
-const LINES = 57658
-const DATABASES = 57659
-const TABLES = 57660
-const EXTENDED = 57661
-const FULL = 57662
-const PROCESSLIST = 57663
-const FIELDS = 57664
-const COLUMNS = 57665
-const OPEN = 57666
-const ERRORS = 57667
-const WARNINGS = 57668
-const INDEXES = 57669
-const NAMES = 57670
-const GLOBAL = 57671
-const SESSION = 57672
-const ISOLATION = 57673
-const LEVEL = 57674
-const READ = 57675
-const WRITE = 57676
-const ONLY = 57677
-const REPEATABLE = 57678
-const COMMITTED = 57679
-const UNCOMMITTED = 57680
-const SERIALIZABLE = 57681
-const LOCAL = 57682
-const EXCEPT = 57683
-const CURRENT_TIMESTAMP = 57684
-const DATABASE = 57685
-const CURRENT_TIME = 57686
-const LOCALTIME = 57687
-const LOCALTIMESTAMP = 57688
-const UTC_DATE = 57689
-const UTC_TIME = 57690
-const UTC_TIMESTAMP = 57691
-const REPLACE = 57692
-const CONVERT = 57693
-const SEPARATOR = 57694
-const CURRENT_DATE = 57695
-const CURRENT_USER = 57696
-const CURRENT_ROLE = 57697
-const SECOND_MICROSECOND = 57698
-const MINUTE_MICROSECOND = 57699
-const MINUTE_SECOND = 57700
-const HOUR_MICROSECOND = 57701
-const HOUR_SECOND = 57702
-const HOUR_MINUTE = 57703
-const DAY_MICROSECOND = 57704
-const DAY_SECOND = 57705
-const DAY_MINUTE = 57706
-const DAY_HOUR = 57707
-const YEAR_MONTH = 57708
-const SQL_TSI_HOUR = 57709
-const SQL_TSI_DAY = 57710
-const SQL_TSI_WEEK = 57711
-const SQL_TSI_MONTH = 57712
-const SQL_TSI_QUARTER = 57713
-const SQL_TSI_YEAR = 57714
-const SQL_TSI_SECOND = 57715
-const SQL_TSI_MINUTE = 57716
-const RECURSIVE = 57717
-const MATCH = 57718
-const AGAINST = 57719
-const BOOLEAN = 57720
-const LANGUAGE = 57721
-const WITH = 57722
-const QUERY = 57723
-const EXPANSION = 57724
-const ADDDATE = 57725
-const BIT_AND = 57726
-const BIT_OR = 57727
-const BIT_XOR = 57728
-const CAST = 57729
-const COUNT = 57730
-const APPROX_COUNT_DISTINCT = 57731
-const APPROX_PERCENTILE = 57732
-const CURDATE = 57733
-const CURTIME = 57734
-const DATE_ADD = 57735
-const DATE_SUB = 57736
-const EXTRACT = 57737
-const GROUP_CONCAT = 57738
-const MAX = 57739
-const MID = 57740
-const MIN = 57741
-const NOW = 57742
-const POSITION = 57743
-const SESSION_USER = 57744
-const STD = 57745
-const STDDEV = 57746
-const STDDEV_POP = 57747
-const STDDEV_SAMP = 57748
-const SUBDATE = 57749
-const SUBSTR = 57750
-const SUBSTRING = 57751
-const SUM = 57752
-const SYSDATE = 57753
-const SYSTEM_USER = 57754
-const TRANSLATE = 57755
-const TRIM = 57756
-const VARIANCE = 57757
-const VAR_POP = 57758
-const VAR_SAMP = 57759
-const AVG = 57760
-const ROW = 57761
-const OUTFILE = 57762
-const HEADER = 57763
-const MAX_FILE_SIZE = 57764
-const FORCE_QUOTE = 57765
-const UNUSED = 57766
+const LOWER_THAN_TIME_UNIT = 57415
+const YEAR = 57416
+const MONTH = 57417
+const QUARTER = 57418
+const UNIQUE = 57419
+const KEY = 57420
+const OR = 57421
+const XOR = 57422
+const AND = 57423
+const NOT = 57424
+const BETWEEN = 57425
+const CASE = 57426
+const WHEN = 57427
+const THEN = 57428
+const ELSE = 57429
+const END = 57430
+const LE = 57431
+const GE = 57432
+const NE = 57433
+const NULL_SAFE_EQUAL = 57434
+const IS = 57435
+const LIKE = 57436
+const REGEXP = 57437
+const IN = 57438
+const ASSIGNMENT = 57439
+const SHIFT_LEFT = 57440
+const SHIFT_RIGHT = 57441
+const JSON_EXTRACT_OP = 57442
+const JSON_UNQUOTE_EXTRACT_OP = 57443
+const DIV = 57444
+const MOD = 57445
+const UNARY = 57446
+const COLLATE = 57447
+const BINARY = 57448
+const UNDERSCORE_BINARY = 57449
+const INTERVAL = 57450
+const BEGIN = 57451
+const START = 57452
+const TRANSACTION = 57453
+const COMMIT = 57454
+const ROLLBACK = 57455
+const WORK = 57456
+const CONSISTENT = 57457
+const SNAPSHOT = 57458
+const CHAIN = 57459
+const NO = 57460
+const RELEASE = 57461
+const PREPARE = 57462
+const DEALLOCATE = 57463
+const BIT = 57464
+const TINYINT = 57465
+const SMALLINT = 57466
+const MEDIUMINT = 57467
+const INT = 57468
+const INTEGER = 57469
+const BIGINT = 57470
+const INTNUM = 57471
+const REAL = 57472
+const DOUBLE = 57473
+const FLOAT_TYPE = 57474
+const DECIMAL = 57475
+const NUMERIC = 57476
+const TIME = 57477
+const TIMESTAMP = 57478
+const DATETIME = 57479
+const CHAR = 57480
+const VARCHAR = 57481
+const BOOL = 57482
+const CHARACTER = 57483
+const VARBINARY = 57484
+const NCHAR = 57485
+const TEXT = 57486
+const TINYTEXT = 57487
+const MEDIUMTEXT = 57488
+const LONGTEXT = 57489
+const BLOB = 57490
+const TINYBLOB = 57491
+const MEDIUMBLOB = 57492
+const LONGBLOB = 57493
+const JSON = 57494
+const ENUM = 57495
+const GEOMETRY = 57496
+const POINT = 57497
+const LINESTRING = 57498
+const POLYGON = 57499
+const GEOMETRYCOLLECTION = 57500
+const MULTIPOINT = 57501
+const MULTILINESTRING = 57502
+const MULTIPOLYGON = 57503
+const INT1 = 57504
+const INT2 = 57505
+const INT3 = 57506
+const INT4 = 57507
+const INT8 = 57508
+const CREATE = 57509
+const ALTER = 57510
+const DROP = 57511
+const RENAME = 57512
+const ANALYZE = 57513
+const ADD = 57514
+const SCHEMA = 57515
+const TABLE = 57516
+const INDEX = 57517
+const VIEW = 57518
+const TO = 57519
+const IGNORE = 57520
+const IF = 57521
+const PRIMARY = 57522
+const COLUMN = 57523
+const CONSTRAINT = 57524
+const SPATIAL = 57525
+const FULLTEXT = 57526
+const FOREIGN = 57527
+const KEY_BLOCK_SIZE = 57528
+const SHOW = 57529
+const DESCRIBE = 57530
+const EXPLAIN = 57531
+const DATE = 57532
+const ESCAPE = 57533
+const REPAIR = 57534
+const OPTIMIZE = 57535
+const TRUNCATE = 57536
+const MAXVALUE = 57537
+const PARTITION = 57538
+const REORGANIZE = 57539
+const LESS = 57540
+const THAN = 57541
+const PROCEDURE = 57542
+const TRIGGER = 57543
+const STATUS = 57544
+const STATS = 57545
+const VARIABLES = 57546
+const ROLE = 57547
+const PROXY = 57548
+const AVG_ROW_LENGTH = 57549
+const STORAGE = 57550
+const DISK = 57551
+const MEMORY = 57552
+const CHECKSUM = 57553
+const COMPRESSION = 57554
+const DATA = 57555
+const DIRECTORY = 57556
+const DELAY_KEY_WRITE = 57557
+const ENCRYPTION = 57558
+const ENGINE = 57559
+const MAX_ROWS = 57560
+const MIN_ROWS = 57561
+const PACK_KEYS = 57562
+const ROW_FORMAT = 57563
+const STATS_AUTO_RECALC = 57564
+const STATS_PERSISTENT = 57565
+const STATS_SAMPLE_PAGES = 57566
+const DYNAMIC = 57567
+const COMPRESSED = 57568
+const REDUNDANT = 57569
+const COMPACT = 57570
+const FIXED = 57571
+const COLUMN_FORMAT = 57572
+const AUTO_RANDOM = 57573
+const RESTRICT = 57574
+const CASCADE = 57575
+const ACTION = 57576
+const PARTIAL = 57577
+const SIMPLE = 57578
+const CHECK = 57579
+const ENFORCED = 57580
+const RANGE = 57581
+const LIST = 57582
+const ALGORITHM = 57583
+const LINEAR = 57584
+const PARTITIONS = 57585
+const SUBPARTITION = 57586
+const SUBPARTITIONS = 57587
+const TYPE = 57588
+const PROPERTIES = 57589
+const PARSER = 57590
+const VISIBLE = 57591
+const INVISIBLE = 57592
+const BTREE = 57593
+const HASH = 57594
+const RTREE = 57595
+const BSI = 57596
+const ZONEMAP = 57597
+const EXPIRE = 57598
+const ACCOUNT = 57599
+const UNLOCK = 57600
+const DAY = 57601
+const NEVER = 57602
+const SECOND = 57603
+const ASCII = 57604
+const COALESCE = 57605
+const COLLATION = 57606
+const HOUR = 57607
+const MICROSECOND = 57608
+const MINUTE = 57609
+const REPEAT = 57610
+const REVERSE = 57611
+const ROW_COUNT = 57612
+const WEEK = 57613
+const REVOKE = 57614
+const FUNCTION = 57615
+const PRIVILEGES = 57616
+const TABLESPACE = 57617
+const EXECUTE = 57618
+const SUPER = 57619
+const GRANT = 57620
+const OPTION = 57621
+const REFERENCES = 57622
+const REPLICATION = 57623
+const SLAVE = 57624
+const CLIENT = 57625
+const USAGE = 57626
+const RELOAD = 57627
+const FILE = 57628
+const TEMPORARY = 57629
+const ROUTINE = 57630
+const EVENT = 57631
+const SHUTDOWN = 57632
+const NULLX = 57633
+const AUTO_INCREMENT = 57634
+const APPROXNUM = 57635
+const SIGNED = 57636
+const UNSIGNED = 57637
+const ZEROFILL = 57638
+const USER = 57639
+const IDENTIFIED = 57640
+const CIPHER = 57641
+const ISSUER = 57642
+const X509 = 57643
+const SUBJECT = 57644
+const SAN = 57645
+const REQUIRE = 57646
+const SSL = 57647
+const NONE = 57648
+const PASSWORD = 57649
+const MAX_QUERIES_PER_HOUR = 57650
+const MAX_UPDATES_PER_HOUR = 57651
+const MAX_CONNECTIONS_PER_HOUR = 57652
+const MAX_USER_CONNECTIONS = 57653
+const FORMAT = 57654
+const VERBOSE = 57655
+const CONNECTION = 57656
+const LOAD = 57657
+const INFILE = 57658
+const TERMINATED = 57659
+const OPTIONALLY = 57660
+const ENCLOSED = 57661
+const ESCAPED = 57662
+const STARTING = 57663
+const LINES = 57664
+const DATABASES = 57665
+const TABLES = 57666
+const EXTENDED = 57667
+const FULL = 57668
+const PROCESSLIST = 57669
+const FIELDS = 57670
+const COLUMNS = 57671
+const OPEN = 57672
+const ERRORS = 57673
+const WARNINGS = 57674
+const INDEXES = 57675
+const NAMES = 57676
+const GLOBAL = 57677
+const SESSION = 57678
+const ISOLATION = 57679
+const LEVEL = 57680
+const READ = 57681
+const WRITE = 57682
+const ONLY = 57683
+const REPEATABLE = 57684
+const COMMITTED = 57685
+const UNCOMMITTED = 57686
+const SERIALIZABLE = 57687
+const LOCAL = 57688
+const EXCEPT = 57689
+const CURRENT_TIMESTAMP = 57690
+const DATABASE = 57691
+const CURRENT_TIME = 57692
+const LOCALTIME = 57693
+const LOCALTIMESTAMP = 57694
+const UTC_DATE = 57695
+const UTC_TIME = 57696
+const UTC_TIMESTAMP = 57697
+const REPLACE = 57698
+const CONVERT = 57699
+const SEPARATOR = 57700
+const CURRENT_DATE = 57701
+const CURRENT_USER = 57702
+const CURRENT_ROLE = 57703
+const SECOND_MICROSECOND = 57704
+const MINUTE_MICROSECOND = 57705
+const MINUTE_SECOND = 57706
+const HOUR_MICROSECOND = 57707
+const HOUR_SECOND = 57708
+const HOUR_MINUTE = 57709
+const DAY_MICROSECOND = 57710
+const DAY_SECOND = 57711
+const DAY_MINUTE = 57712
+const DAY_HOUR = 57713
+const YEAR_MONTH = 57714
+const SQL_TSI_HOUR = 57715
+const SQL_TSI_DAY = 57716
+const SQL_TSI_WEEK = 57717
+const SQL_TSI_MONTH = 57718
+const SQL_TSI_QUARTER = 57719
+const SQL_TSI_YEAR = 57720
+const SQL_TSI_SECOND = 57721
+const SQL_TSI_MINUTE = 57722
+const RECURSIVE = 57723
+const OF = 57724
+const OVER = 57725
+const PRECEDING = 57726
+const FOLLOWING = 57727
+const UNBOUNDED = 57728
+const CURRENT = 57729
+const ROWS = 57730
+const MATCH = 57731
+const AGAINST = 57732
+const BOOLEAN = 57733
+const LANGUAGE = 57734
+const WITH = 57735
+const QUERY = 57736
+const EXPANSION = 57737
+const ADDDATE = 57738
+const BIT_AND = 57739
+const BIT_OR = 57740
+const BIT_XOR = 57741
+const CAST = 57742
+const COUNT = 57743
+const APPROX_COUNT_DISTINCT = 57744
+const APPROX_PERCENTILE = 57745
+const CURDATE = 57746
+const CURTIME = 57747
+const DATE_ADD = 57748
+const DATE_SUB = 57749
+const EXTRACT = 57750
+const GROUP_CONCAT = 57751
+const MAX = 57752
+const MID = 57753
+const MIN = 57754
+const NOW = 57755
+const POSITION = 57756
+const SESSION_USER = 57757
+const STD = 57758
+const STDDEV = 57759
+const STDDEV_POP = 57760
+const STDDEV_SAMP = 57761
+const SUBDATE = 57762
+const SUBSTR = 57763
+const SUBSTRING = 57764
+const SUM = 57765
+const SYSDATE = 57766
+const SYSTEM_USER = 57767
+const TRANSLATE = 57768
+const TRIM = 57769
+const VARIANCE = 57770
+const VAR_POP = 57771
+const VAR_SAMP = 57772
+const AVG = 57773
+const TIMESTAMPDIFF = 57774
+const ROW = 57775
+const OUTFILE = 57776
+const HEADER = 57777
+const MAX_FILE_SIZE = 57778
+const FORCE_QUOTE = 57779
+const UNUSED = 57780
 
 var yyToknames = [...]string{
 	"$end",
@@ -518,6 +532,10 @@ var yyToknames = [...]string{
 	"EMPTY_FROM_CLAUSE",
 	"LOWER_THAN_CHARSET",
 	"CHARSET",
+	"LOWER_THAN_TIME_UNIT",
+	"YEAR",
+	"MONTH",
+	"QUARTER",
 	"UNIQUE",
 	"KEY",
 	"OR",
@@ -547,6 +565,8 @@ var yyToknames = [...]string{
 	"'&'",
 	"SHIFT_LEFT",
 	"SHIFT_RIGHT",
+	"JSON_EXTRACT_OP",
+	"JSON_UNQUOTE_EXTRACT_OP",
 	"'+'",
 	"'-'",
 	"'*'",
@@ -573,6 +593,8 @@ var yyToknames = [...]string{
 	"CHAIN",
 	"NO",
 	"RELEASE",
+	"PREPARE",
+	"DEALLOCATE",
 	"BIT",
 	"TINYINT",
 	"SMALLINT",
@@ -589,7 +611,6 @@ var yyToknames = [...]string{
 	"TIME",
 	"TIMESTAMP",
 	"DATETIME",
-	"YEAR",
 	"CHAR",
 	"VARCHAR",
 	"BOOL",
@@ -655,6 +676,7 @@ var yyToknames = [...]string{
 	"PROCEDURE",
 	"TRIGGER",
 	"STATUS",
+	"STATS",
 	"VARIABLES",
 	"ROLE",
 	"PROXY",
@@ -719,8 +741,6 @@ var yyToknames = [...]string{
 	"HOUR",
 	"MICROSECOND",
 	"MINUTE",
-	"MONTH",
-	"QUARTER",
 	"REPEAT",
 	"REVERSE",
 	"ROW_COUNT",
@@ -835,6 +855,13 @@ var yyToknames = [...]string{
 	"SQL_TSI_SECOND",
 	"SQL_TSI_MINUTE",
 	"RECURSIVE",
+	"OF",
+	"OVER",
+	"PRECEDING",
+	"FOLLOWING",
+	"UNBOUNDED",
+	"CURRENT",
+	"ROWS",
 	"MATCH",
 	"AGAINST",
 	"BOOLEAN",
@@ -878,6 +905,7 @@ var yyToknames = [...]string{
 	"VAR_POP",
 	"VAR_SAMP",
 	"AVG",
+	"TIMESTAMPDIFF",
 	"ROW",
 	"OUTFILE",
 	"HEADER",
@@ -894,1843 +922,2159 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line mysql_sql.y:6272
+//line mysql_sql.y:6625
 
 //line yacctab:1
 var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 52,
-	17, 353,
-	-2, 334,
-	-1, 56,
-	185, 490,
-	-2, 526,
+	-1, 61,
+	17, 378,
+	-2, 359,
 	-1, 65,
-	212, 243,
-	213, 243,
-	-2, 263,
-	-1, 312,
-	58, 1282,
-	443, 1282,
-	-2, 92,
-	-1, 331,
-	58, 653,
-	443, 653,
-	-2, 488,
-	-1, 332,
-	58, 481,
-	443, 481,
-	-2, 489,
-	-1, 338,
-	17, 354,
-	-2, 317,
-	-1, 560,
-	17, 354,
-	-2, 317,
-	-1, 593,
-	54, 779,
-	-2, 1323,
+	192, 516,
+	-2, 552,
+	-1, 74,
+	219, 268,
+	221, 268,
+	-2, 288,
+	-1, 329,
+	58, 1348,
+	457, 1348,
+	-2, 103,
+	-1, 348,
+	58, 679,
+	457, 679,
+	-2, 514,
+	-1, 349,
+	58, 507,
+	457, 507,
+	-2, 515,
+	-1, 364,
+	17, 379,
+	-2, 342,
 	-1, 594,
-	54, 780,
-	-2, 1324,
-	-1, 595,
-	54, 781,
-	-2, 1325,
-	-1, 597,
-	54, 788,
-	-2, 1328,
-	-1, 598,
-	54, 787,
-	-2, 1329,
-	-1, 604,
-	54, 862,
-	-2, 1227,
-	-1, 605,
-	54, 873,
-	-2, 1287,
-	-1, 606,
-	54, 875,
-	-2, 1297,
-	-1, 607,
-	54, 863,
-	-2, 1302,
-	-1, 757,
-	1, 516,
-	56, 516,
-	442, 516,
-	-2, 523,
-	-1, 875,
-	17, 353,
-	-2, 711,
-	-1, 921,
-	119, 1001,
-	-2, 999,
-	-1, 923,
-	119, 435,
-	-2, 996,
-	-1, 924,
-	119, 436,
-	-2, 997,
-	-1, 1115,
-	1, 517,
-	56, 517,
-	442, 517,
-	-2, 523,
-	-1, 1543,
-	75, 523,
-	115, 523,
-	148, 523,
-	151, 523,
-	-2, 563,
-	-1, 1545,
-	246, 678,
-	-2, 659,
-	-1, 1653,
-	75, 523,
-	115, 523,
-	148, 523,
-	151, 523,
-	-2, 564,
-	-1, 1681,
-	246, 678,
-	-2, 660,
-	-1, 2066,
-	55, 538,
-	56, 538,
-	-2, 523,
-	-1, 2070,
-	55, 538,
-	56, 538,
-	-2, 523,
-	-1, 2082,
-	55, 542,
+	17, 379,
+	-2, 342,
+	-1, 611,
+	54, 871,
+	-2, 1374,
+	-1, 620,
+	54, 869,
+	-2, 1384,
+	-1, 621,
+	54, 870,
+	-2, 1385,
+	-1, 627,
+	54, 808,
+	-2, 1394,
+	-1, 628,
+	54, 809,
+	-2, 1395,
+	-1, 629,
+	54, 810,
+	-2, 1396,
+	-1, 631,
+	54, 872,
+	-2, 1398,
+	-1, 632,
+	54, 834,
+	-2, 1399,
+	-1, 633,
+	54, 833,
+	-2, 1400,
+	-1, 641,
+	54, 917,
+	-2, 1292,
+	-1, 642,
+	54, 928,
+	-2, 1353,
+	-1, 643,
+	54, 930,
+	-2, 1363,
+	-1, 644,
+	54, 918,
+	-2, 1368,
+	-1, 814,
+	1, 542,
 	56, 542,
-	-2, 523,
-	-1, 2085,
-	55, 543,
+	456, 542,
+	-2, 549,
+	-1, 936,
+	17, 378,
+	-2, 737,
+	-1, 989,
+	125, 1062,
+	-2, 1060,
+	-1, 991,
+	125, 461,
+	-2, 1057,
+	-1, 992,
+	125, 462,
+	-2, 1058,
+	-1, 1191,
+	1, 543,
 	56, 543,
-	-2, 523,
+	456, 543,
+	-2, 549,
+	-1, 1423,
+	254, 704,
+	-2, 685,
+	-1, 1610,
+	254, 704,
+	-2, 686,
+	-1, 1743,
+	75, 549,
+	121, 549,
+	155, 549,
+	158, 549,
+	-2, 589,
+	-1, 1839,
+	75, 549,
+	121, 549,
+	155, 549,
+	158, 549,
+	-2, 590,
+	-1, 2209,
+	55, 564,
+	56, 564,
+	-2, 549,
+	-1, 2213,
+	55, 564,
+	56, 564,
+	-2, 549,
+	-1, 2225,
+	55, 568,
+	56, 568,
+	-2, 549,
+	-1, 2228,
+	55, 569,
+	56, 569,
+	-2, 549,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 16993
+const yyLast = 20021
 
 var yyAct = [...]int{
-	749, 1168, 2072, 2070, 2069, 2077, 2043, 610, 2017, 1650,
-	738, 1914, 628, 1988, 2032, 1693, 1972, 1887, 1973, 1646,
-	547, 1864, 1823, 513, 83, 1648, 1526, 288, 810, 1105,
-	1875, 453, 1816, 299, 1649, 545, 1538, 86, 1330, 1443,
-	83, 301, 1682, 1793, 388, 1608, 1716, 1715, 1609, 333,
-	333, 1611, 500, 1439, 1412, 794, 581, 82, 1616, 608,
-	1620, 1444, 1448, 1459, 1590, 1477, 1305, 1421, 1108, 903,
-	1476, 1366, 389, 690, 294, 609, 517, 817, 410, 918,
-	921, 912, 83, 555, 3, 904, 619, 1169, 1246, 913,
-	1433, 292, 19, 1299, 1232, 732, 291, 12, 289, 6,
-	51, 735, 290, 5, 787, 762, 1657, 1116, 751, 733,
-	707, 1170, 339, 1183, 1167, 419, 574, 791, 338, 637,
-	52, 763, 764, 1087, 281, 491, 1078, 571, 284, 430,
-	812, 455, 409, 847, 381, 538, 724, 556, 441, 305,
-	1094, 470, 304, 79, 1735, 1642, 52, 1525, 746, 906,
-	308, 308, 407, 78, 1906, 23, 39, 24, 1090, 78,
-	1284, 303, 524, 340, 416, 78, 1413, 76, 78, 295,
-	1300, 19, 1931, 64, 1291, 400, 12, 71, 6, 781,
-	520, 78, 5, 490, 78, 395, 23, 39, 24, 405,
-	404, 397, 687, 399, 401, 684, 40, 350, 1294, 52,
-	766, 74, 78, 368, 23, 39, 24, 74, 1389, 525,
-	1960, 335, 1958, 74, 776, 777, 686, 512, 358, 403,
-	511, 514, 515, 514, 515, 1976, 1977, 741, 485, 74,
-	1992, 481, 74, 1814, 1416, 1895, 396, 1817, 1818, 1819,
-	1820, 1898, 1417, 382, 1418, 522, 1738, 1527, 745, 433,
-	74, 1422, 1423, 1424, 1425, 1271, 424, 1308, 1306, 1303,
-	1307, 1309, 788, 1302, 1301, 1460, 1463, 67, 68, 1090,
-	69, 70, 1308, 1306, 1092, 1307, 1309, 369, 1792, 1702,
-	1701, 83, 423, 472, 483, 484, 1905, 1522, 1698, 471,
-	1639, 422, 482, 725, 83, 1805, 1603, 1426, 1876, 1877,
-	1878, 1880, 1879, 1955, 352, 1602, 1599, 1962, 1799, 2062,
-	476, 2078, 1999, 402, 349, 348, 1957, 1462, 1975, 727,
-	457, 1916, 2006, 1939, 56, 66, 75, 437, 38, 1311,
-	1312, 1313, 1314, 1912, 1913, 344, 1916, 458, 477, 1787,
-	433, 2053, 1778, 1478, 65, 63, 62, 1889, 1908, 1909,
-	1756, 1755, 337, 1292, 1922, 421, 521, 1964, 1965, 534,
-	510, 509, 2073, 479, 2079, 406, 1489, 1486, 1487, 1488,
-	2044, 1483, 1744, 1482, 1481, 1479, 418, 1367, 333, 480,
-	501, 523, 1893, 467, 389, 389, 389, 1288, 400, 503,
-	1139, 435, 434, 726, 1600, 1098, 1452, 502, 392, 504,
-	1523, 462, 505, 293, 373, 52, 52, 401, 1328, 410,
-	474, 779, 577, 1135, 426, 427, 1618, 1617, 528, 353,
-	463, 689, 475, 478, 550, 1137, 1136, 1480, 780, 343,
-	48, 1134, 473, 526, 527, 1782, 49, 704, 1750, 423,
-	83, 83, 83, 83, 778, 370, 860, 2035, 708, 371,
-	2057, 721, 2021, 375, 374, 459, 460, 461, 548, 1419,
-	496, 1340, 1282, 1281, 1270, 1264, 1129, 333, 333, 423,
-	333, 394, 1103, 50, 1319, 457, 506, 493, 739, 308,
-	1072, 351, 435, 434, 829, 692, 722, 1907, 333, 333,
-	552, 1963, 458, 699, 700, 514, 515, 514, 515, 576,
-	1413, 333, 428, 333, 1453, 757, 436, 83, 495, 685,
-	1204, 559, 561, 1888, 549, 487, 558, 397, 560, 420,
-	789, 771, 544, 333, 756, 1093, 469, 1308, 1306, 1110,
-	1307, 1309, 1484, 1485, 801, 333, 389, 52, 333, 533,
-	759, 1285, 1598, 769, 77, 1601, 2036, 1780, 52, 1407,
-	77, 1779, 1405, 802, 518, 1089, 77, 695, 758, 77,
-	537, 570, 396, 2039, 557, 333, 333, 809, 83, 308,
-	410, 740, 77, 818, 772, 77, 703, 827, 516, 2030,
-	519, 392, 748, 743, 702, 752, 760, 761, 720, 813,
-	1849, 767, 507, 77, 768, 1505, 753, 1434, 744, 1406,
-	1926, 811, 1449, 1452, 308, 1088, 814, 737, 728, 709,
-	710, 711, 712, 1172, 1171, 877, 1266, 1141, 773, 747,
-	1076, 1317, 425, 742, 1783, 1784, 795, 541, 542, 543,
-	536, 1200, 795, 1197, 1247, 765, 308, 1199, 1196, 1198,
-	1202, 1203, 539, 804, 1247, 1201, 1372, 790, 564, 565,
-	566, 567, 568, 540, 394, 1377, 754, 1319, 2033, 2034,
-	807, 1164, 800, 824, 755, 1789, 830, 308, 1239, 1788,
-	786, 1594, 1165, 365, 825, 826, 824, 797, 798, 799,
-	508, 803, 1237, 1238, 1236, 1589, 805, 910, 910, 915,
-	878, 879, 880, 881, 72, 1969, 785, 826, 824, 806,
-	1177, 876, 815, 372, 1773, 1341, 818, 882, 2068, 884,
-	886, 1453, 2049, 923, 400, 887, 1446, 825, 826, 824,
-	1447, 1450, 825, 826, 824, 901, 1180, 808, 1860, 1858,
-	924, 1318, 551, 875, 854, 1182, 1185, 1186, 1187, 1188,
-	1189, 1190, 1191, 1192, 1193, 1194, 1195, 1207, 1208, 1209,
-	1210, 1211, 1212, 1205, 1206, 459, 460, 461, 1540, 83,
-	459, 460, 461, 548, 1859, 1857, 288, 1826, 398, 825,
-	826, 824, 1451, 1131, 376, 893, 909, 1507, 2000, 917,
-	1074, 2052, 333, 1073, 813, 1375, 1996, 400, 1374, 825,
-	826, 824, 1119, 1944, 1891, 1850, 1852, 1853, 1854, 1851,
-	1856, 814, 333, 916, 1846, 2050, 401, 1890, 1866, 397,
-	1844, 825, 826, 824, 1541, 546, 52, 1647, 362, 549,
-	1843, 577, 2051, 83, 922, 1070, 363, 1071, 1842, 1161,
-	1162, 1839, 1833, 1120, 1121, 1122, 1855, 1830, 1083, 1123,
-	1845, 1829, 1132, 459, 460, 461, 548, 1178, 1179, 1086,
-	859, 858, 868, 869, 861, 862, 863, 864, 865, 866,
-	867, 860, 1796, 1736, 1117, 1730, 1097, 1729, 1728, 901,
-	1125, 1727, 1127, 868, 869, 861, 862, 863, 864, 865,
-	866, 867, 860, 308, 1347, 795, 795, 795, 1724, 1166,
-	1126, 765, 1128, 1154, 1124, 1534, 1254, 1157, 1533, 1532,
-	1531, 1401, 549, 1146, 693, 459, 460, 461, 576, 1993,
-	1968, 1865, 1158, 1159, 1160, 1142, 1143, 1144, 1220, 1221,
-	1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231,
-	1147, 1175, 1148, 1241, 1242, 1566, 1954, 1155, 1933, 825,
-	826, 824, 1920, 1138, 1919, 1847, 1840, 2083, 1240, 1632,
-	1836, 1835, 1834, 1173, 1174, 1794, 1176, 1234, 1775, 1256,
-	1737, 1331, 1213, 1214, 1215, 1216, 1645, 1217, 1218, 1219,
-	1685, 1643, 1542, 1248, 1431, 360, 1251, 361, 368, 2027,
-	1430, 1429, 359, 357, 356, 364, 1631, 366, 367, 858,
-	868, 869, 861, 862, 863, 864, 865, 866, 867, 860,
-	1269, 1249, 1428, 1250, 1252, 1688, 1100, 1099, 825, 826,
-	824, 1683, 897, 1255, 1258, 1257, 896, 1696, 1697, 895,
-	694, 1554, 1684, 2082, 859, 858, 868, 869, 861, 862,
-	863, 864, 865, 866, 867, 860, 1573, 1577, 1579, 1581,
-	1583, 1584, 1586, 2060, 1489, 1486, 1487, 1488, 1812, 1568,
-	1569, 1570, 1571, 1552, 1553, 1574, 1689, 1555, 1941, 1556,
-	1557, 1558, 1559, 1560, 1561, 1562, 1563, 1564, 1565, 1572,
-	825, 826, 824, 1272, 1106, 1107, 423, 1576, 1578, 1580,
-	1582, 1585, 1940, 1380, 1927, 708, 1343, 1379, 1343, 2087,
-	1873, 333, 2038, 871, 333, 874, 1807, 423, 342, 333,
-	2081, 2080, 1096, 2063, 1297, 1567, 1287, 1806, 341, 872,
-	873, 870, 1633, 859, 858, 868, 869, 861, 862, 863,
-	864, 865, 866, 867, 860, 2059, 2058, 825, 826, 824,
-	1325, 1695, 1630, 1445, 863, 864, 865, 866, 867, 860,
-	333, 861, 862, 863, 864, 865, 866, 867, 860, 563,
-	83, 83, 1096, 2047, 1336, 1096, 2046, 1629, 1691, 1102,
-	2020, 2019, 1316, 1607, 1276, 1740, 1983, 1277, 1740, 1978,
-	1279, 1543, 833, 834, 835, 836, 837, 838, 1348, 831,
-	1690, 1692, 1514, 1333, 1334, 1804, 1275, 1274, 1626, 1295,
-	1296, 1465, 752, 397, 1289, 1286, 1101, 1321, 1150, 1966,
-	1283, 1464, 1322, 1383, 1323, 1513, 1381, 825, 826, 824,
-	825, 826, 824, 1298, 1952, 1951, 1504, 1740, 1937, 825,
-	826, 824, 1117, 1315, 1740, 1936, 1326, 825, 826, 824,
-	1740, 1935, 1698, 1498, 1324, 1329, 1335, 1361, 825, 826,
-	824, 1740, 1934, 19, 1686, 1378, 1332, 1376, 12, 1352,
-	6, 1497, 1925, 1924, 5, 825, 826, 824, 1344, 1349,
-	1496, 1345, 1346, 910, 1342, 1393, 910, 1871, 1872, 1396,
-	1495, 52, 1327, 825, 826, 824, 1871, 1870, 1253, 818,
-	723, 333, 825, 826, 824, 333, 333, 1811, 1810, 333,
-	562, 1399, 825, 826, 824, 1494, 1809, 1808, 1364, 1365,
-	1575, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1400, 1740,
-	1739, 83, 1343, 1363, 2025, 1493, 1388, 825, 826, 824,
-	1259, 423, 1395, 1234, 1153, 1517, 1343, 1499, 1343, 1490,
-	1442, 1362, 1369, 1392, 1371, 1373, 400, 825, 826, 824,
-	83, 1470, 1343, 1351, 1432, 1385, 1384, 1394, 795, 1544,
-	1397, 1398, 1391, 1390, 795, 875, 1403, 691, 1402, 859,
-	858, 868, 869, 861, 862, 863, 864, 865, 866, 867,
-	860, 1427, 1343, 1350, 1153, 1273, 1404, 1268, 1267, 2029,
-	1492, 52, 822, 1475, 1411, 466, 1408, 1410, 1262, 1261,
-	1153, 1152, 1096, 1095, 2023, 1474, 1454, 1455, 2007, 1473,
-	1075, 1456, 825, 826, 824, 825, 826, 824, 1435, 1436,
-	333, 1509, 697, 696, 1469, 1243, 1511, 825, 826, 824,
-	1470, 825, 826, 824, 486, 1503, 820, 1090, 465, 467,
-	464, 1472, 1515, 1339, 465, 1500, 467, 825, 826, 824,
-	1265, 1491, 1512, 1502, 1244, 1508, 1588, 1150, 1104, 569,
-	78, 535, 2004, 2002, 691, 1943, 1539, 1885, 1869, 1516,
-	1506, 438, 1867, 1862, 1821, 1510, 1802, 1801, 1800, 1537,
-	1606, 1797, 443, 446, 447, 448, 444, 1786, 445, 449,
-	1521, 1771, 1610, 443, 446, 447, 448, 444, 1530, 445,
-	449, 1712, 1709, 1535, 1708, 1612, 1985, 1621, 74, 1624,
-	1595, 1592, 1536, 1235, 1320, 1587, 1278, 1260, 1551, 1151,
-	1591, 1518, 1591, 1140, 1593, 1133, 572, 902, 900, 899,
-	333, 333, 1597, 898, 83, 894, 848, 1613, 1614, 1615,
-	1596, 891, 889, 888, 885, 74, 857, 423, 1501, 856,
-	855, 853, 852, 851, 1619, 423, 1654, 850, 1622, 849,
-	1625, 846, 845, 844, 1442, 1605, 843, 1640, 1627, 859,
-	858, 868, 869, 861, 862, 863, 864, 865, 866, 867,
-	860, 1635, 842, 841, 840, 839, 1638, 705, 1634, 688,
-	443, 446, 447, 448, 444, 1699, 445, 449, 468, 1798,
-	1717, 1719, 1113, 1717, 1717, 1628, 1703, 2012, 1679, 2010,
-	1706, 1707, 1705, 795, 1704, 1079, 1080, 1974, 1310, 1149,
-	1082, 488, 302, 717, 1710, 715, 1713, 1714, 718, 1382,
-	716, 1636, 1637, 859, 858, 868, 869, 861, 862, 863,
-	864, 865, 866, 867, 860, 1085, 719, 1718, 447, 448,
-	1720, 1721, 1084, 1726, 714, 713, 2067, 1722, 1263, 553,
-	554, 1118, 1106, 1107, 1519, 1414, 1746, 492, 1111, 775,
-	816, 1520, 334, 1368, 1733, 859, 858, 868, 869, 861,
-	862, 863, 864, 865, 866, 867, 860, 412, 414, 415,
-	1069, 451, 494, 1723, 859, 858, 868, 869, 861, 862,
-	863, 864, 865, 866, 867, 860, 1741, 1172, 1171, 83,
-	498, 499, 1749, 2024, 1948, 1946, 1900, 1899, 1897, 1827,
-	1539, 1822, 1644, 1604, 1529, 1528, 1468, 342, 497, 341,
-	1731, 1699, 1467, 1719, 1772, 691, 1776, 341, 1338, 2014,
-	2013, 2013, 1790, 1353, 1280, 280, 2014, 450, 354, 1742,
-	1, 423, 701, 432, 698, 1774, 1747, 1748, 1828, 1751,
-	1752, 1753, 1754, 1795, 431, 1757, 1758, 1759, 1760, 1761,
-	1762, 1763, 1764, 1765, 1766, 1767, 1768, 1769, 1770, 1803,
-	1861, 1825, 429, 73, 1245, 1824, 1184, 638, 905, 911,
-	457, 1863, 1984, 2016, 1942, 1987, 627, 611, 1892, 1415,
-	1813, 1841, 1894, 1815, 1293, 1732, 1290, 458, 423, 489,
-	1386, 423, 423, 423, 859, 858, 868, 869, 861, 862,
-	863, 864, 865, 866, 867, 860, 1387, 650, 640, 890,
-	641, 683, 1902, 1874, 413, 639, 1882, 1883, 1884, 1725,
-	1881, 1461, 347, 411, 355, 1791, 1524, 1700, 1623, 1711,
-	1831, 1832, 1903, 1181, 2076, 2066, 1837, 1838, 1896, 2042,
-	2022, 1915, 2061, 1956, 2005, 1998, 1910, 1911, 1743, 306,
-	782, 529, 379, 83, 1886, 386, 706, 1917, 1918, 1420,
-	423, 1304, 1109, 1091, 734, 307, 1904, 1868, 345, 1112,
-	346, 1115, 1114, 832, 1233, 892, 423, 883, 579, 1370,
-	618, 1923, 612, 1458, 1457, 1932, 811, 1694, 770, 26,
-	452, 823, 919, 85, 1130, 920, 1901, 1734, 1989, 626,
-	625, 1938, 624, 623, 442, 440, 439, 1947, 298, 1949,
-	1950, 1945, 297, 1337, 1466, 819, 821, 1971, 1970, 1929,
-	1930, 1641, 1785, 1848, 1781, 1777, 1959, 1961, 1921, 1653,
-	1652, 1680, 1681, 1687, 1991, 1550, 1546, 1967, 1548, 1549,
-	1547, 1545, 1440, 1995, 1441, 1928, 1990, 1979, 1980, 1981,
-	1982, 1438, 1437, 1081, 1077, 907, 914, 417, 1994, 750,
-	80, 296, 1156, 573, 11, 18, 1997, 17, 16, 47,
-	46, 45, 44, 15, 8, 43, 42, 2008, 41, 14,
-	2011, 2009, 13, 2018, 37, 36, 1953, 35, 34, 2015,
-	33, 32, 31, 423, 30, 423, 29, 28, 27, 9,
-	55, 54, 739, 2026, 739, 2028, 53, 20, 2001, 21,
-	2003, 1991, 2041, 22, 61, 60, 59, 58, 57, 2037,
-	423, 25, 10, 1990, 2040, 7, 2045, 4, 2, 739,
-	2048, 0, 0, 0, 0, 0, 2018, 2054, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2064, 0,
-	0, 0, 0, 0, 0, 0, 2065, 2031, 0, 0,
-	0, 0, 0, 2075, 0, 2074, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 2086, 2085, 2084, 2075, 0,
-	0, 0, 1037, 1023, 0, 985, 1039, 957, 973, 1047,
-	975, 976, 1010, 935, 994, 210, 971, 927, 960, 961,
-	929, 968, 930, 958, 987, 154, 956, 1026, 997, 179,
-	1045, 181, 0, 0, 239, 194, 0, 2056, 990, 1028,
-	992, 1015, 984, 1011, 943, 1004, 1040, 972, 1008, 1041,
-	0, 0, 0, 0, 459, 460, 461, 0, 0, 0,
-	0, 137, 0, 0, 0, 0, 0, 1007, 1033, 970,
-	0, 0, 944, 1038, 991, 1009, 0, 928, 1005, 0,
-	933, 936, 1046, 1031, 965, 966, 0, 0, 0, 0,
-	0, 0, 0, 988, 993, 1012, 981, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 962, 0, 1001, 0,
-	0, 0, 938, 934, 0, 986, 0, 128, 244, 258,
-	138, 235, 271, 142, 242, 134, 209, 231, 130, 256,
-	241, 191, 173, 174, 129, 0, 226, 152, 165, 149,
-	207, 1035, 1036, 148, 274, 937, 266, 132, 133, 265,
-	206, 253, 257, 192, 186, 131, 255, 190, 185, 177,
-	156, 169, 219, 184, 220, 170, 196, 195, 197, 1057,
-	1058, 1059, 1060, 1061, 942, 0, 963, 1013, 0, 926,
-	1022, 1029, 983, 268, 1032, 980, 979, 1064, 0, 1063,
-	243, 1065, 1066, 178, 1027, 959, 969, 964, 967, 229,
-	212, 1034, 1000, 217, 227, 182, 254, 221, 259, 245,
-	267, 1016, 222, 124, 246, 151, 193, 135, 136, 147,
-	153, 155, 157, 158, 202, 203, 215, 234, 247, 248,
-	249, 150, 143, 228, 144, 167, 145, 125, 236, 146,
-	126, 216, 252, 1062, 164, 224, 189, 127, 188, 218,
-	251, 250, 275, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 925, 263, 0, 208, 1024, 931, 941,
-	939, 977, 1002, 1003, 204, 279, 1018, 1021, 1019, 1048,
-	232, 0, 0, 0, 0, 0, 172, 214, 0, 233,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	932, 0, 240, 261, 273, 264, 978, 950, 989, 272,
-	953, 951, 1017, 952, 1006, 1050, 198, 199, 200, 201,
-	974, 0, 141, 998, 982, 1051, 1052, 1053, 1054, 1055,
-	1056, 955, 1030, 160, 166, 0, 168, 140, 213, 163,
-	270, 175, 205, 171, 237, 176, 183, 225, 269, 211,
-	230, 139, 260, 238, 187, 162, 949, 954, 948, 995,
-	996, 1042, 1043, 1044, 1014, 940, 1025, 945, 947, 946,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1020,
-	999, 123, 0, 180, 1049, 223, 159, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 78, 0, 646,
-	0, 0, 0, 1067, 1068, 276, 277, 278, 262, 210,
-	0, 0, 0, 0, 0, 620, 0, 0, 0, 154,
-	0, 0, 0, 179, 0, 181, 0, 0, 239, 194,
-	0, 0, 0, 0, 662, 668, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 613, 0, 0, 580, 652,
-	651, 629, 0, 0, 0, 137, 630, 0, 635, 0,
-	631, 634, 632, 633, 0, 0, 654, 0, 0, 0,
-	0, 0, 578, 617, 0, 621, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 614, 615, 0, 0,
-	0, 0, 647, 0, 616, 0, 0, 649, 0, 636,
-	0, 128, 244, 258, 138, 235, 271, 142, 242, 134,
-	209, 231, 130, 256, 241, 191, 173, 174, 129, 0,
-	226, 152, 165, 149, 207, 644, 645, 148, 606, 642,
-	266, 132, 133, 265, 206, 253, 257, 192, 186, 131,
-	255, 190, 185, 177, 156, 169, 219, 184, 220, 170,
-	196, 195, 197, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 268, 0, 0,
-	660, 0, 0, 0, 243, 0, 0, 178, 0, 0,
-	0, 643, 0, 229, 212, 671, 0, 217, 227, 182,
-	254, 221, 259, 245, 267, 0, 222, 124, 246, 151,
-	193, 135, 136, 147, 153, 155, 157, 158, 202, 203,
-	215, 234, 247, 248, 249, 150, 143, 228, 144, 167,
-	145, 125, 236, 146, 126, 216, 252, 0, 164, 224,
-	189, 127, 188, 218, 251, 250, 275, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 0, 263, 658,
-	208, 670, 653, 655, 656, 659, 663, 664, 604, 607,
-	665, 667, 669, 672, 232, 0, 0, 0, 0, 0,
-	172, 214, 0, 233, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 240, 261, 273, 605,
-	0, 0, 0, 272, 0, 0, 0, 0, 0, 648,
-	198, 199, 200, 201, 661, 0, 141, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 160, 166, 0,
-	168, 140, 213, 163, 270, 175, 205, 171, 237, 176,
-	183, 225, 269, 211, 230, 139, 260, 238, 187, 162,
-	678, 657, 677, 679, 680, 676, 681, 682, 666, 622,
-	0, 674, 673, 675, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 123, 0, 180, 77, 223,
-	159, 87, 582, 583, 584, 585, 586, 587, 588, 95,
-	589, 97, 98, 590, 100, 591, 102, 592, 104, 105,
-	106, 593, 594, 595, 596, 111, 597, 598, 599, 600,
-	116, 117, 118, 119, 601, 602, 603, 646, 0, 276,
-	277, 278, 262, 0, 0, 0, 0, 210, 0, 0,
-	0, 0, 0, 620, 0, 0, 0, 154, 796, 0,
-	0, 179, 0, 181, 0, 0, 239, 194, 0, 0,
-	0, 0, 662, 668, 0, 0, 0, 0, 0, 0,
-	792, 0, 0, 613, 0, 0, 580, 652, 651, 629,
-	0, 0, 0, 137, 630, 0, 635, 0, 631, 634,
-	632, 633, 0, 0, 654, 0, 0, 0, 0, 0,
-	578, 617, 0, 621, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 614, 615, 0, 0, 0, 0,
-	647, 0, 616, 0, 0, 793, 0, 636, 0, 128,
-	244, 258, 138, 235, 271, 142, 242, 134, 209, 231,
-	130, 256, 241, 191, 173, 174, 129, 0, 226, 152,
-	165, 149, 207, 644, 645, 148, 606, 642, 266, 132,
-	133, 265, 206, 253, 257, 192, 186, 131, 255, 190,
-	185, 177, 156, 169, 219, 184, 220, 170, 196, 195,
-	197, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 268, 0, 0, 660, 0,
-	0, 0, 243, 0, 0, 178, 0, 0, 0, 643,
-	0, 229, 212, 671, 0, 217, 227, 182, 254, 221,
-	259, 245, 267, 0, 222, 124, 246, 151, 193, 135,
-	136, 147, 153, 155, 157, 158, 202, 203, 215, 234,
-	247, 248, 249, 150, 143, 228, 144, 167, 145, 125,
-	236, 146, 126, 216, 252, 0, 164, 224, 189, 127,
-	188, 218, 251, 250, 275, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 0, 263, 658, 208, 670,
-	653, 655, 656, 659, 663, 664, 604, 607, 665, 667,
-	669, 672, 232, 0, 0, 0, 0, 0, 172, 214,
-	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 240, 261, 273, 605, 0, 0,
-	0, 272, 0, 0, 0, 0, 0, 648, 198, 199,
-	200, 201, 661, 0, 141, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 160, 166, 0, 168, 140,
-	213, 163, 270, 175, 205, 171, 237, 176, 183, 225,
-	269, 211, 230, 139, 260, 238, 187, 162, 678, 657,
-	677, 679, 680, 676, 681, 682, 666, 622, 0, 674,
-	673, 675, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 123, 0, 180, 0, 223, 159, 87,
-	582, 583, 584, 585, 586, 587, 588, 95, 589, 97,
-	98, 590, 100, 591, 102, 592, 104, 105, 106, 593,
-	594, 595, 596, 111, 597, 598, 599, 600, 116, 117,
-	118, 119, 601, 602, 603, 646, 0, 276, 277, 278,
-	262, 0, 0, 0, 0, 210, 0, 0, 0, 0,
-	0, 620, 0, 0, 0, 154, 2055, 0, 0, 179,
-	0, 181, 0, 0, 239, 194, 0, 0, 0, 0,
-	662, 668, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 613, 0, 0, 580, 652, 651, 629, 0, 0,
-	0, 137, 630, 0, 635, 0, 631, 634, 632, 633,
-	0, 0, 654, 0, 0, 0, 0, 0, 578, 617,
-	0, 621, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 614, 615, 0, 0, 0, 0, 647, 0,
-	616, 0, 0, 649, 0, 636, 0, 128, 244, 258,
-	138, 235, 271, 142, 242, 134, 209, 231, 130, 256,
-	241, 191, 173, 174, 129, 0, 226, 152, 165, 149,
-	207, 644, 645, 148, 606, 642, 266, 132, 133, 265,
-	206, 253, 257, 192, 186, 131, 255, 190, 185, 177,
-	156, 169, 219, 184, 220, 170, 196, 195, 197, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 268, 0, 0, 660, 0, 0, 0,
-	243, 0, 0, 178, 0, 0, 0, 643, 0, 229,
-	212, 671, 0, 217, 227, 182, 254, 221, 259, 245,
-	267, 0, 222, 124, 246, 151, 193, 135, 136, 147,
-	153, 155, 157, 158, 202, 203, 215, 234, 247, 248,
-	249, 150, 143, 228, 144, 167, 145, 125, 236, 146,
-	126, 216, 252, 0, 164, 224, 189, 127, 188, 218,
-	251, 250, 275, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 0, 263, 658, 208, 670, 653, 655,
-	656, 659, 663, 664, 604, 607, 665, 667, 669, 672,
-	232, 0, 0, 0, 0, 0, 172, 214, 0, 233,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 240, 261, 273, 605, 0, 0, 0, 272,
-	0, 0, 0, 0, 0, 648, 198, 199, 200, 201,
-	661, 0, 141, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 160, 166, 0, 168, 140, 213, 163,
-	270, 175, 205, 171, 237, 176, 183, 225, 269, 211,
-	230, 139, 260, 238, 187, 162, 678, 657, 677, 679,
-	680, 676, 681, 682, 666, 622, 0, 674, 673, 675,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 123, 0, 180, 0, 223, 159, 87, 582, 583,
-	584, 585, 586, 587, 588, 95, 589, 97, 98, 590,
-	100, 591, 102, 592, 104, 105, 106, 593, 594, 595,
-	596, 111, 597, 598, 599, 600, 116, 117, 118, 119,
-	601, 602, 603, 646, 0, 276, 277, 278, 262, 0,
-	0, 0, 0, 210, 0, 0, 0, 0, 0, 620,
-	0, 0, 0, 154, 796, 0, 0, 179, 0, 181,
-	0, 0, 239, 194, 0, 0, 0, 0, 662, 668,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 613,
-	0, 0, 580, 652, 651, 629, 0, 0, 0, 137,
-	630, 0, 635, 0, 631, 634, 632, 633, 0, 0,
-	654, 0, 0, 0, 0, 0, 578, 617, 0, 621,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	614, 615, 0, 0, 0, 0, 647, 0, 616, 0,
-	0, 649, 0, 636, 0, 128, 244, 258, 138, 235,
-	271, 142, 242, 134, 209, 231, 130, 256, 241, 191,
-	173, 174, 129, 0, 226, 152, 165, 149, 207, 644,
-	645, 148, 606, 642, 266, 132, 133, 265, 206, 253,
-	257, 192, 186, 131, 255, 190, 185, 177, 156, 169,
-	219, 184, 220, 170, 196, 195, 197, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 268, 0, 0, 660, 0, 0, 0, 243, 0,
-	0, 178, 0, 0, 0, 643, 0, 229, 212, 671,
-	0, 217, 227, 182, 254, 221, 259, 245, 267, 0,
-	222, 124, 246, 151, 193, 135, 136, 147, 153, 155,
-	157, 158, 202, 203, 215, 234, 247, 248, 249, 150,
-	143, 228, 144, 167, 145, 125, 236, 146, 126, 216,
-	252, 0, 164, 224, 189, 127, 188, 218, 251, 250,
-	275, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 0, 263, 658, 208, 670, 653, 655, 656, 659,
-	663, 664, 604, 607, 665, 667, 669, 672, 232, 0,
-	0, 0, 0, 0, 172, 214, 0, 233, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	240, 261, 273, 605, 0, 0, 0, 272, 0, 0,
-	0, 0, 0, 648, 198, 199, 200, 201, 661, 0,
-	141, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 160, 166, 0, 168, 140, 213, 163, 270, 175,
-	205, 171, 237, 176, 183, 225, 269, 211, 230, 139,
-	260, 238, 187, 162, 678, 657, 677, 679, 680, 676,
-	681, 682, 666, 622, 0, 674, 673, 675, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 123,
-	0, 180, 0, 223, 159, 87, 582, 583, 584, 585,
-	586, 587, 588, 95, 589, 97, 98, 590, 100, 591,
-	102, 592, 104, 105, 106, 593, 594, 595, 596, 111,
-	597, 598, 599, 600, 116, 117, 118, 119, 601, 602,
-	603, 646, 0, 276, 277, 278, 262, 0, 0, 0,
-	0, 210, 0, 0, 0, 0, 0, 620, 0, 0,
-	0, 154, 0, 0, 0, 179, 0, 181, 0, 0,
-	239, 194, 0, 0, 0, 0, 662, 668, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 613, 0, 0,
-	580, 652, 651, 629, 0, 0, 0, 137, 630, 0,
-	635, 0, 631, 634, 632, 633, 0, 0, 654, 0,
-	0, 0, 0, 0, 578, 617, 0, 621, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 614, 615,
-	575, 0, 0, 0, 647, 0, 616, 0, 0, 649,
-	0, 636, 0, 128, 244, 258, 138, 235, 271, 142,
-	242, 134, 209, 231, 130, 256, 241, 191, 173, 174,
-	129, 0, 226, 152, 165, 149, 207, 644, 645, 148,
-	606, 642, 266, 132, 133, 265, 206, 253, 257, 192,
-	186, 131, 255, 190, 185, 177, 156, 169, 219, 184,
-	220, 170, 196, 195, 197, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 268,
-	0, 0, 660, 0, 0, 0, 243, 0, 0, 178,
-	0, 0, 0, 643, 0, 229, 212, 671, 0, 217,
-	227, 182, 254, 221, 259, 245, 267, 0, 222, 124,
-	246, 151, 193, 135, 136, 147, 153, 155, 157, 158,
-	202, 203, 215, 234, 247, 248, 249, 150, 143, 228,
-	144, 167, 145, 125, 236, 146, 126, 216, 252, 0,
-	164, 224, 189, 127, 188, 218, 251, 250, 275, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 0,
-	263, 658, 208, 670, 653, 655, 656, 659, 663, 664,
-	604, 607, 665, 667, 669, 672, 232, 0, 0, 0,
-	0, 0, 172, 214, 0, 233, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 240, 261,
-	273, 605, 0, 0, 0, 272, 0, 0, 0, 0,
-	0, 648, 198, 199, 200, 201, 661, 0, 141, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
-	166, 0, 168, 140, 213, 163, 270, 175, 205, 171,
-	237, 176, 183, 225, 269, 211, 230, 139, 260, 238,
-	187, 162, 678, 657, 677, 679, 680, 676, 681, 682,
-	666, 622, 0, 674, 673, 675, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 180,
-	0, 223, 159, 87, 582, 583, 584, 585, 586, 587,
-	588, 95, 589, 97, 98, 590, 100, 591, 102, 592,
-	104, 105, 106, 593, 594, 595, 596, 111, 597, 598,
-	599, 600, 116, 117, 118, 119, 601, 602, 603, 646,
-	0, 276, 277, 278, 262, 0, 0, 0, 0, 210,
-	0, 0, 0, 0, 0, 620, 0, 0, 0, 154,
-	0, 0, 0, 179, 0, 181, 0, 0, 239, 194,
-	0, 0, 0, 0, 662, 668, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 613, 0, 0, 580, 652,
-	651, 629, 0, 0, 0, 137, 630, 0, 635, 0,
-	631, 634, 632, 633, 0, 0, 654, 0, 0, 0,
-	0, 0, 578, 617, 0, 621, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 614, 615, 0, 0,
-	0, 0, 647, 0, 616, 0, 0, 649, 0, 636,
-	0, 128, 244, 258, 138, 235, 271, 142, 242, 134,
-	209, 231, 130, 256, 241, 191, 173, 174, 129, 0,
-	226, 152, 165, 149, 207, 644, 645, 148, 606, 642,
-	266, 132, 133, 265, 206, 253, 257, 192, 186, 131,
-	255, 190, 185, 177, 156, 169, 219, 184, 220, 170,
-	196, 195, 197, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 268, 0, 0,
-	660, 0, 0, 0, 243, 0, 0, 178, 0, 0,
-	0, 643, 0, 229, 212, 671, 0, 217, 227, 182,
-	254, 221, 259, 245, 267, 0, 222, 124, 246, 151,
-	193, 135, 136, 147, 153, 155, 157, 158, 202, 203,
-	215, 234, 247, 248, 249, 150, 143, 228, 144, 167,
-	145, 125, 236, 146, 126, 216, 252, 0, 164, 224,
-	189, 127, 188, 218, 251, 250, 275, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 0, 263, 658,
-	208, 670, 653, 655, 656, 659, 663, 664, 604, 607,
-	665, 667, 669, 672, 232, 0, 0, 0, 0, 0,
-	172, 214, 0, 233, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 240, 261, 273, 605,
-	0, 0, 0, 272, 0, 0, 0, 0, 0, 648,
-	198, 199, 200, 201, 661, 0, 141, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 160, 166, 0,
-	168, 140, 213, 163, 270, 175, 205, 171, 237, 176,
-	183, 225, 269, 211, 230, 139, 260, 238, 187, 162,
-	678, 657, 677, 679, 680, 676, 681, 682, 666, 622,
-	0, 674, 673, 675, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 123, 0, 180, 0, 223,
-	159, 87, 582, 583, 584, 585, 586, 587, 588, 95,
-	589, 97, 98, 590, 100, 591, 102, 592, 104, 105,
-	106, 593, 594, 595, 596, 111, 597, 598, 599, 600,
-	116, 117, 118, 119, 601, 602, 603, 646, 0, 276,
-	277, 278, 262, 0, 0, 0, 0, 210, 0, 0,
-	0, 0, 0, 620, 0, 0, 0, 154, 0, 0,
-	0, 179, 0, 181, 0, 0, 239, 194, 0, 0,
-	0, 0, 662, 668, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 613, 0, 0, 580, 652, 651, 629,
-	0, 0, 0, 137, 630, 0, 635, 0, 631, 634,
-	632, 633, 0, 0, 654, 0, 0, 0, 0, 0,
-	0, 617, 0, 621, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 614, 615, 0, 0, 0, 0,
-	647, 0, 616, 0, 0, 649, 0, 636, 0, 128,
-	244, 258, 138, 235, 271, 142, 242, 134, 209, 231,
-	130, 256, 241, 191, 173, 174, 129, 0, 226, 152,
-	165, 149, 207, 644, 645, 148, 606, 642, 266, 132,
-	133, 265, 206, 253, 257, 192, 186, 131, 255, 190,
-	185, 177, 156, 169, 219, 184, 220, 170, 196, 195,
-	197, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 268, 0, 0, 660, 0,
-	0, 0, 243, 0, 0, 178, 0, 0, 0, 643,
-	0, 229, 212, 671, 0, 217, 227, 182, 254, 221,
-	259, 245, 267, 0, 222, 124, 246, 151, 193, 135,
-	136, 147, 153, 155, 157, 158, 202, 203, 215, 234,
-	247, 248, 249, 150, 143, 228, 144, 167, 145, 125,
-	236, 146, 126, 216, 252, 0, 164, 224, 189, 127,
-	188, 218, 251, 250, 275, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 0, 263, 658, 208, 670,
-	653, 655, 656, 659, 663, 664, 604, 607, 665, 667,
-	669, 672, 232, 0, 0, 0, 0, 0, 172, 214,
-	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 240, 261, 273, 605, 0, 0,
-	0, 272, 0, 0, 0, 0, 0, 648, 198, 199,
-	200, 201, 661, 0, 141, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 160, 166, 0, 168, 140,
-	213, 163, 270, 175, 205, 171, 237, 176, 183, 225,
-	269, 211, 230, 139, 260, 238, 187, 162, 678, 657,
-	677, 679, 680, 676, 681, 682, 666, 622, 0, 674,
-	673, 675, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 123, 0, 180, 0, 223, 159, 87,
-	582, 583, 584, 585, 586, 587, 588, 95, 589, 97,
-	98, 590, 100, 591, 102, 592, 104, 105, 106, 593,
-	594, 595, 596, 111, 597, 598, 599, 600, 116, 117,
-	118, 119, 601, 602, 603, 0, 0, 276, 277, 278,
-	262, 318, 0, 317, 321, 313, 0, 0, 0, 0,
-	0, 0, 0, 210, 0, 309, 0, 0, 0, 0,
-	0, 0, 0, 154, 0, 0, 328, 179, 0, 181,
-	0, 0, 239, 194, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 331, 0, 0, 332, 0, 0, 0, 137,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 318, 0, 317, 321, 313, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 309, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
-	0, 0, 0, 0, 0, 128, 244, 258, 138, 235,
-	271, 142, 242, 134, 209, 231, 130, 256, 241, 191,
-	173, 174, 129, 0, 226, 152, 165, 149, 207, 0,
-	0, 148, 274, 0, 266, 132, 133, 265, 206, 253,
-	257, 192, 186, 131, 255, 190, 185, 177, 156, 169,
-	219, 184, 220, 170, 196, 195, 197, 0, 0, 0,
-	0, 0, 311, 310, 314, 0, 0, 0, 0, 0,
-	316, 268, 0, 0, 0, 0, 0, 0, 243, 0,
-	0, 178, 320, 0, 0, 0, 0, 229, 212, 0,
-	0, 217, 227, 182, 254, 221, 312, 245, 267, 0,
-	336, 124, 246, 151, 193, 135, 136, 147, 153, 155,
-	157, 158, 202, 203, 215, 234, 247, 248, 249, 150,
-	143, 228, 144, 167, 145, 125, 236, 146, 126, 216,
-	252, 0, 164, 224, 189, 127, 188, 218, 251, 250,
-	275, 0, 0, 0, 0, 311, 310, 314, 0, 0,
-	161, 0, 263, 316, 208, 0, 0, 0, 0, 0,
-	0, 0, 204, 279, 0, 320, 0, 0, 232, 0,
-	0, 0, 315, 319, 322, 214, 323, 324, 0, 729,
-	325, 326, 327, 0, 0, 329, 330, 0, 0, 0,
-	240, 261, 273, 264, 0, 0, 0, 272, 0, 0,
-	0, 0, 0, 0, 198, 199, 200, 201, 0, 0,
-	141, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 160, 166, 0, 168, 140, 213, 163, 270, 175,
-	205, 171, 237, 176, 183, 225, 269, 211, 230, 139,
-	260, 238, 187, 162, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 315, 319, 730, 0, 323,
-	731, 0, 0, 325, 326, 327, 0, 0, 329, 330,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 123,
-	0, 180, 0, 223, 159, 87, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 0, 0, 276, 277, 278, 262, 318, 0, 317,
-	321, 313, 0, 0, 0, 0, 0, 0, 0, 210,
-	0, 309, 0, 0, 0, 0, 0, 0, 0, 154,
-	0, 0, 328, 179, 0, 181, 0, 0, 239, 194,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 331, 0,
-	0, 332, 0, 0, 0, 137, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 128, 244, 258, 138, 235, 271, 142, 242, 134,
-	209, 231, 130, 256, 241, 191, 173, 174, 129, 0,
-	226, 152, 165, 149, 207, 0, 0, 148, 274, 0,
-	266, 132, 133, 265, 206, 253, 257, 192, 186, 131,
-	255, 190, 185, 177, 156, 169, 219, 184, 220, 170,
-	196, 195, 197, 0, 0, 0, 0, 0, 311, 310,
-	314, 0, 0, 0, 0, 0, 316, 268, 0, 0,
-	0, 0, 0, 0, 243, 0, 0, 178, 320, 0,
-	0, 0, 0, 229, 212, 0, 0, 217, 227, 182,
-	254, 221, 312, 245, 267, 0, 222, 124, 246, 151,
-	193, 135, 136, 147, 153, 155, 157, 158, 202, 203,
-	215, 234, 247, 248, 249, 150, 143, 228, 144, 167,
-	145, 125, 236, 146, 126, 216, 252, 0, 164, 224,
-	189, 127, 188, 218, 251, 250, 275, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 161, 0, 263, 0,
-	208, 0, 0, 0, 0, 0, 0, 0, 204, 279,
-	0, 0, 0, 0, 232, 0, 0, 0, 315, 319,
-	322, 214, 323, 324, 0, 0, 325, 326, 327, 0,
-	0, 329, 330, 0, 0, 0, 240, 261, 273, 264,
-	0, 0, 0, 272, 0, 0, 0, 0, 0, 0,
-	198, 199, 200, 201, 0, 0, 141, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 160, 166, 0,
-	168, 140, 213, 163, 270, 175, 205, 171, 237, 176,
-	183, 225, 269, 211, 230, 139, 260, 238, 187, 162,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 123, 0, 180, 0, 223,
-	159, 87, 88, 89, 90, 91, 92, 93, 94, 95,
-	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
-	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
-	116, 117, 118, 119, 120, 121, 122, 0, 0, 276,
-	277, 278, 262, 78, 0, 23, 39, 24, 0, 0,
-	0, 0, 0, 0, 0, 210, 282, 0, 0, 0,
-	0, 0, 0, 0, 0, 154, 0, 0, 0, 179,
-	0, 181, 0, 0, 239, 194, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 287, 0, 0, 84, 0, 0, 0, 0, 0,
-	0, 137, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 128, 244, 258,
-	138, 235, 271, 142, 242, 134, 209, 231, 130, 256,
-	241, 191, 173, 174, 129, 0, 226, 152, 165, 149,
-	207, 0, 0, 148, 274, 0, 266, 132, 133, 265,
-	206, 253, 257, 192, 186, 131, 255, 190, 185, 177,
-	156, 169, 219, 184, 220, 170, 196, 195, 197, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 286, 0,
-	0, 0, 0, 268, 0, 0, 0, 0, 0, 0,
-	243, 0, 0, 178, 0, 0, 0, 0, 0, 229,
-	212, 0, 0, 217, 227, 182, 254, 221, 259, 245,
-	267, 0, 222, 124, 246, 151, 193, 135, 136, 147,
-	153, 155, 157, 158, 202, 203, 215, 234, 247, 248,
-	249, 150, 143, 228, 144, 167, 145, 125, 236, 146,
-	126, 216, 252, 0, 164, 224, 189, 127, 188, 218,
-	251, 250, 275, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 161, 0, 263, 0, 208, 0, 0, 0,
-	0, 0, 0, 0, 204, 279, 0, 0, 0, 0,
-	232, 0, 0, 0, 0, 0, 172, 214, 0, 233,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 240, 261, 273, 264, 0, 0, 0, 272,
-	0, 0, 0, 0, 0, 0, 198, 199, 200, 201,
-	283, 285, 141, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 160, 166, 0, 168, 140, 213, 163,
-	270, 175, 205, 171, 237, 176, 183, 225, 269, 211,
-	230, 139, 260, 238, 187, 162, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 123, 0, 180, 77, 223, 159, 87, 88, 89,
-	90, 91, 92, 93, 94, 95, 96, 97, 98, 99,
-	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
-	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
-	120, 121, 122, 210, 0, 276, 277, 278, 262, 0,
-	0, 0, 0, 154, 0, 0, 0, 179, 0, 181,
-	0, 0, 239, 194, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 0, 0, 0, 0, 0, 0, 137,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1449, 1452, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 128, 244, 258, 138, 235,
-	271, 142, 242, 134, 209, 231, 130, 256, 241, 191,
-	173, 174, 129, 0, 226, 152, 165, 149, 207, 0,
-	0, 148, 274, 0, 266, 132, 133, 265, 206, 253,
-	257, 192, 186, 131, 255, 190, 185, 177, 156, 169,
-	219, 184, 220, 170, 196, 195, 197, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1453, 268, 0, 0, 0, 1446, 0, 1445, 243, 1447,
-	1450, 178, 0, 0, 0, 0, 0, 229, 212, 0,
-	0, 217, 227, 182, 254, 221, 259, 245, 267, 0,
-	222, 124, 246, 151, 193, 135, 136, 147, 153, 155,
-	157, 158, 202, 203, 215, 234, 247, 248, 249, 150,
-	143, 228, 144, 167, 145, 125, 236, 146, 126, 216,
-	252, 1451, 164, 224, 189, 127, 188, 218, 251, 250,
-	275, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	161, 0, 263, 0, 208, 0, 0, 0, 0, 0,
-	0, 0, 204, 279, 0, 0, 0, 0, 232, 0,
-	0, 0, 0, 0, 172, 214, 0, 233, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	240, 261, 273, 264, 0, 0, 0, 272, 0, 0,
-	0, 0, 0, 0, 198, 199, 200, 201, 0, 0,
-	141, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 160, 166, 0, 168, 140, 213, 163, 270, 175,
-	205, 171, 237, 176, 183, 225, 269, 211, 230, 139,
-	260, 238, 187, 162, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 123,
-	0, 180, 0, 223, 159, 87, 88, 89, 90, 91,
-	92, 93, 94, 95, 96, 97, 98, 99, 100, 101,
-	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
-	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
-	122, 210, 0, 276, 277, 278, 262, 0, 0, 0,
-	0, 154, 378, 0, 0, 179, 0, 181, 0, 0,
-	239, 194, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	84, 390, 391, 0, 0, 0, 0, 137, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 392, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 128, 244, 258, 138, 235, 271, 142,
-	242, 134, 209, 231, 130, 256, 241, 191, 173, 174,
-	129, 0, 226, 152, 165, 149, 207, 0, 0, 148,
-	274, 394, 266, 132, 393, 265, 206, 253, 257, 192,
-	186, 131, 255, 190, 185, 177, 156, 169, 219, 184,
-	220, 170, 196, 195, 197, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 268,
-	0, 0, 0, 0, 0, 0, 243, 0, 0, 178,
-	0, 0, 0, 0, 0, 229, 212, 0, 0, 217,
-	227, 182, 254, 221, 259, 245, 267, 377, 222, 124,
-	246, 151, 193, 135, 136, 147, 153, 155, 157, 158,
-	202, 203, 215, 234, 247, 248, 249, 150, 143, 228,
-	144, 167, 145, 125, 236, 146, 126, 216, 252, 0,
-	164, 224, 189, 127, 188, 218, 251, 250, 275, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 0,
-	263, 0, 208, 0, 0, 0, 0, 0, 0, 0,
-	204, 279, 0, 0, 0, 0, 232, 0, 0, 0,
-	0, 0, 172, 214, 0, 233, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 240, 261,
-	273, 264, 0, 0, 0, 272, 0, 0, 0, 0,
-	0, 380, 198, 199, 200, 201, 0, 0, 141, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
-	166, 0, 168, 140, 213, 163, 270, 175, 387, 383,
-	384, 176, 183, 225, 269, 211, 230, 139, 260, 238,
-	385, 162, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 180,
-	0, 223, 159, 87, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 78,
-	0, 276, 277, 278, 262, 0, 0, 0, 0, 0,
-	0, 210, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 154, 0, 0, 0, 179, 0, 181, 0, 0,
-	239, 194, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 74, 0, 908,
-	84, 0, 0, 0, 0, 0, 0, 137, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 128, 244, 258, 138, 235, 271, 142,
-	242, 134, 209, 231, 130, 256, 241, 191, 173, 174,
-	129, 0, 226, 152, 165, 149, 207, 0, 0, 148,
-	274, 0, 266, 132, 133, 265, 206, 253, 257, 192,
-	186, 131, 255, 190, 185, 177, 156, 169, 219, 184,
-	220, 170, 196, 195, 197, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 268,
-	0, 0, 0, 0, 0, 0, 243, 0, 0, 178,
-	0, 0, 0, 0, 0, 229, 212, 0, 0, 217,
-	227, 182, 254, 221, 259, 245, 267, 0, 222, 124,
-	246, 151, 193, 135, 136, 147, 153, 155, 157, 158,
-	202, 203, 215, 234, 247, 248, 249, 150, 143, 228,
-	144, 167, 145, 125, 236, 146, 126, 216, 252, 0,
-	164, 224, 189, 127, 188, 218, 251, 250, 275, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 161, 0,
-	263, 0, 208, 0, 0, 0, 0, 0, 0, 0,
-	204, 279, 0, 0, 0, 0, 232, 0, 0, 0,
-	0, 0, 172, 214, 0, 233, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 240, 261,
-	273, 264, 0, 0, 0, 272, 0, 0, 0, 0,
-	0, 0, 198, 199, 200, 201, 0, 0, 141, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 160,
-	166, 0, 168, 140, 213, 163, 270, 175, 205, 171,
-	237, 176, 183, 225, 269, 211, 230, 139, 260, 238,
-	187, 162, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 123, 0, 180,
-	77, 223, 159, 87, 88, 89, 90, 91, 92, 93,
-	94, 95, 96, 97, 98, 99, 100, 101, 102, 103,
-	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 116, 117, 118, 119, 120, 121, 122, 0,
-	210, 276, 277, 278, 262, 828, 0, 0, 0, 0,
-	154, 0, 0, 0, 179, 0, 181, 0, 0, 239,
-	194, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	0, 0, 0, 0, 0, 0, 137, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	825, 826, 824, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 128, 244, 258, 138, 235, 271, 142, 242,
-	134, 209, 231, 130, 256, 241, 191, 173, 174, 129,
-	0, 226, 152, 165, 149, 207, 0, 0, 148, 274,
-	0, 266, 132, 133, 265, 206, 253, 257, 192, 186,
-	131, 255, 190, 185, 177, 156, 169, 219, 184, 220,
-	170, 196, 195, 197, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	0, 0, 0, 0, 0, 243, 0, 0, 178, 0,
-	0, 0, 0, 0, 229, 212, 0, 0, 217, 227,
-	182, 254, 221, 259, 245, 267, 0, 222, 124, 246,
-	151, 193, 135, 136, 147, 153, 155, 157, 158, 202,
-	203, 215, 234, 247, 248, 249, 150, 143, 228, 144,
-	167, 145, 125, 236, 146, 126, 216, 252, 0, 164,
-	224, 189, 127, 188, 218, 251, 250, 275, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 0, 263,
-	0, 208, 0, 0, 0, 0, 0, 0, 0, 204,
-	279, 0, 0, 0, 0, 232, 0, 0, 0, 0,
-	0, 172, 214, 0, 233, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 240, 261, 273,
-	264, 0, 0, 0, 272, 0, 0, 0, 0, 0,
-	0, 198, 199, 200, 201, 0, 0, 141, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 160, 166,
-	0, 168, 140, 213, 163, 270, 175, 205, 171, 237,
-	176, 183, 225, 269, 211, 230, 139, 260, 238, 187,
-	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 0, 180, 0,
-	223, 159, 87, 88, 89, 90, 91, 92, 93, 94,
+	806, 1249, 2215, 2213, 2212, 2220, 2189, 647, 1836, 2169,
+	666, 1250, 645, 2144, 2075, 1832, 782, 1622, 2134, 1981,
+	2022, 546, 1726, 2043, 581, 2044, 655, 91, 1894, 649,
+	305, 2037, 798, 316, 1834, 579, 1974, 1181, 2025, 1835,
+	1867, 1893, 417, 1475, 1866, 91, 318, 1592, 532, 1565,
+	856, 1801, 1632, 1635, 350, 350, 1656, 355, 356, 1778,
+	90, 610, 1738, 1595, 476, 1400, 1611, 1596, 94, 1600,
+	1748, 1184, 1574, 971, 1673, 1512, 730, 365, 311, 474,
+	1647, 418, 776, 1633, 872, 550, 432, 589, 986, 980,
+	91, 981, 646, 972, 989, 1522, 309, 22, 656, 1332,
+	1316, 3, 60, 779, 308, 12, 306, 6, 307, 5,
+	1394, 1586, 1674, 849, 832, 808, 1593, 750, 1248, 1192,
+	1251, 424, 777, 441, 950, 1265, 1264, 1843, 603, 523,
+	853, 822, 821, 298, 600, 1160, 477, 452, 902, 800,
+	431, 820, 1150, 320, 409, 301, 590, 768, 321, 463,
+	322, 87, 1167, 492, 1906, 735, 1828, 325, 325, 422,
+	1725, 571, 803, 1965, 974, 1376, 429, 410, 366, 86,
+	2067, 26, 44, 27, 86, 1163, 26, 44, 27, 557,
+	1566, 1395, 1955, 364, 22, 312, 427, 1814, 948, 438,
+	1968, 1969, 12, 947, 6, 385, 5, 86, 352, 1383,
+	84, 86, 667, 674, 86, 1966, 1967, 668, 86, 673,
+	358, 669, 672, 670, 671, 1963, 1964, 83, 553, 843,
+	512, 727, 83, 747, 724, 838, 839, 545, 1540, 1895,
+	544, 547, 548, 558, 547, 548, 1389, 395, 2047, 2048,
+	824, 785, 507, 503, 2148, 726, 376, 1972, 1569, 83,
+	423, 2056, 83, 1570, 2059, 1571, 83, 1975, 1976, 1977,
+	1978, 1909, 1727, 789, 362, 361, 1900, 667, 674, 1362,
+	2120, 446, 668, 2118, 673, 455, 669, 672, 670, 671,
+	1575, 1576, 1577, 1578, 1403, 1401, 1398, 1402, 1404, 1657,
+	1397, 1396, 850, 1403, 1401, 360, 1402, 1404, 91, 445,
+	1163, 1775, 1660, 517, 1165, 396, 1627, 444, 494, 2066,
+	1825, 91, 1631, 1630, 504, 505, 506, 1722, 1960, 769,
+	493, 1406, 1407, 1408, 1409, 498, 1787, 676, 61, 2046,
+	1791, 1900, 2115, 1945, 2205, 2122, 1813, 1790, 2221, 1659,
+	2155, 479, 2117, 2077, 459, 771, 2026, 2027, 2028, 2030,
+	2029, 2162, 2073, 2074, 499, 2077, 378, 61, 515, 516,
+	1601, 1604, 2093, 1770, 2167, 1927, 375, 374, 1926, 354,
+	2124, 2125, 2069, 2070, 567, 2083, 443, 1384, 543, 501,
+	542, 1579, 2216, 2039, 2190, 480, 455, 359, 370, 2222,
+	502, 1915, 1524, 554, 440, 1513, 2054, 91, 1214, 533,
+	518, 1723, 1653, 1380, 489, 1761, 350, 427, 1222, 1171,
+	426, 428, 418, 418, 418, 61, 457, 456, 770, 534,
+	484, 536, 535, 1159, 496, 537, 834, 835, 397, 833,
+	555, 432, 1788, 310, 606, 401, 497, 500, 357, 363,
+	1220, 1219, 1473, 729, 1765, 605, 495, 1604, 448, 449,
+	1803, 1802, 1218, 584, 561, 559, 560, 841, 842, 746,
+	1217, 445, 91, 91, 91, 91, 840, 398, 399, 751,
+	379, 2200, 1605, 764, 2007, 2173, 864, 1598, 1572, 1483,
+	369, 1599, 1602, 2137, 1374, 1373, 403, 402, 1361, 350,
+	350, 445, 350, 450, 392, 1355, 1205, 1179, 1143, 783,
+	479, 1558, 884, 593, 595, 732, 586, 458, 442, 325,
+	350, 350, 525, 538, 541, 748, 2068, 919, 350, 551,
+	350, 797, 91, 2123, 791, 793, 2185, 457, 456, 2182,
+	1566, 1587, 377, 350, 1603, 350, 725, 814, 805, 91,
+	766, 809, 91, 509, 480, 1896, 1897, 801, 594, 566,
+	364, 1166, 491, 2038, 829, 799, 851, 350, 1605, 1377,
+	813, 547, 548, 570, 547, 548, 1186, 527, 350, 418,
+	1786, 350, 816, 85, 827, 817, 577, 578, 85, 574,
+	575, 576, 549, 857, 552, 1789, 815, 865, 2138, 857,
+	857, 802, 787, 325, 423, 784, 739, 599, 2087, 432,
+	794, 85, 873, 830, 1560, 85, 882, 364, 85, 745,
+	1896, 1897, 85, 1403, 1401, 810, 1402, 1404, 1763, 885,
+	539, 763, 1762, 325, 591, 934, 935, 825, 818, 819,
+	61, 61, 428, 788, 419, 1357, 772, 569, 325, 836,
+	781, 1766, 1767, 1224, 826, 485, 389, 752, 753, 754,
+	755, 1162, 938, 1148, 390, 804, 786, 1285, 1283, 1284,
+	1559, 447, 937, 796, 519, 520, 521, 522, 585, 1700,
+	945, 325, 738, 1333, 572, 734, 445, 811, 812, 823,
+	1412, 867, 380, 2052, 954, 573, 419, 2008, 2010, 2011,
+	2012, 2009, 580, 852, 528, 1245, 481, 482, 483, 582,
+	879, 556, 2135, 2136, 1253, 1252, 400, 1161, 862, 863,
+	1246, 847, 540, 848, 421, 1772, 738, 1414, 1678, 866,
+	481, 482, 483, 582, 868, 881, 879, 978, 978, 983,
+	743, 744, 1921, 880, 881, 879, 859, 860, 861, 870,
+	1756, 1702, 869, 985, 939, 940, 941, 942, 873, 873,
+	1333, 592, 1518, 427, 1771, 1752, 943, 991, 880, 881,
+	879, 583, 81, 1675, 907, 2166, 421, 61, 1521, 1414,
+	1747, 1520, 1484, 1261, 911, 2211, 737, 2018, 61, 2195,
+	2156, 968, 1263, 2152, 736, 583, 404, 2104, 1468, 1465,
+	1466, 1467, 1413, 1680, 2016, 1679, 1676, 1258, 880, 881,
+	879, 992, 387, 2002, 388, 395, 2165, 1323, 984, 386,
+	384, 383, 391, 2017, 393, 394, 91, 2001, 977, 960,
+	737, 1321, 1322, 1320, 305, 2000, 1146, 1997, 736, 427,
+	2015, 1207, 1144, 1145, 445, 1212, 481, 482, 483, 582,
+	1527, 2014, 1211, 1991, 350, 425, 801, 2004, 1677, 1195,
+	1988, 481, 482, 483, 1740, 1987, 1281, 1959, 1278, 1833,
+	1182, 1183, 1280, 1277, 1279, 350, 1958, 1907, 1282, 990,
+	1889, 857, 857, 857, 1875, 2051, 606, 2013, 91, 1142,
+	1783, 1140, 1782, 2003, 1242, 1243, 1141, 605, 1781, 1777,
+	802, 1239, 1240, 1241, 1196, 1197, 1198, 1155, 1776, 1158,
+	2225, 583, 1259, 1260, 1734, 1733, 1199, 1732, 1215, 1731,
+	1256, 880, 881, 879, 1552, 1300, 1741, 880, 881, 879,
+	733, 1296, 1170, 922, 923, 924, 925, 926, 919, 2149,
+	1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313,
+	1314, 1315, 1193, 2128, 2023, 1325, 1326, 325, 968, 1247,
+	1209, 1235, 1681, 1682, 445, 1807, 1238, 1202, 1334, 1204,
+	823, 1200, 954, 1344, 1201, 2114, 1203, 1341, 1229, 2081,
+	1288, 1289, 1290, 1291, 1292, 1293, 1286, 1287, 936, 1346,
+	2080, 1348, 880, 881, 879, 2005, 1998, 1221, 1817, 1994,
+	1225, 1226, 1227, 1993, 1534, 1178, 692, 1230, 790, 1231,
+	1992, 1957, 1444, 1908, 1236, 927, 928, 692, 1476, 920,
+	921, 922, 923, 924, 925, 926, 919, 1254, 1255, 1831,
+	1257, 880, 881, 879, 1324, 1816, 1294, 1295, 1318, 2040,
+	1297, 1298, 1177, 2203, 1829, 1301, 1302, 1303, 1299, 1984,
+	888, 889, 890, 891, 892, 893, 2196, 886, 1779, 1449,
+	2050, 880, 881, 879, 428, 880, 881, 879, 364, 880,
+	881, 879, 1970, 61, 1758, 880, 881, 879, 61, 481,
+	482, 483, 1360, 1742, 1340, 1342, 1337, 1338, 1339, 1584,
+	1583, 1582, 1581, 1371, 1345, 1328, 1347, 1327, 880, 881,
+	879, 1349, 1176, 1172, 1432, 918, 917, 927, 928, 964,
+	963, 920, 921, 922, 923, 924, 925, 926, 919, 962,
+	1951, 1451, 1455, 1457, 1459, 1461, 1462, 1464, 1884, 1468,
+	1465, 1466, 1467, 1880, 1446, 1447, 1448, 1430, 1431, 1452,
+	1879, 1433, 1818, 1434, 1435, 1436, 1437, 1438, 1439, 1440,
+	1441, 1442, 1443, 1450, 1363, 1811, 1530, 1810, 445, 1486,
+	1529, 1454, 1456, 1458, 1460, 1463, 751, 1490, 1795, 1961,
+	1367, 368, 1743, 1368, 350, 1714, 1370, 350, 1486, 2230,
+	445, 367, 350, 2224, 2223, 91, 91, 1708, 1379, 1445,
+	1392, 1169, 2206, 1705, 1385, 880, 881, 879, 1662, 1390,
+	1391, 2179, 809, 917, 927, 928, 2202, 2201, 920, 921,
+	922, 923, 924, 925, 926, 919, 1420, 1661, 1386, 1387,
+	1533, 445, 597, 1531, 1469, 1470, 880, 881, 879, 1211,
+	1169, 2193, 1528, 350, 1365, 920, 921, 922, 923, 924,
+	925, 926, 919, 1526, 1950, 1479, 1411, 1169, 2192, 1614,
+	918, 917, 927, 928, 1495, 1820, 920, 921, 922, 923,
+	924, 925, 926, 919, 1381, 1806, 2172, 2171, 1492, 1491,
+	880, 881, 879, 1487, 1485, 1366, 1488, 1489, 1911, 2133,
+	1378, 880, 881, 879, 1617, 1805, 1416, 1175, 2126, 1472,
+	1612, 880, 881, 879, 2112, 2111, 1375, 1417, 1343, 1418,
+	1625, 1626, 1911, 2091, 767, 1613, 1393, 1911, 2090, 1911,
+	2089, 880, 881, 879, 731, 1474, 1497, 1498, 596, 1500,
+	1501, 1410, 2184, 1504, 1505, 1506, 1911, 2088, 877, 1193,
+	1486, 1507, 1713, 1421, 1429, 1699, 1471, 1422, 1350, 22,
+	1744, 1618, 2226, 2177, 1478, 1510, 1511, 12, 1477, 6,
+	1693, 5, 1515, 2086, 2085, 1519, 1419, 1147, 880, 881,
+	879, 880, 881, 879, 978, 1163, 1544, 978, 1911, 2049,
+	1547, 1535, 875, 857, 1911, 1910, 880, 881, 879, 857,
+	873, 1692, 508, 350, 1888, 1887, 487, 350, 350, 488,
+	1453, 350, 918, 917, 927, 928, 1550, 1715, 920, 921,
+	922, 923, 924, 925, 926, 919, 479, 880, 881, 879,
+	1886, 1885, 1539, 1882, 1883, 1502, 91, 1624, 1546, 1597,
+	1691, 1882, 1881, 1482, 1509, 1689, 445, 1819, 489, 1318,
+	1234, 1508, 427, 489, 1211, 1234, 1717, 1356, 1543, 1330,
+	1551, 1517, 1525, 1688, 1208, 1620, 880, 881, 879, 1585,
+	480, 880, 881, 879, 1536, 1542, 1545, 1486, 1694, 1541,
+	1548, 1555, 1180, 1553, 1554, 1557, 1549, 1619, 1621, 880,
+	881, 879, 1686, 1564, 1175, 1580, 918, 917, 927, 928,
+	1486, 1683, 920, 921, 922, 923, 924, 925, 926, 919,
+	1561, 1563, 1173, 91, 1667, 1638, 1639, 1628, 880, 881,
+	879, 1486, 1503, 598, 1606, 1607, 1486, 1494, 1669, 1642,
+	1685, 1645, 1646, 1486, 1493, 1608, 1672, 1627, 1684, 1671,
+	568, 1687, 1234, 1364, 1690, 1637, 1670, 1359, 1358, 1615,
+	1353, 1352, 1234, 1233, 1588, 1589, 880, 881, 879, 86,
+	1701, 2181, 880, 881, 879, 880, 881, 879, 2175, 1709,
+	2163, 1329, 880, 881, 879, 1711, 1712, 1649, 319, 1652,
+	1169, 1168, 741, 740, 486, 2160, 2158, 1704, 487, 2103,
+	61, 1666, 2035, 350, 2020, 1979, 1710, 880, 881, 879,
+	1948, 1947, 1946, 1667, 1943, 460, 1698, 83, 1942, 1634,
+	1863, 479, 1878, 1876, 1636, 1695, 465, 468, 469, 470,
+	466, 1769, 467, 471, 1753, 1703, 1736, 1648, 1746, 1944,
+	1651, 1706, 731, 351, 1194, 1644, 1697, 1641, 1640, 1319,
+	1415, 1369, 1351, 1739, 1716, 1336, 1335, 1232, 1223, 1216,
+	601, 766, 1737, 970, 969, 480, 967, 1757, 91, 2214,
+	966, 465, 468, 469, 470, 466, 1721, 467, 471, 1845,
+	965, 961, 903, 1739, 1189, 1735, 1730, 936, 958, 1750,
+	956, 955, 946, 83, 1784, 916, 915, 914, 913, 912,
+	910, 1773, 909, 1745, 908, 1696, 1718, 1794, 906, 1749,
+	905, 1749, 1751, 904, 1628, 901, 1793, 1755, 900, 899,
+	61, 1759, 898, 897, 896, 1754, 918, 917, 927, 928,
+	895, 894, 920, 921, 922, 923, 924, 925, 926, 919,
+	728, 1780, 490, 514, 465, 468, 469, 470, 466, 1815,
+	467, 471, 1785, 2098, 1809, 1151, 1152, 2096, 2045, 2210,
+	350, 350, 1405, 1532, 91, 1174, 1154, 857, 1796, 510,
+	1797, 1798, 1799, 1800, 1804, 760, 758, 445, 1157, 1156,
+	761, 759, 757, 756, 1354, 445, 1840, 2141, 1868, 1870,
+	587, 1868, 1868, 1211, 588, 1194, 1567, 1826, 1808, 762,
+	524, 469, 470, 1182, 1183, 1874, 1821, 1849, 1187, 795,
+	1719, 1824, 871, 918, 917, 927, 928, 1720, 1853, 920,
+	921, 922, 923, 924, 925, 926, 919, 473, 1139, 1869,
+	1253, 1252, 1865, 530, 531, 1871, 1872, 526, 2176, 1842,
+	2108, 1873, 2106, 1844, 1846, 1848, 2061, 1850, 1851, 1852,
+	1854, 1855, 1856, 1858, 1859, 1860, 1861, 2060, 2058, 1892,
+	434, 436, 437, 1822, 1823, 1985, 1980, 1830, 1792, 1902,
+	1514, 1729, 1728, 1707, 1665, 368, 1891, 1664, 529, 1864,
+	1899, 1899, 1917, 1898, 1898, 367, 367, 1481, 1904, 1913,
+	731, 918, 917, 927, 928, 1496, 1901, 920, 921, 922,
+	923, 924, 925, 926, 919, 2100, 2099, 1862, 1372, 513,
+	297, 2099, 2100, 472, 1870, 381, 1, 454, 742, 445,
+	453, 451, 82, 1331, 1841, 677, 1920, 973, 1952, 1912,
+	979, 2021, 2140, 2168, 2102, 2143, 792, 665, 648, 1857,
+	2053, 445, 1568, 1971, 2055, 1973, 1847, 1388, 1903, 954,
+	1382, 511, 445, 1956, 1949, 1537, 1538, 690, 679, 1986,
+	957, 680, 1899, 723, 1962, 1898, 918, 917, 927, 928,
+	435, 678, 920, 921, 922, 923, 924, 925, 926, 919,
+	1890, 2019, 1658, 373, 445, 433, 382, 445, 445, 445,
+	1983, 1982, 1774, 1918, 1919, 479, 1922, 1923, 1924, 1925,
+	1724, 1629, 1928, 1929, 1930, 1931, 1932, 1933, 1934, 1935,
+	1936, 1937, 1938, 1939, 1940, 1941, 1650, 1643, 2024, 1262,
+	2063, 2032, 2033, 2034, 2031, 930, 2219, 933, 2209, 2188,
+	2174, 2076, 2204, 2116, 2161, 1999, 2064, 2154, 2072, 480,
+	1914, 931, 932, 929, 323, 918, 917, 927, 928, 844,
+	2057, 920, 921, 922, 923, 924, 925, 926, 919, 562,
+	407, 91, 2071, 2036, 749, 2078, 2079, 1573, 1399, 1185,
+	1164, 778, 1989, 1990, 324, 2065, 445, 1877, 1995, 1996,
+	371, 1188, 372, 1191, 1190, 887, 1317, 1499, 959, 918,
+	917, 927, 928, 2084, 799, 920, 921, 922, 923, 924,
+	925, 926, 919, 944, 608, 1516, 1655, 1654, 2097, 2095,
+	2092, 1623, 2094, 828, 2107, 29, 2109, 2110, 2105, 1899,
+	878, 2101, 1898, 987, 689, 93, 1206, 988, 2062, 1905,
+	2145, 1812, 1523, 664, 663, 2119, 2121, 662, 661, 660,
+	464, 462, 461, 315, 2147, 314, 1480, 2127, 2129, 2130,
+	2131, 2132, 1663, 2151, 2146, 874, 876, 2042, 2041, 2139,
+	1953, 1954, 1827, 1768, 2150, 2006, 1764, 1760, 2082, 831,
+	1839, 2157, 1838, 2159, 1609, 1610, 1616, 1428, 1424, 1426,
+	2153, 1427, 1425, 1423, 1594, 1591, 1590, 1153, 1149, 2170,
+	975, 2164, 982, 439, 807, 88, 313, 1237, 602, 445,
+	20, 445, 21, 19, 11, 18, 17, 783, 16, 783,
+	52, 51, 50, 2147, 2187, 2178, 2183, 2180, 2113, 49,
+	15, 8, 445, 2146, 48, 47, 2186, 46, 14, 13,
+	783, 2191, 2170, 42, 2197, 41, 40, 2199, 2194, 39,
+	38, 2207, 37, 36, 35, 34, 33, 32, 31, 2208,
+	30, 9, 64, 63, 62, 23, 2218, 24, 2217, 25,
+	70, 69, 68, 67, 66, 28, 10, 7, 2229, 2228,
+	2227, 2218, 1106, 1092, 4, 1053, 1108, 1025, 1041, 1116,
+	1043, 1044, 1079, 1003, 1062, 221, 1039, 995, 1028, 1029,
+	997, 1036, 998, 1026, 1055, 165, 1024, 1095, 1065, 190,
+	1114, 192, 2, 0, 250, 205, 0, 0, 1058, 1097,
+	1060, 1084, 1052, 1080, 1011, 1072, 1109, 1040, 1077, 1110,
+	0, 0, 0, 0, 481, 482, 483, 0, 0, 0,
+	0, 148, 0, 0, 0, 0, 0, 1075, 1102, 1038,
+	0, 0, 1012, 0, 286, 215, 291, 1107, 1059, 1078,
+	0, 996, 1073, 0, 1001, 1004, 1115, 1100, 1033, 1034,
+	0, 0, 0, 0, 0, 0, 0, 1056, 1061, 1081,
+	1049, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1030, 0, 1069, 0, 0, 0, 1006, 1002,
+	0, 1054, 0, 139, 255, 270, 149, 246, 283, 153,
+	253, 145, 220, 242, 134, 133, 141, 268, 252, 202,
+	184, 185, 140, 0, 237, 163, 176, 160, 218, 1104,
+	1105, 159, 1005, 278, 143, 144, 277, 217, 265, 269,
+	203, 197, 142, 267, 201, 196, 188, 167, 180, 230,
+	195, 231, 181, 207, 206, 208, 1126, 1127, 1128, 1129,
+	1130, 1010, 0, 1031, 1082, 0, 994, 1091, 1098, 1051,
+	280, 1101, 1048, 1047, 1133, 0, 1132, 254, 1134, 1135,
+	189, 1096, 1027, 1037, 1032, 1035, 240, 223, 1103, 1068,
+	228, 238, 193, 266, 232, 271, 256, 257, 279, 1085,
+	233, 135, 258, 162, 204, 146, 147, 158, 164, 166,
+	168, 169, 213, 214, 226, 245, 259, 260, 261, 161,
+	154, 239, 155, 178, 156, 136, 247, 157, 137, 227,
+	264, 1131, 175, 235, 200, 138, 199, 229, 263, 262,
+	287, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 993, 275, 0, 219, 1093, 999, 1009, 1007, 1045,
+	1070, 1071, 1087, 1090, 1088, 1117, 243, 0, 0, 0,
+	0, 0, 183, 225, 0, 244, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1000, 0, 251, 273,
+	285, 276, 1046, 1018, 1057, 284, 1021, 1019, 1086, 1020,
+	1074, 1119, 209, 210, 211, 212, 1042, 0, 152, 1066,
+	1050, 1120, 1121, 1122, 1123, 1124, 1125, 1023, 1099, 171,
+	177, 0, 179, 151, 224, 174, 282, 186, 216, 182,
+	248, 187, 194, 236, 281, 222, 241, 150, 272, 249,
+	198, 173, 1017, 1022, 1016, 1063, 1064, 1111, 1112, 1113,
+	1083, 1008, 1094, 1013, 1015, 1014, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1089, 1076, 1138, 292, 293,
+	294, 295, 296, 1067, 132, 0, 191, 1118, 234, 170,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 86, 0, 685, 0, 0, 0, 1136, 1137, 288,
+	289, 290, 274, 221, 0, 0, 0, 0, 0, 657,
+	0, 0, 0, 165, 0, 0, 0, 190, 0, 192,
+	0, 0, 250, 205, 0, 0, 0, 0, 702, 708,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 650,
+	0, 0, 609, 692, 691, 667, 674, 0, 0, 148,
+	668, 0, 673, 0, 669, 672, 670, 671, 0, 0,
+	694, 0, 643, 641, 644, 0, 0, 0, 0, 0,
+	607, 654, 0, 658, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 651, 652, 0, 0,
+	0, 0, 686, 0, 653, 0, 0, 688, 0, 675,
+	0, 139, 255, 270, 149, 246, 283, 153, 253, 145,
+	220, 242, 134, 133, 141, 268, 252, 202, 184, 185,
+	140, 0, 237, 163, 176, 160, 218, 683, 684, 159,
+	681, 278, 143, 144, 277, 217, 265, 269, 203, 197,
+	142, 267, 201, 196, 188, 167, 180, 230, 195, 231,
+	181, 207, 206, 208, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 280, 0,
+	0, 700, 0, 0, 0, 254, 0, 0, 189, 0,
+	0, 0, 682, 0, 240, 223, 711, 0, 228, 238,
+	193, 266, 232, 271, 256, 257, 279, 0, 233, 135,
+	258, 162, 204, 146, 147, 158, 164, 166, 168, 169,
+	213, 214, 226, 245, 259, 260, 261, 161, 154, 239,
+	155, 178, 156, 136, 247, 157, 137, 227, 264, 0,
+	175, 235, 200, 138, 199, 229, 263, 262, 287, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 172, 0,
+	275, 698, 219, 710, 693, 695, 696, 699, 703, 704,
+	705, 707, 709, 712, 243, 0, 0, 0, 0, 0,
+	183, 225, 0, 244, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 251, 273, 285, 642,
+	0, 0, 0, 284, 0, 0, 0, 0, 0, 687,
+	209, 210, 211, 212, 701, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 171, 177, 0,
+	179, 151, 224, 174, 282, 186, 216, 182, 248, 187,
+	194, 236, 281, 222, 241, 150, 272, 249, 198, 173,
+	718, 697, 717, 719, 720, 716, 721, 722, 706, 659,
+	0, 714, 713, 715, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 292, 293, 294, 295,
+	296, 0, 132, 0, 191, 85, 234, 170, 611, 612,
+	613, 614, 615, 616, 617, 618, 103, 619, 620, 621,
+	622, 108, 623, 110, 624, 625, 626, 114, 627, 628,
+	629, 630, 631, 632, 633, 634, 635, 124, 637, 127,
+	128, 638, 639, 640, 636, 685, 0, 288, 289, 290,
+	274, 0, 0, 0, 0, 221, 0, 0, 0, 0,
+	0, 657, 0, 0, 0, 165, 858, 0, 0, 190,
+	0, 192, 0, 0, 250, 205, 0, 0, 0, 0,
+	702, 708, 0, 0, 0, 0, 0, 0, 854, 0,
+	0, 650, 0, 0, 609, 692, 691, 667, 674, 0,
+	0, 148, 668, 0, 673, 0, 669, 672, 670, 671,
+	0, 0, 694, 0, 643, 641, 644, 0, 0, 0,
+	0, 0, 607, 654, 0, 658, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 651, 652,
+	0, 0, 0, 0, 686, 0, 653, 0, 0, 855,
+	0, 675, 0, 139, 255, 270, 149, 246, 283, 153,
+	253, 145, 220, 242, 134, 133, 141, 268, 252, 202,
+	184, 185, 140, 0, 237, 163, 176, 160, 218, 683,
+	684, 159, 681, 278, 143, 144, 277, 217, 265, 269,
+	203, 197, 142, 267, 201, 196, 188, 167, 180, 230,
+	195, 231, 181, 207, 206, 208, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	280, 0, 0, 700, 0, 0, 0, 254, 0, 0,
+	189, 0, 0, 0, 682, 0, 240, 223, 711, 0,
+	228, 238, 193, 266, 232, 271, 256, 257, 279, 0,
+	233, 135, 258, 162, 204, 146, 147, 158, 164, 166,
+	168, 169, 213, 214, 226, 245, 259, 260, 261, 161,
+	154, 239, 155, 178, 156, 136, 247, 157, 137, 227,
+	264, 0, 175, 235, 200, 138, 199, 229, 263, 262,
+	287, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 275, 698, 219, 710, 693, 695, 696, 699,
+	703, 704, 705, 707, 709, 712, 243, 0, 0, 0,
+	0, 0, 183, 225, 0, 244, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 251, 273,
+	285, 642, 0, 0, 0, 284, 0, 0, 0, 0,
+	0, 687, 209, 210, 211, 212, 701, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 171,
+	177, 0, 179, 151, 224, 174, 282, 186, 216, 182,
+	248, 187, 194, 236, 281, 222, 241, 150, 272, 249,
+	198, 173, 718, 697, 717, 719, 720, 716, 721, 722,
+	706, 659, 0, 714, 713, 715, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 292, 293,
+	294, 295, 296, 0, 132, 0, 191, 0, 234, 170,
+	611, 612, 613, 614, 615, 616, 617, 618, 103, 619,
+	620, 621, 622, 108, 623, 110, 624, 625, 626, 114,
+	627, 628, 629, 630, 631, 632, 633, 634, 635, 124,
+	637, 127, 128, 638, 639, 640, 636, 685, 0, 288,
+	289, 290, 274, 0, 0, 0, 0, 221, 0, 0,
+	0, 0, 0, 657, 0, 0, 0, 165, 2198, 0,
+	0, 190, 0, 192, 0, 0, 250, 205, 0, 0,
+	0, 0, 702, 708, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 650, 0, 0, 609, 692, 691, 667,
+	674, 0, 0, 148, 668, 0, 673, 0, 669, 672,
+	670, 671, 0, 0, 694, 0, 643, 641, 644, 0,
+	0, 0, 0, 0, 607, 654, 0, 658, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	651, 652, 0, 0, 0, 0, 686, 0, 653, 0,
+	0, 688, 0, 675, 0, 139, 255, 270, 149, 246,
+	283, 153, 253, 145, 220, 242, 134, 133, 141, 268,
+	252, 202, 184, 185, 140, 0, 237, 163, 176, 160,
+	218, 683, 684, 159, 681, 278, 143, 144, 277, 217,
+	265, 269, 203, 197, 142, 267, 201, 196, 188, 167,
+	180, 230, 195, 231, 181, 207, 206, 208, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 280, 0, 0, 700, 0, 0, 0, 254,
+	0, 0, 189, 0, 0, 0, 682, 0, 240, 223,
+	711, 0, 228, 238, 193, 266, 232, 271, 256, 257,
+	279, 0, 233, 135, 258, 162, 204, 146, 147, 158,
+	164, 166, 168, 169, 213, 214, 226, 245, 259, 260,
+	261, 161, 154, 239, 155, 178, 156, 136, 247, 157,
+	137, 227, 264, 0, 175, 235, 200, 138, 199, 229,
+	263, 262, 287, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 172, 0, 275, 698, 219, 710, 693, 695,
+	696, 699, 703, 704, 705, 707, 709, 712, 243, 0,
+	0, 0, 0, 0, 183, 225, 0, 244, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	251, 273, 285, 642, 0, 0, 0, 284, 0, 0,
+	0, 0, 0, 687, 209, 210, 211, 212, 701, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 171, 177, 0, 179, 151, 224, 174, 282, 186,
+	216, 182, 248, 187, 194, 236, 281, 222, 241, 150,
+	272, 249, 198, 173, 718, 697, 717, 719, 720, 716,
+	721, 722, 706, 659, 0, 714, 713, 715, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	292, 293, 294, 295, 296, 0, 132, 0, 191, 0,
+	234, 170, 611, 612, 613, 614, 615, 616, 617, 618,
+	103, 619, 620, 621, 622, 108, 623, 110, 624, 625,
+	626, 114, 627, 628, 629, 630, 631, 632, 633, 634,
+	635, 124, 637, 127, 128, 638, 639, 640, 636, 685,
+	0, 288, 289, 290, 274, 0, 0, 0, 0, 221,
+	0, 0, 0, 0, 0, 657, 0, 0, 0, 165,
+	858, 0, 0, 190, 0, 192, 0, 0, 250, 205,
+	0, 0, 0, 0, 702, 708, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 650, 0, 0, 609, 692,
+	691, 667, 674, 0, 0, 148, 668, 0, 673, 0,
+	669, 672, 670, 671, 0, 0, 694, 0, 643, 641,
+	644, 0, 0, 0, 0, 0, 607, 654, 0, 658,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 651, 652, 0, 0, 0, 0, 686, 0,
+	653, 0, 0, 688, 0, 675, 0, 139, 255, 270,
+	149, 246, 283, 153, 253, 145, 220, 242, 134, 133,
+	141, 268, 252, 202, 184, 185, 140, 0, 237, 163,
+	176, 160, 218, 683, 684, 159, 681, 278, 143, 144,
+	277, 217, 265, 269, 203, 197, 142, 267, 201, 196,
+	188, 167, 180, 230, 195, 231, 181, 207, 206, 208,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 280, 0, 0, 700, 0, 0,
+	0, 254, 0, 0, 189, 0, 0, 0, 682, 0,
+	240, 223, 711, 0, 228, 238, 193, 266, 232, 271,
+	256, 257, 279, 0, 233, 135, 258, 162, 204, 146,
+	147, 158, 164, 166, 168, 169, 213, 214, 226, 245,
+	259, 260, 261, 161, 154, 239, 155, 178, 156, 136,
+	247, 157, 137, 227, 264, 0, 175, 235, 200, 138,
+	199, 229, 263, 262, 287, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 172, 0, 275, 698, 219, 710,
+	693, 695, 696, 699, 703, 704, 705, 707, 709, 712,
+	243, 0, 0, 0, 0, 0, 183, 225, 0, 244,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 251, 273, 285, 642, 0, 0, 0, 284,
+	0, 0, 0, 0, 0, 687, 209, 210, 211, 212,
+	701, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 171, 177, 0, 179, 151, 224, 174,
+	282, 186, 216, 182, 248, 187, 194, 236, 281, 222,
+	241, 150, 272, 249, 198, 173, 718, 697, 717, 719,
+	720, 716, 721, 722, 706, 659, 0, 714, 713, 715,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 292, 293, 294, 295, 296, 0, 132, 0,
+	191, 0, 234, 170, 611, 612, 613, 614, 615, 616,
+	617, 618, 103, 619, 620, 621, 622, 108, 623, 110,
+	624, 625, 626, 114, 627, 628, 629, 630, 631, 632,
+	633, 634, 635, 124, 637, 127, 128, 638, 639, 640,
+	636, 685, 0, 288, 289, 290, 274, 0, 0, 0,
+	0, 221, 0, 0, 0, 0, 0, 657, 0, 0,
+	0, 165, 0, 0, 0, 190, 0, 192, 0, 0,
+	250, 205, 0, 0, 0, 0, 702, 708, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 650, 0, 0,
+	609, 692, 691, 667, 674, 0, 0, 148, 668, 0,
+	673, 0, 669, 672, 670, 671, 0, 0, 694, 0,
+	643, 641, 644, 0, 0, 0, 0, 0, 607, 654,
+	0, 658, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 651, 652, 604, 0, 0, 0,
+	686, 0, 653, 0, 0, 688, 0, 675, 0, 139,
+	255, 270, 149, 246, 283, 153, 253, 145, 220, 242,
+	134, 133, 141, 268, 252, 202, 184, 185, 140, 0,
+	237, 163, 176, 160, 218, 683, 684, 159, 681, 278,
+	143, 144, 277, 217, 265, 269, 203, 197, 142, 267,
+	201, 196, 188, 167, 180, 230, 195, 231, 181, 207,
+	206, 208, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 280, 0, 0, 700,
+	0, 0, 0, 254, 0, 0, 189, 0, 0, 0,
+	682, 0, 240, 223, 711, 0, 228, 238, 193, 266,
+	232, 271, 256, 257, 279, 0, 233, 135, 258, 162,
+	204, 146, 147, 158, 164, 166, 168, 169, 213, 214,
+	226, 245, 259, 260, 261, 161, 154, 239, 155, 178,
+	156, 136, 247, 157, 137, 227, 264, 0, 175, 235,
+	200, 138, 199, 229, 263, 262, 287, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 172, 0, 275, 698,
+	219, 710, 693, 695, 696, 699, 703, 704, 705, 707,
+	709, 712, 243, 0, 0, 0, 0, 0, 183, 225,
+	0, 244, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 251, 273, 285, 642, 0, 0,
+	0, 284, 0, 0, 0, 0, 0, 687, 209, 210,
+	211, 212, 701, 0, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 171, 177, 0, 179, 151,
+	224, 174, 282, 186, 216, 182, 248, 187, 194, 236,
+	281, 222, 241, 150, 272, 249, 198, 173, 718, 697,
+	717, 719, 720, 716, 721, 722, 706, 659, 0, 714,
+	713, 715, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 292, 293, 294, 295, 296, 0,
+	132, 0, 191, 0, 234, 170, 611, 612, 613, 614,
+	615, 616, 617, 618, 103, 619, 620, 621, 622, 108,
+	623, 110, 624, 625, 626, 114, 627, 628, 629, 630,
+	631, 632, 633, 634, 635, 124, 637, 127, 128, 638,
+	639, 640, 636, 685, 0, 288, 289, 290, 274, 0,
+	0, 0, 0, 221, 0, 0, 0, 0, 0, 657,
+	0, 0, 0, 165, 0, 0, 0, 190, 0, 192,
+	0, 0, 250, 205, 0, 0, 0, 0, 702, 708,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 650,
+	0, 0, 609, 692, 691, 667, 674, 0, 0, 148,
+	668, 0, 673, 0, 669, 672, 670, 671, 0, 0,
+	694, 0, 643, 641, 644, 0, 0, 0, 0, 0,
+	607, 654, 0, 658, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 651, 652, 0, 0,
+	0, 0, 686, 0, 653, 0, 0, 688, 0, 675,
+	0, 139, 255, 270, 149, 246, 283, 153, 253, 145,
+	220, 242, 134, 133, 141, 268, 252, 202, 184, 185,
+	140, 0, 237, 163, 176, 160, 218, 683, 684, 159,
+	681, 278, 143, 144, 277, 217, 265, 269, 203, 197,
+	142, 267, 201, 196, 188, 167, 180, 230, 195, 231,
+	181, 207, 206, 208, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 280, 0,
+	0, 700, 0, 0, 0, 254, 0, 0, 189, 0,
+	0, 0, 682, 0, 240, 223, 711, 0, 228, 238,
+	193, 266, 232, 271, 256, 257, 279, 0, 233, 135,
+	258, 162, 204, 146, 147, 158, 164, 166, 168, 169,
+	213, 214, 226, 245, 259, 260, 261, 161, 154, 239,
+	155, 178, 156, 136, 247, 157, 137, 227, 264, 0,
+	175, 235, 200, 138, 199, 229, 263, 262, 287, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 172, 0,
+	275, 698, 219, 710, 693, 695, 696, 699, 703, 704,
+	705, 707, 709, 712, 243, 0, 0, 0, 0, 0,
+	183, 225, 0, 244, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 251, 273, 285, 642,
+	0, 0, 0, 284, 0, 0, 0, 0, 0, 687,
+	209, 210, 211, 212, 701, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 171, 177, 0,
+	179, 151, 224, 174, 282, 186, 216, 182, 248, 187,
+	194, 236, 281, 222, 241, 150, 272, 249, 198, 173,
+	718, 697, 717, 719, 720, 716, 721, 722, 706, 659,
+	0, 714, 713, 715, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 292, 293, 294, 295,
+	296, 0, 132, 0, 191, 0, 234, 170, 611, 612,
+	613, 614, 615, 616, 617, 618, 103, 619, 620, 621,
+	622, 108, 623, 110, 624, 625, 626, 114, 627, 628,
+	629, 630, 631, 632, 633, 634, 635, 124, 637, 127,
+	128, 638, 639, 640, 636, 685, 0, 288, 289, 290,
+	274, 0, 0, 0, 0, 221, 0, 0, 0, 0,
+	0, 657, 0, 0, 0, 165, 0, 0, 0, 190,
+	0, 192, 0, 0, 250, 205, 0, 0, 0, 0,
+	702, 708, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 650, 0, 0, 609, 692, 691, 667, 674, 0,
+	0, 148, 668, 0, 673, 0, 669, 672, 670, 671,
+	0, 0, 694, 0, 643, 641, 644, 0, 0, 0,
+	0, 0, 0, 654, 0, 658, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 651, 652,
+	0, 0, 0, 0, 686, 0, 653, 0, 0, 688,
+	0, 675, 0, 139, 255, 270, 149, 246, 283, 153,
+	253, 145, 220, 242, 134, 133, 141, 268, 252, 202,
+	184, 185, 140, 0, 237, 163, 176, 160, 218, 683,
+	684, 159, 681, 278, 143, 144, 277, 217, 265, 269,
+	203, 197, 142, 267, 201, 196, 188, 167, 180, 230,
+	195, 231, 181, 207, 206, 208, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	280, 0, 0, 700, 0, 0, 0, 254, 0, 0,
+	189, 0, 0, 0, 682, 0, 240, 223, 711, 0,
+	228, 238, 193, 266, 232, 271, 256, 257, 279, 0,
+	233, 135, 258, 162, 204, 146, 147, 158, 164, 166,
+	168, 169, 213, 214, 226, 245, 259, 260, 261, 161,
+	154, 239, 155, 178, 156, 136, 247, 157, 137, 227,
+	264, 0, 175, 235, 200, 138, 199, 229, 263, 262,
+	287, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 275, 698, 219, 710, 693, 695, 696, 699,
+	703, 704, 705, 707, 709, 712, 243, 0, 0, 0,
+	0, 0, 183, 225, 0, 244, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 251, 273,
+	285, 642, 0, 0, 0, 284, 0, 0, 0, 0,
+	0, 687, 209, 210, 211, 212, 701, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 171,
+	177, 0, 179, 151, 224, 174, 282, 186, 216, 182,
+	248, 187, 194, 236, 281, 222, 241, 150, 272, 249,
+	198, 173, 718, 697, 717, 719, 720, 716, 721, 722,
+	706, 659, 0, 714, 713, 715, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 292, 293,
+	294, 295, 296, 0, 132, 0, 191, 0, 234, 170,
+	611, 612, 613, 614, 615, 616, 617, 618, 103, 619,
+	620, 621, 622, 108, 623, 110, 624, 625, 626, 114,
+	627, 628, 629, 630, 631, 632, 633, 634, 635, 124,
+	637, 127, 128, 638, 639, 640, 636, 0, 0, 288,
+	289, 290, 274, 335, 0, 334, 338, 330, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 326, 0, 0,
+	0, 0, 0, 0, 0, 165, 0, 0, 345, 190,
+	0, 192, 0, 0, 250, 205, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 348, 0, 0, 349, 0, 0,
+	0, 148, 1285, 1283, 1284, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 286, 215, 291, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 255, 270, 149, 246, 283, 153,
+	253, 145, 220, 242, 134, 133, 141, 268, 252, 202,
+	184, 185, 140, 0, 237, 163, 176, 160, 218, 0,
+	0, 159, 0, 278, 143, 144, 277, 217, 265, 269,
+	203, 197, 142, 267, 201, 196, 188, 167, 180, 230,
+	195, 231, 181, 207, 206, 208, 0, 0, 0, 0,
+	0, 328, 327, 331, 0, 0, 0, 0, 0, 333,
+	280, 0, 0, 0, 0, 0, 0, 254, 0, 0,
+	189, 337, 0, 0, 0, 0, 240, 223, 0, 0,
+	228, 238, 193, 266, 232, 329, 256, 257, 279, 0,
+	353, 135, 258, 162, 204, 146, 147, 158, 164, 166,
+	168, 169, 213, 214, 226, 245, 259, 260, 261, 161,
+	154, 239, 155, 178, 156, 136, 247, 157, 137, 227,
+	264, 0, 175, 235, 200, 138, 199, 229, 263, 262,
+	287, 1281, 0, 1278, 0, 0, 0, 1280, 1277, 1279,
+	172, 0, 275, 1282, 219, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 243, 0, 0, 0,
+	332, 336, 339, 225, 340, 341, 0, 0, 342, 343,
+	344, 0, 0, 346, 347, 0, 0, 0, 251, 273,
+	285, 276, 0, 0, 0, 284, 0, 0, 0, 0,
+	0, 0, 209, 210, 211, 212, 0, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 171,
+	177, 0, 179, 151, 224, 174, 282, 186, 216, 182,
+	248, 187, 194, 236, 281, 222, 241, 150, 272, 249,
+	198, 173, 0, 0, 1266, 1267, 1268, 1269, 1270, 1271,
+	1272, 1273, 1274, 1275, 1276, 1288, 1289, 1290, 1291, 1292,
+	1293, 1286, 1287, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 292, 293,
+	294, 295, 296, 0, 132, 0, 191, 0, 234, 170,
 	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
 	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 210, 0,
-	276, 277, 278, 262, 0, 0, 0, 0, 154, 0,
-	0, 0, 179, 0, 181, 0, 0, 239, 194, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 390, 391,
-	0, 0, 0, 0, 137, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 392, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	128, 244, 258, 138, 235, 271, 142, 242, 134, 209,
-	231, 130, 256, 241, 191, 173, 174, 129, 0, 226,
-	152, 165, 149, 207, 0, 0, 148, 274, 394, 266,
-	132, 393, 265, 206, 253, 257, 192, 186, 131, 255,
-	190, 185, 177, 156, 169, 219, 184, 220, 170, 196,
-	195, 197, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 268, 0, 0, 0,
-	0, 0, 0, 243, 0, 0, 178, 0, 0, 0,
-	0, 0, 229, 212, 0, 0, 217, 227, 182, 254,
-	221, 259, 245, 267, 0, 222, 124, 246, 151, 193,
-	135, 136, 147, 153, 155, 157, 158, 202, 203, 215,
-	234, 247, 248, 249, 150, 143, 228, 144, 167, 145,
-	125, 236, 146, 126, 216, 252, 0, 164, 224, 189,
-	127, 188, 218, 251, 250, 275, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 0, 263, 0, 208,
-	0, 0, 0, 0, 0, 0, 0, 204, 279, 0,
-	0, 0, 0, 232, 0, 0, 0, 0, 0, 172,
-	214, 0, 233, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 240, 261, 273, 264, 0,
-	0, 0, 272, 0, 0, 0, 0, 0, 0, 198,
-	199, 200, 201, 0, 0, 141, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 160, 166, 0, 168,
-	140, 213, 163, 270, 175, 387, 383, 384, 176, 183,
-	225, 269, 211, 230, 139, 260, 238, 385, 162, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 123, 0, 180, 0, 223, 159,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 0, 0, 276, 277,
-	278, 262, 210, 0, 530, 0, 0, 0, 0, 0,
-	0, 0, 154, 531, 0, 0, 179, 0, 181, 0,
-	0, 239, 194, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 331, 0, 0, 332, 0, 0, 0, 137, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 128, 244, 258, 138, 235, 271,
-	142, 242, 134, 209, 231, 130, 256, 241, 191, 173,
-	174, 129, 0, 226, 152, 165, 149, 207, 0, 0,
-	148, 274, 0, 266, 132, 133, 265, 206, 253, 257,
-	192, 186, 131, 255, 190, 185, 177, 156, 169, 219,
-	184, 220, 170, 196, 195, 197, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	268, 0, 0, 0, 0, 0, 0, 243, 0, 0,
-	178, 0, 0, 0, 0, 0, 229, 212, 0, 0,
-	217, 227, 182, 254, 221, 259, 245, 267, 0, 222,
-	124, 246, 151, 193, 135, 136, 147, 153, 155, 157,
-	158, 202, 203, 215, 234, 247, 248, 249, 150, 143,
-	228, 144, 167, 145, 125, 236, 146, 126, 216, 252,
-	0, 164, 224, 189, 127, 188, 218, 251, 250, 275,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	0, 263, 0, 208, 0, 0, 0, 0, 0, 0,
-	0, 204, 279, 0, 0, 0, 0, 232, 0, 0,
-	0, 0, 0, 172, 214, 0, 233, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
-	261, 273, 264, 0, 0, 0, 272, 0, 0, 0,
-	0, 532, 0, 198, 199, 200, 201, 0, 0, 141,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	160, 166, 0, 168, 140, 213, 163, 270, 175, 205,
-	171, 237, 176, 183, 225, 269, 211, 230, 139, 260,
-	238, 187, 162, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 123, 0,
-	180, 0, 223, 159, 87, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	126, 127, 128, 129, 130, 131, 125, 0, 0, 288,
+	289, 290, 274, 335, 0, 334, 338, 330, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 326, 0, 0,
+	0, 0, 0, 0, 0, 165, 0, 0, 345, 190,
+	0, 192, 0, 0, 250, 205, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 348, 0, 0, 349, 0, 0,
+	0, 148, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 286, 215, 291, 0, 0, 0,
+	0, 0, 0, 0, 0, 335, 0, 334, 338, 330,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 326,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	345, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 255, 270, 149, 246, 283, 153,
+	253, 145, 220, 242, 134, 133, 141, 268, 252, 202,
+	184, 185, 140, 0, 237, 163, 176, 160, 218, 0,
+	0, 159, 0, 278, 143, 144, 277, 217, 265, 269,
+	203, 197, 142, 267, 201, 196, 188, 167, 180, 230,
+	195, 231, 181, 207, 206, 208, 0, 0, 0, 0,
+	0, 328, 327, 331, 0, 0, 0, 0, 0, 333,
+	280, 0, 0, 0, 0, 0, 0, 254, 0, 0,
+	189, 337, 0, 0, 0, 0, 240, 223, 0, 0,
+	228, 238, 193, 266, 232, 329, 256, 257, 279, 0,
+	233, 135, 258, 162, 204, 146, 147, 158, 164, 166,
+	168, 169, 213, 214, 226, 245, 259, 260, 261, 161,
+	154, 239, 155, 178, 156, 136, 247, 157, 137, 227,
+	264, 0, 175, 235, 200, 138, 199, 229, 263, 262,
+	287, 0, 0, 328, 327, 331, 0, 0, 0, 0,
+	172, 333, 275, 0, 219, 0, 0, 0, 0, 0,
+	0, 0, 0, 337, 0, 0, 243, 0, 0, 0,
+	332, 336, 339, 225, 340, 341, 0, 773, 342, 343,
+	344, 0, 0, 346, 347, 0, 0, 0, 251, 273,
+	285, 276, 0, 0, 0, 284, 0, 0, 0, 0,
+	0, 0, 209, 210, 211, 212, 0, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 171,
+	177, 0, 179, 151, 224, 174, 282, 186, 216, 182,
+	248, 187, 194, 236, 281, 222, 241, 150, 272, 249,
+	198, 173, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 332, 336, 774, 0, 340, 775, 0, 0,
+	342, 343, 344, 0, 0, 346, 347, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 292, 293,
+	294, 295, 296, 0, 132, 0, 191, 0, 234, 170,
+	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	126, 127, 128, 129, 130, 131, 125, 0, 0, 288,
+	289, 290, 274, 86, 0, 26, 44, 27, 0, 0,
+	0, 0, 0, 0, 0, 221, 299, 0, 0, 0,
+	0, 0, 0, 0, 0, 165, 0, 0, 0, 190,
+	0, 192, 0, 0, 250, 205, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 304, 0, 0, 92, 0, 0, 0, 0, 0,
+	0, 148, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 286, 215, 291, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 255, 270, 149, 246, 283, 153,
+	253, 145, 220, 242, 134, 133, 141, 268, 252, 202,
+	184, 185, 140, 0, 237, 163, 176, 160, 218, 0,
+	0, 159, 0, 278, 143, 144, 277, 217, 265, 269,
+	203, 197, 142, 267, 201, 196, 188, 167, 180, 230,
+	195, 231, 181, 207, 206, 208, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 303, 0, 0, 0, 0,
+	280, 0, 0, 0, 0, 0, 0, 254, 0, 0,
+	189, 0, 0, 0, 0, 0, 240, 223, 0, 0,
+	228, 238, 193, 266, 232, 271, 256, 257, 279, 0,
+	233, 135, 258, 162, 204, 146, 147, 158, 164, 166,
+	168, 169, 213, 214, 226, 245, 259, 260, 261, 161,
+	154, 239, 155, 178, 156, 136, 247, 157, 137, 227,
+	264, 0, 175, 235, 200, 138, 199, 229, 263, 262,
+	287, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 275, 0, 219, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 243, 0, 0, 0,
+	0, 0, 183, 225, 0, 244, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 251, 273,
+	285, 276, 0, 0, 0, 284, 0, 0, 0, 0,
+	0, 0, 209, 210, 211, 212, 300, 302, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 171,
+	177, 0, 179, 151, 224, 174, 282, 186, 216, 182,
+	248, 187, 194, 236, 281, 222, 241, 150, 272, 249,
+	198, 173, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 292, 293,
+	294, 295, 296, 0, 132, 0, 191, 85, 234, 170,
+	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	126, 127, 128, 129, 130, 131, 125, 221, 0, 288,
+	289, 290, 274, 0, 0, 0, 0, 165, 0, 0,
+	0, 190, 0, 192, 0, 0, 250, 205, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 92, 0, 0, 0,
+	0, 0, 0, 148, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 286, 215, 291, 1601,
+	1604, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 139, 255, 270, 149, 246,
+	283, 153, 253, 145, 220, 242, 134, 133, 141, 268,
+	252, 202, 184, 185, 140, 0, 237, 163, 176, 160,
+	218, 0, 0, 159, 0, 278, 143, 144, 277, 217,
+	265, 269, 203, 197, 142, 267, 201, 196, 188, 167,
+	180, 230, 195, 231, 181, 207, 206, 208, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1605, 280, 0, 0, 0, 1598, 0, 1597, 254,
+	1599, 1602, 189, 0, 0, 0, 0, 0, 240, 223,
+	0, 0, 228, 238, 193, 266, 232, 271, 256, 257,
+	279, 0, 233, 135, 258, 162, 204, 146, 147, 158,
+	164, 166, 168, 169, 213, 214, 226, 245, 259, 260,
+	261, 161, 154, 239, 155, 178, 156, 136, 247, 157,
+	137, 227, 264, 1603, 175, 235, 200, 138, 199, 229,
+	263, 262, 287, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 172, 0, 275, 0, 219, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 243, 0,
+	0, 0, 0, 0, 183, 225, 0, 244, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	251, 273, 285, 276, 0, 0, 0, 284, 0, 0,
+	0, 0, 0, 0, 209, 210, 211, 212, 0, 0,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 171, 177, 0, 179, 151, 224, 174, 282, 186,
+	216, 182, 248, 187, 194, 236, 281, 222, 241, 150,
+	272, 249, 198, 173, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	292, 293, 294, 295, 296, 0, 132, 0, 191, 0,
+	234, 170, 95, 96, 97, 98, 99, 100, 101, 102,
 	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
 	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	0, 0, 276, 277, 278, 262, 210, 0, 784, 0,
-	0, 0, 0, 0, 0, 0, 154, 0, 0, 0,
-	179, 0, 181, 0, 0, 239, 194, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 331, 0, 0, 332, 0,
-	0, 0, 137, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 128, 244,
-	258, 138, 235, 271, 142, 242, 134, 209, 231, 130,
-	256, 241, 191, 173, 174, 129, 0, 226, 152, 165,
-	149, 207, 0, 0, 148, 274, 0, 266, 132, 133,
-	265, 206, 253, 257, 192, 186, 131, 255, 190, 185,
-	177, 156, 169, 219, 184, 220, 170, 196, 195, 197,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 268, 0, 0, 0, 0, 0,
-	0, 243, 0, 0, 178, 0, 0, 0, 0, 0,
-	229, 212, 0, 0, 217, 227, 182, 254, 221, 259,
-	245, 267, 0, 222, 124, 246, 151, 193, 135, 136,
-	147, 153, 155, 157, 158, 202, 203, 215, 234, 247,
-	248, 249, 150, 143, 228, 144, 167, 145, 125, 236,
-	146, 126, 216, 252, 0, 164, 224, 189, 127, 188,
-	218, 251, 250, 275, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 0, 263, 0, 208, 0, 0,
-	0, 0, 0, 0, 0, 204, 279, 0, 0, 0,
-	0, 232, 0, 0, 0, 0, 0, 172, 214, 0,
-	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 240, 261, 273, 264, 0, 0, 0,
-	272, 0, 0, 0, 0, 783, 0, 198, 199, 200,
-	201, 0, 0, 141, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 160, 166, 0, 168, 140, 213,
-	163, 270, 175, 205, 171, 237, 176, 183, 225, 269,
-	211, 230, 139, 260, 238, 187, 162, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 180, 0, 223, 159, 87, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 210, 0, 276, 277, 278, 262,
-	0, 0, 0, 0, 154, 0, 0, 0, 179, 0,
-	181, 0, 0, 239, 194, 0, 0, 0, 0, 0,
+	123, 124, 126, 127, 128, 129, 130, 131, 125, 221,
+	0, 288, 289, 290, 274, 0, 0, 0, 0, 165,
+	406, 0, 0, 190, 0, 192, 0, 0, 250, 205,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1986, 84, 652, 0, 0, 0, 0, 0,
-	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 92, 414,
+	415, 0, 0, 0, 0, 148, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 419, 0, 286, 215,
+	291, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 255, 270,
+	149, 246, 283, 153, 253, 145, 220, 242, 134, 133,
+	141, 268, 252, 202, 184, 185, 140, 0, 237, 163,
+	176, 160, 218, 0, 0, 159, 421, 278, 143, 420,
+	277, 217, 265, 269, 203, 197, 142, 267, 201, 196,
+	188, 167, 180, 230, 195, 231, 181, 207, 206, 208,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 128, 244, 258, 138,
-	235, 271, 142, 242, 134, 209, 231, 130, 256, 241,
-	191, 173, 174, 129, 0, 226, 152, 165, 149, 207,
-	0, 0, 148, 274, 0, 266, 132, 133, 265, 206,
-	253, 257, 192, 186, 131, 255, 190, 185, 177, 156,
-	169, 219, 184, 220, 170, 196, 195, 197, 0, 0,
+	0, 0, 0, 0, 280, 0, 0, 0, 0, 0,
+	0, 254, 0, 0, 189, 0, 0, 0, 0, 0,
+	240, 223, 0, 0, 228, 238, 193, 266, 232, 271,
+	256, 257, 279, 405, 233, 135, 258, 162, 204, 146,
+	147, 158, 164, 166, 168, 169, 213, 214, 226, 245,
+	259, 260, 261, 161, 154, 239, 155, 178, 156, 136,
+	247, 157, 137, 227, 264, 0, 175, 235, 200, 138,
+	199, 229, 263, 262, 287, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 172, 0, 275, 0, 219, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 268, 0, 0, 0, 0, 0, 0, 243,
-	0, 0, 178, 0, 0, 0, 0, 0, 229, 212,
-	0, 0, 217, 227, 182, 254, 221, 259, 245, 267,
-	0, 222, 124, 246, 151, 193, 135, 136, 147, 153,
-	155, 157, 158, 202, 203, 215, 234, 247, 248, 249,
-	150, 143, 228, 144, 167, 145, 125, 236, 146, 126,
-	216, 252, 0, 164, 224, 189, 127, 188, 218, 251,
-	250, 275, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 0, 263, 0, 208, 0, 0, 0, 0,
-	0, 0, 0, 204, 279, 0, 0, 0, 0, 232,
-	0, 0, 0, 0, 0, 172, 214, 0, 233, 0,
+	243, 0, 0, 0, 0, 0, 183, 225, 0, 244,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 240, 261, 273, 264, 0, 0, 0, 272, 0,
-	0, 0, 0, 0, 0, 198, 199, 200, 201, 0,
-	0, 141, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 160, 166, 0, 168, 140, 213, 163, 270,
-	175, 205, 171, 237, 176, 183, 225, 269, 211, 230,
-	139, 260, 238, 187, 162, 0, 0, 0, 0, 0,
+	0, 0, 251, 273, 285, 276, 0, 0, 0, 284,
+	0, 0, 0, 0, 0, 408, 209, 210, 211, 212,
+	0, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 171, 177, 0, 179, 151, 224, 174,
+	282, 186, 416, 411, 412, 187, 194, 236, 281, 222,
+	241, 150, 272, 249, 413, 173, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 0, 180, 0, 223, 159, 87, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
+	0, 0, 292, 293, 294, 295, 296, 0, 132, 0,
+	191, 0, 234, 170, 95, 96, 97, 98, 99, 100,
 	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
 	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 210, 0, 276, 277, 278, 262, 0, 0,
-	0, 0, 154, 0, 0, 0, 179, 0, 181, 0,
-	0, 239, 194, 0, 0, 0, 0, 0, 0, 0,
+	121, 122, 123, 124, 126, 127, 128, 129, 130, 131,
+	125, 221, 0, 288, 289, 290, 274, 0, 0, 0,
+	0, 165, 0, 0, 0, 190, 0, 192, 0, 0,
+	250, 205, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	92, 0, 0, 949, 0, 0, 0, 148, 951, 0,
+	0, 0, 952, 0, 0, 0, 0, 0, 0, 0,
+	286, 215, 291, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 953, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	255, 270, 149, 246, 283, 153, 253, 145, 220, 242,
+	134, 133, 141, 268, 252, 202, 184, 185, 140, 0,
+	237, 163, 176, 160, 218, 0, 0, 159, 0, 278,
+	143, 144, 277, 217, 265, 269, 203, 197, 142, 267,
+	201, 196, 188, 167, 180, 230, 195, 231, 181, 207,
+	206, 208, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 280, 0, 0, 0,
+	0, 0, 0, 254, 0, 0, 189, 0, 0, 0,
+	0, 0, 240, 223, 0, 0, 228, 238, 193, 266,
+	232, 271, 256, 257, 279, 0, 233, 135, 258, 162,
+	204, 146, 147, 158, 164, 166, 168, 169, 213, 214,
+	226, 245, 259, 260, 261, 161, 154, 239, 155, 178,
+	156, 136, 247, 157, 137, 227, 264, 0, 175, 235,
+	200, 138, 199, 229, 263, 262, 287, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 172, 0, 275, 0,
+	219, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 243, 0, 0, 0, 0, 0, 183, 225,
+	0, 244, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 251, 273, 285, 276, 0, 0,
+	0, 284, 0, 0, 0, 0, 0, 0, 209, 210,
+	211, 212, 0, 0, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 171, 177, 0, 179, 151,
+	224, 174, 282, 186, 216, 182, 248, 187, 194, 236,
+	281, 222, 241, 150, 272, 249, 198, 173, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 292, 293, 294, 295, 296, 0,
+	132, 0, 191, 0, 234, 170, 95, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
+	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
+	119, 120, 121, 122, 123, 124, 126, 127, 128, 129,
+	130, 131, 125, 86, 0, 288, 289, 290, 274, 0,
+	0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 165, 0, 0, 0, 190,
+	0, 192, 0, 0, 250, 205, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 83, 0, 976, 92, 0, 0, 0, 0, 0,
+	0, 148, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 286, 215, 291, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 255, 270, 149, 246, 283, 153,
+	253, 145, 220, 242, 134, 133, 141, 268, 252, 202,
+	184, 185, 140, 0, 237, 163, 176, 160, 218, 0,
+	0, 159, 0, 278, 143, 144, 277, 217, 265, 269,
+	203, 197, 142, 267, 201, 196, 188, 167, 180, 230,
+	195, 231, 181, 207, 206, 208, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	280, 0, 0, 0, 0, 0, 0, 254, 0, 0,
+	189, 0, 0, 0, 0, 0, 240, 223, 0, 0,
+	228, 238, 193, 266, 232, 271, 256, 257, 279, 0,
+	233, 135, 258, 162, 204, 146, 147, 158, 164, 166,
+	168, 169, 213, 214, 226, 245, 259, 260, 261, 161,
+	154, 239, 155, 178, 156, 136, 247, 157, 137, 227,
+	264, 0, 175, 235, 200, 138, 199, 229, 263, 262,
+	287, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 275, 0, 219, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 243, 0, 0, 0,
+	0, 0, 183, 225, 0, 244, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 251, 273,
+	285, 276, 0, 0, 0, 284, 0, 0, 0, 0,
+	0, 0, 209, 210, 211, 212, 0, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 171,
+	177, 0, 179, 151, 224, 174, 282, 186, 216, 182,
+	248, 187, 194, 236, 281, 222, 241, 150, 272, 249,
+	198, 173, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 292, 293,
+	294, 295, 296, 0, 132, 0, 191, 85, 234, 170,
+	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
+	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
+	115, 116, 117, 118, 119, 120, 121, 122, 123, 124,
+	126, 127, 128, 129, 130, 131, 125, 0, 221, 288,
+	289, 290, 274, 883, 0, 0, 0, 0, 165, 0,
+	0, 0, 190, 0, 192, 0, 0, 250, 205, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 92, 0, 0,
+	0, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 286, 215, 291,
+	0, 0, 880, 881, 879, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 255, 270, 149,
+	246, 283, 153, 253, 145, 220, 242, 134, 133, 141,
+	268, 252, 202, 184, 185, 140, 0, 237, 163, 176,
+	160, 218, 0, 0, 159, 0, 278, 143, 144, 277,
+	217, 265, 269, 203, 197, 142, 267, 201, 196, 188,
+	167, 180, 230, 195, 231, 181, 207, 206, 208, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 280, 0, 0, 0, 0, 0, 0,
+	254, 0, 0, 189, 0, 0, 0, 0, 0, 240,
+	223, 0, 0, 228, 238, 193, 266, 232, 271, 256,
+	257, 279, 0, 233, 135, 258, 162, 204, 146, 147,
+	158, 164, 166, 168, 169, 213, 214, 226, 245, 259,
+	260, 261, 161, 154, 239, 155, 178, 156, 136, 247,
+	157, 137, 227, 264, 0, 175, 235, 200, 138, 199,
+	229, 263, 262, 287, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 275, 0, 219, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 243,
+	0, 0, 0, 0, 0, 183, 225, 0, 244, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 273, 285, 276, 0, 0, 0, 284, 0,
+	0, 0, 0, 0, 0, 209, 210, 211, 212, 0,
+	0, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 177, 0, 179, 151, 224, 174, 282,
+	186, 216, 182, 248, 187, 194, 236, 281, 222, 241,
+	150, 272, 249, 198, 173, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 292, 293, 294, 295, 296, 0, 132, 0, 191,
+	0, 234, 170, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 126, 127, 128, 129, 130, 131, 125,
+	221, 0, 288, 289, 290, 274, 0, 0, 0, 0,
+	165, 0, 0, 0, 190, 0, 192, 0, 0, 250,
+	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 92,
+	414, 415, 0, 0, 0, 0, 148, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 419, 0, 286,
+	215, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 255,
+	270, 149, 246, 283, 153, 253, 145, 220, 242, 134,
+	133, 141, 268, 252, 202, 184, 185, 140, 0, 237,
+	163, 176, 160, 218, 0, 0, 159, 421, 278, 143,
+	420, 277, 217, 265, 269, 203, 197, 142, 267, 201,
+	196, 188, 167, 180, 230, 195, 231, 181, 207, 206,
+	208, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 280, 0, 0, 0, 0,
+	0, 0, 254, 0, 0, 189, 0, 0, 0, 0,
+	0, 240, 223, 0, 0, 228, 238, 193, 266, 232,
+	271, 256, 257, 279, 0, 233, 135, 258, 162, 204,
+	146, 147, 158, 164, 166, 168, 169, 213, 214, 226,
+	245, 259, 260, 261, 161, 154, 239, 155, 178, 156,
+	136, 247, 157, 137, 227, 264, 0, 175, 235, 200,
+	138, 199, 229, 263, 262, 287, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 275, 0, 219,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 243, 0, 0, 0, 0, 0, 183, 225, 0,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 273, 285, 276, 0, 0, 0,
+	284, 0, 0, 0, 0, 0, 0, 209, 210, 211,
+	212, 0, 0, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 171, 177, 0, 179, 151, 224,
+	174, 282, 186, 416, 411, 412, 187, 194, 236, 281,
+	222, 241, 150, 272, 249, 413, 173, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 292, 293, 294, 295, 296, 0, 132,
+	0, 191, 0, 234, 170, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 126, 127, 128, 129, 130,
+	131, 125, 0, 0, 288, 289, 290, 274, 221, 0,
+	563, 0, 0, 0, 0, 0, 0, 0, 165, 564,
+	0, 0, 190, 0, 192, 0, 0, 250, 205, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 0, 0, 736, 0, 0, 0, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 348, 0, 0,
+	349, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 286, 215, 291,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 255, 270, 149,
+	246, 283, 153, 253, 145, 220, 242, 134, 133, 141,
+	268, 252, 202, 184, 185, 140, 0, 237, 163, 176,
+	160, 218, 0, 0, 159, 0, 278, 143, 144, 277,
+	217, 265, 269, 203, 197, 142, 267, 201, 196, 188,
+	167, 180, 230, 195, 231, 181, 207, 206, 208, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 128, 244, 258, 138, 235, 271,
-	142, 242, 134, 209, 231, 130, 256, 241, 191, 173,
-	174, 129, 0, 226, 152, 165, 149, 207, 0, 0,
-	148, 274, 0, 266, 132, 133, 265, 206, 253, 257,
-	192, 186, 131, 255, 190, 185, 177, 156, 169, 219,
-	184, 220, 170, 196, 195, 197, 0, 0, 0, 0,
+	0, 0, 0, 280, 0, 0, 0, 0, 0, 0,
+	254, 0, 0, 189, 0, 0, 0, 0, 0, 240,
+	223, 0, 0, 228, 238, 193, 266, 232, 271, 256,
+	257, 279, 0, 233, 135, 258, 162, 204, 146, 147,
+	158, 164, 166, 168, 169, 213, 214, 226, 245, 259,
+	260, 261, 161, 154, 239, 155, 178, 156, 136, 247,
+	157, 137, 227, 264, 0, 175, 235, 200, 138, 199,
+	229, 263, 262, 287, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 275, 0, 219, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 243,
+	0, 0, 0, 0, 0, 183, 225, 0, 244, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	268, 0, 0, 0, 0, 0, 0, 243, 0, 0,
-	178, 0, 0, 0, 0, 0, 229, 212, 0, 0,
-	217, 227, 182, 254, 221, 259, 245, 267, 0, 222,
-	124, 246, 151, 193, 135, 136, 147, 153, 155, 157,
-	158, 202, 203, 215, 234, 247, 248, 249, 150, 143,
-	228, 144, 167, 145, 125, 236, 146, 126, 216, 252,
-	0, 164, 224, 189, 127, 188, 218, 251, 250, 275,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	0, 263, 0, 208, 0, 0, 0, 0, 0, 0,
-	0, 204, 279, 0, 0, 0, 0, 232, 0, 0,
-	0, 0, 0, 172, 214, 0, 233, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
-	261, 273, 264, 0, 0, 0, 272, 0, 0, 0,
-	0, 0, 1409, 198, 199, 200, 201, 0, 0, 141,
+	0, 251, 273, 285, 276, 0, 0, 0, 284, 0,
+	0, 0, 0, 565, 0, 209, 210, 211, 212, 0,
+	0, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 177, 0, 179, 151, 224, 174, 282,
+	186, 216, 182, 248, 187, 194, 236, 281, 222, 241,
+	150, 272, 249, 198, 173, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	160, 166, 0, 168, 140, 213, 163, 270, 175, 205,
-	171, 237, 176, 183, 225, 269, 211, 230, 139, 260,
-	238, 187, 162, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 123, 0,
-	180, 0, 223, 159, 87, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	210, 0, 276, 277, 278, 262, 0, 0, 0, 0,
-	154, 1145, 0, 0, 179, 0, 181, 0, 0, 239,
-	194, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	0, 0, 736, 0, 0, 0, 137, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 128, 244, 258, 138, 235, 271, 142, 242,
-	134, 209, 231, 130, 256, 241, 191, 173, 174, 129,
-	0, 226, 152, 165, 149, 207, 0, 0, 148, 274,
-	0, 266, 132, 133, 265, 206, 253, 257, 192, 186,
-	131, 255, 190, 185, 177, 156, 169, 219, 184, 220,
-	170, 196, 195, 197, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	0, 0, 0, 0, 0, 243, 0, 0, 178, 0,
-	0, 0, 0, 0, 229, 212, 0, 0, 217, 227,
-	182, 254, 221, 259, 245, 267, 0, 222, 124, 246,
-	151, 193, 135, 136, 147, 153, 155, 157, 158, 202,
-	203, 215, 234, 247, 248, 249, 150, 143, 228, 144,
-	167, 145, 125, 236, 146, 126, 216, 252, 0, 164,
-	224, 189, 127, 188, 218, 251, 250, 275, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 0, 263,
-	0, 208, 0, 0, 0, 0, 0, 0, 0, 204,
-	279, 0, 0, 0, 0, 232, 0, 0, 0, 0,
-	0, 172, 214, 0, 233, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 240, 261, 273,
-	264, 0, 0, 0, 272, 0, 0, 0, 0, 0,
-	0, 198, 199, 200, 201, 0, 0, 141, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 160, 166,
-	0, 168, 140, 213, 163, 270, 175, 205, 171, 237,
-	176, 183, 225, 269, 211, 230, 139, 260, 238, 187,
-	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 0, 180, 0,
-	223, 159, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 210, 0,
-	276, 277, 278, 262, 0, 0, 0, 0, 154, 0,
-	0, 0, 179, 0, 181, 0, 0, 239, 194, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 652, 0,
-	0, 0, 0, 0, 137, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	128, 244, 258, 138, 235, 271, 142, 242, 134, 209,
-	231, 130, 256, 241, 191, 173, 174, 129, 0, 226,
-	152, 165, 149, 207, 0, 0, 148, 274, 0, 266,
-	132, 133, 265, 206, 253, 257, 192, 186, 131, 255,
-	190, 185, 177, 156, 169, 219, 184, 220, 170, 196,
-	195, 197, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 268, 0, 0, 0,
-	0, 0, 0, 243, 0, 0, 178, 0, 0, 0,
-	0, 0, 229, 212, 0, 0, 217, 227, 182, 254,
-	221, 259, 245, 267, 0, 222, 124, 246, 151, 193,
-	135, 136, 147, 153, 155, 157, 158, 202, 203, 215,
-	234, 247, 248, 249, 150, 143, 228, 144, 167, 145,
-	125, 236, 146, 126, 216, 252, 0, 164, 224, 189,
-	127, 188, 218, 251, 250, 275, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 0, 263, 0, 208,
-	0, 0, 0, 0, 0, 0, 0, 204, 279, 0,
-	0, 0, 0, 232, 0, 0, 0, 0, 0, 172,
-	214, 0, 233, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 240, 261, 273, 264, 0,
-	0, 0, 272, 0, 0, 0, 0, 0, 0, 198,
-	199, 200, 201, 0, 0, 141, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 160, 166, 0, 168,
-	140, 213, 163, 270, 175, 205, 171, 237, 176, 183,
-	225, 269, 211, 230, 139, 260, 238, 187, 162, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 123, 0, 180, 0, 223, 159,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 210, 0, 276, 277,
-	278, 262, 0, 0, 0, 0, 154, 0, 0, 0,
-	179, 0, 181, 0, 0, 239, 194, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1651, 0, 0, 84, 0, 0, 0, 0,
-	0, 0, 137, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 128, 244,
-	258, 138, 235, 271, 142, 242, 134, 209, 231, 130,
-	256, 241, 191, 173, 174, 129, 0, 226, 152, 165,
-	149, 207, 0, 0, 148, 274, 0, 266, 132, 133,
-	265, 206, 253, 257, 192, 186, 131, 255, 190, 185,
-	177, 156, 169, 219, 184, 220, 170, 196, 195, 197,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 268, 0, 0, 0, 0, 0,
-	0, 243, 0, 0, 178, 0, 0, 0, 0, 0,
-	229, 212, 0, 0, 217, 227, 182, 254, 221, 259,
-	245, 267, 0, 222, 124, 246, 151, 193, 135, 136,
-	147, 153, 155, 157, 158, 202, 203, 215, 234, 247,
-	248, 249, 150, 143, 228, 144, 167, 145, 125, 236,
-	146, 126, 216, 252, 0, 164, 224, 189, 127, 188,
-	218, 251, 250, 275, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 0, 263, 0, 208, 0, 0,
-	0, 0, 0, 0, 0, 204, 279, 0, 0, 0,
-	0, 232, 0, 0, 0, 0, 0, 172, 214, 0,
-	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 240, 261, 273, 264, 0, 0, 0,
-	272, 0, 0, 0, 0, 0, 0, 198, 199, 200,
-	201, 0, 0, 141, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 160, 166, 0, 168, 140, 213,
-	163, 270, 175, 205, 171, 237, 176, 183, 225, 269,
-	211, 230, 139, 260, 238, 187, 162, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 180, 0, 223, 159, 87, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 210, 0, 276, 277, 278, 262,
-	0, 0, 0, 0, 154, 0, 0, 0, 179, 0,
-	181, 0, 0, 239, 194, 0, 0, 0, 0, 0,
+	0, 292, 293, 294, 295, 296, 0, 132, 0, 191,
+	0, 234, 170, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 126, 127, 128, 129, 130, 131, 125,
+	221, 0, 288, 289, 290, 274, 0, 0, 0, 0,
+	165, 0, 0, 0, 190, 0, 192, 0, 0, 250,
+	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 92,
+	0, 0, 0, 0, 0, 0, 148, 951, 0, 0,
+	0, 952, 0, 0, 0, 0, 0, 0, 0, 286,
+	215, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 953, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 255,
+	270, 149, 246, 283, 153, 253, 145, 220, 242, 134,
+	133, 141, 268, 252, 202, 184, 185, 140, 0, 237,
+	163, 176, 160, 218, 0, 0, 159, 0, 278, 143,
+	144, 277, 217, 265, 269, 203, 197, 142, 267, 201,
+	196, 188, 167, 180, 230, 195, 231, 181, 207, 206,
+	208, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 280, 0, 0, 0, 0,
+	0, 0, 254, 0, 0, 189, 0, 0, 0, 0,
+	0, 240, 223, 0, 0, 228, 238, 193, 266, 232,
+	271, 256, 257, 279, 0, 233, 135, 258, 162, 204,
+	146, 147, 158, 164, 166, 168, 169, 213, 214, 226,
+	245, 259, 260, 261, 161, 154, 239, 155, 178, 156,
+	136, 247, 157, 137, 227, 264, 0, 175, 235, 200,
+	138, 199, 229, 263, 262, 287, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 275, 0, 219,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 243, 0, 0, 0, 0, 0, 183, 225, 0,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 273, 285, 276, 0, 0, 0,
+	284, 0, 0, 0, 0, 0, 0, 209, 210, 211,
+	212, 0, 0, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 171, 177, 0, 179, 151, 224,
+	174, 282, 186, 216, 182, 248, 187, 194, 236, 281,
+	222, 241, 150, 272, 249, 198, 173, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 292, 293, 294, 295, 296, 0, 132,
+	0, 191, 0, 234, 170, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 126, 127, 128, 129, 130,
+	131, 125, 0, 0, 288, 289, 290, 274, 221, 0,
+	846, 0, 0, 0, 0, 0, 0, 0, 165, 0,
+	0, 0, 190, 0, 192, 0, 0, 250, 205, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 0, 0, 736, 0, 0, 0,
-	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 348, 0, 0,
+	349, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 286, 215, 291,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 128, 244, 258, 138,
-	235, 271, 142, 242, 134, 209, 231, 130, 256, 241,
-	191, 173, 174, 129, 0, 226, 152, 165, 149, 207,
-	0, 0, 148, 274, 0, 266, 132, 133, 265, 206,
-	253, 257, 192, 186, 131, 255, 190, 185, 177, 156,
-	169, 219, 184, 220, 170, 196, 195, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 255, 270, 149,
+	246, 283, 153, 253, 145, 220, 242, 134, 133, 141,
+	268, 252, 202, 184, 185, 140, 0, 237, 163, 176,
+	160, 218, 0, 0, 159, 0, 278, 143, 144, 277,
+	217, 265, 269, 203, 197, 142, 267, 201, 196, 188,
+	167, 180, 230, 195, 231, 181, 207, 206, 208, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 268, 0, 0, 0, 0, 0, 0, 243,
-	0, 0, 178, 0, 0, 0, 0, 0, 229, 212,
-	0, 0, 217, 227, 182, 254, 221, 259, 245, 267,
-	0, 222, 124, 246, 151, 193, 135, 136, 147, 153,
-	155, 157, 158, 202, 203, 215, 234, 247, 248, 249,
-	150, 143, 228, 144, 167, 145, 125, 236, 146, 126,
-	216, 252, 0, 164, 224, 189, 127, 188, 218, 251,
-	250, 275, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 0, 263, 0, 208, 0, 0, 0, 0,
-	0, 0, 0, 204, 279, 0, 0, 0, 0, 232,
-	0, 0, 0, 0, 0, 172, 214, 0, 233, 0,
+	0, 0, 0, 280, 0, 0, 0, 0, 0, 0,
+	254, 0, 0, 189, 0, 0, 0, 0, 0, 240,
+	223, 0, 0, 228, 238, 193, 266, 232, 271, 256,
+	257, 279, 0, 233, 135, 258, 162, 204, 146, 147,
+	158, 164, 166, 168, 169, 213, 214, 226, 245, 259,
+	260, 261, 161, 154, 239, 155, 178, 156, 136, 247,
+	157, 137, 227, 264, 0, 175, 235, 200, 138, 199,
+	229, 263, 262, 287, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 275, 0, 219, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 243,
+	0, 0, 0, 0, 0, 183, 225, 0, 244, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 240, 261, 273, 264, 0, 0, 0, 272, 0,
-	0, 0, 0, 0, 0, 198, 199, 200, 201, 0,
-	0, 141, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 160, 166, 0, 168, 140, 213, 163, 270,
-	175, 205, 171, 237, 176, 183, 225, 269, 211, 230,
-	139, 260, 238, 187, 162, 0, 0, 0, 0, 0,
+	0, 251, 273, 285, 276, 0, 0, 0, 284, 0,
+	0, 0, 0, 845, 0, 209, 210, 211, 212, 0,
+	0, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 177, 0, 179, 151, 224, 174, 282,
+	186, 216, 182, 248, 187, 194, 236, 281, 222, 241,
+	150, 272, 249, 198, 173, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 0, 180, 0, 223, 159, 87, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 210, 0, 276, 277, 278, 262, 0, 0,
-	0, 0, 154, 0, 0, 0, 179, 0, 181, 0,
-	0, 239, 194, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 0, 0, 0, 0, 0, 0, 137, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1471, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 128, 244, 258, 138, 235, 271,
-	142, 242, 134, 209, 231, 130, 256, 241, 191, 173,
-	174, 129, 0, 226, 152, 165, 149, 207, 0, 0,
-	148, 274, 0, 266, 132, 133, 265, 206, 253, 257,
-	192, 186, 131, 255, 190, 185, 177, 156, 169, 219,
-	184, 220, 170, 196, 195, 197, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	268, 0, 0, 0, 0, 0, 0, 243, 0, 0,
-	178, 0, 0, 0, 0, 0, 229, 212, 0, 0,
-	217, 227, 182, 254, 221, 259, 245, 267, 0, 222,
-	124, 246, 151, 193, 135, 136, 147, 153, 155, 157,
-	158, 202, 203, 215, 234, 247, 248, 249, 150, 143,
-	228, 144, 167, 145, 125, 236, 146, 126, 216, 252,
-	0, 164, 224, 189, 127, 188, 218, 251, 250, 275,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	0, 263, 0, 208, 0, 0, 0, 0, 0, 0,
-	0, 204, 279, 0, 0, 0, 0, 232, 0, 0,
-	0, 0, 0, 172, 214, 0, 233, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
-	261, 273, 264, 0, 0, 0, 272, 0, 0, 0,
-	0, 0, 0, 198, 199, 200, 201, 0, 0, 141,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	160, 166, 0, 168, 140, 213, 163, 270, 175, 205,
-	171, 237, 176, 183, 225, 269, 211, 230, 139, 260,
-	238, 187, 162, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 123, 0,
-	180, 0, 223, 159, 87, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	210, 0, 276, 277, 278, 262, 0, 0, 0, 0,
-	154, 0, 0, 0, 179, 0, 181, 0, 0, 239,
-	194, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 300, 0, 0, 84,
-	0, 0, 0, 0, 0, 0, 137, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 128, 244, 258, 138, 235, 271, 142, 242,
-	134, 209, 231, 130, 256, 241, 191, 173, 174, 129,
-	0, 226, 152, 165, 149, 207, 0, 0, 148, 274,
-	0, 266, 132, 133, 265, 206, 253, 257, 192, 186,
-	131, 255, 190, 185, 177, 156, 169, 219, 184, 220,
-	170, 196, 195, 197, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	0, 0, 0, 0, 0, 243, 0, 0, 178, 0,
-	0, 0, 0, 0, 229, 212, 0, 0, 217, 227,
-	182, 254, 221, 259, 245, 267, 0, 222, 124, 246,
-	151, 193, 135, 136, 147, 153, 155, 157, 158, 202,
-	203, 215, 234, 247, 248, 249, 150, 143, 228, 144,
-	167, 145, 125, 236, 146, 126, 216, 252, 0, 164,
-	224, 189, 127, 188, 218, 251, 250, 275, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 0, 263,
-	0, 208, 0, 0, 0, 0, 0, 0, 0, 204,
-	279, 0, 0, 0, 0, 232, 0, 0, 0, 0,
-	0, 172, 214, 0, 233, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 240, 261, 273,
-	264, 0, 0, 0, 272, 0, 0, 0, 0, 0,
-	0, 198, 199, 200, 201, 0, 0, 141, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 160, 166,
-	0, 168, 140, 213, 163, 270, 175, 205, 171, 237,
-	176, 183, 225, 269, 211, 230, 139, 260, 238, 187,
-	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 0, 180, 0,
-	223, 159, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 210, 0,
-	276, 277, 278, 262, 0, 0, 0, 0, 154, 0,
-	0, 0, 179, 0, 181, 0, 0, 239, 194, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 0, 0,
-	0, 0, 0, 0, 137, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1163, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	128, 244, 258, 138, 235, 271, 142, 242, 134, 209,
-	231, 130, 256, 241, 191, 173, 174, 129, 0, 226,
-	152, 165, 149, 207, 0, 0, 148, 274, 0, 266,
-	132, 133, 265, 206, 253, 257, 192, 186, 131, 255,
-	190, 185, 177, 156, 169, 219, 184, 220, 170, 196,
-	195, 197, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 268, 0, 0, 0,
-	0, 0, 0, 243, 0, 0, 178, 0, 0, 0,
-	0, 0, 229, 212, 0, 0, 217, 227, 182, 254,
-	221, 259, 245, 267, 0, 222, 124, 246, 151, 193,
-	135, 136, 147, 153, 155, 157, 158, 202, 203, 215,
-	234, 247, 248, 249, 150, 143, 228, 144, 167, 145,
-	125, 236, 146, 126, 216, 252, 0, 164, 224, 189,
-	127, 188, 218, 251, 250, 275, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 0, 263, 0, 208,
-	0, 0, 0, 0, 0, 0, 0, 204, 279, 0,
-	0, 0, 0, 232, 0, 0, 0, 0, 0, 172,
-	214, 0, 233, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 240, 261, 273, 264, 0,
-	0, 0, 272, 0, 0, 0, 0, 0, 0, 198,
-	199, 200, 201, 0, 0, 141, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 160, 166, 0, 168,
-	140, 213, 163, 270, 175, 205, 171, 237, 176, 183,
-	225, 269, 211, 230, 139, 260, 238, 187, 162, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 123, 0, 180, 0, 223, 159,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 210, 0, 276, 277,
-	278, 262, 0, 0, 0, 0, 154, 0, 0, 0,
-	179, 0, 181, 0, 0, 239, 194, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 331, 0, 0, 332, 0,
-	0, 0, 137, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 128, 244,
-	258, 138, 235, 271, 142, 242, 134, 209, 231, 130,
-	256, 241, 191, 173, 174, 129, 0, 226, 152, 165,
-	149, 207, 0, 0, 148, 274, 0, 266, 132, 133,
-	265, 206, 253, 257, 192, 186, 131, 255, 190, 185,
-	177, 156, 169, 219, 184, 220, 170, 196, 195, 197,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 268, 0, 0, 0, 0, 0,
-	0, 243, 0, 0, 178, 0, 0, 0, 0, 0,
-	229, 212, 0, 0, 217, 227, 182, 254, 221, 259,
-	245, 267, 0, 222, 124, 246, 151, 193, 135, 136,
-	147, 153, 155, 157, 158, 202, 203, 215, 234, 247,
-	248, 249, 150, 143, 228, 144, 167, 145, 125, 236,
-	146, 126, 216, 252, 0, 164, 224, 189, 127, 188,
-	218, 251, 250, 275, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 161, 0, 263, 0, 208, 0, 0,
-	0, 0, 0, 0, 0, 204, 279, 0, 0, 0,
-	0, 232, 0, 0, 0, 0, 0, 172, 214, 0,
-	233, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 240, 261, 273, 264, 0, 0, 0,
-	272, 0, 0, 0, 0, 0, 0, 198, 199, 200,
-	201, 0, 0, 141, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 160, 166, 0, 168, 140, 213,
-	163, 270, 175, 205, 171, 237, 176, 183, 225, 269,
-	211, 230, 139, 260, 238, 187, 162, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 123, 0, 180, 0, 223, 159, 87, 88,
-	89, 90, 91, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 101, 102, 103, 104, 105, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 116, 117, 118,
-	119, 120, 121, 122, 210, 0, 276, 277, 278, 262,
-	0, 0, 0, 0, 154, 0, 0, 0, 179, 0,
-	181, 0, 0, 239, 194, 0, 0, 0, 0, 0,
+	0, 292, 293, 294, 295, 296, 0, 132, 0, 191,
+	0, 234, 170, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 126, 127, 128, 129, 130, 131, 125,
+	221, 0, 288, 289, 290, 274, 0, 0, 0, 0,
+	165, 0, 0, 0, 190, 0, 192, 0, 0, 250,
+	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2142, 92,
+	692, 0, 0, 0, 0, 0, 148, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 286,
+	215, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 255,
+	270, 149, 246, 283, 153, 253, 145, 220, 242, 134,
+	133, 141, 268, 252, 202, 184, 185, 140, 0, 237,
+	163, 176, 160, 218, 0, 0, 159, 0, 278, 143,
+	144, 277, 217, 265, 269, 203, 197, 142, 267, 201,
+	196, 188, 167, 180, 230, 195, 231, 181, 207, 206,
+	208, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 280, 0, 0, 0, 0,
+	0, 0, 254, 0, 0, 189, 0, 0, 0, 0,
+	0, 240, 223, 0, 0, 228, 238, 193, 266, 232,
+	271, 256, 257, 279, 0, 233, 135, 258, 162, 204,
+	146, 147, 158, 164, 166, 168, 169, 213, 214, 226,
+	245, 259, 260, 261, 161, 154, 239, 155, 178, 156,
+	136, 247, 157, 137, 227, 264, 0, 175, 235, 200,
+	138, 199, 229, 263, 262, 287, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 275, 0, 219,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 243, 0, 0, 0, 0, 0, 183, 225, 0,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 273, 285, 276, 0, 0, 0,
+	284, 0, 0, 0, 0, 0, 0, 209, 210, 211,
+	212, 0, 0, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 171, 177, 0, 179, 151, 224,
+	174, 282, 186, 216, 182, 248, 187, 194, 236, 281,
+	222, 241, 150, 272, 249, 198, 173, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 292, 293, 294, 295, 296, 0, 132,
+	0, 191, 0, 234, 170, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 126, 127, 128, 129, 130,
+	131, 125, 221, 0, 288, 289, 290, 274, 0, 0,
+	0, 0, 165, 0, 0, 0, 190, 0, 192, 0,
+	0, 250, 205, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 92, 0, 0, 780, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 286, 215, 291, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	139, 255, 270, 149, 246, 283, 153, 253, 145, 220,
+	242, 134, 133, 141, 268, 252, 202, 184, 185, 140,
+	0, 237, 163, 176, 160, 218, 0, 0, 159, 0,
+	278, 143, 144, 277, 217, 265, 269, 203, 197, 142,
+	267, 201, 196, 188, 167, 180, 230, 195, 231, 181,
+	207, 206, 208, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 280, 0, 0,
+	0, 0, 0, 0, 254, 0, 0, 189, 0, 0,
+	0, 0, 0, 240, 223, 0, 0, 228, 238, 193,
+	266, 232, 271, 256, 257, 279, 0, 233, 135, 258,
+	162, 204, 146, 147, 158, 164, 166, 168, 169, 213,
+	214, 226, 245, 259, 260, 261, 161, 154, 239, 155,
+	178, 156, 136, 247, 157, 137, 227, 264, 0, 175,
+	235, 200, 138, 199, 229, 263, 262, 287, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 275,
+	0, 219, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 243, 0, 0, 0, 0, 0, 183,
+	225, 0, 244, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 251, 273, 285, 276, 0,
+	0, 0, 284, 0, 0, 0, 0, 0, 1562, 209,
+	210, 211, 212, 0, 0, 152, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 171, 177, 0, 179,
+	151, 224, 174, 282, 186, 216, 182, 248, 187, 194,
+	236, 281, 222, 241, 150, 272, 249, 198, 173, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 292, 293, 294, 295, 296,
+	0, 132, 0, 191, 0, 234, 170, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 126, 127, 128,
+	129, 130, 131, 125, 221, 0, 288, 289, 290, 274,
+	0, 0, 0, 0, 165, 1228, 0, 0, 190, 0,
+	192, 0, 0, 250, 205, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 92, 0, 0, 780, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 215, 291, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 139, 255, 270, 149, 246, 283, 153, 253,
+	145, 220, 242, 134, 133, 141, 268, 252, 202, 184,
+	185, 140, 0, 237, 163, 176, 160, 218, 0, 0,
+	159, 0, 278, 143, 144, 277, 217, 265, 269, 203,
+	197, 142, 267, 201, 196, 188, 167, 180, 230, 195,
+	231, 181, 207, 206, 208, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 280,
+	0, 0, 0, 0, 0, 0, 254, 0, 0, 189,
+	0, 0, 0, 0, 0, 240, 223, 0, 0, 228,
+	238, 193, 266, 232, 271, 256, 257, 279, 0, 233,
+	135, 258, 162, 204, 146, 147, 158, 164, 166, 168,
+	169, 213, 214, 226, 245, 259, 260, 261, 161, 154,
+	239, 155, 178, 156, 136, 247, 157, 137, 227, 264,
+	0, 175, 235, 200, 138, 199, 229, 263, 262, 287,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 275, 0, 219, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 243, 0, 0, 0, 0,
+	0, 183, 225, 0, 244, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 251, 273, 285,
+	276, 0, 0, 0, 284, 0, 0, 0, 0, 0,
+	0, 209, 210, 211, 212, 0, 0, 152, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 171, 177,
+	0, 179, 151, 224, 174, 282, 186, 216, 182, 248,
+	187, 194, 236, 281, 222, 241, 150, 272, 249, 198,
+	173, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 293, 294,
+	295, 296, 0, 132, 0, 191, 0, 234, 170, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 126,
+	127, 128, 129, 130, 131, 125, 221, 0, 288, 289,
+	290, 274, 0, 0, 0, 0, 165, 0, 0, 0,
+	190, 0, 192, 0, 0, 250, 205, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 92, 692, 0, 0, 0,
+	0, 0, 148, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 286, 215, 291, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 139, 255, 270, 149, 246, 283,
+	153, 253, 145, 220, 242, 134, 133, 141, 268, 252,
+	202, 184, 185, 140, 0, 237, 163, 176, 160, 218,
+	0, 0, 159, 0, 278, 143, 144, 277, 217, 265,
+	269, 203, 197, 142, 267, 201, 196, 188, 167, 180,
+	230, 195, 231, 181, 207, 206, 208, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 280, 0, 0, 0, 0, 0, 0, 254, 0,
+	0, 189, 0, 0, 0, 0, 0, 240, 223, 0,
+	0, 228, 238, 193, 266, 232, 271, 256, 257, 279,
+	0, 233, 135, 258, 162, 204, 146, 147, 158, 164,
+	166, 168, 169, 213, 214, 226, 245, 259, 260, 261,
+	161, 154, 239, 155, 178, 156, 136, 247, 157, 137,
+	227, 264, 0, 175, 235, 200, 138, 199, 229, 263,
+	262, 287, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 275, 0, 219, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 243, 0, 0,
+	0, 0, 0, 183, 225, 0, 244, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 251,
+	273, 285, 276, 0, 0, 0, 284, 0, 0, 0,
+	0, 0, 0, 209, 210, 211, 212, 0, 0, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 177, 0, 179, 151, 224, 174, 282, 186, 216,
+	182, 248, 187, 194, 236, 281, 222, 241, 150, 272,
+	249, 198, 173, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 292,
+	293, 294, 295, 296, 0, 132, 0, 191, 0, 234,
+	170, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 126, 127, 128, 129, 130, 131, 125, 221, 0,
+	288, 289, 290, 274, 0, 0, 0, 0, 165, 0,
+	0, 0, 190, 0, 192, 0, 0, 250, 205, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 84, 0, 0, 736, 0, 0, 0,
-	137, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1837, 0, 0, 92, 0, 0,
+	0, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 286, 215, 291,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 128, 244, 258, 138,
-	235, 271, 142, 242, 134, 209, 231, 130, 256, 241,
-	191, 173, 174, 129, 0, 226, 152, 165, 149, 207,
-	0, 0, 148, 274, 0, 266, 132, 133, 265, 206,
-	253, 257, 192, 186, 131, 255, 190, 185, 177, 156,
-	169, 219, 184, 220, 170, 196, 195, 197, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 255, 270, 149,
+	246, 283, 153, 253, 145, 220, 242, 134, 133, 141,
+	268, 252, 202, 184, 185, 140, 0, 237, 163, 176,
+	160, 218, 0, 0, 159, 0, 278, 143, 144, 277,
+	217, 265, 269, 203, 197, 142, 267, 201, 196, 188,
+	167, 180, 230, 195, 231, 181, 207, 206, 208, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 268, 0, 0, 0, 0, 0, 0, 243,
-	0, 0, 178, 0, 0, 0, 0, 0, 229, 212,
-	0, 0, 217, 227, 182, 254, 221, 259, 245, 267,
-	0, 222, 124, 246, 151, 193, 135, 136, 147, 153,
-	155, 157, 158, 202, 203, 215, 234, 247, 248, 249,
-	150, 143, 228, 144, 167, 145, 125, 236, 146, 126,
-	216, 252, 0, 164, 224, 189, 127, 188, 218, 251,
-	250, 275, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 161, 0, 263, 0, 208, 0, 0, 0, 0,
-	0, 0, 0, 204, 279, 0, 0, 0, 0, 232,
-	0, 0, 0, 0, 0, 172, 214, 0, 233, 0,
+	0, 0, 0, 280, 0, 0, 0, 0, 0, 0,
+	254, 0, 0, 189, 0, 0, 0, 0, 0, 240,
+	223, 0, 0, 228, 238, 193, 266, 232, 271, 256,
+	257, 279, 0, 233, 135, 258, 162, 204, 146, 147,
+	158, 164, 166, 168, 169, 213, 214, 226, 245, 259,
+	260, 261, 161, 154, 239, 155, 178, 156, 136, 247,
+	157, 137, 227, 264, 0, 175, 235, 200, 138, 199,
+	229, 263, 262, 287, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 275, 0, 219, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 243,
+	0, 0, 0, 0, 0, 183, 225, 0, 244, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 240, 261, 273, 774, 0, 0, 0, 272, 0,
-	0, 0, 0, 0, 0, 198, 199, 200, 201, 0,
-	0, 141, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 160, 166, 0, 168, 140, 213, 163, 270,
-	175, 205, 171, 237, 176, 183, 225, 269, 211, 230,
-	139, 260, 238, 187, 162, 0, 0, 0, 0, 0,
+	0, 251, 273, 285, 276, 0, 0, 0, 284, 0,
+	0, 0, 0, 0, 0, 209, 210, 211, 212, 0,
+	0, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 177, 0, 179, 151, 224, 174, 282,
+	186, 216, 182, 248, 187, 194, 236, 281, 222, 241,
+	150, 272, 249, 198, 173, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	123, 0, 180, 0, 223, 159, 87, 88, 89, 90,
-	91, 92, 93, 94, 95, 96, 97, 98, 99, 100,
-	101, 102, 103, 104, 105, 106, 107, 108, 109, 110,
-	111, 112, 113, 114, 115, 116, 117, 118, 119, 120,
-	121, 122, 210, 0, 276, 277, 278, 262, 0, 0,
-	0, 0, 154, 0, 0, 0, 179, 0, 181, 0,
-	0, 239, 194, 0, 0, 0, 0, 0, 0, 0,
+	0, 292, 293, 294, 295, 296, 0, 132, 0, 191,
+	0, 234, 170, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 126, 127, 128, 129, 130, 131, 125,
+	221, 0, 288, 289, 290, 274, 0, 0, 0, 0,
+	165, 0, 0, 0, 190, 0, 192, 0, 0, 250,
+	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 92,
+	0, 0, 780, 0, 0, 0, 148, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 286,
+	215, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 255,
+	270, 149, 246, 283, 153, 253, 145, 220, 242, 134,
+	133, 141, 268, 252, 202, 184, 185, 140, 0, 237,
+	163, 176, 160, 218, 0, 0, 159, 0, 278, 143,
+	144, 277, 217, 265, 269, 203, 197, 142, 267, 201,
+	196, 188, 167, 180, 230, 195, 231, 181, 207, 206,
+	208, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 280, 0, 0, 0, 0,
+	0, 0, 254, 0, 0, 189, 0, 0, 0, 0,
+	0, 240, 223, 0, 0, 228, 238, 193, 266, 232,
+	271, 256, 257, 279, 0, 233, 135, 258, 162, 204,
+	146, 147, 158, 164, 166, 168, 169, 213, 214, 226,
+	245, 259, 260, 261, 161, 154, 239, 155, 178, 156,
+	136, 247, 157, 137, 227, 264, 0, 175, 235, 200,
+	138, 199, 229, 263, 262, 287, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 275, 0, 219,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 243, 0, 0, 0, 0, 0, 183, 225, 0,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 273, 285, 276, 0, 0, 0,
+	284, 0, 0, 0, 0, 0, 0, 209, 210, 211,
+	212, 0, 0, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 171, 177, 0, 179, 151, 224,
+	174, 282, 186, 216, 182, 248, 187, 194, 236, 281,
+	222, 241, 150, 272, 249, 198, 173, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 292, 293, 294, 295, 296, 0, 132,
+	0, 191, 0, 234, 170, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 126, 127, 128, 129, 130,
+	131, 125, 221, 0, 288, 289, 290, 274, 0, 0,
+	0, 0, 165, 0, 0, 0, 190, 0, 192, 0,
+	0, 250, 205, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 92, 0, 0, 0, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 286, 215, 291, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1668, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	139, 255, 270, 149, 246, 283, 153, 253, 145, 220,
+	242, 134, 133, 141, 268, 252, 202, 184, 185, 140,
+	0, 237, 163, 176, 160, 218, 0, 0, 159, 0,
+	278, 143, 144, 277, 217, 265, 269, 203, 197, 142,
+	267, 201, 196, 188, 167, 180, 230, 195, 231, 181,
+	207, 206, 208, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 280, 0, 0,
+	0, 0, 0, 0, 254, 0, 0, 189, 0, 0,
+	0, 0, 0, 240, 223, 0, 0, 228, 238, 193,
+	266, 232, 271, 256, 257, 279, 0, 233, 135, 258,
+	162, 204, 146, 147, 158, 164, 166, 168, 169, 213,
+	214, 226, 245, 259, 260, 261, 161, 154, 239, 155,
+	178, 156, 136, 247, 157, 137, 227, 264, 0, 175,
+	235, 200, 138, 199, 229, 263, 262, 287, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 275,
+	0, 219, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 243, 0, 0, 0, 0, 0, 183,
+	225, 0, 244, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 251, 273, 285, 276, 0,
+	0, 0, 284, 0, 0, 0, 0, 0, 0, 209,
+	210, 211, 212, 0, 0, 152, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 171, 177, 0, 179,
+	151, 224, 174, 282, 186, 216, 182, 248, 187, 194,
+	236, 281, 222, 241, 150, 272, 249, 198, 173, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 292, 293, 294, 295, 296,
+	0, 132, 0, 191, 0, 234, 170, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 126, 127, 128,
+	129, 130, 131, 125, 221, 0, 288, 289, 290, 274,
+	0, 0, 0, 0, 165, 0, 0, 0, 190, 0,
+	192, 0, 0, 250, 205, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	317, 0, 0, 92, 0, 0, 0, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 215, 291, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 139, 255, 270, 149, 246, 283, 153, 253,
+	145, 220, 242, 134, 133, 141, 268, 252, 202, 184,
+	185, 140, 0, 237, 163, 176, 160, 218, 0, 0,
+	159, 0, 278, 143, 144, 277, 217, 265, 269, 203,
+	197, 142, 267, 201, 196, 188, 167, 180, 230, 195,
+	231, 181, 207, 206, 208, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 280,
+	0, 0, 0, 0, 0, 0, 254, 0, 0, 189,
+	0, 0, 0, 0, 0, 240, 223, 0, 0, 228,
+	238, 193, 266, 232, 271, 256, 257, 279, 0, 233,
+	135, 258, 162, 204, 146, 147, 158, 164, 166, 168,
+	169, 213, 214, 226, 245, 259, 260, 261, 161, 154,
+	239, 155, 178, 156, 136, 247, 157, 137, 227, 264,
+	0, 175, 235, 200, 138, 199, 229, 263, 262, 287,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 275, 0, 219, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 243, 0, 0, 0, 0,
+	0, 183, 225, 0, 244, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 251, 273, 285,
+	276, 0, 0, 0, 284, 0, 0, 0, 0, 0,
+	0, 209, 210, 211, 212, 0, 0, 152, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 171, 177,
+	0, 179, 151, 224, 174, 282, 186, 216, 182, 248,
+	187, 194, 236, 281, 222, 241, 150, 272, 249, 198,
+	173, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 293, 294,
+	295, 296, 0, 132, 0, 191, 0, 234, 170, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 126,
+	127, 128, 129, 130, 131, 125, 221, 0, 288, 289,
+	290, 274, 0, 0, 0, 0, 165, 0, 0, 0,
+	190, 0, 192, 0, 0, 250, 205, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 92, 0, 0, 0, 0,
+	0, 0, 148, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 286, 215, 291, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1244, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 139, 255, 270, 149, 246, 283,
+	153, 253, 145, 220, 242, 134, 133, 141, 268, 252,
+	202, 184, 185, 140, 0, 237, 163, 176, 160, 218,
+	0, 0, 159, 0, 278, 143, 144, 277, 217, 265,
+	269, 203, 197, 142, 267, 201, 196, 188, 167, 180,
+	230, 195, 231, 181, 207, 206, 208, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 280, 0, 0, 0, 0, 0, 0, 254, 0,
+	0, 189, 0, 0, 0, 0, 0, 240, 223, 0,
+	0, 228, 238, 193, 266, 232, 271, 256, 257, 279,
+	0, 233, 135, 258, 162, 204, 146, 147, 158, 164,
+	166, 168, 169, 213, 214, 226, 245, 259, 260, 261,
+	161, 154, 239, 155, 178, 156, 136, 247, 157, 137,
+	227, 264, 0, 175, 235, 200, 138, 199, 229, 263,
+	262, 287, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 275, 0, 219, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 243, 0, 0,
+	0, 0, 0, 183, 225, 0, 244, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 251,
+	273, 285, 276, 0, 0, 0, 284, 0, 0, 0,
+	0, 0, 0, 209, 210, 211, 212, 0, 0, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 177, 0, 179, 151, 224, 174, 282, 186, 216,
+	182, 248, 187, 194, 236, 281, 222, 241, 150, 272,
+	249, 198, 173, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 292,
+	293, 294, 295, 296, 0, 132, 0, 191, 0, 234,
+	170, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 126, 127, 128, 129, 130, 131, 125, 221, 0,
+	288, 289, 290, 274, 0, 0, 0, 0, 165, 0,
+	0, 0, 190, 0, 192, 0, 0, 250, 205, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 84, 0, 0, 0, 0, 0, 0, 137, 0,
+	0, 0, 0, 0, 0, 0, 0, 348, 0, 0,
+	349, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 286, 215, 291,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 255, 270, 149,
+	246, 283, 153, 253, 145, 220, 242, 134, 133, 141,
+	268, 252, 202, 184, 185, 140, 0, 237, 163, 176,
+	160, 218, 0, 0, 159, 0, 278, 143, 144, 277,
+	217, 265, 269, 203, 197, 142, 267, 201, 196, 188,
+	167, 180, 230, 195, 231, 181, 207, 206, 208, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 128, 244, 258, 138, 235, 271,
-	142, 242, 134, 209, 231, 130, 256, 241, 191, 173,
-	174, 129, 0, 226, 152, 165, 149, 207, 0, 0,
-	148, 274, 0, 266, 132, 133, 265, 206, 253, 257,
-	192, 186, 131, 255, 190, 185, 177, 156, 169, 219,
-	184, 220, 170, 196, 195, 197, 0, 0, 0, 0,
+	0, 0, 0, 280, 0, 0, 0, 0, 0, 0,
+	254, 0, 0, 189, 0, 0, 0, 0, 0, 240,
+	223, 0, 0, 228, 238, 193, 266, 232, 271, 256,
+	257, 279, 0, 233, 135, 258, 162, 204, 146, 147,
+	158, 164, 166, 168, 169, 213, 214, 226, 245, 259,
+	260, 261, 161, 154, 239, 155, 178, 156, 136, 247,
+	157, 137, 227, 264, 0, 175, 235, 200, 138, 199,
+	229, 263, 262, 287, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 275, 0, 219, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 243,
+	0, 0, 0, 0, 0, 183, 225, 0, 244, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	268, 0, 0, 0, 0, 0, 0, 243, 0, 0,
-	178, 0, 0, 0, 0, 0, 229, 212, 0, 0,
-	217, 227, 182, 254, 221, 259, 245, 267, 0, 222,
-	124, 246, 151, 193, 135, 136, 147, 153, 155, 157,
-	158, 202, 203, 215, 234, 247, 248, 249, 150, 143,
-	228, 144, 167, 145, 125, 236, 146, 126, 216, 252,
-	0, 164, 224, 189, 127, 188, 218, 251, 250, 275,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 161,
-	0, 263, 0, 208, 0, 0, 0, 0, 0, 0,
-	0, 204, 279, 0, 0, 0, 0, 232, 0, 0,
-	0, 0, 0, 172, 214, 0, 233, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 240,
-	261, 273, 264, 0, 0, 0, 272, 0, 0, 0,
-	0, 0, 0, 198, 199, 200, 201, 0, 0, 141,
+	0, 251, 273, 285, 276, 0, 0, 0, 284, 0,
+	0, 0, 0, 0, 0, 209, 210, 211, 212, 0,
+	0, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 177, 0, 179, 151, 224, 174, 282,
+	186, 216, 182, 248, 187, 194, 236, 281, 222, 241,
+	150, 272, 249, 198, 173, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	160, 166, 0, 168, 140, 213, 163, 270, 175, 205,
-	171, 237, 176, 183, 225, 269, 211, 230, 139, 260,
-	238, 187, 162, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 408, 0, 123, 0,
-	180, 0, 223, 159, 87, 88, 89, 90, 91, 92,
-	93, 94, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 104, 105, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 116, 117, 118, 119, 120, 121, 122,
-	210, 0, 276, 277, 278, 262, 0, 0, 0, 81,
-	154, 0, 0, 0, 179, 0, 181, 0, 0, 239,
-	194, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	0, 0, 0, 0, 0, 0, 137, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 128, 244, 258, 138, 235, 271, 142, 242,
-	134, 209, 231, 130, 256, 241, 191, 173, 174, 129,
-	0, 226, 152, 165, 149, 207, 0, 0, 148, 274,
-	0, 266, 132, 133, 265, 206, 253, 257, 192, 186,
-	131, 255, 190, 185, 177, 156, 169, 219, 184, 220,
-	170, 196, 195, 197, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	0, 0, 0, 0, 0, 243, 0, 0, 178, 0,
-	0, 0, 0, 0, 229, 212, 0, 0, 217, 227,
-	182, 254, 221, 259, 245, 267, 0, 222, 124, 246,
-	151, 193, 135, 136, 147, 153, 155, 157, 158, 202,
-	203, 215, 234, 247, 248, 249, 150, 143, 228, 144,
-	167, 145, 125, 236, 146, 126, 216, 252, 0, 164,
-	224, 189, 127, 188, 218, 251, 250, 275, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 0, 263,
-	0, 208, 0, 0, 0, 0, 0, 0, 0, 204,
-	279, 0, 0, 0, 0, 232, 0, 0, 0, 0,
-	0, 172, 214, 0, 233, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 240, 261, 273,
-	264, 0, 0, 0, 272, 0, 0, 0, 0, 0,
-	0, 198, 199, 200, 201, 0, 0, 141, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 160, 166,
-	0, 168, 140, 213, 163, 270, 175, 205, 171, 237,
-	176, 183, 225, 269, 211, 230, 139, 260, 238, 187,
-	162, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 0, 180, 0,
-	223, 159, 87, 88, 89, 90, 91, 92, 93, 94,
-	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 116, 117, 118, 119, 120, 121, 122, 210, 0,
-	276, 277, 278, 262, 0, 0, 0, 0, 154, 0,
-	0, 0, 179, 0, 181, 0, 0, 239, 194, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 84, 0, 0,
-	0, 0, 0, 0, 137, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	128, 244, 258, 138, 235, 271, 142, 242, 134, 209,
-	231, 130, 256, 241, 191, 173, 174, 129, 0, 226,
-	152, 165, 149, 207, 0, 0, 148, 274, 0, 266,
-	132, 133, 265, 206, 253, 257, 192, 186, 131, 255,
-	190, 185, 177, 156, 169, 219, 184, 220, 170, 196,
-	195, 197, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 268, 0, 0, 0,
-	0, 0, 0, 243, 0, 0, 178, 0, 0, 0,
-	0, 0, 229, 212, 0, 0, 217, 227, 182, 254,
-	221, 259, 245, 267, 0, 222, 124, 246, 151, 193,
-	135, 136, 147, 153, 155, 157, 158, 202, 203, 215,
-	234, 247, 248, 249, 150, 143, 228, 144, 167, 145,
-	125, 236, 146, 126, 216, 252, 0, 164, 224, 189,
-	127, 188, 218, 251, 250, 275, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 161, 0, 263, 0, 208,
-	0, 0, 0, 0, 0, 0, 0, 204, 279, 0,
-	0, 0, 0, 232, 0, 0, 0, 0, 0, 172,
-	214, 0, 233, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 240, 261, 273, 264, 0,
-	0, 0, 272, 0, 0, 0, 0, 0, 0, 198,
-	199, 200, 201, 0, 0, 141, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 160, 166, 0, 168,
-	140, 213, 163, 270, 175, 205, 171, 237, 176, 183,
-	225, 269, 211, 230, 139, 260, 238, 187, 162, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 123, 0, 180, 0, 223, 159,
-	87, 88, 89, 90, 91, 92, 93, 94, 95, 96,
-	97, 98, 99, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 116,
-	117, 118, 119, 120, 121, 122, 0, 210, 276, 277,
-	278, 262, 454, 0, 0, 0, 0, 154, 0, 0,
-	0, 179, 0, 181, 0, 0, 239, 194, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 459, 460, 461, 456,
-	0, 0, 0, 137, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 128,
-	244, 258, 138, 235, 271, 142, 242, 134, 209, 231,
-	130, 256, 241, 191, 173, 174, 129, 0, 226, 152,
-	165, 149, 207, 0, 0, 148, 274, 0, 266, 132,
-	133, 265, 206, 253, 257, 192, 186, 131, 255, 190,
-	185, 177, 156, 169, 219, 184, 220, 170, 196, 195,
-	197, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 268, 0, 0, 0, 0,
-	0, 0, 243, 0, 0, 178, 0, 0, 0, 0,
-	0, 229, 212, 0, 0, 217, 227, 182, 254, 221,
-	259, 245, 267, 0, 222, 124, 246, 151, 193, 135,
-	136, 147, 153, 155, 157, 158, 202, 203, 215, 234,
-	247, 248, 249, 150, 143, 228, 144, 167, 145, 125,
-	236, 146, 126, 216, 252, 0, 164, 224, 189, 127,
-	188, 218, 251, 250, 275, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 161, 0, 263, 0, 208, 0,
-	0, 0, 0, 0, 0, 0, 204, 279, 0, 0,
-	0, 0, 232, 0, 0, 0, 0, 0, 172, 214,
-	0, 233, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 240, 261, 273, 264, 0, 0,
-	0, 272, 0, 0, 0, 0, 0, 0, 198, 199,
-	200, 201, 0, 0, 141, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 160, 166, 0, 168, 140,
-	213, 163, 270, 175, 205, 171, 237, 176, 183, 225,
-	269, 211, 230, 139, 260, 238, 187, 162, 0, 0,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	154, 0, 0, 0, 179, 0, 181, 0, 0, 239,
-	194, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 123, 0, 180, 0, 223, 159, 459,
-	460, 461, 456, 0, 0, 0, 137, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 276, 277, 278,
-	262, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 128, 244, 258, 138, 235, 271, 142, 242,
-	134, 209, 231, 130, 256, 241, 191, 173, 174, 129,
-	0, 226, 152, 165, 149, 207, 0, 0, 148, 274,
-	0, 266, 132, 133, 265, 206, 253, 257, 192, 186,
-	131, 255, 190, 185, 177, 156, 169, 219, 184, 220,
-	170, 196, 195, 197, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 268, 0,
-	0, 0, 0, 0, 0, 243, 0, 0, 178, 0,
-	0, 0, 0, 0, 229, 212, 0, 0, 217, 227,
-	182, 254, 221, 259, 245, 267, 0, 222, 124, 246,
-	151, 193, 135, 136, 147, 153, 155, 157, 158, 202,
-	203, 215, 234, 247, 248, 249, 150, 143, 228, 144,
-	167, 145, 125, 236, 146, 126, 216, 252, 0, 164,
-	224, 189, 127, 188, 218, 251, 250, 275, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 161, 0, 263,
-	0, 208, 0, 0, 0, 0, 0, 0, 0, 204,
-	279, 0, 0, 0, 0, 232, 0, 0, 0, 0,
-	0, 172, 214, 0, 233, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 240, 261, 273,
-	264, 0, 0, 0, 272, 0, 0, 0, 0, 0,
-	0, 198, 199, 200, 201, 0, 0, 141, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 160, 166,
-	0, 168, 140, 213, 163, 270, 175, 205, 171, 237,
-	176, 183, 225, 269, 211, 230, 139, 260, 238, 187,
-	162, 0, 0, 210, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 154, 0, 0, 0, 179, 0, 181,
-	0, 0, 239, 194, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 123, 0, 180, 0,
-	223, 159, 459, 460, 461, 0, 0, 0, 0, 137,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	276, 277, 278, 262, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 128, 244, 258, 138, 235,
-	271, 142, 242, 134, 209, 231, 130, 256, 241, 191,
-	173, 174, 129, 0, 226, 152, 165, 149, 207, 0,
-	0, 148, 274, 0, 266, 132, 133, 265, 206, 253,
-	257, 192, 186, 131, 255, 190, 185, 177, 156, 169,
-	219, 184, 220, 170, 196, 195, 197, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 268, 0, 0, 0, 0, 0, 0, 243, 0,
-	0, 178, 0, 0, 0, 0, 0, 229, 212, 0,
-	0, 217, 227, 182, 254, 221, 259, 245, 267, 0,
-	222, 124, 246, 151, 193, 135, 136, 147, 153, 155,
-	157, 158, 202, 203, 215, 234, 247, 248, 249, 150,
-	143, 228, 144, 167, 145, 125, 236, 146, 126, 216,
-	252, 0, 164, 224, 189, 127, 188, 218, 251, 250,
-	275, 0, 0, 0, 0, 0, 0, 0, 0, 1677,
-	161, 0, 263, 0, 208, 0, 0, 0, 0, 0,
-	0, 0, 204, 279, 0, 0, 0, 0, 232, 0,
-	0, 0, 0, 1118, 172, 214, 0, 233, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	240, 261, 273, 264, 0, 0, 0, 272, 2071, 1677,
-	0, 0, 0, 0, 198, 199, 200, 201, 1659, 0,
-	141, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 160, 166, 1118, 168, 140, 213, 163, 270, 175,
-	205, 171, 237, 176, 183, 225, 269, 211, 230, 139,
-	260, 238, 187, 162, 0, 0, 0, 0, 0, 1745,
-	0, 0, 0, 0, 0, 0, 0, 0, 1659, 0,
-	0, 0, 1677, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 123,
-	0, 180, 0, 223, 159, 0, 1118, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1659, 0, 276, 277, 278, 262, 0, 0, 1663,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1667, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1656, 0, 0, 0, 1658, 1660, 1662, 0, 1664, 1665,
-	1666, 1668, 1669, 1670, 1672, 1673, 1674, 1675, 0, 1663,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1667, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1678, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1656, 0, 0, 0, 1658, 1660, 1662, 0, 1664, 1665,
-	1666, 1668, 1669, 1670, 1672, 1673, 1674, 1675, 0, 0,
-	1676, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1663, 0, 0, 0, 0, 1655, 0, 0,
-	1678, 0, 0, 1667, 0, 0, 0, 0, 0, 0,
-	0, 0, 1671, 0, 0, 0, 0, 0, 0, 1661,
-	0, 0, 0, 1656, 0, 0, 0, 1658, 1660, 1662,
-	1676, 1664, 1665, 1666, 1668, 1669, 1670, 1672, 1673, 1674,
-	1675, 0, 0, 0, 0, 0, 0, 1655, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1671, 1678, 0, 0, 0, 0, 0, 1661,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1676, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1655, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1671, 0, 0, 0, 0,
-	0, 0, 1661,
+	0, 292, 293, 294, 295, 296, 0, 132, 0, 191,
+	0, 234, 170, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 126, 127, 128, 129, 130, 131, 125,
+	221, 0, 288, 289, 290, 274, 0, 0, 0, 0,
+	165, 0, 0, 0, 190, 0, 192, 0, 0, 250,
+	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 92,
+	0, 0, 0, 0, 0, 0, 148, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 286,
+	215, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 255,
+	270, 149, 246, 283, 153, 253, 145, 220, 242, 134,
+	133, 141, 268, 252, 202, 184, 185, 140, 0, 237,
+	163, 176, 160, 218, 0, 0, 159, 0, 278, 143,
+	144, 277, 217, 265, 269, 203, 197, 142, 267, 201,
+	196, 188, 167, 180, 230, 195, 231, 181, 207, 206,
+	208, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 280, 0, 0, 0, 0,
+	1213, 0, 254, 0, 0, 189, 0, 0, 0, 0,
+	0, 240, 223, 0, 0, 228, 238, 193, 266, 232,
+	271, 256, 257, 279, 0, 233, 135, 258, 162, 204,
+	146, 147, 158, 164, 166, 168, 169, 213, 214, 226,
+	245, 259, 260, 261, 161, 154, 239, 155, 178, 156,
+	136, 247, 157, 137, 227, 264, 0, 175, 235, 200,
+	138, 199, 229, 263, 262, 287, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 275, 0, 219,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 243, 0, 0, 0, 0, 0, 183, 225, 0,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 273, 285, 276, 0, 0, 0,
+	284, 0, 0, 0, 0, 0, 0, 209, 210, 211,
+	212, 0, 0, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 171, 177, 0, 179, 151, 224,
+	174, 282, 186, 216, 182, 248, 187, 194, 236, 281,
+	222, 241, 150, 272, 249, 198, 173, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 292, 293, 294, 295, 296, 0, 132,
+	0, 191, 0, 234, 170, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 126, 127, 128, 129, 130,
+	131, 125, 221, 0, 288, 289, 290, 274, 0, 0,
+	0, 0, 165, 0, 0, 0, 190, 0, 192, 0,
+	0, 250, 205, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 92, 0, 0, 0, 0, 0, 0, 148, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 286, 215, 291, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	139, 255, 270, 149, 246, 283, 153, 253, 145, 220,
+	242, 134, 133, 141, 268, 252, 202, 184, 185, 140,
+	0, 237, 163, 176, 160, 218, 0, 0, 159, 0,
+	278, 143, 144, 277, 217, 265, 269, 203, 197, 142,
+	267, 201, 196, 188, 167, 180, 230, 195, 231, 181,
+	207, 206, 208, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 280, 0, 0,
+	0, 0, 1210, 0, 254, 0, 0, 189, 0, 0,
+	0, 0, 0, 240, 223, 0, 0, 228, 238, 193,
+	266, 232, 271, 256, 257, 279, 0, 233, 135, 258,
+	162, 204, 146, 147, 158, 164, 166, 168, 169, 213,
+	214, 226, 245, 259, 260, 261, 161, 154, 239, 155,
+	178, 156, 136, 247, 157, 137, 227, 264, 0, 175,
+	235, 200, 138, 199, 229, 263, 262, 287, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 172, 0, 275,
+	0, 219, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 243, 0, 0, 0, 0, 0, 183,
+	225, 0, 244, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 251, 273, 285, 276, 0,
+	0, 0, 284, 0, 0, 0, 0, 0, 0, 209,
+	210, 211, 212, 0, 0, 152, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 171, 177, 0, 179,
+	151, 224, 174, 282, 186, 216, 182, 248, 187, 194,
+	236, 281, 222, 241, 150, 272, 249, 198, 173, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 292, 293, 294, 295, 296,
+	0, 132, 0, 191, 0, 234, 170, 95, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 106, 107,
+	108, 109, 110, 111, 112, 113, 114, 115, 116, 117,
+	118, 119, 120, 121, 122, 123, 124, 126, 127, 128,
+	129, 130, 131, 125, 221, 0, 288, 289, 290, 274,
+	0, 0, 0, 0, 165, 0, 0, 0, 190, 0,
+	192, 0, 0, 250, 205, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 92, 0, 0, 780, 0, 0, 0,
+	148, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 215, 291, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 139, 255, 270, 149, 246, 283, 153, 253,
+	145, 220, 242, 134, 133, 141, 268, 252, 202, 184,
+	185, 140, 0, 237, 163, 176, 160, 218, 0, 0,
+	159, 0, 278, 143, 144, 277, 217, 265, 269, 203,
+	197, 142, 267, 201, 196, 188, 167, 180, 230, 195,
+	231, 181, 207, 206, 208, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 280,
+	0, 0, 0, 0, 0, 0, 254, 0, 0, 189,
+	0, 0, 0, 0, 0, 240, 223, 0, 0, 228,
+	238, 193, 266, 232, 271, 256, 257, 279, 0, 233,
+	135, 258, 162, 204, 146, 147, 158, 164, 166, 168,
+	169, 213, 214, 226, 245, 259, 260, 261, 161, 154,
+	239, 155, 178, 156, 136, 247, 157, 137, 227, 264,
+	0, 175, 235, 200, 138, 199, 229, 263, 262, 287,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 172,
+	0, 275, 0, 219, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 243, 0, 0, 0, 0,
+	0, 183, 225, 0, 244, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 251, 273, 285,
+	837, 0, 0, 0, 284, 0, 0, 0, 0, 0,
+	0, 209, 210, 211, 212, 0, 0, 152, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 171, 177,
+	0, 179, 151, 224, 174, 282, 186, 216, 182, 248,
+	187, 194, 236, 281, 222, 241, 150, 272, 249, 198,
+	173, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 292, 293, 294,
+	295, 296, 0, 132, 0, 191, 0, 234, 170, 95,
+	96, 97, 98, 99, 100, 101, 102, 103, 104, 105,
+	106, 107, 108, 109, 110, 111, 112, 113, 114, 115,
+	116, 117, 118, 119, 120, 121, 122, 123, 124, 126,
+	127, 128, 129, 130, 131, 125, 221, 0, 288, 289,
+	290, 274, 0, 0, 0, 0, 165, 0, 0, 0,
+	190, 0, 192, 0, 0, 250, 205, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 92, 0, 0, 0, 0,
+	0, 0, 148, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 286, 215, 291, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 139, 255, 270, 149, 246, 283,
+	153, 253, 145, 220, 242, 134, 133, 141, 268, 252,
+	202, 184, 185, 140, 0, 237, 163, 176, 160, 218,
+	0, 0, 159, 0, 278, 143, 144, 277, 217, 265,
+	269, 203, 197, 142, 267, 201, 196, 188, 167, 180,
+	230, 195, 231, 181, 207, 206, 208, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 280, 0, 0, 0, 0, 0, 0, 254, 0,
+	0, 189, 0, 0, 0, 0, 0, 240, 223, 0,
+	0, 228, 238, 193, 266, 232, 271, 256, 257, 279,
+	0, 233, 135, 258, 162, 204, 146, 147, 158, 164,
+	166, 168, 169, 213, 214, 226, 245, 259, 260, 261,
+	161, 154, 239, 155, 178, 156, 136, 247, 157, 137,
+	227, 264, 0, 175, 235, 200, 138, 199, 229, 263,
+	262, 287, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 172, 0, 275, 0, 219, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 243, 0, 0,
+	0, 0, 0, 183, 225, 0, 244, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 251,
+	273, 285, 276, 0, 0, 0, 284, 0, 0, 0,
+	0, 0, 0, 209, 210, 211, 212, 0, 0, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	171, 177, 0, 179, 151, 224, 174, 282, 186, 216,
+	182, 248, 187, 194, 236, 281, 222, 241, 150, 272,
+	249, 198, 173, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 430, 0, 0, 292,
+	293, 294, 295, 296, 0, 132, 0, 191, 0, 234,
+	170, 95, 96, 97, 98, 99, 100, 101, 102, 103,
+	104, 105, 106, 107, 108, 109, 110, 111, 112, 113,
+	114, 115, 116, 117, 118, 119, 120, 121, 122, 123,
+	124, 126, 127, 128, 129, 130, 131, 125, 221, 0,
+	288, 289, 290, 274, 0, 0, 0, 89, 165, 0,
+	0, 0, 190, 0, 192, 0, 0, 250, 205, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 92, 0, 0,
+	0, 0, 0, 0, 148, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 286, 215, 291,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 139, 255, 270, 149,
+	246, 283, 153, 253, 145, 220, 242, 134, 133, 141,
+	268, 252, 202, 184, 185, 140, 0, 237, 163, 176,
+	160, 218, 0, 0, 159, 0, 278, 143, 144, 277,
+	217, 265, 269, 203, 197, 142, 267, 201, 196, 188,
+	167, 180, 230, 195, 231, 181, 207, 206, 208, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 280, 0, 0, 0, 0, 0, 0,
+	254, 0, 0, 189, 0, 0, 0, 0, 0, 240,
+	223, 0, 0, 228, 238, 193, 266, 232, 271, 256,
+	257, 279, 0, 233, 135, 258, 162, 204, 146, 147,
+	158, 164, 166, 168, 169, 213, 214, 226, 245, 259,
+	260, 261, 161, 154, 239, 155, 178, 156, 136, 247,
+	157, 137, 227, 264, 0, 175, 235, 200, 138, 199,
+	229, 263, 262, 287, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 0, 275, 0, 219, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 243,
+	0, 0, 0, 0, 0, 183, 225, 0, 244, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 251, 273, 285, 276, 0, 0, 0, 284, 0,
+	0, 0, 0, 0, 0, 209, 210, 211, 212, 0,
+	0, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 171, 177, 0, 179, 151, 224, 174, 282,
+	186, 216, 182, 248, 187, 194, 236, 281, 222, 241,
+	150, 272, 249, 198, 173, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 292, 293, 294, 295, 296, 0, 132, 0, 191,
+	0, 234, 170, 95, 96, 97, 98, 99, 100, 101,
+	102, 103, 104, 105, 106, 107, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 117, 118, 119, 120, 121,
+	122, 123, 124, 126, 127, 128, 129, 130, 131, 125,
+	221, 0, 288, 289, 290, 274, 0, 0, 0, 0,
+	165, 0, 0, 0, 190, 0, 192, 0, 0, 250,
+	205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 92,
+	0, 0, 0, 0, 0, 0, 148, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 286,
+	215, 291, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 139, 255,
+	270, 149, 246, 283, 153, 253, 145, 220, 242, 134,
+	133, 141, 268, 252, 202, 184, 185, 140, 0, 237,
+	163, 176, 160, 218, 0, 0, 159, 0, 278, 143,
+	144, 277, 217, 265, 269, 203, 197, 142, 267, 201,
+	196, 188, 167, 180, 230, 195, 231, 181, 207, 206,
+	208, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 280, 0, 0, 0, 0,
+	0, 0, 254, 0, 0, 189, 0, 0, 0, 0,
+	0, 240, 223, 0, 0, 228, 238, 193, 266, 232,
+	271, 256, 257, 279, 0, 233, 135, 258, 162, 204,
+	146, 147, 158, 164, 166, 168, 169, 213, 214, 226,
+	245, 259, 260, 261, 161, 154, 239, 155, 178, 156,
+	136, 247, 157, 137, 227, 264, 0, 175, 235, 200,
+	138, 199, 229, 263, 262, 287, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 172, 0, 275, 0, 219,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 243, 0, 0, 0, 0, 0, 183, 225, 0,
+	244, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 251, 273, 285, 276, 0, 0, 0,
+	284, 0, 0, 0, 0, 0, 0, 209, 210, 211,
+	212, 0, 0, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 171, 177, 0, 179, 151, 224,
+	174, 282, 186, 216, 182, 248, 187, 194, 236, 281,
+	222, 241, 150, 272, 249, 198, 173, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 292, 293, 294, 295, 296, 0, 132,
+	0, 191, 0, 234, 170, 95, 96, 97, 98, 99,
+	100, 101, 102, 103, 104, 105, 106, 107, 108, 109,
+	110, 111, 112, 113, 114, 115, 116, 117, 118, 119,
+	120, 121, 122, 123, 124, 126, 127, 128, 129, 130,
+	131, 125, 0, 221, 288, 289, 290, 274, 1556, 0,
+	0, 0, 0, 165, 0, 0, 0, 190, 0, 192,
+	0, 0, 250, 205, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 481, 482, 483, 478, 0, 0, 0, 148,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 286, 215, 291, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 139, 255, 270, 149, 246, 283, 153, 253, 145,
+	220, 242, 134, 133, 141, 268, 252, 202, 184, 185,
+	140, 0, 237, 163, 176, 160, 218, 0, 0, 159,
+	0, 278, 143, 144, 277, 217, 265, 269, 203, 197,
+	142, 267, 201, 196, 188, 167, 180, 230, 195, 231,
+	181, 207, 206, 208, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 280, 0,
+	0, 0, 0, 0, 0, 254, 0, 0, 189, 0,
+	0, 0, 0, 0, 240, 223, 0, 0, 228, 238,
+	193, 266, 232, 271, 256, 257, 279, 0, 233, 135,
+	258, 162, 204, 146, 147, 158, 164, 166, 168, 169,
+	213, 214, 226, 245, 259, 260, 261, 161, 154, 239,
+	155, 178, 156, 136, 247, 157, 137, 227, 264, 0,
+	175, 235, 200, 138, 199, 229, 263, 262, 287, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 172, 0,
+	275, 0, 219, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 243, 0, 0, 0, 0, 0,
+	183, 225, 0, 244, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 251, 273, 285, 276,
+	0, 0, 0, 284, 0, 0, 0, 0, 0, 0,
+	209, 210, 211, 212, 0, 0, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 171, 177, 0,
+	179, 151, 224, 174, 282, 186, 216, 182, 248, 187,
+	194, 236, 281, 222, 241, 150, 272, 249, 198, 173,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 221,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 165,
+	0, 0, 0, 190, 0, 192, 0, 0, 250, 205,
+	0, 0, 0, 0, 0, 0, 292, 293, 294, 295,
+	296, 0, 132, 0, 191, 0, 234, 170, 481, 482,
+	483, 478, 0, 0, 0, 148, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 286, 215,
+	291, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 288, 289, 290,
+	274, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 139, 255, 270,
+	149, 246, 283, 153, 253, 145, 220, 242, 134, 133,
+	141, 268, 252, 202, 184, 185, 140, 0, 237, 163,
+	176, 160, 218, 0, 0, 159, 0, 278, 143, 144,
+	277, 217, 265, 269, 203, 197, 142, 267, 201, 196,
+	188, 167, 180, 230, 195, 231, 181, 207, 206, 208,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 280, 0, 0, 0, 0, 0,
+	0, 254, 0, 0, 189, 0, 0, 0, 0, 0,
+	240, 223, 0, 0, 228, 238, 193, 266, 232, 271,
+	256, 257, 279, 0, 233, 135, 258, 162, 204, 146,
+	147, 158, 164, 166, 168, 169, 213, 214, 226, 245,
+	259, 260, 261, 161, 154, 239, 155, 178, 156, 136,
+	247, 157, 137, 227, 264, 0, 175, 235, 200, 138,
+	199, 229, 263, 262, 287, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 172, 0, 275, 0, 219, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	243, 0, 0, 0, 0, 0, 183, 225, 0, 244,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 251, 273, 285, 276, 0, 0, 0, 284,
+	0, 0, 0, 0, 0, 0, 209, 210, 211, 212,
+	0, 0, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 171, 177, 0, 179, 151, 224, 174,
+	282, 186, 216, 182, 248, 187, 194, 236, 281, 222,
+	241, 150, 272, 249, 198, 173, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 221, 0, 0, 0, 0,
+	475, 0, 0, 0, 0, 165, 0, 0, 0, 190,
+	0, 192, 0, 0, 250, 205, 0, 0, 0, 0,
+	765, 0, 292, 293, 294, 295, 296, 0, 132, 0,
+	191, 0, 234, 170, 481, 482, 483, 478, 0, 0,
+	0, 148, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 286, 215, 291, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 288, 289, 290, 274, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 139, 255, 270, 149, 246, 283, 153,
+	253, 145, 220, 242, 134, 133, 141, 268, 252, 202,
+	184, 185, 140, 0, 237, 163, 176, 160, 218, 0,
+	0, 159, 0, 278, 143, 144, 277, 217, 265, 269,
+	203, 197, 142, 267, 201, 196, 188, 167, 180, 230,
+	195, 231, 181, 207, 206, 208, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	280, 0, 0, 0, 0, 0, 0, 254, 0, 0,
+	189, 0, 0, 0, 0, 0, 240, 223, 0, 0,
+	228, 238, 193, 266, 232, 271, 256, 257, 279, 0,
+	233, 135, 258, 162, 204, 146, 147, 158, 164, 166,
+	168, 169, 213, 214, 226, 245, 259, 260, 261, 161,
+	154, 239, 155, 178, 156, 136, 247, 157, 137, 227,
+	264, 0, 175, 235, 200, 138, 199, 229, 263, 262,
+	287, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	172, 0, 275, 0, 219, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 243, 0, 0, 0,
+	0, 0, 183, 225, 0, 244, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 251, 273,
+	285, 276, 0, 0, 0, 284, 0, 0, 0, 0,
+	0, 0, 209, 210, 211, 212, 0, 0, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 171,
+	177, 0, 179, 151, 224, 174, 282, 186, 216, 182,
+	248, 187, 194, 236, 281, 222, 241, 150, 272, 249,
+	198, 173, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 221, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 165, 0, 0, 0, 190, 0, 192, 0, 0,
+	250, 205, 0, 0, 0, 0, 0, 0, 292, 293,
+	294, 295, 296, 0, 132, 0, 191, 0, 234, 170,
+	481, 482, 483, 478, 0, 0, 0, 148, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	286, 215, 291, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 288,
+	289, 290, 274, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 139,
+	255, 270, 149, 246, 283, 153, 253, 145, 220, 242,
+	134, 133, 141, 268, 252, 202, 184, 185, 140, 0,
+	237, 163, 176, 160, 218, 0, 0, 159, 0, 278,
+	143, 144, 277, 217, 265, 269, 203, 197, 142, 267,
+	201, 196, 188, 167, 180, 230, 195, 231, 181, 207,
+	206, 208, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 280, 0, 0, 0,
+	0, 0, 0, 254, 0, 0, 189, 0, 0, 0,
+	0, 0, 240, 223, 0, 0, 228, 238, 193, 266,
+	232, 271, 256, 257, 279, 0, 233, 135, 258, 162,
+	204, 146, 147, 158, 164, 166, 168, 169, 213, 214,
+	226, 245, 259, 260, 261, 161, 154, 239, 155, 178,
+	156, 136, 247, 157, 137, 227, 264, 0, 175, 235,
+	200, 138, 199, 229, 263, 262, 287, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 172, 0, 275, 0,
+	219, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 243, 0, 0, 0, 0, 0, 183, 225,
+	0, 244, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 251, 273, 285, 276, 0, 0,
+	0, 284, 0, 0, 0, 0, 0, 0, 209, 210,
+	211, 212, 0, 0, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 171, 177, 0, 179, 151,
+	224, 174, 282, 186, 216, 182, 248, 187, 194, 236,
+	281, 222, 241, 150, 272, 249, 198, 173, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 221, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 165, 0, 0,
+	0, 190, 0, 192, 0, 0, 250, 205, 0, 0,
+	0, 0, 0, 0, 292, 293, 294, 295, 296, 0,
+	132, 0, 191, 0, 234, 170, 481, 482, 483, 0,
+	0, 0, 0, 148, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 286, 215, 291, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 288, 289, 290, 274, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 139, 255, 270, 149, 246,
+	283, 153, 253, 145, 220, 242, 134, 133, 141, 268,
+	252, 202, 184, 185, 140, 0, 237, 163, 176, 160,
+	218, 0, 0, 159, 0, 278, 143, 144, 277, 217,
+	265, 269, 203, 197, 142, 267, 201, 196, 188, 167,
+	180, 230, 195, 231, 181, 207, 206, 208, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 280, 0, 0, 0, 0, 0, 0, 254,
+	0, 0, 189, 0, 0, 0, 0, 0, 240, 223,
+	0, 0, 228, 238, 193, 266, 232, 271, 256, 257,
+	279, 0, 233, 135, 258, 162, 204, 146, 147, 158,
+	164, 166, 168, 169, 213, 214, 226, 245, 259, 260,
+	261, 161, 154, 239, 155, 178, 156, 136, 247, 157,
+	137, 227, 264, 0, 175, 235, 200, 138, 199, 229,
+	263, 262, 287, 1863, 0, 0, 86, 0, 26, 44,
+	27, 0, 172, 0, 275, 0, 219, 0, 0, 0,
+	0, 1863, 0, 0, 0, 0, 73, 1194, 243, 0,
+	80, 0, 0, 0, 183, 225, 0, 244, 0, 0,
+	0, 0, 0, 0, 0, 1194, 0, 0, 0, 45,
+	251, 273, 285, 276, 83, 0, 0, 284, 0, 0,
+	0, 0, 1845, 0, 209, 210, 211, 212, 0, 0,
+	152, 1916, 0, 0, 0, 0, 0, 0, 0, 0,
+	1845, 171, 177, 0, 179, 151, 224, 174, 282, 186,
+	216, 182, 248, 187, 194, 236, 281, 222, 241, 150,
+	272, 249, 198, 173, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 76, 77, 0, 78,
+	79, 0, 0, 0, 0, 0, 0, 56, 58, 0,
+	292, 293, 294, 295, 296, 0, 132, 0, 191, 0,
+	234, 170, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 65, 75, 59, 0, 43, 0,
+	1849, 288, 289, 290, 274, 0, 0, 0, 0, 0,
+	0, 1853, 0, 0, 74, 72, 71, 0, 1849, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1853,
+	0, 0, 1842, 0, 0, 0, 1844, 1846, 1848, 0,
+	1850, 1851, 1852, 1854, 1855, 1856, 1858, 1859, 1860, 1861,
+	1842, 0, 0, 0, 1844, 1846, 1848, 0, 1850, 1851,
+	1852, 1854, 1855, 1856, 1858, 1859, 1860, 1861, 0, 0,
+	0, 0, 1864, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1864, 0, 0, 0, 0, 0, 0, 0, 0, 53,
+	1862, 0, 0, 57, 0, 54, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1841, 1862, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1857, 0, 0, 1841, 0, 0, 0, 1847,
+	0, 0, 55, 0, 0, 0, 0, 0, 0, 0,
+	1857, 0, 0, 0, 0, 0, 0, 1847, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	85,
 }
 
 var yyPact = [...]int{
-	147, -1000, -299, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	19610, -1000, -305, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 17060, 1859, -1000, 6667,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 14772, 1724, -1000, 6387, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 219, 12682,
-	15190, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 5951, 5515,
-	130, -1000, 1712, -1000, -1000, -1000, 121, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 641, -31, 320, 327, 324,
-	324, 7223, 1712, 1444, 153, 5, -1000, 14354, 1657, 147,
-	170, 15190, -1000, 400, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 242, 14036, 17492, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 6217, 5767, 139, 17492, 17492, 301, 73,
+	-1000, 1830, -1000, -1000, -1000, 166, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 462, 114, 336, 340, 351, 351,
+	7531, 1830, 1523, 202, -1000, 16628, 1800, 19610, 181, 17492,
+	-1000, 383, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2750,70 +3094,76 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	12682, 15190, -69, 533, -1000, 196, 178, 159, 387, -1000,
-	-1000, -1000, -1000, 15190, 1431, -1000, -1000, -1000, 1658, 15609,
-	153, -1000, 1379, 1374, -1000, -1000, 1534, -1000, 83, 4,
-	-16, 124, -1000, -1000, 149, -1000, -1000, -1000, -1000, -1000,
-	45, -1000, 2, -1000, -9, -1000, -1000, -1000, -107, -1000,
-	-1000, -1000, -1000, -1000, 1373, 328, 1560, -174, 1630, 1665,
-	1444, 1702, 1680, 191, 191, 204, 191, 218, -1000, -1000,
-	-1000, -1000, -1000, -1000, 581, 148, -1000, -1000, -118, -134,
-	457, -134, -4, -1000, -1000, -1000, -1000, -1000, -1000, 192,
-	-1000, -188, -1000, 305, -1000, 288, -1000, 8914, 145, 1396,
-	541, -1000, 553, 15190, 15190, 15190, 553, 786, 703, 371,
-	-1000, -1000, -1000, 1619, 1620, 1665, 1444, -1000, 1712, 1712,
-	1234, 1093, 192, 192, 192, 192, 192, 1394, 15190, -1000,
-	1462, 4223, -1000, -1000, -1000, -1000, -1000, 162, 1525, -1000,
-	15190, 1442, -1000, 366, 839, 960, -1000, -1000, 196, 1357,
-	-1000, 422, -1000, -1000, -1000, -1000, 15190, 1523, 15190, 12682,
-	12682, 12682, 12682, -1000, 1604, 1603, -1000, 1574, 1572, 1595,
-	15190, -1000, -1000, -1000, 15952, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1224, 1712, 109, 5598, 11846, 13518, 15190, 11846,
-	-1000, -1000, -1000, -1000, -1000, -108, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 109, 11846, 11846, -79,
-	-1000, -1000, -289, 1630, 4651, -1000, -1000, 4651, -1000, -1000,
-	11846, 575, 13518, 848, 15190, 191, 15190, -1000, -1000, 457,
-	457, -1000, 581, 581, -1000, -1000, -135, 1713, 5079, -116,
-	15190, 191, 13936, 1635, -137, 318, 282, 300, -1000, -1000,
-	-179, -1000, -1000, 1381, 9338, 8490, 202, 11846, 2939, -1000,
-	-1000, 553, 553, 553, 2939, 419, -1000, -1000, -1000, -1000,
-	-1000, -1000, 15190, -1000, -1000, 1630, -1000, -1000, -1000, 1665,
-	1630, 1665, -1000, -1000, 11846, 13518, 15190, 15190, 16295, 15190,
-	1394, 1637, 15190, 1371, -1000, -1000, 8072, 365, 4651, 1083,
-	1521, -1000, 1520, 1519, 1518, 1502, 1499, 1498, 1497, 1472,
-	1495, 1493, 1489, -1000, -1000, -1000, 1488, -1000, -1000, 1487,
-	1472, 1486, 1485, 1482, -1000, -1000, -1000, -1000, 1012, -1000,
-	-1000, -1000, -1000, 2511, 5079, 5079, 5079, 5079, -1000, -1000,
-	1481, 4651, 1480, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 650, -1000, 1479, 1478,
-	1477, 1472, 1471, 959, 956, 952, 1469, 1465, 1464, 5079,
-	1463, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 14036, 17492, -60,
+	568, -1000, 168, 163, 198, 382, -1000, -1000, -1000, -1000,
+	17492, 1545, -1000, -1000, -1000, 1764, 18637, 202, -1000, 1503,
+	1368, -1000, -1000, 1648, -1000, 95, 29, 3, 132, -1000,
+	-1000, 157, -1000, -1000, -1000, -1000, -1000, 50, -1000, 18,
+	-1000, 16, -1000, -1000, -1000, -99, -1000, -1000, -1000, -1000,
+	-1000, 1321, 349, 1678, -143, 1858, 1651, 17492, 17492, 204,
+	204, 204, 204, 204, 1733, 1780, 1523, 1822, 1773, 203,
+	203, 230, 203, 234, -1000, -1000, -1000, -1000, -1000, -1000,
+	609, 609, 159, -1000, -1000, -114, -106, 418, -106, 27,
+	-1000, -1000, -1000, -1000, -1000, -1000, 17492, 204, -1000, -177,
+	-1000, 321, -1000, 318, -1000, 9710, 152, 1455, 544, -1000,
+	581, 17492, 17492, 17492, 581, 581, 663, 639, 381, -1000,
+	1720, 1724, 1780, 1523, -1000, 1830, 1830, 1252, 1156, 1438,
+	17492, -1000, 1566, 4433, -1000, -1000, -1000, -1000, -1000, 191,
+	1646, -1000, 17492, 1590, -1000, 380, 855, 615, -1000, -1000,
+	168, 1497, -1000, 659, -1000, -1000, -1000, -1000, 17492, 169,
+	17492, 14036, 14036, 14036, 14036, -1000, 1702, 1701, -1000, 1695,
+	1694, 1718, 17492, -1000, -1000, 18281, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1238, 1830, 128, 6299, 13172, 14900,
+	17492, 13172, -1000, -1000, -1000, -1000, -1000, -100, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 128, 13172,
+	13172, -70, -1000, 938, 949, -1000, -1000, 13172, 1745, 14900,
+	17492, 17492, 19349, -1000, -289, 1733, 4875, -1000, -1000, 4875,
+	-1000, -1000, 13172, 592, 14900, 1012, 17492, 203, 17492, -1000,
+	-1000, 17492, 418, 418, -1000, 609, 609, -1000, -1000, -101,
+	1838, 5317, -111, 17492, 203, 240, 16196, -132, 334, 322,
+	324, -1000, -1000, -145, -1000, -1000, 1363, 10580, 9272, 232,
+	13172, 3107, -1000, -1000, 581, 581, 581, 3107, 3107, 355,
+	-1000, -1000, -1000, -1000, -1000, -1000, 17492, -1000, -1000, 1733,
+	-1000, -1000, -1000, 1780, 1733, 1780, -1000, -1000, 17492, 1438,
+	1749, 17492, 1307, -1000, -1000, 8840, 377, 4875, 947, 1637,
+	-1000, -1000, 1636, 1630, 1629, 1628, 1625, 1624, 1621, 1588,
+	-1000, -1000, 1619, 1616, 1614, 1588, 1610, -1000, -1000, -1000,
+	1608, -1000, -1000, -1000, 1606, 1588, 1605, 1604, 1603, 1602,
+	1601, -1000, -1000, -1000, -1000, 1900, -1000, 516, -1000, -1000,
+	2665, 5317, 5317, 5317, 5317, -1000, -1000, 1599, 4875, 1598,
+	-207, -1000, -1000, -212, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 7963, -1000, 1597, 1596, 1594,
+	1588, 1587, 1049, 1040, 1039, 1586, 1576, 1572, 5317, 1570,
+	1569, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -287, -1000, 7653, 15190, 15190, -1000,
-	1704, 4651, 2087, -1000, 1661, -1000, 196, 68, -1000, -1000,
-	-1000, -1000, -1000, -1000, 361, 15190, 1345, -1000, 531, 1554,
-	1559, 1554, -1000, -1000, -1000, -1000, 1601, -1000, 1594, -1000,
-	-1000, 1462, -1000, -1000, 498, -1000, -1000, -1000, -1000, -1000,
-	2, -9, 1372, -1000, -35, 82, -1000, -1000, 1337, -1000,
-	-1000, -1000, 498, 1372, 208, 947, 946, -1000, 1141, 353,
-	1393, -1000, 1049, 214, 1634, 1381, 1540, 1622, 15190, 1713,
-	1713, 1713, 457, 16295, 581, 15190, 581, -1000, -1000, 581,
-	-1000, 347, 15190, 214, 1461, -1000, -1000, -1000, 304, 283,
-	296, 13518, 203, -1000, -1000, 1381, -1000, -1000, -1000, 1459,
-	528, -1000, -1000, 5079, -1000, 596, -1000, 2939, 2939, 2939,
-	-1000, 10592, -1000, -1000, 1630, -1000, 1630, 1372, 1381, 1558,
-	1392, -1000, -1000, -1000, -1000, -1000, 1455, 1335, -1000, 1713,
-	4223, -1000, 12682, -1000, 4651, 4651, 4651, -1000, 15190, 13100,
-	-1000, 591, 5079, -1000, -1000, -1000, -1000, -1000, -1000, 4651,
-	1677, 1677, 1677, 4651, 593, 4651, 4651, -1000, 670, 363,
-	1677, 1677, 1677, 1677, -1000, 1677, 1677, 1677, 5079, 5079,
-	5079, 5079, 5079, 5079, 5079, 5079, 5079, 5079, 5079, 5079,
-	1449, 585, 5079, 5079, 5079, 1093, 1359, 1389, -1000, -1000,
-	-1000, -1000, -1000, 549, 596, 4651, -1000, 363, 4651, 4651,
-	-1000, 1222, -1000, -1000, 4651, -1000, -1000, -1000, 4651, 5079,
-	4651, -1000, 1677, 1265, -1000, 1453, -1000, 1333, 1615, -1000,
-	346, 1385, -1000, 527, 1322, -1000, 1665, 596, -1000, 345,
+	-1000, -1000, -1000, -1000, -286, -1000, 8407, 17492, 17492, -1000,
+	1831, 4875, 2227, -1000, 1769, 168, -1000, -1000, -1000, -1000,
+	168, 87, -1000, -1000, -1000, -1000, 373, 17492, 17492, 1292,
+	-1000, 560, 1664, 1675, 1664, -1000, -1000, -1000, -1000, 1698,
+	-1000, 1697, -1000, -1000, 1566, 270, -1000, -1000, 594, -1000,
+	-1000, -1000, -1000, -1000, 18, 16, 1300, -1000, -11, 94,
+	-1000, -1000, 1495, -1000, -1000, -1000, 594, 1300, 215, 1033,
+	-1000, -1000, 1427, -1000, 1300, -1000, 1363, 1674, 1409, -1000,
+	-1000, -1000, -1000, 1032, -1000, 977, 372, 1397, -1000, 835,
+	245, 1744, 1363, 1592, 1726, 17492, -1000, 1838, 1838, 1838,
+	418, 19349, 609, 17492, 609, -1000, -1000, 609, -1000, 371,
+	17492, 1379, -1000, 15764, 15332, 200, 245, 1565, -1000, -1000,
+	327, 316, 305, 14900, 214, -1000, -1000, 1363, -1000, -1000,
+	-1000, 1564, 550, -1000, -1000, 5317, -1000, 676, -1000, 3107,
+	3107, 3107, -1000, -1000, 11876, -1000, -1000, 1733, -1000, 1733,
+	-1000, 1563, 1467, -1000, 1838, 4433, -1000, 14036, -1000, 4875,
+	4875, 4875, -1000, 17492, 14468, -1000, 625, 5317, -1000, -1000,
+	-1000, -1000, -1000, -1000, 4875, 1770, 1770, 1770, 4875, 684,
+	4875, 4875, -1000, 717, 5755, 1770, 1770, -1000, 5317, 1770,
+	1770, -1000, 580, 4875, 1770, 1770, 1770, 5317, 5317, 5317,
+	5317, 5317, 5317, 5317, 5317, 5317, 5317, 5317, 5317, 1555,
+	720, 5317, 5317, 5317, 1027, 1025, 1156, 1485, 1374, -1000,
+	-1000, -1000, -1000, -1000, 584, 676, 4875, 1562, 1561, 5755,
+	5755, -1000, -1000, 10142, -1000, 4875, 4875, -1000, 1232, -1000,
+	-1000, 4875, -1000, -1000, -1000, 4875, 5317, 4875, -1000, 4875,
+	1770, 1273, -1000, 1558, -1000, 1465, 1711, -1000, 370, 1372,
+	-1000, 542, 1462, -1000, 1780, 676, -1000, 363, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2828,149 +3178,158 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -70,
-	-1000, -1000, 15190, 1319, 1704, 15190, 4651, -1000, -1000, 4651,
-	1452, -1000, 4651, -1000, -1000, -1000, -1000, 1723, 344, 343,
-	11846, -1000, 144, 11846, -1000, -1000, 15190, 200, 11846, -14,
-	-141, 4651, 4651, 15190, 4651, -1000, -1000, -1000, -227, -1000,
-	-53, -1000, 1557, 69, -1000, 1622, -1000, 506, -1000, 1450,
-	-1000, -1000, -1000, 1713, -1000, 457, -1000, 457, 581, 15190,
-	-1000, -1000, -227, 1216, -1000, -1000, -1000, 278, 1381, 11846,
-	901, 202, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 15190,
-	15190, 147, -1000, 15190, 1715, -1000, 1378, 1539, -1000, 618,
-	583, -1000, 342, -1000, -1000, 635, -1000, 1208, 1257, 596,
-	4651, -1000, -1000, 4651, 4651, 861, 4651, 1203, 1317, 1287,
-	-1000, 1193, -1000, 1722, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -62,
+	-1000, -1000, -1000, 17492, 1457, 1365, 1831, 17492, 4875, -1000,
+	-1000, 4875, 1557, -1000, 4875, -1000, -1000, -1000, -1000, 1023,
+	1857, 360, 359, 13172, -1000, 149, 13172, -1000, -1000, 17492,
+	209, 13172, 4, 949, 17492, 17492, -109, 4875, 4875, 17492,
+	4875, -1000, -1000, -1000, -229, -1000, -32, -1000, 1671, 53,
+	-1000, 1726, -1000, 559, -1000, 1556, -1000, -1000, -1000, 1838,
+	-1000, 418, -1000, 418, 609, 17492, -1000, -1000, 240, -1000,
+	17492, 972, -1000, 17492, 17492, -229, 1223, -1000, -1000, -1000,
+	306, 1363, 13172, 948, 232, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 19610, -1000, 17492, 1834, -1000, 1358, 1663, -1000,
+	642, 616, -1000, 354, -1000, -1000, 702, -1000, 1208, 1265,
+	676, 4875, -1000, -1000, 4875, 4875, 1134, 4875, 1202, 1448,
+	1441, -1000, 1188, -1000, 1844, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 4651, 4651, 4651, 4651, 4651, 4651, 4651,
-	770, 887, -1000, 1027, 1027, 334, 334, 334, 334, 334,
-	1036, 1036, -1000, -1000, -1000, 2511, 1449, 5079, 5079, 5079,
-	176, 1703, 1583, -1000, 4651, 559, -1000, 4651, 733, -1000,
-	1191, 644, 1189, -1000, 1031, 1150, 1564, 1147, 4651, -287,
-	3795, 175, 15190, -287, 15190, 15190, 3795, -1000, 15190, -1000,
-	2087, 836, -1000, -1000, 1665, -1000, 596, 596, 15190, 596,
-	11846, 445, 492, -1000, 10174, 11846, -1000, -1000, 11846, 103,
-	1628, -1000, -1000, -99, -86, 596, 596, 340, -1000, -1000,
-	-68, -1000, -1000, -1000, 217, -1000, 942, 921, 920, 914,
-	15190, -1000, -1000, -1000, -1000, -1000, 508, 508, 508, 1619,
-	6805, -1000, 1713, 1713, 457, -1000, -1, -43, -1000, 1372,
-	1145, -1000, -1000, -1000, -1000, 1135, -1000, 1708, 1700, 12682,
-	12264, -1000, -1000, 4651, 1343, 1339, 1327, 227, 1273, -1000,
-	-1000, -1000, -1000, 4651, 1324, 1259, 1239, 1214, 1204, 1195,
-	1177, 1271, -1000, 176, 1703, 1458, -1000, 5079, 5079, 1160,
-	507, -1000, 4651, 691, 227, 398, -1000, 4651, -1000, -1000,
-	398, -1000, 5079, -1000, 1149, -1000, 1126, 1377, -1000, -287,
-	-1000, -1000, 1265, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1269, 1372, -1000, -1000, -1000, -1000, 11846,
-	1638, 214, -1000, -2, 216, -291, -81, 1699, 1698, 15190,
-	-68, -1000, 835, 834, 833, 830, -38, -1000, -1000, -1000,
-	-1000, -1000, 1448, 398, -1000, 698, 912, 1115, 1294, -1000,
-	-1000, -1000, 905, 526, -1000, 15190, 608, 319, 191, 319,
-	594, 1446, -1000, -1000, -1000, -1000, 1713, -1000, -1, -1000,
-	275, 276, 31, 1697, -1000, -1000, -1000, 4651, 4651, 1539,
-	-1000, -1000, 596, -1000, -1000, -1000, 1107, -1000, 1428, 1441,
-	-1000, 1428, 1428, 1428, 281, 281, 1443, 1443, 1445, 1443,
-	-1000, 1132, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 5079, -1000, -1000, -1000, -1000, 596, 4651, 1101, 1076,
-	930, 1056, 1522, -1000, -1000, 3795, 1265, -1000, -1000, 11846,
-	11846, -231, 0, 15190, -294, 911, -1000, 1696, 906, 757,
-	-1000, -1000, -1000, -1000, -1000, -1000, 11428, -1000, -1000, -1000,
-	-1000, -1000, -1000, 16667, 6805, 941, -26, -1000, -1000, -1000,
-	1428, -1000, 1441, 1428, 1428, 1428, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1440, 1438, -1000, 1428, 1437,
-	1428, 1428, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 15190,
-	15190, -1000, 15190, 15190, 191, 4651, -1000, -1000, -1000, -1000,
-	823, -1000, -1000, -1000, 901, 596, 1257, -1000, -1000, -1000,
-	806, -1000, 803, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 802, -1000, -1000, 800, -1000, -1000, -1000, 596, -1000,
-	-1000, -1000, 4651, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-116, -296, 798, -1000, 900, -84, -1000, -1000, 1254, -1000,
-	1428, 4651, 166, 16604, -1000, 508, 508, 323, 508, 508,
-	508, 508, 128, 127, 508, 508, 508, 508, 508, 508,
-	508, 508, 508, 508, 508, 508, 508, 508, 1427, -1000,
-	-1000, 941, -1000, -1000, 634, 5079, -1000, -1000, 898, 698,
-	313, 406, 1423, -1000, 93, 592, 588, -1000, 15190, -1000,
-	-29, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 895, 895,
-	-1000, -1000, 797, -1000, -1000, 1417, 1537, 53, 1414, -1000,
-	1413, 1412, 15190, 1129, 27, -1000, -1000, 1051, 1040, 1241,
-	1232, 992, -100, -91, -1000, 1410, -1000, -1000, 1695, -1000,
-	11428, 1627, 711, -1000, 1693, 16667, -1000, 776, 772, 508,
-	508, 767, 892, 891, 890, 508, 508, 766, 886, 15952,
-	763, 755, 745, 775, 885, 561, 771, 700, 699, 15190,
-	1409, 851, -1000, -1000, 1703, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 743, 1408, -1000, -1000,
-	1404, -1000, -1000, 1221, -1000, 1212, 1034, 11428, 38, 38,
-	11428, 11428, 11428, 1403, 266, -1000, -1000, -1000, -1000, 742,
-	-1000, 729, -1000, 194, -97, -91, -1000, 1692, -89, 1691,
-	1690, 15190, 757, 90, -1000, -1000, 1627, 85, -1000, -1000,
-	-1000, 398, 398, -1000, -1000, -1000, -1000, 884, 882, -1000,
+	-1000, -1000, -1000, -1000, 4875, 4875, 1944, 4875, 4875, 1350,
+	1436, 4875, 4875, 4875, 898, 1087, -1000, 810, 810, 399,
+	399, 399, 399, 399, 1114, 1114, -1000, -1000, -1000, 2665,
+	1555, 5317, 5317, 5317, 187, 1821, 1746, -1000, -1000, -1000,
+	4875, 661, -1000, 4875, 716, 179, 179, -1000, -1000, -1000,
+	1177, 829, 1166, -1000, 1094, 1157, 1668, 1154, 939, 4875,
+	-286, 3991, 195, 17492, -286, 17492, 17492, 3991, -1000, 17492,
+	-1000, 2227, 849, -1000, -1000, 1780, -1000, 676, 676, 17492,
+	676, 17925, 13172, 388, 547, -1000, 11444, 13172, -1000, -1000,
+	13172, 120, 1729, -1000, -1000, -1000, -1000, -1000, -91, -81,
+	676, 676, 353, -1000, -1000, -45, -1000, -1000, -1000, 297,
+	-1000, 1022, 1021, 1020, 1019, 17492, -1000, -1000, -1000, -1000,
+	-1000, 438, 438, 438, 1720, 7099, -1000, 1838, 1838, 418,
+	-1000, -1000, -1000, 1210, 1, -1000, -1000, -1000, 1525, -1000,
+	1530, 1525, 1525, 1525, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1554, 1553, -1000, 1525, 1551, 1525, 1525,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1543, 1543, 1546, 1543, -1000,
+	208, 15, -13, -1000, 1300, 1151, -1000, -1000, 1132, -1000,
+	1823, 1818, 14036, 13604, -1000, -1000, 4875, 1460, 1453, 1450,
+	641, 1415, -1000, -1000, -1000, -1000, 4875, 1444, 1406, 4875,
+	1377, 1359, 4875, -1000, 1354, 1315, 1284, 1392, -1000, 187,
+	1821, 1581, -1000, 5317, 5317, 1269, 577, -1000, 4875, 651,
+	641, 779, 1127, 1831, 1817, 1121, -1000, 4875, -1000, -1000,
+	779, -1000, 5317, -1000, 4875, 1266, -1000, 1109, 1332, -1000,
+	-286, -1000, -1000, 1273, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1370, -1000, 18993, 1300, -1000, -1000,
+	-1000, -1000, 13172, 1754, 245, -1000, 22, 210, -292, -72,
+	1816, 1815, 17492, -45, -1000, 844, 842, 840, 839, -23,
+	-1000, -1000, -1000, -1000, -1000, 1542, 779, -1000, 794, 1013,
+	1106, 1275, -1000, -1000, -1000, 280, -1000, 17492, 689, 366,
+	203, 366, 674, 1540, -1000, -1000, -1000, -1000, 1838, -1000,
+	1210, -1000, -1000, 670, 5317, -1000, -1000, 1004, 794, 376,
+	415, 1537, -1000, 109, 673, 634, -1000, 17492, -1000, -12,
+	-1000, -1000, -1000, -1000, 833, -1000, 824, -1000, -1000, -1000,
+	988, 988, -1000, -1000, 823, -1000, -1000, -1000, 817, -1000,
+	-1000, 815, -1000, 17492, -1000, 15, -1000, 295, 308, 57,
+	1812, -1000, -1000, -1000, 4875, 4875, 1663, -1000, -1000, 676,
+	-1000, -1000, -1000, 1102, -1000, 1525, 1530, -1000, 1525, 1525,
+	1525, 307, 307, -1000, 1219, -1000, -1000, 1199, -1000, -1000,
+	900, -1000, -1000, -1000, -1000, -1000, 5317, -1000, -1000, -1000,
+	-1000, 676, 4875, 1091, 1089, -1000, -69, 4875, -1000, 969,
+	1076, 1361, 1189, -1000, -1000, 3991, 1273, -1000, -1000, 13172,
+	13172, -230, 14, 17492, -297, 974, -1000, 1811, 959, 799,
+	-1000, -1000, -1000, -1000, -1000, -1000, 12740, -1000, -1000, -1000,
+	-1000, -1000, -1000, 19608, 7099, -1000, -1000, 17492, 17492, -1000,
+	17492, 17492, 203, 4875, -1000, -1000, -1000, 1821, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 809,
+	1529, -1000, -1000, 1528, -1000, -1000, 1074, 1067, 1356, -1000,
+	1348, 1062, 1345, 1319, -1000, -1000, -1000, -1000, 805, -1000,
+	-1000, -1000, 948, 676, 1265, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 4875, -1000, 676,
+	-1000, -1000, -1000, 142, 142, 1265, -1000, 4875, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -111, -300, 802, -1000,
+	943, -75, -1000, -1000, 1309, -1000, 1525, 4875, 178, 19626,
+	-1000, 438, 438, 611, 438, 438, 438, 438, 137, 134,
+	438, 438, 438, 438, 438, 438, 438, 438, 438, 438,
+	438, 438, 438, 438, 1524, -1000, 1520, 1547, 70, 1518,
+	-1000, 1517, 1516, 17492, 1178, 1054, 4875, -224, 12740, -1000,
+	-1000, -1000, 941, -1000, -1000, -1000, 801, -1000, 792, 42,
+	-1000, -1000, 1103, -1000, -1000, 207, -186, -287, -196, -211,
+	7963, -1000, 1006, -92, -77, -1000, 1511, -1000, -1000, 1810,
+	-1000, 12740, 1738, 983, -1000, 1809, 19608, -1000, 790, 785,
+	438, 438, 778, 940, 933, 929, 438, 438, 762, 926,
+	18993, 760, 752, 738, 818, 925, 445, 812, 765, 748,
+	17492, 1510, 884, 12740, 78, 78, 12740, 12740, 12740, 1508,
+	298, -1000, 973, 1667, -1000, -14, 1303, -1000, 994, 819,
+	-1000, -1000, 599, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 201, -87, -77, -1000, 1802, -82, 1801, 1790, 17492,
+	799, 106, -1000, -1000, 1738, 96, -1000, -1000, -1000, 779,
+	779, -1000, -1000, -1000, -1000, 920, 909, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 137, 15190, 1197, -1000, 511, 1028, 4651, -221, 11428,
-	-1000, 878, -1000, -1000, 1186, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1175, 1169, 1162, 11428, -1000, -1000, -1000, 76,
-	1026, 1002, 1401, 728, -81, 1689, -1000, 757, 1688, 757,
-	757, 1159, -1000, -1000, -1000, 508, 876, 46, -1000, -1000,
-	-1000, 64, 158, 156, -1000, 230, -1000, -1000, -1000, -1000,
-	-1000, -1000, 139, 1143, -1000, 851, 850, -1000, 639, 1556,
-	-1000, -19, 1113, -1000, -1000, -1000, -1000, -1000, 1110, -1000,
-	-1000, -1000, 1466, 9756, -103, -1000, 849, -1000, 757, -1000,
-	-1000, -1000, 15190, 721, -1000, 848, 59, 713, 5079, 1399,
-	5079, 1398, 72, 1344, -1000, -1000, -1000, -1000, -1000, 266,
-	-1000, -1000, 1548, 1546, 1720, -1000, -1000, -1000, -1000, 90,
-	90, 90, 90, -3, -1000, 15190, -1000, 1105, -1000, -1000,
-	-1000, 333, -1000, -1000, -1000, -1000, -1000, -1000, 1340, 1687,
-	-1000, 1258, 15190, 923, 15190, 1325, 490, 5079, -1000, -1000,
-	1727, -1000, 1721, 417, 417, -1000, 1037, -1000, 474, -1000,
-	11010, 15190, -1000, 164, 70, -1000, 1100, -1000, 1097, 15190,
-	647, 749, -1000, -1000, -1000, 752, 98, -1000, 15190, 3367,
-	-1000, 331, 1070, -1000, 986, 55, -1000, -1000, 1047, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 596, 15190, -1000, 164,
-	1613, -1000, 643, -1000, -1000, -1000, 16554, 154, -1000, -1000,
-	16554, 58, -1000, 155, -1000, -1000, 1045, -1000, 966, 893,
-	-1000, 58, 16667, 4651, -1000, 16667, 1033, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 150,
+	17492, 1288, -1000, 505, 1261, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1244, 1242, 1237, 12740, -1000, -1000, -1000, 107,
+	298, -1000, -1000, 1666, 1662, 1856, -1000, -1000, -1000, -1000,
+	-1000, -1000, 207, 1505, 722, -72, 1786, -1000, 799, 1784,
+	799, 799, 1229, -1000, -1000, -1000, 438, 905, 67, -1000,
+	-1000, -1000, 82, 219, 216, -1000, 254, -1000, -1000, -1000,
+	-1000, -1000, -1000, 144, 1222, -1000, 884, 883, -1000, -1000,
+	-1000, -1000, 1213, -1000, -1000, -1000, 1863, -1000, 1861, 453,
+	453, -1000, 1717, 11012, -95, -1000, 869, -1000, 799, -1000,
+	-1000, -1000, 17492, 718, -1000, 1012, 79, 715, 5317, 1502,
+	5317, 1501, 93, 1486, -1000, -1000, -1000, -1000, -1000, 106,
+	106, 106, 106, 9, -1000, -1000, -1000, 736, 113, -1000,
+	-1000, 17492, -1000, 1201, -1000, -1000, -1000, 350, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1484, 1782, -1000, 1277, 17492, 1135,
+	17492, 1477, 436, 5317, -1000, -1000, -1000, -1000, 1257, -1000,
+	433, -1000, 12308, 17492, -1000, 171, 84, -1000, 1182, -1000,
+	1165, 17492, 714, 990, 17492, 3549, -1000, 346, 1141, -1000,
+	976, 72, -1000, -1000, 1126, -1000, -1000, -1000, -1000, 676,
+	17492, -1000, 171, 1686, -1000, 710, -1000, -1000, -1000, 1575,
+	167, -1000, -1000, 1575, 77, -1000, 173, -1000, -1000, 1118,
+	-1000, 843, 1278, -1000, 77, 19608, 4875, -1000, 19608, 1113,
+	-1000,
 }
 
 var yyPgo = [...]int{
-	0, 84, 2038, 2037, 102, 98, 2035, 2032, 2031, 2028,
-	2027, 2026, 2025, 2024, 2023, 2019, 2017, 2016, 2011, 2010,
-	2009, 2008, 2007, 2006, 2004, 2002, 2001, 2000, 1998, 1997,
-	1995, 1994, 96, 1992, 1989, 1988, 1986, 1985, 1984, 128,
-	1983, 1982, 1981, 1980, 1979, 1978, 1977, 1975, 1974, 125,
-	91, 100, 694, 119, 167, 1973, 116, 1972, 74, 169,
-	1971, 1970, 29, 108, 1969, 118, 112, 83, 137, 89,
-	77, 127, 1967, 1966, 1965, 126, 1964, 1963, 1962, 1961,
-	53, 1954, 61, 33, 28, 1952, 70, 1951, 1950, 1949,
-	1948, 1946, 65, 1945, 58, 42, 1943, 1942, 1941, 1940,
-	1939, 35, 1938, 36, 1935, 1934, 1933, 1932, 1931, 1930,
-	1929, 14, 16, 18, 1928, 1927, 15, 2, 1926, 1925,
-	73, 1924, 1923, 1922, 163, 1918, 1916, 1915, 138, 1914,
-	111, 1913, 1912, 1910, 1909, 9, 1908, 43, 1907, 1906,
-	1905, 44, 1904, 1903, 80, 37, 90, 79, 1902, 1901,
-	1900, 131, 20, 101, 0, 130, 31, 1899, 124, 122,
-	1898, 76, 218, 105, 38, 1897, 39, 63, 1894, 1893,
-	1892, 56, 59, 1890, 75, 1889, 87, 71, 1888, 94,
-	1887, 114, 1, 85, 1885, 133, 1884, 1883, 107, 1882,
-	1881, 52, 106, 1880, 1879, 1878, 25, 1877, 34, 22,
-	1876, 161, 139, 1875, 1874, 1873, 109, 95, 68, 1872,
-	1871, 66, 1869, 93, 67, 110, 1866, 703, 1865, 104,
-	54, 17, 1864, 134, 1862, 243, 135, 117, 1861, 1860,
-	142, 1612, 136, 1859, 123, 10, 1858, 1857, 11, 1855,
-	23, 1854, 1853, 1852, 1851, 6, 1850, 1849, 1845, 3,
-	5, 1844, 4, 86, 1843, 45, 51, 48, 1839, 60,
-	1838, 1837, 1836, 1835, 1834, 245, 1833, 1832, 1831, 1829,
-	1825, 1824, 1821, 69, 1820, 1819, 1818, 1817, 55, 1816,
-	1800, 1799, 1796, 1795, 32, 1794, 1793, 19, 1792, 26,
-	1790, 1789, 1788, 12, 1787, 1786, 13, 1785, 1784, 7,
-	8, 1783, 1782, 47, 46, 30, 64, 62, 1781, 21,
-	1779, 81, 1778, 1777, 113, 1776, 88, 1774, 1773, 132,
-	152, 1772, 129, 1754, 1744, 1743, 1742, 1740, 1738, 121,
-	1737,
-}
-
-//line mysql_sql.y:6272
+	0, 101, 2262, 2234, 108, 106, 2227, 2226, 2225, 2224,
+	2223, 2222, 2221, 2220, 2219, 2217, 2215, 2214, 2213, 2212,
+	2211, 2210, 2208, 2207, 2206, 2205, 2204, 2203, 2202, 2200,
+	2199, 2196, 2195, 2193, 104, 2189, 2188, 2187, 2185, 2184,
+	2181, 145, 2180, 2179, 2172, 2171, 2170, 2168, 2166, 2165,
+	2164, 2163, 2162, 2160, 129, 96, 102, 762, 327, 200,
+	2158, 128, 2157, 78, 185, 2156, 2155, 37, 115, 2154,
+	121, 77, 87, 146, 91, 84, 134, 2153, 2152, 2150,
+	142, 2148, 2147, 2146, 2145, 47, 2144, 67, 33, 32,
+	116, 74, 2143, 2142, 2141, 2139, 2138, 112, 2137, 51,
+	66, 2136, 2135, 2134, 2132, 2130, 114, 2129, 35, 2128,
+	62, 2127, 2126, 2125, 2123, 2122, 2121, 2120, 18, 23,
+	25, 2118, 2117, 17, 2, 2116, 2115, 76, 2112, 2106,
+	2105, 168, 2103, 2102, 2101, 149, 2100, 120, 2099, 2098,
+	2097, 2094, 2093, 95, 2092, 2091, 41, 28, 8, 2090,
+	59, 2089, 2088, 2087, 42, 2086, 2085, 2084, 94, 68,
+	111, 88, 2083, 2080, 79, 136, 24, 103, 0, 139,
+	64, 2075, 133, 131, 2073, 85, 195, 141, 43, 2071,
+	63, 56, 2067, 2066, 29, 61, 12, 26, 124, 92,
+	2065, 11, 75, 2064, 100, 2063, 118, 1, 93, 2048,
+	138, 2046, 2045, 119, 2044, 2043, 48, 127, 2042, 2041,
+	2040, 34, 2037, 39, 19, 2035, 143, 150, 2034, 2031,
+	2030, 122, 82, 71, 2029, 2028, 65, 2027, 110, 72,
+	117, 2024, 706, 113, 49, 31, 2023, 144, 2020, 167,
+	161, 130, 2019, 2009, 148, 1548, 147, 2004, 135, 16,
+	2000, 1998, 14, 1997, 21, 1994, 1993, 1992, 1991, 6,
+	1990, 1989, 1988, 3, 5, 1986, 4, 98, 1979, 52,
+	53, 83, 1977, 80, 1976, 1961, 1960, 1952, 1946, 303,
+	1945, 1943, 1942, 1940, 1931, 1930, 1923, 73, 1921, 1920,
+	1918, 1917, 50, 1916, 1915, 1911, 1910, 1908, 36, 1907,
+	1905, 15, 1904, 22, 1903, 1902, 1900, 10, 1898, 1897,
+	1896, 13, 1895, 1894, 7, 9, 1893, 1892, 44, 40,
+	38, 70, 69, 1891, 20, 1890, 89, 1887, 1885, 126,
+	125, 99, 1883, 1882, 140, 166, 1881, 137, 1880, 1878,
+	1877, 155, 1876, 132, 1875, 1873,
+}
+
+//line mysql_sql.y:6625
 type yySymType struct {
 	union interface{}
 	id    int
@@ -2984,6 +3343,16 @@ func (st *yySymType) aliasedTableExprUnion() *tree.AliasedTableExpr {
 	return v
 }
 
+func (st *yySymType) alterTableOptionUnion() tree.AlterTableOption {
+	v, _ := st.union.(tree.AlterTableOption)
+	return v
+}
+
+func (st *yySymType) alterTableOptionsUnion() []tree.AlterTableOption {
+	v, _ := st.union.([]tree.AlterTableOption)
+	return v
+}
+
 func (st *yySymType) assignmentUnion() *tree.Assignment {
 	v, _ := st.union.(*tree.Assignment)
 	return v
@@ -3119,6 +3488,16 @@ func (st *yySymType) fieldsListUnion() []*tree.Fields {
 	return v
 }
 
+func (st *yySymType) frameBoundUnion() *tree.FrameBound {
+	v, _ := st.union.(*tree.FrameBound)
+	return v
+}
+
+func (st *yySymType) frameClauseUnion() *tree.FrameClause {
+	v, _ := st.union.(*tree.FrameClause)
+	return v
+}
+
 func (st *yySymType) fromUnion() *tree.From {
 	v, _ := st.union.(*tree.From)
 	return v
@@ -3539,6 +3918,11 @@ func (st *yySymType) varExprUnion() *tree.VarExpr {
 	return v
 }
 
+func (st *yySymType) varExprsUnion() []*tree.VarExpr {
+	v, _ := st.union.([]*tree.VarExpr)
+	return v
+}
+
 func (st *yySymType) whenClauseUnion() *tree.When {
 	v, _ := st.union.(*tree.When)
 	return v
@@ -3554,6 +3938,11 @@ func (st *yySymType) whereUnion() *tree.Where {
 	return v
 }
 
+func (st *yySymType) windowSpecUnion() *tree.WindowSpec {
+	v, _ := st.union.(*tree.WindowSpec)
+	return v
+}
+
 func (st *yySymType) withClauseUnion() *tree.With {
 	v, _ := st.union.(*tree.With)
 	return v
@@ -3565,244 +3954,258 @@ func (st *yySymType) zeroFillOptUnion() bool {
 }
 
 var yyR1 = [...]int{
-	0, 327, 2, 2, 1, 1, 1, 1, 1, 1,
+	0, 342, 2, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 47, 302, 302, 301, 301, 300, 300, 299, 299,
-	299, 298, 298, 298, 297, 297, 296, 296, 294, 294,
-	295, 293, 292, 292, 290, 290, 288, 288, 289, 289,
-	283, 283, 286, 286, 284, 284, 284, 284, 287, 282,
-	282, 282, 281, 281, 46, 46, 46, 220, 220, 45,
-	45, 234, 234, 234, 234, 234, 232, 232, 232, 232,
-	231, 231, 230, 230, 235, 235, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 40,
-	40, 40, 40, 43, 44, 228, 228, 228, 228, 228,
-	229, 229, 229, 41, 42, 42, 219, 219, 224, 224,
-	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 218, 218, 227, 227, 227, 226, 226, 225, 225,
-	34, 34, 34, 37, 36, 217, 217, 217, 217, 217,
-	217, 217, 217, 35, 35, 35, 35, 35, 35, 33,
-	33, 32, 216, 216, 215, 39, 39, 39, 39, 38,
-	38, 38, 38, 38, 38, 38, 38, 38, 157, 157,
-	157, 321, 321, 322, 323, 324, 324, 324, 48, 7,
-	31, 31, 265, 265, 168, 168, 169, 169, 167, 167,
-	167, 167, 167, 167, 268, 269, 164, 20, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 30, 328, 328,
-	328, 28, 29, 264, 264, 264, 27, 26, 25, 24,
-	24, 23, 22, 22, 161, 161, 163, 163, 159, 329,
-	329, 240, 240, 162, 162, 21, 21, 160, 160, 142,
-	158, 158, 158, 6, 8, 8, 8, 8, 8, 13,
-	12, 11, 10, 9, 5, 4, 272, 272, 272, 272,
-	272, 272, 310, 310, 310, 311, 74, 74, 69, 69,
-	273, 273, 183, 312, 312, 280, 280, 279, 279, 278,
-	278, 72, 72, 73, 73, 61, 61, 49, 49, 285,
-	285, 285, 285, 291, 291, 262, 262, 108, 108, 138,
-	138, 139, 139, 50, 50, 51, 51, 51, 51, 51,
-	51, 318, 318, 320, 320, 319, 71, 71, 67, 67,
-	68, 68, 68, 66, 66, 65, 64, 64, 63, 62,
-	62, 62, 53, 53, 52, 52, 52, 52, 52, 124,
-	124, 124, 54, 266, 266, 266, 271, 271, 121, 121,
-	122, 122, 120, 120, 55, 55, 56, 56, 56, 56,
-	119, 119, 118, 57, 57, 58, 58, 60, 60, 60,
-	60, 129, 129, 128, 128, 128, 128, 77, 77, 127,
-	126, 126, 126, 76, 76, 75, 75, 70, 70, 59,
-	59, 125, 330, 330, 123, 150, 150, 150, 156, 156,
-	149, 149, 149, 155, 155, 151, 151, 152, 152, 152,
-	3, 3, 3, 16, 16, 16, 14, 213, 213, 212,
-	212, 214, 214, 214, 214, 208, 208, 209, 209, 209,
-	209, 210, 210, 210, 211, 211, 211, 211, 207, 207,
-	206, 204, 204, 204, 205, 205, 205, 205, 205, 205,
-	153, 153, 15, 201, 201, 202, 202, 202, 203, 203,
-	195, 195, 195, 195, 19, 199, 199, 200, 200, 200,
-	200, 200, 196, 196, 198, 198, 194, 194, 194, 194,
-	194, 18, 193, 193, 191, 191, 189, 189, 190, 190,
-	188, 188, 188, 192, 192, 17, 267, 267, 236, 236,
-	239, 239, 246, 246, 247, 247, 245, 245, 252, 252,
-	251, 251, 250, 250, 249, 249, 248, 248, 243, 243,
-	242, 242, 237, 237, 237, 237, 237, 238, 238, 241,
-	241, 244, 244, 99, 99, 100, 100, 100, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 117, 117, 117, 117, 117, 308, 308, 309,
-	102, 102, 102, 106, 106, 106, 106, 106, 106, 101,
-	101, 101, 103, 103, 103, 84, 84, 83, 83, 78,
-	78, 79, 79, 80, 80, 81, 81, 82, 82, 82,
-	82, 82, 82, 222, 222, 306, 306, 307, 307, 303,
-	303, 303, 305, 305, 305, 305, 305, 304, 304, 85,
-	136, 136, 136, 154, 154, 154, 135, 135, 135, 98,
-	98, 97, 97, 95, 95, 95, 95, 95, 95, 95,
-	95, 95, 95, 95, 95, 95, 221, 221, 165, 165,
-	166, 166, 116, 114, 114, 115, 115, 115, 115, 112,
-	113, 111, 111, 111, 111, 111, 110, 110, 109, 109,
-	109, 197, 197, 107, 107, 105, 105, 105, 104, 104,
-	104, 253, 172, 172, 172, 172, 172, 172, 172, 172,
-	172, 172, 172, 172, 172, 174, 174, 174, 174, 174,
-	174, 174, 174, 174, 174, 174, 174, 174, 174, 174,
-	174, 174, 174, 174, 174, 175, 175, 180, 180, 317,
-	317, 316, 86, 86, 86, 86, 86, 86, 86, 86,
-	86, 94, 94, 94, 134, 134, 134, 134, 134, 134,
-	134, 134, 134, 134, 134, 134, 134, 134, 134, 277,
-	277, 277, 131, 131, 131, 131, 131, 313, 313, 314,
-	314, 314, 314, 314, 314, 314, 314, 314, 314, 314,
-	314, 315, 315, 315, 315, 315, 315, 315, 315, 315,
-	315, 315, 315, 315, 315, 315, 315, 315, 133, 133,
-	132, 132, 132, 132, 132, 132, 132, 132, 132, 132,
-	132, 132, 184, 184, 185, 185, 274, 274, 274, 274,
-	274, 274, 275, 275, 276, 276, 276, 276, 270, 270,
-	270, 270, 270, 270, 270, 270, 270, 270, 270, 270,
-	270, 270, 270, 270, 270, 270, 270, 270, 270, 270,
-	270, 270, 270, 270, 270, 270, 173, 173, 130, 130,
-	130, 186, 181, 181, 182, 182, 176, 176, 176, 176,
-	176, 178, 178, 178, 178, 171, 171, 171, 171, 171,
-	171, 171, 171, 171, 177, 177, 179, 179, 187, 187,
-	187, 187, 187, 187, 96, 96, 96, 96, 254, 170,
-	170, 170, 170, 170, 170, 170, 87, 87, 87, 87,
-	91, 91, 93, 93, 93, 93, 93, 93, 93, 93,
-	93, 93, 93, 93, 93, 93, 92, 92, 92, 92,
-	90, 90, 90, 90, 90, 88, 88, 88, 88, 88,
-	88, 88, 88, 88, 88, 88, 88, 88, 88, 88,
-	89, 137, 137, 255, 255, 258, 258, 256, 256, 257,
-	259, 259, 259, 260, 260, 260, 261, 261, 261, 263,
-	263, 141, 141, 141, 146, 146, 140, 140, 147, 147,
-	148, 148, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
-	143, 143, 143, 143, 143, 143, 143, 143, 143, 325,
-	325, 325, 326, 326,
+	1, 1, 1, 1, 51, 51, 53, 53, 310, 310,
+	52, 52, 49, 317, 317, 316, 316, 315, 315, 314,
+	314, 314, 313, 313, 313, 312, 312, 311, 311, 308,
+	308, 309, 307, 306, 306, 304, 304, 302, 302, 303,
+	303, 297, 297, 300, 300, 298, 298, 298, 298, 301,
+	296, 296, 296, 295, 295, 48, 48, 48, 234, 234,
+	47, 47, 248, 248, 248, 248, 248, 246, 246, 246,
+	246, 245, 245, 244, 244, 249, 249, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
+	247, 247, 247, 247, 247, 247, 247, 247, 247, 247,
+	42, 42, 42, 42, 45, 46, 242, 242, 242, 242,
+	242, 243, 243, 243, 43, 44, 44, 233, 233, 238,
+	238, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 241, 241, 241, 240, 240, 239, 239,
+	36, 36, 36, 39, 38, 232, 232, 232, 232, 232,
+	232, 232, 232, 37, 37, 37, 37, 37, 37, 35,
+	35, 34, 231, 231, 230, 41, 41, 41, 41, 40,
+	40, 40, 40, 40, 40, 40, 40, 40, 40, 171,
+	171, 171, 336, 336, 337, 338, 339, 339, 339, 50,
+	50, 50, 7, 7, 33, 107, 107, 106, 106, 106,
+	106, 106, 32, 32, 279, 279, 182, 182, 183, 183,
+	181, 181, 181, 181, 181, 181, 282, 283, 178, 20,
+	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
+	31, 31, 30, 344, 344, 344, 28, 29, 278, 278,
+	278, 27, 26, 25, 24, 24, 23, 22, 22, 175,
+	175, 177, 177, 173, 343, 343, 254, 254, 176, 176,
+	21, 21, 174, 174, 155, 172, 172, 172, 6, 8,
+	8, 8, 8, 8, 13, 12, 11, 10, 9, 5,
+	4, 286, 286, 286, 286, 286, 286, 325, 325, 325,
+	326, 79, 79, 74, 74, 287, 287, 198, 327, 327,
+	294, 294, 293, 293, 292, 292, 77, 77, 78, 78,
+	66, 66, 54, 54, 299, 299, 299, 299, 305, 305,
+	276, 276, 115, 115, 151, 151, 152, 152, 55, 55,
+	56, 56, 56, 56, 56, 56, 333, 333, 335, 335,
+	334, 76, 76, 72, 72, 73, 73, 73, 71, 71,
+	70, 69, 69, 68, 67, 67, 67, 58, 58, 57,
+	57, 57, 57, 57, 131, 131, 131, 59, 280, 280,
+	280, 285, 285, 128, 128, 129, 129, 127, 127, 60,
+	60, 61, 61, 61, 61, 126, 126, 125, 62, 62,
+	63, 63, 65, 65, 65, 65, 136, 136, 135, 135,
+	135, 135, 82, 82, 134, 133, 133, 133, 81, 81,
+	80, 80, 75, 75, 64, 64, 132, 345, 345, 130,
+	130, 164, 164, 164, 170, 170, 163, 163, 163, 169,
+	169, 165, 165, 166, 166, 166, 3, 3, 3, 16,
+	16, 16, 14, 228, 228, 227, 227, 229, 229, 229,
+	229, 223, 223, 224, 224, 224, 224, 225, 225, 225,
+	226, 226, 226, 226, 222, 222, 221, 219, 219, 219,
+	220, 220, 220, 220, 220, 220, 167, 167, 15, 216,
+	216, 217, 217, 217, 218, 218, 210, 210, 210, 210,
+	19, 214, 214, 215, 215, 215, 215, 215, 211, 211,
+	213, 213, 209, 209, 209, 209, 209, 18, 208, 208,
+	206, 206, 204, 204, 205, 205, 203, 203, 203, 207,
+	207, 17, 281, 281, 250, 250, 253, 253, 260, 260,
+	261, 261, 259, 259, 266, 266, 265, 265, 264, 264,
+	263, 263, 262, 262, 257, 257, 256, 256, 251, 251,
+	251, 251, 251, 252, 252, 255, 255, 258, 258, 104,
+	104, 105, 105, 105, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 124, 124, 124, 124, 124, 124, 124,
+	124, 124, 124, 323, 323, 324, 109, 109, 109, 113,
+	113, 113, 113, 113, 113, 108, 108, 108, 110, 110,
+	110, 89, 89, 88, 88, 83, 83, 84, 84, 85,
+	85, 86, 86, 87, 87, 87, 87, 87, 87, 236,
+	236, 321, 321, 322, 322, 318, 318, 318, 320, 320,
+	320, 320, 320, 319, 319, 90, 149, 149, 149, 168,
+	168, 168, 148, 148, 148, 103, 103, 102, 102, 100,
+	100, 100, 100, 100, 100, 100, 100, 100, 100, 100,
+	100, 100, 235, 235, 179, 179, 180, 180, 123, 121,
+	121, 122, 122, 122, 122, 119, 120, 118, 118, 118,
+	118, 118, 117, 117, 116, 116, 116, 212, 212, 114,
+	114, 112, 112, 112, 111, 111, 111, 267, 186, 186,
+	186, 186, 186, 186, 186, 186, 186, 186, 186, 186,
+	186, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 190, 190, 195, 195, 332, 332,
+	331, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	99, 99, 99, 141, 141, 141, 141, 141, 141, 141,
+	141, 141, 141, 141, 141, 141, 141, 141, 291, 291,
+	291, 142, 142, 143, 144, 144, 145, 145, 145, 146,
+	146, 147, 147, 147, 147, 147, 147, 147, 138, 138,
+	138, 138, 138, 328, 328, 329, 329, 329, 329, 329,
+	329, 329, 329, 329, 329, 329, 329, 330, 330, 330,
+	330, 330, 330, 330, 330, 330, 330, 330, 330, 330,
+	330, 330, 330, 330, 140, 140, 140, 140, 140, 157,
+	157, 157, 157, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 199, 199, 200,
+	200, 288, 288, 288, 288, 288, 288, 289, 289, 290,
+	290, 290, 290, 284, 284, 284, 284, 284, 284, 284,
+	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
+	284, 284, 284, 284, 284, 284, 284, 284, 284, 284,
+	284, 187, 187, 187, 188, 188, 188, 188, 137, 137,
+	137, 201, 196, 196, 197, 197, 191, 191, 191, 191,
+	191, 193, 193, 193, 193, 185, 185, 185, 185, 185,
+	185, 185, 185, 185, 192, 192, 194, 194, 202, 202,
+	202, 202, 202, 202, 101, 101, 101, 101, 268, 184,
+	184, 184, 184, 184, 184, 184, 184, 92, 92, 92,
+	92, 96, 96, 98, 98, 98, 98, 98, 98, 98,
+	98, 98, 98, 98, 98, 98, 98, 97, 97, 97,
+	97, 95, 95, 95, 95, 95, 93, 93, 93, 93,
+	93, 93, 93, 93, 93, 93, 93, 93, 93, 93,
+	93, 94, 150, 150, 269, 269, 272, 272, 270, 270,
+	271, 273, 273, 273, 274, 274, 274, 275, 275, 275,
+	277, 277, 154, 154, 154, 160, 160, 153, 153, 161,
+	161, 162, 162, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 158, 158, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 159, 159, 159, 159, 159, 159,
+	159, 159, 159, 159, 156, 156, 156, 156, 156, 156,
+	156, 156, 156, 156, 156, 156, 156, 156, 156, 156,
+	156, 156, 156, 156, 156, 156, 156, 156, 156, 156,
+	156, 156, 156, 156, 156, 156, 156, 156, 156, 156,
+	156, 340, 340, 340, 341, 341, 341,
 }
 
 var yyR2 = [...]int{
 	0, 1, 1, 3, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 14, 0, 2, 1, 3, 3, 3, 1, 3,
-	5, 0, 2, 3, 1, 3, 1, 1, 1, 1,
-	1, 1, 0, 3, 0, 3, 0, 3, 0, 3,
-	0, 2, 1, 2, 3, 4, 3, 3, 1, 0,
-	1, 1, 0, 1, 9, 4, 7, 0, 3, 7,
-	4, 1, 3, 3, 3, 1, 0, 1, 1, 1,
-	1, 3, 1, 4, 1, 3, 1, 2, 1, 1,
-	2, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 1, 2, 1, 2, 2, 1, 1, 1, 3,
-	2, 2, 2, 2, 2, 2, 2, 1, 1, 1,
-	1, 1, 1, 3, 6, 3, 1, 1, 1, 1,
-	1, 1, 1, 2, 4, 6, 1, 4, 1, 3,
-	3, 4, 4, 4, 3, 2, 4, 4, 2, 2,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 3,
+	1, 1, 1, 0, 4, 4, 2, 4, 1, 3,
+	3, 3, 14, 0, 2, 1, 3, 3, 3, 1,
+	3, 5, 0, 2, 3, 1, 3, 1, 1, 1,
+	1, 1, 1, 0, 3, 0, 3, 0, 3, 0,
+	3, 0, 2, 1, 2, 3, 4, 3, 3, 1,
+	0, 1, 1, 0, 1, 9, 4, 7, 0, 3,
+	7, 4, 1, 3, 3, 3, 1, 0, 1, 1,
+	1, 1, 3, 1, 4, 1, 3, 1, 2, 1,
+	1, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 1, 2, 1, 2, 2, 1, 1, 1,
+	3, 2, 2, 2, 2, 2, 2, 2, 1, 1,
+	1, 1, 1, 1, 3, 6, 3, 1, 1, 1,
+	1, 1, 1, 1, 2, 4, 6, 1, 4, 1,
+	3, 3, 4, 4, 4, 3, 3, 2, 4, 4,
+	2, 2, 2, 1, 1, 1, 1, 1, 1, 3,
 	1, 1, 1, 2, 2, 0, 4, 2, 4, 1,
 	5, 3, 2, 1, 2, 2, 4, 4, 5, 2,
 	1, 7, 1, 3, 3, 1, 1, 1, 1, 2,
-	3, 4, 7, 2, 3, 3, 4, 5, 1, 1,
-	1, 1, 3, 2, 1, 1, 1, 1, 6, 1,
-	7, 9, 0, 2, 0, 1, 1, 2, 2, 2,
-	1, 4, 2, 2, 3, 0, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 5, 1, 1,
-	1, 5, 5, 0, 1, 1, 2, 2, 3, 6,
-	7, 4, 7, 8, 0, 2, 0, 2, 2, 1,
-	1, 1, 1, 0, 1, 4, 5, 1, 3, 1,
-	1, 3, 5, 1, 1, 1, 1, 1, 1, 4,
-	4, 6, 4, 4, 6, 4, 2, 1, 5, 4,
-	4, 2, 0, 1, 3, 3, 1, 3, 1, 3,
-	1, 3, 4, 0, 1, 0, 1, 1, 3, 1,
-	1, 0, 4, 1, 3, 2, 1, 0, 8, 0,
-	4, 7, 4, 0, 2, 0, 2, 0, 2, 0,
-	4, 1, 3, 1, 1, 4, 3, 4, 5, 4,
-	5, 2, 3, 1, 3, 6, 0, 3, 0, 1,
-	2, 4, 4, 0, 1, 3, 1, 3, 2, 0,
-	1, 1, 3, 3, 1, 3, 3, 3, 3, 1,
-	2, 2, 7, 0, 1, 1, 1, 1, 0, 2,
-	0, 3, 0, 2, 1, 3, 1, 2, 3, 5,
-	0, 1, 2, 1, 3, 1, 1, 4, 4, 4,
-	3, 2, 2, 2, 3, 2, 3, 0, 2, 1,
-	1, 2, 2, 0, 1, 2, 4, 1, 3, 1,
-	4, 3, 0, 1, 2, 0, 1, 2, 1, 1,
-	0, 1, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 6, 0, 2, 1,
-	2, 2, 2, 2, 2, 0, 1, 2, 2, 2,
-	2, 1, 3, 2, 2, 2, 2, 2, 1, 3,
-	2, 1, 3, 2, 0, 3, 3, 5, 5, 4,
-	1, 1, 4, 1, 3, 1, 3, 2, 1, 1,
-	0, 1, 1, 1, 11, 0, 2, 3, 2, 3,
-	1, 1, 1, 3, 3, 4, 0, 2, 2, 2,
-	2, 5, 1, 1, 0, 3, 0, 1, 1, 2,
-	4, 4, 4, 0, 1, 10, 0, 1, 0, 6,
-	0, 4, 0, 3, 1, 3, 4, 5, 0, 3,
-	1, 3, 2, 3, 1, 2, 0, 6, 0, 2,
-	0, 2, 4, 5, 4, 5, 1, 6, 5, 0,
-	3, 0, 1, 0, 1, 1, 3, 2, 3, 3,
-	4, 4, 3, 3, 3, 3, 4, 4, 3, 3,
+	3, 4, 7, 2, 3, 3, 4, 5, 5, 1,
+	1, 1, 1, 3, 2, 1, 1, 1, 1, 3,
+	6, 5, 1, 1, 4, 1, 3, 2, 3, 2,
+	3, 5, 7, 9, 0, 2, 0, 1, 1, 2,
+	2, 2, 1, 4, 2, 2, 3, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 4, 5, 1, 1, 1, 5, 5, 0, 1,
+	1, 2, 2, 3, 6, 7, 4, 7, 8, 0,
+	2, 0, 2, 2, 1, 1, 1, 1, 0, 1,
+	4, 5, 1, 3, 1, 1, 3, 5, 1, 1,
+	1, 1, 1, 1, 4, 4, 6, 4, 4, 6,
+	4, 2, 1, 5, 4, 4, 2, 0, 1, 3,
+	3, 1, 3, 1, 3, 1, 3, 4, 0, 1,
+	0, 1, 1, 3, 1, 1, 0, 4, 1, 3,
+	2, 1, 0, 8, 0, 4, 7, 4, 0, 2,
+	0, 2, 0, 2, 0, 4, 1, 3, 1, 1,
+	4, 3, 4, 5, 4, 5, 2, 3, 1, 3,
+	6, 0, 3, 0, 1, 2, 4, 4, 0, 1,
+	3, 1, 3, 2, 0, 1, 1, 3, 3, 1,
+	3, 3, 3, 3, 1, 2, 2, 7, 0, 1,
+	1, 1, 1, 0, 2, 0, 3, 0, 2, 1,
+	3, 1, 2, 3, 5, 0, 1, 2, 1, 3,
+	1, 1, 4, 4, 4, 3, 2, 2, 2, 3,
+	2, 3, 0, 2, 1, 1, 2, 2, 0, 1,
+	2, 4, 1, 3, 1, 4, 3, 0, 1, 2,
+	6, 0, 1, 2, 1, 1, 0, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 6, 0, 2, 1, 2, 2, 2, 2,
+	2, 0, 1, 2, 2, 2, 2, 1, 3, 2,
+	2, 2, 2, 2, 1, 3, 2, 1, 3, 2,
+	0, 3, 3, 5, 5, 4, 1, 1, 4, 1,
+	3, 1, 3, 2, 1, 1, 0, 1, 1, 1,
+	11, 0, 2, 3, 2, 3, 1, 1, 1, 3,
+	3, 4, 0, 2, 2, 2, 2, 5, 1, 1,
+	0, 3, 0, 1, 1, 2, 4, 4, 4, 0,
+	1, 10, 0, 1, 0, 6, 0, 4, 0, 3,
+	1, 3, 4, 5, 0, 3, 1, 3, 2, 3,
+	1, 2, 0, 6, 0, 2, 0, 2, 4, 5,
+	4, 5, 1, 6, 5, 0, 3, 0, 1, 0,
+	1, 1, 3, 2, 3, 3, 4, 4, 3, 3,
+	3, 3, 4, 4, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	4, 5, 4, 1, 3, 3, 0, 2, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 1, 3, 0, 1, 1, 3, 1,
+	1, 2, 1, 7, 7, 7, 7, 8, 5, 0,
+	1, 0, 1, 1, 1, 1, 3, 3, 1, 1,
+	1, 1, 1, 0, 1, 3, 1, 3, 5, 1,
+	1, 1, 1, 3, 5, 0, 1, 1, 2, 1,
+	2, 2, 1, 1, 2, 2, 2, 2, 2, 1,
+	5, 6, 1, 2, 0, 1, 1, 2, 5, 0,
+	1, 1, 1, 2, 2, 3, 3, 1, 1, 2,
+	2, 2, 0, 1, 2, 2, 2, 0, 3, 0,
+	3, 1, 1, 1, 1, 1, 1, 1, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 4, 5, 4, 1, 3, 3,
-	0, 2, 2, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 1, 3, 0,
-	1, 1, 3, 1, 1, 2, 1, 7, 7, 7,
-	7, 8, 5, 0, 1, 0, 1, 1, 1, 1,
-	3, 3, 1, 1, 1, 1, 1, 0, 1, 3,
-	1, 3, 5, 1, 1, 1, 1, 3, 5, 0,
-	1, 1, 2, 1, 2, 2, 1, 1, 2, 2,
-	2, 2, 2, 1, 5, 6, 1, 2, 0, 1,
-	1, 2, 5, 0, 1, 1, 1, 2, 2, 3,
-	3, 1, 1, 2, 2, 2, 0, 1, 2, 2,
-	2, 0, 3, 0, 3, 1, 1, 1, 1, 1,
-	1, 1, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 1, 1, 1, 1, 3, 5,
-	2, 2, 2, 2, 1, 1, 2, 5, 6, 6,
-	6, 1, 1, 1, 1, 0, 2, 0, 1, 1,
-	2, 4, 1, 2, 2, 1, 2, 2, 2, 2,
-	2, 0, 1, 1, 5, 4, 4, 5, 5, 5,
-	5, 4, 5, 5, 5, 5, 5, 5, 5, 1,
-	1, 1, 4, 4, 6, 8, 6, 1, 1, 1,
+	1, 1, 3, 3, 1, 1, 3, 5, 2, 2,
+	2, 2, 1, 1, 2, 5, 6, 6, 6, 1,
+	1, 1, 1, 1, 0, 2, 0, 1, 1, 2,
+	4, 1, 2, 2, 1, 2, 2, 2, 2, 2,
+	0, 1, 1, 5, 4, 4, 5, 5, 5, 5,
+	4, 5, 5, 5, 5, 5, 5, 5, 1, 1,
+	1, 5, 5, 3, 0, 3, 0, 2, 2, 1,
+	4, 2, 2, 2, 2, 2, 2, 2, 4, 4,
+	6, 8, 6, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
-	4, 2, 2, 4, 6, 2, 2, 2, 4, 6,
-	4, 2, 0, 1, 2, 3, 1, 1, 1, 1,
-	1, 1, 0, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 2, 2, 2, 6, 8, 1,
+	1, 1, 1, 4, 2, 2, 4, 6, 2, 2,
+	2, 4, 6, 6, 4, 4, 2, 0, 1, 2,
+	3, 1, 1, 1, 1, 1, 1, 0, 2, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 2, 3, 0, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 2, 3, 3, 1, 1, 2, 1, 0, 1,
 	1, 3, 0, 1, 1, 3, 3, 3, 3, 2,
 	1, 3, 4, 3, 1, 3, 4, 4, 5, 3,
 	4, 5, 6, 1, 0, 2, 1, 1, 1, 1,
 	1, 1, 1, 1, 2, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 1, 1, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 2, 2, 2, 2,
-	1, 2, 2, 2, 2, 2, 2, 2, 2, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 4, 4,
-	1, 1, 3, 0, 1, 0, 3, 0, 3, 3,
-	0, 3, 5, 0, 3, 5, 0, 1, 1, 0,
-	1, 1, 2, 2, 0, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 1, 1,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 2,
+	2, 1, 2, 2, 2, 2, 2, 2, 2, 2,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 4,
+	4, 1, 1, 3, 0, 1, 0, 3, 0, 3,
+	3, 0, 3, 5, 0, 3, 5, 0, 1, 1,
+	0, 1, 1, 2, 2, 0, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -3837,447 +4240,477 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -327, -2, -1, -3, -4, -5, -6, -38, -20,
-	-7, -48, -32, -33, -34, -40, -45, -46, -47, -50,
-	-16, -15, -14, 8, 10, -8, -157, -21, -22, -23,
-	-24, -25, -26, -27, -28, -29, -30, -31, 181, 9,
-	49, -35, -36, -37, -41, -42, -43, -44, 283, 289,
-	326, -51, -53, -17, -18, -19, 177, -9, -10, -11,
-	-12, -13, 199, 198, 26, 197, 178, 120, 121, 123,
-	124, 30, -52, -318, 54, 179, -54, 397, 6, 442,
-	-61, 27, -83, -154, 57, -143, -145, 400, 401, 402,
-	403, 404, 405, 406, 407, 408, 409, 410, 411, 412,
-	413, 414, 415, 416, 417, 418, 419, 420, 421, 422,
-	423, 424, 425, 426, 427, 428, 429, 430, 431, 432,
-	433, 434, 435, 394, 216, 240, 243, 250, 120, 137,
-	131, 158, 150, 151, 128, 220, 221, 64, 123, 354,
-	340, 325, 126, 235, 237, 239, 242, 222, 146, 142,
-	234, 218, 140, 223, 28, 224, 163, 225, 226, 399,
-	336, 265, 358, 342, 247, 141, 337, 238, 339, 164,
-	168, 346, 289, 135, 136, 344, 348, 162, 196, 32,
-	396, 34, 208, 349, 166, 161, 157, 357, 251, 249,
-	160, 134, 156, 219, 38, 170, 169, 171, 319, 320,
-	321, 322, 227, 228, 277, 345, 153, 143, 269, 129,
-	18, 352, 203, 341, 290, 229, 244, 206, 252, 165,
-	167, 210, 215, 398, 248, 350, 139, 207, 236, 202,
-	353, 130, 283, 292, 230, 124, 241, 347, 356, 37,
-	305, 133, 127, 193, 121, 212, 217, 231, 232, 233,
-	254, 253, 245, 154, 209, 159, 132, 155, 122, 211,
-	355, 306, 441, 267, 308, 152, 149, 213, 186, 351,
-	343, 125, 312, 307, 147, 255, 438, 439, 440, 278,
-	11, -158, 19, 323, -39, 324, 181, 54, -154, -5,
-	-4, -32, -50, 184, -58, -59, -60, -123, -125, -83,
-	54, -154, -231, -201, -230, -202, -233, -203, -153, 20,
-	178, 177, 211, 10, 179, 287, 185, 8, 6, 288,
-	197, 9, 289, 291, 292, 295, 296, 297, 31, 300,
-	301, 57, 60, -154, -231, -201, 215, 222, -65, -66,
-	-124, 15, 5, 308, 214, -195, -193, -267, 194, 193,
-	76, 360, 183, 298, -328, -264, 343, 342, -162, 341,
-	334, 336, 177, 185, 344, 32, 346, 347, 337, 308,
-	125, 122, -217, 80, 130, 129, -217, 214, 29, -224,
-	318, -223, -225, 346, 347, 357, -218, 345, -141, -154,
-	58, 59, 75, 151, 148, -66, -124, -65, -52, -53,
-	-51, -53, 308, 214, 185, 184, 360, -320, 392, -319,
-	-154, -266, 20, -271, 21, 22, -1, -72, 206, -83,
-	119, -58, -135, -154, 325, 89, -39, -39, 324, -321,
-	-322, -323, -325, 181, 324, 323, 119, -83, 30, -126,
-	-127, -128, -129, 41, 45, 47, 42, 43, 44, 48,
-	-330, 23, -150, -156, 23, -151, 60, -152, -145, 57,
-	58, 59, -51, -53, 51, 55, 11, 55, 54, 443,
-	58, 285, 299, 308, 286, 298, 186, 214, 299, 214,
-	334, 186, 290, 293, 294, 335, 51, 187, 51, -281,
-	357, -49, 27, -68, 17, -54, -53, 16, 20, 21,
-	-191, 189, -191, 185, -191, 184, -329, 11, 99, 213,
-	212, 338, 335, -240, 339, 340, -162, -161, 97, -162,
-	184, 360, -265, 189, 350, 397, 128, 129, 130, -228,
-	20, 29, 317, -201, 214, 55, 89, 19, -226, 89,
-	100, -225, -225, -225, -226, -101, 29, -152, 60, 116,
-	-101, 29, 119, 30, 30, -67, -68, -54, -53, -66,
-	-65, -66, 56, 56, -265, -265, -265, -265, -265, 55,
-	-320, -71, 54, -55, -56, 107, -176, -154, 81, -178,
-	57, -171, 401, 402, 403, 404, 405, 406, 407, 409,
-	412, 414, 416, 420, 421, 422, 423, 425, 426, 427,
-	428, 433, 434, 435, 277, 308, 147, 278, -172, -174,
-	-299, -294, -170, 54, 105, 106, 113, 82, -173, -253,
-	24, 84, 368, -131, -132, -133, -134, -295, -293, 60,
-	65, 69, 71, 72, 70, 67, 118, -53, -313, -270,
-	-276, -274, 148, 200, 144, 145, 8, 111, 318, 116,
-	-277, 59, 58, 271, 75, 272, 273, 360, 268, 274,
-	189, 323, 43, 275, 276, 279, 367, 280, 44, 281,
-	270, 204, 282, 371, 370, 372, 364, 361, 359, 362,
-	363, 365, 366, -272, 33, -50, 54, 30, 54, -154,
-	-120, 12, 119, 65, 60, -39, 56, 55, -324, 71,
-	72, -326, 162, 154, -154, 54, -216, -215, -135, -59,
-	-59, -59, -59, 41, 41, 41, 46, 41, 46, 41,
-	-128, -154, -156, 56, -232, 184, 284, 210, -230, 211,
-	289, 292, -207, -206, -204, -153, 60, -202, -235, -135,
-	-153, 335, -232, -207, -206, 327, 437, -49, -176, -154,
-	-64, -63, -176, -207, 81, -201, -152, -154, -191, -83,
-	-161, -161, -163, -329, -159, -329, 335, -120, -174, -240,
-	-160, -154, -191, -207, 308, 24, 351, 352, 126, 129,
-	128, 358, -229, 317, 20, -201, -223, -219, 60, 318,
-	-206, -227, 51, 116, -278, -176, 29, -226, -226, -226,
-	-227, 115, -154, -49, -67, -49, -68, -207, -201, -154,
-	-84, -83, -155, -152, -145, -319, 23, -70, -154, -119,
-	55, -118, 11, -149, 80, 78, 79, -154, 23, 119,
-	-176, 96, -187, 89, 90, 91, 92, 93, 94, 54,
-	54, 54, 54, 54, 54, 54, 54, -185, 54, 54,
-	54, 54, 54, 54, -185, 54, 54, 54, 102, 101,
-	112, 105, 106, 107, 108, 109, 110, 111, 103, 104,
-	99, 81, 97, 98, 83, -53, -176, -182, -174, -174,
-	-174, -174, -253, -180, -176, 54, 60, 65, 54, 54,
-	-275, 54, -184, -185, 54, 60, 60, 60, 54, 54,
-	54, -174, 54, -273, -183, -312, 436, -74, 56, -69,
-	-154, -310, -311, -69, -73, -154, -66, -176, -147, -148,
-	-140, -144, -151, -152, -145, 266, 182, 20, 80, 23,
-	25, 271, 303, 83, 116, 16, 84, 148, 115, 273,
-	368, 272, 177, 47, 75, 370, 372, 371, 361, 359,
-	310, 314, 316, 313, 360, 334, 29, 10, 26, 198,
-	21, 22, 109, 179, 200, 87, 88, 201, 24, 199,
-	72, 19, 50, 11, 323, 13, 14, 274, 309, 189,
-	188, 99, 327, 185, 45, 8, 118, 27, 96, 311,
-	41, 77, 43, 97, 17, 362, 363, 31, 326, 393,
-	205, 111, 275, 276, 48, 81, 317, 70, 51, 78,
-	15, 46, 98, 180, 367, 44, 214, 315, 279, 281,
-	392, 280, 183, 6, 270, 369, 30, 197, 42, 184,
-	335, 86, 187, 71, 204, 144, 145, 5, 76, 9,
-	49, 52, 364, 365, 366, 33, 85, 12, 282, 397,
-	318, 328, 329, 330, 331, 332, 333, 172, 173, 174,
-	175, 176, 246, 192, 190, 194, 195, 436, 437, 19,
-	-39, -322, 119, -70, -120, 55, 89, -76, -75, 51,
-	52, -77, 51, -75, 41, 41, -71, -234, 107, 57,
-	55, -205, 309, 443, 58, 56, 55, -234, 187, 60,
-	60, 55, 18, 119, 55, -62, 25, 26, -208, -209,
-	315, 24, -194, 52, -189, -190, -188, -192, 29, -83,
-	-120, -120, -120, -161, -155, -163, -158, -163, -159, 119,
-	-142, -154, -208, 54, 127, 130, 130, 129, -201, 187,
-	54, 89, -227, -227, -227, 29, -153, -49, -49, 51,
-	55, 54, 56, 55, -120, -56, -57, -58, -176, -176,
-	-176, -154, -154, 107, 70, 81, -171, -181, -182, -176,
-	-130, 21, 20, -130, -130, -176, -130, 107, -182, -182,
-	56, -254, 65, -314, -315, 373, 374, 375, 376, 377,
-	378, 379, 380, 381, 382, 383, 275, 270, 276, 274,
-	268, 282, 277, 278, 147, 390, 391, 384, 385, 386,
-	387, 388, 389, -130, -130, -130, -130, -130, -130, -130,
-	-172, -172, -172, -172, -172, -172, -172, -172, -172, -172,
-	-172, -172, -179, -186, -253, 54, 99, 97, 98, 83,
-	-174, -172, -172, 56, 55, -317, -316, 85, -176, -314,
-	-181, -176, -181, 56, -182, -181, -172, -181, -130, 55,
-	54, 56, 55, 33, 119, 55, 89, 56, 55, -67,
-	119, 325, -154, 56, -66, -215, -176, -176, 54, -176,
-	11, 119, 119, -206, 16, 397, -153, -135, 187, -207,
-	-282, 188, 367, -285, 339, -176, -176, -154, -63, -213,
-	397, 317, 316, 312, -210, -211, 311, 313, 310, 314,
-	51, 260, 261, 262, 263, -188, -141, 115, 225, 151,
-	54, -120, -161, -161, -163, -154, -213, 56, 130, -207,
-	-164, 60, -219, -83, -83, -1, -154, -122, 13, 55,
-	119, 70, 56, 55, -176, -176, -176, 23, -182, 56,
-	56, 56, 56, 11, -176, -176, -176, -176, -176, -176,
-	-176, -182, -179, -174, -172, -172, -177, 201, 80, -176,
-	-175, -316, 87, -176, 55, 52, 56, 11, 56, 56,
-	52, 56, 55, 56, -176, -183, -280, -279, -278, 33,
-	-50, -69, -273, -154, -311, -278, -154, -147, -144, -152,
-	-145, 65, -67, -70, -207, 107, 107, 57, -153, 318,
-	-153, -207, -220, 397, 27, -291, 333, 328, 330, 119,
-	-212, -214, 319, 320, 321, 322, 80, -211, 60, 60,
-	60, 60, -83, -146, 89, -146, -146, -78, -79, -80,
-	-85, -81, -135, -166, -82, 192, 190, 194, -307, 76,
-	195, 246, 77, 185, -120, -120, -161, -168, -169, -167,
-	266, -268, 318, 309, 56, 56, -121, 14, 16, -58,
-	-154, 107, -176, 56, 56, 56, -86, -92, 116, 148,
-	200, 147, 146, 144, 305, 306, 140, 141, 142, 139,
-	56, -176, 56, 56, 56, 56, 56, 56, 56, 56,
-	-177, 80, -174, -171, 56, 88, -176, 86, -86, -101,
-	-176, -101, -172, 56, 56, 55, -273, 56, -153, 16,
-	23, -208, 289, 184, -262, 438, -289, 328, 16, 16,
-	-214, 65, 65, 65, 65, -211, 54, -101, -103, -152,
-	60, 116, 60, 56, 55, -87, -91, -88, -90, -89,
-	-93, -92, 148, 149, 116, 152, 154, 155, 156, 157,
-	158, 159, 160, 161, 162, 163, 30, 200, 144, 145,
-	146, 147, 164, 131, 150, 395, 172, 132, 173, 133,
-	174, 134, 175, 135, 136, 176, 137, -82, -154, 77,
-	-306, -307, -191, -306, 77, 54, -120, -167, 267, 31,
-	118, 269, 29, 265, 16, -176, -182, 56, -255, -257,
-	54, -256, 54, -255, -255, -255, -94, 136, 135, -94,
-	-259, 54, -259, -260, 54, -259, 56, -171, -176, 56,
-	56, 56, 19, 56, 56, -278, -153, -153, -220, 290,
-	-83, -108, 439, 60, 16, 60, -287, 60, -196, -198,
-	-135, 54, -99, -100, -117, 303, 216, -192, 220, 64,
-	221, 325, 222, 185, 224, 225, 226, 196, 227, 228,
-	229, 318, 230, 231, 232, 233, 286, 5, 256, -80,
-	-98, -97, -95, 70, 81, 29, 303, -96, 64, 115,
-	239, 217, 240, -116, -165, 190, 76, 77, 291, -166,
-	-261, 306, 305, -255, -256, -257, -255, -255, 54, 54,
-	-255, -258, 54, -255, -255, -303, -304, -154, -304, -154,
-	-303, -303, -191, -176, 65, -269, -164, 65, 65, 65,
-	65, -176, -283, -240, -138, 440, 65, 60, 330, 56,
-	55, -255, -176, -236, 206, 55, -117, -146, -146, -141,
-	115, -146, -146, -146, -146, 223, 223, -146, -146, -146,
-	-146, -146, -146, -146, -146, -146, -146, -146, -146, -146,
-	-146, 54, -95, 70, -172, 60, -103, -104, 29, 238,
-	234, -105, 29, 218, 219, -107, 54, 246, 77, 77,
-	-83, -263, 307, -137, 60, -137, 65, 54, 52, 255,
-	54, 54, 54, -304, 56, 268, 56, 56, 56, 55,
-	56, 55, 56, -290, 333, -286, -284, 328, 329, 330,
-	331, 54, 16, -199, -198, -62, 56, 16, -117, 65,
-	65, -146, -146, 65, 60, 60, 60, -146, -146, 65,
-	60, -156, 65, 65, 65, 65, 29, 60, -106, 29,
-	234, 238, 235, 236, 237, 65, 29, 65, 29, 65,
-	29, -154, 54, -308, -309, 60, 65, 54, -197, 54,
-	56, 55, 56, 56, -196, -305, 260, 261, 262, 264,
-	263, -305, -196, -196, -196, 54, -222, -221, 247, 81,
-	65, 65, -292, 188, -288, 332, -284, 16, 330, 16,
-	16, -139, -154, -287, -200, 196, 64, 397, 258, 259,
-	-62, -237, 248, 249, -238, -244, 251, -101, -101, 60,
-	60, -102, 217, -84, 56, 55, 89, 56, -176, -110,
-	-109, 393, -196, 60, 56, 56, 56, 56, -196, 247,
-	56, 56, -298, 54, 65, -289, 16, -287, 16, -287,
-	-287, 56, 55, -146, 60, 257, -242, 252, 54, -240,
-	54, -240, 77, 261, 218, 219, 56, -309, 60, 56,
-	-114, -115, -112, -113, 51, 337, 244, 245, 56, -199,
-	-199, -199, -199, 56, -302, 30, 56, -297, -296, -136,
-	-293, -154, 333, 60, -287, -154, 65, -152, -239, 253,
-	65, -172, 54, -172, 54, -241, 250, 54, -221, -113,
-	51, -112, 51, 10, 9, -116, -301, -300, -299, 56,
-	55, 119, -246, 54, 16, 56, -235, 56, -235, 54,
-	89, -172, -111, 241, 242, 30, 129, -111, 55, 89,
-	-296, -154, -247, -245, 206, -238, 56, 56, -235, 65,
-	56, 70, 29, 243, -300, 29, -176, 119, 56, 55,
-	57, -243, 254, 56, -154, -245, -248, 33, 65, -252,
-	-249, 54, -117, 208, -252, -117, -251, -250, 253, 209,
-	56, 55, 57, 54, -250, -249, -182, 56,
+	-1000, -342, -2, -1, -3, -4, -5, -6, -40, -20,
+	-7, -50, -34, -35, -36, -42, -47, -48, -49, -51,
+	-53, -52, -55, -16, -15, -14, 8, 10, -8, -171,
+	-21, -22, -23, -24, -25, -26, -27, -28, -29, -30,
+	-31, -32, -33, 188, 9, 49, -37, -38, -39, -43,
+	-44, -45, -46, 289, 295, 332, 137, 293, 138, 186,
+	-56, -58, -17, -18, -19, 184, -9, -10, -11, -12,
+	-13, 206, 205, 26, 204, 185, 126, 127, 129, 130,
+	30, -57, -333, 54, -59, 410, 6, 456, -66, 27,
+	-88, -168, 57, -156, -159, 413, 414, 415, 416, 417,
+	418, 419, 420, 421, 422, 423, 424, 425, 426, 427,
+	428, 429, 430, 431, 432, 433, 434, 435, 436, 437,
+	438, 439, 440, 441, 442, 449, 443, 444, 445, 446,
+	447, 448, 407, 138, 137, 224, 248, 251, 258, 126,
+	145, 139, 165, 157, 158, 134, 228, 229, 64, 129,
+	360, 346, 331, 132, 243, 245, 247, 250, 230, 154,
+	150, 242, 226, 148, 231, 28, 232, 170, 233, 234,
+	412, 342, 273, 364, 348, 255, 149, 343, 246, 345,
+	171, 175, 352, 295, 143, 144, 350, 354, 169, 203,
+	32, 409, 34, 215, 355, 173, 168, 164, 363, 259,
+	257, 167, 142, 163, 227, 38, 177, 176, 178, 325,
+	326, 327, 328, 235, 236, 78, 351, 160, 151, 277,
+	135, 18, 358, 210, 347, 296, 237, 252, 213, 260,
+	172, 174, 217, 223, 411, 256, 356, 147, 214, 244,
+	209, 359, 136, 289, 298, 238, 130, 249, 353, 362,
+	37, 311, 141, 133, 200, 127, 219, 220, 225, 239,
+	240, 241, 262, 261, 253, 161, 216, 166, 140, 162,
+	128, 218, 361, 312, 455, 275, 314, 159, 156, 221,
+	193, 357, 349, 131, 318, 313, 77, 263, 452, 453,
+	454, 79, 401, 402, 403, 404, 405, 11, -172, 19,
+	329, -41, 330, 188, 54, -168, -5, -4, -34, -55,
+	191, -63, -64, -65, -130, -132, -88, 54, -168, -245,
+	-216, -244, -217, -247, -218, -167, 20, 185, 184, 218,
+	10, 186, 293, 192, 8, 6, 294, 204, 9, 295,
+	297, 298, 301, 302, 303, 31, 306, 307, 57, 60,
+	-168, -245, -216, 223, 230, -168, -168, 137, 137, 314,
+	222, 192, 191, 366, -70, -71, -131, 15, 5, 314,
+	222, -210, -208, -281, 201, 200, 80, 366, 190, 304,
+	220, -344, -278, 349, 348, -176, 347, 340, 342, 184,
+	192, 350, 32, 352, 353, 343, 191, 314, 131, 128,
+	-232, 84, 136, 135, -232, 222, 29, -238, 324, -237,
+	-239, 352, 353, 363, 58, 59, 351, -154, -168, 75,
+	158, 155, -71, -131, -70, -57, -58, -56, -58, -335,
+	398, -334, -168, -280, 20, -285, 21, 22, -1, -77,
+	213, -88, 125, -63, -148, -168, 331, 93, -41, -41,
+	330, -336, -337, -338, -340, 188, 330, 329, 125, -88,
+	30, -133, -134, -135, -136, 41, 45, 47, 42, 43,
+	44, 48, -345, 23, -164, 23, -170, -165, 60, -166,
+	-159, 57, 58, 59, -56, -58, 51, 55, 11, 55,
+	54, 457, 58, 291, 305, 314, 292, 304, 193, 222,
+	305, 222, 340, 193, 296, 299, 300, 341, 51, 194,
+	51, -295, 363, 11, 52, -168, -168, -279, 196, -279,
+	-279, -279, -279, -54, 27, -73, 17, -59, -58, 16,
+	20, 21, -206, 196, -206, 192, -206, 191, -343, 11,
+	103, -343, 221, 219, 344, 341, -254, 345, 346, -176,
+	-175, 101, -176, 191, 366, -88, -279, 356, 410, 134,
+	135, 136, -242, 20, 29, 323, -216, 222, 55, 93,
+	19, -240, 93, 104, -239, -239, -239, -240, -240, -108,
+	29, -166, 60, 122, -108, 29, 125, 30, 30, -72,
+	-73, -59, -58, -71, -70, -71, 56, 56, 55, -335,
+	-76, 54, -60, -61, 113, -191, -168, 85, -193, 57,
+	-185, 413, 414, 415, 416, 417, 418, 419, 420, 422,
+	423, 424, 425, 427, 429, 430, 431, 433, 434, 435,
+	436, 437, 438, 439, 440, 441, 449, 443, 446, 447,
+	448, 78, 314, 77, 79, -186, -189, -314, -308, -184,
+	54, 111, 112, 119, 86, -187, -267, 24, 88, 374,
+	-138, -139, -140, -141, -142, -309, -307, 60, 65, 69,
+	71, 72, 70, 67, 61, 124, -58, -328, -284, -290,
+	-288, 155, 207, 152, 153, 8, 117, 324, 122, -157,
+	-291, 59, 58, 279, 75, 280, 281, 366, 276, 282,
+	196, 329, 43, 283, 284, 285, 373, 286, 44, 287,
+	278, 211, 288, 377, 376, 378, 370, 367, 365, 368,
+	369, 371, 372, -286, 33, -55, 54, 30, 54, -168,
+	-127, 12, 125, 65, 60, -341, 169, 161, 57, -41,
+	56, 55, -339, 71, 72, -341, -168, 54, 346, -231,
+	-230, -148, -64, -64, -64, -64, 41, 41, 41, 46,
+	41, 46, 41, -135, -168, 399, -170, 56, -246, 191,
+	290, 217, -244, 218, 295, 298, -222, -221, -219, -167,
+	60, -217, -249, -148, -167, 341, -246, -222, -221, 333,
+	60, -307, -310, -307, -222, 24, -216, -168, -89, -88,
+	-169, -166, -159, 451, -54, -191, -168, -69, -68, -191,
+	-222, 85, -216, -166, -168, -206, -88, -88, -175, -175,
+	-177, -343, -173, -343, 341, -127, -189, -254, -174, -168,
+	-206, -107, -106, 189, 186, 187, -222, 314, 357, 358,
+	132, 135, 134, 364, -243, 323, 20, -216, -237, -233,
+	60, 324, -221, -241, 51, 122, -292, -191, 29, -240,
+	-240, -240, -241, -241, 121, -168, -54, -72, -54, -73,
+	-334, 23, -75, -168, -126, 55, -125, 11, -163, 84,
+	82, 83, -168, 23, 125, -191, 100, -202, 93, 94,
+	95, 96, 97, 98, 54, 54, 54, 54, 54, 54,
+	54, 54, -200, 54, 54, 54, 54, -200, 54, 54,
+	54, -200, 54, 54, 54, 54, 54, 106, 105, 118,
+	111, 112, 113, 114, 115, 116, 117, 107, 108, 103,
+	85, 101, 102, 87, 109, 110, -58, -191, -197, -189,
+	-189, -189, -189, -267, -195, -191, 54, 400, 400, 60,
+	-188, 65, 69, 112, -148, 54, 54, -289, 54, -199,
+	-200, 54, 60, 60, 60, 54, 54, 54, -189, 54,
+	54, -287, -198, -327, 450, -79, 56, -74, -168, -325,
+	-326, -74, -78, -168, -71, -191, -161, -162, -153, -158,
+	-165, -166, -159, 274, 189, 20, 84, 23, 25, 279,
+	309, 87, 122, 16, 88, 155, 121, 281, 374, 280,
+	184, 47, 75, 376, 378, 377, 367, 365, 316, 320,
+	322, 319, 366, 340, 29, 10, 26, 205, 21, 22,
+	115, 186, 207, 91, 92, 208, 24, 206, 72, 19,
+	50, 11, 329, 13, 14, 282, 315, 196, 195, 103,
+	333, 192, 45, 8, 124, 27, 100, 317, 41, 81,
+	43, 101, 17, 368, 369, 31, 332, 406, 212, 117,
+	283, 284, 48, 85, 323, 70, 399, 51, 82, 15,
+	46, 102, 187, 373, 44, 222, 321, 285, 287, 398,
+	286, 190, 6, 278, 375, 30, 204, 42, 191, 341,
+	90, 194, 71, 211, 152, 153, 5, 80, 9, 49,
+	52, 370, 371, 372, 33, 89, 12, 288, 410, 324,
+	334, 335, 336, 337, 338, 339, 179, 180, 181, 182,
+	183, 254, 199, 197, 201, 202, 450, 451, 400, 19,
+	-41, -41, -337, 125, -75, -75, -127, 55, 93, -81,
+	-80, 51, 52, -82, 51, -80, 41, 41, -76, 153,
+	-248, 113, 57, 55, -220, 315, 457, 58, 56, 55,
+	-248, 194, 60, 55, 51, 55, 60, 55, 18, 125,
+	55, -67, 25, 26, -223, -224, 321, 24, -209, 52,
+	-204, -205, -203, -207, 29, -88, -127, -127, -127, -175,
+	-169, -177, -172, -177, -173, 125, -155, -168, 55, -90,
+	198, -148, -168, 198, 198, -223, 54, 133, 136, 136,
+	135, -216, 194, 54, 93, -241, -241, -241, 29, -167,
+	-54, -54, 54, 56, 55, -127, -61, -62, -63, -191,
+	-191, -191, -168, -168, 113, 70, 85, -185, -196, -197,
+	-191, -137, 21, 20, -137, -137, -191, -137, 113, -197,
+	-197, 56, -268, 65, -329, -330, 379, 380, 381, 382,
+	383, 384, 385, 386, 387, 388, 389, 283, 278, 284,
+	282, 276, 288, 78, 79, 77, 396, 397, 390, 391,
+	392, 393, 394, 395, -137, -137, -186, -137, -137, -330,
+	-197, -137, -137, -137, -186, -186, -186, -186, -186, -186,
+	-186, -186, -186, -186, -186, -186, -194, -201, -267, 54,
+	103, 101, 102, 87, -189, -186, -186, 60, 60, 56,
+	55, -332, -331, 89, -191, 54, 54, -329, -329, -188,
+	-196, -191, -196, 56, -197, -196, -186, -196, -191, -137,
+	55, 54, 56, 55, 33, 125, 55, 93, 56, 55,
+	-72, 125, 331, -168, 56, -71, -230, -191, -191, 54,
+	-191, 60, 11, 125, 125, -221, 16, 410, -167, -148,
+	194, -222, -296, 195, 373, -307, -88, -88, -299, 345,
+	-191, -191, -168, -68, -228, 410, 323, 322, 318, -225,
+	-226, 317, 319, 316, 320, 51, 268, 269, 270, 271,
+	-203, -154, 121, 233, 158, 54, -127, -175, -175, -177,
+	-168, -106, -90, -92, -96, -93, -95, -94, -98, -97,
+	155, 156, 122, 159, 161, 162, 163, 164, 165, 166,
+	167, 168, 169, 170, 30, 207, 152, 153, 154, 77,
+	171, 139, 157, 408, 179, 140, 180, 141, 181, 142,
+	182, 143, 144, 183, 145, 148, 149, 150, 147, -168,
+	-168, -228, 56, 136, -222, -178, 60, -233, -1, -168,
+	-129, 13, 55, 125, 70, 56, 55, -191, -191, -191,
+	23, -197, 56, 56, 56, 56, 11, -191, -191, 103,
+	-191, -191, 55, 56, -191, -191, -191, -197, -194, -189,
+	-186, -186, -192, 208, 84, -191, -190, -331, 91, -191,
+	55, 52, -143, -144, 213, -143, 56, 11, 56, 56,
+	52, 56, 55, 56, 55, -191, -198, -294, -293, -292,
+	33, -55, -74, -287, -168, -326, -292, -168, -161, -158,
+	-166, -159, 65, -72, -75, -164, 23, -222, 113, 113,
+	57, -167, 324, -167, -222, -234, 410, 27, -305, 339,
+	334, 336, 125, -227, -229, 325, 326, 327, 328, 84,
+	-226, 60, 60, 60, 60, -88, -160, 93, -160, -160,
+	-83, -84, -85, -90, -86, -180, -87, 199, 197, 201,
+	-322, 80, 202, 254, 81, 192, -127, -127, -175, -103,
+	-102, -100, 70, 85, 29, 309, -101, 64, 121, 247,
+	225, 248, -123, -179, 197, 80, 81, 297, -180, -275,
+	312, 311, -269, -271, 54, -270, 54, -271, -269, -269,
+	54, 54, -269, -272, 54, -269, -269, -273, 54, -273,
+	-274, 54, -273, 194, -182, -183, -181, 274, -282, 324,
+	315, 56, 56, -128, 14, 16, -63, -168, 113, -191,
+	56, 56, 56, -91, -97, 122, 155, 207, 77, 154,
+	152, 311, 312, 56, -191, 56, 56, -191, 56, 56,
+	-191, 56, 56, 56, 56, -192, 84, -189, -185, 56,
+	92, -191, 90, -91, -108, 56, -71, 16, 56, -191,
+	-108, -186, -191, 56, 56, 55, -287, 56, -167, 16,
+	23, -223, 295, 191, -276, 452, -303, 334, 16, 16,
+	-229, 65, 65, 65, 65, -226, 54, -108, -110, -166,
+	60, 122, 60, 56, 55, -87, -168, 81, -321, -322,
+	-206, -321, 81, 54, -127, -100, 70, -186, 60, -110,
+	-111, 29, 246, 242, -112, 29, 226, 227, -114, 54,
+	254, 81, 81, -88, -277, 313, 65, 65, -150, 60,
+	-150, 65, 65, 65, -168, -181, 275, 31, 124, 277,
+	29, 273, 16, -191, -197, 56, -269, -270, -269, -269,
+	-269, -99, 144, 143, -99, 56, 56, 55, -185, -191,
+	56, 56, -145, 405, 256, -197, 56, 19, 56, 56,
+	56, -292, -167, -167, -234, 296, -88, -115, 453, 60,
+	16, 60, -301, 60, -211, -213, -148, 54, -104, -105,
+	-124, 309, 224, -207, 228, 64, 229, 331, 230, 192,
+	232, 233, 234, 203, 235, 236, 237, 324, 238, 239,
+	240, 241, 292, 5, 264, -85, -318, -319, -168, -319,
+	-168, -318, -318, -206, -191, 65, 54, -212, 54, 56,
+	56, 56, 55, 56, 56, 56, 55, 56, 55, 65,
+	-283, -178, -191, -146, -147, 87, 403, 404, -184, -187,
+	124, -146, -191, -297, -254, -151, 454, 65, 60, 336,
+	56, 55, -269, -191, -250, 213, 55, -124, -160, -160,
+	-154, 121, -160, -160, -160, -160, 231, 231, -160, -160,
+	-160, -160, -160, -160, -160, -160, -160, -160, -160, -160,
+	-160, -160, 54, 54, 52, 263, 54, 54, 54, -319,
+	56, 56, -191, -117, -116, 406, -211, 60, 65, 65,
+	276, 56, -147, 401, 402, 450, 401, 402, 401, 402,
+	56, -304, 339, -300, -298, 334, 335, 336, 337, 54,
+	16, -214, -213, -67, 56, 16, -124, 65, 65, -160,
+	-160, 65, 60, 60, 60, -160, -160, 65, 60, -170,
+	65, 65, 65, 65, 29, 60, -113, 29, 242, 246,
+	243, 244, 245, 65, 29, 65, 29, 65, 29, -168,
+	54, -323, -324, 60, -211, -320, 268, 269, 270, 272,
+	271, -320, -211, -211, -211, 54, -236, -235, 255, 85,
+	56, -121, -122, -119, -120, 51, 343, 252, 253, 56,
+	56, 56, 84, -306, 195, -302, 338, -298, 16, 336,
+	16, 16, -152, -168, -301, -215, 203, 64, 410, 266,
+	267, -67, -251, 256, 257, -252, -258, 259, -108, -108,
+	60, 60, -109, 225, -89, 56, 55, 93, 56, 56,
+	56, 56, -211, 255, -235, -120, 51, -119, 51, 10,
+	9, -147, -313, 54, 65, -303, 16, -301, 16, -301,
+	-301, 56, 55, -160, 60, 265, -256, 260, 54, -254,
+	54, -254, 81, 269, 226, 227, 56, -324, 60, -214,
+	-214, -214, -214, 56, -118, 249, 250, 30, 135, -118,
+	-317, 30, 56, -312, -311, -149, -307, -168, 339, 60,
+	-301, -168, 65, -166, -253, 261, 65, -186, 54, -186,
+	54, -255, 258, 54, -123, 70, 29, 251, -316, -315,
+	-314, 56, 55, 125, -260, 54, 16, 56, -249, 56,
+	-249, 54, 93, -186, 55, 93, -311, -168, -261, -259,
+	213, -252, 56, 56, -249, 65, 56, -315, 29, -191,
+	125, 56, 55, 57, -257, 262, 56, -168, -259, -262,
+	33, 65, -266, -263, 54, -124, 215, -266, -124, -265,
+	-264, 261, 216, 56, 55, 57, 54, -264, -263, -197,
+	56,
 }
 
 var yyDef = [...]int{
-	20, -2, 1, 2, 4, 5, 6, 7, 8, 9,
+	23, -2, 1, 2, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-	440, 441, 442, 0, 0, 273, 0, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 209, 0, 0,
-	180, 160, 161, 162, 119, 120, 121, 122, 0, 0,
-	0, 333, -2, 443, 444, 445, -2, 274, 275, 276,
-	277, 278, 198, 199, 200, -2, 0, 173, 0, 165,
-	165, 0, 353, 0, 0, 0, 364, 0, 373, 20,
-	311, 0, 316, 617, 653, 654, 655, 1303, 1304, 1305,
-	1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315,
-	1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325,
-	1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335,
-	1336, 1337, 1338, 1146, 1147, 1148, 1149, 1150, 1151, 1152,
-	1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162,
-	1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172,
-	1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182,
-	1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192,
-	1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202,
-	1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212,
-	1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222,
-	1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
-	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
-	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
-	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
-	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272,
-	1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
-	1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292,
-	1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302,
-	0, 189, 0, 0, 193, 0, 0, 0, 270, 185,
-	186, 187, 188, 0, 0, 395, 396, 419, 422, 425,
-	0, 179, 0, 0, 80, 483, 82, 485, 0, 86,
-	88, 89, -2, 93, 94, 95, 96, 97, 98, 99,
-	0, 101, 1195, 103, 1256, 106, 107, 108, 0, 117,
-	118, -2, -2, 480, 0, 0, 1245, 62, -2, 0,
-	0, 0, 369, 514, 514, 0, 514, 0, 491, 492,
-	493, 512, 513, 527, 0, 0, 246, 247, 0, 263,
-	254, 263, 0, 238, 239, 240, 244, 245, 264, 212,
-	174, 175, 164, 0, 169, 0, 163, 0, 0, 133,
-	0, 138, 0, 1194, 1260, 1210, 0, 1228, 0, 158,
-	151, 152, 991, 1156, 0, 348, 0, 354, 353, 353,
-	0, 353, 212, 212, 212, 212, 212, 341, 0, 343,
-	346, 0, 374, 375, 376, 377, 3, 0, 0, 315,
-	0, 382, 190, 656, 0, 0, 194, 195, 0, 0,
-	201, 0, 204, 1339, 1340, 1341, 0, 0, 0, 0,
-	0, 0, 0, 410, 0, 0, 409, 0, 0, 0,
-	0, 423, 424, 426, 0, 428, 429, 435, 436, 437,
-	438, 439, 0, 353, 76, 0, 0, 0, 0, 0,
-	487, 87, 116, 90, 91, 0, 111, 113, 115, 114,
-	100, 112, 102, 104, 105, 110, 76, 0, 0, 0,
-	63, 336, 0, 317, 0, 366, 368, 0, 370, 371,
-	0, 0, 0, 0, 0, 514, 0, 259, 260, 254,
-	254, 248, 256, 0, 261, 262, 0, 382, 0, 0,
-	0, 514, 0, 0, 0, 0, 167, 0, 172, 123,
-	128, 126, 127, 129, 0, 0, 0, 0, 0, 156,
-	157, 0, 0, 0, 0, 145, 148, 609, 610, 611,
-	149, 150, 0, 992, 993, 317, 349, 365, 367, 348,
-	-2, 0, 362, 363, 0, 0, 0, 0, 0, 0,
-	342, 0, 0, 390, 384, 386, 430, 28, 0, 890,
-	653, 894, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1312,
-	1315, 1317, 1319, -2, -2, -2, 1326, -2, -2, 1330,
-	1331, 1336, 1337, 1338, -2, -2, -2, -2, 903, 724,
-	725, 726, 727, 0, 0, 0, 0, 0, 734, 735,
-	0, 747, 0, 741, 742, 743, 744, 38, 39, 919,
-	920, 921, 922, 923, 924, 925, 857, 711, 0, 0,
-	842, 832, 0, 852, 870, 871, 0, 0, 0, 0,
-	0, 40, 41, 848, 849, 850, 851, 853, 854, 855,
-	856, 858, 859, 860, 861, 864, 865, 866, 867, 868,
-	869, 872, 874, 844, 845, 846, 847, 836, 837, 838,
-	839, 840, 841, 285, 303, 287, 0, 292, 0, 618,
-	353, 0, 0, 191, 0, 196, 0, 0, 203, 205,
-	206, 207, 1342, 1343, 271, 0, 382, 182, 0, 413,
-	407, 0, 400, 411, 412, 403, 0, 405, 0, 401,
-	402, 346, 427, 421, 0, 77, 78, 79, 81, 92,
-	0, 0, 70, 468, 474, 471, 481, 484, 0, 84,
-	486, 109, 0, 65, 0, 0, 0, 337, 350, 28,
-	355, 356, 359, 455, 0, 482, 506, -2, 0, 382,
-	382, 382, 254, 0, 256, 0, 256, 251, 255, 0,
-	265, 267, 0, 455, 1287, 213, 176, 177, 0, 0,
-	171, 0, 0, 130, 131, 132, 139, 134, 136, 0,
-	0, 140, 153, 154, 155, 309, 310, 0, 0, 0,
-	144, 0, 159, 335, 317, 339, 317, 279, 280, 0,
-	282, 615, 283, 433, 434, 344, 0, 0, 417, 382,
-	0, 391, 0, 387, 0, 0, 0, 431, 0, 0,
-	889, 0, 0, 908, 909, 910, 911, 912, 913, 882,
-	878, 878, 878, 0, 878, 0, 0, 818, 0, 0,
-	878, 878, 878, 878, 819, 878, 878, 878, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, -2, 884, 0, 730, 731,
-	732, 733, 736, 0, 748, 0, 876, 0, 882, 882,
-	821, 0, 822, 833, 0, 825, 826, 827, 882, 0,
-	882, 831, 878, 286, 300, 0, 304, 0, 0, 296,
-	298, 291, 293, 0, 0, 313, 348, 383, 657, 0,
-	998, -2, 1000, -2, -2, 1002, 1003, 1004, 1005, 1006,
-	1007, 1008, 1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016,
-	1017, 1018, 1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026,
-	1027, 1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035, 1036,
-	1037, 1038, 1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046,
-	1047, 1048, 1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056,
-	1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064, 1065, 1066,
-	1067, 1068, 1069, 1070, 1071, 1072, 1073, 1074, 1075, 1076,
-	1077, 1078, 1079, 1080, 1081, 1082, 1083, 1084, 1085, 1086,
-	1087, 1088, 1089, 1090, 1091, 1092, 1093, 1094, 1095, 1096,
-	1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104, 1105, 1106,
-	1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115, 1116,
-	1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125, 1126,
-	1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135, 1136,
-	1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145, 0,
-	197, 202, 0, 0, 353, 0, 0, 397, 414, 0,
-	0, 398, 0, 399, 404, 406, 420, 0, 71, 75,
-	0, 470, 0, 0, 473, 83, 0, 0, 0, 59,
-	319, 0, 0, 0, 0, 358, 360, 361, 447, 456,
-	0, 515, 0, 0, 511, -2, 518, 0, 524, 0,
-	237, 241, 242, 382, 257, 254, 258, 254, 256, 0,
-	266, 269, 447, 0, 178, 166, 168, 0, 125, 0,
-	0, 0, 141, 142, 143, 146, 147, 338, 340, 0,
-	0, 20, 347, 0, 380, 385, 392, 393, 886, 887,
-	888, 432, 29, 388, 891, 0, 893, 0, 883, 884,
-	0, 879, 880, 0, 0, 0, 0, 0, 0, 0,
-	834, 0, 918, 0, 789, 790, 791, 792, 793, 794,
-	795, 796, 797, 798, 799, 800, 801, 802, 803, 804,
-	805, 806, 807, 808, 809, 810, 811, 812, 813, 814,
-	815, 816, 817, 0, 0, 0, 0, 0, 0, 0,
-	712, 713, 714, 715, 716, 717, 718, 719, 720, 721,
-	722, 723, 895, 906, 907, 0, 0, 0, 0, 0,
-	904, 899, 0, 728, 0, 745, 749, 0, 0, 877,
-	0, 884, 0, 843, 0, 0, 0, 0, 0, 303,
-	305, 0, 0, 303, 0, 0, 0, 312, 0, 284,
-	0, 0, 272, 208, 348, 183, 184, 415, 0, 408,
-	0, 0, 0, 469, 0, 0, 472, 85, 0, 67,
-	0, 60, 61, 323, 0, 351, 352, 29, 357, 446,
-	0, 457, 458, 459, 460, 461, 0, 0, 0, 0,
-	0, 507, 508, 509, 510, 519, 994, 994, 994, 0,
-	619, 249, 382, 382, 254, 268, 214, 0, 170, 124,
-	0, 226, 135, 281, 616, 0, 418, 378, 0, 0,
-	0, 892, 782, 0, 0, 0, 0, 0, 0, 771,
-	765, 766, 835, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 896, 904, 900, 0, 897, 0, 0, 885,
-	0, 750, 0, 0, 0, 0, 783, 0, 820, 823,
-	0, 828, 0, 830, 0, 301, 0, 306, 307, 303,
-	290, 297, 289, 299, 294, 295, 314, 658, 999, 996,
-	997, 192, 181, 0, 69, 72, 73, 74, 475, 0,
-	476, 455, 66, 0, 0, 325, 48, 0, 0, 0,
-	448, 449, 0, 0, 0, 0, 0, 463, 464, 465,
-	466, 467, 0, 0, 995, 0, 0, 0, 620, 621,
-	623, 624, 0, 0, 626, 680, 0, 635, 514, 635,
-	0, 0, 637, 638, 252, 250, 382, 210, 215, 216,
-	0, 220, 0, 0, 137, 345, 372, 0, 0, 394,
-	30, 389, 885, 767, 768, 769, 0, 752, 973, 977,
-	755, 973, 973, 973, 761, 761, 980, 980, 983, 980,
-	770, 0, 772, 773, 776, 774, 777, 778, 764, 881,
-	898, 0, 905, 901, 729, 737, 746, 0, 0, 0,
-	0, 0, 0, 775, 302, 0, 288, 416, 479, 0,
-	0, 67, 0, 0, 327, 0, 324, 0, 0, 0,
-	450, 451, 452, 453, 454, 462, 0, 520, 521, 612,
-	613, 614, 522, -2, 0, -2, 986, 927, 928, 929,
-	973, 931, 977, 0, 973, 973, 959, 960, 961, 962,
-	963, 964, 965, 966, 967, 0, 0, 950, 973, 975,
-	973, 973, 970, 932, 933, 934, 935, 936, 937, 938,
-	939, 940, 941, 942, 943, 944, 945, 625, 681, 647,
-	647, 636, 647, 647, 514, 0, 253, 217, 218, 219,
-	0, 222, 223, 225, 0, 379, 381, 738, 753, 974,
-	0, 754, 0, 756, 757, 758, 759, 762, 763, 760,
-	946, 0, 947, 948, 0, 949, 786, 902, 751, 739,
-	740, 784, 0, 824, 829, 308, 477, 478, 64, 68,
-	50, 329, 0, 326, 0, 320, 322, 58, 0, 502,
-	973, 0, 528, -2, 565, 994, 994, 0, 994, 994,
-	994, 994, 0, 0, 994, 994, 994, 994, 994, 994,
-	994, 994, 994, 994, 994, 994, 994, 994, 0, 622,
-	649, -2, 661, 663, 0, 0, 666, 667, 0, 0,
-	0, 0, 703, 673, 0, 0, 916, 917, 0, 679,
-	989, 987, 988, 930, 955, 956, 957, 958, 0, 0,
-	951, 952, 0, 953, 954, 0, 639, 648, 0, 648,
-	0, 0, 647, 0, 0, 224, 211, 0, 0, 0,
-	0, 0, 44, 0, 318, 0, 328, 49, 0, 495,
-	0, 359, 0, 525, 0, 523, 567, 0, 0, 994,
-	994, 0, 0, 0, 0, 994, 994, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 662, 664, 665, 668, 669, 670, 708, 709,
-	710, 671, 705, 706, 707, 672, 0, 0, 914, 915,
-	701, 926, 990, 0, 971, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 633, 221, 979, 978, 981, 0,
-	984, 0, 785, 42, 46, 51, 52, 0, 0, 0,
-	0, 0, 0, 494, 503, 504, 359, 561, 566, 568,
-	569, 0, 0, 572, 573, 574, 575, 0, 0, 578,
-	579, 580, 581, 582, 583, 584, 585, 586, 587, 603,
-	604, 605, 606, 607, 608, 588, 589, 590, 591, 592,
-	593, 600, 0, 0, 597, 0, 0, 0, 696, 0,
-	968, 0, 969, 976, 0, 640, 642, 643, 644, 645,
-	646, 641, 0, 0, 0, 0, 632, 634, 676, 0,
-	0, 0, 31, 0, 48, 0, 53, 0, 0, 0,
-	0, 0, 331, 321, 496, 994, 0, 0, 500, 501,
-	505, 550, 0, 0, 556, 0, 562, 570, 571, 576,
-	577, 594, 0, 0, 596, 0, 0, 704, 0, 683,
-	697, 0, 0, 972, 495, 495, 495, 495, 0, 677,
-	982, 985, 22, 0, 0, 45, 0, 54, 0, 56,
-	57, 330, 0, 0, 498, 0, 530, 0, 0, 0,
-	0, 0, 559, 0, 601, 602, 595, 598, 599, 674,
-	682, 684, 685, 686, 0, 698, 699, 700, 702, 627,
-	628, 629, 630, 0, 21, 0, 32, 0, 34, 36,
-	37, 650, 43, 47, 55, 332, 497, 499, 532, 0,
-	551, 0, 0, 0, 0, 0, 0, 0, 675, 687,
-	0, 688, 0, 0, 0, 631, 23, 24, 0, 33,
-	0, 0, 529, 0, 561, 552, 0, 554, 0, 0,
-	0, 0, 689, 691, 692, 0, 0, 690, 0, 0,
-	35, 651, 0, 534, 0, 548, 553, 555, 0, 560,
-	558, 693, 695, 694, 25, 26, 27, 0, 533, 0,
-	546, 531, 0, 557, 652, 535, -2, 0, 549, 536,
-	-2, 0, 544, 0, 537, 545, 0, 540, 0, 0,
-	539, 0, -2, 0, 541, -2, 0, 547,
+	20, 21, 22, 466, 467, 468, 0, 0, 298, 0,
+	249, 250, 251, 252, 253, 254, 255, 256, 257, 258,
+	259, 222, 223, 0, 0, 190, 170, 171, 172, 130,
+	131, 132, 133, 0, 0, 0, 0, 0, 0, 0,
+	358, -2, 469, 470, 471, -2, 299, 300, 301, 302,
+	303, 209, 210, 211, -2, 0, 183, 0, 175, 175,
+	0, 378, 0, 0, 389, 0, 398, 23, 336, 0,
+	341, 643, 679, 680, 681, 1374, 1375, 1376, 1377, 1378,
+	1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387, 1388,
+	1389, 1390, 1391, 1392, 1393, 1394, 1395, 1396, 1397, 1398,
+	1399, 1400, 1401, 1402, 1403, 1404, 1405, 1406, 1407, 1408,
+	1409, 1410, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216,
+	1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226,
+	1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236,
+	1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246,
+	1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256,
+	1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266,
+	1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276,
+	1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286,
+	1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296,
+	1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306,
+	1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316,
+	1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325, 1326,
+	1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335, 1336,
+	1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344, 1345, 1346,
+	1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355, 1356,
+	1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365, 1366,
+	1367, 1368, 1369, 1370, 1371, 1372, 1373, 0, 199, 0,
+	0, 203, 0, 0, 0, 295, 195, 196, 197, 198,
+	0, 0, 420, 421, 444, 447, 451, 0, 189, 0,
+	0, 91, 509, 93, 511, 0, 97, 99, 100, -2,
+	104, 105, 106, 107, 108, 109, 110, 0, 112, 1260,
+	114, 1321, 117, 118, 119, 0, 128, 129, -2, -2,
+	506, 0, 0, 1310, 73, 0, 26, 0, 0, 234,
+	234, 234, 234, 234, -2, 0, 0, 0, 394, 540,
+	540, 0, 540, 0, 517, 518, 519, 538, 539, 553,
+	260, 0, 0, 271, 272, 0, 288, 279, 288, 0,
+	263, 264, 265, 269, 270, 289, 0, 234, 184, 185,
+	174, 0, 179, 0, 173, 0, 0, 144, 0, 149,
+	0, 1259, 1325, 1275, 0, 0, 1293, 0, 168, 1052,
+	1221, 0, 373, 0, 379, 378, 378, 0, 378, 366,
+	0, 368, 371, 0, 399, 400, 401, 402, 3, 0,
+	0, 340, 0, 407, 200, 682, 0, 0, 204, 205,
+	0, 0, 212, 0, 215, 1411, 1412, 1413, 0, 219,
+	0, 0, 0, 0, 0, 435, 0, 0, 434, 0,
+	0, 0, 0, 448, 449, 0, 452, 454, 455, 461,
+	462, 463, 464, 465, 0, 378, 87, 0, 0, 0,
+	0, 0, 513, 98, 127, 101, 102, 0, 122, 124,
+	126, 125, 111, 123, 113, 115, 116, 121, 87, 0,
+	0, 0, 74, 0, 0, 30, 31, 0, 0, 0,
+	0, 0, 0, 361, 0, 342, 0, 391, 393, 0,
+	395, 396, 0, 0, 0, 0, 0, 540, 0, 284,
+	285, 0, 279, 279, 273, 281, 0, 286, 287, 0,
+	407, 0, 0, 0, 540, 0, 0, 0, 0, 177,
+	0, 182, 134, 139, 137, 138, 140, 0, 0, 0,
+	0, 0, 166, 167, 0, 0, 0, 0, 0, 157,
+	160, 635, 636, 637, 161, 162, 0, 1053, 1054, 342,
+	374, 390, 392, 373, -2, 0, 387, 388, 0, 367,
+	0, 0, 415, 409, 411, 456, 39, 0, 950, 679,
+	954, -2, 1375, 1376, 1377, 1378, 1379, 1380, 1381, 1383,
+	-2, -2, 1386, 1388, 1390, 1391, 1392, -2, -2, -2,
+	1397, -2, -2, -2, 1401, 1402, 1404, 1405, 1408, 1409,
+	1410, -2, -2, -2, -2, 963, 750, 751, 754, 755,
+	0, 0, 0, 0, 0, 762, 763, 0, 776, 0,
+	769, 770, 771, 772, 773, 49, 50, 979, 980, 981,
+	982, 983, 984, 985, 986, 912, 737, 0, 0, 897,
+	887, 0, 907, 925, 926, 0, 0, 0, 0, 0,
+	0, 51, 52, 903, 904, 905, 906, 908, 909, 910,
+	911, 913, 914, 915, 916, 919, 920, 921, 922, 923,
+	924, 927, 929, 899, 900, 901, 902, 891, 892, 893,
+	894, 895, 896, 310, 328, 312, 0, 317, 0, 644,
+	378, 0, 0, 201, 0, 0, 1414, 1415, 1416, 206,
+	0, 0, 214, 216, 217, 218, 296, 0, 0, 407,
+	192, 0, 438, 432, 0, 425, 436, 437, 428, 0,
+	430, 0, 426, 427, 371, 0, 453, 446, 0, 88,
+	89, 90, 92, 103, 0, 0, 81, 494, 500, 497,
+	507, 510, 0, 95, 512, 120, 0, 76, 0, 0,
+	24, 25, 27, 28, 304, 235, 305, 0, 307, 641,
+	308, 459, 460, 0, 362, 375, 39, 380, 381, 384,
+	481, 0, 508, 532, -2, 0, 261, 407, 407, 407,
+	279, 0, 281, 0, 281, 276, 280, 0, 290, 292,
+	0, 224, 225, 0, 0, 0, 481, 1353, 186, 187,
+	0, 0, 181, 0, 0, 141, 142, 143, 150, 145,
+	147, 0, 0, 151, 163, 164, 165, 334, 335, 0,
+	0, 0, 155, 156, 0, 169, 360, 342, 364, 342,
+	369, 0, 0, 442, 407, 0, 416, 0, 412, 0,
+	0, 0, 457, 0, 0, 949, 0, 0, 968, 969,
+	970, 971, 972, 973, 942, 938, 938, 938, 0, 938,
+	0, 0, 864, 0, 0, 938, 938, 866, 0, 938,
+	938, 865, 0, 0, 938, 938, 938, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, -2, 944, 0, 758,
+	759, 760, 761, 764, 0, 777, 0, 0, 0, 931,
+	0, 934, 935, 0, 937, 942, 942, 874, 0, 875,
+	888, 0, 878, 879, 880, 942, 0, 942, 886, 0,
+	938, 311, 325, 0, 329, 0, 0, 321, 323, 316,
+	318, 0, 0, 338, 373, 408, 683, 0, 1059, -2,
+	1061, -2, -2, 1063, 1064, 1065, 1066, 1067, 1068, 1069,
+	1070, 1071, 1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079,
+	1080, 1081, 1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089,
+	1090, 1091, 1092, 1093, 1094, 1095, 1096, 1097, 1098, 1099,
+	1100, 1101, 1102, 1103, 1104, 1105, 1106, 1107, 1108, 1109,
+	1110, 1111, 1112, 1113, 1114, 1115, 1116, 1117, 1118, 1119,
+	1120, 1121, 1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129,
+	1130, 1131, 1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139,
+	1140, 1141, 1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149,
+	1150, 1151, 1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159,
+	1160, 1161, 1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169,
+	1170, 1171, 1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179,
+	1180, 1181, 1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189,
+	1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199,
+	1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207, 1208, 0,
+	208, 207, 213, 0, 0, 221, 378, 0, 0, 422,
+	439, 0, 0, 423, 0, 424, 429, 431, 445, 0,
+	0, 82, 86, 0, 496, 0, 0, 499, 94, 0,
+	0, 0, 70, 0, 0, 0, 344, 0, 0, 0,
+	0, 383, 385, 386, 473, 482, 0, 541, 0, 0,
+	537, -2, 544, 0, 550, 0, 262, 266, 267, 407,
+	282, 279, 283, 279, 281, 0, 291, 294, 0, 227,
+	0, 0, 229, 0, 0, 473, 0, 188, 176, 178,
+	0, 136, 0, 0, 0, 152, 153, 154, 158, 159,
+	363, 365, 23, 372, 0, 405, 410, 417, 418, 946,
+	947, 948, 458, 40, 413, 951, 0, 953, 0, 943,
+	944, 0, 939, 940, 0, 0, 0, 0, 0, 0,
+	0, 889, 0, 978, 0, 835, 836, 837, 838, 839,
+	840, 841, 842, 843, 844, 845, 846, 847, 848, 849,
+	850, 851, 852, 853, 854, 855, 856, 857, 858, 859,
+	860, 861, 862, 863, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 738, 739, 740, 741, 742, 743,
+	744, 745, 746, 747, 748, 749, 955, 966, 967, 0,
+	0, 0, 0, 0, 964, 959, 0, 752, 753, 756,
+	0, 774, 778, 0, 0, 814, 814, 932, 933, 936,
+	0, 944, 0, 898, 0, 0, 0, 0, 0, 0,
+	328, 330, 0, 0, 328, 0, 0, 0, 337, 0,
+	309, 0, 0, 297, 220, 373, 193, 194, 440, 0,
+	433, 451, 0, 0, 0, 495, 0, 0, 498, 96,
+	0, 78, 0, 71, 72, 29, 306, 642, 348, 0,
+	376, 377, 40, 382, 472, 0, 483, 484, 485, 486,
+	487, 0, 0, 0, 0, 0, 533, 534, 535, 536,
+	545, 1055, 1055, 1055, 0, 645, 274, 407, 407, 279,
+	293, 226, 228, -2, 1047, 988, 989, 990, 1034, 992,
+	1038, 0, 1034, 1034, 1020, 1021, 1022, 1023, 1024, 1025,
+	1026, 1027, 1028, 0, 0, 1011, 1034, 1036, 1034, 1034,
+	1031, 993, 994, 995, 996, 997, 998, 999, 1000, 1001,
+	1002, 1003, 1004, 1005, 1006, 1041, 1041, 1044, 1041, 230,
+	0, 236, 0, 180, 135, 0, 248, 146, 0, 443,
+	403, 0, 0, 0, 952, 828, 0, 0, 0, 0,
+	0, 0, 800, 794, 795, 890, 0, 0, 0, 0,
+	0, 0, 0, 884, 0, 0, 0, 0, 956, 964,
+	960, 0, 957, 0, 0, 945, 0, 779, 0, 0,
+	0, 0, 0, 378, 0, 0, 829, 0, 873, 876,
+	0, 881, 0, 885, 0, 0, 326, 0, 331, 332,
+	328, 315, 322, 314, 324, 319, 320, 339, 684, 1060,
+	1057, 1058, 202, 191, 0, 450, 0, 80, 83, 84,
+	85, 501, 0, 502, 481, 77, 0, 0, 350, 59,
+	0, 0, 0, 474, 475, 0, 0, 0, 0, 0,
+	489, 490, 491, 492, 493, 0, 0, 1056, 0, 0,
+	0, 646, 647, 649, 650, 0, 652, 706, 0, 661,
+	540, 661, 0, 0, 663, 664, 277, 275, 407, 675,
+	-2, 687, 689, 0, 0, 692, 693, 0, 0, 0,
+	0, 729, 699, 0, 0, 976, 977, 0, 705, 1050,
+	1048, 1049, 991, 1035, 0, 1016, 0, 1017, 1018, 1019,
+	0, 0, 1012, 1013, 0, 1014, 1015, 1007, 0, 1008,
+	1009, 0, 1010, 0, 232, 237, 238, 0, 242, 0,
+	0, 148, 370, 397, 0, 0, 419, 41, 414, 945,
+	796, 797, 798, 0, 781, 1034, 1038, 784, 1034, 1034,
+	1034, 790, 790, 799, 0, 801, 802, 0, 805, 803,
+	0, 806, 807, 793, 941, 958, 0, 965, 961, 757,
+	765, 775, 0, 0, 0, 811, 816, 0, 812, 0,
+	0, 0, 0, 804, 327, 0, 313, 441, 505, 0,
+	0, 78, 0, 0, 352, 0, 349, 0, 0, 0,
+	476, 477, 478, 479, 480, 488, 0, 546, 547, 638,
+	639, 640, 548, -2, 0, 651, 707, 673, 673, 662,
+	673, 673, 540, 0, 278, 688, 690, 691, 694, 695,
+	696, 734, 735, 736, 697, 731, 732, 733, 698, 0,
+	0, 974, 975, 727, 987, 1051, 0, 0, 0, 1032,
+	0, 0, 0, 0, 231, 239, 240, 241, 0, 244,
+	245, 247, 0, 404, 406, 766, 782, 783, 785, 786,
+	787, 788, 791, 792, 789, 832, 883, 0, 962, 780,
+	767, 768, 813, 0, 0, 815, 830, 0, 877, 882,
+	867, 333, 503, 504, 75, 79, 61, 354, 0, 351,
+	0, 345, 347, 69, 0, 528, 1034, 0, 554, -2,
+	591, 1055, 1055, 0, 1055, 1055, 1055, 1055, 0, 0,
+	1055, 1055, 1055, 1055, 1055, 1055, 1055, 1055, 1055, 1055,
+	1055, 1055, 1055, 1055, 0, 648, 0, 665, 674, 0,
+	674, 0, 0, 673, 0, 0, 0, 722, 0, 1040,
+	1039, 1029, 0, 1030, 1037, 1042, 0, 1045, 0, 0,
+	246, 233, 0, 817, 819, 0, 0, 0, 0, 0,
+	0, 818, 0, 55, 0, 343, 0, 353, 60, 0,
+	521, 0, 384, 0, 551, 0, 549, 593, 0, 0,
+	1055, 1055, 0, 0, 0, 0, 1055, 1055, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	659, 730, 0, 709, 723, 0, 0, 1033, 0, 0,
+	243, 868, 0, 821, 822, 823, 824, 825, 826, 827,
+	831, 53, 57, 62, 63, 0, 0, 0, 0, 0,
+	0, 520, 529, 530, 384, 587, 592, 594, 595, 0,
+	0, 598, 599, 600, 601, 0, 0, 604, 605, 606,
+	607, 608, 609, 610, 611, 612, 613, 629, 630, 631,
+	632, 633, 634, 614, 615, 616, 617, 618, 619, 626,
+	0, 0, 623, 0, 0, 666, 668, 669, 670, 671,
+	672, 667, 0, 0, 0, 0, 658, 660, 702, 0,
+	700, 708, 710, 711, 712, 0, 724, 725, 726, 728,
+	1043, 1046, 0, 42, 0, 59, 0, 64, 0, 0,
+	0, 0, 0, 356, 346, 522, 1055, 0, 0, 526,
+	527, 531, 576, 0, 0, 582, 0, 588, 596, 597,
+	602, 603, 620, 0, 0, 622, 0, 0, 521, 521,
+	521, 521, 0, 703, 701, 713, 0, 714, 0, 0,
+	0, 820, 33, 0, 0, 56, 0, 65, 0, 67,
+	68, 355, 0, 0, 524, 0, 556, 0, 0, 0,
+	0, 0, 585, 0, 627, 628, 621, 624, 625, 653,
+	654, 655, 656, 0, 715, 717, 718, 0, 0, 716,
+	32, 0, 43, 0, 45, 47, 48, 676, 54, 58,
+	66, 357, 523, 525, 558, 0, 577, 0, 0, 0,
+	0, 0, 0, 0, 657, 719, 721, 720, 34, 35,
+	0, 44, 0, 0, 555, 0, 587, 578, 0, 580,
+	0, 0, 0, 0, 0, 0, 46, 677, 0, 560,
+	0, 574, 579, 581, 0, 586, 584, 36, 37, 38,
+	0, 559, 0, 572, 557, 0, 583, 678, 561, -2,
+	0, 575, 562, -2, 0, 570, 0, 563, 571, 0,
+	566, 0, 0, 565, 0, -2, 0, 567, -2, 0,
+	573,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 82, 3, 3, 3, 110, 102, 3,
-	54, 56, 107, 105, 55, 106, 119, 108, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 442,
-	90, 89, 91, 3, 443, 3, 3, 3, 3, 3,
+	3, 3, 3, 86, 3, 3, 3, 116, 106, 3,
+	54, 56, 113, 111, 55, 112, 125, 114, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 456,
+	94, 93, 95, 3, 457, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 112, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 118, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 101, 3, 113,
+	3, 3, 3, 3, 105, 3, 119,
 }
 
 var yyTok2 = [...]int{
@@ -4288,10 +4721,10 @@ var yyTok2 = [...]int{
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 57, 58, 59, 60, 61, 62, 63, 64,
 	65, 66, 67, 68, 69, 70, 71, 72, 73, 74,
-	75, 76, 77, 78, 79, 80, 81, 83, 84, 85,
-	86, 87, 88, 92, 93, 94, 95, 96, 97, 98,
-	99, 100, 103, 104, 109, 111, 114, 115, 116, 117,
-	118, 120, 121, 122, 123, 124, 125, 126, 127, 128,
+	75, 76, 77, 78, 79, 80, 81, 82, 83, 84,
+	85, 87, 88, 89, 90, 91, 92, 96, 97, 98,
+	99, 100, 101, 102, 103, 104, 107, 108, 109, 110,
+	115, 117, 120, 121, 122, 123, 124, 126, 127, 128,
 	129, 130, 131, 132, 133, 134, 135, 136, 137, 138,
 	139, 140, 141, 142, 143, 144, 145, 146, 147, 148,
 	149, 150, 151, 152, 153, 154, 155, 156, 157, 158,
@@ -4343,7 +4776,10 @@ var yyTok3 = [...]int{
 	57750, 425, 57751, 426, 57752, 427, 57753, 428, 57754, 429,
 	57755, 430, 57756, 431, 57757, 432, 57758, 433, 57759, 434,
 	57760, 435, 57761, 436, 57762, 437, 57763, 438, 57764, 439,
-	57765, 440, 57766, 441, 0,
+	57765, 440, 57766, 441, 57767, 442, 57768, 443, 57769, 444,
+	57770, 445, 57771, 446, 57772, 447, 57773, 448, 57774, 449,
+	57775, 450, 57776, 451, 57777, 452, 57778, 453, 57779, 454,
+	57780, 455, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -4693,7 +5129,7 @@ yydefault:
 
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:502
+//line mysql_sql.y:529
 		{
 			if yyDollar[1].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[1].statementUnion())
@@ -4701,32 +5137,96 @@ yydefault:
 		}
 	case 3:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:508
+//line mysql_sql.y:535
 		{
 			if yyDollar[3].statementUnion() != nil {
 				yylex.(*Lexer).AppendStmt(yyDollar[3].statementUnion())
 			}
 		}
-	case 19:
+	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:531
+//line mysql_sql.y:561
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 20:
+	case 23:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:535
+//line mysql_sql.y:565
 		{
 			yyLOCAL = tree.Statement(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 21:
+	case 24:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:571
+		{
+			yyLOCAL = tree.NewPrepareString(tree.Identifier(yyDollar[2].str), yyDollar[4].str)
+		}
+		yyVAL.union = yyLOCAL
+	case 25:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:575
+		{
+			yyLOCAL = tree.NewPrepareVar(tree.Identifier(yyDollar[2].str), yyDollar[4].varExprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 26:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:581
+		{
+			yyLOCAL = tree.NewExecute(tree.Identifier(yyDollar[2].str), nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 27:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:585
+		{
+			yyLOCAL = tree.NewExecute(tree.Identifier(yyDollar[2].str), yyDollar[4].varExprsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 28:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []*tree.VarExpr
+//line mysql_sql.y:591
+		{
+			yyLOCAL = []*tree.VarExpr{yyDollar[1].varExprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 29:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []*tree.VarExpr
+//line mysql_sql.y:595
+		{
+			yyLOCAL = append(yyDollar[1].varExprsUnion(), yyDollar[3].varExprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 30:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:601
+		{
+			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), false)
+		}
+		yyVAL.union = yyLOCAL
+	case 31:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:605
+		{
+			yyLOCAL = tree.NewDeallocate(tree.Identifier(yyDollar[3].str), true)
+		}
+		yyVAL.union = yyLOCAL
+	case 32:
 		yyDollar = yyS[yypt-14 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:541
+//line mysql_sql.y:611
 		{
 			yyLOCAL = &tree.Load{
 				Local:             yyDollar[3].boolValUnion(),
@@ -4741,42 +5241,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 22:
+	case 33:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:556
+//line mysql_sql.y:626
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 23:
+	case 34:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:560
+//line mysql_sql.y:630
 		{
 			yyLOCAL = yyDollar[2].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 24:
+	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:566
+//line mysql_sql.y:636
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 25:
+	case 36:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:570
+//line mysql_sql.y:640
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 26:
+	case 37:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:576
+//line mysql_sql.y:646
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4784,10 +5284,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 27:
+	case 38:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:583
+//line mysql_sql.y:653
 		{
 			yyLOCAL = &tree.UpdateExpr{
 				Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()},
@@ -4795,58 +5295,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 28:
+	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:592
+//line mysql_sql.y:662
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 29:
+	case 40:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:596
+//line mysql_sql.y:666
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 30:
+	case 41:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:600
+//line mysql_sql.y:670
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 31:
+	case 42:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:605
+//line mysql_sql.y:675
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 32:
+	case 43:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:609
+//line mysql_sql.y:679
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 33:
+	case 44:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:613
+//line mysql_sql.y:683
 		{
 			yyLOCAL = yyDollar[2].loadColumnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:619
+//line mysql_sql.y:689
 		{
 			switch yyDollar[1].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4856,10 +5356,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 35:
+	case 46:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.LoadColumn
-//line mysql_sql.y:628
+//line mysql_sql.y:698
 		{
 			switch yyDollar[3].loadColumnUnion().(type) {
 			case *tree.UnresolvedName:
@@ -4869,42 +5369,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 36:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:639
+//line mysql_sql.y:709
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 37:
+	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.LoadColumn
-//line mysql_sql.y:643
+//line mysql_sql.y:713
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 38:
+	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:649
+//line mysql_sql.y:719
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 39:
+	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:653
+//line mysql_sql.y:723
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 40:
+	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:659
+//line mysql_sql.y:729
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -4927,10 +5427,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.VarExpr
-//line mysql_sql.y:683
+//line mysql_sql.y:753
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var r string
@@ -4949,34 +5449,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 53:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:702
+//line mysql_sql.y:772
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 54:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:706
+//line mysql_sql.y:776
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 55:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:711
+//line mysql_sql.y:781
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:715
+//line mysql_sql.y:785
 		{
 			yyLOCAL = &tree.Lines{
 				StartingBy:   yyDollar[2].str,
@@ -4984,42 +5484,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 57:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:723
+//line mysql_sql.y:793
 		{
 			yyVAL.str = ""
 		}
-	case 47:
+	case 58:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:727
+//line mysql_sql.y:797
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 48:
+	case 59:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:732
+//line mysql_sql.y:802
 		{
 			yyVAL.str = "\n"
 		}
-	case 49:
+	case 60:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:736
+//line mysql_sql.y:806
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 50:
+	case 61:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:741
+//line mysql_sql.y:811
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 62:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:745
+//line mysql_sql.y:815
 		{
 			res := &tree.Fields{
 				Terminated: "\t",
@@ -5042,36 +5542,36 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:769
+//line mysql_sql.y:839
 		{
 			yyLOCAL = []*tree.Fields{yyDollar[1].fieldsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 64:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.Fields
-//line mysql_sql.y:773
+//line mysql_sql.y:843
 		{
 			yyLOCAL = append(yyDollar[1].fieldsListUnion(), yyDollar[2].fieldsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 65:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:779
+//line mysql_sql.y:849
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 66:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:785
+//line mysql_sql.y:855
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -5090,10 +5590,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:803
+//line mysql_sql.y:873
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5111,10 +5611,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:820
+//line mysql_sql.y:890
 		{
 			str := yyDollar[3].str
 			if str != "\\" && len(str) > 1 {
@@ -5132,50 +5632,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 70:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:843
+//line mysql_sql.y:913
 		{
 			yyLOCAL = &tree.DuplicateKeyError{}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 71:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:847
+//line mysql_sql.y:917
 		{
 			yyLOCAL = &tree.DuplicateKeyIgnore{}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.DuplicateKey
-//line mysql_sql.y:851
+//line mysql_sql.y:921
 		{
 			yyLOCAL = &tree.DuplicateKeyReplace{}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 73:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:856
+//line mysql_sql.y:926
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 63:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:860
+//line mysql_sql.y:930
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 64:
+	case 75:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:866
+//line mysql_sql.y:936
 		{
 			yyLOCAL = &tree.Grant{
 				Privileges:  yyDollar[2].privilegesUnion(),
@@ -5186,10 +5686,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 76:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:876
+//line mysql_sql.y:946
 		{
 			yyLOCAL = &tree.Grant{
 				IsGrantRole:      true,
@@ -5198,10 +5698,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 66:
+	case 77:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:884
+//line mysql_sql.y:954
 		{
 			yyLOCAL = &tree.Grant{
 				IsProxy:     true,
@@ -5211,26 +5711,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 78:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:894
+//line mysql_sql.y:964
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 68:
+	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:898
+//line mysql_sql.y:968
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 80:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:908
+//line mysql_sql.y:978
 		{
 			yyLOCAL = &tree.Revoke{
 				Privileges: yyDollar[2].privilegesUnion(),
@@ -5241,10 +5741,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 70:
+	case 81:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:918
+//line mysql_sql.y:988
 		{
 			yyLOCAL = &tree.Revoke{
 				IsRevokeRole:      true,
@@ -5253,30 +5753,30 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 82:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:928
+//line mysql_sql.y:998
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 83:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:934
+//line mysql_sql.y:1004
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level: tree.PRIVILEGE_LEVEL_TYPE_GLOBAL,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 84:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:940
+//line mysql_sql.y:1010
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:  tree.PRIVILEGE_LEVEL_TYPE_DATABASE,
@@ -5284,10 +5784,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 85:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:947
+//line mysql_sql.y:1017
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5296,10 +5796,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.PrivilegeLevel
-//line mysql_sql.y:955
+//line mysql_sql.y:1025
 		{
 			yyLOCAL = &tree.PrivilegeLevel{
 				Level:   tree.PRIVILEGE_LEVEL_TYPE_TABLE,
@@ -5307,58 +5807,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 87:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:963
+//line mysql_sql.y:1033
 		{
 			yyLOCAL = tree.OBJECT_TYPE_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 77:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:967
+//line mysql_sql.y:1037
 		{
 			yyLOCAL = tree.OBJECT_TYPE_TABLE
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:971
+//line mysql_sql.y:1041
 		{
 			yyLOCAL = tree.OBJECT_TYPE_FUNCTION
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 90:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ObjectType
-//line mysql_sql.y:975
+//line mysql_sql.y:1045
 		{
 			yyLOCAL = tree.OBJECT_TYPE_PROCEDURE
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 91:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:981
+//line mysql_sql.y:1051
 		{
 			yyLOCAL = []*tree.Privilege{yyDollar[1].privilegeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 92:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Privilege
-//line mysql_sql.y:985
+//line mysql_sql.y:1055
 		{
 			yyLOCAL = append(yyDollar[1].privilegesUnion(), yyDollar[3].privilegeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:991
+//line mysql_sql.y:1061
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5366,10 +5866,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 94:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Privilege
-//line mysql_sql.y:998
+//line mysql_sql.y:1068
 		{
 			yyLOCAL = &tree.Privilege{
 				Type:       yyDollar[1].privilegeTypeUnion(),
@@ -5377,418 +5877,418 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 95:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1007
+//line mysql_sql.y:1077
 		{
 			yyLOCAL = []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 96:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.UnresolvedName
-//line mysql_sql.y:1011
+//line mysql_sql.y:1081
 		{
 			yyLOCAL = append(yyDollar[1].unresolveNamesUnion(), yyDollar[3].unresolvedNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1017
+//line mysql_sql.y:1087
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 98:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1021
+//line mysql_sql.y:1091
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1025
+//line mysql_sql.y:1095
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1029
+//line mysql_sql.y:1099
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1033
+//line mysql_sql.y:1103
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_USER
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 102:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1037
+//line mysql_sql.y:1107
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TABLESPACE
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1041
+//line mysql_sql.y:1111
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_TRIGGER
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1045
+//line mysql_sql.y:1115
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DELETE
 		}
 		yyVAL.union = yyLOCAL
-	case 94:
+	case 105:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1049
+//line mysql_sql.y:1119
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP
 		}
 		yyVAL.union = yyLOCAL
-	case 95:
+	case 106:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1053
+//line mysql_sql.y:1123
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EXECUTE
 		}
 		yyVAL.union = yyLOCAL
-	case 96:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1057
+//line mysql_sql.y:1127
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INDEX
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1061
+//line mysql_sql.y:1131
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_INSERT
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1065
+//line mysql_sql.y:1135
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SELECT
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1069
+//line mysql_sql.y:1139
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SUPER
 		}
 		yyVAL.union = yyLOCAL
-	case 100:
+	case 111:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1073
+//line mysql_sql.y:1143
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_DATABASES
 		}
 		yyVAL.union = yyLOCAL
-	case 101:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1077
+//line mysql_sql.y:1147
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_UPDATE
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 113:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1081
+//line mysql_sql.y:1151
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_GRANT_OPTION
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1085
+//line mysql_sql.y:1155
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REFERENCES
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 115:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1089
+//line mysql_sql.y:1159
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_SLAVE
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1093
+//line mysql_sql.y:1163
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_REPLICATION_CLIENT
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 117:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1097
+//line mysql_sql.y:1167
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_USAGE
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 118:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1101
+//line mysql_sql.y:1171
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_RELOAD
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1105
+//line mysql_sql.y:1175
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_FILE
 		}
 		yyVAL.union = yyLOCAL
-	case 109:
+	case 120:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1109
+//line mysql_sql.y:1179
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_TEMPORARY_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 110:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1113
+//line mysql_sql.y:1183
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_LOCK_TABLES
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1117
+//line mysql_sql.y:1187
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1121
+//line mysql_sql.y:1191
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHOW_VIEW
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1125
+//line mysql_sql.y:1195
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1129
+//line mysql_sql.y:1199
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_DROP_ROLE
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 126:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1133
+//line mysql_sql.y:1203
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_CREATE_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 127:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1137
+//line mysql_sql.y:1207
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_ALTER_ROUTINE
 		}
 		yyVAL.union = yyLOCAL
-	case 117:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1141
+//line mysql_sql.y:1211
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_EVENT
 		}
 		yyVAL.union = yyLOCAL
-	case 118:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.PrivilegeType
-//line mysql_sql.y:1145
+//line mysql_sql.y:1215
 		{
 			yyLOCAL = tree.PRIVILEGE_TYPE_STATIC_SHUTDOWN
 		}
 		yyVAL.union = yyLOCAL
-	case 123:
+	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1157
+//line mysql_sql.y:1227
 		{
 			yyLOCAL = yyDollar[3].setRoleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 124:
+	case 135:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1163
+//line mysql_sql.y:1233
 		{
 			dr := yyDollar[4].setDefaultRoleUnion()
 			dr.Users = yyDollar[6].usersUnion()
 			yyLOCAL = dr
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1171
+//line mysql_sql.y:1241
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL_EXCEPT, Roles: yyDollar[3].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1175
+//line mysql_sql.y:1245
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_DEFAULT, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1179
+//line mysql_sql.y:1249
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 128:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1183
+//line mysql_sql.y:1253
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetRole
-//line mysql_sql.y:1187
+//line mysql_sql.y:1257
 		{
 			yyLOCAL = &tree.SetRole{Type: tree.SET_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 141:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1193
+//line mysql_sql.y:1263
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NONE, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 131:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1197
+//line mysql_sql.y:1267
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_ALL, Roles: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 132:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.SetDefaultRole
-//line mysql_sql.y:1201
+//line mysql_sql.y:1271
 		{
 			yyLOCAL = &tree.SetDefaultRole{Type: tree.SET_DEFAULT_ROLE_TYPE_NORMAL, Roles: yyDollar[1].rolesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 133:
+	case 144:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1207
+//line mysql_sql.y:1277
 		{
 			yyLOCAL = &tree.SetVar{Assignments: yyDollar[2].varAssignmentExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 134:
+	case 145:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1213
+//line mysql_sql.y:1283
 		{
 			yyLOCAL = &tree.SetPassword{Password: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 135:
+	case 146:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1217
+//line mysql_sql.y:1287
 		{
 			yyLOCAL = &tree.SetPassword{User: yyDollar[4].userUnion(), Password: yyDollar[6].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 148:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line mysql_sql.y:1224
+//line mysql_sql.y:1294
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 138:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1230
+//line mysql_sql.y:1300
 		{
 			yyLOCAL = []*tree.VarAssignmentExpr{yyDollar[1].varAssignmentExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.VarAssignmentExpr
-//line mysql_sql.y:1234
+//line mysql_sql.y:1304
 		{
 			yyLOCAL = append(yyDollar[1].varAssignmentExprsUnion(), yyDollar[3].varAssignmentExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1240
+//line mysql_sql.y:1310
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5797,10 +6297,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 152:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1248
+//line mysql_sql.y:1318
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5810,10 +6310,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 153:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1257
+//line mysql_sql.y:1327
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5822,10 +6322,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 154:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1265
+//line mysql_sql.y:1335
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				System: true,
@@ -5834,10 +6334,21 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 155:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.VarAssignmentExpr
+//line mysql_sql.y:1343
+		{
+			yyLOCAL = &tree.VarAssignmentExpr{
+				Name:  yyDollar[1].str,
+				Value: yyDollar[3].exprUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 156:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1273
+//line mysql_sql.y:1350
 		{
 			vs := strings.Split(yyDollar[1].str, ".")
 			var isGlobal bool
@@ -5861,10 +6372,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 157:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1296
+//line mysql_sql.y:1373
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5872,10 +6383,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 158:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1303
+//line mysql_sql.y:1380
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5883,10 +6394,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 159:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1310
+//line mysql_sql.y:1387
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:     yyDollar[1].str,
@@ -5895,10 +6406,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 148:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1318
+//line mysql_sql.y:1395
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5906,10 +6417,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 149:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1325
+//line mysql_sql.y:1402
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5917,10 +6428,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 150:
+	case 162:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.VarAssignmentExpr
-//line mysql_sql.y:1332
+//line mysql_sql.y:1409
 		{
 			yyLOCAL = &tree.VarAssignmentExpr{
 				Name:  yyDollar[1].str,
@@ -5928,198 +6439,198 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 153:
+	case 163:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1345
+//line mysql_sql.y:1418
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 154:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1349
+//line mysql_sql.y:1422
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 155:
+	case 165:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:1353
+//line mysql_sql.y:1426
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 156:
+	case 166:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1359
+//line mysql_sql.y:1432
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 157:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1363
+//line mysql_sql.y:1436
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 159:
+	case 169:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1370
+//line mysql_sql.y:1443
 		{
 			yyVAL.str = yyDollar[1].str + "." + yyDollar[3].str
 		}
-	case 163:
+	case 173:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1381
+//line mysql_sql.y:1454
 		{
 			yyLOCAL = &tree.RollbackTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 164:
+	case 174:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1387
+//line mysql_sql.y:1460
 		{
 			yyLOCAL = &tree.CommitTransaction{Type: yyDollar[2].completionTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 165:
+	case 175:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1392
+//line mysql_sql.y:1465
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 166:
+	case 176:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1396
+//line mysql_sql.y:1469
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 167:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1400
+//line mysql_sql.y:1473
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 168:
+	case 178:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1404
+//line mysql_sql.y:1477
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 169:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1408
+//line mysql_sql.y:1481
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_RELEASE
 		}
 		yyVAL.union = yyLOCAL
-	case 170:
+	case 180:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1412
+//line mysql_sql.y:1485
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 171:
+	case 181:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1416
+//line mysql_sql.y:1489
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 172:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.CompletionType
-//line mysql_sql.y:1420
+//line mysql_sql.y:1493
 		{
 			yyLOCAL = tree.COMPLETION_TYPE_NO_CHAIN
 		}
 		yyVAL.union = yyLOCAL
-	case 173:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1426
+//line mysql_sql.y:1499
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 174:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1430
+//line mysql_sql.y:1503
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 175:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1434
+//line mysql_sql.y:1507
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 176:
+	case 186:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1438
+//line mysql_sql.y:1511
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_WRITE)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 177:
+	case 187:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1443
+//line mysql_sql.y:1516
 		{
 			m := tree.MakeTransactionModes(tree.READ_WRITE_MODE_READ_ONLY)
 			yyLOCAL = &tree.BeginTransaction{Modes: m}
 		}
 		yyVAL.union = yyLOCAL
-	case 178:
+	case 188:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1448
+//line mysql_sql.y:1521
 		{
 			yyLOCAL = &tree.BeginTransaction{}
 		}
 		yyVAL.union = yyLOCAL
-	case 179:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1454
+//line mysql_sql.y:1527
 		{
 			yyLOCAL = &tree.Use{Name: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 180:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1458
+//line mysql_sql.y:1531
 		{
 			yyLOCAL = &tree.Use{}
 		}
 		yyVAL.union = yyLOCAL
-	case 181:
+	case 191:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1464
+//line mysql_sql.y:1537
 		{
 			yyLOCAL = &tree.Update{
 				Table:   yyDollar[2].tableExprUnion(),
@@ -6130,84 +6641,84 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 182:
+	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1477
+//line mysql_sql.y:1550
 		{
 			yyLOCAL = tree.UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 183:
+	case 193:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.UpdateExprs
-//line mysql_sql.y:1481
+//line mysql_sql.y:1554
 		{
 			yyLOCAL = append(yyDollar[1].updateExprsUnion(), yyDollar[3].updateExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 184:
+	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UpdateExpr
-//line mysql_sql.y:1487
+//line mysql_sql.y:1560
 		{
 			yyLOCAL = &tree.UpdateExpr{Names: []*tree.UnresolvedName{yyDollar[1].unresolvedNameUnion()}, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 188:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1496
+//line mysql_sql.y:1569
 		{
 			yyLOCAL = yyDollar[1].selectUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 189:
+	case 199:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1502
+//line mysql_sql.y:1575
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 190:
+	case 200:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1507
+//line mysql_sql.y:1580
 		{
 			st := &tree.ShowColumns{Table: yyDollar[2].unresolvedObjectNameUnion(), ColName: yyDollar[3].unresolvedNameUnion()}
 			yyLOCAL = tree.NewExplainStmt(st, "")
 		}
 		yyVAL.union = yyLOCAL
-	case 191:
+	case 201:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1512
+//line mysql_sql.y:1585
 		{
 			yyLOCAL = tree.NewExplainFor("", uint64(yyDollar[4].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 192:
+	case 202:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1516
+//line mysql_sql.y:1589
 		{
 			yyLOCAL = tree.NewExplainFor(yyDollar[4].str, uint64(yyDollar[7].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 193:
+	case 203:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1520
+//line mysql_sql.y:1593
 		{
 			yyLOCAL = tree.NewExplainStmt(yyDollar[2].statementUnion(), "text")
 		}
 		yyVAL.union = yyLOCAL
-	case 194:
+	case 204:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1524
+//line mysql_sql.y:1597
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("verbose", "NULL")
@@ -6217,10 +6728,10 @@ yydefault:
 
 		}
 		yyVAL.union = yyLOCAL
-	case 195:
+	case 205:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1533
+//line mysql_sql.y:1606
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[3].statementUnion(), "text")
 			optionElem := tree.MakeOptionElem("analyze", "NULL")
@@ -6229,10 +6740,10 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 196:
+	case 206:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1541
+//line mysql_sql.y:1614
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[4].statementUnion(), "text")
 			optionElem1 := tree.MakeOptionElem("analyze", "NULL")
@@ -6243,76 +6754,167 @@ yydefault:
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 197:
+	case 207:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1551
+//line mysql_sql.y:1624
 		{
 			explainStmt := tree.NewExplainStmt(yyDollar[5].statementUnion(), "text")
 			explainStmt.Options = yyDollar[3].epxlainOptionsUnion()
 			yyLOCAL = explainStmt
 		}
 		yyVAL.union = yyLOCAL
-	case 201:
+	case 208:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1630
+		{
+			explainStmt := tree.NewExplainStmt(yyDollar[5].statementUnion(), "text")
+			optionElem := tree.MakeOptionElem("format", yyDollar[4].str)
+			explainStmt.Options = tree.MakeOptions(optionElem)
+			yyLOCAL = explainStmt
+		}
+		yyVAL.union = yyLOCAL
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1564
+//line mysql_sql.y:1644
 		{
 			yyLOCAL = tree.MakeOptions(yyDollar[1].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 202:
+	case 213:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.OptionElem
-//line mysql_sql.y:1568
+//line mysql_sql.y:1648
 		{
 			yyLOCAL = append(yyDollar[1].epxlainOptionsUnion(), yyDollar[3].epxlainOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 203:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.OptionElem
-//line mysql_sql.y:1574
+//line mysql_sql.y:1654
 		{
 			yyLOCAL = tree.MakeOptionElem(yyDollar[1].str, yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 204:
+	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1580
+//line mysql_sql.y:1660
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 205:
+	case 216:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1585
+//line mysql_sql.y:1665
 		{
 			yyVAL.str = "true"
 		}
-	case 206:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1586
+//line mysql_sql.y:1666
 		{
 			yyVAL.str = "false"
 		}
-	case 207:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:1587
+//line mysql_sql.y:1667
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 208:
+	case 219:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1672
+		{
+			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 220:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1592
+//line mysql_sql.y:1676
+		{
+			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 221:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1680
 		{
 			yyLOCAL = tree.NewAnalyzeStmt(yyDollar[3].tableNameUnion(), yyDollar[5].identifierListUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 210:
+	case 224:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1691
+		{
+			yyLOCAL = tree.NewAlterTable(yyDollar[3].tableNameUnion(), yyDollar[4].alterTableOptionsUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 225:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL []tree.AlterTableOption
+//line mysql_sql.y:1697
+		{
+			yyLOCAL = []tree.AlterTableOption{yyDollar[1].alterTableOptionUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 226:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL []tree.AlterTableOption
+//line mysql_sql.y:1701
+		{
+			yyLOCAL = append(yyDollar[1].alterTableOptionsUnion(), yyDollar[3].alterTableOptionUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 227:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1707
+		{
+			yyLOCAL = tree.NewAlterOptionAddColumn(yyDollar[2].columnTableDefUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 228:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1711
+		{
+			yyLOCAL = tree.NewAlterOptionAddColumn(yyDollar[3].columnTableDefUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 229:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1715
+		{
+			yyLOCAL = tree.NewAlterOptionDropColumn(tree.Identifier(yyDollar[2].str))
+		}
+		yyVAL.union = yyLOCAL
+	case 230:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1719
+		{
+			yyLOCAL = tree.NewAlterOptionDropColumn(tree.Identifier(yyDollar[3].str))
+		}
+		yyVAL.union = yyLOCAL
+	case 231:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL tree.AlterTableOption
+//line mysql_sql.y:1723
+		{
+			yyLOCAL = tree.NewAlterOptionRenameColumn(tree.Identifier(yyDollar[3].str), tree.Identifier(yyDollar[5].str))
+		}
+		yyVAL.union = yyLOCAL
+	case 232:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1602
+//line mysql_sql.y:1729
 		{
 			yyLOCAL = &tree.AlterUser{
 				IfExists:   yyDollar[3].boolValUnion(),
@@ -6324,10 +6926,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 211:
+	case 233:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1613
+//line mysql_sql.y:1740
 		{
 			auth := &tree.User{
 				AuthString: yyDollar[9].str,
@@ -6340,118 +6942,134 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 212:
+	case 234:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1626
+//line mysql_sql.y:1753
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 213:
+	case 235:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1630
+//line mysql_sql.y:1757
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 214:
+	case 236:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1635
+//line mysql_sql.y:1762
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 215:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1639
+//line mysql_sql.y:1766
 		{
 			yyLOCAL = yyDollar[1].userMiscOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1645
+//line mysql_sql.y:1772
 		{
 			yyLOCAL = []tree.UserMiscOption{yyDollar[1].userMiscOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 239:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.UserMiscOption
-//line mysql_sql.y:1649
+//line mysql_sql.y:1776
 		{
 			yyLOCAL = append(yyDollar[1].userMiscOptionsUnion(), yyDollar[2].userMiscOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 240:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1655
+//line mysql_sql.y:1782
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountUnlock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 241:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1659
+//line mysql_sql.y:1786
 		{
 			yyLOCAL = &tree.UserMiscOptionAccountLock{}
 		}
 		yyVAL.union = yyLOCAL
-	case 220:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1663
+//line mysql_sql.y:1790
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNone{}
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 243:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1667
+//line mysql_sql.y:1794
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireInterval{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 222:
+	case 244:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1671
+//line mysql_sql.y:1798
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireNever{}
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 245:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.UserMiscOption
-//line mysql_sql.y:1675
+//line mysql_sql.y:1802
 		{
 			yyLOCAL = &tree.UserMiscOptionPasswordExpireDefault{}
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
+	case 246:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:1681
+//line mysql_sql.y:1808
 		{
 			yyVAL.item = nil
 		}
-	case 225:
+	case 247:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1686
+//line mysql_sql.y:1813
 		{
 			yyVAL.item = nil
 		}
-	case 237:
+	case 260:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1835
+		{
+			yyLOCAL = tree.NewShowStats(nil)
+		}
+		yyVAL.union = yyLOCAL
+	case 261:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL tree.Statement
+//line mysql_sql.y:1839
+		{
+			yyLOCAL = tree.NewShowStats(yyDollar[4].tableNameUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 262:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1707
+//line mysql_sql.y:1845
 		{
 			yyLOCAL = &tree.ShowIndex{
 				TableName: *yyDollar[4].tableNameUnion(),
@@ -6459,10 +7077,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 241:
+	case 266:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1721
+//line mysql_sql.y:1859
 		{
 			yyLOCAL = &tree.ShowVariables{
 				Global: yyDollar[2].boolValUnion(),
@@ -6471,10 +7089,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 242:
+	case 267:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1731
+//line mysql_sql.y:1869
 		{
 			yyLOCAL = &tree.ShowStatus{
 				Global: yyDollar[2].boolValUnion(),
@@ -6483,58 +7101,58 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 243:
+	case 268:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1740
+//line mysql_sql.y:1878
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 244:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1744
+//line mysql_sql.y:1882
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1748
+//line mysql_sql.y:1886
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 246:
+	case 271:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1754
+//line mysql_sql.y:1892
 		{
 			yyLOCAL = &tree.ShowWarnings{}
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 272:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1760
+//line mysql_sql.y:1898
 		{
 			yyLOCAL = &tree.ShowErrors{}
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1766
+//line mysql_sql.y:1904
 		{
 			yyLOCAL = &tree.ShowProcessList{Full: yyDollar[2].fullOptUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 274:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1772
+//line mysql_sql.y:1910
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   false,
@@ -6545,10 +7163,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 275:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1782
+//line mysql_sql.y:1920
 		{
 			yyLOCAL = &tree.ShowTables{
 				Open:   true,
@@ -6559,18 +7177,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 276:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1794
+//line mysql_sql.y:1932
 		{
 			yyLOCAL = &tree.ShowDatabases{Like: yyDollar[3].comparisionExprUnion(), Where: yyDollar[4].whereUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 277:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1800
+//line mysql_sql.y:1938
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   false,
@@ -6583,10 +7201,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 278:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1812
+//line mysql_sql.y:1950
 		{
 			yyLOCAL = &tree.ShowColumns{
 				Ext:   true,
@@ -6599,118 +7217,118 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 279:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1825
+//line mysql_sql.y:1963
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 280:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ComparisonExpr
-//line mysql_sql.y:1829
+//line mysql_sql.y:1967
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.LIKE, nil, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 281:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:1834
+//line mysql_sql.y:1972
 		{
 			yyVAL.str = ""
 		}
-	case 257:
+	case 282:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:1838
+//line mysql_sql.y:1976
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 258:
+	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1844
+//line mysql_sql.y:1982
 		{
 			yyLOCAL = yyDollar[2].unresolvedObjectNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 288:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1857
+//line mysql_sql.y:1995
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 264:
+	case 289:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:1861
+//line mysql_sql.y:1999
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 265:
+	case 290:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1867
+//line mysql_sql.y:2005
 		{
 			yyLOCAL = &tree.ShowCreateTable{Name: yyDollar[4].unresolvedObjectNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 266:
+	case 291:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1871
+//line mysql_sql.y:2009
 		{
 			yyLOCAL = &tree.ShowCreateDatabase{IfNotExists: yyDollar[4].ifNotExistsUnion(), Name: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 292:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1877
+//line mysql_sql.y:2015
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1881
+//line mysql_sql.y:2019
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1890
+//line mysql_sql.y:2028
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(1, [3]string{yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1894
+//line mysql_sql.y:2032
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(2, [3]string{yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 297:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedObjectName
-//line mysql_sql.y:1898
+//line mysql_sql.y:2036
 		{
 			yyLOCAL = tree.SetUnresolvedObjectName(3, [3]string{yyDollar[5].str, yyDollar[3].str, yyDollar[1].str})
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 304:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1914
+//line mysql_sql.y:2052
 		{
 			yyLOCAL = &tree.DropUser{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6718,10 +7336,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 305:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1923
+//line mysql_sql.y:2061
 		{
 			yyLOCAL = &tree.DropRole{
 				IfExists: yyDollar[3].boolValUnion(),
@@ -6729,10 +7347,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 306:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1932
+//line mysql_sql.y:2070
 		{
 			yyLOCAL = &tree.DropIndex{
 				Name:      tree.Identifier(yyDollar[4].str),
@@ -6741,26 +7359,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 307:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1942
+//line mysql_sql.y:2080
 		{
 			yyLOCAL = &tree.DropTable{IfExists: yyDollar[3].boolValUnion(), Names: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 308:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1948
+//line mysql_sql.y:2086
 		{
 			yyLOCAL = &tree.DropDatabase{Name: tree.Identifier(yyDollar[4].str), IfExists: yyDollar[3].boolValUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 309:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1954
+//line mysql_sql.y:2092
 		{
 			yyLOCAL = &tree.Delete{
 				Table:   yyDollar[3].tableExprUnion(),
@@ -6770,10 +7388,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 310:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:1969
+//line mysql_sql.y:2107
 		{
 			ins := yyDollar[4].insertUnion()
 			ins.Table = yyDollar[2].tableExprUnion()
@@ -6781,10 +7399,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 311:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1978
+//line mysql_sql.y:2116
 		{
 			vc := tree.NewValuesClause(yyDollar[2].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6792,20 +7410,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 312:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1985
+//line mysql_sql.y:2123
 		{
 			yyLOCAL = &tree.Insert{
 				Rows: tree.NewSelect(yyDollar[1].selectUnion(), nil, nil),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 313:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1991
+//line mysql_sql.y:2129
 		{
 			vc := tree.NewValuesClause(yyDollar[5].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6814,10 +7432,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 314:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:1999
+//line mysql_sql.y:2137
 		{
 			vc := tree.NewValuesClause(yyDollar[4].rowsExprsUnion())
 			yyLOCAL = &tree.Insert{
@@ -6825,10 +7443,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 315:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2006
+//line mysql_sql.y:2144
 		{
 			yyLOCAL = &tree.Insert{
 				Columns: yyDollar[2].identifierListUnion(),
@@ -6836,10 +7454,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 316:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Insert
-//line mysql_sql.y:2013
+//line mysql_sql.y:2151
 		{
 			if yyDollar[2].assignmentsUnion() == nil {
 				yylex.Error("the set list of insert can not be empty")
@@ -6858,34 +7476,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 317:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2032
+//line mysql_sql.y:2170
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2036
+//line mysql_sql.y:2174
 		{
 			yyLOCAL = []*tree.Assignment{yyDollar[1].assignmentUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 319:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Assignment
-//line mysql_sql.y:2040
+//line mysql_sql.y:2178
 		{
 			yyLOCAL = append(yyDollar[1].assignmentsUnion(), yyDollar[3].assignmentUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Assignment
-//line mysql_sql.y:2046
+//line mysql_sql.y:2184
 		{
 			yyLOCAL = &tree.Assignment{
 				Column: tree.Identifier(yyDollar[1].str),
@@ -6893,155 +7511,155 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2055
+//line mysql_sql.y:2193
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 322:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2059
+//line mysql_sql.y:2197
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 323:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2065
+//line mysql_sql.y:2203
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 299:
+	case 324:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2069
+//line mysql_sql.y:2207
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 300:
+	case 325:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2075
+//line mysql_sql.y:2213
 		{
 			yyLOCAL = []tree.Exprs{yyDollar[1].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 326:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Exprs
-//line mysql_sql.y:2079
+//line mysql_sql.y:2217
 		{
 			yyLOCAL = append(yyDollar[1].rowsExprsUnion(), yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 327:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2085
+//line mysql_sql.y:2223
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 328:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2090
+//line mysql_sql.y:2228
 		{
 		}
-	case 305:
+	case 330:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2094
+//line mysql_sql.y:2232
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 307:
+	case 332:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2101
+//line mysql_sql.y:2239
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 308:
+	case 333:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:2105
+//line mysql_sql.y:2243
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 310:
+	case 335:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:2112
+//line mysql_sql.y:2250
 		{
 			yyLOCAL = &tree.DefaultVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 311:
+	case 336:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2117
+//line mysql_sql.y:2255
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 312:
+	case 337:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2121
+//line mysql_sql.y:2259
 		{
 			yyLOCAL = yyDollar[3].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 313:
+	case 338:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2127
+//line mysql_sql.y:2265
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 314:
+	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2131
+//line mysql_sql.y:2269
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 315:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2137
+//line mysql_sql.y:2275
 		{
 			yyLOCAL = yyDollar[2].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 316:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2141
+//line mysql_sql.y:2279
 		{
 			yyLOCAL = yyDollar[1].tableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 317:
+	case 342:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2146
+//line mysql_sql.y:2284
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 318:
+	case 343:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.ExportParam
-//line mysql_sql.y:2150
+//line mysql_sql.y:2288
 		{
 			yyLOCAL = &tree.ExportParam{
 				Outfile:     true,
@@ -7054,10 +7672,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 319:
+	case 344:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2163
+//line mysql_sql.y:2301
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: ",",
@@ -7065,10 +7683,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 320:
+	case 345:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2170
+//line mysql_sql.y:2308
 		{
 			yyLOCAL = &tree.Fields{
 				Terminated: yyDollar[4].str,
@@ -7076,10 +7694,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 321:
+	case 346:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2177
+//line mysql_sql.y:2315
 		{
 			str := yyDollar[7].str
 			if str != "\\" && len(str) > 1 {
@@ -7098,10 +7716,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 322:
+	case 347:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Fields
-//line mysql_sql.y:2195
+//line mysql_sql.y:2333
 		{
 			str := yyDollar[4].str
 			if str != "\\" && len(str) > 1 {
@@ -7120,38 +7738,38 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 348:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2214
+//line mysql_sql.y:2352
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: "\n",
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 349:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Lines
-//line mysql_sql.y:2220
+//line mysql_sql.y:2358
 		{
 			yyLOCAL = &tree.Lines{
 				TerminatedBy: yyDollar[2].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 350:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2227
+//line mysql_sql.y:2365
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2231
+//line mysql_sql.y:2369
 		{
 			str := strings.ToLower(yyDollar[2].str)
 			if str == "true" {
@@ -7164,115 +7782,115 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 352:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2244
+//line mysql_sql.y:2382
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 353:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:2248
+//line mysql_sql.y:2386
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 329:
+	case 354:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2253
+//line mysql_sql.y:2391
 		{
 			yyLOCAL = []string{}
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 355:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2257
+//line mysql_sql.y:2395
 		{
 			yyLOCAL = yyDollar[3].strsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 331:
+	case 356:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2264
+//line mysql_sql.y:2402
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 357:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:2269
+//line mysql_sql.y:2407
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 334:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2276
+//line mysql_sql.y:2414
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 335:
+	case 360:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2282
+//line mysql_sql.y:2420
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2286
+//line mysql_sql.y:2424
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Ep: yyDollar[3].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 362:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2290
+//line mysql_sql.y:2428
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[1].selectStatementUnion(), OrderBy: yyDollar[2].orderByUnion(), Limit: yyDollar[3].limitUnion(), Ep: yyDollar[4].exportParmUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 363:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2294
+//line mysql_sql.y:2432
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 364:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2298
+//line mysql_sql.y:2436
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Ep: yyDollar[4].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 365:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Select
-//line mysql_sql.y:2302
+//line mysql_sql.y:2440
 		{
 			yyLOCAL = &tree.Select{Select: yyDollar[2].selectStatementUnion(), OrderBy: yyDollar[3].orderByUnion(), Limit: yyDollar[4].limitUnion(), Ep: yyDollar[5].exportParmUnion(), With: yyDollar[1].withClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 366:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2308
+//line mysql_sql.y:2446
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: false,
@@ -7280,10 +7898,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.With
-//line mysql_sql.y:2315
+//line mysql_sql.y:2453
 		{
 			yyLOCAL = &tree.With{
 				IsRecursive: true,
@@ -7291,26 +7909,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2324
+//line mysql_sql.y:2462
 		{
 			yyLOCAL = []*tree.CTE{yyDollar[1].cteUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.CTE
-//line mysql_sql.y:2328
+//line mysql_sql.y:2466
 		{
 			yyLOCAL = append(yyDollar[1].cteListUnion(), yyDollar[3].cteUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 370:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.CTE
-//line mysql_sql.y:2334
+//line mysql_sql.y:2472
 		{
 			yyLOCAL = &tree.CTE{
 				Name: &tree.AliasClause{Alias: tree.Identifier(yyDollar[1].str), Cols: yyDollar[2].identifierListUnion()},
@@ -7318,162 +7936,162 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 371:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2342
+//line mysql_sql.y:2480
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2346
+//line mysql_sql.y:2484
 		{
 			yyLOCAL = yyDollar[2].identifierListUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 373:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2351
+//line mysql_sql.y:2489
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 374:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2355
+//line mysql_sql.y:2493
 		{
 			yyLOCAL = yyDollar[1].limitUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 375:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2361
+//line mysql_sql.y:2499
 		{
 			yyLOCAL = &tree.Limit{Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 376:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2365
+//line mysql_sql.y:2503
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[2].exprUnion(), Count: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 377:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Limit
-//line mysql_sql.y:2369
+//line mysql_sql.y:2507
 		{
 			yyLOCAL = &tree.Limit{Offset: yyDollar[4].exprUnion(), Count: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 378:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2374
+//line mysql_sql.y:2512
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 379:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2378
+//line mysql_sql.y:2516
 		{
 			yyLOCAL = yyDollar[1].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2384
+//line mysql_sql.y:2522
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 381:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2390
+//line mysql_sql.y:2528
 		{
 			yyLOCAL = tree.OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 382:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.OrderBy
-//line mysql_sql.y:2394
+//line mysql_sql.y:2532
 		{
 			yyLOCAL = append(yyDollar[1].orderByUnion(), yyDollar[3].orderUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Order
-//line mysql_sql.y:2400
+//line mysql_sql.y:2538
 		{
 			yyLOCAL = &tree.Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 359:
+	case 384:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2405
+//line mysql_sql.y:2543
 		{
 			yyLOCAL = tree.DefaultDirection
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2409
+//line mysql_sql.y:2547
 		{
 			yyLOCAL = tree.Ascending
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Direction
-//line mysql_sql.y:2413
+//line mysql_sql.y:2551
 		{
 			yyLOCAL = tree.Descending
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 387:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2420
+//line mysql_sql.y:2558
 		{
 			yyLOCAL = &tree.ParenSelect{Select: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 388:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2424
+//line mysql_sql.y:2562
 		{
 			yyLOCAL = &tree.ParenSelect{Select: &tree.Select{Select: yyDollar[2].selectStatementUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 389:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2430
+//line mysql_sql.y:2568
 		{
 			yyLOCAL = yyDollar[1].selectStatementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 390:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2434
+//line mysql_sql.y:2572
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7484,10 +8102,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 391:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2444
+//line mysql_sql.y:2582
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7498,10 +8116,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 392:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2454
+//line mysql_sql.y:2592
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7512,10 +8130,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 393:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2464
+//line mysql_sql.y:2602
 		{
 			yyLOCAL = &tree.UnionClause{
 				Type:     yyDollar[2].unionTypeRecordUnion().Type,
@@ -7526,10 +8144,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2476
+//line mysql_sql.y:2614
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7538,10 +8156,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 395:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2484
+//line mysql_sql.y:2622
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7550,10 +8168,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 396:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UnionTypeRecord
-//line mysql_sql.y:2492
+//line mysql_sql.y:2630
 		{
 			yyLOCAL = &tree.UnionTypeRecord{
 				Type:     tree.UNION,
@@ -7562,10 +8180,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 397:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.SelectStatement
-//line mysql_sql.y:2502
+//line mysql_sql.y:2640
 		{
 			yyLOCAL = &tree.SelectClause{
 				Distinct: yyDollar[2].boolValUnion(),
@@ -7577,130 +8195,130 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 398:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2514
+//line mysql_sql.y:2652
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 399:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2518
+//line mysql_sql.y:2656
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 400:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:2522
+//line mysql_sql.y:2660
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 403:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2531
+//line mysql_sql.y:2669
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 404:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2535
+//line mysql_sql.y:2673
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstHaving, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 405:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2540
+//line mysql_sql.y:2678
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.GroupBy
-//line mysql_sql.y:2544
+//line mysql_sql.y:2682
 		{
 			yyLOCAL = tree.GroupBy(yyDollar[3].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 407:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2549
+//line mysql_sql.y:2687
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 408:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Where
-//line mysql_sql.y:2553
+//line mysql_sql.y:2691
 		{
 			yyLOCAL = &tree.Where{Type: tree.AstWhere, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 409:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2559
+//line mysql_sql.y:2697
 		{
 			yyLOCAL = tree.SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 410:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExprs
-//line mysql_sql.y:2563
+//line mysql_sql.y:2701
 		{
 			yyLOCAL = append(yyDollar[1].selectExprsUnion(), yyDollar[3].selectExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 411:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2569
+//line mysql_sql.y:2707
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.StarExpr()}
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2573
+//line mysql_sql.y:2711
 		{
 			yyLOCAL = tree.SelectExpr{Expr: yyDollar[1].exprUnion(), As: tree.UnrestrictedIdentifier(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2577
+//line mysql_sql.y:2715
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 414:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.SelectExpr
-//line mysql_sql.y:2581
+//line mysql_sql.y:2719
 		{
 			yyLOCAL = tree.SelectExpr{Expr: tree.SetUnresolvedNameWithStar(yyDollar[3].str, yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 415:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2587
+//line mysql_sql.y:2725
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			tn := tree.NewTableName(tree.Identifier("dual"), prefix)
@@ -7709,44 +8327,44 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 416:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2595
+//line mysql_sql.y:2733
 		{
 			yyLOCAL = yyDollar[1].fromUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 417:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.From
-//line mysql_sql.y:2601
+//line mysql_sql.y:2739
 		{
 			yyLOCAL = &tree.From{
 				Tables: yyDollar[2].tableExprsUnion(),
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 418:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2609
+//line mysql_sql.y:2747
 		{
 			yyLOCAL = tree.TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExprs
-//line mysql_sql.y:2613
+//line mysql_sql.y:2751
 		{
 			yyLOCAL = append(yyDollar[1].tableExprsUnion(), yyDollar[3].tableExprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 422:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2623
+//line mysql_sql.y:2761
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7756,10 +8374,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 423:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2632
+//line mysql_sql.y:2770
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7769,10 +8387,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 424:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2642
+//line mysql_sql.y:2780
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7782,10 +8400,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 425:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2651
+//line mysql_sql.y:2789
 		{
 			yyLOCAL = &tree.JoinTableExpr{
 				Left:     yyDollar[1].tableExprUnion(),
@@ -7794,15 +8412,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 426:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2661
+//line mysql_sql.y:2799
 		{
 			yyVAL.str = tree.JOIN_TYPE_NATURAL
 		}
-	case 402:
+	case 427:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2665
+//line mysql_sql.y:2803
 		{
 			if yyDollar[2].str == tree.JOIN_TYPE_LEFT {
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_LEFT
@@ -7810,130 +8428,130 @@ yydefault:
 				yyVAL.str = tree.JOIN_TYPE_NATURAL_RIGHT
 			}
 		}
-	case 403:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2675
+//line mysql_sql.y:2813
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 404:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2679
+//line mysql_sql.y:2817
 		{
 			yyVAL.str = tree.JOIN_TYPE_LEFT
 		}
-	case 405:
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2683
+//line mysql_sql.y:2821
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 406:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line mysql_sql.y:2687
+//line mysql_sql.y:2825
 		{
 			yyVAL.str = tree.JOIN_TYPE_RIGHT
 		}
-	case 407:
+	case 432:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2693
+//line mysql_sql.y:2831
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 433:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2697
+//line mysql_sql.y:2835
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 434:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2703
+//line mysql_sql.y:2841
 		{
 			yyVAL.str = tree.JOIN_TYPE_STRAIGHT
 		}
-	case 410:
+	case 435:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2709
+//line mysql_sql.y:2847
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 411:
+	case 436:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2713
+//line mysql_sql.y:2851
 		{
 			yyVAL.str = tree.JOIN_TYPE_INNER
 		}
-	case 412:
+	case 437:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2717
+//line mysql_sql.y:2855
 		{
 			yyVAL.str = tree.JOIN_TYPE_CROSS
 		}
-	case 413:
+	case 438:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2723
+//line mysql_sql.y:2861
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 414:
+	case 439:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2727
+//line mysql_sql.y:2865
 		{
 			yyLOCAL = yyDollar[1].joinCondUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 440:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2733
+//line mysql_sql.y:2871
 		{
 			yyLOCAL = &tree.OnJoinCond{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 441:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.JoinCond
-//line mysql_sql.y:2737
+//line mysql_sql.y:2875
 		{
 			yyLOCAL = &tree.UsingJoinCond{Cols: yyDollar[3].identifierListUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 442:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2743
+//line mysql_sql.y:2881
 		{
 			yyLOCAL = tree.IdentifierList{tree.Identifier(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 443:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.IdentifierList
-//line mysql_sql.y:2747
+//line mysql_sql.y:2885
 		{
 			yyLOCAL = append(yyDollar[1].identifierListUnion(), tree.Identifier(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 419:
+	case 444:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2753
+//line mysql_sql.y:2891
 		{
 			yyLOCAL = yyDollar[1].aliasedTableExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 445:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableExpr
-//line mysql_sql.y:2757
+//line mysql_sql.y:2895
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].parenTableExprUnion(),
@@ -7944,28 +8562,28 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ParenTableExpr
-//line mysql_sql.y:2770
+//line mysql_sql.y:2908
 		{
 			yyLOCAL = &tree.ParenTableExpr{Expr: yyDollar[2].selectUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 447:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2775
+//line mysql_sql.y:2913
 		{
 		}
-	case 423:
+	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2776
+//line mysql_sql.y:2914
 		{
 		}
-	case 424:
+	case 449:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.AliasedTableExpr
-//line mysql_sql.y:2780
+//line mysql_sql.y:2918
 		{
 			yyLOCAL = &tree.AliasedTableExpr{
 				Expr: yyDollar[1].tableNameUnion(),
@@ -7975,46 +8593,60 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
+	case 450:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.AliasedTableExpr
+//line mysql_sql.y:2927
+		{
+			yyLOCAL = &tree.AliasedTableExpr{
+				Expr: yyDollar[1].tableNameUnion(),
+				As: tree.AliasClause{
+					Alias: tree.Identifier(yyDollar[6].str),
+				},
+				AsOf: yyDollar[5].str,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 451:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2792
+//line mysql_sql.y:2940
 		{
 			yyVAL.str = ""
 		}
-	case 426:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2796
+//line mysql_sql.y:2944
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 427:
+	case 453:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2800
+//line mysql_sql.y:2948
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 430:
+	case 456:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:2809
+//line mysql_sql.y:2957
 		{
 			yyVAL.str = ""
 		}
-	case 431:
+	case 457:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:2813
+//line mysql_sql.y:2961
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 432:
+	case 458:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:2817
+//line mysql_sql.y:2965
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 446:
+	case 472:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:2846
+//line mysql_sql.y:2994
 		{
 			yyLOCAL = &tree.CreateUser{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -8024,189 +8656,189 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 473:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2856
+//line mysql_sql.y:3004
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 474:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2860
+//line mysql_sql.y:3008
 		{
 			yyLOCAL = yyDollar[2].resourceOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2866
+//line mysql_sql.y:3014
 		{
 			yyLOCAL = []tree.ResourceOption{yyDollar[1].resourceOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 476:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ResourceOption
-//line mysql_sql.y:2870
+//line mysql_sql.y:3018
 		{
 			yyLOCAL = append(yyDollar[1].resourceOptionsUnion(), yyDollar[2].resourceOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 477:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2876
+//line mysql_sql.y:3024
 		{
 			yyLOCAL = &tree.ResourceOptionMaxQueriesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 478:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2880
+//line mysql_sql.y:3028
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUpdatesPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 479:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2884
+//line mysql_sql.y:3032
 		{
 			yyLOCAL = &tree.ResourceOptionMaxConnectionPerHour{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ResourceOption
-//line mysql_sql.y:2888
+//line mysql_sql.y:3036
 		{
 			yyLOCAL = &tree.ResourceOptionMaxUserConnections{Count: yyDollar[2].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 481:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2894
+//line mysql_sql.y:3042
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 483:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2901
+//line mysql_sql.y:3049
 		{
 			t := &tree.TlsOptionNone{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 484:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2906
+//line mysql_sql.y:3054
 		{
 			t := &tree.TlsOptionSSL{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2911
+//line mysql_sql.y:3059
 		{
 			t := &tree.TlsOptionX509{}
 			yyLOCAL = []tree.TlsOption{t}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2916
+//line mysql_sql.y:3064
 		{
 			yyLOCAL = yyDollar[2].tlsOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 487:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2922
+//line mysql_sql.y:3070
 		{
 			yyLOCAL = []tree.TlsOption{yyDollar[1].tlsOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 488:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2926
+//line mysql_sql.y:3074
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[3].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TlsOption
-//line mysql_sql.y:2930
+//line mysql_sql.y:3078
 		{
 			yyLOCAL = append(yyDollar[1].tlsOptionsUnion(), yyDollar[2].tlsOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2936
+//line mysql_sql.y:3084
 		{
 			yyLOCAL = &tree.TlsOptionIssuer{Issuer: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 491:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2940
+//line mysql_sql.y:3088
 		{
 			yyLOCAL = &tree.TlsOptionSubject{Subject: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 492:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2944
+//line mysql_sql.y:3092
 		{
 			yyLOCAL = &tree.TlsOptionCipher{Cipher: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TlsOption
-//line mysql_sql.y:2948
+//line mysql_sql.y:3096
 		{
 			yyLOCAL = &tree.TlsOptionSan{San: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 494:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2954
+//line mysql_sql.y:3102
 		{
 			yyLOCAL = []*tree.User{yyDollar[1].userUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 495:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.User
-//line mysql_sql.y:2958
+//line mysql_sql.y:3106
 		{
 			yyLOCAL = append(yyDollar[1].usersUnion(), yyDollar[3].userUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 496:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.User
-//line mysql_sql.y:2964
+//line mysql_sql.y:3112
 		{
 			yyLOCAL = &tree.User{
 				Username:   yyDollar[1].usernameRecordUnion().Username,
@@ -8218,42 +8850,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 471:
+	case 497:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2977
+//line mysql_sql.y:3125
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 472:
+	case 498:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2981
+//line mysql_sql.y:3129
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 473:
+	case 499:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.UsernameRecord
-//line mysql_sql.y:2985
+//line mysql_sql.y:3133
 		{
 			yyLOCAL = &tree.UsernameRecord{Username: yyDollar[1].str, Hostname: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 474:
+	case 500:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2990
+//line mysql_sql.y:3138
 		{
 			yyLOCAL = &tree.AuthRecord{}
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 501:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:2994
+//line mysql_sql.y:3142
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthString: yyDollar[3].str,
@@ -8261,20 +8893,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 502:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3001
+//line mysql_sql.y:3149
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 477:
+	case 503:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3007
+//line mysql_sql.y:3155
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -8283,10 +8915,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 478:
+	case 504:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3015
+//line mysql_sql.y:3163
 		{
 			yyLOCAL = &tree.AuthRecord{
 				AuthPlugin: yyDollar[3].str,
@@ -8294,20 +8926,20 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 479:
+	case 505:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.AuthRecord
-//line mysql_sql.y:3022
+//line mysql_sql.y:3170
 		{
 			yyLOCAL = &tree.AuthRecord{
 				HashString: yyDollar[4].str,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 508:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3034
+//line mysql_sql.y:3182
 		{
 			yyLOCAL = &tree.CreateRole{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -8315,82 +8947,82 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 509:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3043
+//line mysql_sql.y:3191
 		{
 			yyLOCAL = []*tree.Role{yyDollar[1].roleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 510:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Role
-//line mysql_sql.y:3047
+//line mysql_sql.y:3195
 		{
 			yyLOCAL = append(yyDollar[1].rolesUnion(), yyDollar[3].roleUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3053
+//line mysql_sql.y:3201
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: "%"}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 512:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3057
+//line mysql_sql.y:3205
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 487:
+	case 513:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.Role
-//line mysql_sql.y:3061
+//line mysql_sql.y:3209
 		{
 			yyLOCAL = &tree.Role{UserName: yyDollar[1].str, HostName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 516:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3070
+//line mysql_sql.y:3218
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_NONE
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3074
+//line mysql_sql.y:3222
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_FULLTEXT
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 518:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3078
+//line mysql_sql.y:3226
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_SPATIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 519:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.IndexCategory
-//line mysql_sql.y:3082
+//line mysql_sql.y:3230
 		{
 			yyLOCAL = tree.INDEX_CATEGORY_UNIQUE
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 520:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3088
+//line mysql_sql.y:3236
 		{
 			var io *tree.IndexOption = nil
 			if yyDollar[11].indexOptionUnion() == nil && yyDollar[5].indexTypeUnion() != tree.INDEX_TYPE_INVALID {
@@ -8409,18 +9041,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 521:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3107
+//line mysql_sql.y:3255
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3111
+//line mysql_sql.y:3259
 		{
 			// Merge the options
 			if yyDollar[1].indexOptionUnion() == nil {
@@ -8441,123 +9073,123 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 497:
+	case 523:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3133
+//line mysql_sql.y:3281
 		{
 			yyLOCAL = &tree.IndexOption{KeyBlockSize: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 498:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3137
+//line mysql_sql.y:3285
 		{
 			yyLOCAL = &tree.IndexOption{Comment: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 499:
+	case 525:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3141
+//line mysql_sql.y:3289
 		{
 			yyLOCAL = &tree.IndexOption{ParserName: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 500:
+	case 526:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3145
+//line mysql_sql.y:3293
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_VISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 527:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.IndexOption
-//line mysql_sql.y:3149
+//line mysql_sql.y:3297
 		{
 			yyLOCAL = &tree.IndexOption{Visible: tree.VISIBLE_TYPE_INVISIBLE}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 528:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3155
+//line mysql_sql.y:3303
 		{
 			yyLOCAL = []*tree.KeyPart{yyDollar[1].keyPartUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 529:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:3159
+//line mysql_sql.y:3307
 		{
 			yyLOCAL = append(yyDollar[1].keyPartsUnion(), yyDollar[3].keyPartUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 530:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3165
+//line mysql_sql.y:3313
 		{
 			// Order is parsed but just ignored as MySQL did.
 			yyLOCAL = &tree.KeyPart{ColName: yyDollar[1].unresolvedNameUnion(), Length: int(yyDollar[2].lengthOptUnion()), Direction: yyDollar[3].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 531:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.KeyPart
-//line mysql_sql.y:3170
+//line mysql_sql.y:3318
 		{
 			yyLOCAL = &tree.KeyPart{Expr: yyDollar[2].exprUnion(), Direction: yyDollar[4].directionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 532:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3175
+//line mysql_sql.y:3323
 		{
 			yyLOCAL = tree.INDEX_TYPE_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 533:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3179
+//line mysql_sql.y:3327
 		{
 			yyLOCAL = tree.INDEX_TYPE_BTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 534:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3183
+//line mysql_sql.y:3331
 		{
 			yyLOCAL = tree.INDEX_TYPE_HASH
 		}
 		yyVAL.union = yyLOCAL
-	case 509:
+	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3187
+//line mysql_sql.y:3335
 		{
 			yyLOCAL = tree.INDEX_TYPE_RTREE
 		}
 		yyVAL.union = yyLOCAL
-	case 510:
+	case 536:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.IndexType
-//line mysql_sql.y:3191
+//line mysql_sql.y:3339
 		{
 			yyLOCAL = tree.INDEX_TYPE_BSI
 		}
 		yyVAL.union = yyLOCAL
-	case 511:
+	case 537:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3197
+//line mysql_sql.y:3345
 		{
 			yyLOCAL = &tree.CreateDatabase{
 				IfNotExists:   yyDollar[3].ifNotExistsUnion(),
@@ -8566,98 +9198,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 540:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3211
+//line mysql_sql.y:3359
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 541:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3215
+//line mysql_sql.y:3363
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 516:
+	case 542:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3220
+//line mysql_sql.y:3368
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 517:
+	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3224
+//line mysql_sql.y:3372
 		{
 			yyLOCAL = yyDollar[1].createOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 518:
+	case 544:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3230
+//line mysql_sql.y:3378
 		{
 			yyLOCAL = []tree.CreateOption{yyDollar[1].createOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 545:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.CreateOption
-//line mysql_sql.y:3234
+//line mysql_sql.y:3382
 		{
 			yyLOCAL = append(yyDollar[1].createOptionsUnion(), yyDollar[2].createOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 546:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3240
+//line mysql_sql.y:3388
 		{
 			yyLOCAL = &tree.CreateOptionCharset{IsDefault: yyDollar[1].defaultOptionalUnion(), Charset: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 547:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3244
+//line mysql_sql.y:3392
 		{
 			yyLOCAL = &tree.CreateOptionCollate{IsDefault: yyDollar[1].defaultOptionalUnion(), Collate: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 548:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.CreateOption
-//line mysql_sql.y:3248
+//line mysql_sql.y:3396
 		{
 			yyLOCAL = &tree.CreateOptionEncryption{Encrypt: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 549:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3253
+//line mysql_sql.y:3401
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3257
+//line mysql_sql.y:3405
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 525:
+	case 551:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL tree.Statement
-//line mysql_sql.y:3263
+//line mysql_sql.y:3411
 		{
 			yyLOCAL = &tree.CreateTable{
 				Temporary:       yyDollar[2].boolValUnion(),
@@ -8669,34 +9301,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 552:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3275
+//line mysql_sql.y:3423
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3279
+//line mysql_sql.y:3427
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 554:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3284
+//line mysql_sql.y:3432
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 555:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionOption
-//line mysql_sql.y:3288
+//line mysql_sql.y:3436
 		{
 			yyDollar[3].partitionByUnion().Num = uint64(yyDollar[4].int64ValUnion())
 			yyLOCAL = &tree.PartitionOption{
@@ -8706,18 +9338,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 556:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3298
+//line mysql_sql.y:3446
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 557:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3302
+//line mysql_sql.y:3450
 		{
 			yyLOCAL = &tree.PartitionBy{
 				IsSubPartition: true,
@@ -8726,42 +9358,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 558:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3311
+//line mysql_sql.y:3459
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
+	case 559:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3315
+//line mysql_sql.y:3463
 		{
 			yyLOCAL = yyDollar[2].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 560:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3321
+//line mysql_sql.y:3469
 		{
 			yyLOCAL = []*tree.Partition{yyDollar[1].partitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 561:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.Partition
-//line mysql_sql.y:3325
+//line mysql_sql.y:3473
 		{
 			yyLOCAL = append(yyDollar[1].partitionsUnion(), yyDollar[3].partitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 562:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3331
+//line mysql_sql.y:3479
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8771,10 +9403,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 563:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.Partition
-//line mysql_sql.y:3340
+//line mysql_sql.y:3488
 		{
 			yyLOCAL = &tree.Partition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8784,42 +9416,42 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 564:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3350
+//line mysql_sql.y:3498
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 539:
+	case 565:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3354
+//line mysql_sql.y:3502
 		{
 			yyLOCAL = yyDollar[2].subPartitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 540:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3360
+//line mysql_sql.y:3508
 		{
 			yyLOCAL = []*tree.SubPartition{yyDollar[1].subPartitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 541:
+	case 567:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.SubPartition
-//line mysql_sql.y:3364
+//line mysql_sql.y:3512
 		{
 			yyLOCAL = append(yyDollar[1].subPartitionsUnion(), yyDollar[3].subPartitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 542:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3370
+//line mysql_sql.y:3518
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8827,10 +9459,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 543:
+	case 569:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.SubPartition
-//line mysql_sql.y:3377
+//line mysql_sql.y:3525
 		{
 			yyLOCAL = &tree.SubPartition{
 				Name:    tree.Identifier(yyDollar[2].str),
@@ -8838,50 +9470,50 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 544:
+	case 570:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3386
+//line mysql_sql.y:3534
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 545:
+	case 571:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3390
+//line mysql_sql.y:3538
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 546:
+	case 572:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3395
+//line mysql_sql.y:3543
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 547:
+	case 573:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Values
-//line mysql_sql.y:3399
+//line mysql_sql.y:3547
 		{
 			yyLOCAL = &tree.ValuesLessThan{ValueList: yyDollar[5].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 548:
+	case 574:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3404
+//line mysql_sql.y:3552
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 549:
+	case 575:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3408
+//line mysql_sql.y:3556
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8891,18 +9523,18 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 550:
+	case 576:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3418
+//line mysql_sql.y:3566
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 551:
+	case 577:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3422
+//line mysql_sql.y:3570
 		{
 			res := yyDollar[2].item.(int64)
 			if res == 0 {
@@ -8912,10 +9544,10 @@ yydefault:
 			yyLOCAL = res
 		}
 		yyVAL.union = yyLOCAL
-	case 552:
+	case 578:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3433
+//line mysql_sql.y:3581
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -8924,10 +9556,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 579:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3441
+//line mysql_sql.y:3589
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.RangeType{
@@ -8936,10 +9568,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 580:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3449
+//line mysql_sql.y:3597
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -8948,10 +9580,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 581:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3457
+//line mysql_sql.y:3605
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.ListType{
@@ -8960,10 +9592,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 557:
+	case 583:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3468
+//line mysql_sql.y:3616
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.KeyType{
@@ -8974,10 +9606,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 558:
+	case 584:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.PartitionBy
-//line mysql_sql.y:3478
+//line mysql_sql.y:3626
 		{
 			yyLOCAL = &tree.PartitionBy{
 				PType: &tree.HashType{
@@ -8987,478 +9619,478 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 559:
+	case 585:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3488
+//line mysql_sql.y:3636
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 560:
+	case 586:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:3492
+//line mysql_sql.y:3640
 		{
 			yyLOCAL = yyDollar[3].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 561:
+	case 587:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3497
+//line mysql_sql.y:3645
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3501
+//line mysql_sql.y:3649
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 589:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3506
+//line mysql_sql.y:3654
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 590:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3510
+//line mysql_sql.y:3658
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 565:
+	case 591:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3516
+//line mysql_sql.y:3664
 		{
 			yyLOCAL = []tree.TableOption{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 566:
+	case 592:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3520
+//line mysql_sql.y:3668
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[3].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 567:
+	case 593:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.TableOption
-//line mysql_sql.y:3524
+//line mysql_sql.y:3672
 		{
 			yyLOCAL = append(yyDollar[1].tableOptionsUnion(), yyDollar[2].tableOptionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 594:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3530
+//line mysql_sql.y:3678
 		{
 			yyLOCAL = tree.NewTableOptionAutoIncrement(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 595:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3534
+//line mysql_sql.y:3682
 		{
 			yyLOCAL = tree.NewTableOptionAvgRowLength(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 596:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3538
+//line mysql_sql.y:3686
 		{
 			yyLOCAL = tree.NewTableOptionCharset(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 597:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3542
+//line mysql_sql.y:3690
 		{
 			yyLOCAL = tree.NewTableOptionCollate(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 598:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3546
+//line mysql_sql.y:3694
 		{
 			yyLOCAL = tree.NewTableOptionChecksum(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 573:
+	case 599:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3550
+//line mysql_sql.y:3698
 		{
 			yyLOCAL = tree.NewTableOptionComment(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 574:
+	case 600:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3554
+//line mysql_sql.y:3702
 		{
 			yyLOCAL = tree.NewTableOptionCompression(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 575:
+	case 601:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3558
+//line mysql_sql.y:3706
 		{
 			yyLOCAL = tree.NewTableOptionConnection(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 576:
+	case 602:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3562
+//line mysql_sql.y:3710
 		{
 			yyLOCAL = tree.NewTableOptionDataDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 577:
+	case 603:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3566
+//line mysql_sql.y:3714
 		{
 			yyLOCAL = tree.NewTableOptionIndexDirectory(yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 578:
+	case 604:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3570
+//line mysql_sql.y:3718
 		{
 			yyLOCAL = tree.NewTableOptionDelayKeyWrite(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 579:
+	case 605:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3574
+//line mysql_sql.y:3722
 		{
 			yyLOCAL = tree.NewTableOptionEncryption(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 580:
+	case 606:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3578
+//line mysql_sql.y:3726
 		{
 			yyLOCAL = tree.NewTableOptionEngine(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 581:
+	case 607:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3582
+//line mysql_sql.y:3730
 		{
 			yyLOCAL = tree.NewTableOptionKeyBlockSize(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 582:
+	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3586
+//line mysql_sql.y:3734
 		{
 			yyLOCAL = tree.NewTableOptionMaxRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 583:
+	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3590
+//line mysql_sql.y:3738
 		{
 			yyLOCAL = tree.NewTableOptionMinRows(uint64(yyDollar[3].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 584:
+	case 610:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3594
+//line mysql_sql.y:3742
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Value: yyDollar[3].item.(int64)}
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3598
+//line mysql_sql.y:3746
 		{
 			yyLOCAL = &tree.TableOptionPackKeys{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 586:
+	case 612:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3602
+//line mysql_sql.y:3750
 		{
 			yyLOCAL = tree.NewTableOptionPassword(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3606
+//line mysql_sql.y:3754
 		{
 			yyLOCAL = tree.NewTableOptionRowFormat(yyDollar[3].rowFormatTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 614:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3610
+//line mysql_sql.y:3758
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 615:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3614
+//line mysql_sql.y:3762
 		{
 			yyLOCAL = &tree.TableOptionStatsAutoRecalc{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 616:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3618
+//line mysql_sql.y:3766
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 591:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3622
+//line mysql_sql.y:3770
 		{
 			yyLOCAL = &tree.TableOptionStatsPersistent{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 592:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3626
+//line mysql_sql.y:3774
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Value: uint64(yyDollar[3].item.(int64))}
 		}
 		yyVAL.union = yyLOCAL
-	case 593:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3630
+//line mysql_sql.y:3778
 		{
 			yyLOCAL = &tree.TableOptionStatsSamplePages{Default: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 594:
+	case 620:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3634
+//line mysql_sql.y:3782
 		{
 			yyLOCAL = tree.NewTableOptionTablespace(yyDollar[3].str, yyDollar[4].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 595:
+	case 621:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3638
+//line mysql_sql.y:3786
 		{
 			yyLOCAL = tree.NewTableOptionUnion(yyDollar[4].tableNamesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 622:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.TableOption
-//line mysql_sql.y:3642
+//line mysql_sql.y:3790
 		{
 			yyLOCAL = &tree.TableOptionProperties{Preperties: yyDollar[3].propertiesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3649
+//line mysql_sql.y:3797
 		{
 			yyLOCAL = []tree.Property{yyDollar[1].propertyUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 624:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []tree.Property
-//line mysql_sql.y:3653
+//line mysql_sql.y:3801
 		{
 			yyLOCAL = append(yyDollar[1].propertiesUnion(), yyDollar[3].propertyUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 599:
+	case 625:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Property
-//line mysql_sql.y:3659
+//line mysql_sql.y:3807
 		{
 			yyLOCAL = tree.Property{Key: yyDollar[1].str, Value: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 600:
+	case 626:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3664
+//line mysql_sql.y:3812
 		{
 			yyVAL.str = ""
 		}
-	case 601:
+	case 627:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3668
+//line mysql_sql.y:3816
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 602:
+	case 628:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:3672
+//line mysql_sql.y:3820
 		{
 			yyVAL.str = " " + yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 603:
+	case 629:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3678
+//line mysql_sql.y:3826
 		{
 			yyLOCAL = tree.ROW_FORMAT_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 630:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3682
+//line mysql_sql.y:3830
 		{
 			yyLOCAL = tree.ROW_FORMAT_DYNAMIC
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3686
+//line mysql_sql.y:3834
 		{
 			yyLOCAL = tree.ROW_FORMAT_FIXED
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 632:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3690
+//line mysql_sql.y:3838
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPRESSED
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 633:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3694
+//line mysql_sql.y:3842
 		{
 			yyLOCAL = tree.ROW_FORMAT_REDUNDANT
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.RowFormatType
-//line mysql_sql.y:3698
+//line mysql_sql.y:3846
 		{
 			yyLOCAL = tree.ROW_FORMAT_COMPACT
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 641:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3714
+//line mysql_sql.y:3862
 		{
 			yyLOCAL = tree.TableNames{yyDollar[1].tableNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableNames
-//line mysql_sql.y:3718
+//line mysql_sql.y:3866
 		{
 			yyLOCAL = append(yyDollar[1].tableNamesUnion(), yyDollar[3].tableNameUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3727
+//line mysql_sql.y:3875
 		{
 			prefix := tree.ObjectNamePrefix{ExplicitSchema: false}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[1].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 618:
+	case 644:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.TableName
-//line mysql_sql.y:3732
+//line mysql_sql.y:3880
 		{
 			prefix := tree.ObjectNamePrefix{SchemaName: tree.Identifier(yyDollar[1].str), ExplicitSchema: true}
 			yyLOCAL = tree.NewTableName(tree.Identifier(yyDollar[3].str), prefix)
 		}
 		yyVAL.union = yyLOCAL
-	case 619:
+	case 645:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3738
+//line mysql_sql.y:3886
 		{
 			yyLOCAL = tree.TableDefs(nil)
 		}
 		yyVAL.union = yyLOCAL
-	case 621:
+	case 647:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3745
+//line mysql_sql.y:3893
 		{
 			yyLOCAL = tree.TableDefs{yyDollar[1].tableDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 622:
+	case 648:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.TableDefs
-//line mysql_sql.y:3749
+//line mysql_sql.y:3897
 		{
 			yyLOCAL = append(yyDollar[1].tableDefsUnion(), yyDollar[3].tableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 623:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3755
+//line mysql_sql.y:3903
 		{
 			yyLOCAL = tree.TableDef(yyDollar[1].columnTableDefUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 624:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3759
+//line mysql_sql.y:3907
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 625:
+	case 651:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3765
+//line mysql_sql.y:3913
 		{
 			if yyDollar[1].str != "" {
 				switch v := yyDollar[2].tableDefUnion().(type) {
@@ -9469,18 +10101,18 @@ yydefault:
 			yyLOCAL = yyDollar[2].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 626:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3775
+//line mysql_sql.y:3923
 		{
 			yyLOCAL = yyDollar[1].tableDefUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 627:
+	case 653:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3781
+//line mysql_sql.y:3929
 		{
 			yyLOCAL = &tree.PrimaryKeyIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9490,10 +10122,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 628:
+	case 654:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3790
+//line mysql_sql.y:3938
 		{
 			yyLOCAL = &tree.FullTextIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9503,10 +10135,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 629:
+	case 655:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3799
+//line mysql_sql.y:3947
 		{
 			keyTyp := tree.INDEX_TYPE_INVALID
 			if yyDollar[3].strsUnion()[1] != "" {
@@ -9530,10 +10162,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 630:
+	case 656:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3822
+//line mysql_sql.y:3970
 		{
 			yyLOCAL = &tree.UniqueIndex{
 				KeyParts:    yyDollar[5].keyPartsUnion(),
@@ -9543,10 +10175,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 657:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3831
+//line mysql_sql.y:3979
 		{
 			yyLOCAL = &tree.ForeignKey{
 				IfNotExists: yyDollar[3].ifNotExistsUnion(),
@@ -9557,10 +10189,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 658:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.TableDef
-//line mysql_sql.y:3841
+//line mysql_sql.y:3989
 		{
 			yyLOCAL = &tree.CheckIndex{
 				Expr:     yyDollar[3].exprUnion(),
@@ -9568,298 +10200,298 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 659:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:3849
+//line mysql_sql.y:3997
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 661:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3855
+//line mysql_sql.y:4003
 		{
 			yyVAL.str = ""
 		}
-	case 636:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:3859
+//line mysql_sql.y:4007
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 639:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3869
+//line mysql_sql.y:4017
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = ""
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 666:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3875
+//line mysql_sql.y:4023
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 641:
+	case 667:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:3881
+//line mysql_sql.y:4029
 		{
 			yyLOCAL = make([]string, 2)
 			yyLOCAL[0] = yyDollar[1].str
 			yyLOCAL[1] = yyDollar[3].str
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 673:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:3895
+//line mysql_sql.y:4043
 		{
 			yyVAL.str = ""
 		}
-	case 649:
+	case 675:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.ColumnTableDef
-//line mysql_sql.y:3902
+//line mysql_sql.y:4050
 		{
 			yyLOCAL = tree.NewColumnTableDef(yyDollar[1].unresolvedNameUnion(), yyDollar[2].columnTypeUnion(), yyDollar[3].columnAttributesUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3908
+//line mysql_sql.y:4056
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 677:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3912
+//line mysql_sql.y:4060
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 678:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3916
+//line mysql_sql.y:4064
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3927
+//line mysql_sql.y:4075
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 683:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3931
+//line mysql_sql.y:4079
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 684:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.UnresolvedName
-//line mysql_sql.y:3935
+//line mysql_sql.y:4083
 		{
 			yyLOCAL = tree.SetUnresolvedName(yyDollar[1].str, yyDollar[3].str, yyDollar[5].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 685:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3940
+//line mysql_sql.y:4088
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3944
+//line mysql_sql.y:4092
 		{
 			yyLOCAL = yyDollar[1].columnAttributesUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 661:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3950
+//line mysql_sql.y:4098
 		{
 			yyLOCAL = []tree.ColumnAttribute{yyDollar[1].columnAttributeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 662:
+	case 688:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []tree.ColumnAttribute
-//line mysql_sql.y:3954
+//line mysql_sql.y:4102
 		{
 			yyLOCAL = append(yyDollar[1].columnAttributesUnion(), yyDollar[2].columnAttributeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3960
+//line mysql_sql.y:4108
 		{
 			yyLOCAL = tree.NewAttributeNull(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 690:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3964
+//line mysql_sql.y:4112
 		{
 			yyLOCAL = tree.NewAttributeNull(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 691:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3968
+//line mysql_sql.y:4116
 		{
 			yyLOCAL = tree.NewAttributeDefault(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 692:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3972
+//line mysql_sql.y:4120
 		{
 			yyLOCAL = tree.NewAttributeAutoIncrement()
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3976
+//line mysql_sql.y:4124
 		{
 			yyLOCAL = yyDollar[1].columnAttributeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 694:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3980
+//line mysql_sql.y:4128
 		{
 			yyLOCAL = tree.NewAttributeComment(tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false))
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 695:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3984
+//line mysql_sql.y:4132
 		{
 			yyLOCAL = tree.NewAttributeCollate(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 696:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3988
+//line mysql_sql.y:4136
 		{
 			yyLOCAL = tree.NewAttributeColumnFormat(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 697:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3992
+//line mysql_sql.y:4140
 		{
 			yyLOCAL = tree.NewAttributeStorage(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 698:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:3996
+//line mysql_sql.y:4144
 		{
 			yyLOCAL = tree.NewAttributeAutoRandom(int(yyDollar[2].int64ValUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 699:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4000
+//line mysql_sql.y:4148
 		{
 			yyLOCAL = yyDollar[1].attributeReferenceUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 700:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4004
+//line mysql_sql.y:4152
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), false, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 701:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:4008
+//line mysql_sql.y:4156
 		{
 			yyLOCAL = tree.NewAttributeCheck(yyDollar[4].exprUnion(), yyDollar[6].boolValUnion(), yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 702:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4018
+//line mysql_sql.y:4166
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 703:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:4022
+//line mysql_sql.y:4170
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 704:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4027
+//line mysql_sql.y:4175
 		{
 			yyVAL.str = ""
 		}
-	case 679:
+	case 705:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4031
+//line mysql_sql.y:4179
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 680:
+	case 706:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4037
+//line mysql_sql.y:4185
 		{
 			yyVAL.str = ""
 		}
-	case 681:
+	case 707:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4041
+//line mysql_sql.y:4189
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 682:
+	case 708:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.AttributeReference
-//line mysql_sql.y:4047
+//line mysql_sql.y:4195
 		{
 			yyLOCAL = &tree.AttributeReference{
 				TableName: yyDollar[2].tableNameUnion(),
@@ -9870,10 +10502,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 709:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4058
+//line mysql_sql.y:4206
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9881,10 +10513,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 711:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4068
+//line mysql_sql.y:4216
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -9892,10 +10524,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 712:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4075
+//line mysql_sql.y:4223
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: tree.REFERENCE_OPTION_INVALID,
@@ -9903,10 +10535,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 713:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4082
+//line mysql_sql.y:4230
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[1].referenceOptionTypeUnion(),
@@ -9914,10 +10546,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 714:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.ReferenceOnRecord
-//line mysql_sql.y:4089
+//line mysql_sql.y:4237
 		{
 			yyLOCAL = &tree.ReferenceOnRecord{
 				OnDelete: yyDollar[2].referenceOptionTypeUnion(),
@@ -9925,339 +10557,368 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 715:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4098
+//line mysql_sql.y:4246
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 716:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4104
+//line mysql_sql.y:4252
 		{
 			yyLOCAL = yyDollar[3].referenceOptionTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 717:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4110
+//line mysql_sql.y:4258
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_RESTRICT
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 718:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4114
+//line mysql_sql.y:4262
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_CASCADE
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 719:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4118
+//line mysql_sql.y:4266
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_NULL
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 720:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4122
+//line mysql_sql.y:4270
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_NO_ACTION
 		}
 		yyVAL.union = yyLOCAL
-	case 695:
+	case 721:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ReferenceOptionType
-//line mysql_sql.y:4126
+//line mysql_sql.y:4274
 		{
 			yyLOCAL = tree.REFERENCE_OPTION_SET_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 722:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4131
+//line mysql_sql.y:4279
 		{
 			yyLOCAL = tree.MATCH_INVALID
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4138
+//line mysql_sql.y:4286
 		{
 			yyLOCAL = tree.MATCH_FULL
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 725:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4142
+//line mysql_sql.y:4290
 		{
 			yyLOCAL = tree.MATCH_PARTIAL
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.MatchType
-//line mysql_sql.y:4146
+//line mysql_sql.y:4294
 		{
 			yyLOCAL = tree.MATCH_SIMPLE
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 727:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4151
+//line mysql_sql.y:4299
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 728:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*tree.KeyPart
-//line mysql_sql.y:4155
+//line mysql_sql.y:4303
 		{
 			yyLOCAL = yyDollar[2].keyPartsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 729:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4160
+//line mysql_sql.y:4308
 		{
 			yyLOCAL = -1
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 730:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int64
-//line mysql_sql.y:4164
+//line mysql_sql.y:4312
 		{
 			yyLOCAL = yyDollar[2].item.(int64)
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 737:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.Subquery
-//line mysql_sql.y:4180
+//line mysql_sql.y:4328
 		{
 			yyLOCAL = &tree.Subquery{Select: yyDollar[1].selectStatementUnion(), Exists: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 738:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4186
+//line mysql_sql.y:4334
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_AND, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 739:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4190
+//line mysql_sql.y:4338
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_OR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 740:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4194
+//line mysql_sql.y:4342
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.BIT_XOR, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 741:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4198
+//line mysql_sql.y:4346
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.PLUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 742:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4202
+//line mysql_sql.y:4350
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MINUS, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 743:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4206
+//line mysql_sql.y:4354
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MULTI, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 744:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4210
+//line mysql_sql.y:4358
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 745:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4214
+//line mysql_sql.y:4362
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.INTEGER_DIV, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 746:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4218
+//line mysql_sql.y:4366
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 747:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4222
+//line mysql_sql.y:4370
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.MOD, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 748:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4226
+//line mysql_sql.y:4374
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.LEFT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 749:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4230
+//line mysql_sql.y:4378
 		{
 			yyLOCAL = tree.NewBinaryExpr(tree.RIGHT_SHIFT, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 750:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4234
+//line mysql_sql.y:4382
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 725:
+	case 751:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4240
+//line mysql_sql.y:4388
 		{
 			yyLOCAL = yyDollar[1].unresolvedNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 726:
+	case 752:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4392
+		{
+			name := tree.SetUnresolvedName("json_extract")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), tree.NewNumVal(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false)},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 753:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4400
+		{
+			name := tree.SetUnresolvedName("json_extract")
+			e := &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[1].unresolvedNameUnion(), tree.NewNumVal(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false)},
+			}
+			name = tree.SetUnresolvedName("json_unquote")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{e},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 754:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4244
+//line mysql_sql.y:4413
 		{
 			yyLOCAL = yyDollar[1].varExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 727:
+	case 755:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4248
+//line mysql_sql.y:4417
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 728:
+	case 756:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4252
+//line mysql_sql.y:4421
 		{
 			yyLOCAL = tree.NewParenExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 757:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4256
+//line mysql_sql.y:4425
 		{
 			yyLOCAL = tree.NewTuple(append(yyDollar[2].exprsUnion(), yyDollar[4].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 758:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4260
+//line mysql_sql.y:4429
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_PLUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 759:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4264
+//line mysql_sql.y:4433
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 760:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4268
+//line mysql_sql.y:4437
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_TILDE, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 761:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4272
+//line mysql_sql.y:4441
 		{
 			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MARK, yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 762:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4276
+//line mysql_sql.y:4445
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 763:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4280
+//line mysql_sql.y:4449
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 764:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4284
+//line mysql_sql.y:4453
 		{
 			yyDollar[2].subqueryUnion().Exists = true
 			yyLOCAL = yyDollar[2].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 765:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4289
+//line mysql_sql.y:4458
 		{
 			yyLOCAL = &tree.CaseExpr{
 				Expr:  yyDollar[2].exprUnion(),
@@ -10266,26 +10927,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 766:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4297
+//line mysql_sql.y:4466
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 767:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4301
+//line mysql_sql.y:4470
 		{
 			yyLOCAL = tree.NewCastExpr(yyDollar[3].exprUnion(), yyDollar[5].columnTypeUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 768:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4305
+//line mysql_sql.y:4474
 		{
 			name := tree.SetUnresolvedName("convert")
 			es := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
@@ -10295,90 +10956,98 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 769:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4314
+//line mysql_sql.y:4483
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 770:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4318
+//line mysql_sql.y:4487
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 771:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4322
+//line mysql_sql.y:4491
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 772:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4326
+//line mysql_sql.y:4495
 		{
 			yyLOCAL = yyDollar[1].funcExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 773:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:4499
+		{
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 774:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4331
+//line mysql_sql.y:4504
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 775:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4335
+//line mysql_sql.y:4508
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 776:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4340
+//line mysql_sql.y:4513
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 777:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4344
+//line mysql_sql.y:4517
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 778:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4350
+//line mysql_sql.y:4523
 		{
 			yyLOCAL = []*tree.When{yyDollar[1].whenClauseUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 779:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []*tree.When
-//line mysql_sql.y:4354
+//line mysql_sql.y:4527
 		{
 			yyLOCAL = append(yyDollar[1].whenClauseListUnion(), yyDollar[2].whenClauseUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 780:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.When
-//line mysql_sql.y:4360
+//line mysql_sql.y:4533
 		{
 			yyLOCAL = &tree.When{
 				Cond: yyDollar[2].exprUnion(),
@@ -10386,10 +11055,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 782:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4370
+//line mysql_sql.y:4543
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10403,10 +11072,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 783:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4383
+//line mysql_sql.y:4556
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10420,10 +11089,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 784:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4396
+//line mysql_sql.y:4569
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10436,10 +11105,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 785:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4408
+//line mysql_sql.y:4581
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10454,10 +11123,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 786:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4422
+//line mysql_sql.y:4595
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10473,10 +11142,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 787:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4437
+//line mysql_sql.y:4610
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10492,10 +11161,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 788:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4452
+//line mysql_sql.y:4625
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10509,10 +11178,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 789:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:4465
+//line mysql_sql.y:4638
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -10527,15 +11196,15 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 790:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4480
+//line mysql_sql.y:4653
 		{
 		}
-	case 764:
+	case 793:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4486
+//line mysql_sql.y:4659
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10545,10 +11214,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 794:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4495
+//line mysql_sql.y:4668
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10557,10 +11226,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 795:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4503
+//line mysql_sql.y:4676
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10569,10 +11238,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 796:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4511
+//line mysql_sql.y:4684
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10582,10 +11251,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 797:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4520
+//line mysql_sql.y:4693
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10595,10 +11264,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 798:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4529
+//line mysql_sql.y:4702
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10608,10 +11277,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 799:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4538
+//line mysql_sql.y:4711
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10621,10 +11290,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 800:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4547
+//line mysql_sql.y:4720
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			es := tree.NewNumVal(constant.MakeString("*"), "*", false)
@@ -10634,10 +11303,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 801:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4556
+//line mysql_sql.y:4729
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10647,10 +11316,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 802:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4565
+//line mysql_sql.y:4738
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10660,10 +11329,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 803:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4574
+//line mysql_sql.y:4747
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10673,10 +11342,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 804:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4583
+//line mysql_sql.y:4756
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10686,10 +11355,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 805:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4592
+//line mysql_sql.y:4765
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10699,10 +11368,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 806:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4601
+//line mysql_sql.y:4774
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10712,10 +11381,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 807:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4610
+//line mysql_sql.y:4783
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10725,10 +11394,154 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 811:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4799
+		{
+			yyDollar[1].funcExprUnion().WindowSpec = yyDollar[4].windowSpecUnion()
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 812:
+		yyDollar = yyS[yypt-5 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4804
+		{
+			yyDollar[1].funcExprUnion().WindowSpec = yyDollar[4].windowSpecUnion()
+			yyLOCAL = yyDollar[1].funcExprUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 813:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL *tree.WindowSpec
+//line mysql_sql.y:4811
+		{
+			yyLOCAL = &tree.WindowSpec{
+				PartitionBy: yyDollar[1].exprsUnion(),
+				OrderBy:     yyDollar[2].orderByUnion(),
+				Frame:       yyDollar[3].frameClauseUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 814:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:4820
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 815:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Exprs
+//line mysql_sql.y:4824
+		{
+			yyLOCAL = yyDollar[3].exprsUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 816:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4829
+		{
+			yyLOCAL = nil
+		}
+		yyVAL.union = yyLOCAL
+	case 817:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4833
+		{
+			yyDollar[2].frameClauseUnion().Type = tree.FRAME_TYPE_ROWS
+			yyLOCAL = yyDollar[2].frameClauseUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 818:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4838
+		{
+			yyDollar[2].frameClauseUnion().Type = tree.FRAME_TYPE_RANGE
+			yyLOCAL = yyDollar[2].frameClauseUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 819:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4845
+		{
+			yyLOCAL = &tree.FrameClause{Start: yyDollar[1].frameBoundUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 820:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FrameClause
+//line mysql_sql.y:4849
+		{
+			yyLOCAL = &tree.FrameClause{Start: yyDollar[2].frameBoundUnion(), End: yyDollar[4].frameBoundUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 821:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4855
+		{
+			yyLOCAL = &tree.FrameBound{Type: tree.FRAME_BOUND_UNBOUNDED_PRECEDING}
+		}
+		yyVAL.union = yyLOCAL
+	case 822:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4859
+		{
+			yyLOCAL = &tree.FrameBound{Type: tree.FRAME_BOUND_UNBOUNDED_FOLLOWING}
+		}
+		yyVAL.union = yyLOCAL
+	case 823:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4863
+		{
+			yyLOCAL = &tree.FrameBound{Type: tree.FRAME_BOUND_CURRENT_ROW}
+		}
+		yyVAL.union = yyLOCAL
+	case 824:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4867
+		{
+			yyLOCAL = &tree.FrameBound{Type: tree.FRAME_BOUND_PRECEDING, Expr: yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 825:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4871
+		{
+			yyLOCAL = &tree.FrameBound{Type: tree.FRAME_BOUND_FOLLOWING, Expr: yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 826:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4875
+		{
+			yyLOCAL = &tree.FrameBound{Type: tree.FRAME_BOUND_PRECEDING, Expr: yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 827:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FrameBound
+//line mysql_sql.y:4879
+		{
+			yyLOCAL = &tree.FrameBound{Type: tree.FRAME_BOUND_FOLLOWING, Expr: yyDollar[1].exprUnion()}
+		}
+		yyVAL.union = yyLOCAL
+	case 828:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4626
+//line mysql_sql.y:4885
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10737,10 +11550,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 829:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4634
+//line mysql_sql.y:4893
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10749,10 +11562,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 830:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4642
+//line mysql_sql.y:4901
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10761,10 +11574,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 831:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4650
+//line mysql_sql.y:4909
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10773,29 +11586,45 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 832:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4658
+//line mysql_sql.y:4917
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
-			timeUinit := tree.SetUnresolvedName(strings.ToLower(yyDollar[3].str))
+			timeUinit := tree.NewNumVal(constant.MakeString(strings.ToLower(yyDollar[3].str)), strings.ToLower(yyDollar[3].str), false)
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
 				Exprs: tree.Exprs{timeUinit, yyDollar[5].exprUnion()},
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 835:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:4673
+//line mysql_sql.y:4932
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 818:
+	case 864:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:4968
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			var es tree.Exprs = nil
+			if yyDollar[2].exprUnion() != nil {
+				es = append(es, yyDollar[2].exprUnion())
+			}
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: es,
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 865:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4709
+//line mysql_sql.y:4980
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10808,10 +11637,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 866:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4721
+//line mysql_sql.y:4992
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10824,10 +11653,35 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 867:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5004
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 868:
+		yyDollar = yyS[yypt-8 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5012
+		{
+			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
+			unit := tree.NewNumVal(constant.MakeString(strings.ToLower(yyDollar[3].str)), strings.ToLower(yyDollar[3].str), false)
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{unit, yyDollar[5].exprUnion(), yyDollar[7].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 873:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4735
+//line mysql_sql.y:5029
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10836,10 +11690,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 874:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4743
+//line mysql_sql.y:5037
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			yyLOCAL = &tree.FuncExpr{
@@ -10847,10 +11701,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 875:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4750
+//line mysql_sql.y:5044
 		{
 			name := tree.SetUnresolvedName(strings.ToLower(yyDollar[1].str))
 			var es tree.Exprs = nil
@@ -10863,10 +11717,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 876:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4762
+//line mysql_sql.y:5056
 		{
 			name := tree.SetUnresolvedName("char")
 			yyLOCAL = &tree.FuncExpr{
@@ -10875,10 +11729,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 877:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4770
+//line mysql_sql.y:5064
 		{
 			cn := tree.NewNumVal(constant.MakeString(yyDollar[5].str), yyDollar[5].str, false)
 			es := yyDollar[3].exprsUnion()
@@ -10890,10 +11744,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 825:
+	case 878:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4781
+//line mysql_sql.y:5075
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("date")
@@ -10903,10 +11757,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 879:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4790
+//line mysql_sql.y:5084
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("time")
@@ -10916,10 +11770,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 880:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4799
+//line mysql_sql.y:5093
 		{
 			val := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			name := tree.SetUnresolvedName("timestamp")
@@ -10929,10 +11783,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 881:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4808
+//line mysql_sql.y:5102
 		{
 			name := tree.SetUnresolvedName("insert")
 			yyLOCAL = &tree.FuncExpr{
@@ -10941,10 +11795,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 882:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4816
+//line mysql_sql.y:5110
 		{
 			es := tree.Exprs{yyDollar[3].exprUnion()}
 			es = append(es, yyDollar[5].exprUnion())
@@ -10955,10 +11809,34 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 883:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5120
+		{
+			name := tree.SetUnresolvedName("position")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[3].exprUnion(), yyDollar[5].exprUnion()},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 884:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		var yyLOCAL *tree.FuncExpr
+//line mysql_sql.y:5128
+		{
+			name := tree.SetUnresolvedName("translate")
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: yyDollar[3].exprsUnion(),
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 885:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4826
+//line mysql_sql.y:5136
 		{
 			name := tree.SetUnresolvedName("password")
 			yyLOCAL = &tree.FuncExpr{
@@ -10967,10 +11845,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 886:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.FuncExpr
-//line mysql_sql.y:4834
+//line mysql_sql.y:5144
 		{
 			name := tree.SetUnresolvedName("binary")
 			yyLOCAL = &tree.FuncExpr{
@@ -10979,52 +11857,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 887:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4843
+//line mysql_sql.y:5153
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 888:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4847
+//line mysql_sql.y:5157
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 889:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4853
+//line mysql_sql.y:5163
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 890:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4857
+//line mysql_sql.y:5167
 		{
 			yyLOCAL = yyDollar[2].numValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 897:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:4870
+//line mysql_sql.y:5180
 		{
 		}
-	case 843:
+	case 898:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line mysql_sql.y:4872
+//line mysql_sql.y:5182
 		{
 		}
-	case 876:
+	case 931:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4912
+//line mysql_sql.y:5222
 		{
 			name := tree.SetUnresolvedName("interval")
 			es := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
@@ -11034,15 +11912,13 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 877:
+	case 932:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4921
+//line mysql_sql.y:5231
 		{
 			name := tree.SetUnresolvedName("interval")
-			ival := util.GetUint64(yyDollar[2].item)
-			ustr := strconv.FormatUint(ival, 10)
-			e1 := tree.NewNumVal(constant.MakeUint64(ival), ustr, false)
+			e1 := tree.NewNumVal(constant.MakeString(yyDollar[2].str), yyDollar[2].str, false)
 			e2 := tree.NewNumVal(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false)
 			yyLOCAL = &tree.FuncExpr{
 				Func:  tree.FuncName2ResolvableFunctionReference(name),
@@ -11050,314 +11926,361 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 878:
+	case 933:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5241
+		{
+			name := tree.SetUnresolvedName("interval")
+			e2 := tree.NewNumVal(constant.MakeString(yyDollar[3].str), yyDollar[3].str, false)
+			yyLOCAL = &tree.FuncExpr{
+				Func:  tree.FuncName2ResolvableFunctionReference(name),
+				Exprs: tree.Exprs{yyDollar[2].exprUnion(), e2},
+			}
+		}
+		yyVAL.union = yyLOCAL
+	case 934:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5252
+		{
+			ival := util.GetUint64(yyDollar[1].item)
+			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), strconv.FormatUint(ival, 10), false)
+		}
+		yyVAL.union = yyLOCAL
+	case 935:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5257
+		{
+			fval := yyDollar[1].item.(float64)
+			yyLOCAL = tree.NewNumValWithResFoalt(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, fval)
+		}
+		yyVAL.union = yyLOCAL
+	case 936:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5262
+		{
+			yyLOCAL = tree.NewUnaryExpr(tree.UNARY_MINUS, yyDollar[2].exprUnion())
+		}
+		yyVAL.union = yyLOCAL
+	case 937:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5266
+		{
+			yyLOCAL = yyDollar[1].unresolvedNameUnion()
+		}
+		yyVAL.union = yyLOCAL
+	case 938:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4934
+//line mysql_sql.y:5271
 		{
 			yyLOCAL = tree.FUNC_TYPE_DEFAULT
 		}
 		yyVAL.union = yyLOCAL
-	case 879:
+	case 939:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4938
+//line mysql_sql.y:5275
 		{
 			yyLOCAL = tree.FUNC_TYPE_DISTINCT
 		}
 		yyVAL.union = yyLOCAL
-	case 880:
+	case 940:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.FuncType
-//line mysql_sql.y:4942
+//line mysql_sql.y:5279
 		{
 			yyLOCAL = tree.FUNC_TYPE_ALL
 		}
 		yyVAL.union = yyLOCAL
-	case 881:
+	case 941:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.Tuple
-//line mysql_sql.y:4948
+//line mysql_sql.y:5285
 		{
 			yyLOCAL = tree.NewTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 882:
+	case 942:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4953
+//line mysql_sql.y:5290
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 883:
+	case 943:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4957
+//line mysql_sql.y:5294
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 884:
+	case 944:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4963
+//line mysql_sql.y:5300
 		{
 			yyLOCAL = tree.Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 885:
+	case 945:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Exprs
-//line mysql_sql.y:4967
+//line mysql_sql.y:5304
 		{
 			yyLOCAL = append(yyDollar[1].exprsUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 886:
+	case 946:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4974
+//line mysql_sql.y:5311
 		{
 			yyLOCAL = tree.NewAndExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 887:
+	case 947:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4978
+//line mysql_sql.y:5315
 		{
 			yyLOCAL = tree.NewOrExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 888:
+	case 948:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4982
+//line mysql_sql.y:5319
 		{
 			yyLOCAL = tree.NewXorExpr(yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 889:
+	case 949:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4986
+//line mysql_sql.y:5323
 		{
 			yyLOCAL = tree.NewNotExpr(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 890:
+	case 950:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4990
+//line mysql_sql.y:5327
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 891:
+	case 951:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:4996
+//line mysql_sql.y:5333
 		{
 			yyLOCAL = tree.NewIsNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 892:
+	case 952:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5000
+//line mysql_sql.y:5337
 		{
 			yyLOCAL = tree.NewIsNotNullExpr(yyDollar[1].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 893:
+	case 953:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5004
+//line mysql_sql.y:5341
 		{
 			yyLOCAL = tree.NewComparisonExpr(yyDollar[2].comparisonOpUnion(), yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 895:
+	case 955:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5011
+//line mysql_sql.y:5348
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.IN, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 896:
+	case 956:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5015
+//line mysql_sql.y:5352
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_IN, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 897:
+	case 957:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5019
+//line mysql_sql.y:5356
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.LIKE, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 898:
+	case 958:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5023
+//line mysql_sql.y:5360
 		{
 			yyLOCAL = tree.NewComparisonExprWithEscape(tree.NOT_LIKE, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 959:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5027
+//line mysql_sql.y:5364
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.REG_MATCH, yyDollar[1].exprUnion(), yyDollar[3].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 960:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5031
+//line mysql_sql.y:5368
 		{
 			yyLOCAL = tree.NewComparisonExpr(tree.NOT_REG_MATCH, yyDollar[1].exprUnion(), yyDollar[4].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 961:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5035
+//line mysql_sql.y:5372
 		{
 			yyLOCAL = tree.NewRangeCond(false, yyDollar[1].exprUnion(), yyDollar[3].exprUnion(), yyDollar[5].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 962:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5039
+//line mysql_sql.y:5376
 		{
 			yyLOCAL = tree.NewRangeCond(true, yyDollar[1].exprUnion(), yyDollar[4].exprUnion(), yyDollar[6].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 964:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5045
+//line mysql_sql.y:5382
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 965:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5049
+//line mysql_sql.y:5386
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 906:
+	case 966:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5055
+//line mysql_sql.y:5392
 		{
 			yyLOCAL = yyDollar[1].tupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 907:
+	case 967:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5059
+//line mysql_sql.y:5396
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 968:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5066
+//line mysql_sql.y:5403
 		{
 			yyLOCAL = tree.EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 969:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5070
+//line mysql_sql.y:5407
 		{
 			yyLOCAL = tree.LESS_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 970:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5074
+//line mysql_sql.y:5411
 		{
 			yyLOCAL = tree.GREAT_THAN
 		}
 		yyVAL.union = yyLOCAL
-	case 911:
+	case 971:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5078
+//line mysql_sql.y:5415
 		{
 			yyLOCAL = tree.LESS_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 972:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5082
+//line mysql_sql.y:5419
 		{
 			yyLOCAL = tree.GREAT_THAN_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 973:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ComparisonOp
-//line mysql_sql.y:5086
+//line mysql_sql.y:5423
 		{
 			yyLOCAL = tree.NOT_EQUAL
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 974:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5093
+//line mysql_sql.y:5430
 		{
 			yyLOCAL = tree.NewAttributePrimaryKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 975:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5097
+//line mysql_sql.y:5434
 		{
 			yyLOCAL = tree.NewAttributeUniqueKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 976:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5101
+//line mysql_sql.y:5438
 		{
 			yyLOCAL = tree.NewAttributeUnique()
 		}
 		yyVAL.union = yyLOCAL
-	case 917:
+	case 977:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.ColumnAttribute
-//line mysql_sql.y:5105
+//line mysql_sql.y:5442
 		{
 			yyLOCAL = tree.NewAttributeKey()
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 978:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.NumVal
-//line mysql_sql.y:5111
+//line mysql_sql.y:5448
 		{
 			ival, errStr := util.GetInt64(yyDollar[1].item)
 			if errStr != "" {
@@ -11371,96 +12294,105 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 979:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5126
+//line mysql_sql.y:5463
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeString(yyDollar[1].str), yyDollar[1].str, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 920:
+	case 980:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5130
+//line mysql_sql.y:5467
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 981:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5135
+//line mysql_sql.y:5472
 		{
 			fval := yyDollar[1].item.(float64)
 			yyLOCAL = tree.NewNumValWithResFoalt(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, fval)
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 982:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5140
+//line mysql_sql.y:5477
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(true), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 983:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5144
+//line mysql_sql.y:5481
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeBool(false), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 984:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5148
+//line mysql_sql.y:5485
 		{
 			yyLOCAL = tree.NewNumVal(constant.MakeUnknown(), "", false)
 		}
 		yyVAL.union = yyLOCAL
-	case 925:
+	case 985:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL tree.Expr
-//line mysql_sql.y:5152
+//line mysql_sql.y:5489
 		{
 			ival := util.GetUint64(yyDollar[1].item)
 			yyLOCAL = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 986:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL tree.Expr
+//line mysql_sql.y:5494
+		{
+			offset, _ := strconv.Atoi(yyDollar[1].str[2:])
+			yyLOCAL = tree.NewParamExpr(offset)
+		}
+		yyVAL.union = yyLOCAL
+	case 987:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5162
+//line mysql_sql.y:5503
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.Unsigned = yyDollar[2].unsignedOptUnion()
 			yyLOCAL.InternalType.Zerofill = yyDollar[3].zeroFillOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 991:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5173
+//line mysql_sql.y:5514
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 			yyLOCAL.InternalType.DisplayWith = yyDollar[2].lengthOptUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 992:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5178
+//line mysql_sql.y:5519
 		{
 			yyLOCAL = yyDollar[1].columnTypeUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 932:
+	case 993:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5184
+//line mysql_sql.y:5525
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11473,10 +12405,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 933:
+	case 994:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5196
+//line mysql_sql.y:5537
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11489,10 +12421,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 995:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5208
+//line mysql_sql.y:5549
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11505,10 +12437,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 996:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5220
+//line mysql_sql.y:5561
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11522,10 +12454,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 997:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5233
+//line mysql_sql.y:5574
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11539,10 +12471,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 998:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5246
+//line mysql_sql.y:5587
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11556,10 +12488,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 999:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5259
+//line mysql_sql.y:5600
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11573,10 +12505,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 1000:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5272
+//line mysql_sql.y:5613
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11590,10 +12522,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 1001:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5285
+//line mysql_sql.y:5626
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11607,10 +12539,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 1002:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5298
+//line mysql_sql.y:5639
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11624,10 +12556,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 1003:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5311
+//line mysql_sql.y:5652
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11641,10 +12573,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 943:
+	case 1004:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5324
+//line mysql_sql.y:5665
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11658,10 +12590,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 944:
+	case 1005:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5337
+//line mysql_sql.y:5678
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11675,10 +12607,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 945:
+	case 1006:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5350
+//line mysql_sql.y:5691
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11692,10 +12624,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 1007:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5365
+//line mysql_sql.y:5706
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().DisplayWith > 255 {
@@ -11719,10 +12651,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 1008:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5388
+//line mysql_sql.y:5729
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -11759,10 +12691,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 1009:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5425
+//line mysql_sql.y:5766
 		{
 			locale := ""
 			if yyDollar[2].lengthScaleOptUnion().Precision != tree.NotDefineDec && yyDollar[2].lengthScaleOptUnion().Precision > yyDollar[2].lengthScaleOptUnion().DisplayWith {
@@ -11799,10 +12731,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 1010:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5473
+//line mysql_sql.y:5814
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11818,10 +12750,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 1011:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5490
+//line mysql_sql.y:5831
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11834,10 +12766,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 1012:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5502
+//line mysql_sql.y:5843
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11853,10 +12785,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 952:
+	case 1013:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5517
+//line mysql_sql.y:5858
 		{
 			locale := ""
 			if yyDollar[2].lengthOptUnion() < 0 || yyDollar[2].lengthOptUnion() > 6 {
@@ -11877,10 +12809,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 953:
+	case 1014:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5537
+//line mysql_sql.y:5878
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11896,10 +12828,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 954:
+	case 1015:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5552
+//line mysql_sql.y:5893
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11914,10 +12846,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 955:
+	case 1016:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5568
+//line mysql_sql.y:5909
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11931,10 +12863,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 956:
+	case 1017:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5581
+//line mysql_sql.y:5922
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11948,10 +12880,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 957:
+	case 1018:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5594
+//line mysql_sql.y:5935
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11965,10 +12897,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 958:
+	case 1019:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5607
+//line mysql_sql.y:5948
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11982,10 +12914,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 959:
+	case 1020:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5620
+//line mysql_sql.y:5961
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -11998,10 +12930,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 960:
+	case 1021:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5632
+//line mysql_sql.y:5973
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12014,10 +12946,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 961:
+	case 1022:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5644
+//line mysql_sql.y:5985
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12030,10 +12962,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 962:
+	case 1023:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5656
+//line mysql_sql.y:5997
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12046,10 +12978,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 963:
+	case 1024:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5668
+//line mysql_sql.y:6009
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12062,10 +12994,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 964:
+	case 1025:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5680
+//line mysql_sql.y:6021
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12078,10 +13010,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 965:
+	case 1026:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5692
+//line mysql_sql.y:6033
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12094,10 +13026,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 966:
+	case 1027:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5704
+//line mysql_sql.y:6045
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12110,10 +13042,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 967:
+	case 1028:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5716
+//line mysql_sql.y:6057
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12126,10 +13058,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 968:
+	case 1029:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5728
+//line mysql_sql.y:6069
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12143,10 +13075,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 969:
+	case 1030:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5741
+//line mysql_sql.y:6082
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12160,10 +13092,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 970:
+	case 1031:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *tree.T
-//line mysql_sql.y:5756
+//line mysql_sql.y:6097
 		{
 			locale := ""
 			yyLOCAL = &tree.T{
@@ -12176,75 +13108,75 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 971:
+	case 1032:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5779
+//line mysql_sql.y:6120
 		{
 			yyLOCAL = make([]string, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 972:
+	case 1033:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []string
-//line mysql_sql.y:5784
+//line mysql_sql.y:6125
 		{
 			yyLOCAL = append(yyDollar[1].strsUnion(), yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 973:
+	case 1034:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5790
+//line mysql_sql.y:6131
 		{
 			yyLOCAL = 0
 		}
 		yyVAL.union = yyLOCAL
-	case 975:
+	case 1036:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5797
+//line mysql_sql.y:6138
 		{
 			yyLOCAL = 6
 		}
 		yyVAL.union = yyLOCAL
-	case 976:
+	case 1037:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5801
+//line mysql_sql.y:6142
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 977:
+	case 1038:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5806
+//line mysql_sql.y:6147
 		{
 			yyLOCAL = int32(-1)
 		}
 		yyVAL.union = yyLOCAL
-	case 978:
+	case 1039:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5810
+//line mysql_sql.y:6151
 		{
 			yyLOCAL = int32(yyDollar[2].item.(int64))
 		}
 		yyVAL.union = yyLOCAL
-	case 979:
+	case 1040:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL int32
-//line mysql_sql.y:5816
+//line mysql_sql.y:6157
 		{
 			yyLOCAL = tree.GetDisplayWith(int32(yyDollar[2].item.(int64)))
 		}
 		yyVAL.union = yyLOCAL
-	case 980:
+	case 1041:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5822
+//line mysql_sql.y:6163
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.NotDefineDisplayWidth,
@@ -12252,10 +13184,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 981:
+	case 1042:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5829
+//line mysql_sql.y:6170
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12263,10 +13195,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 982:
+	case 1043:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5836
+//line mysql_sql.y:6177
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12274,10 +13206,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 983:
+	case 1044:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5845
+//line mysql_sql.y:6186
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: 10, // this is the default precision for decimal
@@ -12285,10 +13217,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 984:
+	case 1045:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5852
+//line mysql_sql.y:6193
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12296,10 +13228,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 985:
+	case 1046:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL tree.LengthScaleOpt
-//line mysql_sql.y:5859
+//line mysql_sql.y:6200
 		{
 			yyLOCAL = tree.LengthScaleOpt{
 				DisplayWith: tree.GetDisplayWith(int32(yyDollar[2].item.(int64))),
@@ -12307,52 +13239,52 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 986:
+	case 1047:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5868
+//line mysql_sql.y:6209
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 987:
+	case 1048:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5872
+//line mysql_sql.y:6213
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 988:
+	case 1049:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5876
+//line mysql_sql.y:6217
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 989:
+	case 1050:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5882
+//line mysql_sql.y:6223
 		{
 		}
-	case 990:
+	case 1051:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line mysql_sql.y:5884
+//line mysql_sql.y:6225
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 994:
+	case 1055:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line mysql_sql.y:5894
+//line mysql_sql.y:6235
 		{
 			yyVAL.str = ""
 		}
-	case 995:
+	case 1056:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line mysql_sql.y:5898
+//line mysql_sql.y:6239
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
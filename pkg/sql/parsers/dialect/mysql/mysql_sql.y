@@ -43,6 +43,8 @@ import (
     columnTableDef *tree.ColumnTableDef
     tableOption tree.TableOption
     tableOptions []tree.TableOption
+    alterTableOption tree.AlterTableOption
+    alterTableOptions []tree.AlterTableOption
     tableExprs tree.TableExprs
     tableExpr tree.TableExpr
     rowFormatType tree.RowFormatType
@@ -120,6 +122,9 @@ import (
 
     subquery *tree.Subquery
     funcExpr *tree.FuncExpr
+    windowSpec *tree.WindowSpec
+    frameClause *tree.FrameClause
+    frameBound *tree.FrameBound
 
     roles []*tree.Role
     role *tree.Role
@@ -147,6 +152,7 @@ import (
     fieldsList []*tree.Fields
     lines *tree.Lines
     varExpr *tree.VarExpr
+    varExprs []*tree.VarExpr
     loadColumn tree.LoadColumn
     loadColumns []tree.LoadColumn
     assignments []*tree.Assignment
@@ -181,6 +187,8 @@ import (
 %left EMPTY_FROM_CLAUSE
 %nonassoc <str> LOWER_THAN_CHARSET
 %nonassoc <str> CHARSET
+%nonassoc <str> LOWER_THAN_TIME_UNIT
+%nonassoc YEAR MONTH QUARTER
 %right <str> UNIQUE KEY
 %left <str> OR
 %left <str> XOR
@@ -191,6 +199,7 @@ import (
 %left <str> '|'
 %left <str> '&'
 %left <str> SHIFT_LEFT SHIFT_RIGHT
+%left <str> JSON_EXTRACT_OP JSON_UNQUOTE_EXTRACT_OP
 %left <str> '+' '-'
 %left <str> '*' '/' DIV '%' MOD
 %left <str> '^'
@@ -204,6 +213,9 @@ import (
 %token <str> BEGIN START TRANSACTION COMMIT ROLLBACK WORK CONSISTENT SNAPSHOT
 %token <str> CHAIN NO RELEASE
 
+// Prepared Statement
+%token <str> PREPARE DEALLOCATE
+
 // Type
 %token <str> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM
 %token <str> REAL DOUBLE FLOAT_TYPE DECIMAL NUMERIC
@@ -219,7 +231,7 @@ import (
 %token <str> SCHEMA TABLE INDEX VIEW TO IGNORE IF PRIMARY COLUMN CONSTRAINT SPATIAL FULLTEXT FOREIGN KEY_BLOCK_SIZE
 %token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
 %token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
-%token <str> STATUS VARIABLES ROLE PROXY AVG_ROW_LENGTH STORAGE DISK MEMORY
+%token <str> STATUS STATS VARIABLES ROLE PROXY AVG_ROW_LENGTH STORAGE DISK MEMORY
 %token <str> CHECKSUM COMPRESSION DATA DIRECTORY DELAY_KEY_WRITE ENCRYPTION ENGINE
 %token <str> MAX_ROWS MIN_ROWS PACK_KEYS ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES
 %token <str> DYNAMIC COMPRESSED REDUNDANT COMPACT FIXED COLUMN_FORMAT AUTO_RANDOM
@@ -282,6 +294,12 @@ import (
 // With
 %token <str> RECURSIVE
 
+// Snapshot
+%token <str> OF
+
+// Window function
+%token <str> OVER PRECEDING FOLLOWING UNBOUNDED CURRENT ROWS
+
 // Match
 %token <str> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION
 
@@ -290,7 +308,7 @@ import (
 %token <str> APPROX_PERCENTILE CURDATE CURTIME DATE_ADD DATE_SUB EXTRACT
 %token <str> GROUP_CONCAT MAX MID MIN NOW POSITION SESSION_USER STD STDDEV
 %token <str> STDDEV_POP STDDEV_SAMP SUBDATE SUBSTR SUBSTRING SUM SYSDATE
-%token <str> SYSTEM_USER TRANSLATE TRIM VARIANCE VAR_POP VAR_SAMP AVG
+%token <str> SYSTEM_USER TRANSLATE TRIM VARIANCE VAR_POP VAR_SAMP AVG TIMESTAMPDIFF
 
 // Insert
 %token <str> ROW OUTFILE HEADER MAX_FILE_SIZE FORCE_QUOTE
@@ -306,14 +324,15 @@ import (
 %type <statement> create_ddl_stmt create_table_stmt create_database_stmt create_index_stmt
 %type <statement> show_stmt show_create_stmt show_columns_stmt show_databases_stmt
 %type <statement> show_tables_stmt show_process_stmt show_errors_stmt show_warnings_stmt
-%type <statement> show_variables_stmt show_status_stmt show_index_stmt
-%type <statement> alter_user_stmt update_stmt use_stmt
+%type <statement> show_variables_stmt show_status_stmt show_index_stmt show_stats_stmt
+%type <statement> alter_user_stmt alter_table_stmt update_stmt use_stmt
 %type <statement> transaction_stmt begin_stmt commit_stmt rollback_stmt
 %type <statement> explain_stmt explainable_stmt
 %type <statement> set_stmt set_variable_stmt set_password_stmt set_role_stmt set_default_role_stmt
 %type <statement> revoke_stmt grant_stmt
 %type <statement> load_data_stmt
 %type <statement> analyze_stmt
+%type <statement> prepare_stmt deallocate_stmt execute_stmt
 %type <exportParm> export_data_param_opt
 
 %type <select> select_stmt select_no_parens
@@ -341,6 +360,8 @@ import (
 %type <columnAttribute> column_attribute_elem keys
 %type <columnAttributes> column_attribute_list column_attribute_list_opt
 %type <tableOptions> table_option_list_opt table_option_list
+%type <alterTableOption> alter_table_option
+%type <alterTableOptions> alter_table_option_list
 %type <str> charset_name storage_opt collate_name column_format storage_media
 %type <rowFormatType> row_format_options
 %type <int64Val> field_length_opt max_file_size_opt
@@ -362,11 +383,16 @@ import (
 %type <funcExpr> function_call_keyword
 %type <funcExpr> function_call_nonkeyword
 %type <funcExpr> function_call_aggregate
+%type <funcExpr> function_call_window
+%type <windowSpec> window_spec
+%type <exprs> window_partition_by_opt
+%type <frameClause> window_frame_opt window_frame_extent
+%type <frameBound> window_frame_bound
 
 %type <unresolvedName> column_name column_name_unresolved
 %type <strs> enum_values force_quote_opt force_quote_list
 %type <str> sql_id charset_keyword db_name
-%type <str> not_keyword
+%type <str> not_keyword date_add_option
 %type <str> reserved_keyword non_reserved_keyword
 %type <str> equal_opt reserved_sql_id reserved_table_id
 %type <str> as_name_opt as_opt_id table_id id_or_var name_string ident
@@ -381,7 +407,7 @@ import (
 
 %type <expr> literal
 %type <expr> predicate
-%type <expr> bit_expr interval_expr
+%type <expr> bit_expr interval_expr interval_value
 %type <expr> simple_expr else_opt
 %type <expr> expression like_escape_opt boolean_primary col_tuple expression_opt
 %type <exprs> expression_list_opt
@@ -413,7 +439,7 @@ import (
 %type <updateExpr> update_expression
 %type <updateExprs> update_list
 %type <completionType> completion_type
-%type <str> id_prefix_at password_opt
+%type <str> password_opt
 %type <boolVal> grant_option_opt enforce enforce_opt
 
 %type <varAssignmentExpr> var_assignment
@@ -464,6 +490,7 @@ import (
 %type <lines> load_lines export_lines_opt
 %type <int64Val> ignore_lines
 %type <varExpr> user_variable variable system_variable
+%type <varExprs> user_variable_list
 %type <loadColumn> columns_or_variable
 %type <loadColumns> columns_or_variable_list columns_or_variable_list_opt
 %type <unresolvedName> normal_ident
@@ -527,6 +554,9 @@ stmt:
 |   revoke_stmt
 |   grant_stmt
 |   load_data_stmt
+|   prepare_stmt
+|   execute_stmt
+|   deallocate_stmt
 |   select_stmt
     {
         $$ = $1
@@ -536,6 +566,46 @@ stmt:
         $$ = tree.Statement(nil)
     }
 
+prepare_stmt:
+    PREPARE ident FROM STRING
+    {
+        $$ = tree.NewPrepareString(tree.Identifier($2), $4)
+    }
+|   PREPARE ident FROM user_variable
+    {
+        $$ = tree.NewPrepareVar(tree.Identifier($2), $4)
+    }
+
+execute_stmt:
+    EXECUTE ident
+    {
+        $$ = tree.NewExecute(tree.Identifier($2), nil)
+    }
+|   EXECUTE ident USING user_variable_list
+    {
+        $$ = tree.NewExecute(tree.Identifier($2), $4)
+    }
+
+user_variable_list:
+    user_variable
+    {
+        $$ = []*tree.VarExpr{$1}
+    }
+|   user_variable_list ',' user_variable
+    {
+        $$ = append($1, $3)
+    }
+
+deallocate_stmt:
+    DEALLOCATE PREPARE ident
+    {
+        $$ = tree.NewDeallocate(tree.Identifier($3), false)
+    }
+|   DROP PREPARE ident
+    {
+        $$ = tree.NewDeallocate(tree.Identifier($3), true)
+    }
+
 load_data_stmt:
     LOAD DATA local_opt INFILE STRING duplicate_opt INTO TABLE table_name load_fields load_lines ignore_lines columns_or_variable_list_opt load_set_spec_opt
     {
@@ -1269,7 +1339,14 @@ var_assignment:
             Value: $4,
         }
     }
-|   id_prefix_at equal_or_assignment set_expr
+|   AT_ID equal_or_assignment set_expr
+    {
+        $$ = &tree.VarAssignmentExpr{
+            Name: $1,
+            Value: $3,
+        }
+    }
+|   AT_AT_ID equal_or_assignment set_expr
     {
     	vs := strings.Split($1, ".")
         var isGlobal bool
@@ -1336,10 +1413,6 @@ var_assignment:
         }
     }
 
-id_prefix_at:
-    AT_ID
-|   AT_AT_ID
-
 set_expr:
     ON
     {
@@ -1553,6 +1626,13 @@ explain_stmt:
         explainStmt.Options = $3
         $$ = explainStmt
     }
+|   explain_sym FORMAT '=' explain_foramt_value explainable_stmt
+    {
+        explainStmt := tree.NewExplainStmt($5, "text")
+        optionElem := tree.MakeOptionElem("format", $4)
+        explainStmt.Options = tree.MakeOptions(optionElem)
+        $$ = explainStmt
+    }
 
 explain_sym:
     EXPLAIN
@@ -1588,15 +1668,62 @@ utility_option_arg:
 
 
 analyze_stmt:
-    ANALYZE TABLE table_name '(' column_list ')' 
+    ANALYZE TABLE table_name
+    {
+        $$ = tree.NewAnalyzeStmt($3, nil)
+    }
+|   ANALYZE TABLE table_name '(' column_list ')'
+    {
+        $$ = tree.NewAnalyzeStmt($3, $5)
+    }
+|   ANALYZE TABLE table_name COLUMNS column_list
     {
         $$ = tree.NewAnalyzeStmt($3, $5)
     }
 
 alter_stmt:
     alter_user_stmt
+|   alter_table_stmt
 // |    alter_ddl_stmt
 
+alter_table_stmt:
+    ALTER TABLE table_name alter_table_option_list
+    {
+        $$ = tree.NewAlterTable($3, $4)
+    }
+
+alter_table_option_list:
+    alter_table_option
+    {
+        $$ = []tree.AlterTableOption{$1}
+    }
+|   alter_table_option_list ',' alter_table_option
+    {
+        $$ = append($1, $3)
+    }
+
+alter_table_option:
+    ADD column_def
+    {
+        $$ = tree.NewAlterOptionAddColumn($2)
+    }
+|   ADD COLUMN column_def
+    {
+        $$ = tree.NewAlterOptionAddColumn($3)
+    }
+|   DROP ident
+    {
+        $$ = tree.NewAlterOptionDropColumn(tree.Identifier($2))
+    }
+|   DROP COLUMN ident
+    {
+        $$ = tree.NewAlterOptionDropColumn(tree.Identifier($3))
+    }
+|   RENAME COLUMN ident TO ident
+    {
+        $$ = tree.NewAlterOptionRenameColumn(tree.Identifier($3), tree.Identifier($5))
+    }
+
 alter_user_stmt:
     ALTER USER exists_opt user_spec_list require_clause_opt conn_options pwd_or_lck_opt
     {
@@ -1701,6 +1828,17 @@ show_stmt:
 |   show_variables_stmt
 |   show_status_stmt
 |   show_index_stmt
+|   show_stats_stmt
+
+show_stats_stmt:
+    SHOW STATS
+    {
+        $$ = tree.NewShowStats(nil)
+    }
+|   SHOW STATS from_or_in table_name
+    {
+        $$ = tree.NewShowStats($4)
+    }
 
 show_index_stmt:
     SHOW index_kwd from_or_in table_name where_expression_opt
@@ -2785,6 +2923,16 @@ aliased_table_name:
             },
         }
     }
+|   table_name AS OF TIMESTAMP STRING as_opt_id
+    {
+        $$ = &tree.AliasedTableExpr{
+            Expr: $1,
+            As: tree.AliasClause{
+                Alias: tree.Identifier($6),
+            },
+            AsOf: $5,
+        }
+    }
 // |   table_name PARTITION '(' partition_id_list ')' as_opt_id index_hint_list
 
 
@@ -4240,6 +4388,27 @@ simple_expr:
     {
         $$ = $1
     }
+|   normal_ident JSON_EXTRACT_OP STRING
+    {
+        name := tree.SetUnresolvedName("json_extract")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, tree.NewNumVal(constant.MakeString($3), $3, false)},
+        }
+    }
+|   normal_ident JSON_UNQUOTE_EXTRACT_OP STRING
+    {
+        name := tree.SetUnresolvedName("json_extract")
+        e := &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$1, tree.NewNumVal(constant.MakeString($3), $3, false)},
+        }
+        name = tree.SetUnresolvedName("json_unquote")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{e},
+        }
+    }
 |   variable
     {
         $$ = $1
@@ -4326,6 +4495,10 @@ simple_expr:
     {
         $$ = $1
     }
+|   function_call_window
+    {
+        $$ = $1
+    }
 
 else_opt:
 	{
@@ -4621,6 +4794,92 @@ std_dev_pop:
 |   STDDEV
 |   STDDEV_POP
 
+function_call_window:
+    function_call_generic OVER '(' window_spec ')'
+    {
+        $1.WindowSpec = $4
+        $$ = $1
+    }
+|   function_call_aggregate OVER '(' window_spec ')'
+    {
+        $1.WindowSpec = $4
+        $$ = $1
+    }
+
+window_spec:
+    window_partition_by_opt order_by_opt window_frame_opt
+    {
+        $$ = &tree.WindowSpec{
+            PartitionBy: $1,
+            OrderBy: $2,
+            Frame: $3,
+        }
+    }
+
+window_partition_by_opt:
+    {
+        $$ = nil
+    }
+|   PARTITION BY expression_list
+    {
+        $$ = $3
+    }
+
+window_frame_opt:
+    {
+        $$ = nil
+    }
+|   ROWS window_frame_extent
+    {
+        $2.Type = tree.FRAME_TYPE_ROWS
+        $$ = $2
+    }
+|   RANGE window_frame_extent
+    {
+        $2.Type = tree.FRAME_TYPE_RANGE
+        $$ = $2
+    }
+
+window_frame_extent:
+    window_frame_bound
+    {
+        $$ = &tree.FrameClause{Start: $1}
+    }
+|   BETWEEN window_frame_bound AND window_frame_bound
+    {
+        $$ = &tree.FrameClause{Start: $2, End: $4}
+    }
+
+window_frame_bound:
+    UNBOUNDED PRECEDING
+    {
+        $$ = &tree.FrameBound{Type: tree.FRAME_BOUND_UNBOUNDED_PRECEDING}
+    }
+|   UNBOUNDED FOLLOWING
+    {
+        $$ = &tree.FrameBound{Type: tree.FRAME_BOUND_UNBOUNDED_FOLLOWING}
+    }
+|   CURRENT ROW
+    {
+        $$ = &tree.FrameBound{Type: tree.FRAME_BOUND_CURRENT_ROW}
+    }
+|   literal PRECEDING
+    {
+        $$ = &tree.FrameBound{Type: tree.FRAME_BOUND_PRECEDING, Expr: $1}
+    }
+|   literal FOLLOWING
+    {
+        $$ = &tree.FrameBound{Type: tree.FRAME_BOUND_FOLLOWING, Expr: $1}
+    }
+|   interval_expr PRECEDING
+    {
+        $$ = &tree.FrameBound{Type: tree.FRAME_BOUND_PRECEDING, Expr: $1}
+    }
+|   interval_expr FOLLOWING
+    {
+        $$ = &tree.FrameBound{Type: tree.FRAME_BOUND_FOLLOWING, Expr: $1}
+    }
+
 function_call_generic:
     ID '(' expression_list_opt ')'
     {
@@ -4657,7 +4916,7 @@ function_call_generic:
 |   EXTRACT '(' time_unit FROM expression ')'
     {
         name := tree.SetUnresolvedName(strings.ToLower($1))
-        timeUinit := tree.SetUnresolvedName(strings.ToLower($3))
+        timeUinit := tree.NewNumVal(constant.MakeString(strings.ToLower($3)), strings.ToLower($3), false)
         $$ = &tree.FuncExpr{
              Func: tree.FuncName2ResolvableFunctionReference(name),
              Exprs: tree.Exprs{timeUinit, $5},
@@ -4729,6 +4988,41 @@ function_call_nonkeyword:
             Exprs: es,
         }
     }
+|   NOW datatime_precision
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        var es tree.Exprs = nil
+        if $2 != nil {
+            es = append(es, $2)
+        }
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: es,
+        }
+    }
+|   date_add_option '(' expression ',' expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5},
+        }
+    }
+|   TIMESTAMPDIFF '(' time_stamp_unit ',' expression ',' expression ')'
+    {
+        name := tree.SetUnresolvedName(strings.ToLower($1))
+        unit := tree.NewNumVal(constant.MakeString(strings.ToLower($3)), strings.ToLower($3), false)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{unit, $5, $7},
+        }
+    }
+
+date_add_option:
+    DATE_ADD
+|   DATE_SUB
+|   ADDDATE
+|   SUBDATE
 
 function_call_keyword:
     name_confict '(' expression_list_opt ')'
@@ -4822,6 +5116,22 @@ function_call_keyword:
             Exprs: es,
         }
     }
+|   POSITION '(' bit_expr IN expression ')'
+    {
+        name := tree.SetUnresolvedName("position")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$3, $5},
+        }
+    }
+|   TRANSLATE '(' expression_list ')'
+    {
+        name := tree.SetUnresolvedName("translate")
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: $3,
+        }
+    }
 |   PASSWORD '(' expression_list_opt ')'
     {
         name := tree.SetUnresolvedName("password")
@@ -4908,7 +5218,7 @@ name_confict:
 |   YEAR
 
 interval_expr:
-    INTERVAL STRING
+    INTERVAL STRING %prec LOWER_THAN_TIME_UNIT
 	{
 		name := tree.SetUnresolvedName("interval")
 		es := tree.NewNumVal(constant.MakeString($2), $2, false)
@@ -4917,18 +5227,45 @@ interval_expr:
             Exprs: tree.Exprs{es},
         }
 	}
-|   INTERVAL INTEGRAL time_unit
+|   INTERVAL STRING time_unit
     {
         name := tree.SetUnresolvedName("interval")
-        ival := util.GetUint64($2)
-        ustr := strconv.FormatUint(ival, 10)
-        e1 := tree.NewNumVal(constant.MakeUint64(ival), ustr, false)
+        e1 := tree.NewNumVal(constant.MakeString($2), $2, false)
         e2 := tree.NewNumVal(constant.MakeString($3), $3, false)
         $$ = &tree.FuncExpr{
             Func: tree.FuncName2ResolvableFunctionReference(name),
             Exprs: tree.Exprs{e1, e2},
         }
     }
+|   INTERVAL interval_value time_unit
+    {
+        name := tree.SetUnresolvedName("interval")
+        e2 := tree.NewNumVal(constant.MakeString($3), $3, false)
+        $$ = &tree.FuncExpr{
+            Func: tree.FuncName2ResolvableFunctionReference(name),
+            Exprs: tree.Exprs{$2, e2},
+        }
+    }
+
+interval_value:
+    INTEGRAL
+    {
+        ival := util.GetUint64($1)
+        $$ = tree.NewNumVal(constant.MakeUint64(ival), strconv.FormatUint(ival, 10), false)
+    }
+|   FLOAT
+    {
+        fval := $1.(float64)
+        $$ = tree.NewNumValWithResFoalt(constant.MakeFloat64(fval), yylex.(*Lexer).scanner.LastToken, false, fval)
+    }
+|   '-' interval_value
+    {
+        $$ = tree.NewUnaryExpr(tree.UNARY_MINUS, $2)
+    }
+|   column_name
+    {
+        $$ = $1
+    }
 
 func_type_opt:
     {
@@ -5153,9 +5490,13 @@ literal:
 		ival := util.GetUint64($1)
 		$$ = tree.NewNumVal(constant.MakeUint64(ival), yylex.(*Lexer).scanner.LastToken, false)
 	}
+|   VALUE_ARG
+    {
+        offset, _ := strconv.Atoi($1[2:])
+        $$ = tree.NewParamExpr(offset)
+    }
 // |   HEX
 // |   BIT_LITERAL
-// |   VALUE_ARG
 
 column_type:
     numeric_type unsigned_opt zero_fill_opt
@@ -5995,6 +6336,7 @@ reserved_keyword:
 |   NOT
 |   NONE
 |   NULL
+|   OF
 |   ON
 |   OR
 |   ORDER
@@ -6056,9 +6398,12 @@ reserved_keyword:
 |   FOREIGN
 |	ROW
 |   OUTFILE
+|   OVER
 
 non_reserved_keyword:
     AGAINST
+|   DEALLOCATE
+|   PREPARE
 |   AVG_ROW_LENGTH
 |   AUTO_RANDOM
 |   ACTION
@@ -6181,6 +6526,7 @@ non_reserved_keyword:
 |   SPATIAL
 |   START
 |   STATUS
+|   STATS
 |   STORAGE
 |   STATS_AUTO_RECALC
 |   STATS_PERSISTENT
@@ -6215,6 +6561,11 @@ non_reserved_keyword:
 |   MAX_FILE_SIZE
 |   FORCE_QUOTE
 |   QUARTER
+|   PRECEDING
+|   FOLLOWING
+|   UNBOUNDED
+|   CURRENT
+|   ROWS
 
 not_keyword:
     ADDDATE
@@ -6247,6 +6598,7 @@ not_keyword:
 |   SUM
 |   SYSDATE
 |   SYSTEM_USER
+|   TIMESTAMPDIFF
 |   TRANSLATE
 |   TRIM
 |   VARIANCE
@@ -6262,6 +6614,7 @@ explain_option_key:
 explain_foramt_value:
     JSON
 |   TEXT
+|   ID
 
 
 //mo_keywords:
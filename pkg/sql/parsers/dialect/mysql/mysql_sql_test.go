@@ -57,12 +57,61 @@ var (
 		output string
 	}{{
 		input: "select Quarter from ontime limit 1",
+	}, {
+		input:  "select a, row_number() over (partition by b order by c desc) from t1",
+		output: "select a, row_number() over (partition by b order by c desc) from t1",
+	}, {
+		input:  "select rank() over (order by a), dense_rank() over (order by a) from t1",
+		output: "select rank() over (order by a), dense_rank() over (order by a) from t1",
+	}, {
+		input:  "select lag(a, 1) over (partition by b order by c), lead(a) over (order by c) from t1",
+		output: "select lag(a, 1) over (partition by b order by c), lead(a) over (order by c) from t1",
+	}, {
+		input:  "select first_value(a) over (partition by b, c) from t1",
+		output: "select first_value(a) over (partition by b, c) from t1",
+	}, {
+		input:  "select sum(a) over (order by b rows between unbounded preceding and current row) from t1",
+		output: "select sum(a) over (order by b rows between unbounded preceding and current row) from t1",
+	}, {
+		input:  "select avg(a) over (order by b range between 2 preceding and 3 following) from t1",
+		output: "select avg(a) over (order by b range between 2 preceding and 3 following) from t1",
+	}, {
+		input:  "select count(*) over (rows 1 preceding), max(a) over () from t1",
+		output: "select count(*) over (rows 1 preceding), max(a) over () from t1",
+	}, {
+		input:  "select sum(a) over (order by b rows between current row and unbounded following) from t1",
+		output: "select sum(a) over (order by b rows between current row and unbounded following) from t1",
+	}, {
+		input: "prepare stmt1 from 'select * from t where a = ? and b > ?'",
+	}, {
+		input:  "prepare stmt1 from \"insert into t values (?, ?)\"",
+		output: "prepare stmt1 from 'insert into t values (?, ?)'",
+	}, {
+		input: "prepare stmt1 from @sql",
+	}, {
+		input: "select * from t where a = ? and b in (?, ?)",
+	}, {
+		input: "execute stmt1",
+	}, {
+		input: "execute stmt1 using @a, @b",
+	}, {
+		input: "deallocate prepare stmt1",
+	}, {
+		input: "drop prepare stmt1",
 	}, {
 		input: "select month from ontime limit 1",
 	}, {
 		input: "with tw as (select * from t2), tf as (select * from t3) select * from tw where a > 1",
 	}, {
 		input: "with tw as (select * from t2) select * from tw where a > 1",
+	}, {
+		input:  "with recursive c(n) as (select 1 union all select n + 1 from c where n < 5) select * from c",
+		output: "with recursive c(n) as (select 1 from dual union all select n + 1 from c where n < 5) select * from c",
+	}, {
+		input: "select * from t as of timestamp '2022-05-01 10:00:00' as t1 inner join t2 on t1.a = t2.a",
+	}, {
+		input:  "select a from db.t AS OF TIMESTAMP '2022-05-01 10:00:00'",
+		output: "select a from db.t as of timestamp '2022-05-01 10:00:00'",
 	}, {
 		input:  "create table t (a double(13))  // comment",
 		output: "create table t (a double(13))",
@@ -91,6 +140,12 @@ var (
 		input: "select substr(name, 5, 3) from t1",
 	}, {
 		input: "select substring(name, 5, 3) from t1",
+	}, {
+		input:  "select position('c' in name) from t1",
+		output: "select position(c, name) from t1",
+	}, {
+		input:  "select translate(name, 'abc', 'xy') from t1",
+		output: "select translate(name, abc, xy) from t1",
 	}, {
 		input:  "select * from R join S on R.uid = S.uid",
 		output: "select * from R inner join S on R.uid = S.uid",
@@ -317,6 +372,12 @@ var (
 		input: "select sysdate(), curtime(22) from t",
 	}, {
 		input: "select sysdate(), curtime from t",
+	}, {
+		input:  "select now(), NOW(6), convert_tz(a, '+00:00', 'UTC') from t",
+		output: "select now(), now(6), convert_tz(a, +00:00, UTC) from t",
+	}, {
+		input:  "select a->'$.b', t.a->>'$[0]', json_length(a) from t where a->'$.c' = 1",
+		output: "select json_extract(a, $.b), json_unquote(json_extract(t.a, $[0])), json_length(a) from t where json_extract(a, $.c) = 1",
 	}, {
 		input:  "select current_time(), current_timestamp, lacalTIMe(89), utc_time() from t",
 		output: "select current_time(), current_timestamp(), lacaltime(89), utc_time() from t",
@@ -333,6 +394,15 @@ var (
 	}, {
 		input:  "select u.a, interval 1 second from t",
 		output: "select u.a, interval(1, second) from t",
+	}, {
+		input:  "select a + interval -1 day, a - interval b hour, interval '1:30' hour_minute + a, a + interval '1' year from t",
+		output: "select a + interval(-1, day), a - interval(b, hour), interval(1:30, hour_minute) + a, a + interval(1, year) from t",
+	}, {
+		input:  "select date_add(a, interval 1 day), date_sub(a, interval 1.5 second), adddate(a, 3), subdate(a, interval 2 week) from t",
+		output: "select date_add(a, interval(1, day)), date_sub(a, interval(1.5, second)), adddate(a, 3), subdate(a, interval(2, week)) from t",
+	}, {
+		input:  "select timestampdiff(month, a, b), extract(day_hour from a) from t",
+		output: "select timestampdiff(month, a, b), extract(day_hour, a) from t",
 	}, {
 		input: "select u.a, (select t.a from sa.t, u) from t where (u.a, u.b, u.c) in (select * from t)",
 	}, {
@@ -492,6 +562,14 @@ var (
 	}, {
 		input:  "alter user if exists user() identified by 'test'",
 		output: "alter user if exists user() identified by test",
+	}, {
+		input:  "alter table t1 add column c int default 1",
+		output: "alter table t1 add column c int default 1",
+	}, {
+		input:  "alter table t1 add c varchar(10), drop b",
+		output: "alter table t1 add column c varchar(10), drop column b",
+	}, {
+		input: "alter table db.t1 rename column a to b",
 	}, {
 		input: "show index from t where true",
 	}, {
@@ -691,6 +769,18 @@ var (
 	}, {
 		input:  "analyze table part (a,b )",
 		output: "analyze table part(a, b)",
+	}, {
+		input: "analyze table part",
+	}, {
+		input:  "analyze table db.part columns a, b",
+		output: "analyze table db.part(a, b)",
+	}, {
+		input: "show stats",
+	}, {
+		input:  "show stats in db.part",
+		output: "show stats from db.part",
+	}, {
+		input: "select stats from stats",
 	}, {
 		input:  "select $ from t into outfile '/Users/tmp/test'",
 		output: "select $ from t into outfile /Users/tmp/test fields terminated by , enclosed by \" lines terminated by \n header true",
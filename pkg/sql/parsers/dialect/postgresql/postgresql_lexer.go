@@ -19,27 +19,32 @@ import (
 	"strconv"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/scanner"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
 
+/*
+Parse parses the sql by the grammar of the postgresql, the statements out of it
+are parsed by the grammar of the mysql with the lexical rules of the postgresql.
+*/
 func Parse(sql string) ([]tree.Statement, error) {
 	lexer := NewLexer(dialect.POSTGRESQL, sql)
 	if yyParse(lexer) != 0 {
-		return nil, lexer.scanner.LastError
+		return mysql.ParsePgLexical(sql)
 	}
 	return lexer.stmts, nil
 }
 
 func ParseOne(sql string) (tree.Statement, error) {
-	lexer := NewLexer(dialect.POSTGRESQL, sql)
-	if yyParse(lexer) != 0 {
-		return nil, lexer.scanner.LastError
+	stmts, err := Parse(sql)
+	if err != nil {
+		return nil, err
 	}
-	if len(lexer.stmts) != 1 {
+	if len(stmts) != 1 {
 		return nil, errors.New("Sytax Error, or too many sql to parse")
 	}
-	return lexer.stmts[0], nil
+	return stmts[0], nil
 }
 
 type Lexer struct {
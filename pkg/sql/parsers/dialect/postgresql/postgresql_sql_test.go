@@ -44,3 +44,57 @@ func TestDebug(t *testing.T) {
 		t.Errorf("Parsing failed. \nExpected/Got:\n%s\n%s", debugSQL.output, out)
 	}
 }
+
+var (
+	validSQL = []struct {
+		input  string
+		output string
+	}{{
+		input: "use db1",
+	}, {
+		input:  "select 'a\\b', 'it''s', E'a\\nb' from t",
+		output: "select a\\b, it's, a\nb from t",
+	}, {
+		input:  "select \"x\", \"A\"\"b\" from \"t\" where a = $1 and b > $12",
+		output: "select x, A\"b from t where a = ? and b > ?",
+	}, {
+		input:  "select 1 /*! , 2 */",
+		output: "select 1 from dual",
+	}}
+
+	invalidSQL = []string{
+		"select `a` from t",
+		"select a from t # comment",
+		"select a from t where a = ?",
+		"select @a",
+		"select a::int from t",
+		"select 'a' || 'b'",
+		"select 'a",
+		"select \"\" from t",
+	}
+)
+
+func TestValid(t *testing.T) {
+	for _, tcase := range validSQL {
+		if tcase.output == "" {
+			tcase.output = tcase.input
+		}
+		ast, err := ParseOne(tcase.input)
+		if err != nil {
+			t.Errorf("Parse(%q) err: %v", tcase.input, err)
+			continue
+		}
+		out := tree.String(ast, dialect.POSTGRESQL)
+		if tcase.output != out {
+			t.Errorf("Parsing failed. \nExpected/Got:\n%s\n%s", tcase.output, out)
+		}
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	for _, sql := range invalidSQL {
+		if _, err := Parse(sql); err == nil {
+			t.Errorf("Parse(%q) expects an error", sql)
+		}
+	}
+}
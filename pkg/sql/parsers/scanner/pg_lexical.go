@@ -0,0 +1,120 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scanner
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+)
+
+/*
+NewPgLexicalScanner returns the scanner of the tokens of the mysql grammar which
+reads the sql by the lexical rules of the postgresql:
+1. the backslash is not an escape in the single quoted strings, '' is the quote,
+the E'...' strings take the backslash escapes.
+2. the double quotes delimit the identifiers, "" is the quote.
+3. $n is the nth parameter.
+4. the lexemes of the mysql which mean another thing in the postgresql are errors:
+the backticks, '#', '?', '@', '::' and '||'.
+*/
+func NewPgLexicalScanner(sql string) *Scanner {
+	initTokens(dialect.MYSQL)
+	return &Scanner{
+		buf:       sql,
+		pgLexical: true,
+	}
+}
+
+// scanPgLexeme scans the lexemes differ from the mysql, ok is false for the others
+func (s *Scanner) scanPgLexeme() (int, string, bool) {
+	switch ch := s.cur(); {
+	case ch == '\'':
+		s.skip(1)
+		tok, str := s.scanPgString()
+		return tok, str, true
+	case (ch == 'E' || ch == 'e') && s.peek(1) == '\'':
+		s.skip(2)
+		tok, str := s.scanString('\'', STRING)
+		return tok, str, true
+	case ch == '"':
+		s.skip(1)
+		tok, str := s.scanPgIdentifier()
+		return tok, str, true
+	case ch == '$' && isDigit(s.peek(1)):
+		start := s.Pos
+		s.skip(1)
+		for isDigit(s.cur()) {
+			s.skip(1)
+		}
+		return VALUE_ARG, ":v" + s.buf[start+1:s.Pos], true
+	case ch == '`', ch == '#', ch == '?', ch == '@':
+		s.skip(1)
+		return LEX_ERROR, string(byte(ch)), true
+	case ch == ':' && s.peek(1) == ':', ch == '|' && s.peek(1) == '|':
+		s.skip(2)
+		return LEX_ERROR, s.buf[s.Pos-2 : s.Pos], true
+	case ch == '/' && s.peek(1) == '*':
+		//the /*! is a comment as the others
+		s.skip(2)
+		if tok, str := s.scanCommentTypeBlock(); tok == LEX_ERROR {
+			return tok, str, true
+		}
+		tok, str := s.Scan()
+		return tok, str, true
+	}
+	return 0, "", false
+}
+
+// scanPgString scans a single quoted string whose quote is '', assumes the ' has been scanned
+func (s *Scanner) scanPgString() (int, string) {
+	var buf strings.Builder
+	for {
+		ch := s.cur()
+		switch ch {
+		case eofChar:
+			return LEX_ERROR, buf.String()
+		case '\'':
+			s.skip(1)
+			if s.cur() != '\'' {
+				return STRING, buf.String()
+			}
+		}
+		buf.WriteByte(byte(ch))
+		s.skip(1)
+	}
+}
+
+// scanPgIdentifier scans a double quoted identifier whose quote is "", assumes the " has been scanned
+func (s *Scanner) scanPgIdentifier() (int, string) {
+	var buf strings.Builder
+	for {
+		ch := s.cur()
+		switch ch {
+		case eofChar:
+			return LEX_ERROR, buf.String()
+		case '"':
+			s.skip(1)
+			if s.cur() != '"' {
+				if buf.Len() == 0 {
+					return LEX_ERROR, ""
+				}
+				return ID, buf.String()
+			}
+		}
+		buf.WriteByte(byte(ch))
+		s.skip(1)
+	}
+}
@@ -31,6 +31,8 @@ type Scanner struct {
 	posVarIndex         int
 	dialectType         dialect.DialectType
 	MysqlSpecialComment *Scanner
+	//read the sql by the lexical rules of the postgresql
+	pgLexical bool
 
 	Pos int
 	buf string
@@ -54,6 +56,11 @@ func (s *Scanner) Scan() (int, string) {
 	}
 
 	s.skipBlank()
+	if s.pgLexical {
+		if tok, str, ok := s.scanPgLexeme(); ok {
+			return tok, str
+		}
+	}
 	switch ch := s.cur(); {
 	case ch == '@':
 		tokenID := AT_ID
@@ -178,10 +185,11 @@ func (s *Scanner) stepBackOneChar(ch uint16) (int, string) {
 			}
 		case '>':
 			s.skip(1)
-			// TODO:
-			// JSON_UNQUOTE_EXTRACT_OP
-			// JSON_EXTRACT_OP
-			return 0, ""
+			if s.cur() == '>' {
+				s.skip(1)
+				return JSON_UNQUOTE_EXTRACT_OP, ""
+			}
+			return JSON_EXTRACT_OP, ""
 		}
 		return int(ch), ""
 	case '<':
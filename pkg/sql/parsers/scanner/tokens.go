@@ -86,6 +86,7 @@ func initTokens(dialectType dialect.DialectType) {
 		SUBDATE = MYSQL_SUBDATE
 		SUM = MYSQL_SUM
 		SYSTEM_USER = MYSQL_SYSTEM_USER
+		TIMESTAMPDIFF = MYSQL_TIMESTAMPDIFF
 		TRANSLATE = MYSQL_TRANSLATE
 		TRIM = MYSQL_TRIM
 		VARIANCE = MYSQL_VARIANCE
@@ -115,6 +116,14 @@ func initTokens(dialectType dialect.DialectType) {
 		ALGORITHM = MYSQL_ALGORITHM
 		LIST = MYSQL_LIST
 		RANGE = MYSQL_RANGE
+		OVER = MYSQL_OVER
+		PRECEDING = MYSQL_PRECEDING
+		FOLLOWING = MYSQL_FOLLOWING
+		UNBOUNDED = MYSQL_UNBOUNDED
+		CURRENT = MYSQL_CURRENT
+		ROWS = MYSQL_ROWS
+		RECURSIVE = MYSQL_RECURSIVE
+		OF = MYSQL_OF
 		CHECK = MYSQL_CHECK
 		ENFORCED = MYSQL_ENFORCED
 		RESTRICT = MYSQL_RESTRICT
@@ -152,6 +161,8 @@ func initTokens(dialectType dialect.DialectType) {
 		PRIVILEGES = MYSQL_PRIVILEGES
 		TABLESPACE = MYSQL_TABLESPACE
 		EXECUTE = MYSQL_EXECUTE
+		PREPARE = MYSQL_PREPARE
+		DEALLOCATE = MYSQL_DEALLOCATE
 		SUPER = MYSQL_SUPER
 		GRANT = MYSQL_GRANT
 		OPTION = MYSQL_OPTION
@@ -295,6 +306,8 @@ func initTokens(dialectType dialect.DialectType) {
 		IN = MYSQL_IN
 		SHIFT_LEFT = MYSQL_SHIFT_LEFT
 		SHIFT_RIGHT = MYSQL_SHIFT_RIGHT
+		JSON_EXTRACT_OP = MYSQL_JSON_EXTRACT_OP
+		JSON_UNQUOTE_EXTRACT_OP = MYSQL_JSON_UNQUOTE_EXTRACT_OP
 		DIV = MYSQL_DIV
 		MOD = MYSQL_MOD
 		UNARY = MYSQL_UNARY
@@ -385,6 +398,7 @@ func initTokens(dialectType dialect.DialectType) {
 		PROCEDURE = MYSQL_PROCEDURE
 		TRIGGER = MYSQL_TRIGGER
 		STATUS = MYSQL_STATUS
+		STATS = MYSQL_STATS
 		VARIABLES = MYSQL_VARIABLES
 		NULLX = MYSQL_NULLX
 		AUTO_INCREMENT = MYSQL_AUTO_INCREMENT
@@ -518,6 +532,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"sysdate":                  SYSDATE,
 		"create":                   CREATE,
 		"cross":                    CROSS,
+		"current":                  CURRENT,
 		"current_date":             CURRENT_DATE,
 		"current_time":             CURRENT_TIME,
 		"current_timestamp":        CURRENT_TIMESTAMP,
@@ -568,6 +583,8 @@ func initTokens(dialectType dialect.DialectType) {
 		"expire":                   EXPIRE,
 		"except":                   EXCEPT,
 		"execute":                  EXECUTE,
+		"prepare":                  PREPARE,
+		"deallocate":               DEALLOCATE,
 		"errors":                   ERRORS,
 		"event":                    EVENT,
 		"false":                    FALSE,
@@ -576,6 +593,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"float4":                   UNUSED,
 		"float8":                   UNUSED,
 		"for":                      FOR,
+		"following":                FOLLOWING,
 		"force":                    FORCE,
 		"foreign":                  FOREIGN,
 		"format":                   FORMAT,
@@ -687,6 +705,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"null":                     NULL,
 		"numeric":                  NUMERIC,
 		"none":                     NONE,
+		"of":                       OF,
 		"offset":                   OFFSET,
 		"on":                       ON,
 		"only":                     ONLY,
@@ -700,6 +719,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"out":                      UNUSED,
 		"outer":                    OUTER,
 		"outfile":                  OUTFILE,
+		"over":                     OVER,
 		"header":                   HEADER,
 		"max_file_size":            MAX_FILE_SIZE,
 		"force_quote":              FORCE_QUOTE,
@@ -712,6 +732,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"point":                    POINT,
 		"polygon":                  POLYGON,
 		"precision":                UNUSED,
+		"preceding":                PRECEDING,
 		"primary":                  PRIMARY,
 		"processlist":              PROCESSLIST,
 		"procedure":                PROCEDURE,
@@ -726,6 +747,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"redundant":                REDUNDANT,
 		"read_write":               UNUSED,
 		"real":                     REAL,
+		"recursive":                RECURSIVE,
 		"references":               REFERENCES,
 		"regexp":                   REGEXP,
 		"release":                  RELEASE,
@@ -750,6 +772,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"routine":                  ROUTINE,
 		"row":                      ROW,
 		"row_format":               ROW_FORMAT,
+		"rows":                     ROWS,
 		"row_count":                ROW_COUNT,
 		"rtree":                    RTREE,
 		"schema":                   SCHEMA,
@@ -784,6 +807,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"start":                    START,
 		"starting":                 STARTING,
 		"status":                   STATUS,
+		"stats":                    STATS,
 		"stats_auto_recalc":        STATS_AUTO_RECALC,
 		"stats_persistent":         STATS_PERSISTENT,
 		"stats_sample_pages":       STATS_SAMPLE_PAGES,
@@ -813,6 +837,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"truncate":                 TRUNCATE,
 		"uncommitted":              UNCOMMITTED,
 		"undo":                     UNUSED,
+		"unbounded":                UNBOUNDED,
 		"union":                    UNION,
 		"unique":                   UNIQUE,
 		"unlock":                   UNLOCK,
@@ -867,6 +892,7 @@ func initTokens(dialectType dialect.DialectType) {
 		"subdate":                  SUBDATE,
 		"sum":                      SUM,
 		"system_user":              SYSTEM_USER,
+		"timestampdiff":            TIMESTAMPDIFF,
 		"translate":                TRANSLATE,
 		"trim":                     TRIM,
 		"variance":                 VARIANCE,
@@ -947,6 +973,7 @@ var (
 	SUBDATE                  int
 	SUM                      int
 	SYSTEM_USER              int
+	TIMESTAMPDIFF            int
 	TRANSLATE                int
 	TRIM                     int
 	VARIANCE                 int
@@ -972,6 +999,14 @@ var (
 	ALGORITHM                int
 	LIST                     int
 	RANGE                    int
+	OVER                     int
+	PRECEDING                int
+	FOLLOWING                int
+	UNBOUNDED                int
+	CURRENT                  int
+	ROWS                     int
+	RECURSIVE                int
+	OF                       int
 	CHECK                    int
 	ENFORCED                 int
 	RESTRICT                 int
@@ -1015,6 +1050,8 @@ var (
 	PRIVILEGES               int
 	TABLESPACE               int
 	EXECUTE                  int
+	PREPARE                  int
+	DEALLOCATE               int
 	SUPER                    int
 	GRANT                    int
 	OPTION                   int
@@ -1152,6 +1189,8 @@ var (
 	IN                       int
 	SHIFT_LEFT               int
 	SHIFT_RIGHT              int
+	JSON_EXTRACT_OP          int
+	JSON_UNQUOTE_EXTRACT_OP  int
 	DIV                      int
 	MOD                      int
 	UNARY                    int
@@ -1244,6 +1283,7 @@ var (
 	PROCEDURE                int
 	TRIGGER                  int
 	STATUS                   int
+	STATS                    int
 	VARIABLES                int
 	NULLX                    int
 	AUTO_INCREMENT           int
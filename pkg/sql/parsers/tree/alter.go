@@ -82,3 +82,87 @@ func NewAlterUser(ife bool, iuf bool, uf *User, u []*User, r []*Role, t []TlsOpt
 		MiscOpts:   m,
 	}
 }
+
+type AlterTable struct {
+	statementImpl
+	Table   *TableName
+	Options []AlterTableOption
+}
+
+func (node *AlterTable) Format(ctx *FmtCtx) {
+	ctx.WriteString("alter table ")
+	node.Table.Format(ctx)
+	prefix := " "
+	for _, opt := range node.Options {
+		ctx.WriteString(prefix)
+		opt.Format(ctx)
+		prefix = ", "
+	}
+}
+
+func NewAlterTable(t *TableName, o []AlterTableOption) *AlterTable {
+	return &AlterTable{
+		Table:   t,
+		Options: o,
+	}
+}
+
+type AlterTableOption interface {
+	NodeFormatter
+}
+
+type alterTableOptionImpl struct {
+	AlterTableOption
+}
+
+type AlterOptionAddColumn struct {
+	alterTableOptionImpl
+	Column *ColumnTableDef
+}
+
+func (node *AlterOptionAddColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("add column ")
+	node.Column.Format(ctx)
+}
+
+func NewAlterOptionAddColumn(c *ColumnTableDef) *AlterOptionAddColumn {
+	return &AlterOptionAddColumn{
+		Column: c,
+	}
+}
+
+type AlterOptionDropColumn struct {
+	alterTableOptionImpl
+	Name Identifier
+}
+
+func (node *AlterOptionDropColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop column ")
+	ctx.WriteString(string(node.Name))
+}
+
+func NewAlterOptionDropColumn(n Identifier) *AlterOptionDropColumn {
+	return &AlterOptionDropColumn{
+		Name: n,
+	}
+}
+
+type AlterOptionRenameColumn struct {
+	alterTableOptionImpl
+	Name    Identifier
+	NewName Identifier
+}
+
+func (node *AlterOptionRenameColumn) Format(ctx *FmtCtx) {
+	ctx.WriteString("rename column ")
+	ctx.WriteString(string(node.Name))
+	ctx.WriteString(" to ")
+	ctx.WriteString(string(node.NewName))
+}
+
+func NewAlterOptionRenameColumn(n, nn Identifier) *AlterOptionRenameColumn {
+	return &AlterOptionRenameColumn{
+		Name:    n,
+		NewName: nn,
+	}
+}
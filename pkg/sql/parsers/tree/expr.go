@@ -528,6 +528,9 @@ type FuncExpr struct {
 
 	//aggregations which specify an order.
 	OrderBy OrderBy
+
+	//the window of a window function, nil for other functions.
+	WindowSpec *WindowSpec
 }
 
 func (node *FuncExpr) Format(ctx *FmtCtx) {
@@ -540,6 +543,110 @@ func (node *FuncExpr) Format(ctx *FmtCtx) {
 	}
 	node.Exprs.Format(ctx)
 	ctx.WriteByte(')')
+	if node.WindowSpec != nil {
+		ctx.WriteString(" over (")
+		node.WindowSpec.Format(ctx)
+		ctx.WriteByte(')')
+	}
+}
+
+//the window of a window function:
+//OVER ([PARTITION BY ...] [ORDER BY ...] [frame])
+type WindowSpec struct {
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *FrameClause
+}
+
+func (node *WindowSpec) Format(ctx *FmtCtx) {
+	prefix := ""
+	if len(node.PartitionBy) > 0 {
+		ctx.WriteString("partition by ")
+		node.PartitionBy.Format(ctx)
+		prefix = " "
+	}
+	if len(node.OrderBy) > 0 {
+		ctx.WriteString(prefix)
+		node.OrderBy.Format(ctx)
+		prefix = " "
+	}
+	if node.Frame != nil {
+		ctx.WriteString(prefix)
+		node.Frame.Format(ctx)
+	}
+}
+
+type FrameType int
+
+func (node *FrameType) ToString() string {
+	switch *node {
+	case FRAME_TYPE_ROWS:
+		return "rows"
+	case FRAME_TYPE_RANGE:
+		return "range"
+	default:
+		return "Unknown FrameType"
+	}
+}
+
+const (
+	FRAME_TYPE_ROWS FrameType = iota
+	FRAME_TYPE_RANGE
+)
+
+//the frame of a window: ROWS|RANGE BETWEEN start AND end.
+//End is nil for a frame with a single bound, which ends at the current row.
+type FrameClause struct {
+	Type  FrameType
+	Start *FrameBound
+	End   *FrameBound
+}
+
+func (node *FrameClause) Format(ctx *FmtCtx) {
+	ctx.WriteString(node.Type.ToString())
+	ctx.WriteByte(' ')
+	if node.End == nil {
+		node.Start.Format(ctx)
+		return
+	}
+	ctx.WriteString("between ")
+	node.Start.Format(ctx)
+	ctx.WriteString(" and ")
+	node.End.Format(ctx)
+}
+
+type FrameBoundType int
+
+const (
+	FRAME_BOUND_UNBOUNDED_PRECEDING FrameBoundType = iota
+	FRAME_BOUND_PRECEDING
+	FRAME_BOUND_CURRENT_ROW
+	FRAME_BOUND_FOLLOWING
+	FRAME_BOUND_UNBOUNDED_FOLLOWING
+)
+
+//a bound of a window frame. Expr is the offset of
+//N PRECEDING and N FOLLOWING.
+type FrameBound struct {
+	Type FrameBoundType
+	Expr Expr
+}
+
+func (node *FrameBound) Format(ctx *FmtCtx) {
+	switch node.Type {
+	case FRAME_BOUND_UNBOUNDED_PRECEDING:
+		ctx.WriteString("unbounded preceding")
+	case FRAME_BOUND_PRECEDING:
+		node.Expr.Format(ctx)
+		ctx.WriteString(" preceding")
+	case FRAME_BOUND_CURRENT_ROW:
+		ctx.WriteString("current row")
+	case FRAME_BOUND_FOLLOWING:
+		node.Expr.Format(ctx)
+		ctx.WriteString(" following")
+	case FRAME_BOUND_UNBOUNDED_FOLLOWING:
+		ctx.WriteString("unbounded following")
+	}
 }
 
 func NewFuncExpr(ft FuncType, name *UnresolvedName, e Exprs, order OrderBy) *FuncExpr {
@@ -831,3 +938,20 @@ func NewVarExpr(n string, s bool, g bool, e Expr) *VarExpr {
 		Expr:   e,
 	}
 }
+
+//the placeholder '?' of the prepared statement
+type ParamExpr struct {
+	exprImpl
+	//the position of the placeholder in the prepared statement. starts from 1.
+	Offset int
+}
+
+func (node *ParamExpr) Format(ctx *FmtCtx) {
+	ctx.WriteByte('?')
+}
+
+func NewParamExpr(offset int) *ParamExpr {
+	return &ParamExpr{
+		Offset: offset,
+	}
+}
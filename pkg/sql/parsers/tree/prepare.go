@@ -0,0 +1,98 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+//PREPARE name FROM 'sql'
+type PrepareString struct {
+	statementImpl
+	Name Identifier
+	Sql  string
+}
+
+func (node *PrepareString) Format(ctx *FmtCtx) {
+	ctx.WriteString("prepare ")
+	node.Name.Format(ctx)
+	ctx.WriteString(" from '")
+	ctx.WriteString(node.Sql)
+	ctx.WriteByte('\'')
+}
+
+func NewPrepareString(name Identifier, sql string) *PrepareString {
+	return &PrepareString{Name: name, Sql: sql}
+}
+
+//PREPARE name FROM @var
+type PrepareVar struct {
+	statementImpl
+	Name Identifier
+	Var  *VarExpr
+}
+
+func (node *PrepareVar) Format(ctx *FmtCtx) {
+	ctx.WriteString("prepare ")
+	node.Name.Format(ctx)
+	ctx.WriteString(" from ")
+	node.Var.Format(ctx)
+}
+
+func NewPrepareVar(name Identifier, v *VarExpr) *PrepareVar {
+	return &PrepareVar{Name: name, Var: v}
+}
+
+//EXECUTE name [USING @var [, @var] ...]
+type Execute struct {
+	statementImpl
+	Name      Identifier
+	Variables []*VarExpr
+}
+
+func (node *Execute) Format(ctx *FmtCtx) {
+	ctx.WriteString("execute ")
+	node.Name.Format(ctx)
+	if len(node.Variables) > 0 {
+		ctx.WriteString(" using ")
+		for i, v := range node.Variables {
+			if i > 0 {
+				ctx.WriteString(", ")
+			}
+			v.Format(ctx)
+		}
+	}
+}
+
+func NewExecute(name Identifier, vars []*VarExpr) *Execute {
+	return &Execute{Name: name, Variables: vars}
+}
+
+//{DEALLOCATE | DROP} PREPARE name
+type Deallocate struct {
+	statementImpl
+	Name   Identifier
+	IsDrop bool
+}
+
+func (node *Deallocate) Format(ctx *FmtCtx) {
+	if node.IsDrop {
+		ctx.WriteString("drop")
+	} else {
+		ctx.WriteString("deallocate")
+	}
+	ctx.WriteString(" prepare ")
+	node.Name.Format(ctx)
+}
+
+func NewDeallocate(name Identifier, isDrop bool) *Deallocate {
+	return &Deallocate{Name: name, IsDrop: isDrop}
+}
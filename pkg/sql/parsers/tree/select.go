@@ -402,10 +402,17 @@ type AliasedTableExpr struct {
 	TableExpr
 	Expr TableExpr
 	As   AliasClause
+	// AsOf is the timestamp of the snapshot the table is read from, empty reads the latest data.
+	AsOf string
 }
 
 func (node *AliasedTableExpr) Format(ctx *FmtCtx) {
 	node.Expr.Format(ctx)
+	if node.AsOf != "" {
+		ctx.WriteString(" as of timestamp '")
+		ctx.WriteString(node.AsOf)
+		ctx.WriteByte('\'')
+	}
 	if node.As.Alias != "" {
 		ctx.WriteString(" as ")
 		node.As.Format(ctx)
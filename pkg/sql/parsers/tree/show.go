@@ -307,3 +307,24 @@ func NewShowIndex(t TableName, w *Where) *ShowIndex {
 		Where:     w,
 	}
 }
+
+//show stats statement
+type ShowStats struct {
+	showImpl
+	//nil shows the statistics of all the analyzed tables
+	Table *TableName
+}
+
+func (node *ShowStats) Format(ctx *FmtCtx) {
+	ctx.WriteString("show stats")
+	if node.Table != nil {
+		ctx.WriteString(" from ")
+		node.Table.Format(ctx)
+	}
+}
+
+func NewShowStats(t *TableName) *ShowStats {
+	return &ShowStats{
+		Table: t,
+	}
+}
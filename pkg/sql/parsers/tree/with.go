@@ -14,6 +14,8 @@
 
 package tree
 
+import "strings"
+
 type With struct {
 	statementImpl
 	IsRecursive bool
@@ -44,3 +46,42 @@ func (node *CTE) Format(ctx *FmtCtx) {
 	node.Stmt.Format(ctx)
 	ctx.WriteString(")")
 }
+
+// ReferencesTable returns true if the table name without schema is used in the FROM clauses of stmt
+func ReferencesTable(stmt SelectStatement, name string) bool {
+	switch stmt := stmt.(type) {
+	case *Select:
+		return ReferencesTable(stmt.Select, name)
+	case *ParenSelect:
+		return ReferencesTable(stmt.Select, name)
+	case *UnionClause:
+		return ReferencesTable(stmt.Left, name) || ReferencesTable(stmt.Right, name)
+	case *SelectClause:
+		if stmt.From == nil {
+			return false
+		}
+		for _, table := range stmt.From.Tables {
+			if tableExprHasReference(table, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func tableExprHasReference(expr TableExpr, name string) bool {
+	switch expr := expr.(type) {
+	case *TableName:
+		return len(expr.SchemaName) == 0 && strings.EqualFold(string(expr.ObjectName), name)
+	case *AliasedTableExpr:
+		return tableExprHasReference(expr.Expr, name)
+	case *ParenTableExpr:
+		return tableExprHasReference(expr.Expr, name)
+	case *JoinTableExpr:
+		return tableExprHasReference(expr.Left, name) ||
+			(expr.Right != nil && tableExprHasReference(expr.Right, name))
+	case *Select:
+		return ReferencesTable(expr, name)
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+// BuildAlterTable checks every alter option against the relation's current
+// columns and turns it into a table definition to be added or removed.
+func (b *build) BuildAlterTable(stmt *tree.AlterTable, plan *AlterTable) error {
+	_, tblName, r, err := b.tableName(stmt.Table)
+	if err != nil {
+		return err
+	}
+	cols := make(map[string]struct{}) // map of relation's column names
+	for _, def := range r.TableDefs() {
+		if attr, ok := def.(*engine.AttributeDef); ok {
+			cols[attr.Attr.Name] = struct{}{}
+		}
+	}
+
+	actions := make([]AlterAction, 0, len(stmt.Options))
+	for _, option := range stmt.Options {
+		switch n := option.(type) {
+		case *tree.AlterOptionAddColumn:
+			def, pks, err := b.getTableDef(n.Column)
+			if err != nil {
+				return err
+			}
+			if pks != nil {
+				return errors.New(errno.FeatureNotSupported, "add primary key column not supported")
+			}
+			name := def.(*engine.AttributeDef).Attr.Name
+			if _, ok := cols[name]; ok {
+				return errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", name))
+			}
+			cols[name] = struct{}{}
+			actions = append(actions, AlterAction{Def: def})
+		case *tree.AlterOptionDropColumn:
+			name := string(n.Name)
+			if _, ok := cols[name]; !ok {
+				return errors.New(errno.UndefinedColumn, fmt.Sprintf("unknown column '%s'", name))
+			}
+			delete(cols, name)
+			actions = append(actions, AlterAction{
+				Drop: true,
+				Def:  &engine.AttributeDef{Attr: engine.Attribute{Name: name}},
+			})
+		case *tree.AlterOptionRenameColumn:
+			name, newName := string(n.Name), string(n.NewName)
+			if _, ok := cols[name]; !ok {
+				return errors.New(errno.UndefinedColumn, fmt.Sprintf("unknown column '%s'", name))
+			}
+			if _, ok := cols[newName]; ok {
+				return errors.New(errno.DuplicateColumn, fmt.Sprintf("Duplicate column name '%s'", newName))
+			}
+			delete(cols, name)
+			cols[newName] = struct{}{}
+			actions = append(actions, AlterAction{Def: &engine.RenameColumnDef{Name: name, NewName: newName}})
+		default:
+			return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport alter option: '%v'", option))
+		}
+	}
+	if len(cols) == 0 {
+		return errors.New(errno.InvalidTableDefinition, "You can't delete all columns with ALTER TABLE")
+	}
+
+	plan.Id = tblName
+	plan.Relation = r
+	plan.Actions = actions
+	return nil
+}
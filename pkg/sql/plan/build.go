@@ -16,6 +16,7 @@ package plan
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
@@ -32,6 +33,16 @@ func New(db string, sql string, e engine.Engine) *build {
 	}
 }
 
+// SetTimeZone sets the time zone of the session which the TIMESTAMP literals and NOW() are in
+func (b *build) SetTimeZone(loc *time.Location) {
+	b.loc = loc
+}
+
+// SetParams binds the values to the placeholders of the prepared statement, the i-th value is for the placeholder at offset i+1
+func (b *build) SetParams(params []tree.Expr) {
+	b.params = params
+}
+
 func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 	switch stmt := stmt.(type) {
 	case *tree.Select:
@@ -88,6 +99,12 @@ func (b *build) BuildStatement(stmt tree.Statement) (Plan, error) {
 			return nil, err
 		}
 		return plan, nil
+	case *tree.AlterTable:
+		plan := &AlterTable{}
+		if err := b.BuildAlterTable(stmt, plan); err != nil {
+			return nil, err
+		}
+		return plan, nil
 	case *tree.ShowDatabases:
 		plan := &ShowDatabases{}
 		if err := b.BuildShowDatabases(stmt, plan); err != nil {
@@ -16,12 +16,15 @@ package plan
 
 import (
 	"fmt"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/memEngine"
 	"log"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 var querys = []string{
@@ -205,3 +208,82 @@ func processQuery(query string, e engine.Engine) {
 	}
 
 }
+
+func TestBuildCreateTableCompression(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	build := func(query string) (*CreateTable, error) {
+		stmts, err := parsers.Parse(dialect.MYSQL, query)
+		if err != nil {
+			return nil, err
+		}
+		qry, err := New("test", query, e).BuildStatement(stmts[0])
+		if err != nil {
+			return nil, err
+		}
+		return qry.(*CreateTable), nil
+	}
+	algs := func(ct *CreateTable) (algs []compress.T) {
+		for _, def := range ct.Defs {
+			if attr, ok := def.(*engine.AttributeDef); ok {
+				algs = append(algs, attr.Attr.Alg)
+			}
+		}
+		return
+	}
+
+	ct, err := build("create table t(a int, b int)")
+	require.NoError(t, err)
+	require.Equal(t, []compress.T{compress.Lz4, compress.Lz4}, algs(ct))
+
+	ct, err = build("create table t(a int, b int) compression='ZSTD' properties('compression_level'='19')")
+	require.NoError(t, err)
+	require.Equal(t, []compress.T{compress.Zstd, compress.Zstd}, algs(ct))
+
+	ct, err = build("create table t(a int) compression='snappy'")
+	require.NoError(t, err)
+	require.Equal(t, []compress.T{compress.Snappy}, algs(ct))
+
+	_, err = build("create table t(a int) compression='gzip'")
+	require.Error(t, err)
+	_, err = build("create table t(a int) compression='zstd' properties('compression_level'='30')")
+	require.Error(t, err)
+	_, err = build("create table t(a int) compression='lz4' properties('compression_level'='1')")
+	require.Error(t, err)
+}
+
+func TestBuildAlterTable(t *testing.T) {
+	e := memEngine.NewTestEngine()
+	build := func(query string) (*AlterTable, error) {
+		stmts, err := parsers.Parse(dialect.MYSQL, query)
+		if err != nil {
+			return nil, err
+		}
+		qry, err := New("test", query, e).BuildStatement(stmts[0])
+		if err != nil {
+			return nil, err
+		}
+		return qry.(*AlterTable), nil
+	}
+
+	at, err := build("alter table t1 add column level int default 3, drop column score, rename column userID to uid")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(at.Actions))
+	require.False(t, at.Actions[0].Drop)
+	require.Equal(t, "level", at.Actions[0].Def.(*engine.AttributeDef).Attr.Name)
+	require.True(t, at.Actions[1].Drop)
+	require.Equal(t, "score", at.Actions[1].Def.(*engine.AttributeDef).Attr.Name)
+	require.Equal(t, &engine.RenameColumnDef{Name: "userID", NewName: "uid"}, at.Actions[2].Def)
+
+	_, err = build("alter table t1 add column spID int")
+	require.Error(t, err)
+	_, err = build("alter table t1 drop column nothing")
+	require.Error(t, err)
+	_, err = build("alter table t1 rename column spID to score")
+	require.Error(t, err)
+	_, err = build("alter table t1 add column c int primary key")
+	require.Error(t, err)
+	_, err = build("alter table t1 add column c int default 'abc'")
+	require.Error(t, err)
+	_, err = build("alter table t1 drop spID, drop userID, drop score")
+	require.Error(t, err)
+}
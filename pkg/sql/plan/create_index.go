@@ -29,6 +29,8 @@ var (
 
 	// err for index support
 	errBsiUnsupported = errors.New(errno.FeatureNotSupported, "BSI index not support type char/varchar now")
+	// the indexes on the paths of JSON are not supported, the binary JSON is not comparable either
+	errJsonIndexUnsupported = errors.New(errno.FeatureNotSupported, "index on JSON column or JSON path not support now, index a column holding the extracted value instead")
 )
 
 func (b *build) BuildCreateIndex(stmt *tree.CreateIndex, plan *CreateIndex) error {
@@ -92,6 +94,8 @@ func (b *build) BuildCreateIndex(stmt *tree.CreateIndex, plan *CreateIndex) erro
 				col = key.ColName.Parts[0]
 				if colType, ok := mpColName[col]; !ok {
 					return errors.New(errno.UndefinedColumn, fmt.Sprintf("unknown column '%s'", col))
+				} else if colType.Oid == types.T_json {
+					return errJsonIndexUnsupported
 				} else {
 					if err := bsiSupport(colType); engineIndexType == engine.BsiIndex && err != nil {
 						return err
@@ -64,10 +64,20 @@ func (b *build) BuildCreateTable(stmt *tree.CreateTable, plan *CreateTable) erro
 		defs = append(defs, def)
 	}
 
+	alg := compress.T(compress.Lz4)
 	for _, option := range stmt.Options {
+		if n, ok := option.(*tree.TableOptionCompression); ok {
+			if alg, err = compress.ParseAlgorithm(n.Compression); err != nil {
+				return errors.New(errno.InvalidOptionValue, err.Error())
+			}
+			continue
+		}
 		def, _ := b.getOptionDef(option)
 		defs = append(defs, def)
 	}
+	if err = setCompression(defs, alg); err != nil {
+		return err
+	}
 	if pkFlag && pkNames != nil {
 		defs = append(defs, &engine.PrimaryIndexDef{Names: pkNames})
 	}
@@ -98,6 +108,27 @@ func (b *build) getOptionDef(option tree.TableOption) (engine.TableDef, error) {
 	return nil, nil
 }
 
+// setCompression applies the table's compression algorithm to all its
+// columns and checks the compression level property against it.
+func setCompression(defs []engine.TableDef, alg compress.T) error {
+	for _, def := range defs {
+		switch v := def.(type) {
+		case *engine.AttributeDef:
+			v.Attr.Alg = alg
+		case *engine.PropertiesDef:
+			for _, property := range v.Properties {
+				if property.Key != engine.CompressionLevelProperty {
+					continue
+				}
+				if _, err := compress.ParseLevel(alg, property.Value); err != nil {
+					return errors.New(errno.InvalidOptionValue, err.Error())
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func (b *build) tableInfo(stmt tree.TableExpr) (string, string, error) {
 	tbl, ok := stmt.(tree.TableName)
 	if !ok {
@@ -223,6 +254,8 @@ func (b *build) getTableDefType(typ tree.ResolvableTypeReference) (*types.Type,
 				return &types.Type{Oid: types.T_char, Size: 24, Width: 1}, nil
 			}
 			return &types.Type{Oid: types.T_varchar, Size: 24, Width: n.InternalType.DisplayWith}, nil
+		case defines.MYSQL_TYPE_JSON:
+			return &types.Type{Oid: types.T_json, Size: 24}, nil
 		case defines.MYSQL_TYPE_DATE:
 			return &types.Type{Oid: types.T_date, Size: 4}, nil
 		case defines.MYSQL_TYPE_DATETIME:
@@ -21,6 +21,8 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/matrixorigin/matrixone/pkg/builtin"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/defines"
@@ -70,6 +72,15 @@ func buildValue(val constant.Value, origString string) (extend.Extend, error) {
 	}
 }
 
+// bindParam returns the value bound to the placeholder of the prepared statement,
+// the other expressions and the placeholders without value are returned as they are.
+func (b *build) bindParam(n tree.Expr) tree.Expr {
+	if p, ok := n.(*tree.ParamExpr); ok && p.Offset > 0 && p.Offset <= len(b.params) {
+		return b.params[p.Offset-1]
+	}
+	return n
+}
+
 func (b *build) buildNot(e *tree.NotExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
 	ext, err := fn(e.Expr, qry)
 	if err != nil {
@@ -195,6 +206,16 @@ func (b *build) buildCast(e *tree.CastExpr, qry *Query, fn func(tree.Expr, *Quer
 	case defines.MYSQL_TYPE_VARCHAR, defines.MYSQL_TYPE_VAR_STRING:
 		typ.Size = 24
 		typ.Oid = types.T_varchar
+	case defines.MYSQL_TYPE_DECIMAL:
+		typ.Width = e.Type.(*tree.T).InternalType.DisplayWith
+		typ.Scale = e.Type.(*tree.T).InternalType.Precision
+		if typ.Width > 18 {
+			typ.Size = 16
+			typ.Oid = types.T_decimal128
+		} else {
+			typ.Size = 8
+			typ.Oid = types.T_decimal64
+		}
 	default:
 		return nil, errors.New(errno.IndeterminateDatatype, fmt.Sprintf("'%v' is not support now", e))
 	}
@@ -226,15 +247,30 @@ func (b *build) buildFunc(flg bool, e *tree.FuncExpr, qry *Query, fn func(tree.E
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
 	}
 	funcName := strings.ToLower(name.Parts[0])
+	//the window functions are evaluated before the query only if they are items of the select list
+	if e.WindowSpec != nil {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("window function '%s' is only supported as an item of the select list", tree.String(e, dialect.MYSQL)))
+	}
+	if _, ok := nowFunctions[funcName]; ok {
+		dt, err := b.now(e)
+		if err != nil {
+			return nil, err
+		}
+		vec := vector.New(types.Type{Oid: types.T_datetime, Size: 8})
+		vec.Ref = 1
+		vec.Col = []types.Datetime{dt}
+		return &extend.ValueExtend{V: vec, OrigStr: tree.String(e, dialect.MYSQL)}, nil
+	}
+	exprs := jsonAggArgs(funcName, dateAddArgs(funcName, e.Exprs))
 	if !flg {
 		if _, ok := transformer.TransformerNamesMap[funcName]; ok {
 			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Invalid use of group function"))
 		}
-		args := make([]extend.Extend, len(e.Exprs))
+		args := make([]extend.Extend, len(exprs))
 		{
 			var err error
 
-			for i, expr := range e.Exprs {
+			for i, expr := range exprs {
 				if args[i], err = fn(expr, qry); err != nil {
 					return nil, err
 				}
@@ -243,19 +279,19 @@ func (b *build) buildFunc(flg bool, e *tree.FuncExpr, qry *Query, fn func(tree.E
 		return buildFunctionExtend(&extend.FuncExtend{Name: funcName, Args: args})
 	}
 	if op, ok := transformer.TransformerNamesMap[funcName]; ok {
-		if len(e.Exprs) > 1 {
+		if len(exprs) > 1 {
 			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("Illegal function call '%s'", e))
 		}
 		if e.Type == tree.FUNC_TYPE_DISTINCT || e.Type == tree.FUNC_TYPE_ALL {
 			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("function type not support now"))
 		}
-		return b.buildAggregation(op, funcName, e.Exprs[0], qry, fn)
+		return b.buildAggregation(op, funcName, exprs[0], qry, fn)
 	}
-	args := make([]extend.Extend, len(e.Exprs))
+	args := make([]extend.Extend, len(exprs))
 	{
 		var err error
 
-		for i, expr := range e.Exprs {
+		for i, expr := range exprs {
 			if args[i], err = fn(expr, qry); err != nil {
 				return nil, err
 			}
@@ -267,6 +303,12 @@ func (b *build) buildFunc(flg bool, e *tree.FuncExpr, qry *Query, fn func(tree.E
 func (b *build) buildBinary(e *tree.BinaryExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
 	switch e.Op {
 	case tree.PLUS:
+		if value, unit, ok := splitInterval(e.Right); ok {
+			return b.buildFunc(false, dateAddExpr("date_add", e.Left, value, unit), qry, fn)
+		}
+		if value, unit, ok := splitInterval(e.Left); ok {
+			return b.buildFunc(false, dateAddExpr("date_add", e.Right, value, unit), qry, fn)
+		}
 		left, err := fn(e.Left, qry)
 		if err != nil {
 			return nil, err
@@ -277,6 +319,9 @@ func (b *build) buildBinary(e *tree.BinaryExpr, qry *Query, fn func(tree.Expr, *
 		}
 		return &extend.BinaryExtend{Op: overload.Plus, Left: left, Right: right}, nil
 	case tree.MINUS:
+		if value, unit, ok := splitInterval(e.Right); ok {
+			return b.buildFunc(false, dateAddExpr("date_sub", e.Left, value, unit), qry, fn)
+		}
 		left, err := fn(e.Left, qry)
 		if err != nil {
 			return nil, err
@@ -330,6 +375,128 @@ func (b *build) buildBinary(e *tree.BinaryExpr, qry *Query, fn func(tree.Expr, *
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
 }
 
+// nowFunctions are the functions of the current datetime, they are evaluated once for a statement
+var nowFunctions = map[string]struct{}{
+	"now":               {},
+	"current_timestamp": {},
+	"localtime":         {},
+	"localtimestamp":    {},
+}
+
+// now returns the current datetime in the time zone of the session for the function like NOW(fsp)
+func (b *build) now(e *tree.FuncExpr) (types.Datetime, error) {
+	var fsp int64
+	switch len(e.Exprs) {
+	case 0:
+	case 1:
+		v, err := buildConstant(types.Type{Oid: types.T_int64, Size: 8}, e.Exprs[0])
+		if err != nil {
+			return 0, err
+		}
+		if fsp = v.(int64); fsp < 0 || fsp > 6 {
+			return 0, errors.New(errno.DataException, fmt.Sprintf("too-big precision %d specified for '%s'. Maximum is 6", fsp, e))
+		}
+	default:
+		return 0, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("wrong parameters for function '%s'", e))
+	}
+	return types.NowIn(b.loc).Truncate(int32(fsp)), nil
+}
+
+// foldNow replaces the functions of the current datetime with the string of their value
+func (b *build) foldNow(n tree.Expr) (tree.Expr, error) {
+	e, ok := n.(*tree.FuncExpr)
+	if !ok {
+		return n, nil
+	}
+	name, ok := e.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok {
+		return n, nil
+	}
+	if _, ok := nowFunctions[strings.ToLower(name.Parts[0])]; !ok {
+		return n, nil
+	}
+	dt, err := b.now(e)
+	if err != nil {
+		return nil, err
+	}
+	return stringExpr(dt.String2(6)), nil
+}
+
+// splitInterval returns the value and the unit of the interval expression
+// which is interval(value, unit) or interval('value unit')
+func splitInterval(e tree.Expr) (tree.Expr, tree.Expr, bool) {
+	f, ok := e.(*tree.FuncExpr)
+	if !ok {
+		return nil, nil, false
+	}
+	name, ok := f.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok || !strings.EqualFold(name.Parts[0], "interval") {
+		return nil, nil, false
+	}
+	switch len(f.Exprs) {
+	case 1:
+		v, ok := f.Exprs[0].(*tree.NumVal)
+		if !ok || v.Value.Kind() != constant.String {
+			return nil, nil, false
+		}
+		fields := strings.Fields(constant.StringVal(v.Value))
+		if len(fields) != 2 {
+			return nil, nil, false
+		}
+		return stringExpr(fields[0]), stringExpr(fields[1]), true
+	case 2:
+		return f.Exprs[0], f.Exprs[1], true
+	}
+	return nil, nil, false
+}
+
+// dateAddArgs returns the arguments of date_add(date, value, unit) for the calls
+// date_add(date, interval value unit) and adddate(date, days)
+func dateAddArgs(name string, exprs tree.Exprs) tree.Exprs {
+	switch name {
+	case "date_add", "date_sub", "adddate", "subdate":
+	default:
+		return exprs
+	}
+	if len(exprs) != 2 {
+		return exprs
+	}
+	if value, unit, ok := splitInterval(exprs[1]); ok {
+		return tree.Exprs{exprs[0], value, unit}
+	}
+	if name == "adddate" || name == "subdate" {
+		return tree.Exprs{exprs[0], exprs[1], stringExpr("day")}
+	}
+	return exprs
+}
+
+// jsonAggArgs returns the argument json_array(value) of json_arrayagg(value) and
+// json_object(key, value) of json_objectagg(key, value), the rings merge the arrays and objects
+func jsonAggArgs(name string, exprs tree.Exprs) tree.Exprs {
+	switch {
+	case name == "json_arrayagg" && len(exprs) == 1:
+		return tree.Exprs{funcExpr("json_array", exprs...)}
+	case name == "json_objectagg" && len(exprs) == 2:
+		return tree.Exprs{funcExpr("json_object", exprs...)}
+	}
+	return exprs
+}
+
+func dateAddExpr(name string, date, value, unit tree.Expr) *tree.FuncExpr {
+	return funcExpr(name, date, value, unit)
+}
+
+func funcExpr(name string, exprs ...tree.Expr) *tree.FuncExpr {
+	return &tree.FuncExpr{
+		Func:  tree.FuncName2ResolvableFunctionReference(tree.SetUnresolvedName(name)),
+		Exprs: exprs,
+	}
+}
+
+func stringExpr(s string) *tree.NumVal {
+	return tree.NewNumVal(constant.MakeString(s), s, false)
+}
+
 func (b *build) buildComparison(e *tree.ComparisonExpr, qry *Query, fn func(tree.Expr, *Query) (extend.Extend, error)) (extend.Extend, error) {
 	switch e.Op {
 	case tree.EQUAL:
@@ -402,6 +569,26 @@ func (b *build) buildComparison(e *tree.ComparisonExpr, qry *Query, fn func(tree
 			return nil, err
 		}
 		return &extend.BinaryExtend{Op: overload.Like, Left: left, Right: right}, nil
+	case tree.REG_MATCH:
+		left, err := fn(e.Left, qry)
+		if err != nil {
+			return nil, err
+		}
+		right, err := fn(e.Right, qry)
+		if err != nil {
+			return nil, err
+		}
+		return &extend.BinaryExtend{Op: builtin.RegMatch, Left: left, Right: right}, nil
+	case tree.NOT_REG_MATCH:
+		left, err := fn(e.Left, qry)
+		if err != nil {
+			return nil, err
+		}
+		right, err := fn(e.Right, qry)
+		if err != nil {
+			return nil, err
+		}
+		return &extend.BinaryExtend{Op: builtin.NotRegMatch, Left: left, Right: right}, nil
 	}
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", e))
 }
@@ -810,6 +997,8 @@ func buildConstantValue(typ types.Type, num *tree.NumVal) (interface{}, error) {
 			switch typ.Oid {
 			case types.T_char, types.T_varchar:
 				return constant.StringVal(val), nil
+			case types.T_json:
+				return bytejson.ParseString(constant.StringVal(val))
 			case types.T_date:
 				return types.ParseDate(constant.StringVal(val))
 			case types.T_datetime:
@@ -94,9 +94,9 @@ func (b *build) buildFetch(fetch *tree.Limit, qry *Query) error {
 }
 
 func (b *build) buildFetchExpr(n tree.Expr, qry *Query) (extend.Extend, error) {
-	switch e := n.(type) {
+	switch e := b.bindParam(n).(type) {
 	case *tree.NumVal:
-		return buildValue(e.Value, n.String())
+		return buildValue(e.Value, e.String())
 	case *tree.ParenExpr:
 		return b.buildFetchExpr(e.Expr, qry)
 	case *tree.OrExpr:
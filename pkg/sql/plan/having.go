@@ -40,7 +40,7 @@ func (b *build) buildHaving(stmt *tree.Where, qry *Query) (extend.Extend, error)
 }
 
 func (b *build) buildHavingExpr(n tree.Expr, qry *Query) (extend.Extend, error) {
-	switch e := n.(type) {
+	switch e := b.bindParam(n).(type) {
 	case *tree.NumVal:
 		return buildValue(e.Value, e.String())
 	case *tree.ParenExpr:
@@ -17,6 +17,7 @@ package plan
 import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
 	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -40,7 +41,7 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 	}
 	switch typ := stmt.Rows.Select.(type) {
 	case *tree.ValuesClause:
-		rows = typ
+		rows = b.bindValues(typ)
 	// case *tree.ParenSelect: // todo: not implement now.
 	// case *tree.SelectClause:
 	default:
@@ -109,6 +110,15 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 		return nil
 	}
 
+	// the functions like NOW() are the same value for all the rows
+	for _, row := range rows.Rows {
+		for i := range row {
+			if row[i], err = b.foldNow(row[i]); err != nil {
+				return err
+			}
+		}
+	}
+
 	// insert values for columns
 	for i, vec := range bat.Vecs {
 		switch vec.Typ.Oid {
@@ -354,6 +364,24 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 			if err := vector.Append(vec, vs); err != nil {
 				return err
 			}
+		case types.T_json:
+			vs := make([][]byte, len(rows.Rows))
+			{
+				for j, row := range rows.Rows {
+					v, err := buildConstant(vec.Typ, row[i])
+					if err != nil {
+						return err
+					}
+					if v == nil {
+						nulls.Add(vec.Nsp, uint64(j))
+					} else {
+						vs[j] = v.(bytejson.ByteJson)
+					}
+				}
+			}
+			if err := vector.Append(vec, vs); err != nil {
+				return err
+			}
 		case types.T_date:
 			vs := make([]types.Date, len(rows.Rows))
 			{
@@ -412,7 +440,8 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 						if vv, err := rangeCheck(v.(types.Timestamp), vec.Typ, bat.Attrs[i], j+1); err != nil {
 							return err
 						} else {
-							vs[j] = vv.(types.Timestamp)
+							// the literal is in the time zone of the session
+							vs[j] = vv.(types.Timestamp).ToDatetime(nil).ToTimestamp(b.loc)
 						}
 					}
 				}
@@ -496,7 +525,7 @@ func (b *build) BuildInsert(stmt *tree.Insert, plan *Insert) error {
 			vec.Col = make([]float32, len(rows.Rows))
 		case types.T_float64:
 			vec.Col = make([]float64, len(rows.Rows))
-		case types.T_char, types.T_varchar:
+		case types.T_char, types.T_varchar, types.T_json:
 			col := &types.Bytes{}
 			if err = col.Append(make([][]byte, len(rows.Rows))); err != nil {
 				return err
@@ -553,6 +582,22 @@ func makeExprFromVal(typ types.Type, value interface{}, isNull bool) tree.Expr {
 	return tree.NewNumVal(constant.MakeUnknown(), "NULL", false)
 }
 
+// bindValues returns a copy of the values with the placeholders bound, the rows are
+// rewritten in the copy and the statement is kept for the next execution.
+func (b *build) bindValues(values *tree.ValuesClause) *tree.ValuesClause {
+	rows := make([]tree.Exprs, len(values.Rows))
+	for i, row := range values.Rows {
+		if row == nil {
+			continue
+		}
+		rows[i] = make(tree.Exprs, len(row))
+		for j := range row {
+			rows[i][j] = b.bindParam(row[j])
+		}
+	}
+	return &tree.ValuesClause{Rows: rows}
+}
+
 // rewriteInsertRows rewrite default expressions in valueClause's Rows
 // and convert them to be column-default-expression.
 func rewriteInsertRows(noInsertTarget bool, finalInsertTargets []string, relationAttrs []string, rows []tree.Exprs, defaultExprs map[string]tree.Expr) ([]tree.Exprs, []string, error) {
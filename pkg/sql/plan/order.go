@@ -59,7 +59,7 @@ func (b *build) buildOrderBy(orders tree.OrderBy, proj0, proj *Projection, qry *
 }
 
 func (b *build) buildOrderByExpr(n tree.Expr, qry *Query) (extend.Extend, error) {
-	switch e := n.(type) {
+	switch e := b.bindParam(n).(type) {
 	case *tree.NumVal:
 		return buildValue(e.Value, e.String())
 	case *tree.ParenExpr:
@@ -78,7 +78,7 @@ func (b *build) buildProjection(exprs tree.SelectExprs, proj *Projection, qry *Q
 }
 
 func (b *build) buildProjectionExpr(n tree.Expr, qry *Query) (extend.Extend, error) {
-	switch e := n.(type) {
+	switch e := b.bindParam(n).(type) {
 	case *tree.NumVal:
 		return buildValue(e.Value, e.String())
 	case *tree.ParenExpr:
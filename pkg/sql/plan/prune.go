@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/like"
 
+	"github.com/matrixorigin/matrixone/pkg/builtin"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/errno"
@@ -180,6 +181,10 @@ func logicInverse(e extend.Extend) extend.Extend {
 		if v.Op == overload.Not {
 			return logicInverse(v.E)
 		}
+	case *extend.MultiExtend:
+		if op, ok := overload.NegOps[v.Op]; ok {
+			return &extend.MultiExtend{Op: op, Args: v.Args}
+		}
 	}
 	return e
 }
@@ -202,6 +207,10 @@ func splitNotBinary(e *extend.BinaryExtend) extend.Extend {
 		return &extend.BinaryExtend{Op: overload.GE, Left: logicInverse(e.Left), Right: logicInverse(e.Right)}
 	case overload.NE:
 		return &extend.BinaryExtend{Op: overload.EQ, Left: logicInverse(e.Left), Right: logicInverse(e.Right)}
+	case builtin.RegMatch:
+		return &extend.BinaryExtend{Op: builtin.NotRegMatch, Left: e.Left, Right: e.Right}
+	case builtin.NotRegMatch:
+		return &extend.BinaryExtend{Op: builtin.RegMatch, Left: e.Left, Right: e.Right}
 	}
 	return e
 }
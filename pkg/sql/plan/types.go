@@ -17,10 +17,13 @@ package plan
 import (
 	"bytes"
 	"fmt"
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
 	"github.com/matrixorigin/matrixone/pkg/sql/plan2/explain"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -228,6 +231,19 @@ type CreateIndex struct {
 	Defs           []engine.TableDef
 }
 
+type AlterTable struct {
+	Id       string
+	Relation engine.Relation
+	Actions  []AlterAction
+}
+
+// AlterAction is a single column change of an alter table statement,
+// Def is removed from the relation if Drop is set, otherwise added.
+type AlterAction struct {
+	Drop bool
+	Def  engine.TableDef
+}
+
 type DropDatabase struct {
 	IfExistFlag bool
 	Id          string
@@ -306,6 +322,8 @@ type build struct {
 	db       string // name of schema
 	sql      string
 	e        engine.Engine
+	loc      *time.Location // time zone of the session
+	params   []tree.Expr    // values bound to the placeholders of the prepared statement
 }
 
 func (qry *Query) ResultColumns() []*Attribute {
@@ -407,6 +425,31 @@ func (c CreateIndex) ResultColumns() []*Attribute {
 	return nil
 }
 
+func (a AlterTable) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("alter table %s", a.Id))
+	for i, act := range a.Actions {
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		switch def := act.Def.(type) {
+		case *engine.AttributeDef:
+			if act.Drop {
+				buf.WriteString(fmt.Sprintf(" drop column %s", def.Attr.Name))
+			} else {
+				buf.WriteString(fmt.Sprintf(" add column %s %s", def.Attr.Name, def.Attr.Type))
+			}
+		case *engine.RenameColumnDef:
+			buf.WriteString(fmt.Sprintf(" rename column %s to %s", def.Name, def.NewName))
+		}
+	}
+	return buf.String()
+}
+
+func (a AlterTable) ResultColumns() []*Attribute {
+	return nil
+}
+
 func (d DropDatabase) String() string {
 	var buf bytes.Buffer
 	buf.WriteString("drop database ")
@@ -119,7 +119,7 @@ func buildUpdateProjection(p *tree.Update) (tree.SelectExprs, error) {
 
 func buildProjectionFromExpr(expr tree.Expr, selectExprs *tree.SelectExprs) error {
 	switch e := expr.(type) {
-	case *tree.NumVal:
+	case *tree.NumVal, *tree.ParamExpr:
 		return nil
 	case *tree.ParenExpr:
 		return buildProjectionFromExpr(e.Expr, selectExprs)
@@ -30,6 +30,7 @@ func BuildPlan(ctx CompilerContext, stmt tree.Statement) (*plan.Query, error) {
 	if err != nil {
 		return nil, err
 	}
+	optimizeQuery(ctx, query)
 	return (*plan.Query)(query), nil
 }
 
@@ -0,0 +1,235 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+func buildCTE(withExpr *tree.With, ctx CompilerContext, query *Query, selectCtx *SelectContext) error {
+	if withExpr == nil {
+		return nil
+	}
+
+	names := make(map[string]bool, len(withExpr.CTEs))
+	for _, cte := range withExpr.CTEs {
+		alias := string(cte.Name.Alias)
+		if names[strings.ToUpper(alias)] {
+			return errors.New(errno.DuplicateAlias, fmt.Sprintf("not unique table/alias: '%v'", alias))
+		}
+		names[strings.ToUpper(alias)] = true
+
+		stmt, ok := cte.Stmt.(*tree.Select)
+		if !ok {
+			return errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unsupport statement in CTE '%v': %T", alias, cte.Stmt))
+		}
+		var node *plan.Node
+		var err error
+		if withExpr.IsRecursive && tree.ReferencesTable(stmt, alias) {
+			node, err = buildRecursiveCTE(alias, cte.Name.Cols, stmt, ctx, query, selectCtx)
+		} else {
+			node, err = buildMaterialCTE(alias, cte.Name.Cols, stmt, ctx, query, selectCtx)
+		}
+		if err != nil {
+			return err
+		}
+
+		//set cte table to selectCtx, the following ctes and the main query scan the materialized rows
+		selectCtx.cteTables[strings.ToUpper(alias)] = node.TableDef
+		appendQueryNode(query, node, false)
+
+		//set cte table node_id to step
+		query.Steps = append(query.Steps, node.NodeId)
+	}
+
+	return nil
+}
+
+// buildMaterialCTE build the query of a non-recursive cte, the MATERIAL node returned
+// will be evaluated once no matter how many times the cte is referenced
+func buildMaterialCTE(alias string, cols tree.IdentifierList, stmt *tree.Select, ctx CompilerContext, query *Query, selectCtx *SelectContext) (*plan.Node, error) {
+	stepLength := len(query.Steps)
+	err := buildSelect(stmt, ctx, query, newCTESelectContext(selectCtx))
+	if err != nil {
+		return nil, err
+	}
+	//the query is a child of the MATERIAL node but not a step
+	query.Steps = query.Steps[:stepLength]
+
+	preNode := query.Nodes[len(query.Nodes)-1]
+	tableDef, exprs, err := buildCTETableDef(alias, cols, preNode.ProjectList)
+	if err != nil {
+		return nil, err
+	}
+	return &plan.Node{
+		NodeType:    plan.Node_MATERIAL,
+		ObjRef:      &plan.ObjectRef{ObjName: alias},
+		TableDef:    tableDef,
+		ProjectList: exprs,
+	}, nil
+}
+
+// buildRecursiveCTE build a cte like 'anchor UNION ALL recursive part'. The RECURSIVE_CTE node
+// returned evaluates the anchor first, and then evaluates the recursive part repeatedly with the
+// rows produced by the last iteration (the SINK_SCAN node) until no more rows are produced.
+func buildRecursiveCTE(alias string, cols tree.IdentifierList, stmt *tree.Select, ctx CompilerContext, query *Query, selectCtx *SelectContext) (*plan.Node, error) {
+	union, ok := stmt.Select.(*tree.UnionClause)
+	if !ok {
+		return nil, errors.New(errno.InvalidRecursion, fmt.Sprintf("recursive CTE '%v' must be the form of 'anchor UNION ALL recursive part'", alias))
+	}
+	if union.Type != tree.UNION || !union.All {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("recursive CTE '%v' only support UNION ALL", alias))
+	}
+	if stmt.OrderBy != nil || stmt.Limit != nil {
+		return nil, errors.New(errno.FeatureNotSupported, fmt.Sprintf("recursive CTE '%v' does not support ORDER BY or LIMIT", alias))
+	}
+	if tree.ReferencesTable(union.Left, alias) {
+		return nil, errors.New(errno.InvalidRecursion, fmt.Sprintf("anchor part of recursive CTE '%v' can not reference itself", alias))
+	}
+
+	stepLength := len(query.Steps)
+
+	//anchor part, it also decides the columns of the cte
+	err := buildSelect(&tree.Select{Select: union.Left}, ctx, query, newCTESelectContext(selectCtx))
+	if err != nil {
+		return nil, err
+	}
+	anchorNode := query.Nodes[len(query.Nodes)-1]
+	tableDef, exprs, err := buildCTETableDef(alias, cols, anchorNode.ProjectList)
+	if err != nil {
+		return nil, err
+	}
+
+	//recursive part, the cte referenced in it is the working table of the iteration
+	selectCtx.cteTables[strings.ToUpper(alias)] = tableDef
+	startId := int32(len(query.Nodes))
+	err = buildSelect(&tree.Select{Select: union.Right}, ctx, query, newCTESelectContext(selectCtx))
+	if err != nil {
+		return nil, err
+	}
+	recursiveNode := query.Nodes[len(query.Nodes)-1]
+	sinkScanCount := 0
+	for _, node := range query.Nodes {
+		if node.NodeId < startId {
+			continue
+		}
+		switch node.NodeType {
+		case plan.Node_MATERIAL_SCAN:
+			if strings.EqualFold(node.ObjRef.ObjName, alias) {
+				node.NodeType = plan.Node_SINK_SCAN
+				sinkScanCount++
+			}
+		case plan.Node_AGG, plan.Node_WINDOW, plan.Node_SORT:
+			return nil, errors.New(errno.InvalidRecursion, fmt.Sprintf("recursive part of CTE '%v' can not contain aggregation, window function, DISTINCT or ORDER BY", alias))
+		}
+	}
+	if sinkScanCount != 1 {
+		return nil, errors.New(errno.InvalidRecursion, fmt.Sprintf("recursive part of CTE '%v' must reference itself once in the FROM clause", alias))
+	}
+
+	for _, expr := range recursiveNode.ProjectList {
+		if hasAggregation(expr) {
+			return nil, errors.New(errno.InvalidRecursion, fmt.Sprintf("recursive part of CTE '%v' can not contain aggregation, window function, DISTINCT or ORDER BY", alias))
+		}
+	}
+
+	//the rows of recursive part are cast to the column types decided by the anchor part
+	if len(recursiveNode.ProjectList) != len(exprs) {
+		return nil, errors.New(errno.InvalidColumnReference, fmt.Sprintf("anchor part and recursive part of CTE '%v' have different number of columns", alias))
+	}
+	for idx, expr := range recursiveNode.ProjectList {
+		if expr.Typ.Id == exprs[idx].Typ.Id {
+			continue
+		}
+		castExpr, err := appendCastExpr(expr, exprs[idx].Typ.Id)
+		if err != nil {
+			return nil, err
+		}
+		castExpr.Alias = expr.Alias
+		recursiveNode.ProjectList[idx] = castExpr
+	}
+
+	//the anchor and recursive parts are children of the RECURSIVE_CTE node but not steps
+	query.Steps = query.Steps[:stepLength]
+
+	return &plan.Node{
+		NodeType:    plan.Node_RECURSIVE_CTE,
+		Children:    []int32{anchorNode.NodeId, recursiveNode.NodeId},
+		ObjRef:      &plan.ObjectRef{ObjName: alias},
+		TableDef:    tableDef,
+		ProjectList: exprs,
+	}, nil
+}
+
+// buildCTETableDef return the table definition of a cte and the project list of the cte node
+func buildCTETableDef(alias string, cols tree.IdentifierList, projectList []*plan.Expr) (*plan.TableDef, []*plan.Expr, error) {
+	columnLength := len(projectList)
+	if cols != nil && len(cols) != columnLength {
+		return nil, nil, errors.New(errno.InvalidColumnReference, fmt.Sprintf("CTE table column length not match"))
+	}
+	tableDef := &plan.TableDef{
+		Name: alias,
+		Cols: make([]*plan.ColDef, columnLength),
+	}
+	exprs := make([]*plan.Expr, columnLength)
+	for idx, col := range projectList {
+		name := col.Alias
+		if cols != nil {
+			name = string(cols[idx])
+		}
+		exprs[idx] = &plan.Expr{
+			Expr:  nil,
+			Alias: alias + "." + name,
+			Typ:   col.Typ,
+		}
+		tableDef.Cols[idx] = &plan.ColDef{
+			Typ:  col.Typ,
+			Name: name,
+		}
+	}
+	return tableDef, exprs, nil
+}
+
+// hasAggregation return true if an aggregate function is called in expr
+func hasAggregation(expr *plan.Expr) bool {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return false
+	}
+	if functionSig, ok := BuiltinFunctionsMap[f.F.Func.ObjName]; ok && functionSig.Flag&plan.Function_AGG != 0 {
+		return true
+	}
+	for _, arg := range f.F.Args {
+		if hasAggregation(arg) {
+			return true
+		}
+	}
+	return false
+}
+
+// newCTESelectContext return the context to build the query of a cte, the ctes defined
+// before are visible in it
+func newCTESelectContext(selectCtx *SelectContext) *SelectContext {
+	return &SelectContext{
+		columnAlias: make(map[string]*plan.Expr),
+		cteTables:   selectCtx.cteTables,
+	}
+}
@@ -120,6 +120,14 @@ func buildExpr(stmt tree.Expr, ctx CompilerContext, query *Query, selectCtx *Sel
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("expr max'%v' is not support now", stmt))
 	case *tree.VarExpr:
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("expr var'%v' is not support now", stmt))
+	case *tree.ParamExpr:
+		return &plan.Expr{
+			Expr: &plan.Expr_P{
+				P: &plan.ParamRef{
+					Pos: int32(astExpr.Offset),
+				},
+			},
+		}, nil
 	case *tree.StrVal:
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("expr str'%v' is not support now", stmt))
 	case *tree.ExprList:
@@ -322,6 +330,10 @@ func buildFunctionExpr(expr *tree.FuncExpr, ctx CompilerContext, query *Query, s
 	}
 	funcName := strings.ToUpper(funcReference.Parts[0])
 
+	if expr.WindowSpec != nil {
+		return buildWindowFunctionExpr(funcName, expr, ctx, query, selectCtx)
+	}
+
 	//todo confirm: change count(*) to count(1)  but count(*) funcReference.Star is false why?
 	if funcName == "COUNT" && funcReference.Star {
 		funObjRef := getFunctionObjRef(funcName)
@@ -386,6 +398,25 @@ func buildComparisonExpr(expr *tree.ComparisonExpr, ctx CompilerContext, query *
 				Id: plan.Type_BOOL,
 			},
 		}, nil
+	case tree.REG_MATCH:
+		return getFunctionExprByNameAndExprs("REGEXP", []tree.Expr{expr.Left, expr.Right}, ctx, query, selectCtx)
+	case tree.NOT_REG_MATCH:
+		expr, err := getFunctionExprByNameAndExprs("REGEXP", []tree.Expr{expr.Left, expr.Right}, ctx, query, selectCtx)
+		if err != nil {
+			return nil, err
+		}
+		funObjRef := getFunctionObjRef("NOT")
+		return &plan.Expr{
+			Expr: &plan.Expr_F{
+				F: &plan.Function{
+					Func: funObjRef,
+					Args: []*plan.Expr{expr},
+				},
+			},
+			Typ: &plan.Type{
+				Id: plan.Type_BOOL,
+			},
+		}, nil
 	case tree.IN:
 		return getFunctionExprByNameAndExprs("IN", []tree.Expr{expr.Left, expr.Right}, ctx, query, selectCtx)
 	case tree.NOT_IN:
@@ -426,8 +457,18 @@ func buildUnaryExpr(expr *tree.UnaryExpr, ctx CompilerContext, query *Query, sel
 func buildBinaryExpr(expr *tree.BinaryExpr, ctx CompilerContext, query *Query, selectCtx *SelectContext) (*plan.Expr, error) {
 	switch expr.Op {
 	case tree.PLUS:
+		//datetime + interval and interval + datetime are DATE_ADD(datetime, interval)
+		if isIntervalExpr(expr.Right) {
+			return getFunctionExprByNameAndExprs("DATE_ADD", []tree.Expr{expr.Left, expr.Right}, ctx, query, selectCtx)
+		}
+		if isIntervalExpr(expr.Left) {
+			return getFunctionExprByNameAndExprs("DATE_ADD", []tree.Expr{expr.Right, expr.Left}, ctx, query, selectCtx)
+		}
 		return getFunctionExprByNameAndExprs("+", []tree.Expr{expr.Left, expr.Right}, ctx, query, selectCtx)
 	case tree.MINUS:
+		if isIntervalExpr(expr.Right) {
+			return getFunctionExprByNameAndExprs("DATE_SUB", []tree.Expr{expr.Left, expr.Right}, ctx, query, selectCtx)
+		}
 		return getFunctionExprByNameAndExprs("-", []tree.Expr{expr.Left, expr.Right}, ctx, query, selectCtx)
 	case tree.MULTI:
 		return getFunctionExprByNameAndExprs("*", []tree.Expr{expr.Left, expr.Right}, ctx, query, selectCtx)
@@ -443,6 +484,15 @@ func buildBinaryExpr(expr *tree.BinaryExpr, ctx CompilerContext, query *Query, s
 	return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("'%v' is not support now", expr))
 }
 
+func isIntervalExpr(expr tree.Expr) bool {
+	funcExpr, ok := expr.(*tree.FuncExpr)
+	if !ok {
+		return false
+	}
+	funcReference, ok := funcExpr.Func.FunctionReference.(*tree.UnresolvedName)
+	return ok && strings.EqualFold(funcReference.Parts[0], "interval")
+}
+
 func buildNumVal(val constant.Value) (*plan.Expr, error) {
 	switch val.Kind() {
 	case constant.Int:
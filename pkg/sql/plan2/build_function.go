@@ -31,35 +31,20 @@ func getFunctionExprByNameAndExprs(name string, exprs []tree.Expr, ctx CompilerC
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("function name '%v' is not exist", name))
 	}
 
-	//Check parameters length
-	if len(functionSig.ArgType) != len(exprs) {
+	//Check parameters length, the trailing -1 parameters are optional and the VARARG functions take more parameters
+	minArgs, maxArgs := len(functionSig.ArgType), len(functionSig.ArgType)
+	for minArgs > 0 && functionSig.ArgType[minArgs-1] < 0 {
+		minArgs--
+	}
+	if functionSig.Flag&plan.Function_VARARG != 0 {
+		maxArgs = math.MaxInt32
+	}
+	if len(exprs) < minArgs || len(exprs) > maxArgs {
 		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("number of parameters does not match for function '%v'", functionSig.Name))
 	}
 
 	//Get original input expr
 	args := make([]*plan.Expr, 0, len(exprs))
-	//todo special case  need check
-	if name == "EXTRACT" {
-		kindExpr := exprs[0].(*tree.UnresolvedName)
-		args = append(args, &plan.Expr{
-			Expr: &plan.Expr_C{
-				C: &plan.Const{
-					Isnull: false,
-					Value: &plan.Const_Sval{
-						Sval: kindExpr.Parts[0],
-					},
-				},
-			},
-			Typ: &plan.Type{
-				Id:        plan.Type_VARCHAR,
-				Nullable:  false,
-				Width:     math.MaxInt32,
-				Precision: 0,
-			},
-		})
-		exprs = []tree.Expr{exprs[1]}
-	}
-
 	for _, astExpr := range exprs {
 		expr, err := buildExpr(astExpr, ctx, query, selectCtx)
 		if err != nil {
@@ -163,6 +148,25 @@ func covertArgsTypeAndGetReturnType(fun *FunctionSig, args []*plan.Expr) (*plan.
 		return &plan.Type{
 			Id: args[0].Typ.Id,
 		}, nil
+	case "DATE_ADD", "DATE_SUB", "ADDDATE", "SUBDATE":
+		switch args[0].Typ.Id {
+		case plan.Type_DATE, plan.Type_DATETIME, plan.Type_TIMESTAMP:
+			return &plan.Type{
+				Id: args[0].Typ.Id,
+			}, nil
+		}
+		return &plan.Type{
+			Id: plan.Type_DATETIME,
+		}, nil
+	case "FROM_UNIXTIME":
+		if len(args) > 1 {
+			return &plan.Type{
+				Id: plan.Type_VARCHAR,
+			}, nil
+		}
+		return &plan.Type{
+			Id: fun.ArgTypeClass[0],
+		}, nil
 	case "UNARY_PLUS", "UNARY_MINUS":
 		expr := args[0]
 		isNumberType := checkNumberType(expr.Typ.Id, expr.Alias) == nil
@@ -16,7 +16,6 @@ package plan2
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -57,24 +56,9 @@ func buildSelect(stmt *tree.Select, ctx CompilerContext, query *Query, selectCtx
 			Children: []int32{rootId},
 		}
 
-		orderBys := make([]*plan.OrderBySpec, 0, len(stmt.OrderBy))
-		for _, order := range stmt.OrderBy {
-			orderBy := &plan.OrderBySpec{}
-			expr, err := buildExpr(order.Expr, ctx, query, selectCtx)
-			if err != nil {
-				return err
-			}
-			orderBy.OrderBy = expr
-
-			switch order.Direction {
-			case tree.DefaultDirection:
-				orderBy.OrderByFlags = plan.OrderBySpec_INTERNAL
-			case tree.Ascending:
-				orderBy.OrderByFlags = plan.OrderBySpec_ASC
-			case tree.Descending:
-				orderBy.OrderByFlags = plan.OrderBySpec_DESC
-			}
-			orderBys = append(orderBys, orderBy)
+		orderBys, err := buildOrderBySpecs(stmt.OrderBy, ctx, query, selectCtx)
+		if err != nil {
+			return err
 		}
 		node.OrderBy = orderBys
 
@@ -112,71 +96,27 @@ func buildSelect(stmt *tree.Select, ctx CompilerContext, query *Query, selectCtx
 	return nil
 }
 
-func buildCTE(withExpr *tree.With, ctx CompilerContext, query *Query, selectCtx *SelectContext) error {
-	if withExpr == nil {
-		return nil
-	}
-
-	for _, cte := range withExpr.CTEs {
-		err := buildStatement(cte.Stmt, ctx, query)
+func buildOrderBySpecs(stmt tree.OrderBy, ctx CompilerContext, query *Query, selectCtx *SelectContext) ([]*plan.OrderBySpec, error) {
+	orderBys := make([]*plan.OrderBySpec, 0, len(stmt))
+	for _, order := range stmt {
+		orderBy := &plan.OrderBySpec{}
+		expr, err := buildExpr(order.Expr, ctx, query, selectCtx)
 		if err != nil {
-			return err
-		}
-		//add a projection node
-		alias := string(cte.Name.Alias)
-		node := &plan.Node{
-			NodeType: plan.Node_MATERIAL,
+			return nil, err
 		}
-		preNode := query.Nodes[len(query.Nodes)-1]
+		orderBy.OrderBy = expr
 
-		columnLength := len(preNode.ProjectList)
-		tableDef := &plan.TableDef{
-			Name: alias,
-			Cols: make([]*plan.ColDef, columnLength),
+		switch order.Direction {
+		case tree.DefaultDirection:
+			orderBy.OrderByFlags = plan.OrderBySpec_INTERNAL
+		case tree.Ascending:
+			orderBy.OrderByFlags = plan.OrderBySpec_ASC
+		case tree.Descending:
+			orderBy.OrderByFlags = plan.OrderBySpec_DESC
 		}
-		exprs := make([]*plan.Expr, columnLength)
-		if cte.Name.Cols != nil {
-			if len(preNode.ProjectList) != len(cte.Name.Cols) {
-				return errors.New(errno.InvalidColumnReference, fmt.Sprintf("CTE table column length not match"))
-			}
-			for idx, col := range cte.Name.Cols {
-				exprs[idx] = &plan.Expr{
-					Expr:  nil,
-					Alias: alias + "." + string(col),
-					Typ:   preNode.ProjectList[idx].Typ,
-				}
-				tableDef.Cols[idx] = &plan.ColDef{
-					Typ:  preNode.ProjectList[idx].Typ,
-					Name: string(col),
-				}
-			}
-			node.ProjectList = exprs
-		} else {
-			for idx, col := range preNode.ProjectList {
-				exprs[idx] = &plan.Expr{
-					Expr:  nil,
-					Alias: alias + "." + col.Alias,
-					Typ:   col.Typ,
-				}
-				tableDef.Cols[idx] = &plan.ColDef{
-					Typ:  col.Typ,
-					Name: col.Alias,
-				}
-			}
-			node.ProjectList = exprs
-		}
-
-		//set cte table to selectCtx
-		selectCtx.cteTables[strings.ToUpper(alias)] = tableDef
-		//append node
-		appendQueryNode(query, node, false)
-
-		//set cte table node_id to step
-		cteNodeId := query.Nodes[len(query.Nodes)-1].NodeId
-		query.Steps = append(query.Steps, cteNodeId)
+		orderBys = append(orderBys, orderBy)
 	}
-
-	return nil
+	return orderBys, nil
 }
 
 func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Query, selectCtx *SelectContext) ([]*plan.Expr, error) {
@@ -194,12 +134,12 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 		if err != nil {
 			return nil, err
 		}
-		node.WhereList = exprs
+		node.WhereList = decorrelateSubQueries(query, selectCtx, node, exprs)
 	}
 
 	//projection
-	//todo get windowspec
 	var projectionList []*plan.Expr
+	selectCtx.windowAllowed = true
 	for _, selectExpr := range stmt.Exprs {
 		expr, err := buildExpr(selectExpr.Expr, ctx, query, selectCtx)
 		if err != nil {
@@ -222,6 +162,7 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 			projectionList = append(projectionList, expr)
 		}
 	}
+	selectCtx.windowAllowed = false
 
 	//Agg (group by && having)
 	if stmt.GroupBy != nil || stmt.Having != nil {
@@ -253,6 +194,9 @@ func buildSelectClause(stmt *tree.SelectClause, ctx CompilerContext, query *Quer
 		appendQueryNode(query, aggNode, false)
 	}
 
+	//window
+	buildWindowNodes(query, selectCtx)
+
 	//distinct
 	if stmt.Distinct {
 		if stmt.GroupBy != nil {
@@ -16,11 +16,13 @@ package plan2
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"google.golang.org/protobuf/proto"
 )
 
 func buildSubQuery(subquery *tree.Subquery, ctx CompilerContext, query *Query, selectCtx *SelectContext) (*plan.Expr, error) {
@@ -52,6 +54,10 @@ func buildSubQuery(subquery *tree.Subquery, ctx CompilerContext, query *Query, s
 		return nil, errors.New(errno.SQLStatementNotYetComplete, fmt.Sprintf("unknown select statement: %T", subquery))
 	}
 	expr.IsCorrelated = newCtx.subQueryIsCorrelated
+	if selectCtx.subQueryRoots == nil {
+		selectCtx.subQueryRoots = make(map[*plan.SubQuery]int32)
+	}
+	selectCtx.subQueryRoots[expr] = query.Steps[len(query.Steps)-1]
 
 	//move subquery node to top
 	for i := len(query.Nodes) - 1; i >= 0; i-- {
@@ -84,3 +90,485 @@ func buildSubQuery(subquery *tree.Subquery, ctx CompilerContext, query *Query, s
 	}
 	return returnExpr, nil
 }
+
+//subQueryJoin is a subquery in the filters decorrelated into the join of the outer query and the subquery
+type subQueryJoin struct {
+	root     *plan.Node
+	joinType plan.Node_JoinFlag
+	//the expression compared with the result of the subquery by IN, nil for EXISTS and the scalar subqueries
+	in *plan.Expr
+	//the equalities of the subquery correlated with the outer query, they are the join keys outer[i] = inner[i]
+	corrs []*plan.Expr
+	outer []*plan.Expr
+	inner []*plan.Expr
+	//the expression replaced by the mark or the result of the subquery, nil for the SEMI and ANTI joins
+	replaced *plan.Expr
+}
+
+//decorrelateSubQueries turns the subqueries in the filters of the node into the joins of the node and the subqueries:
+//EXISTS and IN are the SEMI joins, NOT EXISTS and NOT IN are the ANTI joins, EXISTS and IN in the other expressions are
+//the MARK joins whose marks replace them, and the scalar subqueries are the SINGLE joins whose results replace them.
+//The joins are appended after the node, the filters rewritten by them are put on the top join and the other filters
+//are returned. The filters are kept if their subqueries can not be decorrelated.
+func decorrelateSubQueries(query *Query, selectCtx *SelectContext, node *plan.Node, exprs []*plan.Expr) []*plan.Expr {
+	if node.ProjectList == nil || len(selectCtx.subQueryRoots) == 0 {
+		return exprs
+	}
+	var kept, filters []*plan.Expr
+	left := node
+	for _, expr := range exprs {
+		joins, ok := planSubQueryJoins(query, selectCtx, node, expr)
+		if !ok {
+			kept = append(kept, expr)
+			continue
+		}
+		rewritten := false
+		for _, join := range joins {
+			left = join.build(query, left)
+			rewritten = rewritten || join.replaced != nil
+		}
+		if rewritten {
+			filters = append(filters, expr)
+		}
+	}
+	if left != node {
+		left.WhereList = filters
+	}
+	return kept
+}
+
+//planSubQueryJoins returns the joins the subqueries of the filter are decorrelated into,
+//it returns false if the filter has no subquery or one of its subqueries can not be decorrelated
+func planSubQueryJoins(query *Query, selectCtx *SelectContext, node *plan.Node, expr *plan.Expr) ([]*subQueryJoin, bool) {
+	filter, joinType := expr, plan.Node_SEMI
+	if f, ok := expr.Expr.(*plan.Expr_F); ok && strings.ToUpper(f.F.Func.GetObjName()) == "NOT" && len(f.F.Args) == 1 {
+		filter, joinType = f.F.Args[0], plan.Node_ANTI
+	}
+	if sub, in, ok := existsOrIn(filter); ok && !hasSubQuery(in) {
+		//NOT IN is false if the subquery has a null, so the ANTI join of it is null aware
+		if in != nil && joinType == plan.Node_ANTI {
+			joinType |= plan.Node_NULL_AWARE
+		}
+		join, ok := newSubQueryJoin(query, selectCtx, node, sub, joinType, in, nil)
+		if !ok {
+			return nil, false
+		}
+		return []*subQueryJoin{join}, true
+	}
+
+	var joins []*subQueryJoin
+	ok := true
+	findSubQueries(expr, func(e *plan.Expr, sub *plan.SubQuery, in *plan.Expr) {
+		joinType := plan.Node_MARK
+		if in != nil {
+			//the mark of IN is null if the subquery has a null
+			joinType |= plan.Node_NULL_AWARE
+		} else if _, isScalar := e.Expr.(*plan.Expr_Sub); isScalar {
+			joinType = plan.Node_SINGLE
+		}
+		join, decorrelated := newSubQueryJoin(query, selectCtx, node, sub, joinType, in, e)
+		ok = ok && decorrelated
+		joins = append(joins, join)
+	})
+	return joins, ok && len(joins) > 0
+}
+
+//newSubQueryJoin returns the join of the subquery, it returns false if the subquery can not be decorrelated
+func newSubQueryJoin(query *Query, selectCtx *SelectContext, node *plan.Node, sub *plan.SubQuery, joinType plan.Node_JoinFlag, in, replaced *plan.Expr) (*subQueryJoin, bool) {
+	rootId, ok := selectCtx.subQueryRoots[sub]
+	if !ok {
+		return nil, false
+	}
+	root := getQueryNode(query, rootId)
+	if root == nil {
+		return nil, false
+	}
+	join := &subQueryJoin{
+		root:     root,
+		joinType: joinType,
+		in:       in,
+		replaced: replaced,
+	}
+
+	//the equalities of the outer columns and the inner expressions in the filters of the subquery scan are the join keys
+	corrs := 0
+	if sub.IsCorrelated {
+		if root.NodeType != plan.Node_TABLE_SCAN {
+			return nil, false
+		}
+		for _, expr := range root.WhereList {
+			n := countCorrelations(expr)
+			if n == 0 {
+				continue
+			}
+			outer, inner, ok := splitCorrelation(expr, node.NodeId)
+			if !ok {
+				return nil, false
+			}
+			join.corrs = append(join.corrs, expr)
+			join.outer = append(join.outer, outer)
+			join.inner = append(join.inner, inner)
+			corrs += n
+		}
+	}
+	//the subquery can not refer to the outer query elsewhere, and can not have the subqueries which are not decorrelated
+	total, hasSub := 0, false
+	for _, n := range subtreeNodes(query, root) {
+		walkNodeExprs(n, func(expr *plan.Expr) {
+			switch expr.Expr.(type) {
+			case *plan.Expr_Corr:
+				total++
+			case *plan.Expr_Sub:
+				hasSub = true
+			}
+		})
+	}
+	if hasSub || total != corrs {
+		return nil, false
+	}
+
+	if (in != nil || joinType == plan.Node_SINGLE) && len(root.ProjectList) != 1 {
+		return nil, false
+	}
+	//the null aware joins have a single join key
+	if joinType&plan.Node_NULL_AWARE != 0 && len(join.corrs) > 0 {
+		return nil, false
+	}
+	//the aggregations without group by return a row even if the subquery has no row, which is the same as the
+	//unmatched row of the SINGLE join only if the aggregations are null on no rows
+	if root.NodeType != plan.Node_AGG && hasAggregations(root.ProjectList) {
+		if joinType != plan.Node_SINGLE || root.NodeType != plan.Node_TABLE_SCAN || hasCount(root.ProjectList) {
+			return nil, false
+		}
+	}
+	return join, true
+}
+
+//build appends the join of the left node and the subquery, and returns the join
+func (j *subQueryJoin) build(query *Query, left *plan.Node) *plan.Node {
+	right := j.root
+	for i, step := range query.Steps {
+		if step == right.NodeId {
+			query.Steps = append(query.Steps[:i], query.Steps[i+1:]...)
+			break
+		}
+	}
+
+	//the correlated equalities are evaluated by the join, the inner expressions of them are added to the result of the subquery
+	if len(j.corrs) > 0 {
+		whereList := make([]*plan.Expr, 0, len(right.WhereList)-len(j.corrs))
+		for _, expr := range right.WhereList {
+			if !containsExpr(j.corrs, expr) {
+				whereList = append(whereList, expr)
+			}
+		}
+		right.WhereList = whereList
+	}
+	inner := make([]*plan.Expr, len(j.inner))
+	for i, expr := range j.inner {
+		e := proto.Clone(expr).(*plan.Expr)
+		e.Alias = groupByAlias(expr, i)
+		inner[i] = e
+	}
+	if right.NodeType != plan.Node_AGG && hasAggregations(right.ProjectList) {
+		//the aggregations of the scalar subquery are grouped by the inner expressions, the scan returns all columns for them
+		agg := &plan.Node{
+			NodeType: plan.Node_AGG,
+			Children: []int32{right.NodeId},
+			GroupBy:  j.inner,
+		}
+		agg.ProjectList = append(agg.ProjectList, right.ProjectList...)
+		agg.ProjectList = append(agg.ProjectList, inner...)
+		right.ProjectList = tableScanProjectList(right.TableDef, right.TableDef.Name)
+		appendQueryNode(query, agg, false)
+		right = agg
+	} else {
+		right.ProjectList = append(right.ProjectList, inner...)
+	}
+	right.JoinType = j.joinType
+
+	node := &plan.Node{
+		NodeType: plan.Node_JOIN,
+		Children: []int32{left.NodeId, right.NodeId},
+	}
+	keys := len(right.ProjectList) - len(inner)
+	if j.in != nil {
+		node.OnList = append(node.OnList, equalExpr(j.in, joinColumn(right, 0)))
+	}
+	for i, expr := range j.outer {
+		node.OnList = append(node.OnList, equalExpr(expr, joinColumn(right, keys+i)))
+	}
+	appendQueryNode(query, node, false)
+
+	for idx, expr := range left.ProjectList {
+		node.ProjectList = append(node.ProjectList, &plan.Expr{
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					Name:   expr.Alias,
+					RelPos: 0,
+					ColPos: int32(idx),
+				},
+			},
+			Alias: expr.Alias,
+			Typ:   expr.Typ,
+		})
+	}
+	var result *plan.Expr
+	switch {
+	case j.joinType&plan.Node_MARK != 0:
+		//the mark is not a column of the children, it is made by the join
+		alias := fmt.Sprintf("mark#%d", node.NodeId)
+		result = &plan.Expr{
+			Expr: &plan.Expr_Col{
+				Col: &plan.ColRef{
+					Name:   alias,
+					RelPos: -1,
+					ColPos: -1,
+				},
+			},
+			Alias: alias,
+			Typ: &plan.Type{
+				Id:       plan.Type_INT8,
+				Nullable: true,
+			},
+		}
+	case j.joinType == plan.Node_SINGLE:
+		result = joinColumn(right, 0)
+		result.Alias = fmt.Sprintf("subquery#%d", node.NodeId)
+	default:
+		return node
+	}
+	node.ProjectList = append(node.ProjectList, result)
+
+	col := &plan.Expr{
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				Name:   result.Alias,
+				RelPos: 0,
+				ColPos: int32(len(left.ProjectList)),
+			},
+		},
+		Typ: result.Typ,
+	}
+	if j.joinType == plan.Node_SINGLE {
+		j.replaced.Expr = col.Expr
+		j.replaced.Typ = col.Typ
+		return node
+	}
+	//EXISTS or IN is true if the mark is 1
+	j.replaced.Expr = equalExpr(&plan.Expr{
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: getFunctionObjRef("CAST"),
+				Args: []*plan.Expr{col},
+			},
+		},
+		Typ: &plan.Type{
+			Id:       plan.Type_INT64,
+			Nullable: true,
+		},
+	}, &plan.Expr{
+		Expr: &plan.Expr_C{
+			C: &plan.Const{
+				Value: &plan.Const_Ival{
+					Ival: 1,
+				},
+			},
+		},
+		Typ: &plan.Type{
+			Id: plan.Type_INT64,
+		},
+	}).Expr
+	j.replaced.Typ = &plan.Type{
+		Id: plan.Type_BOOL,
+	}
+	return node
+}
+
+//existsOrIn returns the subquery of EXISTS or IN, and the expression compared with it by IN
+func existsOrIn(expr *plan.Expr) (*plan.SubQuery, *plan.Expr, bool) {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return nil, nil, false
+	}
+	switch name := strings.ToUpper(f.F.Func.GetObjName()); {
+	case name == "EXISTS" && len(f.F.Args) == 1:
+		if sub, ok := f.F.Args[0].Expr.(*plan.Expr_Sub); ok {
+			return sub.Sub, nil, true
+		}
+	case name == "IN" && len(f.F.Args) == 2:
+		if sub, ok := f.F.Args[1].Expr.(*plan.Expr_Sub); ok {
+			return sub.Sub, f.F.Args[0], true
+		}
+	}
+	return nil, nil, false
+}
+
+//findSubQueries calls fn on the subqueries in the expression, e is the EXISTS or IN function of the subquery,
+//or the subquery itself if it is a scalar subquery. The subqueries compared by IN are found before the IN.
+func findSubQueries(expr *plan.Expr, fn func(e *plan.Expr, sub *plan.SubQuery, in *plan.Expr)) {
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_Sub:
+		fn(expr, e.Sub, nil)
+	case *plan.Expr_F:
+		if sub, in, ok := existsOrIn(expr); ok {
+			if in != nil {
+				findSubQueries(in, fn)
+			}
+			fn(expr, sub, in)
+			return
+		}
+		for _, arg := range e.F.Args {
+			findSubQueries(arg, fn)
+		}
+	case *plan.Expr_List:
+		for _, item := range e.List.List {
+			findSubQueries(item, fn)
+		}
+	}
+}
+
+func hasSubQuery(expr *plan.Expr) bool {
+	found := false
+	walkExpr(expr, func(e *plan.Expr) {
+		if _, ok := e.Expr.(*plan.Expr_Sub); ok {
+			found = true
+		}
+	})
+	return found
+}
+
+func countCorrelations(expr *plan.Expr) int {
+	n := 0
+	walkExpr(expr, func(e *plan.Expr) {
+		if _, ok := e.Expr.(*plan.Expr_Corr); ok {
+			n++
+		}
+	})
+	return n
+}
+
+//splitCorrelation splits the equality of the outer columns and the inner expression, the outer columns are
+//turned into the columns of the node
+func splitCorrelation(expr *plan.Expr, nodeId int32) (*plan.Expr, *plan.Expr, bool) {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok || f.F.Func.GetObjName() != "=" || len(f.F.Args) != 2 {
+		return nil, nil, false
+	}
+	outer, inner := f.F.Args[0], f.F.Args[1]
+	if countCorrelations(outer) == 0 {
+		outer, inner = inner, outer
+	}
+	if countCorrelations(inner) > 0 {
+		return nil, nil, false
+	}
+	ok = true
+	outer = proto.Clone(outer).(*plan.Expr)
+	walkExpr(outer, func(e *plan.Expr) {
+		switch c := e.Expr.(type) {
+		case *plan.Expr_Col:
+			ok = false
+		case *plan.Expr_Corr:
+			if c.Corr.NodeId != nodeId {
+				ok = false
+			}
+			e.Expr = &plan.Expr_Col{
+				Col: &plan.ColRef{
+					Name:   c.Corr.Name,
+					RelPos: c.Corr.RelPos,
+					ColPos: c.Corr.ColPos,
+				},
+			}
+		}
+	})
+	return outer, inner, ok
+}
+
+func getQueryNode(query *Query, id int32) *plan.Node {
+	for _, node := range query.Nodes {
+		if node.NodeId == id {
+			return node
+		}
+	}
+	return nil
+}
+
+//subtreeNodes returns the node and the nodes under it
+func subtreeNodes(query *Query, root *plan.Node) []*plan.Node {
+	nodes := []*plan.Node{root}
+	for i := 0; i < len(nodes); i++ {
+		for _, child := range nodes[i].Children {
+			if node := getQueryNode(query, child); node != nil {
+				nodes = append(nodes, node)
+			}
+		}
+	}
+	return nodes
+}
+
+func hasAggregations(exprs []*plan.Expr) bool {
+	found := false
+	for _, expr := range exprs {
+		walkExpr(expr, func(e *plan.Expr) {
+			if f, ok := e.Expr.(*plan.Expr_F); ok && isAggregation(f.F) {
+				found = true
+			}
+		})
+	}
+	return found
+}
+
+//hasCount returns true if the expressions have the aggregations which are not null on no rows
+func hasCount(exprs []*plan.Expr) bool {
+	found := false
+	for _, expr := range exprs {
+		walkExpr(expr, func(e *plan.Expr) {
+			if f, ok := e.Expr.(*plan.Expr_F); ok {
+				switch strings.ToUpper(f.F.Func.GetObjName()) {
+				case "COUNT", "COUNT_IF":
+					found = true
+				}
+			}
+		})
+	}
+	return found
+}
+
+func containsExpr(exprs []*plan.Expr, expr *plan.Expr) bool {
+	for _, e := range exprs {
+		if e == expr {
+			return true
+		}
+	}
+	return false
+}
+
+//joinColumn returns the column of the join for the column of its right child
+func joinColumn(right *plan.Node, colPos int) *plan.Expr {
+	expr := right.ProjectList[colPos]
+	return &plan.Expr{
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				Name:   expr.Alias,
+				RelPos: 1,
+				ColPos: int32(colPos),
+			},
+		},
+		Alias: expr.Alias,
+		Typ:   expr.Typ,
+	}
+}
+
+func equalExpr(left, right *plan.Expr) *plan.Expr {
+	return &plan.Expr{
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: getFunctionObjRef("="),
+				Args: []*plan.Expr{left, right},
+			},
+		},
+		Typ: &plan.Type{
+			Id: plan.Type_BOOL,
+		},
+	}
+}
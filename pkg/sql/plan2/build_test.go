@@ -82,26 +82,30 @@ func TestNodeTree(t *testing.T) {
 				1: {0},
 			},
 		},
-		//two nodes- SCAN + AGG(group by)
+		//three nodes- SCAN + AGG(group by) split into BOTTOM and TOP
 		"SELECT N_NAME FROM NATION WHERE N_REGIONKEY = 3 Group By N_NAME": {
 			root: 1,
 			nodeType: map[int]plan.Node_NodeType{
 				0: plan.Node_TABLE_SCAN,
 				1: plan.Node_AGG,
+				2: plan.Node_AGG,
 			},
 			children: map[int][]int32{
-				1: {0},
+				1: {2},
+				2: {0},
 			},
 		},
-		//two nodes- SCAN + AGG(distinct)
+		//three nodes- SCAN + AGG(distinct) split into BOTTOM and TOP
 		"SELECT distinct N_NAME FROM NATION": {
 			root: 1,
 			nodeType: map[int]plan.Node_NodeType{
 				0: plan.Node_TABLE_SCAN,
 				1: plan.Node_AGG,
+				2: plan.Node_AGG,
 			},
 			children: map[int][]int32{
-				1: {0},
+				1: {2},
+				2: {0},
 			},
 		},
 		//three nodes- SCAN + AGG(group by) + SORT
@@ -141,7 +145,7 @@ func TestNodeTree(t *testing.T) {
 				2: {0, 1},
 			},
 		},
-		//5 nodes - SCAN, SCAN, JOIN, SCAN, JOIN  //join three table
+		//5 nodes - SCAN, SCAN, JOIN, SCAN, JOIN  //join three table, the filtered orders join customer first
 		"SELECT l.L_ORDERKEY FROM CUSTOMER c, ORDERS o, LINEITEM l WHERE c.C_CUSTKEY = o.O_CUSTKEY and l.L_ORDERKEY = o.O_ORDERKEY and o.O_ORDERDATE < 10": {
 			root: 4,
 			nodeType: map[int]plan.Node_NodeType{
@@ -153,10 +157,10 @@ func TestNodeTree(t *testing.T) {
 			},
 			children: map[int][]int32{
 				2: {0, 1},
-				4: {2, 3},
+				4: {3, 2},
 			},
 		},
-		//6 nodes - SCAN, SCAN, JOIN, SCAN, JOIN, SORT  //join three table
+		//6 nodes - SCAN, SCAN, JOIN, SCAN, JOIN, SORT  //join three table, the filtered orders join customer first
 		"SELECT l.L_ORDERKEY FROM CUSTOMER c, ORDERS o, LINEITEM l WHERE c.C_CUSTKEY = o.O_CUSTKEY and l.L_ORDERKEY = o.O_ORDERKEY and o.O_ORDERDATE < 10 order by c.C_CUSTKEY": {
 			root: 5,
 			nodeType: map[int]plan.Node_NodeType{
@@ -169,7 +173,7 @@ func TestNodeTree(t *testing.T) {
 			},
 			children: map[int][]int32{
 				2: {0, 1},
-				4: {2, 3},
+				4: {3, 2},
 				5: {4},
 			},
 		},
@@ -181,11 +185,13 @@ func TestNodeTree(t *testing.T) {
 				1: plan.Node_AGG,
 				2: plan.Node_PROJECT,
 				3: plan.Node_PROJECT,
+				4: plan.Node_AGG,
 			},
 			children: map[int][]int32{
-				1: {0},
+				1: {4},
 				2: {1},
 				3: {2},
+				4: {0},
 			},
 		},
 		//4 nodes  //Derived table
@@ -197,12 +203,14 @@ func TestNodeTree(t *testing.T) {
 				2: plan.Node_PROJECT,
 				3: plan.Node_PROJECT,
 				4: plan.Node_SORT,
+				5: plan.Node_AGG,
 			},
 			children: map[int][]int32{
-				1: {0},
+				1: {5},
 				2: {1},
 				3: {2},
 				4: {3},
+				5: {0},
 			},
 		},
 		//Derived table join normal table
@@ -215,12 +223,14 @@ func TestNodeTree(t *testing.T) {
 				3: plan.Node_TABLE_SCAN,
 				4: plan.Node_JOIN,
 				5: plan.Node_SORT,
+				6: plan.Node_AGG,
 			},
 			children: map[int][]int32{
-				1: {0},
+				1: {6},
 				2: {1},
 				4: {2, 3},
 				5: {4},
+				6: {0},
 			},
 		},
 		//insert from values
@@ -266,12 +276,19 @@ func TestNodeTree(t *testing.T) {
 		},
 		// unrelated subquery
 		"SELECT * FROM NATION where N_REGIONKEY > (select max(R_REGIONKEY) from REGION)": {
-			root: 0,
+			root: 3,
 			nodeType: map[int]plan.Node_NodeType{
 				0: plan.Node_TABLE_SCAN, //nodeid = 1  here is the subquery
-				1: plan.Node_TABLE_SCAN, //nodeid = 0, here is SELECT * FROM NATION where N_REGIONKEY > [subquery]
+				1: plan.Node_TABLE_SCAN, //nodeid = 0, here is SELECT * FROM NATION
+				2: plan.Node_AGG,        //nodeid = 2, the subquery is decorrelated into the single join by it
+				3: plan.Node_JOIN,       //nodeid = 3, the single join of nodeid = 0 and nodeid = 2
+				4: plan.Node_AGG,        //nodeid = 4  the bottom aggregation split from nodeid = 2
+			},
+			children: map[int][]int32{
+				2: {4},
+				3: {0, 2},
+				4: {1},
 			},
-			children: map[int][]int32{},
 		},
 		// related subquery
 		`SELECT * FROM NATION where N_REGIONKEY > 
@@ -283,10 +300,12 @@ func TestNodeTree(t *testing.T) {
 				1: plan.Node_AGG,        //nodeid = 2  subquery node，so,wo pop it to top
 				2: plan.Node_TABLE_SCAN, //nodeid = 0
 				3: plan.Node_SORT,       //nodeid = 3
+				4: plan.Node_AGG,        //nodeid = 4  the bottom aggregation split from nodeid = 2
 			},
 			children: map[int][]int32{
-				1: {1}, //nodeid = 2, have children(NodeId=1, position=0)
+				1: {4}, //nodeid = 2, have children(NodeId=4, position=4)
 				3: {2}, //nodeid = 3, have children(NodeId=0, position=2)
+				4: {1}, //nodeid = 4, have children(NodeId=1, position=0)
 			},
 		},
 	}
@@ -343,6 +362,21 @@ func TestSingleTableSqlBuilder(t *testing.T) {
 		"SELECT N_REGIONKEY FROM NATION where N_REGIONKEY is null and N_NAME is not null",                                                                                 //test more expr
 
 		"SELECT abs(-1)",
+		"SELECT upper(N_NAME), lower(N_COMMENT), left(N_NAME, 2), right(N_NAME, 2), repeat(N_NAME, 2) FROM NATION",
+		"SELECT concat(N_NAME, '-', N_COMMENT), concat_ws(',', N_NAME, N_COMMENT), replace(N_NAME, 'a', 'b'), translate(N_NAME, 'ab', 'x') FROM NATION",
+		"SELECT instr(N_NAME, 'a'), locate('a', N_NAME), locate('a', N_NAME, 2), position('a' in N_NAME) FROM NATION",
+		"SELECT N_NAME FROM NATION WHERE N_NAME REGEXP '^A' OR N_COMMENT NOT REGEXP 'x$' OR regexp_like(N_NAME, 'a', 'i')",
+		"SELECT regexp_replace(N_NAME, 'a(.)', '$1'), regexp_replace(N_NAME, 'a', 'b', 1, 2, 'i'), regexp_substr(N_NAME, '[a-z]+'), regexp_substr(N_NAME, 'a', 2) FROM NATION",
+		"SELECT O_ORDERDATE + INTERVAL 1 DAY, INTERVAL '1-2' YEAR_MONTH + O_ORDERDATE, O_ORDERDATE - INTERVAL O_ORDERKEY HOUR FROM ORDERS",
+		"SELECT date_add(O_ORDERDATE, INTERVAL 1 WEEK), date_sub(O_ORDERDATE, INTERVAL '1 2' DAY_HOUR), adddate(O_ORDERDATE, 3), subdate(O_ORDERDATE, INTERVAL 1 MONTH) FROM ORDERS",
+		"SELECT datediff(L_SHIPDATE, L_COMMITDATE), timestampdiff(DAY, L_SHIPDATE, L_RECEIPTDATE), extract(YEAR_MONTH FROM L_SHIPDATE) FROM LINEITEM",
+		"SELECT hour(L_SHIPDATE), minute(L_SHIPDATE), second(L_SHIPDATE), last_day(L_SHIPDATE), date_format(L_SHIPDATE, '%Y-%m') FROM LINEITEM",
+		"SELECT unix_timestamp(), unix_timestamp(L_SHIPDATE), from_unixtime(L_ORDERKEY), from_unixtime(L_ORDERKEY, '%Y'), str_to_date('2022-01-01', '%Y-%m-%d') FROM LINEITEM",
+		"SELECT now(), now(6), current_timestamp, convert_tz(L_SHIPDATE, '+00:00', 'Asia/Shanghai') FROM LINEITEM",
+		"SELECT L_COMMENT->'$.a', json_unquote(json_extract(L_COMMENT, '$.b', '$.c')), json_length(L_COMMENT, '$.a'), json_keys(L_COMMENT) FROM LINEITEM WHERE json_contains(L_COMMENT, '1', '$.a') = 1",
+		"SELECT L_ORDERKEY, json_arrayagg(json_array(L_LINENUMBER, L_COMMENT)), json_objectagg(L_COMMENT, json_object('n', L_LINENUMBER)) FROM LINEITEM GROUP BY L_ORDERKEY",
+		"SELECT ceil(N_REGIONKEY), round(N_REGIONKEY, 1) FROM NATION",            //optional parameters
+		"SELECT N_NAME FROM NATION WHERE N_REGIONKEY > ? AND N_NAME = ? LIMIT ?", //test param
 	}
 	runTestShouldPass(mock, t, sqls, false, false)
 
@@ -353,6 +387,10 @@ func TestSingleTableSqlBuilder(t *testing.T) {
 		"SELECT N_NAME, N_REGIONKEY a FROM NATION ORDER BY cccc",            //column alias not exist
 		"SELECT N_NAME, b.N_REGIONKEY FROM NATION a ORDER BY b.N_REGIONKEY", //table alias not exist
 		"SELECT N_NAME FROM NATION WHERE absTTTT(N_REGIONKEY) > 0",          //function name not exist
+		"SELECT locate('a') FROM NATION",                                    //parameters not match
+		"SELECT replace(N_NAME, 'a') FROM NATION",                           //parameters not match
+		"SELECT regexp_substr(N_NAME) FROM NATION",                          //parameters not match
+		"SELECT datediff(O_ORDERDATE) FROM ORDERS",                          //parameters not match
 		"SELECT NATION.N_NAME FROM NATION a",                                // mysql should error, but i don't think it is necesssary
 
 		"SELECT DISTINCT N_NAME FROM NATION GROUP BY N_REGIONKEY", //test distinct with group by
@@ -416,6 +454,61 @@ func TestDerivedTableSqlBuilder(t *testing.T) {
 	runTestShouldError(mock, t, sqls)
 }
 
+func TestCTE(t *testing.T) {
+	mock := NewMockOptimizer()
+	//should pass
+	sqls := []string{
+		"WITH c AS (SELECT N_NATIONKEY, N_NAME FROM NATION) SELECT * FROM c",
+		"WITH c(a, b) AS (SELECT N_NATIONKEY, N_NAME FROM NATION), d AS (SELECT a FROM c WHERE a > 1) SELECT d.a FROM d JOIN c ON d.a = c.a",
+		"WITH NATION AS (SELECT R_REGIONKEY FROM REGION) SELECT R_REGIONKEY FROM NATION", // cte hides the table
+		"WITH RECURSIVE c AS (SELECT N_NAME FROM NATION) SELECT * FROM c",                // not a recursive cte
+		"WITH RECURSIVE c(n) AS (SELECT N_NATIONKEY FROM NATION UNION ALL SELECT n + 1 FROM c WHERE n < 10) SELECT n FROM c",
+		"WITH RECURSIVE c(k, r) AS (SELECT N_NATIONKEY, N_REGIONKEY FROM NATION WHERE N_NATIONKEY = 1 UNION ALL SELECT NATION.N_NATIONKEY, NATION.N_REGIONKEY FROM NATION JOIN c ON NATION.N_REGIONKEY = c.k) SELECT k FROM c",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	//a cte referenced twice is materialized once
+	query, err := runOneStmt(mock, t, "WITH c(a) AS (SELECT N_NATIONKEY FROM NATION) SELECT c1.a FROM c c1 JOIN c c2 ON c1.a = c2.a")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	nodeCount := make(map[plan.Node_NodeType]int)
+	for _, node := range query.Nodes {
+		nodeCount[node.NodeType]++
+	}
+	if nodeCount[plan.Node_MATERIAL] != 1 || nodeCount[plan.Node_MATERIAL_SCAN] != 2 || len(query.Steps) != 2 {
+		t.Fatalf("cte nodes not match: %v, steps: %v", nodeCount, query.Steps)
+	}
+
+	//the recursive part scans the working table
+	query, err = runOneStmt(mock, t, "WITH RECURSIVE c(n) AS (SELECT N_NATIONKEY FROM NATION UNION ALL SELECT n + 1 FROM c WHERE n < 10) SELECT n FROM c")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	cteNode := query.Nodes[query.Steps[0]]
+	if cteNode.NodeType != plan.Node_RECURSIVE_CTE || len(cteNode.Children) != 2 || len(query.Steps) != 2 {
+		t.Fatalf("recursive cte node not match: %v, steps: %v", cteNode, query.Steps)
+	}
+	if query.Nodes[cteNode.Children[1]].NodeType != plan.Node_SINK_SCAN {
+		t.Fatalf("recursive part should scan the working table: %v", query.Nodes[cteNode.Children[1]])
+	}
+
+	// should error
+	sqls = []string{
+		"WITH c AS (SELECT N_NAME FROM NATION), c AS (SELECT R_NAME FROM REGION) SELECT * FROM c",                                          // not unique
+		"WITH c(a, b) AS (SELECT N_NAME FROM NATION) SELECT a FROM c",                                                                      // column length not match
+		"WITH c AS (SELECT * FROM c) SELECT * FROM c",                                                                                      // not recursive
+		"WITH c AS (SELECT N_NAME FROM NATION) SELECT N_NATIONKEY FROM c",                                                                  // column not exist
+		"WITH RECURSIVE c(n) AS (SELECT n + 1 FROM c) SELECT n FROM c",                                                                     // no anchor part
+		"WITH RECURSIVE c(n) AS (SELECT n FROM c UNION ALL SELECT N_NATIONKEY FROM NATION) SELECT n FROM c",                                // anchor references itself
+		"WITH RECURSIVE c(n) AS (SELECT N_NATIONKEY FROM NATION UNION SELECT n + 1 FROM c) SELECT n FROM c",                                // union distinct
+		"WITH RECURSIVE c(n) AS (SELECT N_NATIONKEY FROM NATION UNION ALL SELECT count(n) FROM c) SELECT n FROM c",                         // aggregation
+		"WITH RECURSIVE c(n) AS (SELECT N_NATIONKEY FROM NATION UNION ALL SELECT c1.n FROM c c1 JOIN c c2 ON c1.n = c2.n) SELECT n FROM c", // referenced twice
+		"WITH RECURSIVE c(n) AS (SELECT N_NATIONKEY FROM NATION UNION ALL SELECT n, n FROM c) SELECT n FROM c",                             // column length not match
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 func TestInsert(t *testing.T) {
 	mock := NewMockOptimizer()
 	//should pass
@@ -515,6 +608,96 @@ func TestSubQuery(t *testing.T) {
 
 }
 
+func TestSubQueryDecorrelation(t *testing.T) {
+	type joinCheck struct {
+		joinType plan.Node_JoinFlag
+		//the node type of the right child of the join
+		rightType plan.Node_NodeType
+		onList    int
+		whereList int
+	}
+	checkList := map[string]joinCheck{
+		"SELECT N_NAME FROM NATION WHERE N_REGIONKEY IN (SELECT R_REGIONKEY FROM REGION)": {
+			joinType:  plan.Node_SEMI,
+			rightType: plan.Node_TABLE_SCAN,
+			onList:    1,
+		},
+		"SELECT N_NAME FROM NATION WHERE N_REGIONKEY NOT IN (SELECT R_REGIONKEY FROM REGION)": {
+			joinType:  plan.Node_ANTI | plan.Node_NULL_AWARE,
+			rightType: plan.Node_TABLE_SCAN,
+			onList:    1,
+		},
+		"SELECT N_NAME FROM NATION WHERE EXISTS (SELECT * FROM REGION WHERE R_REGIONKEY = N_REGIONKEY AND R_NAME = 'A')": {
+			joinType:  plan.Node_SEMI,
+			rightType: plan.Node_TABLE_SCAN,
+			onList:    1,
+		},
+		"SELECT N_NAME FROM NATION WHERE NOT EXISTS (SELECT R_NAME FROM REGION WHERE R_REGIONKEY = N_REGIONKEY)": {
+			joinType:  plan.Node_ANTI,
+			rightType: plan.Node_TABLE_SCAN,
+			onList:    1,
+		},
+		"SELECT N_NAME FROM NATION WHERE N_NATIONKEY < 3 OR N_REGIONKEY IN (SELECT R_REGIONKEY FROM REGION)": {
+			joinType:  plan.Node_MARK | plan.Node_NULL_AWARE,
+			rightType: plan.Node_TABLE_SCAN,
+			onList:    1,
+			whereList: 1,
+		},
+		"SELECT N_NAME FROM NATION WHERE N_REGIONKEY > (SELECT avg(R_REGIONKEY) FROM REGION WHERE R_NAME = N_NAME)": {
+			joinType:  plan.Node_SINGLE,
+			rightType: plan.Node_AGG,
+			onList:    1,
+			whereList: 1,
+		},
+	}
+
+	for sql, check := range checkList {
+		mock := NewMockOptimizer()
+		query, err := runOneStmt(mock, t, sql)
+		if err != nil {
+			t.Fatalf("%+v, sql=%v", err, sql)
+		}
+		if len(query.Steps) != 1 {
+			t.Fatalf("run sql[%+v] error, the subquery should not be a step, steps are [%+v]", sql, query.Steps)
+		}
+		join := getQueryNode(query, query.Steps[0])
+		if join.NodeType != plan.Node_JOIN {
+			t.Fatalf("run sql[%+v] error, root should be JOIN but now is [%+v]", sql, join.NodeType)
+		}
+		right := getQueryNode(query, join.Children[1])
+		if right.JoinType != check.joinType {
+			t.Fatalf("run sql[%+v] error, join type should be [%+v] but now is [%+v]", sql, check.joinType, right.JoinType)
+		}
+		if right.NodeType != check.rightType {
+			t.Fatalf("run sql[%+v] error, right child should be [%+v] but now is [%+v]", sql, check.rightType, right.NodeType)
+		}
+		if len(join.OnList) != check.onList {
+			t.Fatalf("run sql[%+v] error, join should have [%+v] conditions but now has [%+v]", sql, check.onList, len(join.OnList))
+		}
+		if len(join.WhereList) != check.whereList {
+			t.Fatalf("run sql[%+v] error, join should have [%+v] filters but now has [%+v]", sql, check.whereList, len(join.WhereList))
+		}
+		for _, expr := range join.WhereList {
+			if hasSubQuery(expr) {
+				t.Fatalf("run sql[%+v] error, filter of join should not have subquery", sql)
+			}
+		}
+	}
+
+	//the subqueries correlated by the non equalities are not decorrelated
+	mock := NewMockOptimizer()
+	sql := "SELECT N_NAME FROM NATION WHERE EXISTS (SELECT * FROM REGION WHERE R_REGIONKEY < N_REGIONKEY)"
+	query, err := runOneStmt(mock, t, sql)
+	if err != nil {
+		t.Fatalf("%+v, sql=%v", err, sql)
+	}
+	for _, node := range query.Nodes {
+		if node.NodeType == plan.Node_JOIN {
+			t.Fatalf("run sql[%+v] error, subquery should not be decorrelated", sql)
+		}
+	}
+}
+
 func getJson(v any, t *testing.T) []byte {
 	b, err := json.Marshal(v)
 	if err != nil {
@@ -540,6 +723,54 @@ func outPutQuery(query *plan.Query, toFile bool, t *testing.T) {
 	}
 }
 
+func TestWindow(t *testing.T) {
+	mock := NewMockOptimizer()
+	//should pass
+	sqls := []string{
+		"SELECT N_NAME, ROW_NUMBER() OVER (PARTITION BY N_REGIONKEY ORDER BY N_NATIONKEY) FROM NATION",
+		"SELECT N_NAME, RANK() OVER (ORDER BY N_REGIONKEY DESC), DENSE_RANK() OVER (ORDER BY N_REGIONKEY DESC) FROM NATION",
+		"SELECT N_NAME, LAG(N_NAME) OVER (ORDER BY N_NATIONKEY), LEAD(N_NAME, 2, 'none') OVER (ORDER BY N_NATIONKEY) FROM NATION",
+		"SELECT N_NAME, SUM(N_NATIONKEY) OVER (PARTITION BY N_REGIONKEY) FROM NATION",
+		"SELECT N_NAME, COUNT(*) OVER (ORDER BY N_NATIONKEY ROWS BETWEEN 2 PRECEDING AND 1 FOLLOWING) FROM NATION",
+		"SELECT N_NAME, AVG(N_NATIONKEY) OVER (ORDER BY N_REGIONKEY RANGE BETWEEN 1 PRECEDING AND CURRENT ROW) FROM NATION",
+		"SELECT N_NAME, FIRST_VALUE(N_NAME) OVER (ORDER BY N_NATIONKEY ROWS UNBOUNDED PRECEDING) FROM NATION WHERE N_REGIONKEY > 0 ORDER BY N_NAME",
+	}
+	runTestShouldPass(mock, t, sqls, false, false)
+
+	//functions with the same window share one WINDOW node
+	query, err := runOneStmt(mock, t, "SELECT RANK() OVER (ORDER BY N_REGIONKEY), DENSE_RANK() OVER (ORDER BY N_REGIONKEY), ROW_NUMBER() OVER () FROM NATION")
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	var windows []*plan.Node
+	for _, node := range query.Nodes {
+		if node.NodeType == plan.Node_WINDOW {
+			windows = append(windows, node)
+		}
+	}
+	if len(windows) != 2 || len(windows[0].WinSpec.WinFuncs) != 2 || len(windows[1].WinSpec.WinFuncs) != 1 {
+		t.Fatalf("window nodes not match: %v", windows)
+	}
+	if windows[0].WinSpec.Frame.Type != plan.FrameClause_RANGE || windows[1].WinSpec.Frame.End.Type != plan.FrameBound_UNBOUNDED_FOLLOWING {
+		t.Fatalf("default frame not match: %v", windows)
+	}
+
+	// should error
+	sqls = []string{
+		"SELECT N_NAME FROM NATION WHERE ROW_NUMBER() OVER () > 1",                                    // not in select list
+		"SELECT ABS(N_NATIONKEY) OVER () FROM NATION",                                                 // not a window function
+		"SELECT ROW_NUMBER(N_NATIONKEY) OVER () FROM NATION",                                          // parameters not match
+		"SELECT LAG(N_NAME, -1) OVER (ORDER BY N_NATIONKEY) FROM NATION",                              // negative offset
+		"SELECT SUM(N_NATIONKEY) OVER (ORDER BY N_NAME RANGE 1 PRECEDING) FROM NATION",                // range offset on varchar
+		"SELECT SUM(N_NATIONKEY) OVER (ORDER BY N_NAME, N_REGIONKEY RANGE 1 PRECEDING) FROM NATION",   // range offset with two columns
+		"SELECT SUM(N_NATIONKEY) OVER (ROWS BETWEEN UNBOUNDED FOLLOWING AND CURRENT ROW) FROM NATION", // bad frame start
+		"SELECT SUM(N_NATIONKEY) OVER (ROWS BETWEEN 1 FOLLOWING AND 1 PRECEDING) FROM NATION",         // bad frame
+		"SELECT SUM(SUM(N_NATIONKEY) OVER ()) OVER () FROM NATION",                                    // nested
+		"SELECT SUM(N_NATIONKEY) OVER (PARTITION BY column_not_exist) FROM NATION",                    // column not exist
+	}
+	runTestShouldError(mock, t, sqls)
+}
+
 func runOneStmt(opt Optimizer, t *testing.T, sql string) (*plan.Query, error) {
 	stmts, err := mysql.Parse(sql)
 	if err != nil {
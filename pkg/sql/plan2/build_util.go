@@ -111,8 +111,13 @@ func fillTableScanProjectList(query *Query, alias string) {
 	if alias == "" {
 		alias = node.TableDef.Name
 	}
-	exprs := make([]*plan.Expr, 0, len(node.TableDef.Cols))
-	for idx, col := range node.TableDef.Cols {
+	node.ProjectList = tableScanProjectList(node.TableDef, alias)
+}
+
+//tableScanProjectList returns all columns of the table in order
+func tableScanProjectList(tableDef *plan.TableDef, alias string) []*plan.Expr {
+	exprs := make([]*plan.Expr, 0, len(tableDef.Cols))
+	for idx, col := range tableDef.Cols {
 		exprs = append(exprs,
 			&plan.Expr{
 				Alias: alias + "." + col.Name,
@@ -125,7 +130,7 @@ func fillTableScanProjectList(query *Query, alias string) {
 				Typ: col.Typ,
 			})
 	}
-	node.ProjectList = exprs
+	return exprs
 }
 
 func fillJoinProjectList(node *plan.Node, leftNode *plan.Node, rightNode *plan.Node) {
@@ -342,20 +347,20 @@ func setDerivedTableAlias(query *Query, ctx CompilerContext, selectCtx *SelectCo
 }
 
 func getResolveTable(tblName string, ctx CompilerContext, selectCtx *SelectContext) (*plan.ObjectRef, *plan.TableDef, bool) {
-	//get table from context
-	objRef, tableDef := ctx.Resolve(tblName)
-	if tableDef != nil {
-		return objRef, tableDef, false
-	}
-
-	//get table from CTE
+	//get table from CTE, a cte hides the table with the same name
 	tableDef, ok := selectCtx.cteTables[strings.ToUpper(tblName)]
 	if ok {
-		objRef = &plan.ObjectRef{
+		objRef := &plan.ObjectRef{
 			ObjName: tblName,
 		}
 		return objRef, tableDef, true
 	}
+
+	//get table from context
+	objRef, tableDef := ctx.Resolve(tblName)
+	if tableDef != nil {
+		return objRef, tableDef, false
+	}
 	return nil, nil, false
 }
 
@@ -0,0 +1,290 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+// a window function found in the projection, it will be evaluated by
+// the WINDOW node of its window spec
+type windowFunc struct {
+	//functions with the same key share one WINDOW node
+	key  string
+	spec *plan.WindowSpec
+	expr *plan.Expr
+}
+
+func buildWindowFunctionExpr(name string, expr *tree.FuncExpr, ctx CompilerContext, query *Query, selectCtx *SelectContext) (*plan.Expr, error) {
+	if !selectCtx.windowAllowed {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("window function '%v' is only allowed in the select list", tree.String(expr, dialect.MYSQL)))
+	}
+	functionSig, ok := BuiltinFunctionsMap[name]
+	if !ok {
+		return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("function name '%v' is not exist", name))
+	}
+	if functionSig.Flag&(plan.Function_WIN|plan.Function_AGG) == 0 {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("function '%v' is not a window function", name))
+	}
+
+	//window functions can not be nested
+	selectCtx.windowAllowed = false
+	defer func() { selectCtx.windowAllowed = true }()
+
+	funcReference := expr.Func.FunctionReference.(*tree.UnresolvedName)
+	var args []*plan.Expr
+	if name == "COUNT" && funcReference.Star {
+		args = []*plan.Expr{{
+			Expr: &plan.Expr_C{
+				C: &plan.Const{
+					Isnull: false,
+					Value: &plan.Const_Ival{
+						Ival: 1,
+					},
+				},
+			},
+			Typ: &plan.Type{
+				Id: plan.Type_INT64,
+			},
+		}}
+	} else {
+		minArgs, maxArgs := len(functionSig.ArgType), len(functionSig.ArgType)
+		if name == "LAG" || name == "LEAD" {
+			//LAG(expr [, offset [, default]])
+			minArgs, maxArgs = 1, 3
+		}
+		if len(expr.Exprs) < minArgs || len(expr.Exprs) > maxArgs {
+			return nil, errors.New(errno.SyntaxErrororAccessRuleViolation, fmt.Sprintf("number of parameters does not match for function '%v'", functionSig.Name))
+		}
+		args = make([]*plan.Expr, 0, len(expr.Exprs))
+		for _, astExpr := range expr.Exprs {
+			arg, err := buildExpr(astExpr, ctx, query, selectCtx)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+		}
+	}
+
+	var returnType *plan.Type
+	switch name {
+	case "ROW_NUMBER", "RANK", "DENSE_RANK", "COUNT":
+		returnType = &plan.Type{Id: plan.Type_INT64}
+	case "LAG", "LEAD":
+		if len(args) > 1 {
+			if _, err := getNonNegativeIntConst(args[1], name); err != nil {
+				return nil, err
+			}
+		}
+		returnType = args[0].Typ
+	case "SUM":
+		switch args[0].Typ.Id {
+		case plan.Type_FLOAT32, plan.Type_FLOAT64:
+			returnType = &plan.Type{Id: plan.Type_FLOAT64}
+		case plan.Type_DECIMAL, plan.Type_DECIMAL64, plan.Type_DECIMAL128:
+			returnType = args[0].Typ
+		default:
+			returnType = &plan.Type{Id: plan.Type_INT64}
+		}
+	default:
+		switch functionSig.ArgTypeClass[0] {
+		case plan.Type_ANY, plan.Type_ANYNUMBER:
+			returnType = args[0].Typ
+		default:
+			returnType = &plan.Type{Id: functionSig.ArgTypeClass[0]}
+		}
+	}
+
+	spec, err := buildWindowSpec(expr.WindowSpec, ctx, query, selectCtx)
+	if err != nil {
+		return nil, err
+	}
+	funcExpr := &plan.Expr{
+		Expr: &plan.Expr_F{
+			F: &plan.Function{
+				Func: getFunctionObjRef(name),
+				Args: args,
+			},
+		},
+		Typ: returnType,
+	}
+	selectCtx.windowFuncs = append(selectCtx.windowFuncs, &windowFunc{
+		key:  tree.String(expr.WindowSpec, dialect.MYSQL),
+		spec: spec,
+		expr: funcExpr,
+	})
+	return funcExpr, nil
+}
+
+func buildWindowSpec(stmt *tree.WindowSpec, ctx CompilerContext, query *Query, selectCtx *SelectContext) (*plan.WindowSpec, error) {
+	spec := &plan.WindowSpec{}
+	for _, partitionBy := range stmt.PartitionBy {
+		expr, err := buildExpr(partitionBy, ctx, query, selectCtx)
+		if err != nil {
+			return nil, err
+		}
+		spec.PartitionBy = append(spec.PartitionBy, expr)
+	}
+	orderBys, err := buildOrderBySpecs(stmt.OrderBy, ctx, query, selectCtx)
+	if err != nil {
+		return nil, err
+	}
+	spec.OderyBy = orderBys
+	frame, err := buildFrameClause(stmt.Frame, spec, ctx, query, selectCtx)
+	if err != nil {
+		return nil, err
+	}
+	spec.Frame = frame
+	return spec, nil
+}
+
+func buildFrameClause(stmt *tree.FrameClause, spec *plan.WindowSpec, ctx CompilerContext, query *Query, selectCtx *SelectContext) (*plan.FrameClause, error) {
+	//the default frame is the whole partition without ORDER BY,
+	//and from the partition start to the last peer of current row with ORDER BY
+	if stmt == nil {
+		if len(spec.OderyBy) == 0 {
+			return &plan.FrameClause{
+				Type:  plan.FrameClause_ROWS,
+				Start: &plan.FrameBound{Type: plan.FrameBound_UNBOUNDED_PRECEDING},
+				End:   &plan.FrameBound{Type: plan.FrameBound_UNBOUNDED_FOLLOWING},
+			}, nil
+		}
+		return &plan.FrameClause{
+			Type:  plan.FrameClause_RANGE,
+			Start: &plan.FrameBound{Type: plan.FrameBound_UNBOUNDED_PRECEDING},
+			End:   &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW},
+		}, nil
+	}
+
+	frame := &plan.FrameClause{}
+	switch stmt.Type {
+	case tree.FRAME_TYPE_ROWS:
+		frame.Type = plan.FrameClause_ROWS
+	case tree.FRAME_TYPE_RANGE:
+		frame.Type = plan.FrameClause_RANGE
+	}
+	var err error
+	if frame.Start, err = buildFrameBound(stmt.Start, frame.Type, spec, ctx, query, selectCtx); err != nil {
+		return nil, err
+	}
+	if stmt.End == nil {
+		frame.End = &plan.FrameBound{Type: plan.FrameBound_CURRENT_ROW}
+	} else if frame.End, err = buildFrameBound(stmt.End, frame.Type, spec, ctx, query, selectCtx); err != nil {
+		return nil, err
+	}
+
+	if frame.Start.Type == plan.FrameBound_UNBOUNDED_FOLLOWING {
+		return nil, errors.New(errno.WindowingError, "frame start cannot be UNBOUNDED FOLLOWING")
+	}
+	if frame.End.Type == plan.FrameBound_UNBOUNDED_PRECEDING {
+		return nil, errors.New(errno.WindowingError, "frame end cannot be UNBOUNDED PRECEDING")
+	}
+	if frame.Start.Type > frame.End.Type {
+		return nil, errors.New(errno.WindowingError, fmt.Sprintf("frame starting from '%v' cannot end with '%v'", frame.Start.Type, frame.End.Type))
+	}
+	return frame, nil
+}
+
+func buildFrameBound(stmt *tree.FrameBound, frameType plan.FrameClause_FrameType, spec *plan.WindowSpec, ctx CompilerContext, query *Query, selectCtx *SelectContext) (*plan.FrameBound, error) {
+	bound := &plan.FrameBound{}
+	switch stmt.Type {
+	case tree.FRAME_BOUND_UNBOUNDED_PRECEDING:
+		bound.Type = plan.FrameBound_UNBOUNDED_PRECEDING
+	case tree.FRAME_BOUND_PRECEDING:
+		bound.Type = plan.FrameBound_PRECEDING
+	case tree.FRAME_BOUND_CURRENT_ROW:
+		bound.Type = plan.FrameBound_CURRENT_ROW
+	case tree.FRAME_BOUND_FOLLOWING:
+		bound.Type = plan.FrameBound_FOLLOWING
+	case tree.FRAME_BOUND_UNBOUNDED_FOLLOWING:
+		bound.Type = plan.FrameBound_UNBOUNDED_FOLLOWING
+	}
+	if stmt.Expr == nil {
+		return bound, nil
+	}
+
+	offset, err := buildExpr(stmt.Expr, ctx, query, selectCtx)
+	if err != nil {
+		return nil, err
+	}
+	if frameType == plan.FrameClause_ROWS {
+		if _, err := getNonNegativeIntConst(offset, "ROWS frame"); err != nil {
+			return nil, err
+		}
+	} else {
+		//a RANGE offset is added to or subtracted from the single ORDER BY value
+		if len(spec.OderyBy) != 1 {
+			return nil, errors.New(errno.WindowingError, "RANGE with offset PRECEDING/FOLLOWING requires exactly one ORDER BY column")
+		}
+		if err := checkNumberType(spec.OderyBy[0].OrderBy.Typ.Id, spec.OderyBy[0].OrderBy.Alias); err != nil {
+			return nil, err
+		}
+		c, ok := offset.Expr.(*plan.Expr_C)
+		if !ok {
+			return nil, errors.New(errno.WindowingError, "RANGE offset must be a constant")
+		}
+		switch val := c.C.Value.(type) {
+		case *plan.Const_Ival:
+			ok = val.Ival >= 0
+		case *plan.Const_Dval:
+			ok = val.Dval >= 0
+		default:
+			ok = false
+		}
+		if !ok || c.C.Isnull {
+			return nil, errors.New(errno.WindowingError, "RANGE offset must be a non-negative number")
+		}
+	}
+	bound.Offset = offset
+	return bound, nil
+}
+
+// getNonNegativeIntConst returns the value of a constant integer expr which is not negative
+func getNonNegativeIntConst(expr *plan.Expr, name string) (int64, error) {
+	if c, ok := expr.Expr.(*plan.Expr_C); ok && !c.C.Isnull {
+		if val, ok := c.C.Value.(*plan.Const_Ival); ok && val.Ival >= 0 {
+			return val.Ival, nil
+		}
+	}
+	return 0, errors.New(errno.WindowingError, fmt.Sprintf("offset of %v must be a non-negative integer constant", name))
+}
+
+// buildWindowNodes append one WINDOW node for every distinct window spec used in the projection
+func buildWindowNodes(query *Query, selectCtx *SelectContext) {
+	var nodes []*plan.Node
+	keys := make(map[string]*plan.Node)
+	for _, f := range selectCtx.windowFuncs {
+		node, ok := keys[f.key]
+		if !ok {
+			node = &plan.Node{
+				NodeType: plan.Node_WINDOW,
+				WinSpec:  f.spec,
+			}
+			keys[f.key] = node
+			nodes = append(nodes, node)
+		}
+		node.WinSpec.WinFuncs = append(node.WinSpec.WinFuncs, f.expr)
+	}
+	for _, node := range nodes {
+		appendQueryNode(query, node, false)
+	}
+	selectCtx.windowFuncs = nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+//EngineCompilerContext resolves the tables from the storage engine
+type EngineCompilerContext struct {
+	e engine.Engine
+	//the current database, used by the table names without the database
+	db string
+}
+
+func NewEngineCompilerContext(e engine.Engine, db string) *EngineCompilerContext {
+	return &EngineCompilerContext{e: e, db: db}
+}
+
+func (c *EngineCompilerContext) Resolve(name string) (*plan.ObjectRef, *plan.TableDef) {
+	dbName, tblName := c.db, name
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		dbName, tblName = name[:i], name[i+1:]
+	}
+	db, err := c.e.Database(dbName)
+	if err != nil {
+		return nil, nil
+	}
+	rel, err := db.Relation(tblName)
+	if err != nil {
+		return nil, nil
+	}
+	defer rel.Close()
+
+	var cols []*plan.ColDef
+	for _, def := range rel.TableDefs() {
+		attr, ok := def.(*engine.AttributeDef)
+		if !ok {
+			continue
+		}
+		col := &plan.ColDef{
+			Name: attr.Attr.Name,
+			Typ: &plan.Type{
+				Id:        plan.Type_TypeId(attr.Attr.Type.Oid),
+				Nullable:  !attr.Attr.Primary,
+				Width:     attr.Attr.Type.Width,
+				Precision: attr.Attr.Type.Precision,
+			},
+		}
+		if attr.Attr.Primary {
+			col.Pkidx = 1
+		}
+		cols = append(cols, col)
+	}
+	obj := &plan.ObjectRef{
+		DbName:     dbName,
+		SchemaName: dbName,
+		ObjName:    tblName,
+	}
+	return obj, &plan.TableDef{Name: tblName, Cols: cols}
+}
+
+//Stats returns the statistics the storage engine keeps for the table, nil if the engine knows none of its rows.
+//the histograms are given by the wrapper of the context after the table is analyzed
+func (c *EngineCompilerContext) Stats(obj *ObjectRef) *TableStats {
+	db, err := c.e.Database(obj.DbName)
+	if err != nil {
+		return nil
+	}
+	rel, err := db.Relation(obj.ObjName)
+	if err != nil {
+		return nil
+	}
+	defer rel.Close()
+
+	rows := rel.Rows()
+	if rows <= 0 {
+		return nil
+	}
+	stats := &TableStats{
+		RowCount: float64(rows),
+		Cols:     make(map[string]*ColumnStats),
+	}
+	cs, ok := rel.(engine.ColumnStatistics)
+	if !ok {
+		return stats
+	}
+	for _, def := range rel.TableDefs() {
+		attr, ok := def.(*engine.AttributeDef)
+		if !ok {
+			continue
+		}
+		name := attr.Attr.Name
+		col := &ColumnStats{
+			Ndv:      float64(cs.CardinalNumber(name)),
+			IsString: attr.Attr.Type.Oid == types.T_char || attr.Attr.Type.Oid == types.T_varchar,
+		}
+		if min, max, ok := cs.Range(name); ok {
+			col.Min, ok = rangeValue(min)
+			if ok {
+				col.Max, col.HasRange = rangeValue(max)
+			}
+		}
+		if col.Ndv > 0 || col.HasRange {
+			stats.Cols[name] = col
+		}
+	}
+	return stats
+}
+
+func (c *EngineCompilerContext) Cost(obj *ObjectRef, e *Expr) *Cost {
+	return &Cost{}
+}
+
+var unixEpoch = types.FromCalendar(1970, 1, 1)
+
+//rangeValue converts a bound of the zonemap into the value the selectivity is estimated by
+func rangeValue(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case int8:
+		return float64(v), true
+	case int16:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint8:
+		return float64(v), true
+	case uint16:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	case types.Date:
+		return float64(v - unixEpoch), true
+	}
+	return 0, false
+}
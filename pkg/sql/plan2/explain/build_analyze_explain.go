@@ -0,0 +1,84 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+func traversalAnalyze(node *AnalyzeNode, settings *FormatSettings, options *ExplainOptions) {
+	if node == nil {
+		return
+	}
+	for i, line := range DescribeAnalyzeNode(node) {
+		settings.buffer.PushNewLine(line, i == 0, settings.level)
+	}
+	settings.level++
+	for _, child := range node.Children {
+		traversalAnalyze(child, settings, options)
+	}
+	settings.level--
+}
+
+// DescribeAnalyzeNode returns the lines describing an operator and its runtime statistics in text format.
+func DescribeAnalyzeNode(node *AnalyzeNode) []string {
+	name := node.Name
+	if node.Parallel > 1 {
+		name += fmt.Sprintf(" (parallel=%d)", node.Parallel)
+	}
+	return []string{
+		name,
+		fmt.Sprintf("Rows: in=%d out=%d, Batches: in=%d out=%d",
+			node.InputRows, node.OutputRows, node.InputBatches, node.OutputBatches),
+		fmt.Sprintf("Time: wall=%s cpu=%s, Memory Peak: %s, Spill: %s",
+			describeDuration(node.WallTime), describeDuration(node.CPUTime),
+			describeSize(node.MemoryPeak), describeSize(node.SpillSize)),
+	}
+}
+
+// explainAnalyzeJSON renders the operators as an indented json array, one line of the json per line of the buffer.
+func explainAnalyzeJSON(nodes []*AnalyzeNode, buffer *ExplainDataBuffer) {
+	if nodes == nil {
+		nodes = []*AnalyzeNode{}
+	}
+	data, err := json.MarshalIndent(nodes, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		buffer.PushNewLine(line, true, 0)
+	}
+}
+
+// describeDuration formats the nanoseconds in milliseconds.
+func describeDuration(ns int64) string {
+	return fmt.Sprintf("%.3fms", float64(ns)/1e6)
+}
+
+// describeSize formats the bytes in the largest unit which keeps the value not less than 1.
+func describeSize(size int64) string {
+	units := []string{"B", "KB", "MB", "GB", "TB"}
+	v := float64(size)
+	i := 0
+	for ; v >= 1024 && i < len(units)-1; i++ {
+		v /= 1024
+	}
+	if i == 0 {
+		return fmt.Sprintf("%dB", size)
+	}
+	return fmt.Sprintf("%.2f%s", v, units[i])
+}
@@ -0,0 +1,71 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explain
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// explainPlanDOT renders the plan as a graphviz digraph, each node is a box labeled with the lines of format text
+// and each edge points from a node to its child.
+func explainPlanDOT(query *plan.Query, buffer *ExplainDataBuffer, options *ExplainOptions) {
+	buffer.PushNewLine("digraph plan {", true, 0)
+	buffer.PushNewLine("  node [shape=box];", true, 0)
+	nodes := collectPlanNodes(query)
+	for _, id := range nodes {
+		buffer.PushNewLine(fmt.Sprintf("  n%d [label=\"%s\"];", id, describeDOTLabel(query.Nodes[id], options)), true, 0)
+	}
+	for _, id := range nodes {
+		for _, child := range query.Nodes[id].Children {
+			buffer.PushNewLine(fmt.Sprintf("  n%d -> n%d;", id, child), true, 0)
+		}
+	}
+	buffer.PushNewLine("}", true, 0)
+}
+
+func describeDOTLabel(node *plan.Node, options *ExplainOptions) string {
+	nodedescImpl := NewNodeDescriptionImpl(node)
+	lines := []string{nodedescImpl.GetNodeBasicInfo(options)}
+	if options.Verbose {
+		lines = append(lines, nodedescImpl.GetProjectListInfo(options))
+	}
+	lines = append(lines, nodedescImpl.GetExtraInfo(options)...)
+
+	var label string
+	for _, line := range lines {
+		label += escapeDOTString(line) + "\\l"
+	}
+	return label
+}
+
+// escapeDOTString escapes the characters which are special in a quoted string of dot.
+func escapeDOTString(s string) string {
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"', '\\':
+			buf.WriteByte('\\')
+			buf.WriteByte(s[i])
+		case '\n':
+			buf.WriteString("\\l")
+		default:
+			buf.WriteByte(s[i])
+		}
+	}
+	return buf.String()
+}
@@ -22,6 +22,9 @@ import (
 )
 
 func DescribeExpr(expr *plan.Expr) string {
+	if expr == nil {
+		return "NULL"
+	}
 	var result string
 	switch expr.Expr.(type) {
 	case *plan.Expr_Col:
@@ -29,6 +32,9 @@ func DescribeExpr(expr *plan.Expr) string {
 		result += colExpr.Col.GetName()
 	case *plan.Expr_C:
 		constExpr := expr.Expr.(*plan.Expr_C)
+		if constExpr.C.Isnull {
+			return "NULL"
+		}
 		if intConst, ok := constExpr.C.Value.(*plan.Const_Ival); ok {
 			result += fmt.Sprintf("%v", intConst.Ival)
 		}
@@ -42,26 +48,85 @@ func DescribeExpr(expr *plan.Expr) string {
 		}
 	case *plan.Expr_F:
 		funcExpr := expr.Expr.(*plan.Expr_F)
+		if funcExpr.F.GetFunc().GetObjName() == "CAST" {
+			return describeCast(funcExpr, expr.Typ)
+		}
 		result += FuncExprExplain(funcExpr)
 	case *plan.Expr_V:
-		return "unimplement Expr_V"
+		varExpr := expr.Expr.(*plan.Expr_V)
+		result += "@" + varExpr.V.GetName()
 	case *plan.Expr_P:
-		return "unimplement Expr_P"
+		result += "?"
 	case *plan.Expr_List:
-		return "unimplement Expr_List"
+		listExpr := expr.Expr.(*plan.Expr_List)
+		result += "(" + describeExprList(listExpr.List.GetList()) + ")"
+	case *plan.Expr_Sub:
+		subExpr := expr.Expr.(*plan.Expr_Sub)
+		result += fmt.Sprintf("subquery(node %d)", subExpr.Sub.GetNodeId())
+	case *plan.Expr_Corr:
+		corrExpr := expr.Expr.(*plan.Expr_Corr)
+		result += fmt.Sprintf("%s(outer node %d)", corrExpr.Corr.GetName(), corrExpr.Corr.GetNodeId())
 	default:
 		return "error Expr"
 	}
 	return result
 }
 
+func describeExprList(exprs []*plan.Expr) string {
+	var result string
+	for i, v := range exprs {
+		if i > 0 {
+			result += ", "
+		}
+		result += DescribeExpr(v)
+	}
+	return result
+}
+
+// describeCast renders CAST with its target type, which is only kept in the type of the expression.
+func describeCast(funcExpr *plan.Expr_F, typ *plan.Type) string {
+	result := "CAST(" + describeExprList(funcExpr.F.Args)
+	if typ != nil {
+		result += " AS " + plan.Type_TypeId_name[int32(typ.Id)]
+	}
+	return result + ")"
+}
+
+// describeCase renders the CASE built as CASE(caseExpr, ((cond, value), ...), elseExpr),
+// caseExpr and elseExpr are nil if they are absent.
+func describeCase(funcExpr *plan.Expr_F) string {
+	result := "CASE"
+	args := funcExpr.F.Args
+	if len(args) != 3 {
+		return result + "(" + describeExprList(args) + ")"
+	}
+	if args[0] != nil {
+		result += " " + DescribeExpr(args[0])
+	}
+	if whens, ok := args[1].GetExpr().(*plan.Expr_List); ok {
+		for _, when := range whens.List.GetList() {
+			pair, ok := when.GetExpr().(*plan.Expr_List)
+			if !ok || len(pair.List.GetList()) != 2 {
+				result += " WHEN " + DescribeExpr(when)
+				continue
+			}
+			result += " WHEN " + DescribeExpr(pair.List.List[0]) + " THEN " + DescribeExpr(pair.List.List[1])
+		}
+	}
+	if args[2] != nil {
+		result += " ELSE " + DescribeExpr(args[2])
+	}
+	return result + " END"
+}
+
 func FuncExprExplain(funcExpr *plan.Expr_F) string {
 	//SysFunsAndOperatorsMap
 	var result string
 	funcName := funcExpr.F.GetFunc().GetObjName()
 	funcProtoType, ok := plan2.BuiltinFunctionsMap[funcName]
 	if !ok {
-		panic("unkonw expression")
+		// the function is resolved elsewhere, such as the aggregate rewritten by the optimizer
+		return funcName + "(" + describeExprList(funcExpr.F.Args) + ")"
 	}
 	switch funcProtoType.Kind {
 	case plan2.STANDARD_FUNCTION:
@@ -94,19 +159,22 @@ func FuncExprExplain(funcExpr *plan.Expr_F) string {
 	case plan2.COMPARISON_OPERATOR:
 		result += DescribeExpr(funcExpr.F.Args[0]) + " " + funcExpr.F.Func.GetObjName() + " " + DescribeExpr(funcExpr.F.Args[1])
 	case plan2.CAST_EXPRESSION:
-		// panic("CAST_EXPRESSION is not support now")
+		result += describeCast(funcExpr, nil)
 	case plan2.CASE_WHEN_EXPRESSION:
-		panic("CASE_WHEN_EXPRESSION is not support now")
+		result += describeCase(funcExpr)
 	case plan2.BETWEEN_AND_EXPRESSION:
-		panic("CASE_WHEN_EXPRESSION is not support now")
+		if len(funcExpr.F.Args) != 3 {
+			return funcName + "(" + describeExprList(funcExpr.F.Args) + ")"
+		}
+		result += DescribeExpr(funcExpr.F.Args[0]) + " BETWEEN " + DescribeExpr(funcExpr.F.Args[1]) + " AND " + DescribeExpr(funcExpr.F.Args[2])
 	case plan2.IN_EXISTS_EXPRESSION:
-		panic("CASE_WHEN_EXPRESSION is not support now")
+		result += funcName + " " + describeExprList(funcExpr.F.Args)
 	case plan2.IS_NULL_EXPRESSION:
 		result += DescribeExpr(funcExpr.F.Args[0]) + " IS NULL"
 	case plan2.NOPARAMETER_FUNCTION:
 		result += funcExpr.F.Func.GetObjName()
-	case plan2.UNKNOW_KIND_FUNCTION:
-		panic("UNKNOW_KIND_FUNCTION is not support now")
+	default:
+		result += funcName + "(" + describeExprList(funcExpr.F.Args) + ")"
 	}
 	return result
 }
@@ -0,0 +1,129 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package explain
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+// explainPlanJSON renders the plan as an indented json object, one line of the json per line of the buffer.
+func explainPlanJSON(query *plan.Query, buffer *ExplainDataBuffer, options *ExplainOptions) {
+	var data bytes.Buffer
+	// the expressions are kept readable, the comparisons are not escaped as html
+	encoder := json.NewEncoder(&data)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(BuildJSONPlan(query, options)); err != nil {
+		panic(err)
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(data.String(), "\n"), "\n") {
+		buffer.PushNewLine(line, true, 0)
+	}
+}
+
+// BuildJSONPlan converts the plan into JSONPlan.
+func BuildJSONPlan(query *plan.Query, options *ExplainOptions) *JSONPlan {
+	jsonPlan := &JSONPlan{
+		Steps: make([]int32, 0, len(query.Steps)),
+		Nodes: make([]*JSONNode, 0, len(query.Nodes)),
+	}
+	jsonPlan.Steps = append(jsonPlan.Steps, query.Steps...)
+	for _, id := range collectPlanNodes(query) {
+		jsonPlan.Nodes = append(jsonPlan.Nodes, buildJSONNode(id, query.Nodes[id], options))
+	}
+	return jsonPlan
+}
+
+func buildJSONNode(id int32, node *plan.Node, options *ExplainOptions) *JSONNode {
+	jsonNode := &JSONNode{
+		Id:             id,
+		Type:           nodeTypeName(node),
+		Projections:    describeExprs(node.ProjectList),
+		Filters:        describeExprs(node.WhereList),
+		JoinConditions: describeExprs(node.OnList),
+		GroupBy:        describeExprs(node.GroupBy),
+		Children:       make([]int32, 0, len(node.Children)),
+	}
+	jsonNode.Children = append(jsonNode.Children, node.Children...)
+
+	if node.ObjRef != nil {
+		jsonNode.Table = &JSONTable{
+			Db:   node.ObjRef.DbName,
+			Name: node.ObjRef.ObjName,
+		}
+	} else if node.TableDef != nil {
+		jsonNode.Table = &JSONTable{
+			Name: node.TableDef.Name,
+		}
+	}
+
+	if node.Cost != nil {
+		jsonNode.Cost = JSONCost{
+			Card:    jsonFloat(node.Cost.Card),
+			Rowsize: jsonFloat(node.Cost.Rowsize),
+			Ndv:     jsonFloat(node.Cost.Ndv),
+			Start:   jsonFloat(node.Cost.Start),
+			Total:   jsonFloat(node.Cost.Total),
+		}
+	}
+
+	if node.NodeType == plan.Node_JOIN {
+		jsonNode.JoinType = node.JoinType.String()
+	}
+
+	for _, orderBy := range node.OrderBy {
+		jsonNode.OrderBy = append(jsonNode.OrderBy, &JSONOrderBy{
+			Expr: DescribeExpr(orderBy.OrderBy),
+			Flag: orderBy.OrderByFlags.String(),
+		})
+	}
+
+	if node.Limit != nil {
+		jsonNode.Limit = DescribeExpr(node.Limit)
+	}
+	if node.Offset != nil {
+		jsonNode.Offset = DescribeExpr(node.Offset)
+	}
+	return jsonNode
+}
+
+func describeExprs(exprs []*plan.Expr) []string {
+	if len(exprs) == 0 {
+		return nil
+	}
+	result := make([]string, len(exprs))
+	for i, expr := range exprs {
+		result[i] = DescribeExpr(expr)
+	}
+	return result
+}
+
+// jsonFloat replaces the values which can not be encoded by json, NaN becomes 0 and infinity becomes the max float64.
+func jsonFloat(v float64) float64 {
+	switch {
+	case math.IsNaN(v):
+		return 0
+	case math.IsInf(v, 1):
+		return math.MaxFloat64
+	case math.IsInf(v, -1):
+		return -math.MaxFloat64
+	}
+	return v
+}
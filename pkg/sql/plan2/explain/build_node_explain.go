@@ -14,7 +14,11 @@
 
 package explain
 
-import "github.com/matrixorigin/matrixone/pkg/pb/plan"
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
 
 var _ NodeDescribe = &NodeDescribeImpl{}
 
@@ -28,11 +32,11 @@ func NewNodeDescriptionImpl(node *plan.Node) *NodeDescribeImpl {
 	}
 }
 
-func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) string {
-	var result string
-	var pname string /* node type name for text output */
+// nodeTypeName returns the name of the node type for output.
+func nodeTypeName(node *plan.Node) string {
+	var pname string
 
-	switch ndesc.Node.NodeType {
+	switch node.NodeType {
 	case plan.Node_UNKNOWN:
 		pname = "UnKnow Node"
 	case plan.Node_VALUE_SCAN:
@@ -56,7 +60,14 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) string
 	case plan.Node_SINK_SCAN:
 		pname = "Sink Scan"
 	case plan.Node_AGG:
-		pname = "Aggregate"
+		switch node.AggMode {
+		case plan.Node_BOTTOM:
+			pname = "Aggregate(Bottom)"
+		case plan.Node_TOP:
+			pname = "Aggregate(Top)"
+		default:
+			pname = "Aggregate"
+		}
 	case plan.Node_JOIN:
 		pname = "Join"
 	case plan.Node_SAMPLE:
@@ -82,74 +93,73 @@ func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) string
 	default:
 		pname = "???"
 	}
+	return pname
+}
 
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		result += pname
-		switch ndesc.Node.NodeType {
-		case plan.Node_VALUE_SCAN:
-			fallthrough
-		case plan.Node_TABLE_SCAN:
-			fallthrough
-		case plan.Node_FUNCTION_SCAN:
-			fallthrough
-		case plan.Node_EXTERNAL_SCAN:
-			result += " on "
-			if ndesc.Node.ObjRef != nil {
-				objRefImpl := NewObjRefDescribeImpl(ndesc.Node.ObjRef)
-				result += objRefImpl.GetDescription(options)
-			} else if ndesc.Node.TableDef != nil {
-				tableDefImpl := NewTableDefDescribeImpl(ndesc.Node.TableDef)
-				result += tableDefImpl.GetDescription(options)
-			}
-		case plan.Node_PROJECT:
-			fallthrough
-		case plan.Node_EXTERNAL_FUNCTION:
-			fallthrough
-		case plan.Node_MATERIAL:
-			fallthrough
-		case plan.Node_RECURSIVE_CTE:
-			fallthrough
-		case plan.Node_SINK:
-			fallthrough
-		case plan.Node_SINK_SCAN:
-			fallthrough
-		case plan.Node_AGG:
-			fallthrough
-		case plan.Node_JOIN:
-			fallthrough
-		case plan.Node_SAMPLE:
-			fallthrough
-		case plan.Node_SORT:
-			fallthrough
-		case plan.Node_UNION:
-			fallthrough
-		case plan.Node_UNION_ALL:
-			fallthrough
-		case plan.Node_UNIQUE:
-			fallthrough
-		case plan.Node_WINDOW:
-			fallthrough
-		case plan.Node_BROADCAST:
-			fallthrough
-		case plan.Node_SPLIT:
-			fallthrough
-		case plan.Node_GATHER:
-			fallthrough
-		case plan.Node_ASSERT:
-			fallthrough
-		case plan.Node_UNKNOWN:
-			fallthrough
-		default:
+func (ndesc *NodeDescribeImpl) GetNodeBasicInfo(options *ExplainOptions) string {
+	var result string
 
+	result += nodeTypeName(ndesc.Node)
+	switch ndesc.Node.NodeType {
+	case plan.Node_VALUE_SCAN:
+		fallthrough
+	case plan.Node_TABLE_SCAN:
+		fallthrough
+	case plan.Node_FUNCTION_SCAN:
+		fallthrough
+	case plan.Node_EXTERNAL_SCAN:
+		result += " on "
+		if ndesc.Node.ObjRef != nil {
+			objRefImpl := NewObjRefDescribeImpl(ndesc.Node.ObjRef)
+			result += objRefImpl.GetDescription(options)
+		} else if ndesc.Node.TableDef != nil {
+			tableDefImpl := NewTableDefDescribeImpl(ndesc.Node.TableDef)
+			result += tableDefImpl.GetDescription(options)
 		}
-	}
+	case plan.Node_PROJECT:
+		fallthrough
+	case plan.Node_EXTERNAL_FUNCTION:
+		fallthrough
+	case plan.Node_MATERIAL:
+		fallthrough
+	case plan.Node_RECURSIVE_CTE:
+		fallthrough
+	case plan.Node_SINK:
+		fallthrough
+	case plan.Node_SINK_SCAN:
+		fallthrough
+	case plan.Node_AGG:
+		fallthrough
+	case plan.Node_JOIN:
+		fallthrough
+	case plan.Node_SAMPLE:
+		fallthrough
+	case plan.Node_SORT:
+		fallthrough
+	case plan.Node_UNION:
+		fallthrough
+	case plan.Node_UNION_ALL:
+		fallthrough
+	case plan.Node_UNIQUE:
+		fallthrough
+	case plan.Node_WINDOW:
+		fallthrough
+	case plan.Node_BROADCAST:
+		fallthrough
+	case plan.Node_SPLIT:
+		fallthrough
+	case plan.Node_GATHER:
+		fallthrough
+	case plan.Node_ASSERT:
+		fallthrough
+	case plan.Node_UNKNOWN:
+		fallthrough
+	default:
 
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		result += " (cost=%.2f..%.2f rows=%.0f width=%f)"
-	} else {
-		//TODO implement me
-		panic("implement me")
 	}
+
+	costDescImpl := NewCostDescribeImpl(ndesc.Node.Cost)
+	result += " " + costDescImpl.GetDescription(options)
 	return result
 }
 
@@ -204,52 +214,40 @@ func (ndesc *NodeDescribeImpl) GetJoinConditionInfo(options *ExplainOptions) str
 
 func (ndesc *NodeDescribeImpl) GetWhereConditionInfo(options *ExplainOptions) string {
 	var result string = "Filter: "
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		var first bool = true
-		for _, v := range ndesc.Node.WhereList {
-			if !first {
-				result += " AND "
-			}
-			first = false
-			result += DescribeExpr(v)
+	var first bool = true
+	for _, v := range ndesc.Node.WhereList {
+		if !first {
+			result += " AND "
 		}
-	} else {
-		panic("implement me")
+		first = false
+		result += DescribeExpr(v)
 	}
 	return result
 }
 
 func (ndesc *NodeDescribeImpl) GetGroupByInfo(options *ExplainOptions) string {
 	var result string = "Group Key:"
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		var first bool = true
-		for _, v := range ndesc.Node.GetGroupBy() {
-			if !first {
-				result += ", "
-			}
-			first = false
-			result += DescribeExpr(v)
+	var first bool = true
+	for _, v := range ndesc.Node.GetGroupBy() {
+		if !first {
+			result += ", "
 		}
-	} else {
-		panic("implement me")
+		first = false
+		result += DescribeExpr(v)
 	}
 	return result
 }
 
 func (ndesc *NodeDescribeImpl) GetOrderByInfo(options *ExplainOptions) string {
 	var result string = "Sort Key:"
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		var first bool = true
-		for _, v := range ndesc.Node.GetOrderBy() {
-			if !first {
-				result += ", "
-			}
-			first = false
-			orderByDescImpl := NewOrderByDescribeImpl(v)
-			result += orderByDescImpl.GetDescription(options)
+	var first bool = true
+	for _, v := range ndesc.Node.GetOrderBy() {
+		if !first {
+			result += ", "
 		}
-	} else {
-		panic("implement me")
+		first = false
+		orderByDescImpl := NewOrderByDescribeImpl(v)
+		result += orderByDescImpl.GetDescription(options)
 	}
 	return result
 }
@@ -266,9 +264,16 @@ type CostDescribeImpl struct {
 	Cost *Cost
 }
 
+func NewCostDescribeImpl(cost *plan.Cost) *CostDescribeImpl {
+	return &CostDescribeImpl{
+		Cost: (*Cost)(cost),
+	}
+}
+
 func (c *CostDescribeImpl) GetDescription(options *ExplainOptions) string {
-	//TODO implement me
-	panic("implement me")
+	cost := (*plan.Cost)(c.Cost)
+	return fmt.Sprintf("(cost=%.2f..%.2f rows=%.2f ndv=%.2f rowsize=%.0f)",
+		cost.GetStart(), cost.GetTotal(), cost.GetCard(), cost.GetNdv(), cost.GetRowsize())
 }
 
 type ExprListDescribeImpl struct {
@@ -284,16 +289,12 @@ func NewExprListDescribeImpl(ExprList []*plan.Expr) *ExprListDescribeImpl {
 func (e *ExprListDescribeImpl) GetDescription(options *ExplainOptions) string {
 	var first bool = true
 	var result string = " "
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		for _, v := range e.ExprList {
-			if !first {
-				result += ", "
-			}
-			first = false
-			result += DescribeExpr(v)
+	for _, v := range e.ExprList {
+		if !first {
+			result += ", "
 		}
-	} else {
-		panic("implement me")
+		first = false
+		result += DescribeExpr(v)
 	}
 	return result
 }
@@ -22,6 +22,8 @@ var _ ExplainQuery = &ExplainQueryImpl{}
 
 type ExplainQueryImpl struct {
 	QueryPlan *plan.Query
+	// Analysis, the operators of the executed pipelines, only for explain analyze.
+	Analysis []*AnalyzeNode
 }
 
 func NewExplainQueryImpl(query *plan.Query) *ExplainQueryImpl {
@@ -30,6 +32,13 @@ func NewExplainQueryImpl(query *plan.Query) *ExplainQueryImpl {
 	}
 }
 
+func NewExplainAnalyzeImpl(query *plan.Query, analysis []*AnalyzeNode) *ExplainQueryImpl {
+	return &ExplainQueryImpl{
+		QueryPlan: query,
+		Analysis:  analysis,
+	}
+}
+
 func traversalPlan(node *plan.Node, Nodes []*plan.Node, settings *FormatSettings, options *ExplainOptions) {
 	if node == nil {
 		return
@@ -44,7 +53,37 @@ func traversalPlan(node *plan.Node, Nodes []*plan.Node, settings *FormatSettings
 	settings.level--
 }
 
+// collectPlanNodes returns the ids of the nodes reachable from the steps in pre-order,
+// a node shared by several parents is only returned once.
+func collectPlanNodes(query *plan.Query) []int32 {
+	ids := make([]int32, 0, len(query.Nodes))
+	visited := make(map[int32]bool, len(query.Nodes))
+	var visit func(id int32)
+	visit = func(id int32) {
+		if visited[id] || id < 0 || int(id) >= len(query.Nodes) {
+			return
+		}
+		visited[id] = true
+		ids = append(ids, id)
+		for _, child := range query.Nodes[id].Children {
+			visit(child)
+		}
+	}
+	for _, step := range query.Steps {
+		visit(step)
+	}
+	return ids
+}
+
 func (e *ExplainQueryImpl) ExplainPlan(buffer *ExplainDataBuffer, options *ExplainOptions) {
+	switch options.Format {
+	case EXPLAIN_FORMAT_JSON:
+		explainPlanJSON(e.QueryPlan, buffer, options)
+		return
+	case EXPLAIN_FORMAT_DOT:
+		explainPlanDOT(e.QueryPlan, buffer, options)
+		return
+	}
 	var Nodes []*plan.Node = e.QueryPlan.Nodes
 	for _, rootNodeId := range e.QueryPlan.Steps {
 		// logutil.Infof("------------------------------------Query Plan-%v ---------------------------------------------", index)
@@ -58,30 +97,37 @@ func (e *ExplainQueryImpl) ExplainPlan(buffer *ExplainDataBuffer, options *Expla
 	}
 }
 
+// ExplainAnalyze renders the operators of the executed pipelines with their runtime statistics,
+// the plan is not rendered since the operators are not one-to-one with the plan nodes.
 func (e *ExplainQueryImpl) ExplainAnalyze(buffer *ExplainDataBuffer, options *ExplainOptions) {
-	//TODO implement me
-	panic("implement me")
+	if options.Format == EXPLAIN_FORMAT_JSON {
+		explainAnalyzeJSON(e.Analysis, buffer)
+		return
+	}
+	for _, node := range e.Analysis {
+		settings := FormatSettings{
+			buffer: buffer,
+			offset: 0,
+			indent: 2,
+			level:  0,
+		}
+		traversalAnalyze(node, &settings, options)
+	}
 }
 
 func explainStep(step *plan.Node, settings *FormatSettings, options *ExplainOptions) {
 	nodedescImpl := NewNodeDescriptionImpl(step)
 
-	if options.Format == EXPLAIN_FORMAT_TEXT {
-		basicNodeInfo := nodedescImpl.GetNodeBasicInfo(options)
+	basicNodeInfo := nodedescImpl.GetNodeBasicInfo(options)
 
-		settings.buffer.PushNewLine(basicNodeInfo, true, settings.level)
-		if options.Verbose {
-			projecrtInfo := nodedescImpl.GetProjectListInfo(options)
-			settings.buffer.PushNewLine(projecrtInfo, false, settings.level)
-		}
+	settings.buffer.PushNewLine(basicNodeInfo, true, settings.level)
+	if options.Verbose {
+		projecrtInfo := nodedescImpl.GetProjectListInfo(options)
+		settings.buffer.PushNewLine(projecrtInfo, false, settings.level)
+	}
 
-		extraInfo := nodedescImpl.GetExtraInfo(options)
-		for _, line := range extraInfo {
-			settings.buffer.PushNewLine(line, false, settings.level)
-		}
-	} else if options.Format == EXPLAIN_FORMAT_JSON {
-		panic("implement me")
-	} else if options.Format == EXPLAIN_FORMAT_DOT {
-		panic("implement me")
+	extraInfo := nodedescImpl.GetExtraInfo(options)
+	for _, line := range extraInfo {
+		settings.buffer.PushNewLine(line, false, settings.level)
 	}
 }
@@ -15,11 +15,17 @@
 package explain
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
 	"strings"
 	"testing"
 
 	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/errors"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect/mysql"
@@ -53,6 +59,206 @@ func TestBasicSqlExplain(t *testing.T) {
 	runTestShouldPass(mockOptimizer, t, sqls)
 }
 
+func TestExplainAnalyze(t *testing.T) {
+	analysis := []*AnalyzeNode{{
+		Name: "Output", Parallel: 1,
+		InputRows: 4, OutputRows: 4, InputBatches: 1, OutputBatches: 1,
+		WallTime: 1500000, CPUTime: 1000000,
+		Children: []*AnalyzeNode{{
+			Name: "Merge Order", Parallel: 1,
+			OutputRows: 4, OutputBatches: 1,
+			MemoryPeak: 2048, SpillSize: 3 << 20,
+			Children: []*AnalyzeNode{{
+				Name: "Table Scan on test.R", Parallel: 4,
+				OutputRows: 20, OutputBatches: 4,
+			}},
+		}},
+	}}
+	explainQuery := NewExplainAnalyzeImpl(nil, analysis)
+
+	buffer := NewExplainDataBuffer()
+	explainQuery.ExplainAnalyze(buffer, &ExplainOptions{Anzlyze: true, Format: EXPLAIN_FORMAT_TEXT})
+	expected := []string{
+		"Output",
+		"  Rows: in=4 out=4, Batches: in=1 out=1",
+		"  Time: wall=1.500ms cpu=1.000ms, Memory Peak: 0B, Spill: 0B",
+		"  ->  Merge Order",
+		"        Rows: in=0 out=4, Batches: in=0 out=1",
+		"        Time: wall=0.000ms cpu=0.000ms, Memory Peak: 2.00KB, Spill: 3.00MB",
+		"        ->  Table Scan on test.R (parallel=4)",
+		"              Rows: in=0 out=20, Batches: in=0 out=4",
+		"              Time: wall=0.000ms cpu=0.000ms, Memory Peak: 0B, Spill: 0B",
+	}
+	if strings.Join(buffer.Lines, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected text:\n%s", strings.Join(buffer.Lines, "\n"))
+	}
+
+	buffer = NewExplainDataBuffer()
+	explainQuery.ExplainAnalyze(buffer, &ExplainOptions{Anzlyze: true, Format: EXPLAIN_FORMAT_JSON})
+	var nodes []*AnalyzeNode
+	if err := json.Unmarshal([]byte(strings.Join(buffer.Lines, "\n")), &nodes); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(nodes, analysis) {
+		t.Fatalf("unexpected json:\n%s", strings.Join(buffer.Lines, "\n"))
+	}
+}
+
+func TestExplainFormat(t *testing.T) {
+	sql := "SELECT N_NAME, N_REGIONKEY FROM NATION WHERE N_NATIONKEY > 0 ORDER BY N_NAME DESC LIMIT 10"
+	query := buildTestPlan(t, plan2.NewMockOptimizer(), sql)
+
+	buffer := NewExplainDataBuffer()
+	NewExplainQueryImpl(query).ExplainPlan(buffer, &ExplainOptions{Format: EXPLAIN_FORMAT_JSON})
+	var jsonPlan JSONPlan
+	if err := json.Unmarshal([]byte(strings.Join(buffer.Lines, "\n")), &jsonPlan); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&jsonPlan, BuildJSONPlan(query, &ExplainOptions{})) {
+		t.Fatalf("unexpected json:\n%s", strings.Join(buffer.Lines, "\n"))
+	}
+	var scan *JSONNode
+	for _, node := range jsonPlan.Nodes {
+		if node.Type == "Table Scan" {
+			scan = node
+		}
+	}
+	if scan == nil || scan.Table == nil || scan.Table.Name != "NATION" {
+		t.Fatalf("table scan of nation not found:\n%s", strings.Join(buffer.Lines, "\n"))
+	}
+
+	buffer = NewExplainDataBuffer()
+	NewExplainQueryImpl(query).ExplainPlan(buffer, &ExplainOptions{Format: EXPLAIN_FORMAT_DOT})
+	expected := []string{
+		"digraph plan {",
+		"  node [shape=box];",
+		`  n1 [label="Sort (cost=142.51..142.51 rows=10.00 ndv=10.00 rowsize=29)\lSort Key: NATION.N_NAME DESC\lLimit: 10\l"];`,
+		`  n0 [label="Table Scan on tpch.NATION (cost=0.00..25.00 rows=25.00 ndv=25.00 rowsize=185)\lFilter: NATION.N_NATIONKEY > 0\l"];`,
+		"  n1 -> n0;",
+		"}",
+	}
+	if strings.Join(buffer.Lines, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected dot:\n%s", strings.Join(buffer.Lines, "\n"))
+	}
+	if s := escapeDOTString(`N_NAME LIKE "%\A"`); s != `N_NAME LIKE \"%\\A\"` {
+		t.Fatalf("unexpected escaped string: %s", s)
+	}
+}
+
+// TestExplainTPCH renders the plans of the tpch queries in all formats,
+// the output must be stable since it is diffed by the plan regression tests.
+func TestExplainTPCH(t *testing.T) {
+	_, fn, _, _ := runtime.Caller(0)
+	dir := filepath.Join(filepath.Dir(fn), "..", "tpch")
+	files := []string{"simple.sql"}
+	for qn := 1; qn <= 22; qn++ {
+		files = append(files, fmt.Sprintf("q%d.sql", qn))
+	}
+
+	mock := plan2.NewMockOptimizer()
+	for _, file := range files {
+		data, err := os.ReadFile(filepath.Join(dir, file))
+		if err != nil {
+			t.Fatalf("%s: %v", file, err)
+		}
+		stmts, err := mysql.Parse(string(data))
+		if err != nil {
+			t.Fatalf("%s: %v", file, err)
+		}
+		for i, stmt := range stmts {
+			query, err := plan2.BuildPlan(mock.CurrentContext(), stmt)
+			if err != nil {
+				t.Fatalf("%s #%d: %v", file, i, err)
+			}
+			for _, format := range []ExplainFormat{EXPLAIN_FORMAT_TEXT, EXPLAIN_FORMAT_JSON, EXPLAIN_FORMAT_DOT} {
+				options := &ExplainOptions{Verbose: true, Format: format}
+				lines := explainLines(query, options)
+				if !reflect.DeepEqual(lines, explainLines(query, options)) {
+					t.Fatalf("%s #%d: unstable output of format %d", file, i, format)
+				}
+				switch format {
+				case EXPLAIN_FORMAT_JSON:
+					checkJSONPlan(t, query, lines)
+				case EXPLAIN_FORMAT_DOT:
+					checkDOTPlan(t, query, lines)
+				}
+			}
+		}
+	}
+}
+
+func explainLines(query *plan.Query, options *ExplainOptions) []string {
+	buffer := NewExplainDataBuffer()
+	NewExplainQueryImpl(query).ExplainPlan(buffer, options)
+	return buffer.Lines
+}
+
+func checkJSONPlan(t *testing.T, query *plan.Query, lines []string) {
+	var jsonPlan JSONPlan
+	if err := json.Unmarshal([]byte(strings.Join(lines, "\n")), &jsonPlan); err != nil {
+		t.Fatalf("invalid json: %v\n%s", err, strings.Join(lines, "\n"))
+	}
+	ids := make(map[int32]bool)
+	for _, node := range jsonPlan.Nodes {
+		if ids[node.Id] {
+			t.Fatalf("duplicate node %d", node.Id)
+		}
+		ids[node.Id] = true
+		if node.Type != nodeTypeName(query.Nodes[node.Id]) {
+			t.Fatalf("node %d: unexpected type %s", node.Id, node.Type)
+		}
+	}
+	for _, step := range jsonPlan.Steps {
+		if !ids[step] {
+			t.Fatalf("step %d is not in the nodes", step)
+		}
+	}
+	for _, node := range jsonPlan.Nodes {
+		for _, child := range node.Children {
+			if !ids[child] {
+				t.Fatalf("child %d of node %d is not in the nodes", child, node.Id)
+			}
+		}
+	}
+}
+
+func checkDOTPlan(t *testing.T, query *plan.Query, lines []string) {
+	if len(lines) < 2 || lines[0] != "digraph plan {" || lines[len(lines)-1] != "}" {
+		t.Fatalf("invalid dot:\n%s", strings.Join(lines, "\n"))
+	}
+	nodes, edges := 0, 0
+	for _, line := range lines[1 : len(lines)-1] {
+		if !strings.HasSuffix(line, ";") {
+			t.Fatalf("invalid dot line: %s", line)
+		}
+		if strings.Contains(line, " -> ") {
+			edges++
+		} else if strings.Contains(line, " [label=") {
+			nodes++
+		}
+	}
+	ids := collectPlanNodes(query)
+	children := 0
+	for _, id := range ids {
+		children += len(query.Nodes[id].Children)
+	}
+	if nodes != len(ids) || edges != children {
+		t.Fatalf("expect %d nodes and %d edges, got %d and %d:\n%s", len(ids), children, nodes, edges, strings.Join(lines, "\n"))
+	}
+}
+
+func buildTestPlan(t *testing.T, opt plan2.Optimizer, sql string) *plan.Query {
+	stmts, err := mysql.Parse(sql)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	query, err := plan2.BuildPlan(opt.CurrentContext(), stmts[0])
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+	return query
+}
+
 // Single table query
 func TestSingleTableQuery(t *testing.T) {
 	sqls := []string{
@@ -178,6 +384,8 @@ func runOneStmt(opt plan2.Optimizer, t *testing.T, sql string) error {
 					es.Format = EXPLAIN_FORMAT_TEXT
 				} else if strings.EqualFold(v.Value, "JSON") {
 					es.Format = EXPLAIN_FORMAT_JSON
+				} else if strings.EqualFold(v.Value, "DOT") {
+					es.Format = EXPLAIN_FORMAT_DOT
 				} else {
 					return errors.New(errno.InvalidOptionValue, fmt.Sprintf("unrecognized value for EXPLAIN option \"%s\": \"%s\"", v.Name, v.Value))
 				}
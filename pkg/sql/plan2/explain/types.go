@@ -38,6 +38,67 @@ type ExplainQuery interface {
 	ExplainAnalyze(buffer *ExplainDataBuffer, options *ExplainOptions)
 }
 
+// AnalyzeNode is an operator of the executed pipelines with its runtime statistics,
+// the statistics of the same operator run by parallel pipelines are summed up.
+type AnalyzeNode struct {
+	Name string `json:"name"`
+	// Parallel, the number of pipelines which run the operator.
+	Parallel      int   `json:"parallel"`
+	InputRows     int64 `json:"inputRows"`
+	OutputRows    int64 `json:"outputRows"`
+	InputBatches  int64 `json:"inputBatches"`
+	OutputBatches int64 `json:"outputBatches"`
+	// WallTime and CPUTime are in nanoseconds.
+	WallTime int64 `json:"wallTime"`
+	CPUTime  int64 `json:"cpuTime"`
+	// MemoryPeak and SpillSize are in bytes.
+	MemoryPeak int64          `json:"memoryPeak"`
+	SpillSize  int64          `json:"spillSize"`
+	Children   []*AnalyzeNode `json:"children,omitempty"`
+}
+
+// JSONPlan is the plan rendered by explain with format json,
+// Steps are the ids of the root nodes and Nodes are the nodes reachable from them in pre-order.
+type JSONPlan struct {
+	Steps []int32     `json:"steps"`
+	Nodes []*JSONNode `json:"nodes"`
+}
+
+// JSONNode is a node of JSONPlan, the expressions are rendered in the same way as format text.
+type JSONNode struct {
+	Id             int32          `json:"id"`
+	Type           string         `json:"type"`
+	Table          *JSONTable     `json:"table,omitempty"`
+	Cost           JSONCost       `json:"cost"`
+	JoinType       string         `json:"joinType,omitempty"`
+	Projections    []string       `json:"projections,omitempty"`
+	Filters        []string       `json:"filters,omitempty"`
+	JoinConditions []string       `json:"joinConditions,omitempty"`
+	GroupBy        []string       `json:"groupBy,omitempty"`
+	OrderBy        []*JSONOrderBy `json:"orderBy,omitempty"`
+	Limit          string         `json:"limit,omitempty"`
+	Offset         string         `json:"offset,omitempty"`
+	Children       []int32        `json:"children"`
+}
+
+type JSONTable struct {
+	Db   string `json:"db,omitempty"`
+	Name string `json:"name"`
+}
+
+type JSONCost struct {
+	Card    float64 `json:"card"`
+	Rowsize float64 `json:"rowsize"`
+	Ndv     float64 `json:"ndv"`
+	Start   float64 `json:"start"`
+	Total   float64 `json:"total"`
+}
+
+type JSONOrderBy struct {
+	Expr string `json:"expr"`
+	Flag string `json:"flag"`
+}
+
 type NodeDescribe interface {
 	GetNodeBasicInfo(options *ExplainOptions) string
 	GetExtraInfo(options *ExplainOptions) []string
@@ -126,7 +126,10 @@ var BuiltinFunctions = [...]*FunctionSig{
 	{"CEIL", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANYNUMBER, plan.Type_INT32}, []int8{0, -1}},
 	{"CHR", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR, plan.Type_INT32}, []int8{1}},
 	{"COALESCE", plan.Function_VARARG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANY}, []int8{0}},
+	{"CONCAT", plan.Function_STRICT | plan.Function_VARARG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR}, []int8{0}},
+	{"CONCAT_WS", plan.Function_VARARG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR}, []int8{0, 0}},
 	{"CONTAINS", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_BOOL, plan.Type_VARCHAR}, []int8{1, 1}},
+	{"CONVERT_TZ", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_DATETIME, plan.Type_ANYTIME, plan.Type_VARCHAR}, []int8{1, 2, 2}},
 	{"CORR", plan.Function_AGG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{0, 0}},
 	{"COS", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{0}},
 	{"COT", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{0}},
@@ -136,12 +139,15 @@ var BuiltinFunctions = [...]*FunctionSig{
 	{"COVAR_SAMPLE", plan.Function_AGG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{0, 0}},
 	{"CUME_DIST", plan.Function_WIN, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{}},
 	{"CURRENT_DATE", plan.Function_STABLE, NOPARAMETER_FUNCTION, []plan.Type_TypeId{plan.Type_DATE}, []int8{}},
-	{"CURRENT_TIMESTAMP", plan.Function_STABLE, NOPARAMETER_FUNCTION, []plan.Type_TypeId{plan.Type_TIMESTAMP}, []int8{}},
+	{"CURRENT_TIMESTAMP", plan.Function_STABLE, NOPARAMETER_FUNCTION, []plan.Type_TypeId{plan.Type_DATETIME, plan.Type_INT64}, []int8{-1}},
 
+	{"DATE_ADD", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANYTIME, plan.Type_INTERVAL}, []int8{0, 1}},
+	{"DATE_FORMAT", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR, plan.Type_ANYTIME}, []int8{1, 0}},
 	{"DATE_FROM_PARTS", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_DATE, plan.Type_INT32}, []int8{1, 1, 1}},
 	{"DATE_PART", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_INT32, plan.Type_VARCHAR, plan.Type_ANYTIME}, []int8{1, 2}},
+	{"DATE_SUB", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANYTIME, plan.Type_INTERVAL}, []int8{0, 1}},
 	{"DATEADD", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANYTIME, plan.Type_VARCHAR, plan.Type_INT64}, []int8{1, 2, 0}},
-	{"DATEDIFF", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_INT64, plan.Type_VARCHAR, plan.Type_ANYTIME}, []int8{1, 2, 2}},
+	{"DATEDIFF", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_INT64, plan.Type_ANYTIME}, []int8{1, 1}},
 	{"DENSE_RANK", plan.Function_WIN, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_INT32}, []int8{}},
 
 	{"ENDSWITH", plan.Function_STRICT, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_BOOL, plan.Type_VARCHAR}, []int8{1, 1}},
@@ -149,6 +155,7 @@ var BuiltinFunctions = [...]*FunctionSig{
 
 	{"FIRST_VALUE", plan.Function_AGG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANY}, []int8{0}},
 	{"FLOOR", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANYNUMBER, plan.Type_INT32}, []int8{0, -1}},
+	{"FROM_UNIXTIME", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_DATETIME, plan.Type_INT64, plan.Type_VARCHAR}, []int8{1, -1}},
 
 	{"GREATEST", plan.Function_VARARG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANY}, []int8{0}},
 	{"GROUPING_ID", plan.Function_VARARG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_INT64, plan.Type_ANY}, []int8{-1}},
@@ -157,6 +164,7 @@ var BuiltinFunctions = [...]*FunctionSig{
 	{"HASH_AGG", plan.Function_AGG, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_INT64, plan.Type_ANY}, []int8{1}},
 	{"HEX_DECODE", plan.Function_STRICT, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_VARBINARY, plan.Type_VARCHAR}, []int8{1}},
 	{"HEX_ENCODE", plan.Function_STRICT, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR, plan.Type_VARBINARY}, []int8{1}},
+	{"HOUR", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_UINT8, plan.Type_ANYTIME}, []int8{1}},
 
 	{"IFF", plan.Function_NONE, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_ANY, plan.Type_BOOL}, []int8{1, 0, 0}},
 	{"IFNULL", plan.Function_NONE, IS_NULL_EXPRESSION, []plan.Type_TypeId{plan.Type_ANY}, []int8{0}},
@@ -164,8 +172,20 @@ var BuiltinFunctions = [...]*FunctionSig{
 	{"ILIKE_ALL", plan.Function_STRICT | plan.Function_VARARG, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR}, []int8{0, 0}},
 	{"ILIKE_ANY", plan.Function_STRICT | plan.Function_VARARG, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR}, []int8{0, 0}},
 	{"IN", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_BOOL, plan.Type_ANY, plan.Type_ARRAY}, []int8{1, 2}},
+	{"INSTR", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_INT32, plan.Type_VARCHAR}, []int8{1, 1}},
+
+	{"JSON_ARRAY", plan.Function_VARARG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_JSON, plan.Type_ANY}, []int8{1}},
+	{"JSON_ARRAYAGG", plan.Function_AGG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_JSON, plan.Type_ANY}, []int8{1}},
+	{"JSON_CONTAINS", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_INT64, plan.Type_JSON, plan.Type_VARCHAR}, []int8{1, 1, -1}},
+	{"JSON_EXTRACT", plan.Function_STRICT | plan.Function_VARARG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_JSON, plan.Type_VARCHAR}, []int8{0, 1}},
+	{"JSON_KEYS", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_JSON, plan.Type_VARCHAR}, []int8{0, -1}},
+	{"JSON_LENGTH", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_INT64, plan.Type_JSON, plan.Type_VARCHAR}, []int8{1, -1}},
+	{"JSON_OBJECT", plan.Function_VARARG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_JSON, plan.Type_VARCHAR, plan.Type_ANY}, []int8{1, 2}},
+	{"JSON_OBJECTAGG", plan.Function_AGG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_JSON, plan.Type_VARCHAR, plan.Type_ANY}, []int8{1, 2}},
+	{"JSON_UNQUOTE", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR, plan.Type_JSON}, []int8{1}},
 
 	{"LAG", plan.Function_WIN, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANY, plan.Type_INT32, plan.Type_ANY}, []int8{1, 2}},
+	{"LAST_DAY", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_DATE, plan.Type_ANYTIME}, []int8{1}},
 	{"LAST_VALUE", plan.Function_AGG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANY}, []int8{0}},
 	{"LEAD", plan.Function_WIN, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANY, plan.Type_INT32, plan.Type_ANY}, []int8{1, 2}},
 	{"LEAST", plan.Function_VARARG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANY}, []int8{0}},
@@ -175,6 +195,7 @@ var BuiltinFunctions = [...]*FunctionSig{
 	{"LIKE_ALL", plan.Function_STRICT | plan.Function_VARARG, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR}, []int8{0, 0}},
 	{"LIKE_ANY", plan.Function_STRICT | plan.Function_VARARG, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR}, []int8{0, 0}},
 	{"LN", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{0}},
+	{"LOCATE", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_INT32, plan.Type_VARCHAR, plan.Type_INT32}, []int8{1, 1, -1}},
 	{"LOG", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{0}},
 	{"LOWER", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR}, []int8{0}},
 	{"LPAD", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR, plan.Type_INT32}, []int8{0, 1, 0}},
@@ -183,46 +204,54 @@ var BuiltinFunctions = [...]*FunctionSig{
 	{"MAX", plan.Function_AGG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANY}, []int8{0}},
 	{"MEDIAN", plan.Function_AGG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANY}, []int8{0}},
 	{"MIN", plan.Function_AGG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANY}, []int8{0}},
+	{"MINUTE", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_UINT8, plan.Type_ANYTIME}, []int8{1}},
 	{"MODE", plan.Function_AGG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANY}, []int8{0}},
 
 	{"NORMAL", plan.Function_VOLATILE, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{0, 0}},
+	{"NOW", plan.Function_STABLE, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_DATETIME, plan.Type_INT64}, []int8{-1}},
 	{"NTH_VALUE", plan.Function_AGG, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_ANY, plan.Type_INT32}, []int8{0, 1}},
 	{"NTILE", plan.Function_WIN, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_INT32}, []int8{0}},
 	{"NULLIF", plan.Function_WIN, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_ANY}, []int8{0, 0}},
 
 	{"PERCENT_RANK", plan.Function_WIN, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_INT32}, []int8{}},
-	{"POSITION", plan.Function_STRICT, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_INT32, plan.Type_VARCHAR}, []int8{1, 1, 0}},
+	{"POSITION", plan.Function_STRICT, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_INT32, plan.Type_VARCHAR}, []int8{1, 1}},
 	{"POW", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{0, 0}},
 
 	{"RADIAN", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{0}},
 	{"RANDOM", plan.Function_VOLATILE, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{0}},
 	{"RANK", plan.Function_WIN, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_INT32}, []int8{}},
-	{"REGEXP", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_BOOL, plan.Type_VARCHAR}, []int8{1, 1}},
-	{"REGEXP_REPLACE", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR}, []int8{0, 0}},
-	{"REGEXP_SUBSTR", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR}, []int8{0, 0}},
+	{"REGEXP", plan.Function_STRICT, BINARY_LOGICAL_OPERATOR, []plan.Type_TypeId{plan.Type_BOOL, plan.Type_VARCHAR}, []int8{1, 1}},
+	{"REGEXP_LIKE", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_BOOL, plan.Type_VARCHAR}, []int8{1, 1, -1}},
+	{"REGEXP_REPLACE", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR, plan.Type_INT64}, []int8{0, 0, 0, -1, -1, -1}},
+	{"REGEXP_SUBSTR", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR, plan.Type_INT64}, []int8{0, 0, -1, -1, -1}},
 	{"REPEAT", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR, plan.Type_INT32}, []int8{0, 1}},
-	{"REPLACE", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR}, []int8{0, 0}},
+	{"REPLACE", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR}, []int8{0, 0, 0}},
 	{"RIGHT", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR, plan.Type_INT32}, []int8{0, 1}},
 	{"ROUND", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANYNUMBER, plan.Type_INT32}, []int8{0, -1}},
 	{"ROW_NUMBER", plan.Function_WIN, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_INT32}, []int8{}},
 	{"RPAD", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR, plan.Type_INT32}, []int8{0, 1, 0}},
 	{"RTRIM", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR}, []int8{0, 0}},
 
+	{"SECOND", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_UINT8, plan.Type_ANYTIME}, []int8{1}},
 	{"SIGN", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_INT32, plan.Type_ANYNUMBER}, []int8{1}},
 	{"SIN", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{0}},
 	{"SPLIT", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ARRAY, plan.Type_VARCHAR}, []int8{1, 1}},
 	{"STARTSWITH", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_BOOL, plan.Type_VARCHAR}, []int8{1, 1}},
 	{"STDDEV_POP", plan.Function_AGG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{0}},
 	{"STDDEV_SAMPLE", plan.Function_AGG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{0}},
+	{"STR_TO_DATE", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_DATETIME, plan.Type_VARCHAR}, []int8{1, 1}},
+	{"SUBDATE", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANYTIME, plan.Type_INTERVAL}, []int8{0, 1}},
 	{"SUBSTR", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR, plan.Type_INT32}, []int8{0, 0, -1}},
 	{"SUM", plan.Function_AGG, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_ANYNUMBER, plan.Type_ANYNUMBER}, []int8{1}},
 
 	{"TAN", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{0}},
+	{"TIMESTAMPDIFF", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_INT64, plan.Type_VARCHAR, plan.Type_ANYTIME}, []int8{1, 2, 2}},
 	{"TO_INTERVAL", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_INTERVAL, plan.Type_INT32, plan.Type_VARCHAR}, []int8{1, 2}},
-	{"TRANSLATE", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR}, []int8{0, 0}},
+	{"TRANSLATE", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR}, []int8{0, 0, 0}},
 	{"TRIM", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR}, []int8{0, 0}},
 
 	{"UNIFORM", plan.Function_VOLATILE, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{0, 0}},
+	{"UNIX_TIMESTAMP", plan.Function_STABLE, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_INT64, plan.Type_ANYTIME}, []int8{-1}},
 	{"UPPER", plan.Function_STRICT, STANDARD_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR}, []int8{0}},
 
 	{"VAR_POP", plan.Function_AGG, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_FLOAT64}, []int8{0}},
@@ -235,7 +264,7 @@ var BuiltinFunctions = [...]*FunctionSig{
 
 	//add for tpch
 	{"DATE", plan.Function_STRICT, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_DATE, plan.Type_VARCHAR}, []int8{1}},
-	{"INTERVAL", plan.Function_STRICT, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_INTERVAL, plan.Type_VARCHAR}, []int8{1}},
-	{"EXTRACT", plan.Function_STRICT, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_ANYTIME, plan.Type_VARCHAR}, []int8{1, 0}},
+	{"INTERVAL", plan.Function_STRICT, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_INTERVAL, plan.Type_VARCHAR}, []int8{1, -1}},
+	{"EXTRACT", plan.Function_STRICT, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_INT64, plan.Type_VARCHAR, plan.Type_ANYTIME}, []int8{1, 2}},
 	{"SUBSTRING", plan.Function_STRICT, UNKNOW_KIND_FUNCTION, []plan.Type_TypeId{plan.Type_VARCHAR, plan.Type_ANYINT}, []int8{0, 1, 1}},
 }
@@ -16,6 +16,7 @@ package plan2
 
 import (
 	"strings"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
@@ -24,6 +25,7 @@ import (
 type MockCompilerContext struct {
 	objects map[string]*plan.ObjectRef
 	tables  map[string]*plan.TableDef
+	stats   map[string]*TableStats
 }
 
 type col struct {
@@ -164,14 +166,151 @@ func NewMockCompilerContext() *MockCompilerContext {
 	return &MockCompilerContext{
 		objects: objects,
 		tables:  tables,
+		stats:   newMockTpchStats(),
 	}
 }
 
+type colStats struct {
+	Name string
+	Ndv  float64
+	Min  float64
+	Max  float64
+}
+
+//newMockTpchStats returns the statistics of tpch with scale factor 1,
+//the columns without range are the ones with Min == Max.
+func newMockTpchStats() map[string]*TableStats {
+	date := func(s string) float64 {
+		t, _ := time.Parse("2006-01-02", s)
+		return float64(t.Unix() / 86400)
+	}
+	rows := map[string]float64{
+		"NATION":   25,
+		"NATION2":  25,
+		"REGION":   5,
+		"PART":     200000,
+		"SUPPLIER": 10000,
+		"PARTSUPP": 800000,
+		"CUSTOMER": 150000,
+		"ORDERS":   1500000,
+		"LINEITEM": 6001215,
+	}
+	tpchStats := make(map[string][]colStats)
+	tpchStats["NATION"] = []colStats{
+		{"N_NATIONKEY", 25, 0, 24},
+		{"N_NAME", 25, 0, 0},
+		{"N_REGIONKEY", 5, 0, 4},
+		{"N_COMMENT", 25, 0, 0},
+	}
+	tpchStats["NATION2"] = []colStats{
+		{"N_NATIONKEY", 25, 0, 24},
+		{"N_NAME", 25, 0, 0},
+		{"R_REGIONKEY", 5, 0, 4},
+		{"N_COMMENT", 25, 0, 0},
+	}
+	tpchStats["REGION"] = []colStats{
+		{"R_REGIONKEY", 5, 0, 4},
+		{"R_NAME", 5, 0, 0},
+		{"R_COMMENT", 5, 0, 0},
+	}
+	tpchStats["PART"] = []colStats{
+		{"P_PARTKEY", 200000, 1, 200000},
+		{"P_NAME", 199996, 0, 0},
+		{"P_MFGR", 5, 0, 0},
+		{"P_BRAND", 25, 0, 0},
+		{"P_TYPE", 150, 0, 0},
+		{"P_SIZE", 50, 1, 50},
+		{"P_CONTAINER", 40, 0, 0},
+		{"P_RETAILPRICE", 20899, 901, 2098.99},
+		{"P_COMMENT", 131753, 0, 0},
+	}
+	tpchStats["SUPPLIER"] = []colStats{
+		{"S_SUPPKEY", 10000, 1, 10000},
+		{"S_NAME", 10000, 0, 0},
+		{"S_ADDRESS", 10000, 0, 0},
+		{"S_NATIONKEY", 25, 0, 24},
+		{"S_PHONE", 10000, 0, 0},
+		{"S_ACCTBAL", 9955, -999.99, 9999.93},
+		{"S_COMMENT", 10000, 0, 0},
+	}
+	tpchStats["PARTSUPP"] = []colStats{
+		{"PS_PARTKEY", 200000, 1, 200000},
+		{"PS_SUPPKEY", 10000, 1, 10000},
+		{"PS_AVAILQTY", 9999, 1, 9999},
+		{"PS_SUPPLYCOST", 99865, 1, 1000},
+		{"PS_COMMENT", 799124, 0, 0},
+	}
+	tpchStats["CUSTOMER"] = []colStats{
+		{"C_CUSTKEY", 150000, 1, 150000},
+		{"C_NAME", 150000, 0, 0},
+		{"C_ADDRESS", 150000, 0, 0},
+		{"C_NATIONKEY", 25, 0, 24},
+		{"C_PHONE", 150000, 0, 0},
+		{"C_ACCTBAL", 140187, -999.99, 9999.99},
+		{"C_MKTSEGMENT", 5, 0, 0},
+		{"C_COMMENT", 149968, 0, 0},
+	}
+	tpchStats["ORDERS"] = []colStats{
+		{"O_ORDERKEY", 1500000, 1, 6000000},
+		{"O_CUSTKEY", 99996, 1, 149999},
+		{"O_ORDERSTATUS", 3, 0, 0},
+		{"O_TOTALPRICE", 1464556, 857.71, 555285.16},
+		{"O_ORDERDATE", 2406, date("1992-01-01"), date("1998-08-02")},
+		{"O_ORDERPRIORITY", 5, 0, 0},
+		{"O_CLERK", 1000, 0, 0},
+		{"O_SHIPPRIORITY", 1, 0, 0},
+		{"O_COMMENT", 1482071, 0, 0},
+	}
+	tpchStats["LINEITEM"] = []colStats{
+		{"L_ORDERKEY", 1500000, 1, 6000000},
+		{"L_PARTKEY", 200000, 1, 200000},
+		{"L_SUPPKEY", 10000, 1, 10000},
+		{"L_LINENUMBER", 7, 1, 7},
+		{"L_QUANTITY", 50, 1, 50},
+		{"L_EXTENDEDPRICE", 933900, 901, 104949.5},
+		{"L_DISCOUNT", 11, 0, 0.1},
+		{"L_TAX", 9, 0, 0.08},
+		{"L_RETURNFLAG", 3, 0, 0},
+		{"L_LINESTATUS", 2, 0, 0},
+		{"L_SHIPDATE", 2526, date("1992-01-02"), date("1998-12-01")},
+		{"L_COMMITDATE", 2466, date("1992-01-31"), date("1998-10-31")},
+		{"L_RECEIPTDATE", 2554, date("1992-01-03"), date("1998-12-31")},
+		{"L_SHIPINSTRUCT", 4, 0, 0},
+		{"L_SHIPMODE", 7, 0, 0},
+		{"L_COMMENT", 4580667, 0, 0},
+	}
+
+	stats := make(map[string]*TableStats)
+	for tableName, cols := range tpchStats {
+		tableStats := &TableStats{
+			RowCount: rows[tableName],
+			Cols:     make(map[string]*ColumnStats, len(cols)),
+		}
+		for _, col := range cols {
+			tableStats.Cols[col.Name] = &ColumnStats{
+				Ndv:      col.Ndv,
+				Min:      col.Min,
+				Max:      col.Max,
+				HasRange: col.Min != col.Max,
+			}
+		}
+		stats[tableName] = tableStats
+	}
+	return stats
+}
+
 func (m *MockCompilerContext) Resolve(name string) (*plan.ObjectRef, *plan.TableDef) {
 	name = strings.ToUpper(name)
 	return m.objects[name], m.tables[name]
 }
 
+func (m *MockCompilerContext) Stats(obj *ObjectRef) *TableStats {
+	if obj == nil {
+		return nil
+	}
+	return m.stats[strings.ToUpper(obj.ObjName)]
+}
+
 func (m *MockCompilerContext) Cost(obj *ObjectRef, e *Expr) *Cost {
 	c := &Cost{}
 	div := 1.0
@@ -0,0 +1,581 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+const (
+	//defaultSelectivity is the selectivity of the filter which can not be estimated by the statistics
+	defaultSelectivity = 1.0 / 3
+	//defaultEqSelectivity is the selectivity of the equality or like without the statistics
+	defaultEqSelectivity = 0.1
+	//minSelectivity keeps the estimated rows from dropping to zero
+	minSelectivity = 1e-6
+)
+
+type optimizer struct {
+	ctx   CompilerContext
+	query *Query
+	//the nodes keyed by their ids, the ids are not the positions in query.Nodes if there are subqueries
+	nodes map[int32]*plan.Node
+	//the nodes referenced by the correlated columns of the subqueries, their project lists can not be changed
+	corrNodes map[int32]bool
+	done      map[int32]bool
+}
+
+//optimizeQuery reorders the inner joins by the estimated costs, fills the costs of the nodes,
+//and splits the aggregations into the two phases
+func optimizeQuery(ctx CompilerContext, query *Query) {
+	o := newOptimizer(ctx, query)
+	//the parents are after their children in the nodes, so the joins are reordered from their roots
+	for i := len(query.Nodes) - 1; i >= 0; i-- {
+		o.optimize(query.Nodes[i].NodeId)
+	}
+	o.splitAggregations()
+}
+
+func newOptimizer(ctx CompilerContext, query *Query) *optimizer {
+	o := &optimizer{
+		ctx:       ctx,
+		query:     query,
+		nodes:     make(map[int32]*plan.Node, len(query.Nodes)),
+		corrNodes: make(map[int32]bool),
+		done:      make(map[int32]bool),
+	}
+	for _, node := range query.Nodes {
+		o.nodes[node.NodeId] = node
+		walkNodeExprs(node, func(expr *plan.Expr) {
+			if corr, ok := expr.Expr.(*plan.Expr_Corr); ok {
+				o.corrNodes[corr.Corr.NodeId] = true
+			}
+		})
+	}
+	return o
+}
+
+func (o *optimizer) validNode(id int32) bool {
+	return o.nodes[id] != nil
+}
+
+func (o *optimizer) optimize(id int32) {
+	if o.done[id] || !o.validNode(id) {
+		return
+	}
+	o.done[id] = true
+	node := o.nodes[id]
+	if node.NodeType == plan.Node_JOIN && o.reorderJoin(node) {
+		return
+	}
+	for _, child := range node.Children {
+		o.optimize(child)
+	}
+	o.estimate(node)
+}
+
+func (o *optimizer) tableStats(obj *ObjectRef) *TableStats {
+	if obj == nil {
+		return nil
+	}
+	return o.ctx.Stats(obj)
+}
+
+func (o *optimizer) tableRows(obj *ObjectRef) float64 {
+	if stats := o.tableStats(obj); stats != nil {
+		return stats.RowCount
+	}
+	if obj == nil {
+		return 1
+	}
+	return o.ctx.Cost(obj, nil).GetCard()
+}
+
+func (o *optimizer) childCost(node *plan.Node, i int) *Cost {
+	if i < len(node.Children) && o.validNode(node.Children[i]) {
+		if cost := o.nodes[node.Children[i]].Cost; cost != nil {
+			return cost
+		}
+	}
+	return &Cost{}
+}
+
+//estimate fills the cost of the node by the costs of its children,
+//Card is the estimated rows, Total is the estimated rows processed by the node and its children.
+func (o *optimizer) estimate(node *plan.Node) {
+	var card, ndv, start, total float64
+	lookup := o.subtreeColumn(node.NodeId)
+
+	switch node.NodeType {
+	case plan.Node_TABLE_SCAN:
+		rows := o.tableRows(node.ObjRef)
+		card = rows * o.conjunctSelectivity(node.WhereList, o.scanColumn(node, rows))
+		total = rows
+	case plan.Node_VALUE_SCAN:
+		card = 1
+	case plan.Node_JOIN:
+		left, right := o.childCost(node, 0), o.childCost(node, 1)
+		card = left.Card * right.Card * o.conjunctSelectivity(node.OnList, lookup)
+		switch o.joinType(node) &^ plan.Node_NULL_AWARE {
+		case plan.Node_SEMI:
+			//a row of the left side is returned once if it matches
+			card = math.Min(card, left.Card)
+		case plan.Node_ANTI:
+			card = math.Max(left.Card-math.Min(card, left.Card), left.Card*minSelectivity)
+		case plan.Node_MARK, plan.Node_SINGLE:
+			card = left.Card
+		case plan.Node_OUTER:
+			card = math.Max(card, left.Card)
+		}
+		card *= o.conjunctSelectivity(node.WhereList, lookup)
+		//the rows of both sides are read once by the hash join
+		total = left.Total + right.Total + left.Card + right.Card
+	case plan.Node_AGG:
+		input := o.childCost(node, 0)
+		groups := 1.0
+		for _, expr := range node.GroupBy {
+			if stats, rows, ok := o.columnOf(expr, lookup); ok && stats != nil {
+				groups *= effectiveNdv(stats, rows)
+			} else {
+				groups *= math.Max(input.Card/10, 1)
+			}
+		}
+		card = math.Min(groups, math.Max(input.Card, 1)) * o.conjunctSelectivity(node.WhereList, lookup)
+		ndv = card
+		total = input.Total + input.Card
+		start = total
+	case plan.Node_SORT:
+		input := o.childCost(node, 0)
+		card = input.Card
+		total = input.Total + card*math.Log2(card+1)
+		start = total
+	default:
+		if len(node.Children) == 0 {
+			card = 1
+		}
+		for i := range node.Children {
+			input := o.childCost(node, i)
+			card += input.Card
+			total += input.Total
+			start = math.Max(start, input.Start)
+		}
+		card *= o.conjunctSelectivity(node.WhereList, lookup)
+	}
+
+	if limit, ok := constantValue(node.Limit); ok {
+		card = math.Min(card, limit)
+	}
+	card = math.Max(card, 1)
+	if ndv == 0 {
+		ndv = card
+	}
+	node.Cost = &Cost{
+		Card:    card,
+		Ndv:     ndv,
+		Rowsize: rowSize(node.ProjectList),
+		Start:   start,
+		Total:   total,
+	}
+}
+
+//joinType returns the join flag of the right child, the left joins and the joins of the subqueries are flagged on it
+func (o *optimizer) joinType(node *plan.Node) plan.Node_JoinFlag {
+	if len(node.Children) == 2 && o.validNode(node.Children[1]) {
+		return o.nodes[node.Children[1]].JoinType
+	}
+	return plan.Node_INNER
+}
+
+//columnLookup returns the statistics of the column and the rows of the table it belongs to
+type columnLookup func(col *plan.ColRef) (*ColumnStats, float64, bool)
+
+//scanColumn looks up the columns in the project list of the table scan
+func (o *optimizer) scanColumn(node *plan.Node, rows float64) columnLookup {
+	return func(col *plan.ColRef) (*ColumnStats, float64, bool) {
+		for _, expr := range node.ProjectList {
+			if expr.Alias != col.Name {
+				continue
+			}
+			stats := o.tableStats(node.ObjRef)
+			baseCol, ok := expr.Expr.(*plan.Expr_Col)
+			if !ok || stats == nil {
+				return nil, rows, true
+			}
+			return stats.Cols[baseCol.Col.Name], rows, true
+		}
+		//the filters of the table scan refer to the columns of the table by their positions
+		if def := node.TableDef; def != nil && col.ColPos >= 0 && int(col.ColPos) < len(def.Cols) {
+			name := def.Cols[col.ColPos].Name
+			if strings.HasSuffix(col.Name, "."+name) {
+				if stats := o.tableStats(node.ObjRef); stats != nil {
+					return stats.Cols[name], rows, true
+				}
+				return nil, rows, true
+			}
+		}
+		return nil, 0, false
+	}
+}
+
+//subtreeColumn looks up the columns in the table scans under the node
+func (o *optimizer) subtreeColumn(id int32) columnLookup {
+	return func(col *plan.ColRef) (*ColumnStats, float64, bool) {
+		return o.findColumn(id, col)
+	}
+}
+
+func (o *optimizer) findColumn(id int32, col *plan.ColRef) (*ColumnStats, float64, bool) {
+	return o.findColumnIn(id, col, make(map[int32]bool))
+}
+
+func (o *optimizer) findColumnIn(id int32, col *plan.ColRef, visited map[int32]bool) (*ColumnStats, float64, bool) {
+	if visited[id] || !o.validNode(id) {
+		return nil, 0, false
+	}
+	visited[id] = true
+	node := o.nodes[id]
+	if node.NodeType == plan.Node_TABLE_SCAN {
+		rows := o.tableRows(node.ObjRef)
+		if node.Cost != nil {
+			rows = node.Cost.Card
+		}
+		return o.scanColumn(node, rows)(col)
+	}
+	for _, child := range node.Children {
+		if stats, rows, ok := o.findColumnIn(child, col, visited); ok {
+			return stats, rows, true
+		}
+	}
+	return nil, 0, false
+}
+
+func (o *optimizer) columnOf(expr *plan.Expr, lookup columnLookup) (*ColumnStats, float64, bool) {
+	if expr == nil {
+		return nil, 0, false
+	}
+	col, ok := expr.Expr.(*plan.Expr_Col)
+	if !ok {
+		return nil, 0, false
+	}
+	return lookup(col.Col)
+}
+
+//effectiveNdv is the ndv of the column which can not exceed the rows
+func effectiveNdv(stats *ColumnStats, rows float64) float64 {
+	ndv := stats.Ndv
+	if rows > 0 && ndv > rows {
+		ndv = rows
+	}
+	return math.Max(ndv, 1)
+}
+
+func (o *optimizer) conjunctSelectivity(exprs []*plan.Expr, lookup columnLookup) float64 {
+	sel := 1.0
+	for _, expr := range exprs {
+		sel *= o.selectivity(expr, lookup)
+	}
+	return sel
+}
+
+//selectivity estimates the fraction of the rows satisfying the filter
+func (o *optimizer) selectivity(expr *plan.Expr, lookup columnLookup) float64 {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok {
+		return defaultSelectivity
+	}
+	args := f.F.Args
+	var sel float64
+	switch name := strings.ToUpper(f.F.Func.GetObjName()); name {
+	case "AND":
+		if len(args) != 2 {
+			return defaultSelectivity
+		}
+		sel = o.selectivity(args[0], lookup) * o.selectivity(args[1], lookup)
+	case "OR":
+		if len(args) != 2 {
+			return defaultSelectivity
+		}
+		s1, s2 := o.selectivity(args[0], lookup), o.selectivity(args[1], lookup)
+		sel = s1 + s2 - s1*s2
+	case "NOT":
+		if len(args) != 1 {
+			return defaultSelectivity
+		}
+		sel = 1 - o.selectivity(args[0], lookup)
+	case "=", "<>":
+		if len(args) != 2 {
+			return defaultSelectivity
+		}
+		sel = o.eqSelectivity(args[0], args[1], lookup)
+		if name == "<>" {
+			sel = 1 - sel
+		}
+	case "<", "<=", ">", ">=":
+		if len(args) != 2 {
+			return defaultSelectivity
+		}
+		sel = o.rangeSelectivity(name, args[0], args[1], lookup)
+	case "IN":
+		sel = defaultSelectivity
+		if len(args) == 2 {
+			if list, ok := args[1].Expr.(*plan.Expr_List); ok {
+				sel = 0
+				for _, item := range list.List.List {
+					sel += o.eqSelectivity(args[0], item, lookup)
+				}
+			}
+		}
+	case "IFNULL":
+		sel = defaultEqSelectivity
+		if len(args) == 1 {
+			if stats, _, ok := o.columnOf(args[0], lookup); ok && stats != nil {
+				sel = stats.NullFraction
+			}
+		}
+	case "LIKE":
+		sel = defaultEqSelectivity
+	default:
+		sel = defaultSelectivity
+	}
+	return math.Min(math.Max(sel, minSelectivity), 1)
+}
+
+//eqSelectivity estimates the equality of two columns by the larger ndv, and the equality of a column and a value by its ndv
+//or its most common values and histogram if the column is analyzed
+func (o *optimizer) eqSelectivity(left, right *plan.Expr, lookup columnLookup) float64 {
+	if sel, ok := o.valueSelectivity(left, right, lookup); ok {
+		return sel
+	}
+	if sel, ok := o.valueSelectivity(right, left, lookup); ok {
+		return sel
+	}
+	ndv := 0.0
+	for _, expr := range []*plan.Expr{left, right} {
+		if stats, rows, ok := o.columnOf(expr, lookup); ok && stats != nil {
+			ndv = math.Max(ndv, effectiveNdv(stats, rows))
+		}
+	}
+	if ndv == 0 {
+		return defaultEqSelectivity
+	}
+	return 1 / ndv
+}
+
+//rangeSelectivity estimates the comparison of a column and a value by the bounds of the column
+func (o *optimizer) rangeSelectivity(op string, left, right *plan.Expr, lookup columnLookup) float64 {
+	stats, _, ok := o.columnOf(left, lookup)
+	value, isConst := constantValue(right)
+	if !ok {
+		//value op column => column op' value
+		stats, _, ok = o.columnOf(right, lookup)
+		value, isConst = constantValue(left)
+		switch op {
+		case "<":
+			op = ">"
+		case "<=":
+			op = ">="
+		case ">":
+			op = "<"
+		case ">=":
+			op = "<="
+		}
+	}
+	if !ok || !isConst || stats == nil {
+		return defaultSelectivity
+	}
+	if len(stats.Histogram) > 0 {
+		frac := histogramFraction(stats.Histogram, value)
+		if op == ">" || op == ">=" {
+			frac = 1 - stats.NullFraction - frac
+		}
+		return frac
+	}
+	if !stats.HasRange || stats.Max <= stats.Min {
+		return defaultSelectivity
+	}
+	frac := (value - stats.Min) / (stats.Max - stats.Min)
+	if op == ">" || op == ">=" {
+		frac = 1 - frac
+	}
+	return frac
+}
+
+//valueSelectivity estimates the equality of the column and the value by the most common values and the histogram of the column
+func (o *optimizer) valueSelectivity(col, value *plan.Expr, lookup columnLookup) (float64, bool) {
+	stats, rows, ok := o.columnOf(col, lookup)
+	if !ok || stats == nil || len(stats.MCVs) == 0 && len(stats.Histogram) == 0 {
+		return 0, false
+	}
+	var num float64
+	var str string
+	if stats.IsString {
+		str, ok = constantString(value)
+	} else {
+		num, ok = constantValue(value)
+	}
+	if !ok {
+		return 0, false
+	}
+	//the rows not in the most common values are spread over the other values evenly
+	rest := 1 - stats.NullFraction
+	for _, mcv := range stats.MCVs {
+		if stats.IsString && mcv.Str == str || !stats.IsString && mcv.Value == num {
+			return mcv.Frac, true
+		}
+		rest -= mcv.Frac
+	}
+	if !stats.IsString && len(stats.Histogram) > 0 {
+		for _, bucket := range stats.Histogram {
+			if num >= bucket.Lower && num <= bucket.Upper {
+				return bucket.Frac / math.Max(bucket.Ndv, 1), true
+			}
+		}
+		return 0, true
+	}
+	others := effectiveNdv(stats, rows) - float64(len(stats.MCVs))
+	return math.Max(rest, 0) / math.Max(others, 1), true
+}
+
+//histogramFraction returns the fraction of the rows less than the value, the values in a bucket are assumed to be uniform
+func histogramFraction(histogram []*HistogramBucket, value float64) float64 {
+	frac := 0.0
+	for _, bucket := range histogram {
+		if value > bucket.Upper {
+			frac += bucket.Frac
+		} else if value > bucket.Lower {
+			frac += bucket.Frac * (value - bucket.Lower) / (bucket.Upper - bucket.Lower)
+		}
+	}
+	return frac
+}
+
+//constantString returns the string value of the constant
+func constantString(expr *plan.Expr) (string, bool) {
+	if expr == nil {
+		return "", false
+	}
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_C:
+		if v, ok := e.C.Value.(*plan.Const_Sval); ok {
+			return v.Sval, true
+		}
+	case *plan.Expr_F:
+		if strings.EqualFold(e.F.Func.GetObjName(), "CAST") && len(e.F.Args) == 1 {
+			return constantString(e.F.Args[0])
+		}
+	}
+	return "", false
+}
+
+//constantValue returns the numeric value of the constant, dates are converted into the days since 1970-01-01
+func constantValue(expr *plan.Expr) (float64, bool) {
+	if expr == nil {
+		return 0, false
+	}
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_C:
+		switch v := e.C.Value.(type) {
+		case *plan.Const_Ival:
+			return float64(v.Ival), true
+		case *plan.Const_Dval:
+			return v.Dval, true
+		}
+	case *plan.Expr_F:
+		args := e.F.Args
+		if len(args) != 1 {
+			return 0, false
+		}
+		switch strings.ToUpper(e.F.Func.GetObjName()) {
+		case "CAST", "UNARY_PLUS":
+			return constantValue(args[0])
+		case "UNARY_MINUS":
+			v, ok := constantValue(args[0])
+			return -v, ok
+		case "DATE":
+			if c, ok := args[0].Expr.(*plan.Expr_C); ok {
+				if s, ok := c.C.Value.(*plan.Const_Sval); ok {
+					if t, err := time.Parse("2006-01-02", s.Sval); err == nil {
+						return float64(t.Unix() / 86400), true
+					}
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+func rowSize(exprs []*plan.Expr) float64 {
+	size := 0.0
+	for _, expr := range exprs {
+		size += typeSize(expr.Typ)
+	}
+	return size
+}
+
+func typeSize(typ *plan.Type) float64 {
+	if typ == nil {
+		return 8
+	}
+	switch typ.Id {
+	case plan.Type_BOOL, plan.Type_INT8, plan.Type_UINT8:
+		return 1
+	case plan.Type_INT16, plan.Type_UINT16:
+		return 2
+	case plan.Type_INT32, plan.Type_UINT32, plan.Type_FLOAT32, plan.Type_DATE:
+		return 4
+	case plan.Type_INT128, plan.Type_UINT128, plan.Type_DECIMAL128:
+		return 16
+	case plan.Type_CHAR, plan.Type_VARCHAR, plan.Type_BINARY, plan.Type_VARBINARY:
+		if typ.Width > 0 {
+			return float64(typ.Width)
+		}
+	}
+	return 8
+}
+
+//walkExpr calls fn on the expression and its arguments, the subqueries are not walked into
+func walkExpr(expr *plan.Expr, fn func(*plan.Expr)) {
+	if expr == nil {
+		return
+	}
+	fn(expr)
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_F:
+		for _, arg := range e.F.Args {
+			walkExpr(arg, fn)
+		}
+	case *plan.Expr_List:
+		for _, item := range e.List.List {
+			walkExpr(item, fn)
+		}
+	}
+}
+
+func walkNodeExprs(node *plan.Node, fn func(*plan.Expr)) {
+	for _, list := range [][]*plan.Expr{node.ProjectList, node.OnList, node.WhereList, node.GroupBy} {
+		for _, expr := range list {
+			walkExpr(expr, fn)
+		}
+	}
+	for _, orderBy := range node.OrderBy {
+		walkExpr(orderBy.OrderBy, fn)
+	}
+	walkExpr(node.Limit, fn)
+	walkExpr(node.Offset, fn)
+}
@@ -0,0 +1,202 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"google.golang.org/protobuf/proto"
+)
+
+//splitAggregations splits the AGG nodes into the BOTTOM aggregations, which aggregate the rows of every pipeline
+//into the partial groups, and the TOP aggregations, which merge the partial groups of the same group by values.
+//The AGG nodes which can not be split are kept as the FULL aggregations.
+func (o *optimizer) splitAggregations() {
+	for _, node := range o.query.Nodes {
+		if node.NodeType != plan.Node_AGG || node.AggMode != plan.Node_FULL || len(node.ProjectList) == 0 {
+			continue
+		}
+		bottom, ok := o.splitAggregation(node)
+		if !ok {
+			continue
+		}
+		o.estimate(bottom)
+		o.estimate(node)
+	}
+}
+
+//splitAggregation appends the BOTTOM aggregation of the node, and turns the node into the TOP aggregation.
+//The project list of the BOTTOM aggregation is the group by attributes and then the aggregation functions,
+//the TOP aggregation evaluates the same function on the partial result of each aggregation function.
+func (o *optimizer) splitAggregation(node *plan.Node) (*plan.Node, bool) {
+	s := &aggSplitter{groupBy: node.GroupBy}
+	projectList := make([]*plan.Expr, len(node.ProjectList))
+	for i, expr := range node.ProjectList {
+		e, ok := s.rewrite(expr)
+		if !ok {
+			return nil, false
+		}
+		projectList[i] = e
+	}
+	whereList := make([]*plan.Expr, len(node.WhereList))
+	for i, expr := range node.WhereList {
+		e, ok := s.rewrite(expr)
+		if !ok {
+			return nil, false
+		}
+		whereList[i] = e
+	}
+
+	bottom := &plan.Node{
+		NodeType: plan.Node_AGG,
+		NodeId:   int32(len(o.query.Nodes)),
+		Children: node.Children,
+		GroupBy:  node.GroupBy,
+		AggMode:  plan.Node_BOTTOM,
+	}
+	for i, expr := range node.GroupBy {
+		e := proto.Clone(expr).(*plan.Expr)
+		e.Alias = groupByAlias(expr, i)
+		bottom.ProjectList = append(bottom.ProjectList, e)
+	}
+	bottom.ProjectList = append(bottom.ProjectList, s.aggs...)
+	o.query.Nodes = append(o.query.Nodes, bottom)
+	o.nodes[bottom.NodeId] = bottom
+	o.done[bottom.NodeId] = true
+
+	groupBy := make([]*plan.Expr, len(node.GroupBy))
+	for i := range node.GroupBy {
+		groupBy[i] = s.column(i, bottom.ProjectList[i])
+	}
+	node.Children = []int32{bottom.NodeId}
+	node.GroupBy = groupBy
+	node.ProjectList = projectList
+	node.WhereList = whereList
+	node.AggMode = plan.Node_TOP
+	return bottom, true
+}
+
+//aggSplitter rewrites the expressions of an AGG node into the ones of its TOP aggregation
+type aggSplitter struct {
+	groupBy []*plan.Expr
+	//the aggregation functions evaluated by the BOTTOM aggregation
+	aggs []*plan.Expr
+}
+
+func (s *aggSplitter) column(pos int, expr *plan.Expr) *plan.Expr {
+	return &plan.Expr{
+		Typ: expr.Typ,
+		Expr: &plan.Expr_Col{
+			Col: &plan.ColRef{
+				Name:   expr.Alias,
+				ColPos: int32(pos),
+			},
+		},
+	}
+}
+
+//rewrite returns false if the expression refers to a column which is not grouped or has a subquery
+func (s *aggSplitter) rewrite(expr *plan.Expr) (*plan.Expr, bool) {
+	for i, g := range s.groupBy {
+		if sameExpr(expr, g) {
+			e := s.column(i, &plan.Expr{Typ: g.Typ, Alias: groupByAlias(g, i)})
+			e.Alias = expr.Alias
+			return e, true
+		}
+	}
+	switch e := expr.Expr.(type) {
+	case *plan.Expr_C:
+		return expr, true
+	case *plan.Expr_F:
+		if isAggregation(e.F) {
+			pos := len(s.groupBy) + s.aggregation(expr)
+			arg := s.column(pos, s.aggs[pos-len(s.groupBy)])
+			return &plan.Expr{
+				Typ:   expr.Typ,
+				Alias: expr.Alias,
+				Expr: &plan.Expr_F{
+					F: &plan.Function{
+						Func: e.F.Func,
+						Args: []*plan.Expr{arg},
+					},
+				},
+			}, true
+		}
+		args := make([]*plan.Expr, len(e.F.Args))
+		for i, arg := range e.F.Args {
+			a, ok := s.rewrite(arg)
+			if !ok {
+				return nil, false
+			}
+			args[i] = a
+		}
+		return &plan.Expr{
+			Typ:   expr.Typ,
+			Alias: expr.Alias,
+			Expr: &plan.Expr_F{
+				F: &plan.Function{
+					Func: e.F.Func,
+					Args: args,
+				},
+			},
+		}, true
+	}
+	return nil, false
+}
+
+//aggregation returns the position of the aggregation function in the ones of the BOTTOM aggregation
+func (s *aggSplitter) aggregation(expr *plan.Expr) int {
+	for i, agg := range s.aggs {
+		if sameExpr(agg, expr) {
+			return i
+		}
+	}
+	agg := proto.Clone(expr).(*plan.Expr)
+	agg.Alias = fmt.Sprintf("%s#%d", strings.ToLower(expr.GetF().GetFunc().GetObjName()), len(s.aggs))
+	s.aggs = append(s.aggs, agg)
+	return len(s.aggs) - 1
+}
+
+func isAggregation(f *plan.Function) bool {
+	sig, ok := BuiltinFunctionsMap[strings.ToUpper(f.Func.GetObjName())]
+	return ok && sig.Flag&plan.Function_AGG != 0
+}
+
+//groupByAlias is the alias of the group by attribute in the project list of the BOTTOM aggregation,
+//the columns keep their names so that they are still resolved by the names
+func groupByAlias(expr *plan.Expr, i int) string {
+	if col, ok := expr.Expr.(*plan.Expr_Col); ok {
+		return col.Col.Name
+	}
+	if expr.Alias != "" {
+		return expr.Alias
+	}
+	return fmt.Sprintf("group#%d", i)
+}
+
+//sameExpr returns true if the expressions are the same regardless of their aliases
+func sameExpr(a, b *plan.Expr) bool {
+	return proto.Equal(stripAlias(a), stripAlias(b))
+}
+
+func stripAlias(expr *plan.Expr) *plan.Expr {
+	e := proto.Clone(expr).(*plan.Expr)
+	walkExpr(e, func(e *plan.Expr) {
+		e.Alias = ""
+	})
+	return e
+}
@@ -0,0 +1,434 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"math/bits"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+)
+
+const (
+	//maxDPRelations is the max number of the relations reordered by dynamic programming,
+	//the joins of more relations are reordered greedily
+	maxDPRelations = 10
+	//maxJoinRelations is the max number of the relations can be reordered
+	maxJoinRelations = 64
+)
+
+//joinGraph is the inner joins of the relations and the filters on them
+type joinGraph struct {
+	//the node ids of the relations
+	leaves []int32
+	//the estimated rows of the relations
+	cards []float64
+	preds []*joinPred
+	//the estimated rows of the joins of the relation sets
+	cardCache map[uint64]float64
+}
+
+//joinPred is a filter of the joins
+type joinPred struct {
+	expr *plan.Expr
+	//the relations referenced by the filter
+	mask uint64
+	sel  float64
+	//the filter has subqueries or references no relation, it is kept on the top join
+	top bool
+}
+
+//joinTree is a join order of the relations, a leaf if left and right are nil
+type joinTree struct {
+	left  *joinTree
+	right *joinTree
+	leaf  int
+	set   uint64
+	card  float64
+	//the rows read by the joins of the tree
+	cost float64
+}
+
+func (t *joinTree) isLeaf() bool {
+	return t.left == nil
+}
+
+//isInnerJoin returns true if the node joins its two children by inner join
+func (o *optimizer) isInnerJoin(node *plan.Node) bool {
+	if node.NodeType != plan.Node_JOIN || len(node.Children) != 2 {
+		return false
+	}
+	for _, child := range node.Children {
+		if !o.validNode(child) || o.nodes[child].JoinType != plan.Node_INNER {
+			return false
+		}
+	}
+	return true
+}
+
+//collectJoins returns the inner joins under the root and the relations joined by them in the syntactic order
+func (o *optimizer) collectJoins(root *plan.Node) ([]*plan.Node, []int32) {
+	var joins []*plan.Node
+	var leaves []int32
+	visited := make(map[int32]bool)
+	var visit func(node *plan.Node)
+	visit = func(node *plan.Node) {
+		joins = append(joins, node)
+		visited[node.NodeId] = true
+		for _, child := range node.Children {
+			childNode := o.nodes[child]
+			if !visited[child] && !o.done[child] && !o.corrNodes[child] && o.isInnerJoin(childNode) {
+				visit(childNode)
+			} else {
+				leaves = append(leaves, child)
+			}
+		}
+	}
+	visit(root)
+	return joins, leaves
+}
+
+//reorderJoin reorders the inner joins under the root by their estimated costs,
+//the root keeps its output columns so that the nodes above it are not changed.
+//It returns false if the joins are not reordered.
+func (o *optimizer) reorderJoin(root *plan.Node) bool {
+	if !o.isInnerJoin(root) {
+		return false
+	}
+	joins, leaves := o.collectJoins(root)
+	if len(leaves) > maxJoinRelations {
+		return false
+	}
+	for _, leaf := range leaves {
+		o.optimize(leaf)
+	}
+	g, ok := o.buildJoinGraph(root, joins, leaves)
+	if !ok {
+		return false
+	}
+	for _, join := range joins {
+		o.done[join.NodeId] = true
+	}
+	o.rebuildJoins(g, g.enumerate(), root, joins)
+	return true
+}
+
+//buildJoinGraph collects the filters of the joins and pushes the ones on a single table down to the table scan.
+//It returns false if a column of the filters can not be resolved to a relation.
+func (o *optimizer) buildJoinGraph(root *plan.Node, joins []*plan.Node, leaves []int32) (*joinGraph, bool) {
+	//the relation of each output column, -1 if the name is ambiguous
+	relations := make(map[string]int)
+	for i, leaf := range leaves {
+		for _, expr := range o.nodes[leaf].ProjectList {
+			if _, ok := relations[expr.Alias]; ok {
+				relations[expr.Alias] = -1
+			} else {
+				relations[expr.Alias] = i
+			}
+		}
+	}
+
+	var exprs []*plan.Expr
+	for _, join := range joins {
+		exprs = append(exprs, join.OnList...)
+	}
+	exprs = append(exprs, root.WhereList...)
+
+	preds := make([]*joinPred, 0, len(exprs))
+	for _, expr := range exprs {
+		pred := &joinPred{expr: expr}
+		resolved := true
+		walkExpr(expr, func(e *plan.Expr) {
+			switch e := e.Expr.(type) {
+			case *plan.Expr_Col:
+				i, ok := relations[e.Col.Name]
+				if !ok || i < 0 {
+					resolved = false
+					return
+				}
+				pred.mask |= 1 << uint(i)
+			case *plan.Expr_Sub:
+				pred.top = true
+			}
+		})
+		if !resolved {
+			return nil, false
+		}
+		pred.top = pred.top || pred.mask == 0
+		preds = append(preds, pred)
+	}
+
+	g := &joinGraph{
+		leaves:    leaves,
+		cards:     make([]float64, len(leaves)),
+		cardCache: make(map[uint64]float64),
+	}
+	pushed := make(map[int32]bool)
+	for _, pred := range preds {
+		if !pred.top && bits.OnesCount64(pred.mask) == 1 {
+			leaf := o.nodes[leaves[bits.TrailingZeros64(pred.mask)]]
+			if leaf.NodeType == plan.Node_TABLE_SCAN {
+				rewriteColumns(pred.expr, leaf.ProjectList)
+				leaf.WhereList = append(leaf.WhereList, pred.expr)
+				pushed[leaf.NodeId] = true
+				continue
+			}
+		}
+		g.preds = append(g.preds, pred)
+	}
+	for i, leaf := range leaves {
+		if pushed[leaf] {
+			o.estimate(o.nodes[leaf])
+		}
+		g.cards[i] = o.nodes[leaf].Cost.GetCard()
+	}
+	lookup := o.subtreeColumn(root.NodeId)
+	for _, pred := range g.preds {
+		pred.sel = o.selectivity(pred.expr, lookup)
+	}
+	return g, true
+}
+
+//card estimates the rows of the join of the relations in the set
+func (g *joinGraph) card(set uint64) float64 {
+	if card, ok := g.cardCache[set]; ok {
+		return card
+	}
+	card := 1.0
+	for i := range g.leaves {
+		if set&(1<<uint(i)) != 0 {
+			card *= g.cards[i]
+		}
+	}
+	for _, pred := range g.preds {
+		if !pred.top && pred.mask&^set == 0 {
+			card *= pred.sel
+		}
+	}
+	if card < 1 {
+		card = 1
+	}
+	g.cardCache[set] = card
+	return card
+}
+
+//connected returns true if there is a filter joining the two sets of relations
+func (g *joinGraph) connected(left, right uint64) bool {
+	for _, pred := range g.preds {
+		if !pred.top && pred.mask&left != 0 && pred.mask&right != 0 && pred.mask&^(left|right) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *joinGraph) leaf(i int) *joinTree {
+	return &joinTree{
+		leaf: i,
+		set:  1 << uint(i),
+		card: g.cards[i],
+	}
+}
+
+func (g *joinGraph) join(left, right *joinTree) *joinTree {
+	return &joinTree{
+		left:  left,
+		right: right,
+		set:   left.set | right.set,
+		card:  g.card(left.set | right.set),
+		cost:  left.cost + right.cost + left.card + right.card,
+	}
+}
+
+//joinBySize joins the two trees with the smaller one on the right, which is the build side of the hash join
+func (g *joinGraph) joinBySize(left, right *joinTree) *joinTree {
+	if right.card > left.card {
+		left, right = right, left
+	}
+	return g.join(left, right)
+}
+
+//enumerate returns the join order with the least cost
+func (g *joinGraph) enumerate() *joinTree {
+	if len(g.leaves) <= maxDPRelations {
+		return g.dp()
+	}
+	return g.greedy()
+}
+
+//dp enumerates the join orders of all the subsets of the relations, the cross joins are only used
+//if the relations of a subset can not be joined by any filter
+func (g *joinGraph) dp() *joinTree {
+	full := uint64(1)<<uint(len(g.leaves)) - 1
+	best := make([]*joinTree, full+1)
+	//noCross marks the sets whose best trees have no cross joins
+	noCross := make([]bool, full+1)
+	for i := range g.leaves {
+		best[1<<uint(i)] = g.leaf(i)
+		noCross[1<<uint(i)] = true
+	}
+	//the subsets of a set are less than it, so they are planned before it
+	for set := uint64(1); set <= full; set++ {
+		if bits.OnesCount64(set) < 2 {
+			continue
+		}
+		var bestConnected, bestCross *joinTree
+		for sub := (set - 1) & set; sub > 0; sub = (sub - 1) & set {
+			other := set ^ sub
+			if sub < other {
+				continue
+			}
+			t := g.joinBySize(best[sub], best[other])
+			if noCross[sub] && noCross[other] && g.connected(sub, other) {
+				if bestConnected == nil || t.cost < bestConnected.cost {
+					bestConnected = t
+				}
+			} else if bestCross == nil || t.cost < bestCross.cost {
+				bestCross = t
+			}
+		}
+		if bestConnected != nil {
+			best[set] = bestConnected
+			noCross[set] = true
+		} else {
+			best[set] = bestCross
+		}
+	}
+	return best[full]
+}
+
+//greedy joins the two trees with the least rows repeatedly, the ones joined by filters are preferred
+func (g *joinGraph) greedy() *joinTree {
+	trees := make([]*joinTree, len(g.leaves))
+	for i := range g.leaves {
+		trees[i] = g.leaf(i)
+	}
+	for len(trees) > 1 {
+		var best *joinTree
+		var bestConnected bool
+		var bi, bj int
+		for i := 0; i < len(trees); i++ {
+			for j := i + 1; j < len(trees); j++ {
+				connected := g.connected(trees[i].set, trees[j].set)
+				if best != nil && (bestConnected && !connected ||
+					bestConnected == connected && g.card(trees[i].set|trees[j].set) >= best.card) {
+					continue
+				}
+				best, bestConnected, bi, bj = g.joinBySize(trees[i], trees[j]), connected, i, j
+			}
+		}
+		trees[bi] = best
+		trees = append(trees[:bj], trees[bj+1:]...)
+	}
+	return trees[0]
+}
+
+//rebuildJoins replaces the joins by the join order, each filter is placed on the lowest join which has all its relations
+func (o *optimizer) rebuildJoins(g *joinGraph, tree *joinTree, root *plan.Node, joins []*plan.Node) {
+	filters := make(map[*joinTree][]*plan.Expr)
+	var topFilters []*plan.Expr
+	for _, pred := range g.preds {
+		if pred.top {
+			topFilters = append(topFilters, pred.expr)
+			continue
+		}
+		t := tree
+		for {
+			if !t.left.isLeaf() && pred.mask&^t.left.set == 0 {
+				t = t.left
+			} else if !t.right.isLeaf() && pred.mask&^t.right.set == 0 {
+				t = t.right
+			} else {
+				break
+			}
+		}
+		filters[t] = append(filters[t], pred.expr)
+	}
+
+	pool := make([]*plan.Node, 0, len(joins))
+	for _, join := range joins {
+		if join != root {
+			pool = append(pool, join)
+		}
+	}
+	var build func(t *joinTree, node *plan.Node) int32
+	build = func(t *joinTree, node *plan.Node) int32 {
+		if t.isLeaf() {
+			return g.leaves[t.leaf]
+		}
+		if node == nil {
+			node, pool = pool[0], pool[1:]
+		}
+		left := o.nodes[build(t.left, nil)]
+		right := o.nodes[build(t.right, nil)]
+		left.JoinType = plan.Node_INNER
+		right.JoinType = plan.Node_INNER
+		node.Children = []int32{left.NodeId, right.NodeId}
+		if node == root {
+			remapJoinProjectList(node, left, right)
+			node.WhereList = topFilters
+		} else {
+			fillJoinProjectList(node, left, right)
+			node.WhereList = nil
+		}
+		node.OnList = filters[t]
+		for _, expr := range node.OnList {
+			rewriteColumns(expr, node.ProjectList)
+		}
+		for _, expr := range node.WhereList {
+			rewriteColumns(expr, node.ProjectList)
+		}
+		o.estimate(node)
+		return node.NodeId
+	}
+	build(tree, root)
+}
+
+//remapJoinProjectList points the output columns of the join to its new children, the order of the columns is kept
+func remapJoinProjectList(node *plan.Node, left *plan.Node, right *plan.Node) {
+	for _, expr := range node.ProjectList {
+		col, ok := expr.Expr.(*plan.Expr_Col)
+		if !ok {
+			continue
+		}
+		for relPos, child := range []*plan.Node{left, right} {
+			if colPos := aliasIndex(child.ProjectList, col.Col.Name); colPos >= 0 {
+				col.Col.RelPos = int32(relPos)
+				col.Col.ColPos = colPos
+				break
+			}
+		}
+	}
+}
+
+//rewriteColumns points the columns of the expression to the project list of the node evaluating it
+func rewriteColumns(expr *plan.Expr, projectList []*plan.Expr) {
+	walkExpr(expr, func(e *plan.Expr) {
+		if col, ok := e.Expr.(*plan.Expr_Col); ok {
+			if colPos := aliasIndex(projectList, col.Col.Name); colPos >= 0 {
+				col.Col.RelPos = 0
+				col.Col.ColPos = colPos
+			}
+		}
+	})
+}
+
+func aliasIndex(exprs []*plan.Expr, alias string) int32 {
+	for i, expr := range exprs {
+		if expr.Alias == alias {
+			return int32(i)
+		}
+	}
+	return -1
+}
@@ -0,0 +1,287 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan2
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/dialect"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+//Test_TPCH_JoinOrder checks the join orders of the tpch queries against their syntactic orders
+func Test_TPCH_JoinOrder(t *testing.T) {
+	_, fn, _, _ := runtime.Caller(0)
+	dir := filepath.Dir(fn)
+	mock := NewMockOptimizer()
+
+	for qn := 1; qn <= 22; qn++ {
+		qnf, err := os.ReadFile(fmt.Sprintf("%s/tpch/q%d.sql", dir, qn))
+		if err != nil {
+			t.Fatalf("Cannot open file of query %d, error %v", qn, err)
+		}
+		stmts, err := parsers.Parse(dialect.MYSQL, string(qnf))
+		if err != nil {
+			t.Fatalf("Query %d Parser failed, error %v", qn, err)
+		}
+		for _, stmt := range stmts {
+			checkJoinOrder(t, mock.CurrentContext(), qn, stmt)
+		}
+	}
+}
+
+func checkJoinOrder(t *testing.T, ctx CompilerContext, qn int, stmt tree.Statement) {
+	syntactic := &Query{}
+	if err := buildStatement(stmt, ctx, syntactic); err != nil {
+		t.Fatalf("query %d: %v", qn, err)
+	}
+	scans, filters := countScansAndFilters(syntactic)
+
+	//the join graphs are built from the syntactic plan to compare the orders
+	o := newOptimizer(ctx, syntactic)
+	for i := len(syntactic.Nodes) - 1; i >= 0; i-- {
+		root := syntactic.Nodes[i]
+		if o.done[root.NodeId] || !o.isInnerJoin(root) {
+			continue
+		}
+		o.done[root.NodeId] = true
+		joins, leaves := o.collectJoins(root)
+		for _, join := range joins {
+			o.done[join.NodeId] = true
+		}
+		for _, leaf := range leaves {
+			o.optimize(leaf)
+		}
+		g, ok := o.buildJoinGraph(root, joins, leaves)
+		if !ok {
+			continue
+		}
+		full := uint64(1)<<uint(len(leaves)) - 1
+		best := g.enumerate()
+		if best.set != full {
+			t.Fatalf("query %d: the join order misses relations: %b", qn, best.set)
+		}
+		syntacticTree := g.leaf(0)
+		for i := 1; i < len(leaves); i++ {
+			syntacticTree = g.join(syntacticTree, g.leaf(i))
+		}
+		if best.cost > syntacticTree.cost {
+			t.Fatalf("query %d: the cost %v of the join order is larger than the syntactic one %v", qn, best.cost, syntacticTree.cost)
+		}
+		if greedy := g.greedy(); best.cost > greedy.cost {
+			t.Fatalf("query %d: the cost %v of the join order is larger than the greedy one %v", qn, best.cost, greedy.cost)
+		}
+		if g.connectedSet(full) {
+			checkNoCrossJoin(t, qn, g, best)
+		}
+	}
+
+	query, err := BuildPlan(ctx, stmt)
+	if err != nil {
+		t.Fatalf("query %d: %v", qn, err)
+	}
+	if s, f := countScansAndFilters((*Query)(query)); s != scans || f != filters {
+		t.Fatalf("query %d: expect %d scans and %d filters, got %d and %d", qn, scans, filters, s, f)
+	}
+	for _, node := range query.Nodes {
+		if node.Cost == nil || node.Cost.Card < 1 {
+			t.Fatalf("query %d: node %d is not estimated", qn, node.NodeId)
+		}
+	}
+}
+
+//connectedSet returns true if the relations in the set are connected by the filters
+func (g *joinGraph) connectedSet(set uint64) bool {
+	reached := set & -set
+	for changed := true; changed; {
+		changed = false
+		for _, pred := range g.preds {
+			if !pred.top && pred.mask&reached != 0 && pred.mask&^set == 0 && pred.mask&^reached != 0 {
+				reached |= pred.mask
+				changed = true
+			}
+		}
+	}
+	return reached == set
+}
+
+func checkNoCrossJoin(t *testing.T, qn int, g *joinGraph, tree *joinTree) {
+	if tree.isLeaf() {
+		return
+	}
+	if !g.connected(tree.left.set, tree.right.set) {
+		t.Fatalf("query %d: cross join of %b and %b", qn, tree.left.set, tree.right.set)
+	}
+	checkNoCrossJoin(t, qn, g, tree.left)
+	checkNoCrossJoin(t, qn, g, tree.right)
+}
+
+func countScansAndFilters(query *Query) (int, int) {
+	scans, filters := 0, 0
+	for _, node := range query.Nodes {
+		if node.NodeType == plan.Node_TABLE_SCAN {
+			scans++
+		}
+		filters += len(node.OnList) + len(node.WhereList)
+	}
+	return scans, filters
+}
+
+//TestGreedyJoinOrder joins more relations than dynamic programming handles
+func TestGreedyJoinOrder(t *testing.T) {
+	n := maxDPRelations + 2
+	tables := make([]string, n)
+	filters := make([]string, n-1)
+	for i := 0; i < n; i++ {
+		tables[i] = fmt.Sprintf("NATION n%d", i)
+		if i > 0 {
+			filters[i-1] = fmt.Sprintf("n%d.N_REGIONKEY = n%d.N_NATIONKEY", i-1, i)
+		}
+	}
+	sql := fmt.Sprintf("SELECT n0.N_NAME FROM %s, REGION WHERE %s AND n%d.N_REGIONKEY = R_REGIONKEY AND R_NAME = 'ASIA'",
+		strings.Join(tables, ", "), strings.Join(filters, " AND "), n-1)
+	stmt, err := parsers.ParseOne(dialect.MYSQL, sql)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkJoinOrder(t, NewMockOptimizer().CurrentContext(), 0, stmt)
+
+	query, err := BuildPlan(NewMockOptimizer().CurrentContext(), stmt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	joins := 0
+	for _, node := range query.Nodes {
+		if node.NodeType == plan.Node_JOIN {
+			joins++
+			if len(node.OnList) == 0 {
+				t.Fatalf("join %d has no condition", node.NodeId)
+			}
+		}
+	}
+	if joins != n {
+		t.Fatalf("expect %d joins, got %d", n, joins)
+	}
+}
+
+//TestAnalyzedSelectivity estimates the filters by the most common values and the histograms
+func TestAnalyzedSelectivity(t *testing.T) {
+	ctx := NewMockCompilerContext()
+	orders := ctx.stats["ORDERS"]
+	status := orders.Cols["O_ORDERSTATUS"]
+	status.IsString = true
+	status.MCVs = []*MostCommonValue{{Str: "F", Frac: 0.49}, {Str: "O", Frac: 0.49}, {Str: "P", Frac: 0.02}}
+	price := orders.Cols["O_TOTALPRICE"]
+	price.Histogram = []*HistogramBucket{
+		{Lower: 0, Upper: 100000, Frac: 0.5, Ndv: 500000},
+		{Lower: 100000, Upper: 500000, Frac: 0.5, Ndv: 900000},
+	}
+
+	kases := []struct {
+		sql  string
+		rows float64
+	}{
+		{"SELECT O_ORDERKEY FROM ORDERS WHERE O_ORDERSTATUS = 'P'", 30000},
+		{"SELECT O_ORDERKEY FROM ORDERS WHERE O_ORDERSTATUS IN ('F', 'P')", 765000},
+		{"SELECT O_ORDERKEY FROM ORDERS WHERE O_TOTALPRICE < 50000", 375000},
+		{"SELECT O_ORDERKEY FROM ORDERS WHERE O_TOTALPRICE > 300000", 375000},
+		{"SELECT O_ORDERKEY FROM ORDERS WHERE O_TOTALPRICE = 600000", 1.5},
+	}
+	for _, kase := range kases {
+		stmt, err := parsers.ParseOne(dialect.MYSQL, kase.sql)
+		if err != nil {
+			t.Fatalf("%s: %v", kase.sql, err)
+		}
+		query, err := BuildPlan(ctx, stmt)
+		if err != nil {
+			t.Fatalf("%s: %v", kase.sql, err)
+		}
+		for _, node := range query.Nodes {
+			if node.NodeType != plan.Node_TABLE_SCAN {
+				continue
+			}
+			if math.Abs(node.Cost.Card-kase.rows) > 1e-6*kase.rows {
+				t.Fatalf("%s: expect %v rows, got %v", kase.sql, kase.rows, node.Cost.Card)
+			}
+		}
+	}
+}
+
+//TestSplitAggregation splits the aggregations into the BOTTOM ones of the partial groups and the TOP ones merging them
+func TestSplitAggregation(t *testing.T) {
+	kases := []struct {
+		sql   string
+		split bool
+		//the aggregation functions of the BOTTOM aggregation
+		aggs int
+	}{
+		{"SELECT N_REGIONKEY, COUNT(*), SUM(N_NATIONKEY) FROM NATION GROUP BY N_REGIONKEY", true, 2},
+		{"SELECT N_REGIONKEY, SUM(N_NATIONKEY) + 1 FROM NATION GROUP BY N_REGIONKEY HAVING SUM(N_NATIONKEY) > 10", true, 1},
+		{"SELECT DISTINCT N_NAME FROM NATION", true, 0},
+		//N_NAME is not grouped
+		{"SELECT N_NAME, SUM(N_NATIONKEY) FROM NATION GROUP BY N_REGIONKEY", false, 0},
+	}
+	for _, kase := range kases {
+		stmt, err := parsers.ParseOne(dialect.MYSQL, kase.sql)
+		if err != nil {
+			t.Fatalf("%s: %v", kase.sql, err)
+		}
+		query, err := BuildPlan(NewMockOptimizer().CurrentContext(), stmt)
+		if err != nil {
+			t.Fatalf("%s: %v", kase.sql, err)
+		}
+		top := query.Nodes[query.Steps[len(query.Steps)-1]]
+		if top.NodeType != plan.Node_AGG {
+			t.Fatalf("%s: the root is not an aggregation", kase.sql)
+		}
+		if !kase.split {
+			if top.AggMode != plan.Node_FULL || len(query.Nodes) != 2 {
+				t.Fatalf("%s: the aggregation should not be split", kase.sql)
+			}
+			continue
+		}
+		if top.AggMode != plan.Node_TOP || len(top.Children) != 1 {
+			t.Fatalf("%s: expect the TOP aggregation, got %v", kase.sql, top.AggMode)
+		}
+		bottom := query.Nodes[top.Children[0]]
+		if bottom.NodeId != top.Children[0] || bottom.NodeType != plan.Node_AGG || bottom.AggMode != plan.Node_BOTTOM {
+			t.Fatalf("%s: expect the BOTTOM aggregation under the TOP one", kase.sql)
+		}
+		if bottom.Children[0] != 0 || bottom.Cost == nil {
+			t.Fatalf("%s: the BOTTOM aggregation should read the scan and be estimated", kase.sql)
+		}
+		if n := len(bottom.ProjectList) - len(bottom.GroupBy); n != kase.aggs {
+			t.Fatalf("%s: expect %d aggregation functions in the BOTTOM aggregation, got %d", kase.sql, kase.aggs, n)
+		}
+		//the TOP aggregation only refers to the output of the BOTTOM one
+		for _, list := range [][]*plan.Expr{top.GroupBy, top.ProjectList, top.WhereList} {
+			for _, expr := range list {
+				walkExpr(expr, func(e *plan.Expr) {
+					if col, ok := e.Expr.(*plan.Expr_Col); ok && int(col.Col.ColPos) >= len(bottom.ProjectList) {
+						t.Fatalf("%s: column %v is out of the BOTTOM aggregation", kase.sql, col.Col.Name)
+					}
+				})
+			}
+		}
+	}
+}
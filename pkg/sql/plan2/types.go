@@ -31,6 +31,47 @@ type Query = plan.Query
 type CompilerContext interface {
 	Resolve(name string) (*ObjectRef, *TableDef)
 	Cost(obj *ObjectRef, e *Expr) *Cost //change Cost to *Cost to fixed "return copies lock value" warning in new proto code generated
+	//Stats returns the statistics of the table, nil if the table has no statistics
+	Stats(obj *ObjectRef) *TableStats
+}
+
+//TableStats is the statistics of a table used by the cost based optimization
+type TableStats struct {
+	RowCount float64
+	//the statistics of the columns, keyed by the column name
+	Cols map[string]*ColumnStats
+}
+
+//ColumnStats is the statistics of a column
+type ColumnStats struct {
+	Ndv          float64
+	NullFraction float64
+	//the bounds of the column from the zonemaps, dates are the days since 1970-01-01.
+	//only valid if HasRange is true
+	Min      float64
+	Max      float64
+	HasRange bool
+	//the values are strings, they are matched with the Str of the MCVs
+	IsString bool
+	//the equi-depth histogram of the values in the ascending order, nil if the column is not analyzed
+	Histogram []*HistogramBucket
+	//the most common values of the column
+	MCVs []*MostCommonValue
+}
+
+//HistogramBucket is the values in [Lower, Upper], Frac is the fraction of the rows of the table in it
+type HistogramBucket struct {
+	Lower float64
+	Upper float64
+	Frac  float64
+	Ndv   float64
+}
+
+//MostCommonValue is a frequent value of the column and the fraction of the rows of the table it has
+type MostCommonValue struct {
+	Value float64
+	Str   string
+	Frac  float64
 }
 
 type Optimizer interface {
@@ -50,4 +91,10 @@ type SelectContext struct {
 	subQueryIsScalar     bool
 
 	subQueryParentId []int32
+	//the root nodes of the subqueries, the subqueries in the filters are decorrelated into the joins by them
+	subQueryRoots map[*plan.SubQuery]int32
+
+	//use for build window functions
+	windowAllowed bool
+	windowFuncs   []*windowFunc
 }
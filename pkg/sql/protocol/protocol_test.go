@@ -31,6 +31,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/avg"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/count"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/jsonagg"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/max"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/min"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/starcount"
@@ -759,6 +760,16 @@ func TestRing(t *testing.T) {
 			Vs:  []types.Datetime{6123, 123126, 2323328, 02342342},
 			Typ: types.Type{Oid: types.T(types.T_datetime), Size: 8},
 		},
+		&max.Decimal64Ring{
+			Ns:  []int64{1, 0, 2},
+			Vs:  []types.Decimal64{123, -4567, 89},
+			Typ: types.Type{Oid: types.T_decimal64, Size: 8, Width: 10, Scale: 2},
+		},
+		&max.Decimal128Ring{
+			Ns:  []int64{0, 3},
+			Vs:  []types.Decimal128{{Lo: 123, Hi: 0}, {Lo: -1, Hi: -1}},
+			Typ: types.Type{Oid: types.T_decimal128, Size: 16, Width: 30, Scale: 4},
+		},
 		&min.Int8Ring{
 			Ns:  []int64{123123123, 123123908950, 9089374534},
 			Vs:  []int8{6, 6, 8, 0},
@@ -824,6 +835,16 @@ func TestRing(t *testing.T) {
 			Vs:  []types.Datetime{6123, 123126, 2323328, 02342342},
 			Typ: types.Type{Oid: types.T(types.T_datetime), Size: 8},
 		},
+		&min.Decimal64Ring{
+			Ns:  []int64{1, 0, 2},
+			Vs:  []types.Decimal64{123, -4567, 89},
+			Typ: types.Type{Oid: types.T_decimal64, Size: 8, Width: 10, Scale: 2},
+		},
+		&min.Decimal128Ring{
+			Ns:  []int64{0, 3},
+			Vs:  []types.Decimal128{{Lo: 123, Hi: 0}, {Lo: -1, Hi: -1}},
+			Typ: types.Type{Oid: types.T_decimal128, Size: 16, Width: 30, Scale: 4},
+		},
 		&sum.IntRing{
 			Ns:  []int64{178923123, 123123908950, 9089374534},
 			Vs:  []int64{6123, 123126, 2323328, 02342342},
@@ -839,6 +860,16 @@ func TestRing(t *testing.T) {
 			Vs:  []float64{123.123, 34534.345, 234123.345345},
 			Typ: types.Type{Oid: types.T(types.T_varchar), Size: 24},
 		},
+		&sum.DecimalRing{
+			Ns:  []int64{12, 0, 3},
+			Vs:  []types.Decimal128{types.InitDecimal128(12345), types.InitDecimal128(-678), types.InitDecimal128(0)},
+			Typ: types.Type{Oid: types.T_decimal64, Size: 8, Width: 10, Scale: 2},
+		},
+		&avg.DecimalRing{
+			Ns:  []int64{1, 2, 3},
+			Vs:  []types.Decimal128{types.InitDecimal128(98765), types.InitDecimal128(-4321), types.InitDecimal128(10)},
+			Typ: types.Type{Oid: types.T_decimal128, Size: 16, Width: 20, Scale: 4},
+		},
 		&variance.VarRing{
 			NullCounts: []int64{1, 2, 3},
 			SumX:       []float64{4, 9, 13},
@@ -875,6 +906,12 @@ func TestRing(t *testing.T) {
 			NullCounts: []int64{56784567, 123123908950, 9089374534},
 			Typ:        types.Type{Oid: types.T(types.T_varchar), Size: 24},
 		},
+		&jsonagg.JsonAggRing{
+			IsObject: true,
+			Ns:       []int64{1, 0, 3},
+			Vs:       [][]byte{{1}, nil, {2, 3}},
+			Typ:      types.Type{Oid: types.T(types.T_json), Size: 24},
+		},
 	}
 	for _, r := range ringArray {
 		var buf bytes.Buffer
@@ -1224,6 +1261,26 @@ func TestRing(t *testing.T) {
 					return
 				}
 			}
+		case *jsonagg.JsonAggRing:
+			oriRing := r.(*jsonagg.JsonAggRing)
+			if ExpectRing.IsObject != oriRing.IsObject {
+				t.Errorf("Decode ring IsObject failed")
+				return
+			}
+			// Ns
+			for i, n := range oriRing.Ns {
+				if ExpectRing.Ns[i] != n {
+					t.Errorf("Decode ring Ns failed. \nExpected/Got:\n%v\n%v", n, ExpectRing.Ns[i])
+					return
+				}
+			}
+			// Vs
+			for i, v := range oriRing.Vs {
+				if string(ExpectRing.Vs[i]) != string(v) {
+					t.Errorf("Decode ring Vs failed. \nExpected/Got:\n%v\n%v", v, ExpectRing.Vs[i])
+					return
+				}
+			}
 		case *max.StrRing:
 			oriRing := r.(*max.StrRing)
 			// Ns
@@ -1282,6 +1339,48 @@ func TestRing(t *testing.T) {
 					return
 				}
 			}
+		case *max.Decimal64Ring:
+			oriRing := r.(*max.Decimal64Ring)
+			// Da
+			if string(ExpectRing.Da) != string(encoding.EncodeDecimal64Slice(oriRing.Vs)) {
+				t.Errorf("Decode ring Da failed.")
+				return
+			}
+			// Ns
+			for i, n := range oriRing.Ns {
+				if ExpectRing.Ns[i] != n {
+					t.Errorf("Decode ring Ns failed. \nExpected/Got:\n%v\n%v", n, ExpectRing.Ns[i])
+					return
+				}
+			}
+			// Vs
+			for i, v := range oriRing.Vs {
+				if ExpectRing.Vs[i] != v {
+					t.Errorf("Decode ring Vs failed. \nExpected/Got:\n%v\n%v", v, ExpectRing.Vs[i])
+					return
+				}
+			}
+		case *max.Decimal128Ring:
+			oriRing := r.(*max.Decimal128Ring)
+			// Da
+			if string(ExpectRing.Da) != string(encoding.EncodeDecimal128Slice(oriRing.Vs)) {
+				t.Errorf("Decode ring Da failed.")
+				return
+			}
+			// Ns
+			for i, n := range oriRing.Ns {
+				if ExpectRing.Ns[i] != n {
+					t.Errorf("Decode ring Ns failed. \nExpected/Got:\n%v\n%v", n, ExpectRing.Ns[i])
+					return
+				}
+			}
+			// Vs
+			for i, v := range oriRing.Vs {
+				if ExpectRing.Vs[i] != v {
+					t.Errorf("Decode ring Vs failed. \nExpected/Got:\n%v\n%v", v, ExpectRing.Vs[i])
+					return
+				}
+			}
 		case *min.Int8Ring:
 			oriRing := r.(*min.Int8Ring)
 			// Da
@@ -1550,6 +1649,48 @@ func TestRing(t *testing.T) {
 					return
 				}
 			}
+		case *min.Decimal64Ring:
+			oriRing := r.(*min.Decimal64Ring)
+			// Da
+			if string(ExpectRing.Da) != string(encoding.EncodeDecimal64Slice(oriRing.Vs)) {
+				t.Errorf("Decode ring Da failed.")
+				return
+			}
+			// Ns
+			for i, n := range oriRing.Ns {
+				if ExpectRing.Ns[i] != n {
+					t.Errorf("Decode ring Ns failed. \nExpected/Got:\n%v\n%v", n, ExpectRing.Ns[i])
+					return
+				}
+			}
+			// Vs
+			for i, v := range oriRing.Vs {
+				if ExpectRing.Vs[i] != v {
+					t.Errorf("Decode ring Vs failed. \nExpected/Got:\n%v\n%v", v, ExpectRing.Vs[i])
+					return
+				}
+			}
+		case *min.Decimal128Ring:
+			oriRing := r.(*min.Decimal128Ring)
+			// Da
+			if string(ExpectRing.Da) != string(encoding.EncodeDecimal128Slice(oriRing.Vs)) {
+				t.Errorf("Decode ring Da failed.")
+				return
+			}
+			// Ns
+			for i, n := range oriRing.Ns {
+				if ExpectRing.Ns[i] != n {
+					t.Errorf("Decode ring Ns failed. \nExpected/Got:\n%v\n%v", n, ExpectRing.Ns[i])
+					return
+				}
+			}
+			// Vs
+			for i, v := range oriRing.Vs {
+				if ExpectRing.Vs[i] != v {
+					t.Errorf("Decode ring Vs failed. \nExpected/Got:\n%v\n%v", v, ExpectRing.Vs[i])
+					return
+				}
+			}
 		case *sum.IntRing:
 			oriRing := r.(*sum.IntRing)
 			// Da
@@ -1571,6 +1712,48 @@ func TestRing(t *testing.T) {
 					return
 				}
 			}
+		case *sum.DecimalRing:
+			oriRing := r.(*sum.DecimalRing)
+			// Da
+			if string(ExpectRing.Da) != string(encoding.EncodeDecimal128Slice(oriRing.Vs)) {
+				t.Errorf("Decode ring Da failed.")
+				return
+			}
+			// Ns
+			for i, n := range oriRing.Ns {
+				if ExpectRing.Ns[i] != n {
+					t.Errorf("Decode ring Ns failed. \nExpected/Got:\n%v\n%v", n, ExpectRing.Ns[i])
+					return
+				}
+			}
+			// Vs
+			for i, v := range oriRing.Vs {
+				if ExpectRing.Vs[i] != v {
+					t.Errorf("Decode ring Vs failed. \nExpected/Got:\n%v\n%v", v, ExpectRing.Vs[i])
+					return
+				}
+			}
+		case *avg.DecimalRing:
+			oriRing := r.(*avg.DecimalRing)
+			// Da
+			if string(ExpectRing.Da) != string(encoding.EncodeDecimal128Slice(oriRing.Vs)) {
+				t.Errorf("Decode ring Da failed.")
+				return
+			}
+			// Ns
+			for i, n := range oriRing.Ns {
+				if ExpectRing.Ns[i] != n {
+					t.Errorf("Decode ring Ns failed. \nExpected/Got:\n%v\n%v", n, ExpectRing.Ns[i])
+					return
+				}
+			}
+			// Vs
+			for i, v := range oriRing.Vs {
+				if ExpectRing.Vs[i] != v {
+					t.Errorf("Decode ring Vs failed. \nExpected/Got:\n%v\n%v", v, ExpectRing.Vs[i])
+					return
+				}
+			}
 		case *sum.UIntRing:
 			oriRing := r.(*sum.UIntRing)
 			// Da
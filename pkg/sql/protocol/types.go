@@ -81,6 +81,16 @@ const (
 	BitXorRing
 	// StdDevPop
 	StdDevPopRing
+	// JsonArrayAgg, JsonObjectAgg
+	JsonAggRing
+	// Sum, Avg of decimals
+	SumDecimalRing
+	AvgDecimalRing
+	// Max, Min of decimals
+	MaxDecimal64Ring
+	MaxDecimal128Ring
+	MinDecimal64Ring
+	MinDecimal128Ring
 )
 
 // colexec
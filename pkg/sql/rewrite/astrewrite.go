@@ -16,6 +16,7 @@ package rewrite
 
 import (
 	"go/constant"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
 )
@@ -37,6 +38,13 @@ var logicalComparisonOps = map[tree.ComparisonOp]struct{}{
 	tree.NOT_IN:           {},
 	tree.LIKE:             {},
 	tree.NOT_LIKE:         {},
+	tree.REG_MATCH:        {},
+	tree.NOT_REG_MATCH:    {},
+}
+
+// logicalFunctions are the functions whose result is a logical expression already
+var logicalFunctions = map[string]struct{}{
+	"regexp_like": {},
 }
 
 // AstRewrite do sql rewrite before plan build.
@@ -105,6 +113,9 @@ func rewriteFilterCondition(expr tree.Expr) tree.Expr {
 		if notExpr, ok := t.Expr.(*tree.NotExpr); ok {
 			return tree.NewNotExpr(rewriteFilterCondition(notExpr))
 		}
+		if funcExpr, ok := t.Expr.(*tree.FuncExpr); ok && isLogicalFunction(funcExpr) {
+			return t
+		}
 		return tree.NewComparisonExpr(tree.EQUAL, t.Expr, tree.NewNumVal(constant.MakeInt64(0), "0", false))
 	// rewrite to != 0
 	case *tree.UnresolvedName, *tree.NumVal, *tree.CastExpr, *tree.UnaryExpr:
@@ -152,6 +163,15 @@ func isLogicalBinaryOp(op tree.BinaryOp) bool {
 	return ok
 }
 
+func isLogicalFunction(expr *tree.FuncExpr) bool {
+	name, ok := expr.Func.FunctionReference.(*tree.UnresolvedName)
+	if !ok {
+		return false
+	}
+	_, ok = logicalFunctions[strings.ToLower(name.Parts[0])]
+	return ok
+}
+
 func isLogicalComparisonOp(op tree.ComparisonOp) bool {
 	_, ok := logicalComparisonOps[op]
 	return ok
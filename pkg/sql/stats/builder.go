@@ -0,0 +1,368 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+
+	hll "github.com/axiomhq/hyperloglog"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+const (
+	// DefaultSampleSize is the number of the values sampled from each column
+	DefaultSampleSize = 10000
+	// DefaultBuckets is the max number of the buckets of a histogram
+	DefaultBuckets = 32
+	// DefaultMCVs is the max number of the most common values of a column
+	DefaultMCVs = 10
+
+	// the days and the seconds from 0001-01-01 to 1970-01-01
+	unixEpochDays = 719162
+	unixEpochSecs = unixEpochDays * 86400
+)
+
+// value is a sampled value, str is set for the string columns and num for the others
+type value struct {
+	num float64
+	str string
+}
+
+/*
+Builder builds the statistics of the columns from all the rows of a table.
+Every value is inserted into the HyperLogLog sketch of its column, and a fixed
+number of them are sampled by the reservoir sampling for the histogram and the
+most common values.
+*/
+type Builder struct {
+	SampleSize int
+	Buckets    int
+	MCVs       int
+
+	rows float64
+	cols []*columnBuilder
+	rand *rand.Rand
+}
+
+type columnBuilder struct {
+	name string
+	typ  types.Type
+	// nil if the values of the type are not analyzed
+	sketch *hll.Sketch
+	nulls  float64
+	// the number of the non-null values
+	seen     float64
+	sample   []value
+	min, max value
+	// buffer of the values of the batch
+	values []value
+}
+
+func NewBuilder(attrs []engine.Attribute) *Builder {
+	b := &Builder{
+		SampleSize: DefaultSampleSize,
+		Buckets:    DefaultBuckets,
+		MCVs:       DefaultMCVs,
+		cols:       make([]*columnBuilder, len(attrs)),
+		rand:       rand.New(rand.NewSource(1)),
+	}
+	for i, attr := range attrs {
+		b.cols[i] = &columnBuilder{name: attr.Name, typ: attr.Type}
+		if approxcd.Supported(attr.Type.Oid) {
+			b.cols[i].sketch = hll.New()
+		}
+	}
+	return b
+}
+
+// Add adds the rows of the batch, the vectors are in the order of the attributes of the builder
+func (b *Builder) Add(bat *batch.Batch) {
+	if len(bat.Vecs) == 0 {
+		return
+	}
+	b.rows += float64(vector.Length(bat.Vecs[0]))
+	for i, vec := range bat.Vecs {
+		if i < len(b.cols) {
+			b.cols[i].add(b, vec)
+		}
+	}
+}
+
+// Build returns the statistics of the rows added
+func (b *Builder) Build(db, table string) *TableStats {
+	s := &TableStats{
+		Db:       db,
+		Table:    table,
+		RowCount: b.rows,
+		Columns:  make([]*ColumnStats, len(b.cols)),
+	}
+	for i, c := range b.cols {
+		s.Columns[i] = c.build(b, b.rows)
+	}
+	return s
+}
+
+// Analyze builds the statistics of the columns by reading all the rows of the relation
+func Analyze(rel engine.Relation, db, table string, attrs []engine.Attribute) (*TableStats, error) {
+	b := NewBuilder(attrs)
+	names := make([]string, len(attrs))
+	refCnts := make([]uint64, len(attrs))
+	for i, attr := range attrs {
+		names[i] = attr.Name
+		refCnts[i] = 1
+	}
+	for _, rd := range rel.NewReader(1, nil, nil) {
+		for {
+			bat, err := rd.Read(refCnts, names)
+			if err != nil {
+				return nil, err
+			}
+			if bat == nil {
+				break
+			}
+			b.Add(bat)
+		}
+	}
+	return b.Build(db, table), nil
+}
+
+func isString(oid types.T) bool {
+	return oid == types.T_char || oid == types.T_varchar || oid == types.T_json
+}
+
+func (c *columnBuilder) add(b *Builder, vec *vector.Vector) {
+	if c.sketch == nil {
+		c.nulls += float64(nulls.Length(vec.Nsp))
+		return
+	}
+	approxcd.InsertVector(c.sketch, vec)
+	c.values = appendValues(c.values[:0], vec)
+	c.nulls += float64(vector.Length(vec) - len(c.values))
+	str := isString(c.typ.Oid)
+	for _, v := range c.values {
+		if c.seen == 0 || less(v, c.min, str) {
+			c.min = v
+		}
+		if c.seen == 0 || less(c.max, v, str) {
+			c.max = v
+		}
+		c.seen++
+		if len(c.sample) < b.SampleSize {
+			c.sample = append(c.sample, v)
+		} else if j := b.rand.Int63n(int64(c.seen)); j < int64(b.SampleSize) {
+			c.sample[j] = v
+		}
+	}
+}
+
+// run is a distinct value of the sorted sample and its count
+type run struct {
+	v     value
+	count int
+}
+
+func (c *columnBuilder) build(b *Builder, rows float64) *ColumnStats {
+	str := isString(c.typ.Oid)
+	cs := &ColumnStats{
+		Name:      c.name,
+		Type:      c.typ.String(),
+		NullCount: c.nulls,
+		IsString:  str,
+	}
+	if c.sketch == nil || len(c.sample) == 0 {
+		return cs
+	}
+	cs.Sketch, _ = c.sketch.MarshalBinary()
+	cs.Min, cs.Max = c.render(c.min), c.render(c.max)
+	if !str {
+		cs.MinValue, cs.MaxValue = c.min.num, c.max.num
+	}
+
+	sort.Slice(c.sample, func(i, j int) bool { return less(c.sample[i], c.sample[j], str) })
+	var runs []run
+	for i, v := range c.sample {
+		if i > 0 && !less(c.sample[i-1], v, str) {
+			runs[len(runs)-1].count++
+		} else {
+			runs = append(runs, run{v: v, count: 1})
+		}
+	}
+	cs.Ndv = math.Min(math.Max(float64(c.sketch.Estimate()), float64(len(runs))), c.seen)
+	scale := c.seen / float64(len(c.sample))
+
+	//the values more frequent than the average are the most common ones
+	avg := float64(len(c.sample)) / float64(len(runs))
+	var mcvs []run
+	for _, r := range runs {
+		if r.count > 1 && (len(runs) <= b.MCVs || float64(r.count) > avg*1.25) {
+			mcvs = append(mcvs, r)
+		}
+	}
+	sort.SliceStable(mcvs, func(i, j int) bool { return mcvs[i].count > mcvs[j].count })
+	if len(mcvs) > b.MCVs {
+		mcvs = mcvs[:b.MCVs]
+	}
+	for _, r := range mcvs {
+		cs.MCVs = append(cs.MCVs, &MCV{
+			Value: c.render(r.v),
+			Num:   r.v.num,
+			Count: float64(r.count) * scale,
+		})
+	}
+
+	if !str && b.Buckets > 0 {
+		cs.Histogram = buildHistogram(runs, len(c.sample), b.Buckets, scale, cs.Ndv/float64(len(runs)))
+	}
+	return cs
+}
+
+/*
+buildHistogram splits the sorted runs into the buckets with about the same number of values,
+the values of a run are in the same bucket.
+The counts are scaled to the rows by scale and the ndvs by ndvScale.
+*/
+func buildHistogram(runs []run, n int, buckets int, scale, ndvScale float64) []*Bucket {
+	depth := (n + buckets - 1) / buckets
+	var histogram []*Bucket
+	var cur *Bucket
+	count, distinct := 0, 0
+	for _, r := range runs {
+		if cur == nil {
+			cur = &Bucket{Lower: r.v.num}
+			count, distinct = 0, 0
+		}
+		cur.Upper = r.v.num
+		count += r.count
+		distinct++
+		if count >= depth {
+			cur.Count = float64(count) * scale
+			cur.Ndv = math.Max(float64(distinct)*ndvScale, 1)
+			histogram = append(histogram, cur)
+			cur = nil
+		}
+	}
+	if cur != nil {
+		cur.Count = float64(count) * scale
+		cur.Ndv = math.Max(float64(distinct)*ndvScale, 1)
+		histogram = append(histogram, cur)
+	}
+	return histogram
+}
+
+func less(a, b value, str bool) bool {
+	if str {
+		return a.str < b.str
+	}
+	return a.num < b.num
+}
+
+// render returns the value in the format of the column type
+func (c *columnBuilder) render(v value) string {
+	switch c.typ.Oid {
+	case types.T_char, types.T_varchar, types.T_json:
+		return v.str
+	case types.T_date:
+		return types.Date(int32(v.num) + unixEpochDays).String()
+	case types.T_datetime:
+		return types.Datetime((int64(v.num) + unixEpochSecs) << 20).String()
+	case types.T_float32, types.T_float64:
+		return strconv.FormatFloat(v.num, 'g', -1, 64)
+	}
+	return strconv.FormatFloat(v.num, 'f', -1, 64)
+}
+
+// appendValues appends the non-null values of the vector
+func appendValues(vs []value, vec *vector.Vector) []value {
+	switch col := vec.Col.(type) {
+	case *types.Bytes:
+		for i := range col.Lengths {
+			if !nulls.Contains(vec.Nsp, uint64(i)) {
+				vs = append(vs, value{str: string(col.Get(int64(i)))})
+			}
+		}
+	case []int8:
+		vs = appendNumbers(vs, col, vec.Nsp)
+	case []uint8:
+		vs = appendNumbers(vs, col, vec.Nsp)
+	case []int16:
+		vs = appendNumbers(vs, col, vec.Nsp)
+	case []uint16:
+		vs = appendNumbers(vs, col, vec.Nsp)
+	case []int32:
+		vs = appendNumbers(vs, col, vec.Nsp)
+	case []uint32:
+		vs = appendNumbers(vs, col, vec.Nsp)
+	case []int64:
+		vs = appendNumbers(vs, col, vec.Nsp)
+	case []uint64:
+		vs = appendNumbers(vs, col, vec.Nsp)
+	case []float32:
+		vs = appendNumbers(vs, col, vec.Nsp)
+	case []float64:
+		vs = appendNumbers(vs, col, vec.Nsp)
+	case []types.Date:
+		for i, v := range col {
+			if !nulls.Contains(vec.Nsp, uint64(i)) {
+				vs = append(vs, value{num: float64(int32(v) - unixEpochDays)})
+			}
+		}
+	case []types.Datetime:
+		for i, v := range col {
+			if !nulls.Contains(vec.Nsp, uint64(i)) {
+				vs = append(vs, value{num: float64(int64(v)>>20 - unixEpochSecs)})
+			}
+		}
+	case []types.Decimal64:
+		for i, v := range col {
+			if !nulls.Contains(vec.Nsp, uint64(i)) {
+				vs = append(vs, value{num: types.Decimal64ToFloat64(v, vec.Typ.Scale)})
+			}
+		}
+	case []types.Decimal128:
+		for i, v := range col {
+			if !nulls.Contains(vec.Nsp, uint64(i)) {
+				vs = append(vs, value{num: types.Decimal128ToFloat64(v, vec.Typ.Scale)})
+			}
+		}
+	}
+	return vs
+}
+
+type number interface {
+	~int8 | ~uint8 | ~int16 | ~uint16 | ~int32 | ~uint32 | ~int64 | ~uint64 | ~float32 | ~float64
+}
+
+func appendNumbers[T number](vs []value, col []T, nsp *nulls.Nulls) []value {
+	if !nulls.Any(nsp) {
+		for _, v := range col {
+			vs = append(vs, value{num: float64(v)})
+		}
+		return vs
+	}
+	for i, v := range col {
+		if !nulls.Contains(nsp, uint64(i)) {
+			vs = append(vs, value{num: float64(v)})
+		}
+	}
+	return vs
+}
@@ -0,0 +1,144 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+)
+
+func testAttrs() []engine.Attribute {
+	return []engine.Attribute{
+		{Name: "id", Type: types.Type{Oid: types.T_int64, Size: 8}},
+		{Name: "flag", Type: types.Type{Oid: types.T_varchar, Size: 24, Width: 8}},
+		{Name: "day", Type: types.Type{Oid: types.T_date, Size: 4}},
+	}
+}
+
+//testBatch returns n rows, id is 7 for the odd rows and the row number for the others,
+//flag is 'a', 'b' or 'c', day is null for the rows divisible by 10
+func testBatch(t *testing.T, start, n int) *batch.Batch {
+	attrs := testAttrs()
+	bat := batch.New(true, []string{attrs[0].Name, attrs[1].Name, attrs[2].Name})
+	ids := make([]int64, n)
+	flags := make([][]byte, n)
+	days := make([]types.Date, n)
+	var dayNulls []uint64
+	for i := 0; i < n; i++ {
+		row := start + i
+		ids[i] = int64(row)
+		if row%2 == 1 {
+			ids[i] = 7
+		}
+		flags[i] = []byte{"abc"[row%3]}
+		days[i] = types.FromCalendar(1995, 1, 1) + types.Date(row%365)
+		if row%10 == 0 {
+			dayNulls = append(dayNulls, uint64(i))
+		}
+	}
+	for i, data := range []interface{}{ids, flags, days} {
+		bat.Vecs[i] = vector.New(attrs[i].Type)
+		if err := vector.Append(bat.Vecs[i], data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	nulls.Add(bat.Vecs[2].Nsp, dayNulls...)
+	return bat
+}
+
+func TestBuilder(t *testing.T) {
+	b := NewBuilder(testAttrs())
+	for start := 0; start < 30000; start += 1000 {
+		b.Add(testBatch(t, start, 1000))
+	}
+	s := b.Build("db", "t")
+	if s.RowCount != 30000 || len(s.Columns) != 3 {
+		t.Fatalf("unexpected statistics %+v", s)
+	}
+
+	id := s.Column("ID")
+	if id.NullCount != 0 || id.Min != "0" || id.Max != "29998" || id.IsString {
+		t.Fatalf("unexpected statistics of id %+v", id)
+	}
+	//15000 distinct values and the hyperloglog error
+	if math.Abs(id.Ndv-15001) > 15001*0.05 {
+		t.Fatalf("expect about 15001 distinct ids, got %v", id.Ndv)
+	}
+	if len(id.MCVs) != 1 || id.MCVs[0].Value != "7" || math.Abs(id.MCVs[0].Count-15000) > 15000*0.05 {
+		t.Fatalf("unexpected most common values of id %+v", id.MCVs)
+	}
+	if len(id.Histogram) == 0 || len(id.Histogram) > DefaultBuckets {
+		t.Fatalf("unexpected buckets %d", len(id.Histogram))
+	}
+	count := 0.0
+	for i, bucket := range id.Histogram {
+		if bucket.Lower > bucket.Upper || i > 0 && bucket.Lower <= id.Histogram[i-1].Upper {
+			t.Fatalf("bucket %d is out of order: %+v", i, bucket)
+		}
+		count += bucket.Count
+	}
+	if math.Abs(count-30000) > 1e-6 {
+		t.Fatalf("expect the buckets have 30000 rows, got %v", count)
+	}
+
+	flag := s.Column("flag")
+	if !flag.IsString || flag.Min != "a" || flag.Max != "c" || len(flag.Histogram) != 0 || len(flag.MCVs) != 3 {
+		t.Fatalf("unexpected statistics of flag %+v", flag)
+	}
+	if math.Round(flag.Ndv) != 3 {
+		t.Fatalf("expect 3 distinct flags, got %v", flag.Ndv)
+	}
+
+	day := s.Column("day")
+	if day.NullCount != 3000 || day.Min != "1995-01-01" || day.Max != "1995-12-31" {
+		t.Fatalf("unexpected statistics of day %+v", day)
+	}
+	if day.MinValue != 9131 || day.MaxValue != 9131+364 {
+		t.Fatalf("expect the days since 1970-01-01, got %v and %v", day.MinValue, day.MaxValue)
+	}
+
+	tps := s.PlanStats(&plan2.TableDef{Cols: []*plan.ColDef{{Name: "DAY"}, {Name: "OTHER"}}})
+	if tps.RowCount != 30000 || len(tps.Cols) != 1 || tps.Cols["DAY"] == nil {
+		t.Fatalf("unexpected plan statistics %+v", tps)
+	}
+	ps := tps.Cols["DAY"]
+	if ps.NullFraction != 0.1 || !ps.HasRange || ps.IsString {
+		t.Fatalf("unexpected plan statistics %+v", ps)
+	}
+	frac := 0.0
+	for _, bucket := range ps.Histogram {
+		frac += bucket.Frac
+	}
+	if math.Abs(frac-0.9) > 1e-6 {
+		t.Fatalf("expect the buckets have 0.9 of the rows, got %v", frac)
+	}
+}
+
+func TestSetColumn(t *testing.T) {
+	s := &TableStats{Columns: []*ColumnStats{{Name: "a"}, {Name: "b"}}}
+	s.SetColumn(&ColumnStats{Name: "B", Ndv: 2})
+	s.SetColumn(&ColumnStats{Name: "c", Ndv: 3})
+	if len(s.Columns) != 3 || s.Column("b").Ndv != 2 || s.Column("C").Ndv != 3 {
+		t.Fatalf("unexpected columns %+v", s.Columns)
+	}
+}
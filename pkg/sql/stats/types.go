@@ -0,0 +1,142 @@
+// Copyright 2021 - 2022 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/sql/plan2"
+)
+
+// TableStats is the statistics of a table built by ANALYZE TABLE
+type TableStats struct {
+	Db       string         `json:"db"`
+	Table    string         `json:"table"`
+	RowCount float64        `json:"rowCount"`
+	Columns  []*ColumnStats `json:"columns"`
+}
+
+/*
+ColumnStats is the statistics of a column.
+The counts are the estimated rows of the table, the histogram and the most common
+values are built from the sampled rows and scaled to the table.
+*/
+type ColumnStats struct {
+	Name string `json:"name"`
+	// Type is the name of the column type
+	Type      string  `json:"type"`
+	NullCount float64 `json:"nullCount"`
+	Ndv       float64 `json:"ndv"`
+	// IsString is true if the values are strings, they have no numeric values and histogram
+	IsString bool `json:"isString,omitempty"`
+	// Min and Max are the rendered bounds of the values, empty if all the values are null
+	Min string `json:"min,omitempty"`
+	Max string `json:"max,omitempty"`
+	// MinValue and MaxValue are the numeric bounds, dates are the days since 1970-01-01
+	MinValue  float64   `json:"minValue,omitempty"`
+	MaxValue  float64   `json:"maxValue,omitempty"`
+	Histogram []*Bucket `json:"histogram,omitempty"`
+	MCVs      []*MCV    `json:"mcvs,omitempty"`
+	// Sketch is the marshaled HyperLogLog sketch the ndv is estimated by
+	Sketch []byte `json:"sketch,omitempty"`
+}
+
+// Bucket is a bucket of the equi-depth histogram, it has the values in [Lower, Upper]
+type Bucket struct {
+	Lower float64 `json:"lower"`
+	Upper float64 `json:"upper"`
+	Count float64 `json:"count"`
+	Ndv   float64 `json:"ndv"`
+}
+
+// MCV is one of the most common values of the column
+type MCV struct {
+	// Value is the rendered value, Num is the numeric value of the non-string columns
+	Value string  `json:"value"`
+	Num   float64 `json:"num,omitempty"`
+	Count float64 `json:"count"`
+}
+
+// Column returns the statistics of the column, the name is case-insensitive
+func (s *TableStats) Column(name string) *ColumnStats {
+	for _, col := range s.Columns {
+		if strings.EqualFold(col.Name, name) {
+			return col
+		}
+	}
+	return nil
+}
+
+// SetColumn replaces the statistics of the column or appends it
+func (s *TableStats) SetColumn(col *ColumnStats) {
+	for i, c := range s.Columns {
+		if strings.EqualFold(c.Name, col.Name) {
+			s.Columns[i] = col
+			return
+		}
+	}
+	s.Columns = append(s.Columns, col)
+}
+
+// PlanStats converts the statistics for the optimizer, the columns are keyed by their names in the table definition
+func (s *TableStats) PlanStats(def *plan2.TableDef) *plan2.TableStats {
+	ps := &plan2.TableStats{
+		RowCount: s.RowCount,
+		Cols:     make(map[string]*plan2.ColumnStats),
+	}
+	if def == nil {
+		for _, c := range s.Columns {
+			ps.Cols[c.Name] = c.PlanStats(s.RowCount)
+		}
+		return ps
+	}
+	for _, col := range def.Cols {
+		if c := s.Column(col.Name); c != nil {
+			ps.Cols[col.Name] = c.PlanStats(s.RowCount)
+		}
+	}
+	return ps
+}
+
+// PlanStats converts the statistics into the one the optimizer estimates the selectivity by
+func (c *ColumnStats) PlanStats(rows float64) *plan2.ColumnStats {
+	ps := &plan2.ColumnStats{
+		Ndv:      c.Ndv,
+		Min:      c.MinValue,
+		Max:      c.MaxValue,
+		HasRange: !c.IsString && c.Max != "",
+		IsString: c.IsString,
+	}
+	if rows <= 0 {
+		return ps
+	}
+	ps.NullFraction = c.NullCount / rows
+	for _, b := range c.Histogram {
+		ps.Histogram = append(ps.Histogram, &plan2.HistogramBucket{
+			Lower: b.Lower,
+			Upper: b.Upper,
+			Frac:  b.Count / rows,
+			Ndv:   b.Ndv,
+		})
+	}
+	for _, mcv := range c.MCVs {
+		ps.MCVs = append(ps.MCVs, &plan2.MostCommonValue{
+			Value: mcv.Num,
+			Str:   mcv.Value,
+			Frac:  mcv.Count / rows,
+		})
+	}
+	return ps
+}
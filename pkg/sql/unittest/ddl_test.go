@@ -87,6 +87,8 @@ func TestIndexFunction(t *testing.T) {
 		{sql: "create index index_nameb using bsi on tbl(c);", err: "[42703]unknown column 'c'"},
 		{sql: "create index index_nameb using bsi on tbl(a, b);", err: "[0A000]unsupported index type"},
 		{sql: "drop index noeindex on tbl;", err: "[42602]index doesn't exist"},
+		{sql: "create table jtbl(a int, j json);"},
+		{sql: "create index index_namej on jtbl(j);", err: "[0A000]index on JSON column or JSON path not support now, index a column holding the extracted value instead"},
 	}
 	test(t, testCases)
 }
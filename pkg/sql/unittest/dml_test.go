@@ -510,3 +510,218 @@ func TestAQ(t *testing.T) {
 //	}
 //	test(t, testCases)
 //}
+
+func TestCTE(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table emp (id int, name varchar(20), manager int);"},
+		{sql: "insert into emp values (1, 'ceo', 0), (2, 'cto', 1), (3, 'cfo', 1), (4, 'dev', 2), (5, 'ops', 2), (6, 'intern', 4);"},
+
+		{sql: "with c as (select id, name from emp where manager = 1) select * from c;", res: executeResult{
+			attr: []string{"id", "name"},
+			data: [][]string{
+				{"2", "cto"},
+				{"3", "cfo"},
+			},
+		}},
+
+		{sql: "with c(a, b) as (select id, name from emp where manager = 2) select a, b from c order by a;", res: executeResult{
+			attr: []string{"a", "b"},
+			data: [][]string{
+				{"4", "dev"},
+				{"5", "ops"},
+			},
+		}},
+
+		// the cte hides the table with the same name
+		{sql: "with emp as (select id from emp where manager = 1) select count(*) from emp;", res: executeResult{
+			attr: []string{"count(*)"},
+			data: [][]string{
+				{"2"},
+			},
+		}},
+
+		{sql: "with c(mid) as (select id from emp where manager = 1) select id from emp join c on emp.manager = c.mid order by id;", res: executeResult{
+			attr: []string{"id"},
+			data: [][]string{
+				{"4"},
+				{"5"},
+			},
+		}},
+
+		{sql: "with recursive c(n) as (select id from emp where id = 1 union all select n + 1 from c where n < 5) select n from c order by n;", res: executeResult{
+			attr: []string{"n"},
+			data: [][]string{
+				{"1"},
+				{"2"},
+				{"3"},
+				{"4"},
+				{"5"},
+			},
+		}},
+
+		{sql: "with recursive c(n) as (select id from emp where id = 1 union all select n + 1 from c where n < 5) select count(*), sum(n) from c;", res: executeResult{
+			attr: []string{"count(*)", "sum(n)"},
+			data: [][]string{
+				{"5", "15"},
+			},
+		}},
+
+		{sql: "with recursive t(pid, lvl) as (select id, manager from emp where manager = 0 union all select id, lvl + 1 from emp join t on emp.manager = t.pid) select pid, lvl from t order by pid;", res: executeResult{
+			attr: []string{"pid", "lvl"},
+			data: [][]string{
+				{"1", "0"},
+				{"2", "1"},
+				{"3", "1"},
+				{"4", "2"},
+				{"5", "2"},
+				{"6", "3"},
+			},
+		}},
+
+		{sql: "with recursive c(n) as (select id from emp where id = 1 union select n % 3 + 1 from c) select n from c order by n;", res: executeResult{
+			attr: []string{"n"},
+			data: [][]string{
+				{"1"},
+				{"2"},
+				{"3"},
+			},
+		}},
+
+		{sql: "with recursive m(id, name) as (select e.id, e.name from emp e join emp s on s.manager = e.id union select emp.id, emp.name from emp join m on emp.id = m.id) select id, name from m order by id;", res: executeResult{
+			attr: []string{"id", "name"},
+			data: [][]string{
+				{"1", "ceo"},
+				{"2", "cto"},
+				{"4", "dev"},
+			},
+		}},
+
+		{sql: "with c as (select id from emp), c as (select id from emp) select * from c;", err: "[42712]Not unique table/alias: 'c'"},
+		{sql: "with c(a) as (select id, name from emp) select * from c;", err: "[42P10]In definition of view, derived table or common table expression, SELECT list and column names list have different column counts"},
+		{sql: "with recursive c(n) as (select id from emp where id = 1 union all select n + 1 from c) select n from c;", err: "[54000]Recursive query aborted after 1000 iterations. Try increasing cteMaxRecursionDepth to a larger value"},
+	}
+	test(t, testCases)
+}
+
+func TestWindow(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table sales (id int, region varchar(20), amount int);"},
+		{sql: "insert into sales values (1, 'east', 10), (2, 'east', 30), (3, 'west', 20), (4, 'east', 30), (5, 'west', 5), (6, 'north', null);"},
+
+		{sql: "select id, row_number() over (partition by region order by amount desc, id) as rn from sales order by id;", res: executeResult{
+			attr: []string{"id", "rn"},
+			data: [][]string{
+				{"1", "3"},
+				{"2", "1"},
+				{"3", "1"},
+				{"4", "2"},
+				{"5", "2"},
+				{"6", "1"},
+			},
+		}},
+
+		{sql: "select region, amount, rank() over (partition by region order by amount) as r, sum(amount) over (partition by region order by amount) as s from sales where region = 'east' order by amount, r;", res: executeResult{
+			attr: []string{"region", "amount", "r", "s"},
+			data: [][]string{
+				{"east", "10", "1", "10"},
+				{"east", "30", "2", "70"},
+				{"east", "30", "2", "70"},
+			},
+		}},
+
+		{sql: "select id, lag(amount) over (order by id rows between 1 preceding and 1 following) as prev, count(*) over (order by id rows between 1 preceding and 1 following) as c from sales order by id limit 3;", res: executeResult{
+			attr: []string{"id", "prev", "c"},
+			data: [][]string{
+				{"1", "null", "2"},
+				{"2", "10", "3"},
+				{"3", "30", "3"},
+			},
+		}},
+
+		{sql: "select region, count(*) as n, sum(count(*)) over () as total from sales group by region order by region;", res: executeResult{
+			attr: []string{"region", "n", "total"},
+			data: [][]string{
+				{"east", "3", "6"},
+				{"north", "1", "6"},
+				{"west", "2", "6"},
+			},
+		}},
+
+		{sql: "select id, row_number() over (order by id) from sales order by id desc limit 1;", res: executeResult{
+			attr: []string{"id", "row_number() over (order by id)"},
+			data: [][]string{
+				{"6", "6"},
+			},
+		}},
+
+		{sql: "with big as (select * from sales where amount > 10) select *, dense_rank() over (order by amount) as r from big order by id;", res: executeResult{
+			attr: []string{"id", "region", "amount", "r"},
+			data: [][]string{
+				{"2", "east", "30", "2"},
+				{"3", "west", "20", "1"},
+				{"4", "east", "30", "2"},
+			},
+		}},
+
+		{sql: "select id, row_number() over (order by id) + 1 from sales;", err: "[0A000]window function 'row_number() over (order by id)' is only supported as an item of the select list"},
+		{sql: "select row_number() over (order by id), rank() over (order by amount) from sales;", err: "[0A000]window functions with different windows are not supported now"},
+		{sql: "select ntile(2) over (order by id) from sales;", err: "[0A000]window function 'ntile' is not supported now"},
+	}
+	test(t, testCases)
+}
+
+func TestSpill(t *testing.T) {
+	testCases := []testCase{
+		{sql: "create table store (store_id int, store_area varchar(20), store_type int unsigned, incomes double);"},
+		{sql: "create table input (id int, item_id int unsigned, item_num int, input_cost double);"},
+		{sql: "insert into store values (1, 'shanghai', 0, 2500), (2, 'shanghai', 0, 70000), (3, 'beijing', 1, 10000), (4, 'shenzhen', 1, 0), (5, 'beijing', 2, 10000);"},
+		{sql: "insert into input values (1, 100, 1000, 500), (1, 101, 30, 900), (1, 102, 40, 80), (2, 101, 500, 400), (3, 103, 20, 800), (3, 102, 5, 80), (4, 105, 1005, 2010), (6, 106, 1, 1);"},
+
+		{sql: "select store_id, incomes from store order by incomes desc, store_id;", res: executeResult{
+			attr: []string{"store_id", "incomes"},
+			data: [][]string{
+				{"2", "70000.000000"},
+				{"3", "10000.000000"},
+				{"5", "10000.000000"},
+				{"1", "2500.000000"},
+				{"4", "0.000000"},
+			},
+		}},
+
+		{sql: "select item_id, count(*), sum(item_num) from input group by item_id order by item_id;", res: executeResult{
+			attr: []string{"item_id", "count(*)", "sum(item_num)"},
+			data: [][]string{
+				{"100", "1", "1000"},
+				{"101", "2", "530"},
+				{"102", "2", "45"},
+				{"103", "1", "20"},
+				{"105", "1", "1005"},
+				{"106", "1", "1"},
+			},
+		}},
+
+		{sql: "select store_area, item_id, incomes from store join input on store_id = id order by item_id, incomes;", res: executeResult{
+			attr: []string{"store_area", "item_id", "incomes"},
+			data: [][]string{
+				{"shanghai", "100", "2500.000000"},
+				{"shanghai", "101", "2500.000000"},
+				{"shanghai", "101", "70000.000000"},
+				{"shanghai", "102", "2500.000000"},
+				{"beijing", "102", "10000.000000"},
+				{"beijing", "103", "10000.000000"},
+				{"shenzhen", "105", "0.000000"},
+			},
+		}},
+
+		{sql: "select store_area, sum(incomes) from store join input on store_id = id group by store_area order by store_area;", res: executeResult{
+			attr: []string{"store_area", "sum(incomes)"},
+			data: [][]string{
+				{"beijing", "20000.000000"},
+				{"shanghai", "77500.000000"},
+				{"shenzhen", "0.000000"},
+			},
+		}},
+	}
+	test(t, testCases)
+	testWithMemoryLimit(t, 1, testCases)
+}
@@ -523,12 +523,12 @@ func TestDivOperator(t *testing.T) {
 		{sql: "select i1 / d1, i2 / d1, i3 / d1, i4 / d1, d1 / 1, d1 / 12.34, d1 / d1 from int_decimal;", res: executeResult{
 			null: false,
 			attr: []string{"i1 / d1", "i2 / d1", "i3 / d1", "i4 / d1", "d1 / 1", "d1 / 12.34", "d1 / d1"},
-			data: [][]string{{"{0 0}", "{0 0}", "{0 0}", "{0 0}", "{33333300 0}", "{2701239 0}", "{100000 0}"}},
+			data: [][]string{{"{30 0}", "{30 0}", "{660 0}", "{660 0}", "{333333000000 0}", "{27012398703 0}", "{1000000000 0}"}},
 		}},
 		{sql: "select i1 / d1, i2 / d1, i3 / d1, i4 / d1, d1 / 1, d1 / 12.34, d1 / d1 from int_decimal1;", res: executeResult{
 			null: false,
 			attr: []string{"i1 / d1", "i2 / d1", "i3 / d1", "i4 / d1", "d1 / 1", "d1 / 12.34", "d1 / d1"},
-			data: [][]string{{"{0 0}", "{0 0}", "{0 0}", "{0 0}", "{33333300 0}", "{2701239 0}", "{100000 0}"}},
+			data: [][]string{{"{30 0}", "{30 0}", "{660 0}", "{660 0}", "{333333000000 0}", "{27012398703 0}", "{1000000000 0}"}},
 		}},
 	}
 	test(t, testCases)
@@ -121,6 +121,18 @@ func executeSQL(sql string, e engine.Engine, proc *process.Process) (*executeRes
 
 func test(t *testing.T, testCases []testCase) {
 	e, proc := newTestEngine()
+	runTestCases(t, e, proc, testCases)
+}
+
+// testWithMemoryLimit is same as test, but the memory budget of each query is limited to size bytes,
+// so the operators are forced to spill their states to disk.
+func testWithMemoryLimit(t *testing.T, size int64, testCases []testCase) {
+	e, proc := newTestEngine()
+	proc.Lim.Size = size
+	runTestCases(t, e, proc, testCases)
+}
+
+func runTestCases(t *testing.T, e engine.Engine, proc *process.Process, testCases []testCase) {
 	for _, tc := range testCases {
 		res, err := executeSQL(tc.sql, e, proc)
 		switch {
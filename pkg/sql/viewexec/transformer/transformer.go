@@ -28,6 +28,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/ring/approxcd"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/avg"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/count"
+	"github.com/matrixorigin/matrixone/pkg/container/ring/jsonagg"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/max"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/min"
 	"github.com/matrixorigin/matrixone/pkg/container/ring/starcount"
@@ -53,11 +54,17 @@ var sumReturnTypes = map[types.T]types.T{
 	types.T_uint64:  types.T_uint64,
 	types.T_float32: types.T_float64,
 	types.T_float64: types.T_float64,
+
+	types.T_decimal64:  types.T_decimal128,
+	types.T_decimal128: types.T_decimal128,
 }
 
 func ReturnType(op int, typ types.T) types.T {
 	switch op {
 	case Avg:
+		if typ == types.T_decimal64 || typ == types.T_decimal128 {
+			return types.T_decimal128
+		}
 		return types.T_float64
 	case Max:
 		return typ
@@ -79,6 +86,8 @@ func ReturnType(op int, typ types.T) types.T {
 		return types.T_uint64
 	case StdDevPop:
 		return types.T_float64
+	case JsonArrayAgg, JsonObjectAgg:
+		return types.T_json
 	}
 	return 0
 }
@@ -88,6 +97,9 @@ func New(op int, typ types.Type) (ring.Ring, error) {
 	case Sum:
 		return NewSum(typ)
 	case Avg:
+		if typ.Oid == types.T_decimal64 || typ.Oid == types.T_decimal128 {
+			return avg.NewDecimal(typ), nil
+		}
 		return avg.NewAvg(typ), nil
 	case Max:
 		return NewMax(typ)
@@ -109,6 +121,10 @@ func New(op int, typ types.Type) (ring.Ring, error) {
 		return NewBitOr(typ)
 	case StdDevPop:
 		return stddevpop.NewStdDevPopRingWithTypeCheck(typ)
+	case JsonArrayAgg:
+		return NewJsonArrayAgg(typ)
+	case JsonObjectAgg:
+		return NewJsonObjectAgg(typ)
 	}
 	return nil, nil
 }
@@ -137,6 +153,20 @@ func NewBitXor(typ types.Type) (ring.Ring, error) {
 	return nil, errors.New(fmt.Sprintf("'%v' not support BitXor", typ))
 }
 
+func NewJsonArrayAgg(typ types.Type) (ring.Ring, error) {
+	if typ.Oid == types.T_json {
+		return jsonagg.NewArrayAgg(typ), nil
+	}
+	return nil, errors.New(fmt.Sprintf("'%v' not support JsonArrayAgg", typ))
+}
+
+func NewJsonObjectAgg(typ types.Type) (ring.Ring, error) {
+	if typ.Oid == types.T_json {
+		return jsonagg.NewObjectAgg(typ), nil
+	}
+	return nil, errors.New(fmt.Sprintf("'%v' not support JsonObjectAgg", typ))
+}
+
 func NewSum(typ types.Type) (ring.Ring, error) {
 	switch typ.Oid {
 	case types.T_float32, types.T_float64:
@@ -145,6 +175,8 @@ func NewSum(typ types.Type) (ring.Ring, error) {
 		return sum.NewInt(typ), nil
 	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
 		return sum.NewUint(typ), nil
+	case types.T_decimal64, types.T_decimal128:
+		return sum.NewDecimal(typ), nil
 	}
 	return nil, errors.New(fmt.Sprintf("'%v' not support Sum", typ))
 }
@@ -177,6 +209,10 @@ func NewMax(typ types.Type) (ring.Ring, error) {
 		return max.NewDate(typ), nil
 	case types.T_datetime:
 		return max.NewDatetime(typ), nil
+	case types.T_decimal64:
+		return max.NewDecimal64(typ), nil
+	case types.T_decimal128:
+		return max.NewDecimal128(typ), nil
 	}
 	return nil, errors.New(fmt.Sprintf("'%v' not support Max", typ))
 }
@@ -209,6 +245,10 @@ func NewMin(typ types.Type) (ring.Ring, error) {
 		return min.NewDate(typ), nil
 	case types.T_datetime:
 		return min.NewDatetime(typ), nil
+	case types.T_decimal64:
+		return min.NewDecimal64(typ), nil
+	case types.T_decimal128:
+		return min.NewDecimal128(typ), nil
 	}
 	return nil, errors.New(fmt.Sprintf("'%v' not support Min", typ))
 }
@@ -27,6 +27,8 @@ const (
 	BitXor
 	BitOr
 	StdDevPop
+	JsonArrayAgg
+	JsonObjectAgg
 )
 
 var TransformerNames = [...]string{
@@ -42,6 +44,8 @@ var TransformerNames = [...]string{
 	BitXor:              "bit_xor",
 	BitOr:               "bit_or",
 	StdDevPop:           "stddev_pop",
+	JsonArrayAgg:        "json_arrayagg",
+	JsonObjectAgg:       "json_objectagg",
 }
 
 var TransformerNamesMap map[string]int
@@ -16,7 +16,11 @@ package add
 
 import (
 	"fmt"
+	"math"
 	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
 )
 
 func makeIbuffer(l int) []int64 {
@@ -48,3 +52,12 @@ func TestI64Add(t *testing.T) {
 	fmt.Printf("sum: %v\n", Int64Add(xs[:50], xs[50:], res))
 	fmt.Printf("pure sum: %v\n", numericAdd(xs[:50], xs[50:], res))
 }
+
+func TestDecimal64Add(t *testing.T) {
+	// 1.5 + 0.25 = 1.75
+	rs, err := Decimal64Add([]types.Decimal64{15}, []types.Decimal64{25}, 1, 2, make([]types.Decimal64, 1))
+	require.NoError(t, err)
+	require.Equal(t, []types.Decimal64{175}, rs)
+	_, err = Decimal64Add([]types.Decimal64{math.MaxInt64}, []types.Decimal64{1}, 0, 0, make([]types.Decimal64, 1))
+	require.Error(t, err)
+}
@@ -0,0 +1,39 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ascii
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	Ascii func(*types.Bytes, []uint8) []uint8
+)
+
+func init() {
+	Ascii = ascii
+}
+
+// ascii returns the numeric value of the leftmost byte of every string, 0 for the empty string
+func ascii(xs *types.Bytes, rs []uint8) []uint8 {
+	for i, offset := range xs.Offsets {
+		if xs.Lengths[i] == 0 {
+			rs[i] = 0
+		} else {
+			rs[i] = xs.Data[offset]
+		}
+	}
+	return rs
+}
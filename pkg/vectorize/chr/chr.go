@@ -0,0 +1,49 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chr
+
+import (
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	Chr func([]int64, *types.Bytes, *nulls.Nulls) *types.Bytes
+)
+
+func init() {
+	Chr = chr
+}
+
+// chr appends the UTF-8 encoding of every code point to rs,
+// the code points which are not valid characters are set null in nsp
+func chr(xs []int64, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	var buf [utf8.UTFMax]byte
+
+	for i, x := range xs {
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		if x <= 0 || x > utf8.MaxRune || !utf8.ValidRune(rune(x)) {
+			nulls.Add(nsp, uint64(i))
+			rs.Lengths = append(rs.Lengths, 0)
+			continue
+		}
+		n := utf8.EncodeRune(buf[:], rune(x))
+		rs.Data = append(rs.Data, buf[:n]...)
+		rs.Lengths = append(rs.Lengths, uint32(n))
+	}
+	return rs
+}
@@ -0,0 +1,34 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chr
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChr(t *testing.T) {
+	nsp := new(nulls.Nulls)
+	rs := chr([]int64{65, 19990, 0, 0xd800, 0x110000, 97}, &types.Bytes{}, nsp)
+	require.Equal(t, "A", string(rs.Get(0)))
+	require.Equal(t, "世", string(rs.Get(1)))
+	require.Equal(t, "a", string(rs.Get(5)))
+	for i := uint64(0); i < 6; i++ {
+		require.Equal(t, i >= 2 && i <= 4, nulls.Contains(nsp, i))
+	}
+}
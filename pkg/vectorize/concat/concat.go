@@ -0,0 +1,80 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concat
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/broadcast"
+)
+
+var (
+	Concat   func([]*types.Bytes, *types.Bytes) *types.Bytes
+	ConcatWs func(*types.Bytes, []*types.Bytes, []*nulls.Nulls, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	Concat = concat
+	ConcatWs = concatWs
+}
+
+// concat appends the concatenation of the strings of every row to rs,
+// a single string of an argument is used for all the rows.
+func concat(xss []*types.Bytes, rs *types.Bytes) *types.Bytes {
+	rows := maxRows(xss)
+	for i := 0; i < rows; i++ {
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		for _, xs := range xss {
+			rs.Data = append(rs.Data, xs.Get(int64(broadcast.Index(i, len(xs.Offsets))))...)
+		}
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-rs.Offsets[i])
+	}
+	return rs
+}
+
+// concatWs appends the concatenation of the strings of every row separated by the separator to rs,
+// the null strings given by nsps are skipped. A single separator or string of an argument is used
+// for all the rows.
+func concatWs(seps *types.Bytes, xss []*types.Bytes, nsps []*nulls.Nulls, rs *types.Bytes) *types.Bytes {
+	rows := maxRows(append(xss, seps))
+	for i := 0; i < rows; i++ {
+		sep := seps.Get(int64(broadcast.Index(i, len(seps.Offsets))))
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		first := true
+		for j, xs := range xss {
+			k := broadcast.Index(i, len(xs.Offsets))
+			if nulls.Contains(nsps[j], uint64(k)) {
+				continue
+			}
+			if !first {
+				rs.Data = append(rs.Data, sep...)
+			}
+			rs.Data = append(rs.Data, xs.Get(int64(k))...)
+			first = false
+		}
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-rs.Offsets[i])
+	}
+	return rs
+}
+
+func maxRows(xss []*types.Bytes) int {
+	rows := 0
+	for _, xs := range xss {
+		if len(xs.Offsets) > rows {
+			rows = len(xs.Offsets)
+		}
+	}
+	return rows
+}
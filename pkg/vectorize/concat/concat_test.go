@@ -0,0 +1,61 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concat
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcat(t *testing.T) {
+	rs := concat([]*types.Bytes{makeBytes("a", "你", ""), makeBytes("-"), makeBytes("b", "好", "")}, &types.Bytes{})
+	require.Equal(t, []string{"a-b", "你-好", "-"}, toStrings(rs))
+}
+
+func TestConcatWs(t *testing.T) {
+	nsp := new(nulls.Nulls)
+	nulls.Add(nsp, 1)
+	rs := concatWs(makeBytes(","), []*types.Bytes{makeBytes("a", "b", "c"), makeBytes("x", "", "z"), makeBytes("!")},
+		[]*nulls.Nulls{new(nulls.Nulls), nsp, new(nulls.Nulls)}, &types.Bytes{})
+	require.Equal(t, []string{"a,x,!", "b,!", "c,z,!"}, toStrings(rs))
+
+	// the null constant is skipped for all the rows
+	constNsp := new(nulls.Nulls)
+	nulls.Add(constNsp, 0)
+	rs = concatWs(makeBytes("|", "/"), []*types.Bytes{makeBytes("a", "b"), makeBytes(""), makeBytes("c", "d")},
+		[]*nulls.Nulls{new(nulls.Nulls), constNsp, new(nulls.Nulls)}, &types.Bytes{})
+	require.Equal(t, []string{"a|c", "b/d"}, toStrings(rs))
+}
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, str := range strs {
+		xs.Offsets = append(xs.Offsets, uint32(len(xs.Data)))
+		xs.Data = append(xs.Data, str...)
+		xs.Lengths = append(xs.Lengths, uint32(len(str)))
+	}
+	return xs
+}
+
+func toStrings(xs *types.Bytes) []string {
+	strs := make([]string, len(xs.Offsets))
+	for i := range strs {
+		strs[i] = string(xs.Get(int64(i)))
+	}
+	return strs
+}
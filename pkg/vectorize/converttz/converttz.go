@@ -0,0 +1,70 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converttz
+
+import (
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/broadcast"
+)
+
+var (
+	ConvertTz func([]types.Datetime, *types.Bytes, *types.Bytes, *nulls.Nulls, []types.Datetime) []types.Datetime
+)
+
+func init() {
+	ConvertTz = convertTz
+}
+
+// convertTz converts the datetimes from the time zones froms to the time zones tos,
+// the rows of the unknown time zones are set null in nsp like the mysql
+func convertTz(xs []types.Datetime, froms, tos *types.Bytes, nsp *nulls.Nulls, rs []types.Datetime) []types.Datetime {
+	zones := make(map[string]timeZone)
+	for i := range rs {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		from, ok := zone(zones, froms.Get(int64(broadcast.Index(i, len(froms.Offsets)))))
+		if !ok {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		to, ok := zone(zones, tos.Get(int64(broadcast.Index(i, len(tos.Offsets)))))
+		if !ok {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		rs[i] = types.ConvertTimeZone(xs[broadcast.Index(i, len(xs))], from, to)
+	}
+	return rs
+}
+
+type timeZone struct {
+	loc *time.Location
+	ok  bool
+}
+
+// zone returns the location of the time zone name, the parsed zones are cached in zones
+func zone(zones map[string]timeZone, name []byte) (*time.Location, bool) {
+	z, ok := zones[string(name)]
+	if !ok {
+		loc, err := types.ParseTimeZone(string(name))
+		z = timeZone{loc: loc, ok: err == nil}
+		zones[string(name)] = z
+	}
+	return z.loc, z.ok
+}
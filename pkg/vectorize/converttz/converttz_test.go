@@ -0,0 +1,40 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converttz
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertTz(t *testing.T) {
+	dt, err := types.ParseDatetime("2022-01-01 12:00:00")
+	require.NoError(t, err)
+	froms := &types.Bytes{Data: []byte("+00:00"), Offsets: []uint32{0}, Lengths: []uint32{6}}
+	tos := &types.Bytes{}
+	for _, s := range []string{"+08:00", "America/New_York", "Mars/Olympus"} {
+		tos.Offsets = append(tos.Offsets, uint32(len(tos.Data)))
+		tos.Lengths = append(tos.Lengths, uint32(len(s)))
+		tos.Data = append(tos.Data, s...)
+	}
+	nsp := new(nulls.Nulls)
+	rs := ConvertTz([]types.Datetime{dt}, froms, tos, nsp, make([]types.Datetime, 3))
+	require.Equal(t, "2022-01-01 20:00:00", rs[0].String())
+	require.Equal(t, "2022-01-01 07:00:00", rs[1].String())
+	require.True(t, nulls.Contains(nsp, 2))
+}
@@ -0,0 +1,65 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dateadd
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/broadcast"
+)
+
+// the intervals are normalized by types.NormalizeInterval, it is MicroSecond or Month
+var (
+	DateAdd     func([]types.Date, []int64, types.IntervalType, *nulls.Nulls, []types.Date) []types.Date
+	DatetimeAdd func([]types.Datetime, []int64, types.IntervalType, *nulls.Nulls, []types.Datetime) []types.Datetime
+)
+
+func init() {
+	DateAdd = dateAdd
+	DatetimeAdd = datetimeAdd
+}
+
+// dateAdd adds the intervals ns to the dates xs, a single date or interval is used for all the rows.
+// The results out of the range of the date are set null in nsp.
+func dateAdd(xs []types.Date, ns []int64, it types.IntervalType, nsp *nulls.Nulls, rs []types.Date) []types.Date {
+	for i := range rs {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		r, ok := xs[broadcast.Index(i, len(xs))].AddInterval(ns[broadcast.Index(i, len(ns))], it)
+		if !ok {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		rs[i] = r
+	}
+	return rs
+}
+
+// datetimeAdd is dateAdd for the datetimes
+func datetimeAdd(xs []types.Datetime, ns []int64, it types.IntervalType, nsp *nulls.Nulls, rs []types.Datetime) []types.Datetime {
+	for i := range rs {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		r, ok := xs[broadcast.Index(i, len(xs))].AddInterval(ns[broadcast.Index(i, len(ns))], it)
+		if !ok {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		rs[i] = r
+	}
+	return rs
+}
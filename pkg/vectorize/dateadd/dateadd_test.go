@@ -0,0 +1,50 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dateadd
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateAdd(t *testing.T) {
+	d1, _ := types.ParseDate("2022-01-31")
+	d2, _ := types.ParseDate("9999-12-01")
+	nsp := new(nulls.Nulls)
+	rs := DateAdd([]types.Date{d1, d2}, []int64{1}, types.Month, nsp, make([]types.Date, 2))
+	require.Equal(t, "2022-02-28", rs[0].String())
+	require.True(t, nulls.Contains(nsp, 1))
+
+	nsp = new(nulls.Nulls)
+	rs = DateAdd([]types.Date{d1}, []int64{-86400 * 1000000, 86400 * 1000000}, types.MicroSecond, nsp, make([]types.Date, 2))
+	require.Equal(t, "2022-01-30", rs[0].String())
+	require.Equal(t, "2022-02-01", rs[1].String())
+	require.Equal(t, 0, nulls.Length(nsp))
+}
+
+func TestDatetimeAdd(t *testing.T) {
+	dt, _ := types.ParseDatetime("2022-12-31 23:30:00")
+	nsp := new(nulls.Nulls)
+	nulls.Add(nsp, 1)
+	rs := DatetimeAdd([]types.Datetime{dt, dt}, []int64{3600 * 1000000}, types.MicroSecond, nsp, make([]types.Datetime, 2))
+	require.Equal(t, "2023-01-01 00:30:00", rs[0].String())
+	require.True(t, nulls.Contains(nsp, 1))
+
+	rs = DatetimeAdd([]types.Datetime{dt}, []int64{-13}, types.Month, new(nulls.Nulls), make([]types.Datetime, 1))
+	require.Equal(t, "2021-11-30 23:30:00", rs[0].String())
+}
@@ -0,0 +1,50 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datediff
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/broadcast"
+)
+
+var (
+	DateDiff      func([]types.Date, []types.Date, []int64) []int64
+	TimestampDiff func(types.IntervalType, []types.Datetime, []types.Datetime, []int64) ([]int64, error)
+)
+
+func init() {
+	DateDiff = dateDiff
+	TimestampDiff = timestampDiff
+}
+
+// dateDiff returns the days from ys to xs like DATEDIFF, a single date is used for all the rows
+func dateDiff(xs, ys []types.Date, rs []int64) []int64 {
+	for i := range rs {
+		rs[i] = int64(xs[broadcast.Index(i, len(xs))]) - int64(ys[broadcast.Index(i, len(ys))])
+	}
+	return rs
+}
+
+// timestampDiff returns the complete units from xs to ys like TIMESTAMPDIFF, a single datetime is used for all the rows
+func timestampDiff(it types.IntervalType, xs, ys []types.Datetime, rs []int64) ([]int64, error) {
+	for i := range rs {
+		r, err := types.TimestampDiff(it, xs[broadcast.Index(i, len(xs))], ys[broadcast.Index(i, len(ys))])
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
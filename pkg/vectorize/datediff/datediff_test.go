@@ -0,0 +1,43 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datediff
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateDiff(t *testing.T) {
+	d1, _ := types.ParseDate("2022-03-01")
+	d2, _ := types.ParseDate("2022-02-01")
+	d3, _ := types.ParseDate("2023-03-01")
+	require.Equal(t, []int64{28, -365}, DateDiff([]types.Date{d1}, []types.Date{d2, d3}, make([]int64, 2)))
+}
+
+func TestTimestampDiff(t *testing.T) {
+	a, _ := types.ParseDatetime("2022-01-01 00:00:00")
+	b, _ := types.ParseDatetime("2022-01-02 12:00:00")
+	c, _ := types.ParseDatetime("2023-01-01 00:00:00")
+	rs, err := TimestampDiff(types.Hour, []types.Datetime{a}, []types.Datetime{b, c}, make([]int64, 2))
+	require.NoError(t, err)
+	require.Equal(t, []int64{36, 8760}, rs)
+	rs, err = TimestampDiff(types.Year, []types.Datetime{a, c}, []types.Datetime{c}, make([]int64, 2))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 0}, rs)
+	_, err = TimestampDiff(types.Year_Month, []types.Datetime{a}, []types.Datetime{c}, make([]int64, 1))
+	require.Error(t, err)
+}
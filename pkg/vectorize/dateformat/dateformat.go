@@ -0,0 +1,417 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dateformat
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/broadcast"
+)
+
+var (
+	DateFormat func([]types.Datetime, *types.Bytes, *nulls.Nulls, *types.Bytes) *types.Bytes
+	StrToDate  func(*types.Bytes, *types.Bytes, *nulls.Nulls, []types.Datetime) []types.Datetime
+)
+
+func init() {
+	DateFormat = dateFormat
+	StrToDate = strToDate
+}
+
+var (
+	monthNames = []string{
+		"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December",
+	}
+	weekdayNames = []string{
+		"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+	}
+)
+
+// dateFormat formats the datetimes xs with the formats like DATE_FORMAT, a single datetime or format is used for all the rows
+func dateFormat(xs []types.Datetime, formats *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) *types.Bytes {
+	rows := len(xs)
+	if n := len(formats.Offsets); n > rows {
+		rows = n
+	}
+	var buf []byte
+	for i := 0; i < rows; i++ {
+		buf = buf[:0]
+		if !nulls.Contains(nsp, uint64(i)) {
+			buf = Format(buf, xs[broadcast.Index(i, len(xs))], formats.Get(int64(broadcast.Index(i, len(formats.Offsets)))))
+		}
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		rs.Lengths = append(rs.Lengths, uint32(len(buf)))
+		rs.Data = append(rs.Data, buf...)
+	}
+	return rs
+}
+
+/*
+Format appends the datetime formatted with the MySQL format specifiers to buf.
+The unknown specifiers are written without the '%'.
+*/
+func Format(buf []byte, dt types.Datetime, format []byte) []byte {
+	d := dt.ToDate()
+	year, month, day, yday := d.Calendar(true)
+	hour, minute, second := dt.Clock()
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i == len(format)-1 {
+			buf = append(buf, format[i])
+			continue
+		}
+		i++
+		switch format[i] {
+		case 'a':
+			buf = append(buf, weekdayNames[d.DayOfWeek()][:3]...)
+		case 'b':
+			buf = append(buf, monthNames[month-1][:3]...)
+		case 'c':
+			buf = strconv.AppendInt(buf, int64(month), 10)
+		case 'D':
+			buf = strconv.AppendInt(buf, int64(day), 10)
+			buf = append(buf, daySuffix(day)...)
+		case 'd':
+			buf = appendInt(buf, int64(day), 2)
+		case 'e':
+			buf = strconv.AppendInt(buf, int64(day), 10)
+		case 'f':
+			buf = appendInt(buf, dt.MicroSec(), 6)
+		case 'H':
+			buf = appendInt(buf, int64(hour), 2)
+		case 'h', 'I':
+			buf = appendInt(buf, int64(hour12(hour)), 2)
+		case 'i':
+			buf = appendInt(buf, int64(minute), 2)
+		case 'j':
+			buf = appendInt(buf, int64(yday), 3)
+		case 'k':
+			buf = strconv.AppendInt(buf, int64(hour), 10)
+		case 'l':
+			buf = strconv.AppendInt(buf, int64(hour12(hour)), 10)
+		case 'M':
+			buf = append(buf, monthNames[month-1]...)
+		case 'm':
+			buf = appendInt(buf, int64(month), 2)
+		case 'p':
+			buf = append(buf, meridiem(hour)...)
+		case 'r':
+			buf = appendInt(buf, int64(hour12(hour)), 2)
+			buf = append(buf, ':')
+			buf = appendInt(buf, int64(minute), 2)
+			buf = append(buf, ':')
+			buf = appendInt(buf, int64(second), 2)
+			buf = append(buf, ' ')
+			buf = append(buf, meridiem(hour)...)
+		case 'S', 's':
+			buf = appendInt(buf, int64(second), 2)
+		case 'T':
+			buf = appendInt(buf, int64(hour), 2)
+			buf = append(buf, ':')
+			buf = appendInt(buf, int64(minute), 2)
+			buf = append(buf, ':')
+			buf = appendInt(buf, int64(second), 2)
+		case 'U':
+			week, _ := dt.Extract(types.Week)
+			buf = appendInt(buf, week, 2)
+		case 'u':
+			buf = appendInt(buf, int64(mondayWeek(d, yday)), 2)
+		case 'V':
+			_, week := sundayWeek(d, year)
+			buf = appendInt(buf, int64(week), 2)
+		case 'v':
+			_, week := d.WeekOfYear()
+			buf = appendInt(buf, int64(week), 2)
+		case 'W':
+			buf = append(buf, weekdayNames[d.DayOfWeek()]...)
+		case 'w':
+			buf = strconv.AppendInt(buf, int64(d.DayOfWeek()), 10)
+		case 'X':
+			y, _ := sundayWeek(d, year)
+			buf = appendInt(buf, int64(y), 4)
+		case 'x':
+			y, _ := d.WeekOfYear()
+			buf = appendInt(buf, int64(y), 4)
+		case 'Y':
+			buf = appendInt(buf, int64(year), 4)
+		case 'y':
+			buf = appendInt(buf, int64(year%100), 2)
+		default:
+			buf = append(buf, format[i])
+		}
+	}
+	return buf
+}
+
+// strToDate parses the strings xs with the formats like STR_TO_DATE, a single string or format is used for all the rows.
+// The strings which do not match the formats are set null in nsp.
+func strToDate(xs *types.Bytes, formats *types.Bytes, nsp *nulls.Nulls, rs []types.Datetime) []types.Datetime {
+	for i := range rs {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		dt, ok := Parse(xs.Get(int64(broadcast.Index(i, len(xs.Offsets)))), formats.Get(int64(broadcast.Index(i, len(formats.Offsets)))))
+		if !ok {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		rs[i] = dt
+	}
+	return rs
+}
+
+/*
+Parse parses s with the MySQL format specifiers, false if s does not match the format or the date is invalid.
+The missing year, month and day are 1 and the missing time fields are 0.
+*/
+func Parse(s, format []byte) (types.Datetime, bool) {
+	year, month, day := int64(1), int64(1), int64(1)
+	var hour, minute, second, micro int64
+	pm, hasMeridiem := false, false
+	str := string(s)
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c == ' ' {
+			str = strings.TrimLeft(str, " ")
+			continue
+		}
+		if c != '%' || i == len(format)-1 {
+			if len(str) == 0 || str[0] != c {
+				return 0, false
+			}
+			str = str[1:]
+			continue
+		}
+		i++
+		var ok bool
+		switch format[i] {
+		case 'Y':
+			year, str, ok = parseInt(str, 4)
+		case 'y':
+			year, str, ok = parseInt(str, 2)
+			if year < 70 {
+				year += 2000
+			} else {
+				year += 1900
+			}
+		case 'm', 'c':
+			month, str, ok = parseInt(str, 2)
+		case 'M':
+			month, str, ok = parseName(str, monthNames, false)
+		case 'b':
+			month, str, ok = parseName(str, monthNames, true)
+		case 'd', 'e':
+			day, str, ok = parseInt(str, 2)
+		case 'D':
+			day, str, ok = parseInt(str, 2)
+			if ok && len(str) >= 2 {
+				str = str[2:]
+			}
+		case 'j':
+			var yday int64
+			if yday, str, ok = parseInt(str, 3); ok {
+				month, day = 1, yday
+			}
+		case 'H', 'k':
+			hour, str, ok = parseInt(str, 2)
+		case 'h', 'I', 'l':
+			hour, str, ok = parseInt(str, 2)
+			hasMeridiem = true
+		case 'i':
+			minute, str, ok = parseInt(str, 2)
+		case 'S', 's':
+			second, str, ok = parseInt(str, 2)
+		case 'f':
+			micro, str, ok = parseMicroSeconds(str)
+		case 'p':
+			if len(str) >= 2 {
+				switch strings.ToUpper(str[:2]) {
+				case "AM":
+					ok = true
+				case "PM":
+					pm, ok = true, true
+				}
+				str = str[2:]
+			}
+		case 'T':
+			hour, minute, second, str, ok = parseClock(str)
+		case 'r':
+			hour, minute, second, str, ok = parseClock(str)
+			str = strings.TrimLeft(str, " ")
+			if ok && len(str) >= 2 {
+				pm = strings.ToUpper(str[:2]) == "PM"
+				str = str[2:]
+			}
+			hasMeridiem = true
+		case '%':
+			ok = len(str) > 0 && str[0] == '%'
+			if ok {
+				str = str[1:]
+			}
+		default:
+			return 0, false
+		}
+		if !ok {
+			return 0, false
+		}
+	}
+	if hasMeridiem {
+		if hour < 1 || hour > 12 {
+			return 0, false
+		}
+		hour %= 12
+	}
+	if pm {
+		hour += 12
+	}
+	if year < 1 || year > types.MaxDateYear || month < 1 || month > 12 || day < 1 ||
+		hour > 23 || minute > 59 || second > 59 {
+		return 0, false
+	}
+	// the day of the year is given by %j
+	if day > 31 {
+		if day > 366 {
+			return 0, false
+		}
+		d := types.FromCalendar(int32(year), 1, 1) + types.Date(day-1)
+		if d.Year() != uint16(year) {
+			return 0, false
+		}
+		return types.Datetime((int64(d)*86400+hour*3600+minute*60+second)<<20 + micro), true
+	}
+	d, err := types.ParseDate(string(appendInt(appendInt(appendInt(nil, year, 4), month, 2), day, 2)))
+	if err != nil {
+		return 0, false
+	}
+	return types.Datetime((int64(d)*86400+hour*3600+minute*60+second)<<20 + micro), true
+}
+
+// parseInt parses at most n digits from the head of s
+func parseInt(s string, n int) (int64, string, bool) {
+	i := 0
+	for i < len(s) && i < n && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	if i == 0 {
+		return 0, s, false
+	}
+	v, err := strconv.ParseInt(s[:i], 10, 64)
+	return v, s[i:], err == nil
+}
+
+func parseMicroSeconds(s string) (int64, string, bool) {
+	v, rest, ok := parseInt(s, 6)
+	if !ok {
+		return 0, s, false
+	}
+	for i := len(s) - len(rest); i < 6; i++ {
+		v *= 10
+	}
+	return v, rest, true
+}
+
+func parseClock(s string) (int64, int64, int64, string, bool) {
+	var fields [3]int64
+	var ok bool
+	for i := range fields {
+		if i > 0 {
+			if len(s) == 0 || s[0] != ':' {
+				return 0, 0, 0, s, false
+			}
+			s = s[1:]
+		}
+		if fields[i], s, ok = parseInt(s, 2); !ok {
+			return 0, 0, 0, s, false
+		}
+	}
+	return fields[0], fields[1], fields[2], s, true
+}
+
+// parseName parses the month name from the head of s, the index starts from 1
+func parseName(s string, names []string, abbr bool) (int64, string, bool) {
+	for i, name := range names {
+		if abbr {
+			name = name[:3]
+		}
+		if len(s) >= len(name) && strings.EqualFold(s[:len(name)], name) {
+			return int64(i + 1), s[len(name):], true
+		}
+	}
+	return 0, s, false
+}
+
+// appendInt appends v padded with zeros to n digits
+func appendInt(buf []byte, v int64, n int) []byte {
+	s := strconv.FormatInt(v, 10)
+	for i := len(s); i < n; i++ {
+		buf = append(buf, '0')
+	}
+	return append(buf, s...)
+}
+
+func daySuffix(day uint8) string {
+	if day >= 11 && day <= 13 {
+		return "th"
+	}
+	switch day % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+func hour12(hour int8) int8 {
+	if hour%12 == 0 {
+		return 12
+	}
+	return hour % 12
+}
+
+func meridiem(hour int8) string {
+	if hour < 12 {
+		return "AM"
+	}
+	return "PM"
+}
+
+// mondayWeek returns the week of the year in which the weeks start with Monday,
+// the first week has more than 3 days in this year and the days before it are in week 0.
+func mondayWeek(d types.Date, yday uint16) int {
+	jan1 := (int(types.Date(int32(d)-int32(yday)+1).DayOfWeek()) + 6) % 7
+	week := (int(yday) - 1 + jan1) / 7
+	if 7-jan1 > 3 {
+		week++
+	}
+	return week
+}
+
+// sundayWeek returns the year and the week in which the weeks start with Sunday,
+// the days before the first Sunday are in the last week of the previous year.
+func sundayWeek(d types.Date, year int32) (int32, int64) {
+	week, _ := d.ToTime().Extract(types.Week)
+	if week > 0 {
+		return year, week
+	}
+	lastDay := types.FromCalendar(year, 1, 1) - 1
+	week, _ = lastDay.ToTime().Extract(types.Week)
+	return year - 1, week
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dateformat
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func makeBytes(ss ...string) *types.Bytes {
+	bs := &types.Bytes{}
+	for _, s := range ss {
+		bs.Offsets = append(bs.Offsets, uint32(len(bs.Data)))
+		bs.Lengths = append(bs.Lengths, uint32(len(s)))
+		bs.Data = append(bs.Data, s...)
+	}
+	return bs
+}
+
+func TestFormat(t *testing.T) {
+	dt, err := types.ParseDatetime("2022-01-02 15:04:05.000123")
+	require.NoError(t, err)
+	cases := []struct {
+		format string
+		want   string
+	}{
+		{"%Y-%m-%d %H:%i:%s", "2022-01-02 15:04:05"},
+		{"%y/%c/%e %k:%i %p", "22/1/2 15:04 PM"},
+		{"%W %M %D %Y", "Sunday January 2nd 2022"},
+		{"%a %b %j %f", "Sun Jan 002 000123"},
+		{"%r|%T|%h|%l", "03:04:05 PM|15:04:05|03|3"},
+		{"%U %u %V %v %X %x %w", "01 00 01 52 2022 2021 0"},
+		{"100%% %q", "100% q"},
+	}
+	for _, c := range cases {
+		require.Equal(t, c.want, string(Format(nil, dt, []byte(c.format))), c.format)
+	}
+
+	nsp := new(nulls.Nulls)
+	nulls.Add(nsp, 1)
+	rs := DateFormat([]types.Datetime{dt, dt}, makeBytes("%Y"), nsp, &types.Bytes{})
+	require.Equal(t, "2022", string(rs.Get(0)))
+	require.Equal(t, "", string(rs.Get(1)))
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		s, format string
+		want      string
+	}{
+		{"2022-01-02 15:04:05", "%Y-%m-%d %H:%i:%s", "2022-01-02 15:04:05"},
+		{"02/01/22", "%d/%m/%y", "2022-01-02 00:00:00"},
+		{"May 3, 2022 3:04:05 pm", "%M %e, %Y %l:%i:%s %p", "2022-05-03 15:04:05"},
+		{"Dec 1st 1999 12:00:00 AM", "%b %D %Y %r", "1999-12-01 00:00:00"},
+		{"2022 032", "%Y %j", "2022-02-01 00:00:00"},
+		{"2022", "%Y", "2022-01-01 00:00:00"},
+	}
+	for _, c := range cases {
+		dt, ok := Parse([]byte(c.s), []byte(c.format))
+		require.True(t, ok, c.s)
+		require.Equal(t, c.want, dt.String(), c.s)
+	}
+	for _, s := range []string{"2022-02-30", "2022-13-01", "20x2-01-01"} {
+		_, ok := Parse([]byte(s), []byte("%Y-%m-%d"))
+		require.False(t, ok, s)
+	}
+
+	nsp := new(nulls.Nulls)
+	rs := StrToDate(makeBytes("2022-01-02", "bad"), makeBytes("%Y-%m-%d"), nsp, make([]types.Datetime, 2))
+	require.Equal(t, "2022-01-02 00:00:00", rs[0].String())
+	require.True(t, nulls.Contains(nsp, 1))
+}
@@ -193,96 +193,127 @@ func floatIntegerDivByScalarSels[T constraints.Float](x T, ys []T, rs []int64, s
 	return rs
 }
 
-func decimal64Div(xs, ys []types.Decimal64, xsScale, ysScale int32, rs []types.Decimal128) []types.Decimal128 {
-	// a / b
-	// to divide two decimal value
-	// 1. scale dividend using divisor's scale
-	// 2. perform integer division
-	// division result precision: 38(decimal64) division result scale: dividend's scale
+// a / b
+// to divide two decimal value
+// 1. scale dividend using divisor's scale and types.DecimalDivScaleIncrement
+// 2. perform integer division and round the quotient half away from zero
+// division result precision: 38(decimal128) division result scale: dividend's scale + types.DecimalDivScaleIncrement
+func decimal64Div(xs, ys []types.Decimal64, xsScale, ysScale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
 	for i, x := range xs {
-		rs[i] = types.Decimal64Decimal64Div(x, ys[i], xsScale, ysScale)
+		if rs[i], err = types.Decimal64Decimal64Div(x, ys[i], xsScale, ysScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal64DivSels(xs, ys []types.Decimal64, xsScale, ysScale int32, rs []types.Decimal128, sels []int64) []types.Decimal128 {
+func decimal64DivSels(xs, ys []types.Decimal64, xsScale, ysScale int32, rs []types.Decimal128, sels []int64) ([]types.Decimal128, error) {
+	var err error
 	for _, sel := range sels {
-		rs[sel] = types.Decimal64Decimal64Div(xs[sel], ys[sel], xsScale, ysScale)
+		if rs[sel], err = types.Decimal64Decimal64Div(xs[sel], ys[sel], xsScale, ysScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal64DivScalar(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal128) []types.Decimal128 {
+func decimal64DivScalar(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
 	for i, y := range ys {
-		rs[i] = types.Decimal64Decimal64Div(x, y, xScale, ysScale)
+		if rs[i], err = types.Decimal64Decimal64Div(x, y, xScale, ysScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal64DivScalarSels(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal128, sels []int64) []types.Decimal128 {
+func decimal64DivScalarSels(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal128, sels []int64) ([]types.Decimal128, error) {
+	var err error
 	for _, sel := range sels {
-		rs[sel] = types.Decimal64Decimal64Div(x, ys[sel], xScale, ysScale)
+		if rs[sel], err = types.Decimal64Decimal64Div(x, ys[sel], xScale, ysScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal64DivByScalar(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal128) []types.Decimal128 {
+func decimal64DivByScalar(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
 	for i, y := range ys {
-		rs[i] = types.Decimal64Decimal64Div(y, x, ysScale, xScale)
+		if rs[i], err = types.Decimal64Decimal64Div(y, x, ysScale, xScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal64DivByScalarSels(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal128, sels []int64) []types.Decimal128 {
+func decimal64DivByScalarSels(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal128, sels []int64) ([]types.Decimal128, error) {
+	var err error
 	for _, sel := range sels {
-		rs[sel] = types.Decimal64Decimal64Div(x, ys[sel], xScale, ysScale)
+		if rs[sel], err = types.Decimal64Decimal64Div(ys[sel], x, ysScale, xScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal128Div(xs, ys []types.Decimal128, xsScale, ysScale int32, rs []types.Decimal128) []types.Decimal128 {
-	// a / b
-	// to divide two decimal value
-	// 1. scale dividend using divisor's scale
-	// 2. perform integer division
-	// division result precision: 38(decimal128) division result scale: dividend's scale
+func decimal128Div(xs, ys []types.Decimal128, xsScale, ysScale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
 	for i, x := range xs {
-		rs[i] = types.Decimal128Decimal128Div(x, ys[i], xsScale, ysScale)
+		if rs[i], err = types.Decimal128Decimal128Div(x, ys[i], xsScale, ysScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal128DivSels(xs, ys []types.Decimal128, xsScale, ysScale int32, rs []types.Decimal128, sels []int64) []types.Decimal128 {
+func decimal128DivSels(xs, ys []types.Decimal128, xsScale, ysScale int32, rs []types.Decimal128, sels []int64) ([]types.Decimal128, error) {
+	var err error
 	for _, sel := range sels {
-		rs[sel] = types.Decimal128Decimal128Div(xs[sel], ys[sel], xsScale, ysScale)
+		if rs[sel], err = types.Decimal128Decimal128Div(xs[sel], ys[sel], xsScale, ysScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal128DivScalar(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128) []types.Decimal128 {
+func decimal128DivScalar(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
 	for i, y := range ys {
-		rs[i] = types.Decimal128Decimal128Div(x, y, xScale, ysScale)
+		if rs[i], err = types.Decimal128Decimal128Div(x, y, xScale, ysScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal128DivScalarSels(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128, sels []int64) []types.Decimal128 {
+func decimal128DivScalarSels(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128, sels []int64) ([]types.Decimal128, error) {
+	var err error
 	for _, sel := range sels {
-		rs[sel] = types.Decimal128Decimal128Div(x, ys[sel], xScale, ysScale)
+		if rs[sel], err = types.Decimal128Decimal128Div(x, ys[sel], xScale, ysScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal128DivByScalar(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128) []types.Decimal128 {
+func decimal128DivByScalar(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
 	for i, y := range ys {
-		rs[i] = types.Decimal128Decimal128Div(y, x, ysScale, xScale)
+		if rs[i], err = types.Decimal128Decimal128Div(y, x, ysScale, xScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal128DivByScalarSels(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128, sels []int64) []types.Decimal128 {
+func decimal128DivByScalarSels(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128, sels []int64) ([]types.Decimal128, error) {
+	var err error
 	for _, sel := range sels {
-		rs[sel] = types.Decimal128Decimal128Div(x, ys[sel], xScale, ysScale)
+		if rs[sel], err = types.Decimal128Decimal128Div(ys[sel], x, ysScale, xScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
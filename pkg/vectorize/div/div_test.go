@@ -3,6 +3,7 @@ package div
 import (
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -768,3 +769,16 @@ func TestFloat64IntegerDivByScalarSels(t *testing.T) {
 	}
 	require.Equal(t, rsCorrect, rs)
 }
+
+func TestDecimal64Div(t *testing.T) {
+	// 1.00 / 3 = 0.333333, 2.00 / 0.03 = 66.666667
+	xs := []types.Decimal64{100, 200}
+	ys := []types.Decimal64{300, 3}
+	rs := make([]types.Decimal128, len(xs))
+	rs, err := Decimal64Div(xs, ys, 2, 2, rs)
+	require.NoError(t, err)
+	require.Equal(t, "0.333333", string(rs[0].Decimal128ToString(6)))
+	require.Equal(t, "66.666667", string(rs[1].Decimal128ToString(6)))
+	_, err = Decimal64DivByScalar(0, xs, 2, 2, rs)
+	require.Error(t, err)
+}
@@ -0,0 +1,98 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encode
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	HexEncode    func(*types.Bytes, *types.Bytes) *types.Bytes
+	HexDecode    func(*types.Bytes, *types.Bytes, *nulls.Nulls) *types.Bytes
+	Base64Encode func(*types.Bytes, *types.Bytes) *types.Bytes
+	Base64Decode func(*types.Bytes, *types.Bytes, *nulls.Nulls) *types.Bytes
+)
+
+func init() {
+	HexEncode = hexEncode
+	HexDecode = hexDecode
+	Base64Encode = base64Encode
+	Base64Decode = base64Decode
+}
+
+// hexEncode appends the upper case hexadecimal digits of every string to rs
+func hexEncode(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	const digits = "0123456789ABCDEF"
+
+	for i, offset := range xs.Offsets {
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		for _, b := range xs.Data[offset : offset+xs.Lengths[i]] {
+			rs.Data = append(rs.Data, digits[b>>4], digits[b&0x0f])
+		}
+		rs.Lengths = append(rs.Lengths, 2*xs.Lengths[i])
+	}
+	return rs
+}
+
+// hexDecode appends the bytes of every hexadecimal string to rs, the malformed strings are set null in nsp
+func hexDecode(xs *types.Bytes, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	for i, offset := range xs.Offsets {
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		src := xs.Data[offset : offset+xs.Lengths[i]]
+		start := len(rs.Data)
+		rs.Data = append(rs.Data, make([]byte, hex.DecodedLen(len(src)))...)
+		if _, err := hex.Decode(rs.Data[start:], src); err != nil {
+			nulls.Add(nsp, uint64(i))
+			rs.Data = rs.Data[:start]
+		}
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data)-start))
+	}
+	return rs
+}
+
+// base64Encode appends the standard base64 encoding of every string to rs
+func base64Encode(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i, offset := range xs.Offsets {
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		src := xs.Data[offset : offset+xs.Lengths[i]]
+		start := len(rs.Data)
+		rs.Data = append(rs.Data, make([]byte, base64.StdEncoding.EncodedLen(len(src)))...)
+		base64.StdEncoding.Encode(rs.Data[start:], src)
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data)-start))
+	}
+	return rs
+}
+
+// base64Decode appends the bytes of every base64 string to rs, the malformed strings are set null in nsp
+func base64Decode(xs *types.Bytes, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	for i, offset := range xs.Offsets {
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		src := xs.Data[offset : offset+xs.Lengths[i]]
+		start := len(rs.Data)
+		rs.Data = append(rs.Data, make([]byte, base64.StdEncoding.DecodedLen(len(src)))...)
+		n, err := base64.StdEncoding.Decode(rs.Data[start:], src)
+		if err != nil {
+			nulls.Add(nsp, uint64(i))
+			n = 0
+		}
+		rs.Data = rs.Data[:start+n]
+		rs.Lengths = append(rs.Lengths, uint32(n))
+	}
+	return rs
+}
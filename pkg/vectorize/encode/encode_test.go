@@ -0,0 +1,65 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encode
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHex(t *testing.T) {
+	rs := hexEncode(makeBytes("abc", "", "世"), &types.Bytes{})
+	require.Equal(t, []string{"616263", "", "E4B896"}, toStrings(rs))
+
+	nsp := new(nulls.Nulls)
+	rs = hexDecode(makeBytes("616263", "e4b896", "6", "zz", ""), &types.Bytes{}, nsp)
+	require.Equal(t, []string{"abc", "世", "", "", ""}, toStrings(rs))
+	for i := uint64(0); i < 5; i++ {
+		require.Equal(t, i == 2 || i == 3, nulls.Contains(nsp, i))
+	}
+}
+
+func TestBase64(t *testing.T) {
+	rs := base64Encode(makeBytes("abc", "", "hello 世界"), &types.Bytes{})
+	require.Equal(t, []string{"YWJj", "", "aGVsbG8g5LiW55WM"}, toStrings(rs))
+
+	nsp := new(nulls.Nulls)
+	rs = base64Decode(makeBytes("YWJj", "YQ==", "Y", "", "aGVsbG8g5LiW55WM"), &types.Bytes{}, nsp)
+	require.Equal(t, []string{"abc", "a", "", "", "hello 世界"}, toStrings(rs))
+	for i := uint64(0); i < 5; i++ {
+		require.Equal(t, i == 2, nulls.Contains(nsp, i))
+	}
+}
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, str := range strs {
+		xs.Offsets = append(xs.Offsets, uint32(len(xs.Data)))
+		xs.Data = append(xs.Data, str...)
+		xs.Lengths = append(xs.Lengths, uint32(len(str)))
+	}
+	return xs
+}
+
+func toStrings(xs *types.Bytes) []string {
+	strs := make([]string, len(xs.Offsets))
+	for i := range strs {
+		strs[i] = string(xs.Get(int64(i)))
+	}
+	return strs
+}
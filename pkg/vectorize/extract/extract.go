@@ -0,0 +1,39 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extract
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	Extract func(types.IntervalType, []types.Datetime, []int64) ([]int64, error)
+)
+
+func init() {
+	Extract = extract
+}
+
+// extract returns the unit part of the datetimes like EXTRACT(unit FROM datetime)
+func extract(it types.IntervalType, xs []types.Datetime, rs []int64) ([]int64, error) {
+	for i, x := range xs {
+		r, err := x.Extract(it)
+		if err != nil {
+			return nil, err
+		}
+		rs[i] = r
+	}
+	return rs, nil
+}
@@ -0,0 +1,36 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package extract
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtract(t *testing.T) {
+	a, _ := types.ParseDatetime("2022-05-03 04:05:06")
+	b, _ := types.ParseDatetime("1999-12-31 23:59:59")
+	rs, err := Extract(types.Year_Month, []types.Datetime{a, b}, make([]int64, 2))
+	require.NoError(t, err)
+	require.Equal(t, []int64{202205, 199912}, rs)
+	rs, err = Extract(types.Hour, []types.Datetime{a, b}, make([]int64, 2))
+	require.NoError(t, err)
+	require.Equal(t, []int64{4, 23}, rs)
+	rs, err = Extract(types.Quarter, []types.Datetime{a, b}, make([]int64, 2))
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 4}, rs)
+}
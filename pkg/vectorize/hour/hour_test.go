@@ -0,0 +1,30 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hour
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatetimeToHour(t *testing.T) {
+	xs := make([]types.Datetime, 3)
+	for i, s := range []string{"2022-01-01 00:00:00", "2022-01-01 13:14:15", "2022-12-31 23:59:59"} {
+		xs[i], _ = types.ParseDatetime(s)
+	}
+	require.Equal(t, []uint8{0, 13, 23}, DatetimeToHour(xs, make([]uint8, 3)))
+}
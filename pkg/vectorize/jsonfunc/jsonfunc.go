@@ -0,0 +1,217 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonfunc
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/errno"
+	"github.com/matrixorigin/matrixone/pkg/sql/errors"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/broadcast"
+)
+
+var (
+	Extract      func([]bytejson.ByteJson, []*types.Bytes, *nulls.Nulls, *types.Bytes) (*types.Bytes, error)
+	Unquote      func([]bytejson.ByteJson, *nulls.Nulls, *types.Bytes) *types.Bytes
+	UnquoteBytes func(*types.Bytes, *nulls.Nulls, *types.Bytes) (*types.Bytes, error)
+	Contains     func([]bytejson.ByteJson, []bytejson.ByteJson, *types.Bytes, *nulls.Nulls, []int64) ([]int64, error)
+	Length       func([]bytejson.ByteJson, *types.Bytes, *nulls.Nulls, []int64) ([]int64, error)
+	Keys         func([]bytejson.ByteJson, *types.Bytes, *nulls.Nulls, *types.Bytes) (*types.Bytes, error)
+)
+
+func init() {
+	Extract = extract
+	Unquote = unquote
+	UnquoteBytes = unquoteBytes
+	Contains = contains
+	Length = length
+	Keys = keys
+}
+
+// extract returns the values of the documents matched by the paths, the rows matching nothing are set null
+func extract(docs []bytejson.ByteJson, paths []*types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error) {
+	cache := make(map[string]bytejson.Path)
+	ps := make([]bytejson.Path, len(paths))
+	for i, doc := range docs {
+		if nulls.Contains(nsp, uint64(i)) {
+			appendNull(rs)
+			continue
+		}
+		for j, xs := range paths {
+			p, err := path(cache, xs.Get(int64(broadcast.Index(i, len(xs.Offsets)))))
+			if err != nil {
+				return nil, err
+			}
+			ps[j] = p
+		}
+		v, ok := doc.Extract(ps)
+		if !ok {
+			nulls.Add(nsp, uint64(i))
+		}
+		appendBytes(rs, v)
+	}
+	return rs, nil
+}
+
+// unquote returns the text of the documents, the strings are unquoted
+func unquote(docs []bytejson.ByteJson, nsp *nulls.Nulls, rs *types.Bytes) *types.Bytes {
+	for i, doc := range docs {
+		if nulls.Contains(nsp, uint64(i)) {
+			appendNull(rs)
+			continue
+		}
+		appendBytes(rs, doc.Unquote())
+	}
+	return rs
+}
+
+// unquoteBytes unquotes the strings surrounded by the double quotes, the others are returned themselves
+func unquoteBytes(xs *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error) {
+	for i := range xs.Offsets {
+		if nulls.Contains(nsp, uint64(i)) {
+			appendNull(rs)
+			continue
+		}
+		v, err := bytejson.UnquoteString(xs.Get(int64(i)))
+		if err != nil {
+			return nil, err
+		}
+		appendBytes(rs, v)
+	}
+	return rs, nil
+}
+
+// contains returns 1 if the candidate is contained in the document or the value at the path of it,
+// the rows whose path matches nothing are set null, and the path must not contain the wildcards
+func contains(docs, candidates []bytejson.ByteJson, paths *types.Bytes, nsp *nulls.Nulls, rs []int64) ([]int64, error) {
+	cache := make(map[string]bytejson.Path)
+	for i, doc := range docs {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		if paths != nil {
+			var err error
+			var ok bool
+			if doc, ok, err = extractOne(cache, doc, paths.Get(int64(broadcast.Index(i, len(paths.Offsets))))); err != nil {
+				return nil, err
+			}
+			if !ok {
+				nulls.Add(nsp, uint64(i))
+				continue
+			}
+		}
+		if doc.Contains(candidates[i]) {
+			rs[i] = 1
+		} else {
+			rs[i] = 0
+		}
+	}
+	return rs, nil
+}
+
+// length returns the length of the document or the value at the path of it
+func length(docs []bytejson.ByteJson, paths *types.Bytes, nsp *nulls.Nulls, rs []int64) ([]int64, error) {
+	cache := make(map[string]bytejson.Path)
+	for i, doc := range docs {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		if paths != nil {
+			var err error
+			var ok bool
+			if doc, ok, err = extractOne(cache, doc, paths.Get(int64(broadcast.Index(i, len(paths.Offsets))))); err != nil {
+				return nil, err
+			}
+			if !ok {
+				nulls.Add(nsp, uint64(i))
+				continue
+			}
+		}
+		rs[i] = doc.Length()
+	}
+	return rs, nil
+}
+
+// keys returns the keys of the object or the object at the path of the document,
+// the rows which are not an object are set null
+func keys(docs []bytejson.ByteJson, paths *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error) {
+	cache := make(map[string]bytejson.Path)
+	for i, doc := range docs {
+		if nulls.Contains(nsp, uint64(i)) {
+			appendNull(rs)
+			continue
+		}
+		ok := true
+		if paths != nil {
+			var err error
+			if doc, ok, err = extractOne(cache, doc, paths.Get(int64(broadcast.Index(i, len(paths.Offsets))))); err != nil {
+				return nil, err
+			}
+		}
+		var v bytejson.ByteJson
+		if ok {
+			v, ok = doc.Keys()
+		}
+		if !ok {
+			nulls.Add(nsp, uint64(i))
+		}
+		appendBytes(rs, v)
+	}
+	return rs, nil
+}
+
+// extractOne returns the value at the path without wildcard of the document
+func extractOne(cache map[string]bytejson.Path, doc bytejson.ByteJson, s []byte) (bytejson.ByteJson, bool, error) {
+	p, err := path(cache, s)
+	if err != nil {
+		return nil, false, err
+	}
+	if p.ContainsWildcard() {
+		return nil, false, errWildcardPath(s)
+	}
+	v, ok := doc.Extract([]bytejson.Path{p})
+	return v, ok, nil
+}
+
+func errWildcardPath(s []byte) error {
+	return errors.New(errno.DataException, fmt.Sprintf("the path expression '%s' must not contain the wildcards", s))
+}
+
+// path returns the parsed path of s, the parsed paths are cached in cache
+func path(cache map[string]bytejson.Path, s []byte) (bytejson.Path, error) {
+	p, ok := cache[string(s)]
+	if !ok {
+		var err error
+		if p, err = bytejson.ParsePath(string(s)); err != nil {
+			return p, err
+		}
+		cache[string(s)] = p
+	}
+	return p, nil
+}
+
+func appendBytes(rs *types.Bytes, v []byte) {
+	rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+	rs.Lengths = append(rs.Lengths, uint32(len(v)))
+	rs.Data = append(rs.Data, v...)
+}
+
+func appendNull(rs *types.Bytes) {
+	rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+	rs.Lengths = append(rs.Lengths, 0)
+}
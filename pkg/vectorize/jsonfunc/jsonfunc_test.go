@@ -0,0 +1,83 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonfunc
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/bytejson"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtract(t *testing.T) {
+	docs := parseDocs(t, `{"a": [1, 2], "b": "x"}`, `[3]`)
+	nsp := new(nulls.Nulls)
+	rs, err := Extract(docs, []*types.Bytes{newBytes("$.a[1]")}, nsp, &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, "2", bytejson.ByteJson(rs.Get(0)).String())
+	require.True(t, nulls.Contains(nsp, 1))
+
+	_, err = Extract(docs, []*types.Bytes{newBytes("a")}, new(nulls.Nulls), &types.Bytes{})
+	require.Error(t, err)
+
+	rs = Unquote(docs, new(nulls.Nulls), &types.Bytes{})
+	require.Equal(t, "[3]", string(rs.Get(1)))
+	rs, err = UnquoteBytes(newBytes(`"a\tb"`, "c"), new(nulls.Nulls), &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, "a\tb", string(rs.Get(0)))
+	require.Equal(t, "c", string(rs.Get(1)))
+}
+
+func TestContainsLengthKeys(t *testing.T) {
+	docs := parseDocs(t, `{"a": [1, 2], "b": "x"}`, `[3]`)
+	nsp := new(nulls.Nulls)
+	rs, err := Contains(docs, parseDocs(t, `1`, `1`), newBytes("$.a"), nsp, make([]int64, 2))
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rs[0])
+	require.True(t, nulls.Contains(nsp, 1))
+
+	_, err = Contains(docs, docs, newBytes("$[*]"), new(nulls.Nulls), make([]int64, 2))
+	require.Error(t, err)
+
+	rs, err = Length(docs, nil, new(nulls.Nulls), make([]int64, 2))
+	require.NoError(t, err)
+	require.Equal(t, []int64{2, 1}, rs)
+
+	nsp = new(nulls.Nulls)
+	keys, err := Keys(docs, nil, nsp, &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, `["a", "b"]`, bytejson.ByteJson(keys.Get(0)).String())
+	require.True(t, nulls.Contains(nsp, 1))
+}
+
+func parseDocs(t *testing.T, ss ...string) []bytejson.ByteJson {
+	docs := make([]bytejson.ByteJson, len(ss))
+	for i, s := range ss {
+		doc, err := bytejson.ParseString(s)
+		require.NoError(t, err)
+		docs[i] = doc
+	}
+	return docs
+}
+
+func newBytes(ss ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, s := range ss {
+		appendBytes(xs, []byte(s))
+	}
+	return xs
+}
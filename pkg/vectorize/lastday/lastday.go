@@ -0,0 +1,42 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lastday
+
+import "github.com/matrixorigin/matrixone/pkg/container/types"
+
+// vectorize last_day function
+var (
+	DateToLastDay     func([]types.Date, []types.Date) []types.Date
+	DatetimeToLastDay func([]types.Datetime, []types.Date) []types.Date
+)
+
+func init() {
+	DateToLastDay = dateToLastDay
+	DatetimeToLastDay = datetimeToLastDay
+}
+
+func dateToLastDay(xs []types.Date, rs []types.Date) []types.Date {
+	for i, x := range xs {
+		rs[i] = x.LastDay()
+	}
+	return rs
+}
+
+func datetimeToLastDay(xs []types.Datetime, rs []types.Date) []types.Date {
+	for i, x := range xs {
+		rs[i] = x.ToDate().LastDay()
+	}
+	return rs
+}
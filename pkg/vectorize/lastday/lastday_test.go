@@ -0,0 +1,36 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lastday
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLastDay(t *testing.T) {
+	xs := make([]types.Date, 3)
+	for i, s := range []string{"2020-02-01", "2021-02-28", "2022-12-15"} {
+		xs[i], _ = types.ParseDate(s)
+	}
+	rs := DateToLastDay(xs, make([]types.Date, 3))
+	require.Equal(t, "2020-02-29", rs[0].String())
+	require.Equal(t, "2021-02-28", rs[1].String())
+	require.Equal(t, "2022-12-31", rs[2].String())
+
+	dt, _ := types.ParseDatetime("2022-04-10 10:00:00")
+	require.Equal(t, "2022-04-30", DatetimeToLastDay([]types.Datetime{dt}, make([]types.Date, 1))[0].String())
+}
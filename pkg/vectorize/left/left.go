@@ -0,0 +1,54 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package left
+
+import (
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/broadcast"
+)
+
+var (
+	LeftChar    func(*types.Bytes, []int64, *types.Bytes) *types.Bytes
+	LeftVarChar func(*types.Bytes, []int64, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	LeftChar = left
+	LeftVarChar = left
+}
+
+// left appends the leftmost ns characters of every string of xs to rs,
+// a single string or length is used for all the rows.
+func left(xs *types.Bytes, ns []int64, rs *types.Bytes) *types.Bytes {
+	rows := len(xs.Offsets)
+	if len(ns) > rows {
+		rows = len(ns)
+	}
+	for i := 0; i < rows; i++ {
+		x, n := xs.Get(int64(broadcast.Index(i, len(xs.Offsets)))), ns[broadcast.Index(i, len(ns))]
+		// the byte length of the leftmost n characters
+		end := 0
+		for ; n > 0 && end < len(x); n-- {
+			_, size := utf8.DecodeRune(x[end:])
+			end += size
+		}
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		rs.Data = append(rs.Data, x[:end]...)
+		rs.Lengths = append(rs.Lengths, uint32(end))
+	}
+	return rs
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locate
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/broadcast"
+)
+
+var (
+	// Locate returns the character position of the first substring in the string at or after the position,
+	// the position is 1 if the positions are nil
+	Locate func(substrs, strs *types.Bytes, pos []int64, rs []int64) []int64
+)
+
+func init() {
+	Locate = locate
+}
+
+// locate works as LOCATE(substr, str, pos) of MySQL,
+// a single substring, string or position is used for all the rows.
+// The positions are counted in characters from 1, and 0 means not found.
+func locate(substrs, strs *types.Bytes, pos []int64, rs []int64) []int64 {
+	for i := range rs {
+		substr := substrs.Get(int64(broadcast.Index(i, len(substrs.Offsets))))
+		str := strs.Get(int64(broadcast.Index(i, len(strs.Offsets))))
+		p := int64(1)
+		if pos != nil {
+			p = pos[broadcast.Index(i, len(pos))]
+		}
+		rs[i] = locateOne(substr, str, p)
+	}
+	return rs
+}
+
+func locateOne(substr, str []byte, pos int64) int64 {
+	if pos < 1 {
+		return 0
+	}
+	// skip the first pos-1 characters
+	start := 0
+	for n := pos - 1; n > 0; n-- {
+		if start >= len(str) {
+			return 0
+		}
+		_, size := utf8.DecodeRune(str[start:])
+		start += size
+	}
+	idx := bytes.Index(str[start:], substr)
+	if idx < 0 {
+		return 0
+	}
+	return pos + int64(utf8.RuneCount(str[start:start+idx]))
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locate
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocate(t *testing.T) {
+	substrs := makeBytes("bar", "xbar", "界", "", "b")
+	strs := makeBytes("foobarbar", "foobar", "你好世界", "abc", "")
+	require.Equal(t, []int64{4, 0, 4, 1, 0}, locate(substrs, strs, nil, make([]int64, 5)))
+
+	// with the positions
+	substrs = makeBytes("bar")
+	strs = makeBytes("foobarbar")
+	require.Equal(t, []int64{4, 7, 0, 0, 0}, locate(substrs, strs, []int64{1, 5, 8, 0, 100}, make([]int64, 5)))
+
+	substrs = makeBytes("", "界")
+	strs = makeBytes("世界世界", "世界世界")
+	require.Equal(t, []int64{3, 4}, locate(substrs, strs, []int64{3}, make([]int64, 2)))
+	require.Equal(t, []int64{5, 0}, locate(substrs, strs, []int64{5}, make([]int64, 2)))
+}
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, str := range strs {
+		xs.Offsets = append(xs.Offsets, uint32(len(xs.Data)))
+		xs.Data = append(xs.Data, str...)
+		xs.Lengths = append(xs.Lengths, uint32(len(str)))
+	}
+	return xs
+}
+
+func toStrings(xs *types.Bytes) []string {
+	strs := make([]string, len(xs.Offsets))
+	for i := range strs {
+		strs[i] = string(xs.Get(int64(i)))
+	}
+	return strs
+}
@@ -0,0 +1,46 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lower
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLower(t *testing.T) {
+	xs := makeBytes("HELLO", "", "Hello 世界", "ÄRGER")
+	rs := lower(xs, &types.Bytes{})
+	require.Equal(t, []string{"hello", "", "hello 世界", "ärger"}, toStrings(rs))
+}
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, str := range strs {
+		xs.Offsets = append(xs.Offsets, uint32(len(xs.Data)))
+		xs.Data = append(xs.Data, str...)
+		xs.Lengths = append(xs.Lengths, uint32(len(str)))
+	}
+	return xs
+}
+
+func toStrings(xs *types.Bytes) []string {
+	strs := make([]string, len(xs.Offsets))
+	for i := range strs {
+		strs[i] = string(xs.Get(int64(i)))
+	}
+	return strs
+}
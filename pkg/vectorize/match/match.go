@@ -13,3 +13,283 @@
 // limitations under the License.
 
 package match
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/broadcast"
+)
+
+// MaxCachedPatterns is the max number of the compiled patterns kept for the non-constant patterns
+const MaxCachedPatterns = 1024
+
+var (
+	// Sels returns the rows whose string matches the pattern, the null rows are skipped
+	Sels func(xs, pats, matchTypes *types.Bytes, nsp *nulls.Nulls, rs []int64) ([]int64, error)
+	// NotSels returns the rows whose string doesn't match the pattern, the null rows are skipped
+	NotSels func(xs, pats, matchTypes *types.Bytes, nsp *nulls.Nulls, rs []int64) ([]int64, error)
+	// Replace replaces the matches of the pattern from the position, all the matches are replaced if the occurrence is 0
+	Replace func(xs, pats, repls *types.Bytes, pos, occurrences []int64, matchTypes *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error)
+	// Substr returns the occurrence-th match of the pattern from the position, it is null if there is no such match
+	Substr func(xs, pats *types.Bytes, pos, occurrences []int64, matchTypes *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error)
+)
+
+func init() {
+	Sels = func(xs, pats, matchTypes *types.Bytes, nsp *nulls.Nulls, rs []int64) ([]int64, error) {
+		return sels(xs, pats, matchTypes, nsp, true, rs)
+	}
+	NotSels = func(xs, pats, matchTypes *types.Bytes, nsp *nulls.Nulls, rs []int64) ([]int64, error) {
+		return sels(xs, pats, matchTypes, nsp, false, rs)
+	}
+	Replace = replace
+	Substr = substr
+}
+
+/*
+Compile compiles the pattern with the match type of MySQL which is made of the flags:
+
+	c: case sensitive, it is the default
+	i: case insensitive
+	m: multiple line mode, ^ and $ match at the line terminators
+	n: . matches the line terminators
+	u: unix only line endings, it is the only mode of the regular expressions
+
+The later flag wins if c and i are both given.
+*/
+func Compile(pattern, matchType []byte) (*regexp.Regexp, error) {
+	var caseInsensitive, multiline, dotAll bool
+
+	for _, c := range matchType {
+		switch c {
+		case 'c':
+			caseInsensitive = false
+		case 'i':
+			caseInsensitive = true
+		case 'm':
+			multiline = true
+		case 'n':
+			dotAll = true
+		case 'u':
+		default:
+			return nil, fmt.Errorf("invalid match type '%c' of the regular expression", c)
+		}
+	}
+	flags := ""
+	if caseInsensitive {
+		flags += "i"
+	}
+	if multiline {
+		flags += "m"
+	}
+	if dotAll {
+		flags += "s"
+	}
+	expr := string(pattern)
+	if flags != "" {
+		expr = "(?" + flags + ")" + expr
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression '%s': %v", pattern, err)
+	}
+	return re, nil
+}
+
+// cache keeps the compiled patterns, it is cleared when it is full
+type cache struct {
+	sync.Mutex
+	res map[string]*regexp.Regexp
+}
+
+var patternCache = &cache{res: make(map[string]*regexp.Regexp)}
+
+func (c *cache) compile(pattern, matchType []byte) (*regexp.Regexp, error) {
+	// the match type is made of letters only
+	key := string(matchType) + ":" + string(pattern)
+	c.Lock()
+	re, ok := c.res[key]
+	c.Unlock()
+	if ok {
+		return re, nil
+	}
+	re, err := Compile(pattern, matchType)
+	if err != nil {
+		return nil, err
+	}
+	c.Lock()
+	if len(c.res) >= MaxCachedPatterns {
+		c.res = make(map[string]*regexp.Regexp)
+	}
+	c.res[key] = re
+	c.Unlock()
+	return re, nil
+}
+
+// patterns gives the compiled pattern of every row, the constant pattern is compiled once
+type patterns struct {
+	pats       *types.Bytes
+	matchTypes *types.Bytes
+	re         *regexp.Regexp
+}
+
+func newPatterns(pats, matchTypes *types.Bytes) (*patterns, error) {
+	p := &patterns{pats: pats, matchTypes: matchTypes}
+	if len(pats.Offsets) == 1 && (matchTypes == nil || len(matchTypes.Offsets) == 1) {
+		re, err := Compile(pats.Get(0), p.matchType(0))
+		if err != nil {
+			return nil, err
+		}
+		p.re = re
+	}
+	return p, nil
+}
+
+func (p *patterns) get(i int) (*regexp.Regexp, error) {
+	if p.re != nil {
+		return p.re, nil
+	}
+	return patternCache.compile(p.pats.Get(int64(broadcast.Index(i, len(p.pats.Offsets)))), p.matchType(i))
+}
+
+func (p *patterns) matchType(i int) []byte {
+	if p.matchTypes == nil {
+		return nil
+	}
+	return p.matchTypes.Get(int64(broadcast.Index(i, len(p.matchTypes.Offsets))))
+}
+
+func sels(xs, pats, matchTypes *types.Bytes, nsp *nulls.Nulls, want bool, rs []int64) ([]int64, error) {
+	ps, err := newPatterns(pats, matchTypes)
+	if err != nil {
+		return nil, err
+	}
+	rows := maxRows(xs, pats, matchTypes)
+	count := 0
+	for i := 0; i < rows; i++ {
+		if nulls.Contains(nsp, uint64(i)) {
+			continue
+		}
+		re, err := ps.get(i)
+		if err != nil {
+			return nil, err
+		}
+		if re.Match(xs.Get(int64(broadcast.Index(i, len(xs.Offsets))))) == want {
+			rs[count] = int64(i)
+			count++
+		}
+	}
+	return rs[:count], nil
+}
+
+func replace(xs, pats, repls *types.Bytes, pos, occurrences []int64, matchTypes *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error) {
+	ps, err := newPatterns(pats, matchTypes)
+	if err != nil {
+		return nil, err
+	}
+	rows := maxRows(xs, pats, repls, matchTypes)
+	for i := 0; i < rows; i++ {
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		if nulls.Contains(nsp, uint64(i)) {
+			rs.Lengths = append(rs.Lengths, 0)
+			continue
+		}
+		re, err := ps.get(i)
+		if err != nil {
+			return nil, err
+		}
+		x := xs.Get(int64(broadcast.Index(i, len(xs.Offsets))))
+		start, err := startOffset(x, intAt(pos, i, 1))
+		if err != nil {
+			return nil, err
+		}
+		repl := repls.Get(int64(broadcast.Index(i, len(repls.Offsets))))
+		occurrence := intAt(occurrences, i, 0)
+
+		rs.Data = append(rs.Data, x[:start]...)
+		rest, last := x[start:], 0
+		for n, m := range re.FindAllSubmatchIndex(rest, -1) {
+			if occurrence > 0 && int64(n+1) != occurrence {
+				continue
+			}
+			rs.Data = append(rs.Data, rest[last:m[0]]...)
+			rs.Data = re.Expand(rs.Data, repl, rest, m)
+			last = m[1]
+		}
+		rs.Data = append(rs.Data, rest[last:]...)
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-rs.Offsets[i])
+	}
+	return rs, nil
+}
+
+func substr(xs, pats *types.Bytes, pos, occurrences []int64, matchTypes *types.Bytes, nsp *nulls.Nulls, rs *types.Bytes) (*types.Bytes, error) {
+	ps, err := newPatterns(pats, matchTypes)
+	if err != nil {
+		return nil, err
+	}
+	rows := maxRows(xs, pats, matchTypes)
+	for i := 0; i < rows; i++ {
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		if nulls.Contains(nsp, uint64(i)) {
+			rs.Lengths = append(rs.Lengths, 0)
+			continue
+		}
+		re, err := ps.get(i)
+		if err != nil {
+			return nil, err
+		}
+		x := xs.Get(int64(broadcast.Index(i, len(xs.Offsets))))
+		start, err := startOffset(x, intAt(pos, i, 1))
+		if err != nil {
+			return nil, err
+		}
+		occurrence := intAt(occurrences, i, 1)
+		if occurrence < 1 {
+			occurrence = 1
+		}
+		ms := re.FindAllIndex(x[start:], int(occurrence))
+		if int64(len(ms)) < occurrence {
+			nulls.Add(nsp, uint64(i))
+			rs.Lengths = append(rs.Lengths, 0)
+			continue
+		}
+		m := ms[occurrence-1]
+		rs.Data = append(rs.Data, x[start+m[0]:start+m[1]]...)
+		rs.Lengths = append(rs.Lengths, uint32(m[1]-m[0]))
+	}
+	return rs, nil
+}
+
+// startOffset returns the byte offset of the character position which is counted from 1
+func startOffset(x []byte, pos int64) (int, error) {
+	if pos < 1 {
+		return 0, fmt.Errorf("the position %d of the regular expression is out of bounds", pos)
+	}
+	offset := 0
+	for ; pos > 1 && offset < len(x); pos-- {
+		_, size := utf8.DecodeRune(x[offset:])
+		offset += size
+	}
+	return offset, nil
+}
+
+func intAt(xs []int64, i int, def int64) int64 {
+	if xs == nil {
+		return def
+	}
+	return xs[broadcast.Index(i, len(xs))]
+}
+
+func maxRows(xss ...*types.Bytes) int {
+	rows := 0
+	for _, xs := range xss {
+		if xs != nil && len(xs.Offsets) > rows {
+			rows = len(xs.Offsets)
+		}
+	}
+	return rows
+}
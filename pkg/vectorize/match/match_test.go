@@ -0,0 +1,129 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package match
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	re, err := Compile([]byte("^abc$"), nil)
+	require.NoError(t, err)
+	require.False(t, re.MatchString("ABC"))
+
+	re, err = Compile([]byte("^abc$"), []byte("i"))
+	require.NoError(t, err)
+	require.True(t, re.MatchString("ABC"))
+
+	re, err = Compile([]byte("^abc$"), []byte("ic"))
+	require.NoError(t, err)
+	require.False(t, re.MatchString("ABC"))
+
+	re, err = Compile([]byte("^b$"), []byte("m"))
+	require.NoError(t, err)
+	require.True(t, re.MatchString("a\nb\nc"))
+
+	re, err = Compile([]byte("a.b"), []byte("n"))
+	require.NoError(t, err)
+	require.True(t, re.MatchString("a\nb"))
+
+	_, err = Compile([]byte("a"), []byte("x"))
+	require.Error(t, err)
+	_, err = Compile([]byte("a("), nil)
+	require.Error(t, err)
+}
+
+func TestSels(t *testing.T) {
+	xs := makeBytes("error: disk", "info: ok", "ERROR: net", "")
+	nsp := new(nulls.Nulls)
+	nulls.Add(nsp, 3)
+
+	rs, err := Sels(xs, makeBytes("^error"), nil, nsp, make([]int64, 4))
+	require.NoError(t, err)
+	require.Equal(t, []int64{0}, rs)
+
+	rs, err = Sels(xs, makeBytes("^error"), makeBytes("i"), nsp, make([]int64, 4))
+	require.NoError(t, err)
+	require.Equal(t, []int64{0, 2}, rs)
+
+	rs, err = NotSels(xs, makeBytes("^error"), nil, nsp, make([]int64, 4))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2}, rs)
+
+	// the patterns of the rows
+	rs, err = Sels(xs, makeBytes("disk", "disk", "net", "x"), nil, nsp, make([]int64, 4))
+	require.NoError(t, err)
+	require.Equal(t, []int64{0, 2}, rs)
+
+	_, err = Sels(xs, makeBytes("a(", "b", "c", "d"), nil, new(nulls.Nulls), make([]int64, 4))
+	require.Error(t, err)
+}
+
+func TestReplace(t *testing.T) {
+	xs := makeBytes("abc def ghi", "世界 世界", "aaa")
+	rs, err := Replace(xs, makeBytes("[a-z]+"), makeBytes("X"), nil, nil, nil, new(nulls.Nulls), &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"X X X", "世界 世界", "X"}, toStrings(rs))
+
+	// the position, occurrence and the back references
+	rs, err = Replace(xs, makeBytes("(\\pL)(\\pL)"), makeBytes("${2}${1}"), []int64{2}, []int64{1}, nil, new(nulls.Nulls), &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"acb def ghi", "世界 界世", "aaa"}, toStrings(rs))
+
+	rs, err = Replace(xs, makeBytes("A"), makeBytes("b"), nil, []int64{2}, makeBytes("i"), new(nulls.Nulls), &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"abc def ghi", "世界 世界", "aba"}, toStrings(rs))
+
+	_, err = Replace(xs, makeBytes("a"), makeBytes("b"), []int64{0}, nil, nil, new(nulls.Nulls), &types.Bytes{})
+	require.Error(t, err)
+}
+
+func TestSubstr(t *testing.T) {
+	xs := makeBytes("abc def ghi", "世界 你好", "123")
+	nsp := new(nulls.Nulls)
+	rs, err := Substr(xs, makeBytes("\\pL+"), nil, nil, nil, nsp, &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"abc", "世界", ""}, toStrings(rs))
+	require.True(t, nulls.Contains(nsp, 2))
+
+	nsp = new(nulls.Nulls)
+	rs, err = Substr(xs, makeBytes("\\pL+"), []int64{2}, []int64{2}, nil, nsp, &types.Bytes{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"def", "你好", ""}, toStrings(rs))
+	require.False(t, nulls.Contains(nsp, 0))
+	require.True(t, nulls.Contains(nsp, 2))
+}
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, str := range strs {
+		xs.Offsets = append(xs.Offsets, uint32(len(xs.Data)))
+		xs.Data = append(xs.Data, str...)
+		xs.Lengths = append(xs.Lengths, uint32(len(str)))
+	}
+	return xs
+}
+
+func toStrings(xs *types.Bytes) []string {
+	strs := make([]string, len(xs.Offsets))
+	for i := range strs {
+		strs[i] = string(xs.Get(int64(i)))
+	}
+	return strs
+}
@@ -164,8 +164,8 @@ func decimal64Mul(xs []types.Decimal64, ys []types.Decimal64, rs []types.Decimal
 }
 
 func decimal64MulSels(xs, ys []types.Decimal64, rs []types.Decimal128, sels []int64) []types.Decimal128 {
-	for i, sel := range sels {
-		rs[i] = types.Decimal64Decimal64Mul(xs[sel], ys[sel])
+	for _, sel := range sels {
+		rs[sel] = types.Decimal64Decimal64Mul(xs[sel], ys[sel])
 	}
 	return rs
 }
@@ -178,36 +178,48 @@ func decimal64MulScalar(x types.Decimal64, ys []types.Decimal64, rs []types.Deci
 }
 
 func decimal64MulScalarSels(x types.Decimal64, ys []types.Decimal64, rs []types.Decimal128, sels []int64) []types.Decimal128 {
-	for i, sel := range sels {
-		rs[i] = types.Decimal64Decimal64Mul(x, ys[sel])
+	for _, sel := range sels {
+		rs[sel] = types.Decimal64Decimal64Mul(x, ys[sel])
 	}
 	return rs
 }
 
-func decimal128Mul(xs []types.Decimal128, ys []types.Decimal128, rs []types.Decimal128) []types.Decimal128 {
+func decimal128Mul(xs, ys []types.Decimal128, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
 	for i, x := range xs {
-		rs[i] = types.Decimal128Decimal128Mul(x, ys[i])
+		if rs[i], err = types.Decimal128Decimal128Mul(x, ys[i]); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal128MulSels(xs, ys []types.Decimal128, rs []types.Decimal128, sels []int64) []types.Decimal128 {
-	for i, sel := range sels {
-		rs[i] = types.Decimal128Decimal128Mul(xs[sel], ys[sel])
+func decimal128MulSels(xs, ys []types.Decimal128, rs []types.Decimal128, sels []int64) ([]types.Decimal128, error) {
+	var err error
+	for _, sel := range sels {
+		if rs[sel], err = types.Decimal128Decimal128Mul(xs[sel], ys[sel]); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal128MulScalar(x types.Decimal128, ys []types.Decimal128, rs []types.Decimal128) []types.Decimal128 {
+func decimal128MulScalar(x types.Decimal128, ys []types.Decimal128, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
 	for i, y := range ys {
-		rs[i] = types.Decimal128Decimal128Mul(x, y)
+		if rs[i], err = types.Decimal128Decimal128Mul(x, y); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal128MulScalarSels(x types.Decimal128, ys []types.Decimal128, rs []types.Decimal128, sels []int64) []types.Decimal128 {
-	for i, sel := range sels {
-		rs[i] = types.Decimal128Decimal128Mul(x, ys[sel])
+func decimal128MulScalarSels(x types.Decimal128, ys []types.Decimal128, rs []types.Decimal128, sels []int64) ([]types.Decimal128, error) {
+	var err error
+	for _, sel := range sels {
+		if rs[sel], err = types.Decimal128Decimal128Mul(x, ys[sel]); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
@@ -0,0 +1,61 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repeat
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/broadcast"
+)
+
+// MaxResultLength is the max length of a repeated string, the longer results are null
+const MaxResultLength = 16 << 20
+
+var (
+	Repeat func(*types.Bytes, []int64, *types.Bytes, *nulls.Nulls) *types.Bytes
+)
+
+func init() {
+	Repeat = repeat
+}
+
+// repeat appends every string of xs repeated ns times to rs,
+// a single string or count is used for all the rows.
+// The count less than 1 gives the empty string, and the results
+// longer than MaxResultLength are set null in nsp.
+func repeat(xs *types.Bytes, ns []int64, rs *types.Bytes, nsp *nulls.Nulls) *types.Bytes {
+	rows := len(xs.Offsets)
+	if len(ns) > rows {
+		rows = len(ns)
+	}
+	for i := 0; i < rows; i++ {
+		x, n := xs.Get(int64(broadcast.Index(i, len(xs.Offsets)))), ns[broadcast.Index(i, len(ns))]
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		if n < 1 || len(x) == 0 {
+			rs.Lengths = append(rs.Lengths, 0)
+			continue
+		}
+		if n > int64(MaxResultLength/len(x)) {
+			nulls.Add(nsp, uint64(i))
+			rs.Lengths = append(rs.Lengths, 0)
+			continue
+		}
+		for j := int64(0); j < n; j++ {
+			rs.Data = append(rs.Data, x...)
+		}
+		rs.Lengths = append(rs.Lengths, uint32(int64(len(x))*n))
+	}
+	return rs
+}
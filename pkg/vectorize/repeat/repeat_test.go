@@ -0,0 +1,55 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repeat
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepeat(t *testing.T) {
+	nsp := new(nulls.Nulls)
+	rs := repeat(makeBytes("ab", "世", "x", "", "y"), []int64{3, 2, 0, 5, MaxResultLength + 1}, &types.Bytes{}, nsp)
+	require.Equal(t, []string{"ababab", "世世", "", "", ""}, toStrings(rs))
+	require.False(t, nulls.Contains(nsp, 0))
+	require.True(t, nulls.Contains(nsp, 4))
+
+	// the constant string and count
+	rs = repeat(makeBytes("ab"), []int64{1, 2}, &types.Bytes{}, nsp)
+	require.Equal(t, []string{"ab", "abab"}, toStrings(rs))
+	rs = repeat(makeBytes("a", "b"), []int64{2}, &types.Bytes{}, nsp)
+	require.Equal(t, []string{"aa", "bb"}, toStrings(rs))
+}
+
+func makeBytes(strs ...string) *types.Bytes {
+	xs := &types.Bytes{}
+	for _, str := range strs {
+		xs.Offsets = append(xs.Offsets, uint32(len(xs.Data)))
+		xs.Data = append(xs.Data, str...)
+		xs.Lengths = append(xs.Lengths, uint32(len(str)))
+	}
+	return xs
+}
+
+func toStrings(xs *types.Bytes) []string {
+	strs := make([]string, len(xs.Offsets))
+	for i := range strs {
+		strs[i] = string(xs.Get(int64(i)))
+	}
+	return strs
+}
@@ -0,0 +1,60 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package replace
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/broadcast"
+)
+
+var (
+	Replace func(xs, froms, tos, rs *types.Bytes) *types.Bytes
+)
+
+func init() {
+	Replace = replace
+}
+
+// replace appends every string of xs with all the occurrences of from replaced by to,
+// a single string, from or to is used for all the rows.
+// The string is unchanged if from is empty.
+func replace(xs, froms, tos, rs *types.Bytes) *types.Bytes {
+	rows := maxRows(xs, froms, tos)
+	for i := 0; i < rows; i++ {
+		x := xs.Get(int64(broadcast.Index(i, len(xs.Offsets))))
+		from := froms.Get(int64(broadcast.Index(i, len(froms.Offsets))))
+		to := tos.Get(int64(broadcast.Index(i, len(tos.Offsets))))
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		if len(from) == 0 {
+			rs.Data = append(rs.Data, x...)
+		} else {
+			rs.Data = append(rs.Data, bytes.ReplaceAll(x, from, to)...)
+		}
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-rs.Offsets[i])
+	}
+	return rs
+}
+
+func maxRows(xss ...*types.Bytes) int {
+	rows := 0
+	for _, xs := range xss {
+		if len(xs.Offsets) > rows {
+			rows = len(xs.Offsets)
+		}
+	}
+	return rows
+}
@@ -25,11 +25,13 @@ package round
 	round(x) == round(x, 0)
 	N < 0, N zeroes in front of decimal point
 	N >= 0, round to the Nth placeholder after decimal point
+	decimals are rounded half away from zero as MySQL does, and the scale of the result is min(max(N, 0), scale).
 */
 
 import (
 	"math"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vectorize/floor"
 )
 
@@ -44,6 +46,9 @@ var (
 	RoundInt64   func([]int64, []int64, int64) []int64
 	RoundFloat32 func([]float32, []float32, int64) []float32
 	RoundFloat64 func([]float64, []float64, int64) []float64
+
+	RoundDecimal64  = roundDecimal64
+	RoundDecimal128 = roundDecimal128
 )
 
 func init() {
@@ -332,3 +337,55 @@ func roundFloat64(xs []float64, rs []float64, digits int64) []float64 {
 	}
 	return rs
 }
+
+// DecimalResultScale returns the scale of decimals of the given scale rounded to digits places
+func DecimalResultScale(scale int32, digits int64) int32 {
+	switch {
+	case digits < 0:
+		return 0
+	case digits < int64(scale):
+		return int32(digits)
+	}
+	return scale
+}
+
+// decimalDigits clamps digits into the range in which it affects a decimal of the given scale.
+func decimalDigits(scale int32, digits int64) int32 {
+	switch {
+	case digits < -40:
+		return -40
+	case digits > int64(scale):
+		return scale
+	}
+	return int32(digits)
+}
+
+func roundDecimal64(xs []types.Decimal64, rs []types.Decimal64, digits int64, scale int32) ([]types.Decimal64, error) {
+	d := decimalDigits(scale, digits)
+	resultScale := DecimalResultScale(scale, digits)
+	for i, x := range xs {
+		r, err := types.RescaleDecimal64(x, scale, d)
+		if err != nil {
+			return nil, err
+		}
+		if rs[i], err = types.RescaleDecimal64(r, d, resultScale); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func roundDecimal128(xs []types.Decimal128, rs []types.Decimal128, digits int64, scale int32) ([]types.Decimal128, error) {
+	d := decimalDigits(scale, digits)
+	resultScale := DecimalResultScale(scale, digits)
+	for i, x := range xs {
+		r, err := types.RescaleDecimal128(x, scale, d)
+		if err != nil {
+			return nil, err
+		}
+		if rs[i], err = types.RescaleDecimal128(r, d, resultScale); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
@@ -18,6 +18,7 @@ import (
 	"math"
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -213,3 +214,35 @@ func TestRoundFloat64(t *testing.T) {
 		assert.True(t, floatCompare(correctRes1[i], res1[i]))
 	}
 }
+
+func TestRoundDecimal64(t *testing.T) {
+	// 1.25, -1.25, 1.24, 12.35 with scale 2
+	nums := []types.Decimal64{125, -125, 124, 1235}
+	res := make([]types.Decimal64, len(nums))
+	_, err := RoundDecimal64(nums, res, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, []types.Decimal64{13, -13, 12, 124}, res)
+	require.Equal(t, int32(1), DecimalResultScale(2, 1))
+
+	_, err = RoundDecimal64(nums, res, -1, 2)
+	require.NoError(t, err)
+	require.Equal(t, []types.Decimal64{0, 0, 0, 10}, res)
+	require.Equal(t, int32(0), DecimalResultScale(2, -1))
+
+	_, err = RoundDecimal64(nums, res, 5, 2)
+	require.NoError(t, err)
+	require.Equal(t, nums, res)
+	require.Equal(t, int32(2), DecimalResultScale(2, 5))
+}
+
+func TestRoundDecimal128(t *testing.T) {
+	nums := []types.Decimal128{types.InitDecimal128(155), types.InitDecimal128(-155), types.InitDecimal128(154)}
+	res := make([]types.Decimal128, len(nums))
+	_, err := RoundDecimal128(nums, res, 0, 2)
+	require.NoError(t, err)
+	require.Equal(t, []types.Decimal128{types.InitDecimal128(2), types.InitDecimal128(-2), types.InitDecimal128(2)}, res)
+
+	_, err = RoundDecimal128(nums, res, -100, 2)
+	require.NoError(t, err)
+	require.Equal(t, []types.Decimal128{types.InitDecimal128(0), types.InitDecimal128(0), types.InitDecimal128(0)}, res)
+}
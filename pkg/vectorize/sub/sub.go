@@ -15,8 +15,6 @@
 package sub
 
 import (
-	"math"
-
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"golang.org/x/exp/constraints"
 )
@@ -252,244 +250,123 @@ func numericSubByScalarSelsSmallBig[TSmall, TBig constraints.Integer | constrain
 }
 */
 
-func decimal64Sub(xs []types.Decimal64, ys []types.Decimal64, xsScale int32, ysScale int32, rs []types.Decimal64) []types.Decimal64 {
-	if xsScale > ysScale {
-		ysScaled := make([]types.Decimal64, len(ys))
-		scaleDiff := xsScale - ysScale
-		scale := int64(math.Pow10(int(scaleDiff)))
-		for i, y := range ys {
-			ysScaled[i] = types.ScaleDecimal64(y, scale)
-		}
-		for i, x := range xs {
-			rs[i] = types.Decimal64SubAligned(x, ysScaled[i])
-		}
-		return rs
-	} else if xsScale < ysScale {
-		xsScaled := make([]types.Decimal64, len(xs))
-		scaleDiff := ysScale - xsScale
-		scale := int64(math.Pow10(int(scaleDiff)))
-		for i, x := range xs {
-			xsScaled[i] = types.ScaleDecimal64(x, scale)
-		}
-		for i, y := range ys {
-			rs[i] = types.Decimal64SubAligned(xsScaled[i], y)
-		}
-		return rs
-	} else {
-		for i, x := range xs {
-			rs[i] = types.Decimal64SubAligned(x, ys[i])
+// subtraction aligns both operands to the same scale first, an error is returned on overflow
+func decimal64Sub(xs, ys []types.Decimal64, xsScale, ysScale int32, rs []types.Decimal64) ([]types.Decimal64, error) {
+	var err error
+	for i, x := range xs {
+		if rs[i], err = types.Decimal64Sub(x, ys[i], xsScale, ysScale); err != nil {
+			return nil, err
 		}
-		return rs
 	}
+	return rs, nil
 }
 
-func decimal64SubSels(xs, ys []types.Decimal64, xsScale, ysScale int32, rs []types.Decimal64, sels []int64) []types.Decimal64 {
-	for i, sel := range sels {
-		rs[i] = types.Decimal64Sub(xs[sel], ys[sel], xsScale, ysScale)
+func decimal64SubSels(xs, ys []types.Decimal64, xsScale, ysScale int32, rs []types.Decimal64, sels []int64) ([]types.Decimal64, error) {
+	var err error
+	for _, sel := range sels {
+		if rs[sel], err = types.Decimal64Sub(xs[sel], ys[sel], xsScale, ysScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal64SubScalar(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal64) []types.Decimal64 {
-	if xScale > ysScale {
-		ysScaled := make([]types.Decimal64, len(ys))
-		scaleDiff := xScale - ysScale
-		scale := int64(math.Pow10(int(scaleDiff)))
-		for i, y := range ys {
-			ysScaled[i] = types.ScaleDecimal64(y, scale)
-		}
-		for i, yScaled := range ysScaled {
-			rs[i] = types.Decimal64SubAligned(x, yScaled)
-		}
-		return rs
-	} else if xScale < ysScale {
-		scaleDiff := ysScale - xScale
-		scale := int64(math.Pow10(int(scaleDiff)))
-		xScaled := types.ScaleDecimal64(x, scale)
-		for i, y := range ys {
-			rs[i] = types.Decimal64SubAligned(xScaled, y)
-		}
-		return rs
-	} else {
-		for i, y := range ys {
-			rs[i] = types.Decimal64SubAligned(x, y)
+func decimal64SubScalar(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal64) ([]types.Decimal64, error) {
+	var err error
+	for i, y := range ys {
+		if rs[i], err = types.Decimal64Sub(x, y, xScale, ysScale); err != nil {
+			return nil, err
 		}
-		return rs
 	}
+	return rs, nil
 }
 
-func decimal64SubScalarSels(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal64, sels []int64) []types.Decimal64 {
-	for i, sel := range sels {
-		rs[i] = types.Decimal64Sub(x, ys[sel], xScale, ysScale)
+func decimal64SubScalarSels(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal64, sels []int64) ([]types.Decimal64, error) {
+	var err error
+	for _, sel := range sels {
+		if rs[sel], err = types.Decimal64Sub(x, ys[sel], xScale, ysScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal64SubByScalar(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal64) []types.Decimal64 {
-	if xScale > ysScale {
-		ysScaled := make([]types.Decimal64, len(ys))
-		scaleDiff := xScale - ysScale
-		scale := int64(math.Pow10(int(scaleDiff)))
-		for i, y := range ys {
-			ysScaled[i] = types.ScaleDecimal64(y, scale)
-		}
-		for i, yScaled := range ysScaled {
-			rs[i] = types.Decimal64SubAligned(yScaled, x)
-		}
-		return rs
-	} else if xScale < ysScale {
-		scaleDiff := ysScale - xScale
-		scale := int64(math.Pow10(int(scaleDiff)))
-		xScaled := types.ScaleDecimal64(x, scale)
-		for i, y := range ys {
-			rs[i] = types.Decimal64SubAligned(y, xScaled)
-		}
-		return rs
-	} else {
-		for i, y := range ys {
-			rs[i] = types.Decimal64SubAligned(y, x)
+func decimal64SubByScalar(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal64) ([]types.Decimal64, error) {
+	var err error
+	for i, y := range ys {
+		if rs[i], err = types.Decimal64Sub(y, x, ysScale, xScale); err != nil {
+			return nil, err
 		}
-		return rs
 	}
+	return rs, nil
 }
 
-func decimal64SubByScalarSels(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal64, sels []int64) []types.Decimal64 {
-	for i, sel := range sels {
-		rs[i] = types.Decimal64Sub(ys[sel], x, ysScale, xScale)
+func decimal64SubByScalarSels(x types.Decimal64, ys []types.Decimal64, xScale, ysScale int32, rs []types.Decimal64, sels []int64) ([]types.Decimal64, error) {
+	var err error
+	for _, sel := range sels {
+		if rs[sel], err = types.Decimal64Sub(ys[sel], x, ysScale, xScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal128Sub(xs []types.Decimal128, ys []types.Decimal128, xsScale int32, ysScale int32, rs []types.Decimal128) []types.Decimal128 {
-	/* to add two decimal128 value, first we need to align them to the same scale(the maximum of the two)
-																	Decimal(20, 5), Decimal(20, 6)
-	value																321.4			123.5
-	representation														32,140,000		123,500,000
-	align to the same scale	by scale 12340000 by 10 321400000			321,400,000		123,500,000
-	add
-
-	*/
-	if xsScale > ysScale {
-		ysScaled := make([]types.Decimal128, len(ys))
-		scaleDiff := xsScale - ysScale
-		for i, y := range ys {
-			ysScaled[i] = y
-			// since the possible scale difference is (0, 38], and 10**38 can not fit in a int64, double loop is necessary
-			for j := 0; j < int(scaleDiff); j++ {
-				ysScaled[i] = types.ScaleDecimal128By10(ysScaled[i])
-			}
-		}
-		for i, x := range xs {
-			rs[i] = types.Decimal128SubAligned(x, ysScaled[i])
-		}
-		return rs
-	} else if xsScale < ysScale {
-		xsScaled := make([]types.Decimal128, len(xs))
-		scaleDiff := ysScale - xsScale
-		for i, x := range xs {
-			xsScaled[i] = x
-			// since the possible scale difference is (0, 38], and 10**38 can not fit in a int64, double loop is necessary
-			for j := 0; j < int(scaleDiff); j++ {
-				xsScaled[i] = types.ScaleDecimal128By10(xsScaled[i])
-			}
-		}
-		for i, y := range ys {
-			rs[i] = types.Decimal128SubAligned(xsScaled[i], y)
-		}
-		return rs
-	} else {
-		for i, x := range xs {
-			rs[i] = types.Decimal128SubAligned(x, ys[i])
+func decimal128Sub(xs, ys []types.Decimal128, xsScale, ysScale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+	for i, x := range xs {
+		if rs[i], err = types.Decimal128Sub(x, ys[i], xsScale, ysScale); err != nil {
+			return nil, err
 		}
-		return rs
 	}
+	return rs, nil
 }
 
-func decimal128SubSels(xs, ys []types.Decimal128, xsScale, ysScale int32, rs []types.Decimal128, sels []int64) []types.Decimal128 {
-	for i, sel := range sels {
-		rs[i] = types.Decimal128Sub(xs[sel], ys[sel], xsScale, ysScale)
+func decimal128SubSels(xs, ys []types.Decimal128, xsScale, ysScale int32, rs []types.Decimal128, sels []int64) ([]types.Decimal128, error) {
+	var err error
+	for _, sel := range sels {
+		if rs[sel], err = types.Decimal128Sub(xs[sel], ys[sel], xsScale, ysScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal128SubScalar(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128) []types.Decimal128 {
-	if xScale > ysScale {
-		ysScaled := make([]types.Decimal128, len(ys))
-		scaleDiff := xScale - ysScale
-		for i, y := range ys {
-			ysScaled[i] = y
-			// since the possible scale difference is (0, 38], and 10**38 can not fit in a int64, double loop is necessary
-			for j := 0; j < int(scaleDiff); j++ {
-				ysScaled[i] = types.ScaleDecimal128By10(ysScaled[i])
-			}
-		}
-		for i, yScaled := range ysScaled {
-			rs[i] = types.Decimal128SubAligned(x, yScaled)
-		}
-		return rs
-	} else if xScale < ysScale {
-		xScaled := x
-		scaleDiff := ysScale - xScale
-		// since the possible scale difference is (0, 38], and 10**38 can not fit in a int64, double loop is necessary
-		for i := 0; i < int(scaleDiff); i++ {
-			xScaled = types.ScaleDecimal128By10(xScaled)
-		}
-		for i, y := range ys {
-			rs[i] = types.Decimal128SubAligned(xScaled, y)
-		}
-		return rs
-	} else {
-		for i, y := range ys {
-			rs[i] = types.Decimal128SubAligned(x, y)
+func decimal128SubScalar(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+	for i, y := range ys {
+		if rs[i], err = types.Decimal128Sub(x, y, xScale, ysScale); err != nil {
+			return nil, err
 		}
-		return rs
 	}
+	return rs, nil
 }
 
-func decimal128SubScalarSels(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128, sels []int64) []types.Decimal128 {
-	for i, sel := range sels {
-		rs[i] = types.Decimal128Sub(x, ys[sel], xScale, ysScale)
+func decimal128SubScalarSels(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128, sels []int64) ([]types.Decimal128, error) {
+	var err error
+	for _, sel := range sels {
+		if rs[sel], err = types.Decimal128Sub(x, ys[sel], xScale, ysScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
 
-func decimal128SubByScalar(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128) []types.Decimal128 {
-	if xScale > ysScale {
-		ysScaled := make([]types.Decimal128, len(ys))
-		scaleDiff := xScale - ysScale
-		for i, y := range ys {
-			ysScaled[i] = y
-			// since the possible scale difference is (0, 38], and 10**38 can not fit in a int64, double loop is necessary
-			for j := 0; j < int(scaleDiff); j++ {
-				ysScaled[i] = types.ScaleDecimal128By10(ysScaled[i])
-			}
-		}
-		for i, yScaled := range ysScaled {
-			rs[i] = types.Decimal128SubAligned(yScaled, x)
-		}
-		return rs
-	} else if xScale < ysScale {
-		xScaled := x
-		scaleDiff := ysScale - xScale
-		// since the possible scale difference is (0, 38], and 10**38 can not fit in a int64, double loop is necessary
-		for i := 0; i < int(scaleDiff); i++ {
-			xScaled = types.ScaleDecimal128By10(xScaled)
-		}
-		for i, y := range ys {
-			rs[i] = types.Decimal128SubAligned(y, xScaled)
-		}
-		return rs
-	} else {
-		for i, y := range ys {
-			rs[i] = types.Decimal128SubAligned(y, x)
+func decimal128SubByScalar(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+	for i, y := range ys {
+		if rs[i], err = types.Decimal128Sub(y, x, ysScale, xScale); err != nil {
+			return nil, err
 		}
-		return rs
 	}
+	return rs, nil
 }
 
-func decimal128SubByScalarSels(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128, sels []int64) []types.Decimal128 {
-	for i, sel := range sels {
-		rs[i] = types.Decimal128Sub(ys[sel], x, ysScale, xScale)
+func decimal128SubByScalarSels(x types.Decimal128, ys []types.Decimal128, xScale, ysScale int32, rs []types.Decimal128, sels []int64) ([]types.Decimal128, error) {
+	var err error
+	for _, sel := range sels {
+		if rs[sel], err = types.Decimal128Sub(ys[sel], x, ysScale, xScale); err != nil {
+			return nil, err
+		}
 	}
-	return rs
+	return rs, nil
 }
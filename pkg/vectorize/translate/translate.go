@@ -0,0 +1,76 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translate
+
+import (
+	"unicode/utf8"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vectorize/broadcast"
+)
+
+var (
+	Translate func(xs, froms, tos, rs *types.Bytes) *types.Bytes
+)
+
+func init() {
+	Translate = translate
+}
+
+// translate appends every string of xs with each character in from replaced by the
+// corresponding character in to, the characters in from without a corresponding
+// character in to are removed. A single string, from or to is used for all the rows.
+func translate(xs, froms, tos, rs *types.Bytes) *types.Bytes {
+	rows := maxRows(xs, froms, tos)
+	for i := 0; i < rows; i++ {
+		x := xs.Get(int64(broadcast.Index(i, len(xs.Offsets))))
+		from := []rune(string(froms.Get(int64(broadcast.Index(i, len(froms.Offsets))))))
+		to := []rune(string(tos.Get(int64(broadcast.Index(i, len(tos.Offsets))))))
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		for len(x) > 0 {
+			r, size := utf8.DecodeRune(x)
+			j := indexRune(from, r)
+			switch {
+			case j < 0:
+				rs.Data = append(rs.Data, x[:size]...)
+			case j < len(to):
+				rs.Data = utf8.AppendRune(rs.Data, to[j])
+			}
+			x = x[size:]
+		}
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-rs.Offsets[i])
+	}
+	return rs
+}
+
+// indexRune returns the first index of r in rs, the first occurrence wins if r is repeated
+func indexRune(rs []rune, r rune) int {
+	for i := range rs {
+		if rs[i] == r {
+			return i
+		}
+	}
+	return -1
+}
+
+func maxRows(xss ...*types.Bytes) int {
+	rows := 0
+	for _, xs := range xss {
+		if len(xs.Offsets) > rows {
+			rows = len(xs.Offsets)
+		}
+	}
+	return rows
+}
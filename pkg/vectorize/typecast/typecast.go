@@ -144,7 +144,19 @@ var (
 	BytesToFloat64 = bytesToFloat[float64]
 	Float64ToBytes = floatToBytes[float64]
 
-	Decimal64ToDecimal128 = decimal64ToDecimal128Pure
+	Decimal64ToDecimal128  = decimal64ToDecimal128Pure
+	Decimal64ToDecimal64   = decimal64ToDecimal64
+	Decimal128ToDecimal64  = decimal128ToDecimal64
+	Decimal128ToDecimal128 = decimal128ToDecimal128
+
+	Int8ToDecimal64   = intToDecimal64[int8]
+	Int16ToDecimal64  = intToDecimal64[int16]
+	Int32ToDecimal64  = intToDecimal64[int32]
+	Int64ToDecimal64  = intToDecimal64[int64]
+	Uint8ToDecimal64  = uintToDecimal64[uint8]
+	Uint16ToDecimal64 = uintToDecimal64[uint16]
+	Uint32ToDecimal64 = uintToDecimal64[uint32]
+	Uint64ToDecimal64 = uintToDecimal64[uint64]
 
 	Int8ToDecimal128   = intToDecimal128[int8]
 	Int16ToDecimal128  = intToDecimal128[int16]
@@ -155,6 +167,20 @@ var (
 	Uint32ToDecimal128 = uintToDecimal128[uint32]
 	Uint64ToDecimal128 = uintToDecimal128[uint64]
 
+	Decimal64ToFloat32  = decimal64ToFloat[float32]
+	Decimal64ToFloat64  = decimal64ToFloat[float64]
+	Decimal128ToFloat32 = decimal128ToFloat[float32]
+	Decimal128ToFloat64 = decimal128ToFloat[float64]
+	Float32ToDecimal64  = floatToDecimal64[float32]
+	Float64ToDecimal64  = floatToDecimal64[float64]
+	Float32ToDecimal128 = floatToDecimal128[float32]
+	Float64ToDecimal128 = floatToDecimal128[float64]
+
+	Decimal64ToBytes  = decimal64ToBytes
+	Decimal128ToBytes = decimal128ToBytes
+	BytesToDecimal64  = bytesToDecimal64
+	BytesToDecimal128 = bytesToDecimal128
+
 	TimestampToDatetime = timestampToDatetime
 )
 
@@ -226,16 +252,189 @@ func decimal64ToDecimal128Pure(xs []types.Decimal64, rs []types.Decimal128) ([]t
 	return rs, nil
 }
 
-func intToDecimal128[T constraints.Integer](xs []T, rs []types.Decimal128) ([]types.Decimal128, error) {
+func intToDecimal128[T constraints.Integer](xs []T, width, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+	for i, x := range xs {
+		if rs[i], err = types.RescaleDecimal128(types.InitDecimal128(int64(x)), 0, scale); err != nil {
+			return nil, err
+		}
+		if err = types.CheckDecimal128Precision(rs[i], width); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func uintToDecimal128[T constraints.Integer](xs []T, width, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+	for i, x := range xs {
+		if rs[i], err = types.RescaleDecimal128(types.InitDecimal128UsingUint(uint64(x)), 0, scale); err != nil {
+			return nil, err
+		}
+		if err = types.CheckDecimal128Precision(rs[i], width); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+// decimal64ToDecimal64 converts decimals of scale xsScale to decimals of precision width and scale rsScale,
+// a width of 0 means the precision is not limited.
+func decimal64ToDecimal64(xs []types.Decimal64, xsScale, width, rsScale int32, rs []types.Decimal64) ([]types.Decimal64, error) {
+	var err error
+	for i, x := range xs {
+		if rs[i], err = types.RescaleDecimal64(x, xsScale, rsScale); err != nil {
+			return nil, err
+		}
+		if err = types.CheckDecimal64Precision(rs[i], width); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func decimal128ToDecimal64(xs []types.Decimal128, xsScale, width, rsScale int32, rs []types.Decimal64) ([]types.Decimal64, error) {
+	for i, x := range xs {
+		r, err := types.RescaleDecimal128(x, xsScale, rsScale)
+		if err != nil {
+			return nil, err
+		}
+		if rs[i], err = types.Decimal128ToDecimal64(r); err != nil {
+			return nil, err
+		}
+		if err = types.CheckDecimal64Precision(rs[i], width); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func decimal128ToDecimal128(xs []types.Decimal128, xsScale, width, rsScale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+	for i, x := range xs {
+		if rs[i], err = types.RescaleDecimal128(x, xsScale, rsScale); err != nil {
+			return nil, err
+		}
+		if err = types.CheckDecimal128Precision(rs[i], width); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func intToDecimal64[T constraints.Integer](xs []T, width, scale int32, rs []types.Decimal64) ([]types.Decimal64, error) {
+	var err error
+	for i, x := range xs {
+		if rs[i], err = types.RescaleDecimal64(types.Decimal64(x), 0, scale); err != nil {
+			return nil, err
+		}
+		if err = types.CheckDecimal64Precision(rs[i], width); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func uintToDecimal64[T constraints.Integer](xs []T, width, scale int32, rs []types.Decimal64) ([]types.Decimal64, error) {
+	for i, x := range xs {
+		r, err := types.Decimal128ToDecimal64(types.InitDecimal128UsingUint(uint64(x)))
+		if err != nil {
+			return nil, err
+		}
+		if rs[i], err = types.RescaleDecimal64(r, 0, scale); err != nil {
+			return nil, err
+		}
+		if err = types.CheckDecimal64Precision(rs[i], width); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func decimal64ToFloat[T constraints.Float](xs []types.Decimal64, scale int32, rs []T) ([]T, error) {
+	for i, x := range xs {
+		rs[i] = T(types.Decimal64ToFloat64(x, scale))
+	}
+	return rs, nil
+}
+
+func decimal128ToFloat[T constraints.Float](xs []types.Decimal128, scale int32, rs []T) ([]T, error) {
 	for i, x := range xs {
-		rs[i] = types.InitDecimal128(int64(x))
+		rs[i] = T(types.Decimal128ToFloat64(x, scale))
 	}
 	return rs, nil
 }
 
-func uintToDecimal128[T constraints.Integer](xs []T, rs []types.Decimal128) ([]types.Decimal128, error) {
+func floatToDecimal64[T constraints.Float](xs []T, width, scale int32, rs []types.Decimal64) ([]types.Decimal64, error) {
+	var err error
 	for i, x := range xs {
-		rs[i] = types.InitDecimal128UsingUint(uint64(x))
+		if rs[i], err = types.Float64ToDecimal64(float64(x), width, scale); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func floatToDecimal128[T constraints.Float](xs []T, width, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+	for i, x := range xs {
+		if rs[i], err = types.Float64ToDecimal128(float64(x), width, scale); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func decimal64ToBytes(xs []types.Decimal64, scale int32, rs *types.Bytes) (*types.Bytes, error) {
+	oldLen := uint32(0)
+	for _, x := range xs {
+		rs.Data = append(rs.Data, x.Decimal64ToString(scale)...)
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs, nil
+}
+
+func decimal128ToBytes(xs []types.Decimal128, scale int32, rs *types.Bytes) (*types.Bytes, error) {
+	oldLen := uint32(0)
+	for _, x := range xs {
+		rs.Data = append(rs.Data, x.Decimal128ToString(scale)...)
+		newLen := uint32(len(rs.Data))
+		rs.Offsets = append(rs.Offsets, oldLen)
+		rs.Lengths = append(rs.Lengths, newLen-oldLen)
+		oldLen = newLen
+	}
+	return rs, nil
+}
+
+func bytesToDecimal64(xs *types.Bytes, width, scale int32, rs []types.Decimal64) ([]types.Decimal64, error) {
+	var err error
+	for i, o := range xs.Offsets {
+		s := string(xs.Data[o : o+xs.Lengths[i]])
+		if len(s) == 0 { // as MySQL does, an empty string is cast to zero
+			rs[i] = 0
+			continue
+		}
+		if rs[i], err = types.ParseStringToDecimal64(s, width, scale); err != nil {
+			return nil, err
+		}
+	}
+	return rs, nil
+}
+
+func bytesToDecimal128(xs *types.Bytes, width, scale int32, rs []types.Decimal128) ([]types.Decimal128, error) {
+	var err error
+	for i, o := range xs.Offsets {
+		s := string(xs.Data[o : o+xs.Lengths[i]])
+		if len(s) == 0 { // as MySQL does, an empty string is cast to zero
+			rs[i] = types.Decimal128{}
+			continue
+		}
+		if rs[i], err = types.ParseStringToDecimal128(s, width, scale); err != nil {
+			return nil, err
+		}
 	}
 	return rs, nil
 }
@@ -0,0 +1,53 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unixtime
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// the max unix timestamp is 9999-12-31 23:59:59 UTC
+const maxUnixTimestamp = 253402300799
+
+var (
+	UnixTimestamp func([]types.Datetime, []int64) []int64
+	FromUnixtime  func([]int64, *nulls.Nulls, []types.Datetime) []types.Datetime
+)
+
+func init() {
+	UnixTimestamp = unixTimestamp
+	FromUnixtime = fromUnixtime
+}
+
+// unixTimestamp returns the secs since 1970-01-01 00:00:00 UTC of the local datetimes
+func unixTimestamp(xs []types.Datetime, rs []int64) []int64 {
+	for i, x := range xs {
+		rs[i] = x.UnixTimestamp()
+	}
+	return rs
+}
+
+// fromUnixtime returns the local datetimes of the unix timestamps, the negative or too large timestamps are set null in nsp
+func fromUnixtime(xs []int64, nsp *nulls.Nulls, rs []types.Datetime) []types.Datetime {
+	for i, x := range xs {
+		if x < 0 || x > maxUnixTimestamp {
+			nulls.Add(nsp, uint64(i))
+			continue
+		}
+		rs[i] = types.FromUnix(x)
+	}
+	return rs
+}
@@ -0,0 +1,31 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unixtime
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnixtime(t *testing.T) {
+	nsp := new(nulls.Nulls)
+	dts := FromUnixtime([]int64{0, 1651363200, -1}, nsp, make([]types.Datetime, 3))
+	require.True(t, nulls.Contains(nsp, 2))
+	require.False(t, nulls.Contains(nsp, 1))
+	require.Equal(t, []int64{0, 1651363200}, UnixTimestamp(dts[:2], make([]int64, 2)))
+}
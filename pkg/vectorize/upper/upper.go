@@ -0,0 +1,41 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upper
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+var (
+	UpperChar    func(*types.Bytes, *types.Bytes) *types.Bytes
+	UpperVarChar func(*types.Bytes, *types.Bytes) *types.Bytes
+)
+
+func init() {
+	UpperChar = upper
+	UpperVarChar = upper
+}
+
+// upper appends the upper case of every string of xs to rs, it is unicode aware
+func upper(xs *types.Bytes, rs *types.Bytes) *types.Bytes {
+	for i, offset := range xs.Offsets {
+		rs.Offsets = append(rs.Offsets, uint32(len(rs.Data)))
+		rs.Data = append(rs.Data, bytes.ToUpper(xs.Data[offset:offset+xs.Lengths[i]])...)
+		rs.Lengths = append(rs.Lengths, uint32(len(rs.Data))-rs.Offsets[i])
+	}
+	return rs
+}
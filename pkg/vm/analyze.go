@@ -0,0 +1,81 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vm
+
+import (
+	"runtime"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// analyzeCall calls the i-th instruction and accumulates its runtime statistics into proc.Analysis[i].
+func analyzeCall(i int, ins Instructions, proc *process.Process) (bool, error) {
+	if len(proc.Analysis) < len(ins) {
+		proc.Analysis = append(proc.Analysis, make([]process.AnalyzeInfo, len(ins)-len(proc.Analysis))...)
+	}
+	info := &proc.Analysis[i]
+	rows := -1
+	if bat := proc.Reg.InputBatch; bat != nil {
+		rows = batch.Length(bat)
+		info.InputBatches++
+		info.InputRows += int64(rows)
+	}
+	memorySize := mmuSize(proc)
+
+	// the cpu time is read from the thread running the goroutine
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	spillSize := proc.SpillSize
+	cpuTime := threadCPUTime()
+	start := time.Now()
+	ok, err := execFunc[ins[i].Op](proc, ins[i].Arg)
+	info.WallTime += int64(time.Since(start))
+	info.CPUTime += threadCPUTime() - cpuTime
+	info.SpillSize += proc.SpillSize - spillSize
+
+	switch ins[i].Op {
+	case Output, Connector:
+		// the batch has been cleaned or sent to another pipeline,
+		// so the rows sent out are the input rows
+		if rows > 0 {
+			info.OutputBatches++
+			info.OutputRows += int64(rows)
+		}
+	default:
+		if bat := proc.Reg.InputBatch; bat != nil {
+			info.OutputBatches++
+			info.OutputRows += int64(batch.Length(bat))
+		}
+	}
+	// the memory allocated and not freed by the call is held by the instruction,
+	// the memory of the batches freed by the next instructions is not given back
+	if info.MemorySize += mmuSize(proc) - memorySize; info.MemorySize < 0 {
+		info.MemorySize = 0
+	}
+	if info.MemorySize > info.MemoryPeak {
+		info.MemoryPeak = info.MemorySize
+	}
+	return ok, err
+}
+
+// mmuSize returns the memory size allocated by the process.
+func mmuSize(proc *process.Process) int64 {
+	if proc.Mp == nil || proc.Mp.Gm == nil {
+		return 0
+	}
+	return proc.Mp.Gm.Size()
+}
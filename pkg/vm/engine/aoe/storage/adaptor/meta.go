@@ -16,10 +16,15 @@ package adaptor
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/dbi"
@@ -79,6 +84,16 @@ func TableInfoToSchema(catalog *metadata.Catalog, info *aoe.TableInfo) (*db.Tabl
 		}
 		schema.NameIndex[newInfo.Name] = len(schema.ColDefs)
 		schema.ColDefs = append(schema.ColDefs, newInfo)
+		// All the columns of a table share the same algorithm. Column data
+		// of aoe is always compressed, LZ4 is kept if none is specified.
+		if colInfo.Alg != compress.None {
+			schema.Compression = strings.ToLower(compress.T(colInfo.Alg).String())
+		}
+	}
+	for _, property := range info.Properties {
+		if property.Key == engine.CompressionLevelProperty {
+			schema.CompressLevel, _ = strconv.Atoi(property.Value)
+		}
 	}
 	indice := metadata.NewIndexSchema()
 	cols := make([]int, 0)
@@ -25,7 +25,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/dbi"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
-	"github.com/pierrec/lz4"
 	"io"
 	// log "github.com/sirupsen/logrus"
 )
@@ -218,10 +217,10 @@ func (vec *VectorWrapper) WriteTo(w io.Writer) (n int64, err error) {
 	case compress.None:
 		nw, err := w.Write(buf)
 		return int64(nw), err
-	case compress.Lz4:
-		nb := lz4.CompressBlockBound(len(buf))
+	case compress.Lz4, compress.Zstd, compress.Snappy:
+		nb := compress.CompressBlockBound(len(buf), stat.CompressAlgo())
 		tmp := make([]byte, nb)
-		tmp, err = compress.Compress(buf, tmp, compress.Lz4)
+		tmp, err = compress.Compress(buf, tmp, stat.CompressAlgo())
 		if err != nil {
 			return 0, err
 		}
@@ -266,7 +265,7 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 		vec.Col = v.Col
 		err = vec.Vector.Read(data)
 		return int64(nr), err
-	case compress.Lz4:
+	case compress.Lz4, compress.Zstd, compress.Snappy:
 		loadSize := uint64(stat.Size())
 		originSize := uint64(stat.OriginSize())
 		tmpNode := common.GPool.Alloc(loadSize)
@@ -276,7 +275,7 @@ func (vec *VectorWrapper) ReadFrom(r io.Reader) (n int64, err error) {
 			return n, err
 		}
 		vec.MNode = common.GPool.Alloc(originSize)
-		_, err = compress.Decompress(tmpNode.Buf[:loadSize], vec.MNode.Buf[:originSize], compress.Lz4)
+		_, err = compress.Decompress(tmpNode.Buf[:loadSize], vec.MNode.Buf[:originSize], stat.CompressAlgo())
 		if err != nil {
 			common.GPool.Free(vec.MNode)
 			return n, err
@@ -315,7 +314,7 @@ func (vec *VectorWrapper) ReadWithBuffer(r io.Reader, compressed *bytes.Buffer,
 			return n, err
 		}
 		return int64(nr), err
-	case compress.Lz4:
+	case compress.Lz4, compress.Zstd, compress.Snappy:
 		loadSize := stat.Size()
 		originSize := stat.OriginSize()
 		compressed.Reset()
@@ -334,7 +333,7 @@ func (vec *VectorWrapper) ReadWithBuffer(r io.Reader, compressed *bytes.Buffer,
 		if err != nil {
 			return n, err
 		}
-		buf, err = compress.Decompress(tmpBuf, buf, compress.Lz4)
+		buf, err = compress.Decompress(tmpBuf, buf, stat.CompressAlgo())
 		if err != nil {
 			return n, err
 		}
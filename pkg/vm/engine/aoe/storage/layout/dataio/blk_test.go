@@ -16,7 +16,7 @@ package dataio
 
 import (
 	// "encoding/binary"
-	// "fmt"
+	"fmt"
 	// "os"
 	// "path/filepath"
 	"testing"
@@ -363,3 +363,51 @@ func TestTransientBlock(t *testing.T) {
 	// ok = tblk.PreSync(uint32(bat2.Vecs[0].Length()))
 	// assert.False(t, ok)
 }
+
+func TestBlockWriterCompression(t *testing.T) {
+	dir := initTestEnv(t)
+	catalog := metadata.MockCatalog(dir, uint64(40), uint64(10), nil, nil)
+	gen := shard.NewMockIndexAllocator()
+	vals := make([]int32, 40)
+	for i := range vals {
+		vals[i] = int32(i % 4)
+	}
+	for i, algo := range []string{"", "zstd", "snappy"} {
+		schema := metadata.MockSchema(2)
+		schema.Compression = algo
+		if algo == "zstd" {
+			schema.CompressLevel = 9
+		}
+		tblMeta := metadata.MockDBTable(catalog, fmt.Sprintf("db%d", i), schema, nil, 1, gen.Shard(uint64(100+i)))
+		meta := tblMeta.SegmentSet[0].BlockSet[0]
+		assert.NotNil(t, meta)
+		expectedAlgo, _ := schema.CompressAlgo()
+
+		vecs := make([]*gvector.Vector, 2)
+		for j := range vecs {
+			vecs[j] = gvector.New(schema.ColDefs[j].Type)
+			assert.Nil(t, gvector.Append(vecs[j], vals))
+		}
+		bw := NewBlockWriter(vecs, meta, dir)
+		assert.Nil(t, bw.Execute())
+
+		id := *meta.AsCommonID()
+		segFile := NewUnsortedSegmentFile(dir, *meta.Segment.AsCommonID())
+		f := NewBlockFile(segFile, id, nil)
+		assert.Equal(t, expectedAlgo, f.DataCompressAlgo(id))
+		for j := range vecs {
+			sz := f.PartSize(uint64(j), id, false)
+			osz := f.PartSize(uint64(j), id, true)
+			assert.Less(t, sz, osz)
+			buf := make([]byte, sz)
+			f.ReadPart(uint64(j), id, buf)
+			obuf := make([]byte, osz)
+			_, err := compress.Decompress(buf, obuf, f.DataCompressAlgo(id))
+			assert.Nil(t, err)
+			v := gvector.New(schema.ColDefs[j].Type)
+			assert.Nil(t, v.Read(obuf))
+			// Rows are sorted by the primary key on flush
+			assert.ElementsMatch(t, vals, v.Col.([]int32))
+		}
+	}
+}
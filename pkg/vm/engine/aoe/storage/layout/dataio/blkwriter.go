@@ -29,8 +29,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
-
-	"github.com/pierrec/lz4"
 )
 
 type vecsSerializer func(*os.File, []*gvector.Vector, *metadata.Block) error
@@ -40,8 +38,8 @@ type ivecsSerializer func(*os.File, []vector.IVectorNode, *metadata.Block) error
 type blockFileGetter func(string, *metadata.Block) (*os.File, error)
 
 var (
-	defaultVecsSerializer  = lz4CompressionVecs
-	defaultIVecsSerializer = lz4CompressionIVecs
+	defaultVecsSerializer  = compressedVecs
+	defaultIVecsSerializer = compressedIVecs
 	// defaultVecsSerializer = noCompressionVecs
 )
 
@@ -280,13 +278,13 @@ func (bw *BlockWriter) executeVecs() error {
 	return bw.fileCommiter(filename)
 }
 
-func lz4CompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block) error {
+func compressedVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block) error {
 	var (
 		err error
 		buf bytes.Buffer
 	)
-	algo := uint8(compress.Lz4)
-	if err = binary.Write(&buf, binary.BigEndian, algo); err != nil {
+	algo, level := meta.Segment.Table.Schema.CompressAlgo()
+	if err = binary.Write(&buf, binary.BigEndian, uint8(algo)); err != nil {
 		return err
 	}
 	colCnt := len(meta.Segment.Table.Schema.ColDefs)
@@ -336,8 +334,8 @@ func lz4CompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block
 			return err
 		}
 		colSize := len(colBuf)
-		cbuf := make([]byte, lz4.CompressBlockBound(colSize))
-		if cbuf, err = compress.Compress(colBuf, cbuf, compress.Lz4); err != nil {
+		cbuf := make([]byte, compress.CompressBlockBound(colSize, algo))
+		if cbuf, err = compress.CompressWithLevel(colBuf, cbuf, algo, level); err != nil {
 			return err
 		}
 		if err = binary.Write(&buf, binary.BigEndian, uint64(len(cbuf))); err != nil {
@@ -420,13 +418,13 @@ func lz4CompressionVecs(w *os.File, data []*gvector.Vector, meta *metadata.Block
 // 	return nil
 // }
 
-func lz4CompressionIVecs(w *os.File, data []vector.IVectorNode, meta *metadata.Block) error {
+func compressedIVecs(w *os.File, data []vector.IVectorNode, meta *metadata.Block) error {
 	var (
 		err error
 		buf bytes.Buffer
 	)
-	algo := uint8(compress.Lz4)
-	if err = binary.Write(&buf, binary.BigEndian, algo); err != nil {
+	algo, level := meta.Segment.Table.Schema.CompressAlgo()
+	if err = binary.Write(&buf, binary.BigEndian, uint8(algo)); err != nil {
 		return err
 	}
 	colCnt := len(meta.Segment.Table.Schema.ColDefs)
@@ -476,8 +474,8 @@ func lz4CompressionIVecs(w *os.File, data []vector.IVectorNode, meta *metadata.B
 			return err
 		}
 		colSize := len(colBuf)
-		cbuf := make([]byte, lz4.CompressBlockBound(colSize))
-		if cbuf, err = compress.Compress(colBuf, cbuf, compress.Lz4); err != nil {
+		cbuf := make([]byte, compress.CompressBlockBound(colSize, algo))
+		if cbuf, err = compress.CompressWithLevel(colBuf, cbuf, algo, level); err != nil {
 			return err
 		}
 		if err = binary.Write(&buf, binary.BigEndian, uint64(len(cbuf))); err != nil {
@@ -33,8 +33,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/layout/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe/storage/metadata/v1"
-
-	"github.com/pierrec/lz4"
 )
 
 const (
@@ -242,7 +240,8 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 	if err != nil {
 		return err
 	}
-	err = binary.Write(&metaBuf, binary.BigEndian, uint8(compress.Lz4))
+	algo, level := meta.Table.Schema.CompressAlgo()
+	err = binary.Write(&metaBuf, binary.BigEndian, uint8(algo))
 	if err != nil {
 		return err
 	}
@@ -336,7 +335,7 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 			}
 			indices = append(indices, zmi)
 
-			colSz, err := processColumn(pkColumn, &metaBuf, &outputBuffer)
+			colSz, err := processColumn(pkColumn, algo, level, &metaBuf, &outputBuffer)
 			if err != nil {
 				return err
 			}
@@ -361,7 +360,7 @@ func flush(w *os.File, iter iface.BlockIterator, meta *metadata.Segment) error {
 			return err
 		}
 		indices = append(indices, zmi)
-		colSz, err := processColumn(column, &metaBuf, &outputBuffer)
+		colSz, err := processColumn(column, algo, level, &metaBuf, &outputBuffer)
 		if err != nil {
 			return err
 		}
@@ -432,7 +431,7 @@ func preprocessColumn(column []*vector.Vector, sortedIdx *[]uint16, isPrimary bo
 	return nil
 }
 
-func processColumn(column []*vector.Vector, metaBuf, dataBuf *bytes.Buffer) (int, error) {
+func processColumn(column []*vector.Vector, algo, level int, metaBuf, dataBuf *bytes.Buffer) (int, error) {
 	colSz := 0
 	for _, vec := range column {
 		colBuf, err := vec.Show()
@@ -440,8 +439,8 @@ func processColumn(column []*vector.Vector, metaBuf, dataBuf *bytes.Buffer) (int
 			return 0, err
 		}
 		colSize := len(colBuf)
-		cbuf := make([]byte, lz4.CompressBlockBound(colSize))
-		if cbuf, err = compress.Compress(colBuf, cbuf, compress.Lz4); err != nil {
+		cbuf := make([]byte, compress.CompressBlockBound(colSize, algo))
+		if cbuf, err = compress.CompressWithLevel(colBuf, cbuf, algo, level); err != nil {
 			return 0, err
 		}
 		if err = binary.Write(metaBuf, binary.BigEndian, uint64(len(cbuf))); err != nil {
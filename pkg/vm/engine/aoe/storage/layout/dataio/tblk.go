@@ -230,7 +230,7 @@ func (f *TransientBlockFile) LoadBatch(meta *metadata.Block) batch.IBatch {
 		buf := node.Buf[:sz]
 		file.ReadPart(uint64(i), id, buf)
 		obuf := make([]byte, osz)
-		_, err := compress.Decompress(buf, obuf, compress.Lz4)
+		_, err := compress.Decompress(buf, obuf, file.DataCompressAlgo(id))
 		if err != nil {
 			panic(err)
 		}
@@ -333,8 +333,13 @@ func (f *TransientBlockFile) PartSize(colIdx uint64, id common.ID, isOrigin bool
 	return file.PartSize(colIdx, id, isOrigin)
 }
 
-func (f *TransientBlockFile) DataCompressAlgo(common.ID) int {
-	return compress.Lz4
+func (f *TransientBlockFile) DataCompressAlgo(id common.ID) int {
+	file := f.refLatestFile()
+	if file == nil {
+		return compress.None
+	}
+	defer file.Unref()
+	return file.DataCompressAlgo(id)
 }
 
 func (f *TransientBlockFile) Destroy() {
@@ -19,6 +19,7 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 )
 
@@ -166,6 +167,8 @@ type Schema struct {
 	BlockMaxRows     uint64         `json:"blkrows"`
 	PrimaryKey       int            `json:"primarykey"`
 	SegmentMaxBlocks uint64         `json:"segblocks"`
+	Compression      string         `json:"compression,omitempty"`
+	CompressLevel    int            `json:"clevel,omitempty"`
 }
 
 func NewEmptySchema(name string) *Schema {
@@ -191,6 +194,19 @@ func (s *Schema) String() string {
 	return string(buf)
 }
 
+// CompressAlgo returns the algorithm and level used to compress the
+// column data of the table. Schemas without compression settings use LZ4.
+func (s *Schema) CompressAlgo() (int, int) {
+	if s.Compression == "" {
+		return compress.Lz4, 0
+	}
+	alg, err := compress.ParseAlgorithm(s.Compression)
+	if err != nil {
+		panic(err)
+	}
+	return int(alg), s.CompressLevel
+}
+
 func (s *Schema) Types() []types.Type {
 	ts := make([]types.Type, len(s.ColDefs))
 	for i, colDef := range s.ColDefs {
@@ -0,0 +1,156 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+)
+
+type AlterKind uint8
+
+const (
+	AlterAddColumn AlterKind = iota
+	AlterDropColumn
+	AlterRenameColumn
+)
+
+// AlterReq is a change of the columns of a table
+type AlterReq struct {
+	Kind    AlterKind
+	Name    string
+	NewName string
+	Type    types.Type
+	Default interface{}
+}
+
+func NewAddColumnReq(name string, typ types.Type, def interface{}) *AlterReq {
+	return &AlterReq{
+		Kind:    AlterAddColumn,
+		Name:    name,
+		Type:    typ,
+		Default: def,
+	}
+}
+
+func NewDropColumnReq(name string) *AlterReq {
+	return &AlterReq{
+		Kind: AlterDropColumn,
+		Name: name,
+	}
+}
+
+func NewRenameColumnReq(name, newName string) *AlterReq {
+	return &AlterReq{
+		Kind:    AlterRenameColumn,
+		Name:    name,
+		NewName: newName,
+	}
+}
+
+func (req *AlterReq) applyTo(schema *Schema) error {
+	switch req.Kind {
+	case AlterAddColumn:
+		return schema.AddColumn(req.Name, req.Type, req.Default)
+	case AlterDropColumn:
+		return schema.DropColumn(req.Name)
+	case AlterRenameColumn:
+		return schema.RenameColumn(req.Name, req.NewName)
+	}
+	return ErrValidation
+}
+
+// AlterEntry is the txn entry of a new version of the table schema. The
+// blocks written before keep reading their columns with the version they
+// were written with, so the data is not touched by the change.
+type AlterEntry struct {
+	table *TableEntry
+	// base is the schema the new version is altered from
+	base     *Schema
+	indexCnt int
+	schema   *Schema
+	txn      txnif.AsyncTxn
+	// installed is true if the new version is installed to the table
+	installed bool
+}
+
+func newAlterEntry(table *TableEntry, base *Schema, txn txnif.AsyncTxn) *AlterEntry {
+	schema := base.Clone()
+	schema.Version++
+	return &AlterEntry{
+		table:    table,
+		base:     base,
+		indexCnt: len(base.IndexInfos),
+		schema:   schema,
+		txn:      txn,
+	}
+}
+
+func (entry *AlterEntry) Lock()    { entry.table.Lock() }
+func (entry *AlterEntry) Unlock()  { entry.table.Unlock() }
+func (entry *AlterEntry) RLock()   { entry.table.RLock() }
+func (entry *AlterEntry) RUnlock() { entry.table.RUnlock() }
+
+func (entry *AlterEntry) GetTable() *TableEntry { return entry.table }
+func (entry *AlterEntry) GetSchema() *Schema    { return entry.schema }
+
+// Apply applies one more change to the new version of the schema
+func (entry *AlterEntry) Apply(req *AlterReq) error {
+	schema := entry.schema.Clone()
+	if err := req.applyTo(schema); err != nil {
+		return err
+	}
+	entry.schema = schema
+	return nil
+}
+
+func (entry *AlterEntry) String() string {
+	return fmt.Sprintf("ALTER[version=%d]@%s", entry.schema.Version, entry.table.String())
+}
+
+// PrepareCommit installs the new version, so the txns committed after it
+// append the rows with the new columns
+func (entry *AlterEntry) PrepareCommit() error {
+	entry.table.Lock()
+	defer entry.table.Unlock()
+	if entry.table.schema != entry.base || len(entry.base.IndexInfos) != entry.indexCnt {
+		return ErrSchemaChanged
+	}
+	entry.table.addSchemaLocked(entry.schema)
+	entry.installed = true
+	return nil
+}
+
+func (entry *AlterEntry) PrepareRollback() error {
+	entry.table.Lock()
+	defer entry.table.Unlock()
+	if entry.installed {
+		entry.table.schemas = entry.table.schemas[:len(entry.table.schemas)-1]
+		entry.table.schema = entry.base
+		entry.installed = false
+	}
+	return nil
+}
+
+func (entry *AlterEntry) ApplyRollback() error { return nil }
+
+func (entry *AlterEntry) ApplyCommit(index *wal.Index) error { return nil }
+
+func (entry *AlterEntry) MakeCommand(id uint32) (cmd txnif.TxnCmd, err error) {
+	return newAlterCmd(id, CmdAlterTable, entry), nil
+}
@@ -31,7 +31,9 @@ type BlockEntry struct {
 	*BaseEntry
 	segment *SegmentEntry
 	state   EntryState
-	blkData data.Block
+	// schemaVer is the version of the table schema the block is written with
+	schemaVer uint32
+	blkData   data.Block
 }
 
 func NewEmptyBlockEntry() *BlockEntry {
@@ -51,8 +53,9 @@ func NewBlockEntry(segment *SegmentEntry, txn txnif.AsyncTxn, state EntryState,
 			RWMutex: new(sync.RWMutex),
 			ID:      id,
 		},
-		segment: segment,
-		state:   state,
+		segment:   segment,
+		state:     state,
+		schemaVer: segment.GetTable().GetSchema().Version,
 	}
 	if dataFactory != nil {
 		e.blkData = dataFactory(e)
@@ -114,7 +117,13 @@ func (entry *BlockEntry) AsCommonID() *common.ID {
 }
 
 func (entry *BlockEntry) GetBlockData() data.Block { return entry.blkData }
-func (entry *BlockEntry) GetSchema() *Schema       { return entry.GetSegment().GetTable().GetSchema() }
+func (entry *BlockEntry) GetSchemaVersion() uint32 { return entry.schemaVer }
+
+// GetSchema returns the schema the block is written with, which is not the
+// table schema if the table is altered after the block is created
+func (entry *BlockEntry) GetSchema() *Schema {
+	return entry.GetSegment().GetTable().GetSchemaByVersion(entry.schemaVer)
+}
 
 func (entry *BlockEntry) PrepareRollback() (err error) {
 	entry.RLock()
@@ -138,11 +147,25 @@ func (entry *BlockEntry) WriteTo(w io.Writer) (n int64, err error) {
 	if err = binary.Write(w, binary.BigEndian, entry.state); err != nil {
 		return
 	}
-	n += 1
+	if err = binary.Write(w, binary.BigEndian, entry.schemaVer); err != nil {
+		return
+	}
+	n += 1 + 4
 	return
 }
 
 func (entry *BlockEntry) ReadFrom(r io.Reader) (n int64, err error) {
+	if n, err = entry.readV0From(r); err != nil {
+		return
+	}
+	err = binary.Read(r, binary.BigEndian, &entry.schemaVer)
+	n += 4
+	return
+}
+
+// readV0From reads the entry logged without the schema version, which
+// is written with the version 0
+func (entry *BlockEntry) readV0From(r io.Reader) (n int64, err error) {
 	if n, err = entry.BaseEntry.ReadFrom(r); err != nil {
 		return
 	}
@@ -159,6 +182,7 @@ func (entry *BlockEntry) Clone() CheckpointItem {
 	cloned := &BlockEntry{
 		BaseEntry: entry.BaseEntry.Clone(),
 		state:     entry.state,
+		schemaVer: entry.schemaVer,
 		segment:   entry.segment,
 	}
 	return cloned
@@ -168,6 +192,7 @@ func (entry *BlockEntry) CloneCreate() CheckpointItem {
 	cloned := &BlockEntry{
 		BaseEntry: entry.BaseEntry.CloneCreate(),
 		state:     entry.state,
+		schemaVer: entry.schemaVer,
 		segment:   entry.segment,
 	}
 	return cloned
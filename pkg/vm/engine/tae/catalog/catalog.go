@@ -135,6 +135,30 @@ func (catalog *Catalog) replayCmd(txncmd txnif.TxnCmd) (err error) {
 	case CmdLogDatabase:
 		cmd := txncmd.(*EntryCommand)
 		catalog.addEntryLocked(cmd.DB)
+	case CmdCreateIndex:
+		cmd := txncmd.(*EntryCommand)
+		db, err := catalog.GetDatabaseByID(cmd.DBID)
+		if err != nil {
+			return err
+		}
+		tbl, err := db.GetTableEntryByID(cmd.TableID)
+		if err != nil {
+			return err
+		}
+		tbl.Lock()
+		defer tbl.Unlock()
+		return tbl.appendIndexLocked(cmd.Index)
+	case CmdAlterTable:
+		cmd := txncmd.(*EntryCommand)
+		db, err := catalog.GetDatabaseByID(cmd.DBID)
+		if err != nil {
+			return err
+		}
+		tbl, err := db.GetTableEntryByID(cmd.TableID)
+		if err != nil {
+			return err
+		}
+		tbl.AddSchema(cmd.Schema)
 	case CmdCreateDatabase:
 		cmd := txncmd.(*EntryCommand)
 		entry := NewDBEntry(catalog, cmd.DB.name, nil)
@@ -16,11 +16,15 @@ package catalog
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
@@ -433,6 +437,139 @@ func TestCommand(t *testing.T) {
 	assert.Equal(t, tb.db.ID, eCmd.DBID)
 }
 
+func TestIndexCommand(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	catalog := MockCatalog(dir, "mock", nil, nil)
+	defer catalog.Close()
+
+	db := NewDBEntry(catalog, "db", nil)
+	db.ID = uint64(99)
+	schema := MockSchemaAll(13)
+	schema.PrimaryKey = 2
+	assert.NotNil(t, schema.AppendIndex(NewIndexInfo("idx", SecondaryIndex, int(schema.PrimaryKey))))
+	assert.Nil(t, schema.AppendIndex(NewIndexInfo("idx", SecondaryIndex, 3)))
+	assert.NotNil(t, schema.AppendIndex(NewIndexInfo("idx2", SecondaryIndex, 3)))
+	tb := NewTableEntry(db, schema, nil, nil)
+	tb.CreateAt = common.NextGlobalSeqNum()
+	tb.ID = common.NextGlobalSeqNum()
+
+	var w bytes.Buffer
+	cmd, err := tb.MakeCommand(0)
+	assert.Nil(t, err)
+	_, err = cmd.WriteTo(&w)
+	assert.Nil(t, err)
+	cmd, _, err = txnbase.BuildCommandFrom(bytes.NewBuffer(w.Bytes()))
+	assert.Nil(t, err)
+	eCmd := cmd.(*EntryCommand)
+	assert.Equal(t, []int{3}, eCmd.Table.GetSchema().SecondaryIndexCols())
+	assert.Equal(t, "idx", eCmd.Table.GetSchema().GetSecondaryIndex(3).Name)
+
+	created, err := tb.CreateIndex(nil, NewIndexInfo("idx4", SecondaryIndex, 4))
+	assert.Nil(t, err)
+	w.Reset()
+	cmd, err = created.MakeCommand(1)
+	assert.Nil(t, err)
+	_, err = cmd.WriteTo(&w)
+	assert.Nil(t, err)
+	cmd, _, err = txnbase.BuildCommandFrom(bytes.NewBuffer(w.Bytes()))
+	assert.Nil(t, err)
+	eCmd = cmd.(*EntryCommand)
+	assert.Equal(t, CmdCreateIndex, eCmd.GetType())
+	assert.Equal(t, tb.ID, eCmd.TableID)
+	assert.Equal(t, "idx4", eCmd.Index.Name)
+	assert.Equal(t, []uint16{4}, eCmd.Index.Columns)
+
+	assert.Nil(t, created.ApplyCommit(nil))
+	assert.Equal(t, []int{3, 4}, tb.GetSchema().SecondaryIndexCols())
+}
+
+func TestAlterTable(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	catalog := MockCatalog(dir, "mock", nil, nil)
+	defer catalog.Close()
+
+	db := NewDBEntry(catalog, "db", nil)
+	db.ID = uint64(99)
+	schema := MockSchemaAll(13)
+	schema.PrimaryKey = 2
+	tb := NewTableEntry(db, schema, nil, nil)
+	tb.ID = common.NextGlobalSeqNum()
+
+	_, err := tb.AlterTable(nil, NewDropColumnReq("mock_2"))
+	assert.ErrorIs(t, err, ErrValidation)
+	_, err = tb.AlterTable(nil, NewRenameColumnReq("mock_3", "mock_4"))
+	assert.ErrorIs(t, err, ErrDuplicate)
+	_, err = tb.AlterTable(nil, NewDropColumnReq("none"))
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	int64Type := types.Type{Oid: types.T_int64, Size: 8, Width: 64}
+	created, err := tb.AlterTable(nil, NewAddColumnReq("added", int64Type, int64(7)))
+	assert.Nil(t, err)
+	assert.Nil(t, created.Apply(NewDropColumnReq("mock_1")))
+	assert.Nil(t, created.Apply(NewRenameColumnReq("mock_3", "renamed")))
+	assert.Equal(t, 13, len(tb.GetSchema().ColDefs))
+
+	// A rolled back change is not seen
+	assert.Nil(t, created.PrepareCommit())
+	assert.Nil(t, created.PrepareRollback())
+	assert.Equal(t, schema, tb.GetSchema())
+
+	assert.Nil(t, created.PrepareCommit())
+	altered := tb.GetSchema()
+	assert.Equal(t, uint32(1), altered.Version)
+	assert.Equal(t, 13, len(altered.ColDefs))
+	assert.Equal(t, int32(1), altered.PrimaryKey)
+	assert.Equal(t, 2, altered.GetColIdx("renamed"))
+	assert.Equal(t, -1, altered.GetColIdx("mock_1"))
+	assert.Equal(t, int64(7), altered.ColDefs[12].Default)
+	// The columns keep their ids across the versions
+	assert.Equal(t, 3, schema.GetColIdxByID(altered.ColDefs[2].ID))
+	assert.Equal(t, -1, schema.GetColIdxByID(altered.ColDefs[12].ID))
+	assert.Equal(t, schema, tb.GetSchemaByVersion(0))
+	assert.Equal(t, altered, tb.GetSchemaByVersion(1))
+	// Another change based on the replaced version fails
+	stale := newAlterEntry(tb, schema, nil)
+	assert.ErrorIs(t, stale.PrepareCommit(), ErrSchemaChanged)
+
+	// The index is created on the column in all the versions
+	index, err := tb.CreateIndex(nil, NewIndexInfo("idx", SecondaryIndex, 2))
+	assert.Nil(t, err)
+	assert.Nil(t, index.ApplyCommit(nil))
+	assert.Equal(t, []int{2}, altered.SecondaryIndexCols())
+	assert.Equal(t, []int{3}, schema.SecondaryIndexCols())
+
+	var w bytes.Buffer
+	cmd, err := created.MakeCommand(0)
+	assert.Nil(t, err)
+	_, err = cmd.WriteTo(&w)
+	assert.Nil(t, err)
+	cmd, _, err = txnbase.BuildCommandFrom(bytes.NewBuffer(w.Bytes()))
+	assert.Nil(t, err)
+	eCmd := cmd.(*EntryCommand)
+	assert.Equal(t, CmdAlterTable, eCmd.GetType())
+	assert.Equal(t, tb.ID, eCmd.TableID)
+	assert.Equal(t, altered.Version, eCmd.Schema.Version)
+	assert.Equal(t, altered.Attrs(), eCmd.Schema.Attrs())
+	assert.Equal(t, int64(7), eCmd.Schema.ColDefs[12].Default)
+	assert.Nil(t, eCmd.Schema.ColDefs[11].Default)
+
+	// The checkpoint keeps all the versions
+	w.Reset()
+	_, err = tb.WriteTo(&w)
+	assert.Nil(t, err)
+	tb2 := &TableEntry{BaseEntry: &BaseEntry{RWMutex: new(sync.RWMutex)}}
+	_, err = tb2.ReadFrom(bytes.NewBuffer(w.Bytes()))
+	assert.Nil(t, err)
+	assert.Equal(t, altered.Attrs(), tb2.GetSchema().Attrs())
+	assert.Equal(t, schema.Attrs(), tb2.GetSchemaByVersion(0).Attrs())
+
+	// The id of a dropped column is not reused
+	created, err = tb.AlterTable(nil, NewDropColumnReq("added"))
+	assert.Nil(t, err)
+	assert.Nil(t, created.Apply(NewAddColumnReq("added", int64Type, nil)))
+	assert.NotEqual(t, altered.ColDefs[12].ID, created.GetSchema().ColDefs[12].ID)
+}
+
 // UT Steps
 // 1. Start Txn1, create a database "db", table "tb" and segment "seg1", then commit Txn1
 // 1. Start Txn2, create a segment "seg2". Txn2 scan "tb" and "seg1, seg2" found
@@ -463,3 +600,97 @@ func TestSegment1(t *testing.T) {
 	t.Log(seg1.String())
 	t.Log(tb.String())
 }
+
+func TestBlockCommandFormat(t *testing.T) {
+	dir := testutils.InitTestEnv(ModuleName, t)
+	catalog := MockCatalog(dir, "mock", nil, nil)
+	defer catalog.Close()
+
+	db := NewDBEntry(catalog, "db", nil)
+	schema := MockSchemaAll(3)
+	schema.Version = 2
+	tb := NewTableEntry(db, schema, nil, nil)
+	seg := NewSegmentEntry(tb, nil, ES_Appendable, nil)
+	blk := NewBlockEntry(seg, nil, ES_Appendable, nil)
+
+	decode := func(buf []byte) *EntryCommand {
+		cmd, _, err := txnbase.BuildCommandFrom(bytes.NewBuffer(buf))
+		assert.Nil(t, err)
+		return cmd.(*EntryCommand)
+	}
+	for _, typ := range []int16{CmdCreateBlock, CmdLogBlock} {
+		cmd := newBlockCmd(0, typ, blk)
+		var w bytes.Buffer
+		_, err := cmd.WriteTo(&w)
+		assert.Nil(t, err)
+		buf := w.Bytes()
+		eCmd := decode(buf)
+		assert.Equal(t, typ, eCmd.GetType())
+		assert.Equal(t, blk.ID, eCmd.Block.ID)
+		assert.Equal(t, uint32(2), eCmd.Block.GetSchemaVersion())
+
+		// The command logged before the schema version is read with the
+		// version 0
+		old := make([]byte, len(buf)-4)
+		copy(old, buf)
+		v0 := CmdCreateBlockV0
+		if typ == CmdLogBlock {
+			v0 = CmdLogBlockV0
+		}
+		binary.BigEndian.PutUint16(old, uint16(v0))
+		eCmd = decode(old)
+		assert.Equal(t, typ, eCmd.GetType())
+		assert.Equal(t, blk.ID, eCmd.Block.ID)
+		assert.Equal(t, uint32(0), eCmd.Block.GetSchemaVersion())
+	}
+}
+
+func TestSchemaFormat(t *testing.T) {
+	schema := MockSchemaAll(3)
+	schema.Compression = compress.Zstd
+	schema.CompressLevel = 5
+	buf, err := schema.Marshal()
+	assert.Nil(t, err)
+	// Another entry follows the schema in the log
+	r := bytes.NewBuffer(append(buf, 0xff))
+	decoded := NewEmptySchema("")
+	n, err := decoded.ReadFrom(r)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(buf)), n)
+	assert.Equal(t, 1, r.Len())
+	assert.Equal(t, compress.T(compress.Zstd), decoded.Compression)
+	assert.Equal(t, int32(5), decoded.CompressLevel)
+	assert.Equal(t, schema.NextColID, decoded.NextColID)
+
+	// The schema written before the format is versioned
+	var w bytes.Buffer
+	_ = binary.Write(&w, binary.BigEndian, schema.BlockMaxRows)
+	_ = binary.Write(&w, binary.BigEndian, schema.PrimaryKey)
+	_ = binary.Write(&w, binary.BigEndian, schema.SegmentMaxBlocks)
+	_, _ = common.WriteString(schema.Name, &w)
+	_ = binary.Write(&w, binary.BigEndian, uint16(len(schema.ColDefs)))
+	for _, def := range schema.ColDefs {
+		w.Write(encoding.EncodeType(def.Type))
+		_, _ = common.WriteString(def.Name, &w)
+	}
+	old := w.Len()
+	w.WriteByte(0xff)
+	decoded = NewEmptySchema("")
+	n, err = decoded.ReadFrom(&w)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(old), n)
+	assert.Equal(t, 1, w.Len())
+	assert.Equal(t, schema.Name, decoded.Name)
+	assert.Equal(t, schema.BlockMaxRows, decoded.BlockMaxRows)
+	assert.Equal(t, compress.T(compress.None), decoded.Compression)
+	assert.Equal(t, uint32(0), decoded.Version)
+	assert.Equal(t, 0, len(decoded.IndexInfos))
+	assert.Equal(t, len(schema.ColDefs), len(decoded.ColDefs))
+	for i, def := range decoded.ColDefs {
+		assert.Equal(t, schema.ColDefs[i].Name, def.Name)
+		assert.Equal(t, schema.ColDefs[i].Type, def.Type)
+		assert.Equal(t, uint16(i), def.ID)
+	}
+	assert.Equal(t, uint16(len(schema.ColDefs)), decoded.NextColID)
+	assert.True(t, decoded.Valid())
+}
@@ -31,11 +31,18 @@ const (
 	CmdDropTable
 	CmdCreateSegment
 	CmdDropSegment
-	CmdCreateBlock
+	// CmdCreateBlockV0 and CmdLogBlockV0 are the block commands logged
+	// without the schema version, they are read as the CmdCreateBlock and
+	// CmdLogBlock of the version 0
+	CmdCreateBlockV0
 	CmdDropBlock
 	CmdLogDatabase
 	CmdLogTable
 	CmdLogSegment
+	CmdLogBlockV0
+	CmdCreateIndex
+	CmdAlterTable
+	CmdCreateBlock
 	CmdLogBlock
 )
 
@@ -58,12 +65,18 @@ func init() {
 	txnif.RegisterCmdFactory(CmdDropSegment, func(cmdType int16) txnif.TxnCmd {
 		return newEmptyEntryCmd(cmdType)
 	})
+	txnif.RegisterCmdFactory(CmdCreateBlockV0, func(cmdType int16) txnif.TxnCmd {
+		return newEmptyEntryCmd(cmdType)
+	})
 	txnif.RegisterCmdFactory(CmdCreateBlock, func(cmdType int16) txnif.TxnCmd {
 		return newEmptyEntryCmd(cmdType)
 	})
 	txnif.RegisterCmdFactory(CmdDropBlock, func(cmdType int16) txnif.TxnCmd {
 		return newEmptyEntryCmd(cmdType)
 	})
+	txnif.RegisterCmdFactory(CmdLogBlockV0, func(cmdType int16) txnif.TxnCmd {
+		return newEmptyEntryCmd(cmdType)
+	})
 	txnif.RegisterCmdFactory(CmdLogBlock, func(cmdType int16) txnif.TxnCmd {
 		return newEmptyEntryCmd(cmdType)
 	})
@@ -76,6 +89,12 @@ func init() {
 	txnif.RegisterCmdFactory(CmdLogDatabase, func(cmdType int16) txnif.TxnCmd {
 		return newEmptyEntryCmd(cmdType)
 	})
+	txnif.RegisterCmdFactory(CmdCreateIndex, func(cmdType int16) txnif.TxnCmd {
+		return newEmptyEntryCmd(cmdType)
+	})
+	txnif.RegisterCmdFactory(CmdAlterTable, func(cmdType int16) txnif.TxnCmd {
+		return newEmptyEntryCmd(cmdType)
+	})
 }
 
 type EntryCommand struct {
@@ -89,6 +108,8 @@ type EntryCommand struct {
 	Table     *TableEntry
 	Segment   *SegmentEntry
 	Block     *BlockEntry
+	Index     *IndexInfo
+	Schema    *Schema
 }
 
 func newEmptyEntryCmd(cmdType int16) *EntryCommand {
@@ -131,6 +152,28 @@ func newTableCmd(id uint32, cmdType int16, entry *TableEntry) *EntryCommand {
 	return impl
 }
 
+func newIndexCmd(id uint32, cmdType int16, entry *IndexEntry) *EntryCommand {
+	impl := &EntryCommand{
+		DB:      entry.GetTable().GetDB(),
+		Table:   entry.GetTable(),
+		Index:   entry.GetInfo(),
+		cmdType: cmdType,
+	}
+	impl.BaseCustomizedCmd = txnbase.NewBaseCustomizedCmd(id, impl)
+	return impl
+}
+
+func newAlterCmd(id uint32, cmdType int16, entry *AlterEntry) *EntryCommand {
+	impl := &EntryCommand{
+		DB:      entry.GetTable().GetDB(),
+		Table:   entry.GetTable(),
+		Schema:  entry.GetSchema(),
+		cmdType: cmdType,
+	}
+	impl.BaseCustomizedCmd = txnbase.NewBaseCustomizedCmd(id, impl)
+	return impl
+}
+
 func newDBCmd(id uint32, cmdType int16, entry *DBEntry) *EntryCommand {
 	impl := &EntryCommand{
 		DB:      entry,
@@ -193,6 +236,32 @@ func (cmd *EntryCommand) WriteTo(w io.Writer) (n int64, err error) {
 		sn, err = cmd.DB.WriteTo(w)
 		n += sn
 		return
+	case CmdCreateIndex:
+		if err = binary.Write(w, binary.BigEndian, cmd.DB.ID); err != nil {
+			return
+		}
+		if err = binary.Write(w, binary.BigEndian, cmd.Table.ID); err != nil {
+			return
+		}
+		sn, err = cmd.Index.WriteTo(w)
+		n += sn + 8 + 8
+		return
+	case CmdAlterTable:
+		if err = binary.Write(w, binary.BigEndian, cmd.DB.ID); err != nil {
+			return
+		}
+		if err = binary.Write(w, binary.BigEndian, cmd.Table.ID); err != nil {
+			return
+		}
+		var schemaBuf []byte
+		if schemaBuf, err = cmd.Schema.Marshal(); err != nil {
+			return
+		}
+		if _, err = w.Write(schemaBuf); err != nil {
+			return
+		}
+		n += int64(len(schemaBuf)) + 8 + 8
+		return
 	}
 
 	if err = binary.Write(w, binary.BigEndian, cmd.entry.GetID()); err != nil {
@@ -263,7 +332,10 @@ func (cmd *EntryCommand) WriteTo(w io.Writer) (n int64, err error) {
 		if err = binary.Write(w, binary.BigEndian, cmd.entry.CreateAt); err != nil {
 			return
 		}
-		n += 8 + 8 + 8 + 8
+		if err = binary.Write(w, binary.BigEndian, cmd.Block.schemaVer); err != nil {
+			return
+		}
+		n += 8 + 8 + 8 + 8 + 4
 	case CmdDropTable:
 		if err = binary.Write(w, binary.BigEndian, cmd.Table.db.ID); err != nil {
 			return
@@ -332,7 +404,7 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 	n += 4
 	var cn int64
 	switch cmd.GetType() {
-	case CmdLogBlock:
+	case CmdLogBlock, CmdLogBlockV0:
 		cmd.Block = &BlockEntry{
 			BaseEntry: new(BaseEntry),
 		}
@@ -346,7 +418,12 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 
-		cn, err = cmd.Block.ReadFrom(r)
+		if cmd.cmdType == CmdLogBlockV0 {
+			cmd.cmdType = CmdLogBlock
+			cn, err = cmd.Block.readV0From(r)
+		} else {
+			cn, err = cmd.Block.ReadFrom(r)
+		}
 		n += cn + 24
 		return
 	case CmdLogSegment:
@@ -379,6 +456,28 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 		cn, err = cmd.DB.ReadFrom(r)
 		n += cn
 		return
+	case CmdCreateIndex:
+		cmd.Index = new(IndexInfo)
+		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &cmd.TableID); err != nil {
+			return
+		}
+		cn, err = cmd.Index.ReadFrom(r)
+		n += cn + 16
+		return
+	case CmdAlterTable:
+		cmd.Schema = NewEmptySchema("")
+		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &cmd.TableID); err != nil {
+			return
+		}
+		cn, err = cmd.Schema.ReadFrom(r)
+		n += cn + 16
+		return
 	}
 
 	cmd.entry = &BaseEntry{}
@@ -421,6 +520,7 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 		if sn, err = cmd.Table.schema.ReadFrom(r); err != nil {
 			return
 		}
+		cmd.Table.schemas = []*Schema{cmd.Table.schema}
 		n += sn + 8 + 8
 	case CmdCreateSegment:
 		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
@@ -445,7 +545,7 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 			state:     state,
 		}
 		n += 8 + 8 + 8
-	case CmdCreateBlock:
+	case CmdCreateBlock, CmdCreateBlockV0:
 		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
 			return
 		}
@@ -466,6 +566,14 @@ func (cmd *EntryCommand) ReadFrom(r io.Reader) (n int64, err error) {
 			BaseEntry: cmd.entry,
 		}
 		n += 8 + 8 + 8 + 8
+		if cmd.cmdType == CmdCreateBlockV0 {
+			cmd.cmdType = CmdCreateBlock
+			break
+		}
+		if err = binary.Read(r, binary.BigEndian, &cmd.Block.schemaVer); err != nil {
+			return
+		}
+		n += 4
 	case CmdDropTable:
 		if err = binary.Read(r, binary.BigEndian, &cmd.DBID); err != nil {
 			return
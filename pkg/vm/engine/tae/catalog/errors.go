@@ -23,5 +23,8 @@ var (
 
 	ErrValidation = errors.New("tae catalog: validataion")
 
+	ErrSchemaChanged = errors.New("tae catalog: schema changed")
+	ErrUnknownFormat = errors.New("tae catalog: unknown format")
+
 	ErrStopCurrRecur = errors.New("tae catalog: stop current recursion")
 )
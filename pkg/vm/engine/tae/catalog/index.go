@@ -0,0 +1,69 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
+)
+
+// IndexEntry is the txn entry of a secondary index being created on a
+// table. The index joins the table schema only when the txn commits.
+type IndexEntry struct {
+	table *TableEntry
+	info  *IndexInfo
+	txn   txnif.AsyncTxn
+}
+
+func newIndexEntry(table *TableEntry, info *IndexInfo, txn txnif.AsyncTxn) *IndexEntry {
+	return &IndexEntry{
+		table: table,
+		info:  info,
+		txn:   txn,
+	}
+}
+
+func (entry *IndexEntry) Lock()    { entry.table.Lock() }
+func (entry *IndexEntry) Unlock()  { entry.table.Unlock() }
+func (entry *IndexEntry) RLock()   { entry.table.RLock() }
+func (entry *IndexEntry) RUnlock() { entry.table.RUnlock() }
+
+func (entry *IndexEntry) GetTable() *TableEntry { return entry.table }
+func (entry *IndexEntry) GetInfo() *IndexInfo   { return entry.info }
+
+func (entry *IndexEntry) String() string {
+	return fmt.Sprintf("INDEX[name=%s][cols=%v]@%s", entry.info.Name, entry.info.Columns, entry.table.String())
+}
+
+func (entry *IndexEntry) PrepareCommit() error {
+	entry.table.RLock()
+	defer entry.table.RUnlock()
+	return entry.table.schema.checkIndex(entry.info)
+}
+
+func (entry *IndexEntry) PrepareRollback() error { return nil }
+func (entry *IndexEntry) ApplyRollback() error   { return nil }
+
+func (entry *IndexEntry) ApplyCommit(index *wal.Index) error {
+	entry.table.Lock()
+	defer entry.table.Unlock()
+	return entry.table.appendIndexLocked(entry.info)
+}
+
+func (entry *IndexEntry) MakeCommand(id uint32) (cmd txnif.TxnCmd, err error) {
+	return newIndexCmd(id, CmdCreateIndex, entry), nil
+}
@@ -20,18 +20,40 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"math/rand"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 )
 
+// SchemaFormat is the version of the encoding of the schema
+type SchemaFormat = uint16
+
+const (
+	// SchemaFormatV0 has no header, it starts with the BlockMaxRows and has
+	// no compression, indexes, version and ids or defaults of the columns
+	SchemaFormatV0 SchemaFormat = iota
+	// SchemaFormatV1 is led by the schemaFormatMagic and the format
+	SchemaFormatV1
+
+	SchemaFormatCurrent = SchemaFormatV1
+)
+
+// schemaFormatMagic leads the versioned formats. It is not a valid
+// BlockMaxRows, so the V0 schemas are told from them.
+const schemaFormatMagic = uint32(math.MaxUint32)
+
 type IndexT uint16
 
 const (
 	ZoneMap IndexT = iota
+	SecondaryIndex
 )
 
 type IndexInfo struct {
@@ -53,19 +75,75 @@ func NewIndexInfo(name string, typ IndexT, colIdx ...int) *IndexInfo {
 	return index
 }
 
+func (info *IndexInfo) WriteTo(w io.Writer) (n int64, err error) {
+	if err = binary.Write(w, binary.BigEndian, info.Id); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, info.Type); err != nil {
+		return
+	}
+	var sn int64
+	if sn, err = common.WriteString(info.Name, w); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, uint16(len(info.Columns))); err != nil {
+		return
+	}
+	if err = binary.Write(w, binary.BigEndian, info.Columns); err != nil {
+		return
+	}
+	n = 8 + 2 + sn + 2 + 2*int64(len(info.Columns))
+	return
+}
+
+func (info *IndexInfo) ReadFrom(r io.Reader) (n int64, err error) {
+	if err = binary.Read(r, binary.BigEndian, &info.Id); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.BigEndian, &info.Type); err != nil {
+		return
+	}
+	var sn int64
+	if info.Name, sn, err = common.ReadString(r); err != nil {
+		return
+	}
+	colCnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &colCnt); err != nil {
+		return
+	}
+	info.Columns = make([]uint16, colCnt)
+	if err = binary.Read(r, binary.BigEndian, info.Columns); err != nil {
+		return
+	}
+	n = 8 + 2 + sn + 2 + 2*int64(colCnt)
+	return
+}
+
 type ColDef struct {
 	Name string
 	Idx  int
 	Type types.Type
+	// ID identifies the column across the versions of the schema
+	ID uint16
+	// Default is the value of the rows written before the column was added,
+	// nil means NULL
+	Default interface{}
 }
 
 type Schema struct {
 	Name             string         `json:"name"`
+	Version          uint32         `json:"version"`
 	ColDefs          []*ColDef      `json:"cols"`
 	NameIndex        map[string]int `json:"nindex"`
 	BlockMaxRows     uint32         `json:"blkrows"`
 	PrimaryKey       int32          `json:"primarykey"`
 	SegmentMaxBlocks uint16         `json:"segblocks"`
+	IndexInfos       []*IndexInfo   `json:"indexes"`
+	Compression      compress.T     `json:"compression"`
+	CompressLevel    int32          `json:"clevel"`
+	// NextColID is the id of the next added column. The ids are never
+	// reused, so a dropped column is not read as a new one.
+	NextColID uint16 `json:"nextcol"`
 }
 
 func NewEmptySchema(name string) *Schema {
@@ -77,15 +155,51 @@ func NewEmptySchema(name string) *Schema {
 }
 
 func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
-	if err = binary.Read(r, binary.BigEndian, &s.BlockMaxRows); err != nil {
+	header := uint32(0)
+	if err = binary.Read(r, binary.BigEndian, &header); err != nil {
 		return
 	}
+	n = 4
+	format := SchemaFormatV0
+	if header == schemaFormatMagic {
+		if err = binary.Read(r, binary.BigEndian, &format); err != nil {
+			return
+		}
+		if format > SchemaFormatCurrent {
+			err = ErrUnknownFormat
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &s.BlockMaxRows); err != nil {
+			return
+		}
+		n += 2 + 4
+	} else {
+		s.BlockMaxRows = header
+	}
 	if err = binary.Read(r, binary.BigEndian, &s.PrimaryKey); err != nil {
 		return
 	}
 	if err = binary.Read(r, binary.BigEndian, &s.SegmentMaxBlocks); err != nil {
 		return
 	}
+	n += 4 + 2
+	if format >= SchemaFormatV1 {
+		algo := uint8(0)
+		if err = binary.Read(r, binary.BigEndian, &algo); err != nil {
+			return
+		}
+		s.Compression = compress.T(algo)
+		if err = binary.Read(r, binary.BigEndian, &s.CompressLevel); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &s.Version); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.BigEndian, &s.NextColID); err != nil {
+			return
+		}
+		n += 1 + 4 + 4 + 2
+	}
 	var sn int64
 	if s.Name, sn, err = common.ReadString(r); err != nil {
 		return
@@ -94,7 +208,10 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 	if err = binary.Read(r, binary.BigEndian, &colCnt); err != nil {
 		return
 	}
-	n = sn + 4 + 4 + 4 + 2
+	n += sn + 2
+	if s.NameIndex == nil {
+		s.NameIndex = make(map[string]int)
+	}
 	colBuf := make([]byte, encoding.TypeSize)
 	for i := uint16(0); i < colCnt; i++ {
 		if _, err = r.Read(colBuf); err != nil {
@@ -107,14 +224,50 @@ func (s *Schema) ReadFrom(r io.Reader) (n int64, err error) {
 			return
 		}
 		n += sn
+		if format >= SchemaFormatV1 {
+			if sn, err = colDef.readExtraFrom(r); err != nil {
+				return
+			}
+			n += sn
+		} else {
+			colDef.ID = i
+		}
 		s.ColDefs = append(s.ColDefs, colDef)
 		colDef.Idx = int(i)
+		s.NameIndex[colDef.Name] = colDef.Idx
+	}
+	if format == SchemaFormatV0 {
+		// The columns of the old format are never altered, their ids are
+		// the positions
+		s.NextColID = colCnt
+		s.IndexInfos = make([]*IndexInfo, 0)
+		return
+	}
+	idxCnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &idxCnt); err != nil {
+		return
+	}
+	n += 2
+	s.IndexInfos = make([]*IndexInfo, 0, idxCnt)
+	for i := uint16(0); i < idxCnt; i++ {
+		info := new(IndexInfo)
+		if sn, err = info.ReadFrom(r); err != nil {
+			return
+		}
+		n += sn
+		s.IndexInfos = append(s.IndexInfos, info)
 	}
 	return
 }
 
 func (s *Schema) Marshal() (buf []byte, err error) {
 	var w bytes.Buffer
+	if err = binary.Write(&w, binary.BigEndian, schemaFormatMagic); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, SchemaFormatCurrent); err != nil {
+		return
+	}
 	if err = binary.Write(&w, binary.BigEndian, s.BlockMaxRows); err != nil {
 		return
 	}
@@ -124,6 +277,18 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 	if err = binary.Write(&w, binary.BigEndian, s.SegmentMaxBlocks); err != nil {
 		return
 	}
+	if err = binary.Write(&w, binary.BigEndian, uint8(s.Compression)); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.CompressLevel); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.Version); err != nil {
+		return
+	}
+	if err = binary.Write(&w, binary.BigEndian, s.NextColID); err != nil {
+		return
+	}
 	if _, err = common.WriteString(s.Name, &w); err != nil {
 		return
 	}
@@ -137,21 +302,247 @@ func (s *Schema) Marshal() (buf []byte, err error) {
 		if _, err = common.WriteString(colDef.Name, &w); err != nil {
 			return
 		}
+		if _, err = colDef.writeExtraTo(&w); err != nil {
+			return
+		}
+	}
+	if err = binary.Write(&w, binary.BigEndian, uint16(len(s.IndexInfos))); err != nil {
+		return
+	}
+	for _, info := range s.IndexInfos {
+		if _, err = info.WriteTo(&w); err != nil {
+			return
+		}
 	}
 	buf = w.Bytes()
 	return
 }
 
+// writeExtraTo writes the id and the default value of the column
+func (def *ColDef) writeExtraTo(w io.Writer) (n int64, err error) {
+	if err = binary.Write(w, binary.BigEndian, def.ID); err != nil {
+		return
+	}
+	n = 2
+	var buf []byte
+	if def.Default != nil {
+		if buf, err = compute.MakeConstVector(def.Type, def.Default, 1).Show(); err != nil {
+			return
+		}
+	}
+	if err = binary.Write(w, binary.BigEndian, uint32(len(buf))); err != nil {
+		return
+	}
+	sn, err := w.Write(buf)
+	n += 4 + int64(sn)
+	return
+}
+
+func (def *ColDef) readExtraFrom(r io.Reader) (n int64, err error) {
+	if err = binary.Read(r, binary.BigEndian, &def.ID); err != nil {
+		return
+	}
+	length := uint32(0)
+	if err = binary.Read(r, binary.BigEndian, &length); err != nil {
+		return
+	}
+	n = 2 + 4
+	if length == 0 {
+		return
+	}
+	buf := make([]byte, length)
+	if _, err = io.ReadFull(r, buf); err != nil {
+		return
+	}
+	n += int64(length)
+	vec := gvec.New(def.Type)
+	if err = vec.Read(buf); err != nil {
+		return
+	}
+	def.Default = compute.GetValue(vec, 0)
+	return
+}
+
 func (s *Schema) AppendCol(name string, typ types.Type) {
 	colDef := &ColDef{
 		Name: name,
 		Type: typ,
 		Idx:  len(s.ColDefs),
+		ID:   s.nextColID(),
 	}
 	s.ColDefs = append(s.ColDefs, colDef)
 	s.NameIndex[name] = colDef.Idx
 }
 
+func (s *Schema) nextColID() (id uint16) {
+	id = s.NextColID
+	for _, def := range s.ColDefs {
+		if def.ID >= id {
+			id = def.ID + 1
+		}
+	}
+	s.NextColID = id + 1
+	return
+}
+
+// Clone returns a copy of the schema which can be altered without
+// affecting the readers of the schema
+func (s *Schema) Clone() *Schema {
+	cloned := *s
+	cloned.ColDefs = make([]*ColDef, len(s.ColDefs))
+	cloned.NameIndex = make(map[string]int, len(s.ColDefs))
+	for i, def := range s.ColDefs {
+		colDef := *def
+		cloned.ColDefs[i] = &colDef
+		cloned.NameIndex[colDef.Name] = i
+	}
+	cloned.IndexInfos = make([]*IndexInfo, len(s.IndexInfos))
+	for i, info := range s.IndexInfos {
+		cloned.IndexInfos[i] = &IndexInfo{
+			Id:      info.Id,
+			Name:    info.Name,
+			Type:    info.Type,
+			Columns: append([]uint16{}, info.Columns...),
+		}
+	}
+	return &cloned
+}
+
+// GetColIdxByID returns the index of the column with the given id if
+// found, otherwise returns -1.
+func (s *Schema) GetColIdxByID(id uint16) int {
+	for i, def := range s.ColDefs {
+		if def.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// AddColumn appends a column to the schema, the rows written before it
+// was added read def as the value of the column.
+func (s *Schema) AddColumn(name string, typ types.Type, def interface{}) error {
+	if _, ok := s.NameIndex[name]; ok {
+		return ErrDuplicate
+	}
+	s.AppendCol(name, typ)
+	s.ColDefs[len(s.ColDefs)-1].Default = def
+	return nil
+}
+
+// DropColumn removes a column and the indexes on it from the schema. The
+// primary key and the last column cannot be dropped.
+func (s *Schema) DropColumn(name string) error {
+	idx, ok := s.NameIndex[name]
+	if !ok {
+		return ErrNotFound
+	}
+	if idx == int(s.PrimaryKey) || len(s.ColDefs) == 1 {
+		return ErrValidation
+	}
+	s.ColDefs = append(s.ColDefs[:idx], s.ColDefs[idx+1:]...)
+	s.NameIndex = make(map[string]int, len(s.ColDefs))
+	for i, def := range s.ColDefs {
+		def.Idx = i
+		s.NameIndex[def.Name] = i
+	}
+	if int(s.PrimaryKey) > idx {
+		s.PrimaryKey--
+	}
+	infos := make([]*IndexInfo, 0, len(s.IndexInfos))
+	for _, info := range s.IndexInfos {
+		if int(info.Columns[0]) == idx {
+			continue
+		}
+		if int(info.Columns[0]) > idx {
+			info.Columns[0]--
+		}
+		infos = append(infos, info)
+	}
+	s.IndexInfos = infos
+	return nil
+}
+
+// RenameColumn renames a column, the data of the column is untouched
+func (s *Schema) RenameColumn(name, newName string) error {
+	idx, ok := s.NameIndex[name]
+	if !ok {
+		return ErrNotFound
+	}
+	if _, ok = s.NameIndex[newName]; ok {
+		return ErrDuplicate
+	}
+	s.ColDefs[idx].Name = newName
+	delete(s.NameIndex, name)
+	s.NameIndex[newName] = idx
+	return nil
+}
+
+// AppendIndex adds a secondary index to the schema. Only single-column
+// indexes on non-primary columns are supported. The index list is replaced
+// rather than modified in place, so readers holding the old list are safe.
+func (s *Schema) AppendIndex(info *IndexInfo) error {
+	if err := s.checkIndex(info); err != nil {
+		return err
+	}
+	infos := make([]*IndexInfo, len(s.IndexInfos), len(s.IndexInfos)+1)
+	copy(infos, s.IndexInfos)
+	for _, other := range infos {
+		if other.Id >= info.Id {
+			info.Id = other.Id + 1
+		}
+	}
+	s.IndexInfos = append(infos, info)
+	return nil
+}
+
+func (s *Schema) checkIndex(info *IndexInfo) error {
+	if info.Type != SecondaryIndex || len(info.Columns) != 1 {
+		return ErrValidation
+	}
+	col := int(info.Columns[0])
+	if col >= len(s.ColDefs) || col == int(s.PrimaryKey) {
+		return ErrValidation
+	}
+	if s.GetIndexByName(info.Name) != nil || s.GetSecondaryIndex(col) != nil {
+		return ErrDuplicate
+	}
+	return nil
+}
+
+// GetIndexByName returns the index with the given name if found, otherwise
+// returns nil.
+func (s *Schema) GetIndexByName(name string) *IndexInfo {
+	for _, info := range s.IndexInfos {
+		if info.Name == name {
+			return info
+		}
+	}
+	return nil
+}
+
+// GetSecondaryIndex returns the secondary index built on the given column
+// if found, otherwise returns nil.
+func (s *Schema) GetSecondaryIndex(colIdx int) *IndexInfo {
+	for _, info := range s.IndexInfos {
+		if info.Type == SecondaryIndex && int(info.Columns[0]) == colIdx {
+			return info
+		}
+	}
+	return nil
+}
+
+// SecondaryIndexCols returns the columns covered by secondary indexes
+func (s *Schema) SecondaryIndexCols() []int {
+	cols := make([]int, 0)
+	for _, info := range s.IndexInfos {
+		if info.Type == SecondaryIndex {
+			cols = append(cols, int(info.Columns[0]))
+		}
+	}
+	return cols
+}
+
 func (s *Schema) String() string {
 	buf, _ := json.Marshal(s)
 	return string(buf)
@@ -188,6 +579,10 @@ func (s *Schema) Valid() bool {
 	if len(s.ColDefs) == 0 {
 		return false
 	}
+	// The BlockMaxRows is the header of the versioned formats
+	if s.BlockMaxRows == schemaFormatMagic {
+		return false
+	}
 
 	names := make(map[string]bool)
 	for idx, colDef := range s.ColDefs {
@@ -15,6 +15,7 @@
 package catalog
 
 import (
+	"encoding/binary"
 	"fmt"
 	"io"
 	"sync"
@@ -28,8 +29,11 @@ type TableDataFactory = func(meta *TableEntry) data.Table
 
 type TableEntry struct {
 	*BaseEntry
-	db        *DBEntry
-	schema    *Schema
+	db     *DBEntry
+	schema *Schema
+	// schemas are the versions of the schema still read by the blocks, the
+	// last one is the schema
+	schemas   []*Schema
 	entries   map[uint64]*common.DLNode
 	link      *common.Link
 	tableData data.Table
@@ -48,6 +52,7 @@ func NewTableEntry(db *DBEntry, schema *Schema, txnCtx txnif.AsyncTxn, dataFacto
 		},
 		db:      db,
 		schema:  schema,
+		schemas: []*Schema{schema},
 		link:    new(common.Link),
 		entries: make(map[uint64]*common.DLNode),
 	}
@@ -64,6 +69,7 @@ func MockStaloneTableEntry(id uint64, schema *Schema) *TableEntry {
 			ID:      id,
 		},
 		schema:  schema,
+		schemas: []*Schema{schema},
 		link:    new(common.Link),
 		entries: make(map[uint64]*common.DLNode),
 	}
@@ -93,6 +99,61 @@ func (entry *TableEntry) CreateSegment(txn txnif.AsyncTxn, state EntryState, dat
 	return
 }
 
+// CreateIndex validates a new secondary index against the table schema and
+// returns the txn entry that adds it to the schema on commit.
+func (entry *TableEntry) CreateIndex(txn txnif.AsyncTxn, info *IndexInfo) (created *IndexEntry, err error) {
+	entry.RLock()
+	defer entry.RUnlock()
+	if err = entry.schema.checkIndex(info); err != nil {
+		return
+	}
+	created = newIndexEntry(entry, info, txn)
+	return
+}
+
+// AlterTable validates the change against the table schema and returns the
+// txn entry that installs the new version of the schema on commit.
+func (entry *TableEntry) AlterTable(txn txnif.AsyncTxn, req *AlterReq) (created *AlterEntry, err error) {
+	entry.RLock()
+	defer entry.RUnlock()
+	created = newAlterEntry(entry, entry.schema, txn)
+	if err = created.Apply(req); err != nil {
+		created = nil
+	}
+	return
+}
+
+// appendIndexLocked adds the index to the schema and to the old versions
+// having the indexed column, so the blocks written before can be searched
+func (entry *TableEntry) appendIndexLocked(info *IndexInfo) error {
+	if err := entry.schema.AppendIndex(info); err != nil {
+		return err
+	}
+	colID := entry.schema.ColDefs[info.Columns[0]].ID
+	for _, schema := range entry.schemas[:len(entry.schemas)-1] {
+		col := schema.GetColIdxByID(colID)
+		if col < 0 {
+			continue
+		}
+		// the index of an old version is best effort, the blocks of it
+		// are searched without it otherwise
+		_ = schema.AppendIndex(NewIndexInfo(info.Name, info.Type, col))
+	}
+	return nil
+}
+
+func (entry *TableEntry) addSchemaLocked(schema *Schema) {
+	entry.schemas = append(entry.schemas, schema)
+	entry.schema = schema
+}
+
+// AddSchema installs a new version of the schema when replaying
+func (entry *TableEntry) AddSchema(schema *Schema) {
+	entry.Lock()
+	defer entry.Unlock()
+	entry.addSchemaLocked(schema)
+}
+
 func (entry *TableEntry) MakeCommand(id uint32) (cmd txnif.TxnCmd, err error) {
 	cmdType := CmdCreateTable
 	entry.RLock()
@@ -121,6 +182,22 @@ func (entry *TableEntry) GetSchema() *Schema {
 	return entry.schema
 }
 
+// GetSchemaByVersion returns the given version of the schema
+func (entry *TableEntry) GetSchemaByVersion(version uint32) *Schema {
+	schema := entry.schema
+	if schema.Version == version {
+		return schema
+	}
+	entry.RLock()
+	defer entry.RUnlock()
+	for i := len(entry.schemas) - 1; i >= 0; i-- {
+		if entry.schemas[i].Version == version {
+			return entry.schemas[i]
+		}
+	}
+	return entry.schema
+}
+
 func (entry *TableEntry) Compare(o common.NodePayload) int {
 	oe := o.(*TableEntry).BaseEntry
 	return entry.DoCompre(oe)
@@ -252,13 +329,21 @@ func (entry *TableEntry) WriteTo(w io.Writer) (n int64, err error) {
 	if n, err = entry.BaseEntry.WriteTo(w); err != nil {
 		return
 	}
-	buf, err := entry.schema.Marshal()
-	if err != nil {
+	if err = binary.Write(w, binary.BigEndian, uint16(len(entry.schemas))); err != nil {
 		return
 	}
-	sn := int(0)
-	sn, err = w.Write(buf)
-	n += int64(sn)
+	n += 2
+	for _, schema := range entry.schemas {
+		var buf []byte
+		if buf, err = schema.Marshal(); err != nil {
+			return
+		}
+		sn := int(0)
+		if sn, err = w.Write(buf); err != nil {
+			return
+		}
+		n += int64(sn)
+	}
 	return
 }
 
@@ -266,12 +351,21 @@ func (entry *TableEntry) ReadFrom(r io.Reader) (n int64, err error) {
 	if n, err = entry.BaseEntry.ReadFrom(r); err != nil {
 		return
 	}
-	if entry.schema == nil {
-		entry.schema = NewEmptySchema("")
+	cnt := uint16(0)
+	if err = binary.Read(r, binary.BigEndian, &cnt); err != nil {
+		return
+	}
+	n += 2
+	entry.schemas = make([]*Schema, cnt)
+	for i := range entry.schemas {
+		entry.schemas[i] = NewEmptySchema("")
+		sn := int64(0)
+		if sn, err = entry.schemas[i].ReadFrom(r); err != nil {
+			return
+		}
+		n += sn
 	}
-	sn := int64(0)
-	sn, err = entry.schema.ReadFrom(r)
-	n += sn
+	entry.schema = entry.schemas[cnt-1]
 	return
 }
 
@@ -283,6 +377,7 @@ func (entry *TableEntry) Clone() CheckpointItem {
 	cloned := &TableEntry{
 		BaseEntry: entry.BaseEntry.Clone(),
 		schema:    entry.schema,
+		schemas:   entry.schemas,
 		db:        entry.db,
 	}
 	return cloned
@@ -292,6 +387,7 @@ func (entry *TableEntry) CloneCreate() CheckpointItem {
 	cloned := &TableEntry{
 		BaseEntry: entry.BaseEntry.CloneCreate(),
 		schema:    entry.schema,
+		schemas:   entry.schemas,
 		db:        entry.db,
 	}
 	return cloned
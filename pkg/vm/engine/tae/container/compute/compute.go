@@ -83,6 +83,60 @@ func AppendValue(vec *gvec.Vector, v interface{}) {
 	}
 }
 
+// MakeConstVector returns a vector of rows copies of v, which is NULL if v is nil
+func MakeConstVector(typ types.Type, v interface{}, rows int) *gvec.Vector {
+	vec := gvec.New(typ)
+	isNull := v == nil
+	if isNull {
+		v = zeroValue(typ)
+	} else if s, ok := v.(string); ok {
+		v = []byte(s)
+	}
+	for i := 0; i < rows; i++ {
+		AppendValue(vec, v)
+	}
+	if isNull && rows > 0 {
+		vec.Nsp.Np = roaring64.New()
+		vec.Nsp.Np.AddRange(0, uint64(rows))
+	}
+	return vec
+}
+
+func zeroValue(typ types.Type) interface{} {
+	switch typ.Oid {
+	case types.T_int8:
+		return int8(0)
+	case types.T_int16:
+		return int16(0)
+	case types.T_int32:
+		return int32(0)
+	case types.T_int64:
+		return int64(0)
+	case types.T_uint8:
+		return uint8(0)
+	case types.T_uint16:
+		return uint16(0)
+	case types.T_uint32:
+		return uint32(0)
+	case types.T_uint64:
+		return uint64(0)
+	case types.T_decimal64:
+		return types.Decimal64(0)
+	case types.T_float32:
+		return float32(0)
+	case types.T_float64:
+		return float64(0)
+	case types.T_date:
+		return types.Date(0)
+	case types.T_datetime:
+		return types.Datetime(0)
+	case types.T_char, types.T_varchar, types.T_json:
+		return []byte{}
+	default:
+		panic("not expected")
+	}
+}
+
 func GetValue(col *gvec.Vector, row uint32) interface{} {
 	vals := col.Col
 	switch col.Typ.Oid {
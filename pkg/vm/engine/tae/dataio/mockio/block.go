@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 	idxCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
 )
@@ -39,6 +40,9 @@ type blockFile struct {
 	columns   []*columnBlock
 	deletes   *deletesFile
 	indexMeta *dataFile
+
+	compressAlgo  int
+	compressLevel int
 }
 
 func newBlock(id uint64, seg file.Segment, colCnt int, indexCnt map[int]int) *blockFile {
@@ -72,6 +76,11 @@ func (bf *blockFile) close() {
 	bf.Destroy()
 }
 
+func (bf *blockFile) SetCompression(algo, level int) {
+	bf.compressAlgo = algo
+	bf.compressLevel = level
+}
+
 func (bf *blockFile) WriteRows(rows uint32) (err error) {
 	bf.rows = rows
 	return nil
@@ -155,9 +164,8 @@ func (bf *blockFile) LoadIBatch(colTypes []types.Type, maxRow uint32) (bat batch
 			return
 		}
 		defer f.Unref()
-		size := f.Stat().Size()
-		buf := make([]byte, size)
-		if _, err = f.Read(buf); err != nil {
+		var buf []byte
+		if buf, err = colBlk.data.readDecompressed(); err != nil {
 			return
 		}
 		vec := vector.NewVector(colTypes[i], uint64(maxRow))
@@ -179,13 +187,18 @@ func (bf *blockFile) LoadBatch(attrs []string, colTypes []types.Type) (bat *gbat
 			return
 		}
 		defer f.Unref()
-		size := f.Stat().Size()
-		buf := make([]byte, size)
-		if _, err = f.Read(buf); err != nil {
+		var buf []byte
+		if buf, err = colBlk.data.readDecompressed(); err != nil {
 			return
 		}
-		vec := gvec.New(colTypes[i])
-		if err = vec.Read(buf); err != nil {
+		var vec *gvec.Vector
+		if colBlk.data.stat.enc == encoding.Plain {
+			vec = gvec.New(colTypes[i])
+			err = vec.Read(buf)
+		} else {
+			vec, err = encoding.Decode(buf, colTypes[i])
+		}
+		if err != nil {
 			return
 		}
 		bat.Vecs[i] = vec
@@ -200,11 +213,17 @@ func (bf *blockFile) WriteColumnVec(ts uint64, colIdx int, vec *gvec.Vector) (er
 	}
 	defer cb.Close()
 	cb.WriteTS(ts)
-	buf, err := vec.Show()
+	var buf []byte
+	enc := encoding.Select(vec)
+	if enc == encoding.Plain {
+		buf, err = vec.Show()
+	} else {
+		buf, err = encoding.EncodeWith(vec, enc)
+	}
 	if err != nil {
 		return err
 	}
-	err = cb.WriteData(buf)
+	err = cb.(*columnBlock).writeEncodedData(buf, enc)
 	return
 }
 
@@ -273,7 +292,7 @@ func (bf *blockFile) WriteIBatch(bat batch.IBatch, ts uint64, masks map[uint16]*
 		if err != nil {
 			return err
 		}
-		if err = cb.WriteData(buf); err != nil {
+		if err = cb.(*columnBlock).writeCompressedData(buf); err != nil {
 			return err
 		}
 	}
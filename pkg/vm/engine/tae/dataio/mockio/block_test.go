@@ -19,7 +19,12 @@ import (
 	"testing"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 	"github.com/stretchr/testify/assert"
 )
@@ -71,3 +76,79 @@ func TestBlock1(t *testing.T) {
 
 	block.Unref()
 }
+
+func TestBlockCompression(t *testing.T) {
+	schema := catalog.MockSchemaAll(13)
+	schema.PrimaryKey = 2
+	colTypes := schema.Types()
+	attrs := schema.Attrs()
+	bat := compute.MockBatch(colTypes, 1000, int(schema.PrimaryKey), nil)
+	for _, algo := range []int{compress.None, compress.Lz4, compress.Zstd, compress.Snappy} {
+		block := newBlock(common.NextGlobalSeqNum(), nil, len(colTypes), nil)
+		block.SetCompression(algo, 0)
+		err := block.WriteBatch(bat, common.NextGlobalSeqNum())
+		assert.Nil(t, err)
+		// The varchar column is kept plain, encoded ones may not compress further
+		colBlk, err := block.OpenColumn(12)
+		assert.Nil(t, err)
+		stat := colBlk.GetDataFileStat()
+		assert.Equal(t, algo, stat.CompressAlgo())
+		if algo != compress.None {
+			assert.Less(t, stat.Size(), stat.OriginSize())
+		}
+		colBlk.Close()
+
+		loaded, err := block.LoadBatch(attrs, colTypes)
+		assert.Nil(t, err)
+		for i := range bat.Vecs {
+			assert.Equal(t, bat.Vecs[i].Col, loaded.Vecs[i].Col)
+		}
+		block.Unref()
+	}
+}
+
+func TestBlockEncoding(t *testing.T) {
+	schema := catalog.MockSchemaAll(13)
+	schema.PrimaryKey = 3
+	colTypes := schema.Types()
+	attrs := schema.Attrs()
+	bat := compute.MockBatch(colTypes, 1000, int(schema.PrimaryKey), nil)
+	nulls.Add(bat.Vecs[3].Nsp, 10, 20)
+	for _, algo := range []int{compress.None, compress.Zstd} {
+		block := newBlock(common.NextGlobalSeqNum(), nil, len(colTypes), nil)
+		block.SetCompression(algo, 0)
+		err := block.WriteBatch(bat, common.NextGlobalSeqNum())
+		assert.Nil(t, err)
+		for i := range bat.Vecs {
+			colBlk, err := block.OpenColumn(i)
+			assert.Nil(t, err)
+			assert.Equal(t, encoding.Select(bat.Vecs[i]), colBlk.GetDataEncoding())
+			colBlk.Close()
+		}
+		// The sorted primary key is delta encoded
+		colBlk, _ := block.OpenColumn(3)
+		assert.Equal(t, encoding.Delta, colBlk.GetDataEncoding())
+		colBlk.Close()
+
+		loaded, err := block.LoadBatch(attrs, colTypes)
+		assert.Nil(t, err)
+		for i := range bat.Vecs {
+			assert.Equal(t, bat.Vecs[i].Col, loaded.Vecs[i].Col)
+			assert.Equal(t, nulls.Length(bat.Vecs[i].Nsp), nulls.Length(loaded.Vecs[i].Nsp))
+		}
+		assert.True(t, nulls.Contains(loaded.Vecs[3].Nsp, 20))
+
+		// The encoding is kept with the data, the plain data replacing the
+		// encoded one is read as plain
+		buf, err := bat.Vecs[3].Show()
+		assert.Nil(t, err)
+		colBlk, _ = block.OpenColumn(3)
+		assert.Nil(t, colBlk.WriteData(buf))
+		assert.Equal(t, encoding.Plain, colBlk.GetDataEncoding())
+		colBlk.Close()
+		loaded, err = block.LoadBatch(attrs, colTypes)
+		assert.Nil(t, err)
+		assert.Equal(t, bat.Vecs[3].Col, loaded.Vecs[3].Col)
+		block.Unref()
+	}
+}
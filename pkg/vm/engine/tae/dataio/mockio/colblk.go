@@ -17,6 +17,7 @@ package mockio
 import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/file"
 )
 
@@ -54,6 +55,17 @@ func (cb *columnBlock) WriteData(buf []byte) (err error) {
 	return
 }
 
+func (cb *columnBlock) writeCompressedData(buf []byte) (err error) {
+	return cb.writeEncodedData(buf, encoding.Plain)
+}
+
+// writeEncodedData compresses the column data encoded with enc, the encoding
+// is written in the header of the data file
+func (cb *columnBlock) writeEncodedData(buf []byte, enc encoding.T) (err error) {
+	_, err = cb.data.writeCompressed(buf, cb.block.compressAlgo, cb.block.compressLevel, enc)
+	return
+}
+
 func (cb *columnBlock) WriteUpdates(buf []byte) (err error) {
 	_, err = cb.updates.Write(buf)
 	return
@@ -95,6 +107,10 @@ func (cb *columnBlock) GetDataFileStat() (stat common.FileInfo) {
 	return cb.data.stat
 }
 
+func (cb *columnBlock) GetDataEncoding() encoding.T {
+	return cb.data.stat.enc
+}
+
 func (cb *columnBlock) OpenIndexFile(idx int) (vfile common.IRWFile, err error) {
 	if idx >= len(cb.indexes) {
 		err = file.ErrInvalidParam
@@ -15,7 +15,9 @@
 package mockio
 
 import (
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/encoding"
 )
 
 type dataFile struct {
@@ -70,6 +72,42 @@ func (df *dataFile) Write(buf []byte) (n int, err error) {
 	df.buf = make([]byte, len(buf))
 	copy(df.buf, buf)
 	df.stat.size = int64(len(df.buf))
+	df.stat.osize = df.stat.size
+	df.stat.algo = compress.None
+	df.stat.enc = encoding.Plain
+	return
+}
+
+// writeCompressed compresses buf encoded with enc with the given algorithm
+// and records both in the file stat, so that readers can decode files of
+// mixed algorithms and encodings
+func (df *dataFile) writeCompressed(buf []byte, algo, level int, enc encoding.T) (n int, err error) {
+	if algo == compress.None {
+		n, err = df.Write(buf)
+		df.stat.enc = enc
+		return
+	}
+	n = len(buf)
+	cbuf := make([]byte, compress.CompressBlockBound(len(buf), algo))
+	if cbuf, err = compress.CompressWithLevel(buf, cbuf, algo, level); err != nil {
+		return
+	}
+	df.buf = cbuf
+	df.stat.size = int64(len(cbuf))
+	df.stat.osize = int64(n)
+	df.stat.algo = algo
+	df.stat.enc = enc
+	return
+}
+
+// readDecompressed reads the whole file and decodes it
+func (df *dataFile) readDecompressed() (buf []byte, err error) {
+	buf = make([]byte, df.stat.osize)
+	if df.stat.algo == compress.None {
+		copy(buf, df.buf)
+		return
+	}
+	_, err = compress.Decompress(df.buf, buf, df.stat.algo)
 	return
 }
 
@@ -14,11 +14,18 @@
 
 package mockio
 
+import "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/encoding"
+
+// fileStat is the header of the file, it is kept with the data of the file
 type fileStat struct {
-	size int64
+	size  int64
+	osize int64
+	algo  int
+	// enc is the lightweight encoding of the column data
+	enc encoding.T
 }
 
 func (stat *fileStat) Name() string      { return "" }
 func (stat *fileStat) Size() int64       { return stat.size }
-func (stat *fileStat) OriginSize() int64 { return stat.size }
-func (stat *fileStat) CompressAlgo() int { return 0 }
+func (stat *fileStat) OriginSize() int64 { return stat.osize }
+func (stat *fileStat) CompressAlgo() int { return stat.algo }
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"io"
 	"sync/atomic"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -63,6 +64,12 @@ func (db *DB) StartTxn(info []byte) txnif.AsyncTxn {
 	return db.TxnMgr.StartTxn(info)
 }
 
+// StartSnapshotTxn starts a read only txn which reads the data as of ts, ts should
+// be inside the retention window
+func (db *DB) StartSnapshotTxn(info []byte, ts time.Time) (txnif.AsyncTxn, error) {
+	return db.TxnMgr.StartSnapshotTxn(info, ts)
+}
+
 func (db *DB) CommitTxn(txn txnif.AsyncTxn) (err error) {
 	return txn.Commit()
 }
@@ -71,6 +78,29 @@ func (db *DB) RollbackTxn(txn txnif.AsyncTxn) error {
 	return txn.Rollback()
 }
 
+// TxnClient returns a txnif.TxnClient which starts transactions on db
+func (db *DB) TxnClient() txnif.TxnClient {
+	return &txnClient{db: db}
+}
+
+type txnClient struct {
+	db *DB
+}
+
+func (c *txnClient) StartTxn(info []byte) (txnif.AsyncTxn, error) {
+	if err := c.db.Closed.Load(); err != nil {
+		return nil, ErrClosed
+	}
+	return c.db.StartTxn(info), nil
+}
+
+func (c *txnClient) StartSnapshotTxn(info []byte, ts time.Time) (txnif.AsyncTxn, error) {
+	if err := c.db.Closed.Load(); err != nil {
+		return nil, ErrClosed
+	}
+	return c.db.StartSnapshotTxn(info, ts)
+}
+
 func (db *DB) startWorkers() (err error) {
 	db.CKPDriver.Start()
 	db.TimedScanner.Start()
@@ -15,12 +15,27 @@
 package db
 
 import (
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tasks"
 )
 
+// gcSafeTS returns the max ts of the dropped entries which can be reclaimed. The entries
+// dropped inside the retention window or after the start of an active snapshot txn are
+// still visible to the snapshot reads and are kept.
+func gcSafeTS(db *DB, safeTS uint64) uint64 {
+	if ts := db.TxnMgr.Clock.RetainedTS(time.Now()); ts < safeTS {
+		safeTS = ts
+	}
+	if ts := db.TxnMgr.StatSnapshotTS(); ts < safeTS {
+		safeTS = ts
+	}
+	return safeTS
+}
+
 // Destroy is not thread-safe
 func gcBlockClosure(entry *catalog.BlockEntry) tasks.FuncT {
 	return func() error {
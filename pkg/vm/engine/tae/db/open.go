@@ -72,6 +72,7 @@ func Open(dirname string, opts *options.Options) (db *DB, err error) {
 	txnStoreFactory := txnimpl.TxnStoreFactory(db.Opts.Catalog, db.Wal, txnBufMgr, dataFactory)
 	txnFactory := txnimpl.TxnFactory(db.Opts.Catalog)
 	db.TxnMgr = txnbase.NewTxnManager(txnStoreFactory, txnFactory)
+	db.TxnMgr.SetRetentionWindow(time.Duration(opts.RetentionCfg.Window) * time.Millisecond)
 	db.TxnMgr.Start()
 
 	db.DBLocker, dbLocker = dbLocker, nil
@@ -103,6 +103,7 @@ type catalogStatsMonitor struct {
 	unCheckpointedCnt int64
 	minTs             uint64
 	maxTs             uint64
+	gcTs              uint64
 	lastScheduleTime  time.Time
 	cntLimit          int64
 	intervalLimit     time.Duration
@@ -134,6 +135,7 @@ func (monitor *catalogStatsMonitor) PreExecute() error {
 	monitor.unCheckpointedCnt = 0
 	monitor.minTs = monitor.db.Catalog.GetCheckpointed().MaxTS + 1
 	monitor.maxTs = monitor.db.Scheduler.GetSafeTS()
+	monitor.gcTs = gcSafeTS(monitor.db, monitor.maxTs)
 	return nil
 }
 
@@ -159,7 +161,7 @@ func (monitor *catalogStatsMonitor) onBlock(entry *catalog.BlockEntry) (err erro
 	checkpointed := monitor.db.Scheduler.GetCheckpointedLSN()
 	gcNeeded := false
 	entry.RLock()
-	if entry.IsDroppedCommitted() && !entry.DeleteAfter(monitor.maxTs) {
+	if entry.IsDroppedCommitted() && !entry.DeleteAfter(monitor.gcTs) {
 		logIndex := entry.GetLogIndex()
 		if logIndex != nil {
 			gcNeeded = checkpointed >= logIndex.LSN
@@ -188,7 +190,7 @@ func (monitor *catalogStatsMonitor) onSegment(entry *catalog.SegmentEntry) (err
 	checkpointed := monitor.db.Scheduler.GetCheckpointedLSN()
 	gcNeeded := false
 	entry.RLock()
-	if entry.IsDroppedCommitted() {
+	if entry.IsDroppedCommitted() && !entry.DeleteAfter(monitor.gcTs) {
 		logIndex := entry.GetLogIndex()
 		if logIndex != nil {
 			gcNeeded = checkpointed >= logIndex.LSN
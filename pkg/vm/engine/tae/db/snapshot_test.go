@@ -0,0 +1,153 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
+	"github.com/stretchr/testify/assert"
+)
+
+func initSnapshotDB(t *testing.T, window int64) *DB {
+	opts := new(options.Options)
+	opts.RetentionCfg = &options.RetentionCfg{Window: window}
+	return initDB(t, opts)
+}
+
+func TestSnapshotTxn(t *testing.T) {
+	tae := initSnapshotDB(t, 60000)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.PrimaryKey = 3
+	bat := compute.MockBatch(schema.Types(), uint64(schema.BlockMaxRows), int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 2)
+	{
+		txn := tae.StartTxn(nil)
+		db, _ := txn.CreateDatabase("db")
+		rel, _ := db.CreateRelation(schema)
+		assert.Nil(t, rel.Append(bats[0]))
+		assert.Nil(t, txn.Commit())
+	}
+	time.Sleep(time.Millisecond * 2)
+	ts := time.Now()
+	time.Sleep(time.Millisecond * 2)
+	{
+		txn := tae.StartTxn(nil)
+		db, _ := txn.GetDatabase("db")
+		rel, _ := db.GetRelationByName(schema.Name)
+		assert.Nil(t, rel.Append(bats[1]))
+		filter := handle.NewEQFilter(compute.GetValue(bats[0].Vecs[schema.PrimaryKey], 0))
+		id, row, err := rel.GetByFilter(filter)
+		assert.Nil(t, err)
+		assert.Nil(t, rel.RangeDelete(id, row, row))
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn, err := tae.StartSnapshotTxn(nil, ts)
+		assert.Nil(t, err)
+		db, _ := txn.GetDatabase("db")
+		rel, _ := db.GetRelationByName(schema.Name)
+		filter := handle.NewEQFilter(compute.GetValue(bats[0].Vecs[schema.PrimaryKey], 0))
+		_, _, err = rel.GetByFilter(filter)
+		assert.Nil(t, err)
+		filter = handle.NewEQFilter(compute.GetValue(bats[1].Vecs[schema.PrimaryKey], 0))
+		_, _, err = rel.GetByFilter(filter)
+		assert.Equal(t, txnbase.ErrNotFound, err)
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn := tae.StartTxn(nil)
+		db, _ := txn.GetDatabase("db")
+		rel, _ := db.GetRelationByName(schema.Name)
+		filter := handle.NewEQFilter(compute.GetValue(bats[0].Vecs[schema.PrimaryKey], 0))
+		_, _, err := rel.GetByFilter(filter)
+		assert.Equal(t, txnbase.ErrNotFound, err)
+		assert.Nil(t, txn.Commit())
+	}
+	// the snapshot txn is read only
+	{
+		txn, err := tae.StartSnapshotTxn(nil, time.Now())
+		assert.Nil(t, err)
+		_, err = txn.CreateDatabase("db2")
+		assert.Nil(t, err)
+		assert.Equal(t, txnbase.ErrTxnSnapshotReadonly, txn.Commit())
+	}
+	_, err := tae.StartSnapshotTxn(nil, time.Now().Add(time.Hour))
+	assert.Equal(t, txnbase.ErrSnapshotInFuture, err)
+	_, err = tae.StartSnapshotTxn(nil, time.Now().Add(-time.Hour))
+	assert.Equal(t, txnbase.ErrSnapshotTooOld, err)
+}
+
+func TestSnapshotDisabled(t *testing.T) {
+	tae := initDB(t, nil)
+	defer tae.Close()
+	_, err := tae.StartSnapshotTxn(nil, time.Now())
+	assert.Equal(t, txnbase.ErrSnapshotDisabled, err)
+	assert.Equal(t, uint64(100), gcSafeTS(tae, 100))
+}
+
+func TestSnapshotRetention(t *testing.T) {
+	tae := initSnapshotDB(t, 60000)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.PrimaryKey = 3
+	bat := compute.MockBatch(schema.Types(), uint64(schema.BlockMaxRows), int(schema.PrimaryKey), nil)
+	{
+		txn := tae.StartTxn(nil)
+		db, _ := txn.CreateDatabase("db")
+		rel, _ := db.CreateRelation(schema)
+		assert.Nil(t, rel.Append(bat))
+		assert.Nil(t, txn.Commit())
+	}
+	time.Sleep(time.Millisecond * 2)
+	ts := time.Now()
+	time.Sleep(time.Millisecond * 2)
+	var meta *catalog.BlockEntry
+	{
+		txn := tae.StartTxn(nil)
+		db, _ := txn.GetDatabase("db")
+		rel, _ := db.GetRelationByName(schema.Name)
+		it := rel.MakeBlockIt()
+		meta = it.GetBlock().GetMeta().(*catalog.BlockEntry)
+		task, err := jobs.NewCompactBlockTask(nil, txn, meta, tae.Scheduler)
+		assert.Nil(t, err)
+		assert.Nil(t, task.OnExec())
+		assert.Nil(t, txn.Commit())
+	}
+	assert.True(t, meta.IsDroppedCommitted())
+
+	// the block dropped inside the retention window is kept
+	gcTs := gcSafeTS(tae, tae.Scheduler.GetSafeTS())
+	assert.True(t, meta.DeleteAfter(gcTs))
+
+	// and is still visible to the snapshot
+	txn, err := tae.StartSnapshotTxn(nil, ts)
+	assert.Nil(t, err)
+	db, _ := txn.GetDatabase("db")
+	rel, _ := db.GetRelationByName(schema.Name)
+	it := rel.MakeBlockIt()
+	assert.Equal(t, meta.ID, it.GetBlock().Fingerprint().BlockID)
+	assert.Equal(t, txn.GetStartTS(), tae.TxnMgr.StatSnapshotTS())
+	assert.Nil(t, txn.Commit())
+}
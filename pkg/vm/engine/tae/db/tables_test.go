@@ -16,23 +16,29 @@ package db
 
 import (
 	"bytes"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/dataio/mockio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/data"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/txn/txnbase"
 	"github.com/panjf2000/ants/v2"
 	"github.com/stretchr/testify/assert"
 )
@@ -698,3 +704,442 @@ func TestMergeBlocks2(t *testing.T) {
 	t.Logf("Checkpointed: %d", tae.Wal.GetCheckpointed())
 	t.Logf("PendingCnt: %d", tae.Wal.GetPenddingCnt())
 }
+
+func TestSecondaryIndex1(t *testing.T) {
+	db := initDB(t, nil)
+	defer db.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 5
+	schema.SegmentMaxBlocks = 8
+	schema.PrimaryKey = 2
+	col3Data := []int64{10, 8, 1, 6, 15, 7, 3, 12, 11, 4, 9, 5, 14, 13, 2}
+	pkData := []int32{2, 9, 11, 13, 15, 1, 4, 7, 10, 14, 3, 5, 6, 8, 12}
+	pk := gvec.New(schema.GetPKType())
+	col3 := gvec.New(schema.ColDefs[3].Type)
+	for i, v := range pkData {
+		compute.AppendValue(pk, v)
+		compute.AppendValue(col3, col3Data[i])
+	}
+	provider := compute.NewMockDataProvider()
+	provider.AddColumnProvider(int(schema.PrimaryKey), pk)
+	provider.AddColumnProvider(3, col3)
+	bat := compute.MockBatch(schema.Types(), uint64(schema.BlockMaxRows*3), int(schema.PrimaryKey), provider)
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		err := rel.Append(bat)
+		assert.Nil(t, err)
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		err := rel.CreateIndex(catalog.NewIndexInfo("idx", catalog.SecondaryIndex, 3))
+		assert.Nil(t, err)
+		// Only non primary key columns can be indexed
+		err = rel.CreateIndex(catalog.NewIndexInfo("idx2", catalog.SecondaryIndex, int(schema.PrimaryKey)))
+		assert.NotNil(t, err)
+		assert.Nil(t, txn.Commit())
+	}
+	getPKs := func(rel handle.Relation, filter *handle.Filter) []int32 {
+		hits, err := rel.GetByIndex(3, filter)
+		assert.Nil(t, err)
+		pks := make([]int32, 0)
+		for id, rows := range hits {
+			id := id
+			it := rows.Iterator()
+			for it.HasNext() {
+				v, err := rel.GetValue(&id, it.Next(), uint16(schema.PrimaryKey))
+				assert.Nil(t, err)
+				pks = append(pks, v.(int32))
+			}
+		}
+		return pks
+	}
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		assert.NotNil(t, rel.GetMeta().(*catalog.TableEntry).GetSchema().GetSecondaryIndex(3))
+		assert.Equal(t, []int32{13}, getPKs(rel, handle.NewEQFilter(int64(6))))
+		assert.ElementsMatch(t, []int32{2, 10, 7}, getPKs(rel, handle.NewBtwFilter(int64(10), int64(12))))
+		assert.ElementsMatch(t, []int32{15, 6, 8}, getPKs(rel, handle.NewBtwFilter(int64(13), nil)))
+		_, err := rel.GetByIndex(4, handle.NewBtwFilter(int64(6), nil))
+		assert.ErrorIs(t, err, txnbase.ErrNotIndexed)
+
+		// Update the row of 6 to 100 and delete the row of 7
+		id, row, err := rel.GetByFilter(handle.NewEQFilter(int32(13)))
+		assert.Nil(t, err)
+		assert.Nil(t, rel.Update(id, row, 3, int64(100)))
+		id, row, err = rel.GetByFilter(handle.NewEQFilter(int32(1)))
+		assert.Nil(t, err)
+		assert.Nil(t, rel.RangeDelete(id, row, row))
+		assert.Nil(t, txn.Commit())
+	}
+	check := func() {
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		assert.Empty(t, getPKs(rel, handle.NewEQFilter(int64(6))))
+		assert.Empty(t, getPKs(rel, handle.NewEQFilter(int64(7))))
+		assert.Equal(t, []int32{13}, getPKs(rel, handle.NewEQFilter(int64(100))))
+		assert.ElementsMatch(t, []int32{11, 4, 14, 5, 12}, getPKs(rel, handle.NewBtwFilter(int64(1), int64(5))))
+		assert.Nil(t, txn.Commit())
+	}
+	check()
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		blks := make([]*catalog.BlockEntry, 0)
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blks = append(blks, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+			it.Next()
+		}
+		factory := jobs.MergeBlocksIntoSegmentTaskFctory(blks, blks[0].GetSegment(), db.Scheduler)
+		task, err := factory(nil, txn)
+		assert.Nil(t, err)
+		assert.Nil(t, task.OnExec())
+		assert.Nil(t, txn.Commit())
+	}
+	// The merged blocks are served by the persisted indexes
+	check()
+}
+
+func TestRelationStats(t *testing.T) {
+	db := initDB(t, nil)
+	defer db.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 5
+	schema.SegmentMaxBlocks = 8
+	schema.PrimaryKey = 2
+	pkData := []int32{2, 9, 11, 13, 15, 1, 4, 7, 10, 14, 3, 5, 6, 8, 12}
+	pk := gvec.New(schema.GetPKType())
+	for _, v := range pkData {
+		compute.AppendValue(pk, v)
+	}
+	provider := compute.NewMockDataProvider()
+	provider.AddColumnProvider(int(schema.PrimaryKey), pk)
+	bat := compute.MockBatch(schema.Types(), uint64(schema.BlockMaxRows*3), int(schema.PrimaryKey), provider)
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		assert.Nil(t, rel.Append(bat))
+		// The rows not committed are not counted
+		assert.Equal(t, int64(0), rel.Rows())
+		assert.Nil(t, txn.Commit())
+	}
+	pkName := schema.ColDefs[schema.PrimaryKey].Name
+	check := func() {
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		assert.Equal(t, int64(15), rel.Rows())
+		assert.Equal(t, int64(15), rel.GetCardinality(pkName))
+		assert.Equal(t, int64(0), rel.GetCardinality(schema.ColDefs[3].Name))
+		min, max, ok := rel.GetRange(pkName)
+		assert.True(t, ok)
+		assert.Equal(t, int32(1), min)
+		assert.Equal(t, int32(15), max)
+		_, _, ok = rel.GetRange(schema.ColDefs[3].Name)
+		assert.False(t, ok)
+		assert.Nil(t, txn.Commit())
+	}
+	check()
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		blks := make([]*catalog.BlockEntry, 0)
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blks = append(blks, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+			it.Next()
+		}
+		factory := jobs.MergeBlocksIntoSegmentTaskFctory(blks, blks[0].GetSegment(), db.Scheduler)
+		task, err := factory(nil, txn)
+		assert.Nil(t, err)
+		assert.Nil(t, task.OnExec())
+		assert.Nil(t, txn.Commit())
+	}
+	// The merged blocks are served by the persisted zonemaps
+	check()
+}
+
+func TestCompression1(t *testing.T) {
+	db := initDB(t, nil)
+	defer db.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 4
+	schema.PrimaryKey = 2
+	schema.Compression = compress.Zstd
+	schema.CompressLevel = 9
+	bat := compute.MockBatch(schema.Types(), uint64(schema.BlockMaxRows*4), int(schema.PrimaryKey), nil)
+	bats := compute.SplitBatch(bat, 2)
+	merge := func() {
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		blks := make([]*catalog.BlockEntry, 0)
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			meta := it.GetBlock().GetMeta().(*catalog.BlockEntry)
+			if meta.IsAppendable() {
+				blks = append(blks, meta)
+			}
+			it.Next()
+		}
+		factory := jobs.MergeBlocksIntoSegmentTaskFctory(blks, blks[0].GetSegment(), db.Scheduler)
+		task, err := factory(nil, txn)
+		assert.Nil(t, err)
+		assert.Nil(t, task.OnExec())
+		assert.Nil(t, txn.Commit())
+	}
+	check := func(rows int) {
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		for row := 0; row < rows; row++ {
+			filter := handle.NewEQFilter(compute.GetValue(bat.Vecs[schema.PrimaryKey], uint32(row)))
+			id, offset, err := rel.GetByFilter(filter)
+			assert.Nil(t, err)
+			for col := range schema.ColDefs {
+				v, err := rel.GetValue(id, offset, uint16(col))
+				assert.Nil(t, err)
+				assert.Equal(t, compute.GetValue(bat.Vecs[col], uint32(row)), v)
+			}
+		}
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		assert.Nil(t, rel.Append(bats[0]))
+		assert.Nil(t, txn.Commit())
+	}
+	merge()
+	check(int(schema.BlockMaxRows) * 2)
+
+	// Blocks written afterwards use another algorithm, the table then holds
+	// blocks of both
+	schema.Compression = compress.Snappy
+	schema.CompressLevel = 0
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		assert.Nil(t, rel.Append(bats[1]))
+		assert.Nil(t, txn.Commit())
+	}
+	merge()
+	check(int(schema.BlockMaxRows) * 4)
+}
+
+func TestEncoding1(t *testing.T) {
+	db := initDB(t, nil)
+	defer db.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 4
+	schema.PrimaryKey = 2
+	bat := compute.MockBatch(schema.Types(), uint64(schema.BlockMaxRows*4), int(schema.PrimaryKey), nil)
+	// A low cardinality varchar column is dictionary encoded
+	statuses := make([][]byte, gvec.Length(bat.Vecs[12]))
+	for i := range statuses {
+		statuses[i] = []byte(fmt.Sprintf("status-%d", i%2))
+	}
+	bat.Vecs[12] = gvec.New(schema.ColDefs[12].Type)
+	assert.Nil(t, gvec.Append(bat.Vecs[12], statuses))
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		assert.Nil(t, rel.Append(bat))
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		blks := make([]*catalog.BlockEntry, 0)
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blks = append(blks, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+			it.Next()
+		}
+		factory := jobs.MergeBlocksIntoSegmentTaskFctory(blks, blks[0].GetSegment(), db.Scheduler)
+		task, err := factory(nil, txn)
+		assert.Nil(t, err)
+		assert.Nil(t, task.OnExec())
+		assert.Nil(t, txn.Commit())
+	}
+	txn := db.StartTxn(nil)
+	database, _ := txn.GetDatabase("db")
+	rel, _ := database.GetRelationByName(schema.Name)
+	it := rel.MakeBlockIt()
+	blkCnt := 0
+	for it.Valid() {
+		blk := it.GetBlock()
+		meta := blk.GetMeta().(*catalog.BlockEntry)
+		assert.False(t, meta.IsAppendable())
+		colBlk, err := meta.GetBlockData().GetBlockFile().OpenColumn(12)
+		assert.Nil(t, err)
+		assert.Equal(t, encoding.Dict, colBlk.GetDataEncoding())
+		colBlk.Close()
+		colBlk, err = meta.GetBlockData().GetBlockFile().OpenColumn(int(schema.PrimaryKey))
+		assert.Nil(t, err)
+		assert.NotEqual(t, encoding.Plain, colBlk.GetDataEncoding())
+		colBlk.Close()
+
+		view, err := blk.GetColumnDataById(12, nil, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, int(schema.BlockMaxRows), gvec.Length(view.AppliedVec))
+		assert.Equal(t, "status-0", compute.GetValue(view.AppliedVec, 0))
+		assert.Equal(t, "status-1", compute.GetValue(view.AppliedVec, 1))
+		blkCnt++
+		it.Next()
+	}
+	assert.Equal(t, 4, blkCnt)
+	for row := 0; row < gvec.Length(bat.Vecs[0]); row++ {
+		filter := handle.NewEQFilter(compute.GetValue(bat.Vecs[schema.PrimaryKey], uint32(row)))
+		id, offset, err := rel.GetByFilter(filter)
+		assert.Nil(t, err)
+		for col := range schema.ColDefs {
+			v, err := rel.GetValue(id, offset, uint16(col))
+			assert.Nil(t, err)
+			assert.Equal(t, compute.GetValue(bat.Vecs[col], uint32(row)), v)
+		}
+	}
+	_, _, err := rel.GetByFilter(handle.NewEQFilter(int32(-1)))
+	assert.Equal(t, txnbase.ErrNotFound, err)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestAlterTable1(t *testing.T) {
+	db := initDB(t, nil)
+	defer db.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 4
+	schema.PrimaryKey = 2
+	bat := compute.MockBatch(schema.Types(), 32, int(schema.PrimaryKey), nil)
+	// 18 rows are written before the table is altered, the other 14 after
+	bats := compute.SplitBatch(bat, 16)
+	written := 18
+	{
+		txn := db.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		for _, data := range bats[:9] {
+			assert.Nil(t, rel.Append(data))
+		}
+		assert.Nil(t, txn.Commit())
+	}
+	getRel := func(txn txnif.AsyncTxn) handle.Relation {
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		return rel
+	}
+	// A txn written with the schema before the change cannot commit
+	stale := db.StartTxn(nil)
+	assert.Nil(t, getRel(stale).Append(bats[9]))
+	int64Type := types.Type{Oid: types.T_int64, Size: 8, Width: 64}
+	{
+		txn := db.StartTxn(nil)
+		rel := getRel(txn)
+		assert.Nil(t, rel.Alter(catalog.NewAddColumnReq("added", int64Type, int64(7))))
+		assert.Nil(t, rel.Alter(catalog.NewRenameColumnReq("mock_3", "renamed")))
+		assert.ErrorIs(t, rel.Append(bats[9]), txnbase.ErrDDLAlterWritten)
+		assert.Nil(t, txn.Commit())
+	}
+	assert.NotNil(t, stale.Commit())
+
+	check := func(rel handle.Relation, rows int, dropped bool) {
+		added := rel.GetMeta().(*catalog.TableEntry).GetSchema().GetColIdx("added")
+		renamed := rel.GetMeta().(*catalog.TableEntry).GetSchema().GetColIdx("renamed")
+		for row := 0; row < rows; row++ {
+			filter := handle.NewEQFilter(compute.GetValue(bat.Vecs[schema.PrimaryKey], uint32(row)))
+			id, offset, err := rel.GetByFilter(filter)
+			assert.Nil(t, err)
+			v, err := rel.GetValue(id, offset, uint16(renamed))
+			assert.Nil(t, err)
+			assert.Equal(t, compute.GetValue(bat.Vecs[3], uint32(row)), v)
+			v, err = rel.GetValue(id, offset, uint16(added))
+			assert.Nil(t, err)
+			if row < written {
+				assert.Equal(t, int64(7), v)
+			} else {
+				assert.Equal(t, int64(row), v)
+			}
+			if !dropped {
+				v, err = rel.GetValue(id, offset, 4)
+				assert.Nil(t, err)
+				assert.Equal(t, compute.GetValue(bat.Vecs[4], uint32(row)), v)
+			}
+		}
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blk := it.GetBlock()
+			view, err := blk.GetColumnDataByName("added", nil, nil)
+			assert.Nil(t, err)
+			assert.Equal(t, blk.Rows(), gvec.Length(view.AppliedVec))
+			it.Next()
+		}
+	}
+	{
+		txn := db.StartTxn(nil)
+		rel := getRel(txn)
+		assert.Equal(t, 14, len(rel.GetMeta().(*catalog.TableEntry).GetSchema().ColDefs))
+		// Rows of the new schema are appended to a new block
+		for i, data := range bats[9:] {
+			added := gvec.New(int64Type)
+			compute.AppendValue(added, int64(written+2*i))
+			compute.AppendValue(added, int64(written+2*i+1))
+			data.Vecs = append(data.Vecs, added)
+			assert.Nil(t, rel.Append(data))
+		}
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn := db.StartTxn(nil)
+		rel := getRel(txn)
+		check(rel, gvec.Length(bat.Vecs[0]), false)
+		// The data of the dropped column is kept until the blocks are merged
+		assert.Nil(t, rel.Alter(catalog.NewDropColumnReq("mock_4")))
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		txn := db.StartTxn(nil)
+		rel := getRel(txn)
+		check(rel, gvec.Length(bat.Vecs[0]), true)
+		blks := make([]*catalog.BlockEntry, 0)
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blks = append(blks, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+			it.Next()
+		}
+		factory := jobs.MergeBlocksIntoSegmentTaskFctory(blks, blks[0].GetSegment(), db.Scheduler)
+		task, err := factory(nil, txn)
+		assert.Nil(t, err)
+		assert.Nil(t, task.OnExec())
+		assert.Nil(t, txn.Commit())
+	}
+	txn := db.StartTxn(nil)
+	rel := getRel(txn)
+	current := rel.GetMeta().(*catalog.TableEntry).GetSchema()
+	it := rel.MakeBlockIt()
+	for it.Valid() {
+		meta := it.GetBlock().GetMeta().(*catalog.BlockEntry)
+		assert.Equal(t, current.Version, meta.GetSchemaVersion())
+		assert.Equal(t, 13, len(meta.GetSchema().ColDefs))
+		it.Next()
+	}
+	check(rel, gvec.Length(bat.Vecs[0]), true)
+	assert.Nil(t, txn.Commit())
+}
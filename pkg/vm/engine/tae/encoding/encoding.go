@@ -0,0 +1,568 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encoding
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"math/bits"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// An encoded column is laid out as:
+//
+// encoding (1) | rows (4) | nulls size (4) | nulls | payload
+//
+// Plain payloads are the serialized vector, which carries the nulls itself.
+const headerSize = 1 + 4 + 4
+
+type integer interface {
+	~int8 | ~int16 | ~int32 | ~int64 | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Encode encodes the vector with the encoding selected from its statistics
+func Encode(vec *gvec.Vector) (buf []byte, enc T, err error) {
+	enc = Select(vec)
+	buf, err = EncodeWith(vec, enc)
+	return
+}
+
+// EncodeWith encodes the vector with the given encoding. ErrNotEncoding is
+// returned if the encoding does not apply to the vector type.
+func EncodeWith(vec *gvec.Vector, enc T) (buf []byte, err error) {
+	var w bytes.Buffer
+	w.WriteByte(byte(enc))
+	if err = binary.Write(&w, binary.BigEndian, uint32(gvec.Length(vec))); err != nil {
+		return
+	}
+	var nullBuf []byte
+	if enc != Plain {
+		if nullBuf, err = vec.Nsp.Show(); err != nil {
+			return
+		}
+	}
+	if err = binary.Write(&w, binary.BigEndian, uint32(len(nullBuf))); err != nil {
+		return
+	}
+	w.Write(nullBuf)
+	switch enc {
+	case Plain:
+		var data []byte
+		if data, err = vec.Show(); err != nil {
+			return
+		}
+		w.Write(data)
+	case Dict:
+		if !isString(vec.Typ) {
+			return nil, ErrNotEncoding
+		}
+		encodeDict(&w, vec.Col.(*types.Bytes))
+	case RLE, Delta, FOR:
+		vals, ok := toBits(vec)
+		if !ok || (enc != RLE && isFloat(vec.Typ)) {
+			return nil, ErrNotEncoding
+		}
+		switch enc {
+		case RLE:
+			encodeRLE(&w, vals)
+		case Delta:
+			encodeDelta(&w, vals)
+		case FOR:
+			encodeFOR(&w, vals, isSigned(vec.Typ))
+		}
+	default:
+		return nil, ErrNotEncoding
+	}
+	buf = w.Bytes()
+	return
+}
+
+// GetEncoding returns the encoding of an encoded column
+func GetEncoding(buf []byte) (T, error) {
+	if len(buf) < headerSize {
+		return Plain, ErrCorrupted
+	}
+	return T(buf[0]), nil
+}
+
+// Decode decodes an encoded column into a vector of type typ
+func Decode(buf []byte, typ types.Type) (vec *gvec.Vector, err error) {
+	enc, rows, nsp, payload, err := readHeader(buf)
+	if err != nil {
+		return
+	}
+	vec = gvec.New(typ)
+	switch enc {
+	case Plain:
+		err = vec.Read(payload)
+		return
+	case Dict:
+		var dict [][]byte
+		var width int
+		var codes []byte
+		if dict, width, codes, err = parseDict(payload, rows); err != nil {
+			return
+		}
+		col := vec.Col.(*types.Bytes)
+		for i := 0; i < rows; i++ {
+			v := dict[getCode(codes, width, i)]
+			col.Offsets = append(col.Offsets, uint32(len(col.Data)))
+			col.Lengths = append(col.Lengths, uint32(len(v)))
+			col.Data = append(col.Data, v...)
+		}
+	case RLE, Delta, FOR:
+		var vals []uint64
+		switch enc {
+		case RLE:
+			vals, err = decodeRLE(payload, rows)
+		case Delta:
+			vals, err = decodeDelta(payload, rows)
+		case FOR:
+			vals, err = decodeFOR(payload, rows)
+		}
+		if err != nil {
+			return
+		}
+		if !fromBits(vec, vals) {
+			return nil, ErrTypeMismatch
+		}
+	default:
+		return nil, ErrCorrupted
+	}
+	vec.Nsp = nsp
+	return
+}
+
+func readHeader(buf []byte) (enc T, rows int, nsp *nulls.Nulls, payload []byte, err error) {
+	if len(buf) < headerSize {
+		err = ErrCorrupted
+		return
+	}
+	enc = T(buf[0])
+	rows = int(binary.BigEndian.Uint32(buf[1:]))
+	nullSize := int(binary.BigEndian.Uint32(buf[5:]))
+	if len(buf) < headerSize+nullSize {
+		err = ErrCorrupted
+		return
+	}
+	nsp = new(nulls.Nulls)
+	if err = nsp.Read(buf[headerSize : headerSize+nullSize]); err != nil {
+		return
+	}
+	payload = buf[headerSize+nullSize:]
+	return
+}
+
+func isString(typ types.Type) bool {
+	switch typ.Oid {
+	case types.T_char, types.T_varchar, types.T_json:
+		return true
+	}
+	return false
+}
+
+func isFloat(typ types.Type) bool {
+	return typ.Oid == types.T_float32 || typ.Oid == types.T_float64
+}
+
+func isSigned(typ types.Type) bool {
+	switch typ.Oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_date, types.T_datetime, types.T_timestamp, types.T_decimal64:
+		return true
+	}
+	return false
+}
+
+// toBits converts the values of a fixed sized column to uint64. Signed
+// values are sign extended and floats are converted to their IEEE 754
+// representation.
+func toBits(vec *gvec.Vector) ([]uint64, bool) {
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		return intToBits(vec.Col.([]int8)), true
+	case types.T_int16:
+		return intToBits(vec.Col.([]int16)), true
+	case types.T_int32:
+		return intToBits(vec.Col.([]int32)), true
+	case types.T_int64:
+		return intToBits(vec.Col.([]int64)), true
+	case types.T_uint8:
+		return intToBits(vec.Col.([]uint8)), true
+	case types.T_uint16:
+		return intToBits(vec.Col.([]uint16)), true
+	case types.T_uint32:
+		return intToBits(vec.Col.([]uint32)), true
+	case types.T_uint64:
+		return intToBits(vec.Col.([]uint64)), true
+	case types.T_date:
+		return intToBits(vec.Col.([]types.Date)), true
+	case types.T_datetime:
+		return intToBits(vec.Col.([]types.Datetime)), true
+	case types.T_timestamp:
+		return intToBits(vec.Col.([]types.Timestamp)), true
+	case types.T_decimal64:
+		return intToBits(vec.Col.([]types.Decimal64)), true
+	case types.T_float32:
+		col := vec.Col.([]float32)
+		vals := make([]uint64, len(col))
+		for i, v := range col {
+			vals[i] = uint64(math.Float32bits(v))
+		}
+		return vals, true
+	case types.T_float64:
+		col := vec.Col.([]float64)
+		vals := make([]uint64, len(col))
+		for i, v := range col {
+			vals[i] = math.Float64bits(v)
+		}
+		return vals, true
+	}
+	return nil, false
+}
+
+func fromBits(vec *gvec.Vector, vals []uint64) bool {
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		vec.Col = bitsToInt[int8](vals)
+	case types.T_int16:
+		vec.Col = bitsToInt[int16](vals)
+	case types.T_int32:
+		vec.Col = bitsToInt[int32](vals)
+	case types.T_int64:
+		vec.Col = bitsToInt[int64](vals)
+	case types.T_uint8:
+		vec.Col = bitsToInt[uint8](vals)
+	case types.T_uint16:
+		vec.Col = bitsToInt[uint16](vals)
+	case types.T_uint32:
+		vec.Col = bitsToInt[uint32](vals)
+	case types.T_uint64:
+		vec.Col = vals
+	case types.T_date:
+		vec.Col = bitsToInt[types.Date](vals)
+	case types.T_datetime:
+		vec.Col = bitsToInt[types.Datetime](vals)
+	case types.T_timestamp:
+		vec.Col = bitsToInt[types.Timestamp](vals)
+	case types.T_decimal64:
+		vec.Col = bitsToInt[types.Decimal64](vals)
+	case types.T_float32:
+		col := make([]float32, len(vals))
+		for i, v := range vals {
+			col[i] = math.Float32frombits(uint32(v))
+		}
+		vec.Col = col
+	case types.T_float64:
+		col := make([]float64, len(vals))
+		for i, v := range vals {
+			col[i] = math.Float64frombits(v)
+		}
+		vec.Col = col
+	default:
+		return false
+	}
+	return true
+}
+
+func intToBits[T integer](col []T) []uint64 {
+	vals := make([]uint64, len(col))
+	for i, v := range col {
+		vals[i] = uint64(v)
+	}
+	return vals
+}
+
+func bitsToInt[T integer](vals []uint64) []T {
+	col := make([]T, len(vals))
+	for i, v := range vals {
+		col[i] = T(v)
+	}
+	return col
+}
+
+// dictionary returns the sorted distinct values of the column
+func dictionary(col *types.Bytes) []string {
+	distinct := make(map[string]struct{})
+	for i := range col.Offsets {
+		distinct[string(col.Get(int64(i)))] = struct{}{}
+	}
+	dict := make([]string, 0, len(distinct))
+	for v := range distinct {
+		dict = append(dict, v)
+	}
+	sort.Strings(dict)
+	return dict
+}
+
+func codeWidth(dictSize int) int {
+	switch {
+	case dictSize <= math.MaxUint8+1:
+		return 1
+	case dictSize <= math.MaxUint16+1:
+		return 2
+	}
+	return 4
+}
+
+// Dict payload:
+//
+// dict size (4) | [value size (4) | value] ... | code width (1) | codes
+func encodeDict(w *bytes.Buffer, col *types.Bytes) {
+	dict := dictionary(col)
+	codes := make(map[string]uint32, len(dict))
+	_ = binary.Write(w, binary.BigEndian, uint32(len(dict)))
+	for i, v := range dict {
+		codes[v] = uint32(i)
+		_ = binary.Write(w, binary.BigEndian, uint32(len(v)))
+		w.WriteString(v)
+	}
+	width := codeWidth(len(dict))
+	w.WriteByte(byte(width))
+	code := make([]byte, 4)
+	for i := range col.Offsets {
+		binary.BigEndian.PutUint32(code, codes[string(col.Get(int64(i)))])
+		w.Write(code[4-width:])
+	}
+}
+
+func parseDict(payload []byte, rows int) (dict [][]byte, width int, codes []byte, err error) {
+	if len(payload) < 4 {
+		err = ErrCorrupted
+		return
+	}
+	size := int(binary.BigEndian.Uint32(payload))
+	pos := 4
+	dict = make([][]byte, size)
+	for i := range dict {
+		if len(payload) < pos+4 {
+			err = ErrCorrupted
+			return
+		}
+		n := int(binary.BigEndian.Uint32(payload[pos:]))
+		pos += 4
+		if len(payload) < pos+n {
+			err = ErrCorrupted
+			return
+		}
+		dict[i] = payload[pos : pos+n]
+		pos += n
+	}
+	if len(payload) < pos+1 {
+		err = ErrCorrupted
+		return
+	}
+	width = int(payload[pos])
+	pos++
+	codes = payload[pos:]
+	if len(codes) != rows*width {
+		err = ErrCorrupted
+	}
+	return
+}
+
+func getCode(codes []byte, width, i int) uint32 {
+	switch width {
+	case 1:
+		return uint32(codes[i])
+	case 2:
+		return uint32(binary.BigEndian.Uint16(codes[i*2:]))
+	}
+	return binary.BigEndian.Uint32(codes[i*4:])
+}
+
+// RLE payload:
+//
+// runs (4) | [value (8) | run length (4)] ...
+func encodeRLE(w *bytes.Buffer, vals []uint64) {
+	_ = binary.Write(w, binary.BigEndian, uint32(countRuns(vals)))
+	for start := 0; start < len(vals); {
+		end := start + 1
+		for end < len(vals) && vals[end] == vals[start] {
+			end++
+		}
+		_ = binary.Write(w, binary.BigEndian, vals[start])
+		_ = binary.Write(w, binary.BigEndian, uint32(end-start))
+		start = end
+	}
+}
+
+func decodeRLE(payload []byte, rows int) (vals []uint64, err error) {
+	vals = make([]uint64, 0, rows)
+	err = iterRuns(payload, func(v uint64, start, length int) {
+		for i := 0; i < length; i++ {
+			vals = append(vals, v)
+		}
+	})
+	if err == nil && len(vals) != rows {
+		err = ErrCorrupted
+	}
+	return
+}
+
+func iterRuns(payload []byte, fn func(v uint64, start, length int)) error {
+	if len(payload) < 4 {
+		return ErrCorrupted
+	}
+	runs := int(binary.BigEndian.Uint32(payload))
+	if len(payload) != 4+runs*12 {
+		return ErrCorrupted
+	}
+	start := 0
+	for i := 0; i < runs; i++ {
+		pos := 4 + i*12
+		length := int(binary.BigEndian.Uint32(payload[pos+8:]))
+		fn(binary.BigEndian.Uint64(payload[pos:]), start, length)
+		start += length
+	}
+	return nil
+}
+
+// Delta payload:
+//
+// first value (8) | [varint delta] ...
+func encodeDelta(w *bytes.Buffer, vals []uint64) {
+	if len(vals) == 0 {
+		return
+	}
+	_ = binary.Write(w, binary.BigEndian, vals[0])
+	buf := make([]byte, binary.MaxVarintLen64)
+	for i := 1; i < len(vals); i++ {
+		n := binary.PutVarint(buf, int64(vals[i]-vals[i-1]))
+		w.Write(buf[:n])
+	}
+}
+
+func decodeDelta(payload []byte, rows int) (vals []uint64, err error) {
+	if rows == 0 {
+		return
+	}
+	if len(payload) < 8 {
+		return nil, ErrCorrupted
+	}
+	vals = make([]uint64, rows)
+	vals[0] = binary.BigEndian.Uint64(payload)
+	pos := 8
+	for i := 1; i < rows; i++ {
+		d, n := binary.Varint(payload[pos:])
+		if n <= 0 {
+			return nil, ErrCorrupted
+		}
+		pos += n
+		vals[i] = vals[i-1] + uint64(d)
+	}
+	return
+}
+
+// FOR payload:
+//
+// min (8) | bit width (1) | bit packed offsets
+func encodeFOR(w *bytes.Buffer, vals []uint64, signed bool) {
+	min, max := minMax(vals, signed)
+	width := bits.Len64(max - min)
+	_ = binary.Write(w, binary.BigEndian, min)
+	w.WriteByte(byte(width))
+	packed := make([]byte, (len(vals)*width+7)/8)
+	for i, v := range vals {
+		putBits(packed, i*width, width, v-min)
+	}
+	w.Write(packed)
+}
+
+func parseFOR(payload []byte, rows int) (min uint64, width int, packed []byte, err error) {
+	if len(payload) < 9 {
+		err = ErrCorrupted
+		return
+	}
+	min = binary.BigEndian.Uint64(payload)
+	width = int(payload[8])
+	packed = payload[9:]
+	if width > 64 || len(packed) != (rows*width+7)/8 {
+		err = ErrCorrupted
+	}
+	return
+}
+
+func decodeFOR(payload []byte, rows int) (vals []uint64, err error) {
+	min, width, packed, err := parseFOR(payload, rows)
+	if err != nil {
+		return
+	}
+	vals = make([]uint64, rows)
+	for i := range vals {
+		vals[i] = min + getBits(packed, i*width, width)
+	}
+	return
+}
+
+func minMax(vals []uint64, signed bool) (min, max uint64) {
+	if len(vals) == 0 {
+		return
+	}
+	min, max = vals[0], vals[0]
+	for _, v := range vals[1:] {
+		if signed {
+			if int64(v) < int64(min) {
+				min = v
+			}
+			if int64(v) > int64(max) {
+				max = v
+			}
+		} else {
+			if v < min {
+				min = v
+			}
+			if v > max {
+				max = v
+			}
+		}
+	}
+	return
+}
+
+// putBits writes the lowest width bits of v at bit offset pos, least
+// significant bit first
+func putBits(buf []byte, pos, width int, v uint64) {
+	for width > 0 {
+		idx, shift := pos/8, pos%8
+		n := 8 - shift
+		if n > width {
+			n = width
+		}
+		buf[idx] |= byte(v&(1<<n-1)) << shift
+		v >>= n
+		pos += n
+		width -= n
+	}
+}
+
+func getBits(buf []byte, pos, width int) (v uint64) {
+	for got := 0; got < width; {
+		idx, shift := pos/8, pos%8
+		n := 8 - shift
+		if n > width-got {
+			n = width - got
+		}
+		v |= uint64(buf[idx]>>shift&(1<<n-1)) << got
+		pos += n
+		got += n
+	}
+	return
+}
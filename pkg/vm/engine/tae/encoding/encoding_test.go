@@ -0,0 +1,186 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encoding
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockVec(typ types.Type, vals interface{}) *gvec.Vector {
+	vec := gvec.New(typ)
+	if err := gvec.Append(vec, vals); err != nil {
+		panic(err)
+	}
+	return vec
+}
+
+func mockStrVec(vals ...string) *gvec.Vector {
+	vec := gvec.New(types.Type{Oid: types.T_varchar, Size: 24})
+	bs := make([][]byte, len(vals))
+	for i, v := range vals {
+		bs[i] = []byte(v)
+	}
+	if err := gvec.Append(vec, bs); err != nil {
+		panic(err)
+	}
+	return vec
+}
+
+func checkRoundTrip(t *testing.T, vec *gvec.Vector, enc T) {
+	buf, err := EncodeWith(vec, enc)
+	assert.Nil(t, err)
+	actual, err := GetEncoding(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, enc, actual)
+	decoded, err := Decode(buf, vec.Typ)
+	assert.Nil(t, err)
+	assert.Equal(t, vec.Col, decoded.Col, enc.String())
+	assert.Equal(t, nulls.Length(vec.Nsp), nulls.Length(decoded.Nsp))
+}
+
+func TestRoundTrip(t *testing.T) {
+	int32Typ := types.Type{Oid: types.T_int32, Size: 4}
+	ints := []int32{-5, -5, -5, 100, 100, 7, 1 << 30, -(1 << 30)}
+	for _, enc := range []T{Plain, RLE, Delta, FOR} {
+		checkRoundTrip(t, mockVec(int32Typ, ints), enc)
+	}
+	uint64Typ := types.Type{Oid: types.T_uint64, Size: 8}
+	uints := []uint64{0, 1<<64 - 1, 3, 3, 1 << 63}
+	for _, enc := range []T{Plain, RLE, Delta, FOR} {
+		checkRoundTrip(t, mockVec(uint64Typ, uints), enc)
+	}
+	dateTyp := types.Type{Oid: types.T_date, Size: 4}
+	dates := []types.Date{738000, 738001, 738001, 738005}
+	for _, enc := range []T{Plain, RLE, Delta, FOR} {
+		checkRoundTrip(t, mockVec(dateTyp, dates), enc)
+	}
+	floatTyp := types.Type{Oid: types.T_float64, Size: 8}
+	floats := []float64{1.5, 1.5, -2.25, 0}
+	for _, enc := range []T{Plain, RLE} {
+		checkRoundTrip(t, mockVec(floatTyp, floats), enc)
+	}
+	_, err := EncodeWith(mockVec(floatTyp, floats), FOR)
+	assert.ErrorIs(t, err, ErrNotEncoding)
+
+	strs := mockStrVec("b", "a", "", "b", "c", "a")
+	for _, enc := range []T{Plain, Dict} {
+		checkRoundTrip(t, strs, enc)
+	}
+	_, err = EncodeWith(strs, RLE)
+	assert.ErrorIs(t, err, ErrNotEncoding)
+
+	// Nulls are kept
+	vec := mockVec(int32Typ, ints)
+	nulls.Add(vec.Nsp, 1, 6)
+	for _, enc := range []T{RLE, Delta, FOR} {
+		buf, err := EncodeWith(vec, enc)
+		assert.Nil(t, err)
+		decoded, err := Decode(buf, int32Typ)
+		assert.Nil(t, err)
+		assert.True(t, nulls.Contains(decoded.Nsp, 1))
+		assert.True(t, nulls.Contains(decoded.Nsp, 6))
+		assert.Equal(t, 2, nulls.Length(decoded.Nsp))
+	}
+}
+
+func TestSelect(t *testing.T) {
+	int64Typ := types.Type{Oid: types.T_int64, Size: 8}
+	rows := 1000
+	sorted := make([]int64, rows)
+	runs := make([]int64, rows)
+	narrow := make([]int64, rows)
+	random := make([]int64, rows)
+	for i := 0; i < rows; i++ {
+		sorted[i] = int64(1<<40 + i*3)
+		runs[i] = int64(i / 100)
+		narrow[i] = int64(1<<50 + (i*7919)%200)
+		random[i] = int64(uint64(i) * 0x9E3779B97F4A7C15)
+	}
+	assert.Equal(t, Delta, Select(mockVec(int64Typ, sorted)))
+	assert.Equal(t, RLE, Select(mockVec(int64Typ, runs)))
+	assert.Equal(t, FOR, Select(mockVec(int64Typ, narrow)))
+	assert.Equal(t, Plain, Select(mockVec(int64Typ, random)))
+
+	lowCard := make([]string, rows)
+	unique := make([]string, rows)
+	for i := 0; i < rows; i++ {
+		lowCard[i] = fmt.Sprintf("status-%d", i%5)
+		unique[i] = fmt.Sprintf("%d", i)
+	}
+	stats := CollectStats(mockStrVec(lowCard...))
+	assert.Equal(t, 5, stats.Distinct)
+	assert.Equal(t, Dict, Select(mockStrVec(lowCard...)))
+	assert.Equal(t, Plain, Select(mockStrVec(unique...)))
+
+	for _, vals := range [][]int64{sorted, runs, narrow, random} {
+		vec := mockVec(int64Typ, vals)
+		buf, enc, err := Encode(vec)
+		assert.Nil(t, err)
+		assert.Equal(t, Select(vec), enc)
+		plain, _ := vec.Show()
+		if enc != Plain {
+			assert.Less(t, len(buf), len(plain))
+		}
+	}
+}
+
+func TestSearchEq(t *testing.T) {
+	strs := mockStrVec("b", "a", "", "b", "c", "a", "b")
+	nulls.Add(strs.Nsp, 6)
+	for _, enc := range []T{Plain, Dict} {
+		buf, err := EncodeWith(strs, enc)
+		assert.Nil(t, err)
+		rows, err := SearchEq(buf, strs.Typ, []byte("b"))
+		assert.Nil(t, err)
+		assert.Equal(t, []uint32{0, 3}, rows.ToArray())
+		rows, err = SearchEq(buf, strs.Typ, "")
+		assert.Nil(t, err)
+		assert.Equal(t, []uint32{2}, rows.ToArray())
+		rows, err = SearchEq(buf, strs.Typ, "d")
+		assert.Nil(t, err)
+		assert.True(t, rows.IsEmpty())
+		_, err = SearchEq(buf, strs.Typ, int32(1))
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+	}
+
+	int16Typ := types.Type{Oid: types.T_int16, Size: 2}
+	vec := mockVec(int16Typ, []int16{-3, -3, 4, 4, 4, -3, 10, 4})
+	nulls.Add(vec.Nsp, 7)
+	for _, enc := range []T{Plain, RLE, Delta, FOR} {
+		buf, err := EncodeWith(vec, enc)
+		assert.Nil(t, err)
+		rows, err := SearchEq(buf, int16Typ, int16(4))
+		assert.Nil(t, err)
+		assert.Equal(t, []uint32{2, 3, 4}, rows.ToArray(), enc.String())
+		rows, err = SearchEq(buf, int16Typ, int16(-3))
+		assert.Nil(t, err)
+		assert.Equal(t, []uint32{0, 1, 5}, rows.ToArray(), enc.String())
+		// Out of the FOR range on both sides
+		rows, err = SearchEq(buf, int16Typ, int16(-100))
+		assert.Nil(t, err)
+		assert.True(t, rows.IsEmpty())
+		rows, err = SearchEq(buf, int16Typ, int16(100))
+		assert.Nil(t, err)
+		assert.True(t, rows.IsEmpty())
+		_, err = SearchEq(buf, int16Typ, int32(4))
+		assert.ErrorIs(t, err, ErrTypeMismatch)
+	}
+}
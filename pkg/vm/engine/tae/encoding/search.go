@@ -0,0 +1,209 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encoding
+
+import (
+	"bytes"
+	"math"
+	"sort"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// SearchEq returns the rows of an encoded column equal to val. Dict, RLE
+// and FOR columns are searched without being decoded: the value is
+// translated into a dictionary code, compared once per run or turned into
+// an offset from the minimum. Null rows never match.
+func SearchEq(buf []byte, typ types.Type, val interface{}) (rows *roaring.Bitmap, err error) {
+	enc, n, nsp, payload, err := readHeader(buf)
+	if err != nil {
+		return
+	}
+	rows = roaring.New()
+	switch enc {
+	case Dict:
+		var key []byte
+		if key, err = valueBytes(val); err != nil {
+			return
+		}
+		var dict [][]byte
+		var width int
+		var codes []byte
+		if dict, width, codes, err = parseDict(payload, n); err != nil {
+			return
+		}
+		code := sort.Search(len(dict), func(i int) bool {
+			return bytes.Compare(dict[i], key) >= 0
+		})
+		if code == len(dict) || !bytes.Equal(dict[code], key) {
+			return
+		}
+		for i := 0; i < n; i++ {
+			if getCode(codes, width, i) == uint32(code) {
+				rows.Add(uint32(i))
+			}
+		}
+	case RLE:
+		var target uint64
+		if target, err = valueBits(typ, val); err != nil {
+			return
+		}
+		err = iterRuns(payload, func(v uint64, start, length int) {
+			if v == target {
+				rows.AddRange(uint64(start), uint64(start+length))
+			}
+		})
+	case FOR:
+		var target uint64
+		if target, err = valueBits(typ, val); err != nil {
+			return
+		}
+		min, width, packed, err2 := parseFOR(payload, n)
+		if err = err2; err != nil {
+			return
+		}
+		offset := target - min
+		if width < 64 && offset>>width != 0 {
+			return
+		}
+		for i := 0; i < n; i++ {
+			if getBits(packed, i*width, width) == offset {
+				rows.Add(uint32(i))
+			}
+		}
+	default:
+		// Plain columns keep their nulls inside the payload
+		nsp, err = searchDecoded(buf, typ, val, rows)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if nsp != nil && nsp.Np != nil {
+		it := nsp.Np.Iterator()
+		for it.HasNext() {
+			rows.Remove(uint32(it.Next()))
+		}
+	}
+	return
+}
+
+func searchDecoded(buf []byte, typ types.Type, val interface{}, rows *roaring.Bitmap) (*nulls.Nulls, error) {
+	vec, err := Decode(buf, typ)
+	if err != nil {
+		return nil, err
+	}
+	if isString(typ) {
+		key, err := valueBytes(val)
+		if err != nil {
+			return nil, err
+		}
+		col := vec.Col.(*types.Bytes)
+		for i := range col.Offsets {
+			if bytes.Equal(col.Get(int64(i)), key) {
+				rows.Add(uint32(i))
+			}
+		}
+		return vec.Nsp, nil
+	}
+	target, err := valueBits(typ, val)
+	if err != nil {
+		return nil, err
+	}
+	vals, ok := toBits(vec)
+	if !ok {
+		return nil, ErrTypeMismatch
+	}
+	for i, v := range vals {
+		if v == target {
+			rows.Add(uint32(i))
+		}
+	}
+	return vec.Nsp, nil
+}
+
+func valueBytes(val interface{}) ([]byte, error) {
+	switch v := val.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	}
+	return nil, ErrTypeMismatch
+}
+
+// valueBits converts val the same way toBits converts a column of typ
+func valueBits(typ types.Type, val interface{}) (uint64, error) {
+	switch typ.Oid {
+	case types.T_int8:
+		if v, ok := val.(int8); ok {
+			return uint64(v), nil
+		}
+	case types.T_int16:
+		if v, ok := val.(int16); ok {
+			return uint64(v), nil
+		}
+	case types.T_int32:
+		if v, ok := val.(int32); ok {
+			return uint64(v), nil
+		}
+	case types.T_int64:
+		if v, ok := val.(int64); ok {
+			return uint64(v), nil
+		}
+	case types.T_uint8:
+		if v, ok := val.(uint8); ok {
+			return uint64(v), nil
+		}
+	case types.T_uint16:
+		if v, ok := val.(uint16); ok {
+			return uint64(v), nil
+		}
+	case types.T_uint32:
+		if v, ok := val.(uint32); ok {
+			return uint64(v), nil
+		}
+	case types.T_uint64:
+		if v, ok := val.(uint64); ok {
+			return v, nil
+		}
+	case types.T_date:
+		if v, ok := val.(types.Date); ok {
+			return uint64(v), nil
+		}
+	case types.T_datetime:
+		if v, ok := val.(types.Datetime); ok {
+			return uint64(v), nil
+		}
+	case types.T_timestamp:
+		if v, ok := val.(types.Timestamp); ok {
+			return uint64(v), nil
+		}
+	case types.T_decimal64:
+		if v, ok := val.(types.Decimal64); ok {
+			return uint64(v), nil
+		}
+	case types.T_float32:
+		if v, ok := val.(float32); ok {
+			return uint64(math.Float32bits(v)), nil
+		}
+	case types.T_float64:
+		if v, ok := val.(float64); ok {
+			return math.Float64bits(v), nil
+		}
+	}
+	return 0, ErrTypeMismatch
+}
@@ -0,0 +1,105 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encoding
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
+)
+
+// Stats are the statistics of a column the encoding is selected from
+type Stats struct {
+	Rows int
+	// Distinct is the number of distinct values of a string column
+	Distinct int
+	// Runs is the number of runs of repeated values
+	Runs int
+	// Width is the number of bits needed by the offsets from the minimum
+	Width int
+	// Sizes estimated for each applicable encoding
+	Sizes map[T]int
+}
+
+// CollectStats estimates the size of the column in each applicable encoding
+func CollectStats(vec *gvec.Vector) *Stats {
+	stats := &Stats{
+		Rows:  gvec.Length(vec),
+		Sizes: make(map[T]int),
+	}
+	if isString(vec.Typ) {
+		col := vec.Col.(*types.Bytes)
+		dict := dictionary(col)
+		stats.Distinct = len(dict)
+		dictSize := 4 + 1
+		for _, v := range dict {
+			dictSize += 4 + len(v)
+		}
+		stats.Sizes[Plain] = len(col.Data) + stats.Rows*4
+		stats.Sizes[Dict] = dictSize + stats.Rows*codeWidth(len(dict))
+		return stats
+	}
+	vals, ok := toBits(vec)
+	if !ok {
+		return stats
+	}
+	stats.Runs = countRuns(vals)
+	stats.Sizes[Plain] = stats.Rows * int(vec.Typ.Size)
+	stats.Sizes[RLE] = 4 + stats.Runs*12
+	if isFloat(vec.Typ) {
+		return stats
+	}
+	min, max := minMax(vals, isSigned(vec.Typ))
+	stats.Width = bits.Len64(max - min)
+	stats.Sizes[FOR] = 9 + (stats.Rows*stats.Width+7)/8
+	deltaSize := 8
+	buf := make([]byte, binary.MaxVarintLen64)
+	for i := 1; i < len(vals); i++ {
+		deltaSize += binary.PutVarint(buf, int64(vals[i]-vals[i-1]))
+	}
+	stats.Sizes[Delta] = deltaSize
+	return stats
+}
+
+// Select returns the encoding taking the least space for the column. Plain
+// is kept unless another encoding saves space.
+func Select(vec *gvec.Vector) T {
+	if gvec.Length(vec) == 0 {
+		return Plain
+	}
+	stats := CollectStats(vec)
+	best, bestSize := Plain, stats.Sizes[Plain]
+	for _, enc := range []T{Dict, RLE, Delta, FOR} {
+		if size, ok := stats.Sizes[enc]; ok && size < bestSize {
+			best, bestSize = enc, size
+		}
+	}
+	return best
+}
+
+func countRuns(vals []uint64) int {
+	if len(vals) == 0 {
+		return 0
+	}
+	runs := 1
+	for i := 1; i < len(vals); i++ {
+		if vals[i] != vals[i-1] {
+			runs++
+		}
+	}
+	return runs
+}
@@ -0,0 +1,60 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encoding
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrCorrupted    = errors.New("tae encoding: corrupted data")
+	ErrNotEncoding  = errors.New("tae encoding: encoding not applicable")
+	ErrTypeMismatch = errors.New("tae encoding: value type mismatch")
+)
+
+// T is the lightweight encoding of a persisted column
+type T uint8
+
+const (
+	// Plain stores the vector as is
+	Plain T = iota
+	// Dict stores the distinct values of a string column once and each row
+	// as a code into them
+	Dict
+	// RLE stores runs of repeated values as value and length pairs
+	RLE
+	// Delta stores the first value and the varint encoded differences
+	// between neighbouring values
+	Delta
+	// FOR stores the minimum value and the bit packed offsets from it
+	FOR
+)
+
+func (t T) String() string {
+	switch t {
+	case Plain:
+		return "Plain"
+	case Dict:
+		return "Dict"
+	case RLE:
+		return "RLE"
+	case Delta:
+		return "Delta"
+	case FOR:
+		return "FOR"
+	}
+	return fmt.Sprintf("unexpected encoding: %d", t)
+}
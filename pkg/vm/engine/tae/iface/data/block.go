@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"io"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -64,6 +65,10 @@ type Block interface {
 
 	BatchDedup(txn txnif.AsyncTxn, pks *vector.Vector) error
 	GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (uint32, error)
+	GetByIndex(txn txnif.AsyncTxn, col uint16, filter *handle.Filter) (*roaring.Bitmap, error)
+	// GetPKRange returns the bounds of the primary keys ever written into the
+	// block, false if it has none
+	GetPKRange() (min, max interface{}, ok bool)
 	GetValue(txn txnif.AsyncTxn, row uint32, col uint16) (interface{}, error)
 	PPString(level common.PPLevel, depth int, prefix string) string
 	GetBlockFile() file.Block
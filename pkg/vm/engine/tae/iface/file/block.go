@@ -23,6 +23,7 @@ import (
 	gvec "github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/encoding"
 	idxCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
 )
 
@@ -35,6 +36,10 @@ type Block interface {
 	WriteRows(rows uint32) error
 	ReadRows() uint32
 
+	// SetCompression sets the algorithm and level used to compress the
+	// column data written afterwards
+	SetCompression(algo, level int)
+
 	// OpenDeletesFile() common.IRWFile
 	WriteDeletes(buf []byte) error
 	ReadDeletes(buf []byte) error
@@ -66,6 +71,9 @@ type ColumnBlock interface {
 	ReadUpdates(buf []byte) error
 
 	GetDataFileStat() common.FileInfo
+	// GetDataEncoding returns the lightweight encoding of the column data.
+	// Plain data is a serialized vector.
+	GetDataEncoding() encoding.T
 
 	OpenUpdateFile() (common.IRWFile, error)
 	OpenIndexFile(idx int) (common.IRWFile, error)
@@ -41,6 +41,9 @@ type Filter struct {
 	Op  FilterOp
 	Col *vector.Vector
 	Val interface{}
+	// Upper is the upper bound of a FilterBtw filter whose lower bound is
+	// Val. Both bounds are inclusive and a nil bound leaves that side open.
+	Upper interface{}
 }
 
 func NewEQFilter(v interface{}) *Filter {
@@ -50,6 +53,14 @@ func NewEQFilter(v interface{}) *Filter {
 	}
 }
 
+func NewBtwFilter(lower, upper interface{}) *Filter {
+	return &Filter{
+		Op:    FilterBtw,
+		Val:   lower,
+		Upper: upper,
+	}
+}
+
 type BlockReader interface {
 	io.Closer
 	ID() uint64
@@ -17,6 +17,8 @@ package handle
 import (
 	"io"
 
+	"github.com/RoaringBitmap/roaring"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -34,6 +36,9 @@ type Relation interface {
 	String() string
 	SimplePPString(common.PPLevel) string
 	GetCardinality(attr string) int64
+	// GetRange returns the bounds of the column from the zonemaps of the
+	// blocks, false if the column has none
+	GetRange(attr string) (min, max interface{}, ok bool)
 	Schema() interface{}
 	MakeSegmentIt() SegmentIt
 	MakeReader() Reader
@@ -42,6 +47,11 @@ type Relation interface {
 	RangeDelete(id *common.ID, start, end uint32) error
 	Update(id *common.ID, row uint32, col uint16, v interface{}) error
 	GetByFilter(filter *Filter) (id *common.ID, offset uint32, err error)
+	// GetByIndex resolves an equality or range filter on a column through its
+	// secondary index and returns the matched rows of every block. An equality
+	// on a column without secondary index is searched on the encoded blocks
+	// and keeps all the rows of the others.
+	GetByIndex(col uint16, filter *Filter) (map[common.ID]*roaring.Bitmap, error)
 	GetValue(id *common.ID, row uint32, col uint16) (interface{}, error)
 
 	BatchDedup(col *vector.Vector) error
@@ -51,6 +61,8 @@ type Relation interface {
 	CreateSegment() (Segment, error)
 	CreateNonAppendableSegment() (Segment, error)
 	GetSegment(id uint64) (Segment, error)
+	CreateIndex(def interface{}) error
+	Alter(req interface{}) error
 
 	SoftDeleteSegment(id uint64) (err error)
 }
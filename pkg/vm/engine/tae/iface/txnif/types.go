@@ -17,7 +17,9 @@ package txnif
 import (
 	"io"
 	"sync"
+	"time"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -27,6 +29,8 @@ import (
 
 type TxnClient interface {
 	StartTxn(info []byte) (AsyncTxn, error)
+	// StartSnapshotTxn starts a read only txn which reads the data as of ts
+	StartSnapshotTxn(info []byte, ts time.Time) (AsyncTxn, error)
 }
 
 type Txn2PC interface {
@@ -178,9 +182,12 @@ type TxnStore interface {
 	RangeDelete(id *common.ID, start, end uint32) error
 	Update(id *common.ID, row uint32, col uint16, v interface{}) error
 	GetByFilter(id uint64, filter *handle.Filter) (*common.ID, uint32, error)
+	GetByIndex(id uint64, col uint16, filter *handle.Filter) (map[common.ID]*roaring.Bitmap, error)
 	GetValue(id *common.ID, row uint32, col uint16) (interface{}, error)
 
 	CreateRelation(def interface{}) (handle.Relation, error)
+	CreateIndex(id uint64, def interface{}) error
+	Alter(id uint64, req interface{}) error
 	DropRelationByName(name string) (handle.Relation, error)
 	GetRelationByName(name string) (handle.Relation, error)
 
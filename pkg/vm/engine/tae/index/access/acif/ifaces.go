@@ -38,7 +38,24 @@ type INonAppendableBlockIndexHolder interface {
 	InitFromHost(host data.Block, schema *catalog.Schema, bufManager base.INodeManager) error
 }
 
+type ISecondaryIndexHolder interface {
+	// SecondaryIndexedRows returns how many leading rows of the block are
+	// covered by the secondary index on colIdx, and false if there is none
+	SecondaryIndexedRows(colIdx uint16) (uint32, bool)
+	// BatchInsertSecondary indexes count keys of colIdx starting from start,
+	// the first of which lands at row offset. Keys not continuing the covered
+	// rows are ignored and left to a later catch up.
+	BatchInsertSecondary(colIdx uint16, keys *vector.Vector, start uint32, count int, offset uint32) error
+	// SearchSecondary returns the offsets of the rows whose value of colIdx
+	// lies in [lower, upper], where a nil bound leaves that side open
+	SearchSecondary(colIdx uint16, lower, upper interface{}) (*roaring.Bitmap, bool)
+}
+
 type IBlockIndexHolder interface {
+	ISecondaryIndexHolder
 	GetHostBlockId() uint64
+	// PKRange returns the bounds of the primary keys in the zonemap of the
+	// block, false if the block has no keys
+	PKRange() (min, max interface{}, ok bool)
 	Destroy() error
 }
@@ -24,6 +24,7 @@ import (
 )
 
 type appendableBlockIndexHolder struct {
+	*secondaryIndexes
 	host         data.Block
 	treeIndex    basic.ARTMap
 	zoneMapIndex *basic.ZoneMap
@@ -32,6 +33,7 @@ type appendableBlockIndexHolder struct {
 
 func NewAppendableBlockIndexHolder(host data.Block, schema *catalog.Schema) *appendableBlockIndexHolder {
 	holder := new(appendableBlockIndexHolder)
+	holder.secondaryIndexes = newSecondaryIndexes()
 	holder.host = host
 	holder.schema = schema
 	pkType := schema.GetPKType()
@@ -94,9 +96,17 @@ func (holder *appendableBlockIndexHolder) BatchDedup(keys *vector.Vector) error
 func (holder *appendableBlockIndexHolder) Destroy() error {
 	holder.treeIndex = nil
 	holder.zoneMapIndex = nil
+	holder.secondaryIndexes.Destroy()
 	return nil
 }
 
 func (holder *appendableBlockIndexHolder) GetHostBlockId() uint64 {
 	return holder.host.GetID().BlockID
 }
+
+func (holder *appendableBlockIndexHolder) PKRange() (min, max interface{}, ok bool) {
+	if holder.zoneMapIndex == nil {
+		return
+	}
+	return holder.zoneMapIndex.Range()
+}
@@ -27,9 +27,11 @@ import (
 )
 
 type nonAppendableBlockIndexHolder struct {
+	*secondaryIndexes
 	host              data.Block
 	zoneMapIndex      *io.BlockZoneMapIndexReader
 	staticFilterIndex *io.StaticFilterIndexReader
+	secondaryReaders  map[uint16]*io.BlockSecondaryIndexReader
 	schema            *catalog.Schema
 }
 
@@ -77,27 +79,46 @@ func (holder *nonAppendableBlockIndexHolder) MayContainsAnyKeys(keys *vector.Vec
 }
 
 func NewEmptyNonAppendableBlockIndexHolder() *nonAppendableBlockIndexHolder {
-	return &nonAppendableBlockIndexHolder{}
+	return &nonAppendableBlockIndexHolder{
+		secondaryIndexes: newSecondaryIndexes(),
+		secondaryReaders: make(map[uint16]*io.BlockSecondaryIndexReader),
+	}
+}
+
+// SecondaryIndexedRows reports a persisted secondary index as covering the
+// whole block. Columns indexed after the block was written fall back to an
+// in-memory index built on demand.
+func (holder *nonAppendableBlockIndexHolder) SecondaryIndexedRows(colIdx uint16) (uint32, bool) {
+	if _, ok := holder.secondaryReaders[colIdx]; ok {
+		return uint32(holder.host.Rows(nil, true)), true
+	}
+	return holder.secondaryIndexes.SecondaryIndexedRows(colIdx)
+}
+
+func (holder *nonAppendableBlockIndexHolder) SearchSecondary(colIdx uint16, lower, upper interface{}) (*roaring.Bitmap, bool) {
+	if reader, ok := holder.secondaryReaders[colIdx]; ok {
+		return reader.SearchRange(lower, upper), true
+	}
+	return holder.secondaryIndexes.SearchSecondary(colIdx, lower, upper)
 }
 
 func (holder *nonAppendableBlockIndexHolder) InitFromHost(host data.Block, schema *catalog.Schema, bufManager base.INodeManager) error {
 	holder.host = host
 	holder.schema = schema
-	pkIdx := schema.PrimaryKey
 	blkFile := host.GetBlockFile()
 	idxMetas, err := blkFile.LoadIndexMeta()
 	if err != nil {
 		return err
 	}
 
-	colFile, err := blkFile.OpenColumn(int(pkIdx))
-	if err != nil {
-		return err
-	}
 	for _, meta := range idxMetas.Metas {
 		internal := meta.InternalIdx
-		colFile.GetDataFileStat()
+		colFile, err := blkFile.OpenColumn(int(meta.ColIdx))
+		if err != nil {
+			return err
+		}
 		idxFile, err := colFile.OpenIndexFile(int(internal))
+		colFile.Close()
 		if err != nil {
 			return err
 		}
@@ -140,6 +161,19 @@ func (holder *nonAppendableBlockIndexHolder) InitFromHost(host data.Block, schem
 				return err
 			}
 			holder.staticFilterIndex = reader
+		case common.SecondaryIndex:
+			reader := io.NewBlockSecondaryIndexReader()
+			// TODO: refactor id generation
+			id := gCommon.ID{
+				BlockID:   host.GetID().BlockID,
+				SegmentID: uint64(meta.InternalIdx),
+				Idx:       meta.ColIdx,
+			}
+			err = reader.Init(bufManager, idxFile, &id)
+			if err != nil {
+				return err
+			}
+			holder.secondaryReaders[meta.ColIdx] = reader
 		default:
 			panic("unsupported index type for block")
 		}
@@ -154,9 +188,22 @@ func (holder *nonAppendableBlockIndexHolder) Destroy() (err error) {
 	if err = holder.staticFilterIndex.Destroy(); err != nil {
 		return err
 	}
+	for _, reader := range holder.secondaryReaders {
+		if err = reader.Destroy(); err != nil {
+			return err
+		}
+	}
+	holder.secondaryIndexes.Destroy()
 	return nil
 }
 
 func (holder *nonAppendableBlockIndexHolder) GetHostBlockId() uint64 {
 	return holder.host.GetID().BlockID
 }
+
+func (holder *nonAppendableBlockIndexHolder) PKRange() (min, max interface{}, ok bool) {
+	if holder.zoneMapIndex == nil {
+		return
+	}
+	return holder.zoneMapIndex.Range()
+}
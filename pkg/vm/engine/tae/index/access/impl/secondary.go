@@ -0,0 +1,86 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package impl
+
+import (
+	"sync"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/basic"
+)
+
+type secondaryIndex struct {
+	inner *basic.SecondaryIndex
+	rows  uint32
+}
+
+// secondaryIndexes holds the in-memory secondary indexes of a block. Each
+// of them covers a prefix of the block rows, which is extended either by
+// appends or by a catch up when an index is created after the rows.
+type secondaryIndexes struct {
+	mu      sync.RWMutex
+	indexes map[uint16]*secondaryIndex
+}
+
+func newSecondaryIndexes() *secondaryIndexes {
+	return &secondaryIndexes{
+		indexes: make(map[uint16]*secondaryIndex),
+	}
+}
+
+func (holder *secondaryIndexes) SecondaryIndexedRows(colIdx uint16) (uint32, bool) {
+	holder.mu.RLock()
+	defer holder.mu.RUnlock()
+	idx := holder.indexes[colIdx]
+	if idx == nil {
+		return 0, false
+	}
+	return idx.rows, true
+}
+
+func (holder *secondaryIndexes) BatchInsertSecondary(colIdx uint16, keys *vector.Vector, start uint32, count int, offset uint32) error {
+	holder.mu.Lock()
+	defer holder.mu.Unlock()
+	idx := holder.indexes[colIdx]
+	if idx == nil {
+		idx = &secondaryIndex{inner: basic.NewSecondaryIndex(keys.Typ, nil)}
+		holder.indexes[colIdx] = idx
+	}
+	if offset != idx.rows {
+		return nil
+	}
+	if err := idx.inner.BatchInsert(keys, start, count, offset); err != nil {
+		return err
+	}
+	idx.rows += uint32(count)
+	return nil
+}
+
+func (holder *secondaryIndexes) SearchSecondary(colIdx uint16, lower, upper interface{}) (*roaring.Bitmap, bool) {
+	holder.mu.RLock()
+	idx := holder.indexes[colIdx]
+	holder.mu.RUnlock()
+	if idx == nil {
+		return nil, false
+	}
+	return idx.inner.SearchRange(lower, upper), true
+}
+
+func (holder *secondaryIndexes) Destroy() {
+	holder.mu.Lock()
+	defer holder.mu.Unlock()
+	holder.indexes = make(map[uint16]*secondaryIndex)
+}
@@ -0,0 +1,204 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basic
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/google/btree"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common/errors"
+)
+
+const secondaryIndexDegree = 32
+
+type secondaryItem struct {
+	typ types.Type
+	key interface{}
+	row uint32
+}
+
+func (item *secondaryItem) Less(than btree.Item) bool {
+	o := than.(*secondaryItem)
+	if res := common.CompareGeneric(item.key, o.key, item.typ); res != 0 {
+		return res < 0
+	}
+	return item.row < o.row
+}
+
+// SecondaryIndex maps the values of a non-primary column of a block to the
+// offsets of the rows holding them. Keys are not unique, so entries are
+// ordered by key first and row offset second, which keeps both point and
+// range lookups a single ordered scan.
+type SecondaryIndex struct {
+	mu    *sync.RWMutex
+	typ   types.Type
+	inner *btree.BTree
+}
+
+func NewSecondaryIndex(typ types.Type, mutex *sync.RWMutex) *SecondaryIndex {
+	if mutex == nil {
+		mutex = new(sync.RWMutex)
+	}
+	return &SecondaryIndex{
+		mu:    mutex,
+		typ:   typ,
+		inner: btree.New(secondaryIndexDegree),
+	}
+}
+
+func NewSecondaryIndexFromSource(data []byte) (*SecondaryIndex, error) {
+	idx := &SecondaryIndex{}
+	if err := idx.Unmarshal(data); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+func (idx *SecondaryIndex) GetType() types.Type {
+	return idx.typ
+}
+
+func (idx *SecondaryIndex) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.inner.Len()
+}
+
+func (idx *SecondaryIndex) Insert(key interface{}, row uint32) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.inner.ReplaceOrInsert(&secondaryItem{typ: idx.typ, key: key, row: row})
+	return nil
+}
+
+// BatchInsert indexes count keys of the vector starting from start. The
+// first of them lands at row offset of the block. Null keys are skipped.
+func (idx *SecondaryIndex) BatchInsert(keys *vector.Vector, start uint32, count int, offset uint32) error {
+	if !idx.typ.Eq(keys.Typ) {
+		return errors.ErrTypeMismatch
+	}
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	for i := 0; i < count; i++ {
+		pos := start + uint32(i)
+		if nulls.Contains(keys.Nsp, uint64(pos)) {
+			continue
+		}
+		key := compute.GetValue(keys, pos)
+		if v, ok := key.(string); ok {
+			// Keys of char types are compared as bytes
+			key = []byte(v)
+		}
+		idx.inner.ReplaceOrInsert(&secondaryItem{typ: idx.typ, key: key, row: offset + uint32(i)})
+	}
+	return nil
+}
+
+func (idx *SecondaryIndex) Delete(key interface{}, row uint32) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	if idx.inner.Delete(&secondaryItem{typ: idx.typ, key: key, row: row}) == nil {
+		return errors.ErrKeyNotFound
+	}
+	return nil
+}
+
+// Search returns the offsets of all rows whose key equals the given one
+func (idx *SecondaryIndex) Search(key interface{}) *roaring.Bitmap {
+	return idx.SearchRange(key, key)
+}
+
+// SearchRange returns the offsets of all rows whose key lies in the closed
+// range [lower, upper]. A nil bound leaves that side of the range open.
+func (idx *SecondaryIndex) SearchRange(lower, upper interface{}) *roaring.Bitmap {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	rows := roaring.NewBitmap()
+	iter := func(i btree.Item) bool {
+		item := i.(*secondaryItem)
+		if upper != nil && common.CompareGeneric(item.key, upper, idx.typ) > 0 {
+			return false
+		}
+		rows.Add(item.row)
+		return true
+	}
+	if lower == nil {
+		idx.inner.Ascend(iter)
+	} else {
+		idx.inner.AscendGreaterOrEqual(&secondaryItem{typ: idx.typ, key: lower}, iter)
+	}
+	return rows
+}
+
+func (idx *SecondaryIndex) Marshal() ([]byte, error) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	var buf bytes.Buffer
+	var err error
+	buf.Write(encoding.EncodeType(idx.typ))
+	buf.Write(encoding.EncodeUint32(uint32(idx.inner.Len())))
+	idx.inner.Ascend(func(i btree.Item) bool {
+		item := i.(*secondaryItem)
+		var key []byte
+		if key, err = common.EncodeKey(item.key, idx.typ); err != nil {
+			return false
+		}
+		buf.Write(encoding.EncodeUint32(uint32(len(key))))
+		buf.Write(key)
+		buf.Write(encoding.EncodeUint32(item.row))
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (idx *SecondaryIndex) Unmarshal(buf []byte) error {
+	idx.typ = encoding.DecodeType(buf[:encoding.TypeSize])
+	buf = buf[encoding.TypeSize:]
+	idx.mu = new(sync.RWMutex)
+	idx.inner = btree.New(secondaryIndexDegree)
+	count := encoding.DecodeUint32(buf[:4])
+	buf = buf[4:]
+	for i := uint32(0); i < count; i++ {
+		size := encoding.DecodeUint32(buf[:4])
+		buf = buf[4:]
+		keyBuf := make([]byte, size)
+		copy(keyBuf, buf[:size])
+		buf = buf[size:]
+		row := encoding.DecodeUint32(buf[:4])
+		buf = buf[4:]
+		key := common.DecodeKey(keyBuf, idx.typ)
+		idx.inner.ReplaceOrInsert(&secondaryItem{typ: idx.typ, key: key, row: row})
+	}
+	return nil
+}
+
+func (idx *SecondaryIndex) GetMemoryUsage() uint64 {
+	buf, err := idx.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return uint64(len(buf))
+}
@@ -0,0 +1,92 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package basic
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecondaryIndexNumeric(t *testing.T) {
+	typ := types.Type{Oid: types.T_int32}
+	idx := NewSecondaryIndex(typ, nil)
+	require.Equal(t, uint64(0), idx.Search(int32(0)).GetCardinality())
+
+	vec := common.MockVec(typ, 1000, 0)
+	err := idx.BatchInsert(vec, 0, 1000, 0)
+	require.NoError(t, err)
+	// Duplicated keys land at rows [1000, 1100)
+	vec = common.MockVec(typ, 100, 500)
+	err = idx.BatchInsert(vec, 0, 100, 1000)
+	require.NoError(t, err)
+	require.Equal(t, 1100, idx.Len())
+
+	rows := idx.Search(int32(10))
+	require.Equal(t, []uint32{10}, rows.ToArray())
+	rows = idx.Search(int32(520))
+	require.Equal(t, []uint32{520, 1020}, rows.ToArray())
+	rows = idx.Search(int32(1000))
+	require.True(t, rows.IsEmpty())
+
+	rows = idx.SearchRange(int32(995), nil)
+	require.Equal(t, []uint32{995, 996, 997, 998, 999}, rows.ToArray())
+	rows = idx.SearchRange(nil, int32(2))
+	require.Equal(t, []uint32{0, 1, 2}, rows.ToArray())
+	rows = idx.SearchRange(int32(598), int32(601))
+	require.Equal(t, []uint32{598, 599, 600, 601, 1098, 1099}, rows.ToArray())
+
+	err = idx.Delete(int32(520), 1020)
+	require.NoError(t, err)
+	err = idx.Delete(int32(520), 1020)
+	require.ErrorIs(t, err, errors.ErrKeyNotFound)
+	require.Equal(t, []uint32{520}, idx.Search(int32(520)).ToArray())
+
+	err = idx.BatchInsert(common.MockVec(types.Type{Oid: types.T_int64}, 1, 0), 0, 1, 0)
+	require.ErrorIs(t, err, errors.ErrTypeMismatch)
+
+	buf, err := idx.Marshal()
+	require.NoError(t, err)
+	other, err := NewSecondaryIndexFromSource(buf)
+	require.NoError(t, err)
+	require.Equal(t, typ, other.GetType())
+	require.Equal(t, idx.Len(), other.Len())
+	require.Equal(t, []uint32{598, 599, 600, 601, 1098, 1099}, other.SearchRange(int32(598), int32(601)).ToArray())
+}
+
+func TestSecondaryIndexString(t *testing.T) {
+	typ := types.Type{Oid: types.T_varchar, Width: 100}
+	idx := NewSecondaryIndex(typ, nil)
+	vec := common.MockVec(typ, 100, 0)
+	err := idx.BatchInsert(vec, 10, 20, 0)
+	require.NoError(t, err)
+	require.Equal(t, 20, idx.Len())
+
+	rows := idx.Search([]byte(strconv.Itoa(15)))
+	require.Equal(t, []uint32{5}, rows.ToArray())
+	rows = idx.Search([]byte(strconv.Itoa(5)))
+	require.True(t, rows.IsEmpty())
+
+	buf, err := idx.Marshal()
+	require.NoError(t, err)
+	other, err := NewSecondaryIndexFromSource(buf)
+	require.NoError(t, err)
+	rows = other.SearchRange([]byte("18"), []byte("2"))
+	require.Equal(t, []uint32{8, 9}, rows.ToArray())
+}
@@ -61,6 +61,13 @@ func (zm *ZoneMap) Initialized() bool {
 	return zm.initialized
 }
 
+// Range returns the min and max of the zonemap, false if nothing is in it
+func (zm *ZoneMap) Range() (min, max interface{}, ok bool) {
+	zm.mu.RLock()
+	defer zm.mu.RUnlock()
+	return zm.min, zm.max, zm.initialized
+}
+
 func (zm *ZoneMap) Update(v interface{}) error {
 	zm.mu.Lock()
 	defer zm.mu.Unlock()
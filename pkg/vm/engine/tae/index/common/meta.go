@@ -28,6 +28,7 @@ const (
 	SegmentZoneMapIndex
 	StaticFilterIndex
 	ARTIndex
+	SecondaryIndex
 )
 
 type CompressType uint8
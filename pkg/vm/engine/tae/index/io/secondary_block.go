@@ -0,0 +1,187 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer/base"
+	gCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/basic"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common/errors"
+)
+
+type blockSecondaryIndexNode struct {
+	*buffer.Node
+	mgr   base.INodeManager
+	host  gCommon.IVFile
+	inner *basic.SecondaryIndex
+}
+
+func newBlockSecondaryIndexNode(mgr base.INodeManager, host gCommon.IVFile, id *gCommon.ID) *blockSecondaryIndexNode {
+	impl := new(blockSecondaryIndexNode)
+	impl.Node = buffer.NewNode(impl, mgr, *id, uint64(host.Stat().Size()))
+	impl.LoadFunc = impl.OnLoad
+	impl.UnloadFunc = impl.OnUnload
+	impl.DestroyFunc = impl.OnDestroy
+	impl.host = host
+	impl.mgr = mgr
+	mgr.RegisterNode(impl)
+	return impl
+}
+
+func (n *blockSecondaryIndexNode) OnLoad() {
+	if n.inner != nil {
+		// no-op
+		return
+	}
+	var err error
+	stat := n.host.Stat()
+	size := stat.Size()
+	compressTyp := stat.CompressAlgo()
+	data := make([]byte, size)
+	if _, err := n.host.Read(data); err != nil {
+		panic(err)
+	}
+	rawSize := stat.OriginSize()
+	buf := make([]byte, rawSize)
+	if err = common.Decompress(data, buf, common.CompressType(compressTyp)); err != nil {
+		panic(err)
+	}
+	n.inner, err = basic.NewSecondaryIndexFromSource(buf)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (n *blockSecondaryIndexNode) OnUnload() {
+	if n.inner == nil {
+		// no-op
+		return
+	}
+	n.inner = nil
+}
+
+func (n *blockSecondaryIndexNode) OnDestroy() {
+	n.host.Unref()
+}
+
+func (n *blockSecondaryIndexNode) Close() (err error) {
+	if err = n.Node.Close(); err != nil {
+		return err
+	}
+	n.inner = nil
+	return nil
+}
+
+type BlockSecondaryIndexReader struct {
+	inode *blockSecondaryIndexNode
+}
+
+func NewBlockSecondaryIndexReader() *BlockSecondaryIndexReader {
+	return &BlockSecondaryIndexReader{}
+}
+
+func (reader *BlockSecondaryIndexReader) Init(mgr base.INodeManager, host gCommon.IVFile, id *gCommon.ID) error {
+	reader.inode = newBlockSecondaryIndexNode(mgr, host, id)
+	return nil
+}
+
+func (reader *BlockSecondaryIndexReader) Destroy() (err error) {
+	if err = reader.inode.Close(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (reader *BlockSecondaryIndexReader) Search(key interface{}) *roaring.Bitmap {
+	handle := reader.inode.mgr.Pin(reader.inode)
+	defer handle.Close()
+	return handle.GetNode().(*blockSecondaryIndexNode).inner.Search(key)
+}
+
+func (reader *BlockSecondaryIndexReader) SearchRange(lower, upper interface{}) *roaring.Bitmap {
+	handle := reader.inode.mgr.Pin(reader.inode)
+	defer handle.Close()
+	return handle.GetNode().(*blockSecondaryIndexNode).inner.SearchRange(lower, upper)
+}
+
+type BlockSecondaryIndexWriter struct {
+	cType       common.CompressType
+	host        gCommon.IRWFile
+	inner       *basic.SecondaryIndex
+	colIdx      uint16
+	internalIdx uint16
+	rows        uint32
+}
+
+func NewBlockSecondaryIndexWriter() *BlockSecondaryIndexWriter {
+	return &BlockSecondaryIndexWriter{}
+}
+
+func (writer *BlockSecondaryIndexWriter) Init(host gCommon.IRWFile, cType common.CompressType, colIdx uint16, internalIdx uint16) error {
+	writer.host = host
+	writer.cType = cType
+	writer.colIdx = colIdx
+	writer.internalIdx = internalIdx
+	return nil
+}
+
+func (writer *BlockSecondaryIndexWriter) Finalize() (*common.IndexMeta, error) {
+	if writer.inner == nil {
+		panic("unexpected error")
+	}
+	appender := writer.host
+	meta := common.NewEmptyIndexMeta()
+	meta.SetIndexType(common.SecondaryIndex)
+	meta.SetCompressType(writer.cType)
+	meta.SetIndexedColumn(writer.colIdx)
+	meta.SetInternalIndex(writer.internalIdx)
+
+	iBuf, err := writer.inner.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	rawSize := uint32(len(iBuf))
+	compressed := common.Compress(iBuf, writer.cType)
+	exactSize := uint32(len(compressed))
+	meta.SetSize(rawSize, exactSize)
+	_, err = appender.Write(compressed)
+	if err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+// AddValues indexes the values in order, continuing the row offsets from
+// the previous call
+func (writer *BlockSecondaryIndexWriter) AddValues(values *vector.Vector) error {
+	typ := values.Typ
+	if writer.inner == nil {
+		writer.inner = basic.NewSecondaryIndex(typ, nil)
+	} else {
+		if writer.inner.GetType() != typ {
+			return errors.ErrTypeMismatch
+		}
+	}
+	count := vector.Length(values)
+	if err := writer.inner.BatchInsert(values, 0, count, writer.rows); err != nil {
+		return err
+	}
+	writer.rows += uint32(count)
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/buffer"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	idxCommon "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockSecondaryIndex(t *testing.T) {
+	bufManager := buffer.NewNodeManager(1024*1024, nil)
+	file := common.MockRWFile()
+	cType := idxCommon.Plain
+	typ := types.Type{Oid: types.T_int32}
+	colIdx := uint16(1)
+	interIdx := uint16(0)
+	var err error
+
+	writer := NewBlockSecondaryIndexWriter()
+	err = writer.Init(file, cType, colIdx, interIdx)
+	require.NoError(t, err)
+
+	err = writer.AddValues(idxCommon.MockVec(typ, 1000, 0))
+	require.NoError(t, err)
+	err = writer.AddValues(idxCommon.MockVec(typ, 10, 0))
+	require.NoError(t, err)
+
+	meta, err := writer.Finalize()
+	require.NoError(t, err)
+	require.Equal(t, idxCommon.SecondaryIndex, meta.IdxType)
+	require.Equal(t, colIdx, meta.ColIdx)
+
+	reader := NewBlockSecondaryIndexReader()
+	err = reader.Init(bufManager, file, &common.ID{})
+	require.NoError(t, err)
+
+	rows := reader.Search(int32(5))
+	require.Equal(t, []uint32{5, 1005}, rows.ToArray())
+	rows = reader.Search(int32(1000))
+	require.True(t, rows.IsEmpty())
+	rows = reader.SearchRange(int32(998), nil)
+	require.Equal(t, []uint32{998, 999}, rows.ToArray())
+}
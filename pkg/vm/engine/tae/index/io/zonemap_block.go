@@ -123,6 +123,12 @@ func (reader *BlockZoneMapIndexReader) MayContainsKey(key interface{}) (bool, er
 	return handle.GetNode().(*blockZoneMapIndexNode).inner.MayContainsKey(key)
 }
 
+func (reader *BlockZoneMapIndexReader) Range() (min, max interface{}, ok bool) {
+	handle := reader.inode.mgr.Pin(reader.inode)
+	defer handle.Close()
+	return handle.GetNode().(*blockZoneMapIndexNode).inner.Range()
+}
+
 type BlockZoneMapIndexWriter struct {
 	cType       common.CompressType
 	host        gCommon.IRWFile
@@ -0,0 +1,310 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+// reverseOps maps a comparison to the one holding with swapped operands
+var reverseOps = map[int]int{
+	overload.EQ: overload.EQ,
+	overload.LT: overload.GT,
+	overload.LE: overload.GE,
+	overload.GT: overload.LT,
+	overload.GE: overload.LE,
+}
+
+// indexFilter is a comparison between a secondary indexed column and a
+// constant, or an equality between any column and a constant which is
+// searched on the dictionary codes of the encoded blocks. Strict
+// comparisons are widened to inclusive ones, which is fine as the filter
+// only prunes blocks and the condition is still evaluated on the rows read.
+type indexFilter struct {
+	col    uint16
+	filter *handle.Filter
+}
+
+func getIndexFilters(schema *catalog.Schema, e extend.Extend) (filters []indexFilter) {
+	if e == nil {
+		return
+	}
+	for _, cond := range extend.AndExtends(e, nil) {
+		v, ok := cond.(*extend.BinaryExtend)
+		if !ok {
+			continue
+		}
+		op := v.Op
+		attr, ok := v.Left.(*extend.Attribute)
+		val, ok2 := v.Right.(*extend.ValueExtend)
+		if !ok || !ok2 {
+			attr, ok = v.Right.(*extend.Attribute)
+			val, ok2 = v.Left.(*extend.ValueExtend)
+			if !ok || !ok2 {
+				continue
+			}
+			op = reverseOps[op]
+		}
+		if _, ok = reverseOps[op]; !ok {
+			continue
+		}
+		colIdx := schema.GetColIdx(attr.Name)
+		if colIdx < 0 || schema.GetSecondaryIndex(colIdx) == nil && op != overload.EQ {
+			continue
+		}
+		param := castBound(val.V, attr.Type, op)
+		if param == nil {
+			continue
+		}
+		var filter *handle.Filter
+		switch op {
+		case overload.EQ:
+			filter = handle.NewEQFilter(param)
+		case overload.LT, overload.LE:
+			filter = handle.NewBtwFilter(nil, param)
+		case overload.GT, overload.GE:
+			filter = handle.NewBtwFilter(param, nil)
+		}
+		filters = append(filters, indexFilter{col: uint16(colIdx), filter: filter})
+	}
+	return
+}
+
+// getIndexedRows returns the rows of the blocks that may satisfy all the
+// filters, keyed by the block id. The blocks not in the map hold no such
+// rows. It returns nil if the rows cannot be pruned, in which case all of
+// them have to be read.
+func getIndexedRows(rel handle.Relation, filters []indexFilter) (rows map[uint64]*roaring.Bitmap) {
+	for _, f := range filters {
+		hits, err := rel.GetByIndex(f.col, f.filter)
+		if err != nil {
+			logutil.Warnf("index lookup on column %d failed: %v", f.col, err)
+			return nil
+		}
+		found := make(map[uint64]*roaring.Bitmap, len(hits))
+		for id, hit := range hits {
+			if rows == nil {
+				found[id.BlockID] = hit
+			} else if prev, ok := rows[id.BlockID]; ok {
+				if hit = roaring.And(prev, hit); !hit.IsEmpty() {
+					found[id.BlockID] = hit
+				}
+			}
+		}
+		rows = found
+	}
+	return
+}
+
+// castBound converts the constant to a bound of the filter on a column of
+// the type. The numeric constants out of the range of the type are clamped
+// to it and the fractions are rounded outwards, so that no row satisfying
+// the comparison is dropped by the filter.
+func castBound(vec *vector.Vector, typ types.T, op int) interface{} {
+	x, ok := numericValue(getVectorValue(vec))
+	if !ok {
+		return cast(vec, typ)
+	}
+	if x == nil {
+		return nil
+	}
+	upper := op == overload.LT || op == overload.LE || op == overload.EQ
+	switch typ {
+	case types.T_float32:
+		f, _ := x.Float32()
+		if c := new(big.Float).SetFloat64(float64(f)).Cmp(x); upper && c < 0 {
+			f = math.Nextafter32(f, float32(math.Inf(1)))
+		} else if !upper && c > 0 {
+			f = math.Nextafter32(f, float32(math.Inf(-1)))
+		}
+		return f
+	case types.T_float64:
+		f, _ := x.Float64()
+		if c := new(big.Float).SetFloat64(f).Cmp(x); upper && c < 0 {
+			f = math.Nextafter(f, math.Inf(1))
+		} else if !upper && c > 0 {
+			f = math.Nextafter(f, math.Inf(-1))
+		}
+		return f
+	}
+	lo, hi, ok := integerRange(typ)
+	if !ok {
+		return cast(vec, typ)
+	}
+	if x.Cmp(lo) < 0 {
+		x = lo
+	} else if x.Cmp(hi) > 0 {
+		x = hi
+	}
+	i, acc := x.Int(nil)
+	if upper && acc == big.Above {
+		i.Sub(i, big.NewInt(1))
+	} else if !upper && acc == big.Below {
+		i.Add(i, big.NewInt(1))
+	}
+	switch typ {
+	case types.T_int8:
+		return int8(i.Int64())
+	case types.T_int16:
+		return int16(i.Int64())
+	case types.T_int32:
+		return int32(i.Int64())
+	case types.T_int64:
+		return i.Int64()
+	case types.T_uint8:
+		return uint8(i.Uint64())
+	case types.T_uint16:
+		return uint16(i.Uint64())
+	case types.T_uint32:
+		return uint32(i.Uint64())
+	default:
+		return i.Uint64()
+	}
+}
+
+// numericValue returns the exact value of a numeric constant, it is nil
+// for NaN. ok is false if the constant is not numeric.
+func numericValue(v interface{}) (x *big.Float, ok bool) {
+	switch n := v.(type) {
+	case int8:
+		return new(big.Float).SetInt64(int64(n)), true
+	case int16:
+		return new(big.Float).SetInt64(int64(n)), true
+	case int32:
+		return new(big.Float).SetInt64(int64(n)), true
+	case int64:
+		return new(big.Float).SetInt64(n), true
+	case uint8:
+		return new(big.Float).SetUint64(uint64(n)), true
+	case uint16:
+		return new(big.Float).SetUint64(uint64(n)), true
+	case uint32:
+		return new(big.Float).SetUint64(uint64(n)), true
+	case uint64:
+		return new(big.Float).SetUint64(n), true
+	case float32:
+		if math.IsNaN(float64(n)) {
+			return nil, true
+		}
+		return new(big.Float).SetFloat64(float64(n)), true
+	case float64:
+		if math.IsNaN(n) {
+			return nil, true
+		}
+		return new(big.Float).SetFloat64(n), true
+	}
+	return nil, false
+}
+
+// integerRange returns the range of the values of an integer type.
+func integerRange(typ types.T) (lo, hi *big.Float, ok bool) {
+	var min int64
+	var max uint64
+	switch typ {
+	case types.T_int8:
+		min, max = math.MinInt8, math.MaxInt8
+	case types.T_int16:
+		min, max = math.MinInt16, math.MaxInt16
+	case types.T_int32:
+		min, max = math.MinInt32, math.MaxInt32
+	case types.T_int64:
+		min, max = math.MinInt64, math.MaxInt64
+	case types.T_uint8:
+		max = math.MaxUint8
+	case types.T_uint16:
+		max = math.MaxUint16
+	case types.T_uint32:
+		max = math.MaxUint32
+	case types.T_uint64:
+		max = math.MaxUint64
+	default:
+		return nil, nil, false
+	}
+	return new(big.Float).SetInt64(min), new(big.Float).SetUint64(max), true
+}
+
+// castProc casts the constants of the filters. The mmu is thread-safe so
+// it is shared by all the readers instead of being made for every constant.
+var castProc = process.New(mheap.New(guest.New(1<<20, host.New(1<<20))))
+
+func cast(vec *vector.Vector, typ types.T) interface{} {
+	ops := overload.BinOps[overload.Typecast]
+	retVec := vec
+	for _, op := range ops {
+		if op.LeftType == vec.Typ.Oid && op.RightType == typ {
+			retVec, _ = op.Fn(vec, vector.New(types.Type{Oid: typ}), castProc, true, true)
+			if retVec == nil {
+				return nil
+			}
+		}
+	}
+	if retVec != vec {
+		defer vector.Free(retVec, castProc.Mp)
+	}
+	if retVec.Typ.Oid != typ {
+		return nil
+	}
+	v := getVectorValue(retVec)
+	if data, ok := v.([]byte); ok && retVec != vec {
+		v = append([]byte(nil), data...)
+	}
+	return v
+}
+
+func getVectorValue(vec *vector.Vector) interface{} {
+	switch vec.Typ.Oid {
+	case types.T_int8:
+		return vec.Col.([]int8)[0]
+	case types.T_int16:
+		return vec.Col.([]int16)[0]
+	case types.T_int32:
+		return vec.Col.([]int32)[0]
+	case types.T_int64:
+		return vec.Col.([]int64)[0]
+	case types.T_uint8:
+		return vec.Col.([]uint8)[0]
+	case types.T_uint16:
+		return vec.Col.([]uint16)[0]
+	case types.T_uint32:
+		return vec.Col.([]uint32)[0]
+	case types.T_uint64:
+		return vec.Col.([]uint64)[0]
+	case types.T_float32:
+		return vec.Col.([]float32)[0]
+	case types.T_float64:
+		return vec.Col.([]float64)[0]
+	case types.T_date:
+		return vec.Col.([]types.Date)[0]
+	case types.T_datetime:
+		return vec.Col.([]types.Datetime)[0]
+	case types.T_char, types.T_varchar:
+		return vec.Col.(*types.Bytes).Data
+	}
+	return nil
+}
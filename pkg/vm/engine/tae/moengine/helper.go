@@ -16,9 +16,13 @@ package moengine
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/aoe"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 )
@@ -32,6 +36,7 @@ func SchemaToTableInfo(schema *catalog.Schema) aoe.TableInfo {
 	for idx, colDef := range schema.ColDefs {
 		col := aoe.ColumnInfo{
 			Name: colDef.Name,
+			Alg:  int(schema.Compression),
 			Type: colDef.Type,
 		}
 		if idx == int(schema.PrimaryKey) {
@@ -39,6 +44,24 @@ func SchemaToTableInfo(schema *catalog.Schema) aoe.TableInfo {
 		}
 		tblInfo.Columns = append(tblInfo.Columns, col)
 	}
+	if schema.CompressLevel != 0 {
+		tblInfo.Properties = append(tblInfo.Properties, aoe.Property{
+			Key:   engine.CompressionLevelProperty,
+			Value: strconv.Itoa(int(schema.CompressLevel)),
+		})
+	}
+	for _, index := range schema.IndexInfos {
+		if index.Type != catalog.SecondaryIndex {
+			continue
+		}
+		colIdx := index.Columns[0]
+		tblInfo.Indices = append(tblInfo.Indices, aoe.IndexInfo{
+			Type:        aoe.ZoneMap,
+			Name:        index.Name,
+			Columns:     []uint64{uint64(colIdx)},
+			ColumnNames: []string{schema.ColDefs[colIdx].Name},
+		})
+	}
 	return tblInfo
 }
 
@@ -87,6 +110,7 @@ func TableInfoToSchema(info *aoe.TableInfo) *catalog.Schema {
 		newInfo := &catalog.ColDef{
 			Name: colInfo.Name,
 			Idx:  idx,
+			ID:   uint16(idx),
 			Type: colInfo.Type,
 		}
 		if colInfo.PrimaryKey {
@@ -95,7 +119,142 @@ func TableInfoToSchema(info *aoe.TableInfo) *catalog.Schema {
 		}
 		schema.NameIndex[newInfo.Name] = len(schema.ColDefs)
 		schema.ColDefs = append(schema.ColDefs, newInfo)
+		// All the columns of a table share the same algorithm
+		schema.Compression = compress.T(colInfo.Alg)
+	}
+	for _, property := range info.Properties {
+		if property.Key == engine.CompressionLevelProperty {
+			level, _ := strconv.Atoi(property.Value)
+			schema.CompressLevel = int32(level)
+		}
+	}
+	// Single column indexes on non primary key columns are built as
+	// secondary indexes. The primary key is always indexed.
+	for _, idxInfo := range info.Indices {
+		if idxInfo.Type != aoe.ZoneMap {
+			continue
+		}
+		colIdx := -1
+		if len(idxInfo.ColumnNames) == 1 {
+			colIdx = schema.GetColIdx(idxInfo.ColumnNames[0])
+		} else if len(idxInfo.Columns) == 1 && int(idxInfo.Columns[0]) < len(schema.ColDefs) {
+			colIdx = int(idxInfo.Columns[0])
+		}
+		if colIdx < 0 || colIdx == int(schema.PrimaryKey) {
+			continue
+		}
+		if err := schema.AppendIndex(catalog.NewIndexInfo(idxInfo.Name, catalog.SecondaryIndex, colIdx)); err != nil {
+			logutil.Warnf("Table to schema, skip index %s: %v", idxInfo.Name, err)
+		}
 	}
 
 	return schema
 }
+
+// castDefault converts the default value of an attribute to the Go type of
+// the column, which is how the catalog keeps it. A column without a default
+// value is filled with the zero value, and a nullable one with NULL.
+func castDefault(attr engine.Attribute) (interface{}, error) {
+	def := attr.Default
+	if def.Exist && (def.IsNull || def.Value == nil) {
+		return nil, nil
+	}
+	v := def.Value
+	switch attr.Type.Oid {
+	case types.T_char, types.T_varchar, types.T_json:
+		switch s := v.(type) {
+		case nil:
+			return "", nil
+		case string:
+			return s, nil
+		case []byte:
+			return string(s), nil
+		}
+	case types.T_date:
+		switch d := v.(type) {
+		case nil:
+			return types.Date(0), nil
+		case types.Date:
+			return d, nil
+		case string:
+			return types.ParseDate(d)
+		}
+	case types.T_datetime:
+		switch d := v.(type) {
+		case nil:
+			return types.Datetime(0), nil
+		case types.Datetime:
+			return d, nil
+		case string:
+			return types.ParseDatetime(d)
+		}
+	default:
+		if v == nil {
+			v = int64(0)
+		}
+		if casted, ok := castNumeric(v, attr.Type.Oid); ok {
+			return casted, nil
+		}
+	}
+	return nil, ErrDefaultNotSupported
+}
+
+func castNumeric(v interface{}, oid types.T) (interface{}, bool) {
+	var i int64
+	var f float64
+	switch n := v.(type) {
+	case int8:
+		i = int64(n)
+	case int16:
+		i = int64(n)
+	case int32:
+		i = int64(n)
+	case int64:
+		i = n
+	case uint8:
+		i = int64(n)
+	case uint16:
+		i = int64(n)
+	case uint32:
+		i = int64(n)
+	case uint64:
+		i = int64(n)
+	case float32:
+		f = float64(n)
+		i = int64(math.Round(f))
+	case float64:
+		f = n
+		i = int64(math.Round(f))
+	default:
+		return nil, false
+	}
+	if f == 0 {
+		f = float64(i)
+	}
+	switch oid {
+	case types.T_int8:
+		return int8(i), true
+	case types.T_int16:
+		return int16(i), true
+	case types.T_int32:
+		return int32(i), true
+	case types.T_int64:
+		return i, true
+	case types.T_uint8:
+		return uint8(i), true
+	case types.T_uint16:
+		return uint16(i), true
+	case types.T_uint32:
+		return uint32(i), true
+	case types.T_uint64:
+		if n, ok := v.(uint64); ok {
+			return n, true
+		}
+		return uint64(i), true
+	case types.T_float32:
+		return float32(f), true
+	case types.T_float64:
+		return f, true
+	}
+	return nil, false
+}
@@ -17,7 +17,9 @@ package moengine
 import (
 	"bytes"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
@@ -27,7 +29,7 @@ var (
 	_ engine.Reader = (*txnReader)(nil)
 )
 
-func newReader(rel handle.Relation, it handle.BlockIt) *txnReader {
+func newReader(rel handle.Relation, it handle.BlockIt, rows map[uint64]*roaring.Bitmap) *txnReader {
 	attrCnt := len(rel.GetMeta().(*catalog.TableEntry).GetSchema().ColDefs)
 	cds := make([]*bytes.Buffer, attrCnt)
 	dds := make([]*bytes.Buffer, attrCnt)
@@ -40,20 +42,46 @@ func newReader(rel handle.Relation, it handle.BlockIt) *txnReader {
 		decompressed: dds,
 		handle:       rel,
 		it:           it,
+		rows:         rows,
 	}
 }
 
 func (r *txnReader) Read(refCount []uint64, attrs []string) (*batch.Batch, error) {
 	r.it.Lock()
-	if !r.it.Valid() {
-		r.it.Unlock()
-		return nil, nil
+	var h handle.Block
+	var rows *roaring.Bitmap
+	for r.it.Valid() {
+		h = r.it.GetBlock()
+		r.it.Next()
+		if r.rows == nil {
+			break
+		}
+		// Skip the blocks pruned by the secondary indexes
+		var ok bool
+		if rows, ok = r.rows[h.Fingerprint().BlockID]; ok {
+			break
+		}
+		h = nil
 	}
-	h := r.it.GetBlock()
-	r.it.Next()
 	r.it.Unlock()
+	if h == nil {
+		return nil, nil
+	}
 	block := newBlock(h)
-	return block.Read(refCount, attrs, r.compressed, r.decompressed)
+	bat, err := block.Read(refCount, attrs, r.compressed, r.decompressed)
+	if err != nil || rows == nil {
+		return bat, err
+	}
+	// Only keep the rows found by the secondary indexes
+	sels := make([]int64, 0, rows.GetCardinality())
+	it := rows.Iterator()
+	for it.HasNext() {
+		sels = append(sels, int64(it.Next()))
+	}
+	for _, vec := range bat.Vecs {
+		vector.Shrink(vec, sels)
+	}
+	return bat, nil
 }
 
 func (r *txnReader) NewFilter() engine.Filter {
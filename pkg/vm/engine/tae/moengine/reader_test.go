@@ -0,0 +1,292 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moengine
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec/extend/overload"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadByIndex(t *testing.T) {
+	tae, err := db.Open(testutils.InitTestEnv("moengine", t), nil)
+	assert.Nil(t, err)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 5
+	schema.SegmentMaxBlocks = 8
+	schema.PrimaryKey = 2
+	col3Data := []int64{10, 8, 1, 6, 15, 7, 3, 12, 11, 4, 9, 5, 14, 13, 2}
+	pkData := []int32{2, 9, 11, 13, 15, 1, 4, 7, 10, 14, 3, 5, 6, 8, 12}
+	pk := vector.New(schema.GetPKType())
+	col3 := vector.New(schema.ColDefs[3].Type)
+	for i, v := range pkData {
+		compute.AppendValue(pk, v)
+		compute.AppendValue(col3, col3Data[i])
+	}
+	provider := compute.NewMockDataProvider()
+	provider.AddColumnProvider(int(schema.PrimaryKey), pk)
+	provider.AddColumnProvider(3, col3)
+	bat := compute.MockBatch(schema.Types(), uint64(schema.BlockMaxRows*3), int(schema.PrimaryKey), provider)
+	{
+		txn := tae.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		assert.Nil(t, rel.Append(bat))
+		assert.Nil(t, rel.CreateIndex(catalog.NewIndexInfo("idx", catalog.SecondaryIndex, 3)))
+		assert.Nil(t, txn.Commit())
+	}
+
+	constant := func(typ types.Type, v interface{}) *extend.ValueExtend {
+		vec := vector.New(typ)
+		assert.Nil(t, vector.Append(vec, v))
+		return &extend.ValueExtend{V: vec}
+	}
+	attr := &extend.Attribute{Name: schema.ColDefs[3].Name, Type: types.T_int64}
+	//10 <= col3 <= 12, the lower bound is cast from an int32
+	cond := &extend.BinaryExtend{
+		Op: overload.And,
+		Left: &extend.BinaryExtend{
+			Op:    overload.LE,
+			Left:  attr,
+			Right: constant(types.Type{Oid: types.T_int64, Size: 8}, []int64{12}),
+		},
+		Right: &extend.BinaryExtend{
+			Op:    overload.LE,
+			Left:  constant(types.Type{Oid: types.T_int32, Size: 4}, []int32{10}),
+			Right: attr,
+		},
+	}
+
+	txn := tae.StartTxn(nil)
+	database, _ := txn.GetDatabase("db")
+	h, _ := database.GetRelationByName(schema.Name)
+	rel := newRelation(h)
+	attrs := []string{schema.ColDefs[schema.PrimaryKey].Name, schema.ColDefs[3].Name}
+	reader := rel.NewReader(1, cond, nil)[0]
+	pks := make([]int32, 0)
+	vals := make([]int64, 0)
+	blocks := 0
+	for {
+		bat, err := reader.Read([]uint64{1, 1}, attrs)
+		assert.Nil(t, err)
+		if bat == nil {
+			break
+		}
+		blocks++
+		pks = append(pks, bat.Vecs[0].Col.([]int32)...)
+		vals = append(vals, bat.Vecs[1].Col.([]int64)...)
+	}
+	//only the rows found by the index are read, from the 2 blocks holding them
+	assert.Equal(t, 2, blocks)
+	assert.Equal(t, []int32{2, 7, 10}, pks)
+	assert.Equal(t, []int64{10, 12, 11}, vals)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestReadByIndexOutOfRange(t *testing.T) {
+	tae, err := db.Open(testutils.InitTestEnv("moengine", t), nil)
+	assert.Nil(t, err)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 5
+	schema.SegmentMaxBlocks = 8
+	schema.PrimaryKey = 2
+	pk := vector.New(schema.GetPKType())
+	col0 := vector.New(schema.ColDefs[0].Type)
+	col6 := vector.New(schema.ColDefs[6].Type)
+	for i := 0; i < 15; i++ {
+		compute.AppendValue(pk, int32(i))
+		compute.AppendValue(col0, int8(i*8-60))
+		compute.AppendValue(col6, uint32(i*1000))
+	}
+	provider := compute.NewMockDataProvider()
+	provider.AddColumnProvider(int(schema.PrimaryKey), pk)
+	provider.AddColumnProvider(0, col0)
+	provider.AddColumnProvider(6, col6)
+	bat := compute.MockBatch(schema.Types(), uint64(schema.BlockMaxRows*3), int(schema.PrimaryKey), provider)
+	{
+		txn := tae.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		assert.Nil(t, rel.Append(bat))
+		assert.Nil(t, rel.CreateIndex(catalog.NewIndexInfo("idx0", catalog.SecondaryIndex, 0)))
+		assert.Nil(t, rel.CreateIndex(catalog.NewIndexInfo("idx6", catalog.SecondaryIndex, 6)))
+		assert.Nil(t, txn.Commit())
+	}
+
+	txn := tae.StartTxn(nil)
+	database, _ := txn.GetDatabase("db")
+	h, _ := database.GetRelationByName(schema.Name)
+	rel := newRelation(h)
+	count := func(col int, oid types.T, op int, v interface{}) int {
+		typ := types.Type{Oid: types.T_int64, Size: 8}
+		if _, ok := v.([]float64); ok {
+			typ = types.Type{Oid: types.T_float64, Size: 8}
+		}
+		vec := vector.New(typ)
+		assert.Nil(t, vector.Append(vec, v))
+		cond := &extend.BinaryExtend{
+			Op:    op,
+			Left:  &extend.Attribute{Name: schema.ColDefs[col].Name, Type: oid},
+			Right: &extend.ValueExtend{V: vec},
+		}
+		reader := rel.NewReader(1, cond, nil)[0]
+		rows := 0
+		for {
+			bat, err := reader.Read([]uint64{1}, []string{schema.ColDefs[schema.PrimaryKey].Name})
+			assert.Nil(t, err)
+			if bat == nil {
+				break
+			}
+			rows += vector.Length(bat.Vecs[0])
+		}
+		return rows
+	}
+	//the constants out of the range of the column keep all the rows satisfying the comparison
+	assert.Equal(t, 15, count(6, types.T_uint32, overload.GT, []int64{-1}))
+	assert.Equal(t, 15, count(6, types.T_uint32, overload.LT, []int64{math.MaxUint32 + 1}))
+	assert.Equal(t, 15, count(0, types.T_int8, overload.LT, []int64{300}))
+	assert.Equal(t, 15, count(0, types.T_int8, overload.GE, []int64{-300}))
+	//the fractions are rounded outwards
+	assert.Equal(t, 3, count(6, types.T_uint32, overload.LT, []float64{2000.5}))
+	assert.Equal(t, 12, count(6, types.T_uint32, overload.GT, []float64{2999.5}))
+	assert.Equal(t, 8, count(0, types.T_int8, overload.LE, []float64{-3.5}))
+	assert.Nil(t, txn.Commit())
+}
+
+func TestReadByEncodedColumn(t *testing.T) {
+	tae, err := db.Open(testutils.InitTestEnv("moengine", t), nil)
+	assert.Nil(t, err)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(13)
+	schema.BlockMaxRows = 10
+	schema.SegmentMaxBlocks = 4
+	schema.PrimaryKey = 2
+	pk := vector.New(schema.GetPKType())
+	col12 := vector.New(schema.ColDefs[12].Type)
+	for i := 0; i < 40; i++ {
+		compute.AppendValue(pk, int32(i))
+		compute.AppendValue(col12, []byte(fmt.Sprintf("status-%d", i/15)))
+	}
+	provider := compute.NewMockDataProvider()
+	provider.AddColumnProvider(int(schema.PrimaryKey), pk)
+	provider.AddColumnProvider(12, col12)
+	bat := compute.MockBatch(schema.Types(), 40, int(schema.PrimaryKey), provider)
+	{
+		txn := tae.StartTxn(nil)
+		database, _ := txn.CreateDatabase("db")
+		rel, _ := database.CreateRelation(schema)
+		assert.Nil(t, rel.Append(bat))
+		assert.Nil(t, txn.Commit())
+	}
+	{
+		//the merged blocks are persisted with the low cardinality column dictionary encoded
+		txn := tae.StartTxn(nil)
+		database, _ := txn.GetDatabase("db")
+		rel, _ := database.GetRelationByName(schema.Name)
+		blks := make([]*catalog.BlockEntry, 0)
+		it := rel.MakeBlockIt()
+		for it.Valid() {
+			blks = append(blks, it.GetBlock().GetMeta().(*catalog.BlockEntry))
+			it.Next()
+		}
+		factory := jobs.MergeBlocksIntoSegmentTaskFctory(blks, blks[0].GetSegment(), tae.Scheduler)
+		task, err := factory(nil, txn)
+		assert.Nil(t, err)
+		assert.Nil(t, task.OnExec())
+		assert.Nil(t, txn.Commit())
+	}
+
+	txn := tae.StartTxn(nil)
+	database, _ := txn.GetDatabase("db")
+	h, _ := database.GetRelationByName(schema.Name)
+	it := h.MakeBlockIt()
+	for it.Valid() {
+		colBlk, err := it.GetBlock().GetMeta().(*catalog.BlockEntry).GetBlockData().GetBlockFile().OpenColumn(12)
+		assert.Nil(t, err)
+		assert.Equal(t, encoding.Dict, colBlk.GetDataEncoding())
+		colBlk.Close()
+		it.Next()
+	}
+	rel := newRelation(h)
+	vec := vector.New(schema.ColDefs[12].Type)
+	assert.Nil(t, vector.Append(vec, [][]byte{[]byte("status-1")}))
+	cond := &extend.BinaryExtend{
+		Op:    overload.EQ,
+		Left:  &extend.Attribute{Name: schema.ColDefs[12].Name, Type: types.T_varchar},
+		Right: &extend.ValueExtend{V: vec},
+	}
+	reader := rel.NewReader(1, cond, nil)[0]
+	attrs := []string{schema.ColDefs[schema.PrimaryKey].Name, schema.ColDefs[12].Name}
+	pks := make([]int32, 0)
+	blocks := 0
+	for {
+		bat, err := reader.Read([]uint64{1, 1}, attrs)
+		assert.Nil(t, err)
+		if bat == nil {
+			break
+		}
+		blocks++
+		pks = append(pks, bat.Vecs[0].Col.([]int32)...)
+		for i := 0; i < vector.Length(bat.Vecs[1]); i++ {
+			assert.Equal(t, "status-1", string(bat.Vecs[1].Col.(*types.Bytes).Get(int64(i))))
+		}
+	}
+	//the rows are searched on the dictionary codes, only the 2 blocks holding them are read
+	assert.Equal(t, 2, blocks)
+	expected := make([]int32, 0)
+	for i := int32(15); i < 30; i++ {
+		expected = append(expected, i)
+	}
+	assert.Equal(t, expected, pks)
+	assert.Nil(t, txn.Commit())
+}
+
+func TestCastBound(t *testing.T) {
+	bound := func(typ types.T, op int, v interface{}) interface{} {
+		vec := vector.New(types.Type{Oid: types.T_int64, Size: 8})
+		if _, ok := v.([]float64); ok {
+			vec = vector.New(types.Type{Oid: types.T_float64, Size: 8})
+		}
+		assert.Nil(t, vector.Append(vec, v))
+		return castBound(vec, typ, op)
+	}
+	assert.Equal(t, uint32(0), bound(types.T_uint32, overload.GT, []int64{-1}))
+	assert.Equal(t, uint32(0), bound(types.T_uint32, overload.LT, []int64{-1}))
+	assert.Equal(t, uint64(math.MaxUint64), bound(types.T_uint64, overload.LE, []float64{1e30}))
+	assert.Equal(t, int8(math.MaxInt8), bound(types.T_int8, overload.LT, []int64{300}))
+	assert.Equal(t, int8(math.MinInt8), bound(types.T_int8, overload.GT, []int64{-300}))
+	assert.Equal(t, int16(-4), bound(types.T_int16, overload.LE, []float64{-3.5}))
+	assert.Equal(t, int16(-3), bound(types.T_int16, overload.GE, []float64{-3.5}))
+	assert.Equal(t, int32(7), bound(types.T_int32, overload.EQ, []int64{7}))
+	f := bound(types.T_float32, overload.LE, []float64{0.1}).(float32)
+	assert.True(t, float64(f) >= 0.1)
+	f = bound(types.T_float32, overload.GE, []float64{0.1}).(float32)
+	assert.True(t, float64(f) <= 0.1)
+	assert.Nil(t, bound(types.T_float64, overload.EQ, []float64{math.NaN()}))
+}
@@ -24,7 +24,8 @@ import (
 )
 
 var (
-	_ engine.Relation = (*txnRelation)(nil)
+	_ engine.Relation         = (*txnRelation)(nil)
+	_ engine.ColumnStatistics = (*txnRelation)(nil)
 )
 
 func newRelation(h handle.Relation) *txnRelation {
@@ -47,8 +48,12 @@ func (_ *txnRelation) Size(_ string) int64 {
 	return 0
 }
 
-func (_ *txnRelation) CardinalNumber(_ string) int64 {
-	return 0
+func (rel *txnRelation) CardinalNumber(attr string) int64 {
+	return rel.handle.GetCardinality(attr)
+}
+
+func (rel *txnRelation) Range(attr string) (min, max interface{}, ok bool) {
+	return rel.handle.GetRange(attr)
 }
 
 func (_ *txnRelation) CreateIndex(_ uint64, _ []engine.TableDef) error {
@@ -59,12 +64,38 @@ func (_ *txnRelation) DropIndex(_ uint64, _ string) error {
 	panic("implement me")
 }
 
-func (_ *txnRelation) AddTableDef(u uint64, def engine.TableDef) error {
-	panic("implement me")
+func (rel *txnRelation) AddTableDef(_ uint64, def engine.TableDef) error {
+	switch d := def.(type) {
+	case *engine.AttributeDef:
+		v, err := castDefault(d.Attr)
+		if err != nil {
+			return err
+		}
+		return rel.handle.Alter(catalog.NewAddColumnReq(d.Attr.Name, d.Attr.Type, v))
+	case *engine.RenameColumnDef:
+		return rel.handle.Alter(catalog.NewRenameColumnReq(d.Name, d.NewName))
+	}
+	indexDef, ok := def.(*engine.IndexTableDef)
+	if !ok {
+		panic("implement me")
+	}
+	if indexDef.Typ != engine.ZoneMap || len(indexDef.ColNames) != 1 {
+		return ErrIndexNotSupported
+	}
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	colIdx := schema.GetColIdx(indexDef.ColNames[0])
+	if colIdx < 0 {
+		return catalog.ErrNotFound
+	}
+	return rel.handle.CreateIndex(catalog.NewIndexInfo(indexDef.Name, catalog.SecondaryIndex, colIdx))
 }
 
-func (_ *txnRelation) DelTableDef(u uint64, def engine.TableDef) error {
-	panic("implement me")
+func (rel *txnRelation) DelTableDef(_ uint64, def engine.TableDef) error {
+	attrDef, ok := def.(*engine.AttributeDef)
+	if !ok {
+		panic("implement me")
+	}
+	return rel.handle.Alter(catalog.NewDropColumnReq(attrDef.Attr.Name))
 }
 
 func (rel *txnRelation) TableDefs() []engine.TableDef {
@@ -105,10 +136,12 @@ func (rel *txnRelation) Write(_ uint64, bat *batch.Batch) error {
 	return rel.handle.Append(bat)
 }
 
-func (rel *txnRelation) NewReader(num int, _ extend.Extend, _ []byte) (rds []engine.Reader) {
+func (rel *txnRelation) NewReader(num int, e extend.Extend, _ []byte) (rds []engine.Reader) {
+	schema := rel.handle.GetMeta().(*catalog.TableEntry).GetSchema()
+	rows := getIndexedRows(rel.handle, getIndexFilters(schema, e))
 	it := rel.handle.MakeBlockIt()
 	for i := 0; i < num; i++ {
-		reader := newReader(rel.handle, it)
+		reader := newReader(rel.handle, it, rows)
 		rds = append(rds, reader)
 	}
 	return
@@ -16,11 +16,18 @@ package moengine
 
 import (
 	"bytes"
+	"errors"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 )
 
+var (
+	ErrIndexNotSupported   = errors.New("tae: only single column indexes are supported")
+	ErrDefaultNotSupported = errors.New("tae: default value not supported")
+)
+
 type txnEngine struct {
 	txn txnif.AsyncTxn
 }
@@ -44,6 +51,7 @@ type txnBlock struct {
 type txnReader struct {
 	handle       handle.Relation
 	it           handle.BlockIt
+	rows         map[uint64]*roaring.Bitmap
 	compressed   []*bytes.Buffer
 	decompressed []*bytes.Buffer
 }
@@ -37,3 +37,8 @@ type SchedulerCfg struct {
 	IOWorkers    int `toml:"io-workers"`
 	AsyncWorkers int `toml:"async-workers"`
 }
+
+type RetentionCfg struct {
+	// Window is the time in millisecond the history versions are kept for the snapshot reads
+	Window int64 `toml:"window"`
+}
@@ -51,5 +51,11 @@ func (o *Options) FillDefaults(dirname string) *Options {
 		}
 	}
 
+	if o.RetentionCfg == nil {
+		o.RetentionCfg = &RetentionCfg{
+			Window: DefaultRetentionWindow,
+		}
+	}
+
 	return o
 }
@@ -35,6 +35,8 @@ const (
 
 	DefaultIOWorkers    = int(8)
 	DefaultAsyncWorkers = int(16)
+
+	DefaultRetentionWindow = int64(0) // millisecond, 0 disables the snapshot reads
 )
 
 type Options struct {
@@ -42,5 +44,6 @@ type Options struct {
 	StorageCfg    *StorageCfg    `toml:"storage-cfg"`
 	CheckpointCfg *CheckpointCfg `toml:"checkpoint-cfg"`
 	SchedulerCfg  *SchedulerCfg  `toml:"scheduler-cfg"`
+	RetentionCfg  *RetentionCfg  `toml:"retention-cfg"`
 	Catalog       *catalog.Catalog
 }
@@ -53,6 +53,10 @@ func (appender *blockAppender) GetID() *common.ID {
 }
 
 func (appender *blockAppender) IsAppendable() bool {
+	// Rows of a new version of the schema are appended to a new block
+	if appender.node.block.isStale() {
+		return false
+	}
 	return appender.rows+appender.placeholder < appender.node.block.meta.GetSchema().BlockMaxRows
 }
 
@@ -90,6 +94,12 @@ func (appender *blockAppender) ApplyAppend(bat *gbat.Batch, offset, length uint3
 	if err != nil {
 		panic(err)
 	}
+	for _, colIdx := range appender.node.block.meta.GetSchema().SecondaryIndexCols() {
+		err = appender.indexAppender.BatchInsertSecondary(uint16(colIdx), bat.Vecs[colIdx], offset, int(length), from)
+		if err != nil {
+			panic(err)
+		}
+	}
 	node = appender.node.block.mvcc.AddAppendNodeLocked(txn, appender.node.rows)
 	// appender.node.block.mvcc.SetMaxVisible(txn.GetCommitTS())
 
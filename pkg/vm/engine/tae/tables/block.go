@@ -26,10 +26,12 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/wal"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/encoding"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/access/acif"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/access/impl"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables/jobs"
@@ -65,10 +67,14 @@ func newBlock(meta *catalog.BlockEntry, segFile file.Segment, bufMgr base.INodeM
 	colCnt := len(meta.GetSchema().ColDefs)
 	indexCnt := make(map[int]int)
 	indexCnt[int(meta.GetSchema().PrimaryKey)] = 2
+	for _, colIdx := range meta.GetSchema().SecondaryIndexCols() {
+		indexCnt[colIdx] = 1
+	}
 	file, err := segFile.OpenBlock(meta.GetID(), colCnt, indexCnt)
 	if err != nil {
 		panic(err)
 	}
+	file.SetCompression(int(meta.GetSchema().Compression), int(meta.GetSchema().CompressLevel))
 	colFiles := make(map[int]common.IRWFile)
 	for i := 0; i < colCnt; i++ {
 		if colBlk, err := file.OpenColumn(i); err != nil {
@@ -106,7 +112,16 @@ func (blk *dataBlock) ReplayData() (err error) {
 	if blk.meta.IsAppendable() {
 		w, _ := blk.getVectorWrapper(int(blk.meta.GetSchema().PrimaryKey))
 		defer common.GPool.Free(w.MNode)
-		blk.indexHolder.(acif.IAppendableBlockIndexHolder).BatchInsert(&w.Vector, 0, gvec.Length(&w.Vector), 0, false)
+		holder := blk.indexHolder.(acif.IAppendableBlockIndexHolder)
+		holder.BatchInsert(&w.Vector, 0, gvec.Length(&w.Vector), 0, false)
+		for _, colIdx := range blk.meta.GetSchema().SecondaryIndexCols() {
+			cw, _ := blk.getVectorWrapper(colIdx)
+			err = holder.BatchInsertSecondary(uint16(colIdx), &cw.Vector, 0, gvec.Length(&cw.Vector), 0)
+			common.GPool.Free(cw.MNode)
+			if err != nil {
+				return
+			}
+		}
 		return
 	}
 	return blk.indexHolder.(acif.INonAppendableBlockIndexHolder).InitFromHost(blk, blk.meta.GetSchema(), idxCommon.MockIndexBufferManager /* TODO: use dedicated index buffer manager */)
@@ -252,7 +267,8 @@ func (blk *dataBlock) BuildCompactionTaskFactory() (factory tasks.TxnTaskFactory
 	if dropped || inTxn {
 		return
 	}
-	if !blk.meta.IsAppendable() || (blk.meta.IsAppendable() && blk.Rows(nil, true) == int(blk.meta.GetSchema().BlockMaxRows)) {
+	rows := blk.Rows(nil, true)
+	if !blk.meta.IsAppendable() || rows == int(blk.meta.GetSchema().BlockMaxRows) || (blk.isStale() && rows > 0) {
 		factory = jobs.CompactBlockTaskFactory(blk.meta, blk.scheduler)
 		taskType = tasks.DataCompactionTask
 	} else if blk.meta.IsAppendable() {
@@ -267,12 +283,33 @@ func (blk *dataBlock) IsAppendable() bool {
 	if !blk.meta.IsAppendable() {
 		return false
 	}
-	if blk.node.Rows(nil, true) == blk.meta.GetSegment().GetTable().GetSchema().BlockMaxRows {
+	schema := blk.meta.GetSegment().GetTable().GetSchema()
+	if blk.meta.GetSchemaVersion() != schema.Version {
+		return false
+	}
+	if blk.node.Rows(nil, true) == schema.BlockMaxRows {
 		return false
 	}
 	return true
 }
 
+// isStale returns true if the table is altered after the block is created
+func (blk *dataBlock) isStale() bool {
+	return blk.meta.GetSchemaVersion() != blk.meta.GetSegment().GetTable().GetSchema().Version
+}
+
+// physicalColIdx maps the index of a column in the table schema to its index
+// in the block. It returns -1 if the column is added after the block is
+// created.
+func (blk *dataBlock) physicalColIdx(colIdx int) int {
+	schema := blk.meta.GetSchema()
+	current := blk.meta.GetSegment().GetTable().GetSchema()
+	if schema == current {
+		return colIdx
+	}
+	return schema.GetColIdxByID(current.ColDefs[colIdx].ID)
+}
+
 func (blk *dataBlock) GetTotalChanges() int {
 	return int(blk.mvcc.GetChangeNodeCnt())
 }
@@ -285,6 +322,10 @@ func (blk *dataBlock) Rows(txn txnif.AsyncTxn, coarse bool) int {
 	return int(blk.file.ReadRows())
 }
 
+func (blk *dataBlock) GetPKRange() (min, max interface{}, ok bool) {
+	return blk.indexHolder.PKRange()
+}
+
 func (blk *dataBlock) PPString(level common.PPLevel, depth int, prefix string) string {
 	s := fmt.Sprintf("%s | [Rows=%d]", blk.meta.PPString(level, depth, prefix), blk.Rows(nil, true))
 	if level >= common.PPL1 {
@@ -383,11 +424,16 @@ func (blk *dataBlock) GetPKColumnDataOptimized(ts uint64) (view *model.ColumnVie
 }
 
 func (blk *dataBlock) GetColumnDataByName(txn txnif.AsyncTxn, attr string, compressed, decompressed *bytes.Buffer) (view *model.ColumnView, err error) {
-	colIdx := blk.meta.GetSchema().GetColIdx(attr)
+	colIdx := blk.meta.GetSegment().GetTable().GetSchema().GetColIdx(attr)
 	return blk.GetColumnDataById(txn, colIdx, compressed, decompressed)
 }
 
 func (blk *dataBlock) GetColumnDataById(txn txnif.AsyncTxn, colIdx int, compressed, decompressed *bytes.Buffer) (view *model.ColumnView, err error) {
+	if phyIdx := blk.physicalColIdx(colIdx); phyIdx < 0 {
+		return blk.getDefaultColumn(txn.GetStartTS(), colIdx)
+	} else {
+		colIdx = phyIdx
+	}
 	if blk.meta.IsAppendable() {
 		return blk.getVectorCopy(txn.GetStartTS(), colIdx, compressed, decompressed, false)
 	}
@@ -405,6 +451,29 @@ func (blk *dataBlock) GetColumnDataById(txn txnif.AsyncTxn, colIdx int, compress
 	return
 }
 
+// getDefaultColumn makes the data of a column added after the block is
+// created, which is the default value of the column for all rows
+func (blk *dataBlock) getDefaultColumn(ts uint64, colIdx int) (view *model.ColumnView, err error) {
+	def := blk.meta.GetSegment().GetTable().GetSchema().ColDefs[colIdx]
+	rows := uint32(blk.Rows(nil, true))
+	if blk.meta.IsAppendable() {
+		visible := true
+		blk.mvcc.RLock()
+		rows, visible = blk.mvcc.GetMaxVisibleRowLocked(ts)
+		blk.mvcc.RUnlock()
+		if !visible {
+			return
+		}
+	}
+	view = model.NewColumnView(ts, colIdx)
+	view.RawVec = compute.MakeConstVector(def.Type, def.Default, int(rows))
+	blk.mvcc.RLock()
+	blk.FillColumnDeletes(view)
+	blk.mvcc.RUnlock()
+	view.Eval(true)
+	return
+}
+
 func (blk *dataBlock) getVectorCopy(ts uint64, colIdx int, compressed, decompressed *bytes.Buffer, raw bool) (view *model.ColumnView, err error) {
 	h := blk.node.mgr.Pin(blk.node)
 	if h == nil {
@@ -456,7 +525,13 @@ func (blk *dataBlock) getVectorCopy(ts uint64, colIdx int, compressed, decompres
 }
 
 func (blk *dataBlock) Update(txn txnif.AsyncTxn, row uint32, colIdx uint16, v interface{}) (node txnif.UpdateNode, err error) {
-	return blk.updateWithFineLock(txn, row, colIdx, v)
+	phyIdx := blk.physicalColIdx(int(colIdx))
+	if phyIdx < 0 {
+		// The column is written to the block by the compaction
+		err = txnbase.ErrColumnNotMaterialized
+		return
+	}
+	return blk.updateWithFineLock(txn, row, uint16(phyIdx), v)
 }
 
 func (blk *dataBlock) updateWithCoarseLock(txn txnif.AsyncTxn, row uint32, colIdx uint16, v interface{}) (node txnif.UpdateNode, err error) {
@@ -509,6 +584,22 @@ func (blk *dataBlock) RangeDelete(txn txnif.AsyncTxn, start, end uint32) (node t
 
 func (blk *dataBlock) GetValue(txn txnif.AsyncTxn, row uint32, col uint16) (v interface{}, err error) {
 	ts := txn.GetStartTS()
+	phyIdx := blk.physicalColIdx(int(col))
+	if phyIdx < 0 {
+		blk.mvcc.RLock()
+		deleteChain := blk.mvcc.GetDeleteChain()
+		deleteChain.RLock()
+		deleted := deleteChain.IsDeleted(row, ts)
+		deleteChain.RUnlock()
+		blk.mvcc.RUnlock()
+		if deleted {
+			err = txnbase.ErrNotFound
+			return
+		}
+		v = blk.meta.GetSegment().GetTable().GetSchema().ColDefs[col].Default
+		return
+	}
+	col = uint16(phyIdx)
 	blk.mvcc.RLock()
 	deleteChain := blk.mvcc.GetDeleteChain()
 	deleteChain.RLock()
@@ -544,7 +635,39 @@ func (blk *dataBlock) GetValue(txn txnif.AsyncTxn, row uint32, col uint16) (v in
 	return
 }
 
+// loadEncodedColumn reads the column data of a non-appendable block if it
+// is stored in a lightweight encoding. buf is nil for plain columns.
+func (blk *dataBlock) loadEncodedColumn(colIdx int) (enc encoding.T, buf []byte, err error) {
+	colBlk, err := blk.file.OpenColumn(colIdx)
+	if err != nil {
+		return
+	}
+	enc = colBlk.GetDataEncoding()
+	colBlk.Close()
+	if enc == encoding.Plain {
+		return
+	}
+	dataFile := blk.colFiles[colIdx]
+	stat := dataFile.Stat()
+	buf = make([]byte, stat.Size())
+	if _, err = dataFile.Read(buf); err != nil {
+		return
+	}
+	if stat.CompressAlgo() != compress.None {
+		decompressed := make([]byte, stat.OriginSize())
+		buf, err = compress.Decompress(buf, decompressed, stat.CompressAlgo())
+	}
+	return
+}
+
 func (blk *dataBlock) getVectorWithBuffer(colIdx int, compressed, decompressed *bytes.Buffer) (vec *gvec.Vector, err error) {
+	enc, buf, err := blk.loadEncodedColumn(colIdx)
+	if err != nil || enc != encoding.Plain {
+		if err == nil {
+			vec, err = encoding.Decode(buf, blk.meta.GetSchema().ColDefs[colIdx].Type)
+		}
+		return
+	}
 	dataFile := blk.colFiles[colIdx]
 
 	wrapper := vector.NewEmptyWrapper(blk.meta.GetSchema().ColDefs[colIdx].Type)
@@ -566,6 +689,18 @@ func (blk *dataBlock) getVectorWrapper(colIdx int) (wrapper *vector.VectorWrappe
 
 	wrapper = vector.NewEmptyWrapper(blk.meta.GetSchema().ColDefs[colIdx].Type)
 	wrapper.File = dataFile
+	enc, buf, err := blk.loadEncodedColumn(colIdx)
+	if err != nil {
+		return
+	}
+	if enc != encoding.Plain {
+		var vec *gvec.Vector
+		if vec, err = encoding.Decode(buf, wrapper.Typ); err != nil {
+			return
+		}
+		wrapper.Vector = *vec
+		return
+	}
 	_, err = wrapper.ReadFrom(dataFile)
 	if err != nil {
 		return
@@ -593,16 +728,33 @@ func (blk *dataBlock) blkGetByFilter(ts uint64, filter *handle.Filter) (offset u
 		err = txnbase.ErrNotFound
 		return
 	}
-	pkColumn, err := blk.getVectorWrapper(int(blk.meta.GetSchema().PrimaryKey))
+	pkIdx := int(blk.meta.GetSchema().PrimaryKey)
+	enc, buf, err := blk.loadEncodedColumn(pkIdx)
 	if err != nil {
 		return
 	}
-	defer common.GPool.Free(pkColumn.MNode)
-	data := &pkColumn.Vector
-	offset, exist := compute.CheckRowExists(data, filter.Val, nil)
-	if !exist {
-		err = txnbase.ErrNotFound
-		return
+	if enc != encoding.Plain {
+		// Encoded columns are searched without being decoded
+		var rows *roaring.Bitmap
+		if rows, err = encoding.SearchEq(buf, blk.meta.GetSchema().ColDefs[pkIdx].Type, filter.Val); err != nil {
+			return
+		}
+		if rows.IsEmpty() {
+			err = txnbase.ErrNotFound
+			return
+		}
+		offset = rows.Minimum()
+	} else {
+		var pkColumn *vector.VectorWrapper
+		if pkColumn, err = blk.getVectorWrapper(pkIdx); err != nil {
+			return
+		}
+		defer common.GPool.Free(pkColumn.MNode)
+		var exist bool
+		if offset, exist = compute.CheckRowExists(&pkColumn.Vector, filter.Val, nil); !exist {
+			err = txnbase.ErrNotFound
+			return
+		}
 	}
 
 	readLock := blk.mvcc.GetSharedLock()
@@ -623,6 +775,169 @@ func (blk *dataBlock) GetByFilter(txn txnif.AsyncTxn, filter *handle.Filter) (of
 	return blk.blkGetByFilter(txn.GetStartTS(), filter)
 }
 
+// catchUpSecondaryIndex makes the in-memory secondary index of the column
+// cover the first rows of the block. The index may lag behind the data when
+// it was created after the rows were written.
+func (blk *dataBlock) catchUpSecondaryIndex(colIdx uint16, rows uint32) (err error) {
+	indexed, _ := blk.indexHolder.SecondaryIndexedRows(colIdx)
+	if indexed >= rows {
+		return
+	}
+	var vec *gvec.Vector
+	if blk.meta.IsAppendable() {
+		h := blk.node.mgr.Pin(blk.node)
+		if h == nil {
+			panic("not expected")
+		}
+		defer h.Close()
+		if vec, err = blk.node.GetVectorCopy(rows, int(colIdx), nil, nil); err != nil {
+			return
+		}
+	} else {
+		wrapper, err := blk.getVectorWrapper(int(colIdx))
+		if err != nil {
+			return err
+		}
+		defer common.GPool.Free(wrapper.MNode)
+		vec = &wrapper.Vector
+	}
+	return blk.indexHolder.BatchInsertSecondary(colIdx, vec, indexed, int(rows-indexed), indexed)
+}
+
+func (blk *dataBlock) GetByIndex(txn txnif.AsyncTxn, colIdx uint16, filter *handle.Filter) (rows *roaring.Bitmap, err error) {
+	var lower, upper interface{}
+	switch filter.Op {
+	case handle.FilterEq:
+		lower, upper = filter.Val, filter.Val
+	case handle.FilterBtw:
+		lower, upper = filter.Val, filter.Upper
+	default:
+		panic("logic error")
+	}
+	ts := txn.GetStartTS()
+	phyIdx := blk.physicalColIdx(int(colIdx))
+	if phyIdx < 0 {
+		return blk.getByDefault(ts, int(colIdx), lower, upper)
+	}
+	colIdx = uint16(phyIdx)
+	schema := blk.meta.GetSchema()
+	if schema.GetSecondaryIndex(int(colIdx)) == nil && filter.Op != handle.FilterEq {
+		err = txnbase.ErrNotIndexed
+		return
+	}
+	maxRow := uint32(blk.Rows(nil, true))
+	if blk.meta.IsAppendable() {
+		blk.mvcc.RLock()
+		maxRow, _ = blk.mvcc.GetMaxVisibleRowLocked(ts)
+		blk.mvcc.RUnlock()
+	}
+	if schema.GetSecondaryIndex(int(colIdx)) == nil {
+		if rows, err = blk.searchEncoded(int(colIdx), filter.Val, maxRow); err != nil {
+			return
+		}
+	} else {
+		if err = blk.catchUpSecondaryIndex(colIdx, maxRow); err != nil {
+			return
+		}
+		rows, _ = blk.indexHolder.SearchSecondary(colIdx, lower, upper)
+		if rows == nil {
+			rows = roaring.NewBitmap()
+		}
+	}
+	if blk.meta.IsAppendable() {
+		rows.RemoveRange(uint64(maxRow), uint64(maxRow)+uint64(schema.BlockMaxRows))
+	}
+
+	blk.mvcc.RLock()
+	defer blk.mvcc.RUnlock()
+	chain := blk.mvcc.GetColumnChain(colIdx)
+	chain.RLock()
+	updateMask, updateVals := chain.CollectUpdatesLocked(ts)
+	chain.RUnlock()
+	if updateMask != nil {
+		// The index holds the values before any update
+		rows.AndNot(updateMask)
+		typ := schema.ColDefs[colIdx].Type
+		for row, v := range updateVals {
+			if row >= maxRow {
+				continue
+			}
+			if s, ok := v.(string); ok {
+				v = []byte(s)
+			}
+			if lower != nil && common.CompareGeneric(v, lower, typ) < 0 {
+				continue
+			}
+			if upper != nil && common.CompareGeneric(v, upper, typ) > 0 {
+				continue
+			}
+			rows.Add(row)
+		}
+	}
+	deleteChain := blk.mvcc.GetDeleteChain()
+	deleteChain.RLock()
+	dnode := deleteChain.CollectDeletesLocked(ts, false).(*updates.DeleteNode)
+	deleteChain.RUnlock()
+	if dnode != nil {
+		rows.AndNot(dnode.GetDeleteMaskLocked())
+	}
+	return
+}
+
+// searchEncoded searches an equality on a column without secondary index.
+// The encoded columns of the persisted blocks are searched on their
+// dictionary codes, runs or offsets without being decoded. The rows of the
+// other blocks cannot be pruned, so all of them are returned.
+func (blk *dataBlock) searchEncoded(colIdx int, val interface{}, maxRow uint32) (rows *roaring.Bitmap, err error) {
+	if !blk.meta.IsAppendable() {
+		enc, buf, err := blk.loadEncodedColumn(colIdx)
+		if err != nil {
+			return nil, err
+		}
+		if enc != encoding.Plain {
+			return encoding.SearchEq(buf, blk.meta.GetSchema().ColDefs[colIdx].Type, val)
+		}
+	}
+	rows = roaring.NewBitmap()
+	rows.AddRange(0, uint64(maxRow))
+	return
+}
+
+// getByDefault searches a column added after the block is created, in which
+// either all the rows or none of them match
+func (blk *dataBlock) getByDefault(ts uint64, colIdx int, lower, upper interface{}) (rows *roaring.Bitmap, err error) {
+	rows = roaring.NewBitmap()
+	def := blk.meta.GetSegment().GetTable().GetSchema().ColDefs[colIdx]
+	v := def.Default
+	if v == nil {
+		return
+	}
+	if s, ok := v.(string); ok {
+		v = []byte(s)
+	}
+	if lower != nil && common.CompareGeneric(v, lower, def.Type) < 0 {
+		return
+	}
+	if upper != nil && common.CompareGeneric(v, upper, def.Type) > 0 {
+		return
+	}
+	maxRow := uint32(blk.Rows(nil, true))
+	blk.mvcc.RLock()
+	defer blk.mvcc.RUnlock()
+	if blk.meta.IsAppendable() {
+		maxRow, _ = blk.mvcc.GetMaxVisibleRowLocked(ts)
+	}
+	rows.AddRange(0, uint64(maxRow))
+	deleteChain := blk.mvcc.GetDeleteChain()
+	deleteChain.RLock()
+	dnode := deleteChain.CollectDeletesLocked(ts, false).(*updates.DeleteNode)
+	deleteChain.RUnlock()
+	if dnode != nil {
+		rows.AndNot(dnode.GetDeleteMaskLocked())
+	}
+	return
+}
+
 func (blk *dataBlock) BatchDedup(txn txnif.AsyncTxn, pks *gvec.Vector) (err error) {
 	if blk.meta.IsAppendable() {
 		readLock := blk.mvcc.GetSharedLock()
@@ -40,6 +40,7 @@ type compactBlockTask struct {
 	compacted handle.Block
 	created   handle.Block
 	meta      *catalog.BlockEntry
+	schema    *catalog.Schema
 	scheduler tasks.TaskScheduler
 	scopes    []common.ID
 }
@@ -48,6 +49,7 @@ func NewCompactBlockTask(ctx *tasks.Context, txn txnif.AsyncTxn, meta *catalog.B
 	task = &compactBlockTask{
 		txn:       txn,
 		meta:      meta,
+		schema:    meta.GetSegment().GetTable().GetSchema(),
 		scheduler: scheduler,
 	}
 	dbName := meta.GetSegment().GetTable().GetDB().GetName()
@@ -76,10 +78,12 @@ func NewCompactBlockTask(ctx *tasks.Context, txn txnif.AsyncTxn, meta *catalog.B
 func (task *compactBlockTask) Scopes() []common.ID { return task.scopes }
 
 func (task *compactBlockTask) PrepareData() (bat *batch.Batch, err error) {
-	attrs := task.meta.GetSchema().Attrs()
+	// The block is rewritten with the table schema, which drops the columns
+	// dropped after the block is created
+	attrs := task.schema.Attrs()
 	bat = batch.New(true, attrs)
 
-	for i := range task.meta.GetSchema().ColDefs {
+	for i := range task.schema.ColDefs {
 		view, err := task.compacted.GetColumnDataById(i, nil, nil)
 		if err != nil {
 			return bat, err
@@ -87,7 +91,7 @@ func (task *compactBlockTask) PrepareData() (bat *batch.Batch, err error) {
 		vec := view.ApplyDeletes()
 		bat.Vecs[i] = vec
 	}
-	if err = mergesort.SortBlockColumns(bat.Vecs, int(task.meta.GetSchema().PrimaryKey)); err != nil {
+	if err = mergesort.SortBlockColumns(bat.Vecs, int(task.schema.PrimaryKey)); err != nil {
 		return
 	}
 	return
@@ -111,6 +115,9 @@ func (task *compactBlockTask) Execute() (err error) {
 		return err
 	}
 	newMeta := newBlk.GetMeta().(*catalog.BlockEntry)
+	if newMeta.GetSchemaVersion() != task.schema.Version {
+		return catalog.ErrSchemaChanged
+	}
 	newBlkData := newMeta.GetBlockData()
 	blockFile := newBlkData.GetBlockFile()
 
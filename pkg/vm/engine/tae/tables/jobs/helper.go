@@ -22,10 +22,14 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index/io"
 )
 
-func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumnData *vector.Vector) (err error) {
+// BuildAndFlushBlockIndex writes the indexes of a block. data holds the
+// column vectors of the block by column index, of which only the primary
+// key and the secondary indexed columns are needed.
+func BuildAndFlushBlockIndex(blkFile file.Block, meta *catalog.BlockEntry, data []*vector.Vector) (err error) {
 	// write indexes, collect their meta, and refresh host's index holder
 	schema := meta.GetSchema()
-	pkColumn, err := file.OpenColumn(int(schema.PrimaryKey))
+	pkColumnData := data[schema.PrimaryKey]
+	pkColumn, err := blkFile.OpenColumn(int(schema.PrimaryKey))
 	if err != nil {
 		return
 	}
@@ -70,12 +74,40 @@ func BuildAndFlushBlockIndex(file file.Block, meta *catalog.BlockEntry, pkColumn
 		return err
 	}
 	metas.AddIndex(*sfMeta)
+
+	for _, colIdx := range schema.SecondaryIndexCols() {
+		colFile, err := blkFile.OpenColumn(colIdx)
+		if err != nil {
+			return err
+		}
+		idxFile, err := colFile.OpenIndexFile(0)
+		colFile.Close()
+		if err == file.ErrInvalidParam {
+			// The index was created after the block file was opened. It
+			// will be built in memory on demand.
+			continue
+		} else if err != nil {
+			return err
+		}
+		secondaryWriter := io.NewBlockSecondaryIndexWriter()
+		if err = secondaryWriter.Init(idxFile, idxCommon.Plain, uint16(colIdx), 0); err != nil {
+			return err
+		}
+		if err = secondaryWriter.AddValues(data[colIdx]); err != nil {
+			return err
+		}
+		secondaryMeta, err := secondaryWriter.Finalize()
+		if err != nil {
+			return err
+		}
+		metas.AddIndex(*secondaryMeta)
+	}
 	metaBuf, err := metas.Marshal()
 	if err != nil {
 		return err
 	}
 
-	err = file.WriteIndexMeta(metaBuf)
+	err = blkFile.WriteIndexMeta(metaBuf)
 	if err != nil {
 		return err
 	}
@@ -134,7 +134,9 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		}
 	}
 
-	schema := task.mergedBlks[0].GetSchema()
+	// The blocks are merged with the table schema, which drops the columns
+	// dropped after the blocks are created
+	schema := task.mergedBlks[0].GetSegment().GetTable().GetSchema()
 	var view *model.ColumnView
 	vecs := make([]*vector.Vector, 0)
 	rows := make([]uint32, len(task.compacted))
@@ -177,7 +179,9 @@ func (task *mergeBlocksTask) Execute() (err error) {
 	length = 0
 	var blk handle.Block
 	toAddr := make([]uint32, 0, len(vecs))
-	for _, vec := range vecs {
+	// Columns needed by the indexes of each created block
+	idxData := make([][]*vector.Vector, len(vecs))
+	for pos, vec := range vecs {
 		toAddr = append(toAddr, uint32(length))
 		length += gvec.Length(vec)
 		blk, err = toSegEntry.CreateNonAppendableBlock()
@@ -186,6 +190,9 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		}
 		task.createdBlks = append(task.createdBlks, blk.GetMeta().(*catalog.BlockEntry))
 		meta := blk.GetMeta().(*catalog.BlockEntry)
+		if meta.GetSchemaVersion() != schema.Version {
+			return catalog.ErrSchemaChanged
+		}
 		closure := meta.GetBlockData().FlushColumnDataClosure(ts, int(schema.PrimaryKey), vec, false)
 		flushTask, err = task.scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.IOTask, meta.AsCommonID(), closure)
 		if err != nil {
@@ -194,12 +201,8 @@ func (task *mergeBlocksTask) Execute() (err error) {
 		if err = flushTask.WaitDone(); err != nil {
 			return
 		}
-		if err = BuildAndFlushBlockIndex(meta.GetBlockData().GetBlockFile(), meta, vec); err != nil {
-			return
-		}
-		if err = meta.GetBlockData().ReplayData(); err != nil {
-			return
-		}
+		idxData[pos] = make([]*vector.Vector, len(schema.ColDefs))
+		idxData[pos][schema.PrimaryKey] = vec
 		// bf := blk.GetMeta().(*catalog.BlockEntry).GetBlockData().GetBlockFile()
 		// if bf.WriteColumnVec(task.txn.GetStartTS(), int(schema.PrimaryKey), vec); err != nil {
 		// 	return
@@ -219,7 +222,11 @@ func (task *mergeBlocksTask) Execute() (err error) {
 			vecs = append(vecs, vec)
 		}
 		vecs, _ = task.mergeColumn(vecs, &sortedIdx, false, rows, to)
+		indexed := schema.GetSecondaryIndex(i) != nil
 		for pos, vec := range vecs {
+			if indexed {
+				idxData[pos][i] = vec
+			}
 			blk := task.createdBlks[pos]
 			closure := blk.GetBlockData().FlushColumnDataClosure(ts, i, vec, false)
 			flushTask, err = task.scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.IOTask, blk.AsCommonID(), closure)
@@ -231,8 +238,16 @@ func (task *mergeBlocksTask) Execute() (err error) {
 			}
 		}
 	}
+	for pos, blk := range task.createdBlks {
+		if err = BuildAndFlushBlockIndex(blk.GetBlockData().GetBlockFile(), blk, idxData[pos]); err != nil {
+			return
+		}
+		if err = blk.GetBlockData().ReplayData(); err != nil {
+			return
+		}
+	}
 	for i, blk := range task.createdBlks {
-		closure := blk.GetBlockData().SyncBlockDataClosure(ts, rows[i])
+		closure := blk.GetBlockData().SyncBlockDataClosure(ts, to[i])
 		flushTask, err = task.scheduler.ScheduleScopedFn(tasks.WaitableCtx, tasks.IOTask, blk.AsCommonID(), closure)
 		if err != nil {
 			return
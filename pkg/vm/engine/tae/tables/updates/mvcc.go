@@ -202,12 +202,14 @@ func (n *MVCCHandle) AddAppendNodeLocked(txn txnif.AsyncTxn, maxRow uint32) *App
 	return an
 }
 
+// IsVisibleLocked checks the row was appended before ts. The max visible row
+// is the number of the rows visible at ts, the row at it is appended later.
 func (n *MVCCHandle) IsVisibleLocked(row uint32, ts uint64) bool {
 	maxRow, ok := n.GetMaxVisibleRowLocked(ts)
 	if !ok {
 		return ok
 	}
-	return maxRow >= row
+	return row < maxRow
 }
 
 func (n *MVCCHandle) IsDeletedLocked(row uint32, ts uint64) bool {
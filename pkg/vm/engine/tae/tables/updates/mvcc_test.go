@@ -50,3 +50,20 @@ func TestMutationControllerAppend(t *testing.T) {
 	}
 	t.Logf("%s -- %d ops", time.Since(st), len(queries))
 }
+
+func TestMutationControllerIsVisible(t *testing.T) {
+	mc := NewMVCCHandle(nil)
+	for i, ts := range []uint64{2, 4} {
+		txn := mockTxn()
+		txn.CommitTS = ts
+		node := mc.AddAppendNodeLocked(txn, 5*(uint32(i)+1))
+		node.ApplyCommit(nil)
+	}
+
+	assert.False(t, mc.IsVisibleLocked(0, 1))
+	assert.True(t, mc.IsVisibleLocked(4, 3))
+	// The first row appended at ts 4 is not visible at ts 3
+	assert.False(t, mc.IsVisibleLocked(5, 3))
+	assert.True(t, mc.IsVisibleLocked(9, 5))
+	assert.False(t, mc.IsVisibleLocked(10, 5))
+}
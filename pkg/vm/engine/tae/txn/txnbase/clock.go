@@ -0,0 +1,105 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package txnbase
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// TsClock maps the wall clock time to the timestamps allocated by the txn manager,
+// so the data can be read as of a time in the past. Only the time inside the
+// retention window is mapped, and the snapshot reads are disabled if the window is 0.
+type TsClock struct {
+	sync.RWMutex
+	window time.Duration
+	// samples are the timestamps sorted by time, at most one sample per millisecond
+	samples []tsSample
+}
+
+type tsSample struct {
+	ms int64 // unix milliseconds
+	ts uint64
+}
+
+func NewTsClock(window time.Duration) *TsClock {
+	return &TsClock{window: window}
+}
+
+func (c *TsClock) GetWindow() time.Duration { return c.window }
+func (c *TsClock) IsEnabled() bool          { return c.window > 0 }
+
+// Record records the timestamp allocated at now. The timestamp must not be the commit
+// timestamp of any txn, otherwise the entries dropped at it are visible to the snapshot.
+func (c *TsClock) Record(now time.Time, ts uint64) {
+	ms := now.UnixMilli()
+	c.Lock()
+	defer c.Unlock()
+	if n := len(c.samples); n > 0 && c.samples[n-1].ms >= ms {
+		c.samples[n-1].ts = ts
+	} else {
+		c.samples = append(c.samples, tsSample{ms: ms, ts: ts})
+	}
+	// the latest sample before the window is kept to map the start of the window
+	if i := c.searchLocked(ms - c.window.Milliseconds()); i > 1 {
+		c.samples = c.samples[i-1:]
+	}
+}
+
+// TsAt returns the timestamp to read the data as of t
+func (c *TsClock) TsAt(t, now time.Time) (uint64, error) {
+	if !c.IsEnabled() {
+		return 0, ErrSnapshotDisabled
+	}
+	if t.After(now) {
+		return 0, ErrSnapshotInFuture
+	}
+	if t.Before(now.Add(-c.window)) {
+		return 0, ErrSnapshotTooOld
+	}
+	c.RLock()
+	defer c.RUnlock()
+	i := c.searchLocked(t.UnixMilli())
+	if i == 0 {
+		return 0, ErrSnapshotTooOld
+	}
+	return c.samples[i-1].ts, nil
+}
+
+// RetainedTS returns the oldest timestamp which can be read by the snapshots,
+// the entries dropped after it must be kept
+func (c *TsClock) RetainedTS(now time.Time) uint64 {
+	if !c.IsEnabled() {
+		return math.MaxUint64
+	}
+	c.RLock()
+	defer c.RUnlock()
+	if len(c.samples) == 0 {
+		return 0
+	}
+	if i := c.searchLocked(now.Add(-c.window).UnixMilli()); i > 0 {
+		return c.samples[i-1].ts
+	}
+	return c.samples[0].ts
+}
+
+// searchLocked returns the index of the first sample after ms
+func (c *TsClock) searchLocked(ms int64) int {
+	return sort.Search(len(c.samples), func(i int) bool {
+		return c.samples[i].ms > ms
+	})
+}
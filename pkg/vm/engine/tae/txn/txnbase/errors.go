@@ -26,6 +26,16 @@ var (
 
 	ErrNotFound   = errors.New("tae: not found")
 	ErrDuplicated = errors.New("tae: duplicated ")
+	ErrNotIndexed = errors.New("tae: column not indexed")
 
-	ErrDDLDropCreated = errors.New("tae: DDL cannot drop created in a txn")
+	ErrDDLDropCreated  = errors.New("tae: DDL cannot drop created in a txn")
+	ErrDDLAlterWritten = errors.New("tae: DDL cannot alter a table written in a txn")
+
+	ErrSchemaMismatch        = errors.New("tae: rows do not match the table schema")
+	ErrColumnNotMaterialized = errors.New("tae: column is not materialized in the block")
+
+	ErrSnapshotDisabled    = errors.New("tae: snapshot reads are disabled without the retention window")
+	ErrSnapshotTooOld      = errors.New("tae: snapshot is out of the retention window")
+	ErrSnapshotInFuture    = errors.New("tae: snapshot is in the future")
+	ErrTxnSnapshotReadonly = errors.New("tae: snapshot txn is read only")
 )
@@ -64,6 +64,7 @@ func (rel *TxnRelation) Rows() int64
 func (rel *TxnRelation) Size(attr string) int64                                               { return 0 }
 func (rel *TxnRelation) GetCardinality(attr string) int64                                     { return 0 }
 func (rel *TxnRelation) Schema() interface{}                                                  { return nil }
+func (rel *TxnRelation) GetRange(attr string) (min, max interface{}, ok bool)                 { return }
 func (rel *TxnRelation) MakeSegmentIt() handle.SegmentIt                                      { return nil }
 func (rel *TxnRelation) MakeBlockIt() handle.BlockIt                                          { return nil }
 func (rel *TxnRelation) MakeReader() handle.Reader                                            { return nil }
@@ -78,6 +79,11 @@ func (rel *TxnRelation) GetValue(*common.ID, uint32, uint16) (v interface{}, err
 func (rel *TxnRelation) Update(*common.ID, uint32, uint16, interface{}) (err error)           { return }
 func (rel *TxnRelation) RangeDelete(*common.ID, uint32, uint32) (err error)                   { return }
 func (rel *TxnRelation) GetByFilter(*handle.Filter) (id *common.ID, offset uint32, err error) { return }
+func (rel *TxnRelation) GetByIndex(uint16, *handle.Filter) (hits map[common.ID]*roaring.Bitmap, err error) {
+	return
+}
+func (rel *TxnRelation) CreateIndex(def interface{}) (err error) { return }
+func (rel *TxnRelation) Alter(req interface{}) (err error)       { return }
 func (rel *TxnRelation) LogTxnEntry(entry txnif.TxnEntry, readed []*common.ID) (err error) {
 	return
 }
@@ -15,6 +15,7 @@
 package txnbase
 
 import (
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -68,6 +69,11 @@ func (store *NoopTxnStore) RangeDelete(*common.ID, uint32, uint32) (err error)
 func (store *NoopTxnStore) GetByFilter(uint64, *handle.Filter) (id *common.ID, offset uint32, err error) {
 	return
 }
+func (store *NoopTxnStore) GetByIndex(uint64, uint16, *handle.Filter) (hits map[common.ID]*roaring.Bitmap, err error) {
+	return
+}
+func (store *NoopTxnStore) CreateIndex(uint64, interface{}) (err error) { return }
+func (store *NoopTxnStore) Alter(uint64, interface{}) (err error)       { return }
 func (store *NoopTxnStore) GetValue(*common.ID, uint32, uint16) (v interface{}, err error) {
 	return
 }
@@ -81,6 +81,12 @@ func (txn *Txn) Commit() error {
 		txn.Mgr.DeleteTxn(txn.GetID())
 		return nil
 	}
+	if txn.Mgr.IsSnapshotTxn(txn.GetID()) {
+		if err := txn.Rollback(); err != nil {
+			return err
+		}
+		return ErrTxnSnapshotReadonly
+	}
 	txn.Add(1)
 	txn.Mgr.OnOpTxn(&OpTxn{
 		Txn: txn,
@@ -15,6 +15,7 @@
 package txnbase
 
 import (
+	"math"
 	"sync"
 	"time"
 
@@ -37,6 +38,10 @@ type TxnManager struct {
 	TxnStoreFactory  TxnStoreFactory
 	TxnFactory       TxnFactory
 	ActiveMask       *roaring64.Bitmap
+	// Snapshots maps the ids of the active snapshot txns to their start ts, the
+	// snapshot txns are not in the ActiveMask as their start ts is in the past
+	Snapshots map[uint64]uint64
+	Clock     *TsClock
 }
 
 func NewTxnManager(txnStoreFactory TxnStoreFactory, txnFactory TxnFactory) *TxnManager {
@@ -50,6 +55,8 @@ func NewTxnManager(txnStoreFactory TxnStoreFactory, txnFactory TxnFactory) *TxnM
 		TxnStoreFactory: txnStoreFactory,
 		TxnFactory:      txnFactory,
 		ActiveMask:      roaring64.New(),
+		Snapshots:       make(map[uint64]uint64),
+		Clock:           NewTsClock(0),
 	}
 	pqueue := sm.NewSafeQueue(20000, 1000, mgr.onPreparing)
 	cqueue := sm.NewSafeQueue(20000, 1000, mgr.onCommit)
@@ -63,6 +70,16 @@ func (mgr *TxnManager) Init(prevTxnId uint64, prevTs uint64) error {
 	return nil
 }
 
+// SetRetentionWindow enables the snapshot reads as of the time inside the window
+func (mgr *TxnManager) SetRetentionWindow(window time.Duration) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	mgr.Clock = NewTsClock(window)
+	if mgr.Clock.IsEnabled() {
+		mgr.Clock.Record(time.Now(), mgr.TsAlloc.Alloc())
+	}
+}
+
 func (mgr *TxnManager) StatActiveTxnCnt() int {
 	mgr.RLock()
 	defer mgr.RUnlock()
@@ -94,11 +111,53 @@ func (mgr *TxnManager) StartTxn(info []byte) txnif.AsyncTxn {
 	return txn
 }
 
+// StartSnapshotTxn starts a read only txn which reads the data as of ts
+func (mgr *TxnManager) StartSnapshotTxn(info []byte, ts time.Time) (txnif.AsyncTxn, error) {
+	mgr.Lock()
+	defer mgr.Unlock()
+	startTs, err := mgr.Clock.TsAt(ts, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	txnId := mgr.IdAlloc.Alloc()
+
+	store := mgr.TxnStoreFactory()
+	txn := mgr.TxnFactory(mgr, store, txnId, startTs, info)
+	store.BindTxn(txn)
+	mgr.Active[txnId] = txn
+	mgr.Snapshots[txnId] = startTs
+	return txn, nil
+}
+
+func (mgr *TxnManager) IsSnapshotTxn(id uint64) bool {
+	mgr.RLock()
+	defer mgr.RUnlock()
+	_, ok := mgr.Snapshots[id]
+	return ok
+}
+
+// StatSnapshotTS returns the min start ts of the active snapshot txns
+func (mgr *TxnManager) StatSnapshotTS() (ts uint64) {
+	ts = math.MaxUint64
+	mgr.RLock()
+	for _, startTs := range mgr.Snapshots {
+		if startTs < ts {
+			ts = startTs
+		}
+	}
+	mgr.RUnlock()
+	return
+}
+
 func (mgr *TxnManager) DeleteTxn(id uint64) {
 	mgr.Lock()
 	defer mgr.Unlock()
 	txn := mgr.Active[id]
 	delete(mgr.Active, id)
+	if _, ok := mgr.Snapshots[id]; ok {
+		delete(mgr.Snapshots, id)
+		return
+	}
 	mgr.ActiveMask.Remove(txn.GetStartTS())
 }
 
@@ -146,6 +205,10 @@ func (mgr *TxnManager) onPreparing(items ...interface{}) {
 			op.Txn.ToRollbackingLocked(ts)
 		}
 		op.Txn.Unlock()
+		if op.Op == OpCommit && mgr.Clock.IsEnabled() {
+			// a snapshot as of now reads the data like a txn started right after the commit
+			mgr.Clock.Record(time.Now(), mgr.TsAlloc.Alloc())
+		}
 		mgr.Unlock()
 		if op.Op == OpCommit {
 			mgr.onPreparCommit(op.Txn)
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/RoaringBitmap/roaring"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/container/compute"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
@@ -266,6 +267,28 @@ func TestReplay(t *testing.T) {
 	assert.Nil(t, err)
 	e.WaitDone()
 
+	alter, err := tb.AlterTable(txn1, catalog.NewAddColumnReq("added", types.Type{Oid: types.T_int64, Size: 8}, int64(7)))
+	assert.Nil(t, err)
+	assert.Nil(t, alter.PrepareCommit())
+	cmdMgr = newCommandManager(driver)
+	cmd, err = alter.MakeCommand(0)
+	assert.Nil(t, err)
+	cmdMgr.AddCmd(cmd)
+	e, err = cmdMgr.ApplyTxnRecord()
+	assert.Nil(t, err)
+	e.WaitDone()
+
+	altered, err := seg.CreateBlock(txn1, 0, nil)
+	assert.Nil(t, err)
+	altered.PrepareCommit()
+	cmdMgr = newCommandManager(driver)
+	cmd, err = altered.MakeCommand(0)
+	assert.Nil(t, err)
+	cmdMgr.AddCmd(cmd)
+	e, err = cmdMgr.ApplyTxnRecord()
+	assert.Nil(t, err)
+	e.WaitDone()
+
 	blkToDrop, err := seg.CreateBlock(txn1, 0, nil)
 	assert.Nil(t, err)
 	blkToDrop.PrepareCommit()
@@ -321,8 +344,16 @@ func TestReplay(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, segToDrop2.IsDroppedCommitted())
 
-	_, err = seg2.GetBlockEntryByID(blk.ID)
+	blk2, err := seg2.GetBlockEntryByID(blk.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(blk2.GetSchema().ColDefs))
+
+	assert.Equal(t, uint32(1), tb2.GetSchema().Version)
+	assert.Equal(t, int64(7), tb2.GetSchema().ColDefs[1].Default)
+	altered2, err := seg2.GetBlockEntryByID(altered.ID)
 	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), altered2.GetSchemaVersion())
+	assert.Equal(t, 2, len(altered2.GetSchema().ColDefs))
 
 	blkToDrop2, err := seg2.GetBlockEntryByID(blkToDrop.ID)
 	assert.Nil(t, err)
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
@@ -116,11 +117,59 @@ func (h *txnRelation) SimplePPString(level common.PPLevel) string {
 func (h *txnRelation) GetMeta() interface{}   { return h.entry }
 func (h *txnRelation) GetSchema() interface{} { return h.entry.GetSchema() }
 
-func (h *txnRelation) Close() error                     { return nil }
-func (h *txnRelation) Rows() int64                      { return 0 }
-func (h *txnRelation) Size(attr string) int64           { return 0 }
-func (h *txnRelation) GetCardinality(attr string) int64 { return 0 }
-func (h *txnRelation) MakeReader() handle.Reader        { return nil }
+func (h *txnRelation) Close() error              { return nil }
+func (h *txnRelation) Size(attr string) int64    { return 0 }
+func (h *txnRelation) MakeReader() handle.Reader { return nil }
+
+// Rows returns the rows of the blocks visible to the txn, the deleted rows
+// are counted as well
+func (h *txnRelation) Rows() int64 {
+	rows := int64(0)
+	it := h.MakeBlockIt()
+	for it.Valid() {
+		block := it.GetBlock().GetMeta().(*catalog.BlockEntry).GetBlockData()
+		rows += int64(block.Rows(h.Txn, true))
+		it.Next()
+	}
+	return rows
+}
+
+// GetCardinality returns the distinct values of the column. Only the primary
+// key is known, whose values are all distinct, others return 0.
+func (h *txnRelation) GetCardinality(attr string) int64 {
+	schema := h.entry.GetSchema()
+	if schema.GetColIdx(attr) != int(schema.PrimaryKey) {
+		return 0
+	}
+	return h.Rows()
+}
+
+// GetRange merges the zonemaps of the blocks visible to the txn. Only the
+// primary key has zonemaps, others return false.
+func (h *txnRelation) GetRange(attr string) (min, max interface{}, ok bool) {
+	schema := h.entry.GetSchema()
+	if schema.GetColIdx(attr) != int(schema.PrimaryKey) {
+		return
+	}
+	typ := schema.GetPKType()
+	it := h.MakeBlockIt()
+	for it.Valid() {
+		block := it.GetBlock().GetMeta().(*catalog.BlockEntry).GetBlockData()
+		it.Next()
+		lower, upper, exist := block.GetPKRange()
+		if !exist {
+			continue
+		}
+		if !ok || common.CompareGeneric(lower, min, typ) < 0 {
+			min = lower
+		}
+		if !ok || common.CompareGeneric(upper, max, typ) > 0 {
+			max = upper
+		}
+		ok = true
+	}
+	return
+}
 
 func (h *txnRelation) BatchDedup(col *vector.Vector) error {
 	return h.Txn.GetStore().BatchDedup(h.entry.GetID(), col)
@@ -162,6 +211,18 @@ func (h *txnRelation) GetByFilter(filter *handle.Filter) (*common.ID, uint32, er
 	return h.Txn.GetStore().GetByFilter(h.entry.GetID(), filter)
 }
 
+func (h *txnRelation) GetByIndex(col uint16, filter *handle.Filter) (map[common.ID]*roaring.Bitmap, error) {
+	return h.Txn.GetStore().GetByIndex(h.entry.GetID(), col, filter)
+}
+
+func (h *txnRelation) CreateIndex(def interface{}) error {
+	return h.Txn.GetStore().CreateIndex(h.entry.GetID(), def)
+}
+
+func (h *txnRelation) Alter(req interface{}) error {
+	return h.Txn.GetStore().Alter(h.entry.GetID(), req)
+}
+
 func (h *txnRelation) Update(id *common.ID, row uint32, col uint16, v interface{}) error {
 	return h.Txn.GetStore().Update(id, row, col, v)
 }
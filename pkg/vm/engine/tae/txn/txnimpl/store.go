@@ -18,6 +18,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -167,6 +168,42 @@ func (store *txnStore) GetByFilter(tid uint64, filter *handle.Filter) (id *commo
 	return table.GetByFilter(filter)
 }
 
+func (store *txnStore) GetByIndex(tid uint64, col uint16, filter *handle.Filter) (hits map[common.ID]*roaring.Bitmap, err error) {
+	table, err := store.getOrSetTable(tid)
+	if err != nil {
+		return
+	}
+	if table.IsDeleted() {
+		err = txnbase.ErrNotFound
+		return
+	}
+	return table.GetByIndex(col, filter)
+}
+
+func (store *txnStore) CreateIndex(tid uint64, def interface{}) (err error) {
+	store.IncreateWriteCnt()
+	table, err := store.getOrSetTable(tid)
+	if err != nil {
+		return
+	}
+	if table.IsDeleted() {
+		return txnbase.ErrNotFound
+	}
+	return table.CreateIndex(def.(*catalog.IndexInfo))
+}
+
+func (store *txnStore) Alter(tid uint64, req interface{}) (err error) {
+	store.IncreateWriteCnt()
+	table, err := store.getOrSetTable(tid)
+	if err != nil {
+		return
+	}
+	if table.IsDeleted() {
+		return txnbase.ErrNotFound
+	}
+	return table.Alter(req.(*catalog.AlterReq))
+}
+
 func (store *txnStore) GetValue(id *common.ID, row uint32, colIdx uint16) (v interface{}, err error) {
 	table, err := store.getOrSetTable(id.TableID)
 	if err != nil {
@@ -448,6 +485,11 @@ func (store *txnStore) ApplyCommit() (err error) {
 }
 
 func (store *txnStore) PreCommit() (err error) {
+	for _, table := range store.tables {
+		if err = table.CheckSchema(); err != nil {
+			return
+		}
+	}
 	for _, table := range store.tables {
 		if err = table.PreCommitDededup(); err != nil {
 			return
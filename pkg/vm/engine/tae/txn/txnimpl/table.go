@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/RoaringBitmap/roaring"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	gbat "github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -77,6 +78,10 @@ type Table interface {
 
 	GetValue(id *common.ID, row uint32, col uint16) (interface{}, error)
 	GetByFilter(*handle.Filter) (id *common.ID, offset uint32, err error)
+	GetByIndex(col uint16, filter *handle.Filter) (map[common.ID]*roaring.Bitmap, error)
+	CreateIndex(info *catalog.IndexInfo) error
+	Alter(req *catalog.AlterReq) error
+	CheckSchema() error
 	GetSegment(id uint64) (handle.Segment, error)
 	CreateSegment() (handle.Segment, error)
 	CreateNonAppendableSegment() (handle.Segment, error)
@@ -107,6 +112,9 @@ type txnTable struct {
 	logs        []wal.LogEntry
 	maxSegId    uint64
 	maxBlkId    uint64
+	// schema is the schema the rows of the txn are written with
+	schema     *catalog.Schema
+	alterEntry *catalog.AlterEntry
 
 	txnEntries []txnif.TxnEntry
 	csnStart   uint32
@@ -403,6 +411,13 @@ func (tbl *txnTable) AddUpdateNode(node txnif.UpdateNode) error {
 }
 
 func (tbl *txnTable) Append(data *batch.Batch) error {
+	if tbl.alterEntry != nil {
+		return txnbase.ErrDDLAlterWritten
+	}
+	tbl.pinSchema()
+	if len(data.Vecs) != len(tbl.schema.ColDefs) {
+		return txnbase.ErrSchemaMismatch
+	}
 	err := tbl.BatchDedup(data.Vecs[tbl.entry.GetSchema().PrimaryKey])
 	if err != nil {
 		return err
@@ -583,6 +598,113 @@ func (tbl *txnTable) GetByFilter(filter *handle.Filter) (id *common.ID, offset u
 	return
 }
 
+// GetByIndex collects the rows matching the filter on a secondary indexed
+// column, or the equality on a column whose blocks may be encoded. Rows of
+// the txn local segment are keyed by its local id.
+func (tbl *txnTable) GetByIndex(col uint16, filter *handle.Filter) (hits map[common.ID]*roaring.Bitmap, err error) {
+	schema := tbl.entry.GetSchema()
+	if schema.GetSecondaryIndex(int(col)) == nil && filter.Op != handle.FilterEq {
+		err = txnbase.ErrNotIndexed
+		return
+	}
+	hits = make(map[common.ID]*roaring.Bitmap)
+	if rows, err := tbl.getLocalByIndex(col, filter); err != nil {
+		return nil, err
+	} else if !rows.IsEmpty() {
+		id := common.ID{PartID: 1, TableID: tbl.entry.ID}
+		hits[id] = rows
+	}
+	blockIt := tbl.handle.MakeBlockIt()
+	for blockIt.Valid() {
+		h := blockIt.GetBlock()
+		block := h.GetMeta().(*catalog.BlockEntry).GetBlockData()
+		rows, err := block.GetByIndex(tbl.store.txn, col, filter)
+		if err != nil {
+			return nil, err
+		}
+		if !rows.IsEmpty() {
+			hits[*h.Fingerprint()] = rows
+		}
+		blockIt.Next()
+	}
+	return
+}
+
+func (tbl *txnTable) getLocalByIndex(col uint16, filter *handle.Filter) (rows *roaring.Bitmap, err error) {
+	rows = roaring.NewBitmap()
+	typ := tbl.entry.GetSchema().ColDefs[col].Type
+	lower, upper := filter.Val, filter.Val
+	if filter.Op == handle.FilterBtw {
+		upper = filter.Upper
+	}
+	for row := uint32(0); row < tbl.Rows(); row++ {
+		if tbl.IsLocalDeleted(row) {
+			continue
+		}
+		v, err := tbl.GetLocalValue(row, col)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			continue
+		}
+		if s, ok := v.(string); ok {
+			v = []byte(s)
+		}
+		if lower != nil && common.CompareGeneric(v, lower, typ) < 0 {
+			continue
+		}
+		if upper != nil && common.CompareGeneric(v, upper, typ) > 0 {
+			continue
+		}
+		rows.Add(row)
+	}
+	return
+}
+
+func (tbl *txnTable) CreateIndex(info *catalog.IndexInfo) (err error) {
+	txnEntry, err := tbl.entry.CreateIndex(tbl.store.txn, info)
+	if err != nil {
+		return
+	}
+	tbl.txnEntries = append(tbl.txnEntries, txnEntry)
+	tbl.store.warChecker.ReadTable(tbl.entry.AsCommonID())
+	return
+}
+
+func (tbl *txnTable) Alter(req *catalog.AlterReq) (err error) {
+	if tbl.schema != nil || tbl.createEntry != nil {
+		return txnbase.ErrDDLAlterWritten
+	}
+	if tbl.alterEntry != nil {
+		return tbl.alterEntry.Apply(req)
+	}
+	txnEntry, err := tbl.entry.AlterTable(tbl.store.txn, req)
+	if err != nil {
+		return
+	}
+	tbl.alterEntry = txnEntry
+	tbl.txnEntries = append(tbl.txnEntries, txnEntry)
+	tbl.store.warChecker.ReadTable(tbl.entry.AsCommonID())
+	return
+}
+
+// pinSchema remembers the schema the txn writes the rows with
+func (tbl *txnTable) pinSchema() {
+	if tbl.schema == nil {
+		tbl.schema = tbl.entry.GetSchema()
+	}
+}
+
+// CheckSchema fails the txn if the table is altered after the txn wrote
+// the rows with the schema
+func (tbl *txnTable) CheckSchema() error {
+	if tbl.schema != nil && tbl.schema != tbl.entry.GetSchema() {
+		return catalog.ErrSchemaChanged
+	}
+	return nil
+}
+
 func (tbl *txnTable) GetValue(id *common.ID, row uint32, col uint16) (v interface{}, err error) {
 	if id.PartID != 0 {
 		return tbl.GetLocalValue(row, col)
@@ -613,6 +735,10 @@ func (tbl *txnTable) updateWithFineLock(node txnif.UpdateNode, txn txnif.AsyncTx
 }
 
 func (tbl *txnTable) Update(inode uint32, segmentId, blockId uint64, row uint32, col uint16, v interface{}) (err error) {
+	if tbl.alterEntry != nil {
+		return txnbase.ErrDDLAlterWritten
+	}
+	tbl.pinSchema()
 	if inode != 0 {
 		return tbl.UpdateLocalValue(row, col, v)
 	}
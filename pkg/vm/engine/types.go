@@ -16,6 +16,9 @@ package engine
 
 import (
 	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -65,6 +68,22 @@ type Property struct {
 	Value string
 }
 
+// CompressionLevelProperty is the table property holding the level of the
+// table's compression algorithm
+const CompressionLevelProperty = "compression_level"
+
+// CompressionLevel returns the compression level in the properties, or 0
+// for the default level of the algorithm.
+func CompressionLevel(properties []Property) int {
+	for _, property := range properties {
+		if property.Key == CompressionLevelProperty {
+			level, _ := strconv.Atoi(property.Value)
+			return level
+		}
+	}
+	return 0
+}
+
 type NodeInfo struct {
 	Mcpu int
 }
@@ -74,6 +93,14 @@ type Statistics interface {
 	Size(string) int64
 }
 
+// ColumnStatistics is implemented by the relations which keep the statistics of their columns
+type ColumnStatistics interface {
+	// CardinalNumber returns the number of the distinct values of the column, 0 if unknown
+	CardinalNumber(string) int64
+	// Range returns the min and max of the column, false if unknown
+	Range(string) (min, max interface{}, ok bool)
+}
+
 type ListPartition struct {
 	Name         string
 	Extends      []extend.Extend
@@ -122,6 +149,12 @@ type AttributeDef struct {
 	Attr Attribute
 }
 
+// RenameColumnDef renames a column of a table
+type RenameColumnDef struct {
+	Name    string
+	NewName string
+}
+
 type CommentDef struct {
 	Comment string
 }
@@ -130,11 +163,12 @@ type TableDef interface {
 	tableDef()
 }
 
-func (*CommentDef) tableDef()     {}
-func (*AttributeDef) tableDef()   {}
-func (*IndexTableDef) tableDef()  {}
-func (*PartitionByDef) tableDef() {}
-func (*PropertiesDef) tableDef()  {}
+func (*CommentDef) tableDef()      {}
+func (*AttributeDef) tableDef()    {}
+func (*IndexTableDef) tableDef()   {}
+func (*PartitionByDef) tableDef()  {}
+func (*PropertiesDef) tableDef()   {}
+func (*RenameColumnDef) tableDef() {}
 
 type Relation interface {
 	Statistics
@@ -208,6 +242,13 @@ type Engine interface {
 	Node(string) *NodeInfo
 }
 
+// SnapshotEngine is implemented by the engines which keep the history versions of the data
+type SnapshotEngine interface {
+	Engine
+	// Snapshot returns an engine which reads the data as of ts
+	Snapshot(ts time.Time) (Engine, error)
+}
+
 // MakeDefaultExpr returns a new DefaultExpr
 func MakeDefaultExpr(exist bool, value interface{}, isNull bool) DefaultExpr {
 	return DefaultExpr{
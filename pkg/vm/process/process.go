@@ -29,6 +29,18 @@ func New(m *mheap.Mheap) *Process {
 	}
 }
 
+// NewFromProc creates a new Process for a pipeline of the query run by the process p,
+// the query id, limitation, time zone and analyze setting are inherited from p.
+func NewFromProc(m *mheap.Mheap, p *Process) *Process {
+	return &Process{
+		Id:       p.Id,
+		Lim:      p.Lim,
+		Mp:       m,
+		TimeZone: p.TimeZone,
+		Analyze:  p.Analyze,
+	}
+}
+
 func GetSels(proc *Process) []int64 {
 	if len(proc.Reg.Ss) == 0 {
 		return make([]int64, 0, 16)
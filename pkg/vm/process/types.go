@@ -16,6 +16,8 @@ package process
 
 import (
 	"context"
+	"time"
+
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
@@ -64,5 +66,40 @@ type Process struct {
 	Lim Limitation
 	Mp  *mheap.Mheap
 
+	// TimeZone, the time zone of the session which the TIMESTAMP values are read and written in,
+	// nil is the server time zone.
+	TimeZone *time.Location
+
+	// Analyze, collects the runtime statistics of the instructions if it is true.
+	Analyze bool
+	// Analysis, the runtime statistics of the instructions run by the process,
+	// it is in the same order as the instructions.
+	Analysis []AnalyzeInfo
+	// SpillSize, the number of bytes spilled to disk by the process.
+	SpillSize int64
+
 	Cancel context.CancelFunc
 }
+
+// AnalyzeInfo contains the runtime statistics of an instruction, which are
+// accumulated over all the calls of the instruction.
+type AnalyzeInfo struct {
+	// InputRows, the number of rows of the batches passed to the instruction.
+	InputRows int64
+	// OutputRows, the number of rows of the batches produced by the instruction.
+	OutputRows int64
+	// InputBatches, the number of batches passed to the instruction.
+	InputBatches int64
+	// OutputBatches, the number of batches produced by the instruction.
+	OutputBatches int64
+	// WallTime, the elapsed time of the calls in nanoseconds, including the time waiting for the input.
+	WallTime int64
+	// CPUTime, the cpu time of the calls in nanoseconds.
+	CPUTime int64
+	// MemorySize, the memory size allocated by the calls and not freed yet.
+	MemorySize int64
+	// MemoryPeak, the maximum of MemorySize after a call of the instruction.
+	MemoryPeak int64
+	// SpillSize, the number of bytes spilled to disk by the calls.
+	SpillSize int64
+}
@@ -0,0 +1,486 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/encoding"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+)
+
+// Exceeded returns true if the memory used by an operator reaches the memory budget
+// of the query, limit <= 0 means the memory is unlimited.
+func Exceeded(limit, size int64) bool {
+	return limit > 0 && size >= limit
+}
+
+// Size returns the memory size of the vectors.
+func Size(vecs []*vector.Vector) int64 {
+	var size int64
+
+	for _, vec := range vecs {
+		if vs, ok := vec.Col.(*types.Bytes); ok {
+			size += int64(len(vs.Data)) + int64(len(vs.Offsets))*8
+			continue
+		}
+		width := int64(vec.Typ.Size)
+		if width == 0 {
+			width = int64(vec.Typ.Oid.FixedLength())
+		}
+		if width < 0 {
+			continue
+		}
+		size += int64(vector.Length(vec)) * width
+	}
+	return size
+}
+
+// NewFile creates a temporary file in Dir.
+func NewFile() (*File, error) {
+	f, err := os.CreateTemp(Dir, "mo-spill-*")
+	if err != nil {
+		return nil, err
+	}
+	return &File{f: f, w: bufio.NewWriter(f)}, nil
+}
+
+// Rows returns the number of rows written to the file.
+func (f *File) Rows() int64 {
+	return f.rows
+}
+
+// Size returns the number of bytes written to the file.
+func (f *File) Size() int64 {
+	return f.size
+}
+
+// Write writes a batch to the file, the batch should have been shuffled.
+func (f *File) Write(bat *batch.Batch) error {
+	return f.WriteVectors(bat.Attrs, bat.Vecs, bat.Zs)
+}
+
+// WriteVectors writes the columns of a batch to the file, attrs can be nil if
+// the columns are identified by their positions.
+func (f *File) WriteVectors(attrs []string, vecs []*vector.Vector, zs []int64) error {
+	if err := f.writeUint32(uint32(len(attrs))); err != nil {
+		return err
+	}
+	for _, attr := range attrs {
+		if err := f.writeBytes([]byte(attr)); err != nil {
+			return err
+		}
+	}
+	if err := f.writeUint32(uint32(len(vecs))); err != nil {
+		return err
+	}
+	for _, vec := range vecs {
+		data, err := compact(vec).Show()
+		if err != nil {
+			return err
+		}
+		if err := f.writeBytes(data); err != nil {
+			return err
+		}
+	}
+	if err := f.writeBytes(encoding.EncodeInt64Slice(zs)); err != nil {
+		return err
+	}
+	f.rows += int64(len(zs))
+	return nil
+}
+
+// WriteRun writes the columns of a sorted batch to the file in pieces of BatchRows rows,
+// so the run can be merged without loading it into memory.
+func (f *File) WriteRun(attrs []string, vecs []*vector.Vector, zs []int64, m *mheap.Mheap) error {
+	if len(zs) <= BatchRows {
+		return f.WriteVectors(attrs, vecs, zs)
+	}
+	flags := make([]uint8, BatchRows)
+	for i := range flags {
+		flags[i] = 1
+	}
+	pvecs := make([]*vector.Vector, len(vecs))
+	for start := 0; start < len(zs); start += BatchRows {
+		n := len(zs) - start
+		if n > BatchRows {
+			n = BatchRows
+		}
+		for i, vec := range vecs {
+			pvecs[i] = vector.New(vec.Typ)
+			if err := vector.UnionBatch(pvecs[i], vec, int64(start), n, flags[:n], m); err != nil {
+				cleanVectors(pvecs, m)
+				return err
+			}
+		}
+		err := f.WriteVectors(attrs, pvecs, zs[start:start+n])
+		cleanVectors(pvecs, m)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewReader flushes the file and returns a reader which reads the file from the beginning.
+func (f *File) NewReader() (*Reader, error) {
+	if err := f.w.Flush(); err != nil {
+		return nil, err
+	}
+	r, err := os.Open(f.f.Name())
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{f: r, r: bufio.NewReader(r)}, nil
+}
+
+// Close closes and removes the file.
+func (f *File) Close() error {
+	err := f.f.Close()
+	if rerr := os.Remove(f.f.Name()); err == nil {
+		err = rerr
+	}
+	return err
+}
+
+func (f *File) writeUint32(v uint32) error {
+	n, err := f.w.Write(encoding.EncodeUint32(v))
+	f.size += int64(n)
+	return err
+}
+
+func (f *File) writeBytes(data []byte) error {
+	if err := f.writeUint32(uint32(len(data))); err != nil {
+		return err
+	}
+	n, err := f.w.Write(data)
+	f.size += int64(n)
+	return err
+}
+
+// Read returns the next batch of the file, it returns nil if all the batches are read.
+func (r *Reader) Read(m *mheap.Mheap) (*batch.Batch, error) {
+	attrs, vecs, zs, err := r.ReadVectors(m)
+	if err != nil || vecs == nil {
+		return nil, err
+	}
+	bat := batch.New(true, attrs)
+	bat.Vecs = vecs
+	bat.Zs = zs
+	return bat, nil
+}
+
+// ReadVectors returns the columns of the next batch of the file, vecs is nil if all the batches are read.
+func (r *Reader) ReadVectors(m *mheap.Mheap) ([]string, []*vector.Vector, []int64, error) {
+	n, err := r.readUint32()
+	if err == io.EOF {
+		return nil, nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	attrs := make([]string, n)
+	for i := range attrs {
+		data, err := r.readBytes()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		attrs[i] = string(data)
+	}
+	if n, err = r.readUint32(); err != nil {
+		return nil, nil, nil, err
+	}
+	vecs := make([]*vector.Vector, n)
+	for i := range vecs {
+		data, err := r.readBytes()
+		if err != nil {
+			cleanVectors(vecs, m)
+			return nil, nil, nil, err
+		}
+		vec := vector.New(encoding.DecodeType(data[:encoding.TypeSize]))
+		if err := vec.Read(data); err != nil {
+			cleanVectors(vecs, m)
+			return nil, nil, nil, err
+		}
+		// the vector read references the buffer, so duplicate it to make it writable
+		if vecs[i], err = vector.Dup(vec, m); err != nil {
+			cleanVectors(vecs, m)
+			return nil, nil, nil, err
+		}
+	}
+	data, err := r.readBytes()
+	if err != nil {
+		cleanVectors(vecs, m)
+		return nil, nil, nil, err
+	}
+	zs := make([]int64, len(data)/8)
+	copy(zs, encoding.DecodeInt64Slice(data))
+	return attrs, vecs, zs, nil
+}
+
+// Close closes the reader, the file is still readable by other readers.
+func (r *Reader) Close() error {
+	return r.f.Close()
+}
+
+func (r *Reader) readUint32() (uint32, error) {
+	var buf [4]byte
+
+	if _, err := io.ReadFull(r.r, buf[:]); err != nil {
+		return 0, err
+	}
+	return encoding.DecodeUint32(buf[:]), nil
+}
+
+func (r *Reader) readBytes() ([]byte, error) {
+	n, err := r.readUint32()
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, n)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+// NewPartitions creates n files, the rows are distributed to them by the values of attrs.
+func NewPartitions(n int, attrs []string) (*Partitions, error) {
+	ps := &Partitions{
+		attrs: attrs,
+		files: make([]*File, 0, n),
+	}
+	for i := 0; i < n; i++ {
+		f, err := NewFile()
+		if err != nil {
+			ps.Close()
+			return nil, err
+		}
+		ps.files = append(ps.files, f)
+	}
+	return ps, nil
+}
+
+// Len returns the number of partitions.
+func (ps *Partitions) Len() int {
+	return len(ps.files)
+}
+
+// File returns the file of the i-th partition.
+func (ps *Partitions) File(i int) *File {
+	return ps.files[i]
+}
+
+// Size returns the number of bytes written to all the partitions.
+func (ps *Partitions) Size() int64 {
+	var size int64
+
+	for _, f := range ps.files {
+		size += f.size
+	}
+	return size
+}
+
+// Write distributes the rows of a shuffled batch to the partitions.
+func (ps *Partitions) Write(bat *batch.Batch, m *mheap.Mheap) error {
+	n := len(bat.Zs)
+	if n == 0 {
+		return nil
+	}
+	vecs := make([]*vector.Vector, len(ps.attrs))
+	for i, attr := range ps.attrs {
+		vecs[i] = batch.GetVector(bat, attr)
+	}
+	hs := make([]uint64, n)
+	for i := range hs {
+		hs[i] = offset64
+	}
+	for _, vec := range vecs {
+		hashVector(hs, vec)
+	}
+	flags := make([]uint8, n)
+	for i, f := range ps.files {
+		cnt := 0
+		for j, h := range hs {
+			if int(h%uint64(len(ps.files))) == i {
+				flags[j] = 1
+				cnt++
+			} else {
+				flags[j] = 0
+			}
+		}
+		if cnt == 0 {
+			continue
+		}
+		if err := writePartition(f, bat, flags, cnt, m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close closes and removes all the files.
+func (ps *Partitions) Close() error {
+	var err error
+
+	for _, f := range ps.files {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}
+	ps.files = nil
+	return err
+}
+
+// writePartition writes the rows of bat whose flag is 1 to f.
+func writePartition(f *File, bat *batch.Batch, flags []uint8, cnt int, m *mheap.Mheap) error {
+	if cnt == len(bat.Zs) {
+		return f.Write(bat)
+	}
+	vecs := make([]*vector.Vector, len(bat.Vecs))
+	defer cleanVectors(vecs, m)
+	for i, vec := range bat.Vecs {
+		vecs[i] = vector.New(vec.Typ)
+		if err := vector.UnionBatch(vecs[i], vec, 0, cnt, flags, m); err != nil {
+			return err
+		}
+	}
+	zs := make([]int64, 0, cnt)
+	for i, flg := range flags {
+		if flg > 0 {
+			zs = append(zs, bat.Zs[i])
+		}
+	}
+	return f.WriteVectors(bat.Attrs, vecs, zs)
+}
+
+func cleanVectors(vecs []*vector.Vector, m *mheap.Mheap) {
+	for _, vec := range vecs {
+		if vec != nil {
+			vector.Clean(vec, m)
+		}
+	}
+}
+
+// compact returns a vector whose strings are stored in order, vector.Show
+// requires it but some operators only change the offsets of strings.
+func compact(vec *vector.Vector) *vector.Vector {
+	vs, ok := vec.Col.(*types.Bytes)
+	if !ok {
+		return vec
+	}
+	bs := &types.Bytes{
+		Offsets: make([]uint32, len(vs.Offsets)),
+		Lengths: vs.Lengths,
+	}
+	for i := range vs.Offsets {
+		bs.Offsets[i] = uint32(len(bs.Data))
+		bs.Data = append(bs.Data, vs.Get(int64(i))...)
+	}
+	return &vector.Vector{Typ: vec.Typ, Col: bs, Nsp: vec.Nsp}
+}
+
+const (
+	offset64 = 14695981039346656037
+	prime64  = 1099511628211
+)
+
+// hashVector mixes the values of vec into hs by FNV-1a, null values are skipped,
+// so the rows with the same values always get the same hash values.
+func hashVector(hs []uint64, vec *vector.Vector) {
+	if vs, ok := vec.Col.(*types.Bytes); ok {
+		for i := range hs {
+			if nulls.Contains(vec.Nsp, uint64(i)) {
+				continue
+			}
+			hs[i] = hashBytes(hs[i], vs.Get(int64(i)))
+		}
+		return
+	}
+	data, width := fixedBytes(vec)
+	if width == 0 {
+		return
+	}
+	for i := range hs {
+		if nulls.Contains(vec.Nsp, uint64(i)) {
+			continue
+		}
+		hs[i] = hashBytes(hs[i], data[i*width:(i+1)*width])
+	}
+}
+
+func hashBytes(h uint64, data []byte) uint64 {
+	for _, b := range data {
+		h ^= uint64(b)
+		h *= prime64
+	}
+	return h
+}
+
+// fixedBytes returns the memory of a fixed length vector and the width of its values.
+func fixedBytes(vec *vector.Vector) ([]byte, int) {
+	switch vs := vec.Col.(type) {
+	case []int8:
+		return sliceBytes(unsafe.Pointer(&vs), len(vs), 1)
+	case []int16:
+		return sliceBytes(unsafe.Pointer(&vs), len(vs), 2)
+	case []int32:
+		return sliceBytes(unsafe.Pointer(&vs), len(vs), 4)
+	case []int64:
+		return sliceBytes(unsafe.Pointer(&vs), len(vs), 8)
+	case []uint8:
+		return sliceBytes(unsafe.Pointer(&vs), len(vs), 1)
+	case []uint16:
+		return sliceBytes(unsafe.Pointer(&vs), len(vs), 2)
+	case []uint32:
+		return sliceBytes(unsafe.Pointer(&vs), len(vs), 4)
+	case []uint64:
+		return sliceBytes(unsafe.Pointer(&vs), len(vs), 8)
+	case []float32:
+		return sliceBytes(unsafe.Pointer(&vs), len(vs), 4)
+	case []float64:
+		return sliceBytes(unsafe.Pointer(&vs), len(vs), 8)
+	case []types.Date:
+		return sliceBytes(unsafe.Pointer(&vs), len(vs), 4)
+	case []types.Datetime:
+		return sliceBytes(unsafe.Pointer(&vs), len(vs), 8)
+	case []types.Timestamp:
+		return sliceBytes(unsafe.Pointer(&vs), len(vs), 8)
+	case []types.Decimal64:
+		return sliceBytes(unsafe.Pointer(&vs), len(vs), 8)
+	case []types.Decimal128:
+		return sliceBytes(unsafe.Pointer(&vs), len(vs), 16)
+	}
+	return nil, 0
+}
+
+// sliceBytes returns the memory of the slice whose header is at p.
+func sliceBytes(p unsafe.Pointer, n, width int) ([]byte, int) {
+	if n == 0 {
+		return nil, width
+	}
+	data := *(*unsafe.Pointer)(p)
+	return unsafe.Slice((*byte)(data), n*width), width
+}
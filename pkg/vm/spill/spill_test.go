@@ -0,0 +1,133 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/mheap"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/guest"
+	"github.com/matrixorigin/matrixone/pkg/vm/mmu/host"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExceeded(t *testing.T) {
+	require.False(t, Exceeded(0, 1<<40))
+	require.False(t, Exceeded(100, 99))
+	require.True(t, Exceeded(100, 100))
+}
+
+func TestFile(t *testing.T) {
+	m := newTestMheap()
+	f, err := NewFile()
+	require.NoError(t, err)
+	defer f.Close()
+
+	bat := newTestBatch(t, 0, 10)
+	require.NoError(t, f.Write(bat))
+	require.NoError(t, f.Write(newTestBatch(t, 10, 5)))
+	require.Equal(t, int64(15), f.Rows())
+
+	r, err := f.NewReader()
+	require.NoError(t, err)
+	defer r.Close()
+	rbat, err := r.Read(m)
+	require.NoError(t, err)
+	require.Equal(t, bat.Attrs, rbat.Attrs)
+	require.Equal(t, bat.Zs, rbat.Zs)
+	require.Equal(t, bat.Vecs[0].Col, rbat.Vecs[0].Col)
+	require.Equal(t, bat.Vecs[1].Col, rbat.Vecs[1].Col)
+	require.True(t, nulls.Contains(rbat.Vecs[1].Nsp, 3))
+	require.NoError(t, vector.UnionOne(rbat.Vecs[0], bat.Vecs[0], 0, m))
+
+	rbat, err = r.Read(m)
+	require.NoError(t, err)
+	require.Equal(t, []int64{10, 11, 12, 13, 14}, rbat.Vecs[0].Col)
+	rbat, err = r.Read(m)
+	require.NoError(t, err)
+	require.Nil(t, rbat)
+}
+
+func TestPartitions(t *testing.T) {
+	m := newTestMheap()
+	ps, err := NewPartitions(4, []string{"b"})
+	require.NoError(t, err)
+	defer ps.Close()
+
+	// the values of b repeat every 6 rows
+	for i := 0; i < 3; i++ {
+		require.NoError(t, ps.Write(newTestBatch(t, int64(i*20), 20), m))
+	}
+	rows := int64(0)
+	seen := make(map[string]int)
+	for i := 0; i < ps.Len(); i++ {
+		rows += ps.File(i).Rows()
+		r, err := ps.File(i).NewReader()
+		require.NoError(t, err)
+		for {
+			bat, err := r.Read(m)
+			require.NoError(t, err)
+			if bat == nil {
+				break
+			}
+			require.Equal(t, len(bat.Zs), vector.Length(batch.GetVector(bat, "a")))
+			vec := batch.GetVector(bat, "b")
+			vs := vec.Col.(*types.Bytes)
+			for j := range bat.Zs {
+				if nulls.Contains(vec.Nsp, uint64(j)) {
+					continue
+				}
+				key := string(vs.Get(int64(j)))
+				if p, ok := seen[key]; ok {
+					require.Equal(t, p, i, key)
+				}
+				seen[key] = i
+			}
+		}
+		require.NoError(t, r.Close())
+	}
+	require.Equal(t, int64(60), rows)
+	require.Equal(t, 6, len(seen))
+}
+
+func newTestMheap() *mheap.Mheap {
+	return mheap.New(guest.New(1<<30, host.New(1<<30)))
+}
+
+// newTestBatch returns a batch with n rows, a is from start and b is the string of a%6,
+// the 4th row of b is null.
+func newTestBatch(t *testing.T, start int64, n int) *batch.Batch {
+	bat := batch.New(true, []string{"a", "b"})
+	bat.Vecs[0] = vector.New(types.Type{Oid: types.T_int64, Size: 8})
+	bat.Vecs[1] = vector.New(types.Type{Oid: types.T_varchar, Size: 24})
+	as := make([]int64, n)
+	bs := make([][]byte, n)
+	for i := range as {
+		as[i] = start + int64(i)
+		bs[i] = []byte(fmt.Sprintf("%v", as[i]%6))
+	}
+	require.NoError(t, vector.Append(bat.Vecs[0], as))
+	require.NoError(t, vector.Append(bat.Vecs[1], bs))
+	if n > 3 {
+		nulls.Add(bat.Vecs[1].Nsp, 3)
+	}
+	bat.InitZsOne(n)
+	return bat
+}
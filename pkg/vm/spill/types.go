@@ -0,0 +1,55 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spill
+
+import (
+	"bufio"
+	"os"
+)
+
+const (
+	// BatchRows is the maximum number of rows of a batch written to or produced from the spilled data.
+	BatchRows = 8192
+	// PartitionCount is the number of partitions the hash tables are spilled to.
+	PartitionCount = 16
+)
+
+// Dir is the directory where the temporary files are created.
+var Dir = os.TempDir()
+
+// File is a temporary file which stores the batches spilled by an operator,
+// the file is removed when it is closed.
+type File struct {
+	f *os.File
+	w *bufio.Writer
+	// rows, the number of rows written to the file.
+	rows int64
+	// size, the number of bytes written to the file.
+	size int64
+}
+
+// Reader reads the batches of a File in the order they are written.
+type Reader struct {
+	f *os.File
+	r *bufio.Reader
+}
+
+// Partitions spills the rows to a group of files by the hash values of the key attributes,
+// so the rows with the same keys are always written to the same file.
+type Partitions struct {
+	// attrs, the key attributes.
+	attrs []string
+	files []*File
+}
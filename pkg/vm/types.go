@@ -42,6 +42,42 @@ const (
 	UpdateTag
 )
 
+var names = [...]string{
+	Top:         "Top",
+	Join:        "Join",
+	Plus:        "Plus",
+	Limit:       "Limit",
+	Times:       "Times",
+	Merge:       "Merge",
+	Dedup:       "Dedup",
+	Order:       "Order",
+	Oplus:       "Oplus",
+	Output:      "Output",
+	Offset:      "Offset",
+	Restrict:    "Restrict",
+	Connector:   "Connector",
+	Transform:   "Transform",
+	Projection:  "Projection",
+	UnTransform: "UnTransform",
+
+	MergeDedup:  "Merge Dedup",
+	MergeLimit:  "Merge Limit",
+	MergeOffset: "Merge Offset",
+	MergeOrder:  "Merge Order",
+	MergeTop:    "Merge Top",
+
+	DeleteTag: "Delete",
+	UpdateTag: "Update",
+}
+
+// Name returns the name of the operator code op.
+func Name(op int) string {
+	if op < 0 || op >= len(names) {
+		return "Unknown"
+	}
+	return names[op]
+}
+
 // Instruction contains relational algebra
 type Instruction struct {
 	// Op specified the operator code of an instruction.
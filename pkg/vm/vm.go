@@ -51,8 +51,13 @@ func Run(ins Instructions, proc *process.Process) (bool, error) {
 			err = moerr.NewPanicError(e)
 		}
 	}()
-	for _, in := range ins {
-		if ok, err = execFunc[in.Op](proc, in.Arg); err != nil {
+	for i, in := range ins {
+		if proc.Analyze {
+			ok, err = analyzeCall(i, ins, proc)
+		} else {
+			ok, err = execFunc[in.Op](proc, in.Arg)
+		}
+		if err != nil {
 			return ok || end, err
 		}
 		if ok { // ok is true shows that at least one operator has done its work